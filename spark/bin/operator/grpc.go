@@ -10,6 +10,7 @@ import (
 	pbgossip "github.com/lightsparkdev/spark/proto/gossip"
 	pbmock "github.com/lightsparkdev/spark/proto/mock"
 	pbspark "github.com/lightsparkdev/spark/proto/spark"
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
 	pbauthn "github.com/lightsparkdev/spark/proto/spark_authn"
 	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	pbtoken "github.com/lightsparkdev/spark/proto/spark_token"
@@ -49,7 +50,7 @@ func RegisterGrpcServers(
 	pbinternal.RegisterSparkInternalServiceServer(grpcServer, sparkInternalServer)
 
 	// Public SO endpoint
-	sparkServer := sparkgrpc.NewSparkServer(config, mockAction)
+	sparkServer := sparkgrpc.NewSparkServer(config, dbClient, mockAction)
 	pbspark.RegisterSparkServiceServer(grpcServer, sparkServer)
 
 	// Public SO token endpoint
@@ -79,6 +80,10 @@ func RegisterGrpcServers(
 	}
 	pbauthn.RegisterSparkAuthnServiceServer(grpcServer, authnServer)
 
+	// Operator admin endpoint, restricted to the admin identity key
+	adminServer := sparkgrpc.NewSparkAdminServer(config, dbClient)
+	pbadmin.RegisterSparkAdminServiceServer(grpcServer, adminServer)
+
 	// Healthcheck endpoint
 	healthService := health.NewServer()
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthService)
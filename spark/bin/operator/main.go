@@ -14,6 +14,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
@@ -84,6 +85,7 @@ type args struct {
 	RunDirectory               string
 	RateLimiterEnabled         bool
 	RateLimiterMemcachedAddrs  string
+	RateLimiterFailOpen        bool
 	RateLimiterWindow          time.Duration
 	RateLimiterMaxRequests     int
 	RateLimiterMethods         string
@@ -134,6 +136,7 @@ func loadArgs() (*args, error) {
 	flag.StringVar(&args.RunDirectory, "run-dir", "", "Run directory for resolving relative paths")
 	flag.BoolVar(&args.RateLimiterEnabled, "rate-limiter-enabled", false, "Enable rate limiting")
 	flag.StringVar(&args.RateLimiterMemcachedAddrs, "rate-limiter-memcached-addrs", "", "Comma-separated list of Memcached addresses")
+	flag.BoolVar(&args.RateLimiterFailOpen, "rate-limiter-fail-open", false, "Allow requests when the rate limiter's Memcached servers are unreachable")
 	flag.DurationVar(&args.RateLimiterWindow, "rate-limiter-window", 60*time.Second, "Rate limiter time window")
 	flag.IntVar(&args.RateLimiterMaxRequests, "rate-limiter-max-requests", 100, "Maximum requests allowed in the time window")
 	flag.StringVar(&args.RateLimiterMethods, "rate-limiter-methods", "", "Comma-separated list of methods to rate limit")
@@ -281,6 +284,10 @@ func main() {
 			Window:      args.RateLimiterWindow,
 			MaxRequests: args.RateLimiterMaxRequests,
 			Methods:     strings.Split(args.RateLimiterMethods, ","),
+			MemcachedAddrs: slices.DeleteFunc(strings.Split(args.RateLimiterMemcachedAddrs, ","), func(addr string) bool {
+				return addr == ""
+			}),
+			FailOpen: args.RateLimiterFailOpen,
 		},
 	)
 	if err != nil {
@@ -320,7 +327,15 @@ func main() {
 	}
 
 	var valuesProvider knobs.KnobsValuesProvider
-	if config.Knobs.IsEnabled() {
+	if config.Knobs.IsEnabled() && config.Knobs.File != "" {
+		fileProvider, err := knobs.NewKnobsFileValuesProvider(errCtx, config.Knobs.File, knobs.DefaultFilePollInterval)
+		if err != nil {
+			// Knobs has failed to read the file, so the controllers will rely on the default values.
+			slog.Error("Failed to create file knobs", "error", err)
+		} else {
+			valuesProvider = fileProvider
+		}
+	} else if config.Knobs.IsEnabled() {
 		if valuesProvider, err = knobs.NewKnobsK8ValuesProvider(errCtx); err != nil {
 			// Knobs has failed to fetch the config, so the controllers will rely on the default values.
 			slog.Error("Failed to create K8 knobs", "error", err)
@@ -448,7 +463,7 @@ func main() {
 	}
 
 	var rateLimiter *middleware.RateLimiter
-	slog.Info("Rate limiter config", "enabled", config.RateLimiter.Enabled, "window", config.RateLimiter.Window, "max_requests", config.RateLimiter.MaxRequests, "methods", config.RateLimiter.Methods)
+	slog.Info("Rate limiter config", "enabled", config.RateLimiter.Enabled, "window", config.RateLimiter.Window, "max_requests", config.RateLimiter.MaxRequests, "methods", config.RateLimiter.Methods, "memcached_addrs", config.RateLimiter.MemcachedAddrs, "fail_open", config.RateLimiter.FailOpen)
 	if config.RateLimiter.Enabled {
 		var err error
 		rateLimiter, err = createRateLimiter(config)
@@ -509,6 +524,10 @@ func main() {
 			sparkgrpc.TimeoutInterceptor(knobsService, config.GRPC.ServerUnaryHandlerTimeout),
 			sparkgrpc.SparkTokenMetricsInterceptor(),
 			sparkgrpc.PanicRecoveryInterceptor(config.ReturnDetailedPanicErrors),
+			authn.NewInterceptor(sessionTokenCreatorVerifier).AuthnInterceptor,
+			// Rate limiting runs after authentication so that requests can be limited by session identity, and
+			// before any interceptor that opens a database transaction or reserves signing commitments so that
+			// rejected requests stay cheap.
 			func() grpc.UnaryServerInterceptor {
 				if rateLimiter != nil {
 					return rateLimiter.UnaryServerInterceptor()
@@ -519,7 +538,6 @@ func main() {
 			}(),
 			sparkgrpc.DatabaseSessionMiddleware(db.NewDefaultSessionFactory(dbClient, config.Database.NewTxTimeout)),
 			helper.SigningCommitmentInterceptor(config.SigningOperatorMap, knobsService),
-			authn.NewInterceptor(sessionTokenCreatorVerifier).AuthnInterceptor,
 			authz.NewAuthzInterceptor(authz.NewAuthzConfig(
 				authz.WithMode(config.ServiceAuthz.Mode),
 				authz.WithAllowedIPs(config.ServiceAuthz.IPAllowlist),
@@ -531,6 +549,14 @@ func main() {
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
 			sparkerrors.ErrorWrappingStreamingInterceptor(),
 			authn.NewInterceptor(sessionTokenCreatorVerifier).StreamAuthnInterceptor,
+			func() grpc.StreamServerInterceptor {
+				if rateLimiter != nil {
+					return rateLimiter.StreamServerInterceptor()
+				}
+				return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+					return handler(srv, ss)
+				}
+			}(),
 			authz.NewAuthzInterceptor(authz.NewAuthzConfig(
 				authz.WithMode(config.ServiceAuthz.Mode),
 				authz.WithAllowedIPs(config.ServiceAuthz.IPAllowlist),
@@ -258,6 +258,17 @@ func P2TRAddressFromPkScript(pkScript []byte, network Network) (*string, error)
 	return nil, fmt.Errorf("not a Taproot address")
 }
 
+// EphemeralAnchorPkScript returns the script of the zero value output that cpfp transactions are fee-bumped
+// through.
+func EphemeralAnchorPkScript() []byte {
+	return []byte{txscript.OP_TRUE, 0x02, 0x4e, 0x73}
+}
+
+// EphemeralAnchorOutput returns the zero value anchor output of cpfp transactions.
+func EphemeralAnchorOutput() *wire.TxOut {
+	return wire.NewTxOut(0, EphemeralAnchorPkScript())
+}
+
 // TxFromRawTxHex returns a btcd MsgTx from a raw tx hex.
 func TxFromRawTxHex(rawTxHex string) (*wire.MsgTx, error) {
 	txBytes, err := hex.DecodeString(rawTxHex)
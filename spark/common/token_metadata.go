@@ -57,6 +57,7 @@ type TokenMetadata struct {
 	IsFreezable             bool
 	CreationEntityPublicKey []byte
 	Network                 Network
+	IsTransferRestricted    bool
 }
 
 var (
@@ -84,6 +85,7 @@ func NewTokenMetadataFromCreateInput(
 		IsFreezable:             createInput.GetIsFreezable(),
 		CreationEntityPublicKey: createInput.GetCreationEntityPublicKey(),
 		Network:                 network,
+		IsTransferRestricted:    createInput.GetIsTransferRestricted(),
 	}, nil
 }
 
@@ -101,6 +103,7 @@ func (tm *TokenMetadata) ToTokenMetadataProto() *tokenpb.TokenMetadata {
 		IsFreezable:             tm.IsFreezable,
 		CreationEntityPublicKey: tm.CreationEntityPublicKey,
 		TokenIdentifier:         tokenIdentifier,
+		IsTransferRestricted:    tm.IsTransferRestricted,
 	}
 }
 
@@ -157,6 +160,11 @@ func (tm *TokenMetadata) ComputeTokenIdentifierV1() (TokenIdentifier, error) {
 	} else {
 		h.Write(sha256Slice(append([]byte{byte(tokenCreateLayer)}, tm.CreationEntityPublicKey...)))
 	}
+
+	// Hash transfer restricted flag (1 byte), only when set so that existing identifiers are unchanged
+	if tm.IsTransferRestricted {
+		h.Write(trueHash)
+	}
 	return h.Sum(nil), nil
 }
 
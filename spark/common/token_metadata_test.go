@@ -543,6 +543,16 @@ func TestTokenMetadata_ComputeTokenIdentifier(t *testing.T) {
 			},
 			shouldBeEqual: false,
 		},
+		{
+			name: "different transfer restricted flags produce different hashes",
+			modifier1: func(tm *TokenMetadata) {
+				tm.IsTransferRestricted = true
+			},
+			modifier2: func(tm *TokenMetadata) {
+				tm.IsTransferRestricted = false
+			},
+			shouldBeEqual: false,
+		},
 		{
 			name: "different max supply produces different hashes",
 			modifier1: func(tm *TokenMetadata) {
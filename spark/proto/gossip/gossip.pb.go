@@ -41,6 +41,7 @@ type GossipMessage struct {
 	//	*GossipMessage_FinalizeExtendLeaf
 	//	*GossipMessage_RollbackUtxoSwap
 	//	*GossipMessage_DepositCleanup
+	//	*GossipMessage_SettleBatchSenderKeyTweak
 	Message       isGossipMessage_Message `protobuf_oneof:"message"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -180,6 +181,15 @@ func (x *GossipMessage) GetDepositCleanup() *GossipMessageDepositCleanup {
 	return nil
 }
 
+func (x *GossipMessage) GetSettleBatchSenderKeyTweak() *GossipMessageSettleBatchSenderKeyTweak {
+	if x != nil {
+		if x, ok := x.Message.(*GossipMessage_SettleBatchSenderKeyTweak); ok {
+			return x.SettleBatchSenderKeyTweak
+		}
+	}
+	return nil
+}
+
 type isGossipMessage_Message interface {
 	isGossipMessage_Message()
 }
@@ -224,6 +234,10 @@ type GossipMessage_DepositCleanup struct {
 	DepositCleanup *GossipMessageDepositCleanup `protobuf:"bytes,12,opt,name=deposit_cleanup,json=depositCleanup,proto3,oneof"`
 }
 
+type GossipMessage_SettleBatchSenderKeyTweak struct {
+	SettleBatchSenderKeyTweak *GossipMessageSettleBatchSenderKeyTweak `protobuf:"bytes,13,opt,name=settle_batch_sender_key_tweak,json=settleBatchSenderKeyTweak,proto3,oneof"`
+}
+
 func (*GossipMessage_CancelTransfer) isGossipMessage_Message() {}
 
 func (*GossipMessage_SettleSenderKeyTweak) isGossipMessage_Message() {}
@@ -244,6 +258,8 @@ func (*GossipMessage_RollbackUtxoSwap) isGossipMessage_Message() {}
 
 func (*GossipMessage_DepositCleanup) isGossipMessage_Message() {}
 
+func (*GossipMessage_SettleBatchSenderKeyTweak) isGossipMessage_Message() {}
+
 type GossipMessageCancelTransfer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TransferId    string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
@@ -389,6 +405,59 @@ func (x *GossipMessageSettleSenderKeyTweak) GetSenderKeyTweakProofs() map[string
 	return nil
 }
 
+// Settles the sender key tweaks of every transfer in a batch transfer.
+type GossipMessageSettleBatchSenderKeyTweak struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	BatchId       string                               `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Transfers     []*GossipMessageSettleSenderKeyTweak `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GossipMessageSettleBatchSenderKeyTweak) Reset() {
+	*x = GossipMessageSettleBatchSenderKeyTweak{}
+	mi := &file_gossip_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GossipMessageSettleBatchSenderKeyTweak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipMessageSettleBatchSenderKeyTweak) ProtoMessage() {}
+
+func (x *GossipMessageSettleBatchSenderKeyTweak) ProtoReflect() protoreflect.Message {
+	mi := &file_gossip_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipMessageSettleBatchSenderKeyTweak.ProtoReflect.Descriptor instead.
+func (*GossipMessageSettleBatchSenderKeyTweak) Descriptor() ([]byte, []int) {
+	return file_gossip_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GossipMessageSettleBatchSenderKeyTweak) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *GossipMessageSettleBatchSenderKeyTweak) GetTransfers() []*GossipMessageSettleSenderKeyTweak {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
 type GossipMessageMarkTreesExited struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TreeIds       []string               `protobuf:"bytes,1,rep,name=tree_ids,json=treeIds,proto3" json:"tree_ids,omitempty"`
@@ -398,7 +467,7 @@ type GossipMessageMarkTreesExited struct {
 
 func (x *GossipMessageMarkTreesExited) Reset() {
 	*x = GossipMessageMarkTreesExited{}
-	mi := &file_gossip_proto_msgTypes[4]
+	mi := &file_gossip_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -410,7 +479,7 @@ func (x *GossipMessageMarkTreesExited) String() string {
 func (*GossipMessageMarkTreesExited) ProtoMessage() {}
 
 func (x *GossipMessageMarkTreesExited) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[4]
+	mi := &file_gossip_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +492,7 @@ func (x *GossipMessageMarkTreesExited) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipMessageMarkTreesExited.ProtoReflect.Descriptor instead.
 func (*GossipMessageMarkTreesExited) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{4}
+	return file_gossip_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GossipMessageMarkTreesExited) GetTreeIds() []string {
@@ -443,7 +512,7 @@ type GossipMessageFinalizeTreeCreation struct {
 
 func (x *GossipMessageFinalizeTreeCreation) Reset() {
 	*x = GossipMessageFinalizeTreeCreation{}
-	mi := &file_gossip_proto_msgTypes[5]
+	mi := &file_gossip_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +524,7 @@ func (x *GossipMessageFinalizeTreeCreation) String() string {
 func (*GossipMessageFinalizeTreeCreation) ProtoMessage() {}
 
 func (x *GossipMessageFinalizeTreeCreation) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[5]
+	mi := &file_gossip_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +537,7 @@ func (x *GossipMessageFinalizeTreeCreation) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GossipMessageFinalizeTreeCreation.ProtoReflect.Descriptor instead.
 func (*GossipMessageFinalizeTreeCreation) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{5}
+	return file_gossip_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GossipMessageFinalizeTreeCreation) GetInternalNodes() []*spark_internal.TreeNode {
@@ -496,7 +565,7 @@ type GossipMessageFinalizeTransfer struct {
 
 func (x *GossipMessageFinalizeTransfer) Reset() {
 	*x = GossipMessageFinalizeTransfer{}
-	mi := &file_gossip_proto_msgTypes[6]
+	mi := &file_gossip_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +577,7 @@ func (x *GossipMessageFinalizeTransfer) String() string {
 func (*GossipMessageFinalizeTransfer) ProtoMessage() {}
 
 func (x *GossipMessageFinalizeTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[6]
+	mi := &file_gossip_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +590,7 @@ func (x *GossipMessageFinalizeTransfer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipMessageFinalizeTransfer.ProtoReflect.Descriptor instead.
 func (*GossipMessageFinalizeTransfer) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{6}
+	return file_gossip_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GossipMessageFinalizeTransfer) GetTransferId() string {
@@ -554,7 +623,7 @@ type GossipMessageFinalizeRefreshTimelock struct {
 
 func (x *GossipMessageFinalizeRefreshTimelock) Reset() {
 	*x = GossipMessageFinalizeRefreshTimelock{}
-	mi := &file_gossip_proto_msgTypes[7]
+	mi := &file_gossip_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +635,7 @@ func (x *GossipMessageFinalizeRefreshTimelock) String() string {
 func (*GossipMessageFinalizeRefreshTimelock) ProtoMessage() {}
 
 func (x *GossipMessageFinalizeRefreshTimelock) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[7]
+	mi := &file_gossip_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +648,7 @@ func (x *GossipMessageFinalizeRefreshTimelock) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GossipMessageFinalizeRefreshTimelock.ProtoReflect.Descriptor instead.
 func (*GossipMessageFinalizeRefreshTimelock) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{7}
+	return file_gossip_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GossipMessageFinalizeRefreshTimelock) GetInternalNodes() []*spark_internal.TreeNode {
@@ -598,7 +667,7 @@ type GossipMessageFinalizeExtendLeaf struct {
 
 func (x *GossipMessageFinalizeExtendLeaf) Reset() {
 	*x = GossipMessageFinalizeExtendLeaf{}
-	mi := &file_gossip_proto_msgTypes[8]
+	mi := &file_gossip_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -610,7 +679,7 @@ func (x *GossipMessageFinalizeExtendLeaf) String() string {
 func (*GossipMessageFinalizeExtendLeaf) ProtoMessage() {}
 
 func (x *GossipMessageFinalizeExtendLeaf) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[8]
+	mi := &file_gossip_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +692,7 @@ func (x *GossipMessageFinalizeExtendLeaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipMessageFinalizeExtendLeaf.ProtoReflect.Descriptor instead.
 func (*GossipMessageFinalizeExtendLeaf) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{8}
+	return file_gossip_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GossipMessageFinalizeExtendLeaf) GetInternalNodes() []*spark_internal.TreeNode {
@@ -650,7 +719,7 @@ type GossipMessageRollbackUtxoSwap struct {
 
 func (x *GossipMessageRollbackUtxoSwap) Reset() {
 	*x = GossipMessageRollbackUtxoSwap{}
-	mi := &file_gossip_proto_msgTypes[9]
+	mi := &file_gossip_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -662,7 +731,7 @@ func (x *GossipMessageRollbackUtxoSwap) String() string {
 func (*GossipMessageRollbackUtxoSwap) ProtoMessage() {}
 
 func (x *GossipMessageRollbackUtxoSwap) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[9]
+	mi := &file_gossip_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -675,7 +744,7 @@ func (x *GossipMessageRollbackUtxoSwap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipMessageRollbackUtxoSwap.ProtoReflect.Descriptor instead.
 func (*GossipMessageRollbackUtxoSwap) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{9}
+	return file_gossip_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GossipMessageRollbackUtxoSwap) GetOnChainUtxo() *spark.UTXO {
@@ -708,7 +777,7 @@ type GossipMessageDepositCleanup struct {
 
 func (x *GossipMessageDepositCleanup) Reset() {
 	*x = GossipMessageDepositCleanup{}
-	mi := &file_gossip_proto_msgTypes[10]
+	mi := &file_gossip_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +789,7 @@ func (x *GossipMessageDepositCleanup) String() string {
 func (*GossipMessageDepositCleanup) ProtoMessage() {}
 
 func (x *GossipMessageDepositCleanup) ProtoReflect() protoreflect.Message {
-	mi := &file_gossip_proto_msgTypes[10]
+	mi := &file_gossip_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +802,7 @@ func (x *GossipMessageDepositCleanup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GossipMessageDepositCleanup.ProtoReflect.Descriptor instead.
 func (*GossipMessageDepositCleanup) Descriptor() ([]byte, []int) {
-	return file_gossip_proto_rawDescGZIP(), []int{10}
+	return file_gossip_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GossipMessageDepositCleanup) GetTreeId() string {
@@ -747,7 +816,7 @@ var File_gossip_proto protoreflect.FileDescriptor
 
 const file_gossip_proto_rawDesc = "" +
 	"\n" +
-	"\fgossip.proto\x12\x06gossip\x1a\vspark.proto\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14spark_internal.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc6\a\n" +
+	"\fgossip.proto\x12\x06gossip\x1a\vspark.proto\x1a\fcommon.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14spark_internal.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xba\b\n" +
 	"\rGossipMessage\x12\x1d\n" +
 	"\n" +
 	"message_id\x18\x01 \x01(\tR\tmessageId\x12N\n" +
@@ -761,7 +830,8 @@ const file_gossip_proto_rawDesc = "" +
 	"\x14finalize_extend_leaf\x18\n" +
 	" \x01(\v2'.gossip.GossipMessageFinalizeExtendLeafH\x00R\x12finalizeExtendLeaf\x12U\n" +
 	"\x12rollback_utxo_swap\x18\v \x01(\v2%.gossip.GossipMessageRollbackUtxoSwapH\x00R\x10rollbackUtxoSwap\x12N\n" +
-	"\x0fdeposit_cleanup\x18\f \x01(\v2#.gossip.GossipMessageDepositCleanupH\x00R\x0edepositCleanupB\t\n" +
+	"\x0fdeposit_cleanup\x18\f \x01(\v2#.gossip.GossipMessageDepositCleanupH\x00R\x0edepositCleanup\x12r\n" +
+	"\x1dsettle_batch_sender_key_tweak\x18\r \x01(\v2..gossip.GossipMessageSettleBatchSenderKeyTweakH\x00R\x19settleBatchSenderKeyTweakB\t\n" +
 	"\amessageJ\x04\b\x03\x10\x04\">\n" +
 	"\x1bGossipMessageCancelTransfer\x12\x1f\n" +
 	"\vtransfer_id\x18\x01 \x01(\tR\n" +
@@ -775,7 +845,10 @@ const file_gossip_proto_rawDesc = "" +
 	"\x17sender_key_tweak_proofs\x18\x02 \x03(\v2C.gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntryR\x14senderKeyTweakProofs\x1a[\n" +
 	"\x19SenderKeyTweakProofsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
-	"\x05value\x18\x02 \x01(\v2\x12.spark.SecretProofR\x05value:\x028\x01\"9\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.spark.SecretProofR\x05value:\x028\x01\"\x8c\x01\n" +
+	"&GossipMessageSettleBatchSenderKeyTweak\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12G\n" +
+	"\ttransfers\x18\x02 \x03(\v2).gossip.GossipMessageSettleSenderKeyTweakR\ttransfers\"9\n" +
 	"\x1cGossipMessageMarkTreesExited\x12\x19\n" +
 	"\btree_ids\x18\x01 \x03(\tR\atreeIds\"\x99\x01\n" +
 	"!GossipMessageFinalizeTreeCreation\x12?\n" +
@@ -811,54 +884,57 @@ func file_gossip_proto_rawDescGZIP() []byte {
 	return file_gossip_proto_rawDescData
 }
 
-var file_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_gossip_proto_goTypes = []any{
-	(*GossipMessage)(nil),                        // 0: gossip.GossipMessage
-	(*GossipMessageCancelTransfer)(nil),          // 1: gossip.GossipMessageCancelTransfer
-	(*GossipMessageRollbackTransfer)(nil),        // 2: gossip.GossipMessageRollbackTransfer
-	(*GossipMessageSettleSenderKeyTweak)(nil),    // 3: gossip.GossipMessageSettleSenderKeyTweak
-	(*GossipMessageMarkTreesExited)(nil),         // 4: gossip.GossipMessageMarkTreesExited
-	(*GossipMessageFinalizeTreeCreation)(nil),    // 5: gossip.GossipMessageFinalizeTreeCreation
-	(*GossipMessageFinalizeTransfer)(nil),        // 6: gossip.GossipMessageFinalizeTransfer
-	(*GossipMessageFinalizeRefreshTimelock)(nil), // 7: gossip.GossipMessageFinalizeRefreshTimelock
-	(*GossipMessageFinalizeExtendLeaf)(nil),      // 8: gossip.GossipMessageFinalizeExtendLeaf
-	(*GossipMessageRollbackUtxoSwap)(nil),        // 9: gossip.GossipMessageRollbackUtxoSwap
-	(*GossipMessageDepositCleanup)(nil),          // 10: gossip.GossipMessageDepositCleanup
-	nil,                                          // 11: gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntry
-	(*spark_internal.TreeNode)(nil),              // 12: spark_internal.TreeNode
-	(spark.Network)(0),                           // 13: spark.Network
-	(*timestamppb.Timestamp)(nil),                // 14: google.protobuf.Timestamp
-	(*spark.UTXO)(nil),                           // 15: spark.UTXO
-	(*spark.SecretProof)(nil),                    // 16: spark.SecretProof
-	(*emptypb.Empty)(nil),                        // 17: google.protobuf.Empty
+	(*GossipMessage)(nil),                          // 0: gossip.GossipMessage
+	(*GossipMessageCancelTransfer)(nil),            // 1: gossip.GossipMessageCancelTransfer
+	(*GossipMessageRollbackTransfer)(nil),          // 2: gossip.GossipMessageRollbackTransfer
+	(*GossipMessageSettleSenderKeyTweak)(nil),      // 3: gossip.GossipMessageSettleSenderKeyTweak
+	(*GossipMessageSettleBatchSenderKeyTweak)(nil), // 4: gossip.GossipMessageSettleBatchSenderKeyTweak
+	(*GossipMessageMarkTreesExited)(nil),           // 5: gossip.GossipMessageMarkTreesExited
+	(*GossipMessageFinalizeTreeCreation)(nil),      // 6: gossip.GossipMessageFinalizeTreeCreation
+	(*GossipMessageFinalizeTransfer)(nil),          // 7: gossip.GossipMessageFinalizeTransfer
+	(*GossipMessageFinalizeRefreshTimelock)(nil),   // 8: gossip.GossipMessageFinalizeRefreshTimelock
+	(*GossipMessageFinalizeExtendLeaf)(nil),        // 9: gossip.GossipMessageFinalizeExtendLeaf
+	(*GossipMessageRollbackUtxoSwap)(nil),          // 10: gossip.GossipMessageRollbackUtxoSwap
+	(*GossipMessageDepositCleanup)(nil),            // 11: gossip.GossipMessageDepositCleanup
+	nil,                                            // 12: gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntry
+	(*spark_internal.TreeNode)(nil),                // 13: spark_internal.TreeNode
+	(spark.Network)(0),                             // 14: spark.Network
+	(*timestamppb.Timestamp)(nil),                  // 15: google.protobuf.Timestamp
+	(*spark.UTXO)(nil),                             // 16: spark.UTXO
+	(*spark.SecretProof)(nil),                      // 17: spark.SecretProof
+	(*emptypb.Empty)(nil),                          // 18: google.protobuf.Empty
 }
 var file_gossip_proto_depIdxs = []int32{
 	1,  // 0: gossip.GossipMessage.cancel_transfer:type_name -> gossip.GossipMessageCancelTransfer
 	3,  // 1: gossip.GossipMessage.settle_sender_key_tweak:type_name -> gossip.GossipMessageSettleSenderKeyTweak
 	2,  // 2: gossip.GossipMessage.rollback_transfer:type_name -> gossip.GossipMessageRollbackTransfer
-	4,  // 3: gossip.GossipMessage.mark_trees_exited:type_name -> gossip.GossipMessageMarkTreesExited
-	5,  // 4: gossip.GossipMessage.finalize_tree_creation:type_name -> gossip.GossipMessageFinalizeTreeCreation
-	6,  // 5: gossip.GossipMessage.finalize_transfer:type_name -> gossip.GossipMessageFinalizeTransfer
-	7,  // 6: gossip.GossipMessage.finalize_refresh_timelock:type_name -> gossip.GossipMessageFinalizeRefreshTimelock
-	8,  // 7: gossip.GossipMessage.finalize_extend_leaf:type_name -> gossip.GossipMessageFinalizeExtendLeaf
-	9,  // 8: gossip.GossipMessage.rollback_utxo_swap:type_name -> gossip.GossipMessageRollbackUtxoSwap
-	10, // 9: gossip.GossipMessage.deposit_cleanup:type_name -> gossip.GossipMessageDepositCleanup
-	11, // 10: gossip.GossipMessageSettleSenderKeyTweak.sender_key_tweak_proofs:type_name -> gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntry
-	12, // 11: gossip.GossipMessageFinalizeTreeCreation.internal_nodes:type_name -> spark_internal.TreeNode
-	13, // 12: gossip.GossipMessageFinalizeTreeCreation.proto_network:type_name -> spark.Network
-	12, // 13: gossip.GossipMessageFinalizeTransfer.internal_nodes:type_name -> spark_internal.TreeNode
-	14, // 14: gossip.GossipMessageFinalizeTransfer.completion_timestamp:type_name -> google.protobuf.Timestamp
-	12, // 15: gossip.GossipMessageFinalizeRefreshTimelock.internal_nodes:type_name -> spark_internal.TreeNode
-	12, // 16: gossip.GossipMessageFinalizeExtendLeaf.internal_nodes:type_name -> spark_internal.TreeNode
-	15, // 17: gossip.GossipMessageRollbackUtxoSwap.on_chain_utxo:type_name -> spark.UTXO
-	16, // 18: gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	0,  // 19: gossip.GossipService.gossip:input_type -> gossip.GossipMessage
-	17, // 20: gossip.GossipService.gossip:output_type -> google.protobuf.Empty
-	20, // [20:21] is the sub-list for method output_type
-	19, // [19:20] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	5,  // 3: gossip.GossipMessage.mark_trees_exited:type_name -> gossip.GossipMessageMarkTreesExited
+	6,  // 4: gossip.GossipMessage.finalize_tree_creation:type_name -> gossip.GossipMessageFinalizeTreeCreation
+	7,  // 5: gossip.GossipMessage.finalize_transfer:type_name -> gossip.GossipMessageFinalizeTransfer
+	8,  // 6: gossip.GossipMessage.finalize_refresh_timelock:type_name -> gossip.GossipMessageFinalizeRefreshTimelock
+	9,  // 7: gossip.GossipMessage.finalize_extend_leaf:type_name -> gossip.GossipMessageFinalizeExtendLeaf
+	10, // 8: gossip.GossipMessage.rollback_utxo_swap:type_name -> gossip.GossipMessageRollbackUtxoSwap
+	11, // 9: gossip.GossipMessage.deposit_cleanup:type_name -> gossip.GossipMessageDepositCleanup
+	4,  // 10: gossip.GossipMessage.settle_batch_sender_key_tweak:type_name -> gossip.GossipMessageSettleBatchSenderKeyTweak
+	12, // 11: gossip.GossipMessageSettleSenderKeyTweak.sender_key_tweak_proofs:type_name -> gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntry
+	3,  // 12: gossip.GossipMessageSettleBatchSenderKeyTweak.transfers:type_name -> gossip.GossipMessageSettleSenderKeyTweak
+	13, // 13: gossip.GossipMessageFinalizeTreeCreation.internal_nodes:type_name -> spark_internal.TreeNode
+	14, // 14: gossip.GossipMessageFinalizeTreeCreation.proto_network:type_name -> spark.Network
+	13, // 15: gossip.GossipMessageFinalizeTransfer.internal_nodes:type_name -> spark_internal.TreeNode
+	15, // 16: gossip.GossipMessageFinalizeTransfer.completion_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 17: gossip.GossipMessageFinalizeRefreshTimelock.internal_nodes:type_name -> spark_internal.TreeNode
+	13, // 18: gossip.GossipMessageFinalizeExtendLeaf.internal_nodes:type_name -> spark_internal.TreeNode
+	16, // 19: gossip.GossipMessageRollbackUtxoSwap.on_chain_utxo:type_name -> spark.UTXO
+	17, // 20: gossip.GossipMessageSettleSenderKeyTweak.SenderKeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	0,  // 21: gossip.GossipService.gossip:input_type -> gossip.GossipMessage
+	18, // 22: gossip.GossipService.gossip:output_type -> google.protobuf.Empty
+	22, // [22:23] is the sub-list for method output_type
+	21, // [21:22] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_gossip_proto_init() }
@@ -877,6 +953,7 @@ func file_gossip_proto_init() {
 		(*GossipMessage_FinalizeExtendLeaf)(nil),
 		(*GossipMessage_RollbackUtxoSwap)(nil),
 		(*GossipMessage_DepositCleanup)(nil),
+		(*GossipMessage_SettleBatchSenderKeyTweak)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -884,7 +961,7 @@ func file_gossip_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gossip_proto_rawDesc), len(file_gossip_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
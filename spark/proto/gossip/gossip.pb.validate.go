@@ -474,6 +474,47 @@ func (m *GossipMessage) validate(all bool) error {
 			}
 		}
 
+	case *GossipMessage_SettleBatchSenderKeyTweak:
+		if v == nil {
+			err := GossipMessageValidationError{
+				field:  "Message",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSettleBatchSenderKeyTweak()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GossipMessageValidationError{
+						field:  "SettleBatchSenderKeyTweak",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GossipMessageValidationError{
+						field:  "SettleBatchSenderKeyTweak",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSettleBatchSenderKeyTweak()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GossipMessageValidationError{
+					field:  "SettleBatchSenderKeyTweak",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -919,6 +960,148 @@ var _ interface {
 	ErrorName() string
 } = GossipMessageSettleSenderKeyTweakValidationError{}
 
+// Validate checks the field values on GossipMessageSettleBatchSenderKeyTweak
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GossipMessageSettleBatchSenderKeyTweak) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// GossipMessageSettleBatchSenderKeyTweak with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// GossipMessageSettleBatchSenderKeyTweakMultiError, or nil if none found.
+func (m *GossipMessageSettleBatchSenderKeyTweak) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GossipMessageSettleBatchSenderKeyTweak) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BatchId
+
+	for idx, item := range m.GetTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GossipMessageSettleBatchSenderKeyTweakValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GossipMessageSettleBatchSenderKeyTweakValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GossipMessageSettleBatchSenderKeyTweakValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GossipMessageSettleBatchSenderKeyTweakMultiError(errors)
+	}
+
+	return nil
+}
+
+// GossipMessageSettleBatchSenderKeyTweakMultiError is an error wrapping
+// multiple validation errors returned by
+// GossipMessageSettleBatchSenderKeyTweak.ValidateAll() if the designated
+// constraints aren't met.
+type GossipMessageSettleBatchSenderKeyTweakMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GossipMessageSettleBatchSenderKeyTweakMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GossipMessageSettleBatchSenderKeyTweakMultiError) AllErrors() []error { return m }
+
+// GossipMessageSettleBatchSenderKeyTweakValidationError is the validation
+// error returned by GossipMessageSettleBatchSenderKeyTweak.Validate if the
+// designated constraints aren't met.
+type GossipMessageSettleBatchSenderKeyTweakValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GossipMessageSettleBatchSenderKeyTweakValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GossipMessageSettleBatchSenderKeyTweakValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GossipMessageSettleBatchSenderKeyTweakValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GossipMessageSettleBatchSenderKeyTweakValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GossipMessageSettleBatchSenderKeyTweakValidationError) ErrorName() string {
+	return "GossipMessageSettleBatchSenderKeyTweakValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GossipMessageSettleBatchSenderKeyTweakValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGossipMessageSettleBatchSenderKeyTweak.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GossipMessageSettleBatchSenderKeyTweakValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GossipMessageSettleBatchSenderKeyTweakValidationError{}
+
 // Validate checks the field values on GossipMessageMarkTreesExited with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -25,6 +25,55 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PreimageRequestStatus int32
+
+const (
+	PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE PreimageRequestStatus = 0
+	PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_PREIMAGE_SHARED      PreimageRequestStatus = 1
+	PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_RETURNED             PreimageRequestStatus = 2
+)
+
+// Enum value maps for PreimageRequestStatus.
+var (
+	PreimageRequestStatus_name = map[int32]string{
+		0: "PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE",
+		1: "PREIMAGE_REQUEST_STATUS_PREIMAGE_SHARED",
+		2: "PREIMAGE_REQUEST_STATUS_RETURNED",
+	}
+	PreimageRequestStatus_value = map[string]int32{
+		"PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE": 0,
+		"PREIMAGE_REQUEST_STATUS_PREIMAGE_SHARED":      1,
+		"PREIMAGE_REQUEST_STATUS_RETURNED":             2,
+	}
+)
+
+func (x PreimageRequestStatus) Enum() *PreimageRequestStatus {
+	p := new(PreimageRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PreimageRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreimageRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_proto_enumTypes[0].Descriptor()
+}
+
+func (PreimageRequestStatus) Type() protoreflect.EnumType {
+	return &file_spark_proto_enumTypes[0]
+}
+
+func (x PreimageRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreimageRequestStatus.Descriptor instead.
+func (PreimageRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{0}
+}
+
 // *
 // Network is the network type of the bitcoin network.
 type Network int32
@@ -66,11 +115,11 @@ func (x Network) String() string {
 }
 
 func (Network) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[0].Descriptor()
+	return file_spark_proto_enumTypes[1].Descriptor()
 }
 
 func (Network) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[0]
+	return &file_spark_proto_enumTypes[1]
 }
 
 func (x Network) Number() protoreflect.EnumNumber {
@@ -79,7 +128,7 @@ func (x Network) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Network.Descriptor instead.
 func (Network) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{0}
+	return file_spark_proto_rawDescGZIP(), []int{1}
 }
 
 type TokenTransactionStatus int32
@@ -127,11 +176,11 @@ func (x TokenTransactionStatus) String() string {
 }
 
 func (TokenTransactionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[1].Descriptor()
+	return file_spark_proto_enumTypes[2].Descriptor()
 }
 
 func (TokenTransactionStatus) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[1]
+	return &file_spark_proto_enumTypes[2]
 }
 
 func (x TokenTransactionStatus) Number() protoreflect.EnumNumber {
@@ -140,7 +189,7 @@ func (x TokenTransactionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TokenTransactionStatus.Descriptor instead.
 func (TokenTransactionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{1}
+	return file_spark_proto_rawDescGZIP(), []int{2}
 }
 
 type TransferStatus int32
@@ -200,11 +249,11 @@ func (x TransferStatus) String() string {
 }
 
 func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[2].Descriptor()
+	return file_spark_proto_enumTypes[3].Descriptor()
 }
 
 func (TransferStatus) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[2]
+	return &file_spark_proto_enumTypes[3]
 }
 
 func (x TransferStatus) Number() protoreflect.EnumNumber {
@@ -213,7 +262,7 @@ func (x TransferStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransferStatus.Descriptor instead.
 func (TransferStatus) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{2}
+	return file_spark_proto_rawDescGZIP(), []int{3}
 }
 
 type TransferType int32
@@ -223,6 +272,7 @@ const (
 	TransferType_COOPERATIVE_EXIT TransferType = 1
 	TransferType_TRANSFER         TransferType = 2
 	TransferType_UTXO_SWAP        TransferType = 3
+	TransferType_HTLC             TransferType = 4
 	TransferType_SWAP             TransferType = 30
 	TransferType_COUNTER_SWAP     TransferType = 40
 )
@@ -234,6 +284,7 @@ var (
 		1:  "COOPERATIVE_EXIT",
 		2:  "TRANSFER",
 		3:  "UTXO_SWAP",
+		4:  "HTLC",
 		30: "SWAP",
 		40: "COUNTER_SWAP",
 	}
@@ -242,6 +293,7 @@ var (
 		"COOPERATIVE_EXIT": 1,
 		"TRANSFER":         2,
 		"UTXO_SWAP":        3,
+		"HTLC":             4,
 		"SWAP":             30,
 		"COUNTER_SWAP":     40,
 	}
@@ -258,11 +310,11 @@ func (x TransferType) String() string {
 }
 
 func (TransferType) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[3].Descriptor()
+	return file_spark_proto_enumTypes[4].Descriptor()
 }
 
 func (TransferType) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[3]
+	return &file_spark_proto_enumTypes[4]
 }
 
 func (x TransferType) Number() protoreflect.EnumNumber {
@@ -271,7 +323,7 @@ func (x TransferType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransferType.Descriptor instead.
 func (TransferType) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{3}
+	return file_spark_proto_rawDescGZIP(), []int{4}
 }
 
 type Order int32
@@ -304,11 +356,11 @@ func (x Order) String() string {
 }
 
 func (Order) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[4].Descriptor()
+	return file_spark_proto_enumTypes[5].Descriptor()
 }
 
 func (Order) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[4]
+	return &file_spark_proto_enumTypes[5]
 }
 
 func (x Order) Number() protoreflect.EnumNumber {
@@ -317,7 +369,7 @@ func (x Order) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Order.Descriptor instead.
 func (Order) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{4}
+	return file_spark_proto_rawDescGZIP(), []int{5}
 }
 
 // *
@@ -355,11 +407,11 @@ func (x UtxoSwapRequestType) String() string {
 }
 
 func (UtxoSwapRequestType) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[5].Descriptor()
+	return file_spark_proto_enumTypes[6].Descriptor()
 }
 
 func (UtxoSwapRequestType) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[5]
+	return &file_spark_proto_enumTypes[6]
 }
 
 func (x UtxoSwapRequestType) Number() protoreflect.EnumNumber {
@@ -368,7 +420,58 @@ func (x UtxoSwapRequestType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UtxoSwapRequestType.Descriptor instead.
 func (UtxoSwapRequestType) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{5}
+	return file_spark_proto_rawDescGZIP(), []int{6}
+}
+
+type UnilateralExitStepType int32
+
+const (
+	UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_UNSPECIFIED UnilateralExitStepType = 0
+	// Spends the parent node's output into this node's output.
+	UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_NODE UnilateralExitStepType = 1
+	// Spends the leaf's output to the owner. This is always the last step.
+	UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_REFUND UnilateralExitStepType = 2
+)
+
+// Enum value maps for UnilateralExitStepType.
+var (
+	UnilateralExitStepType_name = map[int32]string{
+		0: "UNILATERAL_EXIT_STEP_TYPE_UNSPECIFIED",
+		1: "UNILATERAL_EXIT_STEP_TYPE_NODE",
+		2: "UNILATERAL_EXIT_STEP_TYPE_REFUND",
+	}
+	UnilateralExitStepType_value = map[string]int32{
+		"UNILATERAL_EXIT_STEP_TYPE_UNSPECIFIED": 0,
+		"UNILATERAL_EXIT_STEP_TYPE_NODE":        1,
+		"UNILATERAL_EXIT_STEP_TYPE_REFUND":      2,
+	}
+)
+
+func (x UnilateralExitStepType) Enum() *UnilateralExitStepType {
+	p := new(UnilateralExitStepType)
+	*p = x
+	return p
+}
+
+func (x UnilateralExitStepType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnilateralExitStepType) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_proto_enumTypes[7].Descriptor()
+}
+
+func (UnilateralExitStepType) Type() protoreflect.EnumType {
+	return &file_spark_proto_enumTypes[7]
+}
+
+func (x UnilateralExitStepType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnilateralExitStepType.Descriptor instead.
+func (UnilateralExitStepType) EnumDescriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{7}
 }
 
 type InvoiceStatus int32
@@ -407,11 +510,11 @@ func (x InvoiceStatus) String() string {
 }
 
 func (InvoiceStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[6].Descriptor()
+	return file_spark_proto_enumTypes[8].Descriptor()
 }
 
 func (InvoiceStatus) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[6]
+	return &file_spark_proto_enumTypes[8]
 }
 
 func (x InvoiceStatus) Number() protoreflect.EnumNumber {
@@ -420,7 +523,7 @@ func (x InvoiceStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InvoiceStatus.Descriptor instead.
 func (InvoiceStatus) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{6}
+	return file_spark_proto_rawDescGZIP(), []int{8}
 }
 
 type InitiatePreimageSwapRequest_Reason int32
@@ -455,11 +558,11 @@ func (x InitiatePreimageSwapRequest_Reason) String() string {
 }
 
 func (InitiatePreimageSwapRequest_Reason) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_proto_enumTypes[7].Descriptor()
+	return file_spark_proto_enumTypes[9].Descriptor()
 }
 
 func (InitiatePreimageSwapRequest_Reason) Type() protoreflect.EnumType {
-	return &file_spark_proto_enumTypes[7]
+	return &file_spark_proto_enumTypes[9]
 }
 
 func (x InitiatePreimageSwapRequest_Reason) Number() protoreflect.EnumNumber {
@@ -468,14 +571,18 @@ func (x InitiatePreimageSwapRequest_Reason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InitiatePreimageSwapRequest_Reason.Descriptor instead.
 func (InitiatePreimageSwapRequest_Reason) EnumDescriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{83, 0}
+	return file_spark_proto_rawDescGZIP(), []int{91, 0}
 }
 
 type SubscribeToEventsRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	IdentityPublicKey []byte                 `protobuf:"bytes,10,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The sequence number of the last event the client received. If set, every event
+	// recorded after it is replayed before live events are streamed. Use 0 to replay
+	// all retained events.
+	ResumeFromSequence *uint64 `protobuf:"varint,11,opt,name=resume_from_sequence,json=resumeFromSequence,proto3,oneof" json:"resume_from_sequence,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *SubscribeToEventsRequest) Reset() {
@@ -515,6 +622,13 @@ func (x *SubscribeToEventsRequest) GetIdentityPublicKey() []byte {
 	return nil
 }
 
+func (x *SubscribeToEventsRequest) GetResumeFromSequence() uint64 {
+	if x != nil && x.ResumeFromSequence != nil {
+		return *x.ResumeFromSequence
+	}
+	return 0
+}
+
 type SubscribeToEventsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Event:
@@ -522,7 +636,16 @@ type SubscribeToEventsResponse struct {
 	//	*SubscribeToEventsResponse_Transfer
 	//	*SubscribeToEventsResponse_Deposit
 	//	*SubscribeToEventsResponse_Connected
-	Event         isSubscribeToEventsResponse_Event `protobuf_oneof:"event"`
+	//	*SubscribeToEventsResponse_TokenTransaction
+	//	*SubscribeToEventsResponse_PreimageRequest
+	//	*SubscribeToEventsResponse_CooperativeExit
+	//	*SubscribeToEventsResponse_UtxoSwap
+	//	*SubscribeToEventsResponse_TreeExit
+	Event isSubscribeToEventsResponse_Event `protobuf_oneof:"event"`
+	// The position of the event in the identity's event log. Sequence numbers increase
+	// monotonically per identity. Events that are not recorded, such as connected, have
+	// no sequence number.
+	Sequence      uint64 `protobuf:"varint,20,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -591,6 +714,58 @@ func (x *SubscribeToEventsResponse) GetConnected() *ConnectedEvent {
 	return nil
 }
 
+func (x *SubscribeToEventsResponse) GetTokenTransaction() *TokenTransactionEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SubscribeToEventsResponse_TokenTransaction); ok {
+			return x.TokenTransaction
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeToEventsResponse) GetPreimageRequest() *PreimageRequestEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SubscribeToEventsResponse_PreimageRequest); ok {
+			return x.PreimageRequest
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeToEventsResponse) GetCooperativeExit() *CooperativeExitEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SubscribeToEventsResponse_CooperativeExit); ok {
+			return x.CooperativeExit
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeToEventsResponse) GetUtxoSwap() *UtxoSwapEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SubscribeToEventsResponse_UtxoSwap); ok {
+			return x.UtxoSwap
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeToEventsResponse) GetTreeExit() *TreeExitEvent {
+	if x != nil {
+		if x, ok := x.Event.(*SubscribeToEventsResponse_TreeExit); ok {
+			return x.TreeExit
+		}
+	}
+	return nil
+}
+
+func (x *SubscribeToEventsResponse) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 type isSubscribeToEventsResponse_Event interface {
 	isSubscribeToEventsResponse_Event()
 }
@@ -607,12 +782,42 @@ type SubscribeToEventsResponse_Connected struct {
 	Connected *ConnectedEvent `protobuf:"bytes,3,opt,name=connected,proto3,oneof"`
 }
 
+type SubscribeToEventsResponse_TokenTransaction struct {
+	TokenTransaction *TokenTransactionEvent `protobuf:"bytes,4,opt,name=token_transaction,json=tokenTransaction,proto3,oneof"`
+}
+
+type SubscribeToEventsResponse_PreimageRequest struct {
+	PreimageRequest *PreimageRequestEvent `protobuf:"bytes,5,opt,name=preimage_request,json=preimageRequest,proto3,oneof"`
+}
+
+type SubscribeToEventsResponse_CooperativeExit struct {
+	CooperativeExit *CooperativeExitEvent `protobuf:"bytes,6,opt,name=cooperative_exit,json=cooperativeExit,proto3,oneof"`
+}
+
+type SubscribeToEventsResponse_UtxoSwap struct {
+	UtxoSwap *UtxoSwapEvent `protobuf:"bytes,7,opt,name=utxo_swap,json=utxoSwap,proto3,oneof"`
+}
+
+type SubscribeToEventsResponse_TreeExit struct {
+	TreeExit *TreeExitEvent `protobuf:"bytes,8,opt,name=tree_exit,json=treeExit,proto3,oneof"`
+}
+
 func (*SubscribeToEventsResponse_Transfer) isSubscribeToEventsResponse_Event() {}
 
 func (*SubscribeToEventsResponse_Deposit) isSubscribeToEventsResponse_Event() {}
 
 func (*SubscribeToEventsResponse_Connected) isSubscribeToEventsResponse_Event() {}
 
+func (*SubscribeToEventsResponse_TokenTransaction) isSubscribeToEventsResponse_Event() {}
+
+func (*SubscribeToEventsResponse_PreimageRequest) isSubscribeToEventsResponse_Event() {}
+
+func (*SubscribeToEventsResponse_CooperativeExit) isSubscribeToEventsResponse_Event() {}
+
+func (*SubscribeToEventsResponse_UtxoSwap) isSubscribeToEventsResponse_Event() {}
+
+func (*SubscribeToEventsResponse_TreeExit) isSubscribeToEventsResponse_Event() {}
+
 type ConnectedEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -663,14 +868,283 @@ func (x *TransferEvent) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferEvent) String() string {
+func (x *TransferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEvent) ProtoMessage() {}
+
+func (x *TransferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
+func (*TransferEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransferEvent) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type DepositEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deposit       *TreeNode              `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositEvent) Reset() {
+	*x = DepositEvent{}
+	mi := &file_spark_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositEvent) ProtoMessage() {}
+
+func (x *DepositEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositEvent.ProtoReflect.Descriptor instead.
+func (*DepositEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DepositEvent) GetDeposit() *TreeNode {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// Sent to the owners of the outputs of a token transaction once it is finalized.
+type TokenTransactionEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TokenTransactionHash []byte                 `protobuf:"bytes,10,opt,name=token_transaction_hash,json=tokenTransactionHash,proto3" json:"token_transaction_hash,omitempty"`
+	// The outputs created by the transaction that are owned by the subscriber.
+	ReceivedOutputs []*TokenOutput `protobuf:"bytes,11,rep,name=received_outputs,json=receivedOutputs,proto3" json:"received_outputs,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenTransactionEvent) Reset() {
+	*x = TokenTransactionEvent{}
+	mi := &file_spark_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTransactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransactionEvent) ProtoMessage() {}
+
+func (x *TokenTransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransactionEvent.ProtoReflect.Descriptor instead.
+func (*TokenTransactionEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TokenTransactionEvent) GetTokenTransactionHash() []byte {
+	if x != nil {
+		return x.TokenTransactionHash
+	}
+	return nil
+}
+
+func (x *TokenTransactionEvent) GetReceivedOutputs() []*TokenOutput {
+	if x != nil {
+		return x.ReceivedOutputs
+	}
+	return nil
+}
+
+// Sent to the sender and receiver of a lightning payment when its preimage request
+// changes status.
+type PreimageRequestEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentHash   []byte                 `protobuf:"bytes,10,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	Status        PreimageRequestStatus  `protobuf:"varint,11,opt,name=status,proto3,enum=spark.PreimageRequestStatus" json:"status,omitempty"`
+	TransferId    string                 `protobuf:"bytes,12,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreimageRequestEvent) Reset() {
+	*x = PreimageRequestEvent{}
+	mi := &file_spark_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreimageRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreimageRequestEvent) ProtoMessage() {}
+
+func (x *PreimageRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreimageRequestEvent.ProtoReflect.Descriptor instead.
+func (*PreimageRequestEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PreimageRequestEvent) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *PreimageRequestEvent) GetStatus() PreimageRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE
+}
+
+func (x *PreimageRequestEvent) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+// Sent to the sender of a cooperative exit once the exit transaction is confirmed.
+type CooperativeExitEvent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TransferId         string                 `protobuf:"bytes,10,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	ExitTxid           []byte                 `protobuf:"bytes,11,opt,name=exit_txid,json=exitTxid,proto3" json:"exit_txid,omitempty"`
+	ConfirmationHeight uint64                 `protobuf:"varint,12,opt,name=confirmation_height,json=confirmationHeight,proto3" json:"confirmation_height,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CooperativeExitEvent) Reset() {
+	*x = CooperativeExitEvent{}
+	mi := &file_spark_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CooperativeExitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CooperativeExitEvent) ProtoMessage() {}
+
+func (x *CooperativeExitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CooperativeExitEvent.ProtoReflect.Descriptor instead.
+func (*CooperativeExitEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CooperativeExitEvent) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *CooperativeExitEvent) GetExitTxid() []byte {
+	if x != nil {
+		return x.ExitTxid
+	}
+	return nil
+}
+
+func (x *CooperativeExitEvent) GetConfirmationHeight() uint64 {
+	if x != nil {
+		return x.ConfirmationHeight
+	}
+	return 0
+}
+
+// Sent to the user of a static deposit UTXO swap once the swap is completed.
+type UtxoSwapEvent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Utxo        *UTXO                  `protobuf:"bytes,10,opt,name=utxo,proto3" json:"utxo,omitempty"`
+	RequestType UtxoSwapRequestType    `protobuf:"varint,11,opt,name=request_type,json=requestType,proto3,enum=spark.UtxoSwapRequestType" json:"request_type,omitempty"`
+	// The transfer that credited the swapped UTXO. Not set for refunds.
+	TransferId    string `protobuf:"bytes,12,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UtxoSwapEvent) Reset() {
+	*x = UtxoSwapEvent{}
+	mi := &file_spark_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UtxoSwapEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferEvent) ProtoMessage() {}
+func (*UtxoSwapEvent) ProtoMessage() {}
 
-func (x *TransferEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[3]
+func (x *UtxoSwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,40 +1155,55 @@ func (x *TransferEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferEvent.ProtoReflect.Descriptor instead.
-func (*TransferEvent) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use UtxoSwapEvent.ProtoReflect.Descriptor instead.
+func (*UtxoSwapEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TransferEvent) GetTransfer() *Transfer {
+func (x *UtxoSwapEvent) GetUtxo() *UTXO {
 	if x != nil {
-		return x.Transfer
+		return x.Utxo
 	}
 	return nil
 }
 
-type DepositEvent struct {
+func (x *UtxoSwapEvent) GetRequestType() UtxoSwapRequestType {
+	if x != nil {
+		return x.RequestType
+	}
+	return UtxoSwapRequestType_Fixed
+}
+
+func (x *UtxoSwapEvent) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+// Sent to the owner of a tree once it has exited.
+type TreeExitEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deposit       *TreeNode              `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	TreeId        string                 `protobuf:"bytes,10,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepositEvent) Reset() {
-	*x = DepositEvent{}
-	mi := &file_spark_proto_msgTypes[4]
+func (x *TreeExitEvent) Reset() {
+	*x = TreeExitEvent{}
+	mi := &file_spark_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositEvent) String() string {
+func (x *TreeExitEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositEvent) ProtoMessage() {}
+func (*TreeExitEvent) ProtoMessage() {}
 
-func (x *DepositEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[4]
+func (x *TreeExitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,16 +1214,16 @@ func (x *DepositEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositEvent.ProtoReflect.Descriptor instead.
-func (*DepositEvent) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TreeExitEvent.ProtoReflect.Descriptor instead.
+func (*TreeExitEvent) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DepositEvent) GetDeposit() *TreeNode {
+func (x *TreeExitEvent) GetTreeId() string {
 	if x != nil {
-		return x.Deposit
+		return x.TreeId
 	}
-	return nil
+	return ""
 }
 
 // *
@@ -759,7 +1248,7 @@ type DepositAddressProof struct {
 
 func (x *DepositAddressProof) Reset() {
 	*x = DepositAddressProof{}
-	mi := &file_spark_proto_msgTypes[5]
+	mi := &file_spark_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -771,7 +1260,7 @@ func (x *DepositAddressProof) String() string {
 func (*DepositAddressProof) ProtoMessage() {}
 
 func (x *DepositAddressProof) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[5]
+	mi := &file_spark_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -784,7 +1273,7 @@ func (x *DepositAddressProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddressProof.ProtoReflect.Descriptor instead.
 func (*DepositAddressProof) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{5}
+	return file_spark_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DepositAddressProof) GetAddressSignatures() map[string][]byte {
@@ -823,7 +1312,7 @@ type GenerateDepositAddressRequest struct {
 
 func (x *GenerateDepositAddressRequest) Reset() {
 	*x = GenerateDepositAddressRequest{}
-	mi := &file_spark_proto_msgTypes[6]
+	mi := &file_spark_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -835,7 +1324,7 @@ func (x *GenerateDepositAddressRequest) String() string {
 func (*GenerateDepositAddressRequest) ProtoMessage() {}
 
 func (x *GenerateDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[6]
+	mi := &file_spark_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +1337,7 @@ func (x *GenerateDepositAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDepositAddressRequest.ProtoReflect.Descriptor instead.
 func (*GenerateDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{6}
+	return file_spark_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GenerateDepositAddressRequest) GetSigningPublicKey() []byte {
@@ -904,7 +1393,7 @@ type Address struct {
 
 func (x *Address) Reset() {
 	*x = Address{}
-	mi := &file_spark_proto_msgTypes[7]
+	mi := &file_spark_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -916,7 +1405,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[7]
+	mi := &file_spark_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1418,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{7}
+	return file_spark_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Address) GetAddress() string {
@@ -971,7 +1460,7 @@ type GenerateDepositAddressResponse struct {
 
 func (x *GenerateDepositAddressResponse) Reset() {
 	*x = GenerateDepositAddressResponse{}
-	mi := &file_spark_proto_msgTypes[8]
+	mi := &file_spark_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -983,7 +1472,7 @@ func (x *GenerateDepositAddressResponse) String() string {
 func (*GenerateDepositAddressResponse) ProtoMessage() {}
 
 func (x *GenerateDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[8]
+	mi := &file_spark_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -996,7 +1485,7 @@ func (x *GenerateDepositAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateDepositAddressResponse.ProtoReflect.Descriptor instead.
 func (*GenerateDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{8}
+	return file_spark_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GenerateDepositAddressResponse) GetDepositAddress() *Address {
@@ -1022,7 +1511,7 @@ type GenerateStaticDepositAddressRequest struct {
 
 func (x *GenerateStaticDepositAddressRequest) Reset() {
 	*x = GenerateStaticDepositAddressRequest{}
-	mi := &file_spark_proto_msgTypes[9]
+	mi := &file_spark_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1034,7 +1523,7 @@ func (x *GenerateStaticDepositAddressRequest) String() string {
 func (*GenerateStaticDepositAddressRequest) ProtoMessage() {}
 
 func (x *GenerateStaticDepositAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[9]
+	mi := &file_spark_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,7 +1536,7 @@ func (x *GenerateStaticDepositAddressRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GenerateStaticDepositAddressRequest.ProtoReflect.Descriptor instead.
 func (*GenerateStaticDepositAddressRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{9}
+	return file_spark_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GenerateStaticDepositAddressRequest) GetSigningPublicKey() []byte {
@@ -1082,7 +1571,7 @@ type GenerateStaticDepositAddressResponse struct {
 
 func (x *GenerateStaticDepositAddressResponse) Reset() {
 	*x = GenerateStaticDepositAddressResponse{}
-	mi := &file_spark_proto_msgTypes[10]
+	mi := &file_spark_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1094,7 +1583,7 @@ func (x *GenerateStaticDepositAddressResponse) String() string {
 func (*GenerateStaticDepositAddressResponse) ProtoMessage() {}
 
 func (x *GenerateStaticDepositAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[10]
+	mi := &file_spark_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1107,7 +1596,7 @@ func (x *GenerateStaticDepositAddressResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GenerateStaticDepositAddressResponse.ProtoReflect.Descriptor instead.
 func (*GenerateStaticDepositAddressResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{10}
+	return file_spark_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GenerateStaticDepositAddressResponse) GetDepositAddress() *Address {
@@ -1137,7 +1626,7 @@ type UTXO struct {
 
 func (x *UTXO) Reset() {
 	*x = UTXO{}
-	mi := &file_spark_proto_msgTypes[11]
+	mi := &file_spark_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1149,7 +1638,7 @@ func (x *UTXO) String() string {
 func (*UTXO) ProtoMessage() {}
 
 func (x *UTXO) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[11]
+	mi := &file_spark_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1162,7 +1651,7 @@ func (x *UTXO) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UTXO.ProtoReflect.Descriptor instead.
 func (*UTXO) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{11}
+	return file_spark_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UTXO) GetRawTx() []byte {
@@ -1209,7 +1698,7 @@ type NodeOutput struct {
 
 func (x *NodeOutput) Reset() {
 	*x = NodeOutput{}
-	mi := &file_spark_proto_msgTypes[12]
+	mi := &file_spark_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1221,7 +1710,7 @@ func (x *NodeOutput) String() string {
 func (*NodeOutput) ProtoMessage() {}
 
 func (x *NodeOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[12]
+	mi := &file_spark_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1234,7 +1723,7 @@ func (x *NodeOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeOutput.ProtoReflect.Descriptor instead.
 func (*NodeOutput) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{12}
+	return file_spark_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *NodeOutput) GetNodeId() string {
@@ -1268,7 +1757,7 @@ type SigningJob struct {
 
 func (x *SigningJob) Reset() {
 	*x = SigningJob{}
-	mi := &file_spark_proto_msgTypes[13]
+	mi := &file_spark_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1280,7 +1769,7 @@ func (x *SigningJob) String() string {
 func (*SigningJob) ProtoMessage() {}
 
 func (x *SigningJob) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[13]
+	mi := &file_spark_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,7 +1782,7 @@ func (x *SigningJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningJob.ProtoReflect.Descriptor instead.
 func (*SigningJob) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{13}
+	return file_spark_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SigningJob) GetSigningPublicKey() []byte {
@@ -1337,7 +1826,7 @@ type SigningKeyshare struct {
 
 func (x *SigningKeyshare) Reset() {
 	*x = SigningKeyshare{}
-	mi := &file_spark_proto_msgTypes[14]
+	mi := &file_spark_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1349,7 +1838,7 @@ func (x *SigningKeyshare) String() string {
 func (*SigningKeyshare) ProtoMessage() {}
 
 func (x *SigningKeyshare) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[14]
+	mi := &file_spark_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +1851,7 @@ func (x *SigningKeyshare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningKeyshare.ProtoReflect.Descriptor instead.
 func (*SigningKeyshare) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{14}
+	return file_spark_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SigningKeyshare) GetOwnerIdentifiers() []string {
@@ -1420,7 +1909,7 @@ type SigningResult struct {
 
 func (x *SigningResult) Reset() {
 	*x = SigningResult{}
-	mi := &file_spark_proto_msgTypes[15]
+	mi := &file_spark_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1432,7 +1921,7 @@ func (x *SigningResult) String() string {
 func (*SigningResult) ProtoMessage() {}
 
 func (x *SigningResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[15]
+	mi := &file_spark_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,7 +1934,7 @@ func (x *SigningResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningResult.ProtoReflect.Descriptor instead.
 func (*SigningResult) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{15}
+	return file_spark_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *SigningResult) GetPublicKeys() map[string][]byte {
@@ -1501,7 +1990,7 @@ type NodeSignatureShares struct {
 
 func (x *NodeSignatureShares) Reset() {
 	*x = NodeSignatureShares{}
-	mi := &file_spark_proto_msgTypes[16]
+	mi := &file_spark_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1513,7 +2002,7 @@ func (x *NodeSignatureShares) String() string {
 func (*NodeSignatureShares) ProtoMessage() {}
 
 func (x *NodeSignatureShares) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[16]
+	mi := &file_spark_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1526,7 +2015,7 @@ func (x *NodeSignatureShares) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeSignatureShares.ProtoReflect.Descriptor instead.
 func (*NodeSignatureShares) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{16}
+	return file_spark_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *NodeSignatureShares) GetNodeId() string {
@@ -1601,7 +2090,7 @@ type NodeSignatures struct {
 
 func (x *NodeSignatures) Reset() {
 	*x = NodeSignatures{}
-	mi := &file_spark_proto_msgTypes[17]
+	mi := &file_spark_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +2102,7 @@ func (x *NodeSignatures) String() string {
 func (*NodeSignatures) ProtoMessage() {}
 
 func (x *NodeSignatures) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[17]
+	mi := &file_spark_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +2115,7 @@ func (x *NodeSignatures) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeSignatures.ProtoReflect.Descriptor instead.
 func (*NodeSignatures) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{17}
+	return file_spark_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *NodeSignatures) GetNodeId() string {
@@ -1695,7 +2184,7 @@ type StartTreeCreationRequest struct {
 
 func (x *StartTreeCreationRequest) Reset() {
 	*x = StartTreeCreationRequest{}
-	mi := &file_spark_proto_msgTypes[18]
+	mi := &file_spark_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1707,7 +2196,7 @@ func (x *StartTreeCreationRequest) String() string {
 func (*StartTreeCreationRequest) ProtoMessage() {}
 
 func (x *StartTreeCreationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[18]
+	mi := &file_spark_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,7 +2209,7 @@ func (x *StartTreeCreationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTreeCreationRequest.ProtoReflect.Descriptor instead.
 func (*StartTreeCreationRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{18}
+	return file_spark_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *StartTreeCreationRequest) GetIdentityPublicKey() []byte {
@@ -1786,7 +2275,7 @@ type StartTreeCreationResponse struct {
 
 func (x *StartTreeCreationResponse) Reset() {
 	*x = StartTreeCreationResponse{}
-	mi := &file_spark_proto_msgTypes[19]
+	mi := &file_spark_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1798,7 +2287,7 @@ func (x *StartTreeCreationResponse) String() string {
 func (*StartTreeCreationResponse) ProtoMessage() {}
 
 func (x *StartTreeCreationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[19]
+	mi := &file_spark_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,7 +2300,7 @@ func (x *StartTreeCreationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTreeCreationResponse.ProtoReflect.Descriptor instead.
 func (*StartTreeCreationResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{19}
+	return file_spark_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StartTreeCreationResponse) GetTreeId() string {
@@ -1852,7 +2341,7 @@ type StartDepositTreeCreationRequest struct {
 
 func (x *StartDepositTreeCreationRequest) Reset() {
 	*x = StartDepositTreeCreationRequest{}
-	mi := &file_spark_proto_msgTypes[20]
+	mi := &file_spark_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1864,7 +2353,7 @@ func (x *StartDepositTreeCreationRequest) String() string {
 func (*StartDepositTreeCreationRequest) ProtoMessage() {}
 
 func (x *StartDepositTreeCreationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[20]
+	mi := &file_spark_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1877,7 +2366,7 @@ func (x *StartDepositTreeCreationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartDepositTreeCreationRequest.ProtoReflect.Descriptor instead.
 func (*StartDepositTreeCreationRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{20}
+	return file_spark_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *StartDepositTreeCreationRequest) GetIdentityPublicKey() []byte {
@@ -1943,7 +2432,7 @@ type StartDepositTreeCreationResponse struct {
 
 func (x *StartDepositTreeCreationResponse) Reset() {
 	*x = StartDepositTreeCreationResponse{}
-	mi := &file_spark_proto_msgTypes[21]
+	mi := &file_spark_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1955,7 +2444,7 @@ func (x *StartDepositTreeCreationResponse) String() string {
 func (*StartDepositTreeCreationResponse) ProtoMessage() {}
 
 func (x *StartDepositTreeCreationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[21]
+	mi := &file_spark_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,7 +2457,7 @@ func (x *StartDepositTreeCreationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartDepositTreeCreationResponse.ProtoReflect.Descriptor instead.
 func (*StartDepositTreeCreationResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{21}
+	return file_spark_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *StartDepositTreeCreationResponse) GetTreeId() string {
@@ -1997,7 +2486,7 @@ type TokenOutputToSpend struct {
 
 func (x *TokenOutputToSpend) Reset() {
 	*x = TokenOutputToSpend{}
-	mi := &file_spark_proto_msgTypes[22]
+	mi := &file_spark_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2009,7 +2498,7 @@ func (x *TokenOutputToSpend) String() string {
 func (*TokenOutputToSpend) ProtoMessage() {}
 
 func (x *TokenOutputToSpend) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[22]
+	mi := &file_spark_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2022,7 +2511,7 @@ func (x *TokenOutputToSpend) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenOutputToSpend.ProtoReflect.Descriptor instead.
 func (*TokenOutputToSpend) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{22}
+	return file_spark_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *TokenOutputToSpend) GetPrevTokenTransactionHash() []byte {
@@ -2048,7 +2537,7 @@ type TokenTransferInput struct {
 
 func (x *TokenTransferInput) Reset() {
 	*x = TokenTransferInput{}
-	mi := &file_spark_proto_msgTypes[23]
+	mi := &file_spark_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2060,7 +2549,7 @@ func (x *TokenTransferInput) String() string {
 func (*TokenTransferInput) ProtoMessage() {}
 
 func (x *TokenTransferInput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[23]
+	mi := &file_spark_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2073,7 +2562,7 @@ func (x *TokenTransferInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenTransferInput.ProtoReflect.Descriptor instead.
 func (*TokenTransferInput) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{23}
+	return file_spark_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TokenTransferInput) GetOutputsToSpend() []*TokenOutputToSpend {
@@ -2101,7 +2590,7 @@ type TokenMintInput struct {
 
 func (x *TokenMintInput) Reset() {
 	*x = TokenMintInput{}
-	mi := &file_spark_proto_msgTypes[24]
+	mi := &file_spark_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2113,7 +2602,7 @@ func (x *TokenMintInput) String() string {
 func (*TokenMintInput) ProtoMessage() {}
 
 func (x *TokenMintInput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[24]
+	mi := &file_spark_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,7 +2615,7 @@ func (x *TokenMintInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenMintInput.ProtoReflect.Descriptor instead.
 func (*TokenMintInput) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{24}
+	return file_spark_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *TokenMintInput) GetIssuerPublicKey() []byte {
@@ -2165,7 +2654,7 @@ type TokenCreateInput struct {
 
 func (x *TokenCreateInput) Reset() {
 	*x = TokenCreateInput{}
-	mi := &file_spark_proto_msgTypes[25]
+	mi := &file_spark_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2177,7 +2666,7 @@ func (x *TokenCreateInput) String() string {
 func (*TokenCreateInput) ProtoMessage() {}
 
 func (x *TokenCreateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[25]
+	mi := &file_spark_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2190,7 +2679,7 @@ func (x *TokenCreateInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenCreateInput.ProtoReflect.Descriptor instead.
 func (*TokenCreateInput) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{25}
+	return file_spark_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *TokenCreateInput) GetIssuerPublicKey() []byte {
@@ -2255,13 +2744,15 @@ type TokenOutput struct {
 	TokenPublicKey                []byte                 `protobuf:"bytes,6,opt,name=token_public_key,json=tokenPublicKey,proto3,oneof" json:"token_public_key,omitempty"`
 	TokenIdentifier               []byte                 `protobuf:"bytes,8,opt,name=token_identifier,json=tokenIdentifier,proto3,oneof" json:"token_identifier,omitempty"`
 	TokenAmount                   []byte                 `protobuf:"bytes,7,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"` // Decoded uint128
-	unknownFields                 protoimpl.UnknownFields
-	sizeCache                     protoimpl.SizeCache
+	// When set, the output cannot be spent on Spark before this time.
+	SpendableAfter *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=spendable_after,json=spendableAfter,proto3" json:"spendable_after,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TokenOutput) Reset() {
 	*x = TokenOutput{}
-	mi := &file_spark_proto_msgTypes[26]
+	mi := &file_spark_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2273,7 +2764,7 @@ func (x *TokenOutput) String() string {
 func (*TokenOutput) ProtoMessage() {}
 
 func (x *TokenOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[26]
+	mi := &file_spark_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,7 +2777,7 @@ func (x *TokenOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenOutput.ProtoReflect.Descriptor instead.
 func (*TokenOutput) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{26}
+	return file_spark_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TokenOutput) GetId() string {
@@ -2345,6 +2836,13 @@ func (x *TokenOutput) GetTokenAmount() []byte {
 	return nil
 }
 
+func (x *TokenOutput) GetSpendableAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SpendableAfter
+	}
+	return nil
+}
+
 // This proto is constructed by the wallet and is the core transaction data structure.
 // This proto is deterministically hashed to generate the token_transaction_hash that
 // is cooperatively signed by the SO group to confirm a token transaction.
@@ -2365,7 +2863,7 @@ type TokenTransaction struct {
 
 func (x *TokenTransaction) Reset() {
 	*x = TokenTransaction{}
-	mi := &file_spark_proto_msgTypes[27]
+	mi := &file_spark_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2377,7 +2875,7 @@ func (x *TokenTransaction) String() string {
 func (*TokenTransaction) ProtoMessage() {}
 
 func (x *TokenTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[27]
+	mi := &file_spark_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2390,7 +2888,7 @@ func (x *TokenTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenTransaction.ProtoReflect.Descriptor instead.
 func (*TokenTransaction) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{27}
+	return file_spark_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TokenTransaction) GetTokenInputs() isTokenTransaction_TokenInputs {
@@ -2480,7 +2978,7 @@ type SpentTokenOutputMetadata struct {
 
 func (x *SpentTokenOutputMetadata) Reset() {
 	*x = SpentTokenOutputMetadata{}
-	mi := &file_spark_proto_msgTypes[28]
+	mi := &file_spark_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2492,7 +2990,7 @@ func (x *SpentTokenOutputMetadata) String() string {
 func (*SpentTokenOutputMetadata) ProtoMessage() {}
 
 func (x *SpentTokenOutputMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[28]
+	mi := &file_spark_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2505,7 +3003,7 @@ func (x *SpentTokenOutputMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SpentTokenOutputMetadata.ProtoReflect.Descriptor instead.
 func (*SpentTokenOutputMetadata) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{28}
+	return file_spark_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SpentTokenOutputMetadata) GetOutputId() string {
@@ -2531,7 +3029,7 @@ type TokenTransactionConfirmationMetadata struct {
 
 func (x *TokenTransactionConfirmationMetadata) Reset() {
 	*x = TokenTransactionConfirmationMetadata{}
-	mi := &file_spark_proto_msgTypes[29]
+	mi := &file_spark_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2543,7 +3041,7 @@ func (x *TokenTransactionConfirmationMetadata) String() string {
 func (*TokenTransactionConfirmationMetadata) ProtoMessage() {}
 
 func (x *TokenTransactionConfirmationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[29]
+	mi := &file_spark_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2556,7 +3054,7 @@ func (x *TokenTransactionConfirmationMetadata) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use TokenTransactionConfirmationMetadata.ProtoReflect.Descriptor instead.
 func (*TokenTransactionConfirmationMetadata) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{29}
+	return file_spark_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *TokenTransactionConfirmationMetadata) GetSpentTokenOutputsMetadata() []*SpentTokenOutputMetadata {
@@ -2578,7 +3076,7 @@ type TokenTransactionWithStatus struct {
 
 func (x *TokenTransactionWithStatus) Reset() {
 	*x = TokenTransactionWithStatus{}
-	mi := &file_spark_proto_msgTypes[30]
+	mi := &file_spark_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2590,7 +3088,7 @@ func (x *TokenTransactionWithStatus) String() string {
 func (*TokenTransactionWithStatus) ProtoMessage() {}
 
 func (x *TokenTransactionWithStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[30]
+	mi := &file_spark_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2603,7 +3101,7 @@ func (x *TokenTransactionWithStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenTransactionWithStatus.ProtoReflect.Descriptor instead.
 func (*TokenTransactionWithStatus) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{30}
+	return file_spark_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TokenTransactionWithStatus) GetTokenTransaction() *TokenTransaction {
@@ -2646,7 +3144,7 @@ type SignatureWithIndex struct {
 
 func (x *SignatureWithIndex) Reset() {
 	*x = SignatureWithIndex{}
-	mi := &file_spark_proto_msgTypes[31]
+	mi := &file_spark_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2658,7 +3156,7 @@ func (x *SignatureWithIndex) String() string {
 func (*SignatureWithIndex) ProtoMessage() {}
 
 func (x *SignatureWithIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[31]
+	mi := &file_spark_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2671,7 +3169,7 @@ func (x *SignatureWithIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignatureWithIndex.ProtoReflect.Descriptor instead.
 func (*SignatureWithIndex) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{31}
+	return file_spark_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SignatureWithIndex) GetSignature() []byte {
@@ -2700,7 +3198,7 @@ type TokenTransactionSignatures struct {
 
 func (x *TokenTransactionSignatures) Reset() {
 	*x = TokenTransactionSignatures{}
-	mi := &file_spark_proto_msgTypes[32]
+	mi := &file_spark_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2712,7 +3210,7 @@ func (x *TokenTransactionSignatures) String() string {
 func (*TokenTransactionSignatures) ProtoMessage() {}
 
 func (x *TokenTransactionSignatures) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[32]
+	mi := &file_spark_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2725,7 +3223,7 @@ func (x *TokenTransactionSignatures) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenTransactionSignatures.ProtoReflect.Descriptor instead.
 func (*TokenTransactionSignatures) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{32}
+	return file_spark_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *TokenTransactionSignatures) GetOwnerSignatures() []*SignatureWithIndex {
@@ -2748,7 +3246,7 @@ type StartTokenTransactionRequest struct {
 
 func (x *StartTokenTransactionRequest) Reset() {
 	*x = StartTokenTransactionRequest{}
-	mi := &file_spark_proto_msgTypes[33]
+	mi := &file_spark_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2760,7 +3258,7 @@ func (x *StartTokenTransactionRequest) String() string {
 func (*StartTokenTransactionRequest) ProtoMessage() {}
 
 func (x *StartTokenTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[33]
+	mi := &file_spark_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2773,7 +3271,7 @@ func (x *StartTokenTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTokenTransactionRequest.ProtoReflect.Descriptor instead.
 func (*StartTokenTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{33}
+	return file_spark_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *StartTokenTransactionRequest) GetIdentityPublicKey() []byte {
@@ -2818,7 +3316,7 @@ type StartTokenTransactionResponse struct {
 
 func (x *StartTokenTransactionResponse) Reset() {
 	*x = StartTokenTransactionResponse{}
-	mi := &file_spark_proto_msgTypes[34]
+	mi := &file_spark_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2830,7 +3328,7 @@ func (x *StartTokenTransactionResponse) String() string {
 func (*StartTokenTransactionResponse) ProtoMessage() {}
 
 func (x *StartTokenTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[34]
+	mi := &file_spark_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,7 +3341,7 @@ func (x *StartTokenTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTokenTransactionResponse.ProtoReflect.Descriptor instead.
 func (*StartTokenTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{34}
+	return file_spark_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *StartTokenTransactionResponse) GetFinalTokenTransaction() *TokenTransaction {
@@ -2870,7 +3368,7 @@ type OperatorSpecificTokenTransactionSignablePayload struct {
 
 func (x *OperatorSpecificTokenTransactionSignablePayload) Reset() {
 	*x = OperatorSpecificTokenTransactionSignablePayload{}
-	mi := &file_spark_proto_msgTypes[35]
+	mi := &file_spark_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2882,7 +3380,7 @@ func (x *OperatorSpecificTokenTransactionSignablePayload) String() string {
 func (*OperatorSpecificTokenTransactionSignablePayload) ProtoMessage() {}
 
 func (x *OperatorSpecificTokenTransactionSignablePayload) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[35]
+	mi := &file_spark_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2895,7 +3393,7 @@ func (x *OperatorSpecificTokenTransactionSignablePayload) ProtoReflect() protore
 
 // Deprecated: Use OperatorSpecificTokenTransactionSignablePayload.ProtoReflect.Descriptor instead.
 func (*OperatorSpecificTokenTransactionSignablePayload) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{35}
+	return file_spark_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *OperatorSpecificTokenTransactionSignablePayload) GetFinalTokenTransactionHash() []byte {
@@ -2924,7 +3422,7 @@ type OperatorSpecificOwnerSignature struct {
 
 func (x *OperatorSpecificOwnerSignature) Reset() {
 	*x = OperatorSpecificOwnerSignature{}
-	mi := &file_spark_proto_msgTypes[36]
+	mi := &file_spark_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2936,7 +3434,7 @@ func (x *OperatorSpecificOwnerSignature) String() string {
 func (*OperatorSpecificOwnerSignature) ProtoMessage() {}
 
 func (x *OperatorSpecificOwnerSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[36]
+	mi := &file_spark_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,7 +3447,7 @@ func (x *OperatorSpecificOwnerSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OperatorSpecificOwnerSignature.ProtoReflect.Descriptor instead.
 func (*OperatorSpecificOwnerSignature) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{36}
+	return file_spark_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *OperatorSpecificOwnerSignature) GetOwnerSignature() *SignatureWithIndex {
@@ -2977,7 +3475,7 @@ type SignTokenTransactionRequest struct {
 
 func (x *SignTokenTransactionRequest) Reset() {
 	*x = SignTokenTransactionRequest{}
-	mi := &file_spark_proto_msgTypes[37]
+	mi := &file_spark_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2989,7 +3487,7 @@ func (x *SignTokenTransactionRequest) String() string {
 func (*SignTokenTransactionRequest) ProtoMessage() {}
 
 func (x *SignTokenTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[37]
+	mi := &file_spark_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +3500,7 @@ func (x *SignTokenTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTokenTransactionRequest.ProtoReflect.Descriptor instead.
 func (*SignTokenTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{37}
+	return file_spark_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SignTokenTransactionRequest) GetFinalTokenTransaction() *TokenTransaction {
@@ -3037,7 +3535,7 @@ type KeyshareWithIndex struct {
 
 func (x *KeyshareWithIndex) Reset() {
 	*x = KeyshareWithIndex{}
-	mi := &file_spark_proto_msgTypes[38]
+	mi := &file_spark_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3049,7 +3547,7 @@ func (x *KeyshareWithIndex) String() string {
 func (*KeyshareWithIndex) ProtoMessage() {}
 
 func (x *KeyshareWithIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[38]
+	mi := &file_spark_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3062,7 +3560,7 @@ func (x *KeyshareWithIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyshareWithIndex.ProtoReflect.Descriptor instead.
 func (*KeyshareWithIndex) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{38}
+	return file_spark_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *KeyshareWithIndex) GetInputIndex() uint32 {
@@ -3089,7 +3587,7 @@ type SignTokenTransactionResponse struct {
 
 func (x *SignTokenTransactionResponse) Reset() {
 	*x = SignTokenTransactionResponse{}
-	mi := &file_spark_proto_msgTypes[39]
+	mi := &file_spark_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3101,7 +3599,7 @@ func (x *SignTokenTransactionResponse) String() string {
 func (*SignTokenTransactionResponse) ProtoMessage() {}
 
 func (x *SignTokenTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[39]
+	mi := &file_spark_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3114,7 +3612,7 @@ func (x *SignTokenTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTokenTransactionResponse.ProtoReflect.Descriptor instead.
 func (*SignTokenTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{39}
+	return file_spark_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *SignTokenTransactionResponse) GetSparkOperatorSignature() []byte {
@@ -3142,7 +3640,7 @@ type RevocationSecretWithIndex struct {
 
 func (x *RevocationSecretWithIndex) Reset() {
 	*x = RevocationSecretWithIndex{}
-	mi := &file_spark_proto_msgTypes[40]
+	mi := &file_spark_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3154,7 +3652,7 @@ func (x *RevocationSecretWithIndex) String() string {
 func (*RevocationSecretWithIndex) ProtoMessage() {}
 
 func (x *RevocationSecretWithIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[40]
+	mi := &file_spark_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3167,7 +3665,7 @@ func (x *RevocationSecretWithIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevocationSecretWithIndex.ProtoReflect.Descriptor instead.
 func (*RevocationSecretWithIndex) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{40}
+	return file_spark_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *RevocationSecretWithIndex) GetInputIndex() uint32 {
@@ -3198,7 +3696,7 @@ type FinalizeTokenTransactionRequest struct {
 
 func (x *FinalizeTokenTransactionRequest) Reset() {
 	*x = FinalizeTokenTransactionRequest{}
-	mi := &file_spark_proto_msgTypes[41]
+	mi := &file_spark_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3210,7 +3708,7 @@ func (x *FinalizeTokenTransactionRequest) String() string {
 func (*FinalizeTokenTransactionRequest) ProtoMessage() {}
 
 func (x *FinalizeTokenTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[41]
+	mi := &file_spark_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3223,7 +3721,7 @@ func (x *FinalizeTokenTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeTokenTransactionRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeTokenTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{41}
+	return file_spark_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *FinalizeTokenTransactionRequest) GetFinalTokenTransaction() *TokenTransaction {
@@ -3269,7 +3767,7 @@ type FreezeTokensPayload struct {
 
 func (x *FreezeTokensPayload) Reset() {
 	*x = FreezeTokensPayload{}
-	mi := &file_spark_proto_msgTypes[42]
+	mi := &file_spark_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3281,7 +3779,7 @@ func (x *FreezeTokensPayload) String() string {
 func (*FreezeTokensPayload) ProtoMessage() {}
 
 func (x *FreezeTokensPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[42]
+	mi := &file_spark_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,7 +3792,7 @@ func (x *FreezeTokensPayload) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeTokensPayload.ProtoReflect.Descriptor instead.
 func (*FreezeTokensPayload) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{42}
+	return file_spark_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *FreezeTokensPayload) GetOwnerPublicKey() []byte {
@@ -3350,7 +3848,7 @@ type FreezeTokensRequest struct {
 
 func (x *FreezeTokensRequest) Reset() {
 	*x = FreezeTokensRequest{}
-	mi := &file_spark_proto_msgTypes[43]
+	mi := &file_spark_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3362,7 +3860,7 @@ func (x *FreezeTokensRequest) String() string {
 func (*FreezeTokensRequest) ProtoMessage() {}
 
 func (x *FreezeTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[43]
+	mi := &file_spark_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3375,7 +3873,7 @@ func (x *FreezeTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeTokensRequest.ProtoReflect.Descriptor instead.
 func (*FreezeTokensRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{43}
+	return file_spark_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *FreezeTokensRequest) GetFreezeTokensPayload() *FreezeTokensPayload {
@@ -3402,7 +3900,7 @@ type FreezeTokensResponse struct {
 
 func (x *FreezeTokensResponse) Reset() {
 	*x = FreezeTokensResponse{}
-	mi := &file_spark_proto_msgTypes[44]
+	mi := &file_spark_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3414,7 +3912,7 @@ func (x *FreezeTokensResponse) String() string {
 func (*FreezeTokensResponse) ProtoMessage() {}
 
 func (x *FreezeTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[44]
+	mi := &file_spark_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3427,7 +3925,7 @@ func (x *FreezeTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FreezeTokensResponse.ProtoReflect.Descriptor instead.
 func (*FreezeTokensResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{44}
+	return file_spark_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *FreezeTokensResponse) GetImpactedOutputIds() []string {
@@ -3457,7 +3955,7 @@ type QueryTokenOutputsRequest struct {
 
 func (x *QueryTokenOutputsRequest) Reset() {
 	*x = QueryTokenOutputsRequest{}
-	mi := &file_spark_proto_msgTypes[45]
+	mi := &file_spark_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3469,7 +3967,7 @@ func (x *QueryTokenOutputsRequest) String() string {
 func (*QueryTokenOutputsRequest) ProtoMessage() {}
 
 func (x *QueryTokenOutputsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[45]
+	mi := &file_spark_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3482,7 +3980,7 @@ func (x *QueryTokenOutputsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenOutputsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTokenOutputsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{45}
+	return file_spark_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *QueryTokenOutputsRequest) GetOwnerPublicKeys() [][]byte {
@@ -3534,7 +4032,7 @@ type QueryTokenTransactionsRequest struct {
 
 func (x *QueryTokenTransactionsRequest) Reset() {
 	*x = QueryTokenTransactionsRequest{}
-	mi := &file_spark_proto_msgTypes[46]
+	mi := &file_spark_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3546,7 +4044,7 @@ func (x *QueryTokenTransactionsRequest) String() string {
 func (*QueryTokenTransactionsRequest) ProtoMessage() {}
 
 func (x *QueryTokenTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[46]
+	mi := &file_spark_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3559,7 +4057,7 @@ func (x *QueryTokenTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTokenTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{46}
+	return file_spark_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *QueryTokenTransactionsRequest) GetOutputIds() []string {
@@ -3621,7 +4119,7 @@ type QueryTokenTransactionsResponse struct {
 
 func (x *QueryTokenTransactionsResponse) Reset() {
 	*x = QueryTokenTransactionsResponse{}
-	mi := &file_spark_proto_msgTypes[47]
+	mi := &file_spark_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3633,7 +4131,7 @@ func (x *QueryTokenTransactionsResponse) String() string {
 func (*QueryTokenTransactionsResponse) ProtoMessage() {}
 
 func (x *QueryTokenTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[47]
+	mi := &file_spark_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3646,7 +4144,7 @@ func (x *QueryTokenTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTokenTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{47}
+	return file_spark_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *QueryTokenTransactionsResponse) GetTokenTransactionsWithStatus() []*TokenTransactionWithStatus {
@@ -3674,7 +4172,7 @@ type OutputWithPreviousTransactionData struct {
 
 func (x *OutputWithPreviousTransactionData) Reset() {
 	*x = OutputWithPreviousTransactionData{}
-	mi := &file_spark_proto_msgTypes[48]
+	mi := &file_spark_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3686,7 +4184,7 @@ func (x *OutputWithPreviousTransactionData) String() string {
 func (*OutputWithPreviousTransactionData) ProtoMessage() {}
 
 func (x *OutputWithPreviousTransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[48]
+	mi := &file_spark_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3699,7 +4197,7 @@ func (x *OutputWithPreviousTransactionData) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use OutputWithPreviousTransactionData.ProtoReflect.Descriptor instead.
 func (*OutputWithPreviousTransactionData) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{48}
+	return file_spark_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *OutputWithPreviousTransactionData) GetOutput() *TokenOutput {
@@ -3732,7 +4230,7 @@ type QueryTokenOutputsResponse struct {
 
 func (x *QueryTokenOutputsResponse) Reset() {
 	*x = QueryTokenOutputsResponse{}
-	mi := &file_spark_proto_msgTypes[49]
+	mi := &file_spark_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3744,7 +4242,7 @@ func (x *QueryTokenOutputsResponse) String() string {
 func (*QueryTokenOutputsResponse) ProtoMessage() {}
 
 func (x *QueryTokenOutputsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[49]
+	mi := &file_spark_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3757,7 +4255,7 @@ func (x *QueryTokenOutputsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenOutputsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTokenOutputsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{49}
+	return file_spark_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *QueryTokenOutputsResponse) GetOutputsWithPreviousTransactionData() []*OutputWithPreviousTransactionData {
@@ -3813,7 +4311,7 @@ type TreeNode struct {
 
 func (x *TreeNode) Reset() {
 	*x = TreeNode{}
-	mi := &file_spark_proto_msgTypes[50]
+	mi := &file_spark_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3825,7 +4323,7 @@ func (x *TreeNode) String() string {
 func (*TreeNode) ProtoMessage() {}
 
 func (x *TreeNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[50]
+	mi := &file_spark_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,7 +4336,7 @@ func (x *TreeNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TreeNode.ProtoReflect.Descriptor instead.
 func (*TreeNode) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{50}
+	return file_spark_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *TreeNode) GetId() string {
@@ -3981,7 +4479,7 @@ type FinalizeNodeSignaturesRequest struct {
 
 func (x *FinalizeNodeSignaturesRequest) Reset() {
 	*x = FinalizeNodeSignaturesRequest{}
-	mi := &file_spark_proto_msgTypes[51]
+	mi := &file_spark_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3993,7 +4491,7 @@ func (x *FinalizeNodeSignaturesRequest) String() string {
 func (*FinalizeNodeSignaturesRequest) ProtoMessage() {}
 
 func (x *FinalizeNodeSignaturesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[51]
+	mi := &file_spark_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4006,7 +4504,7 @@ func (x *FinalizeNodeSignaturesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeNodeSignaturesRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeNodeSignaturesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{51}
+	return file_spark_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *FinalizeNodeSignaturesRequest) GetIntent() common.SignatureIntent {
@@ -4035,7 +4533,7 @@ type FinalizeNodeSignaturesResponse struct {
 
 func (x *FinalizeNodeSignaturesResponse) Reset() {
 	*x = FinalizeNodeSignaturesResponse{}
-	mi := &file_spark_proto_msgTypes[52]
+	mi := &file_spark_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4047,7 +4545,7 @@ func (x *FinalizeNodeSignaturesResponse) String() string {
 func (*FinalizeNodeSignaturesResponse) ProtoMessage() {}
 
 func (x *FinalizeNodeSignaturesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[52]
+	mi := &file_spark_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4060,7 +4558,7 @@ func (x *FinalizeNodeSignaturesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeNodeSignaturesResponse.ProtoReflect.Descriptor instead.
 func (*FinalizeNodeSignaturesResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{52}
+	return file_spark_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *FinalizeNodeSignaturesResponse) GetNodes() []*TreeNode {
@@ -4086,7 +4584,7 @@ type SecretShare struct {
 
 func (x *SecretShare) Reset() {
 	*x = SecretShare{}
-	mi := &file_spark_proto_msgTypes[53]
+	mi := &file_spark_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4098,7 +4596,7 @@ func (x *SecretShare) String() string {
 func (*SecretShare) ProtoMessage() {}
 
 func (x *SecretShare) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[53]
+	mi := &file_spark_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4111,7 +4609,7 @@ func (x *SecretShare) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretShare.ProtoReflect.Descriptor instead.
 func (*SecretShare) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{53}
+	return file_spark_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *SecretShare) GetSecretShare() []byte {
@@ -4142,7 +4640,7 @@ type SecretProof struct {
 
 func (x *SecretProof) Reset() {
 	*x = SecretProof{}
-	mi := &file_spark_proto_msgTypes[54]
+	mi := &file_spark_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4154,7 +4652,7 @@ func (x *SecretProof) String() string {
 func (*SecretProof) ProtoMessage() {}
 
 func (x *SecretProof) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[54]
+	mi := &file_spark_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4167,7 +4665,7 @@ func (x *SecretProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SecretProof.ProtoReflect.Descriptor instead.
 func (*SecretProof) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{54}
+	return file_spark_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *SecretProof) GetProofs() [][]byte {
@@ -4189,7 +4687,7 @@ type LeafRefundTxSigningJob struct {
 
 func (x *LeafRefundTxSigningJob) Reset() {
 	*x = LeafRefundTxSigningJob{}
-	mi := &file_spark_proto_msgTypes[55]
+	mi := &file_spark_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4201,7 +4699,7 @@ func (x *LeafRefundTxSigningJob) String() string {
 func (*LeafRefundTxSigningJob) ProtoMessage() {}
 
 func (x *LeafRefundTxSigningJob) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[55]
+	mi := &file_spark_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4214,7 +4712,7 @@ func (x *LeafRefundTxSigningJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeafRefundTxSigningJob.ProtoReflect.Descriptor instead.
 func (*LeafRefundTxSigningJob) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{55}
+	return file_spark_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *LeafRefundTxSigningJob) GetLeafId() string {
@@ -4259,7 +4757,7 @@ type UserSignedTxSigningJob struct {
 
 func (x *UserSignedTxSigningJob) Reset() {
 	*x = UserSignedTxSigningJob{}
-	mi := &file_spark_proto_msgTypes[56]
+	mi := &file_spark_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4271,7 +4769,7 @@ func (x *UserSignedTxSigningJob) String() string {
 func (*UserSignedTxSigningJob) ProtoMessage() {}
 
 func (x *UserSignedTxSigningJob) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[56]
+	mi := &file_spark_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4284,7 +4782,7 @@ func (x *UserSignedTxSigningJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSignedTxSigningJob.ProtoReflect.Descriptor instead.
 func (*UserSignedTxSigningJob) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{56}
+	return file_spark_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *UserSignedTxSigningJob) GetLeafId() string {
@@ -4342,7 +4840,7 @@ type LeafRefundTxSigningResult struct {
 
 func (x *LeafRefundTxSigningResult) Reset() {
 	*x = LeafRefundTxSigningResult{}
-	mi := &file_spark_proto_msgTypes[57]
+	mi := &file_spark_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4354,7 +4852,7 @@ func (x *LeafRefundTxSigningResult) String() string {
 func (*LeafRefundTxSigningResult) ProtoMessage() {}
 
 func (x *LeafRefundTxSigningResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[57]
+	mi := &file_spark_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4367,7 +4865,7 @@ func (x *LeafRefundTxSigningResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeafRefundTxSigningResult.ProtoReflect.Descriptor instead.
 func (*LeafRefundTxSigningResult) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{57}
+	return file_spark_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *LeafRefundTxSigningResult) GetLeafId() string {
@@ -4420,7 +4918,7 @@ type StartUserSignedTransferRequest struct {
 
 func (x *StartUserSignedTransferRequest) Reset() {
 	*x = StartUserSignedTransferRequest{}
-	mi := &file_spark_proto_msgTypes[58]
+	mi := &file_spark_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4432,7 +4930,7 @@ func (x *StartUserSignedTransferRequest) String() string {
 func (*StartUserSignedTransferRequest) ProtoMessage() {}
 
 func (x *StartUserSignedTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[58]
+	mi := &file_spark_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4445,7 +4943,7 @@ func (x *StartUserSignedTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartUserSignedTransferRequest.ProtoReflect.Descriptor instead.
 func (*StartUserSignedTransferRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{58}
+	return file_spark_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *StartUserSignedTransferRequest) GetTransferId() string {
@@ -4514,7 +5012,7 @@ type StartTransferRequest struct {
 
 func (x *StartTransferRequest) Reset() {
 	*x = StartTransferRequest{}
-	mi := &file_spark_proto_msgTypes[59]
+	mi := &file_spark_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4526,7 +5024,7 @@ func (x *StartTransferRequest) String() string {
 func (*StartTransferRequest) ProtoMessage() {}
 
 func (x *StartTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[59]
+	mi := &file_spark_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4539,82 +5037,235 @@ func (x *StartTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTransferRequest.ProtoReflect.Descriptor instead.
 func (*StartTransferRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{59}
+	return file_spark_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StartTransferRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *StartTransferRequest) GetOwnerIdentityPublicKey() []byte {
+	if x != nil {
+		return x.OwnerIdentityPublicKey
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in spark.proto.
+func (x *StartTransferRequest) GetLeavesToSend() []*LeafRefundTxSigningJob {
+	if x != nil {
+		return x.LeavesToSend
+	}
+	return nil
+}
+
+func (x *StartTransferRequest) GetReceiverIdentityPublicKey() []byte {
+	if x != nil {
+		return x.ReceiverIdentityPublicKey
+	}
+	return nil
+}
+
+func (x *StartTransferRequest) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+func (x *StartTransferRequest) GetTransferPackage() *TransferPackage {
+	if x != nil {
+		return x.TransferPackage
+	}
+	return nil
+}
+
+func (x *StartTransferRequest) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
+type StartTransferResponse struct {
+	state          protoimpl.MessageState       `protogen:"open.v1"`
+	Transfer       *Transfer                    `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	SigningResults []*LeafRefundTxSigningResult `protobuf:"bytes,2,rep,name=signing_results,json=signingResults,proto3" json:"signing_results,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartTransferResponse) Reset() {
+	*x = StartTransferResponse{}
+	mi := &file_spark_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTransferResponse) ProtoMessage() {}
+
+func (x *StartTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTransferResponse.ProtoReflect.Descriptor instead.
+func (*StartTransferResponse) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StartTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *StartTransferResponse) GetSigningResults() []*LeafRefundTxSigningResult {
+	if x != nil {
+		return x.SigningResults
+	}
+	return nil
+}
+
+type StartBatchTransferRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	BatchId string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// One transfer per receiver. All transfers must be from the same owner and must use a transfer package.
+	Transfers     []*StartTransferRequest `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartBatchTransferRequest) Reset() {
+	*x = StartBatchTransferRequest{}
+	mi := &file_spark_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartBatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBatchTransferRequest) ProtoMessage() {}
+
+func (x *StartBatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *StartTransferRequest) GetTransferId() string {
+// Deprecated: Use StartBatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*StartBatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *StartBatchTransferRequest) GetBatchId() string {
 	if x != nil {
-		return x.TransferId
+		return x.BatchId
 	}
 	return ""
 }
 
-func (x *StartTransferRequest) GetOwnerIdentityPublicKey() []byte {
+func (x *StartBatchTransferRequest) GetTransfers() []*StartTransferRequest {
 	if x != nil {
-		return x.OwnerIdentityPublicKey
+		return x.Transfers
 	}
 	return nil
 }
 
-// Deprecated: Marked as deprecated in spark.proto.
-func (x *StartTransferRequest) GetLeavesToSend() []*LeafRefundTxSigningJob {
-	if x != nil {
-		return x.LeavesToSend
-	}
-	return nil
+type StartBatchTransferResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Transfers     []*StartTransferResponse `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTransferRequest) GetReceiverIdentityPublicKey() []byte {
-	if x != nil {
-		return x.ReceiverIdentityPublicKey
-	}
-	return nil
+func (x *StartBatchTransferResponse) Reset() {
+	*x = StartBatchTransferResponse{}
+	mi := &file_spark_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTransferRequest) GetExpiryTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ExpiryTime
-	}
-	return nil
+func (x *StartBatchTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartTransferRequest) GetTransferPackage() *TransferPackage {
+func (*StartBatchTransferResponse) ProtoMessage() {}
+
+func (x *StartBatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[67]
 	if x != nil {
-		return x.TransferPackage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StartTransferRequest) GetSparkPaymentIntent() string {
+// Deprecated: Use StartBatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*StartBatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *StartBatchTransferResponse) GetTransfers() []*StartTransferResponse {
 	if x != nil {
-		return x.SparkPaymentIntent
+		return x.Transfers
 	}
-	return ""
+	return nil
 }
 
-type StartTransferResponse struct {
-	state          protoimpl.MessageState       `protogen:"open.v1"`
-	Transfer       *Transfer                    `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
-	SigningResults []*LeafRefundTxSigningResult `protobuf:"bytes,2,rep,name=signing_results,json=signingResults,proto3" json:"signing_results,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type StartHtlcTransferRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The transfer to lock. It must use a transfer package, and its expiry time is the deadline for the receiver
+	// to reveal the preimage.
+	Transfer *StartTransferRequest `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// The sha256 hash of the preimage that unlocks the transfer. The receiver reveals the preimage with
+	// provide_preimage, passing the transfer id.
+	PaymentHash   []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTransferResponse) Reset() {
-	*x = StartTransferResponse{}
-	mi := &file_spark_proto_msgTypes[60]
+func (x *StartHtlcTransferRequest) Reset() {
+	*x = StartHtlcTransferRequest{}
+	mi := &file_spark_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTransferResponse) String() string {
+func (x *StartHtlcTransferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTransferResponse) ProtoMessage() {}
+func (*StartHtlcTransferRequest) ProtoMessage() {}
 
-func (x *StartTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[60]
+func (x *StartHtlcTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4625,21 +5276,21 @@ func (x *StartTransferResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTransferResponse.ProtoReflect.Descriptor instead.
-func (*StartTransferResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use StartHtlcTransferRequest.ProtoReflect.Descriptor instead.
+func (*StartHtlcTransferRequest) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *StartTransferResponse) GetTransfer() *Transfer {
+func (x *StartHtlcTransferRequest) GetTransfer() *StartTransferRequest {
 	if x != nil {
 		return x.Transfer
 	}
 	return nil
 }
 
-func (x *StartTransferResponse) GetSigningResults() []*LeafRefundTxSigningResult {
+func (x *StartHtlcTransferRequest) GetPaymentHash() []byte {
 	if x != nil {
-		return x.SigningResults
+		return x.PaymentHash
 	}
 	return nil
 }
@@ -4666,7 +5317,7 @@ type TransferPackage struct {
 
 func (x *TransferPackage) Reset() {
 	*x = TransferPackage{}
-	mi := &file_spark_proto_msgTypes[61]
+	mi := &file_spark_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4678,7 +5329,7 @@ func (x *TransferPackage) String() string {
 func (*TransferPackage) ProtoMessage() {}
 
 func (x *TransferPackage) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[61]
+	mi := &file_spark_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4691,7 +5342,7 @@ func (x *TransferPackage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferPackage.ProtoReflect.Descriptor instead.
 func (*TransferPackage) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{61}
+	return file_spark_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *TransferPackage) GetLeavesToSend() []*UserSignedTxSigningJob {
@@ -4738,7 +5389,7 @@ type SendLeafKeyTweaks struct {
 
 func (x *SendLeafKeyTweaks) Reset() {
 	*x = SendLeafKeyTweaks{}
-	mi := &file_spark_proto_msgTypes[62]
+	mi := &file_spark_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4750,7 +5401,7 @@ func (x *SendLeafKeyTweaks) String() string {
 func (*SendLeafKeyTweaks) ProtoMessage() {}
 
 func (x *SendLeafKeyTweaks) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[62]
+	mi := &file_spark_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4763,7 +5414,7 @@ func (x *SendLeafKeyTweaks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendLeafKeyTweaks.ProtoReflect.Descriptor instead.
 func (*SendLeafKeyTweaks) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{62}
+	return file_spark_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *SendLeafKeyTweaks) GetLeavesToSend() []*SendLeafKeyTweak {
@@ -4790,7 +5441,7 @@ type SendLeafKeyTweak struct {
 
 func (x *SendLeafKeyTweak) Reset() {
 	*x = SendLeafKeyTweak{}
-	mi := &file_spark_proto_msgTypes[63]
+	mi := &file_spark_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4802,7 +5453,7 @@ func (x *SendLeafKeyTweak) String() string {
 func (*SendLeafKeyTweak) ProtoMessage() {}
 
 func (x *SendLeafKeyTweak) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[63]
+	mi := &file_spark_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4815,7 +5466,7 @@ func (x *SendLeafKeyTweak) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendLeafKeyTweak.ProtoReflect.Descriptor instead.
 func (*SendLeafKeyTweak) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{63}
+	return file_spark_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *SendLeafKeyTweak) GetLeafId() string {
@@ -4886,7 +5537,7 @@ type FinalizeTransferRequest struct {
 
 func (x *FinalizeTransferRequest) Reset() {
 	*x = FinalizeTransferRequest{}
-	mi := &file_spark_proto_msgTypes[64]
+	mi := &file_spark_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4898,7 +5549,7 @@ func (x *FinalizeTransferRequest) String() string {
 func (*FinalizeTransferRequest) ProtoMessage() {}
 
 func (x *FinalizeTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[64]
+	mi := &file_spark_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4911,7 +5562,7 @@ func (x *FinalizeTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeTransferRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeTransferRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{64}
+	return file_spark_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *FinalizeTransferRequest) GetTransferId() string {
@@ -4953,7 +5604,7 @@ type FinalizeTransferWithTransferPackageRequest struct {
 
 func (x *FinalizeTransferWithTransferPackageRequest) Reset() {
 	*x = FinalizeTransferWithTransferPackageRequest{}
-	mi := &file_spark_proto_msgTypes[65]
+	mi := &file_spark_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4965,7 +5616,7 @@ func (x *FinalizeTransferWithTransferPackageRequest) String() string {
 func (*FinalizeTransferWithTransferPackageRequest) ProtoMessage() {}
 
 func (x *FinalizeTransferWithTransferPackageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[65]
+	mi := &file_spark_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4978,7 +5629,7 @@ func (x *FinalizeTransferWithTransferPackageRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use FinalizeTransferWithTransferPackageRequest.ProtoReflect.Descriptor instead.
 func (*FinalizeTransferWithTransferPackageRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{65}
+	return file_spark_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *FinalizeTransferWithTransferPackageRequest) GetTransferId() string {
@@ -5011,7 +5662,7 @@ type FinalizeTransferResponse struct {
 
 func (x *FinalizeTransferResponse) Reset() {
 	*x = FinalizeTransferResponse{}
-	mi := &file_spark_proto_msgTypes[66]
+	mi := &file_spark_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5023,7 +5674,7 @@ func (x *FinalizeTransferResponse) String() string {
 func (*FinalizeTransferResponse) ProtoMessage() {}
 
 func (x *FinalizeTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[66]
+	mi := &file_spark_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5036,7 +5687,7 @@ func (x *FinalizeTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeTransferResponse.ProtoReflect.Descriptor instead.
 func (*FinalizeTransferResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{66}
+	return file_spark_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *FinalizeTransferResponse) GetTransfer() *Transfer {
@@ -5058,13 +5709,17 @@ type Transfer struct {
 	CreatedTime               *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	UpdatedTime               *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_time,json=updatedTime,proto3" json:"updated_time,omitempty"`
 	Type                      TransferType           `protobuf:"varint,10,opt,name=type,proto3,enum=spark.TransferType" json:"type,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	// The payment intent the transfer was started with, if any.
+	SparkPaymentIntent string `protobuf:"bytes,11,opt,name=spark_payment_intent,json=sparkPaymentIntent,proto3" json:"spark_payment_intent,omitempty"`
+	// The batch the transfer was sent in, if it was sent with start_batch_transfer.
+	BatchId       string `protobuf:"bytes,12,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Transfer) Reset() {
 	*x = Transfer{}
-	mi := &file_spark_proto_msgTypes[67]
+	mi := &file_spark_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5076,7 +5731,7 @@ func (x *Transfer) String() string {
 func (*Transfer) ProtoMessage() {}
 
 func (x *Transfer) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[67]
+	mi := &file_spark_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5089,7 +5744,7 @@ func (x *Transfer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
 func (*Transfer) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{67}
+	return file_spark_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *Transfer) GetId() string {
@@ -5162,6 +5817,20 @@ func (x *Transfer) GetType() TransferType {
 	return TransferType_PREIMAGE_SWAP
 }
 
+func (x *Transfer) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
+func (x *Transfer) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
 type TransferLeaf struct {
 	state                              protoimpl.MessageState `protogen:"open.v1"`
 	Leaf                               *TreeNode              `protobuf:"bytes,1,opt,name=leaf,proto3" json:"leaf,omitempty"`
@@ -5177,7 +5846,7 @@ type TransferLeaf struct {
 
 func (x *TransferLeaf) Reset() {
 	*x = TransferLeaf{}
-	mi := &file_spark_proto_msgTypes[68]
+	mi := &file_spark_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5189,7 +5858,7 @@ func (x *TransferLeaf) String() string {
 func (*TransferLeaf) ProtoMessage() {}
 
 func (x *TransferLeaf) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[68]
+	mi := &file_spark_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5202,7 +5871,7 @@ func (x *TransferLeaf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferLeaf.ProtoReflect.Descriptor instead.
 func (*TransferLeaf) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{68}
+	return file_spark_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *TransferLeaf) GetLeaf() *TreeNode {
@@ -5261,21 +5930,23 @@ type TransferFilter struct {
 	//	*TransferFilter_ReceiverIdentityPublicKey
 	//	*TransferFilter_SenderIdentityPublicKey
 	//	*TransferFilter_SenderOrReceiverIdentityPublicKey
-	Participant   isTransferFilter_Participant `protobuf_oneof:"participant"`
-	TransferIds   []string                     `protobuf:"bytes,3,rep,name=transfer_ids,json=transferIds,proto3" json:"transfer_ids,omitempty"`
-	Limit         int64                        `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int64                        `protobuf:"varint,50,opt,name=offset,proto3" json:"offset,omitempty"`
-	Types         []TransferType               `protobuf:"varint,70,rep,packed,name=types,proto3,enum=spark.TransferType" json:"types,omitempty"`
-	Network       Network                      `protobuf:"varint,4,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"` // defaults to mainnet when no network is provided.
-	Statuses      []TransferStatus             `protobuf:"varint,80,rep,packed,name=statuses,proto3,enum=spark.TransferStatus" json:"statuses,omitempty"`
-	Order         Order                        `protobuf:"varint,5,opt,name=order,proto3,enum=spark.Order" json:"order,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Participant isTransferFilter_Participant `protobuf_oneof:"participant"`
+	TransferIds []string                     `protobuf:"bytes,3,rep,name=transfer_ids,json=transferIds,proto3" json:"transfer_ids,omitempty"`
+	Limit       int64                        `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset      int64                        `protobuf:"varint,50,opt,name=offset,proto3" json:"offset,omitempty"`
+	Types       []TransferType               `protobuf:"varint,70,rep,packed,name=types,proto3,enum=spark.TransferType" json:"types,omitempty"`
+	Network     Network                      `protobuf:"varint,4,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"` // defaults to mainnet when no network is provided.
+	Statuses    []TransferStatus             `protobuf:"varint,80,rep,packed,name=statuses,proto3,enum=spark.TransferStatus" json:"statuses,omitempty"`
+	Order       Order                        `protobuf:"varint,5,opt,name=order,proto3,enum=spark.Order" json:"order,omitempty"`
+	// Returns transfers started with one of these payment intents.
+	SparkPaymentIntents []string `protobuf:"bytes,6,rep,name=spark_payment_intents,json=sparkPaymentIntents,proto3" json:"spark_payment_intents,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *TransferFilter) Reset() {
 	*x = TransferFilter{}
-	mi := &file_spark_proto_msgTypes[69]
+	mi := &file_spark_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5287,7 +5958,7 @@ func (x *TransferFilter) String() string {
 func (*TransferFilter) ProtoMessage() {}
 
 func (x *TransferFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[69]
+	mi := &file_spark_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5300,7 +5971,7 @@ func (x *TransferFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferFilter.ProtoReflect.Descriptor instead.
 func (*TransferFilter) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{69}
+	return file_spark_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *TransferFilter) GetParticipant() isTransferFilter_Participant {
@@ -5386,6 +6057,13 @@ func (x *TransferFilter) GetOrder() Order {
 	return Order_DESCENDING
 }
 
+func (x *TransferFilter) GetSparkPaymentIntents() []string {
+	if x != nil {
+		return x.SparkPaymentIntents
+	}
+	return nil
+}
+
 type isTransferFilter_Participant interface {
 	isTransferFilter_Participant()
 }
@@ -5419,7 +6097,7 @@ type QueryTransfersResponse struct {
 
 func (x *QueryTransfersResponse) Reset() {
 	*x = QueryTransfersResponse{}
-	mi := &file_spark_proto_msgTypes[70]
+	mi := &file_spark_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5431,7 +6109,7 @@ func (x *QueryTransfersResponse) String() string {
 func (*QueryTransfersResponse) ProtoMessage() {}
 
 func (x *QueryTransfersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[70]
+	mi := &file_spark_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5444,7 +6122,7 @@ func (x *QueryTransfersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTransfersResponse.ProtoReflect.Descriptor instead.
 func (*QueryTransfersResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{70}
+	return file_spark_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *QueryTransfersResponse) GetTransfers() []*Transfer {
@@ -5472,7 +6150,7 @@ type ClaimLeafKeyTweak struct {
 
 func (x *ClaimLeafKeyTweak) Reset() {
 	*x = ClaimLeafKeyTweak{}
-	mi := &file_spark_proto_msgTypes[71]
+	mi := &file_spark_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5484,7 +6162,7 @@ func (x *ClaimLeafKeyTweak) String() string {
 func (*ClaimLeafKeyTweak) ProtoMessage() {}
 
 func (x *ClaimLeafKeyTweak) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[71]
+	mi := &file_spark_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5497,7 +6175,7 @@ func (x *ClaimLeafKeyTweak) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimLeafKeyTweak.ProtoReflect.Descriptor instead.
 func (*ClaimLeafKeyTweak) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{71}
+	return file_spark_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *ClaimLeafKeyTweak) GetLeafId() string {
@@ -5532,7 +6210,7 @@ type ClaimTransferTweakKeysRequest struct {
 
 func (x *ClaimTransferTweakKeysRequest) Reset() {
 	*x = ClaimTransferTweakKeysRequest{}
-	mi := &file_spark_proto_msgTypes[72]
+	mi := &file_spark_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5544,7 +6222,7 @@ func (x *ClaimTransferTweakKeysRequest) String() string {
 func (*ClaimTransferTweakKeysRequest) ProtoMessage() {}
 
 func (x *ClaimTransferTweakKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[72]
+	mi := &file_spark_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5557,7 +6235,7 @@ func (x *ClaimTransferTweakKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimTransferTweakKeysRequest.ProtoReflect.Descriptor instead.
 func (*ClaimTransferTweakKeysRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{72}
+	return file_spark_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *ClaimTransferTweakKeysRequest) GetTransferId() string {
@@ -5592,7 +6270,7 @@ type ClaimTransferSignRefundsRequest struct {
 
 func (x *ClaimTransferSignRefundsRequest) Reset() {
 	*x = ClaimTransferSignRefundsRequest{}
-	mi := &file_spark_proto_msgTypes[73]
+	mi := &file_spark_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5604,7 +6282,7 @@ func (x *ClaimTransferSignRefundsRequest) String() string {
 func (*ClaimTransferSignRefundsRequest) ProtoMessage() {}
 
 func (x *ClaimTransferSignRefundsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[73]
+	mi := &file_spark_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5617,7 +6295,7 @@ func (x *ClaimTransferSignRefundsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimTransferSignRefundsRequest.ProtoReflect.Descriptor instead.
 func (*ClaimTransferSignRefundsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{73}
+	return file_spark_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ClaimTransferSignRefundsRequest) GetTransferId() string {
@@ -5650,7 +6328,7 @@ type ClaimTransferSignRefundsResponse struct {
 
 func (x *ClaimTransferSignRefundsResponse) Reset() {
 	*x = ClaimTransferSignRefundsResponse{}
-	mi := &file_spark_proto_msgTypes[74]
+	mi := &file_spark_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5662,7 +6340,7 @@ func (x *ClaimTransferSignRefundsResponse) String() string {
 func (*ClaimTransferSignRefundsResponse) ProtoMessage() {}
 
 func (x *ClaimTransferSignRefundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[74]
+	mi := &file_spark_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5675,7 +6353,7 @@ func (x *ClaimTransferSignRefundsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClaimTransferSignRefundsResponse.ProtoReflect.Descriptor instead.
 func (*ClaimTransferSignRefundsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{74}
+	return file_spark_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ClaimTransferSignRefundsResponse) GetSigningResults() []*LeafRefundTxSigningResult {
@@ -5698,7 +6376,7 @@ type StorePreimageShareRequest struct {
 
 func (x *StorePreimageShareRequest) Reset() {
 	*x = StorePreimageShareRequest{}
-	mi := &file_spark_proto_msgTypes[75]
+	mi := &file_spark_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5710,7 +6388,7 @@ func (x *StorePreimageShareRequest) String() string {
 func (*StorePreimageShareRequest) ProtoMessage() {}
 
 func (x *StorePreimageShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[75]
+	mi := &file_spark_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5723,7 +6401,7 @@ func (x *StorePreimageShareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorePreimageShareRequest.ProtoReflect.Descriptor instead.
 func (*StorePreimageShareRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{75}
+	return file_spark_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *StorePreimageShareRequest) GetPaymentHash() []byte {
@@ -5770,7 +6448,7 @@ type RequestedSigningCommitments struct {
 
 func (x *RequestedSigningCommitments) Reset() {
 	*x = RequestedSigningCommitments{}
-	mi := &file_spark_proto_msgTypes[76]
+	mi := &file_spark_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5782,7 +6460,7 @@ func (x *RequestedSigningCommitments) String() string {
 func (*RequestedSigningCommitments) ProtoMessage() {}
 
 func (x *RequestedSigningCommitments) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[76]
+	mi := &file_spark_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5795,7 +6473,7 @@ func (x *RequestedSigningCommitments) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestedSigningCommitments.ProtoReflect.Descriptor instead.
 func (*RequestedSigningCommitments) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{76}
+	return file_spark_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *RequestedSigningCommitments) GetSigningNonceCommitments() map[string]*common.SigningCommitment {
@@ -5815,7 +6493,7 @@ type GetSigningCommitmentsRequest struct {
 
 func (x *GetSigningCommitmentsRequest) Reset() {
 	*x = GetSigningCommitmentsRequest{}
-	mi := &file_spark_proto_msgTypes[77]
+	mi := &file_spark_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5827,7 +6505,7 @@ func (x *GetSigningCommitmentsRequest) String() string {
 func (*GetSigningCommitmentsRequest) ProtoMessage() {}
 
 func (x *GetSigningCommitmentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[77]
+	mi := &file_spark_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5840,7 +6518,7 @@ func (x *GetSigningCommitmentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSigningCommitmentsRequest.ProtoReflect.Descriptor instead.
 func (*GetSigningCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{77}
+	return file_spark_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *GetSigningCommitmentsRequest) GetNodeIds() []string {
@@ -5866,7 +6544,7 @@ type GetSigningCommitmentsResponse struct {
 
 func (x *GetSigningCommitmentsResponse) Reset() {
 	*x = GetSigningCommitmentsResponse{}
-	mi := &file_spark_proto_msgTypes[78]
+	mi := &file_spark_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5878,7 +6556,7 @@ func (x *GetSigningCommitmentsResponse) String() string {
 func (*GetSigningCommitmentsResponse) ProtoMessage() {}
 
 func (x *GetSigningCommitmentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[78]
+	mi := &file_spark_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5891,7 +6569,7 @@ func (x *GetSigningCommitmentsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSigningCommitmentsResponse.ProtoReflect.Descriptor instead.
 func (*GetSigningCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{78}
+	return file_spark_proto_rawDescGZIP(), []int{86}
 }
 
 func (x *GetSigningCommitmentsResponse) GetSigningCommitments() []*RequestedSigningCommitments {
@@ -5910,7 +6588,7 @@ type SigningCommitments struct {
 
 func (x *SigningCommitments) Reset() {
 	*x = SigningCommitments{}
-	mi := &file_spark_proto_msgTypes[79]
+	mi := &file_spark_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5922,7 +6600,7 @@ func (x *SigningCommitments) String() string {
 func (*SigningCommitments) ProtoMessage() {}
 
 func (x *SigningCommitments) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[79]
+	mi := &file_spark_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5935,7 +6613,7 @@ func (x *SigningCommitments) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningCommitments.ProtoReflect.Descriptor instead.
 func (*SigningCommitments) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{79}
+	return file_spark_proto_rawDescGZIP(), []int{87}
 }
 
 func (x *SigningCommitments) GetSigningCommitments() map[string]*common.SigningCommitment {
@@ -5959,7 +6637,7 @@ type UserSignedRefund struct {
 
 func (x *UserSignedRefund) Reset() {
 	*x = UserSignedRefund{}
-	mi := &file_spark_proto_msgTypes[80]
+	mi := &file_spark_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5971,7 +6649,7 @@ func (x *UserSignedRefund) String() string {
 func (*UserSignedRefund) ProtoMessage() {}
 
 func (x *UserSignedRefund) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[80]
+	mi := &file_spark_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5984,7 +6662,7 @@ func (x *UserSignedRefund) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSignedRefund.ProtoReflect.Descriptor instead.
 func (*UserSignedRefund) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{80}
+	return file_spark_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *UserSignedRefund) GetNodeId() string {
@@ -6038,7 +6716,7 @@ type InvoiceAmountProof struct {
 
 func (x *InvoiceAmountProof) Reset() {
 	*x = InvoiceAmountProof{}
-	mi := &file_spark_proto_msgTypes[81]
+	mi := &file_spark_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6050,7 +6728,7 @@ func (x *InvoiceAmountProof) String() string {
 func (*InvoiceAmountProof) ProtoMessage() {}
 
 func (x *InvoiceAmountProof) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[81]
+	mi := &file_spark_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6063,7 +6741,7 @@ func (x *InvoiceAmountProof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceAmountProof.ProtoReflect.Descriptor instead.
 func (*InvoiceAmountProof) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{81}
+	return file_spark_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *InvoiceAmountProof) GetBolt11Invoice() string {
@@ -6083,7 +6761,7 @@ type InvoiceAmount struct {
 
 func (x *InvoiceAmount) Reset() {
 	*x = InvoiceAmount{}
-	mi := &file_spark_proto_msgTypes[82]
+	mi := &file_spark_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6095,7 +6773,7 @@ func (x *InvoiceAmount) String() string {
 func (*InvoiceAmount) ProtoMessage() {}
 
 func (x *InvoiceAmount) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[82]
+	mi := &file_spark_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6108,7 +6786,7 @@ func (x *InvoiceAmount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceAmount.ProtoReflect.Descriptor instead.
 func (*InvoiceAmount) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{82}
+	return file_spark_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *InvoiceAmount) GetValueSats() uint64 {
@@ -6139,7 +6817,7 @@ type InitiatePreimageSwapRequest struct {
 
 func (x *InitiatePreimageSwapRequest) Reset() {
 	*x = InitiatePreimageSwapRequest{}
-	mi := &file_spark_proto_msgTypes[83]
+	mi := &file_spark_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6151,7 +6829,7 @@ func (x *InitiatePreimageSwapRequest) String() string {
 func (*InitiatePreimageSwapRequest) ProtoMessage() {}
 
 func (x *InitiatePreimageSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[83]
+	mi := &file_spark_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6164,7 +6842,7 @@ func (x *InitiatePreimageSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiatePreimageSwapRequest.ProtoReflect.Descriptor instead.
 func (*InitiatePreimageSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{83}
+	return file_spark_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *InitiatePreimageSwapRequest) GetPaymentHash() []byte {
@@ -6219,7 +6897,7 @@ type InitiatePreimageSwapResponse struct {
 
 func (x *InitiatePreimageSwapResponse) Reset() {
 	*x = InitiatePreimageSwapResponse{}
-	mi := &file_spark_proto_msgTypes[84]
+	mi := &file_spark_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6231,7 +6909,7 @@ func (x *InitiatePreimageSwapResponse) String() string {
 func (*InitiatePreimageSwapResponse) ProtoMessage() {}
 
 func (x *InitiatePreimageSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[84]
+	mi := &file_spark_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6244,7 +6922,7 @@ func (x *InitiatePreimageSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiatePreimageSwapResponse.ProtoReflect.Descriptor instead.
 func (*InitiatePreimageSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{84}
+	return file_spark_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *InitiatePreimageSwapResponse) GetPreimage() []byte {
@@ -6271,7 +6949,7 @@ type OutPoint struct {
 
 func (x *OutPoint) Reset() {
 	*x = OutPoint{}
-	mi := &file_spark_proto_msgTypes[85]
+	mi := &file_spark_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6283,7 +6961,7 @@ func (x *OutPoint) String() string {
 func (*OutPoint) ProtoMessage() {}
 
 func (x *OutPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[85]
+	mi := &file_spark_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6296,7 +6974,7 @@ func (x *OutPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OutPoint.ProtoReflect.Descriptor instead.
 func (*OutPoint) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{85}
+	return file_spark_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *OutPoint) GetTxid() []byte {
@@ -6324,7 +7002,7 @@ type CooperativeExitRequest struct {
 
 func (x *CooperativeExitRequest) Reset() {
 	*x = CooperativeExitRequest{}
-	mi := &file_spark_proto_msgTypes[86]
+	mi := &file_spark_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6336,7 +7014,7 @@ func (x *CooperativeExitRequest) String() string {
 func (*CooperativeExitRequest) ProtoMessage() {}
 
 func (x *CooperativeExitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[86]
+	mi := &file_spark_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6349,7 +7027,7 @@ func (x *CooperativeExitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CooperativeExitRequest.ProtoReflect.Descriptor instead.
 func (*CooperativeExitRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{86}
+	return file_spark_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *CooperativeExitRequest) GetTransfer() *StartTransferRequest {
@@ -6383,7 +7061,7 @@ type CooperativeExitResponse struct {
 
 func (x *CooperativeExitResponse) Reset() {
 	*x = CooperativeExitResponse{}
-	mi := &file_spark_proto_msgTypes[87]
+	mi := &file_spark_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6395,7 +7073,7 @@ func (x *CooperativeExitResponse) String() string {
 func (*CooperativeExitResponse) ProtoMessage() {}
 
 func (x *CooperativeExitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[87]
+	mi := &file_spark_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6408,7 +7086,7 @@ func (x *CooperativeExitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CooperativeExitResponse.ProtoReflect.Descriptor instead.
 func (*CooperativeExitResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{87}
+	return file_spark_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *CooperativeExitResponse) GetTransfer() *Transfer {
@@ -6438,7 +7116,7 @@ type CounterLeafSwapRequest struct {
 
 func (x *CounterLeafSwapRequest) Reset() {
 	*x = CounterLeafSwapRequest{}
-	mi := &file_spark_proto_msgTypes[88]
+	mi := &file_spark_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6450,7 +7128,7 @@ func (x *CounterLeafSwapRequest) String() string {
 func (*CounterLeafSwapRequest) ProtoMessage() {}
 
 func (x *CounterLeafSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[88]
+	mi := &file_spark_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6463,7 +7141,7 @@ func (x *CounterLeafSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CounterLeafSwapRequest.ProtoReflect.Descriptor instead.
 func (*CounterLeafSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{88}
+	return file_spark_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *CounterLeafSwapRequest) GetTransfer() *StartTransferRequest {
@@ -6511,7 +7189,7 @@ type CounterLeafSwapResponse struct {
 
 func (x *CounterLeafSwapResponse) Reset() {
 	*x = CounterLeafSwapResponse{}
-	mi := &file_spark_proto_msgTypes[89]
+	mi := &file_spark_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6523,7 +7201,7 @@ func (x *CounterLeafSwapResponse) String() string {
 func (*CounterLeafSwapResponse) ProtoMessage() {}
 
 func (x *CounterLeafSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[89]
+	mi := &file_spark_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6536,7 +7214,7 @@ func (x *CounterLeafSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CounterLeafSwapResponse.ProtoReflect.Descriptor instead.
 func (*CounterLeafSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{89}
+	return file_spark_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *CounterLeafSwapResponse) GetTransfer() *Transfer {
@@ -6564,7 +7242,7 @@ type RefreshTimelockRequest struct {
 
 func (x *RefreshTimelockRequest) Reset() {
 	*x = RefreshTimelockRequest{}
-	mi := &file_spark_proto_msgTypes[90]
+	mi := &file_spark_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6576,7 +7254,7 @@ func (x *RefreshTimelockRequest) String() string {
 func (*RefreshTimelockRequest) ProtoMessage() {}
 
 func (x *RefreshTimelockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[90]
+	mi := &file_spark_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6589,7 +7267,7 @@ func (x *RefreshTimelockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTimelockRequest.ProtoReflect.Descriptor instead.
 func (*RefreshTimelockRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{90}
+	return file_spark_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *RefreshTimelockRequest) GetLeafId() string {
@@ -6623,7 +7301,7 @@ type RefreshTimelockSigningResult struct {
 
 func (x *RefreshTimelockSigningResult) Reset() {
 	*x = RefreshTimelockSigningResult{}
-	mi := &file_spark_proto_msgTypes[91]
+	mi := &file_spark_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6635,7 +7313,7 @@ func (x *RefreshTimelockSigningResult) String() string {
 func (*RefreshTimelockSigningResult) ProtoMessage() {}
 
 func (x *RefreshTimelockSigningResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[91]
+	mi := &file_spark_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6648,7 +7326,7 @@ func (x *RefreshTimelockSigningResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTimelockSigningResult.ProtoReflect.Descriptor instead.
 func (*RefreshTimelockSigningResult) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{91}
+	return file_spark_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *RefreshTimelockSigningResult) GetSigningResult() *SigningResult {
@@ -6674,7 +7352,7 @@ type RefreshTimelockResponse struct {
 
 func (x *RefreshTimelockResponse) Reset() {
 	*x = RefreshTimelockResponse{}
-	mi := &file_spark_proto_msgTypes[92]
+	mi := &file_spark_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6686,7 +7364,7 @@ func (x *RefreshTimelockResponse) String() string {
 func (*RefreshTimelockResponse) ProtoMessage() {}
 
 func (x *RefreshTimelockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[92]
+	mi := &file_spark_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6699,7 +7377,7 @@ func (x *RefreshTimelockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RefreshTimelockResponse.ProtoReflect.Descriptor instead.
 func (*RefreshTimelockResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{92}
+	return file_spark_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *RefreshTimelockResponse) GetSigningResults() []*RefreshTimelockSigningResult {
@@ -6724,7 +7402,7 @@ type ExtendLeafRequest struct {
 
 func (x *ExtendLeafRequest) Reset() {
 	*x = ExtendLeafRequest{}
-	mi := &file_spark_proto_msgTypes[93]
+	mi := &file_spark_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6736,7 +7414,7 @@ func (x *ExtendLeafRequest) String() string {
 func (*ExtendLeafRequest) ProtoMessage() {}
 
 func (x *ExtendLeafRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[93]
+	mi := &file_spark_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6749,7 +7427,7 @@ func (x *ExtendLeafRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendLeafRequest.ProtoReflect.Descriptor instead.
 func (*ExtendLeafRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{93}
+	return file_spark_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *ExtendLeafRequest) GetLeafId() string {
@@ -6811,7 +7489,7 @@ type ExtendLeafSigningResult struct {
 
 func (x *ExtendLeafSigningResult) Reset() {
 	*x = ExtendLeafSigningResult{}
-	mi := &file_spark_proto_msgTypes[94]
+	mi := &file_spark_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6823,7 +7501,7 @@ func (x *ExtendLeafSigningResult) String() string {
 func (*ExtendLeafSigningResult) ProtoMessage() {}
 
 func (x *ExtendLeafSigningResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[94]
+	mi := &file_spark_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6836,7 +7514,7 @@ func (x *ExtendLeafSigningResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendLeafSigningResult.ProtoReflect.Descriptor instead.
 func (*ExtendLeafSigningResult) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{94}
+	return file_spark_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *ExtendLeafSigningResult) GetSigningResult() *SigningResult {
@@ -6867,7 +7545,7 @@ type ExtendLeafResponse struct {
 
 func (x *ExtendLeafResponse) Reset() {
 	*x = ExtendLeafResponse{}
-	mi := &file_spark_proto_msgTypes[95]
+	mi := &file_spark_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6879,7 +7557,7 @@ func (x *ExtendLeafResponse) String() string {
 func (*ExtendLeafResponse) ProtoMessage() {}
 
 func (x *ExtendLeafResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[95]
+	mi := &file_spark_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6892,7 +7570,7 @@ func (x *ExtendLeafResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtendLeafResponse.ProtoReflect.Descriptor instead.
 func (*ExtendLeafResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{95}
+	return file_spark_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *ExtendLeafResponse) GetLeafId() string {
@@ -6947,7 +7625,7 @@ type AddressRequestNode struct {
 
 func (x *AddressRequestNode) Reset() {
 	*x = AddressRequestNode{}
-	mi := &file_spark_proto_msgTypes[96]
+	mi := &file_spark_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6959,7 +7637,7 @@ func (x *AddressRequestNode) String() string {
 func (*AddressRequestNode) ProtoMessage() {}
 
 func (x *AddressRequestNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[96]
+	mi := &file_spark_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6972,7 +7650,7 @@ func (x *AddressRequestNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressRequestNode.ProtoReflect.Descriptor instead.
 func (*AddressRequestNode) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{96}
+	return file_spark_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *AddressRequestNode) GetUserPublicKey() []byte {
@@ -7006,7 +7684,7 @@ type PrepareTreeAddressRequest struct {
 
 func (x *PrepareTreeAddressRequest) Reset() {
 	*x = PrepareTreeAddressRequest{}
-	mi := &file_spark_proto_msgTypes[97]
+	mi := &file_spark_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7018,7 +7696,7 @@ func (x *PrepareTreeAddressRequest) String() string {
 func (*PrepareTreeAddressRequest) ProtoMessage() {}
 
 func (x *PrepareTreeAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[97]
+	mi := &file_spark_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7031,7 +7709,7 @@ func (x *PrepareTreeAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTreeAddressRequest.ProtoReflect.Descriptor instead.
 func (*PrepareTreeAddressRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{97}
+	return file_spark_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *PrepareTreeAddressRequest) GetSource() isPrepareTreeAddressRequest_Source {
@@ -7099,7 +7777,7 @@ type AddressNode struct {
 
 func (x *AddressNode) Reset() {
 	*x = AddressNode{}
-	mi := &file_spark_proto_msgTypes[98]
+	mi := &file_spark_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7111,7 +7789,7 @@ func (x *AddressNode) String() string {
 func (*AddressNode) ProtoMessage() {}
 
 func (x *AddressNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[98]
+	mi := &file_spark_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7124,7 +7802,7 @@ func (x *AddressNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddressNode.ProtoReflect.Descriptor instead.
 func (*AddressNode) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{98}
+	return file_spark_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *AddressNode) GetAddress() *Address {
@@ -7150,7 +7828,7 @@ type PrepareTreeAddressResponse struct {
 
 func (x *PrepareTreeAddressResponse) Reset() {
 	*x = PrepareTreeAddressResponse{}
-	mi := &file_spark_proto_msgTypes[99]
+	mi := &file_spark_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7162,7 +7840,7 @@ func (x *PrepareTreeAddressResponse) String() string {
 func (*PrepareTreeAddressResponse) ProtoMessage() {}
 
 func (x *PrepareTreeAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[99]
+	mi := &file_spark_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7175,7 +7853,7 @@ func (x *PrepareTreeAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareTreeAddressResponse.ProtoReflect.Descriptor instead.
 func (*PrepareTreeAddressResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{99}
+	return file_spark_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *PrepareTreeAddressResponse) GetNode() *AddressNode {
@@ -7205,7 +7883,7 @@ type CreationNode struct {
 
 func (x *CreationNode) Reset() {
 	*x = CreationNode{}
-	mi := &file_spark_proto_msgTypes[100]
+	mi := &file_spark_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7217,7 +7895,7 @@ func (x *CreationNode) String() string {
 func (*CreationNode) ProtoMessage() {}
 
 func (x *CreationNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[100]
+	mi := &file_spark_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7230,7 +7908,7 @@ func (x *CreationNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreationNode.ProtoReflect.Descriptor instead.
 func (*CreationNode) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{100}
+	return file_spark_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *CreationNode) GetNodeTxSigningJob() *SigningJob {
@@ -7292,7 +7970,7 @@ type CreateTreeRequest struct {
 
 func (x *CreateTreeRequest) Reset() {
 	*x = CreateTreeRequest{}
-	mi := &file_spark_proto_msgTypes[101]
+	mi := &file_spark_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7304,7 +7982,7 @@ func (x *CreateTreeRequest) String() string {
 func (*CreateTreeRequest) ProtoMessage() {}
 
 func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[101]
+	mi := &file_spark_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7317,7 +7995,7 @@ func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTreeRequest.ProtoReflect.Descriptor instead.
 func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{101}
+	return file_spark_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *CreateTreeRequest) GetSource() isCreateTreeRequest_Source {
@@ -7390,7 +8068,7 @@ type CreationResponseNode struct {
 
 func (x *CreationResponseNode) Reset() {
 	*x = CreationResponseNode{}
-	mi := &file_spark_proto_msgTypes[102]
+	mi := &file_spark_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7402,7 +8080,7 @@ func (x *CreationResponseNode) String() string {
 func (*CreationResponseNode) ProtoMessage() {}
 
 func (x *CreationResponseNode) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[102]
+	mi := &file_spark_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7415,7 +8093,7 @@ func (x *CreationResponseNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreationResponseNode.ProtoReflect.Descriptor instead.
 func (*CreationResponseNode) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{102}
+	return file_spark_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *CreationResponseNode) GetNodeId() string {
@@ -7476,7 +8154,7 @@ type CreateTreeResponse struct {
 
 func (x *CreateTreeResponse) Reset() {
 	*x = CreateTreeResponse{}
-	mi := &file_spark_proto_msgTypes[103]
+	mi := &file_spark_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7488,7 +8166,7 @@ func (x *CreateTreeResponse) String() string {
 func (*CreateTreeResponse) ProtoMessage() {}
 
 func (x *CreateTreeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[103]
+	mi := &file_spark_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7501,7 +8179,7 @@ func (x *CreateTreeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTreeResponse.ProtoReflect.Descriptor instead.
 func (*CreateTreeResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{103}
+	return file_spark_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *CreateTreeResponse) GetNode() *CreationResponseNode {
@@ -7523,7 +8201,7 @@ type SigningOperatorInfo struct {
 
 func (x *SigningOperatorInfo) Reset() {
 	*x = SigningOperatorInfo{}
-	mi := &file_spark_proto_msgTypes[104]
+	mi := &file_spark_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7535,7 +8213,7 @@ func (x *SigningOperatorInfo) String() string {
 func (*SigningOperatorInfo) ProtoMessage() {}
 
 func (x *SigningOperatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[104]
+	mi := &file_spark_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7548,7 +8226,7 @@ func (x *SigningOperatorInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SigningOperatorInfo.ProtoReflect.Descriptor instead.
 func (*SigningOperatorInfo) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{104}
+	return file_spark_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *SigningOperatorInfo) GetIndex() uint64 {
@@ -7588,7 +8266,7 @@ type GetSigningOperatorListResponse struct {
 
 func (x *GetSigningOperatorListResponse) Reset() {
 	*x = GetSigningOperatorListResponse{}
-	mi := &file_spark_proto_msgTypes[105]
+	mi := &file_spark_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7600,7 +8278,7 @@ func (x *GetSigningOperatorListResponse) String() string {
 func (*GetSigningOperatorListResponse) ProtoMessage() {}
 
 func (x *GetSigningOperatorListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[105]
+	mi := &file_spark_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7613,7 +8291,7 @@ func (x *GetSigningOperatorListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSigningOperatorListResponse.ProtoReflect.Descriptor instead.
 func (*GetSigningOperatorListResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{105}
+	return file_spark_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *GetSigningOperatorListResponse) GetSigningOperators() map[string]*SigningOperatorInfo {
@@ -7633,7 +8311,7 @@ type QueryUserSignedRefundsRequest struct {
 
 func (x *QueryUserSignedRefundsRequest) Reset() {
 	*x = QueryUserSignedRefundsRequest{}
-	mi := &file_spark_proto_msgTypes[106]
+	mi := &file_spark_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7645,7 +8323,7 @@ func (x *QueryUserSignedRefundsRequest) String() string {
 func (*QueryUserSignedRefundsRequest) ProtoMessage() {}
 
 func (x *QueryUserSignedRefundsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[106]
+	mi := &file_spark_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7658,7 +8336,7 @@ func (x *QueryUserSignedRefundsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryUserSignedRefundsRequest.ProtoReflect.Descriptor instead.
 func (*QueryUserSignedRefundsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{106}
+	return file_spark_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *QueryUserSignedRefundsRequest) GetPaymentHash() []byte {
@@ -7685,7 +8363,7 @@ type QueryUserSignedRefundsResponse struct {
 
 func (x *QueryUserSignedRefundsResponse) Reset() {
 	*x = QueryUserSignedRefundsResponse{}
-	mi := &file_spark_proto_msgTypes[107]
+	mi := &file_spark_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7697,7 +8375,7 @@ func (x *QueryUserSignedRefundsResponse) String() string {
 func (*QueryUserSignedRefundsResponse) ProtoMessage() {}
 
 func (x *QueryUserSignedRefundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[107]
+	mi := &file_spark_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7710,7 +8388,7 @@ func (x *QueryUserSignedRefundsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryUserSignedRefundsResponse.ProtoReflect.Descriptor instead.
 func (*QueryUserSignedRefundsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{107}
+	return file_spark_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *QueryUserSignedRefundsResponse) GetUserSignedRefunds() []*UserSignedRefund {
@@ -7732,13 +8410,16 @@ type ProvidePreimageRequest struct {
 	PaymentHash       []byte                 `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
 	Preimage          []byte                 `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
 	IdentityPublicKey []byte                 `protobuf:"bytes,3,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The HTLC transfer to unlock. HTLC transfers are only unlocked by transfer id, so that they never collide
+	// with lightning payments to the same payment hash.
+	TransferId    string `protobuf:"bytes,4,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProvidePreimageRequest) Reset() {
 	*x = ProvidePreimageRequest{}
-	mi := &file_spark_proto_msgTypes[108]
+	mi := &file_spark_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7750,7 +8431,7 @@ func (x *ProvidePreimageRequest) String() string {
 func (*ProvidePreimageRequest) ProtoMessage() {}
 
 func (x *ProvidePreimageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[108]
+	mi := &file_spark_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7763,7 +8444,7 @@ func (x *ProvidePreimageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvidePreimageRequest.ProtoReflect.Descriptor instead.
 func (*ProvidePreimageRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{108}
+	return file_spark_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *ProvidePreimageRequest) GetPaymentHash() []byte {
@@ -7787,6 +8468,13 @@ func (x *ProvidePreimageRequest) GetIdentityPublicKey() []byte {
 	return nil
 }
 
+func (x *ProvidePreimageRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
 type ProvidePreimageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
@@ -7796,7 +8484,7 @@ type ProvidePreimageResponse struct {
 
 func (x *ProvidePreimageResponse) Reset() {
 	*x = ProvidePreimageResponse{}
-	mi := &file_spark_proto_msgTypes[109]
+	mi := &file_spark_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7808,7 +8496,7 @@ func (x *ProvidePreimageResponse) String() string {
 func (*ProvidePreimageResponse) ProtoMessage() {}
 
 func (x *ProvidePreimageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[109]
+	mi := &file_spark_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7821,7 +8509,7 @@ func (x *ProvidePreimageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvidePreimageResponse.ProtoReflect.Descriptor instead.
 func (*ProvidePreimageResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{109}
+	return file_spark_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *ProvidePreimageResponse) GetTransfer() *Transfer {
@@ -7841,7 +8529,7 @@ type ReturnLightningPaymentRequest struct {
 
 func (x *ReturnLightningPaymentRequest) Reset() {
 	*x = ReturnLightningPaymentRequest{}
-	mi := &file_spark_proto_msgTypes[110]
+	mi := &file_spark_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7853,7 +8541,7 @@ func (x *ReturnLightningPaymentRequest) String() string {
 func (*ReturnLightningPaymentRequest) ProtoMessage() {}
 
 func (x *ReturnLightningPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[110]
+	mi := &file_spark_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7866,7 +8554,7 @@ func (x *ReturnLightningPaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnLightningPaymentRequest.ProtoReflect.Descriptor instead.
 func (*ReturnLightningPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{110}
+	return file_spark_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *ReturnLightningPaymentRequest) GetPaymentHash() []byte {
@@ -7892,7 +8580,7 @@ type TreeNodeIds struct {
 
 func (x *TreeNodeIds) Reset() {
 	*x = TreeNodeIds{}
-	mi := &file_spark_proto_msgTypes[111]
+	mi := &file_spark_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7904,7 +8592,7 @@ func (x *TreeNodeIds) String() string {
 func (*TreeNodeIds) ProtoMessage() {}
 
 func (x *TreeNodeIds) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[111]
+	mi := &file_spark_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7917,7 +8605,7 @@ func (x *TreeNodeIds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TreeNodeIds.ProtoReflect.Descriptor instead.
 func (*TreeNodeIds) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{111}
+	return file_spark_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *TreeNodeIds) GetNodeIds() []string {
@@ -7944,7 +8632,7 @@ type QueryNodesRequest struct {
 
 func (x *QueryNodesRequest) Reset() {
 	*x = QueryNodesRequest{}
-	mi := &file_spark_proto_msgTypes[112]
+	mi := &file_spark_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7956,7 +8644,7 @@ func (x *QueryNodesRequest) String() string {
 func (*QueryNodesRequest) ProtoMessage() {}
 
 func (x *QueryNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[112]
+	mi := &file_spark_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7969,7 +8657,7 @@ func (x *QueryNodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesRequest.ProtoReflect.Descriptor instead.
 func (*QueryNodesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{112}
+	return file_spark_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *QueryNodesRequest) GetSource() isQueryNodesRequest_Source {
@@ -8051,7 +8739,7 @@ type QueryNodesResponse struct {
 
 func (x *QueryNodesResponse) Reset() {
 	*x = QueryNodesResponse{}
-	mi := &file_spark_proto_msgTypes[113]
+	mi := &file_spark_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8063,7 +8751,7 @@ func (x *QueryNodesResponse) String() string {
 func (*QueryNodesResponse) ProtoMessage() {}
 
 func (x *QueryNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[113]
+	mi := &file_spark_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8076,7 +8764,7 @@ func (x *QueryNodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesResponse.ProtoReflect.Descriptor instead.
 func (*QueryNodesResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{113}
+	return file_spark_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *QueryNodesResponse) GetNodes() map[string]*TreeNode {
@@ -8103,7 +8791,7 @@ type CancelTransferRequest struct {
 
 func (x *CancelTransferRequest) Reset() {
 	*x = CancelTransferRequest{}
-	mi := &file_spark_proto_msgTypes[114]
+	mi := &file_spark_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8115,7 +8803,7 @@ func (x *CancelTransferRequest) String() string {
 func (*CancelTransferRequest) ProtoMessage() {}
 
 func (x *CancelTransferRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[114]
+	mi := &file_spark_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8128,7 +8816,7 @@ func (x *CancelTransferRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTransferRequest.ProtoReflect.Descriptor instead.
 func (*CancelTransferRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{114}
+	return file_spark_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *CancelTransferRequest) GetTransferId() string {
@@ -8154,7 +8842,7 @@ type CancelTransferResponse struct {
 
 func (x *CancelTransferResponse) Reset() {
 	*x = CancelTransferResponse{}
-	mi := &file_spark_proto_msgTypes[115]
+	mi := &file_spark_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8166,7 +8854,7 @@ func (x *CancelTransferResponse) String() string {
 func (*CancelTransferResponse) ProtoMessage() {}
 
 func (x *CancelTransferResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[115]
+	mi := &file_spark_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8179,7 +8867,7 @@ func (x *CancelTransferResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTransferResponse.ProtoReflect.Descriptor instead.
 func (*CancelTransferResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{115}
+	return file_spark_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *CancelTransferResponse) GetTransfer() *Transfer {
@@ -8204,7 +8892,7 @@ type QueryUnusedDepositAddressesRequest struct {
 
 func (x *QueryUnusedDepositAddressesRequest) Reset() {
 	*x = QueryUnusedDepositAddressesRequest{}
-	mi := &file_spark_proto_msgTypes[116]
+	mi := &file_spark_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8216,7 +8904,7 @@ func (x *QueryUnusedDepositAddressesRequest) String() string {
 func (*QueryUnusedDepositAddressesRequest) ProtoMessage() {}
 
 func (x *QueryUnusedDepositAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[116]
+	mi := &file_spark_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8229,7 +8917,7 @@ func (x *QueryUnusedDepositAddressesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryUnusedDepositAddressesRequest.ProtoReflect.Descriptor instead.
 func (*QueryUnusedDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{116}
+	return file_spark_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *QueryUnusedDepositAddressesRequest) GetIdentityPublicKey() []byte {
@@ -8274,7 +8962,7 @@ type QueryStaticDepositAddressesRequest struct {
 
 func (x *QueryStaticDepositAddressesRequest) Reset() {
 	*x = QueryStaticDepositAddressesRequest{}
-	mi := &file_spark_proto_msgTypes[117]
+	mi := &file_spark_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8286,7 +8974,7 @@ func (x *QueryStaticDepositAddressesRequest) String() string {
 func (*QueryStaticDepositAddressesRequest) ProtoMessage() {}
 
 func (x *QueryStaticDepositAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[117]
+	mi := &file_spark_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8299,7 +8987,7 @@ func (x *QueryStaticDepositAddressesRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use QueryStaticDepositAddressesRequest.ProtoReflect.Descriptor instead.
 func (*QueryStaticDepositAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{117}
+	return file_spark_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *QueryStaticDepositAddressesRequest) GetIdentityPublicKey() []byte {
@@ -8350,7 +9038,7 @@ type DepositAddressQueryResult struct {
 
 func (x *DepositAddressQueryResult) Reset() {
 	*x = DepositAddressQueryResult{}
-	mi := &file_spark_proto_msgTypes[118]
+	mi := &file_spark_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8362,7 +9050,7 @@ func (x *DepositAddressQueryResult) String() string {
 func (*DepositAddressQueryResult) ProtoMessage() {}
 
 func (x *DepositAddressQueryResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[118]
+	mi := &file_spark_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8375,7 +9063,7 @@ func (x *DepositAddressQueryResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositAddressQueryResult.ProtoReflect.Descriptor instead.
 func (*DepositAddressQueryResult) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{118}
+	return file_spark_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *DepositAddressQueryResult) GetDepositAddress() string {
@@ -8423,7 +9111,7 @@ type QueryUnusedDepositAddressesResponse struct {
 
 func (x *QueryUnusedDepositAddressesResponse) Reset() {
 	*x = QueryUnusedDepositAddressesResponse{}
-	mi := &file_spark_proto_msgTypes[119]
+	mi := &file_spark_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8435,7 +9123,7 @@ func (x *QueryUnusedDepositAddressesResponse) String() string {
 func (*QueryUnusedDepositAddressesResponse) ProtoMessage() {}
 
 func (x *QueryUnusedDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[119]
+	mi := &file_spark_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8448,7 +9136,7 @@ func (x *QueryUnusedDepositAddressesResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use QueryUnusedDepositAddressesResponse.ProtoReflect.Descriptor instead.
 func (*QueryUnusedDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{119}
+	return file_spark_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *QueryUnusedDepositAddressesResponse) GetDepositAddresses() []*DepositAddressQueryResult {
@@ -8474,7 +9162,7 @@ type QueryStaticDepositAddressesResponse struct {
 
 func (x *QueryStaticDepositAddressesResponse) Reset() {
 	*x = QueryStaticDepositAddressesResponse{}
-	mi := &file_spark_proto_msgTypes[120]
+	mi := &file_spark_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8486,7 +9174,7 @@ func (x *QueryStaticDepositAddressesResponse) String() string {
 func (*QueryStaticDepositAddressesResponse) ProtoMessage() {}
 
 func (x *QueryStaticDepositAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[120]
+	mi := &file_spark_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8499,7 +9187,7 @@ func (x *QueryStaticDepositAddressesResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use QueryStaticDepositAddressesResponse.ProtoReflect.Descriptor instead.
 func (*QueryStaticDepositAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{120}
+	return file_spark_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *QueryStaticDepositAddressesResponse) GetDepositAddresses() []*DepositAddressQueryResult {
@@ -8519,7 +9207,7 @@ type QueryBalanceRequest struct {
 
 func (x *QueryBalanceRequest) Reset() {
 	*x = QueryBalanceRequest{}
-	mi := &file_spark_proto_msgTypes[121]
+	mi := &file_spark_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8531,7 +9219,7 @@ func (x *QueryBalanceRequest) String() string {
 func (*QueryBalanceRequest) ProtoMessage() {}
 
 func (x *QueryBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[121]
+	mi := &file_spark_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8544,7 +9232,7 @@ func (x *QueryBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryBalanceRequest.ProtoReflect.Descriptor instead.
 func (*QueryBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{121}
+	return file_spark_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *QueryBalanceRequest) GetIdentityPublicKey() []byte {
@@ -8571,7 +9259,7 @@ type QueryBalanceResponse struct {
 
 func (x *QueryBalanceResponse) Reset() {
 	*x = QueryBalanceResponse{}
-	mi := &file_spark_proto_msgTypes[122]
+	mi := &file_spark_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8583,7 +9271,7 @@ func (x *QueryBalanceResponse) String() string {
 func (*QueryBalanceResponse) ProtoMessage() {}
 
 func (x *QueryBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[122]
+	mi := &file_spark_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8596,7 +9284,7 @@ func (x *QueryBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryBalanceResponse.ProtoReflect.Descriptor instead.
 func (*QueryBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{122}
+	return file_spark_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *QueryBalanceResponse) GetBalance() uint64 {
@@ -8624,7 +9312,7 @@ type SparkAddress struct {
 
 func (x *SparkAddress) Reset() {
 	*x = SparkAddress{}
-	mi := &file_spark_proto_msgTypes[123]
+	mi := &file_spark_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8636,7 +9324,7 @@ func (x *SparkAddress) String() string {
 func (*SparkAddress) ProtoMessage() {}
 
 func (x *SparkAddress) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[123]
+	mi := &file_spark_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8649,7 +9337,7 @@ func (x *SparkAddress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SparkAddress.ProtoReflect.Descriptor instead.
 func (*SparkAddress) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{123}
+	return file_spark_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *SparkAddress) GetIdentityPublicKey() []byte {
@@ -8691,7 +9379,7 @@ type SparkInvoiceFields struct {
 
 func (x *SparkInvoiceFields) Reset() {
 	*x = SparkInvoiceFields{}
-	mi := &file_spark_proto_msgTypes[124]
+	mi := &file_spark_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8703,7 +9391,7 @@ func (x *SparkInvoiceFields) String() string {
 func (*SparkInvoiceFields) ProtoMessage() {}
 
 func (x *SparkInvoiceFields) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[124]
+	mi := &file_spark_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8716,7 +9404,7 @@ func (x *SparkInvoiceFields) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SparkInvoiceFields.ProtoReflect.Descriptor instead.
 func (*SparkInvoiceFields) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{124}
+	return file_spark_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *SparkInvoiceFields) GetVersion() uint32 {
@@ -8804,7 +9492,7 @@ type SatsPayment struct {
 
 func (x *SatsPayment) Reset() {
 	*x = SatsPayment{}
-	mi := &file_spark_proto_msgTypes[125]
+	mi := &file_spark_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8816,7 +9504,7 @@ func (x *SatsPayment) String() string {
 func (*SatsPayment) ProtoMessage() {}
 
 func (x *SatsPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[125]
+	mi := &file_spark_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8829,7 +9517,7 @@ func (x *SatsPayment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SatsPayment.ProtoReflect.Descriptor instead.
 func (*SatsPayment) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{125}
+	return file_spark_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *SatsPayment) GetAmount() uint64 {
@@ -8849,7 +9537,7 @@ type TokensPayment struct {
 
 func (x *TokensPayment) Reset() {
 	*x = TokensPayment{}
-	mi := &file_spark_proto_msgTypes[126]
+	mi := &file_spark_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8861,7 +9549,7 @@ func (x *TokensPayment) String() string {
 func (*TokensPayment) ProtoMessage() {}
 
 func (x *TokensPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[126]
+	mi := &file_spark_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8874,7 +9562,7 @@ func (x *TokensPayment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokensPayment.ProtoReflect.Descriptor instead.
 func (*TokensPayment) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{126}
+	return file_spark_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *TokensPayment) GetTokenIdentifier() []byte {
@@ -8919,7 +9607,7 @@ type InitiateStaticDepositUtxoRefundRequest struct {
 
 func (x *InitiateStaticDepositUtxoRefundRequest) Reset() {
 	*x = InitiateStaticDepositUtxoRefundRequest{}
-	mi := &file_spark_proto_msgTypes[127]
+	mi := &file_spark_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8931,7 +9619,7 @@ func (x *InitiateStaticDepositUtxoRefundRequest) String() string {
 func (*InitiateStaticDepositUtxoRefundRequest) ProtoMessage() {}
 
 func (x *InitiateStaticDepositUtxoRefundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[127]
+	mi := &file_spark_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8944,7 +9632,7 @@ func (x *InitiateStaticDepositUtxoRefundRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use InitiateStaticDepositUtxoRefundRequest.ProtoReflect.Descriptor instead.
 func (*InitiateStaticDepositUtxoRefundRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{127}
+	return file_spark_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *InitiateStaticDepositUtxoRefundRequest) GetOnChainUtxo() *UTXO {
@@ -8979,7 +9667,7 @@ type InitiateStaticDepositUtxoRefundResponse struct {
 
 func (x *InitiateStaticDepositUtxoRefundResponse) Reset() {
 	*x = InitiateStaticDepositUtxoRefundResponse{}
-	mi := &file_spark_proto_msgTypes[128]
+	mi := &file_spark_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8991,7 +9679,7 @@ func (x *InitiateStaticDepositUtxoRefundResponse) String() string {
 func (*InitiateStaticDepositUtxoRefundResponse) ProtoMessage() {}
 
 func (x *InitiateStaticDepositUtxoRefundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[128]
+	mi := &file_spark_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9004,7 +9692,7 @@ func (x *InitiateStaticDepositUtxoRefundResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use InitiateStaticDepositUtxoRefundResponse.ProtoReflect.Descriptor instead.
 func (*InitiateStaticDepositUtxoRefundResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{128}
+	return file_spark_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *InitiateStaticDepositUtxoRefundResponse) GetRefundTxSigningResult() *SigningResult {
@@ -9040,7 +9728,7 @@ type InitiateUtxoSwapRequest struct {
 
 func (x *InitiateUtxoSwapRequest) Reset() {
 	*x = InitiateUtxoSwapRequest{}
-	mi := &file_spark_proto_msgTypes[129]
+	mi := &file_spark_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9052,7 +9740,7 @@ func (x *InitiateUtxoSwapRequest) String() string {
 func (*InitiateUtxoSwapRequest) ProtoMessage() {}
 
 func (x *InitiateUtxoSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[129]
+	mi := &file_spark_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9065,7 +9753,7 @@ func (x *InitiateUtxoSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateUtxoSwapRequest.ProtoReflect.Descriptor instead.
 func (*InitiateUtxoSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{129}
+	return file_spark_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *InitiateUtxoSwapRequest) GetOnChainUtxo() *UTXO {
@@ -9162,7 +9850,7 @@ type InitiateUtxoSwapResponse struct {
 
 func (x *InitiateUtxoSwapResponse) Reset() {
 	*x = InitiateUtxoSwapResponse{}
-	mi := &file_spark_proto_msgTypes[130]
+	mi := &file_spark_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9174,7 +9862,7 @@ func (x *InitiateUtxoSwapResponse) String() string {
 func (*InitiateUtxoSwapResponse) ProtoMessage() {}
 
 func (x *InitiateUtxoSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[130]
+	mi := &file_spark_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9187,7 +9875,7 @@ func (x *InitiateUtxoSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateUtxoSwapResponse.ProtoReflect.Descriptor instead.
 func (*InitiateUtxoSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{130}
+	return file_spark_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *InitiateUtxoSwapResponse) GetSpendTxSigningResult() *SigningResult {
@@ -9222,7 +9910,7 @@ type ExitingTree struct {
 
 func (x *ExitingTree) Reset() {
 	*x = ExitingTree{}
-	mi := &file_spark_proto_msgTypes[131]
+	mi := &file_spark_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9234,7 +9922,7 @@ func (x *ExitingTree) String() string {
 func (*ExitingTree) ProtoMessage() {}
 
 func (x *ExitingTree) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[131]
+	mi := &file_spark_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9247,7 +9935,7 @@ func (x *ExitingTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExitingTree.ProtoReflect.Descriptor instead.
 func (*ExitingTree) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{131}
+	return file_spark_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *ExitingTree) GetTreeId() string {
@@ -9282,7 +9970,7 @@ type ExitSingleNodeTreeSigningResult struct {
 
 func (x *ExitSingleNodeTreeSigningResult) Reset() {
 	*x = ExitSingleNodeTreeSigningResult{}
-	mi := &file_spark_proto_msgTypes[132]
+	mi := &file_spark_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9294,7 +9982,7 @@ func (x *ExitSingleNodeTreeSigningResult) String() string {
 func (*ExitSingleNodeTreeSigningResult) ProtoMessage() {}
 
 func (x *ExitSingleNodeTreeSigningResult) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[132]
+	mi := &file_spark_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9307,7 +9995,7 @@ func (x *ExitSingleNodeTreeSigningResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExitSingleNodeTreeSigningResult.ProtoReflect.Descriptor instead.
 func (*ExitSingleNodeTreeSigningResult) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{132}
+	return file_spark_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *ExitSingleNodeTreeSigningResult) GetTreeId() string {
@@ -9341,7 +10029,7 @@ type BitcoinTransactionOutput struct {
 
 func (x *BitcoinTransactionOutput) Reset() {
 	*x = BitcoinTransactionOutput{}
-	mi := &file_spark_proto_msgTypes[133]
+	mi := &file_spark_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9353,7 +10041,7 @@ func (x *BitcoinTransactionOutput) String() string {
 func (*BitcoinTransactionOutput) ProtoMessage() {}
 
 func (x *BitcoinTransactionOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[133]
+	mi := &file_spark_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9366,7 +10054,7 @@ func (x *BitcoinTransactionOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BitcoinTransactionOutput.ProtoReflect.Descriptor instead.
 func (*BitcoinTransactionOutput) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{133}
+	return file_spark_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *BitcoinTransactionOutput) GetValue() int64 {
@@ -9395,7 +10083,7 @@ type ExitSingleNodeTreesRequest struct {
 
 func (x *ExitSingleNodeTreesRequest) Reset() {
 	*x = ExitSingleNodeTreesRequest{}
-	mi := &file_spark_proto_msgTypes[134]
+	mi := &file_spark_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9407,7 +10095,7 @@ func (x *ExitSingleNodeTreesRequest) String() string {
 func (*ExitSingleNodeTreesRequest) ProtoMessage() {}
 
 func (x *ExitSingleNodeTreesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[134]
+	mi := &file_spark_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9420,7 +10108,7 @@ func (x *ExitSingleNodeTreesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExitSingleNodeTreesRequest.ProtoReflect.Descriptor instead.
 func (*ExitSingleNodeTreesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{134}
+	return file_spark_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *ExitSingleNodeTreesRequest) GetOwnerIdentityPublicKey() []byte {
@@ -9460,7 +10148,7 @@ type ExitSingleNodeTreesResponse struct {
 
 func (x *ExitSingleNodeTreesResponse) Reset() {
 	*x = ExitSingleNodeTreesResponse{}
-	mi := &file_spark_proto_msgTypes[135]
+	mi := &file_spark_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9472,7 +10160,7 @@ func (x *ExitSingleNodeTreesResponse) String() string {
 func (*ExitSingleNodeTreesResponse) ProtoMessage() {}
 
 func (x *ExitSingleNodeTreesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[135]
+	mi := &file_spark_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9485,7 +10173,7 @@ func (x *ExitSingleNodeTreesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExitSingleNodeTreesResponse.ProtoReflect.Descriptor instead.
 func (*ExitSingleNodeTreesResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{135}
+	return file_spark_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *ExitSingleNodeTreesResponse) GetSigningResults() []*ExitSingleNodeTreeSigningResult {
@@ -9506,7 +10194,7 @@ type InvestigateLeavesRequest struct {
 
 func (x *InvestigateLeavesRequest) Reset() {
 	*x = InvestigateLeavesRequest{}
-	mi := &file_spark_proto_msgTypes[136]
+	mi := &file_spark_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9518,7 +10206,7 @@ func (x *InvestigateLeavesRequest) String() string {
 func (*InvestigateLeavesRequest) ProtoMessage() {}
 
 func (x *InvestigateLeavesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[136]
+	mi := &file_spark_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9531,7 +10219,7 @@ func (x *InvestigateLeavesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvestigateLeavesRequest.ProtoReflect.Descriptor instead.
 func (*InvestigateLeavesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{136}
+	return file_spark_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *InvestigateLeavesRequest) GetLeafIds() []string {
@@ -9564,7 +10252,7 @@ type QueryNodesDistributionRequest struct {
 
 func (x *QueryNodesDistributionRequest) Reset() {
 	*x = QueryNodesDistributionRequest{}
-	mi := &file_spark_proto_msgTypes[137]
+	mi := &file_spark_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9576,7 +10264,7 @@ func (x *QueryNodesDistributionRequest) String() string {
 func (*QueryNodesDistributionRequest) ProtoMessage() {}
 
 func (x *QueryNodesDistributionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[137]
+	mi := &file_spark_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9589,7 +10277,7 @@ func (x *QueryNodesDistributionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesDistributionRequest.ProtoReflect.Descriptor instead.
 func (*QueryNodesDistributionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{137}
+	return file_spark_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *QueryNodesDistributionRequest) GetOwnerIdentityPublicKey() []byte {
@@ -9608,7 +10296,7 @@ type QueryNodesDistributionResponse struct {
 
 func (x *QueryNodesDistributionResponse) Reset() {
 	*x = QueryNodesDistributionResponse{}
-	mi := &file_spark_proto_msgTypes[138]
+	mi := &file_spark_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9620,7 +10308,7 @@ func (x *QueryNodesDistributionResponse) String() string {
 func (*QueryNodesDistributionResponse) ProtoMessage() {}
 
 func (x *QueryNodesDistributionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[138]
+	mi := &file_spark_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9633,7 +10321,7 @@ func (x *QueryNodesDistributionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesDistributionResponse.ProtoReflect.Descriptor instead.
 func (*QueryNodesDistributionResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{138}
+	return file_spark_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *QueryNodesDistributionResponse) GetNodeDistribution() map[uint64]uint64 {
@@ -9655,7 +10343,7 @@ type QueryNodesByValueRequest struct {
 
 func (x *QueryNodesByValueRequest) Reset() {
 	*x = QueryNodesByValueRequest{}
-	mi := &file_spark_proto_msgTypes[139]
+	mi := &file_spark_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9667,7 +10355,7 @@ func (x *QueryNodesByValueRequest) String() string {
 func (*QueryNodesByValueRequest) ProtoMessage() {}
 
 func (x *QueryNodesByValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[139]
+	mi := &file_spark_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9680,7 +10368,7 @@ func (x *QueryNodesByValueRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesByValueRequest.ProtoReflect.Descriptor instead.
 func (*QueryNodesByValueRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{139}
+	return file_spark_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *QueryNodesByValueRequest) GetOwnerIdentityPublicKey() []byte {
@@ -9721,7 +10409,7 @@ type QueryNodesByValueResponse struct {
 
 func (x *QueryNodesByValueResponse) Reset() {
 	*x = QueryNodesByValueResponse{}
-	mi := &file_spark_proto_msgTypes[140]
+	mi := &file_spark_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9733,7 +10421,7 @@ func (x *QueryNodesByValueResponse) String() string {
 func (*QueryNodesByValueResponse) ProtoMessage() {}
 
 func (x *QueryNodesByValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[140]
+	mi := &file_spark_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9746,7 +10434,7 @@ func (x *QueryNodesByValueResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryNodesByValueResponse.ProtoReflect.Descriptor instead.
 func (*QueryNodesByValueResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{140}
+	return file_spark_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *QueryNodesByValueResponse) GetNodes() map[string]*TreeNode {
@@ -9776,7 +10464,7 @@ type GetUtxosForAddressRequest struct {
 
 func (x *GetUtxosForAddressRequest) Reset() {
 	*x = GetUtxosForAddressRequest{}
-	mi := &file_spark_proto_msgTypes[141]
+	mi := &file_spark_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9788,7 +10476,7 @@ func (x *GetUtxosForAddressRequest) String() string {
 func (*GetUtxosForAddressRequest) ProtoMessage() {}
 
 func (x *GetUtxosForAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[141]
+	mi := &file_spark_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9801,7 +10489,7 @@ func (x *GetUtxosForAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUtxosForAddressRequest.ProtoReflect.Descriptor instead.
 func (*GetUtxosForAddressRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{141}
+	return file_spark_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *GetUtxosForAddressRequest) GetAddress() string {
@@ -9849,7 +10537,7 @@ type GetUtxosForAddressResponse struct {
 
 func (x *GetUtxosForAddressResponse) Reset() {
 	*x = GetUtxosForAddressResponse{}
-	mi := &file_spark_proto_msgTypes[142]
+	mi := &file_spark_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9861,7 +10549,7 @@ func (x *GetUtxosForAddressResponse) String() string {
 func (*GetUtxosForAddressResponse) ProtoMessage() {}
 
 func (x *GetUtxosForAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[142]
+	mi := &file_spark_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9874,7 +10562,7 @@ func (x *GetUtxosForAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUtxosForAddressResponse.ProtoReflect.Descriptor instead.
 func (*GetUtxosForAddressResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{142}
+	return file_spark_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *GetUtxosForAddressResponse) GetUtxos() []*UTXO {
@@ -9891,6 +10579,265 @@ func (x *GetUtxosForAddressResponse) GetOffset() uint64 {
 	return 0
 }
 
+type QueryUnilateralExitKitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LeafId        string                 `protobuf:"bytes,1,opt,name=leaf_id,json=leafId,proto3" json:"leaf_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUnilateralExitKitRequest) Reset() {
+	*x = QueryUnilateralExitKitRequest{}
+	mi := &file_spark_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUnilateralExitKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUnilateralExitKitRequest) ProtoMessage() {}
+
+func (x *QueryUnilateralExitKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUnilateralExitKitRequest.ProtoReflect.Descriptor instead.
+func (*QueryUnilateralExitKitRequest) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *QueryUnilateralExitKitRequest) GetLeafId() string {
+	if x != nil {
+		return x.LeafId
+	}
+	return ""
+}
+
+type UnilateralExitTransaction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tx    []byte                 `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// The number of blocks the transaction spent by this one must be confirmed for before this one can be
+	// broadcast. 0 if the transaction has no relative timelock.
+	Timelock uint32 `protobuf:"varint,2,opt,name=timelock,proto3" json:"timelock,omitempty"`
+	// The vout of the ephemeral anchor to spend in a child transaction to pay the fee. Unset if the
+	// transaction pays its own fee.
+	AnchorVout    *uint32 `protobuf:"varint,3,opt,name=anchor_vout,json=anchorVout,proto3,oneof" json:"anchor_vout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnilateralExitTransaction) Reset() {
+	*x = UnilateralExitTransaction{}
+	mi := &file_spark_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnilateralExitTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnilateralExitTransaction) ProtoMessage() {}
+
+func (x *UnilateralExitTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnilateralExitTransaction.ProtoReflect.Descriptor instead.
+func (*UnilateralExitTransaction) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *UnilateralExitTransaction) GetTx() []byte {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+func (x *UnilateralExitTransaction) GetTimelock() uint32 {
+	if x != nil {
+		return x.Timelock
+	}
+	return 0
+}
+
+func (x *UnilateralExitTransaction) GetAnchorVout() uint32 {
+	if x != nil && x.AnchorVout != nil {
+		return *x.AnchorVout
+	}
+	return 0
+}
+
+type UnilateralExitStep struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Type   UnilateralExitStepType `protobuf:"varint,2,opt,name=type,proto3,enum=spark.UnilateralExitStepType" json:"type,omitempty"`
+	// The zero-fee transaction that must be fee-bumped through its anchor.
+	CpfpTx *UnilateralExitTransaction `protobuf:"bytes,3,opt,name=cpfp_tx,json=cpfpTx,proto3" json:"cpfp_tx,omitempty"`
+	// The transaction that pays its own fee and spends the direct transaction of the previous step, if
+	// the node has one.
+	DirectTx *UnilateralExitTransaction `protobuf:"bytes,4,opt,name=direct_tx,json=directTx,proto3" json:"direct_tx,omitempty"`
+	// For refund steps, the transaction that pays its own fee and spends the cpfp transaction of the
+	// previous step, if the node has one.
+	DirectFromCpfpTx *UnilateralExitTransaction `protobuf:"bytes,5,opt,name=direct_from_cpfp_tx,json=directFromCpfpTx,proto3" json:"direct_from_cpfp_tx,omitempty"`
+	// The block height this step was confirmed at, if it is already on chain.
+	ConfirmationHeight *uint64 `protobuf:"varint,6,opt,name=confirmation_height,json=confirmationHeight,proto3,oneof" json:"confirmation_height,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UnilateralExitStep) Reset() {
+	*x = UnilateralExitStep{}
+	mi := &file_spark_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnilateralExitStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnilateralExitStep) ProtoMessage() {}
+
+func (x *UnilateralExitStep) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnilateralExitStep.ProtoReflect.Descriptor instead.
+func (*UnilateralExitStep) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *UnilateralExitStep) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *UnilateralExitStep) GetType() UnilateralExitStepType {
+	if x != nil {
+		return x.Type
+	}
+	return UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_UNSPECIFIED
+}
+
+func (x *UnilateralExitStep) GetCpfpTx() *UnilateralExitTransaction {
+	if x != nil {
+		return x.CpfpTx
+	}
+	return nil
+}
+
+func (x *UnilateralExitStep) GetDirectTx() *UnilateralExitTransaction {
+	if x != nil {
+		return x.DirectTx
+	}
+	return nil
+}
+
+func (x *UnilateralExitStep) GetDirectFromCpfpTx() *UnilateralExitTransaction {
+	if x != nil {
+		return x.DirectFromCpfpTx
+	}
+	return nil
+}
+
+func (x *UnilateralExitStep) GetConfirmationHeight() uint64 {
+	if x != nil && x.ConfirmationHeight != nil {
+		return *x.ConfirmationHeight
+	}
+	return 0
+}
+
+type QueryUnilateralExitKitResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	LeafId  string                 `protobuf:"bytes,1,opt,name=leaf_id,json=leafId,proto3" json:"leaf_id,omitempty"`
+	Network Network                `protobuf:"varint,2,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"`
+	// The steps in broadcast order, starting at the root of the tree.
+	Steps         []*UnilateralExitStep `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryUnilateralExitKitResponse) Reset() {
+	*x = QueryUnilateralExitKitResponse{}
+	mi := &file_spark_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryUnilateralExitKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryUnilateralExitKitResponse) ProtoMessage() {}
+
+func (x *QueryUnilateralExitKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryUnilateralExitKitResponse.ProtoReflect.Descriptor instead.
+func (*QueryUnilateralExitKitResponse) Descriptor() ([]byte, []int) {
+	return file_spark_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *QueryUnilateralExitKitResponse) GetLeafId() string {
+	if x != nil {
+		return x.LeafId
+	}
+	return ""
+}
+
+func (x *QueryUnilateralExitKitResponse) GetNetwork() Network {
+	if x != nil {
+		return x.Network
+	}
+	return Network_UNSPECIFIED
+}
+
+func (x *QueryUnilateralExitKitResponse) GetSteps() []*UnilateralExitStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
 type QuerySparkInvoicesRequest struct {
 	state  protoimpl.MessageState `protogen:"open.v1"`
 	Limit  int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -9903,7 +10850,7 @@ type QuerySparkInvoicesRequest struct {
 
 func (x *QuerySparkInvoicesRequest) Reset() {
 	*x = QuerySparkInvoicesRequest{}
-	mi := &file_spark_proto_msgTypes[143]
+	mi := &file_spark_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9915,7 +10862,7 @@ func (x *QuerySparkInvoicesRequest) String() string {
 func (*QuerySparkInvoicesRequest) ProtoMessage() {}
 
 func (x *QuerySparkInvoicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[143]
+	mi := &file_spark_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9928,7 +10875,7 @@ func (x *QuerySparkInvoicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySparkInvoicesRequest.ProtoReflect.Descriptor instead.
 func (*QuerySparkInvoicesRequest) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{143}
+	return file_spark_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *QuerySparkInvoicesRequest) GetLimit() int64 {
@@ -9962,7 +10909,7 @@ type QuerySparkInvoicesResponse struct {
 
 func (x *QuerySparkInvoicesResponse) Reset() {
 	*x = QuerySparkInvoicesResponse{}
-	mi := &file_spark_proto_msgTypes[144]
+	mi := &file_spark_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9974,7 +10921,7 @@ func (x *QuerySparkInvoicesResponse) String() string {
 func (*QuerySparkInvoicesResponse) ProtoMessage() {}
 
 func (x *QuerySparkInvoicesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[144]
+	mi := &file_spark_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9987,7 +10934,7 @@ func (x *QuerySparkInvoicesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuerySparkInvoicesResponse.ProtoReflect.Descriptor instead.
 func (*QuerySparkInvoicesResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{144}
+	return file_spark_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *QuerySparkInvoicesResponse) GetOffset() int64 {
@@ -10014,7 +10961,7 @@ type InvoiceResponse struct {
 
 func (x *InvoiceResponse) Reset() {
 	*x = InvoiceResponse{}
-	mi := &file_spark_proto_msgTypes[145]
+	mi := &file_spark_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10026,7 +10973,7 @@ func (x *InvoiceResponse) String() string {
 func (*InvoiceResponse) ProtoMessage() {}
 
 func (x *InvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_proto_msgTypes[145]
+	mi := &file_spark_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10039,7 +10986,7 @@ func (x *InvoiceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceResponse.ProtoReflect.Descriptor instead.
 func (*InvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_spark_proto_rawDescGZIP(), []int{145}
+	return file_spark_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *InvoiceResponse) GetInvoice() string {
@@ -10060,14 +11007,22 @@ var File_spark_proto protoreflect.FileDescriptor
 
 const file_spark_proto_rawDesc = "" +
 	"\n" +
-	"\vspark.proto\x12\x05spark\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\fcommon.proto\"J\n" +
+	"\vspark.proto\x12\x05spark\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17validate/validate.proto\x1a\fcommon.proto\"\x9a\x01\n" +
 	"\x18SubscribeToEventsRequest\x12.\n" +
 	"\x13identity_public_key\x18\n" +
-	" \x01(\fR\x11identityPublicKey\"\xc0\x01\n" +
+	" \x01(\fR\x11identityPublicKey\x125\n" +
+	"\x14resume_from_sequence\x18\v \x01(\x04H\x00R\x12resumeFromSequence\x88\x01\x01B\x17\n" +
+	"\x15_resume_from_sequence\"\xa7\x04\n" +
 	"\x19SubscribeToEventsResponse\x122\n" +
 	"\btransfer\x18\x01 \x01(\v2\x14.spark.TransferEventH\x00R\btransfer\x12/\n" +
 	"\adeposit\x18\x02 \x01(\v2\x13.spark.DepositEventH\x00R\adeposit\x125\n" +
-	"\tconnected\x18\x03 \x01(\v2\x15.spark.ConnectedEventH\x00R\tconnectedB\a\n" +
+	"\tconnected\x18\x03 \x01(\v2\x15.spark.ConnectedEventH\x00R\tconnected\x12K\n" +
+	"\x11token_transaction\x18\x04 \x01(\v2\x1c.spark.TokenTransactionEventH\x00R\x10tokenTransaction\x12H\n" +
+	"\x10preimage_request\x18\x05 \x01(\v2\x1b.spark.PreimageRequestEventH\x00R\x0fpreimageRequest\x12H\n" +
+	"\x10cooperative_exit\x18\x06 \x01(\v2\x1b.spark.CooperativeExitEventH\x00R\x0fcooperativeExit\x123\n" +
+	"\tutxo_swap\x18\a \x01(\v2\x14.spark.UtxoSwapEventH\x00R\butxoSwap\x123\n" +
+	"\ttree_exit\x18\b \x01(\v2\x14.spark.TreeExitEventH\x00R\btreeExit\x12\x1a\n" +
+	"\bsequence\x18\x14 \x01(\x04R\bsequenceB\a\n" +
 	"\x05event\"\x10\n" +
 	"\x0eConnectedEvent\"<\n" +
 	"\rTransferEvent\x12+\n" +
@@ -10075,7 +11030,32 @@ const file_spark_proto_rawDesc = "" +
 	" \x01(\v2\x0f.spark.TransferR\btransfer\"9\n" +
 	"\fDepositEvent\x12)\n" +
 	"\adeposit\x18\n" +
-	" \x01(\v2\x0f.spark.TreeNodeR\adeposit\"\x80\x02\n" +
+	" \x01(\v2\x0f.spark.TreeNodeR\adeposit\"\x8c\x01\n" +
+	"\x15TokenTransactionEvent\x124\n" +
+	"\x16token_transaction_hash\x18\n" +
+	" \x01(\fR\x14tokenTransactionHash\x12=\n" +
+	"\x10received_outputs\x18\v \x03(\v2\x12.spark.TokenOutputR\x0freceivedOutputs\"\x90\x01\n" +
+	"\x14PreimageRequestEvent\x12!\n" +
+	"\fpayment_hash\x18\n" +
+	" \x01(\fR\vpaymentHash\x124\n" +
+	"\x06status\x18\v \x01(\x0e2\x1c.spark.PreimageRequestStatusR\x06status\x12\x1f\n" +
+	"\vtransfer_id\x18\f \x01(\tR\n" +
+	"transferId\"\x85\x01\n" +
+	"\x14CooperativeExitEvent\x12\x1f\n" +
+	"\vtransfer_id\x18\n" +
+	" \x01(\tR\n" +
+	"transferId\x12\x1b\n" +
+	"\texit_txid\x18\v \x01(\fR\bexitTxid\x12/\n" +
+	"\x13confirmation_height\x18\f \x01(\x04R\x12confirmationHeight\"\x90\x01\n" +
+	"\rUtxoSwapEvent\x12\x1f\n" +
+	"\x04utxo\x18\n" +
+	" \x01(\v2\v.spark.UTXOR\x04utxo\x12=\n" +
+	"\frequest_type\x18\v \x01(\x0e2\x1a.spark.UtxoSwapRequestTypeR\vrequestType\x12\x1f\n" +
+	"\vtransfer_id\x18\f \x01(\tR\n" +
+	"transferId\"(\n" +
+	"\rTreeExitEvent\x12\x17\n" +
+	"\atree_id\x18\n" +
+	" \x01(\tR\x06treeId\"\x80\x02\n" +
 	"\x13DepositAddressProof\x12`\n" +
 	"\x12address_signatures\x18\x01 \x03(\v21.spark.DepositAddressProof.AddressSignaturesEntryR\x11addressSignatures\x12A\n" +
 	"\x1dproof_of_possession_signature\x18\x02 \x01(\fR\x1aproofOfPossessionSignature\x1aD\n" +
@@ -10201,7 +11181,7 @@ const file_spark_proto_rawDesc = "" +
 	"max_supply\x18\x05 \x01(\fB\a\xfaB\x04z\x02h\x10R\tmaxSupply\x12!\n" +
 	"\fis_freezable\x18\x06 \x01(\bR\visFreezable\x12I\n" +
 	"\x1acreation_entity_public_key\x18\a \x01(\fB\a\xfaB\x04z\x02h!H\x00R\x17creationEntityPublicKey\x88\x01\x01B\x1d\n" +
-	"\x1b_creation_entity_public_key\"\xc7\x04\n" +
+	"\x1b_creation_entity_public_key\"\x8c\x05\n" +
 	"\vTokenOutput\x12\x1d\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x02id\x88\x01\x01\x121\n" +
 	"\x10owner_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x0eownerPublicKey\x12A\n" +
@@ -10210,7 +11190,8 @@ const file_spark_proto_rawDesc = "" +
 	" withdraw_relative_block_locktime\x18\x05 \x01(\x04H\x03R\x1dwithdrawRelativeBlockLocktime\x88\x01\x01\x126\n" +
 	"\x10token_public_key\x18\x06 \x01(\fB\a\xfaB\x04z\x02h!H\x04R\x0etokenPublicKey\x88\x01\x01\x127\n" +
 	"\x10token_identifier\x18\b \x01(\fB\a\xfaB\x04z\x02h H\x05R\x0ftokenIdentifier\x88\x01\x01\x12*\n" +
-	"\ftoken_amount\x18\a \x01(\fB\a\xfaB\x04z\x02h\x10R\vtokenAmountB\x05\n" +
+	"\ftoken_amount\x18\a \x01(\fB\a\xfaB\x04z\x02h\x10R\vtokenAmount\x12C\n" +
+	"\x0fspendable_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0espendableAfterB\x05\n" +
 	"\x03_idB\x18\n" +
 	"\x16_revocation_commitmentB\x15\n" +
 	"\x13_withdraw_bond_satsB#\n" +
@@ -10387,7 +11368,15 @@ const file_spark_proto_rawDesc = "" +
 	"\x14spark_payment_intent\x18\t \x01(\tR\x12sparkPaymentIntentJ\x04\b\x06\x10\a\"\x8f\x01\n" +
 	"\x15StartTransferResponse\x12+\n" +
 	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\x12I\n" +
-	"\x0fsigning_results\x18\x02 \x03(\v2 .spark.LeafRefundTxSigningResultR\x0esigningResults\"\xd0\x03\n" +
+	"\x0fsigning_results\x18\x02 \x03(\v2 .spark.LeafRefundTxSigningResultR\x0esigningResults\"q\n" +
+	"\x19StartBatchTransferRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x129\n" +
+	"\ttransfers\x18\x02 \x03(\v2\x1b.spark.StartTransferRequestR\ttransfers\"X\n" +
+	"\x1aStartBatchTransferResponse\x12:\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x1c.spark.StartTransferResponseR\ttransfers\"v\n" +
+	"\x18StartHtlcTransferRequest\x127\n" +
+	"\btransfer\x18\x01 \x01(\v2\x1b.spark.StartTransferRequestR\btransfer\x12!\n" +
+	"\fpayment_hash\x18\x02 \x01(\fR\vpaymentHash\"\xd0\x03\n" +
 	"\x0fTransferPackage\x12C\n" +
 	"\x0eleaves_to_send\x18\x01 \x03(\v2\x1d.spark.UserSignedTxSigningJobR\fleavesToSend\x12W\n" +
 	"\x11key_tweak_package\x18\x02 \x03(\v2+.spark.TransferPackage.KeyTweakPackageEntryR\x0fkeyTweakPackage\x12%\n" +
@@ -10423,7 +11412,7 @@ const file_spark_proto_rawDesc = "" +
 	"\x19owner_identity_public_key\x18\x02 \x01(\fR\x16ownerIdentityPublicKey\x12A\n" +
 	"\x10transfer_package\x18\x03 \x01(\v2\x16.spark.TransferPackageR\x0ftransferPackage\"G\n" +
 	"\x18FinalizeTransferResponse\x12+\n" +
-	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\"\xf9\x03\n" +
+	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\"\xc6\x04\n" +
 	"\bTransfer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
 	"\x1asender_identity_public_key\x18\x02 \x01(\fR\x17senderIdentityPublicKey\x12?\n" +
@@ -10437,7 +11426,9 @@ const file_spark_proto_rawDesc = "" +
 	"\fcreated_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedTime\x12=\n" +
 	"\fupdated_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vupdatedTime\x12'\n" +
 	"\x04type\x18\n" +
-	" \x01(\x0e2\x13.spark.TransferTypeR\x04type\"\x84\x03\n" +
+	" \x01(\x0e2\x13.spark.TransferTypeR\x04type\x120\n" +
+	"\x14spark_payment_intent\x18\v \x01(\tR\x12sparkPaymentIntent\x12\x19\n" +
+	"\bbatch_id\x18\f \x01(\tR\abatchId\"\x84\x03\n" +
 	"\fTransferLeaf\x12#\n" +
 	"\x04leaf\x18\x01 \x01(\v2\x0f.spark.TreeNodeR\x04leaf\x12#\n" +
 	"\rsecret_cipher\x18\x02 \x01(\fR\fsecretCipher\x12\x1c\n" +
@@ -10445,7 +11436,7 @@ const file_spark_proto_rawDesc = "" +
 	"\x16intermediate_refund_tx\x18\x04 \x01(\fR\x14intermediateRefundTx\x12A\n" +
 	"\x1dintermediate_direct_refund_tx\x18\x05 \x01(\fR\x1aintermediateDirectRefundTx\x12S\n" +
 	"'intermediate_direct_from_cpfp_refund_tx\x18\x06 \x01(\fR\"intermediateDirectFromCpfpRefundTx\x12>\n" +
-	"\x1cpending_key_tweak_public_key\x18\a \x01(\fR\x18pendingKeyTweakPublicKey\"\xf3\x03\n" +
+	"\x1cpending_key_tweak_public_key\x18\a \x01(\fR\x18pendingKeyTweakPublicKey\"\xa7\x04\n" +
 	"\x0eTransferFilter\x12A\n" +
 	"\x1creceiver_identity_public_key\x18\x01 \x01(\fH\x00R\x19receiverIdentityPublicKey\x12=\n" +
 	"\x1asender_identity_public_key\x18\x02 \x01(\fH\x00R\x17senderIdentityPublicKey\x12S\n" +
@@ -10456,7 +11447,8 @@ const file_spark_proto_rawDesc = "" +
 	"\x05types\x18F \x03(\x0e2\x13.spark.TransferTypeR\x05types\x12(\n" +
 	"\anetwork\x18\x04 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x121\n" +
 	"\bstatuses\x18P \x03(\x0e2\x15.spark.TransferStatusR\bstatuses\x12\"\n" +
-	"\x05order\x18\x05 \x01(\x0e2\f.spark.OrderR\x05orderB\r\n" +
+	"\x05order\x18\x05 \x01(\x0e2\f.spark.OrderR\x05order\x122\n" +
+	"\x15spark_payment_intents\x18\x06 \x03(\tR\x13sparkPaymentIntentsB\r\n" +
 	"\vparticipant\"_\n" +
 	"\x16QueryTransfersResponse\x12-\n" +
 	"\ttransfers\x18\x01 \x03(\v2\x0f.spark.TransferR\ttransfers\x12\x16\n" +
@@ -10628,11 +11620,13 @@ const file_spark_proto_rawDesc = "" +
 	"\x13identity_public_key\x18\x02 \x01(\fR\x11identityPublicKey\"\x9c\x01\n" +
 	"\x1eQueryUserSignedRefundsResponse\x12G\n" +
 	"\x13user_signed_refunds\x18\x01 \x03(\v2\x17.spark.UserSignedRefundR\x11userSignedRefunds\x12+\n" +
-	"\btransfer\x18\x03 \x01(\v2\x0f.spark.TransferR\btransferJ\x04\b\x02\x10\x03\"\x87\x01\n" +
+	"\btransfer\x18\x03 \x01(\v2\x0f.spark.TransferR\btransferJ\x04\b\x02\x10\x03\"\xa8\x01\n" +
 	"\x16ProvidePreimageRequest\x12!\n" +
 	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12\x1a\n" +
 	"\bpreimage\x18\x02 \x01(\fR\bpreimage\x12.\n" +
-	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\"F\n" +
+	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\x12\x1f\n" +
+	"\vtransfer_id\x18\x04 \x01(\tR\n" +
+	"transferId\"F\n" +
 	"\x17ProvidePreimageResponse\x12+\n" +
 	"\btransfer\x18\x01 \x01(\v2\x0f.spark.TransferR\btransfer\"{\n" +
 	"\x1dReturnLightningPaymentRequest\x12!\n" +
@@ -10795,7 +11789,27 @@ const file_spark_proto_rawDesc = "" +
 	"\x0fexclude_claimed\x18\x05 \x01(\bR\x0eexcludeClaimed\"W\n" +
 	"\x1aGetUtxosForAddressResponse\x12!\n" +
 	"\x05utxos\x18\x01 \x03(\v2\v.spark.UTXOR\x05utxos\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x04R\x06offset\"c\n" +
+	"\x06offset\x18\x02 \x01(\x04R\x06offset\"8\n" +
+	"\x1dQueryUnilateralExitKitRequest\x12\x17\n" +
+	"\aleaf_id\x18\x01 \x01(\tR\x06leafId\"}\n" +
+	"\x19UnilateralExitTransaction\x12\x0e\n" +
+	"\x02tx\x18\x01 \x01(\fR\x02tx\x12\x1a\n" +
+	"\btimelock\x18\x02 \x01(\rR\btimelock\x12$\n" +
+	"\vanchor_vout\x18\x03 \x01(\rH\x00R\n" +
+	"anchorVout\x88\x01\x01B\x0e\n" +
+	"\f_anchor_vout\"\xf9\x02\n" +
+	"\x12UnilateralExitStep\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x121\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1d.spark.UnilateralExitStepTypeR\x04type\x129\n" +
+	"\acpfp_tx\x18\x03 \x01(\v2 .spark.UnilateralExitTransactionR\x06cpfpTx\x12=\n" +
+	"\tdirect_tx\x18\x04 \x01(\v2 .spark.UnilateralExitTransactionR\bdirectTx\x12O\n" +
+	"\x13direct_from_cpfp_tx\x18\x05 \x01(\v2 .spark.UnilateralExitTransactionR\x10directFromCpfpTx\x124\n" +
+	"\x13confirmation_height\x18\x06 \x01(\x04H\x00R\x12confirmationHeight\x88\x01\x01B\x16\n" +
+	"\x14_confirmation_height\"\x94\x01\n" +
+	"\x1eQueryUnilateralExitKitResponse\x12\x17\n" +
+	"\aleaf_id\x18\x01 \x01(\tR\x06leafId\x12(\n" +
+	"\anetwork\x18\x02 \x01(\x0e2\x0e.spark.NetworkR\anetwork\x12/\n" +
+	"\x05steps\x18\x03 \x03(\v2\x19.spark.UnilateralExitStepR\x05steps\"c\n" +
 	"\x19QuerySparkInvoicesRequest\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x18\n" +
@@ -10805,7 +11819,11 @@ const file_spark_proto_rawDesc = "" +
 	"\x10invoice_statuses\x18\x02 \x03(\v2\x16.spark.InvoiceResponseR\x0finvoiceStatuses\"Y\n" +
 	"\x0fInvoiceResponse\x12\x18\n" +
 	"\ainvoice\x18\x01 \x01(\tR\ainvoice\x12,\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x14.spark.InvoiceStatusR\x06status*M\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x14.spark.InvoiceStatusR\x06status*\x9c\x01\n" +
+	"\x15PreimageRequestStatus\x120\n" +
+	",PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE\x10\x00\x12+\n" +
+	"'PREIMAGE_REQUEST_STATUS_PREIMAGE_SHARED\x10\x01\x12$\n" +
+	" PREIMAGE_REQUEST_STATUS_RETURNED\x10\x02*M\n" +
 	"\aNetwork\x12\x0f\n" +
 	"\vUNSPECIFIED\x10\x00\x12\v\n" +
 	"\aMAINNET\x10\x01\x12\v\n" +
@@ -10834,12 +11852,13 @@ const file_spark_proto_rawDesc = "" +
 	",TRANSFER_STATUS_SENDER_INITIATED_COORDINATOR\x10\b\x12-\n" +
 	")TRANSFER_STATUS_RECEIVER_KEY_TWEAK_LOCKED\x10\t\x12.\n" +
 	"*TRANSFER_STATUS_RECEIVER_KEY_TWEAK_APPLIED\x10\n" +
-	"*p\n" +
+	"*z\n" +
 	"\fTransferType\x12\x11\n" +
 	"\rPREIMAGE_SWAP\x10\x00\x12\x14\n" +
 	"\x10COOPERATIVE_EXIT\x10\x01\x12\f\n" +
 	"\bTRANSFER\x10\x02\x12\r\n" +
 	"\tUTXO_SWAP\x10\x03\x12\b\n" +
+	"\x04HTLC\x10\x04\x12\b\n" +
 	"\x04SWAP\x10\x1e\x12\x10\n" +
 	"\fCOUNTER_SWAP\x10(*&\n" +
 	"\x05Order\x12\x0e\n" +
@@ -10851,12 +11870,16 @@ const file_spark_proto_rawDesc = "" +
 	"\n" +
 	"\x06MaxFee\x10\x01\x12\n" +
 	"\n" +
-	"\x06Refund\x10\x02*G\n" +
+	"\x06Refund\x10\x02*\x8d\x01\n" +
+	"\x16UnilateralExitStepType\x12)\n" +
+	"%UNILATERAL_EXIT_STEP_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eUNILATERAL_EXIT_STEP_TYPE_NODE\x10\x01\x12$\n" +
+	" UNILATERAL_EXIT_STEP_TYPE_REFUND\x10\x02*G\n" +
 	"\rInvoiceStatus\x12\r\n" +
 	"\tNOT_FOUND\x10\x00\x12\v\n" +
 	"\aPENDING\x10\x01\x12\r\n" +
 	"\tFINALIZED\x10\x02\x12\v\n" +
-	"\aEXPIRED\x10\x032\xdb'\n" +
+	"\aEXPIRED\x10\x032\xfe)\n" +
 	"\fSparkService\x12i\n" +
 	"\x18generate_deposit_address\x12$.spark.GenerateDepositAddressRequest\x1a%.spark.GenerateDepositAddressResponse\"\x00\x12|\n" +
 	"\x1fgenerate_static_deposit_address\x12*.spark.GenerateStaticDepositAddressRequest\x1a+.spark.GenerateStaticDepositAddressResponse\"\x00\x12p\n" +
@@ -10910,7 +11933,10 @@ const file_spark_proto_rawDesc = "" +
 	"\x11start_transfer_v2\x12\x1b.spark.StartTransferRequest\x1a\x1c.spark.StartTransferResponse\"\x00\x12V\n" +
 	"\x13refresh_timelock_v2\x12\x1d.spark.RefreshTimelockRequest\x1a\x1e.spark.RefreshTimelockResponse\"\x00\x12^\n" +
 	"\x15get_utxos_for_address\x12 .spark.GetUtxosForAddressRequest\x1a!.spark.GetUtxosForAddressResponse\"\x00\x12]\n" +
-	"\x14query_spark_invoices\x12 .spark.QuerySparkInvoicesRequest\x1a!.spark.QuerySparkInvoicesResponse\"\x00B,Z*github.com/lightsparkdev/spark/proto/sparkb\x06proto3"
+	"\x14query_spark_invoices\x12 .spark.QuerySparkInvoicesRequest\x1a!.spark.QuerySparkInvoicesResponse\"\x00\x12]\n" +
+	"\x14start_batch_transfer\x12 .spark.StartBatchTransferRequest\x1a!.spark.StartBatchTransferResponse\"\x00\x12V\n" +
+	"\x13start_htlc_transfer\x12\x1f.spark.StartHtlcTransferRequest\x1a\x1c.spark.StartTransferResponse\"\x00\x12j\n" +
+	"\x19query_unilateral_exit_kit\x12$.spark.QueryUnilateralExitKitRequest\x1a%.spark.QueryUnilateralExitKitResponse\"\x00B,Z*github.com/lightsparkdev/spark/proto/sparkb\x06proto3"
 
 var (
 	file_spark_proto_rawDescOnce sync.Once
@@ -10924,513 +11950,552 @@ func file_spark_proto_rawDescGZIP() []byte {
 	return file_spark_proto_rawDescData
 }
 
-var file_spark_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_spark_proto_msgTypes = make([]protoimpl.MessageInfo, 161)
+var file_spark_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_spark_proto_msgTypes = make([]protoimpl.MessageInfo, 173)
 var file_spark_proto_goTypes = []any{
-	(Network)(0),                                            // 0: spark.Network
-	(TokenTransactionStatus)(0),                             // 1: spark.TokenTransactionStatus
-	(TransferStatus)(0),                                     // 2: spark.TransferStatus
-	(TransferType)(0),                                       // 3: spark.TransferType
-	(Order)(0),                                              // 4: spark.Order
-	(UtxoSwapRequestType)(0),                                // 5: spark.UtxoSwapRequestType
-	(InvoiceStatus)(0),                                      // 6: spark.InvoiceStatus
-	(InitiatePreimageSwapRequest_Reason)(0),                 // 7: spark.InitiatePreimageSwapRequest.Reason
-	(*SubscribeToEventsRequest)(nil),                        // 8: spark.SubscribeToEventsRequest
-	(*SubscribeToEventsResponse)(nil),                       // 9: spark.SubscribeToEventsResponse
-	(*ConnectedEvent)(nil),                                  // 10: spark.ConnectedEvent
-	(*TransferEvent)(nil),                                   // 11: spark.TransferEvent
-	(*DepositEvent)(nil),                                    // 12: spark.DepositEvent
-	(*DepositAddressProof)(nil),                             // 13: spark.DepositAddressProof
-	(*GenerateDepositAddressRequest)(nil),                   // 14: spark.GenerateDepositAddressRequest
-	(*Address)(nil),                                         // 15: spark.Address
-	(*GenerateDepositAddressResponse)(nil),                  // 16: spark.GenerateDepositAddressResponse
-	(*GenerateStaticDepositAddressRequest)(nil),             // 17: spark.GenerateStaticDepositAddressRequest
-	(*GenerateStaticDepositAddressResponse)(nil),            // 18: spark.GenerateStaticDepositAddressResponse
-	(*UTXO)(nil),                                            // 19: spark.UTXO
-	(*NodeOutput)(nil),                                      // 20: spark.NodeOutput
-	(*SigningJob)(nil),                                      // 21: spark.SigningJob
-	(*SigningKeyshare)(nil),                                 // 22: spark.SigningKeyshare
-	(*SigningResult)(nil),                                   // 23: spark.SigningResult
-	(*NodeSignatureShares)(nil),                             // 24: spark.NodeSignatureShares
-	(*NodeSignatures)(nil),                                  // 25: spark.NodeSignatures
-	(*StartTreeCreationRequest)(nil),                        // 26: spark.StartTreeCreationRequest
-	(*StartTreeCreationResponse)(nil),                       // 27: spark.StartTreeCreationResponse
-	(*StartDepositTreeCreationRequest)(nil),                 // 28: spark.StartDepositTreeCreationRequest
-	(*StartDepositTreeCreationResponse)(nil),                // 29: spark.StartDepositTreeCreationResponse
-	(*TokenOutputToSpend)(nil),                              // 30: spark.TokenOutputToSpend
-	(*TokenTransferInput)(nil),                              // 31: spark.TokenTransferInput
-	(*TokenMintInput)(nil),                                  // 32: spark.TokenMintInput
-	(*TokenCreateInput)(nil),                                // 33: spark.TokenCreateInput
-	(*TokenOutput)(nil),                                     // 34: spark.TokenOutput
-	(*TokenTransaction)(nil),                                // 35: spark.TokenTransaction
-	(*SpentTokenOutputMetadata)(nil),                        // 36: spark.SpentTokenOutputMetadata
-	(*TokenTransactionConfirmationMetadata)(nil),            // 37: spark.TokenTransactionConfirmationMetadata
-	(*TokenTransactionWithStatus)(nil),                      // 38: spark.TokenTransactionWithStatus
-	(*SignatureWithIndex)(nil),                              // 39: spark.SignatureWithIndex
-	(*TokenTransactionSignatures)(nil),                      // 40: spark.TokenTransactionSignatures
-	(*StartTokenTransactionRequest)(nil),                    // 41: spark.StartTokenTransactionRequest
-	(*StartTokenTransactionResponse)(nil),                   // 42: spark.StartTokenTransactionResponse
-	(*OperatorSpecificTokenTransactionSignablePayload)(nil), // 43: spark.OperatorSpecificTokenTransactionSignablePayload
-	(*OperatorSpecificOwnerSignature)(nil),                  // 44: spark.OperatorSpecificOwnerSignature
-	(*SignTokenTransactionRequest)(nil),                     // 45: spark.SignTokenTransactionRequest
-	(*KeyshareWithIndex)(nil),                               // 46: spark.KeyshareWithIndex
-	(*SignTokenTransactionResponse)(nil),                    // 47: spark.SignTokenTransactionResponse
-	(*RevocationSecretWithIndex)(nil),                       // 48: spark.RevocationSecretWithIndex
-	(*FinalizeTokenTransactionRequest)(nil),                 // 49: spark.FinalizeTokenTransactionRequest
-	(*FreezeTokensPayload)(nil),                             // 50: spark.FreezeTokensPayload
-	(*FreezeTokensRequest)(nil),                             // 51: spark.FreezeTokensRequest
-	(*FreezeTokensResponse)(nil),                            // 52: spark.FreezeTokensResponse
-	(*QueryTokenOutputsRequest)(nil),                        // 53: spark.QueryTokenOutputsRequest
-	(*QueryTokenTransactionsRequest)(nil),                   // 54: spark.QueryTokenTransactionsRequest
-	(*QueryTokenTransactionsResponse)(nil),                  // 55: spark.QueryTokenTransactionsResponse
-	(*OutputWithPreviousTransactionData)(nil),               // 56: spark.OutputWithPreviousTransactionData
-	(*QueryTokenOutputsResponse)(nil),                       // 57: spark.QueryTokenOutputsResponse
-	(*TreeNode)(nil),                                        // 58: spark.TreeNode
-	(*FinalizeNodeSignaturesRequest)(nil),                   // 59: spark.FinalizeNodeSignaturesRequest
-	(*FinalizeNodeSignaturesResponse)(nil),                  // 60: spark.FinalizeNodeSignaturesResponse
-	(*SecretShare)(nil),                                     // 61: spark.SecretShare
-	(*SecretProof)(nil),                                     // 62: spark.SecretProof
-	(*LeafRefundTxSigningJob)(nil),                          // 63: spark.LeafRefundTxSigningJob
-	(*UserSignedTxSigningJob)(nil),                          // 64: spark.UserSignedTxSigningJob
-	(*LeafRefundTxSigningResult)(nil),                       // 65: spark.LeafRefundTxSigningResult
-	(*StartUserSignedTransferRequest)(nil),                  // 66: spark.StartUserSignedTransferRequest
-	(*StartTransferRequest)(nil),                            // 67: spark.StartTransferRequest
-	(*StartTransferResponse)(nil),                           // 68: spark.StartTransferResponse
-	(*TransferPackage)(nil),                                 // 69: spark.TransferPackage
-	(*SendLeafKeyTweaks)(nil),                               // 70: spark.SendLeafKeyTweaks
-	(*SendLeafKeyTweak)(nil),                                // 71: spark.SendLeafKeyTweak
-	(*FinalizeTransferRequest)(nil),                         // 72: spark.FinalizeTransferRequest
-	(*FinalizeTransferWithTransferPackageRequest)(nil),      // 73: spark.FinalizeTransferWithTransferPackageRequest
-	(*FinalizeTransferResponse)(nil),                        // 74: spark.FinalizeTransferResponse
-	(*Transfer)(nil),                                        // 75: spark.Transfer
-	(*TransferLeaf)(nil),                                    // 76: spark.TransferLeaf
-	(*TransferFilter)(nil),                                  // 77: spark.TransferFilter
-	(*QueryTransfersResponse)(nil),                          // 78: spark.QueryTransfersResponse
-	(*ClaimLeafKeyTweak)(nil),                               // 79: spark.ClaimLeafKeyTweak
-	(*ClaimTransferTweakKeysRequest)(nil),                   // 80: spark.ClaimTransferTweakKeysRequest
-	(*ClaimTransferSignRefundsRequest)(nil),                 // 81: spark.ClaimTransferSignRefundsRequest
-	(*ClaimTransferSignRefundsResponse)(nil),                // 82: spark.ClaimTransferSignRefundsResponse
-	(*StorePreimageShareRequest)(nil),                       // 83: spark.StorePreimageShareRequest
-	(*RequestedSigningCommitments)(nil),                     // 84: spark.RequestedSigningCommitments
-	(*GetSigningCommitmentsRequest)(nil),                    // 85: spark.GetSigningCommitmentsRequest
-	(*GetSigningCommitmentsResponse)(nil),                   // 86: spark.GetSigningCommitmentsResponse
-	(*SigningCommitments)(nil),                              // 87: spark.SigningCommitments
-	(*UserSignedRefund)(nil),                                // 88: spark.UserSignedRefund
-	(*InvoiceAmountProof)(nil),                              // 89: spark.InvoiceAmountProof
-	(*InvoiceAmount)(nil),                                   // 90: spark.InvoiceAmount
-	(*InitiatePreimageSwapRequest)(nil),                     // 91: spark.InitiatePreimageSwapRequest
-	(*InitiatePreimageSwapResponse)(nil),                    // 92: spark.InitiatePreimageSwapResponse
-	(*OutPoint)(nil),                                        // 93: spark.OutPoint
-	(*CooperativeExitRequest)(nil),                          // 94: spark.CooperativeExitRequest
-	(*CooperativeExitResponse)(nil),                         // 95: spark.CooperativeExitResponse
-	(*CounterLeafSwapRequest)(nil),                          // 96: spark.CounterLeafSwapRequest
-	(*CounterLeafSwapResponse)(nil),                         // 97: spark.CounterLeafSwapResponse
-	(*RefreshTimelockRequest)(nil),                          // 98: spark.RefreshTimelockRequest
-	(*RefreshTimelockSigningResult)(nil),                    // 99: spark.RefreshTimelockSigningResult
-	(*RefreshTimelockResponse)(nil),                         // 100: spark.RefreshTimelockResponse
-	(*ExtendLeafRequest)(nil),                               // 101: spark.ExtendLeafRequest
-	(*ExtendLeafSigningResult)(nil),                         // 102: spark.ExtendLeafSigningResult
-	(*ExtendLeafResponse)(nil),                              // 103: spark.ExtendLeafResponse
-	(*AddressRequestNode)(nil),                              // 104: spark.AddressRequestNode
-	(*PrepareTreeAddressRequest)(nil),                       // 105: spark.PrepareTreeAddressRequest
-	(*AddressNode)(nil),                                     // 106: spark.AddressNode
-	(*PrepareTreeAddressResponse)(nil),                      // 107: spark.PrepareTreeAddressResponse
-	(*CreationNode)(nil),                                    // 108: spark.CreationNode
-	(*CreateTreeRequest)(nil),                               // 109: spark.CreateTreeRequest
-	(*CreationResponseNode)(nil),                            // 110: spark.CreationResponseNode
-	(*CreateTreeResponse)(nil),                              // 111: spark.CreateTreeResponse
-	(*SigningOperatorInfo)(nil),                             // 112: spark.SigningOperatorInfo
-	(*GetSigningOperatorListResponse)(nil),                  // 113: spark.GetSigningOperatorListResponse
-	(*QueryUserSignedRefundsRequest)(nil),                   // 114: spark.QueryUserSignedRefundsRequest
-	(*QueryUserSignedRefundsResponse)(nil),                  // 115: spark.QueryUserSignedRefundsResponse
-	(*ProvidePreimageRequest)(nil),                          // 116: spark.ProvidePreimageRequest
-	(*ProvidePreimageResponse)(nil),                         // 117: spark.ProvidePreimageResponse
-	(*ReturnLightningPaymentRequest)(nil),                   // 118: spark.ReturnLightningPaymentRequest
-	(*TreeNodeIds)(nil),                                     // 119: spark.TreeNodeIds
-	(*QueryNodesRequest)(nil),                               // 120: spark.QueryNodesRequest
-	(*QueryNodesResponse)(nil),                              // 121: spark.QueryNodesResponse
-	(*CancelTransferRequest)(nil),                           // 122: spark.CancelTransferRequest
-	(*CancelTransferResponse)(nil),                          // 123: spark.CancelTransferResponse
-	(*QueryUnusedDepositAddressesRequest)(nil),              // 124: spark.QueryUnusedDepositAddressesRequest
-	(*QueryStaticDepositAddressesRequest)(nil),              // 125: spark.QueryStaticDepositAddressesRequest
-	(*DepositAddressQueryResult)(nil),                       // 126: spark.DepositAddressQueryResult
-	(*QueryUnusedDepositAddressesResponse)(nil),             // 127: spark.QueryUnusedDepositAddressesResponse
-	(*QueryStaticDepositAddressesResponse)(nil),             // 128: spark.QueryStaticDepositAddressesResponse
-	(*QueryBalanceRequest)(nil),                             // 129: spark.QueryBalanceRequest
-	(*QueryBalanceResponse)(nil),                            // 130: spark.QueryBalanceResponse
-	(*SparkAddress)(nil),                                    // 131: spark.SparkAddress
-	(*SparkInvoiceFields)(nil),                              // 132: spark.SparkInvoiceFields
-	(*SatsPayment)(nil),                                     // 133: spark.SatsPayment
-	(*TokensPayment)(nil),                                   // 134: spark.TokensPayment
-	(*InitiateStaticDepositUtxoRefundRequest)(nil),          // 135: spark.InitiateStaticDepositUtxoRefundRequest
-	(*InitiateStaticDepositUtxoRefundResponse)(nil),         // 136: spark.InitiateStaticDepositUtxoRefundResponse
-	(*InitiateUtxoSwapRequest)(nil),                         // 137: spark.InitiateUtxoSwapRequest
-	(*InitiateUtxoSwapResponse)(nil),                        // 138: spark.InitiateUtxoSwapResponse
-	(*ExitingTree)(nil),                                     // 139: spark.ExitingTree
-	(*ExitSingleNodeTreeSigningResult)(nil),                 // 140: spark.ExitSingleNodeTreeSigningResult
-	(*BitcoinTransactionOutput)(nil),                        // 141: spark.BitcoinTransactionOutput
-	(*ExitSingleNodeTreesRequest)(nil),                      // 142: spark.ExitSingleNodeTreesRequest
-	(*ExitSingleNodeTreesResponse)(nil),                     // 143: spark.ExitSingleNodeTreesResponse
-	(*InvestigateLeavesRequest)(nil),                        // 144: spark.InvestigateLeavesRequest
-	(*QueryNodesDistributionRequest)(nil),                   // 145: spark.QueryNodesDistributionRequest
-	(*QueryNodesDistributionResponse)(nil),                  // 146: spark.QueryNodesDistributionResponse
-	(*QueryNodesByValueRequest)(nil),                        // 147: spark.QueryNodesByValueRequest
-	(*QueryNodesByValueResponse)(nil),                       // 148: spark.QueryNodesByValueResponse
-	(*GetUtxosForAddressRequest)(nil),                       // 149: spark.GetUtxosForAddressRequest
-	(*GetUtxosForAddressResponse)(nil),                      // 150: spark.GetUtxosForAddressResponse
-	(*QuerySparkInvoicesRequest)(nil),                       // 151: spark.QuerySparkInvoicesRequest
-	(*QuerySparkInvoicesResponse)(nil),                      // 152: spark.QuerySparkInvoicesResponse
-	(*InvoiceResponse)(nil),                                 // 153: spark.InvoiceResponse
-	nil,                                                     // 154: spark.DepositAddressProof.AddressSignaturesEntry
-	nil,                                                     // 155: spark.SigningKeyshare.PublicSharesEntry
-	nil,                                                     // 156: spark.SigningResult.PublicKeysEntry
-	nil,                                                     // 157: spark.SigningResult.SigningNonceCommitmentsEntry
-	nil,                                                     // 158: spark.SigningResult.SignatureSharesEntry
-	nil,                                                     // 159: spark.TransferPackage.KeyTweakPackageEntry
-	nil,                                                     // 160: spark.SendLeafKeyTweak.PubkeySharesTweakEntry
-	nil,                                                     // 161: spark.ClaimLeafKeyTweak.PubkeySharesTweakEntry
-	nil,                                                     // 162: spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry
-	nil,                                                     // 163: spark.SigningCommitments.SigningCommitmentsEntry
-	nil,                                                     // 164: spark.GetSigningOperatorListResponse.SigningOperatorsEntry
-	nil,                                                     // 165: spark.QueryNodesResponse.NodesEntry
-	nil,                                                     // 166: spark.QueryBalanceResponse.NodeBalancesEntry
-	nil,                                                     // 167: spark.QueryNodesDistributionResponse.NodeDistributionEntry
-	nil,                                                     // 168: spark.QueryNodesByValueResponse.NodesEntry
-	(*common.SigningCommitment)(nil),                        // 169: common.SigningCommitment
-	(*timestamppb.Timestamp)(nil),                           // 170: google.protobuf.Timestamp
-	(common.SignatureIntent)(0),                             // 171: common.SignatureIntent
-	(*emptypb.Empty)(nil),                                   // 172: google.protobuf.Empty
+	(PreimageRequestStatus)(0),                              // 0: spark.PreimageRequestStatus
+	(Network)(0),                                            // 1: spark.Network
+	(TokenTransactionStatus)(0),                             // 2: spark.TokenTransactionStatus
+	(TransferStatus)(0),                                     // 3: spark.TransferStatus
+	(TransferType)(0),                                       // 4: spark.TransferType
+	(Order)(0),                                              // 5: spark.Order
+	(UtxoSwapRequestType)(0),                                // 6: spark.UtxoSwapRequestType
+	(UnilateralExitStepType)(0),                             // 7: spark.UnilateralExitStepType
+	(InvoiceStatus)(0),                                      // 8: spark.InvoiceStatus
+	(InitiatePreimageSwapRequest_Reason)(0),                 // 9: spark.InitiatePreimageSwapRequest.Reason
+	(*SubscribeToEventsRequest)(nil),                        // 10: spark.SubscribeToEventsRequest
+	(*SubscribeToEventsResponse)(nil),                       // 11: spark.SubscribeToEventsResponse
+	(*ConnectedEvent)(nil),                                  // 12: spark.ConnectedEvent
+	(*TransferEvent)(nil),                                   // 13: spark.TransferEvent
+	(*DepositEvent)(nil),                                    // 14: spark.DepositEvent
+	(*TokenTransactionEvent)(nil),                           // 15: spark.TokenTransactionEvent
+	(*PreimageRequestEvent)(nil),                            // 16: spark.PreimageRequestEvent
+	(*CooperativeExitEvent)(nil),                            // 17: spark.CooperativeExitEvent
+	(*UtxoSwapEvent)(nil),                                   // 18: spark.UtxoSwapEvent
+	(*TreeExitEvent)(nil),                                   // 19: spark.TreeExitEvent
+	(*DepositAddressProof)(nil),                             // 20: spark.DepositAddressProof
+	(*GenerateDepositAddressRequest)(nil),                   // 21: spark.GenerateDepositAddressRequest
+	(*Address)(nil),                                         // 22: spark.Address
+	(*GenerateDepositAddressResponse)(nil),                  // 23: spark.GenerateDepositAddressResponse
+	(*GenerateStaticDepositAddressRequest)(nil),             // 24: spark.GenerateStaticDepositAddressRequest
+	(*GenerateStaticDepositAddressResponse)(nil),            // 25: spark.GenerateStaticDepositAddressResponse
+	(*UTXO)(nil),                                            // 26: spark.UTXO
+	(*NodeOutput)(nil),                                      // 27: spark.NodeOutput
+	(*SigningJob)(nil),                                      // 28: spark.SigningJob
+	(*SigningKeyshare)(nil),                                 // 29: spark.SigningKeyshare
+	(*SigningResult)(nil),                                   // 30: spark.SigningResult
+	(*NodeSignatureShares)(nil),                             // 31: spark.NodeSignatureShares
+	(*NodeSignatures)(nil),                                  // 32: spark.NodeSignatures
+	(*StartTreeCreationRequest)(nil),                        // 33: spark.StartTreeCreationRequest
+	(*StartTreeCreationResponse)(nil),                       // 34: spark.StartTreeCreationResponse
+	(*StartDepositTreeCreationRequest)(nil),                 // 35: spark.StartDepositTreeCreationRequest
+	(*StartDepositTreeCreationResponse)(nil),                // 36: spark.StartDepositTreeCreationResponse
+	(*TokenOutputToSpend)(nil),                              // 37: spark.TokenOutputToSpend
+	(*TokenTransferInput)(nil),                              // 38: spark.TokenTransferInput
+	(*TokenMintInput)(nil),                                  // 39: spark.TokenMintInput
+	(*TokenCreateInput)(nil),                                // 40: spark.TokenCreateInput
+	(*TokenOutput)(nil),                                     // 41: spark.TokenOutput
+	(*TokenTransaction)(nil),                                // 42: spark.TokenTransaction
+	(*SpentTokenOutputMetadata)(nil),                        // 43: spark.SpentTokenOutputMetadata
+	(*TokenTransactionConfirmationMetadata)(nil),            // 44: spark.TokenTransactionConfirmationMetadata
+	(*TokenTransactionWithStatus)(nil),                      // 45: spark.TokenTransactionWithStatus
+	(*SignatureWithIndex)(nil),                              // 46: spark.SignatureWithIndex
+	(*TokenTransactionSignatures)(nil),                      // 47: spark.TokenTransactionSignatures
+	(*StartTokenTransactionRequest)(nil),                    // 48: spark.StartTokenTransactionRequest
+	(*StartTokenTransactionResponse)(nil),                   // 49: spark.StartTokenTransactionResponse
+	(*OperatorSpecificTokenTransactionSignablePayload)(nil), // 50: spark.OperatorSpecificTokenTransactionSignablePayload
+	(*OperatorSpecificOwnerSignature)(nil),                  // 51: spark.OperatorSpecificOwnerSignature
+	(*SignTokenTransactionRequest)(nil),                     // 52: spark.SignTokenTransactionRequest
+	(*KeyshareWithIndex)(nil),                               // 53: spark.KeyshareWithIndex
+	(*SignTokenTransactionResponse)(nil),                    // 54: spark.SignTokenTransactionResponse
+	(*RevocationSecretWithIndex)(nil),                       // 55: spark.RevocationSecretWithIndex
+	(*FinalizeTokenTransactionRequest)(nil),                 // 56: spark.FinalizeTokenTransactionRequest
+	(*FreezeTokensPayload)(nil),                             // 57: spark.FreezeTokensPayload
+	(*FreezeTokensRequest)(nil),                             // 58: spark.FreezeTokensRequest
+	(*FreezeTokensResponse)(nil),                            // 59: spark.FreezeTokensResponse
+	(*QueryTokenOutputsRequest)(nil),                        // 60: spark.QueryTokenOutputsRequest
+	(*QueryTokenTransactionsRequest)(nil),                   // 61: spark.QueryTokenTransactionsRequest
+	(*QueryTokenTransactionsResponse)(nil),                  // 62: spark.QueryTokenTransactionsResponse
+	(*OutputWithPreviousTransactionData)(nil),               // 63: spark.OutputWithPreviousTransactionData
+	(*QueryTokenOutputsResponse)(nil),                       // 64: spark.QueryTokenOutputsResponse
+	(*TreeNode)(nil),                                        // 65: spark.TreeNode
+	(*FinalizeNodeSignaturesRequest)(nil),                   // 66: spark.FinalizeNodeSignaturesRequest
+	(*FinalizeNodeSignaturesResponse)(nil),                  // 67: spark.FinalizeNodeSignaturesResponse
+	(*SecretShare)(nil),                                     // 68: spark.SecretShare
+	(*SecretProof)(nil),                                     // 69: spark.SecretProof
+	(*LeafRefundTxSigningJob)(nil),                          // 70: spark.LeafRefundTxSigningJob
+	(*UserSignedTxSigningJob)(nil),                          // 71: spark.UserSignedTxSigningJob
+	(*LeafRefundTxSigningResult)(nil),                       // 72: spark.LeafRefundTxSigningResult
+	(*StartUserSignedTransferRequest)(nil),                  // 73: spark.StartUserSignedTransferRequest
+	(*StartTransferRequest)(nil),                            // 74: spark.StartTransferRequest
+	(*StartTransferResponse)(nil),                           // 75: spark.StartTransferResponse
+	(*StartBatchTransferRequest)(nil),                       // 76: spark.StartBatchTransferRequest
+	(*StartBatchTransferResponse)(nil),                      // 77: spark.StartBatchTransferResponse
+	(*StartHtlcTransferRequest)(nil),                        // 78: spark.StartHtlcTransferRequest
+	(*TransferPackage)(nil),                                 // 79: spark.TransferPackage
+	(*SendLeafKeyTweaks)(nil),                               // 80: spark.SendLeafKeyTweaks
+	(*SendLeafKeyTweak)(nil),                                // 81: spark.SendLeafKeyTweak
+	(*FinalizeTransferRequest)(nil),                         // 82: spark.FinalizeTransferRequest
+	(*FinalizeTransferWithTransferPackageRequest)(nil),      // 83: spark.FinalizeTransferWithTransferPackageRequest
+	(*FinalizeTransferResponse)(nil),                        // 84: spark.FinalizeTransferResponse
+	(*Transfer)(nil),                                        // 85: spark.Transfer
+	(*TransferLeaf)(nil),                                    // 86: spark.TransferLeaf
+	(*TransferFilter)(nil),                                  // 87: spark.TransferFilter
+	(*QueryTransfersResponse)(nil),                          // 88: spark.QueryTransfersResponse
+	(*ClaimLeafKeyTweak)(nil),                               // 89: spark.ClaimLeafKeyTweak
+	(*ClaimTransferTweakKeysRequest)(nil),                   // 90: spark.ClaimTransferTweakKeysRequest
+	(*ClaimTransferSignRefundsRequest)(nil),                 // 91: spark.ClaimTransferSignRefundsRequest
+	(*ClaimTransferSignRefundsResponse)(nil),                // 92: spark.ClaimTransferSignRefundsResponse
+	(*StorePreimageShareRequest)(nil),                       // 93: spark.StorePreimageShareRequest
+	(*RequestedSigningCommitments)(nil),                     // 94: spark.RequestedSigningCommitments
+	(*GetSigningCommitmentsRequest)(nil),                    // 95: spark.GetSigningCommitmentsRequest
+	(*GetSigningCommitmentsResponse)(nil),                   // 96: spark.GetSigningCommitmentsResponse
+	(*SigningCommitments)(nil),                              // 97: spark.SigningCommitments
+	(*UserSignedRefund)(nil),                                // 98: spark.UserSignedRefund
+	(*InvoiceAmountProof)(nil),                              // 99: spark.InvoiceAmountProof
+	(*InvoiceAmount)(nil),                                   // 100: spark.InvoiceAmount
+	(*InitiatePreimageSwapRequest)(nil),                     // 101: spark.InitiatePreimageSwapRequest
+	(*InitiatePreimageSwapResponse)(nil),                    // 102: spark.InitiatePreimageSwapResponse
+	(*OutPoint)(nil),                                        // 103: spark.OutPoint
+	(*CooperativeExitRequest)(nil),                          // 104: spark.CooperativeExitRequest
+	(*CooperativeExitResponse)(nil),                         // 105: spark.CooperativeExitResponse
+	(*CounterLeafSwapRequest)(nil),                          // 106: spark.CounterLeafSwapRequest
+	(*CounterLeafSwapResponse)(nil),                         // 107: spark.CounterLeafSwapResponse
+	(*RefreshTimelockRequest)(nil),                          // 108: spark.RefreshTimelockRequest
+	(*RefreshTimelockSigningResult)(nil),                    // 109: spark.RefreshTimelockSigningResult
+	(*RefreshTimelockResponse)(nil),                         // 110: spark.RefreshTimelockResponse
+	(*ExtendLeafRequest)(nil),                               // 111: spark.ExtendLeafRequest
+	(*ExtendLeafSigningResult)(nil),                         // 112: spark.ExtendLeafSigningResult
+	(*ExtendLeafResponse)(nil),                              // 113: spark.ExtendLeafResponse
+	(*AddressRequestNode)(nil),                              // 114: spark.AddressRequestNode
+	(*PrepareTreeAddressRequest)(nil),                       // 115: spark.PrepareTreeAddressRequest
+	(*AddressNode)(nil),                                     // 116: spark.AddressNode
+	(*PrepareTreeAddressResponse)(nil),                      // 117: spark.PrepareTreeAddressResponse
+	(*CreationNode)(nil),                                    // 118: spark.CreationNode
+	(*CreateTreeRequest)(nil),                               // 119: spark.CreateTreeRequest
+	(*CreationResponseNode)(nil),                            // 120: spark.CreationResponseNode
+	(*CreateTreeResponse)(nil),                              // 121: spark.CreateTreeResponse
+	(*SigningOperatorInfo)(nil),                             // 122: spark.SigningOperatorInfo
+	(*GetSigningOperatorListResponse)(nil),                  // 123: spark.GetSigningOperatorListResponse
+	(*QueryUserSignedRefundsRequest)(nil),                   // 124: spark.QueryUserSignedRefundsRequest
+	(*QueryUserSignedRefundsResponse)(nil),                  // 125: spark.QueryUserSignedRefundsResponse
+	(*ProvidePreimageRequest)(nil),                          // 126: spark.ProvidePreimageRequest
+	(*ProvidePreimageResponse)(nil),                         // 127: spark.ProvidePreimageResponse
+	(*ReturnLightningPaymentRequest)(nil),                   // 128: spark.ReturnLightningPaymentRequest
+	(*TreeNodeIds)(nil),                                     // 129: spark.TreeNodeIds
+	(*QueryNodesRequest)(nil),                               // 130: spark.QueryNodesRequest
+	(*QueryNodesResponse)(nil),                              // 131: spark.QueryNodesResponse
+	(*CancelTransferRequest)(nil),                           // 132: spark.CancelTransferRequest
+	(*CancelTransferResponse)(nil),                          // 133: spark.CancelTransferResponse
+	(*QueryUnusedDepositAddressesRequest)(nil),              // 134: spark.QueryUnusedDepositAddressesRequest
+	(*QueryStaticDepositAddressesRequest)(nil),              // 135: spark.QueryStaticDepositAddressesRequest
+	(*DepositAddressQueryResult)(nil),                       // 136: spark.DepositAddressQueryResult
+	(*QueryUnusedDepositAddressesResponse)(nil),             // 137: spark.QueryUnusedDepositAddressesResponse
+	(*QueryStaticDepositAddressesResponse)(nil),             // 138: spark.QueryStaticDepositAddressesResponse
+	(*QueryBalanceRequest)(nil),                             // 139: spark.QueryBalanceRequest
+	(*QueryBalanceResponse)(nil),                            // 140: spark.QueryBalanceResponse
+	(*SparkAddress)(nil),                                    // 141: spark.SparkAddress
+	(*SparkInvoiceFields)(nil),                              // 142: spark.SparkInvoiceFields
+	(*SatsPayment)(nil),                                     // 143: spark.SatsPayment
+	(*TokensPayment)(nil),                                   // 144: spark.TokensPayment
+	(*InitiateStaticDepositUtxoRefundRequest)(nil),          // 145: spark.InitiateStaticDepositUtxoRefundRequest
+	(*InitiateStaticDepositUtxoRefundResponse)(nil),         // 146: spark.InitiateStaticDepositUtxoRefundResponse
+	(*InitiateUtxoSwapRequest)(nil),                         // 147: spark.InitiateUtxoSwapRequest
+	(*InitiateUtxoSwapResponse)(nil),                        // 148: spark.InitiateUtxoSwapResponse
+	(*ExitingTree)(nil),                                     // 149: spark.ExitingTree
+	(*ExitSingleNodeTreeSigningResult)(nil),                 // 150: spark.ExitSingleNodeTreeSigningResult
+	(*BitcoinTransactionOutput)(nil),                        // 151: spark.BitcoinTransactionOutput
+	(*ExitSingleNodeTreesRequest)(nil),                      // 152: spark.ExitSingleNodeTreesRequest
+	(*ExitSingleNodeTreesResponse)(nil),                     // 153: spark.ExitSingleNodeTreesResponse
+	(*InvestigateLeavesRequest)(nil),                        // 154: spark.InvestigateLeavesRequest
+	(*QueryNodesDistributionRequest)(nil),                   // 155: spark.QueryNodesDistributionRequest
+	(*QueryNodesDistributionResponse)(nil),                  // 156: spark.QueryNodesDistributionResponse
+	(*QueryNodesByValueRequest)(nil),                        // 157: spark.QueryNodesByValueRequest
+	(*QueryNodesByValueResponse)(nil),                       // 158: spark.QueryNodesByValueResponse
+	(*GetUtxosForAddressRequest)(nil),                       // 159: spark.GetUtxosForAddressRequest
+	(*GetUtxosForAddressResponse)(nil),                      // 160: spark.GetUtxosForAddressResponse
+	(*QueryUnilateralExitKitRequest)(nil),                   // 161: spark.QueryUnilateralExitKitRequest
+	(*UnilateralExitTransaction)(nil),                       // 162: spark.UnilateralExitTransaction
+	(*UnilateralExitStep)(nil),                              // 163: spark.UnilateralExitStep
+	(*QueryUnilateralExitKitResponse)(nil),                  // 164: spark.QueryUnilateralExitKitResponse
+	(*QuerySparkInvoicesRequest)(nil),                       // 165: spark.QuerySparkInvoicesRequest
+	(*QuerySparkInvoicesResponse)(nil),                      // 166: spark.QuerySparkInvoicesResponse
+	(*InvoiceResponse)(nil),                                 // 167: spark.InvoiceResponse
+	nil,                                                     // 168: spark.DepositAddressProof.AddressSignaturesEntry
+	nil,                                                     // 169: spark.SigningKeyshare.PublicSharesEntry
+	nil,                                                     // 170: spark.SigningResult.PublicKeysEntry
+	nil,                                                     // 171: spark.SigningResult.SigningNonceCommitmentsEntry
+	nil,                                                     // 172: spark.SigningResult.SignatureSharesEntry
+	nil,                                                     // 173: spark.TransferPackage.KeyTweakPackageEntry
+	nil,                                                     // 174: spark.SendLeafKeyTweak.PubkeySharesTweakEntry
+	nil,                                                     // 175: spark.ClaimLeafKeyTweak.PubkeySharesTweakEntry
+	nil,                                                     // 176: spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry
+	nil,                                                     // 177: spark.SigningCommitments.SigningCommitmentsEntry
+	nil,                                                     // 178: spark.GetSigningOperatorListResponse.SigningOperatorsEntry
+	nil,                                                     // 179: spark.QueryNodesResponse.NodesEntry
+	nil,                                                     // 180: spark.QueryBalanceResponse.NodeBalancesEntry
+	nil,                                                     // 181: spark.QueryNodesDistributionResponse.NodeDistributionEntry
+	nil,                                                     // 182: spark.QueryNodesByValueResponse.NodesEntry
+	(*common.SigningCommitment)(nil),                        // 183: common.SigningCommitment
+	(*timestamppb.Timestamp)(nil),                           // 184: google.protobuf.Timestamp
+	(common.SignatureIntent)(0),                             // 185: common.SignatureIntent
+	(*emptypb.Empty)(nil),                                   // 186: google.protobuf.Empty
 }
 var file_spark_proto_depIdxs = []int32{
-	11,  // 0: spark.SubscribeToEventsResponse.transfer:type_name -> spark.TransferEvent
-	12,  // 1: spark.SubscribeToEventsResponse.deposit:type_name -> spark.DepositEvent
-	10,  // 2: spark.SubscribeToEventsResponse.connected:type_name -> spark.ConnectedEvent
-	75,  // 3: spark.TransferEvent.transfer:type_name -> spark.Transfer
-	58,  // 4: spark.DepositEvent.deposit:type_name -> spark.TreeNode
-	154, // 5: spark.DepositAddressProof.address_signatures:type_name -> spark.DepositAddressProof.AddressSignaturesEntry
-	0,   // 6: spark.GenerateDepositAddressRequest.network:type_name -> spark.Network
-	13,  // 7: spark.Address.deposit_address_proof:type_name -> spark.DepositAddressProof
-	15,  // 8: spark.GenerateDepositAddressResponse.deposit_address:type_name -> spark.Address
-	0,   // 9: spark.GenerateStaticDepositAddressRequest.network:type_name -> spark.Network
-	15,  // 10: spark.GenerateStaticDepositAddressResponse.deposit_address:type_name -> spark.Address
-	0,   // 11: spark.UTXO.network:type_name -> spark.Network
-	169, // 12: spark.SigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
-	155, // 13: spark.SigningKeyshare.public_shares:type_name -> spark.SigningKeyshare.PublicSharesEntry
-	170, // 14: spark.SigningKeyshare.updated_time:type_name -> google.protobuf.Timestamp
-	156, // 15: spark.SigningResult.public_keys:type_name -> spark.SigningResult.PublicKeysEntry
-	157, // 16: spark.SigningResult.signing_nonce_commitments:type_name -> spark.SigningResult.SigningNonceCommitmentsEntry
-	158, // 17: spark.SigningResult.signature_shares:type_name -> spark.SigningResult.SignatureSharesEntry
-	22,  // 18: spark.SigningResult.signing_keyshare:type_name -> spark.SigningKeyshare
-	23,  // 19: spark.NodeSignatureShares.node_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 20: spark.NodeSignatureShares.refund_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 21: spark.NodeSignatureShares.direct_node_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 22: spark.NodeSignatureShares.direct_refund_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 23: spark.NodeSignatureShares.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.SigningResult
-	19,  // 24: spark.StartTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
-	21,  // 25: spark.StartTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 26: spark.StartTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 27: spark.StartTreeCreationRequest.direct_root_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 28: spark.StartTreeCreationRequest.direct_refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 29: spark.StartTreeCreationRequest.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
-	24,  // 30: spark.StartTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
-	19,  // 31: spark.StartDepositTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
-	21,  // 32: spark.StartDepositTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 33: spark.StartDepositTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 34: spark.StartDepositTreeCreationRequest.direct_root_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 35: spark.StartDepositTreeCreationRequest.direct_refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 36: spark.StartDepositTreeCreationRequest.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
-	24,  // 37: spark.StartDepositTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
-	30,  // 38: spark.TokenTransferInput.outputs_to_spend:type_name -> spark.TokenOutputToSpend
-	32,  // 39: spark.TokenTransaction.mint_input:type_name -> spark.TokenMintInput
-	31,  // 40: spark.TokenTransaction.transfer_input:type_name -> spark.TokenTransferInput
-	33,  // 41: spark.TokenTransaction.create_input:type_name -> spark.TokenCreateInput
-	34,  // 42: spark.TokenTransaction.token_outputs:type_name -> spark.TokenOutput
-	0,   // 43: spark.TokenTransaction.network:type_name -> spark.Network
-	36,  // 44: spark.TokenTransactionConfirmationMetadata.spent_token_outputs_metadata:type_name -> spark.SpentTokenOutputMetadata
-	35,  // 45: spark.TokenTransactionWithStatus.token_transaction:type_name -> spark.TokenTransaction
-	1,   // 46: spark.TokenTransactionWithStatus.status:type_name -> spark.TokenTransactionStatus
-	37,  // 47: spark.TokenTransactionWithStatus.confirmation_metadata:type_name -> spark.TokenTransactionConfirmationMetadata
-	39,  // 48: spark.TokenTransactionSignatures.owner_signatures:type_name -> spark.SignatureWithIndex
-	35,  // 49: spark.StartTokenTransactionRequest.partial_token_transaction:type_name -> spark.TokenTransaction
-	40,  // 50: spark.StartTokenTransactionRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
-	35,  // 51: spark.StartTokenTransactionResponse.final_token_transaction:type_name -> spark.TokenTransaction
-	22,  // 52: spark.StartTokenTransactionResponse.keyshare_info:type_name -> spark.SigningKeyshare
-	39,  // 53: spark.OperatorSpecificOwnerSignature.owner_signature:type_name -> spark.SignatureWithIndex
-	43,  // 54: spark.OperatorSpecificOwnerSignature.payload:type_name -> spark.OperatorSpecificTokenTransactionSignablePayload
-	35,  // 55: spark.SignTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	44,  // 56: spark.SignTokenTransactionRequest.operator_specific_signatures:type_name -> spark.OperatorSpecificOwnerSignature
-	46,  // 57: spark.SignTokenTransactionResponse.revocation_keyshares:type_name -> spark.KeyshareWithIndex
-	35,  // 58: spark.FinalizeTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	48,  // 59: spark.FinalizeTokenTransactionRequest.revocation_secrets:type_name -> spark.RevocationSecretWithIndex
-	50,  // 60: spark.FreezeTokensRequest.freeze_tokens_payload:type_name -> spark.FreezeTokensPayload
-	0,   // 61: spark.QueryTokenOutputsRequest.network:type_name -> spark.Network
-	38,  // 62: spark.QueryTokenTransactionsResponse.token_transactions_with_status:type_name -> spark.TokenTransactionWithStatus
-	34,  // 63: spark.OutputWithPreviousTransactionData.output:type_name -> spark.TokenOutput
-	56,  // 64: spark.QueryTokenOutputsResponse.outputs_with_previous_transaction_data:type_name -> spark.OutputWithPreviousTransactionData
-	22,  // 65: spark.TreeNode.signing_keyshare:type_name -> spark.SigningKeyshare
-	0,   // 66: spark.TreeNode.network:type_name -> spark.Network
-	170, // 67: spark.TreeNode.created_time:type_name -> google.protobuf.Timestamp
-	170, // 68: spark.TreeNode.updated_time:type_name -> google.protobuf.Timestamp
-	171, // 69: spark.FinalizeNodeSignaturesRequest.intent:type_name -> common.SignatureIntent
-	25,  // 70: spark.FinalizeNodeSignaturesRequest.node_signatures:type_name -> spark.NodeSignatures
-	58,  // 71: spark.FinalizeNodeSignaturesResponse.nodes:type_name -> spark.TreeNode
-	21,  // 72: spark.LeafRefundTxSigningJob.refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 73: spark.LeafRefundTxSigningJob.direct_refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 74: spark.LeafRefundTxSigningJob.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
-	169, // 75: spark.UserSignedTxSigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
-	87,  // 76: spark.UserSignedTxSigningJob.signing_commitments:type_name -> spark.SigningCommitments
-	23,  // 77: spark.LeafRefundTxSigningResult.refund_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 78: spark.LeafRefundTxSigningResult.direct_refund_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 79: spark.LeafRefundTxSigningResult.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.SigningResult
-	64,  // 80: spark.StartUserSignedTransferRequest.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	170, // 81: spark.StartUserSignedTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
-	64,  // 82: spark.StartUserSignedTransferRequest.direct_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	64,  // 83: spark.StartUserSignedTransferRequest.direct_from_cpfp_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	63,  // 84: spark.StartTransferRequest.leaves_to_send:type_name -> spark.LeafRefundTxSigningJob
-	170, // 85: spark.StartTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
-	69,  // 86: spark.StartTransferRequest.transfer_package:type_name -> spark.TransferPackage
-	75,  // 87: spark.StartTransferResponse.transfer:type_name -> spark.Transfer
-	65,  // 88: spark.StartTransferResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	64,  // 89: spark.TransferPackage.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	159, // 90: spark.TransferPackage.key_tweak_package:type_name -> spark.TransferPackage.KeyTweakPackageEntry
-	64,  // 91: spark.TransferPackage.direct_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	64,  // 92: spark.TransferPackage.direct_from_cpfp_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
-	71,  // 93: spark.SendLeafKeyTweaks.leaves_to_send:type_name -> spark.SendLeafKeyTweak
-	61,  // 94: spark.SendLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
-	160, // 95: spark.SendLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.SendLeafKeyTweak.PubkeySharesTweakEntry
-	71,  // 96: spark.FinalizeTransferRequest.leaves_to_send:type_name -> spark.SendLeafKeyTweak
-	69,  // 97: spark.FinalizeTransferWithTransferPackageRequest.transfer_package:type_name -> spark.TransferPackage
-	75,  // 98: spark.FinalizeTransferResponse.transfer:type_name -> spark.Transfer
-	2,   // 99: spark.Transfer.status:type_name -> spark.TransferStatus
-	170, // 100: spark.Transfer.expiry_time:type_name -> google.protobuf.Timestamp
-	76,  // 101: spark.Transfer.leaves:type_name -> spark.TransferLeaf
-	170, // 102: spark.Transfer.created_time:type_name -> google.protobuf.Timestamp
-	170, // 103: spark.Transfer.updated_time:type_name -> google.protobuf.Timestamp
-	3,   // 104: spark.Transfer.type:type_name -> spark.TransferType
-	58,  // 105: spark.TransferLeaf.leaf:type_name -> spark.TreeNode
-	3,   // 106: spark.TransferFilter.types:type_name -> spark.TransferType
-	0,   // 107: spark.TransferFilter.network:type_name -> spark.Network
-	2,   // 108: spark.TransferFilter.statuses:type_name -> spark.TransferStatus
-	4,   // 109: spark.TransferFilter.order:type_name -> spark.Order
-	75,  // 110: spark.QueryTransfersResponse.transfers:type_name -> spark.Transfer
-	61,  // 111: spark.ClaimLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
-	161, // 112: spark.ClaimLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.ClaimLeafKeyTweak.PubkeySharesTweakEntry
-	79,  // 113: spark.ClaimTransferTweakKeysRequest.leaves_to_receive:type_name -> spark.ClaimLeafKeyTweak
-	63,  // 114: spark.ClaimTransferSignRefundsRequest.signing_jobs:type_name -> spark.LeafRefundTxSigningJob
-	65,  // 115: spark.ClaimTransferSignRefundsResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	61,  // 116: spark.StorePreimageShareRequest.preimage_share:type_name -> spark.SecretShare
-	162, // 117: spark.RequestedSigningCommitments.signing_nonce_commitments:type_name -> spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry
-	84,  // 118: spark.GetSigningCommitmentsResponse.signing_commitments:type_name -> spark.RequestedSigningCommitments
-	163, // 119: spark.SigningCommitments.signing_commitments:type_name -> spark.SigningCommitments.SigningCommitmentsEntry
-	87,  // 120: spark.UserSignedRefund.signing_commitments:type_name -> spark.SigningCommitments
-	169, // 121: spark.UserSignedRefund.user_signature_commitment:type_name -> common.SigningCommitment
-	0,   // 122: spark.UserSignedRefund.network:type_name -> spark.Network
-	89,  // 123: spark.InvoiceAmount.invoice_amount_proof:type_name -> spark.InvoiceAmountProof
-	90,  // 124: spark.InitiatePreimageSwapRequest.invoice_amount:type_name -> spark.InvoiceAmount
-	7,   // 125: spark.InitiatePreimageSwapRequest.reason:type_name -> spark.InitiatePreimageSwapRequest.Reason
-	66,  // 126: spark.InitiatePreimageSwapRequest.transfer:type_name -> spark.StartUserSignedTransferRequest
-	75,  // 127: spark.InitiatePreimageSwapResponse.transfer:type_name -> spark.Transfer
-	67,  // 128: spark.CooperativeExitRequest.transfer:type_name -> spark.StartTransferRequest
-	75,  // 129: spark.CooperativeExitResponse.transfer:type_name -> spark.Transfer
-	65,  // 130: spark.CooperativeExitResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	67,  // 131: spark.CounterLeafSwapRequest.transfer:type_name -> spark.StartTransferRequest
-	75,  // 132: spark.CounterLeafSwapResponse.transfer:type_name -> spark.Transfer
-	65,  // 133: spark.CounterLeafSwapResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
-	21,  // 134: spark.RefreshTimelockRequest.signing_jobs:type_name -> spark.SigningJob
-	23,  // 135: spark.RefreshTimelockSigningResult.signing_result:type_name -> spark.SigningResult
-	99,  // 136: spark.RefreshTimelockResponse.signing_results:type_name -> spark.RefreshTimelockSigningResult
-	21,  // 137: spark.ExtendLeafRequest.node_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 138: spark.ExtendLeafRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 139: spark.ExtendLeafRequest.direct_node_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 140: spark.ExtendLeafRequest.direct_refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 141: spark.ExtendLeafRequest.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
-	23,  // 142: spark.ExtendLeafSigningResult.signing_result:type_name -> spark.SigningResult
-	102, // 143: spark.ExtendLeafResponse.node_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	102, // 144: spark.ExtendLeafResponse.refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	102, // 145: spark.ExtendLeafResponse.direct_node_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	102, // 146: spark.ExtendLeafResponse.direct_refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	102, // 147: spark.ExtendLeafResponse.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
-	104, // 148: spark.AddressRequestNode.children:type_name -> spark.AddressRequestNode
-	20,  // 149: spark.PrepareTreeAddressRequest.parent_node_output:type_name -> spark.NodeOutput
-	19,  // 150: spark.PrepareTreeAddressRequest.on_chain_utxo:type_name -> spark.UTXO
-	104, // 151: spark.PrepareTreeAddressRequest.node:type_name -> spark.AddressRequestNode
-	15,  // 152: spark.AddressNode.address:type_name -> spark.Address
-	106, // 153: spark.AddressNode.children:type_name -> spark.AddressNode
-	106, // 154: spark.PrepareTreeAddressResponse.node:type_name -> spark.AddressNode
-	21,  // 155: spark.CreationNode.node_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 156: spark.CreationNode.refund_tx_signing_job:type_name -> spark.SigningJob
-	108, // 157: spark.CreationNode.children:type_name -> spark.CreationNode
-	21,  // 158: spark.CreationNode.direct_node_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 159: spark.CreationNode.direct_refund_tx_signing_job:type_name -> spark.SigningJob
-	21,  // 160: spark.CreationNode.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
-	20,  // 161: spark.CreateTreeRequest.parent_node_output:type_name -> spark.NodeOutput
-	19,  // 162: spark.CreateTreeRequest.on_chain_utxo:type_name -> spark.UTXO
-	108, // 163: spark.CreateTreeRequest.node:type_name -> spark.CreationNode
-	23,  // 164: spark.CreationResponseNode.node_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 165: spark.CreationResponseNode.refund_tx_signing_result:type_name -> spark.SigningResult
-	110, // 166: spark.CreationResponseNode.children:type_name -> spark.CreationResponseNode
-	23,  // 167: spark.CreationResponseNode.direct_node_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 168: spark.CreationResponseNode.direct_refund_tx_signing_result:type_name -> spark.SigningResult
-	23,  // 169: spark.CreationResponseNode.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.SigningResult
-	110, // 170: spark.CreateTreeResponse.node:type_name -> spark.CreationResponseNode
-	164, // 171: spark.GetSigningOperatorListResponse.signing_operators:type_name -> spark.GetSigningOperatorListResponse.SigningOperatorsEntry
-	88,  // 172: spark.QueryUserSignedRefundsResponse.user_signed_refunds:type_name -> spark.UserSignedRefund
-	75,  // 173: spark.QueryUserSignedRefundsResponse.transfer:type_name -> spark.Transfer
-	75,  // 174: spark.ProvidePreimageResponse.transfer:type_name -> spark.Transfer
-	119, // 175: spark.QueryNodesRequest.node_ids:type_name -> spark.TreeNodeIds
-	0,   // 176: spark.QueryNodesRequest.network:type_name -> spark.Network
-	165, // 177: spark.QueryNodesResponse.nodes:type_name -> spark.QueryNodesResponse.NodesEntry
-	75,  // 178: spark.CancelTransferResponse.transfer:type_name -> spark.Transfer
-	0,   // 179: spark.QueryUnusedDepositAddressesRequest.network:type_name -> spark.Network
-	0,   // 180: spark.QueryStaticDepositAddressesRequest.network:type_name -> spark.Network
-	13,  // 181: spark.DepositAddressQueryResult.proof_of_possession:type_name -> spark.DepositAddressProof
-	126, // 182: spark.QueryUnusedDepositAddressesResponse.deposit_addresses:type_name -> spark.DepositAddressQueryResult
-	126, // 183: spark.QueryStaticDepositAddressesResponse.deposit_addresses:type_name -> spark.DepositAddressQueryResult
-	0,   // 184: spark.QueryBalanceRequest.network:type_name -> spark.Network
-	166, // 185: spark.QueryBalanceResponse.node_balances:type_name -> spark.QueryBalanceResponse.NodeBalancesEntry
-	132, // 186: spark.SparkAddress.spark_invoice_fields:type_name -> spark.SparkInvoiceFields
-	134, // 187: spark.SparkInvoiceFields.tokens_payment:type_name -> spark.TokensPayment
-	133, // 188: spark.SparkInvoiceFields.sats_payment:type_name -> spark.SatsPayment
-	170, // 189: spark.SparkInvoiceFields.expiry_time:type_name -> google.protobuf.Timestamp
-	19,  // 190: spark.InitiateStaticDepositUtxoRefundRequest.on_chain_utxo:type_name -> spark.UTXO
-	21,  // 191: spark.InitiateStaticDepositUtxoRefundRequest.refund_tx_signing_job:type_name -> spark.SigningJob
-	23,  // 192: spark.InitiateStaticDepositUtxoRefundResponse.refund_tx_signing_result:type_name -> spark.SigningResult
-	126, // 193: spark.InitiateStaticDepositUtxoRefundResponse.deposit_address:type_name -> spark.DepositAddressQueryResult
-	19,  // 194: spark.InitiateUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
-	5,   // 195: spark.InitiateUtxoSwapRequest.request_type:type_name -> spark.UtxoSwapRequestType
-	67,  // 196: spark.InitiateUtxoSwapRequest.transfer:type_name -> spark.StartTransferRequest
-	21,  // 197: spark.InitiateUtxoSwapRequest.spend_tx_signing_job:type_name -> spark.SigningJob
-	23,  // 198: spark.InitiateUtxoSwapResponse.spend_tx_signing_result:type_name -> spark.SigningResult
-	75,  // 199: spark.InitiateUtxoSwapResponse.transfer:type_name -> spark.Transfer
-	126, // 200: spark.InitiateUtxoSwapResponse.deposit_address:type_name -> spark.DepositAddressQueryResult
-	169, // 201: spark.ExitingTree.user_signing_commitment:type_name -> common.SigningCommitment
-	23,  // 202: spark.ExitSingleNodeTreeSigningResult.signing_result:type_name -> spark.SigningResult
-	139, // 203: spark.ExitSingleNodeTreesRequest.exiting_trees:type_name -> spark.ExitingTree
-	141, // 204: spark.ExitSingleNodeTreesRequest.previous_outputs:type_name -> spark.BitcoinTransactionOutput
-	140, // 205: spark.ExitSingleNodeTreesResponse.signing_results:type_name -> spark.ExitSingleNodeTreeSigningResult
-	167, // 206: spark.QueryNodesDistributionResponse.node_distribution:type_name -> spark.QueryNodesDistributionResponse.NodeDistributionEntry
-	168, // 207: spark.QueryNodesByValueResponse.nodes:type_name -> spark.QueryNodesByValueResponse.NodesEntry
-	0,   // 208: spark.GetUtxosForAddressRequest.network:type_name -> spark.Network
-	19,  // 209: spark.GetUtxosForAddressResponse.utxos:type_name -> spark.UTXO
-	153, // 210: spark.QuerySparkInvoicesResponse.invoice_statuses:type_name -> spark.InvoiceResponse
-	6,   // 211: spark.InvoiceResponse.status:type_name -> spark.InvoiceStatus
-	169, // 212: spark.SigningResult.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
-	169, // 213: spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
-	169, // 214: spark.SigningCommitments.SigningCommitmentsEntry.value:type_name -> common.SigningCommitment
-	112, // 215: spark.GetSigningOperatorListResponse.SigningOperatorsEntry.value:type_name -> spark.SigningOperatorInfo
-	58,  // 216: spark.QueryNodesResponse.NodesEntry.value:type_name -> spark.TreeNode
-	58,  // 217: spark.QueryNodesByValueResponse.NodesEntry.value:type_name -> spark.TreeNode
-	14,  // 218: spark.SparkService.generate_deposit_address:input_type -> spark.GenerateDepositAddressRequest
-	17,  // 219: spark.SparkService.generate_static_deposit_address:input_type -> spark.GenerateStaticDepositAddressRequest
-	28,  // 220: spark.SparkService.start_deposit_tree_creation:input_type -> spark.StartDepositTreeCreationRequest
-	26,  // 221: spark.SparkService.start_tree_creation:input_type -> spark.StartTreeCreationRequest
-	59,  // 222: spark.SparkService.finalize_node_signatures:input_type -> spark.FinalizeNodeSignaturesRequest
-	67,  // 223: spark.SparkService.start_transfer:input_type -> spark.StartTransferRequest
-	72,  // 224: spark.SparkService.finalize_transfer:input_type -> spark.FinalizeTransferRequest
-	73,  // 225: spark.SparkService.finalize_transfer_with_transfer_package:input_type -> spark.FinalizeTransferWithTransferPackageRequest
-	122, // 226: spark.SparkService.cancel_transfer:input_type -> spark.CancelTransferRequest
-	77,  // 227: spark.SparkService.query_pending_transfers:input_type -> spark.TransferFilter
-	77,  // 228: spark.SparkService.query_all_transfers:input_type -> spark.TransferFilter
-	80,  // 229: spark.SparkService.claim_transfer_tweak_keys:input_type -> spark.ClaimTransferTweakKeysRequest
-	81,  // 230: spark.SparkService.claim_transfer_sign_refunds:input_type -> spark.ClaimTransferSignRefundsRequest
-	83,  // 231: spark.SparkService.store_preimage_share:input_type -> spark.StorePreimageShareRequest
-	85,  // 232: spark.SparkService.get_signing_commitments:input_type -> spark.GetSigningCommitmentsRequest
-	94,  // 233: spark.SparkService.cooperative_exit:input_type -> spark.CooperativeExitRequest
-	91,  // 234: spark.SparkService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
-	116, // 235: spark.SparkService.provide_preimage:input_type -> spark.ProvidePreimageRequest
-	67,  // 236: spark.SparkService.start_leaf_swap:input_type -> spark.StartTransferRequest
-	96,  // 237: spark.SparkService.leaf_swap:input_type -> spark.CounterLeafSwapRequest
-	96,  // 238: spark.SparkService.counter_leaf_swap:input_type -> spark.CounterLeafSwapRequest
-	98,  // 239: spark.SparkService.refresh_timelock:input_type -> spark.RefreshTimelockRequest
-	101, // 240: spark.SparkService.extend_leaf:input_type -> spark.ExtendLeafRequest
-	172, // 241: spark.SparkService.get_signing_operator_list:input_type -> google.protobuf.Empty
-	120, // 242: spark.SparkService.query_nodes:input_type -> spark.QueryNodesRequest
-	145, // 243: spark.SparkService.query_nodes_distribution:input_type -> spark.QueryNodesDistributionRequest
-	147, // 244: spark.SparkService.query_nodes_by_value:input_type -> spark.QueryNodesByValueRequest
-	129, // 245: spark.SparkService.query_balance:input_type -> spark.QueryBalanceRequest
-	114, // 246: spark.SparkService.query_user_signed_refunds:input_type -> spark.QueryUserSignedRefundsRequest
-	41,  // 247: spark.SparkService.start_token_transaction:input_type -> spark.StartTokenTransactionRequest
-	45,  // 248: spark.SparkService.sign_token_transaction:input_type -> spark.SignTokenTransactionRequest
-	49,  // 249: spark.SparkService.finalize_token_transaction:input_type -> spark.FinalizeTokenTransactionRequest
-	51,  // 250: spark.SparkService.freeze_tokens:input_type -> spark.FreezeTokensRequest
-	53,  // 251: spark.SparkService.query_token_outputs:input_type -> spark.QueryTokenOutputsRequest
-	54,  // 252: spark.SparkService.query_token_transactions:input_type -> spark.QueryTokenTransactionsRequest
-	118, // 253: spark.SparkService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
-	124, // 254: spark.SparkService.query_unused_deposit_addresses:input_type -> spark.QueryUnusedDepositAddressesRequest
-	125, // 255: spark.SparkService.query_static_deposit_addresses:input_type -> spark.QueryStaticDepositAddressesRequest
-	8,   // 256: spark.SparkService.subscribe_to_events:input_type -> spark.SubscribeToEventsRequest
-	135, // 257: spark.SparkService.initiate_static_deposit_utxo_refund:input_type -> spark.InitiateStaticDepositUtxoRefundRequest
-	137, // 258: spark.SparkService.initiate_utxo_swap:input_type -> spark.InitiateUtxoSwapRequest
-	142, // 259: spark.SparkService.exit_single_node_trees:input_type -> spark.ExitSingleNodeTreesRequest
-	94,  // 260: spark.SparkService.cooperative_exit_v2:input_type -> spark.CooperativeExitRequest
-	101, // 261: spark.SparkService.extend_leaf_v2:input_type -> spark.ExtendLeafRequest
-	81,  // 262: spark.SparkService.claim_transfer_sign_refunds_v2:input_type -> spark.ClaimTransferSignRefundsRequest
-	59,  // 263: spark.SparkService.finalize_node_signatures_v2:input_type -> spark.FinalizeNodeSignaturesRequest
-	91,  // 264: spark.SparkService.initiate_preimage_swap_v2:input_type -> spark.InitiatePreimageSwapRequest
-	67,  // 265: spark.SparkService.start_leaf_swap_v2:input_type -> spark.StartTransferRequest
-	96,  // 266: spark.SparkService.counter_leaf_swap_v2:input_type -> spark.CounterLeafSwapRequest
-	67,  // 267: spark.SparkService.start_transfer_v2:input_type -> spark.StartTransferRequest
-	98,  // 268: spark.SparkService.refresh_timelock_v2:input_type -> spark.RefreshTimelockRequest
-	149, // 269: spark.SparkService.get_utxos_for_address:input_type -> spark.GetUtxosForAddressRequest
-	151, // 270: spark.SparkService.query_spark_invoices:input_type -> spark.QuerySparkInvoicesRequest
-	16,  // 271: spark.SparkService.generate_deposit_address:output_type -> spark.GenerateDepositAddressResponse
-	18,  // 272: spark.SparkService.generate_static_deposit_address:output_type -> spark.GenerateStaticDepositAddressResponse
-	29,  // 273: spark.SparkService.start_deposit_tree_creation:output_type -> spark.StartDepositTreeCreationResponse
-	27,  // 274: spark.SparkService.start_tree_creation:output_type -> spark.StartTreeCreationResponse
-	60,  // 275: spark.SparkService.finalize_node_signatures:output_type -> spark.FinalizeNodeSignaturesResponse
-	68,  // 276: spark.SparkService.start_transfer:output_type -> spark.StartTransferResponse
-	74,  // 277: spark.SparkService.finalize_transfer:output_type -> spark.FinalizeTransferResponse
-	74,  // 278: spark.SparkService.finalize_transfer_with_transfer_package:output_type -> spark.FinalizeTransferResponse
-	123, // 279: spark.SparkService.cancel_transfer:output_type -> spark.CancelTransferResponse
-	78,  // 280: spark.SparkService.query_pending_transfers:output_type -> spark.QueryTransfersResponse
-	78,  // 281: spark.SparkService.query_all_transfers:output_type -> spark.QueryTransfersResponse
-	172, // 282: spark.SparkService.claim_transfer_tweak_keys:output_type -> google.protobuf.Empty
-	82,  // 283: spark.SparkService.claim_transfer_sign_refunds:output_type -> spark.ClaimTransferSignRefundsResponse
-	172, // 284: spark.SparkService.store_preimage_share:output_type -> google.protobuf.Empty
-	86,  // 285: spark.SparkService.get_signing_commitments:output_type -> spark.GetSigningCommitmentsResponse
-	95,  // 286: spark.SparkService.cooperative_exit:output_type -> spark.CooperativeExitResponse
-	92,  // 287: spark.SparkService.initiate_preimage_swap:output_type -> spark.InitiatePreimageSwapResponse
-	117, // 288: spark.SparkService.provide_preimage:output_type -> spark.ProvidePreimageResponse
-	68,  // 289: spark.SparkService.start_leaf_swap:output_type -> spark.StartTransferResponse
-	97,  // 290: spark.SparkService.leaf_swap:output_type -> spark.CounterLeafSwapResponse
-	97,  // 291: spark.SparkService.counter_leaf_swap:output_type -> spark.CounterLeafSwapResponse
-	100, // 292: spark.SparkService.refresh_timelock:output_type -> spark.RefreshTimelockResponse
-	103, // 293: spark.SparkService.extend_leaf:output_type -> spark.ExtendLeafResponse
-	113, // 294: spark.SparkService.get_signing_operator_list:output_type -> spark.GetSigningOperatorListResponse
-	121, // 295: spark.SparkService.query_nodes:output_type -> spark.QueryNodesResponse
-	146, // 296: spark.SparkService.query_nodes_distribution:output_type -> spark.QueryNodesDistributionResponse
-	148, // 297: spark.SparkService.query_nodes_by_value:output_type -> spark.QueryNodesByValueResponse
-	130, // 298: spark.SparkService.query_balance:output_type -> spark.QueryBalanceResponse
-	115, // 299: spark.SparkService.query_user_signed_refunds:output_type -> spark.QueryUserSignedRefundsResponse
-	42,  // 300: spark.SparkService.start_token_transaction:output_type -> spark.StartTokenTransactionResponse
-	47,  // 301: spark.SparkService.sign_token_transaction:output_type -> spark.SignTokenTransactionResponse
-	172, // 302: spark.SparkService.finalize_token_transaction:output_type -> google.protobuf.Empty
-	52,  // 303: spark.SparkService.freeze_tokens:output_type -> spark.FreezeTokensResponse
-	57,  // 304: spark.SparkService.query_token_outputs:output_type -> spark.QueryTokenOutputsResponse
-	55,  // 305: spark.SparkService.query_token_transactions:output_type -> spark.QueryTokenTransactionsResponse
-	172, // 306: spark.SparkService.return_lightning_payment:output_type -> google.protobuf.Empty
-	127, // 307: spark.SparkService.query_unused_deposit_addresses:output_type -> spark.QueryUnusedDepositAddressesResponse
-	128, // 308: spark.SparkService.query_static_deposit_addresses:output_type -> spark.QueryStaticDepositAddressesResponse
-	9,   // 309: spark.SparkService.subscribe_to_events:output_type -> spark.SubscribeToEventsResponse
-	136, // 310: spark.SparkService.initiate_static_deposit_utxo_refund:output_type -> spark.InitiateStaticDepositUtxoRefundResponse
-	138, // 311: spark.SparkService.initiate_utxo_swap:output_type -> spark.InitiateUtxoSwapResponse
-	143, // 312: spark.SparkService.exit_single_node_trees:output_type -> spark.ExitSingleNodeTreesResponse
-	95,  // 313: spark.SparkService.cooperative_exit_v2:output_type -> spark.CooperativeExitResponse
-	103, // 314: spark.SparkService.extend_leaf_v2:output_type -> spark.ExtendLeafResponse
-	82,  // 315: spark.SparkService.claim_transfer_sign_refunds_v2:output_type -> spark.ClaimTransferSignRefundsResponse
-	60,  // 316: spark.SparkService.finalize_node_signatures_v2:output_type -> spark.FinalizeNodeSignaturesResponse
-	92,  // 317: spark.SparkService.initiate_preimage_swap_v2:output_type -> spark.InitiatePreimageSwapResponse
-	68,  // 318: spark.SparkService.start_leaf_swap_v2:output_type -> spark.StartTransferResponse
-	97,  // 319: spark.SparkService.counter_leaf_swap_v2:output_type -> spark.CounterLeafSwapResponse
-	68,  // 320: spark.SparkService.start_transfer_v2:output_type -> spark.StartTransferResponse
-	100, // 321: spark.SparkService.refresh_timelock_v2:output_type -> spark.RefreshTimelockResponse
-	150, // 322: spark.SparkService.get_utxos_for_address:output_type -> spark.GetUtxosForAddressResponse
-	152, // 323: spark.SparkService.query_spark_invoices:output_type -> spark.QuerySparkInvoicesResponse
-	271, // [271:324] is the sub-list for method output_type
-	218, // [218:271] is the sub-list for method input_type
-	218, // [218:218] is the sub-list for extension type_name
-	218, // [218:218] is the sub-list for extension extendee
-	0,   // [0:218] is the sub-list for field type_name
+	13,  // 0: spark.SubscribeToEventsResponse.transfer:type_name -> spark.TransferEvent
+	14,  // 1: spark.SubscribeToEventsResponse.deposit:type_name -> spark.DepositEvent
+	12,  // 2: spark.SubscribeToEventsResponse.connected:type_name -> spark.ConnectedEvent
+	15,  // 3: spark.SubscribeToEventsResponse.token_transaction:type_name -> spark.TokenTransactionEvent
+	16,  // 4: spark.SubscribeToEventsResponse.preimage_request:type_name -> spark.PreimageRequestEvent
+	17,  // 5: spark.SubscribeToEventsResponse.cooperative_exit:type_name -> spark.CooperativeExitEvent
+	18,  // 6: spark.SubscribeToEventsResponse.utxo_swap:type_name -> spark.UtxoSwapEvent
+	19,  // 7: spark.SubscribeToEventsResponse.tree_exit:type_name -> spark.TreeExitEvent
+	85,  // 8: spark.TransferEvent.transfer:type_name -> spark.Transfer
+	65,  // 9: spark.DepositEvent.deposit:type_name -> spark.TreeNode
+	41,  // 10: spark.TokenTransactionEvent.received_outputs:type_name -> spark.TokenOutput
+	0,   // 11: spark.PreimageRequestEvent.status:type_name -> spark.PreimageRequestStatus
+	26,  // 12: spark.UtxoSwapEvent.utxo:type_name -> spark.UTXO
+	6,   // 13: spark.UtxoSwapEvent.request_type:type_name -> spark.UtxoSwapRequestType
+	168, // 14: spark.DepositAddressProof.address_signatures:type_name -> spark.DepositAddressProof.AddressSignaturesEntry
+	1,   // 15: spark.GenerateDepositAddressRequest.network:type_name -> spark.Network
+	20,  // 16: spark.Address.deposit_address_proof:type_name -> spark.DepositAddressProof
+	22,  // 17: spark.GenerateDepositAddressResponse.deposit_address:type_name -> spark.Address
+	1,   // 18: spark.GenerateStaticDepositAddressRequest.network:type_name -> spark.Network
+	22,  // 19: spark.GenerateStaticDepositAddressResponse.deposit_address:type_name -> spark.Address
+	1,   // 20: spark.UTXO.network:type_name -> spark.Network
+	183, // 21: spark.SigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
+	169, // 22: spark.SigningKeyshare.public_shares:type_name -> spark.SigningKeyshare.PublicSharesEntry
+	184, // 23: spark.SigningKeyshare.updated_time:type_name -> google.protobuf.Timestamp
+	170, // 24: spark.SigningResult.public_keys:type_name -> spark.SigningResult.PublicKeysEntry
+	171, // 25: spark.SigningResult.signing_nonce_commitments:type_name -> spark.SigningResult.SigningNonceCommitmentsEntry
+	172, // 26: spark.SigningResult.signature_shares:type_name -> spark.SigningResult.SignatureSharesEntry
+	29,  // 27: spark.SigningResult.signing_keyshare:type_name -> spark.SigningKeyshare
+	30,  // 28: spark.NodeSignatureShares.node_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 29: spark.NodeSignatureShares.refund_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 30: spark.NodeSignatureShares.direct_node_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 31: spark.NodeSignatureShares.direct_refund_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 32: spark.NodeSignatureShares.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.SigningResult
+	26,  // 33: spark.StartTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
+	28,  // 34: spark.StartTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 35: spark.StartTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 36: spark.StartTreeCreationRequest.direct_root_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 37: spark.StartTreeCreationRequest.direct_refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 38: spark.StartTreeCreationRequest.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
+	31,  // 39: spark.StartTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
+	26,  // 40: spark.StartDepositTreeCreationRequest.on_chain_utxo:type_name -> spark.UTXO
+	28,  // 41: spark.StartDepositTreeCreationRequest.root_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 42: spark.StartDepositTreeCreationRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 43: spark.StartDepositTreeCreationRequest.direct_root_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 44: spark.StartDepositTreeCreationRequest.direct_refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 45: spark.StartDepositTreeCreationRequest.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
+	31,  // 46: spark.StartDepositTreeCreationResponse.root_node_signature_shares:type_name -> spark.NodeSignatureShares
+	37,  // 47: spark.TokenTransferInput.outputs_to_spend:type_name -> spark.TokenOutputToSpend
+	184, // 48: spark.TokenOutput.spendable_after:type_name -> google.protobuf.Timestamp
+	39,  // 49: spark.TokenTransaction.mint_input:type_name -> spark.TokenMintInput
+	38,  // 50: spark.TokenTransaction.transfer_input:type_name -> spark.TokenTransferInput
+	40,  // 51: spark.TokenTransaction.create_input:type_name -> spark.TokenCreateInput
+	41,  // 52: spark.TokenTransaction.token_outputs:type_name -> spark.TokenOutput
+	1,   // 53: spark.TokenTransaction.network:type_name -> spark.Network
+	43,  // 54: spark.TokenTransactionConfirmationMetadata.spent_token_outputs_metadata:type_name -> spark.SpentTokenOutputMetadata
+	42,  // 55: spark.TokenTransactionWithStatus.token_transaction:type_name -> spark.TokenTransaction
+	2,   // 56: spark.TokenTransactionWithStatus.status:type_name -> spark.TokenTransactionStatus
+	44,  // 57: spark.TokenTransactionWithStatus.confirmation_metadata:type_name -> spark.TokenTransactionConfirmationMetadata
+	46,  // 58: spark.TokenTransactionSignatures.owner_signatures:type_name -> spark.SignatureWithIndex
+	42,  // 59: spark.StartTokenTransactionRequest.partial_token_transaction:type_name -> spark.TokenTransaction
+	47,  // 60: spark.StartTokenTransactionRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
+	42,  // 61: spark.StartTokenTransactionResponse.final_token_transaction:type_name -> spark.TokenTransaction
+	29,  // 62: spark.StartTokenTransactionResponse.keyshare_info:type_name -> spark.SigningKeyshare
+	46,  // 63: spark.OperatorSpecificOwnerSignature.owner_signature:type_name -> spark.SignatureWithIndex
+	50,  // 64: spark.OperatorSpecificOwnerSignature.payload:type_name -> spark.OperatorSpecificTokenTransactionSignablePayload
+	42,  // 65: spark.SignTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	51,  // 66: spark.SignTokenTransactionRequest.operator_specific_signatures:type_name -> spark.OperatorSpecificOwnerSignature
+	53,  // 67: spark.SignTokenTransactionResponse.revocation_keyshares:type_name -> spark.KeyshareWithIndex
+	42,  // 68: spark.FinalizeTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	55,  // 69: spark.FinalizeTokenTransactionRequest.revocation_secrets:type_name -> spark.RevocationSecretWithIndex
+	57,  // 70: spark.FreezeTokensRequest.freeze_tokens_payload:type_name -> spark.FreezeTokensPayload
+	1,   // 71: spark.QueryTokenOutputsRequest.network:type_name -> spark.Network
+	45,  // 72: spark.QueryTokenTransactionsResponse.token_transactions_with_status:type_name -> spark.TokenTransactionWithStatus
+	41,  // 73: spark.OutputWithPreviousTransactionData.output:type_name -> spark.TokenOutput
+	63,  // 74: spark.QueryTokenOutputsResponse.outputs_with_previous_transaction_data:type_name -> spark.OutputWithPreviousTransactionData
+	29,  // 75: spark.TreeNode.signing_keyshare:type_name -> spark.SigningKeyshare
+	1,   // 76: spark.TreeNode.network:type_name -> spark.Network
+	184, // 77: spark.TreeNode.created_time:type_name -> google.protobuf.Timestamp
+	184, // 78: spark.TreeNode.updated_time:type_name -> google.protobuf.Timestamp
+	185, // 79: spark.FinalizeNodeSignaturesRequest.intent:type_name -> common.SignatureIntent
+	32,  // 80: spark.FinalizeNodeSignaturesRequest.node_signatures:type_name -> spark.NodeSignatures
+	65,  // 81: spark.FinalizeNodeSignaturesResponse.nodes:type_name -> spark.TreeNode
+	28,  // 82: spark.LeafRefundTxSigningJob.refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 83: spark.LeafRefundTxSigningJob.direct_refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 84: spark.LeafRefundTxSigningJob.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
+	183, // 85: spark.UserSignedTxSigningJob.signing_nonce_commitment:type_name -> common.SigningCommitment
+	97,  // 86: spark.UserSignedTxSigningJob.signing_commitments:type_name -> spark.SigningCommitments
+	30,  // 87: spark.LeafRefundTxSigningResult.refund_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 88: spark.LeafRefundTxSigningResult.direct_refund_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 89: spark.LeafRefundTxSigningResult.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.SigningResult
+	71,  // 90: spark.StartUserSignedTransferRequest.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	184, // 91: spark.StartUserSignedTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	71,  // 92: spark.StartUserSignedTransferRequest.direct_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	71,  // 93: spark.StartUserSignedTransferRequest.direct_from_cpfp_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	70,  // 94: spark.StartTransferRequest.leaves_to_send:type_name -> spark.LeafRefundTxSigningJob
+	184, // 95: spark.StartTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	79,  // 96: spark.StartTransferRequest.transfer_package:type_name -> spark.TransferPackage
+	85,  // 97: spark.StartTransferResponse.transfer:type_name -> spark.Transfer
+	72,  // 98: spark.StartTransferResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	74,  // 99: spark.StartBatchTransferRequest.transfers:type_name -> spark.StartTransferRequest
+	75,  // 100: spark.StartBatchTransferResponse.transfers:type_name -> spark.StartTransferResponse
+	74,  // 101: spark.StartHtlcTransferRequest.transfer:type_name -> spark.StartTransferRequest
+	71,  // 102: spark.TransferPackage.leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	173, // 103: spark.TransferPackage.key_tweak_package:type_name -> spark.TransferPackage.KeyTweakPackageEntry
+	71,  // 104: spark.TransferPackage.direct_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	71,  // 105: spark.TransferPackage.direct_from_cpfp_leaves_to_send:type_name -> spark.UserSignedTxSigningJob
+	81,  // 106: spark.SendLeafKeyTweaks.leaves_to_send:type_name -> spark.SendLeafKeyTweak
+	68,  // 107: spark.SendLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
+	174, // 108: spark.SendLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.SendLeafKeyTweak.PubkeySharesTweakEntry
+	81,  // 109: spark.FinalizeTransferRequest.leaves_to_send:type_name -> spark.SendLeafKeyTweak
+	79,  // 110: spark.FinalizeTransferWithTransferPackageRequest.transfer_package:type_name -> spark.TransferPackage
+	85,  // 111: spark.FinalizeTransferResponse.transfer:type_name -> spark.Transfer
+	3,   // 112: spark.Transfer.status:type_name -> spark.TransferStatus
+	184, // 113: spark.Transfer.expiry_time:type_name -> google.protobuf.Timestamp
+	86,  // 114: spark.Transfer.leaves:type_name -> spark.TransferLeaf
+	184, // 115: spark.Transfer.created_time:type_name -> google.protobuf.Timestamp
+	184, // 116: spark.Transfer.updated_time:type_name -> google.protobuf.Timestamp
+	4,   // 117: spark.Transfer.type:type_name -> spark.TransferType
+	65,  // 118: spark.TransferLeaf.leaf:type_name -> spark.TreeNode
+	4,   // 119: spark.TransferFilter.types:type_name -> spark.TransferType
+	1,   // 120: spark.TransferFilter.network:type_name -> spark.Network
+	3,   // 121: spark.TransferFilter.statuses:type_name -> spark.TransferStatus
+	5,   // 122: spark.TransferFilter.order:type_name -> spark.Order
+	85,  // 123: spark.QueryTransfersResponse.transfers:type_name -> spark.Transfer
+	68,  // 124: spark.ClaimLeafKeyTweak.secret_share_tweak:type_name -> spark.SecretShare
+	175, // 125: spark.ClaimLeafKeyTweak.pubkey_shares_tweak:type_name -> spark.ClaimLeafKeyTweak.PubkeySharesTweakEntry
+	89,  // 126: spark.ClaimTransferTweakKeysRequest.leaves_to_receive:type_name -> spark.ClaimLeafKeyTweak
+	70,  // 127: spark.ClaimTransferSignRefundsRequest.signing_jobs:type_name -> spark.LeafRefundTxSigningJob
+	72,  // 128: spark.ClaimTransferSignRefundsResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	68,  // 129: spark.StorePreimageShareRequest.preimage_share:type_name -> spark.SecretShare
+	176, // 130: spark.RequestedSigningCommitments.signing_nonce_commitments:type_name -> spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry
+	94,  // 131: spark.GetSigningCommitmentsResponse.signing_commitments:type_name -> spark.RequestedSigningCommitments
+	177, // 132: spark.SigningCommitments.signing_commitments:type_name -> spark.SigningCommitments.SigningCommitmentsEntry
+	97,  // 133: spark.UserSignedRefund.signing_commitments:type_name -> spark.SigningCommitments
+	183, // 134: spark.UserSignedRefund.user_signature_commitment:type_name -> common.SigningCommitment
+	1,   // 135: spark.UserSignedRefund.network:type_name -> spark.Network
+	99,  // 136: spark.InvoiceAmount.invoice_amount_proof:type_name -> spark.InvoiceAmountProof
+	100, // 137: spark.InitiatePreimageSwapRequest.invoice_amount:type_name -> spark.InvoiceAmount
+	9,   // 138: spark.InitiatePreimageSwapRequest.reason:type_name -> spark.InitiatePreimageSwapRequest.Reason
+	73,  // 139: spark.InitiatePreimageSwapRequest.transfer:type_name -> spark.StartUserSignedTransferRequest
+	85,  // 140: spark.InitiatePreimageSwapResponse.transfer:type_name -> spark.Transfer
+	74,  // 141: spark.CooperativeExitRequest.transfer:type_name -> spark.StartTransferRequest
+	85,  // 142: spark.CooperativeExitResponse.transfer:type_name -> spark.Transfer
+	72,  // 143: spark.CooperativeExitResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	74,  // 144: spark.CounterLeafSwapRequest.transfer:type_name -> spark.StartTransferRequest
+	85,  // 145: spark.CounterLeafSwapResponse.transfer:type_name -> spark.Transfer
+	72,  // 146: spark.CounterLeafSwapResponse.signing_results:type_name -> spark.LeafRefundTxSigningResult
+	28,  // 147: spark.RefreshTimelockRequest.signing_jobs:type_name -> spark.SigningJob
+	30,  // 148: spark.RefreshTimelockSigningResult.signing_result:type_name -> spark.SigningResult
+	109, // 149: spark.RefreshTimelockResponse.signing_results:type_name -> spark.RefreshTimelockSigningResult
+	28,  // 150: spark.ExtendLeafRequest.node_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 151: spark.ExtendLeafRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 152: spark.ExtendLeafRequest.direct_node_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 153: spark.ExtendLeafRequest.direct_refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 154: spark.ExtendLeafRequest.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
+	30,  // 155: spark.ExtendLeafSigningResult.signing_result:type_name -> spark.SigningResult
+	112, // 156: spark.ExtendLeafResponse.node_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	112, // 157: spark.ExtendLeafResponse.refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	112, // 158: spark.ExtendLeafResponse.direct_node_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	112, // 159: spark.ExtendLeafResponse.direct_refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	112, // 160: spark.ExtendLeafResponse.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.ExtendLeafSigningResult
+	114, // 161: spark.AddressRequestNode.children:type_name -> spark.AddressRequestNode
+	27,  // 162: spark.PrepareTreeAddressRequest.parent_node_output:type_name -> spark.NodeOutput
+	26,  // 163: spark.PrepareTreeAddressRequest.on_chain_utxo:type_name -> spark.UTXO
+	114, // 164: spark.PrepareTreeAddressRequest.node:type_name -> spark.AddressRequestNode
+	22,  // 165: spark.AddressNode.address:type_name -> spark.Address
+	116, // 166: spark.AddressNode.children:type_name -> spark.AddressNode
+	116, // 167: spark.PrepareTreeAddressResponse.node:type_name -> spark.AddressNode
+	28,  // 168: spark.CreationNode.node_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 169: spark.CreationNode.refund_tx_signing_job:type_name -> spark.SigningJob
+	118, // 170: spark.CreationNode.children:type_name -> spark.CreationNode
+	28,  // 171: spark.CreationNode.direct_node_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 172: spark.CreationNode.direct_refund_tx_signing_job:type_name -> spark.SigningJob
+	28,  // 173: spark.CreationNode.direct_from_cpfp_refund_tx_signing_job:type_name -> spark.SigningJob
+	27,  // 174: spark.CreateTreeRequest.parent_node_output:type_name -> spark.NodeOutput
+	26,  // 175: spark.CreateTreeRequest.on_chain_utxo:type_name -> spark.UTXO
+	118, // 176: spark.CreateTreeRequest.node:type_name -> spark.CreationNode
+	30,  // 177: spark.CreationResponseNode.node_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 178: spark.CreationResponseNode.refund_tx_signing_result:type_name -> spark.SigningResult
+	120, // 179: spark.CreationResponseNode.children:type_name -> spark.CreationResponseNode
+	30,  // 180: spark.CreationResponseNode.direct_node_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 181: spark.CreationResponseNode.direct_refund_tx_signing_result:type_name -> spark.SigningResult
+	30,  // 182: spark.CreationResponseNode.direct_from_cpfp_refund_tx_signing_result:type_name -> spark.SigningResult
+	120, // 183: spark.CreateTreeResponse.node:type_name -> spark.CreationResponseNode
+	178, // 184: spark.GetSigningOperatorListResponse.signing_operators:type_name -> spark.GetSigningOperatorListResponse.SigningOperatorsEntry
+	98,  // 185: spark.QueryUserSignedRefundsResponse.user_signed_refunds:type_name -> spark.UserSignedRefund
+	85,  // 186: spark.QueryUserSignedRefundsResponse.transfer:type_name -> spark.Transfer
+	85,  // 187: spark.ProvidePreimageResponse.transfer:type_name -> spark.Transfer
+	129, // 188: spark.QueryNodesRequest.node_ids:type_name -> spark.TreeNodeIds
+	1,   // 189: spark.QueryNodesRequest.network:type_name -> spark.Network
+	179, // 190: spark.QueryNodesResponse.nodes:type_name -> spark.QueryNodesResponse.NodesEntry
+	85,  // 191: spark.CancelTransferResponse.transfer:type_name -> spark.Transfer
+	1,   // 192: spark.QueryUnusedDepositAddressesRequest.network:type_name -> spark.Network
+	1,   // 193: spark.QueryStaticDepositAddressesRequest.network:type_name -> spark.Network
+	20,  // 194: spark.DepositAddressQueryResult.proof_of_possession:type_name -> spark.DepositAddressProof
+	136, // 195: spark.QueryUnusedDepositAddressesResponse.deposit_addresses:type_name -> spark.DepositAddressQueryResult
+	136, // 196: spark.QueryStaticDepositAddressesResponse.deposit_addresses:type_name -> spark.DepositAddressQueryResult
+	1,   // 197: spark.QueryBalanceRequest.network:type_name -> spark.Network
+	180, // 198: spark.QueryBalanceResponse.node_balances:type_name -> spark.QueryBalanceResponse.NodeBalancesEntry
+	142, // 199: spark.SparkAddress.spark_invoice_fields:type_name -> spark.SparkInvoiceFields
+	144, // 200: spark.SparkInvoiceFields.tokens_payment:type_name -> spark.TokensPayment
+	143, // 201: spark.SparkInvoiceFields.sats_payment:type_name -> spark.SatsPayment
+	184, // 202: spark.SparkInvoiceFields.expiry_time:type_name -> google.protobuf.Timestamp
+	26,  // 203: spark.InitiateStaticDepositUtxoRefundRequest.on_chain_utxo:type_name -> spark.UTXO
+	28,  // 204: spark.InitiateStaticDepositUtxoRefundRequest.refund_tx_signing_job:type_name -> spark.SigningJob
+	30,  // 205: spark.InitiateStaticDepositUtxoRefundResponse.refund_tx_signing_result:type_name -> spark.SigningResult
+	136, // 206: spark.InitiateStaticDepositUtxoRefundResponse.deposit_address:type_name -> spark.DepositAddressQueryResult
+	26,  // 207: spark.InitiateUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
+	6,   // 208: spark.InitiateUtxoSwapRequest.request_type:type_name -> spark.UtxoSwapRequestType
+	74,  // 209: spark.InitiateUtxoSwapRequest.transfer:type_name -> spark.StartTransferRequest
+	28,  // 210: spark.InitiateUtxoSwapRequest.spend_tx_signing_job:type_name -> spark.SigningJob
+	30,  // 211: spark.InitiateUtxoSwapResponse.spend_tx_signing_result:type_name -> spark.SigningResult
+	85,  // 212: spark.InitiateUtxoSwapResponse.transfer:type_name -> spark.Transfer
+	136, // 213: spark.InitiateUtxoSwapResponse.deposit_address:type_name -> spark.DepositAddressQueryResult
+	183, // 214: spark.ExitingTree.user_signing_commitment:type_name -> common.SigningCommitment
+	30,  // 215: spark.ExitSingleNodeTreeSigningResult.signing_result:type_name -> spark.SigningResult
+	149, // 216: spark.ExitSingleNodeTreesRequest.exiting_trees:type_name -> spark.ExitingTree
+	151, // 217: spark.ExitSingleNodeTreesRequest.previous_outputs:type_name -> spark.BitcoinTransactionOutput
+	150, // 218: spark.ExitSingleNodeTreesResponse.signing_results:type_name -> spark.ExitSingleNodeTreeSigningResult
+	181, // 219: spark.QueryNodesDistributionResponse.node_distribution:type_name -> spark.QueryNodesDistributionResponse.NodeDistributionEntry
+	182, // 220: spark.QueryNodesByValueResponse.nodes:type_name -> spark.QueryNodesByValueResponse.NodesEntry
+	1,   // 221: spark.GetUtxosForAddressRequest.network:type_name -> spark.Network
+	26,  // 222: spark.GetUtxosForAddressResponse.utxos:type_name -> spark.UTXO
+	7,   // 223: spark.UnilateralExitStep.type:type_name -> spark.UnilateralExitStepType
+	162, // 224: spark.UnilateralExitStep.cpfp_tx:type_name -> spark.UnilateralExitTransaction
+	162, // 225: spark.UnilateralExitStep.direct_tx:type_name -> spark.UnilateralExitTransaction
+	162, // 226: spark.UnilateralExitStep.direct_from_cpfp_tx:type_name -> spark.UnilateralExitTransaction
+	1,   // 227: spark.QueryUnilateralExitKitResponse.network:type_name -> spark.Network
+	163, // 228: spark.QueryUnilateralExitKitResponse.steps:type_name -> spark.UnilateralExitStep
+	167, // 229: spark.QuerySparkInvoicesResponse.invoice_statuses:type_name -> spark.InvoiceResponse
+	8,   // 230: spark.InvoiceResponse.status:type_name -> spark.InvoiceStatus
+	183, // 231: spark.SigningResult.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
+	183, // 232: spark.RequestedSigningCommitments.SigningNonceCommitmentsEntry.value:type_name -> common.SigningCommitment
+	183, // 233: spark.SigningCommitments.SigningCommitmentsEntry.value:type_name -> common.SigningCommitment
+	122, // 234: spark.GetSigningOperatorListResponse.SigningOperatorsEntry.value:type_name -> spark.SigningOperatorInfo
+	65,  // 235: spark.QueryNodesResponse.NodesEntry.value:type_name -> spark.TreeNode
+	65,  // 236: spark.QueryNodesByValueResponse.NodesEntry.value:type_name -> spark.TreeNode
+	21,  // 237: spark.SparkService.generate_deposit_address:input_type -> spark.GenerateDepositAddressRequest
+	24,  // 238: spark.SparkService.generate_static_deposit_address:input_type -> spark.GenerateStaticDepositAddressRequest
+	35,  // 239: spark.SparkService.start_deposit_tree_creation:input_type -> spark.StartDepositTreeCreationRequest
+	33,  // 240: spark.SparkService.start_tree_creation:input_type -> spark.StartTreeCreationRequest
+	66,  // 241: spark.SparkService.finalize_node_signatures:input_type -> spark.FinalizeNodeSignaturesRequest
+	74,  // 242: spark.SparkService.start_transfer:input_type -> spark.StartTransferRequest
+	82,  // 243: spark.SparkService.finalize_transfer:input_type -> spark.FinalizeTransferRequest
+	83,  // 244: spark.SparkService.finalize_transfer_with_transfer_package:input_type -> spark.FinalizeTransferWithTransferPackageRequest
+	132, // 245: spark.SparkService.cancel_transfer:input_type -> spark.CancelTransferRequest
+	87,  // 246: spark.SparkService.query_pending_transfers:input_type -> spark.TransferFilter
+	87,  // 247: spark.SparkService.query_all_transfers:input_type -> spark.TransferFilter
+	90,  // 248: spark.SparkService.claim_transfer_tweak_keys:input_type -> spark.ClaimTransferTweakKeysRequest
+	91,  // 249: spark.SparkService.claim_transfer_sign_refunds:input_type -> spark.ClaimTransferSignRefundsRequest
+	93,  // 250: spark.SparkService.store_preimage_share:input_type -> spark.StorePreimageShareRequest
+	95,  // 251: spark.SparkService.get_signing_commitments:input_type -> spark.GetSigningCommitmentsRequest
+	104, // 252: spark.SparkService.cooperative_exit:input_type -> spark.CooperativeExitRequest
+	101, // 253: spark.SparkService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
+	126, // 254: spark.SparkService.provide_preimage:input_type -> spark.ProvidePreimageRequest
+	74,  // 255: spark.SparkService.start_leaf_swap:input_type -> spark.StartTransferRequest
+	106, // 256: spark.SparkService.leaf_swap:input_type -> spark.CounterLeafSwapRequest
+	106, // 257: spark.SparkService.counter_leaf_swap:input_type -> spark.CounterLeafSwapRequest
+	108, // 258: spark.SparkService.refresh_timelock:input_type -> spark.RefreshTimelockRequest
+	111, // 259: spark.SparkService.extend_leaf:input_type -> spark.ExtendLeafRequest
+	186, // 260: spark.SparkService.get_signing_operator_list:input_type -> google.protobuf.Empty
+	130, // 261: spark.SparkService.query_nodes:input_type -> spark.QueryNodesRequest
+	155, // 262: spark.SparkService.query_nodes_distribution:input_type -> spark.QueryNodesDistributionRequest
+	157, // 263: spark.SparkService.query_nodes_by_value:input_type -> spark.QueryNodesByValueRequest
+	139, // 264: spark.SparkService.query_balance:input_type -> spark.QueryBalanceRequest
+	124, // 265: spark.SparkService.query_user_signed_refunds:input_type -> spark.QueryUserSignedRefundsRequest
+	48,  // 266: spark.SparkService.start_token_transaction:input_type -> spark.StartTokenTransactionRequest
+	52,  // 267: spark.SparkService.sign_token_transaction:input_type -> spark.SignTokenTransactionRequest
+	56,  // 268: spark.SparkService.finalize_token_transaction:input_type -> spark.FinalizeTokenTransactionRequest
+	58,  // 269: spark.SparkService.freeze_tokens:input_type -> spark.FreezeTokensRequest
+	60,  // 270: spark.SparkService.query_token_outputs:input_type -> spark.QueryTokenOutputsRequest
+	61,  // 271: spark.SparkService.query_token_transactions:input_type -> spark.QueryTokenTransactionsRequest
+	128, // 272: spark.SparkService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
+	134, // 273: spark.SparkService.query_unused_deposit_addresses:input_type -> spark.QueryUnusedDepositAddressesRequest
+	135, // 274: spark.SparkService.query_static_deposit_addresses:input_type -> spark.QueryStaticDepositAddressesRequest
+	10,  // 275: spark.SparkService.subscribe_to_events:input_type -> spark.SubscribeToEventsRequest
+	145, // 276: spark.SparkService.initiate_static_deposit_utxo_refund:input_type -> spark.InitiateStaticDepositUtxoRefundRequest
+	147, // 277: spark.SparkService.initiate_utxo_swap:input_type -> spark.InitiateUtxoSwapRequest
+	152, // 278: spark.SparkService.exit_single_node_trees:input_type -> spark.ExitSingleNodeTreesRequest
+	104, // 279: spark.SparkService.cooperative_exit_v2:input_type -> spark.CooperativeExitRequest
+	111, // 280: spark.SparkService.extend_leaf_v2:input_type -> spark.ExtendLeafRequest
+	91,  // 281: spark.SparkService.claim_transfer_sign_refunds_v2:input_type -> spark.ClaimTransferSignRefundsRequest
+	66,  // 282: spark.SparkService.finalize_node_signatures_v2:input_type -> spark.FinalizeNodeSignaturesRequest
+	101, // 283: spark.SparkService.initiate_preimage_swap_v2:input_type -> spark.InitiatePreimageSwapRequest
+	74,  // 284: spark.SparkService.start_leaf_swap_v2:input_type -> spark.StartTransferRequest
+	106, // 285: spark.SparkService.counter_leaf_swap_v2:input_type -> spark.CounterLeafSwapRequest
+	74,  // 286: spark.SparkService.start_transfer_v2:input_type -> spark.StartTransferRequest
+	108, // 287: spark.SparkService.refresh_timelock_v2:input_type -> spark.RefreshTimelockRequest
+	159, // 288: spark.SparkService.get_utxos_for_address:input_type -> spark.GetUtxosForAddressRequest
+	165, // 289: spark.SparkService.query_spark_invoices:input_type -> spark.QuerySparkInvoicesRequest
+	76,  // 290: spark.SparkService.start_batch_transfer:input_type -> spark.StartBatchTransferRequest
+	78,  // 291: spark.SparkService.start_htlc_transfer:input_type -> spark.StartHtlcTransferRequest
+	161, // 292: spark.SparkService.query_unilateral_exit_kit:input_type -> spark.QueryUnilateralExitKitRequest
+	23,  // 293: spark.SparkService.generate_deposit_address:output_type -> spark.GenerateDepositAddressResponse
+	25,  // 294: spark.SparkService.generate_static_deposit_address:output_type -> spark.GenerateStaticDepositAddressResponse
+	36,  // 295: spark.SparkService.start_deposit_tree_creation:output_type -> spark.StartDepositTreeCreationResponse
+	34,  // 296: spark.SparkService.start_tree_creation:output_type -> spark.StartTreeCreationResponse
+	67,  // 297: spark.SparkService.finalize_node_signatures:output_type -> spark.FinalizeNodeSignaturesResponse
+	75,  // 298: spark.SparkService.start_transfer:output_type -> spark.StartTransferResponse
+	84,  // 299: spark.SparkService.finalize_transfer:output_type -> spark.FinalizeTransferResponse
+	84,  // 300: spark.SparkService.finalize_transfer_with_transfer_package:output_type -> spark.FinalizeTransferResponse
+	133, // 301: spark.SparkService.cancel_transfer:output_type -> spark.CancelTransferResponse
+	88,  // 302: spark.SparkService.query_pending_transfers:output_type -> spark.QueryTransfersResponse
+	88,  // 303: spark.SparkService.query_all_transfers:output_type -> spark.QueryTransfersResponse
+	186, // 304: spark.SparkService.claim_transfer_tweak_keys:output_type -> google.protobuf.Empty
+	92,  // 305: spark.SparkService.claim_transfer_sign_refunds:output_type -> spark.ClaimTransferSignRefundsResponse
+	186, // 306: spark.SparkService.store_preimage_share:output_type -> google.protobuf.Empty
+	96,  // 307: spark.SparkService.get_signing_commitments:output_type -> spark.GetSigningCommitmentsResponse
+	105, // 308: spark.SparkService.cooperative_exit:output_type -> spark.CooperativeExitResponse
+	102, // 309: spark.SparkService.initiate_preimage_swap:output_type -> spark.InitiatePreimageSwapResponse
+	127, // 310: spark.SparkService.provide_preimage:output_type -> spark.ProvidePreimageResponse
+	75,  // 311: spark.SparkService.start_leaf_swap:output_type -> spark.StartTransferResponse
+	107, // 312: spark.SparkService.leaf_swap:output_type -> spark.CounterLeafSwapResponse
+	107, // 313: spark.SparkService.counter_leaf_swap:output_type -> spark.CounterLeafSwapResponse
+	110, // 314: spark.SparkService.refresh_timelock:output_type -> spark.RefreshTimelockResponse
+	113, // 315: spark.SparkService.extend_leaf:output_type -> spark.ExtendLeafResponse
+	123, // 316: spark.SparkService.get_signing_operator_list:output_type -> spark.GetSigningOperatorListResponse
+	131, // 317: spark.SparkService.query_nodes:output_type -> spark.QueryNodesResponse
+	156, // 318: spark.SparkService.query_nodes_distribution:output_type -> spark.QueryNodesDistributionResponse
+	158, // 319: spark.SparkService.query_nodes_by_value:output_type -> spark.QueryNodesByValueResponse
+	140, // 320: spark.SparkService.query_balance:output_type -> spark.QueryBalanceResponse
+	125, // 321: spark.SparkService.query_user_signed_refunds:output_type -> spark.QueryUserSignedRefundsResponse
+	49,  // 322: spark.SparkService.start_token_transaction:output_type -> spark.StartTokenTransactionResponse
+	54,  // 323: spark.SparkService.sign_token_transaction:output_type -> spark.SignTokenTransactionResponse
+	186, // 324: spark.SparkService.finalize_token_transaction:output_type -> google.protobuf.Empty
+	59,  // 325: spark.SparkService.freeze_tokens:output_type -> spark.FreezeTokensResponse
+	64,  // 326: spark.SparkService.query_token_outputs:output_type -> spark.QueryTokenOutputsResponse
+	62,  // 327: spark.SparkService.query_token_transactions:output_type -> spark.QueryTokenTransactionsResponse
+	186, // 328: spark.SparkService.return_lightning_payment:output_type -> google.protobuf.Empty
+	137, // 329: spark.SparkService.query_unused_deposit_addresses:output_type -> spark.QueryUnusedDepositAddressesResponse
+	138, // 330: spark.SparkService.query_static_deposit_addresses:output_type -> spark.QueryStaticDepositAddressesResponse
+	11,  // 331: spark.SparkService.subscribe_to_events:output_type -> spark.SubscribeToEventsResponse
+	146, // 332: spark.SparkService.initiate_static_deposit_utxo_refund:output_type -> spark.InitiateStaticDepositUtxoRefundResponse
+	148, // 333: spark.SparkService.initiate_utxo_swap:output_type -> spark.InitiateUtxoSwapResponse
+	153, // 334: spark.SparkService.exit_single_node_trees:output_type -> spark.ExitSingleNodeTreesResponse
+	105, // 335: spark.SparkService.cooperative_exit_v2:output_type -> spark.CooperativeExitResponse
+	113, // 336: spark.SparkService.extend_leaf_v2:output_type -> spark.ExtendLeafResponse
+	92,  // 337: spark.SparkService.claim_transfer_sign_refunds_v2:output_type -> spark.ClaimTransferSignRefundsResponse
+	67,  // 338: spark.SparkService.finalize_node_signatures_v2:output_type -> spark.FinalizeNodeSignaturesResponse
+	102, // 339: spark.SparkService.initiate_preimage_swap_v2:output_type -> spark.InitiatePreimageSwapResponse
+	75,  // 340: spark.SparkService.start_leaf_swap_v2:output_type -> spark.StartTransferResponse
+	107, // 341: spark.SparkService.counter_leaf_swap_v2:output_type -> spark.CounterLeafSwapResponse
+	75,  // 342: spark.SparkService.start_transfer_v2:output_type -> spark.StartTransferResponse
+	110, // 343: spark.SparkService.refresh_timelock_v2:output_type -> spark.RefreshTimelockResponse
+	160, // 344: spark.SparkService.get_utxos_for_address:output_type -> spark.GetUtxosForAddressResponse
+	166, // 345: spark.SparkService.query_spark_invoices:output_type -> spark.QuerySparkInvoicesResponse
+	77,  // 346: spark.SparkService.start_batch_transfer:output_type -> spark.StartBatchTransferResponse
+	75,  // 347: spark.SparkService.start_htlc_transfer:output_type -> spark.StartTransferResponse
+	164, // 348: spark.SparkService.query_unilateral_exit_kit:output_type -> spark.QueryUnilateralExitKitResponse
+	293, // [293:349] is the sub-list for method output_type
+	237, // [237:293] is the sub-list for method input_type
+	237, // [237:237] is the sub-list for extension type_name
+	237, // [237:237] is the sub-list for extension extendee
+	0,   // [0:237] is the sub-list for field type_name
 }
 
 func init() { file_spark_proto_init() }
@@ -11438,59 +12503,67 @@ func file_spark_proto_init() {
 	if File_spark_proto != nil {
 		return
 	}
+	file_spark_proto_msgTypes[0].OneofWrappers = []any{}
 	file_spark_proto_msgTypes[1].OneofWrappers = []any{
 		(*SubscribeToEventsResponse_Transfer)(nil),
 		(*SubscribeToEventsResponse_Deposit)(nil),
 		(*SubscribeToEventsResponse_Connected)(nil),
-	}
-	file_spark_proto_msgTypes[6].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[24].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[25].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[26].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[27].OneofWrappers = []any{
+		(*SubscribeToEventsResponse_TokenTransaction)(nil),
+		(*SubscribeToEventsResponse_PreimageRequest)(nil),
+		(*SubscribeToEventsResponse_CooperativeExit)(nil),
+		(*SubscribeToEventsResponse_UtxoSwap)(nil),
+		(*SubscribeToEventsResponse_TreeExit)(nil),
+	}
+	file_spark_proto_msgTypes[11].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[29].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[30].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[31].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[32].OneofWrappers = []any{
 		(*TokenTransaction_MintInput)(nil),
 		(*TokenTransaction_TransferInput)(nil),
 		(*TokenTransaction_CreateInput)(nil),
 	}
-	file_spark_proto_msgTypes[42].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[50].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[69].OneofWrappers = []any{
+	file_spark_proto_msgTypes[47].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[55].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[77].OneofWrappers = []any{
 		(*TransferFilter_ReceiverIdentityPublicKey)(nil),
 		(*TransferFilter_SenderIdentityPublicKey)(nil),
 		(*TransferFilter_SenderOrReceiverIdentityPublicKey)(nil),
 	}
-	file_spark_proto_msgTypes[97].OneofWrappers = []any{
+	file_spark_proto_msgTypes[105].OneofWrappers = []any{
 		(*PrepareTreeAddressRequest_ParentNodeOutput)(nil),
 		(*PrepareTreeAddressRequest_OnChainUtxo)(nil),
 	}
-	file_spark_proto_msgTypes[101].OneofWrappers = []any{
+	file_spark_proto_msgTypes[109].OneofWrappers = []any{
 		(*CreateTreeRequest_ParentNodeOutput)(nil),
 		(*CreateTreeRequest_OnChainUtxo)(nil),
 	}
-	file_spark_proto_msgTypes[112].OneofWrappers = []any{
+	file_spark_proto_msgTypes[120].OneofWrappers = []any{
 		(*QueryNodesRequest_OwnerIdentityPubkey)(nil),
 		(*QueryNodesRequest_NodeIds)(nil),
 	}
-	file_spark_proto_msgTypes[117].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[118].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[123].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[124].OneofWrappers = []any{
+	file_spark_proto_msgTypes[125].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[126].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[131].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[132].OneofWrappers = []any{
 		(*SparkInvoiceFields_TokensPayment)(nil),
 		(*SparkInvoiceFields_SatsPayment)(nil),
 	}
-	file_spark_proto_msgTypes[125].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[126].OneofWrappers = []any{}
-	file_spark_proto_msgTypes[129].OneofWrappers = []any{
+	file_spark_proto_msgTypes[133].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[134].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[137].OneofWrappers = []any{
 		(*InitiateUtxoSwapRequest_CreditAmountSats)(nil),
 		(*InitiateUtxoSwapRequest_MaxFeeSats)(nil),
 	}
+	file_spark_proto_msgTypes[152].OneofWrappers = []any{}
+	file_spark_proto_msgTypes[153].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_proto_rawDesc), len(file_spark_proto_rawDesc)),
-			NumEnums:      8,
-			NumMessages:   161,
+			NumEnums:      10,
+			NumMessages:   173,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
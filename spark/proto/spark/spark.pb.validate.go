@@ -66,6 +66,10 @@ func (m *SubscribeToEventsRequest) validate(all bool) error {
 
 	// no validation rules for IdentityPublicKey
 
+	if m.ResumeFromSequence != nil {
+		// no validation rules for ResumeFromSequence
+	}
+
 	if len(errors) > 0 {
 		return SubscribeToEventsRequestMultiError(errors)
 	}
@@ -168,6 +172,8 @@ func (m *SubscribeToEventsResponse) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for Sequence
+
 	switch v := m.Event.(type) {
 	case *SubscribeToEventsResponse_Transfer:
 		if v == nil {
@@ -292,6 +298,211 @@ func (m *SubscribeToEventsResponse) validate(all bool) error {
 			}
 		}
 
+	case *SubscribeToEventsResponse_TokenTransaction:
+		if v == nil {
+			err := SubscribeToEventsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTokenTransaction()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "TokenTransaction",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "TokenTransaction",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTokenTransaction()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscribeToEventsResponseValidationError{
+					field:  "TokenTransaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SubscribeToEventsResponse_PreimageRequest:
+		if v == nil {
+			err := SubscribeToEventsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPreimageRequest()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "PreimageRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "PreimageRequest",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPreimageRequest()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscribeToEventsResponseValidationError{
+					field:  "PreimageRequest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SubscribeToEventsResponse_CooperativeExit:
+		if v == nil {
+			err := SubscribeToEventsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetCooperativeExit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "CooperativeExit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "CooperativeExit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCooperativeExit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscribeToEventsResponseValidationError{
+					field:  "CooperativeExit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SubscribeToEventsResponse_UtxoSwap:
+		if v == nil {
+			err := SubscribeToEventsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUtxoSwap()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "UtxoSwap",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "UtxoSwap",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUtxoSwap()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscribeToEventsResponseValidationError{
+					field:  "UtxoSwap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SubscribeToEventsResponse_TreeExit:
+		if v == nil {
+			err := SubscribeToEventsResponseValidationError{
+				field:  "Event",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTreeExit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "TreeExit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SubscribeToEventsResponseValidationError{
+						field:  "TreeExit",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTreeExit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SubscribeToEventsResponseValidationError{
+					field:  "TreeExit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -733,46 +944,78 @@ var _ interface {
 	ErrorName() string
 } = DepositEventValidationError{}
 
-// Validate checks the field values on DepositAddressProof with the rules
+// Validate checks the field values on TokenTransactionEvent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DepositAddressProof) Validate() error {
+func (m *TokenTransactionEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DepositAddressProof with the rules
+// ValidateAll checks the field values on TokenTransactionEvent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DepositAddressProofMultiError, or nil if none found.
-func (m *DepositAddressProof) ValidateAll() error {
+// TokenTransactionEventMultiError, or nil if none found.
+func (m *TokenTransactionEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DepositAddressProof) validate(all bool) error {
+func (m *TokenTransactionEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AddressSignatures
+	// no validation rules for TokenTransactionHash
 
-	// no validation rules for ProofOfPossessionSignature
+	for idx, item := range m.GetReceivedOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenTransactionEventValidationError{
+						field:  fmt.Sprintf("ReceivedOutputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenTransactionEventValidationError{
+						field:  fmt.Sprintf("ReceivedOutputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenTransactionEventValidationError{
+					field:  fmt.Sprintf("ReceivedOutputs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return DepositAddressProofMultiError(errors)
+		return TokenTransactionEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// DepositAddressProofMultiError is an error wrapping multiple validation
-// errors returned by DepositAddressProof.ValidateAll() if the designated
+// TokenTransactionEventMultiError is an error wrapping multiple validation
+// errors returned by TokenTransactionEvent.ValidateAll() if the designated
 // constraints aren't met.
-type DepositAddressProofMultiError []error
+type TokenTransactionEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DepositAddressProofMultiError) Error() string {
+func (m TokenTransactionEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -781,11 +1024,11 @@ func (m DepositAddressProofMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DepositAddressProofMultiError) AllErrors() []error { return m }
+func (m TokenTransactionEventMultiError) AllErrors() []error { return m }
 
-// DepositAddressProofValidationError is the validation error returned by
-// DepositAddressProof.Validate if the designated constraints aren't met.
-type DepositAddressProofValidationError struct {
+// TokenTransactionEventValidationError is the validation error returned by
+// TokenTransactionEvent.Validate if the designated constraints aren't met.
+type TokenTransactionEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -793,24 +1036,24 @@ type DepositAddressProofValidationError struct {
 }
 
 // Field function returns field value.
-func (e DepositAddressProofValidationError) Field() string { return e.field }
+func (e TokenTransactionEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DepositAddressProofValidationError) Reason() string { return e.reason }
+func (e TokenTransactionEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DepositAddressProofValidationError) Cause() error { return e.cause }
+func (e TokenTransactionEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DepositAddressProofValidationError) Key() bool { return e.key }
+func (e TokenTransactionEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DepositAddressProofValidationError) ErrorName() string {
-	return "DepositAddressProofValidationError"
+func (e TokenTransactionEventValidationError) ErrorName() string {
+	return "TokenTransactionEventValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DepositAddressProofValidationError) Error() string {
+func (e TokenTransactionEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -822,14 +1065,14 @@ func (e DepositAddressProofValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDepositAddressProof.%s: %s%s",
+		"invalid %sTokenTransactionEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DepositAddressProofValidationError{}
+var _ error = TokenTransactionEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -837,87 +1080,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DepositAddressProofValidationError{}
+} = TokenTransactionEventValidationError{}
 
-// Validate checks the field values on GenerateDepositAddressRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PreimageRequestEvent with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateDepositAddressRequest) Validate() error {
+func (m *PreimageRequestEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateDepositAddressRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GenerateDepositAddressRequestMultiError, or nil if none found.
-func (m *GenerateDepositAddressRequest) ValidateAll() error {
+// ValidateAll checks the field values on PreimageRequestEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreimageRequestEventMultiError, or nil if none found.
+func (m *PreimageRequestEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateDepositAddressRequest) validate(all bool) error {
+func (m *PreimageRequestEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SigningPublicKey
+	// no validation rules for PaymentHash
 
-	// no validation rules for IdentityPublicKey
+	// no validation rules for Status
 
-	if _, ok := _GenerateDepositAddressRequest_Network_NotInLookup[m.GetNetwork()]; ok {
-		err := GenerateDepositAddressRequestValidationError{
-			field:  "Network",
-			reason: "value must not be in list [UNSPECIFIED]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.LeafId != nil {
-
-		if err := m._validateUuid(m.GetLeafId()); err != nil {
-			err = GenerateDepositAddressRequestValidationError{
-				field:  "LeafId",
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.IsStatic != nil {
-		// no validation rules for IsStatic
-	}
+	// no validation rules for TransferId
 
 	if len(errors) > 0 {
-		return GenerateDepositAddressRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *GenerateDepositAddressRequest) _validateUuid(uuid string) error {
-	if matched := _spark_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return PreimageRequestEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateDepositAddressRequestMultiError is an error wrapping multiple
-// validation errors returned by GenerateDepositAddressRequest.ValidateAll()
-// if the designated constraints aren't met.
-type GenerateDepositAddressRequestMultiError []error
+// PreimageRequestEventMultiError is an error wrapping multiple validation
+// errors returned by PreimageRequestEvent.ValidateAll() if the designated
+// constraints aren't met.
+type PreimageRequestEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateDepositAddressRequestMultiError) Error() string {
+func (m PreimageRequestEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -926,12 +1132,11 @@ func (m GenerateDepositAddressRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateDepositAddressRequestMultiError) AllErrors() []error { return m }
+func (m PreimageRequestEventMultiError) AllErrors() []error { return m }
 
-// GenerateDepositAddressRequestValidationError is the validation error
-// returned by GenerateDepositAddressRequest.Validate if the designated
-// constraints aren't met.
-type GenerateDepositAddressRequestValidationError struct {
+// PreimageRequestEventValidationError is the validation error returned by
+// PreimageRequestEvent.Validate if the designated constraints aren't met.
+type PreimageRequestEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -939,24 +1144,24 @@ type GenerateDepositAddressRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateDepositAddressRequestValidationError) Field() string { return e.field }
+func (e PreimageRequestEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateDepositAddressRequestValidationError) Reason() string { return e.reason }
+func (e PreimageRequestEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateDepositAddressRequestValidationError) Cause() error { return e.cause }
+func (e PreimageRequestEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateDepositAddressRequestValidationError) Key() bool { return e.key }
+func (e PreimageRequestEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateDepositAddressRequestValidationError) ErrorName() string {
-	return "GenerateDepositAddressRequestValidationError"
+func (e PreimageRequestEventValidationError) ErrorName() string {
+	return "PreimageRequestEventValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateDepositAddressRequestValidationError) Error() string {
+func (e PreimageRequestEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -968,14 +1173,14 @@ func (e GenerateDepositAddressRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateDepositAddressRequest.%s: %s%s",
+		"invalid %sPreimageRequestEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateDepositAddressRequestValidationError{}
+var _ error = PreimageRequestEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -983,81 +1188,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateDepositAddressRequestValidationError{}
-
-var _GenerateDepositAddressRequest_Network_NotInLookup = map[Network]struct{}{
-	0: {},
-}
+} = PreimageRequestEventValidationError{}
 
-// Validate checks the field values on Address with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Address) Validate() error {
+// Validate checks the field values on CooperativeExitEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CooperativeExitEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Address with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AddressMultiError, or nil if none found.
-func (m *Address) ValidateAll() error {
+// ValidateAll checks the field values on CooperativeExitEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CooperativeExitEventMultiError, or nil if none found.
+func (m *CooperativeExitEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Address) validate(all bool) error {
+func (m *CooperativeExitEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
-
-	// no validation rules for VerifyingKey
+	// no validation rules for TransferId
 
-	if all {
-		switch v := interface{}(m.GetDepositAddressProof()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddressValidationError{
-					field:  "DepositAddressProof",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AddressValidationError{
-					field:  "DepositAddressProof",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDepositAddressProof()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AddressValidationError{
-				field:  "DepositAddressProof",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ExitTxid
 
-	// no validation rules for IsStatic
+	// no validation rules for ConfirmationHeight
 
 	if len(errors) > 0 {
-		return AddressMultiError(errors)
+		return CooperativeExitEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddressMultiError is an error wrapping multiple validation errors returned
-// by Address.ValidateAll() if the designated constraints aren't met.
-type AddressMultiError []error
+// CooperativeExitEventMultiError is an error wrapping multiple validation
+// errors returned by CooperativeExitEvent.ValidateAll() if the designated
+// constraints aren't met.
+type CooperativeExitEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddressMultiError) Error() string {
+func (m CooperativeExitEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1066,11 +1240,11 @@ func (m AddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddressMultiError) AllErrors() []error { return m }
+func (m CooperativeExitEventMultiError) AllErrors() []error { return m }
 
-// AddressValidationError is the validation error returned by Address.Validate
-// if the designated constraints aren't met.
-type AddressValidationError struct {
+// CooperativeExitEventValidationError is the validation error returned by
+// CooperativeExitEvent.Validate if the designated constraints aren't met.
+type CooperativeExitEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1078,22 +1252,24 @@ type AddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddressValidationError) Field() string { return e.field }
+func (e CooperativeExitEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddressValidationError) Reason() string { return e.reason }
+func (e CooperativeExitEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddressValidationError) Cause() error { return e.cause }
+func (e CooperativeExitEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddressValidationError) Key() bool { return e.key }
+func (e CooperativeExitEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddressValidationError) ErrorName() string { return "AddressValidationError" }
+func (e CooperativeExitEventValidationError) ErrorName() string {
+	return "CooperativeExitEventValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddressValidationError) Error() string {
+func (e CooperativeExitEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1105,14 +1281,14 @@ func (e AddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddress.%s: %s%s",
+		"invalid %sCooperativeExitEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddressValidationError{}
+var _ error = CooperativeExitEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1120,24 +1296,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddressValidationError{}
+} = CooperativeExitEventValidationError{}
 
-// Validate checks the field values on GenerateDepositAddressResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GenerateDepositAddressResponse) Validate() error {
+// Validate checks the field values on UtxoSwapEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UtxoSwapEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateDepositAddressResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GenerateDepositAddressResponseMultiError, or nil if none found.
-func (m *GenerateDepositAddressResponse) ValidateAll() error {
+// ValidateAll checks the field values on UtxoSwapEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UtxoSwapEventMultiError, or
+// nil if none found.
+func (m *UtxoSwapEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateDepositAddressResponse) validate(all bool) error {
+func (m *UtxoSwapEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1145,48 +1321,52 @@ func (m *GenerateDepositAddressResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetDepositAddress()).(type) {
+		switch v := interface{}(m.GetUtxo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateDepositAddressResponseValidationError{
-					field:  "DepositAddress",
+				errors = append(errors, UtxoSwapEventValidationError{
+					field:  "Utxo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateDepositAddressResponseValidationError{
-					field:  "DepositAddress",
+				errors = append(errors, UtxoSwapEventValidationError{
+					field:  "Utxo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUtxo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return GenerateDepositAddressResponseValidationError{
-				field:  "DepositAddress",
+			return UtxoSwapEventValidationError{
+				field:  "Utxo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for RequestType
+
+	// no validation rules for TransferId
+
 	if len(errors) > 0 {
-		return GenerateDepositAddressResponseMultiError(errors)
+		return UtxoSwapEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateDepositAddressResponseMultiError is an error wrapping multiple
-// validation errors returned by GenerateDepositAddressResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GenerateDepositAddressResponseMultiError []error
+// UtxoSwapEventMultiError is an error wrapping multiple validation errors
+// returned by UtxoSwapEvent.ValidateAll() if the designated constraints
+// aren't met.
+type UtxoSwapEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateDepositAddressResponseMultiError) Error() string {
+func (m UtxoSwapEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1195,12 +1375,11 @@ func (m GenerateDepositAddressResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateDepositAddressResponseMultiError) AllErrors() []error { return m }
+func (m UtxoSwapEventMultiError) AllErrors() []error { return m }
 
-// GenerateDepositAddressResponseValidationError is the validation error
-// returned by GenerateDepositAddressResponse.Validate if the designated
-// constraints aren't met.
-type GenerateDepositAddressResponseValidationError struct {
+// UtxoSwapEventValidationError is the validation error returned by
+// UtxoSwapEvent.Validate if the designated constraints aren't met.
+type UtxoSwapEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1208,24 +1387,22 @@ type GenerateDepositAddressResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateDepositAddressResponseValidationError) Field() string { return e.field }
+func (e UtxoSwapEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateDepositAddressResponseValidationError) Reason() string { return e.reason }
+func (e UtxoSwapEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateDepositAddressResponseValidationError) Cause() error { return e.cause }
+func (e UtxoSwapEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateDepositAddressResponseValidationError) Key() bool { return e.key }
+func (e UtxoSwapEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateDepositAddressResponseValidationError) ErrorName() string {
-	return "GenerateDepositAddressResponseValidationError"
-}
+func (e UtxoSwapEventValidationError) ErrorName() string { return "UtxoSwapEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateDepositAddressResponseValidationError) Error() string {
+func (e UtxoSwapEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1237,14 +1414,14 @@ func (e GenerateDepositAddressResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateDepositAddressResponse.%s: %s%s",
+		"invalid %sUtxoSwapEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateDepositAddressResponseValidationError{}
+var _ error = UtxoSwapEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1252,79 +1429,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateDepositAddressResponseValidationError{}
+} = UtxoSwapEventValidationError{}
 
-// Validate checks the field values on GenerateStaticDepositAddressRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *GenerateStaticDepositAddressRequest) Validate() error {
+// Validate checks the field values on TreeExitEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TreeExitEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GenerateStaticDepositAddressRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GenerateStaticDepositAddressRequestMultiError, or nil if none found.
-func (m *GenerateStaticDepositAddressRequest) ValidateAll() error {
+// ValidateAll checks the field values on TreeExitEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TreeExitEventMultiError, or
+// nil if none found.
+func (m *TreeExitEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateStaticDepositAddressRequest) validate(all bool) error {
+func (m *TreeExitEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetSigningPublicKey()) != 33 {
-		err := GenerateStaticDepositAddressRequestValidationError{
-			field:  "SigningPublicKey",
-			reason: "value length must be 33 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetIdentityPublicKey()) != 33 {
-		err := GenerateStaticDepositAddressRequestValidationError{
-			field:  "IdentityPublicKey",
-			reason: "value length must be 33 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if _, ok := _GenerateStaticDepositAddressRequest_Network_NotInLookup[m.GetNetwork()]; ok {
-		err := GenerateStaticDepositAddressRequestValidationError{
-			field:  "Network",
-			reason: "value must not be in list [UNSPECIFIED]",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for TreeId
 
 	if len(errors) > 0 {
-		return GenerateStaticDepositAddressRequestMultiError(errors)
+		return TreeExitEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStaticDepositAddressRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// GenerateStaticDepositAddressRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GenerateStaticDepositAddressRequestMultiError []error
+// TreeExitEventMultiError is an error wrapping multiple validation errors
+// returned by TreeExitEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TreeExitEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStaticDepositAddressRequestMultiError) Error() string {
+func (m TreeExitEventMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1333,12 +1477,11 @@ func (m GenerateStaticDepositAddressRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStaticDepositAddressRequestMultiError) AllErrors() []error { return m }
+func (m TreeExitEventMultiError) AllErrors() []error { return m }
 
-// GenerateStaticDepositAddressRequestValidationError is the validation error
-// returned by GenerateStaticDepositAddressRequest.Validate if the designated
-// constraints aren't met.
-type GenerateStaticDepositAddressRequestValidationError struct {
+// TreeExitEventValidationError is the validation error returned by
+// TreeExitEvent.Validate if the designated constraints aren't met.
+type TreeExitEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1346,24 +1489,22 @@ type GenerateStaticDepositAddressRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStaticDepositAddressRequestValidationError) Field() string { return e.field }
+func (e TreeExitEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStaticDepositAddressRequestValidationError) Reason() string { return e.reason }
+func (e TreeExitEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStaticDepositAddressRequestValidationError) Cause() error { return e.cause }
+func (e TreeExitEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStaticDepositAddressRequestValidationError) Key() bool { return e.key }
+func (e TreeExitEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStaticDepositAddressRequestValidationError) ErrorName() string {
-	return "GenerateStaticDepositAddressRequestValidationError"
-}
+func (e TreeExitEventValidationError) ErrorName() string { return "TreeExitEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GenerateStaticDepositAddressRequestValidationError) Error() string {
+func (e TreeExitEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1375,14 +1516,14 @@ func (e GenerateStaticDepositAddressRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateStaticDepositAddressRequest.%s: %s%s",
+		"invalid %sTreeExitEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStaticDepositAddressRequestValidationError{}
+var _ error = TreeExitEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -1390,79 +1531,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStaticDepositAddressRequestValidationError{}
+} = TreeExitEventValidationError{}
 
-var _GenerateStaticDepositAddressRequest_Network_NotInLookup = map[Network]struct{}{
-	0: {},
+// Validate checks the field values on DepositAddressProof with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DepositAddressProof) Validate() error {
+	return m.validate(false)
 }
 
-// Validate checks the field values on GenerateStaticDepositAddressResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *GenerateStaticDepositAddressResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GenerateStaticDepositAddressResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// GenerateStaticDepositAddressResponseMultiError, or nil if none found.
-func (m *GenerateStaticDepositAddressResponse) ValidateAll() error {
+// ValidateAll checks the field values on DepositAddressProof with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DepositAddressProofMultiError, or nil if none found.
+func (m *DepositAddressProof) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GenerateStaticDepositAddressResponse) validate(all bool) error {
+func (m *DepositAddressProof) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDepositAddress()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GenerateStaticDepositAddressResponseValidationError{
-					field:  "DepositAddress",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GenerateStaticDepositAddressResponseValidationError{
-					field:  "DepositAddress",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GenerateStaticDepositAddressResponseValidationError{
-				field:  "DepositAddress",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for AddressSignatures
+
+	// no validation rules for ProofOfPossessionSignature
 
 	if len(errors) > 0 {
-		return GenerateStaticDepositAddressResponseMultiError(errors)
+		return DepositAddressProofMultiError(errors)
 	}
 
 	return nil
 }
 
-// GenerateStaticDepositAddressResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// GenerateStaticDepositAddressResponse.ValidateAll() if the designated
+// DepositAddressProofMultiError is an error wrapping multiple validation
+// errors returned by DepositAddressProof.ValidateAll() if the designated
 // constraints aren't met.
-type GenerateStaticDepositAddressResponseMultiError []error
+type DepositAddressProofMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GenerateStaticDepositAddressResponseMultiError) Error() string {
+func (m DepositAddressProofMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1471,12 +1581,11 @@ func (m GenerateStaticDepositAddressResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GenerateStaticDepositAddressResponseMultiError) AllErrors() []error { return m }
+func (m DepositAddressProofMultiError) AllErrors() []error { return m }
 
-// GenerateStaticDepositAddressResponseValidationError is the validation error
-// returned by GenerateStaticDepositAddressResponse.Validate if the designated
-// constraints aren't met.
-type GenerateStaticDepositAddressResponseValidationError struct {
+// DepositAddressProofValidationError is the validation error returned by
+// DepositAddressProof.Validate if the designated constraints aren't met.
+type DepositAddressProofValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1484,24 +1593,24 @@ type GenerateStaticDepositAddressResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GenerateStaticDepositAddressResponseValidationError) Field() string { return e.field }
+func (e DepositAddressProofValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GenerateStaticDepositAddressResponseValidationError) Reason() string { return e.reason }
+func (e DepositAddressProofValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GenerateStaticDepositAddressResponseValidationError) Cause() error { return e.cause }
+func (e DepositAddressProofValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GenerateStaticDepositAddressResponseValidationError) Key() bool { return e.key }
+func (e DepositAddressProofValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GenerateStaticDepositAddressResponseValidationError) ErrorName() string {
-	return "GenerateStaticDepositAddressResponseValidationError"
+func (e DepositAddressProofValidationError) ErrorName() string {
+	return "DepositAddressProofValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GenerateStaticDepositAddressResponseValidationError) Error() string {
+func (e DepositAddressProofValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1513,14 +1622,14 @@ func (e GenerateStaticDepositAddressResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGenerateStaticDepositAddressResponse.%s: %s%s",
+		"invalid %sDepositAddressProof.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GenerateStaticDepositAddressResponseValidationError{}
+var _ error = DepositAddressProofValidationError{}
 
 var _ interface {
 	Field() string
@@ -1528,35 +1637,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GenerateStaticDepositAddressResponseValidationError{}
+} = DepositAddressProofValidationError{}
 
-// Validate checks the field values on UTXO with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *UTXO) Validate() error {
+// Validate checks the field values on GenerateDepositAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateDepositAddressRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UTXO with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in UTXOMultiError, or nil if none found.
-func (m *UTXO) ValidateAll() error {
+// ValidateAll checks the field values on GenerateDepositAddressRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateDepositAddressRequestMultiError, or nil if none found.
+func (m *GenerateDepositAddressRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UTXO) validate(all bool) error {
+func (m *GenerateDepositAddressRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RawTx
+	// no validation rules for SigningPublicKey
 
-	// no validation rules for Vout
+	// no validation rules for IdentityPublicKey
 
-	if _, ok := _UTXO_Network_NotInLookup[m.GetNetwork()]; ok {
-		err := UTXOValidationError{
+	if _, ok := _GenerateDepositAddressRequest_Network_NotInLookup[m.GetNetwork()]; ok {
+		err := GenerateDepositAddressRequestValidationError{
 			field:  "Network",
 			reason: "value must not be in list [UNSPECIFIED]",
 		}
@@ -1566,21 +1676,48 @@ func (m *UTXO) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Txid
+	if m.LeafId != nil {
+
+		if err := m._validateUuid(m.GetLeafId()); err != nil {
+			err = GenerateDepositAddressRequestValidationError{
+				field:  "LeafId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.IsStatic != nil {
+		// no validation rules for IsStatic
+	}
 
 	if len(errors) > 0 {
-		return UTXOMultiError(errors)
+		return GenerateDepositAddressRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UTXOMultiError is an error wrapping multiple validation errors returned by
-// UTXO.ValidateAll() if the designated constraints aren't met.
-type UTXOMultiError []error
+func (m *GenerateDepositAddressRequest) _validateUuid(uuid string) error {
+	if matched := _spark_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GenerateDepositAddressRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateDepositAddressRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateDepositAddressRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UTXOMultiError) Error() string {
+func (m GenerateDepositAddressRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1589,11 +1726,12 @@ func (m UTXOMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UTXOMultiError) AllErrors() []error { return m }
+func (m GenerateDepositAddressRequestMultiError) AllErrors() []error { return m }
 
-// UTXOValidationError is the validation error returned by UTXO.Validate if the
-// designated constraints aren't met.
-type UTXOValidationError struct {
+// GenerateDepositAddressRequestValidationError is the validation error
+// returned by GenerateDepositAddressRequest.Validate if the designated
+// constraints aren't met.
+type GenerateDepositAddressRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1601,22 +1739,24 @@ type UTXOValidationError struct {
 }
 
 // Field function returns field value.
-func (e UTXOValidationError) Field() string { return e.field }
+func (e GenerateDepositAddressRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UTXOValidationError) Reason() string { return e.reason }
+func (e GenerateDepositAddressRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UTXOValidationError) Cause() error { return e.cause }
+func (e GenerateDepositAddressRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UTXOValidationError) Key() bool { return e.key }
+func (e GenerateDepositAddressRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UTXOValidationError) ErrorName() string { return "UTXOValidationError" }
+func (e GenerateDepositAddressRequestValidationError) ErrorName() string {
+	return "GenerateDepositAddressRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UTXOValidationError) Error() string {
+func (e GenerateDepositAddressRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1628,14 +1768,14 @@ func (e UTXOValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUTXO.%s: %s%s",
+		"invalid %sGenerateDepositAddressRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UTXOValidationError{}
+var _ error = GenerateDepositAddressRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1643,51 +1783,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UTXOValidationError{}
+} = GenerateDepositAddressRequestValidationError{}
 
-var _UTXO_Network_NotInLookup = map[Network]struct{}{
+var _GenerateDepositAddressRequest_Network_NotInLookup = map[Network]struct{}{
 	0: {},
 }
 
-// Validate checks the field values on NodeOutput with the rules defined in the
+// Validate checks the field values on Address with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *NodeOutput) Validate() error {
+func (m *Address) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NodeOutput with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NodeOutputMultiError, or
-// nil if none found.
-func (m *NodeOutput) ValidateAll() error {
+// ValidateAll checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AddressMultiError, or nil if none found.
+func (m *Address) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NodeOutput) validate(all bool) error {
+func (m *Address) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NodeId
+	// no validation rules for Address
 
-	// no validation rules for Vout
+	// no validation rules for VerifyingKey
+
+	if all {
+		switch v := interface{}(m.GetDepositAddressProof()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "DepositAddressProof",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "DepositAddressProof",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDepositAddressProof()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddressValidationError{
+				field:  "DepositAddressProof",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for IsStatic
 
 	if len(errors) > 0 {
-		return NodeOutputMultiError(errors)
+		return AddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// NodeOutputMultiError is an error wrapping multiple validation errors
-// returned by NodeOutput.ValidateAll() if the designated constraints aren't met.
-type NodeOutputMultiError []error
+// AddressMultiError is an error wrapping multiple validation errors returned
+// by Address.ValidateAll() if the designated constraints aren't met.
+type AddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NodeOutputMultiError) Error() string {
+func (m AddressMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1696,11 +1866,11 @@ func (m NodeOutputMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NodeOutputMultiError) AllErrors() []error { return m }
+func (m AddressMultiError) AllErrors() []error { return m }
 
-// NodeOutputValidationError is the validation error returned by
-// NodeOutput.Validate if the designated constraints aren't met.
-type NodeOutputValidationError struct {
+// AddressValidationError is the validation error returned by Address.Validate
+// if the designated constraints aren't met.
+type AddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1708,22 +1878,22 @@ type NodeOutputValidationError struct {
 }
 
 // Field function returns field value.
-func (e NodeOutputValidationError) Field() string { return e.field }
+func (e AddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NodeOutputValidationError) Reason() string { return e.reason }
+func (e AddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NodeOutputValidationError) Cause() error { return e.cause }
+func (e AddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NodeOutputValidationError) Key() bool { return e.key }
+func (e AddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NodeOutputValidationError) ErrorName() string { return "NodeOutputValidationError" }
+func (e AddressValidationError) ErrorName() string { return "AddressValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NodeOutputValidationError) Error() string {
+func (e AddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1735,14 +1905,14 @@ func (e NodeOutputValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNodeOutput.%s: %s%s",
+		"invalid %sAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NodeOutputValidationError{}
+var _ error = AddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -1750,57 +1920,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NodeOutputValidationError{}
+} = AddressValidationError{}
 
-// Validate checks the field values on SigningJob with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SigningJob) Validate() error {
+// Validate checks the field values on GenerateDepositAddressResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateDepositAddressResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SigningJob with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SigningJobMultiError, or
-// nil if none found.
-func (m *SigningJob) ValidateAll() error {
+// ValidateAll checks the field values on GenerateDepositAddressResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateDepositAddressResponseMultiError, or nil if none found.
+func (m *GenerateDepositAddressResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SigningJob) validate(all bool) error {
+func (m *GenerateDepositAddressResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SigningPublicKey
-
-	// no validation rules for RawTx
-
 	if all {
-		switch v := interface{}(m.GetSigningNonceCommitment()).(type) {
+		switch v := interface{}(m.GetDepositAddress()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SigningJobValidationError{
-					field:  "SigningNonceCommitment",
+				errors = append(errors, GenerateDepositAddressResponseValidationError{
+					field:  "DepositAddress",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SigningJobValidationError{
-					field:  "SigningNonceCommitment",
+				errors = append(errors, GenerateDepositAddressResponseValidationError{
+					field:  "DepositAddress",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSigningNonceCommitment()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SigningJobValidationError{
-				field:  "SigningNonceCommitment",
+			return GenerateDepositAddressResponseValidationError{
+				field:  "DepositAddress",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1808,18 +1974,19 @@ func (m *SigningJob) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SigningJobMultiError(errors)
+		return GenerateDepositAddressResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SigningJobMultiError is an error wrapping multiple validation errors
-// returned by SigningJob.ValidateAll() if the designated constraints aren't met.
-type SigningJobMultiError []error
+// GenerateDepositAddressResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateDepositAddressResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateDepositAddressResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SigningJobMultiError) Error() string {
+func (m GenerateDepositAddressResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1828,11 +1995,12 @@ func (m SigningJobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SigningJobMultiError) AllErrors() []error { return m }
+func (m GenerateDepositAddressResponseMultiError) AllErrors() []error { return m }
 
-// SigningJobValidationError is the validation error returned by
-// SigningJob.Validate if the designated constraints aren't met.
-type SigningJobValidationError struct {
+// GenerateDepositAddressResponseValidationError is the validation error
+// returned by GenerateDepositAddressResponse.Validate if the designated
+// constraints aren't met.
+type GenerateDepositAddressResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1840,22 +2008,24 @@ type SigningJobValidationError struct {
 }
 
 // Field function returns field value.
-func (e SigningJobValidationError) Field() string { return e.field }
+func (e GenerateDepositAddressResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SigningJobValidationError) Reason() string { return e.reason }
+func (e GenerateDepositAddressResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SigningJobValidationError) Cause() error { return e.cause }
+func (e GenerateDepositAddressResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SigningJobValidationError) Key() bool { return e.key }
+func (e GenerateDepositAddressResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SigningJobValidationError) ErrorName() string { return "SigningJobValidationError" }
+func (e GenerateDepositAddressResponseValidationError) ErrorName() string {
+	return "GenerateDepositAddressResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SigningJobValidationError) Error() string {
+func (e GenerateDepositAddressResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1867,14 +2037,14 @@ func (e SigningJobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSigningJob.%s: %s%s",
+		"invalid %sGenerateDepositAddressResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SigningJobValidationError{}
+var _ error = GenerateDepositAddressResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1882,79 +2052,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SigningJobValidationError{}
+} = GenerateDepositAddressResponseValidationError{}
 
-// Validate checks the field values on SigningKeyshare with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *SigningKeyshare) Validate() error {
+// Validate checks the field values on GenerateStaticDepositAddressRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GenerateStaticDepositAddressRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SigningKeyshare with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SigningKeyshareMultiError, or nil if none found.
-func (m *SigningKeyshare) ValidateAll() error {
+// ValidateAll checks the field values on GenerateStaticDepositAddressRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GenerateStaticDepositAddressRequestMultiError, or nil if none found.
+func (m *GenerateStaticDepositAddressRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SigningKeyshare) validate(all bool) error {
+func (m *GenerateStaticDepositAddressRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Threshold
-
-	// no validation rules for PublicKey
+	if len(m.GetSigningPublicKey()) != 33 {
+		err := GenerateStaticDepositAddressRequestValidationError{
+			field:  "SigningPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for PublicShares
+	if len(m.GetIdentityPublicKey()) != 33 {
+		err := GenerateStaticDepositAddressRequestValidationError{
+			field:  "IdentityPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SigningKeyshareValidationError{
-					field:  "UpdatedTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, SigningKeyshareValidationError{
-					field:  "UpdatedTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _GenerateStaticDepositAddressRequest_Network_NotInLookup[m.GetNetwork()]; ok {
+		err := GenerateStaticDepositAddressRequestValidationError{
+			field:  "Network",
+			reason: "value must not be in list [UNSPECIFIED]",
 		}
-	} else if v, ok := interface{}(m.GetUpdatedTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SigningKeyshareValidationError{
-				field:  "UpdatedTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return SigningKeyshareMultiError(errors)
+		return GenerateStaticDepositAddressRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SigningKeyshareMultiError is an error wrapping multiple validation errors
-// returned by SigningKeyshare.ValidateAll() if the designated constraints
-// aren't met.
-type SigningKeyshareMultiError []error
+// GenerateStaticDepositAddressRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateStaticDepositAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStaticDepositAddressRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SigningKeyshareMultiError) Error() string {
+func (m GenerateStaticDepositAddressRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1963,11 +2133,12 @@ func (m SigningKeyshareMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SigningKeyshareMultiError) AllErrors() []error { return m }
+func (m GenerateStaticDepositAddressRequestMultiError) AllErrors() []error { return m }
 
-// SigningKeyshareValidationError is the validation error returned by
-// SigningKeyshare.Validate if the designated constraints aren't met.
-type SigningKeyshareValidationError struct {
+// GenerateStaticDepositAddressRequestValidationError is the validation error
+// returned by GenerateStaticDepositAddressRequest.Validate if the designated
+// constraints aren't met.
+type GenerateStaticDepositAddressRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1975,22 +2146,24 @@ type SigningKeyshareValidationError struct {
 }
 
 // Field function returns field value.
-func (e SigningKeyshareValidationError) Field() string { return e.field }
+func (e GenerateStaticDepositAddressRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SigningKeyshareValidationError) Reason() string { return e.reason }
+func (e GenerateStaticDepositAddressRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SigningKeyshareValidationError) Cause() error { return e.cause }
+func (e GenerateStaticDepositAddressRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SigningKeyshareValidationError) Key() bool { return e.key }
+func (e GenerateStaticDepositAddressRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SigningKeyshareValidationError) ErrorName() string { return "SigningKeyshareValidationError" }
+func (e GenerateStaticDepositAddressRequestValidationError) ErrorName() string {
+	return "GenerateStaticDepositAddressRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SigningKeyshareValidationError) Error() string {
+func (e GenerateStaticDepositAddressRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2002,14 +2175,14 @@ func (e SigningKeyshareValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSigningKeyshare.%s: %s%s",
+		"invalid %sGenerateStaticDepositAddressRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SigningKeyshareValidationError{}
+var _ error = GenerateStaticDepositAddressRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2017,103 +2190,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SigningKeyshareValidationError{}
+} = GenerateStaticDepositAddressRequestValidationError{}
 
-// Validate checks the field values on SigningResult with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SigningResult) Validate() error {
+var _GenerateStaticDepositAddressRequest_Network_NotInLookup = map[Network]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GenerateStaticDepositAddressResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *GenerateStaticDepositAddressResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SigningResult with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SigningResultMultiError, or
-// nil if none found.
-func (m *SigningResult) ValidateAll() error {
+// ValidateAll checks the field values on GenerateStaticDepositAddressResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GenerateStaticDepositAddressResponseMultiError, or nil if none found.
+func (m *GenerateStaticDepositAddressResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SigningResult) validate(all bool) error {
+func (m *GenerateStaticDepositAddressResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PublicKeys
-
-	{
-		sorted_keys := make([]string, len(m.GetSigningNonceCommitments()))
-		i := 0
-		for key := range m.GetSigningNonceCommitments() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetSigningNonceCommitments()[key]
-			_ = val
-
-			// no validation rules for SigningNonceCommitments[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, SigningResultValidationError{
-							field:  fmt.Sprintf("SigningNonceCommitments[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, SigningResultValidationError{
-							field:  fmt.Sprintf("SigningNonceCommitments[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return SigningResultValidationError{
-						field:  fmt.Sprintf("SigningNonceCommitments[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
-
-		}
-	}
-
-	// no validation rules for SignatureShares
-
 	if all {
-		switch v := interface{}(m.GetSigningKeyshare()).(type) {
+		switch v := interface{}(m.GetDepositAddress()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SigningResultValidationError{
-					field:  "SigningKeyshare",
+				errors = append(errors, GenerateStaticDepositAddressResponseValidationError{
+					field:  "DepositAddress",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SigningResultValidationError{
-					field:  "SigningKeyshare",
+				errors = append(errors, GenerateStaticDepositAddressResponseValidationError{
+					field:  "DepositAddress",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSigningKeyshare()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SigningResultValidationError{
-				field:  "SigningKeyshare",
+			return GenerateStaticDepositAddressResponseValidationError{
+				field:  "DepositAddress",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2121,19 +2249,20 @@ func (m *SigningResult) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return SigningResultMultiError(errors)
+		return GenerateStaticDepositAddressResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SigningResultMultiError is an error wrapping multiple validation errors
-// returned by SigningResult.ValidateAll() if the designated constraints
-// aren't met.
-type SigningResultMultiError []error
+// GenerateStaticDepositAddressResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GenerateStaticDepositAddressResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GenerateStaticDepositAddressResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SigningResultMultiError) Error() string {
+func (m GenerateStaticDepositAddressResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2142,11 +2271,12 @@ func (m SigningResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SigningResultMultiError) AllErrors() []error { return m }
+func (m GenerateStaticDepositAddressResponseMultiError) AllErrors() []error { return m }
 
-// SigningResultValidationError is the validation error returned by
-// SigningResult.Validate if the designated constraints aren't met.
-type SigningResultValidationError struct {
+// GenerateStaticDepositAddressResponseValidationError is the validation error
+// returned by GenerateStaticDepositAddressResponse.Validate if the designated
+// constraints aren't met.
+type GenerateStaticDepositAddressResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2154,22 +2284,24 @@ type SigningResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e SigningResultValidationError) Field() string { return e.field }
+func (e GenerateStaticDepositAddressResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SigningResultValidationError) Reason() string { return e.reason }
+func (e GenerateStaticDepositAddressResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SigningResultValidationError) Cause() error { return e.cause }
+func (e GenerateStaticDepositAddressResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SigningResultValidationError) Key() bool { return e.key }
+func (e GenerateStaticDepositAddressResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SigningResultValidationError) ErrorName() string { return "SigningResultValidationError" }
+func (e GenerateStaticDepositAddressResponseValidationError) ErrorName() string {
+	return "GenerateStaticDepositAddressResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SigningResultValidationError) Error() string {
+func (e GenerateStaticDepositAddressResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2181,14 +2313,14 @@ func (e SigningResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSigningResult.%s: %s%s",
+		"invalid %sGenerateStaticDepositAddressResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SigningResultValidationError{}
+var _ error = GenerateStaticDepositAddressResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2196,206 +2328,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SigningResultValidationError{}
+} = GenerateStaticDepositAddressResponseValidationError{}
 
-// Validate checks the field values on NodeSignatureShares with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *NodeSignatureShares) Validate() error {
+// Validate checks the field values on UTXO with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *UTXO) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NodeSignatureShares with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// NodeSignatureSharesMultiError, or nil if none found.
-func (m *NodeSignatureShares) ValidateAll() error {
+// ValidateAll checks the field values on UTXO with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UTXOMultiError, or nil if none found.
+func (m *UTXO) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NodeSignatureShares) validate(all bool) error {
+func (m *UTXO) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NodeId
+	// no validation rules for RawTx
 
-	if all {
-		switch v := interface{}(m.GetNodeTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "NodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "NodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNodeTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NodeSignatureSharesValidationError{
-				field:  "NodeTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Vout
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if _, ok := _UTXO_Network_NotInLookup[m.GetNetwork()]; ok {
+		err := UTXOValidationError{
+			field:  "Network",
+			reason: "value must not be in list [UNSPECIFIED]",
 		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NodeSignatureSharesValidationError{
-				field:  "RefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for VerifyingKey
+	// no validation rules for Txid
 
-	if all {
-		switch v := interface{}(m.GetDirectNodeTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "DirectNodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "DirectNodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectNodeTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NodeSignatureSharesValidationError{
-				field:  "DirectNodeTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return UTXOMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "DirectRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "DirectRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NodeSignatureSharesValidationError{
-				field:  "DirectRefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// UTXOMultiError is an error wrapping multiple validation errors returned by
+// UTXO.ValidateAll() if the designated constraints aren't met.
+type UTXOMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UTXOMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
-
-	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, NodeSignatureSharesValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NodeSignatureSharesValidationError{
-				field:  "DirectFromCpfpRefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(errors) > 0 {
-		return NodeSignatureSharesMultiError(errors)
-	}
-
-	return nil
-}
-
-// NodeSignatureSharesMultiError is an error wrapping multiple validation
-// errors returned by NodeSignatureShares.ValidateAll() if the designated
-// constraints aren't met.
-type NodeSignatureSharesMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m NodeSignatureSharesMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	return strings.Join(msgs, "; ")
+}
 
 // AllErrors returns a list of validation violation errors.
-func (m NodeSignatureSharesMultiError) AllErrors() []error { return m }
+func (m UTXOMultiError) AllErrors() []error { return m }
 
-// NodeSignatureSharesValidationError is the validation error returned by
-// NodeSignatureShares.Validate if the designated constraints aren't met.
-type NodeSignatureSharesValidationError struct {
+// UTXOValidationError is the validation error returned by UTXO.Validate if the
+// designated constraints aren't met.
+type UTXOValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2403,24 +2401,22 @@ type NodeSignatureSharesValidationError struct {
 }
 
 // Field function returns field value.
-func (e NodeSignatureSharesValidationError) Field() string { return e.field }
+func (e UTXOValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NodeSignatureSharesValidationError) Reason() string { return e.reason }
+func (e UTXOValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NodeSignatureSharesValidationError) Cause() error { return e.cause }
+func (e UTXOValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NodeSignatureSharesValidationError) Key() bool { return e.key }
+func (e UTXOValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NodeSignatureSharesValidationError) ErrorName() string {
-	return "NodeSignatureSharesValidationError"
-}
+func (e UTXOValidationError) ErrorName() string { return "UTXOValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NodeSignatureSharesValidationError) Error() string {
+func (e UTXOValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2432,14 +2428,14 @@ func (e NodeSignatureSharesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNodeSignatureShares.%s: %s%s",
+		"invalid %sUTXO.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NodeSignatureSharesValidationError{}
+var _ error = UTXOValidationError{}
 
 var _ interface {
 	Field() string
@@ -2447,24 +2443,28 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NodeSignatureSharesValidationError{}
+} = UTXOValidationError{}
 
-// Validate checks the field values on NodeSignatures with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+var _UTXO_Network_NotInLookup = map[Network]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on NodeOutput with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *NodeSignatures) Validate() error {
+func (m *NodeOutput) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NodeSignatures with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NodeSignaturesMultiError,
-// or nil if none found.
-func (m *NodeSignatures) ValidateAll() error {
+// ValidateAll checks the field values on NodeOutput with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NodeOutputMultiError, or
+// nil if none found.
+func (m *NodeOutput) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NodeSignatures) validate(all bool) error {
+func (m *NodeOutput) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2473,30 +2473,21 @@ func (m *NodeSignatures) validate(all bool) error {
 
 	// no validation rules for NodeId
 
-	// no validation rules for NodeTxSignature
-
-	// no validation rules for RefundTxSignature
-
-	// no validation rules for DirectNodeTxSignature
-
-	// no validation rules for DirectRefundTxSignature
-
-	// no validation rules for DirectFromCpfpRefundTxSignature
+	// no validation rules for Vout
 
 	if len(errors) > 0 {
-		return NodeSignaturesMultiError(errors)
+		return NodeOutputMultiError(errors)
 	}
 
 	return nil
 }
 
-// NodeSignaturesMultiError is an error wrapping multiple validation errors
-// returned by NodeSignatures.ValidateAll() if the designated constraints
-// aren't met.
-type NodeSignaturesMultiError []error
+// NodeOutputMultiError is an error wrapping multiple validation errors
+// returned by NodeOutput.ValidateAll() if the designated constraints aren't met.
+type NodeOutputMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NodeSignaturesMultiError) Error() string {
+func (m NodeOutputMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2505,11 +2496,11 @@ func (m NodeSignaturesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NodeSignaturesMultiError) AllErrors() []error { return m }
+func (m NodeOutputMultiError) AllErrors() []error { return m }
 
-// NodeSignaturesValidationError is the validation error returned by
-// NodeSignatures.Validate if the designated constraints aren't met.
-type NodeSignaturesValidationError struct {
+// NodeOutputValidationError is the validation error returned by
+// NodeOutput.Validate if the designated constraints aren't met.
+type NodeOutputValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2517,22 +2508,22 @@ type NodeSignaturesValidationError struct {
 }
 
 // Field function returns field value.
-func (e NodeSignaturesValidationError) Field() string { return e.field }
+func (e NodeOutputValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NodeSignaturesValidationError) Reason() string { return e.reason }
+func (e NodeOutputValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NodeSignaturesValidationError) Cause() error { return e.cause }
+func (e NodeOutputValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NodeSignaturesValidationError) Key() bool { return e.key }
+func (e NodeOutputValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NodeSignaturesValidationError) ErrorName() string { return "NodeSignaturesValidationError" }
+func (e NodeOutputValidationError) ErrorName() string { return "NodeOutputValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NodeSignaturesValidationError) Error() string {
+func (e NodeOutputValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2544,14 +2535,14 @@ func (e NodeSignaturesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNodeSignatures.%s: %s%s",
+		"invalid %sNodeOutput.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NodeSignaturesValidationError{}
+var _ error = NodeOutputValidationError{}
 
 var _ interface {
 	Field() string
@@ -2559,233 +2550,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NodeSignaturesValidationError{}
+} = NodeOutputValidationError{}
 
-// Validate checks the field values on StartTreeCreationRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartTreeCreationRequest) Validate() error {
+// Validate checks the field values on SigningJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SigningJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartTreeCreationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// StartTreeCreationRequestMultiError, or nil if none found.
-func (m *StartTreeCreationRequest) ValidateAll() error {
+// ValidateAll checks the field values on SigningJob with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SigningJobMultiError, or
+// nil if none found.
+func (m *SigningJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartTreeCreationRequest) validate(all bool) error {
+func (m *SigningJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IdentityPublicKey
+	// no validation rules for SigningPublicKey
+
+	// no validation rules for RawTx
 
 	if all {
-		switch v := interface{}(m.GetOnChainUtxo()).(type) {
+		switch v := interface{}(m.GetSigningNonceCommitment()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "OnChainUtxo",
+				errors = append(errors, SigningJobValidationError{
+					field:  "SigningNonceCommitment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "OnChainUtxo",
+				errors = append(errors, SigningJobValidationError{
+					field:  "SigningNonceCommitment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSigningNonceCommitment()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartTreeCreationRequestValidationError{
-				field:  "OnChainUtxo",
+			return SigningJobValidationError{
+				field:  "SigningNonceCommitment",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetRootTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "RootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "RootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRootTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTreeCreationRequestValidationError{
-				field:  "RootTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return SigningJobMultiError(errors)
 	}
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "RefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "RefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTreeCreationRequestValidationError{
-				field:  "RefundTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// SigningJobMultiError is an error wrapping multiple validation errors
+// returned by SigningJob.ValidateAll() if the designated constraints aren't met.
+type SigningJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SigningJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
-
-	if all {
-		switch v := interface{}(m.GetDirectRootTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "DirectRootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "DirectRootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRootTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTreeCreationRequestValidationError{
-				field:  "DirectRootTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "DirectRefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "DirectRefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTreeCreationRequestValidationError{
-				field:  "DirectRefundTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationRequestValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTreeCreationRequestValidationError{
-				field:  "DirectFromCpfpRefundTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(errors) > 0 {
-		return StartTreeCreationRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// StartTreeCreationRequestMultiError is an error wrapping multiple validation
-// errors returned by StartTreeCreationRequest.ValidateAll() if the designated
-// constraints aren't met.
-type StartTreeCreationRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m StartTreeCreationRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	return strings.Join(msgs, "; ")
+}
 
 // AllErrors returns a list of validation violation errors.
-func (m StartTreeCreationRequestMultiError) AllErrors() []error { return m }
+func (m SigningJobMultiError) AllErrors() []error { return m }
 
-// StartTreeCreationRequestValidationError is the validation error returned by
-// StartTreeCreationRequest.Validate if the designated constraints aren't met.
-type StartTreeCreationRequestValidationError struct {
+// SigningJobValidationError is the validation error returned by
+// SigningJob.Validate if the designated constraints aren't met.
+type SigningJobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2793,24 +2640,22 @@ type StartTreeCreationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartTreeCreationRequestValidationError) Field() string { return e.field }
+func (e SigningJobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartTreeCreationRequestValidationError) Reason() string { return e.reason }
+func (e SigningJobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartTreeCreationRequestValidationError) Cause() error { return e.cause }
+func (e SigningJobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartTreeCreationRequestValidationError) Key() bool { return e.key }
+func (e SigningJobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartTreeCreationRequestValidationError) ErrorName() string {
-	return "StartTreeCreationRequestValidationError"
-}
+func (e SigningJobValidationError) ErrorName() string { return "SigningJobValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StartTreeCreationRequestValidationError) Error() string {
+func (e SigningJobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2822,14 +2667,14 @@ func (e StartTreeCreationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartTreeCreationRequest.%s: %s%s",
+		"invalid %sSigningJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartTreeCreationRequestValidationError{}
+var _ error = SigningJobValidationError{}
 
 var _ interface {
 	Field() string
@@ -2837,55 +2682,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartTreeCreationRequestValidationError{}
+} = SigningJobValidationError{}
 
-// Validate checks the field values on StartTreeCreationResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartTreeCreationResponse) Validate() error {
+// Validate checks the field values on SigningKeyshare with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SigningKeyshare) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartTreeCreationResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SigningKeyshare with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StartTreeCreationResponseMultiError, or nil if none found.
-func (m *StartTreeCreationResponse) ValidateAll() error {
+// SigningKeyshareMultiError, or nil if none found.
+func (m *SigningKeyshare) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartTreeCreationResponse) validate(all bool) error {
+func (m *SigningKeyshare) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TreeId
+	// no validation rules for Threshold
+
+	// no validation rules for PublicKey
+
+	// no validation rules for PublicShares
 
 	if all {
-		switch v := interface{}(m.GetRootNodeSignatureShares()).(type) {
+		switch v := interface{}(m.GetUpdatedTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTreeCreationResponseValidationError{
-					field:  "RootNodeSignatureShares",
+				errors = append(errors, SigningKeyshareValidationError{
+					field:  "UpdatedTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTreeCreationResponseValidationError{
-					field:  "RootNodeSignatureShares",
+				errors = append(errors, SigningKeyshareValidationError{
+					field:  "UpdatedTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRootNodeSignatureShares()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUpdatedTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartTreeCreationResponseValidationError{
-				field:  "RootNodeSignatureShares",
+			return SigningKeyshareValidationError{
+				field:  "UpdatedTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2893,19 +2742,19 @@ func (m *StartTreeCreationResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StartTreeCreationResponseMultiError(errors)
+		return SigningKeyshareMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartTreeCreationResponseMultiError is an error wrapping multiple validation
-// errors returned by StartTreeCreationResponse.ValidateAll() if the
-// designated constraints aren't met.
-type StartTreeCreationResponseMultiError []error
+// SigningKeyshareMultiError is an error wrapping multiple validation errors
+// returned by SigningKeyshare.ValidateAll() if the designated constraints
+// aren't met.
+type SigningKeyshareMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartTreeCreationResponseMultiError) Error() string {
+func (m SigningKeyshareMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2914,11 +2763,11 @@ func (m StartTreeCreationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartTreeCreationResponseMultiError) AllErrors() []error { return m }
+func (m SigningKeyshareMultiError) AllErrors() []error { return m }
 
-// StartTreeCreationResponseValidationError is the validation error returned by
-// StartTreeCreationResponse.Validate if the designated constraints aren't met.
-type StartTreeCreationResponseValidationError struct {
+// SigningKeyshareValidationError is the validation error returned by
+// SigningKeyshare.Validate if the designated constraints aren't met.
+type SigningKeyshareValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2926,24 +2775,22 @@ type StartTreeCreationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartTreeCreationResponseValidationError) Field() string { return e.field }
+func (e SigningKeyshareValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartTreeCreationResponseValidationError) Reason() string { return e.reason }
+func (e SigningKeyshareValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartTreeCreationResponseValidationError) Cause() error { return e.cause }
+func (e SigningKeyshareValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartTreeCreationResponseValidationError) Key() bool { return e.key }
+func (e SigningKeyshareValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartTreeCreationResponseValidationError) ErrorName() string {
-	return "StartTreeCreationResponseValidationError"
-}
+func (e SigningKeyshareValidationError) ErrorName() string { return "SigningKeyshareValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StartTreeCreationResponseValidationError) Error() string {
+func (e SigningKeyshareValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2955,14 +2802,14 @@ func (e StartTreeCreationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartTreeCreationResponse.%s: %s%s",
+		"invalid %sSigningKeyshare.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartTreeCreationResponseValidationError{}
+var _ error = SigningKeyshareValidationError{}
 
 var _ interface {
 	Field() string
@@ -2970,200 +2817,103 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartTreeCreationResponseValidationError{}
+} = SigningKeyshareValidationError{}
 
-// Validate checks the field values on StartDepositTreeCreationRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartDepositTreeCreationRequest) Validate() error {
+// Validate checks the field values on SigningResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SigningResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartDepositTreeCreationRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// StartDepositTreeCreationRequestMultiError, or nil if none found.
-func (m *StartDepositTreeCreationRequest) ValidateAll() error {
+// ValidateAll checks the field values on SigningResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SigningResultMultiError, or
+// nil if none found.
+func (m *SigningResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartDepositTreeCreationRequest) validate(all bool) error {
+func (m *SigningResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IdentityPublicKey
+	// no validation rules for PublicKeys
 
-	if all {
-		switch v := interface{}(m.GetOnChainUtxo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	{
+		sorted_keys := make([]string, len(m.GetSigningNonceCommitments()))
+		i := 0
+		for key := range m.GetSigningNonceCommitments() {
+			sorted_keys[i] = key
+			i++
 		}
-	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationRequestValidationError{
-				field:  "OnChainUtxo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetSigningNonceCommitments()[key]
+			_ = val
 
-	if all {
-		switch v := interface{}(m.GetRootTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "RootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "RootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRootTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationRequestValidationError{
-				field:  "RootTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+			// no validation rules for SigningNonceCommitments[key]
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "RefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "RefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationRequestValidationError{
-				field:  "RefundTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, SigningResultValidationError{
+							field:  fmt.Sprintf("SigningNonceCommitments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, SigningResultValidationError{
+							field:  fmt.Sprintf("SigningNonceCommitments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return SigningResultValidationError{
+						field:  fmt.Sprintf("SigningNonceCommitments[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
 			}
-		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetDirectRootTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "DirectRootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "DirectRootTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRootTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationRequestValidationError{
-				field:  "DirectRootTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "DirectRefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "DirectRefundTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationRequestValidationError{
-				field:  "DirectRefundTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for SignatureShares
 
 	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetSigningKeyshare()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, SigningResultValidationError{
+					field:  "SigningKeyshare",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationRequestValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, SigningResultValidationError{
+					field:  "SigningKeyshare",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSigningKeyshare()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationRequestValidationError{
-				field:  "DirectFromCpfpRefundTxSigningJob",
+			return SigningResultValidationError{
+				field:  "SigningKeyshare",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3171,19 +2921,19 @@ func (m *StartDepositTreeCreationRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StartDepositTreeCreationRequestMultiError(errors)
+		return SigningResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartDepositTreeCreationRequestMultiError is an error wrapping multiple
-// validation errors returned by StartDepositTreeCreationRequest.ValidateAll()
-// if the designated constraints aren't met.
-type StartDepositTreeCreationRequestMultiError []error
+// SigningResultMultiError is an error wrapping multiple validation errors
+// returned by SigningResult.ValidateAll() if the designated constraints
+// aren't met.
+type SigningResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartDepositTreeCreationRequestMultiError) Error() string {
+func (m SigningResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3192,12 +2942,11 @@ func (m StartDepositTreeCreationRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartDepositTreeCreationRequestMultiError) AllErrors() []error { return m }
+func (m SigningResultMultiError) AllErrors() []error { return m }
 
-// StartDepositTreeCreationRequestValidationError is the validation error
-// returned by StartDepositTreeCreationRequest.Validate if the designated
-// constraints aren't met.
-type StartDepositTreeCreationRequestValidationError struct {
+// SigningResultValidationError is the validation error returned by
+// SigningResult.Validate if the designated constraints aren't met.
+type SigningResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3205,24 +2954,22 @@ type StartDepositTreeCreationRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartDepositTreeCreationRequestValidationError) Field() string { return e.field }
+func (e SigningResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartDepositTreeCreationRequestValidationError) Reason() string { return e.reason }
+func (e SigningResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartDepositTreeCreationRequestValidationError) Cause() error { return e.cause }
+func (e SigningResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartDepositTreeCreationRequestValidationError) Key() bool { return e.key }
+func (e SigningResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartDepositTreeCreationRequestValidationError) ErrorName() string {
-	return "StartDepositTreeCreationRequestValidationError"
-}
+func (e SigningResultValidationError) ErrorName() string { return "SigningResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StartDepositTreeCreationRequestValidationError) Error() string {
+func (e SigningResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3234,14 +2981,14 @@ func (e StartDepositTreeCreationRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartDepositTreeCreationRequest.%s: %s%s",
+		"invalid %sSigningResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartDepositTreeCreationRequestValidationError{}
+var _ error = SigningResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -3249,77 +2996,193 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartDepositTreeCreationRequestValidationError{}
+} = SigningResultValidationError{}
 
-// Validate checks the field values on StartDepositTreeCreationResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *StartDepositTreeCreationResponse) Validate() error {
+// Validate checks the field values on NodeSignatureShares with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NodeSignatureShares) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartDepositTreeCreationResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// StartDepositTreeCreationResponseMultiError, or nil if none found.
-func (m *StartDepositTreeCreationResponse) ValidateAll() error {
+// ValidateAll checks the field values on NodeSignatureShares with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NodeSignatureSharesMultiError, or nil if none found.
+func (m *NodeSignatureShares) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartDepositTreeCreationResponse) validate(all bool) error {
+func (m *NodeSignatureShares) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TreeId
+	// no validation rules for NodeId
 
 	if all {
-		switch v := interface{}(m.GetRootNodeSignatureShares()).(type) {
+		switch v := interface{}(m.GetNodeTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartDepositTreeCreationResponseValidationError{
-					field:  "RootNodeSignatureShares",
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "NodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartDepositTreeCreationResponseValidationError{
-					field:  "RootNodeSignatureShares",
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "NodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRootNodeSignatureShares()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNodeTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartDepositTreeCreationResponseValidationError{
-				field:  "RootNodeSignatureShares",
+			return NodeSignatureSharesValidationError{
+				field:  "NodeTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if len(errors) > 0 {
-		return StartDepositTreeCreationResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// StartDepositTreeCreationResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// StartDepositTreeCreationResponse.ValidateAll() if the designated
-// constraints aren't met.
-type StartDepositTreeCreationResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m StartDepositTreeCreationResponseMultiError) Error() string {
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "RefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "RefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeSignatureSharesValidationError{
+				field:  "RefundTxSigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for VerifyingKey
+
+	if all {
+		switch v := interface{}(m.GetDirectNodeTxSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "DirectNodeTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "DirectNodeTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectNodeTxSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeSignatureSharesValidationError{
+				field:  "DirectNodeTxSigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "DirectRefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "DirectRefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeSignatureSharesValidationError{
+				field:  "DirectRefundTxSigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NodeSignatureSharesValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NodeSignatureSharesValidationError{
+				field:  "DirectFromCpfpRefundTxSigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NodeSignatureSharesMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeSignatureSharesMultiError is an error wrapping multiple validation
+// errors returned by NodeSignatureShares.ValidateAll() if the designated
+// constraints aren't met.
+type NodeSignatureSharesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeSignatureSharesMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3328,12 +3191,11 @@ func (m StartDepositTreeCreationResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartDepositTreeCreationResponseMultiError) AllErrors() []error { return m }
+func (m NodeSignatureSharesMultiError) AllErrors() []error { return m }
 
-// StartDepositTreeCreationResponseValidationError is the validation error
-// returned by StartDepositTreeCreationResponse.Validate if the designated
-// constraints aren't met.
-type StartDepositTreeCreationResponseValidationError struct {
+// NodeSignatureSharesValidationError is the validation error returned by
+// NodeSignatureShares.Validate if the designated constraints aren't met.
+type NodeSignatureSharesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3341,24 +3203,24 @@ type StartDepositTreeCreationResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartDepositTreeCreationResponseValidationError) Field() string { return e.field }
+func (e NodeSignatureSharesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartDepositTreeCreationResponseValidationError) Reason() string { return e.reason }
+func (e NodeSignatureSharesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartDepositTreeCreationResponseValidationError) Cause() error { return e.cause }
+func (e NodeSignatureSharesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartDepositTreeCreationResponseValidationError) Key() bool { return e.key }
+func (e NodeSignatureSharesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartDepositTreeCreationResponseValidationError) ErrorName() string {
-	return "StartDepositTreeCreationResponseValidationError"
+func (e NodeSignatureSharesValidationError) ErrorName() string {
+	return "NodeSignatureSharesValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartDepositTreeCreationResponseValidationError) Error() string {
+func (e NodeSignatureSharesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3370,14 +3232,14 @@ func (e StartDepositTreeCreationResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartDepositTreeCreationResponse.%s: %s%s",
+		"invalid %sNodeSignatureShares.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartDepositTreeCreationResponseValidationError{}
+var _ error = NodeSignatureSharesValidationError{}
 
 var _ interface {
 	Field() string
@@ -3385,57 +3247,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartDepositTreeCreationResponseValidationError{}
+} = NodeSignatureSharesValidationError{}
 
-// Validate checks the field values on TokenOutputToSpend with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TokenOutputToSpend) Validate() error {
+// Validate checks the field values on NodeSignatures with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NodeSignatures) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenOutputToSpend with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// TokenOutputToSpendMultiError, or nil if none found.
-func (m *TokenOutputToSpend) ValidateAll() error {
+// ValidateAll checks the field values on NodeSignatures with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NodeSignaturesMultiError,
+// or nil if none found.
+func (m *NodeSignatures) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenOutputToSpend) validate(all bool) error {
+func (m *NodeSignatures) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetPrevTokenTransactionHash()) != 32 {
-		err := TokenOutputToSpendValidationError{
-			field:  "PrevTokenTransactionHash",
-			reason: "value length must be 32 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for NodeId
 
-	// no validation rules for PrevTokenTransactionVout
+	// no validation rules for NodeTxSignature
+
+	// no validation rules for RefundTxSignature
+
+	// no validation rules for DirectNodeTxSignature
+
+	// no validation rules for DirectRefundTxSignature
+
+	// no validation rules for DirectFromCpfpRefundTxSignature
 
 	if len(errors) > 0 {
-		return TokenOutputToSpendMultiError(errors)
+		return NodeSignaturesMultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenOutputToSpendMultiError is an error wrapping multiple validation errors
-// returned by TokenOutputToSpend.ValidateAll() if the designated constraints
+// NodeSignaturesMultiError is an error wrapping multiple validation errors
+// returned by NodeSignatures.ValidateAll() if the designated constraints
 // aren't met.
-type TokenOutputToSpendMultiError []error
+type NodeSignaturesMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenOutputToSpendMultiError) Error() string {
+func (m NodeSignaturesMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3444,11 +3305,11 @@ func (m TokenOutputToSpendMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenOutputToSpendMultiError) AllErrors() []error { return m }
+func (m NodeSignaturesMultiError) AllErrors() []error { return m }
 
-// TokenOutputToSpendValidationError is the validation error returned by
-// TokenOutputToSpend.Validate if the designated constraints aren't met.
-type TokenOutputToSpendValidationError struct {
+// NodeSignaturesValidationError is the validation error returned by
+// NodeSignatures.Validate if the designated constraints aren't met.
+type NodeSignaturesValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3456,24 +3317,22 @@ type TokenOutputToSpendValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenOutputToSpendValidationError) Field() string { return e.field }
+func (e NodeSignaturesValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenOutputToSpendValidationError) Reason() string { return e.reason }
+func (e NodeSignaturesValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenOutputToSpendValidationError) Cause() error { return e.cause }
+func (e NodeSignaturesValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenOutputToSpendValidationError) Key() bool { return e.key }
+func (e NodeSignaturesValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenOutputToSpendValidationError) ErrorName() string {
-	return "TokenOutputToSpendValidationError"
-}
+func (e NodeSignaturesValidationError) ErrorName() string { return "NodeSignaturesValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TokenOutputToSpendValidationError) Error() string {
+func (e NodeSignaturesValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3485,14 +3344,14 @@ func (e TokenOutputToSpendValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokenOutputToSpend.%s: %s%s",
+		"invalid %sNodeSignatures.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenOutputToSpendValidationError{}
+var _ error = NodeSignaturesValidationError{}
 
 var _ interface {
 	Field() string
@@ -3500,31 +3359,972 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenOutputToSpendValidationError{}
+} = NodeSignaturesValidationError{}
 
-// Validate checks the field values on TokenTransferInput with the rules
+// Validate checks the field values on StartTreeCreationRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TokenTransferInput) Validate() error {
+func (m *StartTreeCreationRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenTransferInput with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartTreeCreationRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TokenTransferInputMultiError, or nil if none found.
-func (m *TokenTransferInput) ValidateAll() error {
+// StartTreeCreationRequestMultiError, or nil if none found.
+func (m *StartTreeCreationRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenTransferInput) validate(all bool) error {
+func (m *StartTreeCreationRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetOutputsToSpend() {
+	// no validation rules for IdentityPublicKey
+
+	if all {
+		switch v := interface{}(m.GetOnChainUtxo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationRequestValidationError{
+				field:  "OnChainUtxo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRootTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "RootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "RootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRootTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationRequestValidationError{
+				field:  "RootTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationRequestValidationError{
+				field:  "RefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRootTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "DirectRootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "DirectRootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRootTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationRequestValidationError{
+				field:  "DirectRootTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationRequestValidationError{
+				field:  "DirectRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationRequestValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationRequestValidationError{
+				field:  "DirectFromCpfpRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartTreeCreationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartTreeCreationRequestMultiError is an error wrapping multiple validation
+// errors returned by StartTreeCreationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartTreeCreationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartTreeCreationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartTreeCreationRequestMultiError) AllErrors() []error { return m }
+
+// StartTreeCreationRequestValidationError is the validation error returned by
+// StartTreeCreationRequest.Validate if the designated constraints aren't met.
+type StartTreeCreationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartTreeCreationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartTreeCreationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartTreeCreationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartTreeCreationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartTreeCreationRequestValidationError) ErrorName() string {
+	return "StartTreeCreationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartTreeCreationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartTreeCreationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartTreeCreationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartTreeCreationRequestValidationError{}
+
+// Validate checks the field values on StartTreeCreationResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartTreeCreationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartTreeCreationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartTreeCreationResponseMultiError, or nil if none found.
+func (m *StartTreeCreationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartTreeCreationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TreeId
+
+	if all {
+		switch v := interface{}(m.GetRootNodeSignatureShares()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTreeCreationResponseValidationError{
+					field:  "RootNodeSignatureShares",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTreeCreationResponseValidationError{
+					field:  "RootNodeSignatureShares",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRootNodeSignatureShares()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTreeCreationResponseValidationError{
+				field:  "RootNodeSignatureShares",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartTreeCreationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartTreeCreationResponseMultiError is an error wrapping multiple validation
+// errors returned by StartTreeCreationResponse.ValidateAll() if the
+// designated constraints aren't met.
+type StartTreeCreationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartTreeCreationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartTreeCreationResponseMultiError) AllErrors() []error { return m }
+
+// StartTreeCreationResponseValidationError is the validation error returned by
+// StartTreeCreationResponse.Validate if the designated constraints aren't met.
+type StartTreeCreationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartTreeCreationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartTreeCreationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartTreeCreationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartTreeCreationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartTreeCreationResponseValidationError) ErrorName() string {
+	return "StartTreeCreationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartTreeCreationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartTreeCreationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartTreeCreationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartTreeCreationResponseValidationError{}
+
+// Validate checks the field values on StartDepositTreeCreationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartDepositTreeCreationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDepositTreeCreationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StartDepositTreeCreationRequestMultiError, or nil if none found.
+func (m *StartDepositTreeCreationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDepositTreeCreationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IdentityPublicKey
+
+	if all {
+		switch v := interface{}(m.GetOnChainUtxo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationRequestValidationError{
+				field:  "OnChainUtxo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRootTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "RootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "RootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRootTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationRequestValidationError{
+				field:  "RootTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationRequestValidationError{
+				field:  "RefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRootTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "DirectRootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "DirectRootTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRootTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationRequestValidationError{
+				field:  "DirectRootTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationRequestValidationError{
+				field:  "DirectRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationRequestValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationRequestValidationError{
+				field:  "DirectFromCpfpRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartDepositTreeCreationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDepositTreeCreationRequestMultiError is an error wrapping multiple
+// validation errors returned by StartDepositTreeCreationRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StartDepositTreeCreationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDepositTreeCreationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDepositTreeCreationRequestMultiError) AllErrors() []error { return m }
+
+// StartDepositTreeCreationRequestValidationError is the validation error
+// returned by StartDepositTreeCreationRequest.Validate if the designated
+// constraints aren't met.
+type StartDepositTreeCreationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDepositTreeCreationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDepositTreeCreationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDepositTreeCreationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDepositTreeCreationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDepositTreeCreationRequestValidationError) ErrorName() string {
+	return "StartDepositTreeCreationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDepositTreeCreationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDepositTreeCreationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDepositTreeCreationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDepositTreeCreationRequestValidationError{}
+
+// Validate checks the field values on StartDepositTreeCreationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartDepositTreeCreationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartDepositTreeCreationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StartDepositTreeCreationResponseMultiError, or nil if none found.
+func (m *StartDepositTreeCreationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartDepositTreeCreationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TreeId
+
+	if all {
+		switch v := interface{}(m.GetRootNodeSignatureShares()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartDepositTreeCreationResponseValidationError{
+					field:  "RootNodeSignatureShares",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartDepositTreeCreationResponseValidationError{
+					field:  "RootNodeSignatureShares",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRootNodeSignatureShares()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartDepositTreeCreationResponseValidationError{
+				field:  "RootNodeSignatureShares",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StartDepositTreeCreationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartDepositTreeCreationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// StartDepositTreeCreationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartDepositTreeCreationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartDepositTreeCreationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartDepositTreeCreationResponseMultiError) AllErrors() []error { return m }
+
+// StartDepositTreeCreationResponseValidationError is the validation error
+// returned by StartDepositTreeCreationResponse.Validate if the designated
+// constraints aren't met.
+type StartDepositTreeCreationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartDepositTreeCreationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartDepositTreeCreationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartDepositTreeCreationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartDepositTreeCreationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartDepositTreeCreationResponseValidationError) ErrorName() string {
+	return "StartDepositTreeCreationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartDepositTreeCreationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartDepositTreeCreationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartDepositTreeCreationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartDepositTreeCreationResponseValidationError{}
+
+// Validate checks the field values on TokenOutputToSpend with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenOutputToSpend) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenOutputToSpend with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenOutputToSpendMultiError, or nil if none found.
+func (m *TokenOutputToSpend) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenOutputToSpend) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetPrevTokenTransactionHash()) != 32 {
+		err := TokenOutputToSpendValidationError{
+			field:  "PrevTokenTransactionHash",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PrevTokenTransactionVout
+
+	if len(errors) > 0 {
+		return TokenOutputToSpendMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenOutputToSpendMultiError is an error wrapping multiple validation errors
+// returned by TokenOutputToSpend.ValidateAll() if the designated constraints
+// aren't met.
+type TokenOutputToSpendMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenOutputToSpendMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenOutputToSpendMultiError) AllErrors() []error { return m }
+
+// TokenOutputToSpendValidationError is the validation error returned by
+// TokenOutputToSpend.Validate if the designated constraints aren't met.
+type TokenOutputToSpendValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenOutputToSpendValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenOutputToSpendValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenOutputToSpendValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenOutputToSpendValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenOutputToSpendValidationError) ErrorName() string {
+	return "TokenOutputToSpendValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenOutputToSpendValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenOutputToSpend.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenOutputToSpendValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenOutputToSpendValidationError{}
+
+// Validate checks the field values on TokenTransferInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenTransferInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenTransferInput with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenTransferInputMultiError, or nil if none found.
+func (m *TokenTransferInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenTransferInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOutputsToSpend() {
 		_, _ = idx, item
 
 		if all {
@@ -3982,6 +4782,35 @@ func (m *TokenOutput) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSpendableAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenOutputValidationError{
+					field:  "SpendableAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenOutputValidationError{
+					field:  "SpendableAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpendableAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenOutputValidationError{
+				field:  "SpendableAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Id != nil {
 
 		if err := m._validateUuid(m.GetId()); err != nil {
@@ -7933,19 +8762,260 @@ func (m *FinalizeNodeSignaturesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return FinalizeNodeSignaturesRequestMultiError(errors)
+		return FinalizeNodeSignaturesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinalizeNodeSignaturesRequestMultiError is an error wrapping multiple
+// validation errors returned by FinalizeNodeSignaturesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type FinalizeNodeSignaturesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinalizeNodeSignaturesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinalizeNodeSignaturesRequestMultiError) AllErrors() []error { return m }
+
+// FinalizeNodeSignaturesRequestValidationError is the validation error
+// returned by FinalizeNodeSignaturesRequest.Validate if the designated
+// constraints aren't met.
+type FinalizeNodeSignaturesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinalizeNodeSignaturesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinalizeNodeSignaturesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinalizeNodeSignaturesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinalizeNodeSignaturesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinalizeNodeSignaturesRequestValidationError) ErrorName() string {
+	return "FinalizeNodeSignaturesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinalizeNodeSignaturesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinalizeNodeSignaturesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinalizeNodeSignaturesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinalizeNodeSignaturesRequestValidationError{}
+
+// Validate checks the field values on FinalizeNodeSignaturesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FinalizeNodeSignaturesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FinalizeNodeSignaturesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FinalizeNodeSignaturesResponseMultiError, or nil if none found.
+func (m *FinalizeNodeSignaturesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FinalizeNodeSignaturesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FinalizeNodeSignaturesResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FinalizeNodeSignaturesResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FinalizeNodeSignaturesResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FinalizeNodeSignaturesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FinalizeNodeSignaturesResponseMultiError is an error wrapping multiple
+// validation errors returned by FinalizeNodeSignaturesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type FinalizeNodeSignaturesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FinalizeNodeSignaturesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FinalizeNodeSignaturesResponseMultiError) AllErrors() []error { return m }
+
+// FinalizeNodeSignaturesResponseValidationError is the validation error
+// returned by FinalizeNodeSignaturesResponse.Validate if the designated
+// constraints aren't met.
+type FinalizeNodeSignaturesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FinalizeNodeSignaturesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FinalizeNodeSignaturesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FinalizeNodeSignaturesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FinalizeNodeSignaturesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FinalizeNodeSignaturesResponseValidationError) ErrorName() string {
+	return "FinalizeNodeSignaturesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FinalizeNodeSignaturesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFinalizeNodeSignaturesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FinalizeNodeSignaturesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FinalizeNodeSignaturesResponseValidationError{}
+
+// Validate checks the field values on SecretShare with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretShare) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretShare with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretShareMultiError, or
+// nil if none found.
+func (m *SecretShare) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretShare) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SecretShare
+
+	if len(errors) > 0 {
+		return SecretShareMultiError(errors)
 	}
 
 	return nil
 }
 
-// FinalizeNodeSignaturesRequestMultiError is an error wrapping multiple
-// validation errors returned by FinalizeNodeSignaturesRequest.ValidateAll()
-// if the designated constraints aren't met.
-type FinalizeNodeSignaturesRequestMultiError []error
+// SecretShareMultiError is an error wrapping multiple validation errors
+// returned by SecretShare.ValidateAll() if the designated constraints aren't met.
+type SecretShareMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FinalizeNodeSignaturesRequestMultiError) Error() string {
+func (m SecretShareMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7954,12 +9024,11 @@ func (m FinalizeNodeSignaturesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FinalizeNodeSignaturesRequestMultiError) AllErrors() []error { return m }
+func (m SecretShareMultiError) AllErrors() []error { return m }
 
-// FinalizeNodeSignaturesRequestValidationError is the validation error
-// returned by FinalizeNodeSignaturesRequest.Validate if the designated
-// constraints aren't met.
-type FinalizeNodeSignaturesRequestValidationError struct {
+// SecretShareValidationError is the validation error returned by
+// SecretShare.Validate if the designated constraints aren't met.
+type SecretShareValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7967,24 +9036,22 @@ type FinalizeNodeSignaturesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e FinalizeNodeSignaturesRequestValidationError) Field() string { return e.field }
+func (e SecretShareValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FinalizeNodeSignaturesRequestValidationError) Reason() string { return e.reason }
+func (e SecretShareValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FinalizeNodeSignaturesRequestValidationError) Cause() error { return e.cause }
+func (e SecretShareValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FinalizeNodeSignaturesRequestValidationError) Key() bool { return e.key }
+func (e SecretShareValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FinalizeNodeSignaturesRequestValidationError) ErrorName() string {
-	return "FinalizeNodeSignaturesRequestValidationError"
-}
+func (e SecretShareValidationError) ErrorName() string { return "SecretShareValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FinalizeNodeSignaturesRequestValidationError) Error() string {
+func (e SecretShareValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7996,14 +9063,14 @@ func (e FinalizeNodeSignaturesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFinalizeNodeSignaturesRequest.%s: %s%s",
+		"invalid %sSecretShare.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FinalizeNodeSignaturesRequestValidationError{}
+var _ error = SecretShareValidationError{}
 
 var _ interface {
 	Field() string
@@ -8011,78 +9078,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FinalizeNodeSignaturesRequestValidationError{}
+} = SecretShareValidationError{}
 
-// Validate checks the field values on FinalizeNodeSignaturesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FinalizeNodeSignaturesResponse) Validate() error {
+// Validate checks the field values on SecretProof with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretProof) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FinalizeNodeSignaturesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// FinalizeNodeSignaturesResponseMultiError, or nil if none found.
-func (m *FinalizeNodeSignaturesResponse) ValidateAll() error {
+// ValidateAll checks the field values on SecretProof with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretProofMultiError, or
+// nil if none found.
+func (m *SecretProof) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FinalizeNodeSignaturesResponse) validate(all bool) error {
+func (m *SecretProof) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetNodes() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, FinalizeNodeSignaturesResponseValidationError{
-						field:  fmt.Sprintf("Nodes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, FinalizeNodeSignaturesResponseValidationError{
-						field:  fmt.Sprintf("Nodes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return FinalizeNodeSignaturesResponseValidationError{
-					field:  fmt.Sprintf("Nodes[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return FinalizeNodeSignaturesResponseMultiError(errors)
+		return SecretProofMultiError(errors)
 	}
 
 	return nil
 }
 
-// FinalizeNodeSignaturesResponseMultiError is an error wrapping multiple
-// validation errors returned by FinalizeNodeSignaturesResponse.ValidateAll()
-// if the designated constraints aren't met.
-type FinalizeNodeSignaturesResponseMultiError []error
+// SecretProofMultiError is an error wrapping multiple validation errors
+// returned by SecretProof.ValidateAll() if the designated constraints aren't met.
+type SecretProofMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FinalizeNodeSignaturesResponseMultiError) Error() string {
+func (m SecretProofMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8091,12 +9123,11 @@ func (m FinalizeNodeSignaturesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FinalizeNodeSignaturesResponseMultiError) AllErrors() []error { return m }
+func (m SecretProofMultiError) AllErrors() []error { return m }
 
-// FinalizeNodeSignaturesResponseValidationError is the validation error
-// returned by FinalizeNodeSignaturesResponse.Validate if the designated
-// constraints aren't met.
-type FinalizeNodeSignaturesResponseValidationError struct {
+// SecretProofValidationError is the validation error returned by
+// SecretProof.Validate if the designated constraints aren't met.
+type SecretProofValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8104,24 +9135,22 @@ type FinalizeNodeSignaturesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e FinalizeNodeSignaturesResponseValidationError) Field() string { return e.field }
+func (e SecretProofValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FinalizeNodeSignaturesResponseValidationError) Reason() string { return e.reason }
+func (e SecretProofValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FinalizeNodeSignaturesResponseValidationError) Cause() error { return e.cause }
+func (e SecretProofValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FinalizeNodeSignaturesResponseValidationError) Key() bool { return e.key }
+func (e SecretProofValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FinalizeNodeSignaturesResponseValidationError) ErrorName() string {
-	return "FinalizeNodeSignaturesResponseValidationError"
-}
+func (e SecretProofValidationError) ErrorName() string { return "SecretProofValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FinalizeNodeSignaturesResponseValidationError) Error() string {
+func (e SecretProofValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8133,14 +9162,14 @@ func (e FinalizeNodeSignaturesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFinalizeNodeSignaturesResponse.%s: %s%s",
+		"invalid %sSecretProof.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FinalizeNodeSignaturesResponseValidationError{}
+var _ error = SecretProofValidationError{}
 
 var _ interface {
 	Field() string
@@ -8148,45 +9177,133 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FinalizeNodeSignaturesResponseValidationError{}
+} = SecretProofValidationError{}
 
-// Validate checks the field values on SecretShare with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecretShare) Validate() error {
+// Validate checks the field values on LeafRefundTxSigningJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LeafRefundTxSigningJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretShare with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretShareMultiError, or
-// nil if none found.
-func (m *SecretShare) ValidateAll() error {
+// ValidateAll checks the field values on LeafRefundTxSigningJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LeafRefundTxSigningJobMultiError, or nil if none found.
+func (m *LeafRefundTxSigningJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretShare) validate(all bool) error {
+func (m *LeafRefundTxSigningJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for SecretShare
-
+	var errors []error
+
+	// no validation rules for LeafId
+
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeafRefundTxSigningJobValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeafRefundTxSigningJobValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeafRefundTxSigningJobValidationError{
+				field:  "RefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeafRefundTxSigningJobValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeafRefundTxSigningJobValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeafRefundTxSigningJobValidationError{
+				field:  "DirectRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LeafRefundTxSigningJobValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LeafRefundTxSigningJobValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LeafRefundTxSigningJobValidationError{
+				field:  "DirectFromCpfpRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return SecretShareMultiError(errors)
+		return LeafRefundTxSigningJobMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretShareMultiError is an error wrapping multiple validation errors
-// returned by SecretShare.ValidateAll() if the designated constraints aren't met.
-type SecretShareMultiError []error
+// LeafRefundTxSigningJobMultiError is an error wrapping multiple validation
+// errors returned by LeafRefundTxSigningJob.ValidateAll() if the designated
+// constraints aren't met.
+type LeafRefundTxSigningJobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretShareMultiError) Error() string {
+func (m LeafRefundTxSigningJobMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8195,11 +9312,11 @@ func (m SecretShareMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretShareMultiError) AllErrors() []error { return m }
+func (m LeafRefundTxSigningJobMultiError) AllErrors() []error { return m }
 
-// SecretShareValidationError is the validation error returned by
-// SecretShare.Validate if the designated constraints aren't met.
-type SecretShareValidationError struct {
+// LeafRefundTxSigningJobValidationError is the validation error returned by
+// LeafRefundTxSigningJob.Validate if the designated constraints aren't met.
+type LeafRefundTxSigningJobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8207,22 +9324,24 @@ type SecretShareValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretShareValidationError) Field() string { return e.field }
+func (e LeafRefundTxSigningJobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretShareValidationError) Reason() string { return e.reason }
+func (e LeafRefundTxSigningJobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretShareValidationError) Cause() error { return e.cause }
+func (e LeafRefundTxSigningJobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretShareValidationError) Key() bool { return e.key }
+func (e LeafRefundTxSigningJobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretShareValidationError) ErrorName() string { return "SecretShareValidationError" }
+func (e LeafRefundTxSigningJobValidationError) ErrorName() string {
+	return "LeafRefundTxSigningJobValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SecretShareValidationError) Error() string {
+func (e LeafRefundTxSigningJobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8234,14 +9353,14 @@ func (e SecretShareValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretShare.%s: %s%s",
+		"invalid %sLeafRefundTxSigningJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretShareValidationError{}
+var _ error = LeafRefundTxSigningJobValidationError{}
 
 var _ interface {
 	Field() string
@@ -8249,43 +9368,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretShareValidationError{}
+} = LeafRefundTxSigningJobValidationError{}
 
-// Validate checks the field values on SecretProof with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SecretProof) Validate() error {
+// Validate checks the field values on UserSignedTxSigningJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserSignedTxSigningJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SecretProof with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SecretProofMultiError, or
-// nil if none found.
-func (m *SecretProof) ValidateAll() error {
+// ValidateAll checks the field values on UserSignedTxSigningJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserSignedTxSigningJobMultiError, or nil if none found.
+func (m *UserSignedTxSigningJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SecretProof) validate(all bool) error {
+func (m *UserSignedTxSigningJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for LeafId
+
+	// no validation rules for SigningPublicKey
+
+	// no validation rules for RawTx
+
+	if all {
+		switch v := interface{}(m.GetSigningNonceCommitment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSignedTxSigningJobValidationError{
+					field:  "SigningNonceCommitment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSignedTxSigningJobValidationError{
+					field:  "SigningNonceCommitment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSigningNonceCommitment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSignedTxSigningJobValidationError{
+				field:  "SigningNonceCommitment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserSignature
+
+	if all {
+		switch v := interface{}(m.GetSigningCommitments()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserSignedTxSigningJobValidationError{
+					field:  "SigningCommitments",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserSignedTxSigningJobValidationError{
+					field:  "SigningCommitments",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSigningCommitments()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserSignedTxSigningJobValidationError{
+				field:  "SigningCommitments",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return SecretProofMultiError(errors)
+		return UserSignedTxSigningJobMultiError(errors)
 	}
 
 	return nil
 }
 
-// SecretProofMultiError is an error wrapping multiple validation errors
-// returned by SecretProof.ValidateAll() if the designated constraints aren't met.
-type SecretProofMultiError []error
+// UserSignedTxSigningJobMultiError is an error wrapping multiple validation
+// errors returned by UserSignedTxSigningJob.ValidateAll() if the designated
+// constraints aren't met.
+type UserSignedTxSigningJobMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SecretProofMultiError) Error() string {
+func (m UserSignedTxSigningJobMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8294,11 +9480,11 @@ func (m SecretProofMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SecretProofMultiError) AllErrors() []error { return m }
+func (m UserSignedTxSigningJobMultiError) AllErrors() []error { return m }
 
-// SecretProofValidationError is the validation error returned by
-// SecretProof.Validate if the designated constraints aren't met.
-type SecretProofValidationError struct {
+// UserSignedTxSigningJobValidationError is the validation error returned by
+// UserSignedTxSigningJob.Validate if the designated constraints aren't met.
+type UserSignedTxSigningJobValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8306,22 +9492,24 @@ type SecretProofValidationError struct {
 }
 
 // Field function returns field value.
-func (e SecretProofValidationError) Field() string { return e.field }
+func (e UserSignedTxSigningJobValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SecretProofValidationError) Reason() string { return e.reason }
+func (e UserSignedTxSigningJobValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SecretProofValidationError) Cause() error { return e.cause }
+func (e UserSignedTxSigningJobValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SecretProofValidationError) Key() bool { return e.key }
+func (e UserSignedTxSigningJobValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SecretProofValidationError) ErrorName() string { return "SecretProofValidationError" }
+func (e UserSignedTxSigningJobValidationError) ErrorName() string {
+	return "UserSignedTxSigningJobValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SecretProofValidationError) Error() string {
+func (e UserSignedTxSigningJobValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8333,14 +9521,14 @@ func (e SecretProofValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSecretProof.%s: %s%s",
+		"invalid %sUserSignedTxSigningJob.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SecretProofValidationError{}
+var _ error = UserSignedTxSigningJobValidationError{}
 
 var _ interface {
 	Field() string
@@ -8348,24 +9536,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SecretProofValidationError{}
+} = UserSignedTxSigningJobValidationError{}
 
-// Validate checks the field values on LeafRefundTxSigningJob with the rules
+// Validate checks the field values on LeafRefundTxSigningResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeafRefundTxSigningJob) Validate() error {
+func (m *LeafRefundTxSigningResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeafRefundTxSigningJob with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on LeafRefundTxSigningResult with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LeafRefundTxSigningJobMultiError, or nil if none found.
-func (m *LeafRefundTxSigningJob) ValidateAll() error {
+// LeafRefundTxSigningResultMultiError, or nil if none found.
+func (m *LeafRefundTxSigningResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeafRefundTxSigningJob) validate(all bool) error {
+func (m *LeafRefundTxSigningResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8375,57 +9563,59 @@ func (m *LeafRefundTxSigningJob) validate(all bool) error {
 	// no validation rules for LeafId
 
 	if all {
-		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeafRefundTxSigningJobValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, LeafRefundTxSigningResultValidationError{
+					field:  "RefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeafRefundTxSigningJobValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, LeafRefundTxSigningResultValidationError{
+					field:  "RefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LeafRefundTxSigningJobValidationError{
-				field:  "RefundTxSigningJob",
+			return LeafRefundTxSigningResultValidationError{
+				field:  "RefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for VerifyingKey
+
 	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeafRefundTxSigningJobValidationError{
-					field:  "DirectRefundTxSigningJob",
+				errors = append(errors, LeafRefundTxSigningResultValidationError{
+					field:  "DirectRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeafRefundTxSigningJobValidationError{
-					field:  "DirectRefundTxSigningJob",
+				errors = append(errors, LeafRefundTxSigningResultValidationError{
+					field:  "DirectRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LeafRefundTxSigningJobValidationError{
-				field:  "DirectRefundTxSigningJob",
+			return LeafRefundTxSigningResultValidationError{
+				field:  "DirectRefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8433,28 +9623,28 @@ func (m *LeafRefundTxSigningJob) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeafRefundTxSigningJobValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, LeafRefundTxSigningResultValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeafRefundTxSigningJobValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, LeafRefundTxSigningResultValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LeafRefundTxSigningJobValidationError{
-				field:  "DirectFromCpfpRefundTxSigningJob",
+			return LeafRefundTxSigningResultValidationError{
+				field:  "DirectFromCpfpRefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8462,19 +9652,19 @@ func (m *LeafRefundTxSigningJob) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return LeafRefundTxSigningJobMultiError(errors)
+		return LeafRefundTxSigningResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeafRefundTxSigningJobMultiError is an error wrapping multiple validation
-// errors returned by LeafRefundTxSigningJob.ValidateAll() if the designated
-// constraints aren't met.
-type LeafRefundTxSigningJobMultiError []error
+// LeafRefundTxSigningResultMultiError is an error wrapping multiple validation
+// errors returned by LeafRefundTxSigningResult.ValidateAll() if the
+// designated constraints aren't met.
+type LeafRefundTxSigningResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeafRefundTxSigningJobMultiError) Error() string {
+func (m LeafRefundTxSigningResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8483,11 +9673,11 @@ func (m LeafRefundTxSigningJobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeafRefundTxSigningJobMultiError) AllErrors() []error { return m }
+func (m LeafRefundTxSigningResultMultiError) AllErrors() []error { return m }
 
-// LeafRefundTxSigningJobValidationError is the validation error returned by
-// LeafRefundTxSigningJob.Validate if the designated constraints aren't met.
-type LeafRefundTxSigningJobValidationError struct {
+// LeafRefundTxSigningResultValidationError is the validation error returned by
+// LeafRefundTxSigningResult.Validate if the designated constraints aren't met.
+type LeafRefundTxSigningResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8495,24 +9685,24 @@ type LeafRefundTxSigningJobValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeafRefundTxSigningJobValidationError) Field() string { return e.field }
+func (e LeafRefundTxSigningResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeafRefundTxSigningJobValidationError) Reason() string { return e.reason }
+func (e LeafRefundTxSigningResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeafRefundTxSigningJobValidationError) Cause() error { return e.cause }
+func (e LeafRefundTxSigningResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeafRefundTxSigningJobValidationError) Key() bool { return e.key }
+func (e LeafRefundTxSigningResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeafRefundTxSigningJobValidationError) ErrorName() string {
-	return "LeafRefundTxSigningJobValidationError"
+func (e LeafRefundTxSigningResultValidationError) ErrorName() string {
+	return "LeafRefundTxSigningResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeafRefundTxSigningJobValidationError) Error() string {
+func (e LeafRefundTxSigningResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8524,14 +9714,14 @@ func (e LeafRefundTxSigningJobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeafRefundTxSigningJob.%s: %s%s",
+		"invalid %sLeafRefundTxSigningResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeafRefundTxSigningJobValidationError{}
+var _ error = LeafRefundTxSigningResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -8539,110 +9729,181 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeafRefundTxSigningJobValidationError{}
+} = LeafRefundTxSigningResultValidationError{}
 
-// Validate checks the field values on UserSignedTxSigningJob with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on StartUserSignedTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UserSignedTxSigningJob) Validate() error {
+func (m *StartUserSignedTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UserSignedTxSigningJob with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UserSignedTxSigningJobMultiError, or nil if none found.
-func (m *UserSignedTxSigningJob) ValidateAll() error {
+// ValidateAll checks the field values on StartUserSignedTransferRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StartUserSignedTransferRequestMultiError, or nil if none found.
+func (m *StartUserSignedTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UserSignedTxSigningJob) validate(all bool) error {
+func (m *StartUserSignedTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LeafId
+	// no validation rules for TransferId
 
-	// no validation rules for SigningPublicKey
+	// no validation rules for OwnerIdentityPublicKey
 
-	// no validation rules for RawTx
+	for idx, item := range m.GetLeavesToSend() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartUserSignedTransferRequestValidationError{
+						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartUserSignedTransferRequestValidationError{
+						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartUserSignedTransferRequestValidationError{
+					field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for ReceiverIdentityPublicKey
 
 	if all {
-		switch v := interface{}(m.GetSigningNonceCommitment()).(type) {
+		switch v := interface{}(m.GetExpiryTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserSignedTxSigningJobValidationError{
-					field:  "SigningNonceCommitment",
+				errors = append(errors, StartUserSignedTransferRequestValidationError{
+					field:  "ExpiryTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserSignedTxSigningJobValidationError{
-					field:  "SigningNonceCommitment",
+				errors = append(errors, StartUserSignedTransferRequestValidationError{
+					field:  "ExpiryTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSigningNonceCommitment()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UserSignedTxSigningJobValidationError{
-				field:  "SigningNonceCommitment",
+			return StartUserSignedTransferRequestValidationError{
+				field:  "ExpiryTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for UserSignature
+	for idx, item := range m.GetDirectLeavesToSend() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetSigningCommitments()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UserSignedTxSigningJobValidationError{
-					field:  "SigningCommitments",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartUserSignedTransferRequestValidationError{
+						field:  fmt.Sprintf("DirectLeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartUserSignedTransferRequestValidationError{
+						field:  fmt.Sprintf("DirectLeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UserSignedTxSigningJobValidationError{
-					field:  "SigningCommitments",
+				return StartUserSignedTransferRequestValidationError{
+					field:  fmt.Sprintf("DirectLeavesToSend[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetSigningCommitments()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserSignedTxSigningJobValidationError{
-				field:  "SigningCommitments",
-				reason: "embedded message failed validation",
-				cause:  err,
+
+	}
+
+	for idx, item := range m.GetDirectFromCpfpLeavesToSend() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartUserSignedTransferRequestValidationError{
+						field:  fmt.Sprintf("DirectFromCpfpLeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartUserSignedTransferRequestValidationError{
+						field:  fmt.Sprintf("DirectFromCpfpLeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartUserSignedTransferRequestValidationError{
+					field:  fmt.Sprintf("DirectFromCpfpLeavesToSend[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return UserSignedTxSigningJobMultiError(errors)
+		return StartUserSignedTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserSignedTxSigningJobMultiError is an error wrapping multiple validation
-// errors returned by UserSignedTxSigningJob.ValidateAll() if the designated
-// constraints aren't met.
-type UserSignedTxSigningJobMultiError []error
+// StartUserSignedTransferRequestMultiError is an error wrapping multiple
+// validation errors returned by StartUserSignedTransferRequest.ValidateAll()
+// if the designated constraints aren't met.
+type StartUserSignedTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UserSignedTxSigningJobMultiError) Error() string {
+func (m StartUserSignedTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8651,11 +9912,12 @@ func (m UserSignedTxSigningJobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UserSignedTxSigningJobMultiError) AllErrors() []error { return m }
+func (m StartUserSignedTransferRequestMultiError) AllErrors() []error { return m }
 
-// UserSignedTxSigningJobValidationError is the validation error returned by
-// UserSignedTxSigningJob.Validate if the designated constraints aren't met.
-type UserSignedTxSigningJobValidationError struct {
+// StartUserSignedTransferRequestValidationError is the validation error
+// returned by StartUserSignedTransferRequest.Validate if the designated
+// constraints aren't met.
+type StartUserSignedTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8663,24 +9925,24 @@ type UserSignedTxSigningJobValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserSignedTxSigningJobValidationError) Field() string { return e.field }
+func (e StartUserSignedTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserSignedTxSigningJobValidationError) Reason() string { return e.reason }
+func (e StartUserSignedTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserSignedTxSigningJobValidationError) Cause() error { return e.cause }
+func (e StartUserSignedTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserSignedTxSigningJobValidationError) Key() bool { return e.key }
+func (e StartUserSignedTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserSignedTxSigningJobValidationError) ErrorName() string {
-	return "UserSignedTxSigningJobValidationError"
+func (e StartUserSignedTransferRequestValidationError) ErrorName() string {
+	return "StartUserSignedTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UserSignedTxSigningJobValidationError) Error() string {
+func (e StartUserSignedTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8692,14 +9954,14 @@ func (e UserSignedTxSigningJobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserSignedTxSigningJob.%s: %s%s",
+		"invalid %sStartUserSignedTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserSignedTxSigningJobValidationError{}
+var _ error = StartUserSignedTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8707,86 +9969,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserSignedTxSigningJobValidationError{}
+} = StartUserSignedTransferRequestValidationError{}
 
-// Validate checks the field values on LeafRefundTxSigningResult with the rules
+// Validate checks the field values on StartTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *LeafRefundTxSigningResult) Validate() error {
+func (m *StartTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LeafRefundTxSigningResult with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// LeafRefundTxSigningResultMultiError, or nil if none found.
-func (m *LeafRefundTxSigningResult) ValidateAll() error {
+// StartTransferRequestMultiError, or nil if none found.
+func (m *StartTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LeafRefundTxSigningResult) validate(all bool) error {
+func (m *StartTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LeafId
+	// no validation rules for TransferId
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeafRefundTxSigningResultValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for OwnerIdentityPublicKey
+
+	for idx, item := range m.GetLeavesToSend() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartTransferRequestValidationError{
+						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartTransferRequestValidationError{
+						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeafRefundTxSigningResultValidationError{
-					field:  "RefundTxSigningResult",
+				return StartTransferRequestValidationError{
+					field:  fmt.Sprintf("LeavesToSend[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return LeafRefundTxSigningResultValidationError{
-				field:  "RefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	// no validation rules for VerifyingKey
+	// no validation rules for ReceiverIdentityPublicKey
 
 	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
+		switch v := interface{}(m.GetExpiryTime()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeafRefundTxSigningResultValidationError{
-					field:  "DirectRefundTxSigningResult",
+				errors = append(errors, StartTransferRequestValidationError{
+					field:  "ExpiryTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeafRefundTxSigningResultValidationError{
-					field:  "DirectRefundTxSigningResult",
+				errors = append(errors, StartTransferRequestValidationError{
+					field:  "ExpiryTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LeafRefundTxSigningResultValidationError{
-				field:  "DirectRefundTxSigningResult",
+			return StartTransferRequestValidationError{
+				field:  "ExpiryTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -8794,48 +10063,50 @@ func (m *LeafRefundTxSigningResult) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
+		switch v := interface{}(m.GetTransferPackage()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LeafRefundTxSigningResultValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
+				errors = append(errors, StartTransferRequestValidationError{
+					field:  "TransferPackage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LeafRefundTxSigningResultValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
+				errors = append(errors, StartTransferRequestValidationError{
+					field:  "TransferPackage",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTransferPackage()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LeafRefundTxSigningResultValidationError{
-				field:  "DirectFromCpfpRefundTxSigningResult",
+			return StartTransferRequestValidationError{
+				field:  "TransferPackage",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for SparkPaymentIntent
+
 	if len(errors) > 0 {
-		return LeafRefundTxSigningResultMultiError(errors)
+		return StartTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LeafRefundTxSigningResultMultiError is an error wrapping multiple validation
-// errors returned by LeafRefundTxSigningResult.ValidateAll() if the
-// designated constraints aren't met.
-type LeafRefundTxSigningResultMultiError []error
+// StartTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by StartTransferRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LeafRefundTxSigningResultMultiError) Error() string {
+func (m StartTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8844,11 +10115,11 @@ func (m LeafRefundTxSigningResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LeafRefundTxSigningResultMultiError) AllErrors() []error { return m }
+func (m StartTransferRequestMultiError) AllErrors() []error { return m }
 
-// LeafRefundTxSigningResultValidationError is the validation error returned by
-// LeafRefundTxSigningResult.Validate if the designated constraints aren't met.
-type LeafRefundTxSigningResultValidationError struct {
+// StartTransferRequestValidationError is the validation error returned by
+// StartTransferRequest.Validate if the designated constraints aren't met.
+type StartTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8856,24 +10127,24 @@ type LeafRefundTxSigningResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e LeafRefundTxSigningResultValidationError) Field() string { return e.field }
+func (e StartTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LeafRefundTxSigningResultValidationError) Reason() string { return e.reason }
+func (e StartTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LeafRefundTxSigningResultValidationError) Cause() error { return e.cause }
+func (e StartTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LeafRefundTxSigningResultValidationError) Key() bool { return e.key }
+func (e StartTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LeafRefundTxSigningResultValidationError) ErrorName() string {
-	return "LeafRefundTxSigningResultValidationError"
+func (e StartTransferRequestValidationError) ErrorName() string {
+	return "StartTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e LeafRefundTxSigningResultValidationError) Error() string {
+func (e StartTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8885,14 +10156,14 @@ func (e LeafRefundTxSigningResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLeafRefundTxSigningResult.%s: %s%s",
+		"invalid %sStartTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LeafRefundTxSigningResultValidationError{}
+var _ error = StartTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8900,51 +10171,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LeafRefundTxSigningResultValidationError{}
+} = StartTransferRequestValidationError{}
 
-// Validate checks the field values on StartUserSignedTransferRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on StartTransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartUserSignedTransferRequest) Validate() error {
+func (m *StartTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartUserSignedTransferRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// StartUserSignedTransferRequestMultiError, or nil if none found.
-func (m *StartUserSignedTransferRequest) ValidateAll() error {
+// ValidateAll checks the field values on StartTransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartTransferResponseMultiError, or nil if none found.
+func (m *StartTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartUserSignedTransferRequest) validate(all bool) error {
+func (m *StartTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TransferId
-
-	// no validation rules for OwnerIdentityPublicKey
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartTransferResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartTransferResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartTransferResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	for idx, item := range m.GetLeavesToSend() {
+	for idx, item := range m.GetSigningResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartUserSignedTransferRequestValidationError{
-						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+					errors = append(errors, StartTransferResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartUserSignedTransferRequestValidationError{
-						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+					errors = append(errors, StartTransferResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -8952,8 +10248,8 @@ func (m *StartUserSignedTransferRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartUserSignedTransferRequestValidationError{
-					field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+				return StartTransferResponseValidationError{
+					field:  fmt.Sprintf("SigningResults[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -8962,88 +10258,127 @@ func (m *StartUserSignedTransferRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for ReceiverIdentityPublicKey
+	if len(errors) > 0 {
+		return StartTransferResponseMultiError(errors)
+	}
 
-	if all {
-		switch v := interface{}(m.GetExpiryTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartUserSignedTransferRequestValidationError{
-					field:  "ExpiryTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartUserSignedTransferRequestValidationError{
-					field:  "ExpiryTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartUserSignedTransferRequestValidationError{
-				field:  "ExpiryTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// StartTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by StartTransferResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartTransferResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartTransferResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartTransferResponseMultiError) AllErrors() []error { return m }
+
+// StartTransferResponseValidationError is the validation error returned by
+// StartTransferResponse.Validate if the designated constraints aren't met.
+type StartTransferResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartTransferResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartTransferResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartTransferResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartTransferResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartTransferResponseValidationError) ErrorName() string {
+	return "StartTransferResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartTransferResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	for idx, item := range m.GetDirectLeavesToSend() {
-		_, _ = idx, item
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartUserSignedTransferRequestValidationError{
-						field:  fmt.Sprintf("DirectLeavesToSend[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartUserSignedTransferRequestValidationError{
-						field:  fmt.Sprintf("DirectLeavesToSend[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartUserSignedTransferRequestValidationError{
-					field:  fmt.Sprintf("DirectLeavesToSend[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sStartTransferResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartTransferResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartTransferResponseValidationError{}
+
+// Validate checks the field values on StartBatchTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartBatchTransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartBatchTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartBatchTransferRequestMultiError, or nil if none found.
+func (m *StartBatchTransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *StartBatchTransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetDirectFromCpfpLeavesToSend() {
+	var errors []error
+
+	// no validation rules for BatchId
+
+	for idx, item := range m.GetTransfers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartUserSignedTransferRequestValidationError{
-						field:  fmt.Sprintf("DirectFromCpfpLeavesToSend[%v]", idx),
+					errors = append(errors, StartBatchTransferRequestValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartUserSignedTransferRequestValidationError{
-						field:  fmt.Sprintf("DirectFromCpfpLeavesToSend[%v]", idx),
+					errors = append(errors, StartBatchTransferRequestValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9051,8 +10386,8 @@ func (m *StartUserSignedTransferRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartUserSignedTransferRequestValidationError{
-					field:  fmt.Sprintf("DirectFromCpfpLeavesToSend[%v]", idx),
+				return StartBatchTransferRequestValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9062,19 +10397,19 @@ func (m *StartUserSignedTransferRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StartUserSignedTransferRequestMultiError(errors)
+		return StartBatchTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartUserSignedTransferRequestMultiError is an error wrapping multiple
-// validation errors returned by StartUserSignedTransferRequest.ValidateAll()
-// if the designated constraints aren't met.
-type StartUserSignedTransferRequestMultiError []error
+// StartBatchTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by StartBatchTransferRequest.ValidateAll() if the
+// designated constraints aren't met.
+type StartBatchTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartUserSignedTransferRequestMultiError) Error() string {
+func (m StartBatchTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9083,12 +10418,11 @@ func (m StartUserSignedTransferRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartUserSignedTransferRequestMultiError) AllErrors() []error { return m }
+func (m StartBatchTransferRequestMultiError) AllErrors() []error { return m }
 
-// StartUserSignedTransferRequestValidationError is the validation error
-// returned by StartUserSignedTransferRequest.Validate if the designated
-// constraints aren't met.
-type StartUserSignedTransferRequestValidationError struct {
+// StartBatchTransferRequestValidationError is the validation error returned by
+// StartBatchTransferRequest.Validate if the designated constraints aren't met.
+type StartBatchTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9096,24 +10430,24 @@ type StartUserSignedTransferRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartUserSignedTransferRequestValidationError) Field() string { return e.field }
+func (e StartBatchTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartUserSignedTransferRequestValidationError) Reason() string { return e.reason }
+func (e StartBatchTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartUserSignedTransferRequestValidationError) Cause() error { return e.cause }
+func (e StartBatchTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartUserSignedTransferRequestValidationError) Key() bool { return e.key }
+func (e StartBatchTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartUserSignedTransferRequestValidationError) ErrorName() string {
-	return "StartUserSignedTransferRequestValidationError"
+func (e StartBatchTransferRequestValidationError) ErrorName() string {
+	return "StartBatchTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartUserSignedTransferRequestValidationError) Error() string {
+func (e StartBatchTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9125,14 +10459,14 @@ func (e StartUserSignedTransferRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartUserSignedTransferRequest.%s: %s%s",
+		"invalid %sStartBatchTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartUserSignedTransferRequestValidationError{}
+var _ error = StartBatchTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9140,51 +10474,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartUserSignedTransferRequestValidationError{}
+} = StartBatchTransferRequestValidationError{}
 
-// Validate checks the field values on StartTransferRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on StartBatchTransferResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartTransferRequest) Validate() error {
+func (m *StartBatchTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartTransferRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartBatchTransferResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StartTransferRequestMultiError, or nil if none found.
-func (m *StartTransferRequest) ValidateAll() error {
+// StartBatchTransferResponseMultiError, or nil if none found.
+func (m *StartBatchTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartTransferRequest) validate(all bool) error {
+func (m *StartBatchTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TransferId
-
-	// no validation rules for OwnerIdentityPublicKey
-
-	for idx, item := range m.GetLeavesToSend() {
+	for idx, item := range m.GetTransfers() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartTransferRequestValidationError{
-						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+					errors = append(errors, StartBatchTransferResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartTransferRequestValidationError{
-						field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+					errors = append(errors, StartBatchTransferResponseValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -9192,8 +10522,8 @@ func (m *StartTransferRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartTransferRequestValidationError{
-					field:  fmt.Sprintf("LeavesToSend[%v]", idx),
+				return StartBatchTransferResponseValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -9202,82 +10532,20 @@ func (m *StartTransferRequest) validate(all bool) error {
 
 	}
 
-	// no validation rules for ReceiverIdentityPublicKey
-
-	if all {
-		switch v := interface{}(m.GetExpiryTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTransferRequestValidationError{
-					field:  "ExpiryTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTransferRequestValidationError{
-					field:  "ExpiryTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTransferRequestValidationError{
-				field:  "ExpiryTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetTransferPackage()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTransferRequestValidationError{
-					field:  "TransferPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTransferRequestValidationError{
-					field:  "TransferPackage",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransferPackage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartTransferRequestValidationError{
-				field:  "TransferPackage",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for SparkPaymentIntent
-
 	if len(errors) > 0 {
-		return StartTransferRequestMultiError(errors)
+		return StartBatchTransferResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartTransferRequestMultiError is an error wrapping multiple validation
-// errors returned by StartTransferRequest.ValidateAll() if the designated
-// constraints aren't met.
-type StartTransferRequestMultiError []error
+// StartBatchTransferResponseMultiError is an error wrapping multiple
+// validation errors returned by StartBatchTransferResponse.ValidateAll() if
+// the designated constraints aren't met.
+type StartBatchTransferResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartTransferRequestMultiError) Error() string {
+func (m StartBatchTransferResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9286,11 +10554,11 @@ func (m StartTransferRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartTransferRequestMultiError) AllErrors() []error { return m }
+func (m StartBatchTransferResponseMultiError) AllErrors() []error { return m }
 
-// StartTransferRequestValidationError is the validation error returned by
-// StartTransferRequest.Validate if the designated constraints aren't met.
-type StartTransferRequestValidationError struct {
+// StartBatchTransferResponseValidationError is the validation error returned
+// by StartBatchTransferResponse.Validate if the designated constraints aren't met.
+type StartBatchTransferResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9298,24 +10566,24 @@ type StartTransferRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartTransferRequestValidationError) Field() string { return e.field }
+func (e StartBatchTransferResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartTransferRequestValidationError) Reason() string { return e.reason }
+func (e StartBatchTransferResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartTransferRequestValidationError) Cause() error { return e.cause }
+func (e StartBatchTransferResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartTransferRequestValidationError) Key() bool { return e.key }
+func (e StartBatchTransferResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartTransferRequestValidationError) ErrorName() string {
-	return "StartTransferRequestValidationError"
+func (e StartBatchTransferResponseValidationError) ErrorName() string {
+	return "StartBatchTransferResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartTransferRequestValidationError) Error() string {
+func (e StartBatchTransferResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9327,14 +10595,14 @@ func (e StartTransferRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartTransferRequest.%s: %s%s",
+		"invalid %sStartBatchTransferResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartTransferRequestValidationError{}
+var _ error = StartBatchTransferResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9342,24 +10610,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartTransferRequestValidationError{}
+} = StartBatchTransferResponseValidationError{}
 
-// Validate checks the field values on StartTransferResponse with the rules
+// Validate checks the field values on StartHtlcTransferRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartTransferResponse) Validate() error {
+func (m *StartHtlcTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartTransferResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on StartHtlcTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StartTransferResponseMultiError, or nil if none found.
-func (m *StartTransferResponse) ValidateAll() error {
+// StartHtlcTransferRequestMultiError, or nil if none found.
+func (m *StartHtlcTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartTransferResponse) validate(all bool) error {
+func (m *StartHtlcTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9370,7 +10638,7 @@ func (m *StartTransferResponse) validate(all bool) error {
 		switch v := interface{}(m.GetTransfer()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartTransferResponseValidationError{
+				errors = append(errors, StartHtlcTransferRequestValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9378,7 +10646,7 @@ func (m *StartTransferResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartTransferResponseValidationError{
+				errors = append(errors, StartHtlcTransferRequestValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9387,7 +10655,7 @@ func (m *StartTransferResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartTransferResponseValidationError{
+			return StartHtlcTransferRequestValidationError{
 				field:  "Transfer",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9395,54 +10663,22 @@ func (m *StartTransferResponse) validate(all bool) error {
 		}
 	}
 
-	for idx, item := range m.GetSigningResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartTransferResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartTransferResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartTransferResponseValidationError{
-					field:  fmt.Sprintf("SigningResults[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for PaymentHash
 
 	if len(errors) > 0 {
-		return StartTransferResponseMultiError(errors)
+		return StartHtlcTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartTransferResponseMultiError is an error wrapping multiple validation
-// errors returned by StartTransferResponse.ValidateAll() if the designated
+// StartHtlcTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by StartHtlcTransferRequest.ValidateAll() if the designated
 // constraints aren't met.
-type StartTransferResponseMultiError []error
+type StartHtlcTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartTransferResponseMultiError) Error() string {
+func (m StartHtlcTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9451,11 +10687,11 @@ func (m StartTransferResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartTransferResponseMultiError) AllErrors() []error { return m }
+func (m StartHtlcTransferRequestMultiError) AllErrors() []error { return m }
 
-// StartTransferResponseValidationError is the validation error returned by
-// StartTransferResponse.Validate if the designated constraints aren't met.
-type StartTransferResponseValidationError struct {
+// StartHtlcTransferRequestValidationError is the validation error returned by
+// StartHtlcTransferRequest.Validate if the designated constraints aren't met.
+type StartHtlcTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9463,24 +10699,24 @@ type StartTransferResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartTransferResponseValidationError) Field() string { return e.field }
+func (e StartHtlcTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartTransferResponseValidationError) Reason() string { return e.reason }
+func (e StartHtlcTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartTransferResponseValidationError) Cause() error { return e.cause }
+func (e StartHtlcTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartTransferResponseValidationError) Key() bool { return e.key }
+func (e StartHtlcTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartTransferResponseValidationError) ErrorName() string {
-	return "StartTransferResponseValidationError"
+func (e StartHtlcTransferRequestValidationError) ErrorName() string {
+	return "StartHtlcTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartTransferResponseValidationError) Error() string {
+func (e StartHtlcTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9492,14 +10728,14 @@ func (e StartTransferResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartTransferResponse.%s: %s%s",
+		"invalid %sStartHtlcTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StartTransferResponseValidationError{}
+var _ error = StartHtlcTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9507,7 +10743,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartTransferResponseValidationError{}
+} = StartHtlcTransferRequestValidationError{}
 
 // Validate checks the field values on TransferPackage with the rules defined
 // in the proto definition for this message. If any rules are violated, the
@@ -10561,6 +11797,10 @@ func (m *Transfer) validate(all bool) error {
 
 	// no validation rules for Type
 
+	// no validation rules for SparkPaymentIntent
+
+	// no validation rules for BatchId
+
 	if len(errors) > 0 {
 		return TransferMultiError(errors)
 	}
@@ -12918,7 +14158,242 @@ func (m *InitiatePreimageSwapResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiatePreimageSwapResponseValidationError{
+				errors = append(errors, InitiatePreimageSwapResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiatePreimageSwapResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InitiatePreimageSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiatePreimageSwapResponseMultiError is an error wrapping multiple
+// validation errors returned by InitiatePreimageSwapResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InitiatePreimageSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiatePreimageSwapResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiatePreimageSwapResponseMultiError) AllErrors() []error { return m }
+
+// InitiatePreimageSwapResponseValidationError is the validation error returned
+// by InitiatePreimageSwapResponse.Validate if the designated constraints
+// aren't met.
+type InitiatePreimageSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiatePreimageSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiatePreimageSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiatePreimageSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiatePreimageSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiatePreimageSwapResponseValidationError) ErrorName() string {
+	return "InitiatePreimageSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiatePreimageSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiatePreimageSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiatePreimageSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiatePreimageSwapResponseValidationError{}
+
+// Validate checks the field values on OutPoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutPointMultiError, or nil
+// if none found.
+func (m *OutPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Txid
+
+	// no validation rules for Vout
+
+	if len(errors) > 0 {
+		return OutPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutPointMultiError is an error wrapping multiple validation errors returned
+// by OutPoint.ValidateAll() if the designated constraints aren't met.
+type OutPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutPointMultiError) AllErrors() []error { return m }
+
+// OutPointValidationError is the validation error returned by
+// OutPoint.Validate if the designated constraints aren't met.
+type OutPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutPointValidationError) ErrorName() string { return "OutPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutPointValidationError{}
+
+// Validate checks the field values on CooperativeExitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CooperativeExitRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CooperativeExitRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CooperativeExitRequestMultiError, or nil if none found.
+func (m *CooperativeExitRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CooperativeExitRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CooperativeExitRequestValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CooperativeExitRequestValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12927,7 +14402,7 @@ func (m *InitiatePreimageSwapResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InitiatePreimageSwapResponseValidationError{
+			return CooperativeExitRequestValidationError{
 				field:  "Transfer",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12935,20 +14410,24 @@ func (m *InitiatePreimageSwapResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ExitId
+
+	// no validation rules for ExitTxid
+
 	if len(errors) > 0 {
-		return InitiatePreimageSwapResponseMultiError(errors)
+		return CooperativeExitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitiatePreimageSwapResponseMultiError is an error wrapping multiple
-// validation errors returned by InitiatePreimageSwapResponse.ValidateAll() if
-// the designated constraints aren't met.
-type InitiatePreimageSwapResponseMultiError []error
+// CooperativeExitRequestMultiError is an error wrapping multiple validation
+// errors returned by CooperativeExitRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CooperativeExitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitiatePreimageSwapResponseMultiError) Error() string {
+func (m CooperativeExitRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12957,12 +14436,11 @@ func (m InitiatePreimageSwapResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitiatePreimageSwapResponseMultiError) AllErrors() []error { return m }
+func (m CooperativeExitRequestMultiError) AllErrors() []error { return m }
 
-// InitiatePreimageSwapResponseValidationError is the validation error returned
-// by InitiatePreimageSwapResponse.Validate if the designated constraints
-// aren't met.
-type InitiatePreimageSwapResponseValidationError struct {
+// CooperativeExitRequestValidationError is the validation error returned by
+// CooperativeExitRequest.Validate if the designated constraints aren't met.
+type CooperativeExitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12970,24 +14448,24 @@ type InitiatePreimageSwapResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitiatePreimageSwapResponseValidationError) Field() string { return e.field }
+func (e CooperativeExitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitiatePreimageSwapResponseValidationError) Reason() string { return e.reason }
+func (e CooperativeExitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitiatePreimageSwapResponseValidationError) Cause() error { return e.cause }
+func (e CooperativeExitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitiatePreimageSwapResponseValidationError) Key() bool { return e.key }
+func (e CooperativeExitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitiatePreimageSwapResponseValidationError) ErrorName() string {
-	return "InitiatePreimageSwapResponseValidationError"
+func (e CooperativeExitRequestValidationError) ErrorName() string {
+	return "CooperativeExitRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitiatePreimageSwapResponseValidationError) Error() string {
+func (e CooperativeExitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12999,14 +14477,14 @@ func (e InitiatePreimageSwapResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitiatePreimageSwapResponse.%s: %s%s",
+		"invalid %sCooperativeExitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitiatePreimageSwapResponseValidationError{}
+var _ error = CooperativeExitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13014,47 +14492,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitiatePreimageSwapResponseValidationError{}
+} = CooperativeExitRequestValidationError{}
 
-// Validate checks the field values on OutPoint with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *OutPoint) Validate() error {
+// Validate checks the field values on CooperativeExitResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CooperativeExitResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OutPoint with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OutPointMultiError, or nil
-// if none found.
-func (m *OutPoint) ValidateAll() error {
+// ValidateAll checks the field values on CooperativeExitResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CooperativeExitResponseMultiError, or nil if none found.
+func (m *CooperativeExitResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OutPoint) validate(all bool) error {
+func (m *CooperativeExitResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Txid
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CooperativeExitResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CooperativeExitResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CooperativeExitResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Vout
+	for idx, item := range m.GetSigningResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CooperativeExitResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CooperativeExitResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CooperativeExitResponseValidationError{
+					field:  fmt.Sprintf("SigningResults[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return OutPointMultiError(errors)
+		return CooperativeExitResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// OutPointMultiError is an error wrapping multiple validation errors returned
-// by OutPoint.ValidateAll() if the designated constraints aren't met.
-type OutPointMultiError []error
+// CooperativeExitResponseMultiError is an error wrapping multiple validation
+// errors returned by CooperativeExitResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CooperativeExitResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OutPointMultiError) Error() string {
+func (m CooperativeExitResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13063,11 +14601,11 @@ func (m OutPointMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OutPointMultiError) AllErrors() []error { return m }
+func (m CooperativeExitResponseMultiError) AllErrors() []error { return m }
 
-// OutPointValidationError is the validation error returned by
-// OutPoint.Validate if the designated constraints aren't met.
-type OutPointValidationError struct {
+// CooperativeExitResponseValidationError is the validation error returned by
+// CooperativeExitResponse.Validate if the designated constraints aren't met.
+type CooperativeExitResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13075,22 +14613,24 @@ type OutPointValidationError struct {
 }
 
 // Field function returns field value.
-func (e OutPointValidationError) Field() string { return e.field }
+func (e CooperativeExitResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OutPointValidationError) Reason() string { return e.reason }
+func (e CooperativeExitResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OutPointValidationError) Cause() error { return e.cause }
+func (e CooperativeExitResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OutPointValidationError) Key() bool { return e.key }
+func (e CooperativeExitResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OutPointValidationError) ErrorName() string { return "OutPointValidationError" }
+func (e CooperativeExitResponseValidationError) ErrorName() string {
+	return "CooperativeExitResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e OutPointValidationError) Error() string {
+func (e CooperativeExitResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13102,14 +14642,14 @@ func (e OutPointValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOutPoint.%s: %s%s",
+		"invalid %sCooperativeExitResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OutPointValidationError{}
+var _ error = CooperativeExitResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13117,24 +14657,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OutPointValidationError{}
+} = CooperativeExitResponseValidationError{}
 
-// Validate checks the field values on CooperativeExitRequest with the rules
+// Validate checks the field values on CounterLeafSwapRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CooperativeExitRequest) Validate() error {
+func (m *CounterLeafSwapRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CooperativeExitRequest with the rules
+// ValidateAll checks the field values on CounterLeafSwapRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CooperativeExitRequestMultiError, or nil if none found.
-func (m *CooperativeExitRequest) ValidateAll() error {
+// CounterLeafSwapRequestMultiError, or nil if none found.
+func (m *CounterLeafSwapRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CooperativeExitRequest) validate(all bool) error {
+func (m *CounterLeafSwapRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13145,7 +14685,7 @@ func (m *CooperativeExitRequest) validate(all bool) error {
 		switch v := interface{}(m.GetTransfer()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CooperativeExitRequestValidationError{
+				errors = append(errors, CounterLeafSwapRequestValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13153,7 +14693,7 @@ func (m *CooperativeExitRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CooperativeExitRequestValidationError{
+				errors = append(errors, CounterLeafSwapRequestValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13162,7 +14702,7 @@ func (m *CooperativeExitRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CooperativeExitRequestValidationError{
+			return CounterLeafSwapRequestValidationError{
 				field:  "Transfer",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13170,24 +14710,28 @@ func (m *CooperativeExitRequest) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for ExitId
+	// no validation rules for SwapId
 
-	// no validation rules for ExitTxid
+	// no validation rules for AdaptorPublicKey
+
+	// no validation rules for DirectAdaptorPublicKey
+
+	// no validation rules for DirectFromCpfpAdaptorPublicKey
 
 	if len(errors) > 0 {
-		return CooperativeExitRequestMultiError(errors)
+		return CounterLeafSwapRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CooperativeExitRequestMultiError is an error wrapping multiple validation
-// errors returned by CooperativeExitRequest.ValidateAll() if the designated
+// CounterLeafSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by CounterLeafSwapRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CooperativeExitRequestMultiError []error
+type CounterLeafSwapRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CooperativeExitRequestMultiError) Error() string {
+func (m CounterLeafSwapRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13196,11 +14740,11 @@ func (m CooperativeExitRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CooperativeExitRequestMultiError) AllErrors() []error { return m }
+func (m CounterLeafSwapRequestMultiError) AllErrors() []error { return m }
 
-// CooperativeExitRequestValidationError is the validation error returned by
-// CooperativeExitRequest.Validate if the designated constraints aren't met.
-type CooperativeExitRequestValidationError struct {
+// CounterLeafSwapRequestValidationError is the validation error returned by
+// CounterLeafSwapRequest.Validate if the designated constraints aren't met.
+type CounterLeafSwapRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13208,24 +14752,24 @@ type CooperativeExitRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CooperativeExitRequestValidationError) Field() string { return e.field }
+func (e CounterLeafSwapRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CooperativeExitRequestValidationError) Reason() string { return e.reason }
+func (e CounterLeafSwapRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CooperativeExitRequestValidationError) Cause() error { return e.cause }
+func (e CounterLeafSwapRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CooperativeExitRequestValidationError) Key() bool { return e.key }
+func (e CounterLeafSwapRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CooperativeExitRequestValidationError) ErrorName() string {
-	return "CooperativeExitRequestValidationError"
+func (e CounterLeafSwapRequestValidationError) ErrorName() string {
+	return "CounterLeafSwapRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CooperativeExitRequestValidationError) Error() string {
+func (e CounterLeafSwapRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13237,14 +14781,14 @@ func (e CooperativeExitRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCooperativeExitRequest.%s: %s%s",
+		"invalid %sCounterLeafSwapRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CooperativeExitRequestValidationError{}
+var _ error = CounterLeafSwapRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13252,24 +14796,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CooperativeExitRequestValidationError{}
+} = CounterLeafSwapRequestValidationError{}
 
-// Validate checks the field values on CooperativeExitResponse with the rules
+// Validate checks the field values on CounterLeafSwapResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CooperativeExitResponse) Validate() error {
+func (m *CounterLeafSwapResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CooperativeExitResponse with the
+// ValidateAll checks the field values on CounterLeafSwapResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CooperativeExitResponseMultiError, or nil if none found.
-func (m *CooperativeExitResponse) ValidateAll() error {
+// CounterLeafSwapResponseMultiError, or nil if none found.
+func (m *CounterLeafSwapResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CooperativeExitResponse) validate(all bool) error {
+func (m *CounterLeafSwapResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13280,7 +14824,7 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 		switch v := interface{}(m.GetTransfer()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CooperativeExitResponseValidationError{
+				errors = append(errors, CounterLeafSwapResponseValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13288,7 +14832,7 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CooperativeExitResponseValidationError{
+				errors = append(errors, CounterLeafSwapResponseValidationError{
 					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13297,7 +14841,7 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CooperativeExitResponseValidationError{
+			return CounterLeafSwapResponseValidationError{
 				field:  "Transfer",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13312,7 +14856,7 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CooperativeExitResponseValidationError{
+					errors = append(errors, CounterLeafSwapResponseValidationError{
 						field:  fmt.Sprintf("SigningResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -13320,7 +14864,7 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CooperativeExitResponseValidationError{
+					errors = append(errors, CounterLeafSwapResponseValidationError{
 						field:  fmt.Sprintf("SigningResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -13329,7 +14873,7 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CooperativeExitResponseValidationError{
+				return CounterLeafSwapResponseValidationError{
 					field:  fmt.Sprintf("SigningResults[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13340,19 +14884,19 @@ func (m *CooperativeExitResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CooperativeExitResponseMultiError(errors)
+		return CounterLeafSwapResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CooperativeExitResponseMultiError is an error wrapping multiple validation
-// errors returned by CooperativeExitResponse.ValidateAll() if the designated
+// CounterLeafSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by CounterLeafSwapResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CooperativeExitResponseMultiError []error
+type CounterLeafSwapResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CooperativeExitResponseMultiError) Error() string {
+func (m CounterLeafSwapResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13361,11 +14905,11 @@ func (m CooperativeExitResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CooperativeExitResponseMultiError) AllErrors() []error { return m }
+func (m CounterLeafSwapResponseMultiError) AllErrors() []error { return m }
 
-// CooperativeExitResponseValidationError is the validation error returned by
-// CooperativeExitResponse.Validate if the designated constraints aren't met.
-type CooperativeExitResponseValidationError struct {
+// CounterLeafSwapResponseValidationError is the validation error returned by
+// CounterLeafSwapResponse.Validate if the designated constraints aren't met.
+type CounterLeafSwapResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13373,24 +14917,24 @@ type CooperativeExitResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CooperativeExitResponseValidationError) Field() string { return e.field }
+func (e CounterLeafSwapResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CooperativeExitResponseValidationError) Reason() string { return e.reason }
+func (e CounterLeafSwapResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CooperativeExitResponseValidationError) Cause() error { return e.cause }
+func (e CounterLeafSwapResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CooperativeExitResponseValidationError) Key() bool { return e.key }
+func (e CounterLeafSwapResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CooperativeExitResponseValidationError) ErrorName() string {
-	return "CooperativeExitResponseValidationError"
+func (e CounterLeafSwapResponseValidationError) ErrorName() string {
+	return "CounterLeafSwapResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CooperativeExitResponseValidationError) Error() string {
+func (e CounterLeafSwapResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13402,14 +14946,14 @@ func (e CooperativeExitResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCooperativeExitResponse.%s: %s%s",
+		"invalid %sCounterLeafSwapResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CooperativeExitResponseValidationError{}
+var _ error = CounterLeafSwapResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13417,81 +14961,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CooperativeExitResponseValidationError{}
+} = CounterLeafSwapResponseValidationError{}
 
-// Validate checks the field values on CounterLeafSwapRequest with the rules
+// Validate checks the field values on RefreshTimelockRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CounterLeafSwapRequest) Validate() error {
+func (m *RefreshTimelockRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CounterLeafSwapRequest with the rules
+// ValidateAll checks the field values on RefreshTimelockRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CounterLeafSwapRequestMultiError, or nil if none found.
-func (m *CounterLeafSwapRequest) ValidateAll() error {
+// RefreshTimelockRequestMultiError, or nil if none found.
+func (m *RefreshTimelockRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CounterLeafSwapRequest) validate(all bool) error {
+func (m *RefreshTimelockRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CounterLeafSwapRequestValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for LeafId
+
+	// no validation rules for OwnerIdentityPublicKey
+
+	for idx, item := range m.GetSigningJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RefreshTimelockRequestValidationError{
+						field:  fmt.Sprintf("SigningJobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RefreshTimelockRequestValidationError{
+						field:  fmt.Sprintf("SigningJobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CounterLeafSwapRequestValidationError{
-					field:  "Transfer",
+				return RefreshTimelockRequestValidationError{
+					field:  fmt.Sprintf("SigningJobs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CounterLeafSwapRequestValidationError{
-				field:  "Transfer",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
-
-	// no validation rules for SwapId
-
-	// no validation rules for AdaptorPublicKey
-
-	// no validation rules for DirectAdaptorPublicKey
 
-	// no validation rules for DirectFromCpfpAdaptorPublicKey
+	}
 
 	if len(errors) > 0 {
-		return CounterLeafSwapRequestMultiError(errors)
+		return RefreshTimelockRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CounterLeafSwapRequestMultiError is an error wrapping multiple validation
-// errors returned by CounterLeafSwapRequest.ValidateAll() if the designated
+// RefreshTimelockRequestMultiError is an error wrapping multiple validation
+// errors returned by RefreshTimelockRequest.ValidateAll() if the designated
 // constraints aren't met.
-type CounterLeafSwapRequestMultiError []error
+type RefreshTimelockRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CounterLeafSwapRequestMultiError) Error() string {
+func (m RefreshTimelockRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13500,11 +15045,11 @@ func (m CounterLeafSwapRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CounterLeafSwapRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTimelockRequestMultiError) AllErrors() []error { return m }
 
-// CounterLeafSwapRequestValidationError is the validation error returned by
-// CounterLeafSwapRequest.Validate if the designated constraints aren't met.
-type CounterLeafSwapRequestValidationError struct {
+// RefreshTimelockRequestValidationError is the validation error returned by
+// RefreshTimelockRequest.Validate if the designated constraints aren't met.
+type RefreshTimelockRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13512,24 +15057,24 @@ type CounterLeafSwapRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CounterLeafSwapRequestValidationError) Field() string { return e.field }
+func (e RefreshTimelockRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CounterLeafSwapRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTimelockRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CounterLeafSwapRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTimelockRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CounterLeafSwapRequestValidationError) Key() bool { return e.key }
+func (e RefreshTimelockRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CounterLeafSwapRequestValidationError) ErrorName() string {
-	return "CounterLeafSwapRequestValidationError"
+func (e RefreshTimelockRequestValidationError) ErrorName() string {
+	return "RefreshTimelockRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CounterLeafSwapRequestValidationError) Error() string {
+func (e RefreshTimelockRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13541,14 +15086,14 @@ func (e CounterLeafSwapRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCounterLeafSwapRequest.%s: %s%s",
+		"invalid %sRefreshTimelockRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CounterLeafSwapRequestValidationError{}
+var _ error = RefreshTimelockRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13556,24 +15101,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CounterLeafSwapRequestValidationError{}
+} = RefreshTimelockRequestValidationError{}
 
-// Validate checks the field values on CounterLeafSwapResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RefreshTimelockSigningResult with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CounterLeafSwapResponse) Validate() error {
+func (m *RefreshTimelockSigningResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CounterLeafSwapResponse with the
+// ValidateAll checks the field values on RefreshTimelockSigningResult with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CounterLeafSwapResponseMultiError, or nil if none found.
-func (m *CounterLeafSwapResponse) ValidateAll() error {
+// RefreshTimelockSigningResultMultiError, or nil if none found.
+func (m *RefreshTimelockSigningResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CounterLeafSwapResponse) validate(all bool) error {
+func (m *RefreshTimelockSigningResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13581,82 +15126,50 @@ func (m *CounterLeafSwapResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
+		switch v := interface{}(m.GetSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CounterLeafSwapResponseValidationError{
-					field:  "Transfer",
+				errors = append(errors, RefreshTimelockSigningResultValidationError{
+					field:  "SigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CounterLeafSwapResponseValidationError{
-					field:  "Transfer",
+				errors = append(errors, RefreshTimelockSigningResultValidationError{
+					field:  "SigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CounterLeafSwapResponseValidationError{
-				field:  "Transfer",
+			return RefreshTimelockSigningResultValidationError{
+				field:  "SigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetSigningResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CounterLeafSwapResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CounterLeafSwapResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CounterLeafSwapResponseValidationError{
-					field:  fmt.Sprintf("SigningResults[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for VerifyingKey
 
 	if len(errors) > 0 {
-		return CounterLeafSwapResponseMultiError(errors)
+		return RefreshTimelockSigningResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// CounterLeafSwapResponseMultiError is an error wrapping multiple validation
-// errors returned by CounterLeafSwapResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CounterLeafSwapResponseMultiError []error
+// RefreshTimelockSigningResultMultiError is an error wrapping multiple
+// validation errors returned by RefreshTimelockSigningResult.ValidateAll() if
+// the designated constraints aren't met.
+type RefreshTimelockSigningResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CounterLeafSwapResponseMultiError) Error() string {
+func (m RefreshTimelockSigningResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13665,11 +15178,12 @@ func (m CounterLeafSwapResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CounterLeafSwapResponseMultiError) AllErrors() []error { return m }
+func (m RefreshTimelockSigningResultMultiError) AllErrors() []error { return m }
 
-// CounterLeafSwapResponseValidationError is the validation error returned by
-// CounterLeafSwapResponse.Validate if the designated constraints aren't met.
-type CounterLeafSwapResponseValidationError struct {
+// RefreshTimelockSigningResultValidationError is the validation error returned
+// by RefreshTimelockSigningResult.Validate if the designated constraints
+// aren't met.
+type RefreshTimelockSigningResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13677,24 +15191,24 @@ type CounterLeafSwapResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CounterLeafSwapResponseValidationError) Field() string { return e.field }
+func (e RefreshTimelockSigningResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CounterLeafSwapResponseValidationError) Reason() string { return e.reason }
+func (e RefreshTimelockSigningResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CounterLeafSwapResponseValidationError) Cause() error { return e.cause }
+func (e RefreshTimelockSigningResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CounterLeafSwapResponseValidationError) Key() bool { return e.key }
+func (e RefreshTimelockSigningResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CounterLeafSwapResponseValidationError) ErrorName() string {
-	return "CounterLeafSwapResponseValidationError"
+func (e RefreshTimelockSigningResultValidationError) ErrorName() string {
+	return "RefreshTimelockSigningResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CounterLeafSwapResponseValidationError) Error() string {
+func (e RefreshTimelockSigningResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13706,14 +15220,14 @@ func (e CounterLeafSwapResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCounterLeafSwapResponse.%s: %s%s",
+		"invalid %sRefreshTimelockSigningResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CounterLeafSwapResponseValidationError{}
+var _ error = RefreshTimelockSigningResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -13721,51 +15235,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CounterLeafSwapResponseValidationError{}
+} = RefreshTimelockSigningResultValidationError{}
 
-// Validate checks the field values on RefreshTimelockRequest with the rules
+// Validate checks the field values on RefreshTimelockResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshTimelockRequest) Validate() error {
+func (m *RefreshTimelockResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshTimelockRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RefreshTimelockResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefreshTimelockRequestMultiError, or nil if none found.
-func (m *RefreshTimelockRequest) ValidateAll() error {
+// RefreshTimelockResponseMultiError, or nil if none found.
+func (m *RefreshTimelockResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshTimelockRequest) validate(all bool) error {
+func (m *RefreshTimelockResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LeafId
-
-	// no validation rules for OwnerIdentityPublicKey
-
-	for idx, item := range m.GetSigningJobs() {
+	for idx, item := range m.GetSigningResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RefreshTimelockRequestValidationError{
-						field:  fmt.Sprintf("SigningJobs[%v]", idx),
+					errors = append(errors, RefreshTimelockResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, RefreshTimelockRequestValidationError{
-						field:  fmt.Sprintf("SigningJobs[%v]", idx),
+					errors = append(errors, RefreshTimelockResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -13773,8 +15283,8 @@ func (m *RefreshTimelockRequest) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return RefreshTimelockRequestValidationError{
-					field:  fmt.Sprintf("SigningJobs[%v]", idx),
+				return RefreshTimelockResponseValidationError{
+					field:  fmt.Sprintf("SigningResults[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -13784,19 +15294,19 @@ func (m *RefreshTimelockRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return RefreshTimelockRequestMultiError(errors)
+		return RefreshTimelockResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshTimelockRequestMultiError is an error wrapping multiple validation
-// errors returned by RefreshTimelockRequest.ValidateAll() if the designated
+// RefreshTimelockResponseMultiError is an error wrapping multiple validation
+// errors returned by RefreshTimelockResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RefreshTimelockRequestMultiError []error
+type RefreshTimelockResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTimelockRequestMultiError) Error() string {
+func (m RefreshTimelockResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13805,11 +15315,11 @@ func (m RefreshTimelockRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshTimelockRequestMultiError) AllErrors() []error { return m }
+func (m RefreshTimelockResponseMultiError) AllErrors() []error { return m }
 
-// RefreshTimelockRequestValidationError is the validation error returned by
-// RefreshTimelockRequest.Validate if the designated constraints aren't met.
-type RefreshTimelockRequestValidationError struct {
+// RefreshTimelockResponseValidationError is the validation error returned by
+// RefreshTimelockResponse.Validate if the designated constraints aren't met.
+type RefreshTimelockResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13817,24 +15327,24 @@ type RefreshTimelockRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshTimelockRequestValidationError) Field() string { return e.field }
+func (e RefreshTimelockResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshTimelockRequestValidationError) Reason() string { return e.reason }
+func (e RefreshTimelockResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshTimelockRequestValidationError) Cause() error { return e.cause }
+func (e RefreshTimelockResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshTimelockRequestValidationError) Key() bool { return e.key }
+func (e RefreshTimelockResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshTimelockRequestValidationError) ErrorName() string {
-	return "RefreshTimelockRequestValidationError"
+func (e RefreshTimelockResponseValidationError) ErrorName() string {
+	return "RefreshTimelockResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefreshTimelockRequestValidationError) Error() string {
+func (e RefreshTimelockResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13846,14 +15356,14 @@ func (e RefreshTimelockRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshTimelockRequest.%s: %s%s",
+		"invalid %sRefreshTimelockResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshTimelockRequestValidationError{}
+var _ error = RefreshTimelockResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -13861,75 +15371,193 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshTimelockRequestValidationError{}
+} = RefreshTimelockResponseValidationError{}
 
-// Validate checks the field values on RefreshTimelockSigningResult with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshTimelockSigningResult) Validate() error {
+// Validate checks the field values on ExtendLeafRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExtendLeafRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshTimelockSigningResult with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ExtendLeafRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefreshTimelockSigningResultMultiError, or nil if none found.
-func (m *RefreshTimelockSigningResult) ValidateAll() error {
+// ExtendLeafRequestMultiError, or nil if none found.
+func (m *ExtendLeafRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshTimelockSigningResult) validate(all bool) error {
+func (m *ExtendLeafRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for LeafId
+
+	// no validation rules for OwnerIdentityPublicKey
+
 	if all {
-		switch v := interface{}(m.GetSigningResult()).(type) {
+		switch v := interface{}(m.GetNodeTxSigningJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RefreshTimelockSigningResultValidationError{
-					field:  "SigningResult",
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "NodeTxSigningJob",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, RefreshTimelockSigningResultValidationError{
-					field:  "SigningResult",
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "NodeTxSigningJob",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSigningResult()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNodeTxSigningJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return RefreshTimelockSigningResultValidationError{
-				field:  "SigningResult",
+			return ExtendLeafRequestValidationError{
+				field:  "NodeTxSigningJob",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for VerifyingKey
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendLeafRequestValidationError{
+				field:  "RefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectNodeTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "DirectNodeTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "DirectNodeTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectNodeTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendLeafRequestValidationError{
+				field:  "DirectNodeTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendLeafRequestValidationError{
+				field:  "DirectRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendLeafRequestValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendLeafRequestValidationError{
+				field:  "DirectFromCpfpRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return RefreshTimelockSigningResultMultiError(errors)
+		return ExtendLeafRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshTimelockSigningResultMultiError is an error wrapping multiple
-// validation errors returned by RefreshTimelockSigningResult.ValidateAll() if
-// the designated constraints aren't met.
-type RefreshTimelockSigningResultMultiError []error
+// ExtendLeafRequestMultiError is an error wrapping multiple validation errors
+// returned by ExtendLeafRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExtendLeafRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTimelockSigningResultMultiError) Error() string {
+func (m ExtendLeafRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13938,12 +15566,11 @@ func (m RefreshTimelockSigningResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshTimelockSigningResultMultiError) AllErrors() []error { return m }
+func (m ExtendLeafRequestMultiError) AllErrors() []error { return m }
 
-// RefreshTimelockSigningResultValidationError is the validation error returned
-// by RefreshTimelockSigningResult.Validate if the designated constraints
-// aren't met.
-type RefreshTimelockSigningResultValidationError struct {
+// ExtendLeafRequestValidationError is the validation error returned by
+// ExtendLeafRequest.Validate if the designated constraints aren't met.
+type ExtendLeafRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13951,24 +15578,24 @@ type RefreshTimelockSigningResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshTimelockSigningResultValidationError) Field() string { return e.field }
+func (e ExtendLeafRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshTimelockSigningResultValidationError) Reason() string { return e.reason }
+func (e ExtendLeafRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshTimelockSigningResultValidationError) Cause() error { return e.cause }
+func (e ExtendLeafRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshTimelockSigningResultValidationError) Key() bool { return e.key }
+func (e ExtendLeafRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshTimelockSigningResultValidationError) ErrorName() string {
-	return "RefreshTimelockSigningResultValidationError"
+func (e ExtendLeafRequestValidationError) ErrorName() string {
+	return "ExtendLeafRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefreshTimelockSigningResultValidationError) Error() string {
+func (e ExtendLeafRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13980,14 +15607,14 @@ func (e RefreshTimelockSigningResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshTimelockSigningResult.%s: %s%s",
+		"invalid %sExtendLeafRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshTimelockSigningResultValidationError{}
+var _ error = ExtendLeafRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -13995,78 +15622,75 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshTimelockSigningResultValidationError{}
+} = ExtendLeafRequestValidationError{}
 
-// Validate checks the field values on RefreshTimelockResponse with the rules
+// Validate checks the field values on ExtendLeafSigningResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RefreshTimelockResponse) Validate() error {
+func (m *ExtendLeafSigningResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RefreshTimelockResponse with the
+// ValidateAll checks the field values on ExtendLeafSigningResult with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RefreshTimelockResponseMultiError, or nil if none found.
-func (m *RefreshTimelockResponse) ValidateAll() error {
+// ExtendLeafSigningResultMultiError, or nil if none found.
+func (m *ExtendLeafSigningResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RefreshTimelockResponse) validate(all bool) error {
+func (m *ExtendLeafSigningResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSigningResults() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, RefreshTimelockResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, RefreshTimelockResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendLeafSigningResultValidationError{
+					field:  "SigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return RefreshTimelockResponseValidationError{
-					field:  fmt.Sprintf("SigningResults[%v]", idx),
+				errors = append(errors, ExtendLeafSigningResultValidationError{
+					field:  "SigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendLeafSigningResultValidationError{
+				field:  "SigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
+	// no validation rules for VerifyingKey
+
 	if len(errors) > 0 {
-		return RefreshTimelockResponseMultiError(errors)
+		return ExtendLeafSigningResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// RefreshTimelockResponseMultiError is an error wrapping multiple validation
-// errors returned by RefreshTimelockResponse.ValidateAll() if the designated
+// ExtendLeafSigningResultMultiError is an error wrapping multiple validation
+// errors returned by ExtendLeafSigningResult.ValidateAll() if the designated
 // constraints aren't met.
-type RefreshTimelockResponseMultiError []error
+type ExtendLeafSigningResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RefreshTimelockResponseMultiError) Error() string {
+func (m ExtendLeafSigningResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14075,11 +15699,11 @@ func (m RefreshTimelockResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RefreshTimelockResponseMultiError) AllErrors() []error { return m }
+func (m ExtendLeafSigningResultMultiError) AllErrors() []error { return m }
 
-// RefreshTimelockResponseValidationError is the validation error returned by
-// RefreshTimelockResponse.Validate if the designated constraints aren't met.
-type RefreshTimelockResponseValidationError struct {
+// ExtendLeafSigningResultValidationError is the validation error returned by
+// ExtendLeafSigningResult.Validate if the designated constraints aren't met.
+type ExtendLeafSigningResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14087,24 +15711,24 @@ type RefreshTimelockResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RefreshTimelockResponseValidationError) Field() string { return e.field }
+func (e ExtendLeafSigningResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RefreshTimelockResponseValidationError) Reason() string { return e.reason }
+func (e ExtendLeafSigningResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RefreshTimelockResponseValidationError) Cause() error { return e.cause }
+func (e ExtendLeafSigningResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RefreshTimelockResponseValidationError) Key() bool { return e.key }
+func (e ExtendLeafSigningResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RefreshTimelockResponseValidationError) ErrorName() string {
-	return "RefreshTimelockResponseValidationError"
+func (e ExtendLeafSigningResultValidationError) ErrorName() string {
+	return "ExtendLeafSigningResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RefreshTimelockResponseValidationError) Error() string {
+func (e ExtendLeafSigningResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14116,14 +15740,14 @@ func (e RefreshTimelockResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRefreshTimelockResponse.%s: %s%s",
+		"invalid %sExtendLeafSigningResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RefreshTimelockResponseValidationError{}
+var _ error = ExtendLeafSigningResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -14131,24 +15755,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RefreshTimelockResponseValidationError{}
+} = ExtendLeafSigningResultValidationError{}
 
-// Validate checks the field values on ExtendLeafRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ExtendLeafRequest) Validate() error {
+// Validate checks the field values on ExtendLeafResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendLeafResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExtendLeafRequest with the rules
+// ValidateAll checks the field values on ExtendLeafResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExtendLeafRequestMultiError, or nil if none found.
-func (m *ExtendLeafRequest) ValidateAll() error {
+// ExtendLeafResponseMultiError, or nil if none found.
+func (m *ExtendLeafResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExtendLeafRequest) validate(all bool) error {
+func (m *ExtendLeafResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14157,31 +15781,29 @@ func (m *ExtendLeafRequest) validate(all bool) error {
 
 	// no validation rules for LeafId
 
-	// no validation rules for OwnerIdentityPublicKey
-
 	if all {
-		switch v := interface{}(m.GetNodeTxSigningJob()).(type) {
+		switch v := interface{}(m.GetNodeTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "NodeTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "NodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "NodeTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "NodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNodeTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNodeTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExtendLeafRequestValidationError{
-				field:  "NodeTxSigningJob",
+			return ExtendLeafResponseValidationError{
+				field:  "NodeTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -14189,28 +15811,28 @@ func (m *ExtendLeafRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "RefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "RefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExtendLeafRequestValidationError{
-				field:  "RefundTxSigningJob",
+			return ExtendLeafResponseValidationError{
+				field:  "RefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -14218,28 +15840,28 @@ func (m *ExtendLeafRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectNodeTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectNodeTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "DirectNodeTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "DirectNodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "DirectNodeTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "DirectNodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectNodeTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectNodeTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExtendLeafRequestValidationError{
-				field:  "DirectNodeTxSigningJob",
+			return ExtendLeafResponseValidationError{
+				field:  "DirectNodeTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -14247,28 +15869,28 @@ func (m *ExtendLeafRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "DirectRefundTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "DirectRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "DirectRefundTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "DirectRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExtendLeafRequestValidationError{
-				field:  "DirectRefundTxSigningJob",
+			return ExtendLeafResponseValidationError{
+				field:  "DirectRefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -14276,28 +15898,28 @@ func (m *ExtendLeafRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafRequestValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, ExtendLeafResponseValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExtendLeafRequestValidationError{
-				field:  "DirectFromCpfpRefundTxSigningJob",
+			return ExtendLeafResponseValidationError{
+				field:  "DirectFromCpfpRefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -14305,19 +15927,19 @@ func (m *ExtendLeafRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ExtendLeafRequestMultiError(errors)
+		return ExtendLeafResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExtendLeafRequestMultiError is an error wrapping multiple validation errors
-// returned by ExtendLeafRequest.ValidateAll() if the designated constraints
+// ExtendLeafResponseMultiError is an error wrapping multiple validation errors
+// returned by ExtendLeafResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ExtendLeafRequestMultiError []error
+type ExtendLeafResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExtendLeafRequestMultiError) Error() string {
+func (m ExtendLeafResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14326,11 +15948,11 @@ func (m ExtendLeafRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExtendLeafRequestMultiError) AllErrors() []error { return m }
+func (m ExtendLeafResponseMultiError) AllErrors() []error { return m }
 
-// ExtendLeafRequestValidationError is the validation error returned by
-// ExtendLeafRequest.Validate if the designated constraints aren't met.
-type ExtendLeafRequestValidationError struct {
+// ExtendLeafResponseValidationError is the validation error returned by
+// ExtendLeafResponse.Validate if the designated constraints aren't met.
+type ExtendLeafResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14338,24 +15960,24 @@ type ExtendLeafRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExtendLeafRequestValidationError) Field() string { return e.field }
+func (e ExtendLeafResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExtendLeafRequestValidationError) Reason() string { return e.reason }
+func (e ExtendLeafResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExtendLeafRequestValidationError) Cause() error { return e.cause }
+func (e ExtendLeafResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExtendLeafRequestValidationError) Key() bool { return e.key }
+func (e ExtendLeafResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExtendLeafRequestValidationError) ErrorName() string {
-	return "ExtendLeafRequestValidationError"
+func (e ExtendLeafResponseValidationError) ErrorName() string {
+	return "ExtendLeafResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExtendLeafRequestValidationError) Error() string {
+func (e ExtendLeafResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14367,14 +15989,14 @@ func (e ExtendLeafRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExtendLeafRequest.%s: %s%s",
+		"invalid %sExtendLeafResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExtendLeafRequestValidationError{}
+var _ error = ExtendLeafResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -14382,75 +16004,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExtendLeafRequestValidationError{}
+} = ExtendLeafResponseValidationError{}
 
-// Validate checks the field values on ExtendLeafSigningResult with the rules
+// Validate checks the field values on AddressRequestNode with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExtendLeafSigningResult) Validate() error {
+func (m *AddressRequestNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExtendLeafSigningResult with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddressRequestNode with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExtendLeafSigningResultMultiError, or nil if none found.
-func (m *ExtendLeafSigningResult) ValidateAll() error {
+// AddressRequestNodeMultiError, or nil if none found.
+func (m *AddressRequestNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExtendLeafSigningResult) validate(all bool) error {
+func (m *AddressRequestNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafSigningResultValidationError{
-					field:  "SigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for UserPublicKey
+
+	for idx, item := range m.GetChildren() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddressRequestNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddressRequestNodeValidationError{
+						field:  fmt.Sprintf("Children[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafSigningResultValidationError{
-					field:  "SigningResult",
+				return AddressRequestNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExtendLeafSigningResultValidationError{
-				field:  "SigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for VerifyingKey
+	}
 
 	if len(errors) > 0 {
-		return ExtendLeafSigningResultMultiError(errors)
+		return AddressRequestNodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExtendLeafSigningResultMultiError is an error wrapping multiple validation
-// errors returned by ExtendLeafSigningResult.ValidateAll() if the designated
-// constraints aren't met.
-type ExtendLeafSigningResultMultiError []error
+// AddressRequestNodeMultiError is an error wrapping multiple validation errors
+// returned by AddressRequestNode.ValidateAll() if the designated constraints
+// aren't met.
+type AddressRequestNodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExtendLeafSigningResultMultiError) Error() string {
+func (m AddressRequestNodeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14459,11 +16086,11 @@ func (m ExtendLeafSigningResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExtendLeafSigningResultMultiError) AllErrors() []error { return m }
+func (m AddressRequestNodeMultiError) AllErrors() []error { return m }
 
-// ExtendLeafSigningResultValidationError is the validation error returned by
-// ExtendLeafSigningResult.Validate if the designated constraints aren't met.
-type ExtendLeafSigningResultValidationError struct {
+// AddressRequestNodeValidationError is the validation error returned by
+// AddressRequestNode.Validate if the designated constraints aren't met.
+type AddressRequestNodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14471,24 +16098,24 @@ type ExtendLeafSigningResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExtendLeafSigningResultValidationError) Field() string { return e.field }
+func (e AddressRequestNodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExtendLeafSigningResultValidationError) Reason() string { return e.reason }
+func (e AddressRequestNodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExtendLeafSigningResultValidationError) Cause() error { return e.cause }
+func (e AddressRequestNodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExtendLeafSigningResultValidationError) Key() bool { return e.key }
+func (e AddressRequestNodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExtendLeafSigningResultValidationError) ErrorName() string {
-	return "ExtendLeafSigningResultValidationError"
+func (e AddressRequestNodeValidationError) ErrorName() string {
+	return "AddressRequestNodeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExtendLeafSigningResultValidationError) Error() string {
+func (e AddressRequestNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14500,14 +16127,14 @@ func (e ExtendLeafSigningResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExtendLeafSigningResult.%s: %s%s",
+		"invalid %sAddressRequestNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExtendLeafSigningResultValidationError{}
+var _ error = AddressRequestNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -14515,191 +16142,162 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExtendLeafSigningResultValidationError{}
+} = AddressRequestNodeValidationError{}
 
-// Validate checks the field values on ExtendLeafResponse with the rules
+// Validate checks the field values on PrepareTreeAddressRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExtendLeafResponse) Validate() error {
+func (m *PrepareTreeAddressRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExtendLeafResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on PrepareTreeAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExtendLeafResponseMultiError, or nil if none found.
-func (m *ExtendLeafResponse) ValidateAll() error {
+// PrepareTreeAddressRequestMultiError, or nil if none found.
+func (m *PrepareTreeAddressRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExtendLeafResponse) validate(all bool) error {
+func (m *PrepareTreeAddressRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for LeafId
-
 	if all {
-		switch v := interface{}(m.GetNodeTxSigningResult()).(type) {
+		switch v := interface{}(m.GetNode()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "NodeTxSigningResult",
+				errors = append(errors, PrepareTreeAddressRequestValidationError{
+					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "NodeTxSigningResult",
+				errors = append(errors, PrepareTreeAddressRequestValidationError{
+					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNodeTxSigningResult()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ExtendLeafResponseValidationError{
-				field:  "NodeTxSigningResult",
+			return PrepareTreeAddressRequestValidationError{
+				field:  "Node",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for UserIdentityPublicKey
+
+	switch v := m.Source.(type) {
+	case *PrepareTreeAddressRequest_ParentNodeOutput:
+		if v == nil {
+			err := PrepareTreeAddressRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExtendLeafResponseValidationError{
-				field:  "RefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetDirectNodeTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "DirectNodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(m.GetParentNodeOutput()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrepareTreeAddressRequestValidationError{
+						field:  "ParentNodeOutput",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrepareTreeAddressRequestValidationError{
+						field:  "ParentNodeOutput",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetParentNodeOutput()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "DirectNodeTxSigningResult",
+				return PrepareTreeAddressRequestValidationError{
+					field:  "ParentNodeOutput",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectNodeTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExtendLeafResponseValidationError{
-				field:  "DirectNodeTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "DirectRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "DirectRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	case *PrepareTreeAddressRequest_OnChainUtxo:
+		if v == nil {
+			err := PrepareTreeAddressRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExtendLeafResponseValidationError{
-				field:  "DirectRefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
 
-	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(m.GetOnChainUtxo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PrepareTreeAddressRequestValidationError{
+						field:  "OnChainUtxo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PrepareTreeAddressRequestValidationError{
+						field:  "OnChainUtxo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExtendLeafResponseValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
+				return PrepareTreeAddressRequestValidationError{
+					field:  "OnChainUtxo",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExtendLeafResponseValidationError{
-				field:  "DirectFromCpfpRefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return ExtendLeafResponseMultiError(errors)
+		return PrepareTreeAddressRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExtendLeafResponseMultiError is an error wrapping multiple validation errors
-// returned by ExtendLeafResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ExtendLeafResponseMultiError []error
+// PrepareTreeAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by PrepareTreeAddressRequest.ValidateAll() if the
+// designated constraints aren't met.
+type PrepareTreeAddressRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExtendLeafResponseMultiError) Error() string {
+func (m PrepareTreeAddressRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14708,11 +16306,11 @@ func (m ExtendLeafResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExtendLeafResponseMultiError) AllErrors() []error { return m }
+func (m PrepareTreeAddressRequestMultiError) AllErrors() []error { return m }
 
-// ExtendLeafResponseValidationError is the validation error returned by
-// ExtendLeafResponse.Validate if the designated constraints aren't met.
-type ExtendLeafResponseValidationError struct {
+// PrepareTreeAddressRequestValidationError is the validation error returned by
+// PrepareTreeAddressRequest.Validate if the designated constraints aren't met.
+type PrepareTreeAddressRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14720,24 +16318,24 @@ type ExtendLeafResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExtendLeafResponseValidationError) Field() string { return e.field }
+func (e PrepareTreeAddressRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExtendLeafResponseValidationError) Reason() string { return e.reason }
+func (e PrepareTreeAddressRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExtendLeafResponseValidationError) Cause() error { return e.cause }
+func (e PrepareTreeAddressRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExtendLeafResponseValidationError) Key() bool { return e.key }
+func (e PrepareTreeAddressRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExtendLeafResponseValidationError) ErrorName() string {
-	return "ExtendLeafResponseValidationError"
+func (e PrepareTreeAddressRequestValidationError) ErrorName() string {
+	return "PrepareTreeAddressRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExtendLeafResponseValidationError) Error() string {
+func (e PrepareTreeAddressRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14749,14 +16347,14 @@ func (e ExtendLeafResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExtendLeafResponse.%s: %s%s",
+		"invalid %sPrepareTreeAddressRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExtendLeafResponseValidationError{}
+var _ error = PrepareTreeAddressRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -14764,31 +16362,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExtendLeafResponseValidationError{}
+} = PrepareTreeAddressRequestValidationError{}
 
-// Validate checks the field values on AddressRequestNode with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddressRequestNode) Validate() error {
+// Validate checks the field values on AddressNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AddressNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddressRequestNode with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddressRequestNodeMultiError, or nil if none found.
-func (m *AddressRequestNode) ValidateAll() error {
+// ValidateAll checks the field values on AddressNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AddressNodeMultiError, or
+// nil if none found.
+func (m *AddressNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddressRequestNode) validate(all bool) error {
+func (m *AddressNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserPublicKey
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddressNodeValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddressNodeValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddressNodeValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	for idx, item := range m.GetChildren() {
 		_, _ = idx, item
@@ -14797,7 +16422,7 @@ func (m *AddressRequestNode) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddressRequestNodeValidationError{
+					errors = append(errors, AddressNodeValidationError{
 						field:  fmt.Sprintf("Children[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14805,7 +16430,7 @@ func (m *AddressRequestNode) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddressRequestNodeValidationError{
+					errors = append(errors, AddressNodeValidationError{
 						field:  fmt.Sprintf("Children[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14814,7 +16439,7 @@ func (m *AddressRequestNode) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddressRequestNodeValidationError{
+				return AddressNodeValidationError{
 					field:  fmt.Sprintf("Children[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14825,19 +16450,18 @@ func (m *AddressRequestNode) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddressRequestNodeMultiError(errors)
+		return AddressNodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddressRequestNodeMultiError is an error wrapping multiple validation errors
-// returned by AddressRequestNode.ValidateAll() if the designated constraints
-// aren't met.
-type AddressRequestNodeMultiError []error
+// AddressNodeMultiError is an error wrapping multiple validation errors
+// returned by AddressNode.ValidateAll() if the designated constraints aren't met.
+type AddressNodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddressRequestNodeMultiError) Error() string {
+func (m AddressNodeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14846,11 +16470,11 @@ func (m AddressRequestNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddressRequestNodeMultiError) AllErrors() []error { return m }
+func (m AddressNodeMultiError) AllErrors() []error { return m }
 
-// AddressRequestNodeValidationError is the validation error returned by
-// AddressRequestNode.Validate if the designated constraints aren't met.
-type AddressRequestNodeValidationError struct {
+// AddressNodeValidationError is the validation error returned by
+// AddressNode.Validate if the designated constraints aren't met.
+type AddressNodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14858,24 +16482,22 @@ type AddressRequestNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddressRequestNodeValidationError) Field() string { return e.field }
+func (e AddressNodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddressRequestNodeValidationError) Reason() string { return e.reason }
+func (e AddressNodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddressRequestNodeValidationError) Cause() error { return e.cause }
+func (e AddressNodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddressRequestNodeValidationError) Key() bool { return e.key }
+func (e AddressNodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddressRequestNodeValidationError) ErrorName() string {
-	return "AddressRequestNodeValidationError"
-}
+func (e AddressNodeValidationError) ErrorName() string { return "AddressNodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddressRequestNodeValidationError) Error() string {
+func (e AddressNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14887,14 +16509,14 @@ func (e AddressRequestNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddressRequestNode.%s: %s%s",
+		"invalid %sAddressNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddressRequestNodeValidationError{}
+var _ error = AddressNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -14902,162 +16524,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddressRequestNodeValidationError{}
-
-// Validate checks the field values on PrepareTreeAddressRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PrepareTreeAddressRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on PrepareTreeAddressRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// PrepareTreeAddressRequestMultiError, or nil if none found.
-func (m *PrepareTreeAddressRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *PrepareTreeAddressRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetNode()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PrepareTreeAddressRequestValidationError{
-					field:  "Node",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, PrepareTreeAddressRequestValidationError{
-					field:  "Node",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return PrepareTreeAddressRequestValidationError{
-				field:  "Node",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for UserIdentityPublicKey
-
-	switch v := m.Source.(type) {
-	case *PrepareTreeAddressRequest_ParentNodeOutput:
-		if v == nil {
-			err := PrepareTreeAddressRequestValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetParentNodeOutput()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PrepareTreeAddressRequestValidationError{
-						field:  "ParentNodeOutput",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PrepareTreeAddressRequestValidationError{
-						field:  "ParentNodeOutput",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetParentNodeOutput()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PrepareTreeAddressRequestValidationError{
-					field:  "ParentNodeOutput",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+} = AddressNodeValidationError{}
 
-	case *PrepareTreeAddressRequest_OnChainUtxo:
-		if v == nil {
-			err := PrepareTreeAddressRequestValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Validate checks the field values on PrepareTreeAddressResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PrepareTreeAddressResponse) Validate() error {
+	return m.validate(false)
+}
 
-		if all {
-			switch v := interface{}(m.GetOnChainUtxo()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PrepareTreeAddressRequestValidationError{
-						field:  "OnChainUtxo",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PrepareTreeAddressRequestValidationError{
-						field:  "OnChainUtxo",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+// ValidateAll checks the field values on PrepareTreeAddressResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrepareTreeAddressResponseMultiError, or nil if none found.
+func (m *PrepareTreeAddressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrepareTreeAddressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PrepareTreeAddressResponseValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return PrepareTreeAddressRequestValidationError{
-					field:  "OnChainUtxo",
+				errors = append(errors, PrepareTreeAddressResponseValidationError{
+					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PrepareTreeAddressResponseValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return PrepareTreeAddressRequestMultiError(errors)
+		return PrepareTreeAddressResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PrepareTreeAddressRequestMultiError is an error wrapping multiple validation
-// errors returned by PrepareTreeAddressRequest.ValidateAll() if the
-// designated constraints aren't met.
-type PrepareTreeAddressRequestMultiError []error
+// PrepareTreeAddressResponseMultiError is an error wrapping multiple
+// validation errors returned by PrepareTreeAddressResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PrepareTreeAddressResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PrepareTreeAddressRequestMultiError) Error() string {
+func (m PrepareTreeAddressResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15066,11 +16599,11 @@ func (m PrepareTreeAddressRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PrepareTreeAddressRequestMultiError) AllErrors() []error { return m }
+func (m PrepareTreeAddressResponseMultiError) AllErrors() []error { return m }
 
-// PrepareTreeAddressRequestValidationError is the validation error returned by
-// PrepareTreeAddressRequest.Validate if the designated constraints aren't met.
-type PrepareTreeAddressRequestValidationError struct {
+// PrepareTreeAddressResponseValidationError is the validation error returned
+// by PrepareTreeAddressResponse.Validate if the designated constraints aren't met.
+type PrepareTreeAddressResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15078,24 +16611,24 @@ type PrepareTreeAddressRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PrepareTreeAddressRequestValidationError) Field() string { return e.field }
+func (e PrepareTreeAddressResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PrepareTreeAddressRequestValidationError) Reason() string { return e.reason }
+func (e PrepareTreeAddressResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PrepareTreeAddressRequestValidationError) Cause() error { return e.cause }
+func (e PrepareTreeAddressResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PrepareTreeAddressRequestValidationError) Key() bool { return e.key }
+func (e PrepareTreeAddressResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PrepareTreeAddressRequestValidationError) ErrorName() string {
-	return "PrepareTreeAddressRequestValidationError"
+func (e PrepareTreeAddressResponseValidationError) ErrorName() string {
+	return "PrepareTreeAddressResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PrepareTreeAddressRequestValidationError) Error() string {
+func (e PrepareTreeAddressResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15107,14 +16640,14 @@ func (e PrepareTreeAddressRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPrepareTreeAddressRequest.%s: %s%s",
+		"invalid %sPrepareTreeAddressResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PrepareTreeAddressRequestValidationError{}
+var _ error = PrepareTreeAddressResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -15122,24 +16655,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PrepareTreeAddressRequestValidationError{}
+} = PrepareTreeAddressResponseValidationError{}
 
-// Validate checks the field values on AddressNode with the rules defined in
+// Validate checks the field values on CreationNode with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AddressNode) Validate() error {
+func (m *CreationNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddressNode with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AddressNodeMultiError, or
+// ValidateAll checks the field values on CreationNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreationNodeMultiError, or
 // nil if none found.
-func (m *AddressNode) ValidateAll() error {
+func (m *CreationNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddressNode) validate(all bool) error {
+func (m *CreationNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15147,28 +16680,57 @@ func (m *AddressNode) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetAddress()).(type) {
+		switch v := interface{}(m.GetNodeTxSigningJob()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddressNodeValidationError{
-					field:  "Address",
+				errors = append(errors, CreationNodeValidationError{
+					field:  "NodeTxSigningJob",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AddressNodeValidationError{
-					field:  "Address",
+				errors = append(errors, CreationNodeValidationError{
+					field:  "NodeTxSigningJob",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNodeTxSigningJob()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AddressNodeValidationError{
-				field:  "Address",
+			return CreationNodeValidationError{
+				field:  "NodeTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreationNodeValidationError{
+				field:  "RefundTxSigningJob",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15182,7 +16744,7 @@ func (m *AddressNode) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddressNodeValidationError{
+					errors = append(errors, CreationNodeValidationError{
 						field:  fmt.Sprintf("Children[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -15190,38 +16752,125 @@ func (m *AddressNode) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddressNodeValidationError{
+					errors = append(errors, CreationNodeValidationError{
 						field:  fmt.Sprintf("Children[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreationNodeValidationError{
+					field:  fmt.Sprintf("Children[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectNodeTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "DirectNodeTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "DirectNodeTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectNodeTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreationNodeValidationError{
+				field:  "DirectNodeTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "DirectRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreationNodeValidationError{
+				field:  "DirectRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreationNodeValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return AddressNodeValidationError{
-					field:  fmt.Sprintf("Children[%v]", idx),
+				errors = append(errors, CreationNodeValidationError{
+					field:  "DirectFromCpfpRefundTxSigningJob",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreationNodeValidationError{
+				field:  "DirectFromCpfpRefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return AddressNodeMultiError(errors)
+		return CreationNodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddressNodeMultiError is an error wrapping multiple validation errors
-// returned by AddressNode.ValidateAll() if the designated constraints aren't met.
-type AddressNodeMultiError []error
+// CreationNodeMultiError is an error wrapping multiple validation errors
+// returned by CreationNode.ValidateAll() if the designated constraints aren't met.
+type CreationNodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddressNodeMultiError) Error() string {
+func (m CreationNodeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15230,11 +16879,11 @@ func (m AddressNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddressNodeMultiError) AllErrors() []error { return m }
+func (m CreationNodeMultiError) AllErrors() []error { return m }
 
-// AddressNodeValidationError is the validation error returned by
-// AddressNode.Validate if the designated constraints aren't met.
-type AddressNodeValidationError struct {
+// CreationNodeValidationError is the validation error returned by
+// CreationNode.Validate if the designated constraints aren't met.
+type CreationNodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15242,22 +16891,22 @@ type AddressNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddressNodeValidationError) Field() string { return e.field }
+func (e CreationNodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddressNodeValidationError) Reason() string { return e.reason }
+func (e CreationNodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddressNodeValidationError) Cause() error { return e.cause }
+func (e CreationNodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddressNodeValidationError) Key() bool { return e.key }
+func (e CreationNodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddressNodeValidationError) ErrorName() string { return "AddressNodeValidationError" }
+func (e CreationNodeValidationError) ErrorName() string { return "CreationNodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AddressNodeValidationError) Error() string {
+func (e CreationNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15269,14 +16918,14 @@ func (e AddressNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddressNode.%s: %s%s",
+		"invalid %sCreationNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddressNodeValidationError{}
+var _ error = CreationNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -15284,24 +16933,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddressNodeValidationError{}
+} = CreationNodeValidationError{}
 
-// Validate checks the field values on PrepareTreeAddressResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PrepareTreeAddressResponse) Validate() error {
+// Validate checks the field values on CreateTreeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateTreeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PrepareTreeAddressResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateTreeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PrepareTreeAddressResponseMultiError, or nil if none found.
-func (m *PrepareTreeAddressResponse) ValidateAll() error {
+// CreateTreeRequestMultiError, or nil if none found.
+func (m *CreateTreeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PrepareTreeAddressResponse) validate(all bool) error {
+func (m *CreateTreeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15312,7 +16961,7 @@ func (m *PrepareTreeAddressResponse) validate(all bool) error {
 		switch v := interface{}(m.GetNode()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, PrepareTreeAddressResponseValidationError{
+				errors = append(errors, CreateTreeRequestValidationError{
 					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15320,7 +16969,7 @@ func (m *PrepareTreeAddressResponse) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, PrepareTreeAddressResponseValidationError{
+				errors = append(errors, CreateTreeRequestValidationError{
 					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15329,7 +16978,7 @@ func (m *PrepareTreeAddressResponse) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return PrepareTreeAddressResponseValidationError{
+			return CreateTreeRequestValidationError{
 				field:  "Node",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15337,20 +16986,109 @@ func (m *PrepareTreeAddressResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for UserIdentityPublicKey
+
+	switch v := m.Source.(type) {
+	case *CreateTreeRequest_ParentNodeOutput:
+		if v == nil {
+			err := CreateTreeRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetParentNodeOutput()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTreeRequestValidationError{
+						field:  "ParentNodeOutput",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTreeRequestValidationError{
+						field:  "ParentNodeOutput",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetParentNodeOutput()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTreeRequestValidationError{
+					field:  "ParentNodeOutput",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CreateTreeRequest_OnChainUtxo:
+		if v == nil {
+			err := CreateTreeRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOnChainUtxo()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTreeRequestValidationError{
+						field:  "OnChainUtxo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTreeRequestValidationError{
+						field:  "OnChainUtxo",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTreeRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
 	if len(errors) > 0 {
-		return PrepareTreeAddressResponseMultiError(errors)
+		return CreateTreeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PrepareTreeAddressResponseMultiError is an error wrapping multiple
-// validation errors returned by PrepareTreeAddressResponse.ValidateAll() if
-// the designated constraints aren't met.
-type PrepareTreeAddressResponseMultiError []error
+// CreateTreeRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateTreeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTreeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PrepareTreeAddressResponseMultiError) Error() string {
+func (m CreateTreeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15359,11 +17097,11 @@ func (m PrepareTreeAddressResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PrepareTreeAddressResponseMultiError) AllErrors() []error { return m }
+func (m CreateTreeRequestMultiError) AllErrors() []error { return m }
 
-// PrepareTreeAddressResponseValidationError is the validation error returned
-// by PrepareTreeAddressResponse.Validate if the designated constraints aren't met.
-type PrepareTreeAddressResponseValidationError struct {
+// CreateTreeRequestValidationError is the validation error returned by
+// CreateTreeRequest.Validate if the designated constraints aren't met.
+type CreateTreeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15371,24 +17109,24 @@ type PrepareTreeAddressResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PrepareTreeAddressResponseValidationError) Field() string { return e.field }
+func (e CreateTreeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PrepareTreeAddressResponseValidationError) Reason() string { return e.reason }
+func (e CreateTreeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PrepareTreeAddressResponseValidationError) Cause() error { return e.cause }
+func (e CreateTreeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PrepareTreeAddressResponseValidationError) Key() bool { return e.key }
+func (e CreateTreeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PrepareTreeAddressResponseValidationError) ErrorName() string {
-	return "PrepareTreeAddressResponseValidationError"
+func (e CreateTreeRequestValidationError) ErrorName() string {
+	return "CreateTreeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PrepareTreeAddressResponseValidationError) Error() string {
+func (e CreateTreeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15400,14 +17138,14 @@ func (e PrepareTreeAddressResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPrepareTreeAddressResponse.%s: %s%s",
+		"invalid %sCreateTreeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PrepareTreeAddressResponseValidationError{}
+var _ error = CreateTreeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -15415,53 +17153,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PrepareTreeAddressResponseValidationError{}
+} = CreateTreeRequestValidationError{}
 
-// Validate checks the field values on CreationNode with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CreationNode) Validate() error {
+// Validate checks the field values on CreationResponseNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreationResponseNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreationNode with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CreationNodeMultiError, or
-// nil if none found.
-func (m *CreationNode) ValidateAll() error {
+// ValidateAll checks the field values on CreationResponseNode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreationResponseNodeMultiError, or nil if none found.
+func (m *CreationResponseNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreationNode) validate(all bool) error {
+func (m *CreationResponseNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for NodeId
+
 	if all {
-		switch v := interface{}(m.GetNodeTxSigningJob()).(type) {
+		switch v := interface{}(m.GetNodeTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "NodeTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "NodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "NodeTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "NodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNodeTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetNodeTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreationNodeValidationError{
-				field:  "NodeTxSigningJob",
+			return CreationResponseNodeValidationError{
+				field:  "NodeTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15469,28 +17209,28 @@ func (m *CreationNode) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "RefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "RefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreationNodeValidationError{
-				field:  "RefundTxSigningJob",
+			return CreationResponseNodeValidationError{
+				field:  "RefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15504,7 +17244,7 @@ func (m *CreationNode) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreationNodeValidationError{
+					errors = append(errors, CreationResponseNodeValidationError{
 						field:  fmt.Sprintf("Children[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -15512,7 +17252,7 @@ func (m *CreationNode) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, CreationNodeValidationError{
+					errors = append(errors, CreationResponseNodeValidationError{
 						field:  fmt.Sprintf("Children[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -15521,7 +17261,7 @@ func (m *CreationNode) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return CreationNodeValidationError{
+				return CreationResponseNodeValidationError{
 					field:  fmt.Sprintf("Children[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15532,28 +17272,28 @@ func (m *CreationNode) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectNodeTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectNodeTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "DirectNodeTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "DirectNodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "DirectNodeTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "DirectNodeTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectNodeTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectNodeTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreationNodeValidationError{
-				field:  "DirectNodeTxSigningJob",
+			return CreationResponseNodeValidationError{
+				field:  "DirectNodeTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15561,28 +17301,28 @@ func (m *CreationNode) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "DirectRefundTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "DirectRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "DirectRefundTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "DirectRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreationNodeValidationError{
-				field:  "DirectRefundTxSigningJob",
+			return CreationResponseNodeValidationError{
+				field:  "DirectRefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15590,28 +17330,28 @@ func (m *CreationNode) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationNodeValidationError{
-					field:  "DirectFromCpfpRefundTxSigningJob",
+				errors = append(errors, CreationResponseNodeValidationError{
+					field:  "DirectFromCpfpRefundTxSigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreationNodeValidationError{
-				field:  "DirectFromCpfpRefundTxSigningJob",
+			return CreationResponseNodeValidationError{
+				field:  "DirectFromCpfpRefundTxSigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -15619,18 +17359,19 @@ func (m *CreationNode) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreationNodeMultiError(errors)
+		return CreationResponseNodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreationNodeMultiError is an error wrapping multiple validation errors
-// returned by CreationNode.ValidateAll() if the designated constraints aren't met.
-type CreationNodeMultiError []error
+// CreationResponseNodeMultiError is an error wrapping multiple validation
+// errors returned by CreationResponseNode.ValidateAll() if the designated
+// constraints aren't met.
+type CreationResponseNodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreationNodeMultiError) Error() string {
+func (m CreationResponseNodeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15639,11 +17380,11 @@ func (m CreationNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreationNodeMultiError) AllErrors() []error { return m }
+func (m CreationResponseNodeMultiError) AllErrors() []error { return m }
 
-// CreationNodeValidationError is the validation error returned by
-// CreationNode.Validate if the designated constraints aren't met.
-type CreationNodeValidationError struct {
+// CreationResponseNodeValidationError is the validation error returned by
+// CreationResponseNode.Validate if the designated constraints aren't met.
+type CreationResponseNodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15651,22 +17392,24 @@ type CreationNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreationNodeValidationError) Field() string { return e.field }
+func (e CreationResponseNodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreationNodeValidationError) Reason() string { return e.reason }
+func (e CreationResponseNodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreationNodeValidationError) Cause() error { return e.cause }
+func (e CreationResponseNodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreationNodeValidationError) Key() bool { return e.key }
+func (e CreationResponseNodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreationNodeValidationError) ErrorName() string { return "CreationNodeValidationError" }
+func (e CreationResponseNodeValidationError) ErrorName() string {
+	return "CreationResponseNodeValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreationNodeValidationError) Error() string {
+func (e CreationResponseNodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15678,14 +17421,14 @@ func (e CreationNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreationNode.%s: %s%s",
+		"invalid %sCreationResponseNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreationNodeValidationError{}
+var _ error = CreationResponseNodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -15693,24 +17436,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreationNodeValidationError{}
+} = CreationResponseNodeValidationError{}
 
-// Validate checks the field values on CreateTreeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *CreateTreeRequest) Validate() error {
+// Validate checks the field values on CreateTreeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTreeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTreeRequest with the rules
+// ValidateAll checks the field values on CreateTreeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateTreeRequestMultiError, or nil if none found.
-func (m *CreateTreeRequest) ValidateAll() error {
+// CreateTreeResponseMultiError, or nil if none found.
+func (m *CreateTreeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTreeRequest) validate(all bool) error {
+func (m *CreateTreeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -15721,7 +17464,7 @@ func (m *CreateTreeRequest) validate(all bool) error {
 		switch v := interface{}(m.GetNode()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTreeRequestValidationError{
+				errors = append(errors, CreateTreeResponseValidationError{
 					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15729,7 +17472,7 @@ func (m *CreateTreeRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTreeRequestValidationError{
+				errors = append(errors, CreateTreeResponseValidationError{
 					field:  "Node",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -15738,7 +17481,7 @@ func (m *CreateTreeRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateTreeRequestValidationError{
+			return CreateTreeResponseValidationError{
 				field:  "Node",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -15746,109 +17489,130 @@ func (m *CreateTreeRequest) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for UserIdentityPublicKey
+	if len(errors) > 0 {
+		return CreateTreeResponseMultiError(errors)
+	}
 
-	switch v := m.Source.(type) {
-	case *CreateTreeRequest_ParentNodeOutput:
-		if v == nil {
-			err := CreateTreeRequestValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetParentNodeOutput()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTreeRequestValidationError{
-						field:  "ParentNodeOutput",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTreeRequestValidationError{
-						field:  "ParentNodeOutput",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetParentNodeOutput()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateTreeRequestValidationError{
-					field:  "ParentNodeOutput",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// CreateTreeResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTreeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTreeResponseMultiError []error
 
-	case *CreateTreeRequest_OnChainUtxo:
-		if v == nil {
-			err := CreateTreeRequestValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTreeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTreeResponseMultiError) AllErrors() []error { return m }
+
+// CreateTreeResponseValidationError is the validation error returned by
+// CreateTreeResponse.Validate if the designated constraints aren't met.
+type CreateTreeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTreeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTreeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTreeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTreeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTreeResponseValidationError) ErrorName() string {
+	return "CreateTreeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTreeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTreeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTreeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTreeResponseValidationError{}
+
+// Validate checks the field values on SigningOperatorInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SigningOperatorInfo) Validate() error {
+	return m.validate(false)
+}
 
-		if all {
-			switch v := interface{}(m.GetOnChainUtxo()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateTreeRequestValidationError{
-						field:  "OnChainUtxo",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateTreeRequestValidationError{
-						field:  "OnChainUtxo",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateTreeRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ValidateAll checks the field values on SigningOperatorInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SigningOperatorInfoMultiError, or nil if none found.
+func (m *SigningOperatorInfo) ValidateAll() error {
+	return m.validate(true)
+}
 
-	default:
-		_ = v // ensures v is used
+func (m *SigningOperatorInfo) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for Identifier
+
+	// no validation rules for PublicKey
+
+	// no validation rules for Address
+
 	if len(errors) > 0 {
-		return CreateTreeRequestMultiError(errors)
+		return SigningOperatorInfoMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTreeRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateTreeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type CreateTreeRequestMultiError []error
+// SigningOperatorInfoMultiError is an error wrapping multiple validation
+// errors returned by SigningOperatorInfo.ValidateAll() if the designated
+// constraints aren't met.
+type SigningOperatorInfoMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTreeRequestMultiError) Error() string {
+func (m SigningOperatorInfoMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -15857,11 +17621,11 @@ func (m CreateTreeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTreeRequestMultiError) AllErrors() []error { return m }
+func (m SigningOperatorInfoMultiError) AllErrors() []error { return m }
 
-// CreateTreeRequestValidationError is the validation error returned by
-// CreateTreeRequest.Validate if the designated constraints aren't met.
-type CreateTreeRequestValidationError struct {
+// SigningOperatorInfoValidationError is the validation error returned by
+// SigningOperatorInfo.Validate if the designated constraints aren't met.
+type SigningOperatorInfoValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -15869,24 +17633,24 @@ type CreateTreeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTreeRequestValidationError) Field() string { return e.field }
+func (e SigningOperatorInfoValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTreeRequestValidationError) Reason() string { return e.reason }
+func (e SigningOperatorInfoValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTreeRequestValidationError) Cause() error { return e.cause }
+func (e SigningOperatorInfoValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTreeRequestValidationError) Key() bool { return e.key }
+func (e SigningOperatorInfoValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTreeRequestValidationError) ErrorName() string {
-	return "CreateTreeRequestValidationError"
+func (e SigningOperatorInfoValidationError) ErrorName() string {
+	return "SigningOperatorInfoValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTreeRequestValidationError) Error() string {
+func (e SigningOperatorInfoValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -15898,14 +17662,14 @@ func (e CreateTreeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTreeRequest.%s: %s%s",
+		"invalid %sSigningOperatorInfo.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTreeRequestValidationError{}
+var _ error = SigningOperatorInfoValidationError{}
 
 var _ interface {
 	Field() string
@@ -15913,225 +17677,197 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTreeRequestValidationError{}
+} = SigningOperatorInfoValidationError{}
 
-// Validate checks the field values on CreationResponseNode with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetSigningOperatorListResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreationResponseNode) Validate() error {
+func (m *GetSigningOperatorListResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreationResponseNode with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreationResponseNodeMultiError, or nil if none found.
-func (m *CreationResponseNode) ValidateAll() error {
+// ValidateAll checks the field values on GetSigningOperatorListResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetSigningOperatorListResponseMultiError, or nil if none found.
+func (m *GetSigningOperatorListResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreationResponseNode) validate(all bool) error {
+func (m *GetSigningOperatorListResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NodeId
-
-	if all {
-		switch v := interface{}(m.GetNodeTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "NodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "NodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	{
+		sorted_keys := make([]string, len(m.GetSigningOperators()))
+		i := 0
+		for key := range m.GetSigningOperators() {
+			sorted_keys[i] = key
+			i++
 		}
-	} else if v, ok := interface{}(m.GetNodeTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreationResponseNodeValidationError{
-				field:  "NodeTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetSigningOperators()[key]
+			_ = val
+
+			// no validation rules for SigningOperators[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetSigningOperatorListResponseValidationError{
+							field:  fmt.Sprintf("SigningOperators[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetSigningOperatorListResponseValidationError{
+							field:  fmt.Sprintf("SigningOperators[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetSigningOperatorListResponseValidationError{
+						field:  fmt.Sprintf("SigningOperators[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
 			}
+
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreationResponseNodeValidationError{
-				field:  "RefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return GetSigningOperatorListResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSigningOperatorListResponseMultiError is an error wrapping multiple
+// validation errors returned by GetSigningOperatorListResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetSigningOperatorListResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSigningOperatorListResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSigningOperatorListResponseMultiError) AllErrors() []error { return m }
+
+// GetSigningOperatorListResponseValidationError is the validation error
+// returned by GetSigningOperatorListResponse.Validate if the designated
+// constraints aren't met.
+type GetSigningOperatorListResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetSigningOperatorListResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetSigningOperatorListResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetSigningOperatorListResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetSigningOperatorListResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetSigningOperatorListResponseValidationError) ErrorName() string {
+	return "GetSigningOperatorListResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetSigningOperatorListResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	for idx, item := range m.GetChildren() {
-		_, _ = idx, item
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreationResponseNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreationResponseNodeValidationError{
-						field:  fmt.Sprintf("Children[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreationResponseNodeValidationError{
-					field:  fmt.Sprintf("Children[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sGetSigningOperatorListResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	}
+var _ error = GetSigningOperatorListResponseValidationError{}
 
-	if all {
-		switch v := interface{}(m.GetDirectNodeTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "DirectNodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "DirectNodeTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectNodeTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreationResponseNodeValidationError{
-				field:  "DirectNodeTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetSigningOperatorListResponseValidationError{}
 
-	if all {
-		switch v := interface{}(m.GetDirectRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "DirectRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "DirectRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreationResponseNodeValidationError{
-				field:  "DirectRefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+// Validate checks the field values on QueryUserSignedRefundsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryUserSignedRefundsRequest) Validate() error {
+	return m.validate(false)
+}
 
-	if all {
-		switch v := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CreationResponseNodeValidationError{
-					field:  "DirectFromCpfpRefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDirectFromCpfpRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CreationResponseNodeValidationError{
-				field:  "DirectFromCpfpRefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// ValidateAll checks the field values on QueryUserSignedRefundsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryUserSignedRefundsRequestMultiError, or nil if none found.
+func (m *QueryUserSignedRefundsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryUserSignedRefundsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for PaymentHash
+
+	// no validation rules for IdentityPublicKey
+
 	if len(errors) > 0 {
-		return CreationResponseNodeMultiError(errors)
+		return QueryUserSignedRefundsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreationResponseNodeMultiError is an error wrapping multiple validation
-// errors returned by CreationResponseNode.ValidateAll() if the designated
-// constraints aren't met.
-type CreationResponseNodeMultiError []error
+// QueryUserSignedRefundsRequestMultiError is an error wrapping multiple
+// validation errors returned by QueryUserSignedRefundsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type QueryUserSignedRefundsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreationResponseNodeMultiError) Error() string {
+func (m QueryUserSignedRefundsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16140,11 +17876,12 @@ func (m CreationResponseNodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreationResponseNodeMultiError) AllErrors() []error { return m }
+func (m QueryUserSignedRefundsRequestMultiError) AllErrors() []error { return m }
 
-// CreationResponseNodeValidationError is the validation error returned by
-// CreationResponseNode.Validate if the designated constraints aren't met.
-type CreationResponseNodeValidationError struct {
+// QueryUserSignedRefundsRequestValidationError is the validation error
+// returned by QueryUserSignedRefundsRequest.Validate if the designated
+// constraints aren't met.
+type QueryUserSignedRefundsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16152,24 +17889,24 @@ type CreationResponseNodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreationResponseNodeValidationError) Field() string { return e.field }
+func (e QueryUserSignedRefundsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreationResponseNodeValidationError) Reason() string { return e.reason }
+func (e QueryUserSignedRefundsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreationResponseNodeValidationError) Cause() error { return e.cause }
+func (e QueryUserSignedRefundsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreationResponseNodeValidationError) Key() bool { return e.key }
+func (e QueryUserSignedRefundsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreationResponseNodeValidationError) ErrorName() string {
-	return "CreationResponseNodeValidationError"
+func (e QueryUserSignedRefundsRequestValidationError) ErrorName() string {
+	return "QueryUserSignedRefundsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreationResponseNodeValidationError) Error() string {
+func (e QueryUserSignedRefundsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16181,14 +17918,14 @@ func (e CreationResponseNodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreationResponseNode.%s: %s%s",
+		"invalid %sQueryUserSignedRefundsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreationResponseNodeValidationError{}
+var _ error = QueryUserSignedRefundsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16196,53 +17933,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreationResponseNodeValidationError{}
+} = QueryUserSignedRefundsRequestValidationError{}
 
-// Validate checks the field values on CreateTreeResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on QueryUserSignedRefundsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTreeResponse) Validate() error {
+func (m *QueryUserSignedRefundsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTreeResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateTreeResponseMultiError, or nil if none found.
-func (m *CreateTreeResponse) ValidateAll() error {
+// ValidateAll checks the field values on QueryUserSignedRefundsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryUserSignedRefundsResponseMultiError, or nil if none found.
+func (m *QueryUserSignedRefundsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTreeResponse) validate(all bool) error {
+func (m *QueryUserSignedRefundsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetUserSignedRefunds() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryUserSignedRefundsResponseValidationError{
+						field:  fmt.Sprintf("UserSignedRefunds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryUserSignedRefundsResponseValidationError{
+						field:  fmt.Sprintf("UserSignedRefunds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryUserSignedRefundsResponseValidationError{
+					field:  fmt.Sprintf("UserSignedRefunds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetNode()).(type) {
+		switch v := interface{}(m.GetTransfer()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateTreeResponseValidationError{
-					field:  "Node",
+				errors = append(errors, QueryUserSignedRefundsResponseValidationError{
+					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateTreeResponseValidationError{
-					field:  "Node",
+				errors = append(errors, QueryUserSignedRefundsResponseValidationError{
+					field:  "Transfer",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateTreeResponseValidationError{
-				field:  "Node",
+			return QueryUserSignedRefundsResponseValidationError{
+				field:  "Transfer",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -16250,19 +18021,19 @@ func (m *CreateTreeResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateTreeResponseMultiError(errors)
+		return QueryUserSignedRefundsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTreeResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateTreeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type CreateTreeResponseMultiError []error
+// QueryUserSignedRefundsResponseMultiError is an error wrapping multiple
+// validation errors returned by QueryUserSignedRefundsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type QueryUserSignedRefundsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTreeResponseMultiError) Error() string {
+func (m QueryUserSignedRefundsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16271,11 +18042,12 @@ func (m CreateTreeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTreeResponseMultiError) AllErrors() []error { return m }
+func (m QueryUserSignedRefundsResponseMultiError) AllErrors() []error { return m }
 
-// CreateTreeResponseValidationError is the validation error returned by
-// CreateTreeResponse.Validate if the designated constraints aren't met.
-type CreateTreeResponseValidationError struct {
+// QueryUserSignedRefundsResponseValidationError is the validation error
+// returned by QueryUserSignedRefundsResponse.Validate if the designated
+// constraints aren't met.
+type QueryUserSignedRefundsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16283,24 +18055,24 @@ type CreateTreeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTreeResponseValidationError) Field() string { return e.field }
+func (e QueryUserSignedRefundsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTreeResponseValidationError) Reason() string { return e.reason }
+func (e QueryUserSignedRefundsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTreeResponseValidationError) Cause() error { return e.cause }
+func (e QueryUserSignedRefundsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTreeResponseValidationError) Key() bool { return e.key }
+func (e QueryUserSignedRefundsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTreeResponseValidationError) ErrorName() string {
-	return "CreateTreeResponseValidationError"
+func (e QueryUserSignedRefundsResponseValidationError) ErrorName() string {
+	return "QueryUserSignedRefundsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateTreeResponseValidationError) Error() string {
+func (e QueryUserSignedRefundsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16312,14 +18084,14 @@ func (e CreateTreeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTreeResponse.%s: %s%s",
+		"invalid %sQueryUserSignedRefundsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTreeResponseValidationError{}
+var _ error = QueryUserSignedRefundsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16327,52 +18099,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTreeResponseValidationError{}
+} = QueryUserSignedRefundsResponseValidationError{}
 
-// Validate checks the field values on SigningOperatorInfo with the rules
+// Validate checks the field values on ProvidePreimageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SigningOperatorInfo) Validate() error {
+func (m *ProvidePreimageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SigningOperatorInfo with the rules
+// ValidateAll checks the field values on ProvidePreimageRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SigningOperatorInfoMultiError, or nil if none found.
-func (m *SigningOperatorInfo) ValidateAll() error {
+// ProvidePreimageRequestMultiError, or nil if none found.
+func (m *ProvidePreimageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SigningOperatorInfo) validate(all bool) error {
+func (m *ProvidePreimageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Index
+	// no validation rules for PaymentHash
 
-	// no validation rules for Identifier
+	// no validation rules for Preimage
 
-	// no validation rules for PublicKey
+	// no validation rules for IdentityPublicKey
 
-	// no validation rules for Address
+	// no validation rules for TransferId
 
 	if len(errors) > 0 {
-		return SigningOperatorInfoMultiError(errors)
+		return ProvidePreimageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SigningOperatorInfoMultiError is an error wrapping multiple validation
-// errors returned by SigningOperatorInfo.ValidateAll() if the designated
+// ProvidePreimageRequestMultiError is an error wrapping multiple validation
+// errors returned by ProvidePreimageRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SigningOperatorInfoMultiError []error
+type ProvidePreimageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SigningOperatorInfoMultiError) Error() string {
+func (m ProvidePreimageRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16381,11 +18153,11 @@ func (m SigningOperatorInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SigningOperatorInfoMultiError) AllErrors() []error { return m }
+func (m ProvidePreimageRequestMultiError) AllErrors() []error { return m }
 
-// SigningOperatorInfoValidationError is the validation error returned by
-// SigningOperatorInfo.Validate if the designated constraints aren't met.
-type SigningOperatorInfoValidationError struct {
+// ProvidePreimageRequestValidationError is the validation error returned by
+// ProvidePreimageRequest.Validate if the designated constraints aren't met.
+type ProvidePreimageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16393,24 +18165,24 @@ type SigningOperatorInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e SigningOperatorInfoValidationError) Field() string { return e.field }
+func (e ProvidePreimageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SigningOperatorInfoValidationError) Reason() string { return e.reason }
+func (e ProvidePreimageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SigningOperatorInfoValidationError) Cause() error { return e.cause }
+func (e ProvidePreimageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SigningOperatorInfoValidationError) Key() bool { return e.key }
+func (e ProvidePreimageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SigningOperatorInfoValidationError) ErrorName() string {
-	return "SigningOperatorInfoValidationError"
+func (e ProvidePreimageRequestValidationError) ErrorName() string {
+	return "ProvidePreimageRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SigningOperatorInfoValidationError) Error() string {
+func (e ProvidePreimageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16422,14 +18194,14 @@ func (e SigningOperatorInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSigningOperatorInfo.%s: %s%s",
+		"invalid %sProvidePreimageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SigningOperatorInfoValidationError{}
+var _ error = ProvidePreimageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16437,90 +18209,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SigningOperatorInfoValidationError{}
+} = ProvidePreimageRequestValidationError{}
 
-// Validate checks the field values on GetSigningOperatorListResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ProvidePreimageResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetSigningOperatorListResponse) Validate() error {
+func (m *ProvidePreimageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetSigningOperatorListResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetSigningOperatorListResponseMultiError, or nil if none found.
-func (m *GetSigningOperatorListResponse) ValidateAll() error {
+// ValidateAll checks the field values on ProvidePreimageResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProvidePreimageResponseMultiError, or nil if none found.
+func (m *ProvidePreimageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetSigningOperatorListResponse) validate(all bool) error {
+func (m *ProvidePreimageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetSigningOperators()))
-		i := 0
-		for key := range m.GetSigningOperators() {
-			sorted_keys[i] = key
-			i++
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProvidePreimageResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProvidePreimageResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetSigningOperators()[key]
-			_ = val
-
-			// no validation rules for SigningOperators[key]
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, GetSigningOperatorListResponseValidationError{
-							field:  fmt.Sprintf("SigningOperators[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, GetSigningOperatorListResponseValidationError{
-							field:  fmt.Sprintf("SigningOperators[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return GetSigningOperatorListResponseValidationError{
-						field:  fmt.Sprintf("SigningOperators[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProvidePreimageResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-
 		}
 	}
 
 	if len(errors) > 0 {
-		return GetSigningOperatorListResponseMultiError(errors)
+		return ProvidePreimageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetSigningOperatorListResponseMultiError is an error wrapping multiple
-// validation errors returned by GetSigningOperatorListResponse.ValidateAll()
-// if the designated constraints aren't met.
-type GetSigningOperatorListResponseMultiError []error
+// ProvidePreimageResponseMultiError is an error wrapping multiple validation
+// errors returned by ProvidePreimageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ProvidePreimageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetSigningOperatorListResponseMultiError) Error() string {
+func (m ProvidePreimageResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16529,12 +18284,11 @@ func (m GetSigningOperatorListResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetSigningOperatorListResponseMultiError) AllErrors() []error { return m }
+func (m ProvidePreimageResponseMultiError) AllErrors() []error { return m }
 
-// GetSigningOperatorListResponseValidationError is the validation error
-// returned by GetSigningOperatorListResponse.Validate if the designated
-// constraints aren't met.
-type GetSigningOperatorListResponseValidationError struct {
+// ProvidePreimageResponseValidationError is the validation error returned by
+// ProvidePreimageResponse.Validate if the designated constraints aren't met.
+type ProvidePreimageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16542,24 +18296,24 @@ type GetSigningOperatorListResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetSigningOperatorListResponseValidationError) Field() string { return e.field }
+func (e ProvidePreimageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetSigningOperatorListResponseValidationError) Reason() string { return e.reason }
+func (e ProvidePreimageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetSigningOperatorListResponseValidationError) Cause() error { return e.cause }
+func (e ProvidePreimageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetSigningOperatorListResponseValidationError) Key() bool { return e.key }
+func (e ProvidePreimageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetSigningOperatorListResponseValidationError) ErrorName() string {
-	return "GetSigningOperatorListResponseValidationError"
+func (e ProvidePreimageResponseValidationError) ErrorName() string {
+	return "ProvidePreimageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetSigningOperatorListResponseValidationError) Error() string {
+func (e ProvidePreimageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16571,14 +18325,14 @@ func (e GetSigningOperatorListResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetSigningOperatorListResponse.%s: %s%s",
+		"invalid %sProvidePreimageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetSigningOperatorListResponseValidationError{}
+var _ error = ProvidePreimageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -16586,24 +18340,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetSigningOperatorListResponseValidationError{}
+} = ProvidePreimageResponseValidationError{}
 
-// Validate checks the field values on QueryUserSignedRefundsRequest with the
+// Validate checks the field values on ReturnLightningPaymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryUserSignedRefundsRequest) Validate() error {
+func (m *ReturnLightningPaymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryUserSignedRefundsRequest with
+// ValidateAll checks the field values on ReturnLightningPaymentRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// QueryUserSignedRefundsRequestMultiError, or nil if none found.
-func (m *QueryUserSignedRefundsRequest) ValidateAll() error {
+// ReturnLightningPaymentRequestMultiError, or nil if none found.
+func (m *ReturnLightningPaymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryUserSignedRefundsRequest) validate(all bool) error {
+func (m *ReturnLightningPaymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -16612,22 +18366,22 @@ func (m *QueryUserSignedRefundsRequest) validate(all bool) error {
 
 	// no validation rules for PaymentHash
 
-	// no validation rules for IdentityPublicKey
+	// no validation rules for UserIdentityPublicKey
 
 	if len(errors) > 0 {
-		return QueryUserSignedRefundsRequestMultiError(errors)
+		return ReturnLightningPaymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryUserSignedRefundsRequestMultiError is an error wrapping multiple
-// validation errors returned by QueryUserSignedRefundsRequest.ValidateAll()
+// ReturnLightningPaymentRequestMultiError is an error wrapping multiple
+// validation errors returned by ReturnLightningPaymentRequest.ValidateAll()
 // if the designated constraints aren't met.
-type QueryUserSignedRefundsRequestMultiError []error
+type ReturnLightningPaymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryUserSignedRefundsRequestMultiError) Error() string {
+func (m ReturnLightningPaymentRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16636,12 +18390,12 @@ func (m QueryUserSignedRefundsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryUserSignedRefundsRequestMultiError) AllErrors() []error { return m }
+func (m ReturnLightningPaymentRequestMultiError) AllErrors() []error { return m }
 
-// QueryUserSignedRefundsRequestValidationError is the validation error
-// returned by QueryUserSignedRefundsRequest.Validate if the designated
+// ReturnLightningPaymentRequestValidationError is the validation error
+// returned by ReturnLightningPaymentRequest.Validate if the designated
 // constraints aren't met.
-type QueryUserSignedRefundsRequestValidationError struct {
+type ReturnLightningPaymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16649,24 +18403,24 @@ type QueryUserSignedRefundsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryUserSignedRefundsRequestValidationError) Field() string { return e.field }
+func (e ReturnLightningPaymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryUserSignedRefundsRequestValidationError) Reason() string { return e.reason }
+func (e ReturnLightningPaymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryUserSignedRefundsRequestValidationError) Cause() error { return e.cause }
+func (e ReturnLightningPaymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryUserSignedRefundsRequestValidationError) Key() bool { return e.key }
+func (e ReturnLightningPaymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryUserSignedRefundsRequestValidationError) ErrorName() string {
-	return "QueryUserSignedRefundsRequestValidationError"
+func (e ReturnLightningPaymentRequestValidationError) ErrorName() string {
+	return "ReturnLightningPaymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryUserSignedRefundsRequestValidationError) Error() string {
+func (e ReturnLightningPaymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16678,14 +18432,14 @@ func (e QueryUserSignedRefundsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryUserSignedRefundsRequest.%s: %s%s",
+		"invalid %sReturnLightningPaymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryUserSignedRefundsRequestValidationError{}
+var _ error = ReturnLightningPaymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16693,107 +18447,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryUserSignedRefundsRequestValidationError{}
+} = ReturnLightningPaymentRequestValidationError{}
 
-// Validate checks the field values on QueryUserSignedRefundsResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryUserSignedRefundsResponse) Validate() error {
+// Validate checks the field values on TreeNodeIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TreeNodeIds) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryUserSignedRefundsResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QueryUserSignedRefundsResponseMultiError, or nil if none found.
-func (m *QueryUserSignedRefundsResponse) ValidateAll() error {
+// ValidateAll checks the field values on TreeNodeIds with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TreeNodeIdsMultiError, or
+// nil if none found.
+func (m *TreeNodeIds) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryUserSignedRefundsResponse) validate(all bool) error {
+func (m *TreeNodeIds) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUserSignedRefunds() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryUserSignedRefundsResponseValidationError{
-						field:  fmt.Sprintf("UserSignedRefunds[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryUserSignedRefundsResponseValidationError{
-						field:  fmt.Sprintf("UserSignedRefunds[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return QueryUserSignedRefundsResponseValidationError{
-					field:  fmt.Sprintf("UserSignedRefunds[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, QueryUserSignedRefundsResponseValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, QueryUserSignedRefundsResponseValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return QueryUserSignedRefundsResponseValidationError{
-				field:  "Transfer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return QueryUserSignedRefundsResponseMultiError(errors)
+		return TreeNodeIdsMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryUserSignedRefundsResponseMultiError is an error wrapping multiple
-// validation errors returned by QueryUserSignedRefundsResponse.ValidateAll()
-// if the designated constraints aren't met.
-type QueryUserSignedRefundsResponseMultiError []error
+// TreeNodeIdsMultiError is an error wrapping multiple validation errors
+// returned by TreeNodeIds.ValidateAll() if the designated constraints aren't met.
+type TreeNodeIdsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryUserSignedRefundsResponseMultiError) Error() string {
+func (m TreeNodeIdsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16802,12 +18492,11 @@ func (m QueryUserSignedRefundsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryUserSignedRefundsResponseMultiError) AllErrors() []error { return m }
+func (m TreeNodeIdsMultiError) AllErrors() []error { return m }
 
-// QueryUserSignedRefundsResponseValidationError is the validation error
-// returned by QueryUserSignedRefundsResponse.Validate if the designated
-// constraints aren't met.
-type QueryUserSignedRefundsResponseValidationError struct {
+// TreeNodeIdsValidationError is the validation error returned by
+// TreeNodeIds.Validate if the designated constraints aren't met.
+type TreeNodeIdsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16815,24 +18504,22 @@ type QueryUserSignedRefundsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryUserSignedRefundsResponseValidationError) Field() string { return e.field }
+func (e TreeNodeIdsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryUserSignedRefundsResponseValidationError) Reason() string { return e.reason }
+func (e TreeNodeIdsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryUserSignedRefundsResponseValidationError) Cause() error { return e.cause }
+func (e TreeNodeIdsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryUserSignedRefundsResponseValidationError) Key() bool { return e.key }
+func (e TreeNodeIdsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryUserSignedRefundsResponseValidationError) ErrorName() string {
-	return "QueryUserSignedRefundsResponseValidationError"
-}
+func (e TreeNodeIdsValidationError) ErrorName() string { return "TreeNodeIdsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueryUserSignedRefundsResponseValidationError) Error() string {
+func (e TreeNodeIdsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16844,14 +18531,14 @@ func (e QueryUserSignedRefundsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryUserSignedRefundsResponse.%s: %s%s",
+		"invalid %sTreeNodeIds.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryUserSignedRefundsResponseValidationError{}
+var _ error = TreeNodeIdsValidationError{}
 
 var _ interface {
 	Field() string
@@ -16859,50 +18546,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryUserSignedRefundsResponseValidationError{}
+} = TreeNodeIdsValidationError{}
 
-// Validate checks the field values on ProvidePreimageRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProvidePreimageRequest) Validate() error {
+// Validate checks the field values on QueryNodesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryNodesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProvidePreimageRequest with the rules
+// ValidateAll checks the field values on QueryNodesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProvidePreimageRequestMultiError, or nil if none found.
-func (m *ProvidePreimageRequest) ValidateAll() error {
+// QueryNodesRequestMultiError, or nil if none found.
+func (m *QueryNodesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProvidePreimageRequest) validate(all bool) error {
+func (m *QueryNodesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PaymentHash
+	// no validation rules for IncludeParents
 
-	// no validation rules for Preimage
+	// no validation rules for Limit
 
-	// no validation rules for IdentityPublicKey
+	// no validation rules for Offset
+
+	// no validation rules for Network
+
+	switch v := m.Source.(type) {
+	case *QueryNodesRequest_OwnerIdentityPubkey:
+		if v == nil {
+			err := QueryNodesRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for OwnerIdentityPubkey
+	case *QueryNodesRequest_NodeIds:
+		if v == nil {
+			err := QueryNodesRequestValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNodeIds()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryNodesRequestValidationError{
+						field:  "NodeIds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryNodesRequestValidationError{
+						field:  "NodeIds",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNodeIds()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryNodesRequestValidationError{
+					field:  "NodeIds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
 
 	if len(errors) > 0 {
-		return ProvidePreimageRequestMultiError(errors)
+		return QueryNodesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProvidePreimageRequestMultiError is an error wrapping multiple validation
-// errors returned by ProvidePreimageRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ProvidePreimageRequestMultiError []error
+// QueryNodesRequestMultiError is an error wrapping multiple validation errors
+// returned by QueryNodesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type QueryNodesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProvidePreimageRequestMultiError) Error() string {
+func (m QueryNodesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -16911,11 +18658,11 @@ func (m ProvidePreimageRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProvidePreimageRequestMultiError) AllErrors() []error { return m }
+func (m QueryNodesRequestMultiError) AllErrors() []error { return m }
 
-// ProvidePreimageRequestValidationError is the validation error returned by
-// ProvidePreimageRequest.Validate if the designated constraints aren't met.
-type ProvidePreimageRequestValidationError struct {
+// QueryNodesRequestValidationError is the validation error returned by
+// QueryNodesRequest.Validate if the designated constraints aren't met.
+type QueryNodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -16923,24 +18670,24 @@ type ProvidePreimageRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProvidePreimageRequestValidationError) Field() string { return e.field }
+func (e QueryNodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProvidePreimageRequestValidationError) Reason() string { return e.reason }
+func (e QueryNodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProvidePreimageRequestValidationError) Cause() error { return e.cause }
+func (e QueryNodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProvidePreimageRequestValidationError) Key() bool { return e.key }
+func (e QueryNodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProvidePreimageRequestValidationError) ErrorName() string {
-	return "ProvidePreimageRequestValidationError"
+func (e QueryNodesRequestValidationError) ErrorName() string {
+	return "QueryNodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProvidePreimageRequestValidationError) Error() string {
+func (e QueryNodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -16952,14 +18699,14 @@ func (e ProvidePreimageRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProvidePreimageRequest.%s: %s%s",
+		"invalid %sQueryNodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProvidePreimageRequestValidationError{}
+var _ error = QueryNodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -16967,73 +18714,92 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProvidePreimageRequestValidationError{}
+} = QueryNodesRequestValidationError{}
 
-// Validate checks the field values on ProvidePreimageResponse with the rules
+// Validate checks the field values on QueryNodesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ProvidePreimageResponse) Validate() error {
+func (m *QueryNodesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ProvidePreimageResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on QueryNodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ProvidePreimageResponseMultiError, or nil if none found.
-func (m *ProvidePreimageResponse) ValidateAll() error {
+// QueryNodesResponseMultiError, or nil if none found.
+func (m *QueryNodesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ProvidePreimageResponse) validate(all bool) error {
+func (m *QueryNodesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ProvidePreimageResponseValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ProvidePreimageResponseValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	{
+		sorted_keys := make([]string, len(m.GetNodes()))
+		i := 0
+		for key := range m.GetNodes() {
+			sorted_keys[i] = key
+			i++
 		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ProvidePreimageResponseValidationError{
-				field:  "Transfer",
-				reason: "embedded message failed validation",
-				cause:  err,
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetNodes()[key]
+			_ = val
+
+			// no validation rules for Nodes[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, QueryNodesResponseValidationError{
+							field:  fmt.Sprintf("Nodes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, QueryNodesResponseValidationError{
+							field:  fmt.Sprintf("Nodes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return QueryNodesResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
 			}
+
 		}
 	}
 
+	// no validation rules for Offset
+
 	if len(errors) > 0 {
-		return ProvidePreimageResponseMultiError(errors)
+		return QueryNodesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ProvidePreimageResponseMultiError is an error wrapping multiple validation
-// errors returned by ProvidePreimageResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ProvidePreimageResponseMultiError []error
+// QueryNodesResponseMultiError is an error wrapping multiple validation errors
+// returned by QueryNodesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type QueryNodesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ProvidePreimageResponseMultiError) Error() string {
+func (m QueryNodesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17042,11 +18808,11 @@ func (m ProvidePreimageResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ProvidePreimageResponseMultiError) AllErrors() []error { return m }
+func (m QueryNodesResponseMultiError) AllErrors() []error { return m }
 
-// ProvidePreimageResponseValidationError is the validation error returned by
-// ProvidePreimageResponse.Validate if the designated constraints aren't met.
-type ProvidePreimageResponseValidationError struct {
+// QueryNodesResponseValidationError is the validation error returned by
+// QueryNodesResponse.Validate if the designated constraints aren't met.
+type QueryNodesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17054,24 +18820,24 @@ type ProvidePreimageResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ProvidePreimageResponseValidationError) Field() string { return e.field }
+func (e QueryNodesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ProvidePreimageResponseValidationError) Reason() string { return e.reason }
+func (e QueryNodesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ProvidePreimageResponseValidationError) Cause() error { return e.cause }
+func (e QueryNodesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ProvidePreimageResponseValidationError) Key() bool { return e.key }
+func (e QueryNodesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ProvidePreimageResponseValidationError) ErrorName() string {
-	return "ProvidePreimageResponseValidationError"
+func (e QueryNodesResponseValidationError) ErrorName() string {
+	return "QueryNodesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ProvidePreimageResponseValidationError) Error() string {
+func (e QueryNodesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17083,14 +18849,14 @@ func (e ProvidePreimageResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sProvidePreimageResponse.%s: %s%s",
+		"invalid %sQueryNodesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ProvidePreimageResponseValidationError{}
+var _ error = QueryNodesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17098,48 +18864,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ProvidePreimageResponseValidationError{}
+} = QueryNodesResponseValidationError{}
 
-// Validate checks the field values on ReturnLightningPaymentRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on CancelTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReturnLightningPaymentRequest) Validate() error {
+func (m *CancelTransferRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnLightningPaymentRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ReturnLightningPaymentRequestMultiError, or nil if none found.
-func (m *ReturnLightningPaymentRequest) ValidateAll() error {
+// ValidateAll checks the field values on CancelTransferRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTransferRequestMultiError, or nil if none found.
+func (m *CancelTransferRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnLightningPaymentRequest) validate(all bool) error {
+func (m *CancelTransferRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PaymentHash
+	// no validation rules for TransferId
 
-	// no validation rules for UserIdentityPublicKey
+	// no validation rules for SenderIdentityPublicKey
 
 	if len(errors) > 0 {
-		return ReturnLightningPaymentRequestMultiError(errors)
+		return CancelTransferRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnLightningPaymentRequestMultiError is an error wrapping multiple
-// validation errors returned by ReturnLightningPaymentRequest.ValidateAll()
-// if the designated constraints aren't met.
-type ReturnLightningPaymentRequestMultiError []error
+// CancelTransferRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelTransferRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelTransferRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnLightningPaymentRequestMultiError) Error() string {
+func (m CancelTransferRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17148,12 +18914,11 @@ func (m ReturnLightningPaymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnLightningPaymentRequestMultiError) AllErrors() []error { return m }
+func (m CancelTransferRequestMultiError) AllErrors() []error { return m }
 
-// ReturnLightningPaymentRequestValidationError is the validation error
-// returned by ReturnLightningPaymentRequest.Validate if the designated
-// constraints aren't met.
-type ReturnLightningPaymentRequestValidationError struct {
+// CancelTransferRequestValidationError is the validation error returned by
+// CancelTransferRequest.Validate if the designated constraints aren't met.
+type CancelTransferRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17161,24 +18926,24 @@ type ReturnLightningPaymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnLightningPaymentRequestValidationError) Field() string { return e.field }
+func (e CancelTransferRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnLightningPaymentRequestValidationError) Reason() string { return e.reason }
+func (e CancelTransferRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnLightningPaymentRequestValidationError) Cause() error { return e.cause }
+func (e CancelTransferRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnLightningPaymentRequestValidationError) Key() bool { return e.key }
+func (e CancelTransferRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnLightningPaymentRequestValidationError) ErrorName() string {
-	return "ReturnLightningPaymentRequestValidationError"
+func (e CancelTransferRequestValidationError) ErrorName() string {
+	return "CancelTransferRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReturnLightningPaymentRequestValidationError) Error() string {
+func (e CancelTransferRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17190,14 +18955,14 @@ func (e ReturnLightningPaymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnLightningPaymentRequest.%s: %s%s",
+		"invalid %sCancelTransferRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnLightningPaymentRequestValidationError{}
+var _ error = CancelTransferRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17205,43 +18970,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnLightningPaymentRequestValidationError{}
+} = CancelTransferRequestValidationError{}
 
-// Validate checks the field values on TreeNodeIds with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TreeNodeIds) Validate() error {
+// Validate checks the field values on CancelTransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelTransferResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TreeNodeIds with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TreeNodeIdsMultiError, or
-// nil if none found.
-func (m *TreeNodeIds) ValidateAll() error {
+// ValidateAll checks the field values on CancelTransferResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTransferResponseMultiError, or nil if none found.
+func (m *CancelTransferResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TreeNodeIds) validate(all bool) error {
+func (m *CancelTransferResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelTransferResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelTransferResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelTransferResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return TreeNodeIdsMultiError(errors)
+		return CancelTransferResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TreeNodeIdsMultiError is an error wrapping multiple validation errors
-// returned by TreeNodeIds.ValidateAll() if the designated constraints aren't met.
-type TreeNodeIdsMultiError []error
+// CancelTransferResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelTransferResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelTransferResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TreeNodeIdsMultiError) Error() string {
+func (m CancelTransferResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17250,11 +19045,11 @@ func (m TreeNodeIdsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TreeNodeIdsMultiError) AllErrors() []error { return m }
+func (m CancelTransferResponseMultiError) AllErrors() []error { return m }
 
-// TreeNodeIdsValidationError is the validation error returned by
-// TreeNodeIds.Validate if the designated constraints aren't met.
-type TreeNodeIdsValidationError struct {
+// CancelTransferResponseValidationError is the validation error returned by
+// CancelTransferResponse.Validate if the designated constraints aren't met.
+type CancelTransferResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17262,22 +19057,24 @@ type TreeNodeIdsValidationError struct {
 }
 
 // Field function returns field value.
-func (e TreeNodeIdsValidationError) Field() string { return e.field }
+func (e CancelTransferResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TreeNodeIdsValidationError) Reason() string { return e.reason }
+func (e CancelTransferResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TreeNodeIdsValidationError) Cause() error { return e.cause }
+func (e CancelTransferResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TreeNodeIdsValidationError) Key() bool { return e.key }
+func (e CancelTransferResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TreeNodeIdsValidationError) ErrorName() string { return "TreeNodeIdsValidationError" }
+func (e CancelTransferResponseValidationError) ErrorName() string {
+	return "CancelTransferResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TreeNodeIdsValidationError) Error() string {
+func (e CancelTransferResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17289,14 +19086,14 @@ func (e TreeNodeIdsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTreeNodeIds.%s: %s%s",
+		"invalid %sCancelTransferResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TreeNodeIdsValidationError{}
+var _ error = CancelTransferResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17304,110 +19101,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TreeNodeIdsValidationError{}
+} = CancelTransferResponseValidationError{}
 
-// Validate checks the field values on QueryNodesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *QueryNodesRequest) Validate() error {
+// Validate checks the field values on QueryUnusedDepositAddressesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *QueryUnusedDepositAddressesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryNodesRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// QueryNodesRequestMultiError, or nil if none found.
-func (m *QueryNodesRequest) ValidateAll() error {
+// ValidateAll checks the field values on QueryUnusedDepositAddressesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// QueryUnusedDepositAddressesRequestMultiError, or nil if none found.
+func (m *QueryUnusedDepositAddressesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryNodesRequest) validate(all bool) error {
+func (m *QueryUnusedDepositAddressesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IncludeParents
-
-	// no validation rules for Limit
-
-	// no validation rules for Offset
+	// no validation rules for IdentityPublicKey
 
 	// no validation rules for Network
 
-	switch v := m.Source.(type) {
-	case *QueryNodesRequest_OwnerIdentityPubkey:
-		if v == nil {
-			err := QueryNodesRequestValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for OwnerIdentityPubkey
-	case *QueryNodesRequest_NodeIds:
-		if v == nil {
-			err := QueryNodesRequestValidationError{
-				field:  "Source",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNodeIds()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryNodesRequestValidationError{
-						field:  "NodeIds",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryNodesRequestValidationError{
-						field:  "NodeIds",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNodeIds()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return QueryNodesRequestValidationError{
-					field:  "NodeIds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Limit
 
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return QueryNodesRequestMultiError(errors)
+		return QueryUnusedDepositAddressesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryNodesRequestMultiError is an error wrapping multiple validation errors
-// returned by QueryNodesRequest.ValidateAll() if the designated constraints
-// aren't met.
-type QueryNodesRequestMultiError []error
+// QueryUnusedDepositAddressesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// QueryUnusedDepositAddressesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryUnusedDepositAddressesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryNodesRequestMultiError) Error() string {
+func (m QueryUnusedDepositAddressesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17416,11 +19157,12 @@ func (m QueryNodesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryNodesRequestMultiError) AllErrors() []error { return m }
+func (m QueryUnusedDepositAddressesRequestMultiError) AllErrors() []error { return m }
 
-// QueryNodesRequestValidationError is the validation error returned by
-// QueryNodesRequest.Validate if the designated constraints aren't met.
-type QueryNodesRequestValidationError struct {
+// QueryUnusedDepositAddressesRequestValidationError is the validation error
+// returned by QueryUnusedDepositAddressesRequest.Validate if the designated
+// constraints aren't met.
+type QueryUnusedDepositAddressesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17428,24 +19170,24 @@ type QueryNodesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryNodesRequestValidationError) Field() string { return e.field }
+func (e QueryUnusedDepositAddressesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryNodesRequestValidationError) Reason() string { return e.reason }
+func (e QueryUnusedDepositAddressesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryNodesRequestValidationError) Cause() error { return e.cause }
+func (e QueryUnusedDepositAddressesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryNodesRequestValidationError) Key() bool { return e.key }
+func (e QueryUnusedDepositAddressesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryNodesRequestValidationError) ErrorName() string {
-	return "QueryNodesRequestValidationError"
+func (e QueryUnusedDepositAddressesRequestValidationError) ErrorName() string {
+	return "QueryUnusedDepositAddressesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryNodesRequestValidationError) Error() string {
+func (e QueryUnusedDepositAddressesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17457,14 +19199,14 @@ func (e QueryNodesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryNodesRequest.%s: %s%s",
+		"invalid %sQueryUnusedDepositAddressesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryNodesRequestValidationError{}
+var _ error = QueryUnusedDepositAddressesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17472,92 +19214,58 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryNodesRequestValidationError{}
+} = QueryUnusedDepositAddressesRequestValidationError{}
 
-// Validate checks the field values on QueryNodesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryNodesResponse) Validate() error {
+// Validate checks the field values on QueryStaticDepositAddressesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *QueryStaticDepositAddressesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryNodesResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// QueryNodesResponseMultiError, or nil if none found.
-func (m *QueryNodesResponse) ValidateAll() error {
+// ValidateAll checks the field values on QueryStaticDepositAddressesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// QueryStaticDepositAddressesRequestMultiError, or nil if none found.
+func (m *QueryStaticDepositAddressesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryNodesResponse) validate(all bool) error {
+func (m *QueryStaticDepositAddressesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetNodes()))
-		i := 0
-		for key := range m.GetNodes() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetNodes()[key]
-			_ = val
-
-			// no validation rules for Nodes[key]
+	// no validation rules for IdentityPublicKey
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, QueryNodesResponseValidationError{
-							field:  fmt.Sprintf("Nodes[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, QueryNodesResponseValidationError{
-							field:  fmt.Sprintf("Nodes[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return QueryNodesResponseValidationError{
-						field:  fmt.Sprintf("Nodes[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for Network
 
-		}
-	}
+	// no validation rules for Limit
 
 	// no validation rules for Offset
 
+	if m.DepositAddress != nil {
+		// no validation rules for DepositAddress
+	}
+
 	if len(errors) > 0 {
-		return QueryNodesResponseMultiError(errors)
+		return QueryStaticDepositAddressesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryNodesResponseMultiError is an error wrapping multiple validation errors
-// returned by QueryNodesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type QueryNodesResponseMultiError []error
+// QueryStaticDepositAddressesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// QueryStaticDepositAddressesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryStaticDepositAddressesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryNodesResponseMultiError) Error() string {
+func (m QueryStaticDepositAddressesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17566,11 +19274,12 @@ func (m QueryNodesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryNodesResponseMultiError) AllErrors() []error { return m }
-
-// QueryNodesResponseValidationError is the validation error returned by
-// QueryNodesResponse.Validate if the designated constraints aren't met.
-type QueryNodesResponseValidationError struct {
+func (m QueryStaticDepositAddressesRequestMultiError) AllErrors() []error { return m }
+
+// QueryStaticDepositAddressesRequestValidationError is the validation error
+// returned by QueryStaticDepositAddressesRequest.Validate if the designated
+// constraints aren't met.
+type QueryStaticDepositAddressesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17578,24 +19287,24 @@ type QueryNodesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryNodesResponseValidationError) Field() string { return e.field }
+func (e QueryStaticDepositAddressesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryNodesResponseValidationError) Reason() string { return e.reason }
+func (e QueryStaticDepositAddressesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryNodesResponseValidationError) Cause() error { return e.cause }
+func (e QueryStaticDepositAddressesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryNodesResponseValidationError) Key() bool { return e.key }
+func (e QueryStaticDepositAddressesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryNodesResponseValidationError) ErrorName() string {
-	return "QueryNodesResponseValidationError"
+func (e QueryStaticDepositAddressesRequestValidationError) ErrorName() string {
+	return "QueryStaticDepositAddressesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryNodesResponseValidationError) Error() string {
+func (e QueryStaticDepositAddressesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17607,14 +19316,14 @@ func (e QueryNodesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryNodesResponse.%s: %s%s",
+		"invalid %sQueryStaticDepositAddressesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryNodesResponseValidationError{}
+var _ error = QueryStaticDepositAddressesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -17622,48 +19331,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryNodesResponseValidationError{}
+} = QueryStaticDepositAddressesRequestValidationError{}
 
-// Validate checks the field values on CancelTransferRequest with the rules
+// Validate checks the field values on DepositAddressQueryResult with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelTransferRequest) Validate() error {
+func (m *DepositAddressQueryResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelTransferRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DepositAddressQueryResult with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelTransferRequestMultiError, or nil if none found.
-func (m *CancelTransferRequest) ValidateAll() error {
+// DepositAddressQueryResultMultiError, or nil if none found.
+func (m *DepositAddressQueryResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelTransferRequest) validate(all bool) error {
+func (m *DepositAddressQueryResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TransferId
+	// no validation rules for DepositAddress
 
-	// no validation rules for SenderIdentityPublicKey
+	// no validation rules for UserSigningPublicKey
+
+	// no validation rules for VerifyingPublicKey
+
+	if m.LeafId != nil {
+
+		if err := m._validateUuid(m.GetLeafId()); err != nil {
+			err = DepositAddressQueryResultValidationError{
+				field:  "LeafId",
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ProofOfPossession != nil {
+
+		if all {
+			switch v := interface{}(m.GetProofOfPossession()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DepositAddressQueryResultValidationError{
+						field:  "ProofOfPossession",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DepositAddressQueryResultValidationError{
+						field:  "ProofOfPossession",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProofOfPossession()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DepositAddressQueryResultValidationError{
+					field:  "ProofOfPossession",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return CancelTransferRequestMultiError(errors)
+		return DepositAddressQueryResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelTransferRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelTransferRequest.ValidateAll() if the designated
-// constraints aren't met.
-type CancelTransferRequestMultiError []error
+func (m *DepositAddressQueryResult) _validateUuid(uuid string) error {
+	if matched := _spark_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DepositAddressQueryResultMultiError is an error wrapping multiple validation
+// errors returned by DepositAddressQueryResult.ValidateAll() if the
+// designated constraints aren't met.
+type DepositAddressQueryResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelTransferRequestMultiError) Error() string {
+func (m DepositAddressQueryResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17672,11 +19440,11 @@ func (m CancelTransferRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelTransferRequestMultiError) AllErrors() []error { return m }
+func (m DepositAddressQueryResultMultiError) AllErrors() []error { return m }
 
-// CancelTransferRequestValidationError is the validation error returned by
-// CancelTransferRequest.Validate if the designated constraints aren't met.
-type CancelTransferRequestValidationError struct {
+// DepositAddressQueryResultValidationError is the validation error returned by
+// DepositAddressQueryResult.Validate if the designated constraints aren't met.
+type DepositAddressQueryResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17684,24 +19452,24 @@ type CancelTransferRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelTransferRequestValidationError) Field() string { return e.field }
+func (e DepositAddressQueryResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelTransferRequestValidationError) Reason() string { return e.reason }
+func (e DepositAddressQueryResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelTransferRequestValidationError) Cause() error { return e.cause }
+func (e DepositAddressQueryResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelTransferRequestValidationError) Key() bool { return e.key }
+func (e DepositAddressQueryResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelTransferRequestValidationError) ErrorName() string {
-	return "CancelTransferRequestValidationError"
+func (e DepositAddressQueryResultValidationError) ErrorName() string {
+	return "DepositAddressQueryResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelTransferRequestValidationError) Error() string {
+func (e DepositAddressQueryResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17713,14 +19481,14 @@ func (e CancelTransferRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelTransferRequest.%s: %s%s",
+		"invalid %sDepositAddressQueryResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelTransferRequestValidationError{}
+var _ error = DepositAddressQueryResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -17728,73 +19496,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelTransferRequestValidationError{}
+} = DepositAddressQueryResultValidationError{}
 
-// Validate checks the field values on CancelTransferResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelTransferResponse) Validate() error {
+// Validate checks the field values on QueryUnusedDepositAddressesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *QueryUnusedDepositAddressesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelTransferResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CancelTransferResponseMultiError, or nil if none found.
-func (m *CancelTransferResponse) ValidateAll() error {
+// ValidateAll checks the field values on QueryUnusedDepositAddressesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// QueryUnusedDepositAddressesResponseMultiError, or nil if none found.
+func (m *QueryUnusedDepositAddressesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelTransferResponse) validate(all bool) error {
+func (m *QueryUnusedDepositAddressesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CancelTransferResponseValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetDepositAddresses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryUnusedDepositAddressesResponseValidationError{
+						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryUnusedDepositAddressesResponseValidationError{
+						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CancelTransferResponseValidationError{
-					field:  "Transfer",
+				return QueryUnusedDepositAddressesResponseValidationError{
+					field:  fmt.Sprintf("DepositAddresses[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CancelTransferResponseValidationError{
-				field:  "Transfer",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
+	// no validation rules for Offset
+
 	if len(errors) > 0 {
-		return CancelTransferResponseMultiError(errors)
+		return QueryUnusedDepositAddressesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelTransferResponseMultiError is an error wrapping multiple validation
-// errors returned by CancelTransferResponse.ValidateAll() if the designated
+// QueryUnusedDepositAddressesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// QueryUnusedDepositAddressesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CancelTransferResponseMultiError []error
+type QueryUnusedDepositAddressesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelTransferResponseMultiError) Error() string {
+func (m QueryUnusedDepositAddressesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17803,11 +19580,12 @@ func (m CancelTransferResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelTransferResponseMultiError) AllErrors() []error { return m }
+func (m QueryUnusedDepositAddressesResponseMultiError) AllErrors() []error { return m }
 
-// CancelTransferResponseValidationError is the validation error returned by
-// CancelTransferResponse.Validate if the designated constraints aren't met.
-type CancelTransferResponseValidationError struct {
+// QueryUnusedDepositAddressesResponseValidationError is the validation error
+// returned by QueryUnusedDepositAddressesResponse.Validate if the designated
+// constraints aren't met.
+type QueryUnusedDepositAddressesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17815,24 +19593,24 @@ type CancelTransferResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelTransferResponseValidationError) Field() string { return e.field }
+func (e QueryUnusedDepositAddressesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelTransferResponseValidationError) Reason() string { return e.reason }
+func (e QueryUnusedDepositAddressesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelTransferResponseValidationError) Cause() error { return e.cause }
+func (e QueryUnusedDepositAddressesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelTransferResponseValidationError) Key() bool { return e.key }
+func (e QueryUnusedDepositAddressesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelTransferResponseValidationError) ErrorName() string {
-	return "CancelTransferResponseValidationError"
+func (e QueryUnusedDepositAddressesResponseValidationError) ErrorName() string {
+	return "QueryUnusedDepositAddressesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelTransferResponseValidationError) Error() string {
+func (e QueryUnusedDepositAddressesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17844,14 +19622,14 @@ func (e CancelTransferResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelTransferResponse.%s: %s%s",
+		"invalid %sQueryUnusedDepositAddressesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelTransferResponseValidationError{}
+var _ error = QueryUnusedDepositAddressesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17859,54 +19637,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelTransferResponseValidationError{}
+} = QueryUnusedDepositAddressesResponseValidationError{}
 
-// Validate checks the field values on QueryUnusedDepositAddressesRequest with
+// Validate checks the field values on QueryStaticDepositAddressesResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *QueryUnusedDepositAddressesRequest) Validate() error {
+func (m *QueryStaticDepositAddressesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryUnusedDepositAddressesRequest
+// ValidateAll checks the field values on QueryStaticDepositAddressesResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// QueryUnusedDepositAddressesRequestMultiError, or nil if none found.
-func (m *QueryUnusedDepositAddressesRequest) ValidateAll() error {
+// QueryStaticDepositAddressesResponseMultiError, or nil if none found.
+func (m *QueryStaticDepositAddressesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryUnusedDepositAddressesRequest) validate(all bool) error {
+func (m *QueryStaticDepositAddressesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IdentityPublicKey
-
-	// no validation rules for Network
+	for idx, item := range m.GetDepositAddresses() {
+		_, _ = idx, item
 
-	// no validation rules for Limit
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryStaticDepositAddressesResponseValidationError{
+						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryStaticDepositAddressesResponseValidationError{
+						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryStaticDepositAddressesResponseValidationError{
+					field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Offset
+	}
 
 	if len(errors) > 0 {
-		return QueryUnusedDepositAddressesRequestMultiError(errors)
+		return QueryStaticDepositAddressesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryUnusedDepositAddressesRequestMultiError is an error wrapping multiple
+// QueryStaticDepositAddressesResponseMultiError is an error wrapping multiple
 // validation errors returned by
-// QueryUnusedDepositAddressesRequest.ValidateAll() if the designated
+// QueryStaticDepositAddressesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type QueryUnusedDepositAddressesRequestMultiError []error
+type QueryStaticDepositAddressesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryUnusedDepositAddressesRequestMultiError) Error() string {
+func (m QueryStaticDepositAddressesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -17915,12 +19719,12 @@ func (m QueryUnusedDepositAddressesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryUnusedDepositAddressesRequestMultiError) AllErrors() []error { return m }
+func (m QueryStaticDepositAddressesResponseMultiError) AllErrors() []error { return m }
 
-// QueryUnusedDepositAddressesRequestValidationError is the validation error
-// returned by QueryUnusedDepositAddressesRequest.Validate if the designated
+// QueryStaticDepositAddressesResponseValidationError is the validation error
+// returned by QueryStaticDepositAddressesResponse.Validate if the designated
 // constraints aren't met.
-type QueryUnusedDepositAddressesRequestValidationError struct {
+type QueryStaticDepositAddressesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -17928,24 +19732,24 @@ type QueryUnusedDepositAddressesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryUnusedDepositAddressesRequestValidationError) Field() string { return e.field }
+func (e QueryStaticDepositAddressesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryUnusedDepositAddressesRequestValidationError) Reason() string { return e.reason }
+func (e QueryStaticDepositAddressesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryUnusedDepositAddressesRequestValidationError) Cause() error { return e.cause }
+func (e QueryStaticDepositAddressesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryUnusedDepositAddressesRequestValidationError) Key() bool { return e.key }
+func (e QueryStaticDepositAddressesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryUnusedDepositAddressesRequestValidationError) ErrorName() string {
-	return "QueryUnusedDepositAddressesRequestValidationError"
+func (e QueryStaticDepositAddressesResponseValidationError) ErrorName() string {
+	return "QueryStaticDepositAddressesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryUnusedDepositAddressesRequestValidationError) Error() string {
+func (e QueryStaticDepositAddressesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -17957,14 +19761,14 @@ func (e QueryUnusedDepositAddressesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryUnusedDepositAddressesRequest.%s: %s%s",
+		"invalid %sQueryStaticDepositAddressesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryUnusedDepositAddressesRequestValidationError{}
+var _ error = QueryStaticDepositAddressesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -17972,25 +19776,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryUnusedDepositAddressesRequestValidationError{}
+} = QueryStaticDepositAddressesResponseValidationError{}
 
-// Validate checks the field values on QueryStaticDepositAddressesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *QueryStaticDepositAddressesRequest) Validate() error {
+// Validate checks the field values on QueryBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryBalanceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryStaticDepositAddressesRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// QueryStaticDepositAddressesRequestMultiError, or nil if none found.
-func (m *QueryStaticDepositAddressesRequest) ValidateAll() error {
+// ValidateAll checks the field values on QueryBalanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryBalanceRequestMultiError, or nil if none found.
+func (m *QueryBalanceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryStaticDepositAddressesRequest) validate(all bool) error {
+func (m *QueryBalanceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -18001,29 +19804,20 @@ func (m *QueryStaticDepositAddressesRequest) validate(all bool) error {
 
 	// no validation rules for Network
 
-	// no validation rules for Limit
-
-	// no validation rules for Offset
-
-	if m.DepositAddress != nil {
-		// no validation rules for DepositAddress
-	}
-
 	if len(errors) > 0 {
-		return QueryStaticDepositAddressesRequestMultiError(errors)
+		return QueryBalanceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryStaticDepositAddressesRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// QueryStaticDepositAddressesRequest.ValidateAll() if the designated
+// QueryBalanceRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryBalanceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type QueryStaticDepositAddressesRequestMultiError []error
+type QueryBalanceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryStaticDepositAddressesRequestMultiError) Error() string {
+func (m QueryBalanceRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18032,12 +19826,11 @@ func (m QueryStaticDepositAddressesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryStaticDepositAddressesRequestMultiError) AllErrors() []error { return m }
+func (m QueryBalanceRequestMultiError) AllErrors() []error { return m }
 
-// QueryStaticDepositAddressesRequestValidationError is the validation error
-// returned by QueryStaticDepositAddressesRequest.Validate if the designated
-// constraints aren't met.
-type QueryStaticDepositAddressesRequestValidationError struct {
+// QueryBalanceRequestValidationError is the validation error returned by
+// QueryBalanceRequest.Validate if the designated constraints aren't met.
+type QueryBalanceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18045,24 +19838,24 @@ type QueryStaticDepositAddressesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryStaticDepositAddressesRequestValidationError) Field() string { return e.field }
+func (e QueryBalanceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryStaticDepositAddressesRequestValidationError) Reason() string { return e.reason }
+func (e QueryBalanceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryStaticDepositAddressesRequestValidationError) Cause() error { return e.cause }
+func (e QueryBalanceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryStaticDepositAddressesRequestValidationError) Key() bool { return e.key }
+func (e QueryBalanceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryStaticDepositAddressesRequestValidationError) ErrorName() string {
-	return "QueryStaticDepositAddressesRequestValidationError"
+func (e QueryBalanceRequestValidationError) ErrorName() string {
+	return "QueryBalanceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryStaticDepositAddressesRequestValidationError) Error() string {
+func (e QueryBalanceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18074,14 +19867,14 @@ func (e QueryStaticDepositAddressesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryStaticDepositAddressesRequest.%s: %s%s",
+		"invalid %sQueryBalanceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryStaticDepositAddressesRequestValidationError{}
+var _ error = QueryBalanceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18089,107 +19882,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryStaticDepositAddressesRequestValidationError{}
+} = QueryBalanceRequestValidationError{}
 
-// Validate checks the field values on DepositAddressQueryResult with the rules
+// Validate checks the field values on QueryBalanceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DepositAddressQueryResult) Validate() error {
+func (m *QueryBalanceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DepositAddressQueryResult with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on QueryBalanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DepositAddressQueryResultMultiError, or nil if none found.
-func (m *DepositAddressQueryResult) ValidateAll() error {
+// QueryBalanceResponseMultiError, or nil if none found.
+func (m *QueryBalanceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DepositAddressQueryResult) validate(all bool) error {
+func (m *QueryBalanceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DepositAddress
-
-	// no validation rules for UserSigningPublicKey
-
-	// no validation rules for VerifyingPublicKey
-
-	if m.LeafId != nil {
-
-		if err := m._validateUuid(m.GetLeafId()); err != nil {
-			err = DepositAddressQueryResultValidationError{
-				field:  "LeafId",
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.ProofOfPossession != nil {
-
-		if all {
-			switch v := interface{}(m.GetProofOfPossession()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DepositAddressQueryResultValidationError{
-						field:  "ProofOfPossession",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, DepositAddressQueryResultValidationError{
-						field:  "ProofOfPossession",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetProofOfPossession()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return DepositAddressQueryResultValidationError{
-					field:  "ProofOfPossession",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Balance
 
-	}
+	// no validation rules for NodeBalances
 
 	if len(errors) > 0 {
-		return DepositAddressQueryResultMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *DepositAddressQueryResult) _validateUuid(uuid string) error {
-	if matched := _spark_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return QueryBalanceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DepositAddressQueryResultMultiError is an error wrapping multiple validation
-// errors returned by DepositAddressQueryResult.ValidateAll() if the
-// designated constraints aren't met.
-type DepositAddressQueryResultMultiError []error
+// QueryBalanceResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryBalanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueryBalanceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DepositAddressQueryResultMultiError) Error() string {
+func (m QueryBalanceResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18198,11 +19932,11 @@ func (m DepositAddressQueryResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DepositAddressQueryResultMultiError) AllErrors() []error { return m }
+func (m QueryBalanceResponseMultiError) AllErrors() []error { return m }
 
-// DepositAddressQueryResultValidationError is the validation error returned by
-// DepositAddressQueryResult.Validate if the designated constraints aren't met.
-type DepositAddressQueryResultValidationError struct {
+// QueryBalanceResponseValidationError is the validation error returned by
+// QueryBalanceResponse.Validate if the designated constraints aren't met.
+type QueryBalanceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18210,24 +19944,24 @@ type DepositAddressQueryResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e DepositAddressQueryResultValidationError) Field() string { return e.field }
+func (e QueryBalanceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DepositAddressQueryResultValidationError) Reason() string { return e.reason }
+func (e QueryBalanceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DepositAddressQueryResultValidationError) Cause() error { return e.cause }
+func (e QueryBalanceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DepositAddressQueryResultValidationError) Key() bool { return e.key }
+func (e QueryBalanceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DepositAddressQueryResultValidationError) ErrorName() string {
-	return "DepositAddressQueryResultValidationError"
+func (e QueryBalanceResponseValidationError) ErrorName() string {
+	return "QueryBalanceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DepositAddressQueryResultValidationError) Error() string {
+func (e QueryBalanceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18239,14 +19973,14 @@ func (e DepositAddressQueryResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDepositAddressQueryResult.%s: %s%s",
+		"invalid %sQueryBalanceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DepositAddressQueryResultValidationError{}
+var _ error = QueryBalanceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -18254,82 +19988,89 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DepositAddressQueryResultValidationError{}
+} = QueryBalanceResponseValidationError{}
 
-// Validate checks the field values on QueryUnusedDepositAddressesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *QueryUnusedDepositAddressesResponse) Validate() error {
+// Validate checks the field values on SparkAddress with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SparkAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryUnusedDepositAddressesResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// QueryUnusedDepositAddressesResponseMultiError, or nil if none found.
-func (m *QueryUnusedDepositAddressesResponse) ValidateAll() error {
+// ValidateAll checks the field values on SparkAddress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SparkAddressMultiError, or
+// nil if none found.
+func (m *SparkAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryUnusedDepositAddressesResponse) validate(all bool) error {
+func (m *SparkAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDepositAddresses() {
-		_, _ = idx, item
+	// no validation rules for IdentityPublicKey
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryUnusedDepositAddressesResponseValidationError{
-						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryUnusedDepositAddressesResponseValidationError{
-						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSparkInvoiceFields()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SparkAddressValidationError{
+					field:  "SparkInvoiceFields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return QueryUnusedDepositAddressesResponseValidationError{
-					field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+				errors = append(errors, SparkAddressValidationError{
+					field:  "SparkInvoiceFields",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSparkInvoiceFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SparkAddressValidationError{
+				field:  "SparkInvoiceFields",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	// no validation rules for Offset
+	if m.Signature != nil {
+
+		if len(m.GetSignature()) != 64 {
+			err := SparkAddressValidationError{
+				field:  "Signature",
+				reason: "value length must be 64 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return QueryUnusedDepositAddressesResponseMultiError(errors)
+		return SparkAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryUnusedDepositAddressesResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// QueryUnusedDepositAddressesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type QueryUnusedDepositAddressesResponseMultiError []error
+// SparkAddressMultiError is an error wrapping multiple validation errors
+// returned by SparkAddress.ValidateAll() if the designated constraints aren't met.
+type SparkAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryUnusedDepositAddressesResponseMultiError) Error() string {
+func (m SparkAddressMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18338,12 +20079,11 @@ func (m QueryUnusedDepositAddressesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryUnusedDepositAddressesResponseMultiError) AllErrors() []error { return m }
+func (m SparkAddressMultiError) AllErrors() []error { return m }
 
-// QueryUnusedDepositAddressesResponseValidationError is the validation error
-// returned by QueryUnusedDepositAddressesResponse.Validate if the designated
-// constraints aren't met.
-type QueryUnusedDepositAddressesResponseValidationError struct {
+// SparkAddressValidationError is the validation error returned by
+// SparkAddress.Validate if the designated constraints aren't met.
+type SparkAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18351,24 +20091,22 @@ type QueryUnusedDepositAddressesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryUnusedDepositAddressesResponseValidationError) Field() string { return e.field }
+func (e SparkAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryUnusedDepositAddressesResponseValidationError) Reason() string { return e.reason }
+func (e SparkAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryUnusedDepositAddressesResponseValidationError) Cause() error { return e.cause }
+func (e SparkAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryUnusedDepositAddressesResponseValidationError) Key() bool { return e.key }
+func (e SparkAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryUnusedDepositAddressesResponseValidationError) ErrorName() string {
-	return "QueryUnusedDepositAddressesResponseValidationError"
-}
+func (e SparkAddressValidationError) ErrorName() string { return "SparkAddressValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueryUnusedDepositAddressesResponseValidationError) Error() string {
+func (e SparkAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18380,14 +20118,14 @@ func (e QueryUnusedDepositAddressesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryUnusedDepositAddressesResponse.%s: %s%s",
+		"invalid %sSparkAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryUnusedDepositAddressesResponseValidationError{}
+var _ error = SparkAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -18395,57 +20133,185 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryUnusedDepositAddressesResponseValidationError{}
+} = SparkAddressValidationError{}
+
+// Validate checks the field values on SparkInvoiceFields with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SparkInvoiceFields) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SparkInvoiceFields with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SparkInvoiceFieldsMultiError, or nil if none found.
+func (m *SparkInvoiceFields) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SparkInvoiceFields) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(m.GetId()) != 16 {
+		err := SparkInvoiceFieldsValidationError{
+			field:  "Id",
+			reason: "value length must be 16 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.PaymentType.(type) {
+	case *SparkInvoiceFields_TokensPayment:
+		if v == nil {
+			err := SparkInvoiceFieldsValidationError{
+				field:  "PaymentType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTokensPayment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SparkInvoiceFieldsValidationError{
+						field:  "TokensPayment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SparkInvoiceFieldsValidationError{
+						field:  "TokensPayment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTokensPayment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SparkInvoiceFieldsValidationError{
+					field:  "TokensPayment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SparkInvoiceFields_SatsPayment:
+		if v == nil {
+			err := SparkInvoiceFieldsValidationError{
+				field:  "PaymentType",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSatsPayment()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SparkInvoiceFieldsValidationError{
+						field:  "SatsPayment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SparkInvoiceFieldsValidationError{
+						field:  "SatsPayment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSatsPayment()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SparkInvoiceFieldsValidationError{
+					field:  "SatsPayment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
 
-// Validate checks the field values on QueryStaticDepositAddressesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *QueryStaticDepositAddressesResponse) Validate() error {
-	return m.validate(false)
-}
+	if m.Memo != nil {
 
-// ValidateAll checks the field values on QueryStaticDepositAddressesResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// QueryStaticDepositAddressesResponseMultiError, or nil if none found.
-func (m *QueryStaticDepositAddressesResponse) ValidateAll() error {
-	return m.validate(true)
-}
+		if len(m.GetMemo()) > 120 {
+			err := SparkInvoiceFieldsValidationError{
+				field:  "Memo",
+				reason: "value length must be at most 120 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-func (m *QueryStaticDepositAddressesResponse) validate(all bool) error {
-	if m == nil {
-		return nil
 	}
 
-	var errors []error
+	if m.SenderPublicKey != nil {
 
-	for idx, item := range m.GetDepositAddresses() {
-		_, _ = idx, item
+		if len(m.GetSenderPublicKey()) != 33 {
+			err := SparkInvoiceFieldsValidationError{
+				field:  "SenderPublicKey",
+				reason: "value length must be 33 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ExpiryTime != nil {
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetExpiryTime()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryStaticDepositAddressesResponseValidationError{
-						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+					errors = append(errors, SparkInvoiceFieldsValidationError{
+						field:  "ExpiryTime",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryStaticDepositAddressesResponseValidationError{
-						field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+					errors = append(errors, SparkInvoiceFieldsValidationError{
+						field:  "ExpiryTime",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return QueryStaticDepositAddressesResponseValidationError{
-					field:  fmt.Sprintf("DepositAddresses[%v]", idx),
+				return SparkInvoiceFieldsValidationError{
+					field:  "ExpiryTime",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -18455,20 +20321,19 @@ func (m *QueryStaticDepositAddressesResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return QueryStaticDepositAddressesResponseMultiError(errors)
+		return SparkInvoiceFieldsMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryStaticDepositAddressesResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// QueryStaticDepositAddressesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type QueryStaticDepositAddressesResponseMultiError []error
+// SparkInvoiceFieldsMultiError is an error wrapping multiple validation errors
+// returned by SparkInvoiceFields.ValidateAll() if the designated constraints
+// aren't met.
+type SparkInvoiceFieldsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryStaticDepositAddressesResponseMultiError) Error() string {
+func (m SparkInvoiceFieldsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18477,12 +20342,11 @@ func (m QueryStaticDepositAddressesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryStaticDepositAddressesResponseMultiError) AllErrors() []error { return m }
+func (m SparkInvoiceFieldsMultiError) AllErrors() []error { return m }
 
-// QueryStaticDepositAddressesResponseValidationError is the validation error
-// returned by QueryStaticDepositAddressesResponse.Validate if the designated
-// constraints aren't met.
-type QueryStaticDepositAddressesResponseValidationError struct {
+// SparkInvoiceFieldsValidationError is the validation error returned by
+// SparkInvoiceFields.Validate if the designated constraints aren't met.
+type SparkInvoiceFieldsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18490,24 +20354,24 @@ type QueryStaticDepositAddressesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryStaticDepositAddressesResponseValidationError) Field() string { return e.field }
+func (e SparkInvoiceFieldsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryStaticDepositAddressesResponseValidationError) Reason() string { return e.reason }
+func (e SparkInvoiceFieldsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryStaticDepositAddressesResponseValidationError) Cause() error { return e.cause }
+func (e SparkInvoiceFieldsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryStaticDepositAddressesResponseValidationError) Key() bool { return e.key }
+func (e SparkInvoiceFieldsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryStaticDepositAddressesResponseValidationError) ErrorName() string {
-	return "QueryStaticDepositAddressesResponseValidationError"
+func (e SparkInvoiceFieldsValidationError) ErrorName() string {
+	return "SparkInvoiceFieldsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryStaticDepositAddressesResponseValidationError) Error() string {
+func (e SparkInvoiceFieldsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18519,14 +20383,14 @@ func (e QueryStaticDepositAddressesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryStaticDepositAddressesResponse.%s: %s%s",
+		"invalid %sSparkInvoiceFields.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryStaticDepositAddressesResponseValidationError{}
+var _ error = SparkInvoiceFieldsValidationError{}
 
 var _ interface {
 	Field() string
@@ -18534,48 +20398,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryStaticDepositAddressesResponseValidationError{}
+} = SparkInvoiceFieldsValidationError{}
 
-// Validate checks the field values on QueryBalanceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryBalanceRequest) Validate() error {
+// Validate checks the field values on SatsPayment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SatsPayment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryBalanceRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// QueryBalanceRequestMultiError, or nil if none found.
-func (m *QueryBalanceRequest) ValidateAll() error {
+// ValidateAll checks the field values on SatsPayment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SatsPaymentMultiError, or
+// nil if none found.
+func (m *SatsPayment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryBalanceRequest) validate(all bool) error {
+func (m *SatsPayment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IdentityPublicKey
-
-	// no validation rules for Network
+	if m.Amount != nil {
+		// no validation rules for Amount
+	}
 
 	if len(errors) > 0 {
-		return QueryBalanceRequestMultiError(errors)
+		return SatsPaymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryBalanceRequestMultiError is an error wrapping multiple validation
-// errors returned by QueryBalanceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type QueryBalanceRequestMultiError []error
+// SatsPaymentMultiError is an error wrapping multiple validation errors
+// returned by SatsPayment.ValidateAll() if the designated constraints aren't met.
+type SatsPaymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryBalanceRequestMultiError) Error() string {
+func (m SatsPaymentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18584,11 +20447,11 @@ func (m QueryBalanceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryBalanceRequestMultiError) AllErrors() []error { return m }
+func (m SatsPaymentMultiError) AllErrors() []error { return m }
 
-// QueryBalanceRequestValidationError is the validation error returned by
-// QueryBalanceRequest.Validate if the designated constraints aren't met.
-type QueryBalanceRequestValidationError struct {
+// SatsPaymentValidationError is the validation error returned by
+// SatsPayment.Validate if the designated constraints aren't met.
+type SatsPaymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18596,24 +20459,22 @@ type QueryBalanceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryBalanceRequestValidationError) Field() string { return e.field }
+func (e SatsPaymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryBalanceRequestValidationError) Reason() string { return e.reason }
+func (e SatsPaymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryBalanceRequestValidationError) Cause() error { return e.cause }
+func (e SatsPaymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryBalanceRequestValidationError) Key() bool { return e.key }
+func (e SatsPaymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryBalanceRequestValidationError) ErrorName() string {
-	return "QueryBalanceRequestValidationError"
-}
+func (e SatsPaymentValidationError) ErrorName() string { return "SatsPaymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueryBalanceRequestValidationError) Error() string {
+func (e SatsPaymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18625,14 +20486,14 @@ func (e QueryBalanceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryBalanceRequest.%s: %s%s",
+		"invalid %sSatsPayment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryBalanceRequestValidationError{}
+var _ error = SatsPaymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -18640,48 +20501,74 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryBalanceRequestValidationError{}
+} = SatsPaymentValidationError{}
 
-// Validate checks the field values on QueryBalanceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryBalanceResponse) Validate() error {
+// Validate checks the field values on TokensPayment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokensPayment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryBalanceResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// QueryBalanceResponseMultiError, or nil if none found.
-func (m *QueryBalanceResponse) ValidateAll() error {
+// ValidateAll checks the field values on TokensPayment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokensPaymentMultiError, or
+// nil if none found.
+func (m *TokensPayment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryBalanceResponse) validate(all bool) error {
+func (m *TokensPayment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Balance
+	if m.TokenIdentifier != nil {
 
-	// no validation rules for NodeBalances
+		if len(m.GetTokenIdentifier()) != 32 {
+			err := TokensPaymentValidationError{
+				field:  "TokenIdentifier",
+				reason: "value length must be 32 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Amount != nil {
+
+		if len(m.GetAmount()) > 16 {
+			err := TokensPaymentValidationError{
+				field:  "Amount",
+				reason: "value length must be at most 16 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return QueryBalanceResponseMultiError(errors)
+		return TokensPaymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryBalanceResponseMultiError is an error wrapping multiple validation
-// errors returned by QueryBalanceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type QueryBalanceResponseMultiError []error
+// TokensPaymentMultiError is an error wrapping multiple validation errors
+// returned by TokensPayment.ValidateAll() if the designated constraints
+// aren't met.
+type TokensPaymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryBalanceResponseMultiError) Error() string {
+func (m TokensPaymentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18690,11 +20577,11 @@ func (m QueryBalanceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryBalanceResponseMultiError) AllErrors() []error { return m }
+func (m TokensPaymentMultiError) AllErrors() []error { return m }
 
-// QueryBalanceResponseValidationError is the validation error returned by
-// QueryBalanceResponse.Validate if the designated constraints aren't met.
-type QueryBalanceResponseValidationError struct {
+// TokensPaymentValidationError is the validation error returned by
+// TokensPayment.Validate if the designated constraints aren't met.
+type TokensPaymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18702,24 +20589,22 @@ type QueryBalanceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryBalanceResponseValidationError) Field() string { return e.field }
+func (e TokensPaymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryBalanceResponseValidationError) Reason() string { return e.reason }
+func (e TokensPaymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryBalanceResponseValidationError) Cause() error { return e.cause }
+func (e TokensPaymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryBalanceResponseValidationError) Key() bool { return e.key }
+func (e TokensPaymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryBalanceResponseValidationError) ErrorName() string {
-	return "QueryBalanceResponseValidationError"
-}
+func (e TokensPaymentValidationError) ErrorName() string { return "TokensPaymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QueryBalanceResponseValidationError) Error() string {
+func (e TokensPaymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18731,14 +20616,14 @@ func (e QueryBalanceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryBalanceResponse.%s: %s%s",
+		"invalid %sTokensPayment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryBalanceResponseValidationError{}
+var _ error = TokensPaymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -18746,89 +20631,107 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryBalanceResponseValidationError{}
+} = TokensPaymentValidationError{}
 
-// Validate checks the field values on SparkAddress with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SparkAddress) Validate() error {
+// Validate checks the field values on InitiateStaticDepositUtxoRefundRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InitiateStaticDepositUtxoRefundRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SparkAddress with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SparkAddressMultiError, or
-// nil if none found.
-func (m *SparkAddress) ValidateAll() error {
+// ValidateAll checks the field values on
+// InitiateStaticDepositUtxoRefundRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// InitiateStaticDepositUtxoRefundRequestMultiError, or nil if none found.
+func (m *InitiateStaticDepositUtxoRefundRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SparkAddress) validate(all bool) error {
+func (m *InitiateStaticDepositUtxoRefundRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IdentityPublicKey
-
 	if all {
-		switch v := interface{}(m.GetSparkInvoiceFields()).(type) {
+		switch v := interface{}(m.GetOnChainUtxo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, SparkAddressValidationError{
-					field:  "SparkInvoiceFields",
+				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
+					field:  "OnChainUtxo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, SparkAddressValidationError{
-					field:  "SparkInvoiceFields",
+				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
+					field:  "OnChainUtxo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSparkInvoiceFields()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SparkAddressValidationError{
-				field:  "SparkInvoiceFields",
+			return InitiateStaticDepositUtxoRefundRequestValidationError{
+				field:  "OnChainUtxo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.Signature != nil {
-
-		if len(m.GetSignature()) != 64 {
-			err := SparkAddressValidationError{
-				field:  "Signature",
-				reason: "value length must be 64 bytes",
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
+					field:  "RefundTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateStaticDepositUtxoRefundRequestValidationError{
+				field:  "RefundTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
+	// no validation rules for UserSignature
+
 	if len(errors) > 0 {
-		return SparkAddressMultiError(errors)
+		return InitiateStaticDepositUtxoRefundRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SparkAddressMultiError is an error wrapping multiple validation errors
-// returned by SparkAddress.ValidateAll() if the designated constraints aren't met.
-type SparkAddressMultiError []error
+// InitiateStaticDepositUtxoRefundRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// InitiateStaticDepositUtxoRefundRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InitiateStaticDepositUtxoRefundRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SparkAddressMultiError) Error() string {
+func (m InitiateStaticDepositUtxoRefundRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -18837,11 +20740,12 @@ func (m SparkAddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SparkAddressMultiError) AllErrors() []error { return m }
+func (m InitiateStaticDepositUtxoRefundRequestMultiError) AllErrors() []error { return m }
 
-// SparkAddressValidationError is the validation error returned by
-// SparkAddress.Validate if the designated constraints aren't met.
-type SparkAddressValidationError struct {
+// InitiateStaticDepositUtxoRefundRequestValidationError is the validation
+// error returned by InitiateStaticDepositUtxoRefundRequest.Validate if the
+// designated constraints aren't met.
+type InitiateStaticDepositUtxoRefundRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -18849,22 +20753,24 @@ type SparkAddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e SparkAddressValidationError) Field() string { return e.field }
+func (e InitiateStaticDepositUtxoRefundRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SparkAddressValidationError) Reason() string { return e.reason }
+func (e InitiateStaticDepositUtxoRefundRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SparkAddressValidationError) Cause() error { return e.cause }
+func (e InitiateStaticDepositUtxoRefundRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SparkAddressValidationError) Key() bool { return e.key }
+func (e InitiateStaticDepositUtxoRefundRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SparkAddressValidationError) ErrorName() string { return "SparkAddressValidationError" }
+func (e InitiateStaticDepositUtxoRefundRequestValidationError) ErrorName() string {
+	return "InitiateStaticDepositUtxoRefundRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SparkAddressValidationError) Error() string {
+func (e InitiateStaticDepositUtxoRefundRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -18876,14 +20782,14 @@ func (e SparkAddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSparkAddress.%s: %s%s",
+		"invalid %sInitiateStaticDepositUtxoRefundRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SparkAddressValidationError{}
+var _ error = InitiateStaticDepositUtxoRefundRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -18891,207 +20797,105 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SparkAddressValidationError{}
+} = InitiateStaticDepositUtxoRefundRequestValidationError{}
 
-// Validate checks the field values on SparkInvoiceFields with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SparkInvoiceFields) Validate() error {
+// Validate checks the field values on InitiateStaticDepositUtxoRefundResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InitiateStaticDepositUtxoRefundResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SparkInvoiceFields with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// SparkInvoiceFieldsMultiError, or nil if none found.
-func (m *SparkInvoiceFields) ValidateAll() error {
+// ValidateAll checks the field values on
+// InitiateStaticDepositUtxoRefundResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// InitiateStaticDepositUtxoRefundResponseMultiError, or nil if none found.
+func (m *InitiateStaticDepositUtxoRefundResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SparkInvoiceFields) validate(all bool) error {
+func (m *InitiateStaticDepositUtxoRefundResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Version
-
-	if len(m.GetId()) != 16 {
-		err := SparkInvoiceFieldsValidationError{
-			field:  "Id",
-			reason: "value length must be 16 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	switch v := m.PaymentType.(type) {
-	case *SparkInvoiceFields_TokensPayment:
-		if v == nil {
-			err := SparkInvoiceFieldsValidationError{
-				field:  "PaymentType",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetTokensPayment()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SparkInvoiceFieldsValidationError{
-						field:  "TokensPayment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SparkInvoiceFieldsValidationError{
-						field:  "TokensPayment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTokensPayment()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SparkInvoiceFieldsValidationError{
-					field:  "TokensPayment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *SparkInvoiceFields_SatsPayment:
-		if v == nil {
-			err := SparkInvoiceFieldsValidationError{
-				field:  "PaymentType",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetSatsPayment()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SparkInvoiceFieldsValidationError{
-						field:  "SatsPayment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SparkInvoiceFieldsValidationError{
-						field:  "SatsPayment",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSatsPayment()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SparkInvoiceFieldsValidationError{
-					field:  "SatsPayment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
-
-	if m.Memo != nil {
-
-		if len(m.GetMemo()) > 120 {
-			err := SparkInvoiceFieldsValidationError{
-				field:  "Memo",
-				reason: "value length must be at most 120 bytes",
+	if all {
+		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
+					field:  "RefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
+					field:  "RefundTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
-
-	}
-
-	if m.SenderPublicKey != nil {
-
-		if len(m.GetSenderPublicKey()) != 33 {
-			err := SparkInvoiceFieldsValidationError{
-				field:  "SenderPublicKey",
-				reason: "value length must be 33 bytes",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateStaticDepositUtxoRefundResponseValidationError{
+				field:  "RefundTxSigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	if m.ExpiryTime != nil {
-
-		if all {
-			switch v := interface{}(m.GetExpiryTime()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, SparkInvoiceFieldsValidationError{
-						field:  "ExpiryTime",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, SparkInvoiceFieldsValidationError{
-						field:  "ExpiryTime",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDepositAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
+					field:  "DepositAddress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return SparkInvoiceFieldsValidationError{
-					field:  "ExpiryTime",
+				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
+					field:  "DepositAddress",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateStaticDepositUtxoRefundResponseValidationError{
+				field:  "DepositAddress",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return SparkInvoiceFieldsMultiError(errors)
+		return InitiateStaticDepositUtxoRefundResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// SparkInvoiceFieldsMultiError is an error wrapping multiple validation errors
-// returned by SparkInvoiceFields.ValidateAll() if the designated constraints
-// aren't met.
-type SparkInvoiceFieldsMultiError []error
+// InitiateStaticDepositUtxoRefundResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// InitiateStaticDepositUtxoRefundResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InitiateStaticDepositUtxoRefundResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SparkInvoiceFieldsMultiError) Error() string {
+func (m InitiateStaticDepositUtxoRefundResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19100,11 +20904,12 @@ func (m SparkInvoiceFieldsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SparkInvoiceFieldsMultiError) AllErrors() []error { return m }
+func (m InitiateStaticDepositUtxoRefundResponseMultiError) AllErrors() []error { return m }
 
-// SparkInvoiceFieldsValidationError is the validation error returned by
-// SparkInvoiceFields.Validate if the designated constraints aren't met.
-type SparkInvoiceFieldsValidationError struct {
+// InitiateStaticDepositUtxoRefundResponseValidationError is the validation
+// error returned by InitiateStaticDepositUtxoRefundResponse.Validate if the
+// designated constraints aren't met.
+type InitiateStaticDepositUtxoRefundResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19112,24 +20917,24 @@ type SparkInvoiceFieldsValidationError struct {
 }
 
 // Field function returns field value.
-func (e SparkInvoiceFieldsValidationError) Field() string { return e.field }
+func (e InitiateStaticDepositUtxoRefundResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SparkInvoiceFieldsValidationError) Reason() string { return e.reason }
+func (e InitiateStaticDepositUtxoRefundResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SparkInvoiceFieldsValidationError) Cause() error { return e.cause }
+func (e InitiateStaticDepositUtxoRefundResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SparkInvoiceFieldsValidationError) Key() bool { return e.key }
+func (e InitiateStaticDepositUtxoRefundResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SparkInvoiceFieldsValidationError) ErrorName() string {
-	return "SparkInvoiceFieldsValidationError"
+func (e InitiateStaticDepositUtxoRefundResponseValidationError) ErrorName() string {
+	return "InitiateStaticDepositUtxoRefundResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SparkInvoiceFieldsValidationError) Error() string {
+func (e InitiateStaticDepositUtxoRefundResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19141,14 +20946,14 @@ func (e SparkInvoiceFieldsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSparkInvoiceFields.%s: %s%s",
+		"invalid %sInitiateStaticDepositUtxoRefundResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SparkInvoiceFieldsValidationError{}
+var _ error = InitiateStaticDepositUtxoRefundResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19156,47 +20961,166 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SparkInvoiceFieldsValidationError{}
+} = InitiateStaticDepositUtxoRefundResponseValidationError{}
 
-// Validate checks the field values on SatsPayment with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *SatsPayment) Validate() error {
+// Validate checks the field values on InitiateUtxoSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateUtxoSwapRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SatsPayment with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in SatsPaymentMultiError, or
-// nil if none found.
-func (m *SatsPayment) ValidateAll() error {
+// ValidateAll checks the field values on InitiateUtxoSwapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateUtxoSwapRequestMultiError, or nil if none found.
+func (m *InitiateUtxoSwapRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SatsPayment) validate(all bool) error {
+func (m *InitiateUtxoSwapRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Amount != nil {
-		// no validation rules for Amount
+	if all {
+		switch v := interface{}(m.GetOnChainUtxo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateUtxoSwapRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateUtxoSwapRequestValidationError{
+					field:  "OnChainUtxo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateUtxoSwapRequestValidationError{
+				field:  "OnChainUtxo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestType
+
+	// no validation rules for SspSignature
+
+	// no validation rules for UserSignature
+
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateUtxoSwapRequestValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateUtxoSwapRequestValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateUtxoSwapRequestValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSpendTxSigningJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateUtxoSwapRequestValidationError{
+					field:  "SpendTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateUtxoSwapRequestValidationError{
+					field:  "SpendTxSigningJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpendTxSigningJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateUtxoSwapRequestValidationError{
+				field:  "SpendTxSigningJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Amount.(type) {
+	case *InitiateUtxoSwapRequest_CreditAmountSats:
+		if v == nil {
+			err := InitiateUtxoSwapRequestValidationError{
+				field:  "Amount",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for CreditAmountSats
+	case *InitiateUtxoSwapRequest_MaxFeeSats:
+		if v == nil {
+			err := InitiateUtxoSwapRequestValidationError{
+				field:  "Amount",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for MaxFeeSats
+	default:
+		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return SatsPaymentMultiError(errors)
+		return InitiateUtxoSwapRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SatsPaymentMultiError is an error wrapping multiple validation errors
-// returned by SatsPayment.ValidateAll() if the designated constraints aren't met.
-type SatsPaymentMultiError []error
+// InitiateUtxoSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by InitiateUtxoSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InitiateUtxoSwapRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SatsPaymentMultiError) Error() string {
+func (m InitiateUtxoSwapRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19205,11 +21129,11 @@ func (m SatsPaymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SatsPaymentMultiError) AllErrors() []error { return m }
+func (m InitiateUtxoSwapRequestMultiError) AllErrors() []error { return m }
 
-// SatsPaymentValidationError is the validation error returned by
-// SatsPayment.Validate if the designated constraints aren't met.
-type SatsPaymentValidationError struct {
+// InitiateUtxoSwapRequestValidationError is the validation error returned by
+// InitiateUtxoSwapRequest.Validate if the designated constraints aren't met.
+type InitiateUtxoSwapRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19217,22 +21141,24 @@ type SatsPaymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e SatsPaymentValidationError) Field() string { return e.field }
+func (e InitiateUtxoSwapRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SatsPaymentValidationError) Reason() string { return e.reason }
+func (e InitiateUtxoSwapRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SatsPaymentValidationError) Cause() error { return e.cause }
+func (e InitiateUtxoSwapRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SatsPaymentValidationError) Key() bool { return e.key }
+func (e InitiateUtxoSwapRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SatsPaymentValidationError) ErrorName() string { return "SatsPaymentValidationError" }
+func (e InitiateUtxoSwapRequestValidationError) ErrorName() string {
+	return "InitiateUtxoSwapRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SatsPaymentValidationError) Error() string {
+func (e InitiateUtxoSwapRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19244,14 +21170,14 @@ func (e SatsPaymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSatsPayment.%s: %s%s",
+		"invalid %sInitiateUtxoSwapRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SatsPaymentValidationError{}
+var _ error = InitiateUtxoSwapRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -19259,74 +21185,131 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SatsPaymentValidationError{}
+} = InitiateUtxoSwapRequestValidationError{}
 
-// Validate checks the field values on TokensPayment with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *TokensPayment) Validate() error {
+// Validate checks the field values on InitiateUtxoSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateUtxoSwapResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokensPayment with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TokensPaymentMultiError, or
-// nil if none found.
-func (m *TokensPayment) ValidateAll() error {
+// ValidateAll checks the field values on InitiateUtxoSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateUtxoSwapResponseMultiError, or nil if none found.
+func (m *InitiateUtxoSwapResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokensPayment) validate(all bool) error {
+func (m *InitiateUtxoSwapResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.TokenIdentifier != nil {
-
-		if len(m.GetTokenIdentifier()) != 32 {
-			err := TokensPaymentValidationError{
-				field:  "TokenIdentifier",
-				reason: "value length must be 32 bytes",
+	if all {
+		switch v := interface{}(m.GetSpendTxSigningResult()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateUtxoSwapResponseValidationError{
+					field:  "SpendTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateUtxoSwapResponseValidationError{
+					field:  "SpendTxSigningResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpendTxSigningResult()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateUtxoSwapResponseValidationError{
+				field:  "SpendTxSigningResult",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-
 	}
 
-	if m.Amount != nil {
-
-		if len(m.GetAmount()) > 16 {
-			err := TokensPaymentValidationError{
-				field:  "Amount",
-				reason: "value length must be at most 16 bytes",
+	if all {
+		switch v := interface{}(m.GetTransfer()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateUtxoSwapResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateUtxoSwapResponseValidationError{
+					field:  "Transfer",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateUtxoSwapResponseValidationError{
+				field:  "Transfer",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetDepositAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InitiateUtxoSwapResponseValidationError{
+					field:  "DepositAddress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InitiateUtxoSwapResponseValidationError{
+					field:  "DepositAddress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InitiateUtxoSwapResponseValidationError{
+				field:  "DepositAddress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return TokensPaymentMultiError(errors)
+		return InitiateUtxoSwapResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TokensPaymentMultiError is an error wrapping multiple validation errors
-// returned by TokensPayment.ValidateAll() if the designated constraints
-// aren't met.
-type TokensPaymentMultiError []error
+// InitiateUtxoSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by InitiateUtxoSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InitiateUtxoSwapResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokensPaymentMultiError) Error() string {
+func (m InitiateUtxoSwapResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19335,11 +21318,11 @@ func (m TokensPaymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokensPaymentMultiError) AllErrors() []error { return m }
+func (m InitiateUtxoSwapResponseMultiError) AllErrors() []error { return m }
 
-// TokensPaymentValidationError is the validation error returned by
-// TokensPayment.Validate if the designated constraints aren't met.
-type TokensPaymentValidationError struct {
+// InitiateUtxoSwapResponseValidationError is the validation error returned by
+// InitiateUtxoSwapResponse.Validate if the designated constraints aren't met.
+type InitiateUtxoSwapResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19347,22 +21330,24 @@ type TokensPaymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokensPaymentValidationError) Field() string { return e.field }
+func (e InitiateUtxoSwapResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokensPaymentValidationError) Reason() string { return e.reason }
+func (e InitiateUtxoSwapResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokensPaymentValidationError) Cause() error { return e.cause }
+func (e InitiateUtxoSwapResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokensPaymentValidationError) Key() bool { return e.key }
+func (e InitiateUtxoSwapResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokensPaymentValidationError) ErrorName() string { return "TokensPaymentValidationError" }
+func (e InitiateUtxoSwapResponseValidationError) ErrorName() string {
+	return "InitiateUtxoSwapResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TokensPaymentValidationError) Error() string {
+func (e InitiateUtxoSwapResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19374,14 +21359,14 @@ func (e TokensPaymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokensPayment.%s: %s%s",
+		"invalid %sInitiateUtxoSwapResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokensPaymentValidationError{}
+var _ error = InitiateUtxoSwapResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -19389,107 +21374,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokensPaymentValidationError{}
+} = InitiateUtxoSwapResponseValidationError{}
 
-// Validate checks the field values on InitiateStaticDepositUtxoRefundRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *InitiateStaticDepositUtxoRefundRequest) Validate() error {
+// Validate checks the field values on ExitingTree with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExitingTree) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// InitiateStaticDepositUtxoRefundRequest with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// InitiateStaticDepositUtxoRefundRequestMultiError, or nil if none found.
-func (m *InitiateStaticDepositUtxoRefundRequest) ValidateAll() error {
+// ValidateAll checks the field values on ExitingTree with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExitingTreeMultiError, or
+// nil if none found.
+func (m *ExitingTree) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitiateStaticDepositUtxoRefundRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetOnChainUtxo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateStaticDepositUtxoRefundRequestValidationError{
-				field:  "OnChainUtxo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *ExitingTree) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for TreeId
+
 	if all {
-		switch v := interface{}(m.GetRefundTxSigningJob()).(type) {
+		switch v := interface{}(m.GetUserSigningCommitment()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, ExitingTreeValidationError{
+					field:  "UserSigningCommitment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundRequestValidationError{
-					field:  "RefundTxSigningJob",
+				errors = append(errors, ExitingTreeValidationError{
+					field:  "UserSigningCommitment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetUserSigningCommitment()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InitiateStaticDepositUtxoRefundRequestValidationError{
-				field:  "RefundTxSigningJob",
+			return ExitingTreeValidationError{
+				field:  "UserSigningCommitment",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for UserSignature
+	// no validation rules for Vin
 
 	if len(errors) > 0 {
-		return InitiateStaticDepositUtxoRefundRequestMultiError(errors)
+		return ExitingTreeMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitiateStaticDepositUtxoRefundRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// InitiateStaticDepositUtxoRefundRequest.ValidateAll() if the designated
-// constraints aren't met.
-type InitiateStaticDepositUtxoRefundRequestMultiError []error
+// ExitingTreeMultiError is an error wrapping multiple validation errors
+// returned by ExitingTree.ValidateAll() if the designated constraints aren't met.
+type ExitingTreeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitiateStaticDepositUtxoRefundRequestMultiError) Error() string {
+func (m ExitingTreeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19498,12 +21452,11 @@ func (m InitiateStaticDepositUtxoRefundRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitiateStaticDepositUtxoRefundRequestMultiError) AllErrors() []error { return m }
+func (m ExitingTreeMultiError) AllErrors() []error { return m }
 
-// InitiateStaticDepositUtxoRefundRequestValidationError is the validation
-// error returned by InitiateStaticDepositUtxoRefundRequest.Validate if the
-// designated constraints aren't met.
-type InitiateStaticDepositUtxoRefundRequestValidationError struct {
+// ExitingTreeValidationError is the validation error returned by
+// ExitingTree.Validate if the designated constraints aren't met.
+type ExitingTreeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19511,24 +21464,22 @@ type InitiateStaticDepositUtxoRefundRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitiateStaticDepositUtxoRefundRequestValidationError) Field() string { return e.field }
+func (e ExitingTreeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitiateStaticDepositUtxoRefundRequestValidationError) Reason() string { return e.reason }
+func (e ExitingTreeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitiateStaticDepositUtxoRefundRequestValidationError) Cause() error { return e.cause }
+func (e ExitingTreeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitiateStaticDepositUtxoRefundRequestValidationError) Key() bool { return e.key }
+func (e ExitingTreeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitiateStaticDepositUtxoRefundRequestValidationError) ErrorName() string {
-	return "InitiateStaticDepositUtxoRefundRequestValidationError"
-}
+func (e ExitingTreeValidationError) ErrorName() string { return "ExitingTreeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e InitiateStaticDepositUtxoRefundRequestValidationError) Error() string {
+func (e ExitingTreeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19540,14 +21491,14 @@ func (e InitiateStaticDepositUtxoRefundRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitiateStaticDepositUtxoRefundRequest.%s: %s%s",
+		"invalid %sExitingTree.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitiateStaticDepositUtxoRefundRequestValidationError{}
+var _ error = ExitingTreeValidationError{}
 
 var _ interface {
 	Field() string
@@ -19555,105 +21506,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitiateStaticDepositUtxoRefundRequestValidationError{}
+} = ExitingTreeValidationError{}
 
-// Validate checks the field values on InitiateStaticDepositUtxoRefundResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *InitiateStaticDepositUtxoRefundResponse) Validate() error {
+// Validate checks the field values on ExitSingleNodeTreeSigningResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExitSingleNodeTreeSigningResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// InitiateStaticDepositUtxoRefundResponse with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// InitiateStaticDepositUtxoRefundResponseMultiError, or nil if none found.
-func (m *InitiateStaticDepositUtxoRefundResponse) ValidateAll() error {
+// ValidateAll checks the field values on ExitSingleNodeTreeSigningResult with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExitSingleNodeTreeSigningResultMultiError, or nil if none found.
+func (m *ExitSingleNodeTreeSigningResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitiateStaticDepositUtxoRefundResponse) validate(all bool) error {
+func (m *ExitSingleNodeTreeSigningResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetRefundTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
-					field:  "RefundTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRefundTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateStaticDepositUtxoRefundResponseValidationError{
-				field:  "RefundTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for TreeId
 
 	if all {
-		switch v := interface{}(m.GetDepositAddress()).(type) {
+		switch v := interface{}(m.GetSigningResult()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
-					field:  "DepositAddress",
+				errors = append(errors, ExitSingleNodeTreeSigningResultValidationError{
+					field:  "SigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateStaticDepositUtxoRefundResponseValidationError{
-					field:  "DepositAddress",
+				errors = append(errors, ExitSingleNodeTreeSigningResultValidationError{
+					field:  "SigningResult",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSigningResult()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return InitiateStaticDepositUtxoRefundResponseValidationError{
-				field:  "DepositAddress",
+			return ExitSingleNodeTreeSigningResultValidationError{
+				field:  "SigningResult",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for VerifyingKey
+
 	if len(errors) > 0 {
-		return InitiateStaticDepositUtxoRefundResponseMultiError(errors)
+		return ExitSingleNodeTreeSigningResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitiateStaticDepositUtxoRefundResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// InitiateStaticDepositUtxoRefundResponse.ValidateAll() if the designated
-// constraints aren't met.
-type InitiateStaticDepositUtxoRefundResponseMultiError []error
+// ExitSingleNodeTreeSigningResultMultiError is an error wrapping multiple
+// validation errors returned by ExitSingleNodeTreeSigningResult.ValidateAll()
+// if the designated constraints aren't met.
+type ExitSingleNodeTreeSigningResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitiateStaticDepositUtxoRefundResponseMultiError) Error() string {
+func (m ExitSingleNodeTreeSigningResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19662,12 +21585,12 @@ func (m InitiateStaticDepositUtxoRefundResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitiateStaticDepositUtxoRefundResponseMultiError) AllErrors() []error { return m }
+func (m ExitSingleNodeTreeSigningResultMultiError) AllErrors() []error { return m }
 
-// InitiateStaticDepositUtxoRefundResponseValidationError is the validation
-// error returned by InitiateStaticDepositUtxoRefundResponse.Validate if the
-// designated constraints aren't met.
-type InitiateStaticDepositUtxoRefundResponseValidationError struct {
+// ExitSingleNodeTreeSigningResultValidationError is the validation error
+// returned by ExitSingleNodeTreeSigningResult.Validate if the designated
+// constraints aren't met.
+type ExitSingleNodeTreeSigningResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19675,24 +21598,24 @@ type InitiateStaticDepositUtxoRefundResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitiateStaticDepositUtxoRefundResponseValidationError) Field() string { return e.field }
+func (e ExitSingleNodeTreeSigningResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitiateStaticDepositUtxoRefundResponseValidationError) Reason() string { return e.reason }
+func (e ExitSingleNodeTreeSigningResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitiateStaticDepositUtxoRefundResponseValidationError) Cause() error { return e.cause }
+func (e ExitSingleNodeTreeSigningResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitiateStaticDepositUtxoRefundResponseValidationError) Key() bool { return e.key }
+func (e ExitSingleNodeTreeSigningResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitiateStaticDepositUtxoRefundResponseValidationError) ErrorName() string {
-	return "InitiateStaticDepositUtxoRefundResponseValidationError"
+func (e ExitSingleNodeTreeSigningResultValidationError) ErrorName() string {
+	return "ExitSingleNodeTreeSigningResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitiateStaticDepositUtxoRefundResponseValidationError) Error() string {
+func (e ExitSingleNodeTreeSigningResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19704,14 +21627,14 @@ func (e InitiateStaticDepositUtxoRefundResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitiateStaticDepositUtxoRefundResponse.%s: %s%s",
+		"invalid %sExitSingleNodeTreeSigningResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitiateStaticDepositUtxoRefundResponseValidationError{}
+var _ error = ExitSingleNodeTreeSigningResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -19719,166 +21642,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitiateStaticDepositUtxoRefundResponseValidationError{}
+} = ExitSingleNodeTreeSigningResultValidationError{}
 
-// Validate checks the field values on InitiateUtxoSwapRequest with the rules
+// Validate checks the field values on BitcoinTransactionOutput with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitiateUtxoSwapRequest) Validate() error {
+func (m *BitcoinTransactionOutput) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitiateUtxoSwapRequest with the
+// ValidateAll checks the field values on BitcoinTransactionOutput with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InitiateUtxoSwapRequestMultiError, or nil if none found.
-func (m *InitiateUtxoSwapRequest) ValidateAll() error {
+// BitcoinTransactionOutputMultiError, or nil if none found.
+func (m *BitcoinTransactionOutput) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitiateUtxoSwapRequest) validate(all bool) error {
+func (m *BitcoinTransactionOutput) validate(all bool) error {
 	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetOnChainUtxo()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateUtxoSwapRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateUtxoSwapRequestValidationError{
-					field:  "OnChainUtxo",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOnChainUtxo()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateUtxoSwapRequestValidationError{
-				field:  "OnChainUtxo",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for RequestType
-
-	// no validation rules for SspSignature
-
-	// no validation rules for UserSignature
-
-	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateUtxoSwapRequestValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateUtxoSwapRequestValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateUtxoSwapRequestValidationError{
-				field:  "Transfer",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+		return nil
 	}
 
-	if all {
-		switch v := interface{}(m.GetSpendTxSigningJob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateUtxoSwapRequestValidationError{
-					field:  "SpendTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateUtxoSwapRequestValidationError{
-					field:  "SpendTxSigningJob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpendTxSigningJob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateUtxoSwapRequestValidationError{
-				field:  "SpendTxSigningJob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	var errors []error
 
-	switch v := m.Amount.(type) {
-	case *InitiateUtxoSwapRequest_CreditAmountSats:
-		if v == nil {
-			err := InitiateUtxoSwapRequestValidationError{
-				field:  "Amount",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for CreditAmountSats
-	case *InitiateUtxoSwapRequest_MaxFeeSats:
-		if v == nil {
-			err := InitiateUtxoSwapRequestValidationError{
-				field:  "Amount",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-		// no validation rules for MaxFeeSats
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for Value
+
+	// no validation rules for PkScript
 
 	if len(errors) > 0 {
-		return InitiateUtxoSwapRequestMultiError(errors)
+		return BitcoinTransactionOutputMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitiateUtxoSwapRequestMultiError is an error wrapping multiple validation
-// errors returned by InitiateUtxoSwapRequest.ValidateAll() if the designated
+// BitcoinTransactionOutputMultiError is an error wrapping multiple validation
+// errors returned by BitcoinTransactionOutput.ValidateAll() if the designated
 // constraints aren't met.
-type InitiateUtxoSwapRequestMultiError []error
+type BitcoinTransactionOutputMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitiateUtxoSwapRequestMultiError) Error() string {
+func (m BitcoinTransactionOutputMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -19887,11 +21692,11 @@ func (m InitiateUtxoSwapRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitiateUtxoSwapRequestMultiError) AllErrors() []error { return m }
+func (m BitcoinTransactionOutputMultiError) AllErrors() []error { return m }
 
-// InitiateUtxoSwapRequestValidationError is the validation error returned by
-// InitiateUtxoSwapRequest.Validate if the designated constraints aren't met.
-type InitiateUtxoSwapRequestValidationError struct {
+// BitcoinTransactionOutputValidationError is the validation error returned by
+// BitcoinTransactionOutput.Validate if the designated constraints aren't met.
+type BitcoinTransactionOutputValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -19899,24 +21704,24 @@ type InitiateUtxoSwapRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitiateUtxoSwapRequestValidationError) Field() string { return e.field }
+func (e BitcoinTransactionOutputValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitiateUtxoSwapRequestValidationError) Reason() string { return e.reason }
+func (e BitcoinTransactionOutputValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitiateUtxoSwapRequestValidationError) Cause() error { return e.cause }
+func (e BitcoinTransactionOutputValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitiateUtxoSwapRequestValidationError) Key() bool { return e.key }
+func (e BitcoinTransactionOutputValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitiateUtxoSwapRequestValidationError) ErrorName() string {
-	return "InitiateUtxoSwapRequestValidationError"
+func (e BitcoinTransactionOutputValidationError) ErrorName() string {
+	return "BitcoinTransactionOutputValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitiateUtxoSwapRequestValidationError) Error() string {
+func (e BitcoinTransactionOutputValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -19928,14 +21733,14 @@ func (e InitiateUtxoSwapRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitiateUtxoSwapRequest.%s: %s%s",
+		"invalid %sBitcoinTransactionOutput.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitiateUtxoSwapRequestValidationError{}
+var _ error = BitcoinTransactionOutputValidationError{}
 
 var _ interface {
 	Field() string
@@ -19943,131 +21748,116 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitiateUtxoSwapRequestValidationError{}
+} = BitcoinTransactionOutputValidationError{}
 
-// Validate checks the field values on InitiateUtxoSwapResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ExitSingleNodeTreesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InitiateUtxoSwapResponse) Validate() error {
+func (m *ExitSingleNodeTreesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InitiateUtxoSwapResponse with the
+// ValidateAll checks the field values on ExitSingleNodeTreesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InitiateUtxoSwapResponseMultiError, or nil if none found.
-func (m *InitiateUtxoSwapResponse) ValidateAll() error {
+// ExitSingleNodeTreesRequestMultiError, or nil if none found.
+func (m *ExitSingleNodeTreesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InitiateUtxoSwapResponse) validate(all bool) error {
+func (m *ExitSingleNodeTreesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSpendTxSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateUtxoSwapResponseValidationError{
-					field:  "SpendTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateUtxoSwapResponseValidationError{
-					field:  "SpendTxSigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSpendTxSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateUtxoSwapResponseValidationError{
-				field:  "SpendTxSigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for OwnerIdentityPublicKey
 
-	if all {
-		switch v := interface{}(m.GetTransfer()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateUtxoSwapResponseValidationError{
-					field:  "Transfer",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	for idx, item := range m.GetExitingTrees() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
+						field:  fmt.Sprintf("ExitingTrees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
+						field:  fmt.Sprintf("ExitingTrees[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateUtxoSwapResponseValidationError{
-					field:  "Transfer",
+				return ExitSingleNodeTreesRequestValidationError{
+					field:  fmt.Sprintf("ExitingTrees[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTransfer()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateUtxoSwapResponseValidationError{
-				field:  "Transfer",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
-	if all {
-		switch v := interface{}(m.GetDepositAddress()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InitiateUtxoSwapResponseValidationError{
-					field:  "DepositAddress",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for RawTx
+
+	for idx, item := range m.GetPreviousOutputs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
+						field:  fmt.Sprintf("PreviousOutputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
+						field:  fmt.Sprintf("PreviousOutputs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InitiateUtxoSwapResponseValidationError{
-					field:  "DepositAddress",
+				return ExitSingleNodeTreesRequestValidationError{
+					field:  fmt.Sprintf("PreviousOutputs[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDepositAddress()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InitiateUtxoSwapResponseValidationError{
-				field:  "DepositAddress",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return InitiateUtxoSwapResponseMultiError(errors)
+		return ExitSingleNodeTreesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InitiateUtxoSwapResponseMultiError is an error wrapping multiple validation
-// errors returned by InitiateUtxoSwapResponse.ValidateAll() if the designated
-// constraints aren't met.
-type InitiateUtxoSwapResponseMultiError []error
+// ExitSingleNodeTreesRequestMultiError is an error wrapping multiple
+// validation errors returned by ExitSingleNodeTreesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExitSingleNodeTreesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InitiateUtxoSwapResponseMultiError) Error() string {
+func (m ExitSingleNodeTreesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20076,11 +21866,11 @@ func (m InitiateUtxoSwapResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InitiateUtxoSwapResponseMultiError) AllErrors() []error { return m }
-
-// InitiateUtxoSwapResponseValidationError is the validation error returned by
-// InitiateUtxoSwapResponse.Validate if the designated constraints aren't met.
-type InitiateUtxoSwapResponseValidationError struct {
+func (m ExitSingleNodeTreesRequestMultiError) AllErrors() []error { return m }
+
+// ExitSingleNodeTreesRequestValidationError is the validation error returned
+// by ExitSingleNodeTreesRequest.Validate if the designated constraints aren't met.
+type ExitSingleNodeTreesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20088,24 +21878,24 @@ type InitiateUtxoSwapResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InitiateUtxoSwapResponseValidationError) Field() string { return e.field }
+func (e ExitSingleNodeTreesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InitiateUtxoSwapResponseValidationError) Reason() string { return e.reason }
+func (e ExitSingleNodeTreesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InitiateUtxoSwapResponseValidationError) Cause() error { return e.cause }
+func (e ExitSingleNodeTreesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InitiateUtxoSwapResponseValidationError) Key() bool { return e.key }
+func (e ExitSingleNodeTreesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InitiateUtxoSwapResponseValidationError) ErrorName() string {
-	return "InitiateUtxoSwapResponseValidationError"
+func (e ExitSingleNodeTreesRequestValidationError) ErrorName() string {
+	return "ExitSingleNodeTreesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InitiateUtxoSwapResponseValidationError) Error() string {
+func (e ExitSingleNodeTreesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20117,14 +21907,14 @@ func (e InitiateUtxoSwapResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInitiateUtxoSwapResponse.%s: %s%s",
+		"invalid %sExitSingleNodeTreesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InitiateUtxoSwapResponseValidationError{}
+var _ error = ExitSingleNodeTreesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20132,76 +21922,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InitiateUtxoSwapResponseValidationError{}
+} = ExitSingleNodeTreesRequestValidationError{}
 
-// Validate checks the field values on ExitingTree with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ExitingTree) Validate() error {
+// Validate checks the field values on ExitSingleNodeTreesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExitSingleNodeTreesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExitingTree with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ExitingTreeMultiError, or
-// nil if none found.
-func (m *ExitingTree) ValidateAll() error {
+// ValidateAll checks the field values on ExitSingleNodeTreesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExitSingleNodeTreesResponseMultiError, or nil if none found.
+func (m *ExitSingleNodeTreesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExitingTree) validate(all bool) error {
+func (m *ExitSingleNodeTreesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TreeId
+	for idx, item := range m.GetSigningResults() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetUserSigningCommitment()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExitingTreeValidationError{
-					field:  "UserSigningCommitment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExitSingleNodeTreesResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExitSingleNodeTreesResponseValidationError{
+						field:  fmt.Sprintf("SigningResults[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ExitingTreeValidationError{
-					field:  "UserSigningCommitment",
+				return ExitSingleNodeTreesResponseValidationError{
+					field:  fmt.Sprintf("SigningResults[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUserSigningCommitment()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExitingTreeValidationError{
-				field:  "UserSigningCommitment",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for Vin
+	}
 
 	if len(errors) > 0 {
-		return ExitingTreeMultiError(errors)
+		return ExitSingleNodeTreesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExitingTreeMultiError is an error wrapping multiple validation errors
-// returned by ExitingTree.ValidateAll() if the designated constraints aren't met.
-type ExitingTreeMultiError []error
+// ExitSingleNodeTreesResponseMultiError is an error wrapping multiple
+// validation errors returned by ExitSingleNodeTreesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExitSingleNodeTreesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExitingTreeMultiError) Error() string {
+func (m ExitSingleNodeTreesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20210,11 +22002,12 @@ func (m ExitingTreeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExitingTreeMultiError) AllErrors() []error { return m }
+func (m ExitSingleNodeTreesResponseMultiError) AllErrors() []error { return m }
 
-// ExitingTreeValidationError is the validation error returned by
-// ExitingTree.Validate if the designated constraints aren't met.
-type ExitingTreeValidationError struct {
+// ExitSingleNodeTreesResponseValidationError is the validation error returned
+// by ExitSingleNodeTreesResponse.Validate if the designated constraints
+// aren't met.
+type ExitSingleNodeTreesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20222,22 +22015,24 @@ type ExitingTreeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExitingTreeValidationError) Field() string { return e.field }
+func (e ExitSingleNodeTreesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExitingTreeValidationError) Reason() string { return e.reason }
+func (e ExitSingleNodeTreesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExitingTreeValidationError) Cause() error { return e.cause }
+func (e ExitSingleNodeTreesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExitingTreeValidationError) Key() bool { return e.key }
+func (e ExitSingleNodeTreesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExitingTreeValidationError) ErrorName() string { return "ExitingTreeValidationError" }
+func (e ExitSingleNodeTreesResponseValidationError) ErrorName() string {
+	return "ExitSingleNodeTreesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExitingTreeValidationError) Error() string {
+func (e ExitSingleNodeTreesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20249,14 +22044,14 @@ func (e ExitingTreeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExitingTree.%s: %s%s",
+		"invalid %sExitSingleNodeTreesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExitingTreeValidationError{}
+var _ error = ExitSingleNodeTreesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20264,77 +22059,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExitingTreeValidationError{}
+} = ExitSingleNodeTreesResponseValidationError{}
 
-// Validate checks the field values on ExitSingleNodeTreeSigningResult with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on InvestigateLeavesRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExitSingleNodeTreeSigningResult) Validate() error {
+func (m *InvestigateLeavesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExitSingleNodeTreeSigningResult with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ExitSingleNodeTreeSigningResultMultiError, or nil if none found.
-func (m *ExitSingleNodeTreeSigningResult) ValidateAll() error {
+// ValidateAll checks the field values on InvestigateLeavesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvestigateLeavesRequestMultiError, or nil if none found.
+func (m *InvestigateLeavesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExitSingleNodeTreeSigningResult) validate(all bool) error {
+func (m *InvestigateLeavesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TreeId
-
-	if all {
-		switch v := interface{}(m.GetSigningResult()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExitSingleNodeTreeSigningResultValidationError{
-					field:  "SigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExitSingleNodeTreeSigningResultValidationError{
-					field:  "SigningResult",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSigningResult()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExitSingleNodeTreeSigningResultValidationError{
-				field:  "SigningResult",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for OwnerIdentityPublicKey
 
-	// no validation rules for VerifyingKey
+	// no validation rules for TransferId
 
 	if len(errors) > 0 {
-		return ExitSingleNodeTreeSigningResultMultiError(errors)
+		return InvestigateLeavesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExitSingleNodeTreeSigningResultMultiError is an error wrapping multiple
-// validation errors returned by ExitSingleNodeTreeSigningResult.ValidateAll()
-// if the designated constraints aren't met.
-type ExitSingleNodeTreeSigningResultMultiError []error
+// InvestigateLeavesRequestMultiError is an error wrapping multiple validation
+// errors returned by InvestigateLeavesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InvestigateLeavesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExitSingleNodeTreeSigningResultMultiError) Error() string {
+func (m InvestigateLeavesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20343,12 +22109,11 @@ func (m ExitSingleNodeTreeSigningResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExitSingleNodeTreeSigningResultMultiError) AllErrors() []error { return m }
+func (m InvestigateLeavesRequestMultiError) AllErrors() []error { return m }
 
-// ExitSingleNodeTreeSigningResultValidationError is the validation error
-// returned by ExitSingleNodeTreeSigningResult.Validate if the designated
-// constraints aren't met.
-type ExitSingleNodeTreeSigningResultValidationError struct {
+// InvestigateLeavesRequestValidationError is the validation error returned by
+// InvestigateLeavesRequest.Validate if the designated constraints aren't met.
+type InvestigateLeavesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20356,24 +22121,24 @@ type ExitSingleNodeTreeSigningResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExitSingleNodeTreeSigningResultValidationError) Field() string { return e.field }
+func (e InvestigateLeavesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExitSingleNodeTreeSigningResultValidationError) Reason() string { return e.reason }
+func (e InvestigateLeavesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExitSingleNodeTreeSigningResultValidationError) Cause() error { return e.cause }
+func (e InvestigateLeavesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExitSingleNodeTreeSigningResultValidationError) Key() bool { return e.key }
+func (e InvestigateLeavesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExitSingleNodeTreeSigningResultValidationError) ErrorName() string {
-	return "ExitSingleNodeTreeSigningResultValidationError"
+func (e InvestigateLeavesRequestValidationError) ErrorName() string {
+	return "InvestigateLeavesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExitSingleNodeTreeSigningResultValidationError) Error() string {
+func (e InvestigateLeavesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20385,14 +22150,14 @@ func (e ExitSingleNodeTreeSigningResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExitSingleNodeTreeSigningResult.%s: %s%s",
+		"invalid %sInvestigateLeavesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExitSingleNodeTreeSigningResultValidationError{}
+var _ error = InvestigateLeavesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20400,48 +22165,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExitSingleNodeTreeSigningResultValidationError{}
+} = InvestigateLeavesRequestValidationError{}
 
-// Validate checks the field values on BitcoinTransactionOutput with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on QueryNodesDistributionRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *BitcoinTransactionOutput) Validate() error {
+func (m *QueryNodesDistributionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BitcoinTransactionOutput with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// BitcoinTransactionOutputMultiError, or nil if none found.
-func (m *BitcoinTransactionOutput) ValidateAll() error {
+// ValidateAll checks the field values on QueryNodesDistributionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryNodesDistributionRequestMultiError, or nil if none found.
+func (m *QueryNodesDistributionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BitcoinTransactionOutput) validate(all bool) error {
+func (m *QueryNodesDistributionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Value
-
-	// no validation rules for PkScript
+	// no validation rules for OwnerIdentityPublicKey
 
 	if len(errors) > 0 {
-		return BitcoinTransactionOutputMultiError(errors)
+		return QueryNodesDistributionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// BitcoinTransactionOutputMultiError is an error wrapping multiple validation
-// errors returned by BitcoinTransactionOutput.ValidateAll() if the designated
-// constraints aren't met.
-type BitcoinTransactionOutputMultiError []error
+// QueryNodesDistributionRequestMultiError is an error wrapping multiple
+// validation errors returned by QueryNodesDistributionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type QueryNodesDistributionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m BitcoinTransactionOutputMultiError) Error() string {
+func (m QueryNodesDistributionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20450,11 +22213,12 @@ func (m BitcoinTransactionOutputMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m BitcoinTransactionOutputMultiError) AllErrors() []error { return m }
+func (m QueryNodesDistributionRequestMultiError) AllErrors() []error { return m }
 
-// BitcoinTransactionOutputValidationError is the validation error returned by
-// BitcoinTransactionOutput.Validate if the designated constraints aren't met.
-type BitcoinTransactionOutputValidationError struct {
+// QueryNodesDistributionRequestValidationError is the validation error
+// returned by QueryNodesDistributionRequest.Validate if the designated
+// constraints aren't met.
+type QueryNodesDistributionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20462,24 +22226,24 @@ type BitcoinTransactionOutputValidationError struct {
 }
 
 // Field function returns field value.
-func (e BitcoinTransactionOutputValidationError) Field() string { return e.field }
+func (e QueryNodesDistributionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e BitcoinTransactionOutputValidationError) Reason() string { return e.reason }
+func (e QueryNodesDistributionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e BitcoinTransactionOutputValidationError) Cause() error { return e.cause }
+func (e QueryNodesDistributionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e BitcoinTransactionOutputValidationError) Key() bool { return e.key }
+func (e QueryNodesDistributionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e BitcoinTransactionOutputValidationError) ErrorName() string {
-	return "BitcoinTransactionOutputValidationError"
+func (e QueryNodesDistributionRequestValidationError) ErrorName() string {
+	return "QueryNodesDistributionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e BitcoinTransactionOutputValidationError) Error() string {
+func (e QueryNodesDistributionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20491,14 +22255,14 @@ func (e BitcoinTransactionOutputValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sBitcoinTransactionOutput.%s: %s%s",
+		"invalid %sQueryNodesDistributionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = BitcoinTransactionOutputValidationError{}
+var _ error = QueryNodesDistributionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20506,116 +22270,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = BitcoinTransactionOutputValidationError{}
+} = QueryNodesDistributionRequestValidationError{}
 
-// Validate checks the field values on ExitSingleNodeTreesRequest with the
+// Validate checks the field values on QueryNodesDistributionResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExitSingleNodeTreesRequest) Validate() error {
+func (m *QueryNodesDistributionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExitSingleNodeTreesRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ExitSingleNodeTreesRequestMultiError, or nil if none found.
-func (m *ExitSingleNodeTreesRequest) ValidateAll() error {
+// ValidateAll checks the field values on QueryNodesDistributionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryNodesDistributionResponseMultiError, or nil if none found.
+func (m *QueryNodesDistributionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExitSingleNodeTreesRequest) validate(all bool) error {
+func (m *QueryNodesDistributionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OwnerIdentityPublicKey
-
-	for idx, item := range m.GetExitingTrees() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
-						field:  fmt.Sprintf("ExitingTrees[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
-						field:  fmt.Sprintf("ExitingTrees[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ExitSingleNodeTreesRequestValidationError{
-					field:  fmt.Sprintf("ExitingTrees[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
-	// no validation rules for RawTx
-
-	for idx, item := range m.GetPreviousOutputs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
-						field:  fmt.Sprintf("PreviousOutputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ExitSingleNodeTreesRequestValidationError{
-						field:  fmt.Sprintf("PreviousOutputs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ExitSingleNodeTreesRequestValidationError{
-					field:  fmt.Sprintf("PreviousOutputs[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for NodeDistribution
 
 	if len(errors) > 0 {
-		return ExitSingleNodeTreesRequestMultiError(errors)
+		return QueryNodesDistributionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExitSingleNodeTreesRequestMultiError is an error wrapping multiple
-// validation errors returned by ExitSingleNodeTreesRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ExitSingleNodeTreesRequestMultiError []error
+// QueryNodesDistributionResponseMultiError is an error wrapping multiple
+// validation errors returned by QueryNodesDistributionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type QueryNodesDistributionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExitSingleNodeTreesRequestMultiError) Error() string {
+func (m QueryNodesDistributionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20624,11 +22318,12 @@ func (m ExitSingleNodeTreesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExitSingleNodeTreesRequestMultiError) AllErrors() []error { return m }
+func (m QueryNodesDistributionResponseMultiError) AllErrors() []error { return m }
 
-// ExitSingleNodeTreesRequestValidationError is the validation error returned
-// by ExitSingleNodeTreesRequest.Validate if the designated constraints aren't met.
-type ExitSingleNodeTreesRequestValidationError struct {
+// QueryNodesDistributionResponseValidationError is the validation error
+// returned by QueryNodesDistributionResponse.Validate if the designated
+// constraints aren't met.
+type QueryNodesDistributionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20636,24 +22331,24 @@ type ExitSingleNodeTreesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExitSingleNodeTreesRequestValidationError) Field() string { return e.field }
+func (e QueryNodesDistributionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExitSingleNodeTreesRequestValidationError) Reason() string { return e.reason }
+func (e QueryNodesDistributionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExitSingleNodeTreesRequestValidationError) Cause() error { return e.cause }
+func (e QueryNodesDistributionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExitSingleNodeTreesRequestValidationError) Key() bool { return e.key }
+func (e QueryNodesDistributionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExitSingleNodeTreesRequestValidationError) ErrorName() string {
-	return "ExitSingleNodeTreesRequestValidationError"
+func (e QueryNodesDistributionResponseValidationError) ErrorName() string {
+	return "QueryNodesDistributionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExitSingleNodeTreesRequestValidationError) Error() string {
+func (e QueryNodesDistributionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20665,14 +22360,14 @@ func (e ExitSingleNodeTreesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExitSingleNodeTreesRequest.%s: %s%s",
+		"invalid %sQueryNodesDistributionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExitSingleNodeTreesRequestValidationError{}
+var _ error = QueryNodesDistributionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20680,78 +22375,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExitSingleNodeTreesRequestValidationError{}
+} = QueryNodesDistributionResponseValidationError{}
 
-// Validate checks the field values on ExitSingleNodeTreesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on QueryNodesByValueRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExitSingleNodeTreesResponse) Validate() error {
+func (m *QueryNodesByValueRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExitSingleNodeTreesResponse with the
+// ValidateAll checks the field values on QueryNodesByValueRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExitSingleNodeTreesResponseMultiError, or nil if none found.
-func (m *ExitSingleNodeTreesResponse) ValidateAll() error {
+// QueryNodesByValueRequestMultiError, or nil if none found.
+func (m *QueryNodesByValueRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExitSingleNodeTreesResponse) validate(all bool) error {
+func (m *QueryNodesByValueRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSigningResults() {
-		_, _ = idx, item
+	// no validation rules for OwnerIdentityPublicKey
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ExitSingleNodeTreesResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ExitSingleNodeTreesResponseValidationError{
-						field:  fmt.Sprintf("SigningResults[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ExitSingleNodeTreesResponseValidationError{
-					field:  fmt.Sprintf("SigningResults[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Value
 
-	}
+	// no validation rules for Offset
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return ExitSingleNodeTreesResponseMultiError(errors)
+		return QueryNodesByValueRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExitSingleNodeTreesResponseMultiError is an error wrapping multiple
-// validation errors returned by ExitSingleNodeTreesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ExitSingleNodeTreesResponseMultiError []error
+// QueryNodesByValueRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryNodesByValueRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryNodesByValueRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExitSingleNodeTreesResponseMultiError) Error() string {
+func (m QueryNodesByValueRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20760,12 +22429,11 @@ func (m ExitSingleNodeTreesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExitSingleNodeTreesResponseMultiError) AllErrors() []error { return m }
+func (m QueryNodesByValueRequestMultiError) AllErrors() []error { return m }
 
-// ExitSingleNodeTreesResponseValidationError is the validation error returned
-// by ExitSingleNodeTreesResponse.Validate if the designated constraints
-// aren't met.
-type ExitSingleNodeTreesResponseValidationError struct {
+// QueryNodesByValueRequestValidationError is the validation error returned by
+// QueryNodesByValueRequest.Validate if the designated constraints aren't met.
+type QueryNodesByValueRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20773,24 +22441,24 @@ type ExitSingleNodeTreesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExitSingleNodeTreesResponseValidationError) Field() string { return e.field }
+func (e QueryNodesByValueRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExitSingleNodeTreesResponseValidationError) Reason() string { return e.reason }
+func (e QueryNodesByValueRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExitSingleNodeTreesResponseValidationError) Cause() error { return e.cause }
+func (e QueryNodesByValueRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExitSingleNodeTreesResponseValidationError) Key() bool { return e.key }
+func (e QueryNodesByValueRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExitSingleNodeTreesResponseValidationError) ErrorName() string {
-	return "ExitSingleNodeTreesResponseValidationError"
+func (e QueryNodesByValueRequestValidationError) ErrorName() string {
+	return "QueryNodesByValueRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExitSingleNodeTreesResponseValidationError) Error() string {
+func (e QueryNodesByValueRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20802,14 +22470,14 @@ func (e ExitSingleNodeTreesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExitSingleNodeTreesResponse.%s: %s%s",
+		"invalid %sQueryNodesByValueRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExitSingleNodeTreesResponseValidationError{}
+var _ error = QueryNodesByValueRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -20817,48 +22485,92 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExitSingleNodeTreesResponseValidationError{}
+} = QueryNodesByValueRequestValidationError{}
 
-// Validate checks the field values on InvestigateLeavesRequest with the rules
+// Validate checks the field values on QueryNodesByValueResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InvestigateLeavesRequest) Validate() error {
+func (m *QueryNodesByValueResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InvestigateLeavesRequest with the
+// ValidateAll checks the field values on QueryNodesByValueResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InvestigateLeavesRequestMultiError, or nil if none found.
-func (m *InvestigateLeavesRequest) ValidateAll() error {
+// QueryNodesByValueResponseMultiError, or nil if none found.
+func (m *QueryNodesByValueResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InvestigateLeavesRequest) validate(all bool) error {
+func (m *QueryNodesByValueResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OwnerIdentityPublicKey
+	{
+		sorted_keys := make([]string, len(m.GetNodes()))
+		i := 0
+		for key := range m.GetNodes() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetNodes()[key]
+			_ = val
 
-	// no validation rules for TransferId
+			// no validation rules for Nodes[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, QueryNodesByValueResponseValidationError{
+							field:  fmt.Sprintf("Nodes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, QueryNodesByValueResponseValidationError{
+							field:  fmt.Sprintf("Nodes[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return QueryNodesByValueResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return InvestigateLeavesRequestMultiError(errors)
+		return QueryNodesByValueResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InvestigateLeavesRequestMultiError is an error wrapping multiple validation
-// errors returned by InvestigateLeavesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type InvestigateLeavesRequestMultiError []error
+// QueryNodesByValueResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryNodesByValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type QueryNodesByValueResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InvestigateLeavesRequestMultiError) Error() string {
+func (m QueryNodesByValueResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20867,11 +22579,11 @@ func (m InvestigateLeavesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InvestigateLeavesRequestMultiError) AllErrors() []error { return m }
+func (m QueryNodesByValueResponseMultiError) AllErrors() []error { return m }
 
-// InvestigateLeavesRequestValidationError is the validation error returned by
-// InvestigateLeavesRequest.Validate if the designated constraints aren't met.
-type InvestigateLeavesRequestValidationError struct {
+// QueryNodesByValueResponseValidationError is the validation error returned by
+// QueryNodesByValueResponse.Validate if the designated constraints aren't met.
+type QueryNodesByValueResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20879,24 +22591,24 @@ type InvestigateLeavesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InvestigateLeavesRequestValidationError) Field() string { return e.field }
+func (e QueryNodesByValueResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InvestigateLeavesRequestValidationError) Reason() string { return e.reason }
+func (e QueryNodesByValueResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InvestigateLeavesRequestValidationError) Cause() error { return e.cause }
+func (e QueryNodesByValueResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InvestigateLeavesRequestValidationError) Key() bool { return e.key }
+func (e QueryNodesByValueResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InvestigateLeavesRequestValidationError) ErrorName() string {
-	return "InvestigateLeavesRequestValidationError"
+func (e QueryNodesByValueResponseValidationError) ErrorName() string {
+	return "QueryNodesByValueResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InvestigateLeavesRequestValidationError) Error() string {
+func (e QueryNodesByValueResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -20908,14 +22620,14 @@ func (e InvestigateLeavesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInvestigateLeavesRequest.%s: %s%s",
+		"invalid %sQueryNodesByValueResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InvestigateLeavesRequestValidationError{}
+var _ error = QueryNodesByValueResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -20923,46 +22635,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InvestigateLeavesRequestValidationError{}
+} = QueryNodesByValueResponseValidationError{}
 
-// Validate checks the field values on QueryNodesDistributionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetUtxosForAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryNodesDistributionRequest) Validate() error {
+func (m *GetUtxosForAddressRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryNodesDistributionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QueryNodesDistributionRequestMultiError, or nil if none found.
-func (m *QueryNodesDistributionRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetUtxosForAddressRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUtxosForAddressRequestMultiError, or nil if none found.
+func (m *GetUtxosForAddressRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryNodesDistributionRequest) validate(all bool) error {
+func (m *GetUtxosForAddressRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OwnerIdentityPublicKey
+	// no validation rules for Address
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	// no validation rules for Network
+
+	// no validation rules for ExcludeClaimed
 
 	if len(errors) > 0 {
-		return QueryNodesDistributionRequestMultiError(errors)
+		return GetUtxosForAddressRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryNodesDistributionRequestMultiError is an error wrapping multiple
-// validation errors returned by QueryNodesDistributionRequest.ValidateAll()
-// if the designated constraints aren't met.
-type QueryNodesDistributionRequestMultiError []error
+// GetUtxosForAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUtxosForAddressRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetUtxosForAddressRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryNodesDistributionRequestMultiError) Error() string {
+func (m GetUtxosForAddressRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -20971,12 +22691,11 @@ func (m QueryNodesDistributionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryNodesDistributionRequestMultiError) AllErrors() []error { return m }
+func (m GetUtxosForAddressRequestMultiError) AllErrors() []error { return m }
 
-// QueryNodesDistributionRequestValidationError is the validation error
-// returned by QueryNodesDistributionRequest.Validate if the designated
-// constraints aren't met.
-type QueryNodesDistributionRequestValidationError struct {
+// GetUtxosForAddressRequestValidationError is the validation error returned by
+// GetUtxosForAddressRequest.Validate if the designated constraints aren't met.
+type GetUtxosForAddressRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -20984,24 +22703,24 @@ type QueryNodesDistributionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryNodesDistributionRequestValidationError) Field() string { return e.field }
+func (e GetUtxosForAddressRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryNodesDistributionRequestValidationError) Reason() string { return e.reason }
+func (e GetUtxosForAddressRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryNodesDistributionRequestValidationError) Cause() error { return e.cause }
+func (e GetUtxosForAddressRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryNodesDistributionRequestValidationError) Key() bool { return e.key }
+func (e GetUtxosForAddressRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryNodesDistributionRequestValidationError) ErrorName() string {
-	return "QueryNodesDistributionRequestValidationError"
+func (e GetUtxosForAddressRequestValidationError) ErrorName() string {
+	return "GetUtxosForAddressRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryNodesDistributionRequestValidationError) Error() string {
+func (e GetUtxosForAddressRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21013,14 +22732,14 @@ func (e QueryNodesDistributionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryNodesDistributionRequest.%s: %s%s",
+		"invalid %sGetUtxosForAddressRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryNodesDistributionRequestValidationError{}
+var _ error = GetUtxosForAddressRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21028,46 +22747,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryNodesDistributionRequestValidationError{}
+} = GetUtxosForAddressRequestValidationError{}
 
-// Validate checks the field values on QueryNodesDistributionResponse with the
+// Validate checks the field values on GetUtxosForAddressResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryNodesDistributionResponse) Validate() error {
+func (m *GetUtxosForAddressResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryNodesDistributionResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QueryNodesDistributionResponseMultiError, or nil if none found.
-func (m *QueryNodesDistributionResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetUtxosForAddressResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUtxosForAddressResponseMultiError, or nil if none found.
+func (m *GetUtxosForAddressResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryNodesDistributionResponse) validate(all bool) error {
+func (m *GetUtxosForAddressResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for NodeDistribution
+	for idx, item := range m.GetUtxos() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetUtxosForAddressResponseValidationError{
+						field:  fmt.Sprintf("Utxos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetUtxosForAddressResponseValidationError{
+						field:  fmt.Sprintf("Utxos[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetUtxosForAddressResponseValidationError{
+					field:  fmt.Sprintf("Utxos[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return QueryNodesDistributionResponseMultiError(errors)
+		return GetUtxosForAddressResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryNodesDistributionResponseMultiError is an error wrapping multiple
-// validation errors returned by QueryNodesDistributionResponse.ValidateAll()
-// if the designated constraints aren't met.
-type QueryNodesDistributionResponseMultiError []error
+// GetUtxosForAddressResponseMultiError is an error wrapping multiple
+// validation errors returned by GetUtxosForAddressResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetUtxosForAddressResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryNodesDistributionResponseMultiError) Error() string {
+func (m GetUtxosForAddressResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21076,12 +22829,11 @@ func (m QueryNodesDistributionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryNodesDistributionResponseMultiError) AllErrors() []error { return m }
+func (m GetUtxosForAddressResponseMultiError) AllErrors() []error { return m }
 
-// QueryNodesDistributionResponseValidationError is the validation error
-// returned by QueryNodesDistributionResponse.Validate if the designated
-// constraints aren't met.
-type QueryNodesDistributionResponseValidationError struct {
+// GetUtxosForAddressResponseValidationError is the validation error returned
+// by GetUtxosForAddressResponse.Validate if the designated constraints aren't met.
+type GetUtxosForAddressResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21089,24 +22841,24 @@ type QueryNodesDistributionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryNodesDistributionResponseValidationError) Field() string { return e.field }
+func (e GetUtxosForAddressResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryNodesDistributionResponseValidationError) Reason() string { return e.reason }
+func (e GetUtxosForAddressResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryNodesDistributionResponseValidationError) Cause() error { return e.cause }
+func (e GetUtxosForAddressResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryNodesDistributionResponseValidationError) Key() bool { return e.key }
+func (e GetUtxosForAddressResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryNodesDistributionResponseValidationError) ErrorName() string {
-	return "QueryNodesDistributionResponseValidationError"
+func (e GetUtxosForAddressResponseValidationError) ErrorName() string {
+	return "GetUtxosForAddressResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryNodesDistributionResponseValidationError) Error() string {
+func (e GetUtxosForAddressResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21118,14 +22870,14 @@ func (e QueryNodesDistributionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryNodesDistributionResponse.%s: %s%s",
+		"invalid %sGetUtxosForAddressResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryNodesDistributionResponseValidationError{}
+var _ error = GetUtxosForAddressResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21133,52 +22885,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryNodesDistributionResponseValidationError{}
+} = GetUtxosForAddressResponseValidationError{}
 
-// Validate checks the field values on QueryNodesByValueRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on QueryUnilateralExitKitRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryNodesByValueRequest) Validate() error {
+func (m *QueryUnilateralExitKitRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryNodesByValueRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// QueryNodesByValueRequestMultiError, or nil if none found.
-func (m *QueryNodesByValueRequest) ValidateAll() error {
+// ValidateAll checks the field values on QueryUnilateralExitKitRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryUnilateralExitKitRequestMultiError, or nil if none found.
+func (m *QueryUnilateralExitKitRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryNodesByValueRequest) validate(all bool) error {
+func (m *QueryUnilateralExitKitRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OwnerIdentityPublicKey
-
-	// no validation rules for Value
-
-	// no validation rules for Offset
-
-	// no validation rules for Limit
+	// no validation rules for LeafId
 
 	if len(errors) > 0 {
-		return QueryNodesByValueRequestMultiError(errors)
+		return QueryUnilateralExitKitRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryNodesByValueRequestMultiError is an error wrapping multiple validation
-// errors returned by QueryNodesByValueRequest.ValidateAll() if the designated
-// constraints aren't met.
-type QueryNodesByValueRequestMultiError []error
+// QueryUnilateralExitKitRequestMultiError is an error wrapping multiple
+// validation errors returned by QueryUnilateralExitKitRequest.ValidateAll()
+// if the designated constraints aren't met.
+type QueryUnilateralExitKitRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryNodesByValueRequestMultiError) Error() string {
+func (m QueryUnilateralExitKitRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21187,11 +22933,12 @@ func (m QueryNodesByValueRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryNodesByValueRequestMultiError) AllErrors() []error { return m }
+func (m QueryUnilateralExitKitRequestMultiError) AllErrors() []error { return m }
 
-// QueryNodesByValueRequestValidationError is the validation error returned by
-// QueryNodesByValueRequest.Validate if the designated constraints aren't met.
-type QueryNodesByValueRequestValidationError struct {
+// QueryUnilateralExitKitRequestValidationError is the validation error
+// returned by QueryUnilateralExitKitRequest.Validate if the designated
+// constraints aren't met.
+type QueryUnilateralExitKitRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21199,24 +22946,24 @@ type QueryNodesByValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryNodesByValueRequestValidationError) Field() string { return e.field }
+func (e QueryUnilateralExitKitRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryNodesByValueRequestValidationError) Reason() string { return e.reason }
+func (e QueryUnilateralExitKitRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryNodesByValueRequestValidationError) Cause() error { return e.cause }
+func (e QueryUnilateralExitKitRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryNodesByValueRequestValidationError) Key() bool { return e.key }
+func (e QueryUnilateralExitKitRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryNodesByValueRequestValidationError) ErrorName() string {
-	return "QueryNodesByValueRequestValidationError"
+func (e QueryUnilateralExitKitRequestValidationError) ErrorName() string {
+	return "QueryUnilateralExitKitRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryNodesByValueRequestValidationError) Error() string {
+func (e QueryUnilateralExitKitRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21228,14 +22975,14 @@ func (e QueryNodesByValueRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryNodesByValueRequest.%s: %s%s",
+		"invalid %sQueryUnilateralExitKitRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryNodesByValueRequestValidationError{}
+var _ error = QueryUnilateralExitKitRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -21243,92 +22990,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryNodesByValueRequestValidationError{}
+} = QueryUnilateralExitKitRequestValidationError{}
 
-// Validate checks the field values on QueryNodesByValueResponse with the rules
+// Validate checks the field values on UnilateralExitTransaction with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryNodesByValueResponse) Validate() error {
+func (m *UnilateralExitTransaction) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryNodesByValueResponse with the
+// ValidateAll checks the field values on UnilateralExitTransaction with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// QueryNodesByValueResponseMultiError, or nil if none found.
-func (m *QueryNodesByValueResponse) ValidateAll() error {
+// UnilateralExitTransactionMultiError, or nil if none found.
+func (m *UnilateralExitTransaction) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryNodesByValueResponse) validate(all bool) error {
+func (m *UnilateralExitTransaction) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetNodes()))
-		i := 0
-		for key := range m.GetNodes() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetNodes()[key]
-			_ = val
-
-			// no validation rules for Nodes[key]
+	// no validation rules for Tx
 
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, QueryNodesByValueResponseValidationError{
-							field:  fmt.Sprintf("Nodes[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, QueryNodesByValueResponseValidationError{
-							field:  fmt.Sprintf("Nodes[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return QueryNodesByValueResponseValidationError{
-						field:  fmt.Sprintf("Nodes[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
-			}
+	// no validation rules for Timelock
 
-		}
+	if m.AnchorVout != nil {
+		// no validation rules for AnchorVout
 	}
 
-	// no validation rules for Offset
-
 	if len(errors) > 0 {
-		return QueryNodesByValueResponseMultiError(errors)
+		return UnilateralExitTransactionMultiError(errors)
 	}
 
 	return nil
 }
 
-// QueryNodesByValueResponseMultiError is an error wrapping multiple validation
-// errors returned by QueryNodesByValueResponse.ValidateAll() if the
+// UnilateralExitTransactionMultiError is an error wrapping multiple validation
+// errors returned by UnilateralExitTransaction.ValidateAll() if the
 // designated constraints aren't met.
-type QueryNodesByValueResponseMultiError []error
+type UnilateralExitTransactionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QueryNodesByValueResponseMultiError) Error() string {
+func (m UnilateralExitTransactionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21337,11 +23044,11 @@ func (m QueryNodesByValueResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QueryNodesByValueResponseMultiError) AllErrors() []error { return m }
+func (m UnilateralExitTransactionMultiError) AllErrors() []error { return m }
 
-// QueryNodesByValueResponseValidationError is the validation error returned by
-// QueryNodesByValueResponse.Validate if the designated constraints aren't met.
-type QueryNodesByValueResponseValidationError struct {
+// UnilateralExitTransactionValidationError is the validation error returned by
+// UnilateralExitTransaction.Validate if the designated constraints aren't met.
+type UnilateralExitTransactionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21349,24 +23056,24 @@ type QueryNodesByValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e QueryNodesByValueResponseValidationError) Field() string { return e.field }
+func (e UnilateralExitTransactionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QueryNodesByValueResponseValidationError) Reason() string { return e.reason }
+func (e UnilateralExitTransactionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QueryNodesByValueResponseValidationError) Cause() error { return e.cause }
+func (e UnilateralExitTransactionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QueryNodesByValueResponseValidationError) Key() bool { return e.key }
+func (e UnilateralExitTransactionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QueryNodesByValueResponseValidationError) ErrorName() string {
-	return "QueryNodesByValueResponseValidationError"
+func (e UnilateralExitTransactionValidationError) ErrorName() string {
+	return "UnilateralExitTransactionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QueryNodesByValueResponseValidationError) Error() string {
+func (e UnilateralExitTransactionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21378,14 +23085,14 @@ func (e QueryNodesByValueResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQueryNodesByValueResponse.%s: %s%s",
+		"invalid %sUnilateralExitTransaction.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = QueryNodesByValueResponseValidationError{}
+var _ error = UnilateralExitTransactionValidationError{}
 
 var _ interface {
 	Field() string
@@ -21393,54 +23100,139 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QueryNodesByValueResponseValidationError{}
+} = UnilateralExitTransactionValidationError{}
 
-// Validate checks the field values on GetUtxosForAddressRequest with the rules
+// Validate checks the field values on UnilateralExitStep with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUtxosForAddressRequest) Validate() error {
+func (m *UnilateralExitStep) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUtxosForAddressRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UnilateralExitStep with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetUtxosForAddressRequestMultiError, or nil if none found.
-func (m *GetUtxosForAddressRequest) ValidateAll() error {
+// UnilateralExitStepMultiError, or nil if none found.
+func (m *UnilateralExitStep) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUtxosForAddressRequest) validate(all bool) error {
+func (m *UnilateralExitStep) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Address
+	// no validation rules for NodeId
 
-	// no validation rules for Offset
+	// no validation rules for Type
 
-	// no validation rules for Limit
+	if all {
+		switch v := interface{}(m.GetCpfpTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnilateralExitStepValidationError{
+					field:  "CpfpTx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnilateralExitStepValidationError{
+					field:  "CpfpTx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCpfpTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnilateralExitStepValidationError{
+				field:  "CpfpTx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Network
+	if all {
+		switch v := interface{}(m.GetDirectTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnilateralExitStepValidationError{
+					field:  "DirectTx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnilateralExitStepValidationError{
+					field:  "DirectTx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnilateralExitStepValidationError{
+				field:  "DirectTx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ExcludeClaimed
+	if all {
+		switch v := interface{}(m.GetDirectFromCpfpTx()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UnilateralExitStepValidationError{
+					field:  "DirectFromCpfpTx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UnilateralExitStepValidationError{
+					field:  "DirectFromCpfpTx",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDirectFromCpfpTx()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UnilateralExitStepValidationError{
+				field:  "DirectFromCpfpTx",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ConfirmationHeight != nil {
+		// no validation rules for ConfirmationHeight
+	}
 
 	if len(errors) > 0 {
-		return GetUtxosForAddressRequestMultiError(errors)
+		return UnilateralExitStepMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUtxosForAddressRequestMultiError is an error wrapping multiple validation
-// errors returned by GetUtxosForAddressRequest.ValidateAll() if the
-// designated constraints aren't met.
-type GetUtxosForAddressRequestMultiError []error
+// UnilateralExitStepMultiError is an error wrapping multiple validation errors
+// returned by UnilateralExitStep.ValidateAll() if the designated constraints
+// aren't met.
+type UnilateralExitStepMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUtxosForAddressRequestMultiError) Error() string {
+func (m UnilateralExitStepMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21449,11 +23241,11 @@ func (m GetUtxosForAddressRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUtxosForAddressRequestMultiError) AllErrors() []error { return m }
+func (m UnilateralExitStepMultiError) AllErrors() []error { return m }
 
-// GetUtxosForAddressRequestValidationError is the validation error returned by
-// GetUtxosForAddressRequest.Validate if the designated constraints aren't met.
-type GetUtxosForAddressRequestValidationError struct {
+// UnilateralExitStepValidationError is the validation error returned by
+// UnilateralExitStep.Validate if the designated constraints aren't met.
+type UnilateralExitStepValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21461,24 +23253,24 @@ type GetUtxosForAddressRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUtxosForAddressRequestValidationError) Field() string { return e.field }
+func (e UnilateralExitStepValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUtxosForAddressRequestValidationError) Reason() string { return e.reason }
+func (e UnilateralExitStepValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUtxosForAddressRequestValidationError) Cause() error { return e.cause }
+func (e UnilateralExitStepValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUtxosForAddressRequestValidationError) Key() bool { return e.key }
+func (e UnilateralExitStepValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUtxosForAddressRequestValidationError) ErrorName() string {
-	return "GetUtxosForAddressRequestValidationError"
+func (e UnilateralExitStepValidationError) ErrorName() string {
+	return "UnilateralExitStepValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUtxosForAddressRequestValidationError) Error() string {
+func (e UnilateralExitStepValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21490,14 +23282,14 @@ func (e GetUtxosForAddressRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUtxosForAddressRequest.%s: %s%s",
+		"invalid %sUnilateralExitStep.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUtxosForAddressRequestValidationError{}
+var _ error = UnilateralExitStepValidationError{}
 
 var _ interface {
 	Field() string
@@ -21505,47 +23297,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUtxosForAddressRequestValidationError{}
+} = UnilateralExitStepValidationError{}
 
-// Validate checks the field values on GetUtxosForAddressResponse with the
+// Validate checks the field values on QueryUnilateralExitKitResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetUtxosForAddressResponse) Validate() error {
+func (m *QueryUnilateralExitKitResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetUtxosForAddressResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetUtxosForAddressResponseMultiError, or nil if none found.
-func (m *GetUtxosForAddressResponse) ValidateAll() error {
+// ValidateAll checks the field values on QueryUnilateralExitKitResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryUnilateralExitKitResponseMultiError, or nil if none found.
+func (m *QueryUnilateralExitKitResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetUtxosForAddressResponse) validate(all bool) error {
+func (m *QueryUnilateralExitKitResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetUtxos() {
+	// no validation rules for LeafId
+
+	// no validation rules for Network
+
+	for idx, item := range m.GetSteps() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetUtxosForAddressResponseValidationError{
-						field:  fmt.Sprintf("Utxos[%v]", idx),
+					errors = append(errors, QueryUnilateralExitKitResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetUtxosForAddressResponseValidationError{
-						field:  fmt.Sprintf("Utxos[%v]", idx),
+					errors = append(errors, QueryUnilateralExitKitResponseValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -21553,8 +23349,8 @@ func (m *GetUtxosForAddressResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetUtxosForAddressResponseValidationError{
-					field:  fmt.Sprintf("Utxos[%v]", idx),
+				return QueryUnilateralExitKitResponseValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -21563,22 +23359,20 @@ func (m *GetUtxosForAddressResponse) validate(all bool) error {
 
 	}
 
-	// no validation rules for Offset
-
 	if len(errors) > 0 {
-		return GetUtxosForAddressResponseMultiError(errors)
+		return QueryUnilateralExitKitResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetUtxosForAddressResponseMultiError is an error wrapping multiple
-// validation errors returned by GetUtxosForAddressResponse.ValidateAll() if
-// the designated constraints aren't met.
-type GetUtxosForAddressResponseMultiError []error
+// QueryUnilateralExitKitResponseMultiError is an error wrapping multiple
+// validation errors returned by QueryUnilateralExitKitResponse.ValidateAll()
+// if the designated constraints aren't met.
+type QueryUnilateralExitKitResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetUtxosForAddressResponseMultiError) Error() string {
+func (m QueryUnilateralExitKitResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -21587,11 +23381,12 @@ func (m GetUtxosForAddressResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetUtxosForAddressResponseMultiError) AllErrors() []error { return m }
+func (m QueryUnilateralExitKitResponseMultiError) AllErrors() []error { return m }
 
-// GetUtxosForAddressResponseValidationError is the validation error returned
-// by GetUtxosForAddressResponse.Validate if the designated constraints aren't met.
-type GetUtxosForAddressResponseValidationError struct {
+// QueryUnilateralExitKitResponseValidationError is the validation error
+// returned by QueryUnilateralExitKitResponse.Validate if the designated
+// constraints aren't met.
+type QueryUnilateralExitKitResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -21599,24 +23394,24 @@ type GetUtxosForAddressResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetUtxosForAddressResponseValidationError) Field() string { return e.field }
+func (e QueryUnilateralExitKitResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetUtxosForAddressResponseValidationError) Reason() string { return e.reason }
+func (e QueryUnilateralExitKitResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetUtxosForAddressResponseValidationError) Cause() error { return e.cause }
+func (e QueryUnilateralExitKitResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetUtxosForAddressResponseValidationError) Key() bool { return e.key }
+func (e QueryUnilateralExitKitResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetUtxosForAddressResponseValidationError) ErrorName() string {
-	return "GetUtxosForAddressResponseValidationError"
+func (e QueryUnilateralExitKitResponseValidationError) ErrorName() string {
+	return "QueryUnilateralExitKitResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetUtxosForAddressResponseValidationError) Error() string {
+func (e QueryUnilateralExitKitResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -21628,14 +23423,14 @@ func (e GetUtxosForAddressResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetUtxosForAddressResponse.%s: %s%s",
+		"invalid %sQueryUnilateralExitKitResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetUtxosForAddressResponseValidationError{}
+var _ error = QueryUnilateralExitKitResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -21643,7 +23438,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetUtxosForAddressResponseValidationError{}
+} = QueryUnilateralExitKitResponseValidationError{}
 
 // Validate checks the field values on QuerySparkInvoicesRequest with the rules
 // defined in the proto definition for this message. If any rules are
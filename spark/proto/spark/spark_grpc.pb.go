@@ -73,6 +73,9 @@ const (
 	SparkService_RefreshTimelockV2_FullMethodName                   = "/spark.SparkService/refresh_timelock_v2"
 	SparkService_GetUtxosForAddress_FullMethodName                  = "/spark.SparkService/get_utxos_for_address"
 	SparkService_QuerySparkInvoices_FullMethodName                  = "/spark.SparkService/query_spark_invoices"
+	SparkService_StartBatchTransfer_FullMethodName                  = "/spark.SparkService/start_batch_transfer"
+	SparkService_StartHtlcTransfer_FullMethodName                   = "/spark.SparkService/start_htlc_transfer"
+	SparkService_QueryUnilateralExitKit_FullMethodName              = "/spark.SparkService/query_unilateral_exit_kit"
 )
 
 // SparkServiceClient is the client API for SparkService service.
@@ -155,6 +158,19 @@ type SparkServiceClient interface {
 	RefreshTimelockV2(ctx context.Context, in *RefreshTimelockRequest, opts ...grpc.CallOption) (*RefreshTimelockResponse, error)
 	GetUtxosForAddress(ctx context.Context, in *GetUtxosForAddressRequest, opts ...grpc.CallOption) (*GetUtxosForAddressResponse, error)
 	QuerySparkInvoices(ctx context.Context, in *QuerySparkInvoicesRequest, opts ...grpc.CallOption) (*QuerySparkInvoicesResponse, error)
+	// Sends leaves to several receivers at once. Either every transfer in the batch is sent or all of them
+	// are cancelled.
+	StartBatchTransfer(ctx context.Context, in *StartBatchTransferRequest, opts ...grpc.CallOption) (*StartBatchTransferResponse, error)
+	// Sends a transfer that the receiver can only claim by revealing the preimage of a payment hash with
+	// provide_preimage before the transfer expires. After it expires the transfer is returned to the sender.
+	StartHtlcTransfer(ctx context.Context, in *StartHtlcTransferRequest, opts ...grpc.CallOption) (*StartTransferResponse, error)
+	// Returns the ordered list of transactions to broadcast to unilaterally exit a leaf, from the root of
+	// its tree down to the leaf's refund transaction.
+	//
+	// Unilateral exit is temporarily disabled on mainnet, where query_nodes leaves the root node out of
+	// the ancestors it returns. A kit would hand out the root transaction that query_nodes withholds, so
+	// requests for mainnet leaves fail with FAILED_PRECONDITION until exits are enabled again.
+	QueryUnilateralExitKit(ctx context.Context, in *QueryUnilateralExitKitRequest, opts ...grpc.CallOption) (*QueryUnilateralExitKitResponse, error)
 }
 
 type sparkServiceClient struct {
@@ -708,6 +724,36 @@ func (c *sparkServiceClient) QuerySparkInvoices(ctx context.Context, in *QuerySp
 	return out, nil
 }
 
+func (c *sparkServiceClient) StartBatchTransfer(ctx context.Context, in *StartBatchTransferRequest, opts ...grpc.CallOption) (*StartBatchTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartBatchTransferResponse)
+	err := c.cc.Invoke(ctx, SparkService_StartBatchTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkServiceClient) StartHtlcTransfer(ctx context.Context, in *StartHtlcTransferRequest, opts ...grpc.CallOption) (*StartTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartTransferResponse)
+	err := c.cc.Invoke(ctx, SparkService_StartHtlcTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkServiceClient) QueryUnilateralExitKit(ctx context.Context, in *QueryUnilateralExitKitRequest, opts ...grpc.CallOption) (*QueryUnilateralExitKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnilateralExitKitResponse)
+	err := c.cc.Invoke(ctx, SparkService_QueryUnilateralExitKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SparkServiceServer is the server API for SparkService service.
 // All implementations must embed UnimplementedSparkServiceServer
 // for forward compatibility.
@@ -788,6 +834,19 @@ type SparkServiceServer interface {
 	RefreshTimelockV2(context.Context, *RefreshTimelockRequest) (*RefreshTimelockResponse, error)
 	GetUtxosForAddress(context.Context, *GetUtxosForAddressRequest) (*GetUtxosForAddressResponse, error)
 	QuerySparkInvoices(context.Context, *QuerySparkInvoicesRequest) (*QuerySparkInvoicesResponse, error)
+	// Sends leaves to several receivers at once. Either every transfer in the batch is sent or all of them
+	// are cancelled.
+	StartBatchTransfer(context.Context, *StartBatchTransferRequest) (*StartBatchTransferResponse, error)
+	// Sends a transfer that the receiver can only claim by revealing the preimage of a payment hash with
+	// provide_preimage before the transfer expires. After it expires the transfer is returned to the sender.
+	StartHtlcTransfer(context.Context, *StartHtlcTransferRequest) (*StartTransferResponse, error)
+	// Returns the ordered list of transactions to broadcast to unilaterally exit a leaf, from the root of
+	// its tree down to the leaf's refund transaction.
+	//
+	// Unilateral exit is temporarily disabled on mainnet, where query_nodes leaves the root node out of
+	// the ancestors it returns. A kit would hand out the root transaction that query_nodes withholds, so
+	// requests for mainnet leaves fail with FAILED_PRECONDITION until exits are enabled again.
+	QueryUnilateralExitKit(context.Context, *QueryUnilateralExitKitRequest) (*QueryUnilateralExitKitResponse, error)
 	mustEmbedUnimplementedSparkServiceServer()
 }
 
@@ -957,6 +1016,15 @@ func (UnimplementedSparkServiceServer) GetUtxosForAddress(context.Context, *GetU
 func (UnimplementedSparkServiceServer) QuerySparkInvoices(context.Context, *QuerySparkInvoicesRequest) (*QuerySparkInvoicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySparkInvoices not implemented")
 }
+func (UnimplementedSparkServiceServer) StartBatchTransfer(context.Context, *StartBatchTransferRequest) (*StartBatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBatchTransfer not implemented")
+}
+func (UnimplementedSparkServiceServer) StartHtlcTransfer(context.Context, *StartHtlcTransferRequest) (*StartTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartHtlcTransfer not implemented")
+}
+func (UnimplementedSparkServiceServer) QueryUnilateralExitKit(context.Context, *QueryUnilateralExitKitRequest) (*QueryUnilateralExitKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryUnilateralExitKit not implemented")
+}
 func (UnimplementedSparkServiceServer) mustEmbedUnimplementedSparkServiceServer() {}
 func (UnimplementedSparkServiceServer) testEmbeddedByValue()                      {}
 
@@ -1925,6 +1993,60 @@ func _SparkService_QuerySparkInvoices_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkService_StartBatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBatchTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkServiceServer).StartBatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkService_StartBatchTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkServiceServer).StartBatchTransfer(ctx, req.(*StartBatchTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkService_StartHtlcTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartHtlcTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkServiceServer).StartHtlcTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkService_StartHtlcTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkServiceServer).StartHtlcTransfer(ctx, req.(*StartHtlcTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkService_QueryUnilateralExitKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnilateralExitKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkServiceServer).QueryUnilateralExitKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkService_QueryUnilateralExitKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkServiceServer).QueryUnilateralExitKit(ctx, req.(*QueryUnilateralExitKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SparkService_ServiceDesc is the grpc.ServiceDesc for SparkService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2140,6 +2262,18 @@ var SparkService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "query_spark_invoices",
 			Handler:    _SparkService_QuerySparkInvoices_Handler,
 		},
+		{
+			MethodName: "start_batch_transfer",
+			Handler:    _SparkService_StartBatchTransfer_Handler,
+		},
+		{
+			MethodName: "start_htlc_transfer",
+			Handler:    _SparkService_StartHtlcTransfer_Handler,
+		},
+		{
+			MethodName: "query_unilateral_exit_kit",
+			Handler:    _SparkService_QueryUnilateralExitKit_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -0,0 +1,708 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: spark_admin.proto
+
+package spark_admin
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TaskRunResult int32
+
+const (
+	TaskRunResult_TASK_RUN_RESULT_UNSPECIFIED TaskRunResult = 0
+	TaskRunResult_TASK_RUN_RESULT_SUCCESS     TaskRunResult = 1
+	TaskRunResult_TASK_RUN_RESULT_FAILURE     TaskRunResult = 2
+	TaskRunResult_TASK_RUN_RESULT_TIMEOUT     TaskRunResult = 3
+	TaskRunResult_TASK_RUN_RESULT_PANIC       TaskRunResult = 4
+)
+
+// Enum value maps for TaskRunResult.
+var (
+	TaskRunResult_name = map[int32]string{
+		0: "TASK_RUN_RESULT_UNSPECIFIED",
+		1: "TASK_RUN_RESULT_SUCCESS",
+		2: "TASK_RUN_RESULT_FAILURE",
+		3: "TASK_RUN_RESULT_TIMEOUT",
+		4: "TASK_RUN_RESULT_PANIC",
+	}
+	TaskRunResult_value = map[string]int32{
+		"TASK_RUN_RESULT_UNSPECIFIED": 0,
+		"TASK_RUN_RESULT_SUCCESS":     1,
+		"TASK_RUN_RESULT_FAILURE":     2,
+		"TASK_RUN_RESULT_TIMEOUT":     3,
+		"TASK_RUN_RESULT_PANIC":       4,
+	}
+)
+
+func (x TaskRunResult) Enum() *TaskRunResult {
+	p := new(TaskRunResult)
+	*p = x
+	return p
+}
+
+func (x TaskRunResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRunResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (TaskRunResult) Type() protoreflect.EnumType {
+	return &file_spark_admin_proto_enumTypes[0]
+}
+
+func (x TaskRunResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRunResult.Descriptor instead.
+func (TaskRunResult) EnumDescriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type TaskStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExecutionInterval *durationpb.Duration   `protobuf:"bytes,2,opt,name=execution_interval,json=executionInterval,proto3" json:"execution_interval,omitempty"`
+	// Whether the task is disabled in code and never scheduled.
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Paused   bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	Running  bool `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	// Unset if the task has not run since the operator started.
+	LastRunStartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_run_start_time,json=lastRunStartTime,proto3" json:"last_run_start_time,omitempty"`
+	LastRunEndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_run_end_time,json=lastRunEndTime,proto3" json:"last_run_end_time,omitempty"`
+	LastRunResult    TaskRunResult          `protobuf:"varint,8,opt,name=last_run_result,json=lastRunResult,proto3,enum=spark_admin.TaskRunResult" json:"last_run_result,omitempty"`
+	// The error returned by the last run, if it did not succeed.
+	LastRunError  string `protobuf:"bytes,9,opt,name=last_run_error,json=lastRunError,proto3" json:"last_run_error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskStatus) Reset() {
+	*x = TaskStatus{}
+	mi := &file_spark_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskStatus) ProtoMessage() {}
+
+func (x *TaskStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskStatus.ProtoReflect.Descriptor instead.
+func (*TaskStatus) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TaskStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskStatus) GetExecutionInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ExecutionInterval
+	}
+	return nil
+}
+
+func (x *TaskStatus) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TaskStatus) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *TaskStatus) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *TaskStatus) GetLastRunStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunStartTime
+	}
+	return nil
+}
+
+func (x *TaskStatus) GetLastRunEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunEndTime
+	}
+	return nil
+}
+
+func (x *TaskStatus) GetLastRunResult() TaskRunResult {
+	if x != nil {
+		return x.LastRunResult
+	}
+	return TaskRunResult_TASK_RUN_RESULT_UNSPECIFIED
+}
+
+func (x *TaskStatus) GetLastRunError() string {
+	if x != nil {
+		return x.LastRunError
+	}
+	return ""
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_spark_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{1}
+}
+
+type ListTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*TaskStatus          `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_spark_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTasksResponse) GetTasks() []*TaskStatus {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+type TriggerTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskName      string                 `protobuf:"bytes,1,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TriggerTaskRequest) Reset() {
+	*x = TriggerTaskRequest{}
+	mi := &file_spark_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TriggerTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerTaskRequest) ProtoMessage() {}
+
+func (x *TriggerTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerTaskRequest.ProtoReflect.Descriptor instead.
+func (*TriggerTaskRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TriggerTaskRequest) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+type PauseTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskName      string                 `protobuf:"bytes,1,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseTaskRequest) Reset() {
+	*x = PauseTaskRequest{}
+	mi := &file_spark_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTaskRequest) ProtoMessage() {}
+
+func (x *PauseTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTaskRequest.ProtoReflect.Descriptor instead.
+func (*PauseTaskRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PauseTaskRequest) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+type ResumeTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskName      string                 `protobuf:"bytes,1,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeTaskRequest) Reset() {
+	*x = ResumeTaskRequest{}
+	mi := &file_spark_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeTaskRequest) ProtoMessage() {}
+
+func (x *ResumeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeTaskRequest.ProtoReflect.Descriptor instead.
+func (*ResumeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResumeTaskRequest) GetTaskName() string {
+	if x != nil {
+		return x.TaskName
+	}
+	return ""
+}
+
+type GetKnobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The knobs to read, optionally with a target as "knob@target". If empty, every knob that is
+	// currently set is returned.
+	Knobs         []string `protobuf:"bytes,1,rep,name=knobs,proto3" json:"knobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKnobsRequest) Reset() {
+	*x = GetKnobsRequest{}
+	mi := &file_spark_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKnobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnobsRequest) ProtoMessage() {}
+
+func (x *GetKnobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnobsRequest.ProtoReflect.Descriptor instead.
+func (*GetKnobsRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetKnobsRequest) GetKnobs() []string {
+	if x != nil {
+		return x.Knobs
+	}
+	return nil
+}
+
+type GetKnobsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The knob values that are set. Knobs that are not set use the default in code and are omitted.
+	Values        map[string]float64 `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKnobsResponse) Reset() {
+	*x = GetKnobsResponse{}
+	mi := &file_spark_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKnobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKnobsResponse) ProtoMessage() {}
+
+func (x *GetKnobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKnobsResponse.ProtoReflect.Descriptor instead.
+func (*GetKnobsResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetKnobsResponse) GetValues() map[string]float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_spark_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{8}
+}
+
+type GetConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The operator configuration as JSON, with secrets redacted.
+	ConfigJson    string `protobuf:"bytes,1,opt,name=config_json,json=configJson,proto3" json:"config_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	mi := &file_spark_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_spark_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetConfigResponse) GetConfigJson() string {
+	if x != nil {
+		return x.ConfigJson
+	}
+	return ""
+}
+
+var File_spark_admin_proto protoreflect.FileDescriptor
+
+const file_spark_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11spark_admin.proto\x12\vspark_admin\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb4\x03\n" +
+	"\n" +
+	"TaskStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12H\n" +
+	"\x12execution_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x11executionInterval\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x16\n" +
+	"\x06paused\x18\x04 \x01(\bR\x06paused\x12\x18\n" +
+	"\arunning\x18\x05 \x01(\bR\arunning\x12I\n" +
+	"\x13last_run_start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastRunStartTime\x12E\n" +
+	"\x11last_run_end_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0elastRunEndTime\x12B\n" +
+	"\x0flast_run_result\x18\b \x01(\x0e2\x1a.spark_admin.TaskRunResultR\rlastRunResult\x12$\n" +
+	"\x0elast_run_error\x18\t \x01(\tR\flastRunError\"\x12\n" +
+	"\x10ListTasksRequest\"B\n" +
+	"\x11ListTasksResponse\x12-\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x17.spark_admin.TaskStatusR\x05tasks\"1\n" +
+	"\x12TriggerTaskRequest\x12\x1b\n" +
+	"\ttask_name\x18\x01 \x01(\tR\btaskName\"/\n" +
+	"\x10PauseTaskRequest\x12\x1b\n" +
+	"\ttask_name\x18\x01 \x01(\tR\btaskName\"0\n" +
+	"\x11ResumeTaskRequest\x12\x1b\n" +
+	"\ttask_name\x18\x01 \x01(\tR\btaskName\"'\n" +
+	"\x0fGetKnobsRequest\x12\x14\n" +
+	"\x05knobs\x18\x01 \x03(\tR\x05knobs\"\x90\x01\n" +
+	"\x10GetKnobsResponse\x12A\n" +
+	"\x06values\x18\x01 \x03(\v2).spark_admin.GetKnobsResponse.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\x12\n" +
+	"\x10GetConfigRequest\"4\n" +
+	"\x11GetConfigResponse\x12\x1f\n" +
+	"\vconfig_json\x18\x01 \x01(\tR\n" +
+	"configJson*\xa2\x01\n" +
+	"\rTaskRunResult\x12\x1f\n" +
+	"\x1bTASK_RUN_RESULT_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_RUN_RESULT_SUCCESS\x10\x01\x12\x1b\n" +
+	"\x17TASK_RUN_RESULT_FAILURE\x10\x02\x12\x1b\n" +
+	"\x17TASK_RUN_RESULT_TIMEOUT\x10\x03\x12\x19\n" +
+	"\x15TASK_RUN_RESULT_PANIC\x10\x042\xdb\x03\n" +
+	"\x11SparkAdminService\x12M\n" +
+	"\n" +
+	"list_tasks\x12\x1d.spark_admin.ListTasksRequest\x1a\x1e.spark_admin.ListTasksResponse\"\x00\x12J\n" +
+	"\ftrigger_task\x12\x1f.spark_admin.TriggerTaskRequest\x1a\x17.spark_admin.TaskStatus\"\x00\x12F\n" +
+	"\n" +
+	"pause_task\x12\x1d.spark_admin.PauseTaskRequest\x1a\x17.spark_admin.TaskStatus\"\x00\x12H\n" +
+	"\vresume_task\x12\x1e.spark_admin.ResumeTaskRequest\x1a\x17.spark_admin.TaskStatus\"\x00\x12J\n" +
+	"\tget_knobs\x12\x1c.spark_admin.GetKnobsRequest\x1a\x1d.spark_admin.GetKnobsResponse\"\x00\x12M\n" +
+	"\n" +
+	"get_config\x12\x1d.spark_admin.GetConfigRequest\x1a\x1e.spark_admin.GetConfigResponse\"\x00B2Z0github.com/lightsparkdev/spark/proto/spark_adminb\x06proto3"
+
+var (
+	file_spark_admin_proto_rawDescOnce sync.Once
+	file_spark_admin_proto_rawDescData []byte
+)
+
+func file_spark_admin_proto_rawDescGZIP() []byte {
+	file_spark_admin_proto_rawDescOnce.Do(func() {
+		file_spark_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spark_admin_proto_rawDesc), len(file_spark_admin_proto_rawDesc)))
+	})
+	return file_spark_admin_proto_rawDescData
+}
+
+var file_spark_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_spark_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_spark_admin_proto_goTypes = []any{
+	(TaskRunResult)(0),            // 0: spark_admin.TaskRunResult
+	(*TaskStatus)(nil),            // 1: spark_admin.TaskStatus
+	(*ListTasksRequest)(nil),      // 2: spark_admin.ListTasksRequest
+	(*ListTasksResponse)(nil),     // 3: spark_admin.ListTasksResponse
+	(*TriggerTaskRequest)(nil),    // 4: spark_admin.TriggerTaskRequest
+	(*PauseTaskRequest)(nil),      // 5: spark_admin.PauseTaskRequest
+	(*ResumeTaskRequest)(nil),     // 6: spark_admin.ResumeTaskRequest
+	(*GetKnobsRequest)(nil),       // 7: spark_admin.GetKnobsRequest
+	(*GetKnobsResponse)(nil),      // 8: spark_admin.GetKnobsResponse
+	(*GetConfigRequest)(nil),      // 9: spark_admin.GetConfigRequest
+	(*GetConfigResponse)(nil),     // 10: spark_admin.GetConfigResponse
+	nil,                           // 11: spark_admin.GetKnobsResponse.ValuesEntry
+	(*durationpb.Duration)(nil),   // 12: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+}
+var file_spark_admin_proto_depIdxs = []int32{
+	12, // 0: spark_admin.TaskStatus.execution_interval:type_name -> google.protobuf.Duration
+	13, // 1: spark_admin.TaskStatus.last_run_start_time:type_name -> google.protobuf.Timestamp
+	13, // 2: spark_admin.TaskStatus.last_run_end_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: spark_admin.TaskStatus.last_run_result:type_name -> spark_admin.TaskRunResult
+	1,  // 4: spark_admin.ListTasksResponse.tasks:type_name -> spark_admin.TaskStatus
+	11, // 5: spark_admin.GetKnobsResponse.values:type_name -> spark_admin.GetKnobsResponse.ValuesEntry
+	2,  // 6: spark_admin.SparkAdminService.list_tasks:input_type -> spark_admin.ListTasksRequest
+	4,  // 7: spark_admin.SparkAdminService.trigger_task:input_type -> spark_admin.TriggerTaskRequest
+	5,  // 8: spark_admin.SparkAdminService.pause_task:input_type -> spark_admin.PauseTaskRequest
+	6,  // 9: spark_admin.SparkAdminService.resume_task:input_type -> spark_admin.ResumeTaskRequest
+	7,  // 10: spark_admin.SparkAdminService.get_knobs:input_type -> spark_admin.GetKnobsRequest
+	9,  // 11: spark_admin.SparkAdminService.get_config:input_type -> spark_admin.GetConfigRequest
+	3,  // 12: spark_admin.SparkAdminService.list_tasks:output_type -> spark_admin.ListTasksResponse
+	1,  // 13: spark_admin.SparkAdminService.trigger_task:output_type -> spark_admin.TaskStatus
+	1,  // 14: spark_admin.SparkAdminService.pause_task:output_type -> spark_admin.TaskStatus
+	1,  // 15: spark_admin.SparkAdminService.resume_task:output_type -> spark_admin.TaskStatus
+	8,  // 16: spark_admin.SparkAdminService.get_knobs:output_type -> spark_admin.GetKnobsResponse
+	10, // 17: spark_admin.SparkAdminService.get_config:output_type -> spark_admin.GetConfigResponse
+	12, // [12:18] is the sub-list for method output_type
+	6,  // [6:12] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_spark_admin_proto_init() }
+func file_spark_admin_proto_init() {
+	if File_spark_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_admin_proto_rawDesc), len(file_spark_admin_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_spark_admin_proto_goTypes,
+		DependencyIndexes: file_spark_admin_proto_depIdxs,
+		EnumInfos:         file_spark_admin_proto_enumTypes,
+		MessageInfos:      file_spark_admin_proto_msgTypes,
+	}.Build()
+	File_spark_admin_proto = out.File
+	file_spark_admin_proto_goTypes = nil
+	file_spark_admin_proto_depIdxs = nil
+}
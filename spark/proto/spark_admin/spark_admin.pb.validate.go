@@ -0,0 +1,1186 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: spark_admin.proto
+
+package spark_admin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TaskStatus with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskStatusMultiError, or
+// nil if none found.
+func (m *TaskStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetExecutionInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskStatusValidationError{
+					field:  "ExecutionInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskStatusValidationError{
+					field:  "ExecutionInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExecutionInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskStatusValidationError{
+				field:  "ExecutionInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Disabled
+
+	// no validation rules for Paused
+
+	// no validation rules for Running
+
+	if all {
+		switch v := interface{}(m.GetLastRunStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskStatusValidationError{
+					field:  "LastRunStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskStatusValidationError{
+					field:  "LastRunStartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskStatusValidationError{
+				field:  "LastRunStartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskStatusValidationError{
+					field:  "LastRunEndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskStatusValidationError{
+					field:  "LastRunEndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskStatusValidationError{
+				field:  "LastRunEndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastRunResult
+
+	// no validation rules for LastRunError
+
+	if len(errors) > 0 {
+		return TaskStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskStatusMultiError is an error wrapping multiple validation errors
+// returned by TaskStatus.ValidateAll() if the designated constraints aren't met.
+type TaskStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskStatusMultiError) AllErrors() []error { return m }
+
+// TaskStatusValidationError is the validation error returned by
+// TaskStatus.Validate if the designated constraints aren't met.
+type TaskStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskStatusValidationError) ErrorName() string { return "TaskStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskStatusValidationError{}
+
+// Validate checks the field values on ListTasksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksRequestMultiError, or nil if none found.
+func (m *ListTasksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListTasksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTasksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksRequestMultiError) AllErrors() []error { return m }
+
+// ListTasksRequestValidationError is the validation error returned by
+// ListTasksRequest.Validate if the designated constraints aren't met.
+type ListTasksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksRequestValidationError) ErrorName() string { return "ListTasksRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListTasksRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksRequestValidationError{}
+
+// Validate checks the field values on ListTasksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTasksResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTasksResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTasksResponseMultiError, or nil if none found.
+func (m *ListTasksResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTasksResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTasks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTasksResponseValidationError{
+						field:  fmt.Sprintf("Tasks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTasksResponseValidationError{
+					field:  fmt.Sprintf("Tasks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTasksResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTasksResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTasksResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTasksResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTasksResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTasksResponseMultiError) AllErrors() []error { return m }
+
+// ListTasksResponseValidationError is the validation error returned by
+// ListTasksResponse.Validate if the designated constraints aren't met.
+type ListTasksResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTasksResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTasksResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTasksResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTasksResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTasksResponseValidationError) ErrorName() string {
+	return "ListTasksResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTasksResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTasksResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTasksResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTasksResponseValidationError{}
+
+// Validate checks the field values on TriggerTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerTaskRequestMultiError, or nil if none found.
+func (m *TriggerTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskName
+
+	if len(errors) > 0 {
+		return TriggerTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerTaskRequestMultiError) AllErrors() []error { return m }
+
+// TriggerTaskRequestValidationError is the validation error returned by
+// TriggerTaskRequest.Validate if the designated constraints aren't met.
+type TriggerTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerTaskRequestValidationError) ErrorName() string {
+	return "TriggerTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerTaskRequestValidationError{}
+
+// Validate checks the field values on PauseTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseTaskRequestMultiError, or nil if none found.
+func (m *PauseTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskName
+
+	if len(errors) > 0 {
+		return PauseTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseTaskRequestMultiError) AllErrors() []error { return m }
+
+// PauseTaskRequestValidationError is the validation error returned by
+// PauseTaskRequest.Validate if the designated constraints aren't met.
+type PauseTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseTaskRequestValidationError) ErrorName() string { return "PauseTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PauseTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseTaskRequestValidationError{}
+
+// Validate checks the field values on ResumeTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResumeTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeTaskRequestMultiError, or nil if none found.
+func (m *ResumeTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskName
+
+	if len(errors) > 0 {
+		return ResumeTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by ResumeTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResumeTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeTaskRequestMultiError) AllErrors() []error { return m }
+
+// ResumeTaskRequestValidationError is the validation error returned by
+// ResumeTaskRequest.Validate if the designated constraints aren't met.
+type ResumeTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeTaskRequestValidationError) ErrorName() string {
+	return "ResumeTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeTaskRequestValidationError{}
+
+// Validate checks the field values on GetKnobsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetKnobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKnobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKnobsRequestMultiError, or nil if none found.
+func (m *GetKnobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKnobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetKnobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKnobsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetKnobsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetKnobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKnobsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKnobsRequestMultiError) AllErrors() []error { return m }
+
+// GetKnobsRequestValidationError is the validation error returned by
+// GetKnobsRequest.Validate if the designated constraints aren't met.
+type GetKnobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKnobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKnobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKnobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKnobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKnobsRequestValidationError) ErrorName() string { return "GetKnobsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetKnobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKnobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKnobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKnobsRequestValidationError{}
+
+// Validate checks the field values on GetKnobsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetKnobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetKnobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetKnobsResponseMultiError, or nil if none found.
+func (m *GetKnobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetKnobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Values
+
+	if len(errors) > 0 {
+		return GetKnobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetKnobsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetKnobsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetKnobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetKnobsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetKnobsResponseMultiError) AllErrors() []error { return m }
+
+// GetKnobsResponseValidationError is the validation error returned by
+// GetKnobsResponse.Validate if the designated constraints aren't met.
+type GetKnobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetKnobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetKnobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetKnobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetKnobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetKnobsResponseValidationError) ErrorName() string { return "GetKnobsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetKnobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetKnobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetKnobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetKnobsResponseValidationError{}
+
+// Validate checks the field values on GetConfigRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigRequestMultiError, or nil if none found.
+func (m *GetConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigRequestMultiError is an error wrapping multiple validation errors
+// returned by GetConfigRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigRequestMultiError) AllErrors() []error { return m }
+
+// GetConfigRequestValidationError is the validation error returned by
+// GetConfigRequest.Validate if the designated constraints aren't met.
+type GetConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigRequestValidationError) ErrorName() string { return "GetConfigRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigRequestValidationError{}
+
+// Validate checks the field values on GetConfigResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetConfigResponseMultiError, or nil if none found.
+func (m *GetConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ConfigJson
+
+	if len(errors) > 0 {
+		return GetConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetConfigResponseMultiError is an error wrapping multiple validation errors
+// returned by GetConfigResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetConfigResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetConfigResponseMultiError) AllErrors() []error { return m }
+
+// GetConfigResponseValidationError is the validation error returned by
+// GetConfigResponse.Validate if the designated constraints aren't met.
+type GetConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetConfigResponseValidationError) ErrorName() string {
+	return "GetConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetConfigResponseValidationError{}
@@ -0,0 +1,333 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: spark_admin.proto
+
+package spark_admin
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SparkAdminService_ListTasks_FullMethodName   = "/spark_admin.SparkAdminService/list_tasks"
+	SparkAdminService_TriggerTask_FullMethodName = "/spark_admin.SparkAdminService/trigger_task"
+	SparkAdminService_PauseTask_FullMethodName   = "/spark_admin.SparkAdminService/pause_task"
+	SparkAdminService_ResumeTask_FullMethodName  = "/spark_admin.SparkAdminService/resume_task"
+	SparkAdminService_GetKnobs_FullMethodName    = "/spark_admin.SparkAdminService/get_knobs"
+	SparkAdminService_GetConfig_FullMethodName   = "/spark_admin.SparkAdminService/get_config"
+)
+
+// SparkAdminServiceClient is the client API for SparkAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SparkAdminService is the operator-facing admin surface. Every call must be made with a session for
+// the admin identity key configured on the operator.
+type SparkAdminServiceClient interface {
+	// Lists the scheduled tasks with their last run and whether they are paused.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// Runs a scheduled task immediately and waits for it to finish. Paused tasks can still be
+	// triggered.
+	TriggerTask(ctx context.Context, in *TriggerTaskRequest, opts ...grpc.CallOption) (*TaskStatus, error)
+	// Stops a scheduled task from running on its schedule until it is resumed. The pause is not
+	// persisted across restarts.
+	PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*TaskStatus, error)
+	// Resumes a paused task.
+	ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*TaskStatus, error)
+	// Returns the current knob values.
+	GetKnobs(ctx context.Context, in *GetKnobsRequest, opts ...grpc.CallOption) (*GetKnobsResponse, error)
+	// Returns the operator configuration with secrets redacted.
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+}
+
+type sparkAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSparkAdminServiceClient(cc grpc.ClientConnInterface) SparkAdminServiceClient {
+	return &sparkAdminServiceClient{cc}
+}
+
+func (c *sparkAdminServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) TriggerTask(ctx context.Context, in *TriggerTaskRequest, opts ...grpc.CallOption) (*TaskStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskStatus)
+	err := c.cc.Invoke(ctx, SparkAdminService_TriggerTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) PauseTask(ctx context.Context, in *PauseTaskRequest, opts ...grpc.CallOption) (*TaskStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskStatus)
+	err := c.cc.Invoke(ctx, SparkAdminService_PauseTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) ResumeTask(ctx context.Context, in *ResumeTaskRequest, opts ...grpc.CallOption) (*TaskStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskStatus)
+	err := c.cc.Invoke(ctx, SparkAdminService_ResumeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) GetKnobs(ctx context.Context, in *GetKnobsRequest, opts ...grpc.CallOption) (*GetKnobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKnobsResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_GetKnobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkAdminServiceClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, SparkAdminService_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SparkAdminServiceServer is the server API for SparkAdminService service.
+// All implementations must embed UnimplementedSparkAdminServiceServer
+// for forward compatibility.
+//
+// SparkAdminService is the operator-facing admin surface. Every call must be made with a session for
+// the admin identity key configured on the operator.
+type SparkAdminServiceServer interface {
+	// Lists the scheduled tasks with their last run and whether they are paused.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// Runs a scheduled task immediately and waits for it to finish. Paused tasks can still be
+	// triggered.
+	TriggerTask(context.Context, *TriggerTaskRequest) (*TaskStatus, error)
+	// Stops a scheduled task from running on its schedule until it is resumed. The pause is not
+	// persisted across restarts.
+	PauseTask(context.Context, *PauseTaskRequest) (*TaskStatus, error)
+	// Resumes a paused task.
+	ResumeTask(context.Context, *ResumeTaskRequest) (*TaskStatus, error)
+	// Returns the current knob values.
+	GetKnobs(context.Context, *GetKnobsRequest) (*GetKnobsResponse, error)
+	// Returns the operator configuration with secrets redacted.
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	mustEmbedUnimplementedSparkAdminServiceServer()
+}
+
+// UnimplementedSparkAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSparkAdminServiceServer struct{}
+
+func (UnimplementedSparkAdminServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) TriggerTask(context.Context, *TriggerTaskRequest) (*TaskStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerTask not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) PauseTask(context.Context, *PauseTaskRequest) (*TaskStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTask not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) ResumeTask(context.Context, *ResumeTaskRequest) (*TaskStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTask not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) GetKnobs(context.Context, *GetKnobsRequest) (*GetKnobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKnobs not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedSparkAdminServiceServer) mustEmbedUnimplementedSparkAdminServiceServer() {}
+func (UnimplementedSparkAdminServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSparkAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SparkAdminServiceServer will
+// result in compilation errors.
+type UnsafeSparkAdminServiceServer interface {
+	mustEmbedUnimplementedSparkAdminServiceServer()
+}
+
+func RegisterSparkAdminServiceServer(s grpc.ServiceRegistrar, srv SparkAdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSparkAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SparkAdminService_ServiceDesc, srv)
+}
+
+func _SparkAdminService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_TriggerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).TriggerTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_TriggerTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).TriggerTask(ctx, req.(*TriggerTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_PauseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).PauseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_PauseTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).PauseTask(ctx, req.(*PauseTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_ResumeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).ResumeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_ResumeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).ResumeTask(ctx, req.(*ResumeTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_GetKnobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKnobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).GetKnobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_GetKnobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).GetKnobs(ctx, req.(*GetKnobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkAdminService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkAdminServiceServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkAdminService_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkAdminServiceServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SparkAdminService_ServiceDesc is the grpc.ServiceDesc for SparkAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SparkAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "spark_admin.SparkAdminService",
+	HandlerType: (*SparkAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "list_tasks",
+			Handler:    _SparkAdminService_ListTasks_Handler,
+		},
+		{
+			MethodName: "trigger_task",
+			Handler:    _SparkAdminService_TriggerTask_Handler,
+		},
+		{
+			MethodName: "pause_task",
+			Handler:    _SparkAdminService_PauseTask_Handler,
+		},
+		{
+			MethodName: "resume_task",
+			Handler:    _SparkAdminService_ResumeTask_Handler,
+		},
+		{
+			MethodName: "get_knobs",
+			Handler:    _SparkAdminService_GetKnobs_Handler,
+		},
+		{
+			MethodName: "get_config",
+			Handler:    _SparkAdminService_GetConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spark_admin.proto",
+}
@@ -74,6 +74,61 @@ func (SettleKeyTweakAction) EnumDescriptor() ([]byte, []int) {
 	return file_spark_internal_proto_rawDescGZIP(), []int{0}
 }
 
+type ConsistencyEntityType int32
+
+const (
+	ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_UNSPECIFIED      ConsistencyEntityType = 0
+	ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TRANSFER         ConsistencyEntityType = 1
+	ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TREE_NODE        ConsistencyEntityType = 2
+	ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TOKEN_OUTPUT     ConsistencyEntityType = 3
+	ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE ConsistencyEntityType = 4
+)
+
+// Enum value maps for ConsistencyEntityType.
+var (
+	ConsistencyEntityType_name = map[int32]string{
+		0: "CONSISTENCY_ENTITY_TYPE_UNSPECIFIED",
+		1: "CONSISTENCY_ENTITY_TYPE_TRANSFER",
+		2: "CONSISTENCY_ENTITY_TYPE_TREE_NODE",
+		3: "CONSISTENCY_ENTITY_TYPE_TOKEN_OUTPUT",
+		4: "CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE",
+	}
+	ConsistencyEntityType_value = map[string]int32{
+		"CONSISTENCY_ENTITY_TYPE_UNSPECIFIED":      0,
+		"CONSISTENCY_ENTITY_TYPE_TRANSFER":         1,
+		"CONSISTENCY_ENTITY_TYPE_TREE_NODE":        2,
+		"CONSISTENCY_ENTITY_TYPE_TOKEN_OUTPUT":     3,
+		"CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE": 4,
+	}
+)
+
+func (x ConsistencyEntityType) Enum() *ConsistencyEntityType {
+	p := new(ConsistencyEntityType)
+	*p = x
+	return p
+}
+
+func (x ConsistencyEntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsistencyEntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_internal_proto_enumTypes[1].Descriptor()
+}
+
+func (ConsistencyEntityType) Type() protoreflect.EnumType {
+	return &file_spark_internal_proto_enumTypes[1]
+}
+
+func (x ConsistencyEntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsistencyEntityType.Descriptor instead.
+func (ConsistencyEntityType) EnumDescriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{1}
+}
+
 type MarkKeysharesAsUsedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	KeyshareId    []string               `protobuf:"bytes,1,rep,name=keyshare_id,json=keyshareId,proto3" json:"keyshare_id,omitempty"`
@@ -1196,8 +1251,14 @@ type InitiateTransferRequest struct {
 	DirectRefundSignatures map[string][]byte `protobuf:"bytes,10,rep,name=direct_refund_signatures,json=directRefundSignatures,proto3" json:"direct_refund_signatures,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// The finalized signatures for the direct from cpfp refund transactions.
 	DirectFromCpfpRefundSignatures map[string][]byte `protobuf:"bytes,11,rep,name=direct_from_cpfp_refund_signatures,json=directFromCpfpRefundSignatures,proto3" json:"direct_from_cpfp_refund_signatures,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+	// The sats spark invoice paid by the transfer, if any.
+	SparkPaymentIntent string `protobuf:"bytes,12,opt,name=spark_payment_intent,json=sparkPaymentIntent,proto3" json:"spark_payment_intent,omitempty"`
+	// The batch the transfer is part of, if any.
+	BatchId string `protobuf:"bytes,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// The payment hash that locks an HTLC transfer.
+	PaymentHash   []byte `protobuf:"bytes,14,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InitiateTransferRequest) Reset() {
@@ -1307,6 +1368,71 @@ func (x *InitiateTransferRequest) GetDirectFromCpfpRefundSignatures() map[string
 	return nil
 }
 
+func (x *InitiateTransferRequest) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
+func (x *InitiateTransferRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *InitiateTransferRequest) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+type InitiateBatchTransferRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Transfers     []*InitiateTransferRequest `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateBatchTransferRequest) Reset() {
+	*x = InitiateBatchTransferRequest{}
+	mi := &file_spark_internal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateBatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateBatchTransferRequest) ProtoMessage() {}
+
+func (x *InitiateBatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateBatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*InitiateBatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InitiateBatchTransferRequest) GetTransfers() []*InitiateTransferRequest {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
 type DeliverSenderKeyTweakRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	TransferId              string                 `protobuf:"bytes,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
@@ -1318,7 +1444,7 @@ type DeliverSenderKeyTweakRequest struct {
 
 func (x *DeliverSenderKeyTweakRequest) Reset() {
 	*x = DeliverSenderKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[19]
+	mi := &file_spark_internal_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1330,7 +1456,7 @@ func (x *DeliverSenderKeyTweakRequest) String() string {
 func (*DeliverSenderKeyTweakRequest) ProtoMessage() {}
 
 func (x *DeliverSenderKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[19]
+	mi := &file_spark_internal_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1469,7 @@ func (x *DeliverSenderKeyTweakRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeliverSenderKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*DeliverSenderKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{19}
+	return file_spark_internal_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeliverSenderKeyTweakRequest) GetTransferId() string {
@@ -1378,7 +1504,7 @@ type InitiateCooperativeExitRequest struct {
 
 func (x *InitiateCooperativeExitRequest) Reset() {
 	*x = InitiateCooperativeExitRequest{}
-	mi := &file_spark_internal_proto_msgTypes[20]
+	mi := &file_spark_internal_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1390,7 +1516,7 @@ func (x *InitiateCooperativeExitRequest) String() string {
 func (*InitiateCooperativeExitRequest) ProtoMessage() {}
 
 func (x *InitiateCooperativeExitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[20]
+	mi := &file_spark_internal_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,7 +1529,7 @@ func (x *InitiateCooperativeExitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitiateCooperativeExitRequest.ProtoReflect.Descriptor instead.
 func (*InitiateCooperativeExitRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{20}
+	return file_spark_internal_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *InitiateCooperativeExitRequest) GetTransfer() *InitiateTransferRequest {
@@ -1438,7 +1564,7 @@ type UpdatePreimageRequestRequest struct {
 
 func (x *UpdatePreimageRequestRequest) Reset() {
 	*x = UpdatePreimageRequestRequest{}
-	mi := &file_spark_internal_proto_msgTypes[21]
+	mi := &file_spark_internal_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1450,7 +1576,7 @@ func (x *UpdatePreimageRequestRequest) String() string {
 func (*UpdatePreimageRequestRequest) ProtoMessage() {}
 
 func (x *UpdatePreimageRequestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[21]
+	mi := &file_spark_internal_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,7 +1589,7 @@ func (x *UpdatePreimageRequestRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePreimageRequestRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePreimageRequestRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{21}
+	return file_spark_internal_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdatePreimageRequestRequest) GetPreimageRequestId() string {
@@ -1493,13 +1619,14 @@ type StartTokenTransactionInternalRequest struct {
 	TokenTransactionSignatures *spark.TokenTransactionSignatures `protobuf:"bytes,2,opt,name=token_transaction_signatures,json=tokenTransactionSignatures,proto3" json:"token_transaction_signatures,omitempty"`
 	KeyshareIds                []string                          `protobuf:"bytes,3,rep,name=keyshare_ids,json=keyshareIds,proto3" json:"keyshare_ids,omitempty"`
 	CoordinatorPublicKey       []byte                            `protobuf:"bytes,10,opt,name=coordinator_public_key,json=coordinatorPublicKey,proto3" json:"coordinator_public_key,omitempty"`
+	SparkPaymentIntent         string                            `protobuf:"bytes,11,opt,name=spark_payment_intent,json=sparkPaymentIntent,proto3" json:"spark_payment_intent,omitempty"`
 	unknownFields              protoimpl.UnknownFields
 	sizeCache                  protoimpl.SizeCache
 }
 
 func (x *StartTokenTransactionInternalRequest) Reset() {
 	*x = StartTokenTransactionInternalRequest{}
-	mi := &file_spark_internal_proto_msgTypes[22]
+	mi := &file_spark_internal_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1511,7 +1638,7 @@ func (x *StartTokenTransactionInternalRequest) String() string {
 func (*StartTokenTransactionInternalRequest) ProtoMessage() {}
 
 func (x *StartTokenTransactionInternalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[22]
+	mi := &file_spark_internal_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,7 +1651,7 @@ func (x *StartTokenTransactionInternalRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use StartTokenTransactionInternalRequest.ProtoReflect.Descriptor instead.
 func (*StartTokenTransactionInternalRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{22}
+	return file_spark_internal_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *StartTokenTransactionInternalRequest) GetFinalTokenTransaction() *spark.TokenTransaction {
@@ -1555,6 +1682,13 @@ func (x *StartTokenTransactionInternalRequest) GetCoordinatorPublicKey() []byte
 	return nil
 }
 
+func (x *StartTokenTransactionInternalRequest) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
 type StartTokenTransactionInternalResponse struct {
 	state                 protoimpl.MessageState  `protogen:"open.v1"`
 	FinalTokenTransaction *spark.TokenTransaction `protobuf:"bytes,1,opt,name=final_token_transaction,json=finalTokenTransaction,proto3" json:"final_token_transaction,omitempty"`
@@ -1564,7 +1698,7 @@ type StartTokenTransactionInternalResponse struct {
 
 func (x *StartTokenTransactionInternalResponse) Reset() {
 	*x = StartTokenTransactionInternalResponse{}
-	mi := &file_spark_internal_proto_msgTypes[23]
+	mi := &file_spark_internal_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1576,7 +1710,7 @@ func (x *StartTokenTransactionInternalResponse) String() string {
 func (*StartTokenTransactionInternalResponse) ProtoMessage() {}
 
 func (x *StartTokenTransactionInternalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[23]
+	mi := &file_spark_internal_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1589,7 +1723,7 @@ func (x *StartTokenTransactionInternalResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use StartTokenTransactionInternalResponse.ProtoReflect.Descriptor instead.
 func (*StartTokenTransactionInternalResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{23}
+	return file_spark_internal_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *StartTokenTransactionInternalResponse) GetFinalTokenTransaction() *spark.TokenTransaction {
@@ -1610,7 +1744,7 @@ type InitiateSettleReceiverKeyTweakRequest struct {
 
 func (x *InitiateSettleReceiverKeyTweakRequest) Reset() {
 	*x = InitiateSettleReceiverKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[24]
+	mi := &file_spark_internal_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1622,7 +1756,7 @@ func (x *InitiateSettleReceiverKeyTweakRequest) String() string {
 func (*InitiateSettleReceiverKeyTweakRequest) ProtoMessage() {}
 
 func (x *InitiateSettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[24]
+	mi := &file_spark_internal_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1635,7 +1769,7 @@ func (x *InitiateSettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use InitiateSettleReceiverKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*InitiateSettleReceiverKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{24}
+	return file_spark_internal_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *InitiateSettleReceiverKeyTweakRequest) GetTransferId() string {
@@ -1669,7 +1803,7 @@ type SettleReceiverKeyTweakRequest struct {
 
 func (x *SettleReceiverKeyTweakRequest) Reset() {
 	*x = SettleReceiverKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[25]
+	mi := &file_spark_internal_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1681,7 +1815,7 @@ func (x *SettleReceiverKeyTweakRequest) String() string {
 func (*SettleReceiverKeyTweakRequest) ProtoMessage() {}
 
 func (x *SettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[25]
+	mi := &file_spark_internal_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1694,7 +1828,7 @@ func (x *SettleReceiverKeyTweakRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleReceiverKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*SettleReceiverKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{25}
+	return file_spark_internal_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SettleReceiverKeyTweakRequest) GetTransferId() string {
@@ -1721,7 +1855,7 @@ type SettleSenderKeyTweakRequest struct {
 
 func (x *SettleSenderKeyTweakRequest) Reset() {
 	*x = SettleSenderKeyTweakRequest{}
-	mi := &file_spark_internal_proto_msgTypes[26]
+	mi := &file_spark_internal_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1733,7 +1867,7 @@ func (x *SettleSenderKeyTweakRequest) String() string {
 func (*SettleSenderKeyTweakRequest) ProtoMessage() {}
 
 func (x *SettleSenderKeyTweakRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[26]
+	mi := &file_spark_internal_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1746,7 +1880,7 @@ func (x *SettleSenderKeyTweakRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettleSenderKeyTweakRequest.ProtoReflect.Descriptor instead.
 func (*SettleSenderKeyTweakRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{26}
+	return file_spark_internal_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SettleSenderKeyTweakRequest) GetTransferId() string {
@@ -1774,7 +1908,7 @@ type CreateUtxoSwapRequest struct {
 
 func (x *CreateUtxoSwapRequest) Reset() {
 	*x = CreateUtxoSwapRequest{}
-	mi := &file_spark_internal_proto_msgTypes[27]
+	mi := &file_spark_internal_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1786,7 +1920,7 @@ func (x *CreateUtxoSwapRequest) String() string {
 func (*CreateUtxoSwapRequest) ProtoMessage() {}
 
 func (x *CreateUtxoSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[27]
+	mi := &file_spark_internal_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,7 +1933,7 @@ func (x *CreateUtxoSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUtxoSwapRequest.ProtoReflect.Descriptor instead.
 func (*CreateUtxoSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{27}
+	return file_spark_internal_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateUtxoSwapRequest) GetRequest() *spark.InitiateUtxoSwapRequest {
@@ -1832,7 +1966,7 @@ type CreateUtxoSwapResponse struct {
 
 func (x *CreateUtxoSwapResponse) Reset() {
 	*x = CreateUtxoSwapResponse{}
-	mi := &file_spark_internal_proto_msgTypes[28]
+	mi := &file_spark_internal_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1844,7 +1978,7 @@ func (x *CreateUtxoSwapResponse) String() string {
 func (*CreateUtxoSwapResponse) ProtoMessage() {}
 
 func (x *CreateUtxoSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[28]
+	mi := &file_spark_internal_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,7 +1991,7 @@ func (x *CreateUtxoSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUtxoSwapResponse.ProtoReflect.Descriptor instead.
 func (*CreateUtxoSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{28}
+	return file_spark_internal_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CreateUtxoSwapResponse) GetUtxoDepositAddress() string {
@@ -1886,7 +2020,7 @@ type InitiateStaticDepositUtxoSwapRequest struct {
 
 func (x *InitiateStaticDepositUtxoSwapRequest) Reset() {
 	*x = InitiateStaticDepositUtxoSwapRequest{}
-	mi := &file_spark_internal_proto_msgTypes[29]
+	mi := &file_spark_internal_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1898,7 +2032,7 @@ func (x *InitiateStaticDepositUtxoSwapRequest) String() string {
 func (*InitiateStaticDepositUtxoSwapRequest) ProtoMessage() {}
 
 func (x *InitiateStaticDepositUtxoSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[29]
+	mi := &file_spark_internal_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,7 +2045,7 @@ func (x *InitiateStaticDepositUtxoSwapRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use InitiateStaticDepositUtxoSwapRequest.ProtoReflect.Descriptor instead.
 func (*InitiateStaticDepositUtxoSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{29}
+	return file_spark_internal_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *InitiateStaticDepositUtxoSwapRequest) GetOnChainUtxo() *spark.UTXO {
@@ -1960,7 +2094,7 @@ type CreateStaticDepositUtxoSwapRequest struct {
 
 func (x *CreateStaticDepositUtxoSwapRequest) Reset() {
 	*x = CreateStaticDepositUtxoSwapRequest{}
-	mi := &file_spark_internal_proto_msgTypes[30]
+	mi := &file_spark_internal_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1972,7 +2106,7 @@ func (x *CreateStaticDepositUtxoSwapRequest) String() string {
 func (*CreateStaticDepositUtxoSwapRequest) ProtoMessage() {}
 
 func (x *CreateStaticDepositUtxoSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[30]
+	mi := &file_spark_internal_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,7 +2119,7 @@ func (x *CreateStaticDepositUtxoSwapRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateStaticDepositUtxoSwapRequest.ProtoReflect.Descriptor instead.
 func (*CreateStaticDepositUtxoSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{30}
+	return file_spark_internal_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *CreateStaticDepositUtxoSwapRequest) GetRequest() *InitiateStaticDepositUtxoSwapRequest {
@@ -2018,7 +2152,7 @@ type CreateStaticDepositUtxoSwapResponse struct {
 
 func (x *CreateStaticDepositUtxoSwapResponse) Reset() {
 	*x = CreateStaticDepositUtxoSwapResponse{}
-	mi := &file_spark_internal_proto_msgTypes[31]
+	mi := &file_spark_internal_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2030,7 +2164,7 @@ func (x *CreateStaticDepositUtxoSwapResponse) String() string {
 func (*CreateStaticDepositUtxoSwapResponse) ProtoMessage() {}
 
 func (x *CreateStaticDepositUtxoSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[31]
+	mi := &file_spark_internal_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2043,7 +2177,7 @@ func (x *CreateStaticDepositUtxoSwapResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CreateStaticDepositUtxoSwapResponse.ProtoReflect.Descriptor instead.
 func (*CreateStaticDepositUtxoSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{31}
+	return file_spark_internal_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CreateStaticDepositUtxoSwapResponse) GetUtxoDepositAddress() string {
@@ -2064,7 +2198,7 @@ type CreateStaticDepositUtxoRefundRequest struct {
 
 func (x *CreateStaticDepositUtxoRefundRequest) Reset() {
 	*x = CreateStaticDepositUtxoRefundRequest{}
-	mi := &file_spark_internal_proto_msgTypes[32]
+	mi := &file_spark_internal_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2076,7 +2210,7 @@ func (x *CreateStaticDepositUtxoRefundRequest) String() string {
 func (*CreateStaticDepositUtxoRefundRequest) ProtoMessage() {}
 
 func (x *CreateStaticDepositUtxoRefundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[32]
+	mi := &file_spark_internal_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2089,7 +2223,7 @@ func (x *CreateStaticDepositUtxoRefundRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CreateStaticDepositUtxoRefundRequest.ProtoReflect.Descriptor instead.
 func (*CreateStaticDepositUtxoRefundRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{32}
+	return file_spark_internal_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CreateStaticDepositUtxoRefundRequest) GetRequest() *spark.InitiateStaticDepositUtxoRefundRequest {
@@ -2122,7 +2256,7 @@ type CreateStaticDepositUtxoRefundResponse struct {
 
 func (x *CreateStaticDepositUtxoRefundResponse) Reset() {
 	*x = CreateStaticDepositUtxoRefundResponse{}
-	mi := &file_spark_internal_proto_msgTypes[33]
+	mi := &file_spark_internal_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2134,7 +2268,7 @@ func (x *CreateStaticDepositUtxoRefundResponse) String() string {
 func (*CreateStaticDepositUtxoRefundResponse) ProtoMessage() {}
 
 func (x *CreateStaticDepositUtxoRefundResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[33]
+	mi := &file_spark_internal_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2147,7 +2281,7 @@ func (x *CreateStaticDepositUtxoRefundResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use CreateStaticDepositUtxoRefundResponse.ProtoReflect.Descriptor instead.
 func (*CreateStaticDepositUtxoRefundResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{33}
+	return file_spark_internal_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CreateStaticDepositUtxoRefundResponse) GetUtxoDepositAddress() string {
@@ -2168,7 +2302,7 @@ type RollbackUtxoSwapRequest struct {
 
 func (x *RollbackUtxoSwapRequest) Reset() {
 	*x = RollbackUtxoSwapRequest{}
-	mi := &file_spark_internal_proto_msgTypes[34]
+	mi := &file_spark_internal_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2180,7 +2314,7 @@ func (x *RollbackUtxoSwapRequest) String() string {
 func (*RollbackUtxoSwapRequest) ProtoMessage() {}
 
 func (x *RollbackUtxoSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[34]
+	mi := &file_spark_internal_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2193,7 +2327,7 @@ func (x *RollbackUtxoSwapRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RollbackUtxoSwapRequest.ProtoReflect.Descriptor instead.
 func (*RollbackUtxoSwapRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{34}
+	return file_spark_internal_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *RollbackUtxoSwapRequest) GetOnChainUtxo() *spark.UTXO {
@@ -2225,7 +2359,7 @@ type RollbackUtxoSwapResponse struct {
 
 func (x *RollbackUtxoSwapResponse) Reset() {
 	*x = RollbackUtxoSwapResponse{}
-	mi := &file_spark_internal_proto_msgTypes[35]
+	mi := &file_spark_internal_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2237,7 +2371,7 @@ func (x *RollbackUtxoSwapResponse) String() string {
 func (*RollbackUtxoSwapResponse) ProtoMessage() {}
 
 func (x *RollbackUtxoSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[35]
+	mi := &file_spark_internal_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,7 +2384,7 @@ func (x *RollbackUtxoSwapResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RollbackUtxoSwapResponse.ProtoReflect.Descriptor instead.
 func (*RollbackUtxoSwapResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{35}
+	return file_spark_internal_proto_rawDescGZIP(), []int{36}
 }
 
 type UtxoSwapCompletedRequest struct {
@@ -2264,7 +2398,7 @@ type UtxoSwapCompletedRequest struct {
 
 func (x *UtxoSwapCompletedRequest) Reset() {
 	*x = UtxoSwapCompletedRequest{}
-	mi := &file_spark_internal_proto_msgTypes[36]
+	mi := &file_spark_internal_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2276,7 +2410,7 @@ func (x *UtxoSwapCompletedRequest) String() string {
 func (*UtxoSwapCompletedRequest) ProtoMessage() {}
 
 func (x *UtxoSwapCompletedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[36]
+	mi := &file_spark_internal_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,7 +2423,7 @@ func (x *UtxoSwapCompletedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UtxoSwapCompletedRequest.ProtoReflect.Descriptor instead.
 func (*UtxoSwapCompletedRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{36}
+	return file_spark_internal_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UtxoSwapCompletedRequest) GetOnChainUtxo() *spark.UTXO {
@@ -2321,7 +2455,7 @@ type UtxoSwapCompletedResponse struct {
 
 func (x *UtxoSwapCompletedResponse) Reset() {
 	*x = UtxoSwapCompletedResponse{}
-	mi := &file_spark_internal_proto_msgTypes[37]
+	mi := &file_spark_internal_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2333,7 +2467,7 @@ func (x *UtxoSwapCompletedResponse) String() string {
 func (*UtxoSwapCompletedResponse) ProtoMessage() {}
 
 func (x *UtxoSwapCompletedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[37]
+	mi := &file_spark_internal_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,7 +2480,7 @@ func (x *UtxoSwapCompletedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UtxoSwapCompletedResponse.ProtoReflect.Descriptor instead.
 func (*UtxoSwapCompletedResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{37}
+	return file_spark_internal_proto_rawDescGZIP(), []int{38}
 }
 
 type CancelOrFinalizeExpiredTokenTransactionRequest struct {
@@ -2358,7 +2492,7 @@ type CancelOrFinalizeExpiredTokenTransactionRequest struct {
 
 func (x *CancelOrFinalizeExpiredTokenTransactionRequest) Reset() {
 	*x = CancelOrFinalizeExpiredTokenTransactionRequest{}
-	mi := &file_spark_internal_proto_msgTypes[38]
+	mi := &file_spark_internal_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2370,7 +2504,7 @@ func (x *CancelOrFinalizeExpiredTokenTransactionRequest) String() string {
 func (*CancelOrFinalizeExpiredTokenTransactionRequest) ProtoMessage() {}
 
 func (x *CancelOrFinalizeExpiredTokenTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[38]
+	mi := &file_spark_internal_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2383,7 +2517,7 @@ func (x *CancelOrFinalizeExpiredTokenTransactionRequest) ProtoReflect() protoref
 
 // Deprecated: Use CancelOrFinalizeExpiredTokenTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CancelOrFinalizeExpiredTokenTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{38}
+	return file_spark_internal_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *CancelOrFinalizeExpiredTokenTransactionRequest) GetFinalTokenTransaction() *spark.TokenTransaction {
@@ -2402,7 +2536,7 @@ type QueryLeafSigningPubkeysRequest struct {
 
 func (x *QueryLeafSigningPubkeysRequest) Reset() {
 	*x = QueryLeafSigningPubkeysRequest{}
-	mi := &file_spark_internal_proto_msgTypes[39]
+	mi := &file_spark_internal_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2414,7 +2548,7 @@ func (x *QueryLeafSigningPubkeysRequest) String() string {
 func (*QueryLeafSigningPubkeysRequest) ProtoMessage() {}
 
 func (x *QueryLeafSigningPubkeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[39]
+	mi := &file_spark_internal_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,7 +2561,7 @@ func (x *QueryLeafSigningPubkeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryLeafSigningPubkeysRequest.ProtoReflect.Descriptor instead.
 func (*QueryLeafSigningPubkeysRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{39}
+	return file_spark_internal_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *QueryLeafSigningPubkeysRequest) GetLeafIds() []string {
@@ -2446,7 +2580,7 @@ type QueryLeafSigningPubkeysResponse struct {
 
 func (x *QueryLeafSigningPubkeysResponse) Reset() {
 	*x = QueryLeafSigningPubkeysResponse{}
-	mi := &file_spark_internal_proto_msgTypes[40]
+	mi := &file_spark_internal_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2458,7 +2592,7 @@ func (x *QueryLeafSigningPubkeysResponse) String() string {
 func (*QueryLeafSigningPubkeysResponse) ProtoMessage() {}
 
 func (x *QueryLeafSigningPubkeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[40]
+	mi := &file_spark_internal_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,7 +2605,7 @@ func (x *QueryLeafSigningPubkeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryLeafSigningPubkeysResponse.ProtoReflect.Descriptor instead.
 func (*QueryLeafSigningPubkeysResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{40}
+	return file_spark_internal_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *QueryLeafSigningPubkeysResponse) GetSigningPubkeys() map[string][]byte {
@@ -2491,7 +2625,7 @@ type ResolveLeafInvestigationRequest struct {
 
 func (x *ResolveLeafInvestigationRequest) Reset() {
 	*x = ResolveLeafInvestigationRequest{}
-	mi := &file_spark_internal_proto_msgTypes[41]
+	mi := &file_spark_internal_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2503,7 +2637,7 @@ func (x *ResolveLeafInvestigationRequest) String() string {
 func (*ResolveLeafInvestigationRequest) ProtoMessage() {}
 
 func (x *ResolveLeafInvestigationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[41]
+	mi := &file_spark_internal_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,7 +2650,7 @@ func (x *ResolveLeafInvestigationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveLeafInvestigationRequest.ProtoReflect.Descriptor instead.
 func (*ResolveLeafInvestigationRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{41}
+	return file_spark_internal_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ResolveLeafInvestigationRequest) GetLostLeafIds() []string {
@@ -2540,13 +2674,15 @@ type ProvidePreimageRequest struct {
 	IdentityPublicKey []byte                 `protobuf:"bytes,3,opt,name=identity_public_key,json=identityPublicKey,proto3" json:"identity_public_key,omitempty"`
 	// The key tweak proofs for the leaves, to validate that each SO holds the correct key tweak.
 	KeyTweakProofs map[string]*spark.SecretProof `protobuf:"bytes,4,rep,name=key_tweak_proofs,json=keyTweakProofs,proto3" json:"key_tweak_proofs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// The HTLC transfer to unlock, if any.
+	TransferId    string `protobuf:"bytes,5,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProvidePreimageRequest) Reset() {
 	*x = ProvidePreimageRequest{}
-	mi := &file_spark_internal_proto_msgTypes[42]
+	mi := &file_spark_internal_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2558,7 +2694,7 @@ func (x *ProvidePreimageRequest) String() string {
 func (*ProvidePreimageRequest) ProtoMessage() {}
 
 func (x *ProvidePreimageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[42]
+	mi := &file_spark_internal_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,7 +2707,7 @@ func (x *ProvidePreimageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvidePreimageRequest.ProtoReflect.Descriptor instead.
 func (*ProvidePreimageRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{42}
+	return file_spark_internal_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ProvidePreimageRequest) GetPaymentHash() []byte {
@@ -2602,6 +2738,13 @@ func (x *ProvidePreimageRequest) GetKeyTweakProofs() map[string]*spark.SecretPro
 	return nil
 }
 
+func (x *ProvidePreimageRequest) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
 type ReserveEntityDkgKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	KeyshareId    string                 `protobuf:"bytes,1,opt,name=keyshare_id,json=keyshareId,proto3" json:"keyshare_id,omitempty"`
@@ -2611,7 +2754,7 @@ type ReserveEntityDkgKeyRequest struct {
 
 func (x *ReserveEntityDkgKeyRequest) Reset() {
 	*x = ReserveEntityDkgKeyRequest{}
-	mi := &file_spark_internal_proto_msgTypes[43]
+	mi := &file_spark_internal_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2623,7 +2766,7 @@ func (x *ReserveEntityDkgKeyRequest) String() string {
 func (*ReserveEntityDkgKeyRequest) ProtoMessage() {}
 
 func (x *ReserveEntityDkgKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[43]
+	mi := &file_spark_internal_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,7 +2779,7 @@ func (x *ReserveEntityDkgKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveEntityDkgKeyRequest.ProtoReflect.Descriptor instead.
 func (*ReserveEntityDkgKeyRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{43}
+	return file_spark_internal_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ReserveEntityDkgKeyRequest) GetKeyshareId() string {
@@ -2657,7 +2800,7 @@ type FixKeyshareRequest struct {
 
 func (x *FixKeyshareRequest) Reset() {
 	*x = FixKeyshareRequest{}
-	mi := &file_spark_internal_proto_msgTypes[44]
+	mi := &file_spark_internal_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2669,7 +2812,7 @@ func (x *FixKeyshareRequest) String() string {
 func (*FixKeyshareRequest) ProtoMessage() {}
 
 func (x *FixKeyshareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[44]
+	mi := &file_spark_internal_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2682,7 +2825,7 @@ func (x *FixKeyshareRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixKeyshareRequest.ProtoReflect.Descriptor instead.
 func (*FixKeyshareRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{44}
+	return file_spark_internal_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *FixKeyshareRequest) GetBadKeyshareId() string {
@@ -2717,7 +2860,7 @@ type FixKeyshareRound1Request struct {
 
 func (x *FixKeyshareRound1Request) Reset() {
 	*x = FixKeyshareRound1Request{}
-	mi := &file_spark_internal_proto_msgTypes[45]
+	mi := &file_spark_internal_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2729,7 +2872,7 @@ func (x *FixKeyshareRound1Request) String() string {
 func (*FixKeyshareRound1Request) ProtoMessage() {}
 
 func (x *FixKeyshareRound1Request) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[45]
+	mi := &file_spark_internal_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2742,7 +2885,7 @@ func (x *FixKeyshareRound1Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixKeyshareRound1Request.ProtoReflect.Descriptor instead.
 func (*FixKeyshareRound1Request) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{45}
+	return file_spark_internal_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *FixKeyshareRound1Request) GetBadKeyshareId() string {
@@ -2775,7 +2918,7 @@ type FixKeyshareRound1Response struct {
 
 func (x *FixKeyshareRound1Response) Reset() {
 	*x = FixKeyshareRound1Response{}
-	mi := &file_spark_internal_proto_msgTypes[46]
+	mi := &file_spark_internal_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2787,7 +2930,7 @@ func (x *FixKeyshareRound1Response) String() string {
 func (*FixKeyshareRound1Response) ProtoMessage() {}
 
 func (x *FixKeyshareRound1Response) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[46]
+	mi := &file_spark_internal_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2800,7 +2943,7 @@ func (x *FixKeyshareRound1Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixKeyshareRound1Response.ProtoReflect.Descriptor instead.
 func (*FixKeyshareRound1Response) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{46}
+	return file_spark_internal_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *FixKeyshareRound1Response) GetMessage() [][]byte {
@@ -2822,7 +2965,7 @@ type FixKeyshareRound2Request struct {
 
 func (x *FixKeyshareRound2Request) Reset() {
 	*x = FixKeyshareRound2Request{}
-	mi := &file_spark_internal_proto_msgTypes[47]
+	mi := &file_spark_internal_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2834,7 +2977,7 @@ func (x *FixKeyshareRound2Request) String() string {
 func (*FixKeyshareRound2Request) ProtoMessage() {}
 
 func (x *FixKeyshareRound2Request) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[47]
+	mi := &file_spark_internal_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2847,7 +2990,7 @@ func (x *FixKeyshareRound2Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixKeyshareRound2Request.ProtoReflect.Descriptor instead.
 func (*FixKeyshareRound2Request) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{47}
+	return file_spark_internal_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *FixKeyshareRound2Request) GetBadKeyshareId() string {
@@ -2887,7 +3030,7 @@ type FixKeyshareRound2Response struct {
 
 func (x *FixKeyshareRound2Response) Reset() {
 	*x = FixKeyshareRound2Response{}
-	mi := &file_spark_internal_proto_msgTypes[48]
+	mi := &file_spark_internal_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2899,7 +3042,7 @@ func (x *FixKeyshareRound2Response) String() string {
 func (*FixKeyshareRound2Response) ProtoMessage() {}
 
 func (x *FixKeyshareRound2Response) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[48]
+	mi := &file_spark_internal_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2912,7 +3055,7 @@ func (x *FixKeyshareRound2Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FixKeyshareRound2Response.ProtoReflect.Descriptor instead.
 func (*FixKeyshareRound2Response) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{48}
+	return file_spark_internal_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *FixKeyshareRound2Response) GetMessage() []byte {
@@ -2931,7 +3074,7 @@ type GetTransfersRequest struct {
 
 func (x *GetTransfersRequest) Reset() {
 	*x = GetTransfersRequest{}
-	mi := &file_spark_internal_proto_msgTypes[49]
+	mi := &file_spark_internal_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2943,7 +3086,7 @@ func (x *GetTransfersRequest) String() string {
 func (*GetTransfersRequest) ProtoMessage() {}
 
 func (x *GetTransfersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[49]
+	mi := &file_spark_internal_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2956,7 +3099,7 @@ func (x *GetTransfersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransfersRequest.ProtoReflect.Descriptor instead.
 func (*GetTransfersRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{49}
+	return file_spark_internal_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetTransfersRequest) GetTransferIds() []string {
@@ -2975,7 +3118,7 @@ type GetTransfersResponse struct {
 
 func (x *GetTransfersResponse) Reset() {
 	*x = GetTransfersResponse{}
-	mi := &file_spark_internal_proto_msgTypes[50]
+	mi := &file_spark_internal_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2987,7 +3130,7 @@ func (x *GetTransfersResponse) String() string {
 func (*GetTransfersResponse) ProtoMessage() {}
 
 func (x *GetTransfersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[50]
+	mi := &file_spark_internal_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,7 +3143,7 @@ func (x *GetTransfersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTransfersResponse.ProtoReflect.Descriptor instead.
 func (*GetTransfersResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{50}
+	return file_spark_internal_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetTransfersResponse) GetTransfers() []*spark.Transfer {
@@ -3021,7 +3164,7 @@ type GenerateStaticDepositAddressProofsRequest struct {
 
 func (x *GenerateStaticDepositAddressProofsRequest) Reset() {
 	*x = GenerateStaticDepositAddressProofsRequest{}
-	mi := &file_spark_internal_proto_msgTypes[51]
+	mi := &file_spark_internal_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3033,7 +3176,7 @@ func (x *GenerateStaticDepositAddressProofsRequest) String() string {
 func (*GenerateStaticDepositAddressProofsRequest) ProtoMessage() {}
 
 func (x *GenerateStaticDepositAddressProofsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[51]
+	mi := &file_spark_internal_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3046,7 +3189,7 @@ func (x *GenerateStaticDepositAddressProofsRequest) ProtoReflect() protoreflect.
 
 // Deprecated: Use GenerateStaticDepositAddressProofsRequest.ProtoReflect.Descriptor instead.
 func (*GenerateStaticDepositAddressProofsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{51}
+	return file_spark_internal_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GenerateStaticDepositAddressProofsRequest) GetKeyshareId() string {
@@ -3079,7 +3222,7 @@ type GenerateStaticDepositAddressProofsResponse struct {
 
 func (x *GenerateStaticDepositAddressProofsResponse) Reset() {
 	*x = GenerateStaticDepositAddressProofsResponse{}
-	mi := &file_spark_internal_proto_msgTypes[52]
+	mi := &file_spark_internal_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3091,7 +3234,7 @@ func (x *GenerateStaticDepositAddressProofsResponse) String() string {
 func (*GenerateStaticDepositAddressProofsResponse) ProtoMessage() {}
 
 func (x *GenerateStaticDepositAddressProofsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_internal_proto_msgTypes[52]
+	mi := &file_spark_internal_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3104,7 +3247,7 @@ func (x *GenerateStaticDepositAddressProofsResponse) ProtoReflect() protoreflect
 
 // Deprecated: Use GenerateStaticDepositAddressProofsResponse.ProtoReflect.Descriptor instead.
 func (*GenerateStaticDepositAddressProofsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_internal_proto_rawDescGZIP(), []int{52}
+	return file_spark_internal_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *GenerateStaticDepositAddressProofsResponse) GetAddressSignature() []byte {
@@ -3114,6 +3257,661 @@ func (x *GenerateStaticDepositAddressProofsResponse) GetAddressSignature() []byt
 	return nil
 }
 
+type QueryFailedGossipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryFailedGossipRequest) Reset() {
+	*x = QueryFailedGossipRequest{}
+	mi := &file_spark_internal_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFailedGossipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFailedGossipRequest) ProtoMessage() {}
+
+func (x *QueryFailedGossipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFailedGossipRequest.ProtoReflect.Descriptor instead.
+func (*QueryFailedGossipRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *QueryFailedGossipRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryFailedGossipRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type QueryFailedGossipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gossip        []*FailedGossip        `protobuf:"bytes,1,rep,name=gossip,proto3" json:"gossip,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryFailedGossipResponse) Reset() {
+	*x = QueryFailedGossipResponse{}
+	mi := &file_spark_internal_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryFailedGossipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryFailedGossipResponse) ProtoMessage() {}
+
+func (x *QueryFailedGossipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryFailedGossipResponse.ProtoReflect.Descriptor instead.
+func (*QueryFailedGossipResponse) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *QueryFailedGossipResponse) GetGossip() []*FailedGossip {
+	if x != nil {
+		return x.Gossip
+	}
+	return nil
+}
+
+func (x *QueryFailedGossipResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type FailedGossip struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The serialized gossip.GossipMessage.
+	Message       []byte                       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Participants  []*GossipParticipantDelivery `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	CreateTime    *timestamppb.Timestamp       `protobuf:"bytes,4,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	ExpiryTime    *timestamppb.Timestamp       `protobuf:"bytes,5,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailedGossip) Reset() {
+	*x = FailedGossip{}
+	mi := &file_spark_internal_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailedGossip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedGossip) ProtoMessage() {}
+
+func (x *FailedGossip) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedGossip.ProtoReflect.Descriptor instead.
+func (*FailedGossip) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FailedGossip) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FailedGossip) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *FailedGossip) GetParticipants() []*GossipParticipantDelivery {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *FailedGossip) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *FailedGossip) GetExpiryTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return nil
+}
+
+type GossipParticipantDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identifier    string                 `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Delivered     bool                   `protobuf:"varint,2,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Attempts      uint32                 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GossipParticipantDelivery) Reset() {
+	*x = GossipParticipantDelivery{}
+	mi := &file_spark_internal_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GossipParticipantDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipParticipantDelivery) ProtoMessage() {}
+
+func (x *GossipParticipantDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipParticipantDelivery.ProtoReflect.Descriptor instead.
+func (*GossipParticipantDelivery) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GossipParticipantDelivery) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *GossipParticipantDelivery) GetDelivered() bool {
+	if x != nil {
+		return x.Delivered
+	}
+	return false
+}
+
+func (x *GossipParticipantDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+type ReplayFailedGossipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GossipIds     []string               `protobuf:"bytes,1,rep,name=gossip_ids,json=gossipIds,proto3" json:"gossip_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayFailedGossipRequest) Reset() {
+	*x = ReplayFailedGossipRequest{}
+	mi := &file_spark_internal_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayFailedGossipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayFailedGossipRequest) ProtoMessage() {}
+
+func (x *ReplayFailedGossipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayFailedGossipRequest.ProtoReflect.Descriptor instead.
+func (*ReplayFailedGossipRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReplayFailedGossipRequest) GetGossipIds() []string {
+	if x != nil {
+		return x.GossipIds
+	}
+	return nil
+}
+
+type ConsistencyEntity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    ConsistencyEntityType  `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=spark_internal.ConsistencyEntityType" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsistencyEntity) Reset() {
+	*x = ConsistencyEntity{}
+	mi := &file_spark_internal_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyEntity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyEntity) ProtoMessage() {}
+
+func (x *ConsistencyEntity) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyEntity.ProtoReflect.Descriptor instead.
+func (*ConsistencyEntity) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ConsistencyEntity) GetEntityType() ConsistencyEntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *ConsistencyEntity) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type QueryConsistencySnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entities      []*ConsistencyEntity   `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryConsistencySnapshotRequest) Reset() {
+	*x = QueryConsistencySnapshotRequest{}
+	mi := &file_spark_internal_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryConsistencySnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryConsistencySnapshotRequest) ProtoMessage() {}
+
+func (x *QueryConsistencySnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryConsistencySnapshotRequest.ProtoReflect.Descriptor instead.
+func (*QueryConsistencySnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *QueryConsistencySnapshotRequest) GetEntities() []*ConsistencyEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type ConsistencySnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Entities this operator does not know about are omitted.
+	Entities      []*ConsistencyEntityState `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsistencySnapshot) Reset() {
+	*x = ConsistencySnapshot{}
+	mi := &file_spark_internal_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencySnapshot) ProtoMessage() {}
+
+func (x *ConsistencySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencySnapshot.ProtoReflect.Descriptor instead.
+func (*ConsistencySnapshot) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ConsistencySnapshot) GetEntities() []*ConsistencyEntityState {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type ConsistencyEntityState struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Entity *ConsistencyEntity     `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// The audited fields of the entity, keyed by field name. Bytes are hex encoded.
+	Fields        map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsistencyEntityState) Reset() {
+	*x = ConsistencyEntityState{}
+	mi := &file_spark_internal_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyEntityState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyEntityState) ProtoMessage() {}
+
+func (x *ConsistencyEntityState) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyEntityState.ProtoReflect.Descriptor instead.
+func (*ConsistencyEntityState) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ConsistencyEntityState) GetEntity() *ConsistencyEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *ConsistencyEntityState) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type AuditConsistencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// If empty, the entities updated within the last audit window are audited.
+	Entities      []*ConsistencyEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditConsistencyRequest) Reset() {
+	*x = AuditConsistencyRequest{}
+	mi := &file_spark_internal_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditConsistencyRequest) ProtoMessage() {}
+
+func (x *AuditConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*AuditConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AuditConsistencyRequest) GetEntities() []*ConsistencyEntity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type ConsistencyReport struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AuditTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=audit_time,json=auditTime,proto3" json:"audit_time,omitempty"`
+	// The identifier of the operator that ran the audit.
+	Auditor         string                   `protobuf:"bytes,2,opt,name=auditor,proto3" json:"auditor,omitempty"`
+	EntitiesChecked uint32                   `protobuf:"varint,3,opt,name=entities_checked,json=entitiesChecked,proto3" json:"entities_checked,omitempty"`
+	Divergences     []*ConsistencyDivergence `protobuf:"bytes,4,rep,name=divergences,proto3" json:"divergences,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConsistencyReport) Reset() {
+	*x = ConsistencyReport{}
+	mi := &file_spark_internal_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyReport) ProtoMessage() {}
+
+func (x *ConsistencyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyReport.ProtoReflect.Descriptor instead.
+func (*ConsistencyReport) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ConsistencyReport) GetAuditTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuditTime
+	}
+	return nil
+}
+
+func (x *ConsistencyReport) GetAuditor() string {
+	if x != nil {
+		return x.Auditor
+	}
+	return ""
+}
+
+func (x *ConsistencyReport) GetEntitiesChecked() uint32 {
+	if x != nil {
+		return x.EntitiesChecked
+	}
+	return 0
+}
+
+func (x *ConsistencyReport) GetDivergences() []*ConsistencyDivergence {
+	if x != nil {
+		return x.Divergences
+	}
+	return nil
+}
+
+type ConsistencyDivergence struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Entity *ConsistencyEntity     `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	// The name of the field that differs. It is "exists" when some operators do not know about the entity.
+	Field string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	// The value of the field on each operator, keyed by operator identifier.
+	Values        map[string]string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsistencyDivergence) Reset() {
+	*x = ConsistencyDivergence{}
+	mi := &file_spark_internal_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsistencyDivergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsistencyDivergence) ProtoMessage() {}
+
+func (x *ConsistencyDivergence) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_internal_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsistencyDivergence.ProtoReflect.Descriptor instead.
+func (*ConsistencyDivergence) Descriptor() ([]byte, []int) {
+	return file_spark_internal_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ConsistencyDivergence) GetEntity() *ConsistencyEntity {
+	if x != nil {
+		return x.Entity
+	}
+	return nil
+}
+
+func (x *ConsistencyDivergence) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ConsistencyDivergence) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 var File_spark_internal_proto protoreflect.FileDescriptor
 
 const file_spark_internal_proto_rawDesc = "" +
@@ -3216,7 +4014,8 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\aleaf_id\x18\x01 \x01(\tR\x06leafId\x12\"\n" +
 	"\rraw_refund_tx\x18\x02 \x01(\fR\vrawRefundTx\x12(\n" +
 	"\x10direct_refund_tx\x18\x03 \x01(\fR\x0edirectRefundTx\x12:\n" +
-	"\x1adirect_from_cpfp_refund_tx\x18\x04 \x01(\fR\x16directFromCpfpRefundTx\"\xde\t\n" +
+	"\x1adirect_from_cpfp_refund_tx\x18\x04 \x01(\fR\x16directFromCpfpRefundTx\"\xce\n" +
+	"\n" +
 	"\x17InitiateTransferRequest\x12\x1f\n" +
 	"\vtransfer_id\x18\x01 \x01(\tR\n" +
 	"transferId\x12;\n" +
@@ -3231,7 +4030,10 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x11refund_signatures\x18\t \x03(\v2=.spark_internal.InitiateTransferRequest.RefundSignaturesEntryR\x10refundSignatures\x12}\n" +
 	"\x18direct_refund_signatures\x18\n" +
 	" \x03(\v2C.spark_internal.InitiateTransferRequest.DirectRefundSignaturesEntryR\x16directRefundSignatures\x12\x97\x01\n" +
-	"\"direct_from_cpfp_refund_signatures\x18\v \x03(\v2K.spark_internal.InitiateTransferRequest.DirectFromCpfpRefundSignaturesEntryR\x1edirectFromCpfpRefundSignatures\x1a[\n" +
+	"\"direct_from_cpfp_refund_signatures\x18\v \x03(\v2K.spark_internal.InitiateTransferRequest.DirectFromCpfpRefundSignaturesEntryR\x1edirectFromCpfpRefundSignatures\x120\n" +
+	"\x14spark_payment_intent\x18\f \x01(\tR\x12sparkPaymentIntent\x12\x19\n" +
+	"\bbatch_id\x18\r \x01(\tR\abatchId\x12!\n" +
+	"\fpayment_hash\x18\x0e \x01(\fR\vpaymentHash\x1a[\n" +
 	"\x19SenderKeyTweakProofsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
 	"\x05value\x18\x02 \x01(\v2\x12.spark.SecretProofR\x05value:\x028\x01\x1aC\n" +
@@ -3243,7 +4045,9 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\x1aQ\n" +
 	"#DirectFromCpfpRefundSignaturesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xbf\x01\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"e\n" +
+	"\x1cInitiateBatchTransferRequest\x12E\n" +
+	"\ttransfers\x18\x01 \x03(\v2'.spark_internal.InitiateTransferRequestR\ttransfers\"\xbf\x01\n" +
 	"\x1cDeliverSenderKeyTweakRequest\x12\x1f\n" +
 	"\vtransfer_id\x18\x01 \x01(\tR\n" +
 	"transferId\x12;\n" +
@@ -3256,13 +4060,14 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x1cUpdatePreimageRequestRequest\x12.\n" +
 	"\x13preimage_request_id\x18\x01 \x01(\tR\x11preimageRequestId\x12\x1a\n" +
 	"\bpreimage\x18\x02 \x01(\fR\bpreimage\x12.\n" +
-	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\"\xb5\x02\n" +
+	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\"\xe7\x02\n" +
 	"$StartTokenTransactionInternalRequest\x12O\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x17.spark.TokenTransactionR\x15finalTokenTransaction\x12c\n" +
 	"\x1ctoken_transaction_signatures\x18\x02 \x01(\v2!.spark.TokenTransactionSignaturesR\x1atokenTransactionSignatures\x12!\n" +
 	"\fkeyshare_ids\x18\x03 \x03(\tR\vkeyshareIds\x124\n" +
 	"\x16coordinator_public_key\x18\n" +
-	" \x01(\fR\x14coordinatorPublicKey\"x\n" +
+	" \x01(\fR\x14coordinatorPublicKey\x120\n" +
+	"\x14spark_payment_intent\x18\v \x01(\tR\x12sparkPaymentIntent\"x\n" +
 	"%StartTokenTransactionInternalResponse\x12O\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x17.spark.TokenTransactionR\x15finalTokenTransaction\"\xcc\x03\n" +
 	"%InitiateSettleReceiverKeyTweakRequest\x12\x1f\n" +
@@ -3329,12 +4134,14 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"s\n" +
 	"\x1fResolveLeafInvestigationRequest\x12\"\n" +
 	"\rlost_leaf_ids\x18\x01 \x03(\tR\vlostLeafIds\x12,\n" +
-	"\x12available_leaf_ids\x18\x02 \x03(\tR\x10availableLeafIds\"\xc4\x02\n" +
+	"\x12available_leaf_ids\x18\x02 \x03(\tR\x10availableLeafIds\"\xe5\x02\n" +
 	"\x16ProvidePreimageRequest\x12!\n" +
 	"\fpayment_hash\x18\x01 \x01(\fR\vpaymentHash\x12\x1a\n" +
 	"\bpreimage\x18\x02 \x01(\fR\bpreimage\x12.\n" +
 	"\x13identity_public_key\x18\x03 \x01(\fR\x11identityPublicKey\x12d\n" +
-	"\x10key_tweak_proofs\x18\x04 \x03(\v2:.spark_internal.ProvidePreimageRequest.KeyTweakProofsEntryR\x0ekeyTweakProofs\x1aU\n" +
+	"\x10key_tweak_proofs\x18\x04 \x03(\v2:.spark_internal.ProvidePreimageRequest.KeyTweakProofsEntryR\x0ekeyTweakProofs\x12\x1f\n" +
+	"\vtransfer_id\x18\x05 \x01(\tR\n" +
+	"transferId\x1aU\n" +
 	"\x13KeyTweakProofsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
 	"\x05value\x18\x02 \x01(\v2\x12.spark.SecretProofR\x05value:\x028\x01\"=\n" +
@@ -3368,12 +4175,70 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x129\n" +
 	"\x19owner_identity_public_key\x18\x03 \x01(\fR\x16ownerIdentityPublicKey\"Y\n" +
 	"*GenerateStaticDepositAddressProofsResponse\x12+\n" +
-	"\x11address_signature\x18\x01 \x01(\fR\x10addressSignature*:\n" +
+	"\x11address_signature\x18\x01 \x01(\fR\x10addressSignature\"H\n" +
+	"\x18QueryFailedGossipRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"i\n" +
+	"\x19QueryFailedGossipResponse\x124\n" +
+	"\x06gossip\x18\x01 \x03(\v2\x1c.spark_internal.FailedGossipR\x06gossip\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"\x81\x02\n" +
+	"\fFailedGossip\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\fR\amessage\x12M\n" +
+	"\fparticipants\x18\x03 \x03(\v2).spark_internal.GossipParticipantDeliveryR\fparticipants\x12;\n" +
+	"\vcreate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vexpiry_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expiryTime\"u\n" +
+	"\x19GossipParticipantDelivery\x12\x1e\n" +
+	"\n" +
+	"identifier\x18\x01 \x01(\tR\n" +
+	"identifier\x12\x1c\n" +
+	"\tdelivered\x18\x02 \x01(\bR\tdelivered\x12\x1a\n" +
+	"\battempts\x18\x03 \x01(\rR\battempts\":\n" +
+	"\x19ReplayFailedGossipRequest\x12\x1d\n" +
+	"\n" +
+	"gossip_ids\x18\x01 \x03(\tR\tgossipIds\"x\n" +
+	"\x11ConsistencyEntity\x12F\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2%.spark_internal.ConsistencyEntityTypeR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"`\n" +
+	"\x1fQueryConsistencySnapshotRequest\x12=\n" +
+	"\bentities\x18\x01 \x03(\v2!.spark_internal.ConsistencyEntityR\bentities\"Y\n" +
+	"\x13ConsistencySnapshot\x12B\n" +
+	"\bentities\x18\x01 \x03(\v2&.spark_internal.ConsistencyEntityStateR\bentities\"\xda\x01\n" +
+	"\x16ConsistencyEntityState\x129\n" +
+	"\x06entity\x18\x01 \x01(\v2!.spark_internal.ConsistencyEntityR\x06entity\x12J\n" +
+	"\x06fields\x18\x02 \x03(\v22.spark_internal.ConsistencyEntityState.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"X\n" +
+	"\x17AuditConsistencyRequest\x12=\n" +
+	"\bentities\x18\x01 \x03(\v2!.spark_internal.ConsistencyEntityR\bentities\"\xdc\x01\n" +
+	"\x11ConsistencyReport\x129\n" +
+	"\n" +
+	"audit_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tauditTime\x12\x18\n" +
+	"\aauditor\x18\x02 \x01(\tR\aauditor\x12)\n" +
+	"\x10entities_checked\x18\x03 \x01(\rR\x0fentitiesChecked\x12G\n" +
+	"\vdivergences\x18\x04 \x03(\v2%.spark_internal.ConsistencyDivergenceR\vdivergences\"\xee\x01\n" +
+	"\x15ConsistencyDivergence\x129\n" +
+	"\x06entity\x18\x01 \x01(\v2!.spark_internal.ConsistencyEntityR\x06entity\x12\x14\n" +
+	"\x05field\x18\x02 \x01(\tR\x05field\x12I\n" +
+	"\x06values\x18\x03 \x03(\v21.spark_internal.ConsistencyDivergence.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*:\n" +
 	"\x14SettleKeyTweakAction\x12\b\n" +
 	"\x04NONE\x10\x00\x12\n" +
 	"\n" +
 	"\x06COMMIT\x10\x01\x12\f\n" +
-	"\bROLLBACK\x10\x022\xc8\x1c\n" +
+	"\bROLLBACK\x10\x02*\xe5\x01\n" +
+	"\x15ConsistencyEntityType\x12'\n" +
+	"#CONSISTENCY_ENTITY_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" CONSISTENCY_ENTITY_TYPE_TRANSFER\x10\x01\x12%\n" +
+	"!CONSISTENCY_ENTITY_TYPE_TREE_NODE\x10\x02\x12(\n" +
+	"$CONSISTENCY_ENTITY_TYPE_TOKEN_OUTPUT\x10\x03\x12,\n" +
+	"(CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE\x10\x042\xcf \n" +
 	"\x14SparkInternalService\x12^\n" +
 	"\x16mark_keyshares_as_used\x12*.spark_internal.MarkKeysharesAsUsedRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\x92\x01\n" +
 	"!mark_keyshare_for_deposit_address\x124.spark_internal.MarkKeyshareForDepositAddressRequest\x1a5.spark_internal.MarkKeyshareForDepositAddressResponse\"\x00\x12^\n" +
@@ -3388,7 +4253,8 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x10provide_preimage\x12&.spark_internal.ProvidePreimageRequest\x1a\x16.google.protobuf.Empty\"\x00\x12a\n" +
 	"\x17update_preimage_request\x12,.spark_internal.UpdatePreimageRequestRequest\x1a\x16.google.protobuf.Empty\"\x00\x12o\n" +
 	"\x14prepare_tree_address\x12).spark_internal.PrepareTreeAddressRequest\x1a*.spark_internal.PrepareTreeAddressResponse\"\x00\x12V\n" +
-	"\x11initiate_transfer\x12'.spark_internal.InitiateTransferRequest\x1a\x16.google.protobuf.Empty\"\x00\x12b\n" +
+	"\x11initiate_transfer\x12'.spark_internal.InitiateTransferRequest\x1a\x16.google.protobuf.Empty\"\x00\x12a\n" +
+	"\x17initiate_batch_transfer\x12,.spark_internal.InitiateBatchTransferRequest\x1a\x16.google.protobuf.Empty\"\x00\x12b\n" +
 	"\x18deliver_sender_key_tweak\x12,.spark_internal.DeliverSenderKeyTweakRequest\x1a\x16.google.protobuf.Empty\"\x00\x12e\n" +
 	"\x19initiate_cooperative_exit\x12..spark_internal.InitiateCooperativeExitRequest\x1a\x16.google.protobuf.Empty\"\x00\x12Z\n" +
 	"\x18return_lightning_payment\x12$.spark.ReturnLightningPaymentRequest\x1a\x16.google.protobuf.Empty\"\x00\x12r\n" +
@@ -3408,7 +4274,11 @@ const file_spark_internal_proto_rawDesc = "" +
 	"\x13fix_keyshare_round1\x12(.spark_internal.FixKeyshareRound1Request\x1a).spark_internal.FixKeyshareRound1Response\"\x00\x12l\n" +
 	"\x13fix_keyshare_round2\x12(.spark_internal.FixKeyshareRound2Request\x1a).spark_internal.FixKeyshareRound2Response\"\x00\x12\\\n" +
 	"\rget_transfers\x12#.spark_internal.GetTransfersRequest\x1a$.spark_internal.GetTransfersResponse\"\x00\x12\xa1\x01\n" +
-	"&generate_static_deposit_address_proofs\x129.spark_internal.GenerateStaticDepositAddressProofsRequest\x1a:.spark_internal.GenerateStaticDepositAddressProofsResponse\"\x00B5Z3github.com/lightsparkdev/spark/proto/spark_internalb\x06proto3"
+	"&generate_static_deposit_address_proofs\x129.spark_internal.GenerateStaticDepositAddressProofsRequest\x1a:.spark_internal.GenerateStaticDepositAddressProofsResponse\"\x00\x12l\n" +
+	"\x13query_failed_gossip\x12(.spark_internal.QueryFailedGossipRequest\x1a).spark_internal.QueryFailedGossipResponse\"\x00\x12[\n" +
+	"\x14replay_failed_gossip\x12).spark_internal.ReplayFailedGossipRequest\x1a\x16.google.protobuf.Empty\"\x00\x12t\n" +
+	"\x1aquery_consistency_snapshot\x12/.spark_internal.QueryConsistencySnapshotRequest\x1a#.spark_internal.ConsistencySnapshot\"\x00\x12a\n" +
+	"\x11audit_consistency\x12'.spark_internal.AuditConsistencyRequest\x1a!.spark_internal.ConsistencyReport\"\x00B5Z3github.com/lightsparkdev/spark/proto/spark_internalb\x06proto3"
 
 var (
 	file_spark_internal_proto_rawDescOnce sync.Once
@@ -3422,220 +4292,261 @@ func file_spark_internal_proto_rawDescGZIP() []byte {
 	return file_spark_internal_proto_rawDescData
 }
 
-var file_spark_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_spark_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_spark_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_spark_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_spark_internal_proto_goTypes = []any{
 	(SettleKeyTweakAction)(0),                              // 0: spark_internal.SettleKeyTweakAction
-	(*MarkKeysharesAsUsedRequest)(nil),                     // 1: spark_internal.MarkKeysharesAsUsedRequest
-	(*MarkKeyshareForDepositAddressRequest)(nil),           // 2: spark_internal.MarkKeyshareForDepositAddressRequest
-	(*MarkKeyshareForDepositAddressResponse)(nil),          // 3: spark_internal.MarkKeyshareForDepositAddressResponse
-	(*FrostRound1Request)(nil),                             // 4: spark_internal.FrostRound1Request
-	(*FrostRound1Response)(nil),                            // 5: spark_internal.FrostRound1Response
-	(*SigningJob)(nil),                                     // 6: spark_internal.SigningJob
-	(*FrostRound2Request)(nil),                             // 7: spark_internal.FrostRound2Request
-	(*FrostRound2Response)(nil),                            // 8: spark_internal.FrostRound2Response
-	(*FinalizeTreeCreationRequest)(nil),                    // 9: spark_internal.FinalizeTreeCreationRequest
-	(*FinalizeTransferRequest)(nil),                        // 10: spark_internal.FinalizeTransferRequest
-	(*FinalizeRefreshTimelockRequest)(nil),                 // 11: spark_internal.FinalizeRefreshTimelockRequest
-	(*FinalizeExtendLeafRequest)(nil),                      // 12: spark_internal.FinalizeExtendLeafRequest
-	(*TreeNode)(nil),                                       // 13: spark_internal.TreeNode
-	(*InitiatePreimageSwapResponse)(nil),                   // 14: spark_internal.InitiatePreimageSwapResponse
-	(*PrepareTreeAddressNode)(nil),                         // 15: spark_internal.PrepareTreeAddressNode
-	(*PrepareTreeAddressRequest)(nil),                      // 16: spark_internal.PrepareTreeAddressRequest
-	(*PrepareTreeAddressResponse)(nil),                     // 17: spark_internal.PrepareTreeAddressResponse
-	(*InitiateTransferLeaf)(nil),                           // 18: spark_internal.InitiateTransferLeaf
-	(*InitiateTransferRequest)(nil),                        // 19: spark_internal.InitiateTransferRequest
-	(*DeliverSenderKeyTweakRequest)(nil),                   // 20: spark_internal.DeliverSenderKeyTweakRequest
-	(*InitiateCooperativeExitRequest)(nil),                 // 21: spark_internal.InitiateCooperativeExitRequest
-	(*UpdatePreimageRequestRequest)(nil),                   // 22: spark_internal.UpdatePreimageRequestRequest
-	(*StartTokenTransactionInternalRequest)(nil),           // 23: spark_internal.StartTokenTransactionInternalRequest
-	(*StartTokenTransactionInternalResponse)(nil),          // 24: spark_internal.StartTokenTransactionInternalResponse
-	(*InitiateSettleReceiverKeyTweakRequest)(nil),          // 25: spark_internal.InitiateSettleReceiverKeyTweakRequest
-	(*SettleReceiverKeyTweakRequest)(nil),                  // 26: spark_internal.SettleReceiverKeyTweakRequest
-	(*SettleSenderKeyTweakRequest)(nil),                    // 27: spark_internal.SettleSenderKeyTweakRequest
-	(*CreateUtxoSwapRequest)(nil),                          // 28: spark_internal.CreateUtxoSwapRequest
-	(*CreateUtxoSwapResponse)(nil),                         // 29: spark_internal.CreateUtxoSwapResponse
-	(*InitiateStaticDepositUtxoSwapRequest)(nil),           // 30: spark_internal.InitiateStaticDepositUtxoSwapRequest
-	(*CreateStaticDepositUtxoSwapRequest)(nil),             // 31: spark_internal.CreateStaticDepositUtxoSwapRequest
-	(*CreateStaticDepositUtxoSwapResponse)(nil),            // 32: spark_internal.CreateStaticDepositUtxoSwapResponse
-	(*CreateStaticDepositUtxoRefundRequest)(nil),           // 33: spark_internal.CreateStaticDepositUtxoRefundRequest
-	(*CreateStaticDepositUtxoRefundResponse)(nil),          // 34: spark_internal.CreateStaticDepositUtxoRefundResponse
-	(*RollbackUtxoSwapRequest)(nil),                        // 35: spark_internal.RollbackUtxoSwapRequest
-	(*RollbackUtxoSwapResponse)(nil),                       // 36: spark_internal.RollbackUtxoSwapResponse
-	(*UtxoSwapCompletedRequest)(nil),                       // 37: spark_internal.UtxoSwapCompletedRequest
-	(*UtxoSwapCompletedResponse)(nil),                      // 38: spark_internal.UtxoSwapCompletedResponse
-	(*CancelOrFinalizeExpiredTokenTransactionRequest)(nil), // 39: spark_internal.CancelOrFinalizeExpiredTokenTransactionRequest
-	(*QueryLeafSigningPubkeysRequest)(nil),                 // 40: spark_internal.QueryLeafSigningPubkeysRequest
-	(*QueryLeafSigningPubkeysResponse)(nil),                // 41: spark_internal.QueryLeafSigningPubkeysResponse
-	(*ResolveLeafInvestigationRequest)(nil),                // 42: spark_internal.ResolveLeafInvestigationRequest
-	(*ProvidePreimageRequest)(nil),                         // 43: spark_internal.ProvidePreimageRequest
-	(*ReserveEntityDkgKeyRequest)(nil),                     // 44: spark_internal.ReserveEntityDkgKeyRequest
-	(*FixKeyshareRequest)(nil),                             // 45: spark_internal.FixKeyshareRequest
-	(*FixKeyshareRound1Request)(nil),                       // 46: spark_internal.FixKeyshareRound1Request
-	(*FixKeyshareRound1Response)(nil),                      // 47: spark_internal.FixKeyshareRound1Response
-	(*FixKeyshareRound2Request)(nil),                       // 48: spark_internal.FixKeyshareRound2Request
-	(*FixKeyshareRound2Response)(nil),                      // 49: spark_internal.FixKeyshareRound2Response
-	(*GetTransfersRequest)(nil),                            // 50: spark_internal.GetTransfersRequest
-	(*GetTransfersResponse)(nil),                           // 51: spark_internal.GetTransfersResponse
-	(*GenerateStaticDepositAddressProofsRequest)(nil),      // 52: spark_internal.GenerateStaticDepositAddressProofsRequest
-	(*GenerateStaticDepositAddressProofsResponse)(nil),     // 53: spark_internal.GenerateStaticDepositAddressProofsResponse
-	nil,                                      // 54: spark_internal.FrostRound1Request.PublicKeysEntry
-	nil,                                      // 55: spark_internal.SigningJob.CommitmentsEntry
-	nil,                                      // 56: spark_internal.FrostRound2Response.ResultsEntry
-	nil,                                      // 57: spark_internal.PrepareTreeAddressResponse.SignaturesEntry
-	nil,                                      // 58: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
-	nil,                                      // 59: spark_internal.InitiateTransferRequest.RefundSignaturesEntry
-	nil,                                      // 60: spark_internal.InitiateTransferRequest.DirectRefundSignaturesEntry
-	nil,                                      // 61: spark_internal.InitiateTransferRequest.DirectFromCpfpRefundSignaturesEntry
-	nil,                                      // 62: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
-	nil,                                      // 63: spark_internal.InitiateSettleReceiverKeyTweakRequest.UserPublicKeysEntry
-	nil,                                      // 64: spark_internal.QueryLeafSigningPubkeysResponse.SigningPubkeysEntry
-	nil,                                      // 65: spark_internal.ProvidePreimageRequest.KeyTweakProofsEntry
-	(*common.SigningCommitment)(nil),         // 66: common.SigningCommitment
-	(spark.Network)(0),                       // 67: spark.Network
-	(*timestamppb.Timestamp)(nil),            // 68: google.protobuf.Timestamp
-	(spark.TransferType)(0),                  // 69: spark.TransferType
-	(*spark.TransferPackage)(nil),            // 70: spark.TransferPackage
-	(*spark.TokenTransaction)(nil),           // 71: spark.TokenTransaction
-	(*spark.TokenTransactionSignatures)(nil), // 72: spark.TokenTransactionSignatures
-	(*spark.InitiateUtxoSwapRequest)(nil),    // 73: spark.InitiateUtxoSwapRequest
-	(*spark.UTXO)(nil),                       // 74: spark.UTXO
-	(*spark.StartTransferRequest)(nil),       // 75: spark.StartTransferRequest
-	(*spark.SigningJob)(nil),                 // 76: spark.SigningJob
-	(*spark.InitiateStaticDepositUtxoRefundRequest)(nil), // 77: spark.InitiateStaticDepositUtxoRefundRequest
-	(*spark.Transfer)(nil),                               // 78: spark.Transfer
-	(*common.SigningResult)(nil),                         // 79: common.SigningResult
-	(*spark.SecretProof)(nil),                            // 80: spark.SecretProof
-	(*spark.InitiatePreimageSwapRequest)(nil),            // 81: spark.InitiatePreimageSwapRequest
-	(*spark.ReturnLightningPaymentRequest)(nil),          // 82: spark.ReturnLightningPaymentRequest
-	(*spark.QueryTokenOutputsRequest)(nil),               // 83: spark.QueryTokenOutputsRequest
-	(*emptypb.Empty)(nil),                                // 84: google.protobuf.Empty
-	(*spark.QueryTokenOutputsResponse)(nil),              // 85: spark.QueryTokenOutputsResponse
+	(ConsistencyEntityType)(0),                             // 1: spark_internal.ConsistencyEntityType
+	(*MarkKeysharesAsUsedRequest)(nil),                     // 2: spark_internal.MarkKeysharesAsUsedRequest
+	(*MarkKeyshareForDepositAddressRequest)(nil),           // 3: spark_internal.MarkKeyshareForDepositAddressRequest
+	(*MarkKeyshareForDepositAddressResponse)(nil),          // 4: spark_internal.MarkKeyshareForDepositAddressResponse
+	(*FrostRound1Request)(nil),                             // 5: spark_internal.FrostRound1Request
+	(*FrostRound1Response)(nil),                            // 6: spark_internal.FrostRound1Response
+	(*SigningJob)(nil),                                     // 7: spark_internal.SigningJob
+	(*FrostRound2Request)(nil),                             // 8: spark_internal.FrostRound2Request
+	(*FrostRound2Response)(nil),                            // 9: spark_internal.FrostRound2Response
+	(*FinalizeTreeCreationRequest)(nil),                    // 10: spark_internal.FinalizeTreeCreationRequest
+	(*FinalizeTransferRequest)(nil),                        // 11: spark_internal.FinalizeTransferRequest
+	(*FinalizeRefreshTimelockRequest)(nil),                 // 12: spark_internal.FinalizeRefreshTimelockRequest
+	(*FinalizeExtendLeafRequest)(nil),                      // 13: spark_internal.FinalizeExtendLeafRequest
+	(*TreeNode)(nil),                                       // 14: spark_internal.TreeNode
+	(*InitiatePreimageSwapResponse)(nil),                   // 15: spark_internal.InitiatePreimageSwapResponse
+	(*PrepareTreeAddressNode)(nil),                         // 16: spark_internal.PrepareTreeAddressNode
+	(*PrepareTreeAddressRequest)(nil),                      // 17: spark_internal.PrepareTreeAddressRequest
+	(*PrepareTreeAddressResponse)(nil),                     // 18: spark_internal.PrepareTreeAddressResponse
+	(*InitiateTransferLeaf)(nil),                           // 19: spark_internal.InitiateTransferLeaf
+	(*InitiateTransferRequest)(nil),                        // 20: spark_internal.InitiateTransferRequest
+	(*InitiateBatchTransferRequest)(nil),                   // 21: spark_internal.InitiateBatchTransferRequest
+	(*DeliverSenderKeyTweakRequest)(nil),                   // 22: spark_internal.DeliverSenderKeyTweakRequest
+	(*InitiateCooperativeExitRequest)(nil),                 // 23: spark_internal.InitiateCooperativeExitRequest
+	(*UpdatePreimageRequestRequest)(nil),                   // 24: spark_internal.UpdatePreimageRequestRequest
+	(*StartTokenTransactionInternalRequest)(nil),           // 25: spark_internal.StartTokenTransactionInternalRequest
+	(*StartTokenTransactionInternalResponse)(nil),          // 26: spark_internal.StartTokenTransactionInternalResponse
+	(*InitiateSettleReceiverKeyTweakRequest)(nil),          // 27: spark_internal.InitiateSettleReceiverKeyTweakRequest
+	(*SettleReceiverKeyTweakRequest)(nil),                  // 28: spark_internal.SettleReceiverKeyTweakRequest
+	(*SettleSenderKeyTweakRequest)(nil),                    // 29: spark_internal.SettleSenderKeyTweakRequest
+	(*CreateUtxoSwapRequest)(nil),                          // 30: spark_internal.CreateUtxoSwapRequest
+	(*CreateUtxoSwapResponse)(nil),                         // 31: spark_internal.CreateUtxoSwapResponse
+	(*InitiateStaticDepositUtxoSwapRequest)(nil),           // 32: spark_internal.InitiateStaticDepositUtxoSwapRequest
+	(*CreateStaticDepositUtxoSwapRequest)(nil),             // 33: spark_internal.CreateStaticDepositUtxoSwapRequest
+	(*CreateStaticDepositUtxoSwapResponse)(nil),            // 34: spark_internal.CreateStaticDepositUtxoSwapResponse
+	(*CreateStaticDepositUtxoRefundRequest)(nil),           // 35: spark_internal.CreateStaticDepositUtxoRefundRequest
+	(*CreateStaticDepositUtxoRefundResponse)(nil),          // 36: spark_internal.CreateStaticDepositUtxoRefundResponse
+	(*RollbackUtxoSwapRequest)(nil),                        // 37: spark_internal.RollbackUtxoSwapRequest
+	(*RollbackUtxoSwapResponse)(nil),                       // 38: spark_internal.RollbackUtxoSwapResponse
+	(*UtxoSwapCompletedRequest)(nil),                       // 39: spark_internal.UtxoSwapCompletedRequest
+	(*UtxoSwapCompletedResponse)(nil),                      // 40: spark_internal.UtxoSwapCompletedResponse
+	(*CancelOrFinalizeExpiredTokenTransactionRequest)(nil), // 41: spark_internal.CancelOrFinalizeExpiredTokenTransactionRequest
+	(*QueryLeafSigningPubkeysRequest)(nil),                 // 42: spark_internal.QueryLeafSigningPubkeysRequest
+	(*QueryLeafSigningPubkeysResponse)(nil),                // 43: spark_internal.QueryLeafSigningPubkeysResponse
+	(*ResolveLeafInvestigationRequest)(nil),                // 44: spark_internal.ResolveLeafInvestigationRequest
+	(*ProvidePreimageRequest)(nil),                         // 45: spark_internal.ProvidePreimageRequest
+	(*ReserveEntityDkgKeyRequest)(nil),                     // 46: spark_internal.ReserveEntityDkgKeyRequest
+	(*FixKeyshareRequest)(nil),                             // 47: spark_internal.FixKeyshareRequest
+	(*FixKeyshareRound1Request)(nil),                       // 48: spark_internal.FixKeyshareRound1Request
+	(*FixKeyshareRound1Response)(nil),                      // 49: spark_internal.FixKeyshareRound1Response
+	(*FixKeyshareRound2Request)(nil),                       // 50: spark_internal.FixKeyshareRound2Request
+	(*FixKeyshareRound2Response)(nil),                      // 51: spark_internal.FixKeyshareRound2Response
+	(*GetTransfersRequest)(nil),                            // 52: spark_internal.GetTransfersRequest
+	(*GetTransfersResponse)(nil),                           // 53: spark_internal.GetTransfersResponse
+	(*GenerateStaticDepositAddressProofsRequest)(nil),      // 54: spark_internal.GenerateStaticDepositAddressProofsRequest
+	(*GenerateStaticDepositAddressProofsResponse)(nil),     // 55: spark_internal.GenerateStaticDepositAddressProofsResponse
+	(*QueryFailedGossipRequest)(nil),                       // 56: spark_internal.QueryFailedGossipRequest
+	(*QueryFailedGossipResponse)(nil),                      // 57: spark_internal.QueryFailedGossipResponse
+	(*FailedGossip)(nil),                                   // 58: spark_internal.FailedGossip
+	(*GossipParticipantDelivery)(nil),                      // 59: spark_internal.GossipParticipantDelivery
+	(*ReplayFailedGossipRequest)(nil),                      // 60: spark_internal.ReplayFailedGossipRequest
+	(*ConsistencyEntity)(nil),                              // 61: spark_internal.ConsistencyEntity
+	(*QueryConsistencySnapshotRequest)(nil),                // 62: spark_internal.QueryConsistencySnapshotRequest
+	(*ConsistencySnapshot)(nil),                            // 63: spark_internal.ConsistencySnapshot
+	(*ConsistencyEntityState)(nil),                         // 64: spark_internal.ConsistencyEntityState
+	(*AuditConsistencyRequest)(nil),                        // 65: spark_internal.AuditConsistencyRequest
+	(*ConsistencyReport)(nil),                              // 66: spark_internal.ConsistencyReport
+	(*ConsistencyDivergence)(nil),                          // 67: spark_internal.ConsistencyDivergence
+	nil,                                                    // 68: spark_internal.FrostRound1Request.PublicKeysEntry
+	nil,                                                    // 69: spark_internal.SigningJob.CommitmentsEntry
+	nil,                                                    // 70: spark_internal.FrostRound2Response.ResultsEntry
+	nil,                                                    // 71: spark_internal.PrepareTreeAddressResponse.SignaturesEntry
+	nil,                                                    // 72: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
+	nil,                                                    // 73: spark_internal.InitiateTransferRequest.RefundSignaturesEntry
+	nil,                                                    // 74: spark_internal.InitiateTransferRequest.DirectRefundSignaturesEntry
+	nil,                                                    // 75: spark_internal.InitiateTransferRequest.DirectFromCpfpRefundSignaturesEntry
+	nil,                                                    // 76: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
+	nil,                                                    // 77: spark_internal.InitiateSettleReceiverKeyTweakRequest.UserPublicKeysEntry
+	nil,                                                    // 78: spark_internal.QueryLeafSigningPubkeysResponse.SigningPubkeysEntry
+	nil,                                                    // 79: spark_internal.ProvidePreimageRequest.KeyTweakProofsEntry
+	nil,                                                    // 80: spark_internal.ConsistencyEntityState.FieldsEntry
+	nil,                                                    // 81: spark_internal.ConsistencyDivergence.ValuesEntry
+	(*common.SigningCommitment)(nil),                       // 82: common.SigningCommitment
+	(spark.Network)(0),                                     // 83: spark.Network
+	(*timestamppb.Timestamp)(nil),                          // 84: google.protobuf.Timestamp
+	(spark.TransferType)(0),                                // 85: spark.TransferType
+	(*spark.TransferPackage)(nil),                          // 86: spark.TransferPackage
+	(*spark.TokenTransaction)(nil),                         // 87: spark.TokenTransaction
+	(*spark.TokenTransactionSignatures)(nil),               // 88: spark.TokenTransactionSignatures
+	(*spark.InitiateUtxoSwapRequest)(nil),                  // 89: spark.InitiateUtxoSwapRequest
+	(*spark.UTXO)(nil),                                     // 90: spark.UTXO
+	(*spark.StartTransferRequest)(nil),                     // 91: spark.StartTransferRequest
+	(*spark.SigningJob)(nil),                               // 92: spark.SigningJob
+	(*spark.InitiateStaticDepositUtxoRefundRequest)(nil),   // 93: spark.InitiateStaticDepositUtxoRefundRequest
+	(*spark.Transfer)(nil),                                 // 94: spark.Transfer
+	(*common.SigningResult)(nil),                           // 95: common.SigningResult
+	(*spark.SecretProof)(nil),                              // 96: spark.SecretProof
+	(*spark.InitiatePreimageSwapRequest)(nil),              // 97: spark.InitiatePreimageSwapRequest
+	(*spark.ReturnLightningPaymentRequest)(nil),            // 98: spark.ReturnLightningPaymentRequest
+	(*spark.QueryTokenOutputsRequest)(nil),                 // 99: spark.QueryTokenOutputsRequest
+	(*emptypb.Empty)(nil),                                  // 100: google.protobuf.Empty
+	(*spark.QueryTokenOutputsResponse)(nil),                // 101: spark.QueryTokenOutputsResponse
 }
 var file_spark_internal_proto_depIdxs = []int32{
-	54, // 0: spark_internal.FrostRound1Request.public_keys:type_name -> spark_internal.FrostRound1Request.PublicKeysEntry
-	66, // 1: spark_internal.FrostRound1Response.signing_commitments:type_name -> common.SigningCommitment
-	55, // 2: spark_internal.SigningJob.commitments:type_name -> spark_internal.SigningJob.CommitmentsEntry
-	66, // 3: spark_internal.SigningJob.user_commitments:type_name -> common.SigningCommitment
-	6,  // 4: spark_internal.FrostRound2Request.signing_jobs:type_name -> spark_internal.SigningJob
-	56, // 5: spark_internal.FrostRound2Response.results:type_name -> spark_internal.FrostRound2Response.ResultsEntry
-	13, // 6: spark_internal.FinalizeTreeCreationRequest.nodes:type_name -> spark_internal.TreeNode
-	67, // 7: spark_internal.FinalizeTreeCreationRequest.network:type_name -> spark.Network
-	13, // 8: spark_internal.FinalizeTransferRequest.nodes:type_name -> spark_internal.TreeNode
-	68, // 9: spark_internal.FinalizeTransferRequest.timestamp:type_name -> google.protobuf.Timestamp
-	13, // 10: spark_internal.FinalizeRefreshTimelockRequest.nodes:type_name -> spark_internal.TreeNode
-	13, // 11: spark_internal.FinalizeExtendLeafRequest.node:type_name -> spark_internal.TreeNode
-	15, // 12: spark_internal.PrepareTreeAddressNode.children:type_name -> spark_internal.PrepareTreeAddressNode
-	15, // 13: spark_internal.PrepareTreeAddressRequest.node:type_name -> spark_internal.PrepareTreeAddressNode
-	67, // 14: spark_internal.PrepareTreeAddressRequest.network:type_name -> spark.Network
-	57, // 15: spark_internal.PrepareTreeAddressResponse.signatures:type_name -> spark_internal.PrepareTreeAddressResponse.SignaturesEntry
-	68, // 16: spark_internal.InitiateTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
-	18, // 17: spark_internal.InitiateTransferRequest.leaves:type_name -> spark_internal.InitiateTransferLeaf
-	58, // 18: spark_internal.InitiateTransferRequest.sender_key_tweak_proofs:type_name -> spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
-	69, // 19: spark_internal.InitiateTransferRequest.type:type_name -> spark.TransferType
-	70, // 20: spark_internal.InitiateTransferRequest.transfer_package:type_name -> spark.TransferPackage
-	59, // 21: spark_internal.InitiateTransferRequest.refund_signatures:type_name -> spark_internal.InitiateTransferRequest.RefundSignaturesEntry
-	60, // 22: spark_internal.InitiateTransferRequest.direct_refund_signatures:type_name -> spark_internal.InitiateTransferRequest.DirectRefundSignaturesEntry
-	61, // 23: spark_internal.InitiateTransferRequest.direct_from_cpfp_refund_signatures:type_name -> spark_internal.InitiateTransferRequest.DirectFromCpfpRefundSignaturesEntry
-	70, // 24: spark_internal.DeliverSenderKeyTweakRequest.transfer_package:type_name -> spark.TransferPackage
-	19, // 25: spark_internal.InitiateCooperativeExitRequest.transfer:type_name -> spark_internal.InitiateTransferRequest
-	71, // 26: spark_internal.StartTokenTransactionInternalRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	72, // 27: spark_internal.StartTokenTransactionInternalRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
-	71, // 28: spark_internal.StartTokenTransactionInternalResponse.final_token_transaction:type_name -> spark.TokenTransaction
-	62, // 29: spark_internal.InitiateSettleReceiverKeyTweakRequest.key_tweak_proofs:type_name -> spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
-	63, // 30: spark_internal.InitiateSettleReceiverKeyTweakRequest.user_public_keys:type_name -> spark_internal.InitiateSettleReceiverKeyTweakRequest.UserPublicKeysEntry
-	0,  // 31: spark_internal.SettleReceiverKeyTweakRequest.action:type_name -> spark_internal.SettleKeyTweakAction
-	0,  // 32: spark_internal.SettleSenderKeyTweakRequest.action:type_name -> spark_internal.SettleKeyTweakAction
-	73, // 33: spark_internal.CreateUtxoSwapRequest.request:type_name -> spark.InitiateUtxoSwapRequest
-	74, // 34: spark_internal.InitiateStaticDepositUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
-	75, // 35: spark_internal.InitiateStaticDepositUtxoSwapRequest.transfer:type_name -> spark.StartTransferRequest
-	76, // 36: spark_internal.InitiateStaticDepositUtxoSwapRequest.spend_tx_signing_job:type_name -> spark.SigningJob
-	30, // 37: spark_internal.CreateStaticDepositUtxoSwapRequest.request:type_name -> spark_internal.InitiateStaticDepositUtxoSwapRequest
-	77, // 38: spark_internal.CreateStaticDepositUtxoRefundRequest.request:type_name -> spark.InitiateStaticDepositUtxoRefundRequest
-	74, // 39: spark_internal.RollbackUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
-	74, // 40: spark_internal.UtxoSwapCompletedRequest.on_chain_utxo:type_name -> spark.UTXO
-	71, // 41: spark_internal.CancelOrFinalizeExpiredTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
-	64, // 42: spark_internal.QueryLeafSigningPubkeysResponse.signing_pubkeys:type_name -> spark_internal.QueryLeafSigningPubkeysResponse.SigningPubkeysEntry
-	65, // 43: spark_internal.ProvidePreimageRequest.key_tweak_proofs:type_name -> spark_internal.ProvidePreimageRequest.KeyTweakProofsEntry
-	78, // 44: spark_internal.GetTransfersResponse.transfers:type_name -> spark.Transfer
-	66, // 45: spark_internal.SigningJob.CommitmentsEntry.value:type_name -> common.SigningCommitment
-	79, // 46: spark_internal.FrostRound2Response.ResultsEntry.value:type_name -> common.SigningResult
-	80, // 47: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	80, // 48: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	80, // 49: spark_internal.ProvidePreimageRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
-	1,  // 50: spark_internal.SparkInternalService.mark_keyshares_as_used:input_type -> spark_internal.MarkKeysharesAsUsedRequest
-	2,  // 51: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:input_type -> spark_internal.MarkKeyshareForDepositAddressRequest
-	44, // 52: spark_internal.SparkInternalService.reserve_entity_dkg_key:input_type -> spark_internal.ReserveEntityDkgKeyRequest
-	9,  // 53: spark_internal.SparkInternalService.finalize_tree_creation:input_type -> spark_internal.FinalizeTreeCreationRequest
-	4,  // 54: spark_internal.SparkInternalService.frost_round1:input_type -> spark_internal.FrostRound1Request
-	7,  // 55: spark_internal.SparkInternalService.frost_round2:input_type -> spark_internal.FrostRound2Request
-	10, // 56: spark_internal.SparkInternalService.finalize_transfer:input_type -> spark_internal.FinalizeTransferRequest
-	11, // 57: spark_internal.SparkInternalService.finalize_refresh_timelock:input_type -> spark_internal.FinalizeRefreshTimelockRequest
-	12, // 58: spark_internal.SparkInternalService.finalize_extend_leaf:input_type -> spark_internal.FinalizeExtendLeafRequest
-	81, // 59: spark_internal.SparkInternalService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
-	43, // 60: spark_internal.SparkInternalService.provide_preimage:input_type -> spark_internal.ProvidePreimageRequest
-	22, // 61: spark_internal.SparkInternalService.update_preimage_request:input_type -> spark_internal.UpdatePreimageRequestRequest
-	16, // 62: spark_internal.SparkInternalService.prepare_tree_address:input_type -> spark_internal.PrepareTreeAddressRequest
-	19, // 63: spark_internal.SparkInternalService.initiate_transfer:input_type -> spark_internal.InitiateTransferRequest
-	20, // 64: spark_internal.SparkInternalService.deliver_sender_key_tweak:input_type -> spark_internal.DeliverSenderKeyTweakRequest
-	21, // 65: spark_internal.SparkInternalService.initiate_cooperative_exit:input_type -> spark_internal.InitiateCooperativeExitRequest
-	82, // 66: spark_internal.SparkInternalService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
-	23, // 67: spark_internal.SparkInternalService.start_token_transaction_internal:input_type -> spark_internal.StartTokenTransactionInternalRequest
-	83, // 68: spark_internal.SparkInternalService.query_token_outputs_internal:input_type -> spark.QueryTokenOutputsRequest
-	25, // 69: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:input_type -> spark_internal.InitiateSettleReceiverKeyTweakRequest
-	26, // 70: spark_internal.SparkInternalService.settle_receiver_key_tweak:input_type -> spark_internal.SettleReceiverKeyTweakRequest
-	27, // 71: spark_internal.SparkInternalService.settle_sender_key_tweak:input_type -> spark_internal.SettleSenderKeyTweakRequest
-	28, // 72: spark_internal.SparkInternalService.create_utxo_swap:input_type -> spark_internal.CreateUtxoSwapRequest
-	31, // 73: spark_internal.SparkInternalService.create_static_deposit_utxo_swap:input_type -> spark_internal.CreateStaticDepositUtxoSwapRequest
-	33, // 74: spark_internal.SparkInternalService.create_static_deposit_utxo_refund:input_type -> spark_internal.CreateStaticDepositUtxoRefundRequest
-	35, // 75: spark_internal.SparkInternalService.rollback_utxo_swap:input_type -> spark_internal.RollbackUtxoSwapRequest
-	37, // 76: spark_internal.SparkInternalService.utxo_swap_completed:input_type -> spark_internal.UtxoSwapCompletedRequest
-	40, // 77: spark_internal.SparkInternalService.query_leaf_signing_pubkeys:input_type -> spark_internal.QueryLeafSigningPubkeysRequest
-	42, // 78: spark_internal.SparkInternalService.resolve_leaf_investigation:input_type -> spark_internal.ResolveLeafInvestigationRequest
-	45, // 79: spark_internal.SparkInternalService.fix_keyshare:input_type -> spark_internal.FixKeyshareRequest
-	46, // 80: spark_internal.SparkInternalService.fix_keyshare_round1:input_type -> spark_internal.FixKeyshareRound1Request
-	48, // 81: spark_internal.SparkInternalService.fix_keyshare_round2:input_type -> spark_internal.FixKeyshareRound2Request
-	50, // 82: spark_internal.SparkInternalService.get_transfers:input_type -> spark_internal.GetTransfersRequest
-	52, // 83: spark_internal.SparkInternalService.generate_static_deposit_address_proofs:input_type -> spark_internal.GenerateStaticDepositAddressProofsRequest
-	84, // 84: spark_internal.SparkInternalService.mark_keyshares_as_used:output_type -> google.protobuf.Empty
-	3,  // 85: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:output_type -> spark_internal.MarkKeyshareForDepositAddressResponse
-	84, // 86: spark_internal.SparkInternalService.reserve_entity_dkg_key:output_type -> google.protobuf.Empty
-	84, // 87: spark_internal.SparkInternalService.finalize_tree_creation:output_type -> google.protobuf.Empty
-	5,  // 88: spark_internal.SparkInternalService.frost_round1:output_type -> spark_internal.FrostRound1Response
-	8,  // 89: spark_internal.SparkInternalService.frost_round2:output_type -> spark_internal.FrostRound2Response
-	84, // 90: spark_internal.SparkInternalService.finalize_transfer:output_type -> google.protobuf.Empty
-	84, // 91: spark_internal.SparkInternalService.finalize_refresh_timelock:output_type -> google.protobuf.Empty
-	84, // 92: spark_internal.SparkInternalService.finalize_extend_leaf:output_type -> google.protobuf.Empty
-	14, // 93: spark_internal.SparkInternalService.initiate_preimage_swap:output_type -> spark_internal.InitiatePreimageSwapResponse
-	84, // 94: spark_internal.SparkInternalService.provide_preimage:output_type -> google.protobuf.Empty
-	84, // 95: spark_internal.SparkInternalService.update_preimage_request:output_type -> google.protobuf.Empty
-	17, // 96: spark_internal.SparkInternalService.prepare_tree_address:output_type -> spark_internal.PrepareTreeAddressResponse
-	84, // 97: spark_internal.SparkInternalService.initiate_transfer:output_type -> google.protobuf.Empty
-	84, // 98: spark_internal.SparkInternalService.deliver_sender_key_tweak:output_type -> google.protobuf.Empty
-	84, // 99: spark_internal.SparkInternalService.initiate_cooperative_exit:output_type -> google.protobuf.Empty
-	84, // 100: spark_internal.SparkInternalService.return_lightning_payment:output_type -> google.protobuf.Empty
-	84, // 101: spark_internal.SparkInternalService.start_token_transaction_internal:output_type -> google.protobuf.Empty
-	85, // 102: spark_internal.SparkInternalService.query_token_outputs_internal:output_type -> spark.QueryTokenOutputsResponse
-	84, // 103: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:output_type -> google.protobuf.Empty
-	84, // 104: spark_internal.SparkInternalService.settle_receiver_key_tweak:output_type -> google.protobuf.Empty
-	84, // 105: spark_internal.SparkInternalService.settle_sender_key_tweak:output_type -> google.protobuf.Empty
-	29, // 106: spark_internal.SparkInternalService.create_utxo_swap:output_type -> spark_internal.CreateUtxoSwapResponse
-	32, // 107: spark_internal.SparkInternalService.create_static_deposit_utxo_swap:output_type -> spark_internal.CreateStaticDepositUtxoSwapResponse
-	34, // 108: spark_internal.SparkInternalService.create_static_deposit_utxo_refund:output_type -> spark_internal.CreateStaticDepositUtxoRefundResponse
-	36, // 109: spark_internal.SparkInternalService.rollback_utxo_swap:output_type -> spark_internal.RollbackUtxoSwapResponse
-	38, // 110: spark_internal.SparkInternalService.utxo_swap_completed:output_type -> spark_internal.UtxoSwapCompletedResponse
-	41, // 111: spark_internal.SparkInternalService.query_leaf_signing_pubkeys:output_type -> spark_internal.QueryLeafSigningPubkeysResponse
-	84, // 112: spark_internal.SparkInternalService.resolve_leaf_investigation:output_type -> google.protobuf.Empty
-	84, // 113: spark_internal.SparkInternalService.fix_keyshare:output_type -> google.protobuf.Empty
-	47, // 114: spark_internal.SparkInternalService.fix_keyshare_round1:output_type -> spark_internal.FixKeyshareRound1Response
-	49, // 115: spark_internal.SparkInternalService.fix_keyshare_round2:output_type -> spark_internal.FixKeyshareRound2Response
-	51, // 116: spark_internal.SparkInternalService.get_transfers:output_type -> spark_internal.GetTransfersResponse
-	53, // 117: spark_internal.SparkInternalService.generate_static_deposit_address_proofs:output_type -> spark_internal.GenerateStaticDepositAddressProofsResponse
-	84, // [84:118] is the sub-list for method output_type
-	50, // [50:84] is the sub-list for method input_type
-	50, // [50:50] is the sub-list for extension type_name
-	50, // [50:50] is the sub-list for extension extendee
-	0,  // [0:50] is the sub-list for field type_name
+	68,  // 0: spark_internal.FrostRound1Request.public_keys:type_name -> spark_internal.FrostRound1Request.PublicKeysEntry
+	82,  // 1: spark_internal.FrostRound1Response.signing_commitments:type_name -> common.SigningCommitment
+	69,  // 2: spark_internal.SigningJob.commitments:type_name -> spark_internal.SigningJob.CommitmentsEntry
+	82,  // 3: spark_internal.SigningJob.user_commitments:type_name -> common.SigningCommitment
+	7,   // 4: spark_internal.FrostRound2Request.signing_jobs:type_name -> spark_internal.SigningJob
+	70,  // 5: spark_internal.FrostRound2Response.results:type_name -> spark_internal.FrostRound2Response.ResultsEntry
+	14,  // 6: spark_internal.FinalizeTreeCreationRequest.nodes:type_name -> spark_internal.TreeNode
+	83,  // 7: spark_internal.FinalizeTreeCreationRequest.network:type_name -> spark.Network
+	14,  // 8: spark_internal.FinalizeTransferRequest.nodes:type_name -> spark_internal.TreeNode
+	84,  // 9: spark_internal.FinalizeTransferRequest.timestamp:type_name -> google.protobuf.Timestamp
+	14,  // 10: spark_internal.FinalizeRefreshTimelockRequest.nodes:type_name -> spark_internal.TreeNode
+	14,  // 11: spark_internal.FinalizeExtendLeafRequest.node:type_name -> spark_internal.TreeNode
+	16,  // 12: spark_internal.PrepareTreeAddressNode.children:type_name -> spark_internal.PrepareTreeAddressNode
+	16,  // 13: spark_internal.PrepareTreeAddressRequest.node:type_name -> spark_internal.PrepareTreeAddressNode
+	83,  // 14: spark_internal.PrepareTreeAddressRequest.network:type_name -> spark.Network
+	71,  // 15: spark_internal.PrepareTreeAddressResponse.signatures:type_name -> spark_internal.PrepareTreeAddressResponse.SignaturesEntry
+	84,  // 16: spark_internal.InitiateTransferRequest.expiry_time:type_name -> google.protobuf.Timestamp
+	19,  // 17: spark_internal.InitiateTransferRequest.leaves:type_name -> spark_internal.InitiateTransferLeaf
+	72,  // 18: spark_internal.InitiateTransferRequest.sender_key_tweak_proofs:type_name -> spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry
+	85,  // 19: spark_internal.InitiateTransferRequest.type:type_name -> spark.TransferType
+	86,  // 20: spark_internal.InitiateTransferRequest.transfer_package:type_name -> spark.TransferPackage
+	73,  // 21: spark_internal.InitiateTransferRequest.refund_signatures:type_name -> spark_internal.InitiateTransferRequest.RefundSignaturesEntry
+	74,  // 22: spark_internal.InitiateTransferRequest.direct_refund_signatures:type_name -> spark_internal.InitiateTransferRequest.DirectRefundSignaturesEntry
+	75,  // 23: spark_internal.InitiateTransferRequest.direct_from_cpfp_refund_signatures:type_name -> spark_internal.InitiateTransferRequest.DirectFromCpfpRefundSignaturesEntry
+	20,  // 24: spark_internal.InitiateBatchTransferRequest.transfers:type_name -> spark_internal.InitiateTransferRequest
+	86,  // 25: spark_internal.DeliverSenderKeyTweakRequest.transfer_package:type_name -> spark.TransferPackage
+	20,  // 26: spark_internal.InitiateCooperativeExitRequest.transfer:type_name -> spark_internal.InitiateTransferRequest
+	87,  // 27: spark_internal.StartTokenTransactionInternalRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	88,  // 28: spark_internal.StartTokenTransactionInternalRequest.token_transaction_signatures:type_name -> spark.TokenTransactionSignatures
+	87,  // 29: spark_internal.StartTokenTransactionInternalResponse.final_token_transaction:type_name -> spark.TokenTransaction
+	76,  // 30: spark_internal.InitiateSettleReceiverKeyTweakRequest.key_tweak_proofs:type_name -> spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry
+	77,  // 31: spark_internal.InitiateSettleReceiverKeyTweakRequest.user_public_keys:type_name -> spark_internal.InitiateSettleReceiverKeyTweakRequest.UserPublicKeysEntry
+	0,   // 32: spark_internal.SettleReceiverKeyTweakRequest.action:type_name -> spark_internal.SettleKeyTweakAction
+	0,   // 33: spark_internal.SettleSenderKeyTweakRequest.action:type_name -> spark_internal.SettleKeyTweakAction
+	89,  // 34: spark_internal.CreateUtxoSwapRequest.request:type_name -> spark.InitiateUtxoSwapRequest
+	90,  // 35: spark_internal.InitiateStaticDepositUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
+	91,  // 36: spark_internal.InitiateStaticDepositUtxoSwapRequest.transfer:type_name -> spark.StartTransferRequest
+	92,  // 37: spark_internal.InitiateStaticDepositUtxoSwapRequest.spend_tx_signing_job:type_name -> spark.SigningJob
+	32,  // 38: spark_internal.CreateStaticDepositUtxoSwapRequest.request:type_name -> spark_internal.InitiateStaticDepositUtxoSwapRequest
+	93,  // 39: spark_internal.CreateStaticDepositUtxoRefundRequest.request:type_name -> spark.InitiateStaticDepositUtxoRefundRequest
+	90,  // 40: spark_internal.RollbackUtxoSwapRequest.on_chain_utxo:type_name -> spark.UTXO
+	90,  // 41: spark_internal.UtxoSwapCompletedRequest.on_chain_utxo:type_name -> spark.UTXO
+	87,  // 42: spark_internal.CancelOrFinalizeExpiredTokenTransactionRequest.final_token_transaction:type_name -> spark.TokenTransaction
+	78,  // 43: spark_internal.QueryLeafSigningPubkeysResponse.signing_pubkeys:type_name -> spark_internal.QueryLeafSigningPubkeysResponse.SigningPubkeysEntry
+	79,  // 44: spark_internal.ProvidePreimageRequest.key_tweak_proofs:type_name -> spark_internal.ProvidePreimageRequest.KeyTweakProofsEntry
+	94,  // 45: spark_internal.GetTransfersResponse.transfers:type_name -> spark.Transfer
+	58,  // 46: spark_internal.QueryFailedGossipResponse.gossip:type_name -> spark_internal.FailedGossip
+	59,  // 47: spark_internal.FailedGossip.participants:type_name -> spark_internal.GossipParticipantDelivery
+	84,  // 48: spark_internal.FailedGossip.create_time:type_name -> google.protobuf.Timestamp
+	84,  // 49: spark_internal.FailedGossip.expiry_time:type_name -> google.protobuf.Timestamp
+	1,   // 50: spark_internal.ConsistencyEntity.entity_type:type_name -> spark_internal.ConsistencyEntityType
+	61,  // 51: spark_internal.QueryConsistencySnapshotRequest.entities:type_name -> spark_internal.ConsistencyEntity
+	64,  // 52: spark_internal.ConsistencySnapshot.entities:type_name -> spark_internal.ConsistencyEntityState
+	61,  // 53: spark_internal.ConsistencyEntityState.entity:type_name -> spark_internal.ConsistencyEntity
+	80,  // 54: spark_internal.ConsistencyEntityState.fields:type_name -> spark_internal.ConsistencyEntityState.FieldsEntry
+	61,  // 55: spark_internal.AuditConsistencyRequest.entities:type_name -> spark_internal.ConsistencyEntity
+	84,  // 56: spark_internal.ConsistencyReport.audit_time:type_name -> google.protobuf.Timestamp
+	67,  // 57: spark_internal.ConsistencyReport.divergences:type_name -> spark_internal.ConsistencyDivergence
+	61,  // 58: spark_internal.ConsistencyDivergence.entity:type_name -> spark_internal.ConsistencyEntity
+	81,  // 59: spark_internal.ConsistencyDivergence.values:type_name -> spark_internal.ConsistencyDivergence.ValuesEntry
+	82,  // 60: spark_internal.SigningJob.CommitmentsEntry.value:type_name -> common.SigningCommitment
+	95,  // 61: spark_internal.FrostRound2Response.ResultsEntry.value:type_name -> common.SigningResult
+	96,  // 62: spark_internal.InitiateTransferRequest.SenderKeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	96,  // 63: spark_internal.InitiateSettleReceiverKeyTweakRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	96,  // 64: spark_internal.ProvidePreimageRequest.KeyTweakProofsEntry.value:type_name -> spark.SecretProof
+	2,   // 65: spark_internal.SparkInternalService.mark_keyshares_as_used:input_type -> spark_internal.MarkKeysharesAsUsedRequest
+	3,   // 66: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:input_type -> spark_internal.MarkKeyshareForDepositAddressRequest
+	46,  // 67: spark_internal.SparkInternalService.reserve_entity_dkg_key:input_type -> spark_internal.ReserveEntityDkgKeyRequest
+	10,  // 68: spark_internal.SparkInternalService.finalize_tree_creation:input_type -> spark_internal.FinalizeTreeCreationRequest
+	5,   // 69: spark_internal.SparkInternalService.frost_round1:input_type -> spark_internal.FrostRound1Request
+	8,   // 70: spark_internal.SparkInternalService.frost_round2:input_type -> spark_internal.FrostRound2Request
+	11,  // 71: spark_internal.SparkInternalService.finalize_transfer:input_type -> spark_internal.FinalizeTransferRequest
+	12,  // 72: spark_internal.SparkInternalService.finalize_refresh_timelock:input_type -> spark_internal.FinalizeRefreshTimelockRequest
+	13,  // 73: spark_internal.SparkInternalService.finalize_extend_leaf:input_type -> spark_internal.FinalizeExtendLeafRequest
+	97,  // 74: spark_internal.SparkInternalService.initiate_preimage_swap:input_type -> spark.InitiatePreimageSwapRequest
+	45,  // 75: spark_internal.SparkInternalService.provide_preimage:input_type -> spark_internal.ProvidePreimageRequest
+	24,  // 76: spark_internal.SparkInternalService.update_preimage_request:input_type -> spark_internal.UpdatePreimageRequestRequest
+	17,  // 77: spark_internal.SparkInternalService.prepare_tree_address:input_type -> spark_internal.PrepareTreeAddressRequest
+	20,  // 78: spark_internal.SparkInternalService.initiate_transfer:input_type -> spark_internal.InitiateTransferRequest
+	21,  // 79: spark_internal.SparkInternalService.initiate_batch_transfer:input_type -> spark_internal.InitiateBatchTransferRequest
+	22,  // 80: spark_internal.SparkInternalService.deliver_sender_key_tweak:input_type -> spark_internal.DeliverSenderKeyTweakRequest
+	23,  // 81: spark_internal.SparkInternalService.initiate_cooperative_exit:input_type -> spark_internal.InitiateCooperativeExitRequest
+	98,  // 82: spark_internal.SparkInternalService.return_lightning_payment:input_type -> spark.ReturnLightningPaymentRequest
+	25,  // 83: spark_internal.SparkInternalService.start_token_transaction_internal:input_type -> spark_internal.StartTokenTransactionInternalRequest
+	99,  // 84: spark_internal.SparkInternalService.query_token_outputs_internal:input_type -> spark.QueryTokenOutputsRequest
+	27,  // 85: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:input_type -> spark_internal.InitiateSettleReceiverKeyTweakRequest
+	28,  // 86: spark_internal.SparkInternalService.settle_receiver_key_tweak:input_type -> spark_internal.SettleReceiverKeyTweakRequest
+	29,  // 87: spark_internal.SparkInternalService.settle_sender_key_tweak:input_type -> spark_internal.SettleSenderKeyTweakRequest
+	30,  // 88: spark_internal.SparkInternalService.create_utxo_swap:input_type -> spark_internal.CreateUtxoSwapRequest
+	33,  // 89: spark_internal.SparkInternalService.create_static_deposit_utxo_swap:input_type -> spark_internal.CreateStaticDepositUtxoSwapRequest
+	35,  // 90: spark_internal.SparkInternalService.create_static_deposit_utxo_refund:input_type -> spark_internal.CreateStaticDepositUtxoRefundRequest
+	37,  // 91: spark_internal.SparkInternalService.rollback_utxo_swap:input_type -> spark_internal.RollbackUtxoSwapRequest
+	39,  // 92: spark_internal.SparkInternalService.utxo_swap_completed:input_type -> spark_internal.UtxoSwapCompletedRequest
+	42,  // 93: spark_internal.SparkInternalService.query_leaf_signing_pubkeys:input_type -> spark_internal.QueryLeafSigningPubkeysRequest
+	44,  // 94: spark_internal.SparkInternalService.resolve_leaf_investigation:input_type -> spark_internal.ResolveLeafInvestigationRequest
+	47,  // 95: spark_internal.SparkInternalService.fix_keyshare:input_type -> spark_internal.FixKeyshareRequest
+	48,  // 96: spark_internal.SparkInternalService.fix_keyshare_round1:input_type -> spark_internal.FixKeyshareRound1Request
+	50,  // 97: spark_internal.SparkInternalService.fix_keyshare_round2:input_type -> spark_internal.FixKeyshareRound2Request
+	52,  // 98: spark_internal.SparkInternalService.get_transfers:input_type -> spark_internal.GetTransfersRequest
+	54,  // 99: spark_internal.SparkInternalService.generate_static_deposit_address_proofs:input_type -> spark_internal.GenerateStaticDepositAddressProofsRequest
+	56,  // 100: spark_internal.SparkInternalService.query_failed_gossip:input_type -> spark_internal.QueryFailedGossipRequest
+	60,  // 101: spark_internal.SparkInternalService.replay_failed_gossip:input_type -> spark_internal.ReplayFailedGossipRequest
+	62,  // 102: spark_internal.SparkInternalService.query_consistency_snapshot:input_type -> spark_internal.QueryConsistencySnapshotRequest
+	65,  // 103: spark_internal.SparkInternalService.audit_consistency:input_type -> spark_internal.AuditConsistencyRequest
+	100, // 104: spark_internal.SparkInternalService.mark_keyshares_as_used:output_type -> google.protobuf.Empty
+	4,   // 105: spark_internal.SparkInternalService.mark_keyshare_for_deposit_address:output_type -> spark_internal.MarkKeyshareForDepositAddressResponse
+	100, // 106: spark_internal.SparkInternalService.reserve_entity_dkg_key:output_type -> google.protobuf.Empty
+	100, // 107: spark_internal.SparkInternalService.finalize_tree_creation:output_type -> google.protobuf.Empty
+	6,   // 108: spark_internal.SparkInternalService.frost_round1:output_type -> spark_internal.FrostRound1Response
+	9,   // 109: spark_internal.SparkInternalService.frost_round2:output_type -> spark_internal.FrostRound2Response
+	100, // 110: spark_internal.SparkInternalService.finalize_transfer:output_type -> google.protobuf.Empty
+	100, // 111: spark_internal.SparkInternalService.finalize_refresh_timelock:output_type -> google.protobuf.Empty
+	100, // 112: spark_internal.SparkInternalService.finalize_extend_leaf:output_type -> google.protobuf.Empty
+	15,  // 113: spark_internal.SparkInternalService.initiate_preimage_swap:output_type -> spark_internal.InitiatePreimageSwapResponse
+	100, // 114: spark_internal.SparkInternalService.provide_preimage:output_type -> google.protobuf.Empty
+	100, // 115: spark_internal.SparkInternalService.update_preimage_request:output_type -> google.protobuf.Empty
+	18,  // 116: spark_internal.SparkInternalService.prepare_tree_address:output_type -> spark_internal.PrepareTreeAddressResponse
+	100, // 117: spark_internal.SparkInternalService.initiate_transfer:output_type -> google.protobuf.Empty
+	100, // 118: spark_internal.SparkInternalService.initiate_batch_transfer:output_type -> google.protobuf.Empty
+	100, // 119: spark_internal.SparkInternalService.deliver_sender_key_tweak:output_type -> google.protobuf.Empty
+	100, // 120: spark_internal.SparkInternalService.initiate_cooperative_exit:output_type -> google.protobuf.Empty
+	100, // 121: spark_internal.SparkInternalService.return_lightning_payment:output_type -> google.protobuf.Empty
+	100, // 122: spark_internal.SparkInternalService.start_token_transaction_internal:output_type -> google.protobuf.Empty
+	101, // 123: spark_internal.SparkInternalService.query_token_outputs_internal:output_type -> spark.QueryTokenOutputsResponse
+	100, // 124: spark_internal.SparkInternalService.initiate_settle_receiver_key_tweak:output_type -> google.protobuf.Empty
+	100, // 125: spark_internal.SparkInternalService.settle_receiver_key_tweak:output_type -> google.protobuf.Empty
+	100, // 126: spark_internal.SparkInternalService.settle_sender_key_tweak:output_type -> google.protobuf.Empty
+	31,  // 127: spark_internal.SparkInternalService.create_utxo_swap:output_type -> spark_internal.CreateUtxoSwapResponse
+	34,  // 128: spark_internal.SparkInternalService.create_static_deposit_utxo_swap:output_type -> spark_internal.CreateStaticDepositUtxoSwapResponse
+	36,  // 129: spark_internal.SparkInternalService.create_static_deposit_utxo_refund:output_type -> spark_internal.CreateStaticDepositUtxoRefundResponse
+	38,  // 130: spark_internal.SparkInternalService.rollback_utxo_swap:output_type -> spark_internal.RollbackUtxoSwapResponse
+	40,  // 131: spark_internal.SparkInternalService.utxo_swap_completed:output_type -> spark_internal.UtxoSwapCompletedResponse
+	43,  // 132: spark_internal.SparkInternalService.query_leaf_signing_pubkeys:output_type -> spark_internal.QueryLeafSigningPubkeysResponse
+	100, // 133: spark_internal.SparkInternalService.resolve_leaf_investigation:output_type -> google.protobuf.Empty
+	100, // 134: spark_internal.SparkInternalService.fix_keyshare:output_type -> google.protobuf.Empty
+	49,  // 135: spark_internal.SparkInternalService.fix_keyshare_round1:output_type -> spark_internal.FixKeyshareRound1Response
+	51,  // 136: spark_internal.SparkInternalService.fix_keyshare_round2:output_type -> spark_internal.FixKeyshareRound2Response
+	53,  // 137: spark_internal.SparkInternalService.get_transfers:output_type -> spark_internal.GetTransfersResponse
+	55,  // 138: spark_internal.SparkInternalService.generate_static_deposit_address_proofs:output_type -> spark_internal.GenerateStaticDepositAddressProofsResponse
+	57,  // 139: spark_internal.SparkInternalService.query_failed_gossip:output_type -> spark_internal.QueryFailedGossipResponse
+	100, // 140: spark_internal.SparkInternalService.replay_failed_gossip:output_type -> google.protobuf.Empty
+	63,  // 141: spark_internal.SparkInternalService.query_consistency_snapshot:output_type -> spark_internal.ConsistencySnapshot
+	66,  // 142: spark_internal.SparkInternalService.audit_consistency:output_type -> spark_internal.ConsistencyReport
+	104, // [104:143] is the sub-list for method output_type
+	65,  // [65:104] is the sub-list for method input_type
+	65,  // [65:65] is the sub-list for extension type_name
+	65,  // [65:65] is the sub-list for extension extendee
+	0,   // [0:65] is the sub-list for field type_name
 }
 
 func init() { file_spark_internal_proto_init() }
@@ -3650,8 +4561,8 @@ func file_spark_internal_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_internal_proto_rawDesc), len(file_spark_internal_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   65,
+			NumEnums:      2,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2552,6 +2552,12 @@ func (m *InitiateTransferRequest) validate(all bool) error {
 
 	// no validation rules for DirectFromCpfpRefundSignatures
 
+	// no validation rules for SparkPaymentIntent
+
+	// no validation rules for BatchId
+
+	// no validation rules for PaymentHash
+
 	if len(errors) > 0 {
 		return InitiateTransferRequestMultiError(errors)
 	}
@@ -2632,6 +2638,143 @@ var _ interface {
 	ErrorName() string
 } = InitiateTransferRequestValidationError{}
 
+// Validate checks the field values on InitiateBatchTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InitiateBatchTransferRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InitiateBatchTransferRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InitiateBatchTransferRequestMultiError, or nil if none found.
+func (m *InitiateBatchTransferRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InitiateBatchTransferRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTransfers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InitiateBatchTransferRequestValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InitiateBatchTransferRequestValidationError{
+						field:  fmt.Sprintf("Transfers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InitiateBatchTransferRequestValidationError{
+					field:  fmt.Sprintf("Transfers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InitiateBatchTransferRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InitiateBatchTransferRequestMultiError is an error wrapping multiple
+// validation errors returned by InitiateBatchTransferRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InitiateBatchTransferRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InitiateBatchTransferRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InitiateBatchTransferRequestMultiError) AllErrors() []error { return m }
+
+// InitiateBatchTransferRequestValidationError is the validation error returned
+// by InitiateBatchTransferRequest.Validate if the designated constraints
+// aren't met.
+type InitiateBatchTransferRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InitiateBatchTransferRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InitiateBatchTransferRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InitiateBatchTransferRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InitiateBatchTransferRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InitiateBatchTransferRequestValidationError) ErrorName() string {
+	return "InitiateBatchTransferRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InitiateBatchTransferRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInitiateBatchTransferRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InitiateBatchTransferRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InitiateBatchTransferRequestValidationError{}
+
 // Validate checks the field values on DeliverSenderKeyTweakRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -3096,6 +3239,8 @@ func (m *StartTokenTransactionInternalRequest) validate(all bool) error {
 
 	// no validation rules for CoordinatorPublicKey
 
+	// no validation rules for SparkPaymentIntent
+
 	if len(errors) > 0 {
 		return StartTokenTransactionInternalRequestMultiError(errors)
 	}
@@ -5603,6 +5748,8 @@ func (m *ProvidePreimageRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for TransferId
+
 	if len(errors) > 0 {
 		return ProvidePreimageRequestMultiError(errors)
 	}
@@ -6768,3 +6915,1604 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GenerateStaticDepositAddressProofsResponseValidationError{}
+
+// Validate checks the field values on QueryFailedGossipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryFailedGossipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryFailedGossipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryFailedGossipRequestMultiError, or nil if none found.
+func (m *QueryFailedGossipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryFailedGossipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return QueryFailedGossipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryFailedGossipRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryFailedGossipRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryFailedGossipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryFailedGossipRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryFailedGossipRequestMultiError) AllErrors() []error { return m }
+
+// QueryFailedGossipRequestValidationError is the validation error returned by
+// QueryFailedGossipRequest.Validate if the designated constraints aren't met.
+type QueryFailedGossipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryFailedGossipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryFailedGossipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryFailedGossipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryFailedGossipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryFailedGossipRequestValidationError) ErrorName() string {
+	return "QueryFailedGossipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryFailedGossipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryFailedGossipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryFailedGossipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryFailedGossipRequestValidationError{}
+
+// Validate checks the field values on QueryFailedGossipResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryFailedGossipResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryFailedGossipResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryFailedGossipResponseMultiError, or nil if none found.
+func (m *QueryFailedGossipResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryFailedGossipResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGossip() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryFailedGossipResponseValidationError{
+						field:  fmt.Sprintf("Gossip[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryFailedGossipResponseValidationError{
+						field:  fmt.Sprintf("Gossip[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryFailedGossipResponseValidationError{
+					field:  fmt.Sprintf("Gossip[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return QueryFailedGossipResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryFailedGossipResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryFailedGossipResponse.ValidateAll() if the
+// designated constraints aren't met.
+type QueryFailedGossipResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryFailedGossipResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryFailedGossipResponseMultiError) AllErrors() []error { return m }
+
+// QueryFailedGossipResponseValidationError is the validation error returned by
+// QueryFailedGossipResponse.Validate if the designated constraints aren't met.
+type QueryFailedGossipResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryFailedGossipResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryFailedGossipResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryFailedGossipResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryFailedGossipResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryFailedGossipResponseValidationError) ErrorName() string {
+	return "QueryFailedGossipResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryFailedGossipResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryFailedGossipResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryFailedGossipResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryFailedGossipResponseValidationError{}
+
+// Validate checks the field values on FailedGossip with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FailedGossip) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailedGossip with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FailedGossipMultiError, or
+// nil if none found.
+func (m *FailedGossip) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailedGossip) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Message
+
+	for idx, item := range m.GetParticipants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FailedGossipValidationError{
+						field:  fmt.Sprintf("Participants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FailedGossipValidationError{
+						field:  fmt.Sprintf("Participants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FailedGossipValidationError{
+					field:  fmt.Sprintf("Participants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FailedGossipValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FailedGossipValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FailedGossipValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiryTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FailedGossipValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FailedGossipValidationError{
+					field:  "ExpiryTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiryTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FailedGossipValidationError{
+				field:  "ExpiryTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FailedGossipMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailedGossipMultiError is an error wrapping multiple validation errors
+// returned by FailedGossip.ValidateAll() if the designated constraints aren't met.
+type FailedGossipMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailedGossipMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailedGossipMultiError) AllErrors() []error { return m }
+
+// FailedGossipValidationError is the validation error returned by
+// FailedGossip.Validate if the designated constraints aren't met.
+type FailedGossipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailedGossipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailedGossipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailedGossipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailedGossipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailedGossipValidationError) ErrorName() string { return "FailedGossipValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FailedGossipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailedGossip.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailedGossipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailedGossipValidationError{}
+
+// Validate checks the field values on GossipParticipantDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GossipParticipantDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GossipParticipantDelivery with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GossipParticipantDeliveryMultiError, or nil if none found.
+func (m *GossipParticipantDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GossipParticipantDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	// no validation rules for Delivered
+
+	// no validation rules for Attempts
+
+	if len(errors) > 0 {
+		return GossipParticipantDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// GossipParticipantDeliveryMultiError is an error wrapping multiple validation
+// errors returned by GossipParticipantDelivery.ValidateAll() if the
+// designated constraints aren't met.
+type GossipParticipantDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GossipParticipantDeliveryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GossipParticipantDeliveryMultiError) AllErrors() []error { return m }
+
+// GossipParticipantDeliveryValidationError is the validation error returned by
+// GossipParticipantDelivery.Validate if the designated constraints aren't met.
+type GossipParticipantDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GossipParticipantDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GossipParticipantDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GossipParticipantDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GossipParticipantDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GossipParticipantDeliveryValidationError) ErrorName() string {
+	return "GossipParticipantDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GossipParticipantDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGossipParticipantDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GossipParticipantDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GossipParticipantDeliveryValidationError{}
+
+// Validate checks the field values on ReplayFailedGossipRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayFailedGossipRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayFailedGossipRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayFailedGossipRequestMultiError, or nil if none found.
+func (m *ReplayFailedGossipRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayFailedGossipRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReplayFailedGossipRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayFailedGossipRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayFailedGossipRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReplayFailedGossipRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayFailedGossipRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayFailedGossipRequestMultiError) AllErrors() []error { return m }
+
+// ReplayFailedGossipRequestValidationError is the validation error returned by
+// ReplayFailedGossipRequest.Validate if the designated constraints aren't met.
+type ReplayFailedGossipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayFailedGossipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayFailedGossipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayFailedGossipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayFailedGossipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayFailedGossipRequestValidationError) ErrorName() string {
+	return "ReplayFailedGossipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayFailedGossipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayFailedGossipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayFailedGossipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayFailedGossipRequestValidationError{}
+
+// Validate checks the field values on ConsistencyEntity with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyEntity) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyEntity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyEntityMultiError, or nil if none found.
+func (m *ConsistencyEntity) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyEntity) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	if len(errors) > 0 {
+		return ConsistencyEntityMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyEntityMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyEntity.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyEntityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyEntityMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyEntityMultiError) AllErrors() []error { return m }
+
+// ConsistencyEntityValidationError is the validation error returned by
+// ConsistencyEntity.Validate if the designated constraints aren't met.
+type ConsistencyEntityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyEntityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyEntityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyEntityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyEntityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyEntityValidationError) ErrorName() string {
+	return "ConsistencyEntityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyEntityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyEntity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyEntityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyEntityValidationError{}
+
+// Validate checks the field values on QueryConsistencySnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryConsistencySnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryConsistencySnapshotRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QueryConsistencySnapshotRequestMultiError, or nil if none found.
+func (m *QueryConsistencySnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryConsistencySnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryConsistencySnapshotRequestValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryConsistencySnapshotRequestValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryConsistencySnapshotRequestValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryConsistencySnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryConsistencySnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by QueryConsistencySnapshotRequest.ValidateAll()
+// if the designated constraints aren't met.
+type QueryConsistencySnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryConsistencySnapshotRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryConsistencySnapshotRequestMultiError) AllErrors() []error { return m }
+
+// QueryConsistencySnapshotRequestValidationError is the validation error
+// returned by QueryConsistencySnapshotRequest.Validate if the designated
+// constraints aren't met.
+type QueryConsistencySnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryConsistencySnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryConsistencySnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryConsistencySnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryConsistencySnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryConsistencySnapshotRequestValidationError) ErrorName() string {
+	return "QueryConsistencySnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryConsistencySnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryConsistencySnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryConsistencySnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryConsistencySnapshotRequestValidationError{}
+
+// Validate checks the field values on ConsistencySnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencySnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencySnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencySnapshotMultiError, or nil if none found.
+func (m *ConsistencySnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencySnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConsistencySnapshotValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConsistencySnapshotValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConsistencySnapshotValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConsistencySnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencySnapshotMultiError is an error wrapping multiple validation
+// errors returned by ConsistencySnapshot.ValidateAll() if the designated
+// constraints aren't met.
+type ConsistencySnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencySnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencySnapshotMultiError) AllErrors() []error { return m }
+
+// ConsistencySnapshotValidationError is the validation error returned by
+// ConsistencySnapshot.Validate if the designated constraints aren't met.
+type ConsistencySnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencySnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencySnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencySnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencySnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencySnapshotValidationError) ErrorName() string {
+	return "ConsistencySnapshotValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencySnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencySnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencySnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencySnapshotValidationError{}
+
+// Validate checks the field values on ConsistencyEntityState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyEntityState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyEntityState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyEntityStateMultiError, or nil if none found.
+func (m *ConsistencyEntityState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyEntityState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsistencyEntityStateValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsistencyEntityStateValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsistencyEntityStateValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Fields
+
+	if len(errors) > 0 {
+		return ConsistencyEntityStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyEntityStateMultiError is an error wrapping multiple validation
+// errors returned by ConsistencyEntityState.ValidateAll() if the designated
+// constraints aren't met.
+type ConsistencyEntityStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyEntityStateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyEntityStateMultiError) AllErrors() []error { return m }
+
+// ConsistencyEntityStateValidationError is the validation error returned by
+// ConsistencyEntityState.Validate if the designated constraints aren't met.
+type ConsistencyEntityStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyEntityStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyEntityStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyEntityStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyEntityStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyEntityStateValidationError) ErrorName() string {
+	return "ConsistencyEntityStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyEntityStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyEntityState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyEntityStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyEntityStateValidationError{}
+
+// Validate checks the field values on AuditConsistencyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AuditConsistencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditConsistencyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuditConsistencyRequestMultiError, or nil if none found.
+func (m *AuditConsistencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditConsistencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntities() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AuditConsistencyRequestValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AuditConsistencyRequestValidationError{
+						field:  fmt.Sprintf("Entities[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AuditConsistencyRequestValidationError{
+					field:  fmt.Sprintf("Entities[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AuditConsistencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditConsistencyRequestMultiError is an error wrapping multiple validation
+// errors returned by AuditConsistencyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AuditConsistencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditConsistencyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditConsistencyRequestMultiError) AllErrors() []error { return m }
+
+// AuditConsistencyRequestValidationError is the validation error returned by
+// AuditConsistencyRequest.Validate if the designated constraints aren't met.
+type AuditConsistencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditConsistencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditConsistencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditConsistencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditConsistencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditConsistencyRequestValidationError) ErrorName() string {
+	return "AuditConsistencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuditConsistencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditConsistencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditConsistencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditConsistencyRequestValidationError{}
+
+// Validate checks the field values on ConsistencyReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyReportMultiError, or nil if none found.
+func (m *ConsistencyReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAuditTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsistencyReportValidationError{
+					field:  "AuditTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsistencyReportValidationError{
+					field:  "AuditTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuditTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsistencyReportValidationError{
+				field:  "AuditTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Auditor
+
+	// no validation rules for EntitiesChecked
+
+	for idx, item := range m.GetDivergences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConsistencyReportValidationError{
+						field:  fmt.Sprintf("Divergences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConsistencyReportValidationError{
+						field:  fmt.Sprintf("Divergences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConsistencyReportValidationError{
+					field:  fmt.Sprintf("Divergences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConsistencyReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyReportMultiError is an error wrapping multiple validation errors
+// returned by ConsistencyReport.ValidateAll() if the designated constraints
+// aren't met.
+type ConsistencyReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyReportMultiError) AllErrors() []error { return m }
+
+// ConsistencyReportValidationError is the validation error returned by
+// ConsistencyReport.Validate if the designated constraints aren't met.
+type ConsistencyReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyReportValidationError) ErrorName() string {
+	return "ConsistencyReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyReportValidationError{}
+
+// Validate checks the field values on ConsistencyDivergence with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConsistencyDivergence) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConsistencyDivergence with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConsistencyDivergenceMultiError, or nil if none found.
+func (m *ConsistencyDivergence) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConsistencyDivergence) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConsistencyDivergenceValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConsistencyDivergenceValidationError{
+					field:  "Entity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConsistencyDivergenceValidationError{
+				field:  "Entity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Field
+
+	// no validation rules for Values
+
+	if len(errors) > 0 {
+		return ConsistencyDivergenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConsistencyDivergenceMultiError is an error wrapping multiple validation
+// errors returned by ConsistencyDivergence.ValidateAll() if the designated
+// constraints aren't met.
+type ConsistencyDivergenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConsistencyDivergenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConsistencyDivergenceMultiError) AllErrors() []error { return m }
+
+// ConsistencyDivergenceValidationError is the validation error returned by
+// ConsistencyDivergence.Validate if the designated constraints aren't met.
+type ConsistencyDivergenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConsistencyDivergenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConsistencyDivergenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConsistencyDivergenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConsistencyDivergenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConsistencyDivergenceValidationError) ErrorName() string {
+	return "ConsistencyDivergenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConsistencyDivergenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConsistencyDivergence.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConsistencyDivergenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConsistencyDivergenceValidationError{}
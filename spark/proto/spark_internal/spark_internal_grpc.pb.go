@@ -35,6 +35,7 @@ const (
 	SparkInternalService_UpdatePreimageRequest_FullMethodName              = "/spark_internal.SparkInternalService/update_preimage_request"
 	SparkInternalService_PrepareTreeAddress_FullMethodName                 = "/spark_internal.SparkInternalService/prepare_tree_address"
 	SparkInternalService_InitiateTransfer_FullMethodName                   = "/spark_internal.SparkInternalService/initiate_transfer"
+	SparkInternalService_InitiateBatchTransfer_FullMethodName              = "/spark_internal.SparkInternalService/initiate_batch_transfer"
 	SparkInternalService_DeliverSenderKeyTweak_FullMethodName              = "/spark_internal.SparkInternalService/deliver_sender_key_tweak"
 	SparkInternalService_InitiateCooperativeExit_FullMethodName            = "/spark_internal.SparkInternalService/initiate_cooperative_exit"
 	SparkInternalService_ReturnLightningPayment_FullMethodName             = "/spark_internal.SparkInternalService/return_lightning_payment"
@@ -55,6 +56,10 @@ const (
 	SparkInternalService_FixKeyshareRound2_FullMethodName                  = "/spark_internal.SparkInternalService/fix_keyshare_round2"
 	SparkInternalService_GetTransfers_FullMethodName                       = "/spark_internal.SparkInternalService/get_transfers"
 	SparkInternalService_GenerateStaticDepositAddressProofs_FullMethodName = "/spark_internal.SparkInternalService/generate_static_deposit_address_proofs"
+	SparkInternalService_QueryFailedGossip_FullMethodName                  = "/spark_internal.SparkInternalService/query_failed_gossip"
+	SparkInternalService_ReplayFailedGossip_FullMethodName                 = "/spark_internal.SparkInternalService/replay_failed_gossip"
+	SparkInternalService_QueryConsistencySnapshot_FullMethodName           = "/spark_internal.SparkInternalService/query_consistency_snapshot"
+	SparkInternalService_AuditConsistency_FullMethodName                   = "/spark_internal.SparkInternalService/audit_consistency"
 )
 
 // SparkInternalServiceClient is the client API for SparkInternalService service.
@@ -75,6 +80,7 @@ type SparkInternalServiceClient interface {
 	UpdatePreimageRequest(ctx context.Context, in *UpdatePreimageRequestRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	PrepareTreeAddress(ctx context.Context, in *PrepareTreeAddressRequest, opts ...grpc.CallOption) (*PrepareTreeAddressResponse, error)
 	InitiateTransfer(ctx context.Context, in *InitiateTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InitiateBatchTransfer(ctx context.Context, in *InitiateBatchTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeliverSenderKeyTweak(ctx context.Context, in *DeliverSenderKeyTweakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	InitiateCooperativeExit(ctx context.Context, in *InitiateCooperativeExitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ReturnLightningPayment(ctx context.Context, in *spark.ReturnLightningPaymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -104,6 +110,15 @@ type SparkInternalServiceClient interface {
 	// The client can use them to validate that all SOs know about this address.
 	// The coordinator can use them to validate if an address was created correctly.
 	GenerateStaticDepositAddressProofs(ctx context.Context, in *GenerateStaticDepositAddressProofsRequest, opts ...grpc.CallOption) (*GenerateStaticDepositAddressProofsResponse, error)
+	// Lists gossip messages that expired before every participant received them.
+	QueryFailedGossip(ctx context.Context, in *QueryFailedGossipRequest, opts ...grpc.CallOption) (*QueryFailedGossipResponse, error)
+	// Moves failed gossip messages back to pending so that delivery to the remaining participants is retried.
+	ReplayFailedGossip(ctx context.Context, in *ReplayFailedGossipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Returns this operator's view of the given entities, for comparison with the other operators.
+	QueryConsistencySnapshot(ctx context.Context, in *QueryConsistencySnapshotRequest, opts ...grpc.CallOption) (*ConsistencySnapshot, error)
+	// Compares the given entities, or the recently updated ones if none are given, across all
+	// operators and reports where they diverge.
+	AuditConsistency(ctx context.Context, in *AuditConsistencyRequest, opts ...grpc.CallOption) (*ConsistencyReport, error)
 }
 
 type sparkInternalServiceClient struct {
@@ -254,6 +269,16 @@ func (c *sparkInternalServiceClient) InitiateTransfer(ctx context.Context, in *I
 	return out, nil
 }
 
+func (c *sparkInternalServiceClient) InitiateBatchTransfer(ctx context.Context, in *InitiateBatchTransferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SparkInternalService_InitiateBatchTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sparkInternalServiceClient) DeliverSenderKeyTweak(ctx context.Context, in *DeliverSenderKeyTweakRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -455,6 +480,46 @@ func (c *sparkInternalServiceClient) GenerateStaticDepositAddressProofs(ctx cont
 	return out, nil
 }
 
+func (c *sparkInternalServiceClient) QueryFailedGossip(ctx context.Context, in *QueryFailedGossipRequest, opts ...grpc.CallOption) (*QueryFailedGossipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFailedGossipResponse)
+	err := c.cc.Invoke(ctx, SparkInternalService_QueryFailedGossip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkInternalServiceClient) ReplayFailedGossip(ctx context.Context, in *ReplayFailedGossipRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SparkInternalService_ReplayFailedGossip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkInternalServiceClient) QueryConsistencySnapshot(ctx context.Context, in *QueryConsistencySnapshotRequest, opts ...grpc.CallOption) (*ConsistencySnapshot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsistencySnapshot)
+	err := c.cc.Invoke(ctx, SparkInternalService_QueryConsistencySnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkInternalServiceClient) AuditConsistency(ctx context.Context, in *AuditConsistencyRequest, opts ...grpc.CallOption) (*ConsistencyReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsistencyReport)
+	err := c.cc.Invoke(ctx, SparkInternalService_AuditConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SparkInternalServiceServer is the server API for SparkInternalService service.
 // All implementations must embed UnimplementedSparkInternalServiceServer
 // for forward compatibility.
@@ -473,6 +538,7 @@ type SparkInternalServiceServer interface {
 	UpdatePreimageRequest(context.Context, *UpdatePreimageRequestRequest) (*emptypb.Empty, error)
 	PrepareTreeAddress(context.Context, *PrepareTreeAddressRequest) (*PrepareTreeAddressResponse, error)
 	InitiateTransfer(context.Context, *InitiateTransferRequest) (*emptypb.Empty, error)
+	InitiateBatchTransfer(context.Context, *InitiateBatchTransferRequest) (*emptypb.Empty, error)
 	DeliverSenderKeyTweak(context.Context, *DeliverSenderKeyTweakRequest) (*emptypb.Empty, error)
 	InitiateCooperativeExit(context.Context, *InitiateCooperativeExitRequest) (*emptypb.Empty, error)
 	ReturnLightningPayment(context.Context, *spark.ReturnLightningPaymentRequest) (*emptypb.Empty, error)
@@ -502,6 +568,15 @@ type SparkInternalServiceServer interface {
 	// The client can use them to validate that all SOs know about this address.
 	// The coordinator can use them to validate if an address was created correctly.
 	GenerateStaticDepositAddressProofs(context.Context, *GenerateStaticDepositAddressProofsRequest) (*GenerateStaticDepositAddressProofsResponse, error)
+	// Lists gossip messages that expired before every participant received them.
+	QueryFailedGossip(context.Context, *QueryFailedGossipRequest) (*QueryFailedGossipResponse, error)
+	// Moves failed gossip messages back to pending so that delivery to the remaining participants is retried.
+	ReplayFailedGossip(context.Context, *ReplayFailedGossipRequest) (*emptypb.Empty, error)
+	// Returns this operator's view of the given entities, for comparison with the other operators.
+	QueryConsistencySnapshot(context.Context, *QueryConsistencySnapshotRequest) (*ConsistencySnapshot, error)
+	// Compares the given entities, or the recently updated ones if none are given, across all
+	// operators and reports where they diverge.
+	AuditConsistency(context.Context, *AuditConsistencyRequest) (*ConsistencyReport, error)
 	mustEmbedUnimplementedSparkInternalServiceServer()
 }
 
@@ -554,6 +629,9 @@ func (UnimplementedSparkInternalServiceServer) PrepareTreeAddress(context.Contex
 func (UnimplementedSparkInternalServiceServer) InitiateTransfer(context.Context, *InitiateTransferRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiateTransfer not implemented")
 }
+func (UnimplementedSparkInternalServiceServer) InitiateBatchTransfer(context.Context, *InitiateBatchTransferRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateBatchTransfer not implemented")
+}
 func (UnimplementedSparkInternalServiceServer) DeliverSenderKeyTweak(context.Context, *DeliverSenderKeyTweakRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeliverSenderKeyTweak not implemented")
 }
@@ -614,6 +692,18 @@ func (UnimplementedSparkInternalServiceServer) GetTransfers(context.Context, *Ge
 func (UnimplementedSparkInternalServiceServer) GenerateStaticDepositAddressProofs(context.Context, *GenerateStaticDepositAddressProofsRequest) (*GenerateStaticDepositAddressProofsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateStaticDepositAddressProofs not implemented")
 }
+func (UnimplementedSparkInternalServiceServer) QueryFailedGossip(context.Context, *QueryFailedGossipRequest) (*QueryFailedGossipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryFailedGossip not implemented")
+}
+func (UnimplementedSparkInternalServiceServer) ReplayFailedGossip(context.Context, *ReplayFailedGossipRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayFailedGossip not implemented")
+}
+func (UnimplementedSparkInternalServiceServer) QueryConsistencySnapshot(context.Context, *QueryConsistencySnapshotRequest) (*ConsistencySnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsistencySnapshot not implemented")
+}
+func (UnimplementedSparkInternalServiceServer) AuditConsistency(context.Context, *AuditConsistencyRequest) (*ConsistencyReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditConsistency not implemented")
+}
 func (UnimplementedSparkInternalServiceServer) mustEmbedUnimplementedSparkInternalServiceServer() {}
 func (UnimplementedSparkInternalServiceServer) testEmbeddedByValue()                              {}
 
@@ -887,6 +977,24 @@ func _SparkInternalService_InitiateTransfer_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkInternalService_InitiateBatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateBatchTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).InitiateBatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_InitiateBatchTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).InitiateBatchTransfer(ctx, req.(*InitiateBatchTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SparkInternalService_DeliverSenderKeyTweak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeliverSenderKeyTweakRequest)
 	if err := dec(in); err != nil {
@@ -1247,6 +1355,78 @@ func _SparkInternalService_GenerateStaticDepositAddressProofs_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkInternalService_QueryFailedGossip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedGossipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).QueryFailedGossip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_QueryFailedGossip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).QueryFailedGossip(ctx, req.(*QueryFailedGossipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkInternalService_ReplayFailedGossip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayFailedGossipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).ReplayFailedGossip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_ReplayFailedGossip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).ReplayFailedGossip(ctx, req.(*ReplayFailedGossipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkInternalService_QueryConsistencySnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsistencySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).QueryConsistencySnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_QueryConsistencySnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).QueryConsistencySnapshot(ctx, req.(*QueryConsistencySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkInternalService_AuditConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkInternalServiceServer).AuditConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkInternalService_AuditConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkInternalServiceServer).AuditConsistency(ctx, req.(*AuditConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SparkInternalService_ServiceDesc is the grpc.ServiceDesc for SparkInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1310,6 +1490,10 @@ var SparkInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "initiate_transfer",
 			Handler:    _SparkInternalService_InitiateTransfer_Handler,
 		},
+		{
+			MethodName: "initiate_batch_transfer",
+			Handler:    _SparkInternalService_InitiateBatchTransfer_Handler,
+		},
 		{
 			MethodName: "deliver_sender_key_tweak",
 			Handler:    _SparkInternalService_DeliverSenderKeyTweak_Handler,
@@ -1390,6 +1574,22 @@ var SparkInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "generate_static_deposit_address_proofs",
 			Handler:    _SparkInternalService_GenerateStaticDepositAddressProofs_Handler,
 		},
+		{
+			MethodName: "query_failed_gossip",
+			Handler:    _SparkInternalService_QueryFailedGossip_Handler,
+		},
+		{
+			MethodName: "replay_failed_gossip",
+			Handler:    _SparkInternalService_ReplayFailedGossip_Handler,
+		},
+		{
+			MethodName: "query_consistency_snapshot",
+			Handler:    _SparkInternalService_QueryConsistencySnapshot_Handler,
+		},
+		{
+			MethodName: "audit_consistency",
+			Handler:    _SparkInternalService_AuditConsistency_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spark_internal.proto",
@@ -31,6 +31,7 @@ const (
 	TokenTransactionType_TOKEN_TRANSACTION_TYPE_CREATE      TokenTransactionType = 1
 	TokenTransactionType_TOKEN_TRANSACTION_TYPE_MINT        TokenTransactionType = 2
 	TokenTransactionType_TOKEN_TRANSACTION_TYPE_TRANSFER    TokenTransactionType = 3
+	TokenTransactionType_TOKEN_TRANSACTION_TYPE_BURN        TokenTransactionType = 4
 )
 
 // Enum value maps for TokenTransactionType.
@@ -40,12 +41,14 @@ var (
 		1: "TOKEN_TRANSACTION_TYPE_CREATE",
 		2: "TOKEN_TRANSACTION_TYPE_MINT",
 		3: "TOKEN_TRANSACTION_TYPE_TRANSFER",
+		4: "TOKEN_TRANSACTION_TYPE_BURN",
 	}
 	TokenTransactionType_value = map[string]int32{
 		"TOKEN_TRANSACTION_TYPE_UNSPECIFIED": 0,
 		"TOKEN_TRANSACTION_TYPE_CREATE":      1,
 		"TOKEN_TRANSACTION_TYPE_MINT":        2,
 		"TOKEN_TRANSACTION_TYPE_TRANSFER":    3,
+		"TOKEN_TRANSACTION_TYPE_BURN":        4,
 	}
 )
 
@@ -125,6 +128,53 @@ func (CommitStatus) EnumDescriptor() ([]byte, []int) {
 	return file_spark_token_proto_rawDescGZIP(), []int{1}
 }
 
+type TokenTransactionSortField int32
+
+const (
+	TokenTransactionSortField_TOKEN_TRANSACTION_SORT_FIELD_UPDATE_TIME TokenTransactionSortField = 0
+	// Creation time does not change as a transaction progresses, so pages stay stable. Required when paging by cursor.
+	TokenTransactionSortField_TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME TokenTransactionSortField = 1
+)
+
+// Enum value maps for TokenTransactionSortField.
+var (
+	TokenTransactionSortField_name = map[int32]string{
+		0: "TOKEN_TRANSACTION_SORT_FIELD_UPDATE_TIME",
+		1: "TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME",
+	}
+	TokenTransactionSortField_value = map[string]int32{
+		"TOKEN_TRANSACTION_SORT_FIELD_UPDATE_TIME": 0,
+		"TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME": 1,
+	}
+)
+
+func (x TokenTransactionSortField) Enum() *TokenTransactionSortField {
+	p := new(TokenTransactionSortField)
+	*p = x
+	return p
+}
+
+func (x TokenTransactionSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenTransactionSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_spark_token_proto_enumTypes[2].Descriptor()
+}
+
+func (TokenTransactionSortField) Type() protoreflect.EnumType {
+	return &file_spark_token_proto_enumTypes[2]
+}
+
+func (x TokenTransactionSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenTransactionSortField.Descriptor instead.
+func (TokenTransactionSortField) EnumDescriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{2}
+}
+
 type TokenTransactionStatus int32
 
 const (
@@ -170,11 +220,11 @@ func (x TokenTransactionStatus) String() string {
 }
 
 func (TokenTransactionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_spark_token_proto_enumTypes[2].Descriptor()
+	return file_spark_token_proto_enumTypes[3].Descriptor()
 }
 
 func (TokenTransactionStatus) Type() protoreflect.EnumType {
-	return &file_spark_token_proto_enumTypes[2]
+	return &file_spark_token_proto_enumTypes[3]
 }
 
 func (x TokenTransactionStatus) Number() protoreflect.EnumNumber {
@@ -183,7 +233,7 @@ func (x TokenTransactionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TokenTransactionStatus.Descriptor instead.
 func (TokenTransactionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{2}
+	return file_spark_token_proto_rawDescGZIP(), []int{3}
 }
 
 // This proto is constructed by the wallet to specify leaves it wants to spend
@@ -284,6 +334,60 @@ func (x *TokenTransferInput) GetOutputsToSpend() []*TokenOutputToSpend {
 	return nil
 }
 
+// Burns the outputs it spends. Burn transactions create no outputs, so the
+// spent amount is removed from the token's supply.
+type TokenBurnInput struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OutputsToSpend  []*TokenOutputToSpend  `protobuf:"bytes,1,rep,name=outputs_to_spend,json=outputsToSpend,proto3" json:"outputs_to_spend,omitempty"`
+	TokenIdentifier []byte                 `protobuf:"bytes,2,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TokenBurnInput) Reset() {
+	*x = TokenBurnInput{}
+	mi := &file_spark_token_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenBurnInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenBurnInput) ProtoMessage() {}
+
+func (x *TokenBurnInput) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenBurnInput.ProtoReflect.Descriptor instead.
+func (*TokenBurnInput) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TokenBurnInput) GetOutputsToSpend() []*TokenOutputToSpend {
+	if x != nil {
+		return x.OutputsToSpend
+	}
+	return nil
+}
+
+func (x *TokenBurnInput) GetTokenIdentifier() []byte {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
 type TokenMintInput struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	IssuerPublicKey []byte                 `protobuf:"bytes,1,opt,name=issuer_public_key,json=issuerPublicKey,proto3" json:"issuer_public_key,omitempty"`
@@ -294,7 +398,7 @@ type TokenMintInput struct {
 
 func (x *TokenMintInput) Reset() {
 	*x = TokenMintInput{}
-	mi := &file_spark_token_proto_msgTypes[2]
+	mi := &file_spark_token_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -306,7 +410,7 @@ func (x *TokenMintInput) String() string {
 func (*TokenMintInput) ProtoMessage() {}
 
 func (x *TokenMintInput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[2]
+	mi := &file_spark_token_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -319,7 +423,7 @@ func (x *TokenMintInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenMintInput.ProtoReflect.Descriptor instead.
 func (*TokenMintInput) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{2}
+	return file_spark_token_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TokenMintInput) GetIssuerPublicKey() []byte {
@@ -345,13 +449,15 @@ type TokenCreateInput struct {
 	MaxSupply               []byte                 `protobuf:"bytes,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"` // Decoded uint128
 	IsFreezable             bool                   `protobuf:"varint,6,opt,name=is_freezable,json=isFreezable,proto3" json:"is_freezable,omitempty"`
 	CreationEntityPublicKey []byte                 `protobuf:"bytes,7,opt,name=creation_entity_public_key,json=creationEntityPublicKey,proto3,oneof" json:"creation_entity_public_key,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// When set, outputs of the token can only be created for owners on the issuer's allowlist.
+	IsTransferRestricted bool `protobuf:"varint,8,opt,name=is_transfer_restricted,json=isTransferRestricted,proto3" json:"is_transfer_restricted,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *TokenCreateInput) Reset() {
 	*x = TokenCreateInput{}
-	mi := &file_spark_token_proto_msgTypes[3]
+	mi := &file_spark_token_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -363,7 +469,7 @@ func (x *TokenCreateInput) String() string {
 func (*TokenCreateInput) ProtoMessage() {}
 
 func (x *TokenCreateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[3]
+	mi := &file_spark_token_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -376,7 +482,7 @@ func (x *TokenCreateInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenCreateInput.ProtoReflect.Descriptor instead.
 func (*TokenCreateInput) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{3}
+	return file_spark_token_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TokenCreateInput) GetIssuerPublicKey() []byte {
@@ -428,6 +534,13 @@ func (x *TokenCreateInput) GetCreationEntityPublicKey() []byte {
 	return nil
 }
 
+func (x *TokenCreateInput) GetIsTransferRestricted() bool {
+	if x != nil {
+		return x.IsTransferRestricted
+	}
+	return false
+}
+
 // This proto is constructed by the wallet to specify outputs it wants to create
 // as part of a token transaction. Output id and revocation public key should
 // remain unfilled so that the SE can fill them as part of the
@@ -442,13 +555,15 @@ type TokenOutput struct {
 	TokenPublicKey                []byte                 `protobuf:"bytes,6,opt,name=token_public_key,json=tokenPublicKey,proto3,oneof" json:"token_public_key,omitempty"`
 	TokenIdentifier               []byte                 `protobuf:"bytes,8,opt,name=token_identifier,json=tokenIdentifier,proto3,oneof" json:"token_identifier,omitempty"`
 	TokenAmount                   []byte                 `protobuf:"bytes,7,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"` // Decoded uint128
-	unknownFields                 protoimpl.UnknownFields
-	sizeCache                     protoimpl.SizeCache
+	// When set, the output cannot be spent on Spark before this time. Committed to with millisecond precision.
+	SpendableAfter *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=spendable_after,json=spendableAfter,proto3" json:"spendable_after,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TokenOutput) Reset() {
 	*x = TokenOutput{}
-	mi := &file_spark_token_proto_msgTypes[4]
+	mi := &file_spark_token_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -460,7 +575,7 @@ func (x *TokenOutput) String() string {
 func (*TokenOutput) ProtoMessage() {}
 
 func (x *TokenOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[4]
+	mi := &file_spark_token_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,7 +588,7 @@ func (x *TokenOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenOutput.ProtoReflect.Descriptor instead.
 func (*TokenOutput) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{4}
+	return file_spark_token_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TokenOutput) GetId() string {
@@ -532,6 +647,13 @@ func (x *TokenOutput) GetTokenAmount() []byte {
 	return nil
 }
 
+func (x *TokenOutput) GetSpendableAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SpendableAfter
+	}
+	return nil
+}
+
 // This proto is constructed by the wallet and is the core transaction data
 // structure. This proto is deterministically hashed to generate the
 // token_transaction_hash that is cooperatively signed by the SO group to
@@ -547,6 +669,7 @@ type TokenTransaction struct {
 	//	*TokenTransaction_MintInput
 	//	*TokenTransaction_TransferInput
 	//	*TokenTransaction_CreateInput
+	//	*TokenTransaction_BurnInput
 	TokenInputs                     isTokenTransaction_TokenInputs `protobuf_oneof:"token_inputs"`
 	TokenOutputs                    []*TokenOutput                 `protobuf:"bytes,4,rep,name=token_outputs,json=tokenOutputs,proto3" json:"token_outputs,omitempty"`
 	SparkOperatorIdentityPublicKeys [][]byte                       `protobuf:"bytes,5,rep,name=spark_operator_identity_public_keys,json=sparkOperatorIdentityPublicKeys,proto3" json:"spark_operator_identity_public_keys,omitempty"`
@@ -567,7 +690,7 @@ type TokenTransaction struct {
 
 func (x *TokenTransaction) Reset() {
 	*x = TokenTransaction{}
-	mi := &file_spark_token_proto_msgTypes[5]
+	mi := &file_spark_token_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +702,7 @@ func (x *TokenTransaction) String() string {
 func (*TokenTransaction) ProtoMessage() {}
 
 func (x *TokenTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[5]
+	mi := &file_spark_token_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +715,7 @@ func (x *TokenTransaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenTransaction.ProtoReflect.Descriptor instead.
 func (*TokenTransaction) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{5}
+	return file_spark_token_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TokenTransaction) GetVersion() uint32 {
@@ -636,6 +759,15 @@ func (x *TokenTransaction) GetCreateInput() *TokenCreateInput {
 	return nil
 }
 
+func (x *TokenTransaction) GetBurnInput() *TokenBurnInput {
+	if x != nil {
+		if x, ok := x.TokenInputs.(*TokenTransaction_BurnInput); ok {
+			return x.BurnInput
+		}
+	}
+	return nil
+}
+
 func (x *TokenTransaction) GetTokenOutputs() []*TokenOutput {
 	if x != nil {
 		return x.TokenOutputs
@@ -694,12 +826,18 @@ type TokenTransaction_CreateInput struct {
 	CreateInput *TokenCreateInput `protobuf:"bytes,8,opt,name=create_input,json=createInput,proto3,oneof"`
 }
 
+type TokenTransaction_BurnInput struct {
+	BurnInput *TokenBurnInput `protobuf:"bytes,11,opt,name=burn_input,json=burnInput,proto3,oneof"`
+}
+
 func (*TokenTransaction_MintInput) isTokenTransaction_TokenInputs() {}
 
 func (*TokenTransaction_TransferInput) isTokenTransaction_TokenInputs() {}
 
 func (*TokenTransaction_CreateInput) isTokenTransaction_TokenInputs() {}
 
+func (*TokenTransaction_BurnInput) isTokenTransaction_TokenInputs() {}
+
 type InvoiceAttachment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SparkInvoice  string                 `protobuf:"bytes,1,opt,name=spark_invoice,json=sparkInvoice,proto3" json:"spark_invoice,omitempty"`
@@ -709,7 +847,7 @@ type InvoiceAttachment struct {
 
 func (x *InvoiceAttachment) Reset() {
 	*x = InvoiceAttachment{}
-	mi := &file_spark_token_proto_msgTypes[6]
+	mi := &file_spark_token_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -721,7 +859,7 @@ func (x *InvoiceAttachment) String() string {
 func (*InvoiceAttachment) ProtoMessage() {}
 
 func (x *InvoiceAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[6]
+	mi := &file_spark_token_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -734,7 +872,7 @@ func (x *InvoiceAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvoiceAttachment.ProtoReflect.Descriptor instead.
 func (*InvoiceAttachment) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{6}
+	return file_spark_token_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InvoiceAttachment) GetSparkInvoice() string {
@@ -757,7 +895,7 @@ type SignatureWithIndex struct {
 
 func (x *SignatureWithIndex) Reset() {
 	*x = SignatureWithIndex{}
-	mi := &file_spark_token_proto_msgTypes[7]
+	mi := &file_spark_token_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -769,7 +907,7 @@ func (x *SignatureWithIndex) String() string {
 func (*SignatureWithIndex) ProtoMessage() {}
 
 func (x *SignatureWithIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[7]
+	mi := &file_spark_token_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -782,7 +920,7 @@ func (x *SignatureWithIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignatureWithIndex.ProtoReflect.Descriptor instead.
 func (*SignatureWithIndex) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{7}
+	return file_spark_token_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SignatureWithIndex) GetSignature() []byte {
@@ -811,7 +949,7 @@ type InputTtxoSignaturesPerOperator struct {
 
 func (x *InputTtxoSignaturesPerOperator) Reset() {
 	*x = InputTtxoSignaturesPerOperator{}
-	mi := &file_spark_token_proto_msgTypes[8]
+	mi := &file_spark_token_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -823,7 +961,7 @@ func (x *InputTtxoSignaturesPerOperator) String() string {
 func (*InputTtxoSignaturesPerOperator) ProtoMessage() {}
 
 func (x *InputTtxoSignaturesPerOperator) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[8]
+	mi := &file_spark_token_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,7 +974,7 @@ func (x *InputTtxoSignaturesPerOperator) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InputTtxoSignaturesPerOperator.ProtoReflect.Descriptor instead.
 func (*InputTtxoSignaturesPerOperator) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{8}
+	return file_spark_token_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InputTtxoSignaturesPerOperator) GetTtxoSignatures() []*SignatureWithIndex {
@@ -867,13 +1005,15 @@ type StartTransactionRequest struct {
 	// The server will set the actual expiry_time in the final transaction based
 	// on this duration. Must be within [1, 300] seconds.
 	ValidityDurationSeconds uint64 `protobuf:"varint,4,opt,name=validity_duration_seconds,json=validityDurationSeconds,proto3" json:"validity_duration_seconds,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// A spark invoice identifying what the transaction pays for, recorded alongside the transaction.
+	SparkPaymentIntent string `protobuf:"bytes,5,opt,name=spark_payment_intent,json=sparkPaymentIntent,proto3" json:"spark_payment_intent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *StartTransactionRequest) Reset() {
 	*x = StartTransactionRequest{}
-	mi := &file_spark_token_proto_msgTypes[9]
+	mi := &file_spark_token_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -885,7 +1025,7 @@ func (x *StartTransactionRequest) String() string {
 func (*StartTransactionRequest) ProtoMessage() {}
 
 func (x *StartTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[9]
+	mi := &file_spark_token_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1038,7 @@ func (x *StartTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTransactionRequest.ProtoReflect.Descriptor instead.
 func (*StartTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{9}
+	return file_spark_token_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *StartTransactionRequest) GetIdentityPublicKey() []byte {
@@ -929,6 +1069,13 @@ func (x *StartTransactionRequest) GetValidityDurationSeconds() uint64 {
 	return 0
 }
 
+func (x *StartTransactionRequest) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
 type StartTransactionResponse struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	FinalTokenTransaction *TokenTransaction      `protobuf:"bytes,1,opt,name=final_token_transaction,json=finalTokenTransaction,proto3" json:"final_token_transaction,omitempty"`
@@ -939,7 +1086,7 @@ type StartTransactionResponse struct {
 
 func (x *StartTransactionResponse) Reset() {
 	*x = StartTransactionResponse{}
-	mi := &file_spark_token_proto_msgTypes[10]
+	mi := &file_spark_token_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1098,7 @@ func (x *StartTransactionResponse) String() string {
 func (*StartTransactionResponse) ProtoMessage() {}
 
 func (x *StartTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[10]
+	mi := &file_spark_token_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1111,7 @@ func (x *StartTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartTransactionResponse.ProtoReflect.Descriptor instead.
 func (*StartTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{10}
+	return file_spark_token_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StartTransactionResponse) GetFinalTokenTransaction() *TokenTransaction {
@@ -993,7 +1140,7 @@ type CommitTransactionRequest struct {
 
 func (x *CommitTransactionRequest) Reset() {
 	*x = CommitTransactionRequest{}
-	mi := &file_spark_token_proto_msgTypes[11]
+	mi := &file_spark_token_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1005,7 +1152,7 @@ func (x *CommitTransactionRequest) String() string {
 func (*CommitTransactionRequest) ProtoMessage() {}
 
 func (x *CommitTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[11]
+	mi := &file_spark_token_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,7 +1165,7 @@ func (x *CommitTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CommitTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{11}
+	return file_spark_token_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CommitTransactionRequest) GetFinalTokenTransaction() *TokenTransaction {
@@ -1059,7 +1206,7 @@ type CommitProgress struct {
 
 func (x *CommitProgress) Reset() {
 	*x = CommitProgress{}
-	mi := &file_spark_token_proto_msgTypes[12]
+	mi := &file_spark_token_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1071,7 +1218,7 @@ func (x *CommitProgress) String() string {
 func (*CommitProgress) ProtoMessage() {}
 
 func (x *CommitProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[12]
+	mi := &file_spark_token_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,7 +1231,7 @@ func (x *CommitProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitProgress.ProtoReflect.Descriptor instead.
 func (*CommitProgress) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{12}
+	return file_spark_token_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CommitProgress) GetCommittedOperatorPublicKeys() [][]byte {
@@ -1111,7 +1258,7 @@ type CommitTransactionResponse struct {
 
 func (x *CommitTransactionResponse) Reset() {
 	*x = CommitTransactionResponse{}
-	mi := &file_spark_token_proto_msgTypes[13]
+	mi := &file_spark_token_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1270,7 @@ func (x *CommitTransactionResponse) String() string {
 func (*CommitTransactionResponse) ProtoMessage() {}
 
 func (x *CommitTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[13]
+	mi := &file_spark_token_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1283,7 @@ func (x *CommitTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommitTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CommitTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{13}
+	return file_spark_token_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CommitTransactionResponse) GetCommitStatus() CommitStatus {
@@ -1163,7 +1310,7 @@ type QueryTokenMetadataRequest struct {
 
 func (x *QueryTokenMetadataRequest) Reset() {
 	*x = QueryTokenMetadataRequest{}
-	mi := &file_spark_token_proto_msgTypes[14]
+	mi := &file_spark_token_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1322,7 @@ func (x *QueryTokenMetadataRequest) String() string {
 func (*QueryTokenMetadataRequest) ProtoMessage() {}
 
 func (x *QueryTokenMetadataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[14]
+	mi := &file_spark_token_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1335,7 @@ func (x *QueryTokenMetadataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenMetadataRequest.ProtoReflect.Descriptor instead.
 func (*QueryTokenMetadataRequest) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{14}
+	return file_spark_token_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryTokenMetadataRequest) GetTokenIdentifiers() [][]byte {
@@ -1215,13 +1362,20 @@ type TokenMetadata struct {
 	IsFreezable             bool                   `protobuf:"varint,6,opt,name=is_freezable,json=isFreezable,proto3" json:"is_freezable,omitempty"`
 	CreationEntityPublicKey []byte                 `protobuf:"bytes,7,opt,name=creation_entity_public_key,json=creationEntityPublicKey,proto3,oneof" json:"creation_entity_public_key,omitempty"`
 	TokenIdentifier         []byte                 `protobuf:"bytes,8,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// The key currently allowed to mint and freeze. Equal to issuer_public_key unless the issuer key was rotated.
+	CurrentIssuerPublicKey []byte `protobuf:"bytes,9,opt,name=current_issuer_public_key,json=currentIssuerPublicKey,proto3" json:"current_issuer_public_key,omitempty"`
+	// Issuer key rotations in the order they were applied.
+	IssuerKeyRotations   []*IssuerKeyRotation `protobuf:"bytes,10,rep,name=issuer_key_rotations,json=issuerKeyRotations,proto3" json:"issuer_key_rotations,omitempty"`
+	IsTransferRestricted bool                 `protobuf:"varint,11,opt,name=is_transfer_restricted,json=isTransferRestricted,proto3" json:"is_transfer_restricted,omitempty"`
+	// While paused, mints, transfers and burns of the token are rejected.
+	IsPaused      bool `protobuf:"varint,12,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TokenMetadata) Reset() {
 	*x = TokenMetadata{}
-	mi := &file_spark_token_proto_msgTypes[15]
+	mi := &file_spark_token_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1233,7 +1387,7 @@ func (x *TokenMetadata) String() string {
 func (*TokenMetadata) ProtoMessage() {}
 
 func (x *TokenMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[15]
+	mi := &file_spark_token_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1246,7 +1400,7 @@ func (x *TokenMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenMetadata.ProtoReflect.Descriptor instead.
 func (*TokenMetadata) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{15}
+	return file_spark_token_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TokenMetadata) GetIssuerPublicKey() []byte {
@@ -1305,75 +1459,57 @@ func (x *TokenMetadata) GetTokenIdentifier() []byte {
 	return nil
 }
 
-type QueryTokenMetadataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TokenMetadata []*TokenMetadata       `protobuf:"bytes,1,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *QueryTokenMetadataResponse) Reset() {
-	*x = QueryTokenMetadataResponse{}
-	mi := &file_spark_token_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *QueryTokenMetadataResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TokenMetadata) GetCurrentIssuerPublicKey() []byte {
+	if x != nil {
+		return x.CurrentIssuerPublicKey
+	}
+	return nil
 }
 
-func (*QueryTokenMetadataResponse) ProtoMessage() {}
-
-func (x *QueryTokenMetadataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[16]
+func (x *TokenMetadata) GetIssuerKeyRotations() []*IssuerKeyRotation {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IssuerKeyRotations
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use QueryTokenMetadataResponse.ProtoReflect.Descriptor instead.
-func (*QueryTokenMetadataResponse) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{16}
+func (x *TokenMetadata) GetIsTransferRestricted() bool {
+	if x != nil {
+		return x.IsTransferRestricted
+	}
+	return false
 }
 
-func (x *QueryTokenMetadataResponse) GetTokenMetadata() []*TokenMetadata {
+func (x *TokenMetadata) GetIsPaused() bool {
 	if x != nil {
-		return x.TokenMetadata
+		return x.IsPaused
 	}
-	return nil
+	return false
 }
 
-type QueryTokenOutputsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	OwnerPublicKeys [][]byte               `protobuf:"bytes,1,rep,name=owner_public_keys,json=ownerPublicKeys,proto3" json:"owner_public_keys,omitempty"`
-	// Optionally provide issuer public keys or token identifiers. If both are not set return outputs for all tokens.
-	IssuerPublicKeys [][]byte      `protobuf:"bytes,2,rep,name=issuer_public_keys,json=issuerPublicKeys,proto3" json:"issuer_public_keys,omitempty"`
-	TokenIdentifiers [][]byte      `protobuf:"bytes,4,rep,name=token_identifiers,json=tokenIdentifiers,proto3" json:"token_identifiers,omitempty"`
-	Network          spark.Network `protobuf:"varint,3,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"` // defaults to mainnet when no network is provided.
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type IssuerKeyRotation struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	PreviousIssuerPublicKey []byte                 `protobuf:"bytes,1,opt,name=previous_issuer_public_key,json=previousIssuerPublicKey,proto3" json:"previous_issuer_public_key,omitempty"`
+	NewIssuerPublicKey      []byte                 `protobuf:"bytes,2,opt,name=new_issuer_public_key,json=newIssuerPublicKey,proto3" json:"new_issuer_public_key,omitempty"`
+	IssuerProvidedTimestamp uint64                 `protobuf:"varint,3,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *QueryTokenOutputsRequest) Reset() {
-	*x = QueryTokenOutputsRequest{}
+func (x *IssuerKeyRotation) Reset() {
+	*x = IssuerKeyRotation{}
 	mi := &file_spark_token_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryTokenOutputsRequest) String() string {
+func (x *IssuerKeyRotation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenOutputsRequest) ProtoMessage() {}
+func (*IssuerKeyRotation) ProtoMessage() {}
 
-func (x *QueryTokenOutputsRequest) ProtoReflect() protoreflect.Message {
+func (x *IssuerKeyRotation) ProtoReflect() protoreflect.Message {
 	mi := &file_spark_token_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,12 +1521,118 @@ func (x *QueryTokenOutputsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryTokenOutputsRequest.ProtoReflect.Descriptor instead.
-func (*QueryTokenOutputsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssuerKeyRotation.ProtoReflect.Descriptor instead.
+func (*IssuerKeyRotation) Descriptor() ([]byte, []int) {
 	return file_spark_token_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryTokenOutputsRequest) GetOwnerPublicKeys() [][]byte {
+func (x *IssuerKeyRotation) GetPreviousIssuerPublicKey() []byte {
+	if x != nil {
+		return x.PreviousIssuerPublicKey
+	}
+	return nil
+}
+
+func (x *IssuerKeyRotation) GetNewIssuerPublicKey() []byte {
+	if x != nil {
+		return x.NewIssuerPublicKey
+	}
+	return nil
+}
+
+func (x *IssuerKeyRotation) GetIssuerProvidedTimestamp() uint64 {
+	if x != nil {
+		return x.IssuerProvidedTimestamp
+	}
+	return 0
+}
+
+type QueryTokenMetadataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenMetadata []*TokenMetadata       `protobuf:"bytes,1,rep,name=token_metadata,json=tokenMetadata,proto3" json:"token_metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTokenMetadataResponse) Reset() {
+	*x = QueryTokenMetadataResponse{}
+	mi := &file_spark_token_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenMetadataResponse) ProtoMessage() {}
+
+func (x *QueryTokenMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenMetadataResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QueryTokenMetadataResponse) GetTokenMetadata() []*TokenMetadata {
+	if x != nil {
+		return x.TokenMetadata
+	}
+	return nil
+}
+
+type QueryTokenOutputsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OwnerPublicKeys [][]byte               `protobuf:"bytes,1,rep,name=owner_public_keys,json=ownerPublicKeys,proto3" json:"owner_public_keys,omitempty"`
+	// Optionally provide issuer public keys or token identifiers. If both are not set return outputs for all tokens.
+	IssuerPublicKeys [][]byte      `protobuf:"bytes,2,rep,name=issuer_public_keys,json=issuerPublicKeys,proto3" json:"issuer_public_keys,omitempty"`
+	TokenIdentifiers [][]byte      `protobuf:"bytes,4,rep,name=token_identifiers,json=tokenIdentifiers,proto3" json:"token_identifiers,omitempty"`
+	Network          spark.Network `protobuf:"varint,3,opt,name=network,proto3,enum=spark.Network" json:"network,omitempty"` // defaults to mainnet when no network is provided.
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QueryTokenOutputsRequest) Reset() {
+	*x = QueryTokenOutputsRequest{}
+	mi := &file_spark_token_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenOutputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenOutputsRequest) ProtoMessage() {}
+
+func (x *QueryTokenOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenOutputsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QueryTokenOutputsRequest) GetOwnerPublicKeys() [][]byte {
 	if x != nil {
 		return x.OwnerPublicKeys
 	}
@@ -1433,13 +1675,28 @@ type QueryTokenTransactionsRequest struct {
 	TokenTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=token_transaction_hashes,json=tokenTransactionHashes,proto3" json:"token_transaction_hashes,omitempty"`
 	Limit                  int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset                 int64    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+	// Opaque cursor from next_cursor of a previous response. Unlike offset, pages do not shift when new
+	// transactions are added. Requires sorting by creation time and cannot be combined with offset.
+	Cursor string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Returns transactions created at or after this time.
+	CreatedAfter *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	// Returns transactions created before this time.
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// Returns transactions with one of these statuses.
+	Statuses []TokenTransactionStatus `protobuf:"varint,11,rep,packed,name=statuses,proto3,enum=spark_token.TokenTransactionStatus" json:"statuses,omitempty"`
+	// Sort direction, newest first by default.
+	Order spark.Order `protobuf:"varint,12,opt,name=order,proto3,enum=spark.Order" json:"order,omitempty"`
+	// Returns transactions started with one of these payment intents.
+	SparkPaymentIntents []string `protobuf:"bytes,13,rep,name=spark_payment_intents,json=sparkPaymentIntents,proto3" json:"spark_payment_intents,omitempty"`
+	// Sort field, last update time by default.
+	SortField     TokenTransactionSortField `protobuf:"varint,14,opt,name=sort_field,json=sortField,proto3,enum=spark_token.TokenTransactionSortField" json:"sort_field,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueryTokenTransactionsRequest) Reset() {
 	*x = QueryTokenTransactionsRequest{}
-	mi := &file_spark_token_proto_msgTypes[18]
+	mi := &file_spark_token_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1451,7 +1708,7 @@ func (x *QueryTokenTransactionsRequest) String() string {
 func (*QueryTokenTransactionsRequest) ProtoMessage() {}
 
 func (x *QueryTokenTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[18]
+	mi := &file_spark_token_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,7 +1721,7 @@ func (x *QueryTokenTransactionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenTransactionsRequest.ProtoReflect.Descriptor instead.
 func (*QueryTokenTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{18}
+	return file_spark_token_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueryTokenTransactionsRequest) GetOutputIds() []string {
@@ -1516,17 +1773,69 @@ func (x *QueryTokenTransactionsRequest) GetOffset() int64 {
 	return 0
 }
 
+func (x *QueryTokenTransactionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *QueryTokenTransactionsRequest) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *QueryTokenTransactionsRequest) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *QueryTokenTransactionsRequest) GetStatuses() []TokenTransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *QueryTokenTransactionsRequest) GetOrder() spark.Order {
+	if x != nil {
+		return x.Order
+	}
+	return spark.Order(0)
+}
+
+func (x *QueryTokenTransactionsRequest) GetSparkPaymentIntents() []string {
+	if x != nil {
+		return x.SparkPaymentIntents
+	}
+	return nil
+}
+
+func (x *QueryTokenTransactionsRequest) GetSortField() TokenTransactionSortField {
+	if x != nil {
+		return x.SortField
+	}
+	return TokenTransactionSortField_TOKEN_TRANSACTION_SORT_FIELD_UPDATE_TIME
+}
+
 type QueryTokenTransactionsResponse struct {
 	state                       protoimpl.MessageState        `protogen:"open.v1"`
 	TokenTransactionsWithStatus []*TokenTransactionWithStatus `protobuf:"bytes,1,rep,name=token_transactions_with_status,json=tokenTransactionsWithStatus,proto3" json:"token_transactions_with_status,omitempty"`
 	Offset                      int64                         `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+	// Pass as cursor to fetch the next page. Only set when sorting by creation time and empty when there are
+	// no more results.
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *QueryTokenTransactionsResponse) Reset() {
 	*x = QueryTokenTransactionsResponse{}
-	mi := &file_spark_token_proto_msgTypes[19]
+	mi := &file_spark_token_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1538,7 +1847,7 @@ func (x *QueryTokenTransactionsResponse) String() string {
 func (*QueryTokenTransactionsResponse) ProtoMessage() {}
 
 func (x *QueryTokenTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[19]
+	mi := &file_spark_token_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1860,7 @@ func (x *QueryTokenTransactionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryTokenTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*QueryTokenTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{19}
+	return file_spark_token_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueryTokenTransactionsResponse) GetTokenTransactionsWithStatus() []*TokenTransactionWithStatus {
@@ -1568,6 +1877,13 @@ func (x *QueryTokenTransactionsResponse) GetOffset() int64 {
 	return 0
 }
 
+func (x *QueryTokenTransactionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type OutputWithPreviousTransactionData struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Output                  *TokenOutput           `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
@@ -1579,7 +1895,7 @@ type OutputWithPreviousTransactionData struct {
 
 func (x *OutputWithPreviousTransactionData) Reset() {
 	*x = OutputWithPreviousTransactionData{}
-	mi := &file_spark_token_proto_msgTypes[20]
+	mi := &file_spark_token_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1591,7 +1907,7 @@ func (x *OutputWithPreviousTransactionData) String() string {
 func (*OutputWithPreviousTransactionData) ProtoMessage() {}
 
 func (x *OutputWithPreviousTransactionData) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[20]
+	mi := &file_spark_token_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,52 +1920,1143 @@ func (x *OutputWithPreviousTransactionData) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use OutputWithPreviousTransactionData.ProtoReflect.Descriptor instead.
 func (*OutputWithPreviousTransactionData) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{20}
+	return file_spark_token_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OutputWithPreviousTransactionData) GetOutput() *TokenOutput {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *OutputWithPreviousTransactionData) GetPreviousTransactionHash() []byte {
+	if x != nil {
+		return x.PreviousTransactionHash
+	}
+	return nil
+}
+
+func (x *OutputWithPreviousTransactionData) GetPreviousTransactionVout() uint32 {
+	if x != nil {
+		return x.PreviousTransactionVout
+	}
+	return 0
+}
+
+type QueryTokenOutputsResponse struct {
+	state                              protoimpl.MessageState               `protogen:"open.v1"`
+	OutputsWithPreviousTransactionData []*OutputWithPreviousTransactionData `protobuf:"bytes,1,rep,name=outputs_with_previous_transaction_data,json=outputsWithPreviousTransactionData,proto3" json:"outputs_with_previous_transaction_data,omitempty"`
+	unknownFields                      protoimpl.UnknownFields
+	sizeCache                          protoimpl.SizeCache
+}
+
+func (x *QueryTokenOutputsResponse) Reset() {
+	*x = QueryTokenOutputsResponse{}
+	mi := &file_spark_token_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenOutputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenOutputsResponse) ProtoMessage() {}
+
+func (x *QueryTokenOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenOutputsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryTokenOutputsResponse) GetOutputsWithPreviousTransactionData() []*OutputWithPreviousTransactionData {
+	if x != nil {
+		return x.OutputsWithPreviousTransactionData
+	}
+	return nil
+}
+
+type QueryTokenHoldersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TokenIdentifier []byte                 `protobuf:"bytes,1,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	// Return balances as of this time. Defaults to now.
+	AsOfTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of_time,json=asOfTime,proto3" json:"as_of_time,omitempty"`
+	Limit         int64                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTokenHoldersRequest) Reset() {
+	*x = QueryTokenHoldersRequest{}
+	mi := &file_spark_token_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenHoldersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenHoldersRequest) ProtoMessage() {}
+
+func (x *QueryTokenHoldersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenHoldersRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenHoldersRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QueryTokenHoldersRequest) GetTokenIdentifier() []byte {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *QueryTokenHoldersRequest) GetAsOfTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOfTime
+	}
+	return nil
+}
+
+func (x *QueryTokenHoldersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryTokenHoldersRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TokenHolder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OwnerPublicKey []byte                 `protobuf:"bytes,1,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
+	Balance        []byte                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"` // Decoded uint128
+	OutputCount    uint32                 `protobuf:"varint,3,opt,name=output_count,json=outputCount,proto3" json:"output_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TokenHolder) Reset() {
+	*x = TokenHolder{}
+	mi := &file_spark_token_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenHolder) ProtoMessage() {}
+
+func (x *TokenHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenHolder.ProtoReflect.Descriptor instead.
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TokenHolder) GetOwnerPublicKey() []byte {
+	if x != nil {
+		return x.OwnerPublicKey
+	}
+	return nil
+}
+
+func (x *TokenHolder) GetBalance() []byte {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *TokenHolder) GetOutputCount() uint32 {
+	if x != nil {
+		return x.OutputCount
+	}
+	return 0
+}
+
+type QueryTokenHoldersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ordered by owner public key.
+	TokenHolders  []*TokenHolder `protobuf:"bytes,1,rep,name=token_holders,json=tokenHolders,proto3" json:"token_holders,omitempty"`
+	Offset        int64          `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTokenHoldersResponse) Reset() {
+	*x = QueryTokenHoldersResponse{}
+	mi := &file_spark_token_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenHoldersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenHoldersResponse) ProtoMessage() {}
+
+func (x *QueryTokenHoldersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenHoldersResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenHoldersResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *QueryTokenHoldersResponse) GetTokenHolders() []*TokenHolder {
+	if x != nil {
+		return x.TokenHolders
+	}
+	return nil
+}
+
+func (x *QueryTokenHoldersResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type QueryTokenSupplyRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TokenIdentifiers [][]byte               `protobuf:"bytes,1,rep,name=token_identifiers,json=tokenIdentifiers,proto3" json:"token_identifiers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *QueryTokenSupplyRequest) Reset() {
+	*x = QueryTokenSupplyRequest{}
+	mi := &file_spark_token_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenSupplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenSupplyRequest) ProtoMessage() {}
+
+func (x *QueryTokenSupplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenSupplyRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenSupplyRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *QueryTokenSupplyRequest) GetTokenIdentifiers() [][]byte {
+	if x != nil {
+		return x.TokenIdentifiers
+	}
+	return nil
+}
+
+type TokenSupply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TokenIdentifier []byte                 `protobuf:"bytes,1,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	MaxSupply       []byte                 `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"` // Decoded uint128, 0 means unlimited
+	// Everything ever minted.
+	MintedSupply []byte `protobuf:"bytes,3,opt,name=minted_supply,json=mintedSupply,proto3" json:"minted_supply,omitempty"` // Decoded uint128
+	// Minted supply less burned supply.
+	CirculatingSupply []byte `protobuf:"bytes,4,opt,name=circulating_supply,json=circulatingSupply,proto3" json:"circulating_supply,omitempty"` // Decoded uint128
+	// Circulating supply held by owners with an active freeze.
+	FrozenSupply      []byte `protobuf:"bytes,5,opt,name=frozen_supply,json=frozenSupply,proto3" json:"frozen_supply,omitempty"` // Decoded uint128
+	FrozenOutputCount uint32 `protobuf:"varint,6,opt,name=frozen_output_count,json=frozenOutputCount,proto3" json:"frozen_output_count,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TokenSupply) Reset() {
+	*x = TokenSupply{}
+	mi := &file_spark_token_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenSupply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSupply) ProtoMessage() {}
+
+func (x *TokenSupply) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSupply.ProtoReflect.Descriptor instead.
+func (*TokenSupply) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TokenSupply) GetTokenIdentifier() []byte {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *TokenSupply) GetMaxSupply() []byte {
+	if x != nil {
+		return x.MaxSupply
+	}
+	return nil
+}
+
+func (x *TokenSupply) GetMintedSupply() []byte {
+	if x != nil {
+		return x.MintedSupply
+	}
+	return nil
+}
+
+func (x *TokenSupply) GetCirculatingSupply() []byte {
+	if x != nil {
+		return x.CirculatingSupply
+	}
+	return nil
+}
+
+func (x *TokenSupply) GetFrozenSupply() []byte {
+	if x != nil {
+		return x.FrozenSupply
+	}
+	return nil
+}
+
+func (x *TokenSupply) GetFrozenOutputCount() uint32 {
+	if x != nil {
+		return x.FrozenOutputCount
+	}
+	return 0
+}
+
+type QueryTokenSupplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenSupplies []*TokenSupply         `protobuf:"bytes,1,rep,name=token_supplies,json=tokenSupplies,proto3" json:"token_supplies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTokenSupplyResponse) Reset() {
+	*x = QueryTokenSupplyResponse{}
+	mi := &file_spark_token_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenSupplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenSupplyResponse) ProtoMessage() {}
+
+func (x *QueryTokenSupplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenSupplyResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *QueryTokenSupplyResponse) GetTokenSupplies() []*TokenSupply {
+	if x != nil {
+		return x.TokenSupplies
+	}
+	return nil
+}
+
+type SpentTokenOutputMetadata struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OutputId         string                 `protobuf:"bytes,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
+	RevocationSecret []byte                 `protobuf:"bytes,2,opt,name=revocation_secret,json=revocationSecret,proto3" json:"revocation_secret,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SpentTokenOutputMetadata) Reset() {
+	*x = SpentTokenOutputMetadata{}
+	mi := &file_spark_token_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpentTokenOutputMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpentTokenOutputMetadata) ProtoMessage() {}
+
+func (x *SpentTokenOutputMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpentTokenOutputMetadata.ProtoReflect.Descriptor instead.
+func (*SpentTokenOutputMetadata) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SpentTokenOutputMetadata) GetOutputId() string {
+	if x != nil {
+		return x.OutputId
+	}
+	return ""
+}
+
+func (x *SpentTokenOutputMetadata) GetRevocationSecret() []byte {
+	if x != nil {
+		return x.RevocationSecret
+	}
+	return nil
+}
+
+type TokenTransactionConfirmationMetadata struct {
+	state                     protoimpl.MessageState      `protogen:"open.v1"`
+	SpentTokenOutputsMetadata []*SpentTokenOutputMetadata `protobuf:"bytes,1,rep,name=spent_token_outputs_metadata,json=spentTokenOutputsMetadata,proto3" json:"spent_token_outputs_metadata,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *TokenTransactionConfirmationMetadata) Reset() {
+	*x = TokenTransactionConfirmationMetadata{}
+	mi := &file_spark_token_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTransactionConfirmationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransactionConfirmationMetadata) ProtoMessage() {}
+
+func (x *TokenTransactionConfirmationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransactionConfirmationMetadata.ProtoReflect.Descriptor instead.
+func (*TokenTransactionConfirmationMetadata) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TokenTransactionConfirmationMetadata) GetSpentTokenOutputsMetadata() []*SpentTokenOutputMetadata {
+	if x != nil {
+		return x.SpentTokenOutputsMetadata
+	}
+	return nil
+}
+
+type TokenTransactionWithStatus struct {
+	state                protoimpl.MessageState                `protogen:"open.v1"`
+	TokenTransaction     *TokenTransaction                     `protobuf:"bytes,1,opt,name=token_transaction,json=tokenTransaction,proto3" json:"token_transaction,omitempty"`
+	Status               TokenTransactionStatus                `protobuf:"varint,2,opt,name=status,proto3,enum=spark_token.TokenTransactionStatus" json:"status,omitempty"`
+	ConfirmationMetadata *TokenTransactionConfirmationMetadata `protobuf:"bytes,3,opt,name=confirmation_metadata,json=confirmationMetadata,proto3" json:"confirmation_metadata,omitempty"`
+	// In rare cases the above reconstructed token transaction may not match the original token transaction due to:
+	// a) a pre-empted transfer transaction having its input TTXOs remapped to the newer transaction
+	// b) proto migrations or field deprecations resulting in missing/swapped fields (eg. token public key -> token identifier)
+	// Include the original hash to ensure clients can reconcile this transaction with the original if needed.
+	TokenTransactionHash []byte `protobuf:"bytes,4,opt,name=token_transaction_hash,json=tokenTransactionHash,proto3" json:"token_transaction_hash,omitempty"`
+	// The payment intent the transaction was started with, if any.
+	SparkPaymentIntent string `protobuf:"bytes,5,opt,name=spark_payment_intent,json=sparkPaymentIntent,proto3" json:"spark_payment_intent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TokenTransactionWithStatus) Reset() {
+	*x = TokenTransactionWithStatus{}
+	mi := &file_spark_token_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTransactionWithStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTransactionWithStatus) ProtoMessage() {}
+
+func (x *TokenTransactionWithStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTransactionWithStatus.ProtoReflect.Descriptor instead.
+func (*TokenTransactionWithStatus) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TokenTransactionWithStatus) GetTokenTransaction() *TokenTransaction {
+	if x != nil {
+		return x.TokenTransaction
+	}
+	return nil
+}
+
+func (x *TokenTransactionWithStatus) GetStatus() TokenTransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TokenTransactionStatus_TOKEN_TRANSACTION_STARTED
+}
+
+func (x *TokenTransactionWithStatus) GetConfirmationMetadata() *TokenTransactionConfirmationMetadata {
+	if x != nil {
+		return x.ConfirmationMetadata
+	}
+	return nil
+}
+
+func (x *TokenTransactionWithStatus) GetTokenTransactionHash() []byte {
+	if x != nil {
+		return x.TokenTransactionHash
+	}
+	return nil
+}
+
+func (x *TokenTransactionWithStatus) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
+type FreezeTokensPayload struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Version                   uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	OwnerPublicKey            []byte                 `protobuf:"bytes,2,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
+	TokenPublicKey            []byte                 `protobuf:"bytes,3,opt,name=token_public_key,json=tokenPublicKey,proto3,oneof" json:"token_public_key,omitempty"`
+	TokenIdentifier           []byte                 `protobuf:"bytes,4,opt,name=token_identifier,json=tokenIdentifier,proto3,oneof" json:"token_identifier,omitempty"`
+	IssuerProvidedTimestamp   uint64                 `protobuf:"varint,5,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
+	OperatorIdentityPublicKey []byte                 `protobuf:"bytes,6,opt,name=operator_identity_public_key,json=operatorIdentityPublicKey,proto3" json:"operator_identity_public_key,omitempty"`
+	// Set to false when requesting a freeze.
+	ShouldUnfreeze bool `protobuf:"varint,7,opt,name=should_unfreeze,json=shouldUnfreeze,proto3" json:"should_unfreeze,omitempty"`
+	// Unix timestamp in milliseconds at which the freeze is automatically thawed. Leave unset for a freeze
+	// that lasts until it is explicitly thawed. Must be at least one minute after issuer_provided_timestamp.
+	// Requires version 1.
+	FreezeExpiryTimestamp uint64 `protobuf:"varint,8,opt,name=freeze_expiry_timestamp,json=freezeExpiryTimestamp,proto3" json:"freeze_expiry_timestamp,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *FreezeTokensPayload) Reset() {
+	*x = FreezeTokensPayload{}
+	mi := &file_spark_token_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeTokensPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeTokensPayload) ProtoMessage() {}
+
+func (x *FreezeTokensPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeTokensPayload.ProtoReflect.Descriptor instead.
+func (*FreezeTokensPayload) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FreezeTokensPayload) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FreezeTokensPayload) GetOwnerPublicKey() []byte {
+	if x != nil {
+		return x.OwnerPublicKey
+	}
+	return nil
+}
+
+func (x *FreezeTokensPayload) GetTokenPublicKey() []byte {
+	if x != nil {
+		return x.TokenPublicKey
+	}
+	return nil
+}
+
+func (x *FreezeTokensPayload) GetTokenIdentifier() []byte {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *FreezeTokensPayload) GetIssuerProvidedTimestamp() uint64 {
+	if x != nil {
+		return x.IssuerProvidedTimestamp
+	}
+	return 0
+}
+
+func (x *FreezeTokensPayload) GetOperatorIdentityPublicKey() []byte {
+	if x != nil {
+		return x.OperatorIdentityPublicKey
+	}
+	return nil
+}
+
+func (x *FreezeTokensPayload) GetShouldUnfreeze() bool {
+	if x != nil {
+		return x.ShouldUnfreeze
+	}
+	return false
+}
+
+func (x *FreezeTokensPayload) GetFreezeExpiryTimestamp() uint64 {
+	if x != nil {
+		return x.FreezeExpiryTimestamp
+	}
+	return 0
+}
+
+type FreezeTokensRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	FreezeTokensPayload *FreezeTokensPayload   `protobuf:"bytes,1,opt,name=freeze_tokens_payload,json=freezeTokensPayload,proto3" json:"freeze_tokens_payload,omitempty"`
+	// This is a Schnorr or ECDSA DER signature which can be between 64 and 73 bytes.
+	IssuerSignature []byte `protobuf:"bytes,2,opt,name=issuer_signature,json=issuerSignature,proto3" json:"issuer_signature,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FreezeTokensRequest) Reset() {
+	*x = FreezeTokensRequest{}
+	mi := &file_spark_token_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeTokensRequest) ProtoMessage() {}
+
+func (x *FreezeTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeTokensRequest.ProtoReflect.Descriptor instead.
+func (*FreezeTokensRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FreezeTokensRequest) GetFreezeTokensPayload() *FreezeTokensPayload {
+	if x != nil {
+		return x.FreezeTokensPayload
+	}
+	return nil
+}
+
+func (x *FreezeTokensRequest) GetIssuerSignature() []byte {
+	if x != nil {
+		return x.IssuerSignature
+	}
+	return nil
+}
+
+type FreezeTokensResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ImpactedOutputIds   []string               `protobuf:"bytes,1,rep,name=impacted_output_ids,json=impactedOutputIds,proto3" json:"impacted_output_ids,omitempty"`
+	ImpactedTokenAmount []byte                 `protobuf:"bytes,2,opt,name=impacted_token_amount,json=impactedTokenAmount,proto3" json:"impacted_token_amount,omitempty"` // Decoded uint128
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *FreezeTokensResponse) Reset() {
+	*x = FreezeTokensResponse{}
+	mi := &file_spark_token_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeTokensResponse) ProtoMessage() {}
+
+func (x *FreezeTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeTokensResponse.ProtoReflect.Descriptor instead.
+func (*FreezeTokensResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FreezeTokensResponse) GetImpactedOutputIds() []string {
+	if x != nil {
+		return x.ImpactedOutputIds
+	}
+	return nil
+}
+
+func (x *FreezeTokensResponse) GetImpactedTokenAmount() []byte {
+	if x != nil {
+		return x.ImpactedTokenAmount
+	}
+	return nil
+}
+
+type QueryTokenFreezesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TokenIdentifier []byte                 `protobuf:"bytes,1,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	Limit           int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueryTokenFreezesRequest) Reset() {
+	*x = QueryTokenFreezesRequest{}
+	mi := &file_spark_token_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenFreezesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenFreezesRequest) ProtoMessage() {}
+
+func (x *QueryTokenFreezesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenFreezesRequest.ProtoReflect.Descriptor instead.
+func (*QueryTokenFreezesRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *QueryTokenFreezesRequest) GetTokenIdentifier() []byte {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *QueryTokenFreezesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QueryTokenFreezesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type TokenFreezeInfo struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	OwnerPublicKey          []byte                 `protobuf:"bytes,1,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
+	IssuerProvidedTimestamp uint64                 `protobuf:"varint,2,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
+	// Unset when the freeze lasts until it is explicitly thawed.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenFreezeInfo) Reset() {
+	*x = TokenFreezeInfo{}
+	mi := &file_spark_token_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenFreezeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenFreezeInfo) ProtoMessage() {}
+
+func (x *TokenFreezeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenFreezeInfo.ProtoReflect.Descriptor instead.
+func (*TokenFreezeInfo) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TokenFreezeInfo) GetOwnerPublicKey() []byte {
+	if x != nil {
+		return x.OwnerPublicKey
+	}
+	return nil
+}
+
+func (x *TokenFreezeInfo) GetIssuerProvidedTimestamp() uint64 {
+	if x != nil {
+		return x.IssuerProvidedTimestamp
+	}
+	return 0
+}
+
+func (x *TokenFreezeInfo) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type QueryTokenFreezesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TokenFreezes  []*TokenFreezeInfo     `protobuf:"bytes,1,rep,name=token_freezes,json=tokenFreezes,proto3" json:"token_freezes,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryTokenFreezesResponse) Reset() {
+	*x = QueryTokenFreezesResponse{}
+	mi := &file_spark_token_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryTokenFreezesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTokenFreezesResponse) ProtoMessage() {}
+
+func (x *QueryTokenFreezesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryTokenFreezesResponse.ProtoReflect.Descriptor instead.
+func (*QueryTokenFreezesResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *QueryTokenFreezesResponse) GetTokenFreezes() []*TokenFreezeInfo {
+	if x != nil {
+		return x.TokenFreezes
+	}
+	return nil
+}
+
+func (x *QueryTokenFreezesResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type RotateIssuerKeyPayload struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Version            uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	TokenIdentifier    []byte                 `protobuf:"bytes,2,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	NewIssuerPublicKey []byte                 `protobuf:"bytes,3,opt,name=new_issuer_public_key,json=newIssuerPublicKey,proto3" json:"new_issuer_public_key,omitempty"`
+	// Must be later than the timestamp of any previous rotation of this token.
+	IssuerProvidedTimestamp   uint64 `protobuf:"varint,4,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
+	OperatorIdentityPublicKey []byte `protobuf:"bytes,5,opt,name=operator_identity_public_key,json=operatorIdentityPublicKey,proto3" json:"operator_identity_public_key,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RotateIssuerKeyPayload) Reset() {
+	*x = RotateIssuerKeyPayload{}
+	mi := &file_spark_token_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateIssuerKeyPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateIssuerKeyPayload) ProtoMessage() {}
+
+func (x *RotateIssuerKeyPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateIssuerKeyPayload.ProtoReflect.Descriptor instead.
+func (*RotateIssuerKeyPayload) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RotateIssuerKeyPayload) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RotateIssuerKeyPayload) GetTokenIdentifier() []byte {
+	if x != nil {
+		return x.TokenIdentifier
+	}
+	return nil
+}
+
+func (x *RotateIssuerKeyPayload) GetNewIssuerPublicKey() []byte {
+	if x != nil {
+		return x.NewIssuerPublicKey
+	}
+	return nil
+}
+
+func (x *RotateIssuerKeyPayload) GetIssuerProvidedTimestamp() uint64 {
+	if x != nil {
+		return x.IssuerProvidedTimestamp
+	}
+	return 0
+}
+
+func (x *RotateIssuerKeyPayload) GetOperatorIdentityPublicKey() []byte {
+	if x != nil {
+		return x.OperatorIdentityPublicKey
+	}
+	return nil
+}
+
+type RotateIssuerKeyRequest struct {
+	state                  protoimpl.MessageState  `protogen:"open.v1"`
+	RotateIssuerKeyPayload *RotateIssuerKeyPayload `protobuf:"bytes,1,opt,name=rotate_issuer_key_payload,json=rotateIssuerKeyPayload,proto3" json:"rotate_issuer_key_payload,omitempty"`
+	// Signature over the payload hash by the current issuer key.
+	// This is a Schnorr or ECDSA DER signature which can be between 64 and 73 bytes.
+	IssuerSignature []byte `protobuf:"bytes,2,opt,name=issuer_signature,json=issuerSignature,proto3" json:"issuer_signature,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RotateIssuerKeyRequest) Reset() {
+	*x = RotateIssuerKeyRequest{}
+	mi := &file_spark_token_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateIssuerKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateIssuerKeyRequest) ProtoMessage() {}
+
+func (x *RotateIssuerKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *OutputWithPreviousTransactionData) GetOutput() *TokenOutput {
-	if x != nil {
-		return x.Output
-	}
-	return nil
+// Deprecated: Use RotateIssuerKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateIssuerKeyRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *OutputWithPreviousTransactionData) GetPreviousTransactionHash() []byte {
+func (x *RotateIssuerKeyRequest) GetRotateIssuerKeyPayload() *RotateIssuerKeyPayload {
 	if x != nil {
-		return x.PreviousTransactionHash
+		return x.RotateIssuerKeyPayload
 	}
 	return nil
 }
 
-func (x *OutputWithPreviousTransactionData) GetPreviousTransactionVout() uint32 {
+func (x *RotateIssuerKeyRequest) GetIssuerSignature() []byte {
 	if x != nil {
-		return x.PreviousTransactionVout
+		return x.IssuerSignature
 	}
-	return 0
+	return nil
 }
 
-type QueryTokenOutputsResponse struct {
-	state                              protoimpl.MessageState               `protogen:"open.v1"`
-	OutputsWithPreviousTransactionData []*OutputWithPreviousTransactionData `protobuf:"bytes,1,rep,name=outputs_with_previous_transaction_data,json=outputsWithPreviousTransactionData,proto3" json:"outputs_with_previous_transaction_data,omitempty"`
-	unknownFields                      protoimpl.UnknownFields
-	sizeCache                          protoimpl.SizeCache
+type RotateIssuerKeyResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	CurrentIssuerPublicKey []byte                 `protobuf:"bytes,1,opt,name=current_issuer_public_key,json=currentIssuerPublicKey,proto3" json:"current_issuer_public_key,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *QueryTokenOutputsResponse) Reset() {
-	*x = QueryTokenOutputsResponse{}
-	mi := &file_spark_token_proto_msgTypes[21]
+func (x *RotateIssuerKeyResponse) Reset() {
+	*x = RotateIssuerKeyResponse{}
+	mi := &file_spark_token_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryTokenOutputsResponse) String() string {
+func (x *RotateIssuerKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTokenOutputsResponse) ProtoMessage() {}
+func (*RotateIssuerKeyResponse) ProtoMessage() {}
 
-func (x *QueryTokenOutputsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[21]
+func (x *RotateIssuerKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,41 +3067,47 @@ func (x *QueryTokenOutputsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryTokenOutputsResponse.ProtoReflect.Descriptor instead.
-func (*QueryTokenOutputsResponse) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use RotateIssuerKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateIssuerKeyResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *QueryTokenOutputsResponse) GetOutputsWithPreviousTransactionData() []*OutputWithPreviousTransactionData {
+func (x *RotateIssuerKeyResponse) GetCurrentIssuerPublicKey() []byte {
 	if x != nil {
-		return x.OutputsWithPreviousTransactionData
+		return x.CurrentIssuerPublicKey
 	}
 	return nil
 }
 
-type SpentTokenOutputMetadata struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	OutputId         string                 `protobuf:"bytes,1,opt,name=output_id,json=outputId,proto3" json:"output_id,omitempty"`
-	RevocationSecret []byte                 `protobuf:"bytes,2,opt,name=revocation_secret,json=revocationSecret,proto3" json:"revocation_secret,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type UpdateTokenAllowlistPayload struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Version         uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	TokenIdentifier []byte                 `protobuf:"bytes,2,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	OwnerPublicKey  []byte                 `protobuf:"bytes,3,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
+	// Must be later than the timestamp of any previous allowlist update for this owner and token.
+	IssuerProvidedTimestamp   uint64 `protobuf:"varint,4,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
+	OperatorIdentityPublicKey []byte `protobuf:"bytes,5,opt,name=operator_identity_public_key,json=operatorIdentityPublicKey,proto3" json:"operator_identity_public_key,omitempty"`
+	// Set to false when adding the owner to the allowlist.
+	ShouldRemove  bool `protobuf:"varint,6,opt,name=should_remove,json=shouldRemove,proto3" json:"should_remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpentTokenOutputMetadata) Reset() {
-	*x = SpentTokenOutputMetadata{}
-	mi := &file_spark_token_proto_msgTypes[22]
+func (x *UpdateTokenAllowlistPayload) Reset() {
+	*x = UpdateTokenAllowlistPayload{}
+	mi := &file_spark_token_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpentTokenOutputMetadata) String() string {
+func (x *UpdateTokenAllowlistPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpentTokenOutputMetadata) ProtoMessage() {}
+func (*UpdateTokenAllowlistPayload) ProtoMessage() {}
 
-func (x *SpentTokenOutputMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[22]
+func (x *UpdateTokenAllowlistPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1705,47 +3118,78 @@ func (x *SpentTokenOutputMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpentTokenOutputMetadata.ProtoReflect.Descriptor instead.
-func (*SpentTokenOutputMetadata) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateTokenAllowlistPayload.ProtoReflect.Descriptor instead.
+func (*UpdateTokenAllowlistPayload) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *SpentTokenOutputMetadata) GetOutputId() string {
+func (x *UpdateTokenAllowlistPayload) GetVersion() uint32 {
 	if x != nil {
-		return x.OutputId
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *SpentTokenOutputMetadata) GetRevocationSecret() []byte {
+func (x *UpdateTokenAllowlistPayload) GetTokenIdentifier() []byte {
 	if x != nil {
-		return x.RevocationSecret
+		return x.TokenIdentifier
 	}
 	return nil
 }
 
-type TokenTransactionConfirmationMetadata struct {
-	state                     protoimpl.MessageState      `protogen:"open.v1"`
-	SpentTokenOutputsMetadata []*SpentTokenOutputMetadata `protobuf:"bytes,1,rep,name=spent_token_outputs_metadata,json=spentTokenOutputsMetadata,proto3" json:"spent_token_outputs_metadata,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *UpdateTokenAllowlistPayload) GetOwnerPublicKey() []byte {
+	if x != nil {
+		return x.OwnerPublicKey
+	}
+	return nil
 }
 
-func (x *TokenTransactionConfirmationMetadata) Reset() {
-	*x = TokenTransactionConfirmationMetadata{}
-	mi := &file_spark_token_proto_msgTypes[23]
+func (x *UpdateTokenAllowlistPayload) GetIssuerProvidedTimestamp() uint64 {
+	if x != nil {
+		return x.IssuerProvidedTimestamp
+	}
+	return 0
+}
+
+func (x *UpdateTokenAllowlistPayload) GetOperatorIdentityPublicKey() []byte {
+	if x != nil {
+		return x.OperatorIdentityPublicKey
+	}
+	return nil
+}
+
+func (x *UpdateTokenAllowlistPayload) GetShouldRemove() bool {
+	if x != nil {
+		return x.ShouldRemove
+	}
+	return false
+}
+
+type UpdateTokenAllowlistRequest struct {
+	state                       protoimpl.MessageState       `protogen:"open.v1"`
+	UpdateTokenAllowlistPayload *UpdateTokenAllowlistPayload `protobuf:"bytes,1,opt,name=update_token_allowlist_payload,json=updateTokenAllowlistPayload,proto3" json:"update_token_allowlist_payload,omitempty"`
+	// Signature over the payload hash by the current issuer key.
+	// This is a Schnorr or ECDSA DER signature which can be between 64 and 73 bytes.
+	IssuerSignature []byte `protobuf:"bytes,2,opt,name=issuer_signature,json=issuerSignature,proto3" json:"issuer_signature,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateTokenAllowlistRequest) Reset() {
+	*x = UpdateTokenAllowlistRequest{}
+	mi := &file_spark_token_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenTransactionConfirmationMetadata) String() string {
+func (x *UpdateTokenAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenTransactionConfirmationMetadata) ProtoMessage() {}
+func (*UpdateTokenAllowlistRequest) ProtoMessage() {}
 
-func (x *TokenTransactionConfirmationMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[23]
+func (x *UpdateTokenAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,47 +3200,47 @@ func (x *TokenTransactionConfirmationMetadata) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenTransactionConfirmationMetadata.ProtoReflect.Descriptor instead.
-func (*TokenTransactionConfirmationMetadata) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UpdateTokenAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTokenAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *TokenTransactionConfirmationMetadata) GetSpentTokenOutputsMetadata() []*SpentTokenOutputMetadata {
+func (x *UpdateTokenAllowlistRequest) GetUpdateTokenAllowlistPayload() *UpdateTokenAllowlistPayload {
 	if x != nil {
-		return x.SpentTokenOutputsMetadata
+		return x.UpdateTokenAllowlistPayload
 	}
 	return nil
 }
 
-type TokenTransactionWithStatus struct {
-	state                protoimpl.MessageState                `protogen:"open.v1"`
-	TokenTransaction     *TokenTransaction                     `protobuf:"bytes,1,opt,name=token_transaction,json=tokenTransaction,proto3" json:"token_transaction,omitempty"`
-	Status               TokenTransactionStatus                `protobuf:"varint,2,opt,name=status,proto3,enum=spark_token.TokenTransactionStatus" json:"status,omitempty"`
-	ConfirmationMetadata *TokenTransactionConfirmationMetadata `protobuf:"bytes,3,opt,name=confirmation_metadata,json=confirmationMetadata,proto3" json:"confirmation_metadata,omitempty"`
-	// In rare cases the above reconstructed token transaction may not match the original token transaction due to:
-	// a) a pre-empted transfer transaction having its input TTXOs remapped to the newer transaction
-	// b) proto migrations or field deprecations resulting in missing/swapped fields (eg. token public key -> token identifier)
-	// Include the original hash to ensure clients can reconcile this transaction with the original if needed.
-	TokenTransactionHash []byte `protobuf:"bytes,4,opt,name=token_transaction_hash,json=tokenTransactionHash,proto3" json:"token_transaction_hash,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *UpdateTokenAllowlistRequest) GetIssuerSignature() []byte {
+	if x != nil {
+		return x.IssuerSignature
+	}
+	return nil
 }
 
-func (x *TokenTransactionWithStatus) Reset() {
-	*x = TokenTransactionWithStatus{}
-	mi := &file_spark_token_proto_msgTypes[24]
+type UpdateTokenAllowlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsAllowed     bool                   `protobuf:"varint,1,opt,name=is_allowed,json=isAllowed,proto3" json:"is_allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTokenAllowlistResponse) Reset() {
+	*x = UpdateTokenAllowlistResponse{}
+	mi := &file_spark_token_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenTransactionWithStatus) String() string {
+func (x *UpdateTokenAllowlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenTransactionWithStatus) ProtoMessage() {}
+func (*UpdateTokenAllowlistResponse) ProtoMessage() {}
 
-func (x *TokenTransactionWithStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[24]
+func (x *UpdateTokenAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1807,68 +3251,46 @@ func (x *TokenTransactionWithStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenTransactionWithStatus.ProtoReflect.Descriptor instead.
-func (*TokenTransactionWithStatus) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *TokenTransactionWithStatus) GetTokenTransaction() *TokenTransaction {
-	if x != nil {
-		return x.TokenTransaction
-	}
-	return nil
-}
-
-func (x *TokenTransactionWithStatus) GetStatus() TokenTransactionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TokenTransactionStatus_TOKEN_TRANSACTION_STARTED
-}
-
-func (x *TokenTransactionWithStatus) GetConfirmationMetadata() *TokenTransactionConfirmationMetadata {
-	if x != nil {
-		return x.ConfirmationMetadata
-	}
-	return nil
+// Deprecated: Use UpdateTokenAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTokenAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *TokenTransactionWithStatus) GetTokenTransactionHash() []byte {
+func (x *UpdateTokenAllowlistResponse) GetIsAllowed() bool {
 	if x != nil {
-		return x.TokenTransactionHash
+		return x.IsAllowed
 	}
-	return nil
+	return false
 }
 
-type FreezeTokensPayload struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	Version                   uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	OwnerPublicKey            []byte                 `protobuf:"bytes,2,opt,name=owner_public_key,json=ownerPublicKey,proto3" json:"owner_public_key,omitempty"`
-	TokenPublicKey            []byte                 `protobuf:"bytes,3,opt,name=token_public_key,json=tokenPublicKey,proto3,oneof" json:"token_public_key,omitempty"`
-	TokenIdentifier           []byte                 `protobuf:"bytes,4,opt,name=token_identifier,json=tokenIdentifier,proto3,oneof" json:"token_identifier,omitempty"`
-	IssuerProvidedTimestamp   uint64                 `protobuf:"varint,5,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
-	OperatorIdentityPublicKey []byte                 `protobuf:"bytes,6,opt,name=operator_identity_public_key,json=operatorIdentityPublicKey,proto3" json:"operator_identity_public_key,omitempty"`
-	// Set to false when requesting a freeze.
-	ShouldUnfreeze bool `protobuf:"varint,7,opt,name=should_unfreeze,json=shouldUnfreeze,proto3" json:"should_unfreeze,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type PauseTokenPayload struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Version         uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	TokenIdentifier []byte                 `protobuf:"bytes,2,opt,name=token_identifier,json=tokenIdentifier,proto3" json:"token_identifier,omitempty"`
+	// Must be later than the timestamp of any previous pause or unpause of this token.
+	IssuerProvidedTimestamp   uint64 `protobuf:"varint,3,opt,name=issuer_provided_timestamp,json=issuerProvidedTimestamp,proto3" json:"issuer_provided_timestamp,omitempty"`
+	OperatorIdentityPublicKey []byte `protobuf:"bytes,4,opt,name=operator_identity_public_key,json=operatorIdentityPublicKey,proto3" json:"operator_identity_public_key,omitempty"`
+	// Set to false when pausing the token.
+	ShouldUnpause bool `protobuf:"varint,5,opt,name=should_unpause,json=shouldUnpause,proto3" json:"should_unpause,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FreezeTokensPayload) Reset() {
-	*x = FreezeTokensPayload{}
-	mi := &file_spark_token_proto_msgTypes[25]
+func (x *PauseTokenPayload) Reset() {
+	*x = PauseTokenPayload{}
+	mi := &file_spark_token_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeTokensPayload) String() string {
+func (x *PauseTokenPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeTokensPayload) ProtoMessage() {}
+func (*PauseTokenPayload) ProtoMessage() {}
 
-func (x *FreezeTokensPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[25]
+func (x *PauseTokenPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1879,84 +3301,71 @@ func (x *FreezeTokensPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeTokensPayload.ProtoReflect.Descriptor instead.
-func (*FreezeTokensPayload) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PauseTokenPayload.ProtoReflect.Descriptor instead.
+func (*PauseTokenPayload) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *FreezeTokensPayload) GetVersion() uint32 {
+func (x *PauseTokenPayload) GetVersion() uint32 {
 	if x != nil {
 		return x.Version
 	}
 	return 0
 }
 
-func (x *FreezeTokensPayload) GetOwnerPublicKey() []byte {
-	if x != nil {
-		return x.OwnerPublicKey
-	}
-	return nil
-}
-
-func (x *FreezeTokensPayload) GetTokenPublicKey() []byte {
-	if x != nil {
-		return x.TokenPublicKey
-	}
-	return nil
-}
-
-func (x *FreezeTokensPayload) GetTokenIdentifier() []byte {
+func (x *PauseTokenPayload) GetTokenIdentifier() []byte {
 	if x != nil {
 		return x.TokenIdentifier
 	}
 	return nil
 }
 
-func (x *FreezeTokensPayload) GetIssuerProvidedTimestamp() uint64 {
+func (x *PauseTokenPayload) GetIssuerProvidedTimestamp() uint64 {
 	if x != nil {
 		return x.IssuerProvidedTimestamp
 	}
 	return 0
 }
 
-func (x *FreezeTokensPayload) GetOperatorIdentityPublicKey() []byte {
+func (x *PauseTokenPayload) GetOperatorIdentityPublicKey() []byte {
 	if x != nil {
 		return x.OperatorIdentityPublicKey
 	}
 	return nil
 }
 
-func (x *FreezeTokensPayload) GetShouldUnfreeze() bool {
+func (x *PauseTokenPayload) GetShouldUnpause() bool {
 	if x != nil {
-		return x.ShouldUnfreeze
+		return x.ShouldUnpause
 	}
 	return false
 }
 
-type FreezeTokensRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	FreezeTokensPayload *FreezeTokensPayload   `protobuf:"bytes,1,opt,name=freeze_tokens_payload,json=freezeTokensPayload,proto3" json:"freeze_tokens_payload,omitempty"`
+type PauseTokenRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PauseTokenPayload *PauseTokenPayload     `protobuf:"bytes,1,opt,name=pause_token_payload,json=pauseTokenPayload,proto3" json:"pause_token_payload,omitempty"`
+	// Signature over the payload hash by the current issuer key.
 	// This is a Schnorr or ECDSA DER signature which can be between 64 and 73 bytes.
 	IssuerSignature []byte `protobuf:"bytes,2,opt,name=issuer_signature,json=issuerSignature,proto3" json:"issuer_signature,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *FreezeTokensRequest) Reset() {
-	*x = FreezeTokensRequest{}
-	mi := &file_spark_token_proto_msgTypes[26]
+func (x *PauseTokenRequest) Reset() {
+	*x = PauseTokenRequest{}
+	mi := &file_spark_token_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeTokensRequest) String() string {
+func (x *PauseTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeTokensRequest) ProtoMessage() {}
+func (*PauseTokenRequest) ProtoMessage() {}
 
-func (x *FreezeTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[26]
+func (x *PauseTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1967,48 +3376,47 @@ func (x *FreezeTokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeTokensRequest.ProtoReflect.Descriptor instead.
-func (*FreezeTokensRequest) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PauseTokenRequest.ProtoReflect.Descriptor instead.
+func (*PauseTokenRequest) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *FreezeTokensRequest) GetFreezeTokensPayload() *FreezeTokensPayload {
+func (x *PauseTokenRequest) GetPauseTokenPayload() *PauseTokenPayload {
 	if x != nil {
-		return x.FreezeTokensPayload
+		return x.PauseTokenPayload
 	}
 	return nil
 }
 
-func (x *FreezeTokensRequest) GetIssuerSignature() []byte {
+func (x *PauseTokenRequest) GetIssuerSignature() []byte {
 	if x != nil {
 		return x.IssuerSignature
 	}
 	return nil
 }
 
-type FreezeTokensResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ImpactedOutputIds   []string               `protobuf:"bytes,1,rep,name=impacted_output_ids,json=impactedOutputIds,proto3" json:"impacted_output_ids,omitempty"`
-	ImpactedTokenAmount []byte                 `protobuf:"bytes,2,opt,name=impacted_token_amount,json=impactedTokenAmount,proto3" json:"impacted_token_amount,omitempty"` // Decoded uint128
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type PauseTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsPaused      bool                   `protobuf:"varint,1,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FreezeTokensResponse) Reset() {
-	*x = FreezeTokensResponse{}
-	mi := &file_spark_token_proto_msgTypes[27]
+func (x *PauseTokenResponse) Reset() {
+	*x = PauseTokenResponse{}
+	mi := &file_spark_token_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeTokensResponse) String() string {
+func (x *PauseTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeTokensResponse) ProtoMessage() {}
+func (*PauseTokenResponse) ProtoMessage() {}
 
-func (x *FreezeTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spark_token_proto_msgTypes[27]
+func (x *PauseTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spark_token_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,23 +3427,16 @@ func (x *FreezeTokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeTokensResponse.ProtoReflect.Descriptor instead.
-func (*FreezeTokensResponse) Descriptor() ([]byte, []int) {
-	return file_spark_token_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *FreezeTokensResponse) GetImpactedOutputIds() []string {
-	if x != nil {
-		return x.ImpactedOutputIds
-	}
-	return nil
+// Deprecated: Use PauseTokenResponse.ProtoReflect.Descriptor instead.
+func (*PauseTokenResponse) Descriptor() ([]byte, []int) {
+	return file_spark_token_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *FreezeTokensResponse) GetImpactedTokenAmount() []byte {
+func (x *PauseTokenResponse) GetIsPaused() bool {
 	if x != nil {
-		return x.ImpactedTokenAmount
+		return x.IsPaused
 	}
-	return nil
+	return false
 }
 
 var File_spark_token_proto protoreflect.FileDescriptor
@@ -2047,11 +3448,14 @@ const file_spark_token_proto_rawDesc = "" +
 	"\x1bprev_token_transaction_hash\x18\x01 \x01(\fB\a\xfaB\x04z\x02h R\x18prevTokenTransactionHash\x12=\n" +
 	"\x1bprev_token_transaction_vout\x18\x02 \x01(\rR\x18prevTokenTransactionVout\"_\n" +
 	"\x12TokenTransferInput\x12I\n" +
-	"\x10outputs_to_spend\x18\x01 \x03(\v2\x1f.spark_token.TokenOutputToSpendR\x0eoutputsToSpend\"\x93\x01\n" +
+	"\x10outputs_to_spend\x18\x01 \x03(\v2\x1f.spark_token.TokenOutputToSpendR\x0eoutputsToSpend\"\x8f\x01\n" +
+	"\x0eTokenBurnInput\x12I\n" +
+	"\x10outputs_to_spend\x18\x01 \x03(\v2\x1f.spark_token.TokenOutputToSpendR\x0eoutputsToSpend\x122\n" +
+	"\x10token_identifier\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\"\x93\x01\n" +
 	"\x0eTokenMintInput\x123\n" +
 	"\x11issuer_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x0fissuerPublicKey\x127\n" +
 	"\x10token_identifier\x18\x02 \x01(\fB\a\xfaB\x04z\x02h H\x00R\x0ftokenIdentifier\x88\x01\x01B\x13\n" +
-	"\x11_token_identifier\"\xf6\x02\n" +
+	"\x11_token_identifier\"\xac\x03\n" +
 	"\x10TokenCreateInput\x123\n" +
 	"\x11issuer_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x0fissuerPublicKey\x12&\n" +
 	"\n" +
@@ -2061,8 +3465,9 @@ const file_spark_token_proto_rawDesc = "" +
 	"\n" +
 	"max_supply\x18\x05 \x01(\fB\a\xfaB\x04z\x02h\x10R\tmaxSupply\x12!\n" +
 	"\fis_freezable\x18\x06 \x01(\bR\visFreezable\x12I\n" +
-	"\x1acreation_entity_public_key\x18\a \x01(\fB\a\xfaB\x04z\x02h!H\x00R\x17creationEntityPublicKey\x88\x01\x01B\x1d\n" +
-	"\x1b_creation_entity_public_key\"\xc7\x04\n" +
+	"\x1acreation_entity_public_key\x18\a \x01(\fB\a\xfaB\x04z\x02h!H\x00R\x17creationEntityPublicKey\x88\x01\x01\x124\n" +
+	"\x16is_transfer_restricted\x18\b \x01(\bR\x14isTransferRestrictedB\x1d\n" +
+	"\x1b_creation_entity_public_key\"\x8c\x05\n" +
 	"\vTokenOutput\x12\x1d\n" +
 	"\x02id\x18\x01 \x01(\tB\b\xfaB\x05r\x03\xb0\x01\x01H\x00R\x02id\x88\x01\x01\x121\n" +
 	"\x10owner_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x0eownerPublicKey\x12A\n" +
@@ -2071,19 +3476,22 @@ const file_spark_token_proto_rawDesc = "" +
 	" withdraw_relative_block_locktime\x18\x05 \x01(\x04H\x03R\x1dwithdrawRelativeBlockLocktime\x88\x01\x01\x126\n" +
 	"\x10token_public_key\x18\x06 \x01(\fB\a\xfaB\x04z\x02h!H\x04R\x0etokenPublicKey\x88\x01\x01\x127\n" +
 	"\x10token_identifier\x18\b \x01(\fB\a\xfaB\x04z\x02h H\x05R\x0ftokenIdentifier\x88\x01\x01\x12*\n" +
-	"\ftoken_amount\x18\a \x01(\fB\a\xfaB\x04z\x02h\x10R\vtokenAmountB\x05\n" +
+	"\ftoken_amount\x18\a \x01(\fB\a\xfaB\x04z\x02h\x10R\vtokenAmount\x12C\n" +
+	"\x0fspendable_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0espendableAfterB\x05\n" +
 	"\x03_idB\x18\n" +
 	"\x16_revocation_commitmentB\x15\n" +
 	"\x13_withdraw_bond_satsB#\n" +
 	"!_withdraw_relative_block_locktimeB\x13\n" +
 	"\x11_token_public_keyB\x13\n" +
-	"\x11_token_identifier\"\xbb\x05\n" +
+	"\x11_token_identifier\"\xf9\x05\n" +
 	"\x10TokenTransaction\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\rR\aversion\x12<\n" +
 	"\n" +
 	"mint_input\x18\x02 \x01(\v2\x1b.spark_token.TokenMintInputH\x00R\tmintInput\x12H\n" +
 	"\x0etransfer_input\x18\x03 \x01(\v2\x1f.spark_token.TokenTransferInputH\x00R\rtransferInput\x12B\n" +
-	"\fcreate_input\x18\b \x01(\v2\x1d.spark_token.TokenCreateInputH\x00R\vcreateInput\x12=\n" +
+	"\fcreate_input\x18\b \x01(\v2\x1d.spark_token.TokenCreateInputH\x00R\vcreateInput\x12<\n" +
+	"\n" +
+	"burn_input\x18\v \x01(\v2\x1b.spark_token.TokenBurnInputH\x00R\tburnInput\x12=\n" +
 	"\rtoken_outputs\x18\x04 \x03(\v2\x18.spark_token.TokenOutputR\ftokenOutputs\x12Z\n" +
 	"#spark_operator_identity_public_keys\x18\x05 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h!R\x1fsparkOperatorIdentityPublicKeys\x12;\n" +
 	"\vexpiry_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
@@ -2101,13 +3509,14 @@ const file_spark_token_proto_rawDesc = "" +
 	"inputIndex\"\xb4\x01\n" +
 	"\x1eInputTtxoSignaturesPerOperator\x12H\n" +
 	"\x0fttxo_signatures\x18\x01 \x03(\v2\x1f.spark_token.SignatureWithIndexR\x0ettxoSignatures\x12H\n" +
-	"\x1coperator_identity_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\"\xf2\x02\n" +
+	"\x1coperator_identity_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\"\xa4\x03\n" +
 	"\x17StartTransactionRequest\x127\n" +
 	"\x13identity_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x11identityPublicKey\x12Y\n" +
 	"\x19partial_token_transaction\x18\x02 \x01(\v2\x1d.spark_token.TokenTransactionR\x17partialTokenTransaction\x12{\n" +
 	"*partial_token_transaction_owner_signatures\x18\x03 \x03(\v2\x1f.spark_token.SignatureWithIndexR&partialTokenTransactionOwnerSignatures\x12F\n" +
 	"\x19validity_duration_seconds\x18\x04 \x01(\x04B\n" +
-	"\xfaB\a2\x05\x18\xac\x02(\x01R\x17validityDurationSeconds\"\xae\x01\n" +
+	"\xfaB\a2\x05\x18\xac\x02(\x01R\x17validityDurationSeconds\x120\n" +
+	"\x14spark_payment_intent\x18\x05 \x01(\tR\x12sparkPaymentIntent\"\xae\x01\n" +
 	"\x18StartTransactionResponse\x12U\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x1d.spark_token.TokenTransactionR\x15finalTokenTransaction\x12;\n" +
 	"\rkeyshare_info\x18\x02 \x01(\v2\x16.spark.SigningKeyshareR\fkeyshareInfo\"\xf8\x02\n" +
@@ -2124,7 +3533,7 @@ const file_spark_token_proto_rawDesc = "" +
 	"\x0fcommit_progress\x18\x02 \x01(\v2\x1b.spark_token.CommitProgressR\x0ecommitProgress\"\x92\x01\n" +
 	"\x19QueryTokenMetadataRequest\x129\n" +
 	"\x11token_identifiers\x18\x01 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h R\x10tokenIdentifiers\x12:\n" +
-	"\x12issuer_public_keys\x18\x02 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h!R\x10issuerPublicKeys\"\xa7\x03\n" +
+	"\x12issuer_public_keys\x18\x02 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h!R\x10issuerPublicKeys\"\x90\x05\n" +
 	"\rTokenMetadata\x123\n" +
 	"\x11issuer_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x0fissuerPublicKey\x12&\n" +
 	"\n" +
@@ -2135,15 +3544,24 @@ const file_spark_token_proto_rawDesc = "" +
 	"max_supply\x18\x05 \x01(\fB\a\xfaB\x04z\x02h\x10R\tmaxSupply\x12!\n" +
 	"\fis_freezable\x18\x06 \x01(\bR\visFreezable\x12I\n" +
 	"\x1acreation_entity_public_key\x18\a \x01(\fB\a\xfaB\x04z\x02h!H\x00R\x17creationEntityPublicKey\x88\x01\x01\x122\n" +
-	"\x10token_identifier\x18\b \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifierB\x1d\n" +
-	"\x1b_creation_entity_public_key\"_\n" +
+	"\x10token_identifier\x18\b \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x12B\n" +
+	"\x19current_issuer_public_key\x18\t \x01(\fB\a\xfaB\x04z\x02h!R\x16currentIssuerPublicKey\x12P\n" +
+	"\x14issuer_key_rotations\x18\n" +
+	" \x03(\v2\x1e.spark_token.IssuerKeyRotationR\x12issuerKeyRotations\x124\n" +
+	"\x16is_transfer_restricted\x18\v \x01(\bR\x14isTransferRestricted\x12\x1b\n" +
+	"\tis_paused\x18\f \x01(\bR\bisPausedB\x1d\n" +
+	"\x1b_creation_entity_public_key\"\xd1\x01\n" +
+	"\x11IssuerKeyRotation\x12D\n" +
+	"\x1aprevious_issuer_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x17previousIssuerPublicKey\x12:\n" +
+	"\x15new_issuer_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x12newIssuerPublicKey\x12:\n" +
+	"\x19issuer_provided_timestamp\x18\x03 \x01(\x04R\x17issuerProvidedTimestamp\"_\n" +
 	"\x1aQueryTokenMetadataResponse\x12A\n" +
 	"\x0etoken_metadata\x18\x01 \x03(\v2\x1a.spark_token.TokenMetadataR\rtokenMetadata\"\xf5\x01\n" +
 	"\x18QueryTokenOutputsRequest\x128\n" +
 	"\x11owner_public_keys\x18\x01 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h!R\x0fownerPublicKeys\x12:\n" +
 	"\x12issuer_public_keys\x18\x02 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h!R\x10issuerPublicKeys\x129\n" +
 	"\x11token_identifiers\x18\x04 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h R\x10tokenIdentifiers\x12(\n" +
-	"\anetwork\x18\x03 \x01(\x0e2\x0e.spark.NetworkR\anetwork\"\xf4\x02\n" +
+	"\anetwork\x18\x03 \x01(\x0e2\x0e.spark.NetworkR\anetwork\"\xf0\x05\n" +
 	"\x1dQueryTokenTransactionsRequest\x12,\n" +
 	"\n" +
 	"output_ids\x18\x01 \x03(\tB\r\xfaB\n" +
@@ -2153,26 +3571,63 @@ const file_spark_token_proto_rawDesc = "" +
 	"\x11token_identifiers\x18\a \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h R\x10tokenIdentifiers\x12F\n" +
 	"\x18token_transaction_hashes\x18\x04 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h R\x16tokenTransactionHashes\x12\x14\n" +
 	"\x05limit\x18\x05 \x01(\x03R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x06 \x01(\x03R\x06offset\"\xa6\x01\n" +
+	"\x06offset\x18\x06 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12?\n" +
+	"\rcreated_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12A\n" +
+	"\x0ecreated_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\rcreatedBefore\x12?\n" +
+	"\bstatuses\x18\v \x03(\x0e2#.spark_token.TokenTransactionStatusR\bstatuses\x12\"\n" +
+	"\x05order\x18\f \x01(\x0e2\f.spark.OrderR\x05order\x122\n" +
+	"\x15spark_payment_intents\x18\r \x03(\tR\x13sparkPaymentIntents\x12E\n" +
+	"\n" +
+	"sort_field\x18\x0e \x01(\x0e2&.spark_token.TokenTransactionSortFieldR\tsortField\"\xc7\x01\n" +
 	"\x1eQueryTokenTransactionsResponse\x12l\n" +
 	"\x1etoken_transactions_with_status\x18\x01 \x03(\v2'.spark_token.TokenTransactionWithStatusR\x1btokenTransactionsWithStatus\x12\x16\n" +
-	"\x06offset\x18\x02 \x01(\x03R\x06offset\"\xd6\x01\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xd6\x01\n" +
 	"!OutputWithPreviousTransactionData\x120\n" +
 	"\x06output\x18\x01 \x01(\v2\x18.spark_token.TokenOutputR\x06output\x12C\n" +
 	"\x19previous_transaction_hash\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\x17previousTransactionHash\x12:\n" +
 	"\x19previous_transaction_vout\x18\x03 \x01(\rR\x17previousTransactionVout\"\xa0\x01\n" +
 	"\x19QueryTokenOutputsResponse\x12\x82\x01\n" +
-	"&outputs_with_previous_transaction_data\x18\x01 \x03(\v2..spark_token.OutputWithPreviousTransactionDataR\"outputsWithPreviousTransactionData\"d\n" +
+	"&outputs_with_previous_transaction_data\x18\x01 \x03(\v2..spark_token.OutputWithPreviousTransactionDataR\"outputsWithPreviousTransactionData\"\xb6\x01\n" +
+	"\x18QueryTokenHoldersRequest\x122\n" +
+	"\x10token_identifier\x18\x01 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x128\n" +
+	"\n" +
+	"as_of_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\basOfTime\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\"}\n" +
+	"\vTokenHolder\x121\n" +
+	"\x10owner_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x0eownerPublicKey\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\fR\abalance\x12!\n" +
+	"\foutput_count\x18\x03 \x01(\rR\voutputCount\"r\n" +
+	"\x19QueryTokenHoldersResponse\x12=\n" +
+	"\rtoken_holders\x18\x01 \x03(\v2\x18.spark_token.TokenHolderR\ftokenHolders\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"T\n" +
+	"\x17QueryTokenSupplyRequest\x129\n" +
+	"\x11token_identifiers\x18\x01 \x03(\fB\f\xfaB\t\x92\x01\x06\"\x04z\x02h R\x10tokenIdentifiers\"\xad\x02\n" +
+	"\vTokenSupply\x122\n" +
+	"\x10token_identifier\x18\x01 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x12&\n" +
+	"\n" +
+	"max_supply\x18\x02 \x01(\fB\a\xfaB\x04z\x02h\x10R\tmaxSupply\x12,\n" +
+	"\rminted_supply\x18\x03 \x01(\fB\a\xfaB\x04z\x02h\x10R\fmintedSupply\x126\n" +
+	"\x12circulating_supply\x18\x04 \x01(\fB\a\xfaB\x04z\x02h\x10R\x11circulatingSupply\x12,\n" +
+	"\rfrozen_supply\x18\x05 \x01(\fB\a\xfaB\x04z\x02h\x10R\ffrozenSupply\x12.\n" +
+	"\x13frozen_output_count\x18\x06 \x01(\rR\x11frozenOutputCount\"[\n" +
+	"\x18QueryTokenSupplyResponse\x12?\n" +
+	"\x0etoken_supplies\x18\x01 \x03(\v2\x18.spark_token.TokenSupplyR\rtokenSupplies\"d\n" +
 	"\x18SpentTokenOutputMetadata\x12\x1b\n" +
 	"\toutput_id\x18\x01 \x01(\tR\boutputId\x12+\n" +
 	"\x11revocation_secret\x18\x02 \x01(\fR\x10revocationSecret\"\x8e\x01\n" +
 	"$TokenTransactionConfirmationMetadata\x12f\n" +
-	"\x1cspent_token_outputs_metadata\x18\x01 \x03(\v2%.spark_token.SpentTokenOutputMetadataR\x19spentTokenOutputsMetadata\"\xcc\x02\n" +
+	"\x1cspent_token_outputs_metadata\x18\x01 \x03(\v2%.spark_token.SpentTokenOutputMetadataR\x19spentTokenOutputsMetadata\"\xfe\x02\n" +
 	"\x1aTokenTransactionWithStatus\x12J\n" +
 	"\x11token_transaction\x18\x01 \x01(\v2\x1d.spark_token.TokenTransactionR\x10tokenTransaction\x12;\n" +
 	"\x06status\x18\x02 \x01(\x0e2#.spark_token.TokenTransactionStatusR\x06status\x12f\n" +
 	"\x15confirmation_metadata\x18\x03 \x01(\v21.spark_token.TokenTransactionConfirmationMetadataR\x14confirmationMetadata\x12=\n" +
-	"\x16token_transaction_hash\x18\x04 \x01(\fB\a\xfaB\x04z\x02h R\x14tokenTransactionHash\"\xac\x03\n" +
+	"\x16token_transaction_hash\x18\x04 \x01(\fB\a\xfaB\x04z\x02h R\x14tokenTransactionHash\x120\n" +
+	"\x14spark_payment_intent\x18\x05 \x01(\tR\x12sparkPaymentIntent\"\xe4\x03\n" +
 	"\x13FreezeTokensPayload\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\rR\aversion\x121\n" +
 	"\x10owner_public_key\x18\x02 \x01(\fB\a\xfaB\x04z\x02h!R\x0eownerPublicKey\x126\n" +
@@ -2180,7 +3635,8 @@ const file_spark_token_proto_rawDesc = "" +
 	"\x10token_identifier\x18\x04 \x01(\fB\a\xfaB\x04z\x02h H\x01R\x0ftokenIdentifier\x88\x01\x01\x12:\n" +
 	"\x19issuer_provided_timestamp\x18\x05 \x01(\x04R\x17issuerProvidedTimestamp\x12H\n" +
 	"\x1coperator_identity_public_key\x18\x06 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\x12'\n" +
-	"\x0fshould_unfreeze\x18\a \x01(\bR\x0eshouldUnfreezeB\x13\n" +
+	"\x0fshould_unfreeze\x18\a \x01(\bR\x0eshouldUnfreeze\x126\n" +
+	"\x17freeze_expiry_timestamp\x18\b \x01(\x04R\x15freezeExpiryTimestampB\x13\n" +
 	"\x11_token_public_keyB\x13\n" +
 	"\x11_token_identifier\"\xa1\x01\n" +
 	"\x13FreezeTokensRequest\x12T\n" +
@@ -2189,16 +3645,67 @@ const file_spark_token_proto_rawDesc = "" +
 	"\x14FreezeTokensResponse\x12=\n" +
 	"\x13impacted_output_ids\x18\x01 \x03(\tB\r\xfaB\n" +
 	"\x92\x01\a\"\x05r\x03\xb0\x01\x01R\x11impactedOutputIds\x122\n" +
-	"\x15impacted_token_amount\x18\x02 \x01(\fR\x13impactedTokenAmount*\xa7\x01\n" +
+	"\x15impacted_token_amount\x18\x02 \x01(\fR\x13impactedTokenAmount\"|\n" +
+	"\x18QueryTokenFreezesRequest\x122\n" +
+	"\x10token_identifier\x18\x01 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\"\xbb\x01\n" +
+	"\x0fTokenFreezeInfo\x121\n" +
+	"\x10owner_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x0eownerPublicKey\x12:\n" +
+	"\x19issuer_provided_timestamp\x18\x02 \x01(\x04R\x17issuerProvidedTimestamp\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"v\n" +
+	"\x19QueryTokenFreezesResponse\x12A\n" +
+	"\rtoken_freezes\x18\x01 \x03(\v2\x1c.spark_token.TokenFreezeInfoR\ftokenFreezes\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\"\xa8\x02\n" +
+	"\x16RotateIssuerKeyPayload\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x122\n" +
+	"\x10token_identifier\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x12:\n" +
+	"\x15new_issuer_public_key\x18\x03 \x01(\fB\a\xfaB\x04z\x02h!R\x12newIssuerPublicKey\x12:\n" +
+	"\x19issuer_provided_timestamp\x18\x04 \x01(\x04R\x17issuerProvidedTimestamp\x12H\n" +
+	"\x1coperator_identity_public_key\x18\x05 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\"\xae\x01\n" +
+	"\x16RotateIssuerKeyRequest\x12^\n" +
+	"\x19rotate_issuer_key_payload\x18\x01 \x01(\v2#.spark_token.RotateIssuerKeyPayloadR\x16rotateIssuerKeyPayload\x124\n" +
+	"\x10issuer_signature\x18\x02 \x01(\fB\t\xfaB\x06z\x04\x10@\x18IR\x0fissuerSignature\"]\n" +
+	"\x17RotateIssuerKeyResponse\x12B\n" +
+	"\x19current_issuer_public_key\x18\x01 \x01(\fB\a\xfaB\x04z\x02h!R\x16currentIssuerPublicKey\"\xc9\x02\n" +
+	"\x1bUpdateTokenAllowlistPayload\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x122\n" +
+	"\x10token_identifier\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x121\n" +
+	"\x10owner_public_key\x18\x03 \x01(\fB\a\xfaB\x04z\x02h!R\x0eownerPublicKey\x12:\n" +
+	"\x19issuer_provided_timestamp\x18\x04 \x01(\x04R\x17issuerProvidedTimestamp\x12H\n" +
+	"\x1coperator_identity_public_key\x18\x05 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\x12#\n" +
+	"\rshould_remove\x18\x06 \x01(\bR\fshouldRemove\"\xc2\x01\n" +
+	"\x1bUpdateTokenAllowlistRequest\x12m\n" +
+	"\x1eupdate_token_allowlist_payload\x18\x01 \x01(\v2(.spark_token.UpdateTokenAllowlistPayloadR\x1bupdateTokenAllowlistPayload\x124\n" +
+	"\x10issuer_signature\x18\x02 \x01(\fB\t\xfaB\x06z\x04\x10@\x18IR\x0fissuerSignature\"=\n" +
+	"\x1cUpdateTokenAllowlistResponse\x12\x1d\n" +
+	"\n" +
+	"is_allowed\x18\x01 \x01(\bR\tisAllowed\"\x8e\x02\n" +
+	"\x11PauseTokenPayload\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x122\n" +
+	"\x10token_identifier\x18\x02 \x01(\fB\a\xfaB\x04z\x02h R\x0ftokenIdentifier\x12:\n" +
+	"\x19issuer_provided_timestamp\x18\x03 \x01(\x04R\x17issuerProvidedTimestamp\x12H\n" +
+	"\x1coperator_identity_public_key\x18\x04 \x01(\fB\a\xfaB\x04z\x02h!R\x19operatorIdentityPublicKey\x12%\n" +
+	"\x0eshould_unpause\x18\x05 \x01(\bR\rshouldUnpause\"\x99\x01\n" +
+	"\x11PauseTokenRequest\x12N\n" +
+	"\x13pause_token_payload\x18\x01 \x01(\v2\x1e.spark_token.PauseTokenPayloadR\x11pauseTokenPayload\x124\n" +
+	"\x10issuer_signature\x18\x02 \x01(\fB\t\xfaB\x06z\x04\x10@\x18IR\x0fissuerSignature\"1\n" +
+	"\x12PauseTokenResponse\x12\x1b\n" +
+	"\tis_paused\x18\x01 \x01(\bR\bisPaused*\xc8\x01\n" +
 	"\x14TokenTransactionType\x12&\n" +
 	"\"TOKEN_TRANSACTION_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
 	"\x1dTOKEN_TRANSACTION_TYPE_CREATE\x10\x01\x12\x1f\n" +
 	"\x1bTOKEN_TRANSACTION_TYPE_MINT\x10\x02\x12#\n" +
-	"\x1fTOKEN_TRANSACTION_TYPE_TRANSFER\x10\x03*S\n" +
+	"\x1fTOKEN_TRANSACTION_TYPE_TRANSFER\x10\x03\x12\x1f\n" +
+	"\x1bTOKEN_TRANSACTION_TYPE_BURN\x10\x04*S\n" +
 	"\fCommitStatus\x12\x16\n" +
 	"\x12COMMIT_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11COMMIT_PROCESSING\x10\x01\x12\x14\n" +
-	"\x10COMMIT_FINALIZED\x10\x02*\x86\x02\n" +
+	"\x10COMMIT_FINALIZED\x10\x02*w\n" +
+	"\x19TokenTransactionSortField\x12,\n" +
+	"(TOKEN_TRANSACTION_SORT_FIELD_UPDATE_TIME\x10\x00\x12,\n" +
+	"(TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME\x10\x01*\x86\x02\n" +
 	"\x16TokenTransactionStatus\x12\x1d\n" +
 	"\x19TOKEN_TRANSACTION_STARTED\x10\x00\x12\x1c\n" +
 	"\x18TOKEN_TRANSACTION_SIGNED\x10\x01\x12\x1e\n" +
@@ -2207,14 +3714,20 @@ const file_spark_token_proto_rawDesc = "" +
 	"#TOKEN_TRANSACTION_STARTED_CANCELLED\x10\x03\x12&\n" +
 	"\"TOKEN_TRANSACTION_SIGNED_CANCELLED\x10\x04\x12\x1d\n" +
 	"\x19TOKEN_TRANSACTION_UNKNOWN\x10\n" +
-	"2\x80\x05\n" +
+	"2\xda\t\n" +
 	"\x11SparkTokenService\x12b\n" +
 	"\x11start_transaction\x12$.spark_token.StartTransactionRequest\x1a%.spark_token.StartTransactionResponse\"\x00\x12e\n" +
 	"\x12commit_transaction\x12%.spark_token.CommitTransactionRequest\x1a&.spark_token.CommitTransactionResponse\"\x00\x12i\n" +
 	"\x14query_token_metadata\x12&.spark_token.QueryTokenMetadataRequest\x1a'.spark_token.QueryTokenMetadataResponse\"\x00\x12u\n" +
 	"\x18query_token_transactions\x12*.spark_token.QueryTokenTransactionsRequest\x1a+.spark_token.QueryTokenTransactionsResponse\"\x00\x12f\n" +
-	"\x13query_token_outputs\x12%.spark_token.QueryTokenOutputsRequest\x1a&.spark_token.QueryTokenOutputsResponse\"\x00\x12V\n" +
-	"\rfreeze_tokens\x12 .spark_token.FreezeTokensRequest\x1a!.spark_token.FreezeTokensResponse\"\x00B2Z0github.com/lightsparkdev/spark/proto/spark_tokenb\x06proto3"
+	"\x13query_token_outputs\x12%.spark_token.QueryTokenOutputsRequest\x1a&.spark_token.QueryTokenOutputsResponse\"\x00\x12f\n" +
+	"\x13query_token_holders\x12%.spark_token.QueryTokenHoldersRequest\x1a&.spark_token.QueryTokenHoldersResponse\"\x00\x12c\n" +
+	"\x12query_token_supply\x12$.spark_token.QueryTokenSupplyRequest\x1a%.spark_token.QueryTokenSupplyResponse\"\x00\x12f\n" +
+	"\x13query_token_freezes\x12%.spark_token.QueryTokenFreezesRequest\x1a&.spark_token.QueryTokenFreezesResponse\"\x00\x12V\n" +
+	"\rfreeze_tokens\x12 .spark_token.FreezeTokensRequest\x1a!.spark_token.FreezeTokensResponse\"\x00\x12`\n" +
+	"\x11rotate_issuer_key\x12#.spark_token.RotateIssuerKeyRequest\x1a$.spark_token.RotateIssuerKeyResponse\"\x00\x12o\n" +
+	"\x16update_token_allowlist\x12(.spark_token.UpdateTokenAllowlistRequest\x1a).spark_token.UpdateTokenAllowlistResponse\"\x00\x12P\n" +
+	"\vpause_token\x12\x1e.spark_token.PauseTokenRequest\x1a\x1f.spark_token.PauseTokenResponse\"\x00B2Z0github.com/lightsparkdev/spark/proto/spark_tokenb\x06proto3"
 
 var (
 	file_spark_token_proto_rawDescOnce sync.Once
@@ -2228,90 +3741,141 @@ func file_spark_token_proto_rawDescGZIP() []byte {
 	return file_spark_token_proto_rawDescData
 }
 
-var file_spark_token_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_spark_token_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_spark_token_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_spark_token_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_spark_token_proto_goTypes = []any{
 	(TokenTransactionType)(0),                    // 0: spark_token.TokenTransactionType
 	(CommitStatus)(0),                            // 1: spark_token.CommitStatus
-	(TokenTransactionStatus)(0),                  // 2: spark_token.TokenTransactionStatus
-	(*TokenOutputToSpend)(nil),                   // 3: spark_token.TokenOutputToSpend
-	(*TokenTransferInput)(nil),                   // 4: spark_token.TokenTransferInput
-	(*TokenMintInput)(nil),                       // 5: spark_token.TokenMintInput
-	(*TokenCreateInput)(nil),                     // 6: spark_token.TokenCreateInput
-	(*TokenOutput)(nil),                          // 7: spark_token.TokenOutput
-	(*TokenTransaction)(nil),                     // 8: spark_token.TokenTransaction
-	(*InvoiceAttachment)(nil),                    // 9: spark_token.InvoiceAttachment
-	(*SignatureWithIndex)(nil),                   // 10: spark_token.SignatureWithIndex
-	(*InputTtxoSignaturesPerOperator)(nil),       // 11: spark_token.InputTtxoSignaturesPerOperator
-	(*StartTransactionRequest)(nil),              // 12: spark_token.StartTransactionRequest
-	(*StartTransactionResponse)(nil),             // 13: spark_token.StartTransactionResponse
-	(*CommitTransactionRequest)(nil),             // 14: spark_token.CommitTransactionRequest
-	(*CommitProgress)(nil),                       // 15: spark_token.CommitProgress
-	(*CommitTransactionResponse)(nil),            // 16: spark_token.CommitTransactionResponse
-	(*QueryTokenMetadataRequest)(nil),            // 17: spark_token.QueryTokenMetadataRequest
-	(*TokenMetadata)(nil),                        // 18: spark_token.TokenMetadata
-	(*QueryTokenMetadataResponse)(nil),           // 19: spark_token.QueryTokenMetadataResponse
-	(*QueryTokenOutputsRequest)(nil),             // 20: spark_token.QueryTokenOutputsRequest
-	(*QueryTokenTransactionsRequest)(nil),        // 21: spark_token.QueryTokenTransactionsRequest
-	(*QueryTokenTransactionsResponse)(nil),       // 22: spark_token.QueryTokenTransactionsResponse
-	(*OutputWithPreviousTransactionData)(nil),    // 23: spark_token.OutputWithPreviousTransactionData
-	(*QueryTokenOutputsResponse)(nil),            // 24: spark_token.QueryTokenOutputsResponse
-	(*SpentTokenOutputMetadata)(nil),             // 25: spark_token.SpentTokenOutputMetadata
-	(*TokenTransactionConfirmationMetadata)(nil), // 26: spark_token.TokenTransactionConfirmationMetadata
-	(*TokenTransactionWithStatus)(nil),           // 27: spark_token.TokenTransactionWithStatus
-	(*FreezeTokensPayload)(nil),                  // 28: spark_token.FreezeTokensPayload
-	(*FreezeTokensRequest)(nil),                  // 29: spark_token.FreezeTokensRequest
-	(*FreezeTokensResponse)(nil),                 // 30: spark_token.FreezeTokensResponse
-	(*timestamppb.Timestamp)(nil),                // 31: google.protobuf.Timestamp
-	(spark.Network)(0),                           // 32: spark.Network
-	(*spark.SigningKeyshare)(nil),                // 33: spark.SigningKeyshare
+	(TokenTransactionSortField)(0),               // 2: spark_token.TokenTransactionSortField
+	(TokenTransactionStatus)(0),                  // 3: spark_token.TokenTransactionStatus
+	(*TokenOutputToSpend)(nil),                   // 4: spark_token.TokenOutputToSpend
+	(*TokenTransferInput)(nil),                   // 5: spark_token.TokenTransferInput
+	(*TokenBurnInput)(nil),                       // 6: spark_token.TokenBurnInput
+	(*TokenMintInput)(nil),                       // 7: spark_token.TokenMintInput
+	(*TokenCreateInput)(nil),                     // 8: spark_token.TokenCreateInput
+	(*TokenOutput)(nil),                          // 9: spark_token.TokenOutput
+	(*TokenTransaction)(nil),                     // 10: spark_token.TokenTransaction
+	(*InvoiceAttachment)(nil),                    // 11: spark_token.InvoiceAttachment
+	(*SignatureWithIndex)(nil),                   // 12: spark_token.SignatureWithIndex
+	(*InputTtxoSignaturesPerOperator)(nil),       // 13: spark_token.InputTtxoSignaturesPerOperator
+	(*StartTransactionRequest)(nil),              // 14: spark_token.StartTransactionRequest
+	(*StartTransactionResponse)(nil),             // 15: spark_token.StartTransactionResponse
+	(*CommitTransactionRequest)(nil),             // 16: spark_token.CommitTransactionRequest
+	(*CommitProgress)(nil),                       // 17: spark_token.CommitProgress
+	(*CommitTransactionResponse)(nil),            // 18: spark_token.CommitTransactionResponse
+	(*QueryTokenMetadataRequest)(nil),            // 19: spark_token.QueryTokenMetadataRequest
+	(*TokenMetadata)(nil),                        // 20: spark_token.TokenMetadata
+	(*IssuerKeyRotation)(nil),                    // 21: spark_token.IssuerKeyRotation
+	(*QueryTokenMetadataResponse)(nil),           // 22: spark_token.QueryTokenMetadataResponse
+	(*QueryTokenOutputsRequest)(nil),             // 23: spark_token.QueryTokenOutputsRequest
+	(*QueryTokenTransactionsRequest)(nil),        // 24: spark_token.QueryTokenTransactionsRequest
+	(*QueryTokenTransactionsResponse)(nil),       // 25: spark_token.QueryTokenTransactionsResponse
+	(*OutputWithPreviousTransactionData)(nil),    // 26: spark_token.OutputWithPreviousTransactionData
+	(*QueryTokenOutputsResponse)(nil),            // 27: spark_token.QueryTokenOutputsResponse
+	(*QueryTokenHoldersRequest)(nil),             // 28: spark_token.QueryTokenHoldersRequest
+	(*TokenHolder)(nil),                          // 29: spark_token.TokenHolder
+	(*QueryTokenHoldersResponse)(nil),            // 30: spark_token.QueryTokenHoldersResponse
+	(*QueryTokenSupplyRequest)(nil),              // 31: spark_token.QueryTokenSupplyRequest
+	(*TokenSupply)(nil),                          // 32: spark_token.TokenSupply
+	(*QueryTokenSupplyResponse)(nil),             // 33: spark_token.QueryTokenSupplyResponse
+	(*SpentTokenOutputMetadata)(nil),             // 34: spark_token.SpentTokenOutputMetadata
+	(*TokenTransactionConfirmationMetadata)(nil), // 35: spark_token.TokenTransactionConfirmationMetadata
+	(*TokenTransactionWithStatus)(nil),           // 36: spark_token.TokenTransactionWithStatus
+	(*FreezeTokensPayload)(nil),                  // 37: spark_token.FreezeTokensPayload
+	(*FreezeTokensRequest)(nil),                  // 38: spark_token.FreezeTokensRequest
+	(*FreezeTokensResponse)(nil),                 // 39: spark_token.FreezeTokensResponse
+	(*QueryTokenFreezesRequest)(nil),             // 40: spark_token.QueryTokenFreezesRequest
+	(*TokenFreezeInfo)(nil),                      // 41: spark_token.TokenFreezeInfo
+	(*QueryTokenFreezesResponse)(nil),            // 42: spark_token.QueryTokenFreezesResponse
+	(*RotateIssuerKeyPayload)(nil),               // 43: spark_token.RotateIssuerKeyPayload
+	(*RotateIssuerKeyRequest)(nil),               // 44: spark_token.RotateIssuerKeyRequest
+	(*RotateIssuerKeyResponse)(nil),              // 45: spark_token.RotateIssuerKeyResponse
+	(*UpdateTokenAllowlistPayload)(nil),          // 46: spark_token.UpdateTokenAllowlistPayload
+	(*UpdateTokenAllowlistRequest)(nil),          // 47: spark_token.UpdateTokenAllowlistRequest
+	(*UpdateTokenAllowlistResponse)(nil),         // 48: spark_token.UpdateTokenAllowlistResponse
+	(*PauseTokenPayload)(nil),                    // 49: spark_token.PauseTokenPayload
+	(*PauseTokenRequest)(nil),                    // 50: spark_token.PauseTokenRequest
+	(*PauseTokenResponse)(nil),                   // 51: spark_token.PauseTokenResponse
+	(*timestamppb.Timestamp)(nil),                // 52: google.protobuf.Timestamp
+	(spark.Network)(0),                           // 53: spark.Network
+	(*spark.SigningKeyshare)(nil),                // 54: spark.SigningKeyshare
+	(spark.Order)(0),                             // 55: spark.Order
 }
 var file_spark_token_proto_depIdxs = []int32{
-	3,  // 0: spark_token.TokenTransferInput.outputs_to_spend:type_name -> spark_token.TokenOutputToSpend
-	5,  // 1: spark_token.TokenTransaction.mint_input:type_name -> spark_token.TokenMintInput
-	4,  // 2: spark_token.TokenTransaction.transfer_input:type_name -> spark_token.TokenTransferInput
-	6,  // 3: spark_token.TokenTransaction.create_input:type_name -> spark_token.TokenCreateInput
-	7,  // 4: spark_token.TokenTransaction.token_outputs:type_name -> spark_token.TokenOutput
-	31, // 5: spark_token.TokenTransaction.expiry_time:type_name -> google.protobuf.Timestamp
-	32, // 6: spark_token.TokenTransaction.network:type_name -> spark.Network
-	31, // 7: spark_token.TokenTransaction.client_created_timestamp:type_name -> google.protobuf.Timestamp
-	9,  // 8: spark_token.TokenTransaction.invoice_attachments:type_name -> spark_token.InvoiceAttachment
-	10, // 9: spark_token.InputTtxoSignaturesPerOperator.ttxo_signatures:type_name -> spark_token.SignatureWithIndex
-	8,  // 10: spark_token.StartTransactionRequest.partial_token_transaction:type_name -> spark_token.TokenTransaction
-	10, // 11: spark_token.StartTransactionRequest.partial_token_transaction_owner_signatures:type_name -> spark_token.SignatureWithIndex
-	8,  // 12: spark_token.StartTransactionResponse.final_token_transaction:type_name -> spark_token.TokenTransaction
-	33, // 13: spark_token.StartTransactionResponse.keyshare_info:type_name -> spark.SigningKeyshare
-	8,  // 14: spark_token.CommitTransactionRequest.final_token_transaction:type_name -> spark_token.TokenTransaction
-	11, // 15: spark_token.CommitTransactionRequest.input_ttxo_signatures_per_operator:type_name -> spark_token.InputTtxoSignaturesPerOperator
-	1,  // 16: spark_token.CommitTransactionResponse.commit_status:type_name -> spark_token.CommitStatus
-	15, // 17: spark_token.CommitTransactionResponse.commit_progress:type_name -> spark_token.CommitProgress
-	18, // 18: spark_token.QueryTokenMetadataResponse.token_metadata:type_name -> spark_token.TokenMetadata
-	32, // 19: spark_token.QueryTokenOutputsRequest.network:type_name -> spark.Network
-	27, // 20: spark_token.QueryTokenTransactionsResponse.token_transactions_with_status:type_name -> spark_token.TokenTransactionWithStatus
-	7,  // 21: spark_token.OutputWithPreviousTransactionData.output:type_name -> spark_token.TokenOutput
-	23, // 22: spark_token.QueryTokenOutputsResponse.outputs_with_previous_transaction_data:type_name -> spark_token.OutputWithPreviousTransactionData
-	25, // 23: spark_token.TokenTransactionConfirmationMetadata.spent_token_outputs_metadata:type_name -> spark_token.SpentTokenOutputMetadata
-	8,  // 24: spark_token.TokenTransactionWithStatus.token_transaction:type_name -> spark_token.TokenTransaction
-	2,  // 25: spark_token.TokenTransactionWithStatus.status:type_name -> spark_token.TokenTransactionStatus
-	26, // 26: spark_token.TokenTransactionWithStatus.confirmation_metadata:type_name -> spark_token.TokenTransactionConfirmationMetadata
-	28, // 27: spark_token.FreezeTokensRequest.freeze_tokens_payload:type_name -> spark_token.FreezeTokensPayload
-	12, // 28: spark_token.SparkTokenService.start_transaction:input_type -> spark_token.StartTransactionRequest
-	14, // 29: spark_token.SparkTokenService.commit_transaction:input_type -> spark_token.CommitTransactionRequest
-	17, // 30: spark_token.SparkTokenService.query_token_metadata:input_type -> spark_token.QueryTokenMetadataRequest
-	21, // 31: spark_token.SparkTokenService.query_token_transactions:input_type -> spark_token.QueryTokenTransactionsRequest
-	20, // 32: spark_token.SparkTokenService.query_token_outputs:input_type -> spark_token.QueryTokenOutputsRequest
-	29, // 33: spark_token.SparkTokenService.freeze_tokens:input_type -> spark_token.FreezeTokensRequest
-	13, // 34: spark_token.SparkTokenService.start_transaction:output_type -> spark_token.StartTransactionResponse
-	16, // 35: spark_token.SparkTokenService.commit_transaction:output_type -> spark_token.CommitTransactionResponse
-	19, // 36: spark_token.SparkTokenService.query_token_metadata:output_type -> spark_token.QueryTokenMetadataResponse
-	22, // 37: spark_token.SparkTokenService.query_token_transactions:output_type -> spark_token.QueryTokenTransactionsResponse
-	24, // 38: spark_token.SparkTokenService.query_token_outputs:output_type -> spark_token.QueryTokenOutputsResponse
-	30, // 39: spark_token.SparkTokenService.freeze_tokens:output_type -> spark_token.FreezeTokensResponse
-	34, // [34:40] is the sub-list for method output_type
-	28, // [28:34] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	4,  // 0: spark_token.TokenTransferInput.outputs_to_spend:type_name -> spark_token.TokenOutputToSpend
+	4,  // 1: spark_token.TokenBurnInput.outputs_to_spend:type_name -> spark_token.TokenOutputToSpend
+	52, // 2: spark_token.TokenOutput.spendable_after:type_name -> google.protobuf.Timestamp
+	7,  // 3: spark_token.TokenTransaction.mint_input:type_name -> spark_token.TokenMintInput
+	5,  // 4: spark_token.TokenTransaction.transfer_input:type_name -> spark_token.TokenTransferInput
+	8,  // 5: spark_token.TokenTransaction.create_input:type_name -> spark_token.TokenCreateInput
+	6,  // 6: spark_token.TokenTransaction.burn_input:type_name -> spark_token.TokenBurnInput
+	9,  // 7: spark_token.TokenTransaction.token_outputs:type_name -> spark_token.TokenOutput
+	52, // 8: spark_token.TokenTransaction.expiry_time:type_name -> google.protobuf.Timestamp
+	53, // 9: spark_token.TokenTransaction.network:type_name -> spark.Network
+	52, // 10: spark_token.TokenTransaction.client_created_timestamp:type_name -> google.protobuf.Timestamp
+	11, // 11: spark_token.TokenTransaction.invoice_attachments:type_name -> spark_token.InvoiceAttachment
+	12, // 12: spark_token.InputTtxoSignaturesPerOperator.ttxo_signatures:type_name -> spark_token.SignatureWithIndex
+	10, // 13: spark_token.StartTransactionRequest.partial_token_transaction:type_name -> spark_token.TokenTransaction
+	12, // 14: spark_token.StartTransactionRequest.partial_token_transaction_owner_signatures:type_name -> spark_token.SignatureWithIndex
+	10, // 15: spark_token.StartTransactionResponse.final_token_transaction:type_name -> spark_token.TokenTransaction
+	54, // 16: spark_token.StartTransactionResponse.keyshare_info:type_name -> spark.SigningKeyshare
+	10, // 17: spark_token.CommitTransactionRequest.final_token_transaction:type_name -> spark_token.TokenTransaction
+	13, // 18: spark_token.CommitTransactionRequest.input_ttxo_signatures_per_operator:type_name -> spark_token.InputTtxoSignaturesPerOperator
+	1,  // 19: spark_token.CommitTransactionResponse.commit_status:type_name -> spark_token.CommitStatus
+	17, // 20: spark_token.CommitTransactionResponse.commit_progress:type_name -> spark_token.CommitProgress
+	21, // 21: spark_token.TokenMetadata.issuer_key_rotations:type_name -> spark_token.IssuerKeyRotation
+	20, // 22: spark_token.QueryTokenMetadataResponse.token_metadata:type_name -> spark_token.TokenMetadata
+	53, // 23: spark_token.QueryTokenOutputsRequest.network:type_name -> spark.Network
+	52, // 24: spark_token.QueryTokenTransactionsRequest.created_after:type_name -> google.protobuf.Timestamp
+	52, // 25: spark_token.QueryTokenTransactionsRequest.created_before:type_name -> google.protobuf.Timestamp
+	3,  // 26: spark_token.QueryTokenTransactionsRequest.statuses:type_name -> spark_token.TokenTransactionStatus
+	55, // 27: spark_token.QueryTokenTransactionsRequest.order:type_name -> spark.Order
+	2,  // 28: spark_token.QueryTokenTransactionsRequest.sort_field:type_name -> spark_token.TokenTransactionSortField
+	36, // 29: spark_token.QueryTokenTransactionsResponse.token_transactions_with_status:type_name -> spark_token.TokenTransactionWithStatus
+	9,  // 30: spark_token.OutputWithPreviousTransactionData.output:type_name -> spark_token.TokenOutput
+	26, // 31: spark_token.QueryTokenOutputsResponse.outputs_with_previous_transaction_data:type_name -> spark_token.OutputWithPreviousTransactionData
+	52, // 32: spark_token.QueryTokenHoldersRequest.as_of_time:type_name -> google.protobuf.Timestamp
+	29, // 33: spark_token.QueryTokenHoldersResponse.token_holders:type_name -> spark_token.TokenHolder
+	32, // 34: spark_token.QueryTokenSupplyResponse.token_supplies:type_name -> spark_token.TokenSupply
+	34, // 35: spark_token.TokenTransactionConfirmationMetadata.spent_token_outputs_metadata:type_name -> spark_token.SpentTokenOutputMetadata
+	10, // 36: spark_token.TokenTransactionWithStatus.token_transaction:type_name -> spark_token.TokenTransaction
+	3,  // 37: spark_token.TokenTransactionWithStatus.status:type_name -> spark_token.TokenTransactionStatus
+	35, // 38: spark_token.TokenTransactionWithStatus.confirmation_metadata:type_name -> spark_token.TokenTransactionConfirmationMetadata
+	37, // 39: spark_token.FreezeTokensRequest.freeze_tokens_payload:type_name -> spark_token.FreezeTokensPayload
+	52, // 40: spark_token.TokenFreezeInfo.expires_at:type_name -> google.protobuf.Timestamp
+	41, // 41: spark_token.QueryTokenFreezesResponse.token_freezes:type_name -> spark_token.TokenFreezeInfo
+	43, // 42: spark_token.RotateIssuerKeyRequest.rotate_issuer_key_payload:type_name -> spark_token.RotateIssuerKeyPayload
+	46, // 43: spark_token.UpdateTokenAllowlistRequest.update_token_allowlist_payload:type_name -> spark_token.UpdateTokenAllowlistPayload
+	49, // 44: spark_token.PauseTokenRequest.pause_token_payload:type_name -> spark_token.PauseTokenPayload
+	14, // 45: spark_token.SparkTokenService.start_transaction:input_type -> spark_token.StartTransactionRequest
+	16, // 46: spark_token.SparkTokenService.commit_transaction:input_type -> spark_token.CommitTransactionRequest
+	19, // 47: spark_token.SparkTokenService.query_token_metadata:input_type -> spark_token.QueryTokenMetadataRequest
+	24, // 48: spark_token.SparkTokenService.query_token_transactions:input_type -> spark_token.QueryTokenTransactionsRequest
+	23, // 49: spark_token.SparkTokenService.query_token_outputs:input_type -> spark_token.QueryTokenOutputsRequest
+	28, // 50: spark_token.SparkTokenService.query_token_holders:input_type -> spark_token.QueryTokenHoldersRequest
+	31, // 51: spark_token.SparkTokenService.query_token_supply:input_type -> spark_token.QueryTokenSupplyRequest
+	40, // 52: spark_token.SparkTokenService.query_token_freezes:input_type -> spark_token.QueryTokenFreezesRequest
+	38, // 53: spark_token.SparkTokenService.freeze_tokens:input_type -> spark_token.FreezeTokensRequest
+	44, // 54: spark_token.SparkTokenService.rotate_issuer_key:input_type -> spark_token.RotateIssuerKeyRequest
+	47, // 55: spark_token.SparkTokenService.update_token_allowlist:input_type -> spark_token.UpdateTokenAllowlistRequest
+	50, // 56: spark_token.SparkTokenService.pause_token:input_type -> spark_token.PauseTokenRequest
+	15, // 57: spark_token.SparkTokenService.start_transaction:output_type -> spark_token.StartTransactionResponse
+	18, // 58: spark_token.SparkTokenService.commit_transaction:output_type -> spark_token.CommitTransactionResponse
+	22, // 59: spark_token.SparkTokenService.query_token_metadata:output_type -> spark_token.QueryTokenMetadataResponse
+	25, // 60: spark_token.SparkTokenService.query_token_transactions:output_type -> spark_token.QueryTokenTransactionsResponse
+	27, // 61: spark_token.SparkTokenService.query_token_outputs:output_type -> spark_token.QueryTokenOutputsResponse
+	30, // 62: spark_token.SparkTokenService.query_token_holders:output_type -> spark_token.QueryTokenHoldersResponse
+	33, // 63: spark_token.SparkTokenService.query_token_supply:output_type -> spark_token.QueryTokenSupplyResponse
+	42, // 64: spark_token.SparkTokenService.query_token_freezes:output_type -> spark_token.QueryTokenFreezesResponse
+	39, // 65: spark_token.SparkTokenService.freeze_tokens:output_type -> spark_token.FreezeTokensResponse
+	45, // 66: spark_token.SparkTokenService.rotate_issuer_key:output_type -> spark_token.RotateIssuerKeyResponse
+	48, // 67: spark_token.SparkTokenService.update_token_allowlist:output_type -> spark_token.UpdateTokenAllowlistResponse
+	51, // 68: spark_token.SparkTokenService.pause_token:output_type -> spark_token.PauseTokenResponse
+	57, // [57:69] is the sub-list for method output_type
+	45, // [45:57] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_spark_token_proto_init() }
@@ -2319,23 +3883,24 @@ func file_spark_token_proto_init() {
 	if File_spark_token_proto != nil {
 		return
 	}
-	file_spark_token_proto_msgTypes[2].OneofWrappers = []any{}
 	file_spark_token_proto_msgTypes[3].OneofWrappers = []any{}
 	file_spark_token_proto_msgTypes[4].OneofWrappers = []any{}
-	file_spark_token_proto_msgTypes[5].OneofWrappers = []any{
+	file_spark_token_proto_msgTypes[5].OneofWrappers = []any{}
+	file_spark_token_proto_msgTypes[6].OneofWrappers = []any{
 		(*TokenTransaction_MintInput)(nil),
 		(*TokenTransaction_TransferInput)(nil),
 		(*TokenTransaction_CreateInput)(nil),
+		(*TokenTransaction_BurnInput)(nil),
 	}
-	file_spark_token_proto_msgTypes[15].OneofWrappers = []any{}
-	file_spark_token_proto_msgTypes[25].OneofWrappers = []any{}
+	file_spark_token_proto_msgTypes[16].OneofWrappers = []any{}
+	file_spark_token_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spark_token_proto_rawDesc), len(file_spark_token_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   28,
+			NumEnums:      4,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -293,6 +293,151 @@ var _ interface {
 	ErrorName() string
 } = TokenTransferInputValidationError{}
 
+// Validate checks the field values on TokenBurnInput with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenBurnInput) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenBurnInput with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenBurnInputMultiError,
+// or nil if none found.
+func (m *TokenBurnInput) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenBurnInput) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOutputsToSpend() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenBurnInputValidationError{
+						field:  fmt.Sprintf("OutputsToSpend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenBurnInputValidationError{
+						field:  fmt.Sprintf("OutputsToSpend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenBurnInputValidationError{
+					field:  fmt.Sprintf("OutputsToSpend[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := TokenBurnInputValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TokenBurnInputMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenBurnInputMultiError is an error wrapping multiple validation errors
+// returned by TokenBurnInput.ValidateAll() if the designated constraints
+// aren't met.
+type TokenBurnInputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenBurnInputMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenBurnInputMultiError) AllErrors() []error { return m }
+
+// TokenBurnInputValidationError is the validation error returned by
+// TokenBurnInput.Validate if the designated constraints aren't met.
+type TokenBurnInputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenBurnInputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenBurnInputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenBurnInputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenBurnInputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenBurnInputValidationError) ErrorName() string { return "TokenBurnInputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenBurnInputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenBurnInput.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenBurnInputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenBurnInputValidationError{}
+
 // Validate checks the field values on TokenMintInput with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -498,6 +643,8 @@ func (m *TokenCreateInput) validate(all bool) error {
 
 	// no validation rules for IsFreezable
 
+	// no validation rules for IsTransferRestricted
+
 	if m.CreationEntityPublicKey != nil {
 
 		if len(m.GetCreationEntityPublicKey()) != 33 {
@@ -635,6 +782,35 @@ func (m *TokenOutput) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetSpendableAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenOutputValidationError{
+					field:  "SpendableAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenOutputValidationError{
+					field:  "SpendableAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpendableAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenOutputValidationError{
+				field:  "SpendableAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Id != nil {
 
 		if err := m._validateUuid(m.GetId()); err != nil {
@@ -1090,6 +1266,47 @@ func (m *TokenTransaction) validate(all bool) error {
 			}
 		}
 
+	case *TokenTransaction_BurnInput:
+		if v == nil {
+			err := TokenTransactionValidationError{
+				field:  "TokenInputs",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetBurnInput()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenTransactionValidationError{
+						field:  "BurnInput",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenTransactionValidationError{
+						field:  "BurnInput",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBurnInput()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenTransactionValidationError{
+					field:  "BurnInput",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1650,6 +1867,8 @@ func (m *StartTransactionRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for SparkPaymentIntent
+
 	if len(errors) > 0 {
 		return StartTransactionRequestMultiError(errors)
 	}
@@ -2566,6 +2785,55 @@ func (m *TokenMetadata) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetCurrentIssuerPublicKey()) != 33 {
+		err := TokenMetadataValidationError{
+			field:  "CurrentIssuerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIssuerKeyRotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenMetadataValidationError{
+						field:  fmt.Sprintf("IssuerKeyRotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenMetadataValidationError{
+						field:  fmt.Sprintf("IssuerKeyRotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenMetadataValidationError{
+					field:  fmt.Sprintf("IssuerKeyRotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IsTransferRestricted
+
+	// no validation rules for IsPaused
+
 	if m.CreationEntityPublicKey != nil {
 
 		if len(m.GetCreationEntityPublicKey()) != 33 {
@@ -2659,58 +2927,184 @@ var _ interface {
 	ErrorName() string
 } = TokenMetadataValidationError{}
 
-// Validate checks the field values on QueryTokenMetadataResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QueryTokenMetadataResponse) Validate() error {
+// Validate checks the field values on IssuerKeyRotation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *IssuerKeyRotation) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QueryTokenMetadataResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on IssuerKeyRotation with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// QueryTokenMetadataResponseMultiError, or nil if none found.
-func (m *QueryTokenMetadataResponse) ValidateAll() error {
+// IssuerKeyRotationMultiError, or nil if none found.
+func (m *IssuerKeyRotation) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QueryTokenMetadataResponse) validate(all bool) error {
+func (m *IssuerKeyRotation) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetTokenMetadata() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, QueryTokenMetadataResponseValidationError{
-						field:  fmt.Sprintf("TokenMetadata[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, QueryTokenMetadataResponseValidationError{
-						field:  fmt.Sprintf("TokenMetadata[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return QueryTokenMetadataResponseValidationError{
-					field:  fmt.Sprintf("TokenMetadata[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if len(m.GetPreviousIssuerPublicKey()) != 33 {
+		err := IssuerKeyRotationValidationError{
+			field:  "PreviousIssuerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNewIssuerPublicKey()) != 33 {
+		err := IssuerKeyRotationValidationError{
+			field:  "NewIssuerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IssuerProvidedTimestamp
+
+	if len(errors) > 0 {
+		return IssuerKeyRotationMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssuerKeyRotationMultiError is an error wrapping multiple validation errors
+// returned by IssuerKeyRotation.ValidateAll() if the designated constraints
+// aren't met.
+type IssuerKeyRotationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssuerKeyRotationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssuerKeyRotationMultiError) AllErrors() []error { return m }
+
+// IssuerKeyRotationValidationError is the validation error returned by
+// IssuerKeyRotation.Validate if the designated constraints aren't met.
+type IssuerKeyRotationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssuerKeyRotationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssuerKeyRotationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssuerKeyRotationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssuerKeyRotationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssuerKeyRotationValidationError) ErrorName() string {
+	return "IssuerKeyRotationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IssuerKeyRotationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssuerKeyRotation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssuerKeyRotationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssuerKeyRotationValidationError{}
+
+// Validate checks the field values on QueryTokenMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryTokenMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryTokenMetadataResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryTokenMetadataResponseMultiError, or nil if none found.
+func (m *QueryTokenMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryTokenMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokenMetadata() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryTokenMetadataResponseValidationError{
+						field:  fmt.Sprintf("TokenMetadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryTokenMetadataResponseValidationError{
+						field:  fmt.Sprintf("TokenMetadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryTokenMetadataResponseValidationError{
+					field:  fmt.Sprintf("TokenMetadata[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
 
 	}
@@ -3054,6 +3448,70 @@ func (m *QueryTokenTransactionsRequest) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for Cursor
+
+	if all {
+		switch v := interface{}(m.GetCreatedAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryTokenTransactionsRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryTokenTransactionsRequestValidationError{
+					field:  "CreatedAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryTokenTransactionsRequestValidationError{
+				field:  "CreatedAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryTokenTransactionsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryTokenTransactionsRequestValidationError{
+					field:  "CreatedBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryTokenTransactionsRequestValidationError{
+				field:  "CreatedBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Order
+
+	// no validation rules for SortField
+
 	if len(errors) > 0 {
 		return QueryTokenTransactionsRequestMultiError(errors)
 	}
@@ -3201,6 +3659,8 @@ func (m *QueryTokenTransactionsResponse) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for NextCursor
+
 	if len(errors) > 0 {
 		return QueryTokenTransactionsResponseMultiError(errors)
 	}
@@ -3565,46 +4025,86 @@ var _ interface {
 	ErrorName() string
 } = QueryTokenOutputsResponseValidationError{}
 
-// Validate checks the field values on SpentTokenOutputMetadata with the rules
+// Validate checks the field values on QueryTokenHoldersRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *SpentTokenOutputMetadata) Validate() error {
+func (m *QueryTokenHoldersRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on SpentTokenOutputMetadata with the
+// ValidateAll checks the field values on QueryTokenHoldersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// SpentTokenOutputMetadataMultiError, or nil if none found.
-func (m *SpentTokenOutputMetadata) ValidateAll() error {
+// QueryTokenHoldersRequestMultiError, or nil if none found.
+func (m *QueryTokenHoldersRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *SpentTokenOutputMetadata) validate(all bool) error {
+func (m *QueryTokenHoldersRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OutputId
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := QueryTokenHoldersRequestValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for RevocationSecret
+	if all {
+		switch v := interface{}(m.GetAsOfTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryTokenHoldersRequestValidationError{
+					field:  "AsOfTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryTokenHoldersRequestValidationError{
+					field:  "AsOfTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOfTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryTokenHoldersRequestValidationError{
+				field:  "AsOfTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
 
 	if len(errors) > 0 {
-		return SpentTokenOutputMetadataMultiError(errors)
+		return QueryTokenHoldersRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// SpentTokenOutputMetadataMultiError is an error wrapping multiple validation
-// errors returned by SpentTokenOutputMetadata.ValidateAll() if the designated
+// QueryTokenHoldersRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryTokenHoldersRequest.ValidateAll() if the designated
 // constraints aren't met.
-type SpentTokenOutputMetadataMultiError []error
+type QueryTokenHoldersRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SpentTokenOutputMetadataMultiError) Error() string {
+func (m QueryTokenHoldersRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3613,11 +4113,11 @@ func (m SpentTokenOutputMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SpentTokenOutputMetadataMultiError) AllErrors() []error { return m }
+func (m QueryTokenHoldersRequestMultiError) AllErrors() []error { return m }
 
-// SpentTokenOutputMetadataValidationError is the validation error returned by
-// SpentTokenOutputMetadata.Validate if the designated constraints aren't met.
-type SpentTokenOutputMetadataValidationError struct {
+// QueryTokenHoldersRequestValidationError is the validation error returned by
+// QueryTokenHoldersRequest.Validate if the designated constraints aren't met.
+type QueryTokenHoldersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3625,24 +4125,24 @@ type SpentTokenOutputMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e SpentTokenOutputMetadataValidationError) Field() string { return e.field }
+func (e QueryTokenHoldersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SpentTokenOutputMetadataValidationError) Reason() string { return e.reason }
+func (e QueryTokenHoldersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SpentTokenOutputMetadataValidationError) Cause() error { return e.cause }
+func (e QueryTokenHoldersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SpentTokenOutputMetadataValidationError) Key() bool { return e.key }
+func (e QueryTokenHoldersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SpentTokenOutputMetadataValidationError) ErrorName() string {
-	return "SpentTokenOutputMetadataValidationError"
+func (e QueryTokenHoldersRequestValidationError) ErrorName() string {
+	return "QueryTokenHoldersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SpentTokenOutputMetadataValidationError) Error() string {
+func (e QueryTokenHoldersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3654,14 +4154,14 @@ func (e SpentTokenOutputMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSpentTokenOutputMetadata.%s: %s%s",
+		"invalid %sQueryTokenHoldersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SpentTokenOutputMetadataValidationError{}
+var _ error = QueryTokenHoldersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3669,48 +4169,161 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SpentTokenOutputMetadataValidationError{}
+} = QueryTokenHoldersRequestValidationError{}
 
-// Validate checks the field values on TokenTransactionConfirmationMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *TokenTransactionConfirmationMetadata) Validate() error {
+// Validate checks the field values on TokenHolder with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenHolder) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenTransactionConfirmationMetadata
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// TokenTransactionConfirmationMetadataMultiError, or nil if none found.
-func (m *TokenTransactionConfirmationMetadata) ValidateAll() error {
+// ValidateAll checks the field values on TokenHolder with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenHolderMultiError, or
+// nil if none found.
+func (m *TokenHolder) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenTransactionConfirmationMetadata) validate(all bool) error {
+func (m *TokenHolder) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSpentTokenOutputsMetadata() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
+	if len(m.GetOwnerPublicKey()) != 33 {
+		err := TokenHolderValidationError{
+			field:  "OwnerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Balance
+
+	// no validation rules for OutputCount
+
+	if len(errors) > 0 {
+		return TokenHolderMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenHolderMultiError is an error wrapping multiple validation errors
+// returned by TokenHolder.ValidateAll() if the designated constraints aren't met.
+type TokenHolderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenHolderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenHolderMultiError) AllErrors() []error { return m }
+
+// TokenHolderValidationError is the validation error returned by
+// TokenHolder.Validate if the designated constraints aren't met.
+type TokenHolderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenHolderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenHolderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenHolderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenHolderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenHolderValidationError) ErrorName() string { return "TokenHolderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenHolderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenHolder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenHolderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenHolderValidationError{}
+
+// Validate checks the field values on QueryTokenHoldersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryTokenHoldersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryTokenHoldersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryTokenHoldersResponseMultiError, or nil if none found.
+func (m *QueryTokenHoldersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryTokenHoldersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokenHolders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, TokenTransactionConfirmationMetadataValidationError{
-						field:  fmt.Sprintf("SpentTokenOutputsMetadata[%v]", idx),
+					errors = append(errors, QueryTokenHoldersResponseValidationError{
+						field:  fmt.Sprintf("TokenHolders[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, TokenTransactionConfirmationMetadataValidationError{
-						field:  fmt.Sprintf("SpentTokenOutputsMetadata[%v]", idx),
+					errors = append(errors, QueryTokenHoldersResponseValidationError{
+						field:  fmt.Sprintf("TokenHolders[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3718,8 +4331,8 @@ func (m *TokenTransactionConfirmationMetadata) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return TokenTransactionConfirmationMetadataValidationError{
-					field:  fmt.Sprintf("SpentTokenOutputsMetadata[%v]", idx),
+				return QueryTokenHoldersResponseValidationError{
+					field:  fmt.Sprintf("TokenHolders[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3728,21 +4341,22 @@ func (m *TokenTransactionConfirmationMetadata) validate(all bool) error {
 
 	}
 
+	// no validation rules for Offset
+
 	if len(errors) > 0 {
-		return TokenTransactionConfirmationMetadataMultiError(errors)
+		return QueryTokenHoldersResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenTransactionConfirmationMetadataMultiError is an error wrapping multiple
-// validation errors returned by
-// TokenTransactionConfirmationMetadata.ValidateAll() if the designated
-// constraints aren't met.
-type TokenTransactionConfirmationMetadataMultiError []error
+// QueryTokenHoldersResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryTokenHoldersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type QueryTokenHoldersResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenTransactionConfirmationMetadataMultiError) Error() string {
+func (m QueryTokenHoldersResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3751,12 +4365,11 @@ func (m TokenTransactionConfirmationMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenTransactionConfirmationMetadataMultiError) AllErrors() []error { return m }
+func (m QueryTokenHoldersResponseMultiError) AllErrors() []error { return m }
 
-// TokenTransactionConfirmationMetadataValidationError is the validation error
-// returned by TokenTransactionConfirmationMetadata.Validate if the designated
-// constraints aren't met.
-type TokenTransactionConfirmationMetadataValidationError struct {
+// QueryTokenHoldersResponseValidationError is the validation error returned by
+// QueryTokenHoldersResponse.Validate if the designated constraints aren't met.
+type QueryTokenHoldersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3764,24 +4377,24 @@ type TokenTransactionConfirmationMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenTransactionConfirmationMetadataValidationError) Field() string { return e.field }
+func (e QueryTokenHoldersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenTransactionConfirmationMetadataValidationError) Reason() string { return e.reason }
+func (e QueryTokenHoldersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenTransactionConfirmationMetadataValidationError) Cause() error { return e.cause }
+func (e QueryTokenHoldersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenTransactionConfirmationMetadataValidationError) Key() bool { return e.key }
+func (e QueryTokenHoldersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenTransactionConfirmationMetadataValidationError) ErrorName() string {
-	return "TokenTransactionConfirmationMetadataValidationError"
+func (e QueryTokenHoldersResponseValidationError) ErrorName() string {
+	return "QueryTokenHoldersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TokenTransactionConfirmationMetadataValidationError) Error() string {
+func (e QueryTokenHoldersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3793,14 +4406,14 @@ func (e TokenTransactionConfirmationMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokenTransactionConfirmationMetadata.%s: %s%s",
+		"invalid %sQueryTokenHoldersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenTransactionConfirmationMetadataValidationError{}
+var _ error = QueryTokenHoldersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3808,115 +4421,2385 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenTransactionConfirmationMetadataValidationError{}
+} = QueryTokenHoldersResponseValidationError{}
 
-// Validate checks the field values on TokenTransactionWithStatus with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on QueryTokenSupplyRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TokenTransactionWithStatus) Validate() error {
+func (m *QueryTokenSupplyRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TokenTransactionWithStatus with the
+// ValidateAll checks the field values on QueryTokenSupplyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryTokenSupplyRequestMultiError, or nil if none found.
+func (m *QueryTokenSupplyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryTokenSupplyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokenIdentifiers() {
+		_, _ = idx, item
+
+		if len(item) != 32 {
+			err := QueryTokenSupplyRequestValidationError{
+				field:  fmt.Sprintf("TokenIdentifiers[%v]", idx),
+				reason: "value length must be 32 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryTokenSupplyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryTokenSupplyRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryTokenSupplyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryTokenSupplyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryTokenSupplyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryTokenSupplyRequestMultiError) AllErrors() []error { return m }
+
+// QueryTokenSupplyRequestValidationError is the validation error returned by
+// QueryTokenSupplyRequest.Validate if the designated constraints aren't met.
+type QueryTokenSupplyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryTokenSupplyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryTokenSupplyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryTokenSupplyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryTokenSupplyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryTokenSupplyRequestValidationError) ErrorName() string {
+	return "QueryTokenSupplyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryTokenSupplyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryTokenSupplyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryTokenSupplyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryTokenSupplyRequestValidationError{}
+
+// Validate checks the field values on TokenSupply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TokenSupply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenSupply with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TokenSupplyMultiError, or
+// nil if none found.
+func (m *TokenSupply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenSupply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := TokenSupplyValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMaxSupply()) != 16 {
+		err := TokenSupplyValidationError{
+			field:  "MaxSupply",
+			reason: "value length must be 16 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMintedSupply()) != 16 {
+		err := TokenSupplyValidationError{
+			field:  "MintedSupply",
+			reason: "value length must be 16 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCirculatingSupply()) != 16 {
+		err := TokenSupplyValidationError{
+			field:  "CirculatingSupply",
+			reason: "value length must be 16 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetFrozenSupply()) != 16 {
+		err := TokenSupplyValidationError{
+			field:  "FrozenSupply",
+			reason: "value length must be 16 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FrozenOutputCount
+
+	if len(errors) > 0 {
+		return TokenSupplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenSupplyMultiError is an error wrapping multiple validation errors
+// returned by TokenSupply.ValidateAll() if the designated constraints aren't met.
+type TokenSupplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenSupplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenSupplyMultiError) AllErrors() []error { return m }
+
+// TokenSupplyValidationError is the validation error returned by
+// TokenSupply.Validate if the designated constraints aren't met.
+type TokenSupplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenSupplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenSupplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenSupplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenSupplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenSupplyValidationError) ErrorName() string { return "TokenSupplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenSupplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenSupply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenSupplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenSupplyValidationError{}
+
+// Validate checks the field values on QueryTokenSupplyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryTokenSupplyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryTokenSupplyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryTokenSupplyResponseMultiError, or nil if none found.
+func (m *QueryTokenSupplyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryTokenSupplyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokenSupplies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryTokenSupplyResponseValidationError{
+						field:  fmt.Sprintf("TokenSupplies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryTokenSupplyResponseValidationError{
+						field:  fmt.Sprintf("TokenSupplies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryTokenSupplyResponseValidationError{
+					field:  fmt.Sprintf("TokenSupplies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryTokenSupplyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryTokenSupplyResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryTokenSupplyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueryTokenSupplyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryTokenSupplyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryTokenSupplyResponseMultiError) AllErrors() []error { return m }
+
+// QueryTokenSupplyResponseValidationError is the validation error returned by
+// QueryTokenSupplyResponse.Validate if the designated constraints aren't met.
+type QueryTokenSupplyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryTokenSupplyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryTokenSupplyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryTokenSupplyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryTokenSupplyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryTokenSupplyResponseValidationError) ErrorName() string {
+	return "QueryTokenSupplyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryTokenSupplyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryTokenSupplyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryTokenSupplyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryTokenSupplyResponseValidationError{}
+
+// Validate checks the field values on SpentTokenOutputMetadata with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SpentTokenOutputMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SpentTokenOutputMetadata with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SpentTokenOutputMetadataMultiError, or nil if none found.
+func (m *SpentTokenOutputMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SpentTokenOutputMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OutputId
+
+	// no validation rules for RevocationSecret
+
+	if len(errors) > 0 {
+		return SpentTokenOutputMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// SpentTokenOutputMetadataMultiError is an error wrapping multiple validation
+// errors returned by SpentTokenOutputMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type SpentTokenOutputMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SpentTokenOutputMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SpentTokenOutputMetadataMultiError) AllErrors() []error { return m }
+
+// SpentTokenOutputMetadataValidationError is the validation error returned by
+// SpentTokenOutputMetadata.Validate if the designated constraints aren't met.
+type SpentTokenOutputMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SpentTokenOutputMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SpentTokenOutputMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SpentTokenOutputMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SpentTokenOutputMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SpentTokenOutputMetadataValidationError) ErrorName() string {
+	return "SpentTokenOutputMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SpentTokenOutputMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSpentTokenOutputMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SpentTokenOutputMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SpentTokenOutputMetadataValidationError{}
+
+// Validate checks the field values on TokenTransactionConfirmationMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *TokenTransactionConfirmationMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenTransactionConfirmationMetadata
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// TokenTransactionConfirmationMetadataMultiError, or nil if none found.
+func (m *TokenTransactionConfirmationMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenTransactionConfirmationMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSpentTokenOutputsMetadata() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TokenTransactionConfirmationMetadataValidationError{
+						field:  fmt.Sprintf("SpentTokenOutputsMetadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TokenTransactionConfirmationMetadataValidationError{
+						field:  fmt.Sprintf("SpentTokenOutputsMetadata[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TokenTransactionConfirmationMetadataValidationError{
+					field:  fmt.Sprintf("SpentTokenOutputsMetadata[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TokenTransactionConfirmationMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenTransactionConfirmationMetadataMultiError is an error wrapping multiple
+// validation errors returned by
+// TokenTransactionConfirmationMetadata.ValidateAll() if the designated
+// constraints aren't met.
+type TokenTransactionConfirmationMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenTransactionConfirmationMetadataMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenTransactionConfirmationMetadataMultiError) AllErrors() []error { return m }
+
+// TokenTransactionConfirmationMetadataValidationError is the validation error
+// returned by TokenTransactionConfirmationMetadata.Validate if the designated
+// constraints aren't met.
+type TokenTransactionConfirmationMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenTransactionConfirmationMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenTransactionConfirmationMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenTransactionConfirmationMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenTransactionConfirmationMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenTransactionConfirmationMetadataValidationError) ErrorName() string {
+	return "TokenTransactionConfirmationMetadataValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenTransactionConfirmationMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenTransactionConfirmationMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenTransactionConfirmationMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenTransactionConfirmationMetadataValidationError{}
+
+// Validate checks the field values on TokenTransactionWithStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TokenTransactionWithStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenTransactionWithStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenTransactionWithStatusMultiError, or nil if none found.
+func (m *TokenTransactionWithStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenTransactionWithStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTokenTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenTransactionWithStatusValidationError{
+					field:  "TokenTransaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenTransactionWithStatusValidationError{
+					field:  "TokenTransaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTokenTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenTransactionWithStatusValidationError{
+				field:  "TokenTransaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetConfirmationMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenTransactionWithStatusValidationError{
+					field:  "ConfirmationMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenTransactionWithStatusValidationError{
+					field:  "ConfirmationMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfirmationMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenTransactionWithStatusValidationError{
+				field:  "ConfirmationMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetTokenTransactionHash()) != 32 {
+		err := TokenTransactionWithStatusValidationError{
+			field:  "TokenTransactionHash",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SparkPaymentIntent
+
+	if len(errors) > 0 {
+		return TokenTransactionWithStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenTransactionWithStatusMultiError is an error wrapping multiple
+// validation errors returned by TokenTransactionWithStatus.ValidateAll() if
+// the designated constraints aren't met.
+type TokenTransactionWithStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenTransactionWithStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenTransactionWithStatusMultiError) AllErrors() []error { return m }
+
+// TokenTransactionWithStatusValidationError is the validation error returned
+// by TokenTransactionWithStatus.Validate if the designated constraints aren't met.
+type TokenTransactionWithStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenTransactionWithStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenTransactionWithStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenTransactionWithStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenTransactionWithStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenTransactionWithStatusValidationError) ErrorName() string {
+	return "TokenTransactionWithStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TokenTransactionWithStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenTransactionWithStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenTransactionWithStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenTransactionWithStatusValidationError{}
+
+// Validate checks the field values on FreezeTokensPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FreezeTokensPayload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreezeTokensPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreezeTokensPayloadMultiError, or nil if none found.
+func (m *FreezeTokensPayload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreezeTokensPayload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(m.GetOwnerPublicKey()) != 33 {
+		err := FreezeTokensPayloadValidationError{
+			field:  "OwnerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IssuerProvidedTimestamp
+
+	if len(m.GetOperatorIdentityPublicKey()) != 33 {
+		err := FreezeTokensPayloadValidationError{
+			field:  "OperatorIdentityPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ShouldUnfreeze
+
+	// no validation rules for FreezeExpiryTimestamp
+
+	if m.TokenPublicKey != nil {
+
+		if len(m.GetTokenPublicKey()) != 33 {
+			err := FreezeTokensPayloadValidationError{
+				field:  "TokenPublicKey",
+				reason: "value length must be 33 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.TokenIdentifier != nil {
+
+		if len(m.GetTokenIdentifier()) != 32 {
+			err := FreezeTokensPayloadValidationError{
+				field:  "TokenIdentifier",
+				reason: "value length must be 32 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FreezeTokensPayloadMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreezeTokensPayloadMultiError is an error wrapping multiple validation
+// errors returned by FreezeTokensPayload.ValidateAll() if the designated
+// constraints aren't met.
+type FreezeTokensPayloadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeTokensPayloadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeTokensPayloadMultiError) AllErrors() []error { return m }
+
+// FreezeTokensPayloadValidationError is the validation error returned by
+// FreezeTokensPayload.Validate if the designated constraints aren't met.
+type FreezeTokensPayloadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeTokensPayloadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeTokensPayloadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeTokensPayloadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeTokensPayloadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeTokensPayloadValidationError) ErrorName() string {
+	return "FreezeTokensPayloadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FreezeTokensPayloadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreezeTokensPayload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeTokensPayloadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeTokensPayloadValidationError{}
+
+// Validate checks the field values on FreezeTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FreezeTokensRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreezeTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreezeTokensRequestMultiError, or nil if none found.
+func (m *FreezeTokensRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreezeTokensRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFreezeTokensPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FreezeTokensRequestValidationError{
+					field:  "FreezeTokensPayload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FreezeTokensRequestValidationError{
+					field:  "FreezeTokensPayload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFreezeTokensPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FreezeTokensRequestValidationError{
+				field:  "FreezeTokensPayload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetIssuerSignature()); l < 64 || l > 73 {
+		err := FreezeTokensRequestValidationError{
+			field:  "IssuerSignature",
+			reason: "value length must be between 64 and 73 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FreezeTokensRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FreezeTokensRequestMultiError is an error wrapping multiple validation
+// errors returned by FreezeTokensRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FreezeTokensRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeTokensRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeTokensRequestMultiError) AllErrors() []error { return m }
+
+// FreezeTokensRequestValidationError is the validation error returned by
+// FreezeTokensRequest.Validate if the designated constraints aren't met.
+type FreezeTokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeTokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeTokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeTokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeTokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeTokensRequestValidationError) ErrorName() string {
+	return "FreezeTokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FreezeTokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreezeTokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeTokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeTokensRequestValidationError{}
+
+// Validate checks the field values on FreezeTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FreezeTokensResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FreezeTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FreezeTokensResponseMultiError, or nil if none found.
+func (m *FreezeTokensResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FreezeTokensResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetImpactedOutputIds() {
+		_, _ = idx, item
+
+		if err := m._validateUuid(item); err != nil {
+			err = FreezeTokensResponseValidationError{
+				field:  fmt.Sprintf("ImpactedOutputIds[%v]", idx),
+				reason: "value must be a valid UUID",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ImpactedTokenAmount
+
+	if len(errors) > 0 {
+		return FreezeTokensResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *FreezeTokensResponse) _validateUuid(uuid string) error {
+	if matched := _spark_token_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// FreezeTokensResponseMultiError is an error wrapping multiple validation
+// errors returned by FreezeTokensResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FreezeTokensResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FreezeTokensResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FreezeTokensResponseMultiError) AllErrors() []error { return m }
+
+// FreezeTokensResponseValidationError is the validation error returned by
+// FreezeTokensResponse.Validate if the designated constraints aren't met.
+type FreezeTokensResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FreezeTokensResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FreezeTokensResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FreezeTokensResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FreezeTokensResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FreezeTokensResponseValidationError) ErrorName() string {
+	return "FreezeTokensResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FreezeTokensResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFreezeTokensResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FreezeTokensResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FreezeTokensResponseValidationError{}
+
+// Validate checks the field values on QueryTokenFreezesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryTokenFreezesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryTokenFreezesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryTokenFreezesRequestMultiError, or nil if none found.
+func (m *QueryTokenFreezesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryTokenFreezesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := QueryTokenFreezesRequestValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return QueryTokenFreezesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryTokenFreezesRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryTokenFreezesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryTokenFreezesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryTokenFreezesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryTokenFreezesRequestMultiError) AllErrors() []error { return m }
+
+// QueryTokenFreezesRequestValidationError is the validation error returned by
+// QueryTokenFreezesRequest.Validate if the designated constraints aren't met.
+type QueryTokenFreezesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryTokenFreezesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryTokenFreezesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryTokenFreezesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryTokenFreezesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryTokenFreezesRequestValidationError) ErrorName() string {
+	return "QueryTokenFreezesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryTokenFreezesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryTokenFreezesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryTokenFreezesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryTokenFreezesRequestValidationError{}
+
+// Validate checks the field values on TokenFreezeInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TokenFreezeInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TokenFreezeInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TokenFreezeInfoMultiError, or nil if none found.
+func (m *TokenFreezeInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TokenFreezeInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOwnerPublicKey()) != 33 {
+		err := TokenFreezeInfoValidationError{
+			field:  "OwnerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IssuerProvidedTimestamp
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TokenFreezeInfoValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TokenFreezeInfoValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TokenFreezeInfoValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TokenFreezeInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// TokenFreezeInfoMultiError is an error wrapping multiple validation errors
+// returned by TokenFreezeInfo.ValidateAll() if the designated constraints
+// aren't met.
+type TokenFreezeInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TokenFreezeInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TokenFreezeInfoMultiError) AllErrors() []error { return m }
+
+// TokenFreezeInfoValidationError is the validation error returned by
+// TokenFreezeInfo.Validate if the designated constraints aren't met.
+type TokenFreezeInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TokenFreezeInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TokenFreezeInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TokenFreezeInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TokenFreezeInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TokenFreezeInfoValidationError) ErrorName() string { return "TokenFreezeInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TokenFreezeInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTokenFreezeInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TokenFreezeInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TokenFreezeInfoValidationError{}
+
+// Validate checks the field values on QueryTokenFreezesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryTokenFreezesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryTokenFreezesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryTokenFreezesResponseMultiError, or nil if none found.
+func (m *QueryTokenFreezesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryTokenFreezesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokenFreezes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryTokenFreezesResponseValidationError{
+						field:  fmt.Sprintf("TokenFreezes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryTokenFreezesResponseValidationError{
+						field:  fmt.Sprintf("TokenFreezes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryTokenFreezesResponseValidationError{
+					field:  fmt.Sprintf("TokenFreezes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Offset
+
+	if len(errors) > 0 {
+		return QueryTokenFreezesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryTokenFreezesResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryTokenFreezesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type QueryTokenFreezesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryTokenFreezesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryTokenFreezesResponseMultiError) AllErrors() []error { return m }
+
+// QueryTokenFreezesResponseValidationError is the validation error returned by
+// QueryTokenFreezesResponse.Validate if the designated constraints aren't met.
+type QueryTokenFreezesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryTokenFreezesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryTokenFreezesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryTokenFreezesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryTokenFreezesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryTokenFreezesResponseValidationError) ErrorName() string {
+	return "QueryTokenFreezesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryTokenFreezesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryTokenFreezesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryTokenFreezesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryTokenFreezesResponseValidationError{}
+
+// Validate checks the field values on RotateIssuerKeyPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateIssuerKeyPayload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateIssuerKeyPayload with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateIssuerKeyPayloadMultiError, or nil if none found.
+func (m *RotateIssuerKeyPayload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateIssuerKeyPayload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := RotateIssuerKeyPayloadValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNewIssuerPublicKey()) != 33 {
+		err := RotateIssuerKeyPayloadValidationError{
+			field:  "NewIssuerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IssuerProvidedTimestamp
+
+	if len(m.GetOperatorIdentityPublicKey()) != 33 {
+		err := RotateIssuerKeyPayloadValidationError{
+			field:  "OperatorIdentityPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateIssuerKeyPayloadMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateIssuerKeyPayloadMultiError is an error wrapping multiple validation
+// errors returned by RotateIssuerKeyPayload.ValidateAll() if the designated
+// constraints aren't met.
+type RotateIssuerKeyPayloadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateIssuerKeyPayloadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateIssuerKeyPayloadMultiError) AllErrors() []error { return m }
+
+// RotateIssuerKeyPayloadValidationError is the validation error returned by
+// RotateIssuerKeyPayload.Validate if the designated constraints aren't met.
+type RotateIssuerKeyPayloadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateIssuerKeyPayloadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateIssuerKeyPayloadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateIssuerKeyPayloadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateIssuerKeyPayloadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateIssuerKeyPayloadValidationError) ErrorName() string {
+	return "RotateIssuerKeyPayloadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateIssuerKeyPayloadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateIssuerKeyPayload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateIssuerKeyPayloadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateIssuerKeyPayloadValidationError{}
+
+// Validate checks the field values on RotateIssuerKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateIssuerKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateIssuerKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateIssuerKeyRequestMultiError, or nil if none found.
+func (m *RotateIssuerKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateIssuerKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRotateIssuerKeyPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RotateIssuerKeyRequestValidationError{
+					field:  "RotateIssuerKeyPayload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RotateIssuerKeyRequestValidationError{
+					field:  "RotateIssuerKeyPayload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRotateIssuerKeyPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RotateIssuerKeyRequestValidationError{
+				field:  "RotateIssuerKeyPayload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetIssuerSignature()); l < 64 || l > 73 {
+		err := RotateIssuerKeyRequestValidationError{
+			field:  "IssuerSignature",
+			reason: "value length must be between 64 and 73 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateIssuerKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateIssuerKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by RotateIssuerKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RotateIssuerKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateIssuerKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateIssuerKeyRequestMultiError) AllErrors() []error { return m }
+
+// RotateIssuerKeyRequestValidationError is the validation error returned by
+// RotateIssuerKeyRequest.Validate if the designated constraints aren't met.
+type RotateIssuerKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateIssuerKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateIssuerKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateIssuerKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateIssuerKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateIssuerKeyRequestValidationError) ErrorName() string {
+	return "RotateIssuerKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateIssuerKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateIssuerKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateIssuerKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateIssuerKeyRequestValidationError{}
+
+// Validate checks the field values on RotateIssuerKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RotateIssuerKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateIssuerKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateIssuerKeyResponseMultiError, or nil if none found.
+func (m *RotateIssuerKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateIssuerKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCurrentIssuerPublicKey()) != 33 {
+		err := RotateIssuerKeyResponseValidationError{
+			field:  "CurrentIssuerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateIssuerKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateIssuerKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by RotateIssuerKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RotateIssuerKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateIssuerKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateIssuerKeyResponseMultiError) AllErrors() []error { return m }
+
+// RotateIssuerKeyResponseValidationError is the validation error returned by
+// RotateIssuerKeyResponse.Validate if the designated constraints aren't met.
+type RotateIssuerKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateIssuerKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateIssuerKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateIssuerKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateIssuerKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateIssuerKeyResponseValidationError) ErrorName() string {
+	return "RotateIssuerKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RotateIssuerKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateIssuerKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateIssuerKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateIssuerKeyResponseValidationError{}
+
+// Validate checks the field values on UpdateTokenAllowlistPayload with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTokenAllowlistPayload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTokenAllowlistPayload with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTokenAllowlistPayloadMultiError, or nil if none found.
+func (m *UpdateTokenAllowlistPayload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTokenAllowlistPayload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := UpdateTokenAllowlistPayloadValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetOwnerPublicKey()) != 33 {
+		err := UpdateTokenAllowlistPayloadValidationError{
+			field:  "OwnerPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IssuerProvidedTimestamp
+
+	if len(m.GetOperatorIdentityPublicKey()) != 33 {
+		err := UpdateTokenAllowlistPayloadValidationError{
+			field:  "OperatorIdentityPublicKey",
+			reason: "value length must be 33 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ShouldRemove
+
+	if len(errors) > 0 {
+		return UpdateTokenAllowlistPayloadMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTokenAllowlistPayloadMultiError is an error wrapping multiple
+// validation errors returned by UpdateTokenAllowlistPayload.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTokenAllowlistPayloadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTokenAllowlistPayloadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTokenAllowlistPayloadMultiError) AllErrors() []error { return m }
+
+// UpdateTokenAllowlistPayloadValidationError is the validation error returned
+// by UpdateTokenAllowlistPayload.Validate if the designated constraints
+// aren't met.
+type UpdateTokenAllowlistPayloadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTokenAllowlistPayloadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTokenAllowlistPayloadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTokenAllowlistPayloadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTokenAllowlistPayloadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTokenAllowlistPayloadValidationError) ErrorName() string {
+	return "UpdateTokenAllowlistPayloadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTokenAllowlistPayloadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTokenAllowlistPayload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTokenAllowlistPayloadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTokenAllowlistPayloadValidationError{}
+
+// Validate checks the field values on UpdateTokenAllowlistRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTokenAllowlistRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTokenAllowlistRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateTokenAllowlistRequestMultiError, or nil if none found.
+func (m *UpdateTokenAllowlistRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateTokenAllowlistRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUpdateTokenAllowlistPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateTokenAllowlistRequestValidationError{
+					field:  "UpdateTokenAllowlistPayload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateTokenAllowlistRequestValidationError{
+					field:  "UpdateTokenAllowlistPayload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTokenAllowlistPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateTokenAllowlistRequestValidationError{
+				field:  "UpdateTokenAllowlistPayload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetIssuerSignature()); l < 64 || l > 73 {
+		err := UpdateTokenAllowlistRequestValidationError{
+			field:  "IssuerSignature",
+			reason: "value length must be between 64 and 73 bytes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateTokenAllowlistRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateTokenAllowlistRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateTokenAllowlistRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateTokenAllowlistRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateTokenAllowlistRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateTokenAllowlistRequestMultiError) AllErrors() []error { return m }
+
+// UpdateTokenAllowlistRequestValidationError is the validation error returned
+// by UpdateTokenAllowlistRequest.Validate if the designated constraints
+// aren't met.
+type UpdateTokenAllowlistRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateTokenAllowlistRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateTokenAllowlistRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateTokenAllowlistRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateTokenAllowlistRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateTokenAllowlistRequestValidationError) ErrorName() string {
+	return "UpdateTokenAllowlistRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateTokenAllowlistRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateTokenAllowlistRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateTokenAllowlistRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateTokenAllowlistRequestValidationError{}
+
+// Validate checks the field values on UpdateTokenAllowlistResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateTokenAllowlistResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateTokenAllowlistResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TokenTransactionWithStatusMultiError, or nil if none found.
-func (m *TokenTransactionWithStatus) ValidateAll() error {
+// UpdateTokenAllowlistResponseMultiError, or nil if none found.
+func (m *UpdateTokenAllowlistResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TokenTransactionWithStatus) validate(all bool) error {
+func (m *UpdateTokenAllowlistResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetTokenTransaction()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TokenTransactionWithStatusValidationError{
-					field:  "TokenTransaction",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TokenTransactionWithStatusValidationError{
-					field:  "TokenTransaction",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTokenTransaction()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TokenTransactionWithStatusValidationError{
-				field:  "TokenTransaction",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Status
-
-	if all {
-		switch v := interface{}(m.GetConfirmationMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TokenTransactionWithStatusValidationError{
-					field:  "ConfirmationMetadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TokenTransactionWithStatusValidationError{
-					field:  "ConfirmationMetadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfirmationMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TokenTransactionWithStatusValidationError{
-				field:  "ConfirmationMetadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if len(m.GetTokenTransactionHash()) != 32 {
-		err := TokenTransactionWithStatusValidationError{
-			field:  "TokenTransactionHash",
-			reason: "value length must be 32 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for IsAllowed
 
 	if len(errors) > 0 {
-		return TokenTransactionWithStatusMultiError(errors)
+		return UpdateTokenAllowlistResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TokenTransactionWithStatusMultiError is an error wrapping multiple
-// validation errors returned by TokenTransactionWithStatus.ValidateAll() if
+// UpdateTokenAllowlistResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateTokenAllowlistResponse.ValidateAll() if
 // the designated constraints aren't met.
-type TokenTransactionWithStatusMultiError []error
+type UpdateTokenAllowlistResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TokenTransactionWithStatusMultiError) Error() string {
+func (m UpdateTokenAllowlistResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3925,11 +6808,12 @@ func (m TokenTransactionWithStatusMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TokenTransactionWithStatusMultiError) AllErrors() []error { return m }
+func (m UpdateTokenAllowlistResponseMultiError) AllErrors() []error { return m }
 
-// TokenTransactionWithStatusValidationError is the validation error returned
-// by TokenTransactionWithStatus.Validate if the designated constraints aren't met.
-type TokenTransactionWithStatusValidationError struct {
+// UpdateTokenAllowlistResponseValidationError is the validation error returned
+// by UpdateTokenAllowlistResponse.Validate if the designated constraints
+// aren't met.
+type UpdateTokenAllowlistResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3937,24 +6821,24 @@ type TokenTransactionWithStatusValidationError struct {
 }
 
 // Field function returns field value.
-func (e TokenTransactionWithStatusValidationError) Field() string { return e.field }
+func (e UpdateTokenAllowlistResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TokenTransactionWithStatusValidationError) Reason() string { return e.reason }
+func (e UpdateTokenAllowlistResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TokenTransactionWithStatusValidationError) Cause() error { return e.cause }
+func (e UpdateTokenAllowlistResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TokenTransactionWithStatusValidationError) Key() bool { return e.key }
+func (e UpdateTokenAllowlistResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TokenTransactionWithStatusValidationError) ErrorName() string {
-	return "TokenTransactionWithStatusValidationError"
+func (e UpdateTokenAllowlistResponseValidationError) ErrorName() string {
+	return "UpdateTokenAllowlistResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TokenTransactionWithStatusValidationError) Error() string {
+func (e UpdateTokenAllowlistResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3966,14 +6850,14 @@ func (e TokenTransactionWithStatusValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTokenTransactionWithStatus.%s: %s%s",
+		"invalid %sUpdateTokenAllowlistResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TokenTransactionWithStatusValidationError{}
+var _ error = UpdateTokenAllowlistResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3981,24 +6865,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TokenTransactionWithStatusValidationError{}
+} = UpdateTokenAllowlistResponseValidationError{}
 
-// Validate checks the field values on FreezeTokensPayload with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FreezeTokensPayload) Validate() error {
+// Validate checks the field values on PauseTokenPayload with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseTokenPayload) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FreezeTokensPayload with the rules
+// ValidateAll checks the field values on PauseTokenPayload with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FreezeTokensPayloadMultiError, or nil if none found.
-func (m *FreezeTokensPayload) ValidateAll() error {
+// PauseTokenPayloadMultiError, or nil if none found.
+func (m *PauseTokenPayload) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FreezeTokensPayload) validate(all bool) error {
+func (m *PauseTokenPayload) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4007,10 +6891,10 @@ func (m *FreezeTokensPayload) validate(all bool) error {
 
 	// no validation rules for Version
 
-	if len(m.GetOwnerPublicKey()) != 33 {
-		err := FreezeTokensPayloadValidationError{
-			field:  "OwnerPublicKey",
-			reason: "value length must be 33 bytes",
+	if len(m.GetTokenIdentifier()) != 32 {
+		err := PauseTokenPayloadValidationError{
+			field:  "TokenIdentifier",
+			reason: "value length must be 32 bytes",
 		}
 		if !all {
 			return err
@@ -4021,7 +6905,7 @@ func (m *FreezeTokensPayload) validate(all bool) error {
 	// no validation rules for IssuerProvidedTimestamp
 
 	if len(m.GetOperatorIdentityPublicKey()) != 33 {
-		err := FreezeTokensPayloadValidationError{
+		err := PauseTokenPayloadValidationError{
 			field:  "OperatorIdentityPublicKey",
 			reason: "value length must be 33 bytes",
 		}
@@ -4031,52 +6915,22 @@ func (m *FreezeTokensPayload) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ShouldUnfreeze
-
-	if m.TokenPublicKey != nil {
-
-		if len(m.GetTokenPublicKey()) != 33 {
-			err := FreezeTokensPayloadValidationError{
-				field:  "TokenPublicKey",
-				reason: "value length must be 33 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.TokenIdentifier != nil {
-
-		if len(m.GetTokenIdentifier()) != 32 {
-			err := FreezeTokensPayloadValidationError{
-				field:  "TokenIdentifier",
-				reason: "value length must be 32 bytes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
+	// no validation rules for ShouldUnpause
 
 	if len(errors) > 0 {
-		return FreezeTokensPayloadMultiError(errors)
+		return PauseTokenPayloadMultiError(errors)
 	}
 
 	return nil
 }
 
-// FreezeTokensPayloadMultiError is an error wrapping multiple validation
-// errors returned by FreezeTokensPayload.ValidateAll() if the designated
-// constraints aren't met.
-type FreezeTokensPayloadMultiError []error
+// PauseTokenPayloadMultiError is an error wrapping multiple validation errors
+// returned by PauseTokenPayload.ValidateAll() if the designated constraints
+// aren't met.
+type PauseTokenPayloadMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FreezeTokensPayloadMultiError) Error() string {
+func (m PauseTokenPayloadMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4085,11 +6939,11 @@ func (m FreezeTokensPayloadMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FreezeTokensPayloadMultiError) AllErrors() []error { return m }
+func (m PauseTokenPayloadMultiError) AllErrors() []error { return m }
 
-// FreezeTokensPayloadValidationError is the validation error returned by
-// FreezeTokensPayload.Validate if the designated constraints aren't met.
-type FreezeTokensPayloadValidationError struct {
+// PauseTokenPayloadValidationError is the validation error returned by
+// PauseTokenPayload.Validate if the designated constraints aren't met.
+type PauseTokenPayloadValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4097,24 +6951,24 @@ type FreezeTokensPayloadValidationError struct {
 }
 
 // Field function returns field value.
-func (e FreezeTokensPayloadValidationError) Field() string { return e.field }
+func (e PauseTokenPayloadValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FreezeTokensPayloadValidationError) Reason() string { return e.reason }
+func (e PauseTokenPayloadValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FreezeTokensPayloadValidationError) Cause() error { return e.cause }
+func (e PauseTokenPayloadValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FreezeTokensPayloadValidationError) Key() bool { return e.key }
+func (e PauseTokenPayloadValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FreezeTokensPayloadValidationError) ErrorName() string {
-	return "FreezeTokensPayloadValidationError"
+func (e PauseTokenPayloadValidationError) ErrorName() string {
+	return "PauseTokenPayloadValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FreezeTokensPayloadValidationError) Error() string {
+func (e PauseTokenPayloadValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4126,14 +6980,14 @@ func (e FreezeTokensPayloadValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFreezeTokensPayload.%s: %s%s",
+		"invalid %sPauseTokenPayload.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FreezeTokensPayloadValidationError{}
+var _ error = PauseTokenPayloadValidationError{}
 
 var _ interface {
 	Field() string
@@ -4141,24 +6995,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FreezeTokensPayloadValidationError{}
+} = PauseTokenPayloadValidationError{}
 
-// Validate checks the field values on FreezeTokensRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FreezeTokensRequest) Validate() error {
+// Validate checks the field values on PauseTokenRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PauseTokenRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FreezeTokensRequest with the rules
+// ValidateAll checks the field values on PauseTokenRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FreezeTokensRequestMultiError, or nil if none found.
-func (m *FreezeTokensRequest) ValidateAll() error {
+// PauseTokenRequestMultiError, or nil if none found.
+func (m *PauseTokenRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FreezeTokensRequest) validate(all bool) error {
+func (m *PauseTokenRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4166,28 +7020,28 @@ func (m *FreezeTokensRequest) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetFreezeTokensPayload()).(type) {
+		switch v := interface{}(m.GetPauseTokenPayload()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, FreezeTokensRequestValidationError{
-					field:  "FreezeTokensPayload",
+				errors = append(errors, PauseTokenRequestValidationError{
+					field:  "PauseTokenPayload",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, FreezeTokensRequestValidationError{
-					field:  "FreezeTokensPayload",
+				errors = append(errors, PauseTokenRequestValidationError{
+					field:  "PauseTokenPayload",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFreezeTokensPayload()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPauseTokenPayload()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return FreezeTokensRequestValidationError{
-				field:  "FreezeTokensPayload",
+			return PauseTokenRequestValidationError{
+				field:  "PauseTokenPayload",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4195,7 +7049,7 @@ func (m *FreezeTokensRequest) validate(all bool) error {
 	}
 
 	if l := len(m.GetIssuerSignature()); l < 64 || l > 73 {
-		err := FreezeTokensRequestValidationError{
+		err := PauseTokenRequestValidationError{
 			field:  "IssuerSignature",
 			reason: "value length must be between 64 and 73 bytes, inclusive",
 		}
@@ -4206,19 +7060,19 @@ func (m *FreezeTokensRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return FreezeTokensRequestMultiError(errors)
+		return PauseTokenRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// FreezeTokensRequestMultiError is an error wrapping multiple validation
-// errors returned by FreezeTokensRequest.ValidateAll() if the designated
-// constraints aren't met.
-type FreezeTokensRequestMultiError []error
+// PauseTokenRequestMultiError is an error wrapping multiple validation errors
+// returned by PauseTokenRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PauseTokenRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FreezeTokensRequestMultiError) Error() string {
+func (m PauseTokenRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4227,11 +7081,11 @@ func (m FreezeTokensRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FreezeTokensRequestMultiError) AllErrors() []error { return m }
+func (m PauseTokenRequestMultiError) AllErrors() []error { return m }
 
-// FreezeTokensRequestValidationError is the validation error returned by
-// FreezeTokensRequest.Validate if the designated constraints aren't met.
-type FreezeTokensRequestValidationError struct {
+// PauseTokenRequestValidationError is the validation error returned by
+// PauseTokenRequest.Validate if the designated constraints aren't met.
+type PauseTokenRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4239,24 +7093,24 @@ type FreezeTokensRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e FreezeTokensRequestValidationError) Field() string { return e.field }
+func (e PauseTokenRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FreezeTokensRequestValidationError) Reason() string { return e.reason }
+func (e PauseTokenRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FreezeTokensRequestValidationError) Cause() error { return e.cause }
+func (e PauseTokenRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FreezeTokensRequestValidationError) Key() bool { return e.key }
+func (e PauseTokenRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FreezeTokensRequestValidationError) ErrorName() string {
-	return "FreezeTokensRequestValidationError"
+func (e PauseTokenRequestValidationError) ErrorName() string {
+	return "PauseTokenRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FreezeTokensRequestValidationError) Error() string {
+func (e PauseTokenRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4268,14 +7122,14 @@ func (e FreezeTokensRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFreezeTokensRequest.%s: %s%s",
+		"invalid %sPauseTokenRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FreezeTokensRequestValidationError{}
+var _ error = PauseTokenRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4283,71 +7137,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FreezeTokensRequestValidationError{}
+} = PauseTokenRequestValidationError{}
 
-// Validate checks the field values on FreezeTokensResponse with the rules
+// Validate checks the field values on PauseTokenResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *FreezeTokensResponse) Validate() error {
+func (m *PauseTokenResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on FreezeTokensResponse with the rules
+// ValidateAll checks the field values on PauseTokenResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// FreezeTokensResponseMultiError, or nil if none found.
-func (m *FreezeTokensResponse) ValidateAll() error {
+// PauseTokenResponseMultiError, or nil if none found.
+func (m *PauseTokenResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *FreezeTokensResponse) validate(all bool) error {
+func (m *PauseTokenResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetImpactedOutputIds() {
-		_, _ = idx, item
-
-		if err := m._validateUuid(item); err != nil {
-			err = FreezeTokensResponseValidationError{
-				field:  fmt.Sprintf("ImpactedOutputIds[%v]", idx),
-				reason: "value must be a valid UUID",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	// no validation rules for ImpactedTokenAmount
+	// no validation rules for IsPaused
 
 	if len(errors) > 0 {
-		return FreezeTokensResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *FreezeTokensResponse) _validateUuid(uuid string) error {
-	if matched := _spark_token_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return PauseTokenResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// FreezeTokensResponseMultiError is an error wrapping multiple validation
-// errors returned by FreezeTokensResponse.ValidateAll() if the designated
-// constraints aren't met.
-type FreezeTokensResponseMultiError []error
+// PauseTokenResponseMultiError is an error wrapping multiple validation errors
+// returned by PauseTokenResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PauseTokenResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m FreezeTokensResponseMultiError) Error() string {
+func (m PauseTokenResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4356,11 +7185,11 @@ func (m FreezeTokensResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m FreezeTokensResponseMultiError) AllErrors() []error { return m }
+func (m PauseTokenResponseMultiError) AllErrors() []error { return m }
 
-// FreezeTokensResponseValidationError is the validation error returned by
-// FreezeTokensResponse.Validate if the designated constraints aren't met.
-type FreezeTokensResponseValidationError struct {
+// PauseTokenResponseValidationError is the validation error returned by
+// PauseTokenResponse.Validate if the designated constraints aren't met.
+type PauseTokenResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4368,24 +7197,24 @@ type FreezeTokensResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e FreezeTokensResponseValidationError) Field() string { return e.field }
+func (e PauseTokenResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FreezeTokensResponseValidationError) Reason() string { return e.reason }
+func (e PauseTokenResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FreezeTokensResponseValidationError) Cause() error { return e.cause }
+func (e PauseTokenResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FreezeTokensResponseValidationError) Key() bool { return e.key }
+func (e PauseTokenResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FreezeTokensResponseValidationError) ErrorName() string {
-	return "FreezeTokensResponseValidationError"
+func (e PauseTokenResponseValidationError) ErrorName() string {
+	return "PauseTokenResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e FreezeTokensResponseValidationError) Error() string {
+func (e PauseTokenResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4397,14 +7226,14 @@ func (e FreezeTokensResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFreezeTokensResponse.%s: %s%s",
+		"invalid %sPauseTokenResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FreezeTokensResponseValidationError{}
+var _ error = PauseTokenResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4412,4 +7241,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FreezeTokensResponseValidationError{}
+} = PauseTokenResponseValidationError{}
@@ -24,7 +24,13 @@ const (
 	SparkTokenService_QueryTokenMetadata_FullMethodName     = "/spark_token.SparkTokenService/query_token_metadata"
 	SparkTokenService_QueryTokenTransactions_FullMethodName = "/spark_token.SparkTokenService/query_token_transactions"
 	SparkTokenService_QueryTokenOutputs_FullMethodName      = "/spark_token.SparkTokenService/query_token_outputs"
+	SparkTokenService_QueryTokenHolders_FullMethodName      = "/spark_token.SparkTokenService/query_token_holders"
+	SparkTokenService_QueryTokenSupply_FullMethodName       = "/spark_token.SparkTokenService/query_token_supply"
+	SparkTokenService_QueryTokenFreezes_FullMethodName      = "/spark_token.SparkTokenService/query_token_freezes"
 	SparkTokenService_FreezeTokens_FullMethodName           = "/spark_token.SparkTokenService/freeze_tokens"
+	SparkTokenService_RotateIssuerKey_FullMethodName        = "/spark_token.SparkTokenService/rotate_issuer_key"
+	SparkTokenService_UpdateTokenAllowlist_FullMethodName   = "/spark_token.SparkTokenService/update_token_allowlist"
+	SparkTokenService_PauseToken_FullMethodName             = "/spark_token.SparkTokenService/pause_token"
 )
 
 // SparkTokenServiceClient is the client API for SparkTokenService service.
@@ -40,7 +46,21 @@ type SparkTokenServiceClient interface {
 	QueryTokenMetadata(ctx context.Context, in *QueryTokenMetadataRequest, opts ...grpc.CallOption) (*QueryTokenMetadataResponse, error)
 	QueryTokenTransactions(ctx context.Context, in *QueryTokenTransactionsRequest, opts ...grpc.CallOption) (*QueryTokenTransactionsResponse, error)
 	QueryTokenOutputs(ctx context.Context, in *QueryTokenOutputsRequest, opts ...grpc.CallOption) (*QueryTokenOutputsResponse, error)
+	// Returns every holder of a token with their balance, optionally as of a past time.
+	QueryTokenHolders(ctx context.Context, in *QueryTokenHoldersRequest, opts ...grpc.CallOption) (*QueryTokenHoldersResponse, error)
+	QueryTokenSupply(ctx context.Context, in *QueryTokenSupplyRequest, opts ...grpc.CallOption) (*QueryTokenSupplyResponse, error)
+	// Returns the active freezes of a token and when they expire.
+	QueryTokenFreezes(ctx context.Context, in *QueryTokenFreezesRequest, opts ...grpc.CallOption) (*QueryTokenFreezesResponse, error)
 	FreezeTokens(ctx context.Context, in *FreezeTokensRequest, opts ...grpc.CallOption) (*FreezeTokensResponse, error)
+	// Replace the key allowed to mint and freeze a token. Must be signed by the current issuer key and
+	// sent to every SO, like freeze_tokens.
+	RotateIssuerKey(ctx context.Context, in *RotateIssuerKeyRequest, opts ...grpc.CallOption) (*RotateIssuerKeyResponse, error)
+	// Add an owner to or remove an owner from the allowlist of a transfer restricted token. Must be signed
+	// by the current issuer key and sent to every SO, like freeze_tokens.
+	UpdateTokenAllowlist(ctx context.Context, in *UpdateTokenAllowlistRequest, opts ...grpc.CallOption) (*UpdateTokenAllowlistResponse, error)
+	// Pause or unpause all mints, transfers and burns of a token. Must be signed by the current issuer key and
+	// sent to every SO, like freeze_tokens.
+	PauseToken(ctx context.Context, in *PauseTokenRequest, opts ...grpc.CallOption) (*PauseTokenResponse, error)
 }
 
 type sparkTokenServiceClient struct {
@@ -101,6 +121,36 @@ func (c *sparkTokenServiceClient) QueryTokenOutputs(ctx context.Context, in *Que
 	return out, nil
 }
 
+func (c *sparkTokenServiceClient) QueryTokenHolders(ctx context.Context, in *QueryTokenHoldersRequest, opts ...grpc.CallOption) (*QueryTokenHoldersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenHoldersResponse)
+	err := c.cc.Invoke(ctx, SparkTokenService_QueryTokenHolders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkTokenServiceClient) QueryTokenSupply(ctx context.Context, in *QueryTokenSupplyRequest, opts ...grpc.CallOption) (*QueryTokenSupplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenSupplyResponse)
+	err := c.cc.Invoke(ctx, SparkTokenService_QueryTokenSupply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkTokenServiceClient) QueryTokenFreezes(ctx context.Context, in *QueryTokenFreezesRequest, opts ...grpc.CallOption) (*QueryTokenFreezesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTokenFreezesResponse)
+	err := c.cc.Invoke(ctx, SparkTokenService_QueryTokenFreezes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sparkTokenServiceClient) FreezeTokens(ctx context.Context, in *FreezeTokensRequest, opts ...grpc.CallOption) (*FreezeTokensResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FreezeTokensResponse)
@@ -111,6 +161,36 @@ func (c *sparkTokenServiceClient) FreezeTokens(ctx context.Context, in *FreezeTo
 	return out, nil
 }
 
+func (c *sparkTokenServiceClient) RotateIssuerKey(ctx context.Context, in *RotateIssuerKeyRequest, opts ...grpc.CallOption) (*RotateIssuerKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateIssuerKeyResponse)
+	err := c.cc.Invoke(ctx, SparkTokenService_RotateIssuerKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkTokenServiceClient) UpdateTokenAllowlist(ctx context.Context, in *UpdateTokenAllowlistRequest, opts ...grpc.CallOption) (*UpdateTokenAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateTokenAllowlistResponse)
+	err := c.cc.Invoke(ctx, SparkTokenService_UpdateTokenAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sparkTokenServiceClient) PauseToken(ctx context.Context, in *PauseTokenRequest, opts ...grpc.CallOption) (*PauseTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseTokenResponse)
+	err := c.cc.Invoke(ctx, SparkTokenService_PauseToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SparkTokenServiceServer is the server API for SparkTokenService service.
 // All implementations must embed UnimplementedSparkTokenServiceServer
 // for forward compatibility.
@@ -124,7 +204,21 @@ type SparkTokenServiceServer interface {
 	QueryTokenMetadata(context.Context, *QueryTokenMetadataRequest) (*QueryTokenMetadataResponse, error)
 	QueryTokenTransactions(context.Context, *QueryTokenTransactionsRequest) (*QueryTokenTransactionsResponse, error)
 	QueryTokenOutputs(context.Context, *QueryTokenOutputsRequest) (*QueryTokenOutputsResponse, error)
+	// Returns every holder of a token with their balance, optionally as of a past time.
+	QueryTokenHolders(context.Context, *QueryTokenHoldersRequest) (*QueryTokenHoldersResponse, error)
+	QueryTokenSupply(context.Context, *QueryTokenSupplyRequest) (*QueryTokenSupplyResponse, error)
+	// Returns the active freezes of a token and when they expire.
+	QueryTokenFreezes(context.Context, *QueryTokenFreezesRequest) (*QueryTokenFreezesResponse, error)
 	FreezeTokens(context.Context, *FreezeTokensRequest) (*FreezeTokensResponse, error)
+	// Replace the key allowed to mint and freeze a token. Must be signed by the current issuer key and
+	// sent to every SO, like freeze_tokens.
+	RotateIssuerKey(context.Context, *RotateIssuerKeyRequest) (*RotateIssuerKeyResponse, error)
+	// Add an owner to or remove an owner from the allowlist of a transfer restricted token. Must be signed
+	// by the current issuer key and sent to every SO, like freeze_tokens.
+	UpdateTokenAllowlist(context.Context, *UpdateTokenAllowlistRequest) (*UpdateTokenAllowlistResponse, error)
+	// Pause or unpause all mints, transfers and burns of a token. Must be signed by the current issuer key and
+	// sent to every SO, like freeze_tokens.
+	PauseToken(context.Context, *PauseTokenRequest) (*PauseTokenResponse, error)
 	mustEmbedUnimplementedSparkTokenServiceServer()
 }
 
@@ -150,9 +244,27 @@ func (UnimplementedSparkTokenServiceServer) QueryTokenTransactions(context.Conte
 func (UnimplementedSparkTokenServiceServer) QueryTokenOutputs(context.Context, *QueryTokenOutputsRequest) (*QueryTokenOutputsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryTokenOutputs not implemented")
 }
+func (UnimplementedSparkTokenServiceServer) QueryTokenHolders(context.Context, *QueryTokenHoldersRequest) (*QueryTokenHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTokenHolders not implemented")
+}
+func (UnimplementedSparkTokenServiceServer) QueryTokenSupply(context.Context, *QueryTokenSupplyRequest) (*QueryTokenSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTokenSupply not implemented")
+}
+func (UnimplementedSparkTokenServiceServer) QueryTokenFreezes(context.Context, *QueryTokenFreezesRequest) (*QueryTokenFreezesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTokenFreezes not implemented")
+}
 func (UnimplementedSparkTokenServiceServer) FreezeTokens(context.Context, *FreezeTokensRequest) (*FreezeTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FreezeTokens not implemented")
 }
+func (UnimplementedSparkTokenServiceServer) RotateIssuerKey(context.Context, *RotateIssuerKeyRequest) (*RotateIssuerKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateIssuerKey not implemented")
+}
+func (UnimplementedSparkTokenServiceServer) UpdateTokenAllowlist(context.Context, *UpdateTokenAllowlistRequest) (*UpdateTokenAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTokenAllowlist not implemented")
+}
+func (UnimplementedSparkTokenServiceServer) PauseToken(context.Context, *PauseTokenRequest) (*PauseTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseToken not implemented")
+}
 func (UnimplementedSparkTokenServiceServer) mustEmbedUnimplementedSparkTokenServiceServer() {}
 func (UnimplementedSparkTokenServiceServer) testEmbeddedByValue()                           {}
 
@@ -264,6 +376,60 @@ func _SparkTokenService_QueryTokenOutputs_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkTokenService_QueryTokenHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkTokenServiceServer).QueryTokenHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkTokenService_QueryTokenHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkTokenServiceServer).QueryTokenHolders(ctx, req.(*QueryTokenHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkTokenService_QueryTokenSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkTokenServiceServer).QueryTokenSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkTokenService_QueryTokenSupply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkTokenServiceServer).QueryTokenSupply(ctx, req.(*QueryTokenSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkTokenService_QueryTokenFreezes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenFreezesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkTokenServiceServer).QueryTokenFreezes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkTokenService_QueryTokenFreezes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkTokenServiceServer).QueryTokenFreezes(ctx, req.(*QueryTokenFreezesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SparkTokenService_FreezeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FreezeTokensRequest)
 	if err := dec(in); err != nil {
@@ -282,6 +448,60 @@ func _SparkTokenService_FreezeTokens_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SparkTokenService_RotateIssuerKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateIssuerKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkTokenServiceServer).RotateIssuerKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkTokenService_RotateIssuerKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkTokenServiceServer).RotateIssuerKey(ctx, req.(*RotateIssuerKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkTokenService_UpdateTokenAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTokenAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkTokenServiceServer).UpdateTokenAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkTokenService_UpdateTokenAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkTokenServiceServer).UpdateTokenAllowlist(ctx, req.(*UpdateTokenAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SparkTokenService_PauseToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SparkTokenServiceServer).PauseToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SparkTokenService_PauseToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SparkTokenServiceServer).PauseToken(ctx, req.(*PauseTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SparkTokenService_ServiceDesc is the grpc.ServiceDesc for SparkTokenService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -309,10 +529,34 @@ var SparkTokenService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "query_token_outputs",
 			Handler:    _SparkTokenService_QueryTokenOutputs_Handler,
 		},
+		{
+			MethodName: "query_token_holders",
+			Handler:    _SparkTokenService_QueryTokenHolders_Handler,
+		},
+		{
+			MethodName: "query_token_supply",
+			Handler:    _SparkTokenService_QueryTokenSupply_Handler,
+		},
+		{
+			MethodName: "query_token_freezes",
+			Handler:    _SparkTokenService_QueryTokenFreezes_Handler,
+		},
 		{
 			MethodName: "freeze_tokens",
 			Handler:    _SparkTokenService_FreezeTokens_Handler,
 		},
+		{
+			MethodName: "rotate_issuer_key",
+			Handler:    _SparkTokenService_RotateIssuerKey_Handler,
+		},
+		{
+			MethodName: "update_token_allowlist",
+			Handler:    _SparkTokenService_UpdateTokenAllowlist_Handler,
+		},
+		{
+			MethodName: "pause_token",
+			Handler:    _SparkTokenService_PauseToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spark_token.proto",
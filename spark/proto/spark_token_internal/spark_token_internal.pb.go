@@ -29,6 +29,7 @@ type PrepareTransactionRequest struct {
 	TokenTransactionSignatures []*spark_token.SignatureWithIndex `protobuf:"bytes,2,rep,name=token_transaction_signatures,json=tokenTransactionSignatures,proto3" json:"token_transaction_signatures,omitempty"`
 	KeyshareIds                []string                          `protobuf:"bytes,3,rep,name=keyshare_ids,json=keyshareIds,proto3" json:"keyshare_ids,omitempty"`
 	CoordinatorPublicKey       []byte                            `protobuf:"bytes,4,opt,name=coordinator_public_key,json=coordinatorPublicKey,proto3" json:"coordinator_public_key,omitempty"`
+	SparkPaymentIntent         string                            `protobuf:"bytes,5,opt,name=spark_payment_intent,json=sparkPaymentIntent,proto3" json:"spark_payment_intent,omitempty"`
 	unknownFields              protoimpl.UnknownFields
 	sizeCache                  protoimpl.SizeCache
 }
@@ -91,6 +92,13 @@ func (x *PrepareTransactionRequest) GetCoordinatorPublicKey() []byte {
 	return nil
 }
 
+func (x *PrepareTransactionRequest) GetSparkPaymentIntent() string {
+	if x != nil {
+		return x.SparkPaymentIntent
+	}
+	return ""
+}
+
 type PrepareTransactionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -524,12 +532,13 @@ var File_spark_token_internal_proto protoreflect.FileDescriptor
 
 const file_spark_token_internal_proto_rawDesc = "" +
 	"\n" +
-	"\x1aspark_token_internal.proto\x12\vspark_token\x1a\x11spark_token.proto\x1a\x17validate/validate.proto\"\xae\x02\n" +
+	"\x1aspark_token_internal.proto\x12\vspark_token\x1a\x11spark_token.proto\x1a\x17validate/validate.proto\"\xe0\x02\n" +
 	"\x19PrepareTransactionRequest\x12U\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x1d.spark_token.TokenTransactionR\x15finalTokenTransaction\x12a\n" +
 	"\x1ctoken_transaction_signatures\x18\x02 \x03(\v2\x1f.spark_token.SignatureWithIndexR\x1atokenTransactionSignatures\x12!\n" +
 	"\fkeyshare_ids\x18\x03 \x03(\tR\vkeyshareIds\x124\n" +
-	"\x16coordinator_public_key\x18\x04 \x01(\fR\x14coordinatorPublicKey\"\x1c\n" +
+	"\x16coordinator_public_key\x18\x04 \x01(\fR\x14coordinatorPublicKey\x120\n" +
+	"\x14spark_payment_intent\x18\x05 \x01(\tR\x12sparkPaymentIntent\"\x1c\n" +
 	"\x1aPrepareTransactionResponse\"\x8b\x03\n" +
 	"+SignTokenTransactionFromCoordinationRequest\x12U\n" +
 	"\x17final_token_transaction\x18\x01 \x01(\v2\x1d.spark_token.TokenTransactionR\x15finalTokenTransaction\x12H\n" +
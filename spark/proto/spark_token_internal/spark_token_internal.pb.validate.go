@@ -122,6 +122,8 @@ func (m *PrepareTransactionRequest) validate(all bool) error {
 
 	// no validation rules for CoordinatorPublicKey
 
+	// no validation rules for SparkPaymentIntent
+
 	if len(errors) > 0 {
 		return PrepareTransactionRequestMultiError(errors)
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	pb "github.com/lightsparkdev/spark/proto/spark"
@@ -27,9 +28,14 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
+	"github.com/lightsparkdev/spark/so/ent/l1tokencreate"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/utxo"
+	"github.com/lightsparkdev/spark/so/ent/utxoswap"
 	"github.com/lightsparkdev/spark/so/helper"
 	events "github.com/lightsparkdev/spark/so/stream"
 	"github.com/lightsparkdev/spark/so/watchtower"
@@ -100,6 +106,15 @@ func pollInterval(network common.Network) time.Duration {
 	}
 }
 
+// bitcoinRPCClient is the subset of the bitcoind RPC client used by the chain watcher.
+type bitcoinRPCClient interface {
+	GetBlockCount() (int64, error)
+	GetBlockHash(blockHeight int64) (*chainhash.Hash, error)
+	GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error)
+	GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error)
+	SendRawTransaction(tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error)
+}
+
 // Tip represents the tip of a blockchain.
 type Tip struct {
 	Height int64
@@ -119,7 +134,7 @@ type Difference struct {
 	Connected      []Tip
 }
 
-func findPreviousChainTip(chainTip Tip, client *rpcclient.Client) (Tip, error) {
+func findPreviousChainTip(chainTip Tip, client bitcoinRPCClient) (Tip, error) {
 	blockResp, err := client.GetBlockVerbose(&chainTip.Hash)
 	if err != nil {
 		return Tip{}, err
@@ -132,7 +147,7 @@ func findPreviousChainTip(chainTip Tip, client *rpcclient.Client) (Tip, error) {
 	return Tip{Height: blockResp.Height - 1, Hash: prevHash}, nil
 }
 
-func findDifference(currChainTip, newChainTip Tip, client *rpcclient.Client) (Difference, error) {
+func findDifference(currChainTip, newChainTip Tip, client bitcoinRPCClient) (Difference, error) {
 	var disconnected []Tip
 	var connected []Tip
 
@@ -170,7 +185,7 @@ func scanChainUpdates(
 	ctx context.Context,
 	config *so.Config,
 	dbClient *ent.Client,
-	bitcoinClient *rpcclient.Client,
+	bitcoinClient bitcoinRPCClient,
 	network common.Network,
 ) error {
 	logger := logging.GetLoggerFromContext(ctx)
@@ -203,9 +218,9 @@ func scanChainUpdates(
 	if err != nil {
 		return fmt.Errorf("failed to query block height: %w", err)
 	}
-	dbBlockHash, err := bitcoinClient.GetBlockHash(dbBlockHeight.Height)
+	dbBlockHash, err := lastProcessedBlockHash(dbBlockHeight, bitcoinClient)
 	if err != nil {
-		return fmt.Errorf("failed to get block hash at db height %d: %w", dbBlockHeight.Height, err)
+		return err
 	}
 
 	dbChainTip := NewTip(dbBlockHeight.Height, *dbBlockHash)
@@ -214,10 +229,11 @@ func scanChainUpdates(
 	if err != nil {
 		return fmt.Errorf("failed to find difference: %w", err)
 	}
-	err = disconnectBlocks(ctx, dbClient, difference.Disconnected, network)
+	err = disconnectBlocks(ctx, dbClient, bitcoinClient, difference.Disconnected, network)
 	if err != nil {
 		return fmt.Errorf("failed to disconnect blocks: %w", err)
 	}
+	logger.Info("Disconnected blocks", "count", len(difference.Disconnected))
 	err = connectBlocks(
 		ctx,
 		config,
@@ -233,6 +249,24 @@ func scanChainUpdates(
 	return nil
 }
 
+// lastProcessedBlockHash returns the hash of the last block the chain watcher processed. Rows written
+// before block hashes were recorded fall back to the hash currently at that height, which means a reorg
+// that happened while such a row was the latest cannot be detected.
+func lastProcessedBlockHash(dbBlockHeight *ent.BlockHeight, bitcoinClient bitcoinRPCClient) (*chainhash.Hash, error) {
+	if len(dbBlockHeight.BlockHash) > 0 {
+		blockHash, err := chainhash.NewHash(dbBlockHeight.BlockHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stored block hash at db height %d: %w", dbBlockHeight.Height, err)
+		}
+		return blockHash, nil
+	}
+	blockHash, err := bitcoinClient.GetBlockHash(dbBlockHeight.Height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get block hash at db height %d: %w", dbBlockHeight.Height, err)
+	}
+	return blockHash, nil
+}
+
 func RPCClientConfig(cfg so.BitcoindConfig) rpcclient.ConnConfig {
 	return rpcclient.ConnConfig{
 		Host:         cfg.Host,
@@ -307,27 +341,401 @@ func WatchChain(
 	}
 }
 
-func disconnectBlocks(_ context.Context, _ *ent.Client, _ []Tip, _ common.Network) error {
-	// TODO(DL-100): Add handling for disconnected token withdrawal transactions.
+// disconnectBlocks undoes the effects of blocks that are no longer part of the best chain. The chain tips
+// are expected in the order returned by findDifference, i.e. from the highest block down to the block just
+// above the common ancestor. Each block is rolled back in its own database transaction, and the stored
+// block height is moved back to the block's parent so an interrupted rollback resumes where it left off.
+func disconnectBlocks(
+	ctx context.Context,
+	dbClient *ent.Client,
+	bitcoinClient bitcoinRPCClient,
+	chainTips []Tip,
+	network common.Network,
+) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	for _, chainTip := range chainTips {
+		block, err := bitcoinClient.GetBlockVerboseTx(&chainTip.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to get disconnected block %s: %w", chainTip.Hash.String(), err)
+		}
+		var prevHash chainhash.Hash
+		if err := chainhash.Decode(&prevHash, block.PreviousHash); err != nil {
+			return fmt.Errorf("failed to decode previous hash of block %s: %w", chainTip.Hash.String(), err)
+		}
+		var txs []wire.MsgTx
+		for _, tx := range block.Tx {
+			rawTx, err := TxFromRPCTx(tx)
+			if err != nil {
+				return err
+			}
+			txs = append(txs, rawTx)
+		}
+
+		dbTx, err := dbClient.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		err = handleDisconnectedBlock(ctx, dbTx, txs, chainTip, NewTip(chainTip.Height-1, prevHash), network)
+		if err != nil {
+			logger.Error("Failed to handle disconnected block", "error", err)
+			rollbackErr := dbTx.Rollback()
+			if rollbackErr != nil {
+				return rollbackErr
+			}
+			return err
+		}
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+
+		if blockHeightGauge != nil {
+			blockHeightGauge.Record(ctx, chainTip.Height-1, metric.WithAttributes(
+				attribute.String("network", network.String()),
+			))
+		}
+	}
 	return nil
 }
 
+// handleDisconnectedBlock reverts everything handleBlock recorded for a block that has been reorged out:
+// deposit, cooperative exit and tree node confirmations, static deposit UTXOs and L1 token announcements.
+// Effects that cannot be undone locally, such as keys already tweaked for a confirmed cooperative exit or
+// UTXOs that have already been swapped, are left in place and logged for manual follow up.
+func handleDisconnectedBlock(
+	ctx context.Context,
+	dbTx *ent.Tx,
+	txs []wire.MsgTx,
+	chainTip Tip,
+	parentTip Tip,
+	network common.Network,
+) error {
+	logger := logging.GetLoggerFromContext(ctx)
+	logger.Info("Starting to handle disconnected block", "height", chainTip.Height, "hash", chainTip.Hash.String())
+
+	schemaNetwork := common.SchemaNetwork(network)
+	_, err := dbTx.BlockHeight.Update().
+		SetHeight(parentTip.Height).
+		SetBlockHash(parentTip.Hash.CloneBytes()).
+		Where(blockheight.NetworkEQ(schemaNetwork)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	disconnectedTxHashSet := make(map[[32]byte]bool)
+	var disconnectedTxids []string
+	var disconnectedTxHashes [][]byte
+	for _, tx := range txs {
+		txid := tx.TxHash()
+		disconnectedTxHashSet[txid] = true
+		disconnectedTxids = append(disconnectedTxids, txid.String())
+		disconnectedTxHashes = append(disconnectedTxHashes, txid.CloneBytes())
+	}
+
+	numDeposits, err := dbTx.DepositAddress.Update().
+		Where(depositaddress.ConfirmationHeightEQ(chainTip.Height)).
+		Where(depositaddress.ConfirmationTxidIn(disconnectedTxids...)).
+		ClearConfirmationHeight().
+		ClearConfirmationTxid().
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to roll back deposit confirmations: %w", err)
+	}
+	logger.Info("Rolled back deposit confirmations", "count", numDeposits, "height", chainTip.Height)
+
+	rolledBackTrees, err := dbTx.Tree.Query().
+		Where(tree.NetworkEQ(schemaNetwork)).
+		Where(tree.StatusEQ(st.TreeStatusAvailable)).
+		Where(tree.BaseTxidIn(disconnectedTxHashes...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query trees with disconnected base txid: %w", err)
+	}
+	for _, rolledBackTree := range rolledBackTrees {
+		if err := disconnectTree(ctx, dbTx, rolledBackTree); err != nil {
+			return err
+		}
+	}
+
+	coopExits, err := dbTx.CooperativeExit.Query().
+		Where(cooperativeexit.ConfirmationHeightEQ(chainTip.Height)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, coopExit := range coopExits {
+		reversedHash := slices.Clone(coopExit.ExitTxid)
+		slices.Reverse(reversedHash)
+		if !disconnectedTxHashSet[[32]byte(coopExit.ExitTxid)] && !disconnectedTxHashSet[[32]byte(reversedHash)] {
+			continue
+		}
+		_, err = coopExit.Update().ClearConfirmationHeight().Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to roll back coop exit %s: %w", coopExit.ID.String(), err)
+		}
+		// The sender keys have already been tweaked on confirmation. The exit transaction is expected to be
+		// mined again on the new chain, at which point the confirmation height is set again.
+		logger.Warn("Rolled back coop exit confirmation", "coop_exit_id", coopExit.ID, "height", chainTip.Height)
+	}
+
+	if err := disconnectTreeNodes(ctx, dbTx, disconnectedTxHashSet, chainTip.Height, schemaNetwork); err != nil {
+		return err
+	}
+
+	if err := disconnectStaticDeposits(ctx, dbTx, chainTip.Height, schemaNetwork); err != nil {
+		return err
+	}
+
+	if err := disconnectTokenAnnouncements(ctx, dbTx, disconnectedTxHashes, schemaNetwork); err != nil {
+		return err
+	}
+
+	logger.Info("Finished handling disconnected block", "height", chainTip.Height)
+	return nil
+}
+
+// disconnectTree puts a tree whose base transaction was disconnected back into the state it was in before the
+// deposit confirmed, so that the deposit is processed again once the base transaction is mined on the new chain.
+// A tree whose root has already been split or is locked in a transfer cannot be rolled back without affecting
+// other users, so it stays available and is logged for manual follow up.
+func disconnectTree(ctx context.Context, dbTx *ent.Tx, disconnectedTree *ent.Tree) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	root, err := disconnectedTree.QueryNodes().Where(treenode.Not(treenode.HasParent())).Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query root of tree %s: %w", disconnectedTree.ID, err)
+	}
+	if root.Status != st.TreeNodeStatusAvailable {
+		logger.Warn("Base transaction of tree was disconnected after its root was used, keeping it available",
+			"tree_id", disconnectedTree.ID,
+			"root_status", root.Status)
+		return nil
+	}
+
+	_, err = dbTx.Tree.UpdateOne(disconnectedTree).SetStatus(st.TreeStatusPending).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to roll back tree %s: %w", disconnectedTree.ID, err)
+	}
+	_, err = dbTx.TreeNode.UpdateOne(root).SetStatus(st.TreeNodeStatusCreating).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to roll back root %s of tree %s: %w", root.ID, disconnectedTree.ID, err)
+	}
+	logger.Info("Rolled back tree with disconnected base transaction", "tree_id", disconnectedTree.ID, "root_id", root.ID)
+	return nil
+}
+
+// disconnectTreeNodes clears node and refund confirmation heights recorded at the disconnected height. A
+// node whose refund is no longer confirmed goes back to ON_CHAIN, and a node whose own transaction is no
+// longer confirmed goes back to AVAILABLE.
+func disconnectTreeNodes(ctx context.Context, dbTx *ent.Tx, disconnectedTxHashSet map[[32]byte]bool, blockHeight int64, network st.Network) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	nodes, err := dbTx.TreeNode.Query().
+		Where(treenode.HasTreeWith(tree.NetworkEQ(network))).
+		Where(treenode.Or(
+			treenode.NodeConfirmationHeightEQ(uint64(blockHeight)),
+			treenode.RefundConfirmationHeightEQ(uint64(blockHeight)),
+		)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query confirmed tree nodes: %w", err)
+	}
+
+	for _, node := range nodes {
+		refundDisconnected, err := anyTxDisconnected(disconnectedTxHashSet, node.RawRefundTx, node.DirectRefundTx, node.DirectFromCpfpRefundTx)
+		if err != nil {
+			return fmt.Errorf("failed to parse refund txs for node %s: %w", node.ID, err)
+		}
+		nodeDisconnected, err := anyTxDisconnected(disconnectedTxHashSet, node.RawTx, node.DirectTx)
+		if err != nil {
+			return fmt.Errorf("failed to parse node txs for node %s: %w", node.ID, err)
+		}
+		refundDisconnected = refundDisconnected && node.RefundConfirmationHeight == uint64(blockHeight)
+		nodeDisconnected = nodeDisconnected && node.NodeConfirmationHeight == uint64(blockHeight)
+		if !refundDisconnected && !nodeDisconnected {
+			continue
+		}
+
+		update := dbTx.TreeNode.UpdateOne(node)
+		status := node.Status
+		if refundDisconnected {
+			update = update.ClearRefundConfirmationHeight()
+			if status == st.TreeNodeStatusExited {
+				status = st.TreeNodeStatusOnChain
+			}
+		}
+		if nodeDisconnected {
+			update = update.ClearNodeConfirmationHeight()
+			if status == st.TreeNodeStatusOnChain {
+				status = st.TreeNodeStatusAvailable
+			}
+		}
+		_, err = update.SetStatus(status).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to roll back tree node %s: %w", node.ID, err)
+		}
+		logger.Info("Rolled back tree node confirmation",
+			"node_id", node.ID,
+			"node_disconnected", nodeDisconnected,
+			"refund_disconnected", refundDisconnected,
+			"status", status,
+			"block_height", blockHeight)
+	}
+	return nil
+}
+
+// anyTxDisconnected returns whether any of the given raw transactions is in the disconnected set.
+func anyTxDisconnected(disconnectedTxHashSet map[[32]byte]bool, rawTxs ...[]byte) (bool, error) {
+	for _, rawTx := range rawTxs {
+		if len(rawTx) == 0 {
+			continue
+		}
+		tx, err := common.TxFromRawTxBytes(rawTx)
+		if err != nil {
+			return false, err
+		}
+		if disconnectedTxHashSet[tx.TxHash()] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// disconnectStaticDeposits removes the UTXOs credited to static deposit addresses at the disconnected height,
+// along with any cancelled swaps referencing them. UTXOs that already have an active swap cannot be un-credited
+// and are kept. If the transaction is mined again on the new chain, storeStaticDeposits updates their block height.
+func disconnectStaticDeposits(ctx context.Context, dbTx *ent.Tx, blockHeight int64, network st.Network) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	utxos, err := dbTx.Utxo.Query().
+		Where(utxo.NetworkEQ(network)).
+		Where(utxo.BlockHeightEQ(blockHeight)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query disconnected utxos: %w", err)
+	}
+
+	var utxoIDsToDelete []uuid.UUID
+	for _, disconnectedUtxo := range utxos {
+		swapped, err := dbTx.UtxoSwap.Query().
+			Where(utxoswap.HasUtxoWith(utxo.IDEQ(disconnectedUtxo.ID))).
+			Where(utxoswap.StatusNEQ(st.UtxoSwapStatusCancelled)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query utxo swaps for utxo %s: %w", disconnectedUtxo.ID, err)
+		}
+		if swapped {
+			logger.Warn("Disconnected utxo has already been swapped, keeping it",
+				"utxo_id", disconnectedUtxo.ID,
+				"txid", hex.EncodeToString(disconnectedUtxo.Txid),
+				"vout", disconnectedUtxo.Vout)
+			continue
+		}
+		utxoIDsToDelete = append(utxoIDsToDelete, disconnectedUtxo.ID)
+	}
+
+	if len(utxoIDsToDelete) > 0 {
+		_, err := dbTx.UtxoSwap.Delete().Where(utxoswap.HasUtxoWith(utxo.IDIn(utxoIDsToDelete...))).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete cancelled utxo swaps: %w", err)
+		}
+		numDeleted, err := dbTx.Utxo.Delete().Where(utxo.IDIn(utxoIDsToDelete...)).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete disconnected utxos: %w", err)
+		}
+		logger.Info("Deleted disconnected static deposit utxos", "count", numDeleted, "height", blockHeight)
+	}
+	return nil
+}
+
+// disconnectTokenAnnouncements deletes L1 token announcements made in the disconnected block, along with the
+// Spark native tokens created from them. A Spark token that is already in use is kept and detached from its
+// announcement, since its outputs or its issuer's actions refer to it.
+func disconnectTokenAnnouncements(ctx context.Context, dbTx *ent.Tx, disconnectedTxHashes [][]byte, network st.Network) error {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	l1TokenCreates, err := dbTx.L1TokenCreate.Query().
+		Where(l1tokencreate.NetworkEQ(network)).
+		Where(l1tokencreate.TransactionIDIn(disconnectedTxHashes...)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query disconnected token announcements: %w", err)
+	}
+
+	for _, l1TokenCreate := range l1TokenCreates {
+		tokenCreates, err := dbTx.TokenCreate.Query().
+			Where(tokencreate.HasL1TokenCreateWith(l1tokencreate.IDEQ(l1TokenCreate.ID))).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query spark token for announcement %s: %w", l1TokenCreate.ID, err)
+		}
+		for _, tokenCreate := range tokenCreates {
+			inUse, err := tokenCreateInUse(ctx, dbTx, tokenCreate)
+			if err != nil {
+				return err
+			}
+			if inUse {
+				logger.Warn("Spark token created from a disconnected announcement is already in use, keeping it",
+					"token_create_id", tokenCreate.ID,
+					"token_identifier", hex.EncodeToString(tokenCreate.TokenIdentifier))
+				if _, err := tokenCreate.Update().ClearL1TokenCreate().Save(ctx); err != nil {
+					return fmt.Errorf("failed to detach spark token %s from announcement: %w", tokenCreate.ID, err)
+				}
+				continue
+			}
+			if err := dbTx.TokenCreate.DeleteOne(tokenCreate).Exec(ctx); err != nil {
+				return fmt.Errorf("failed to delete spark token %s: %w", tokenCreate.ID, err)
+			}
+		}
+
+		if err := dbTx.L1TokenCreate.DeleteOne(l1TokenCreate).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete token announcement %s: %w", l1TokenCreate.ID, err)
+		}
+		logger.Info("Deleted disconnected token announcement",
+			"token_identifier", hex.EncodeToString(l1TokenCreate.TokenIdentifier),
+			"txid", hex.EncodeToString(l1TokenCreate.TransactionID))
+	}
+	return nil
+}
+
+// tokenCreateInUse reports whether the token has outputs, has been paused, or is referred to by a token
+// transaction, freeze, issuer key rotation or allowlist entry.
+func tokenCreateInUse(ctx context.Context, dbTx *ent.Tx, tokenCreate *ent.TokenCreate) (bool, error) {
+	if tokenCreate.IsPaused || len(tokenCreate.PauseIssuerSignature) > 0 {
+		return true, nil
+	}
+	inUse, err := dbTx.TokenCreate.Query().
+		Where(
+			tokencreate.IDEQ(tokenCreate.ID),
+			tokencreate.Or(
+				tokencreate.HasTokenOutput(),
+				tokencreate.HasTokenTransaction(),
+				tokencreate.HasTokenFreeze(),
+				tokencreate.HasIssuerKeyRotations(),
+				tokencreate.HasAllowlistEntries(),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query usage of spark token %s: %w", tokenCreate.ID, err)
+	}
+	return inUse, nil
+}
+
 func connectBlocks(
 	ctx context.Context,
 	config *so.Config,
 	dbClient *ent.Client,
-	bitcoinClient *rpcclient.Client,
+	bitcoinClient bitcoinRPCClient,
 	chainTips []Tip,
 	network common.Network,
 ) error {
 	logger := logging.GetLoggerFromContext(ctx)
 
 	for _, chainTip := range chainTips {
-		blockHash, err := bitcoinClient.GetBlockHash(chainTip.Height)
-		if err != nil {
-			return err
-		}
-		block, err := bitcoinClient.GetBlockVerboseTx(blockHash)
+		block, err := bitcoinClient.GetBlockVerboseTx(&chainTip.Hash)
 		if err != nil {
 			return err
 		}
@@ -353,6 +761,13 @@ func connectBlocks(
 			chainTip.Height,
 			network,
 		)
+		if err == nil {
+			// Record the hash alongside the height so that a later reorg of this block can be detected.
+			_, err = dbTx.BlockHeight.Update().
+				SetBlockHash(chainTip.Hash.CloneBytes()).
+				Where(blockheight.NetworkEQ(common.SchemaNetwork(network))).
+				Save(ctx)
+		}
 		if err != nil {
 			logger.Error("Failed to handle block", "error", err)
 			rollbackErr := dbTx.Rollback()
@@ -427,7 +842,7 @@ func handleBlock(
 	ctx context.Context,
 	config *so.Config,
 	dbTx *ent.Tx,
-	bitcoinClient *rpcclient.Client,
+	bitcoinClient bitcoinRPCClient,
 	txs []wire.MsgTx,
 	blockHeight int64,
 	network common.Network,
@@ -610,6 +1025,9 @@ func handleBlock(
 		if err != nil {
 			return fmt.Errorf("failed to update coop exit %s: %w", coopExit.ID.String(), err)
 		}
+		if err := notifyCoopExitConfirmed(ctx, dbTx, coopExit, blockHeight); err != nil {
+			return fmt.Errorf("failed to notify user of coop exit %s confirmation: %w", coopExit.ID.String(), err)
+		}
 
 		// Attempt to tweak keys for the coop exit. Ok to log the error and continue here
 		// since this is not critical for the block processing.
@@ -731,16 +1149,13 @@ func handleBlock(
 				if err != nil {
 					return fmt.Errorf("unable to parse tree node's owner identity public key: %w", err)
 				}
-				err = eventRouter.NotifyUser(ownerIdentityPubKey, &pb.SubscribeToEventsResponse{
+				eventRouter.NotifyUser(dbTx, ownerIdentityPubKey, &pb.SubscribeToEventsResponse{
 					Event: &pb.SubscribeToEventsResponse_Deposit{
 						Deposit: &pb.DepositEvent{
 							Deposit: treeNodeProto,
 						},
 					},
 				})
-				if err != nil {
-					logger.Error("Failed to notify user of deposit event", "error", err, "identity_public_key", ownerIdentityPubKey)
-				}
 			} else {
 				_, err = dbTx.TreeNode.UpdateOne(treeNode).
 					SetStatus(st.TreeNodeStatusSplitted).
@@ -798,6 +1213,29 @@ func storeStaticDeposits(ctx context.Context, dbTx *ent.Tx, creditedAddresses []
 	return nil
 }
 
+// notifyCoopExitConfirmed notifies the sender of the cooperative exit's transfer that the exit
+// transaction confirmed.
+func notifyCoopExitConfirmed(ctx context.Context, dbTx *ent.Tx, coopExit *ent.CooperativeExit, blockHeight int64) error {
+	transfer, err := coopExit.QueryTransfer().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query transfer: %w", err)
+	}
+	senderIdentityPubKey, err := keys.ParsePublicKey(transfer.SenderIdentityPubkey)
+	if err != nil {
+		return fmt.Errorf("unable to parse transfer's sender identity public key: %w", err)
+	}
+	events.GetDefaultRouter().NotifyUser(dbTx, senderIdentityPubKey, &pb.SubscribeToEventsResponse{
+		Event: &pb.SubscribeToEventsResponse_CooperativeExit{
+			CooperativeExit: &pb.CooperativeExitEvent{
+				TransferId:         transfer.ID.String(),
+				ExitTxid:           coopExit.ExitTxid,
+				ConfirmationHeight: uint64(blockHeight),
+			},
+		},
+	})
+	return nil
+}
+
 func tweakKeysForCoopExit(ctx context.Context, coopExit *ent.CooperativeExit, blockHeight int64) error {
 	logger := logging.GetLoggerFromContext(ctx)
 	transfer, err := coopExit.QueryTransfer().ForUpdate().Only(ctx)
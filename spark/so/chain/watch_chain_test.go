@@ -2,18 +2,25 @@ package chain
 
 import (
 	"bytes"
+	"encoding/hex"
+	"fmt"
 	"math/rand/v2"
 	"testing"
+	"time"
 
 	"github.com/lightsparkdev/spark/common/keys"
 	"github.com/lightsparkdev/spark/so/db"
 	"github.com/lightsparkdev/spark/so/ent"
 	sparktesting "github.com/lightsparkdev/spark/testing"
 
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
@@ -365,3 +372,524 @@ func TestHandleBlock_MixedTransactions(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, schematype.TreeNodeStatusExited, node.Status)
 }
+
+type fakeBlock struct {
+	height   int64
+	prevHash chainhash.Hash
+	txs      []wire.MsgTx
+}
+
+// fakeBitcoinClient serves blocks from memory. Blocks stay retrievable by hash after they are
+// reorged out of the best chain, the same way bitcoind keeps stale blocks around.
+type fakeBitcoinClient struct {
+	blocks    map[chainhash.Hash]*fakeBlock
+	bestChain map[int64]chainhash.Hash
+	tipHeight int64
+}
+
+func newFakeBitcoinClient() *fakeBitcoinClient {
+	return &fakeBitcoinClient{
+		blocks:    make(map[chainhash.Hash]*fakeBlock),
+		bestChain: make(map[int64]chainhash.Hash),
+	}
+}
+
+// addBlock adds a block on top of prevHash, makes it the best tip and returns its hash. The nonce
+// keeps otherwise identical blocks on competing branches distinct.
+func (c *fakeBitcoinClient) addBlock(prevHash chainhash.Hash, nonce uint32, txs ...wire.MsgTx) chainhash.Hash {
+	height := int64(0)
+	if prev, ok := c.blocks[prevHash]; ok {
+		height = prev.height + 1
+	}
+	header := wire.BlockHeader{PrevBlock: prevHash, Nonce: nonce}
+	hash := header.BlockHash()
+	c.blocks[hash] = &fakeBlock{height: height, prevHash: prevHash, txs: txs}
+
+	// Rewrite the best chain back to the fork point.
+	for curr := hash; ; {
+		block, ok := c.blocks[curr]
+		if !ok || c.bestChain[block.height] == curr {
+			break
+		}
+		c.bestChain[block.height] = curr
+		curr = block.prevHash
+	}
+	for h := height + 1; h <= c.tipHeight; h++ {
+		delete(c.bestChain, h)
+	}
+	c.tipHeight = height
+	return hash
+}
+
+func (c *fakeBitcoinClient) GetBlockCount() (int64, error) {
+	return c.tipHeight, nil
+}
+
+func (c *fakeBitcoinClient) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
+	hash, ok := c.bestChain[blockHeight]
+	if !ok {
+		return nil, fmt.Errorf("block at height %d not found", blockHeight)
+	}
+	return &hash, nil
+}
+
+func (c *fakeBitcoinClient) GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
+	block, ok := c.blocks[*blockHash]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", blockHash)
+	}
+	return &btcjson.GetBlockVerboseResult{
+		Hash:         blockHash.String(),
+		Height:       block.height,
+		PreviousHash: block.prevHash.String(),
+	}, nil
+}
+
+func (c *fakeBitcoinClient) GetBlockVerboseTx(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseTxResult, error) {
+	block, ok := c.blocks[*blockHash]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", blockHash)
+	}
+	var txs []btcjson.TxRawResult
+	for _, tx := range block.txs {
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			return nil, err
+		}
+		txs = append(txs, btcjson.TxRawResult{Hex: hex.EncodeToString(buf.Bytes()), Txid: tx.TxHash().String()})
+	}
+	return &btcjson.GetBlockVerboseTxResult{
+		Hash:         blockHash.String(),
+		Height:       block.height,
+		PreviousHash: block.prevHash.String(),
+		Tx:           txs,
+	}, nil
+}
+
+func (c *fakeBitcoinClient) SendRawTransaction(tx *wire.MsgTx, _ bool) (*chainhash.Hash, error) {
+	txHash := tx.TxHash()
+	return &txHash, nil
+}
+
+func TestScanChainUpdates_Reorg(t *testing.T) {
+	rng := rand.NewChaCha8([32]byte{1})
+	ctx := t.Context()
+	dbClient := db.NewTestSQLiteClient(t)
+	defer dbClient.Close()
+
+	network := common.Regtest
+	schemaNetwork := common.SchemaNetwork(network)
+	networkParams := common.NetworkParams(network)
+	config := &so.Config{
+		SupportedNetworks:          []common.Network{network},
+		FrostGRPCConnectionFactory: &sparktesting.TestGRPCConnectionFactory{},
+	}
+
+	ownerIDPubKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+	newKeyshare := func() *ent.SigningKeyshare {
+		keyshare, err := dbClient.SigningKeyshare.Create().
+			SetPublicKey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+			SetSecretShare(keys.MustGeneratePrivateKeyFromRand(rng).Serialize()).
+			SetMinSigners(1).
+			SetPublicShares(map[string][]byte{}).
+			SetStatus(schematype.KeyshareStatusAvailable).
+			SetCoordinatorIndex(0).
+			Save(ctx)
+		require.NoError(t, err)
+		return keyshare
+	}
+	newAddress := func() (string, []byte) {
+		pubKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+		address, err := btcutil.NewAddressTaproot(pubKey.Serialize()[1:], networkParams)
+		require.NoError(t, err)
+		pkScript, err := txscript.PayToAddrScript(address)
+		require.NoError(t, err)
+		return address.EncodeAddress(), pkScript
+	}
+	payTo := func(pkScript []byte, amount int64) wire.MsgTx {
+		return wire.MsgTx{Version: 2, TxIn: []*wire.TxIn{{}}, TxOut: []*wire.TxOut{{Value: amount, PkScript: pkScript}}}
+	}
+
+	_, err := dbClient.EntityDkgKey.Create().SetSigningKeyshare(newKeyshare()).Save(ctx)
+	require.NoError(t, err)
+
+	// A single use deposit, and the tree created from it.
+	depositAddress, depositPkScript := newAddress()
+	depositKeyshare := newKeyshare()
+	deposit, err := dbClient.DepositAddress.Create().
+		SetAddress(depositAddress).
+		SetOwnerIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetOwnerSigningPubkey(ownerIDPubKey.Serialize()).
+		SetSigningKeyshare(depositKeyshare).
+		Save(ctx)
+	require.NoError(t, err)
+	depositTx := payTo(depositPkScript, 10_000)
+	depositTxHash := depositTx.TxHash()
+	_, depositRootPkScript := newAddress()
+	depositRootTx := payTo(depositRootPkScript, 10_000)
+	var depositRootTxBuf bytes.Buffer
+	require.NoError(t, depositRootTx.Serialize(&depositRootTxBuf))
+	_, depositRefundPkScript := newAddress()
+	depositRefundTx := payTo(depositRefundPkScript, 10_000)
+	depositRefundTx.TxIn[0].Witness = wire.TxWitness{[]byte("signature")}
+	var depositRefundTxBuf bytes.Buffer
+	require.NoError(t, depositRefundTx.Serialize(&depositRefundTxBuf))
+	depositTree, err := dbClient.Tree.Create().
+		SetStatus(schematype.TreeStatusPending).
+		SetBaseTxid(depositTxHash.CloneBytes()).
+		SetOwnerIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetNetwork(schemaNetwork).
+		SetVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+	depositRoot, err := dbClient.TreeNode.Create().
+		SetStatus(schematype.TreeNodeStatusCreating).
+		SetOwnerIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetOwnerSigningPubkey(ownerIDPubKey.Serialize()).
+		SetVerifyingPubkey(ownerIDPubKey.Serialize()).
+		SetRawTx(depositRootTxBuf.Bytes()).
+		SetRawRefundTx(depositRefundTxBuf.Bytes()).
+		SetTree(depositTree).
+		SetValue(10_000).
+		SetVout(0).
+		SetSigningKeyshare(depositKeyshare).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A static deposit.
+	staticAddress, staticPkScript := newAddress()
+	_, err = dbClient.DepositAddress.Create().
+		SetAddress(staticAddress).
+		SetOwnerIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetOwnerSigningPubkey(ownerIDPubKey.Serialize()).
+		SetSigningKeyshare(newKeyshare()).
+		SetIsStatic(true).
+		Save(ctx)
+	require.NoError(t, err)
+	staticDepositTx := payTo(staticPkScript, 20_000)
+
+	// A root node whose node tx gets broadcast.
+	_, nodePkScript := newAddress()
+	nodeTx := payTo(nodePkScript, 30_000)
+	var nodeTxBuf bytes.Buffer
+	require.NoError(t, nodeTx.Serialize(&nodeTxBuf))
+	nodeTree, err := dbClient.Tree.Create().
+		SetStatus(schematype.TreeStatusAvailable).
+		SetBaseTxid([]byte("basetxid")).
+		SetOwnerIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetNetwork(schemaNetwork).
+		SetVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+	node, err := dbClient.TreeNode.Create().
+		SetStatus(schematype.TreeNodeStatusAvailable).
+		SetOwnerIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetOwnerSigningPubkey(ownerIDPubKey.Serialize()).
+		SetVerifyingPubkey(ownerIDPubKey.Serialize()).
+		SetRawTx(nodeTxBuf.Bytes()).
+		SetTree(nodeTree).
+		SetValue(30_000).
+		SetVout(0).
+		SetSigningKeyshare(newKeyshare()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A cooperative exit whose keys have already been tweaked.
+	_, exitPkScript := newAddress()
+	exitTx := payTo(exitPkScript, 40_000)
+	exitTxHash := exitTx.TxHash()
+	exitTransfer, err := dbClient.Transfer.Create().
+		SetStatus(schematype.TransferStatusSenderKeyTweaked).
+		SetType(schematype.TransferTypeCooperativeExit).
+		SetSenderIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetReceiverIdentityPubkey(ownerIDPubKey.Serialize()).
+		SetTotalValue(40_000).
+		SetExpiryTime(time.Now().Add(time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	coopExit, err := dbClient.CooperativeExit.Create().
+		SetExitTxid(exitTxHash.CloneBytes()).
+		SetTransfer(exitTransfer).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// An L1 token announcement.
+	issuerPubKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+	announcement := []byte(announcementPrefix)
+	announcement = append(announcement, creationAnnouncementKind[:]...)
+	announcement = append(announcement, issuerPubKey.Serialize()...)
+	announcement = append(announcement, 9)
+	announcement = append(announcement, []byte("TestToken")...)
+	announcement = append(announcement, 4)
+	announcement = append(announcement, []byte("TICK")...)
+	announcement = append(announcement, 8)
+	announcement = append(announcement, make([]byte, 16)...)
+	announcement = append(announcement, 1)
+	announcementScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).AddData(announcement).Script()
+	require.NoError(t, err)
+	tokenTx := wire.MsgTx{Version: 2, TxIn: []*wire.TxIn{{}}, TxOut: []*wire.TxOut{{Value: 0, PkScript: announcementScript}}}
+
+	// Chain A: 0 <- 1 <- 2 (everything confirms at height 2) <- 3.
+	client := newFakeBitcoinClient()
+	genesis := client.addBlock(chainhash.Hash{}, 0)
+	blockA1 := client.addBlock(genesis, 0)
+	blockA2 := client.addBlock(blockA1, 0, depositTx, staticDepositTx, nodeTx, exitTx, tokenTx)
+	blockA3 := client.addBlock(blockA2, 0)
+
+	_, err = dbClient.BlockHeight.Create().
+		SetHeight(1).
+		SetBlockHash(blockA1.CloneBytes()).
+		SetNetwork(schemaNetwork).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, scanChainUpdates(ctx, config, dbClient, client, network))
+
+	blockHeight, err := dbClient.BlockHeight.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, blockHeight.Height)
+	assert.Equal(t, blockA3.CloneBytes(), blockHeight.BlockHash)
+
+	deposit, err = dbClient.DepositAddress.Get(ctx, deposit.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, deposit.ConfirmationHeight)
+	assert.Equal(t, depositTx.TxHash().String(), deposit.ConfirmationTxid)
+
+	depositTree, err = dbClient.Tree.Get(ctx, depositTree.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeStatusAvailable, depositTree.Status)
+	depositRoot, err = dbClient.TreeNode.Get(ctx, depositRoot.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeNodeStatusAvailable, depositRoot.Status)
+
+	node, err = dbClient.TreeNode.Get(ctx, node.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeNodeStatusOnChain, node.Status)
+	assert.EqualValues(t, 2, node.NodeConfirmationHeight)
+
+	coopExit, err = dbClient.CooperativeExit.Get(ctx, coopExit.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, coopExit.ConfirmationHeight)
+
+	utxoCount, err := dbClient.Utxo.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, utxoCount)
+
+	l1TokenCount, err := dbClient.L1TokenCreate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l1TokenCount)
+	tokenCount, err := dbClient.TokenCreate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, tokenCount)
+
+	// Chain B forks off at height 1 and overtakes chain A without any of its transactions.
+	blockB2 := client.addBlock(blockA1, 1)
+	blockB3 := client.addBlock(blockB2, 1)
+	blockB4 := client.addBlock(blockB3, 1)
+
+	require.NoError(t, scanChainUpdates(ctx, config, dbClient, client, network))
+
+	blockHeight, err = dbClient.BlockHeight.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, blockHeight.Height)
+	assert.Equal(t, blockB4.CloneBytes(), blockHeight.BlockHash)
+
+	deposit, err = dbClient.DepositAddress.Get(ctx, deposit.ID)
+	require.NoError(t, err)
+	assert.Zero(t, deposit.ConfirmationHeight)
+	assert.Empty(t, deposit.ConfirmationTxid)
+
+	depositTree, err = dbClient.Tree.Get(ctx, depositTree.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeStatusPending, depositTree.Status)
+	depositRoot, err = dbClient.TreeNode.Get(ctx, depositRoot.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeNodeStatusCreating, depositRoot.Status)
+
+	node, err = dbClient.TreeNode.Get(ctx, node.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeNodeStatusAvailable, node.Status)
+	assert.Zero(t, node.NodeConfirmationHeight)
+
+	coopExit, err = dbClient.CooperativeExit.Get(ctx, coopExit.ID)
+	require.NoError(t, err)
+	assert.Zero(t, coopExit.ConfirmationHeight)
+
+	utxoCount, err = dbClient.Utxo.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, utxoCount)
+
+	l1TokenCount, err = dbClient.L1TokenCreate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, l1TokenCount)
+	tokenCount, err = dbClient.TokenCreate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, tokenCount)
+
+	// The transactions are mined again on chain B and everything confirms at the new height.
+	client.addBlock(blockB4, 1, depositTx, staticDepositTx, nodeTx, exitTx, tokenTx)
+
+	require.NoError(t, scanChainUpdates(ctx, config, dbClient, client, network))
+
+	deposit, err = dbClient.DepositAddress.Get(ctx, deposit.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, deposit.ConfirmationHeight)
+
+	depositTree, err = dbClient.Tree.Get(ctx, depositTree.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeStatusAvailable, depositTree.Status)
+	depositRoot, err = dbClient.TreeNode.Get(ctx, depositRoot.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeNodeStatusAvailable, depositRoot.Status)
+
+	node, err = dbClient.TreeNode.Get(ctx, node.ID)
+	require.NoError(t, err)
+	assert.Equal(t, schematype.TreeNodeStatusOnChain, node.Status)
+	assert.EqualValues(t, 5, node.NodeConfirmationHeight)
+
+	coopExit, err = dbClient.CooperativeExit.Get(ctx, coopExit.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, coopExit.ConfirmationHeight)
+
+	reconfirmedUtxo, err := dbClient.Utxo.Query().Only(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, reconfirmedUtxo.BlockHeight)
+
+	l1TokenCount, err = dbClient.L1TokenCreate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, l1TokenCount)
+}
+
+func TestDisconnectStaticDeposits_KeepsSwappedUtxos(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	dbTx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	keyshare, err := dbTx.SigningKeyshare.Create().
+		SetPublicKey([]byte("test_public_key")).
+		SetSecretShare([]byte("test_secret_share")).
+		SetMinSigners(1).
+		SetPublicShares(map[string][]byte{}).
+		SetStatus(schematype.KeyshareStatusAvailable).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	depositAddress, err := dbTx.DepositAddress.Create().
+		SetAddress("test_static_address").
+		SetOwnerIdentityPubkey([]byte("test_identity_pubkey")).
+		SetOwnerSigningPubkey([]byte("test_signing_pubkey")).
+		SetSigningKeyshare(keyshare).
+		SetIsStatic(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	newUtxo := func(txid string, blockHeight int64) *ent.Utxo {
+		utxo, err := dbTx.Utxo.Create().
+			SetNetwork(schematype.NetworkRegtest).
+			SetTxid([]byte(txid)).
+			SetVout(0).
+			SetBlockHeight(blockHeight).
+			SetAmount(1000).
+			SetPkScript([]byte("test_script")).
+			SetDepositAddress(depositAddress).
+			Save(ctx)
+		require.NoError(t, err)
+		return utxo
+	}
+	newUtxo("unswapped", 10)
+	swapped := newUtxo("swapped", 10)
+	cancelled := newUtxo("cancelled", 10)
+	otherHeight := newUtxo("other_height", 11)
+
+	for utxo, status := range map[*ent.Utxo]schematype.UtxoSwapStatus{
+		swapped:   schematype.UtxoSwapStatusCreated,
+		cancelled: schematype.UtxoSwapStatusCancelled,
+	} {
+		_, err = dbTx.UtxoSwap.Create().
+			SetStatus(status).
+			SetRequestType(schematype.UtxoSwapRequestTypeFixedAmount).
+			SetCoordinatorIdentityPublicKey([]byte("test_coordinator")).
+			SetUtxo(utxo).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, disconnectStaticDeposits(ctx, dbTx, 10, schematype.NetworkRegtest))
+
+	remaining, err := dbTx.Utxo.Query().IDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{swapped.ID, otherHeight.ID}, remaining)
+
+	swapCount, err := dbTx.UtxoSwap.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, swapCount)
+}
+
+func TestDisconnectTokenAnnouncements_KeepsTokensInUse(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	dbTx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	rng := rand.NewChaCha8([32]byte{1})
+
+	var disconnectedTxHashes [][]byte
+	announceToken := func(txid string) *ent.TokenCreate {
+		issuerPublicKey := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+		l1TokenCreate, err := dbTx.L1TokenCreate.Create().
+			SetIssuerPublicKey(issuerPublicKey).
+			SetTokenName("TestToken").
+			SetTokenTicker("TTK").
+			SetDecimals(0).
+			SetMaxSupply(make([]byte, 16)).
+			SetIsFreezable(true).
+			SetNetwork(schematype.NetworkRegtest).
+			SetTokenIdentifier([]byte(txid)).
+			SetTransactionID([]byte(txid)).
+			Save(ctx)
+		require.NoError(t, err)
+		tokenCreate, err := sparktesting.NewTestTokenCreate(t, dbTx, issuerPublicKey).
+			SetL1TokenCreate(l1TokenCreate).
+			Save(ctx)
+		require.NoError(t, err)
+		disconnectedTxHashes = append(disconnectedTxHashes, []byte(txid))
+		return tokenCreate
+	}
+	unused := announceToken("unused")
+	frozen := announceToken("frozen")
+	allowlisted := announceToken("allowlisted")
+	paused := announceToken("paused")
+
+	_, err = dbTx.TokenFreeze.Create().
+		SetStatus(schematype.TokenFreezeStatusFrozen).
+		SetOwnerPublicKey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetIssuerSignature([]byte("freeze_signature")).
+		SetWalletProvidedFreezeTimestamp(1).
+		SetTokenCreateID(frozen.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = dbTx.TokenAllowlistEntry.Create().
+		SetStatus(schematype.TokenAllowlistStatusAllowed).
+		SetOwnerPublicKey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetIssuerSignature([]byte("allowlist_signature")).
+		SetIssuerProvidedTimestamp(1).
+		SetTokenCreateID(allowlisted.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = paused.Update().
+		SetIsPaused(true).
+		SetPauseIssuerSignature([]byte("pause_signature")).
+		SetPauseIssuerProvidedTimestamp(1).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, disconnectTokenAnnouncements(ctx, dbTx, disconnectedTxHashes, schematype.NetworkRegtest))
+
+	l1TokenCount, err := dbTx.L1TokenCreate.Query().Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, l1TokenCount)
+	remaining, err := dbTx.TokenCreate.Query().IDs(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uuid.UUID{frozen.ID, allowlisted.ID, paused.ID}, remaining)
+	assert.NotContains(t, remaining, unused.ID)
+}
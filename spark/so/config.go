@@ -114,6 +114,9 @@ type Config struct {
 	FrostGRPCConnectionFactory frost.FrostGRPCConnectionFactory
 	// GRPC contains configuration for gRPC server behavior
 	GRPC GRPCConfig
+	// AdminIdentityPublicKey is the identity key allowed to call the admin service. The admin
+	// service rejects every call if it is not set.
+	AdminIdentityPublicKey keys.Public
 }
 
 // DatabaseDriver returns the database driver based on the database path.
@@ -169,6 +172,8 @@ type OperatorConfig struct {
 	RateLimiter RateLimiterConfig `yaml:"rate_limiter"`
 	// GRPC holds configuration for gRPC server behavior
 	GRPC GRPCConfig `yaml:"grpc"`
+	// AdminIdentityPublicKey is the hex-encoded identity key allowed to call the admin service.
+	AdminIdentityPublicKey string `yaml:"admin_identity_public_key"`
 }
 
 type DkgConfig struct {
@@ -245,9 +250,15 @@ type RateLimiterConfig struct {
 	// MaxRequests is the maximum number of requests allowed in the window
 	MaxRequests int `yaml:"max_requests"`
 	// Methods is a list of methods to rate limit
-	// Note: This does not set up rate limiting across methods by IP,
-	// nor does it provide configuration for custom per-method rate limiting.
+	// Note: Each method is limited separately, by client IP and by session identity.
+	// The period and limit knobs can target a method to give it its own limit.
 	Methods []string `yaml:"methods"`
+	// MemcachedAddrs is a list of memcached addresses used to share rate limit counters
+	// across replicas. If empty, counters are kept in memory by each replica.
+	MemcachedAddrs []string `yaml:"memcached_addrs"`
+	// FailOpen allows requests through when the memcached servers cannot be reached.
+	// Otherwise they are rejected.
+	FailOpen bool `yaml:"fail_open"`
 }
 
 // The authzEnabled field currently gates authorization enforcement for client
@@ -345,6 +356,18 @@ func NewConfig(
 		rateLimiter = operatorConfig.RateLimiter
 	}
 
+	var adminIdentityPublicKey keys.Public
+	if operatorConfig.AdminIdentityPublicKey != "" {
+		adminIdentityPublicKeyBytes, err := hex.DecodeString(operatorConfig.AdminIdentityPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode admin identity public key: %w", err)
+		}
+		adminIdentityPublicKey, err = keys.ParsePublicKey(adminIdentityPublicKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse admin identity public key: %w", err)
+		}
+	}
+
 	conf := &Config{
 		Index:                      index,
 		Identifier:                 identifier,
@@ -373,6 +396,7 @@ func NewConfig(
 		Knobs:                      operatorConfig.Knobs,
 		FrostGRPCConnectionFactory: &frost.FrostGRPCConnectionFactorySecure{},
 		GRPC:                       operatorConfig.GRPC,
+		AdminIdentityPublicKey:     adminIdentityPublicKey,
 	}
 
 	conf.buildIdentityPubkeyMap()
@@ -635,6 +659,8 @@ func (c *Config) GetRateLimiterConfig() *middleware.RateLimiterConfig {
 		MaxRequests:         c.RateLimiter.MaxRequests,
 		Methods:             c.RateLimiter.Methods,
 		XffClientIpPosition: c.XffClientIpPosition,
+		MemcachedAddrs:      c.RateLimiter.MemcachedAddrs,
+		FailOpen:            c.RateLimiter.FailOpen,
 	}
 }
 
@@ -0,0 +1,102 @@
+package so
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/lightsparkdev/spark/common/keys"
+)
+
+const redacted = "REDACTED"
+
+// RedactedJSON returns the config as JSON for display, with private keys, passwords and database
+// credentials redacted. Functions, interfaces and unexported fields are left out.
+func (c *Config) RedactedJSON() ([]byte, error) {
+	return json.MarshalIndent(redactedValue(reflect.ValueOf(c), ""), "", "  ")
+}
+
+var (
+	privateKeyType = reflect.TypeFor[keys.Private]()
+	stringerType   = reflect.TypeFor[fmt.Stringer]()
+)
+
+// redactedValue converts the value to something that can be marshalled to JSON, redacting secrets.
+// The field name is used to recognize string fields holding secrets.
+func redactedValue(v reflect.Value, fieldName string) any {
+	if v.Type() == privateKeyType {
+		return redacted
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return redactedValue(v.Elem(), fieldName)
+	case reflect.Struct:
+		if v.Type().Implements(stringerType) {
+			return v.Interface().(fmt.Stringer).String()
+		}
+		fields := make(map[string]any)
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			switch field.Type.Kind() {
+			case reflect.Func, reflect.Interface, reflect.Chan:
+				continue
+			}
+			fields[field.Name] = redactedValue(v.Field(i), field.Name)
+		}
+		return fields
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		entries := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entries[fmt.Sprint(iter.Key().Interface())] = redactedValue(iter.Value(), fieldName)
+		}
+		return entries
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		elements := make([]any, v.Len())
+		for i := range v.Len() {
+			elements[i] = redactedValue(v.Index(i), fieldName)
+		}
+		return elements
+	case reflect.String:
+		return redactedString(v.String(), fieldName)
+	}
+
+	if v.Type().Implements(stringerType) {
+		return v.Interface().(fmt.Stringer).String()
+	}
+	return v.Interface()
+}
+
+func redactedString(value string, fieldName string) string {
+	name := strings.ToLower(fieldName)
+	if value == "" {
+		return value
+	}
+	if strings.Contains(name, "password") || strings.Contains(name, "secret") {
+		return redacted
+	}
+	// Connection strings can carry credentials.
+	if uri, err := url.Parse(value); err == nil && (uri.User != nil || uri.Query().Has("password")) {
+		if query := uri.Query(); query.Has("password") {
+			query.Set("password", redacted)
+			uri.RawQuery = query.Encode()
+		}
+		return uri.Redacted()
+	}
+	return value
+}
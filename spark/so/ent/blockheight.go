@@ -26,7 +26,9 @@ type BlockHeight struct {
 	// Height holds the value of the "height" field.
 	Height int64 `json:"height,omitempty"`
 	// Network holds the value of the "network" field.
-	Network      schematype.Network `json:"network,omitempty"`
+	Network schematype.Network `json:"network,omitempty"`
+	// BlockHash holds the value of the "block_hash" field.
+	BlockHash    []byte `json:"block_hash,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -35,6 +37,8 @@ func (*BlockHeight) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case blockheight.FieldBlockHash:
+			values[i] = new([]byte)
 		case blockheight.FieldHeight:
 			values[i] = new(sql.NullInt64)
 		case blockheight.FieldNetwork:
@@ -88,6 +92,12 @@ func (bh *BlockHeight) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bh.Network = schematype.Network(value.String)
 			}
+		case blockheight.FieldBlockHash:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field block_hash", values[i])
+			} else if value != nil {
+				bh.BlockHash = *value
+			}
 		default:
 			bh.selectValues.Set(columns[i], values[i])
 		}
@@ -135,6 +145,9 @@ func (bh *BlockHeight) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("network=")
 	builder.WriteString(fmt.Sprintf("%v", bh.Network))
+	builder.WriteString(", ")
+	builder.WriteString("block_hash=")
+	builder.WriteString(fmt.Sprintf("%v", bh.BlockHash))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,8 @@ const (
 	FieldHeight = "height"
 	// FieldNetwork holds the string denoting the network field in the database.
 	FieldNetwork = "network"
+	// FieldBlockHash holds the string denoting the block_hash field in the database.
+	FieldBlockHash = "block_hash"
 	// Table holds the table name of the blockheight in the database.
 	Table = "block_heights"
 )
@@ -35,6 +37,7 @@ var Columns = []string{
 	FieldUpdateTime,
 	FieldHeight,
 	FieldNetwork,
+	FieldBlockHash,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -65,6 +65,12 @@ func (bhc *BlockHeightCreate) SetNetwork(s schematype.Network) *BlockHeightCreat
 	return bhc
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (bhc *BlockHeightCreate) SetBlockHash(b []byte) *BlockHeightCreate {
+	bhc.mutation.SetBlockHash(b)
+	return bhc
+}
+
 // SetID sets the "id" field.
 func (bhc *BlockHeightCreate) SetID(u uuid.UUID) *BlockHeightCreate {
 	bhc.mutation.SetID(u)
@@ -199,6 +205,10 @@ func (bhc *BlockHeightCreate) createSpec() (*BlockHeight, *sqlgraph.CreateSpec)
 		_spec.SetField(blockheight.FieldNetwork, field.TypeEnum, value)
 		_node.Network = value
 	}
+	if value, ok := bhc.mutation.BlockHash(); ok {
+		_spec.SetField(blockheight.FieldBlockHash, field.TypeBytes, value)
+		_node.BlockHash = value
+	}
 	return _node, _spec
 }
 
@@ -293,6 +303,24 @@ func (u *BlockHeightUpsert) UpdateNetwork() *BlockHeightUpsert {
 	return u
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (u *BlockHeightUpsert) SetBlockHash(v []byte) *BlockHeightUpsert {
+	u.Set(blockheight.FieldBlockHash, v)
+	return u
+}
+
+// UpdateBlockHash sets the "block_hash" field to the value that was provided on create.
+func (u *BlockHeightUpsert) UpdateBlockHash() *BlockHeightUpsert {
+	u.SetExcluded(blockheight.FieldBlockHash)
+	return u
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (u *BlockHeightUpsert) ClearBlockHash() *BlockHeightUpsert {
+	u.SetNull(blockheight.FieldBlockHash)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -393,6 +421,27 @@ func (u *BlockHeightUpsertOne) UpdateNetwork() *BlockHeightUpsertOne {
 	})
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (u *BlockHeightUpsertOne) SetBlockHash(v []byte) *BlockHeightUpsertOne {
+	return u.Update(func(s *BlockHeightUpsert) {
+		s.SetBlockHash(v)
+	})
+}
+
+// UpdateBlockHash sets the "block_hash" field to the value that was provided on create.
+func (u *BlockHeightUpsertOne) UpdateBlockHash() *BlockHeightUpsertOne {
+	return u.Update(func(s *BlockHeightUpsert) {
+		s.UpdateBlockHash()
+	})
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (u *BlockHeightUpsertOne) ClearBlockHash() *BlockHeightUpsertOne {
+	return u.Update(func(s *BlockHeightUpsert) {
+		s.ClearBlockHash()
+	})
+}
+
 // Exec executes the query.
 func (u *BlockHeightUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -660,6 +709,27 @@ func (u *BlockHeightUpsertBulk) UpdateNetwork() *BlockHeightUpsertBulk {
 	})
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (u *BlockHeightUpsertBulk) SetBlockHash(v []byte) *BlockHeightUpsertBulk {
+	return u.Update(func(s *BlockHeightUpsert) {
+		s.SetBlockHash(v)
+	})
+}
+
+// UpdateBlockHash sets the "block_hash" field to the value that was provided on create.
+func (u *BlockHeightUpsertBulk) UpdateBlockHash() *BlockHeightUpsertBulk {
+	return u.Update(func(s *BlockHeightUpsert) {
+		s.UpdateBlockHash()
+	})
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (u *BlockHeightUpsertBulk) ClearBlockHash() *BlockHeightUpsertBulk {
+	return u.Update(func(s *BlockHeightUpsert) {
+		s.ClearBlockHash()
+	})
+}
+
 // Exec executes the query.
 func (u *BlockHeightUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
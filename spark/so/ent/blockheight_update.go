@@ -70,6 +70,18 @@ func (bhu *BlockHeightUpdate) SetNillableNetwork(s *schematype.Network) *BlockHe
 	return bhu
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (bhu *BlockHeightUpdate) SetBlockHash(b []byte) *BlockHeightUpdate {
+	bhu.mutation.SetBlockHash(b)
+	return bhu
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (bhu *BlockHeightUpdate) ClearBlockHash() *BlockHeightUpdate {
+	bhu.mutation.ClearBlockHash()
+	return bhu
+}
+
 // Mutation returns the BlockHeightMutation object of the builder.
 func (bhu *BlockHeightUpdate) Mutation() *BlockHeightMutation {
 	return bhu.mutation
@@ -145,6 +157,12 @@ func (bhu *BlockHeightUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bhu.mutation.Network(); ok {
 		_spec.SetField(blockheight.FieldNetwork, field.TypeEnum, value)
 	}
+	if value, ok := bhu.mutation.BlockHash(); ok {
+		_spec.SetField(blockheight.FieldBlockHash, field.TypeBytes, value)
+	}
+	if bhu.mutation.BlockHashCleared() {
+		_spec.ClearField(blockheight.FieldBlockHash, field.TypeBytes)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, bhu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{blockheight.Label}
@@ -206,6 +224,18 @@ func (bhuo *BlockHeightUpdateOne) SetNillableNetwork(s *schematype.Network) *Blo
 	return bhuo
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (bhuo *BlockHeightUpdateOne) SetBlockHash(b []byte) *BlockHeightUpdateOne {
+	bhuo.mutation.SetBlockHash(b)
+	return bhuo
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (bhuo *BlockHeightUpdateOne) ClearBlockHash() *BlockHeightUpdateOne {
+	bhuo.mutation.ClearBlockHash()
+	return bhuo
+}
+
 // Mutation returns the BlockHeightMutation object of the builder.
 func (bhuo *BlockHeightUpdateOne) Mutation() *BlockHeightMutation {
 	return bhuo.mutation
@@ -311,6 +341,12 @@ func (bhuo *BlockHeightUpdateOne) sqlSave(ctx context.Context) (_node *BlockHeig
 	if value, ok := bhuo.mutation.Network(); ok {
 		_spec.SetField(blockheight.FieldNetwork, field.TypeEnum, value)
 	}
+	if value, ok := bhuo.mutation.BlockHash(); ok {
+		_spec.SetField(blockheight.FieldBlockHash, field.TypeBytes, value)
+	}
+	if bhuo.mutation.BlockHashCleared() {
+		_spec.ClearField(blockheight.FieldBlockHash, field.TypeBytes)
+	}
 	_node = &BlockHeight{config: bhuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -29,8 +29,10 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokenpartialrevocationsecretshare"
@@ -40,6 +42,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
 	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
@@ -78,10 +82,14 @@ type Client struct {
 	SigningNonce *SigningNonceClient
 	// SparkInvoice is the client for interacting with the SparkInvoice builders.
 	SparkInvoice *SparkInvoiceClient
+	// TokenAllowlistEntry is the client for interacting with the TokenAllowlistEntry builders.
+	TokenAllowlistEntry *TokenAllowlistEntryClient
 	// TokenCreate is the client for interacting with the TokenCreate builders.
 	TokenCreate *TokenCreateClient
 	// TokenFreeze is the client for interacting with the TokenFreeze builders.
 	TokenFreeze *TokenFreezeClient
+	// TokenIssuerKeyRotation is the client for interacting with the TokenIssuerKeyRotation builders.
+	TokenIssuerKeyRotation *TokenIssuerKeyRotationClient
 	// TokenMint is the client for interacting with the TokenMint builders.
 	TokenMint *TokenMintClient
 	// TokenOutput is the client for interacting with the TokenOutput builders.
@@ -100,6 +108,10 @@ type Client struct {
 	Tree *TreeClient
 	// TreeNode is the client for interacting with the TreeNode builders.
 	TreeNode *TreeNodeClient
+	// UserEvent is the client for interacting with the UserEvent builders.
+	UserEvent *UserEventClient
+	// UserEventSequence is the client for interacting with the UserEventSequence builders.
+	UserEventSequence *UserEventSequenceClient
 	// UserSignedTransaction is the client for interacting with the UserSignedTransaction builders.
 	UserSignedTransaction *UserSignedTransactionClient
 	// Utxo is the client for interacting with the Utxo builders.
@@ -130,8 +142,10 @@ func (c *Client) init() {
 	c.SigningKeyshare = NewSigningKeyshareClient(c.config)
 	c.SigningNonce = NewSigningNonceClient(c.config)
 	c.SparkInvoice = NewSparkInvoiceClient(c.config)
+	c.TokenAllowlistEntry = NewTokenAllowlistEntryClient(c.config)
 	c.TokenCreate = NewTokenCreateClient(c.config)
 	c.TokenFreeze = NewTokenFreezeClient(c.config)
+	c.TokenIssuerKeyRotation = NewTokenIssuerKeyRotationClient(c.config)
 	c.TokenMint = NewTokenMintClient(c.config)
 	c.TokenOutput = NewTokenOutputClient(c.config)
 	c.TokenPartialRevocationSecretShare = NewTokenPartialRevocationSecretShareClient(c.config)
@@ -141,6 +155,8 @@ func (c *Client) init() {
 	c.TransferLeaf = NewTransferLeafClient(c.config)
 	c.Tree = NewTreeClient(c.config)
 	c.TreeNode = NewTreeNodeClient(c.config)
+	c.UserEvent = NewUserEventClient(c.config)
+	c.UserEventSequence = NewUserEventSequenceClient(c.config)
 	c.UserSignedTransaction = NewUserSignedTransactionClient(c.config)
 	c.Utxo = NewUtxoClient(c.config)
 	c.UtxoSwap = NewUtxoSwapClient(c.config)
@@ -249,8 +265,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		SigningKeyshare:                   NewSigningKeyshareClient(cfg),
 		SigningNonce:                      NewSigningNonceClient(cfg),
 		SparkInvoice:                      NewSparkInvoiceClient(cfg),
+		TokenAllowlistEntry:               NewTokenAllowlistEntryClient(cfg),
 		TokenCreate:                       NewTokenCreateClient(cfg),
 		TokenFreeze:                       NewTokenFreezeClient(cfg),
+		TokenIssuerKeyRotation:            NewTokenIssuerKeyRotationClient(cfg),
 		TokenMint:                         NewTokenMintClient(cfg),
 		TokenOutput:                       NewTokenOutputClient(cfg),
 		TokenPartialRevocationSecretShare: NewTokenPartialRevocationSecretShareClient(cfg),
@@ -260,6 +278,8 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		TransferLeaf:                      NewTransferLeafClient(cfg),
 		Tree:                              NewTreeClient(cfg),
 		TreeNode:                          NewTreeNodeClient(cfg),
+		UserEvent:                         NewUserEventClient(cfg),
+		UserEventSequence:                 NewUserEventSequenceClient(cfg),
 		UserSignedTransaction:             NewUserSignedTransactionClient(cfg),
 		Utxo:                              NewUtxoClient(cfg),
 		UtxoSwap:                          NewUtxoSwapClient(cfg),
@@ -295,8 +315,10 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		SigningKeyshare:                   NewSigningKeyshareClient(cfg),
 		SigningNonce:                      NewSigningNonceClient(cfg),
 		SparkInvoice:                      NewSparkInvoiceClient(cfg),
+		TokenAllowlistEntry:               NewTokenAllowlistEntryClient(cfg),
 		TokenCreate:                       NewTokenCreateClient(cfg),
 		TokenFreeze:                       NewTokenFreezeClient(cfg),
+		TokenIssuerKeyRotation:            NewTokenIssuerKeyRotationClient(cfg),
 		TokenMint:                         NewTokenMintClient(cfg),
 		TokenOutput:                       NewTokenOutputClient(cfg),
 		TokenPartialRevocationSecretShare: NewTokenPartialRevocationSecretShareClient(cfg),
@@ -306,6 +328,8 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		TransferLeaf:                      NewTransferLeafClient(cfg),
 		Tree:                              NewTreeClient(cfg),
 		TreeNode:                          NewTreeNodeClient(cfg),
+		UserEvent:                         NewUserEventClient(cfg),
+		UserEventSequence:                 NewUserEventSequenceClient(cfg),
 		UserSignedTransaction:             NewUserSignedTransactionClient(cfg),
 		Utxo:                              NewUtxoClient(cfg),
 		UtxoSwap:                          NewUtxoSwapClient(cfg),
@@ -341,10 +365,11 @@ func (c *Client) Use(hooks ...Hook) {
 		c.BlockHeight, c.CooperativeExit, c.DepositAddress, c.EntityDkgKey, c.Gossip,
 		c.L1TokenCreate, c.PaymentIntent, c.PreimageRequest, c.PreimageShare,
 		c.SigningCommitment, c.SigningKeyshare, c.SigningNonce, c.SparkInvoice,
-		c.TokenCreate, c.TokenFreeze, c.TokenMint, c.TokenOutput,
-		c.TokenPartialRevocationSecretShare, c.TokenTransaction,
-		c.TokenTransactionPeerSignature, c.Transfer, c.TransferLeaf, c.Tree,
-		c.TreeNode, c.UserSignedTransaction, c.Utxo, c.UtxoSwap,
+		c.TokenAllowlistEntry, c.TokenCreate, c.TokenFreeze, c.TokenIssuerKeyRotation,
+		c.TokenMint, c.TokenOutput, c.TokenPartialRevocationSecretShare,
+		c.TokenTransaction, c.TokenTransactionPeerSignature, c.Transfer,
+		c.TransferLeaf, c.Tree, c.TreeNode, c.UserEvent, c.UserEventSequence,
+		c.UserSignedTransaction, c.Utxo, c.UtxoSwap,
 	} {
 		n.Use(hooks...)
 	}
@@ -357,10 +382,11 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.BlockHeight, c.CooperativeExit, c.DepositAddress, c.EntityDkgKey, c.Gossip,
 		c.L1TokenCreate, c.PaymentIntent, c.PreimageRequest, c.PreimageShare,
 		c.SigningCommitment, c.SigningKeyshare, c.SigningNonce, c.SparkInvoice,
-		c.TokenCreate, c.TokenFreeze, c.TokenMint, c.TokenOutput,
-		c.TokenPartialRevocationSecretShare, c.TokenTransaction,
-		c.TokenTransactionPeerSignature, c.Transfer, c.TransferLeaf, c.Tree,
-		c.TreeNode, c.UserSignedTransaction, c.Utxo, c.UtxoSwap,
+		c.TokenAllowlistEntry, c.TokenCreate, c.TokenFreeze, c.TokenIssuerKeyRotation,
+		c.TokenMint, c.TokenOutput, c.TokenPartialRevocationSecretShare,
+		c.TokenTransaction, c.TokenTransactionPeerSignature, c.Transfer,
+		c.TransferLeaf, c.Tree, c.TreeNode, c.UserEvent, c.UserEventSequence,
+		c.UserSignedTransaction, c.Utxo, c.UtxoSwap,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -395,10 +421,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SigningNonce.mutate(ctx, m)
 	case *SparkInvoiceMutation:
 		return c.SparkInvoice.mutate(ctx, m)
+	case *TokenAllowlistEntryMutation:
+		return c.TokenAllowlistEntry.mutate(ctx, m)
 	case *TokenCreateMutation:
 		return c.TokenCreate.mutate(ctx, m)
 	case *TokenFreezeMutation:
 		return c.TokenFreeze.mutate(ctx, m)
+	case *TokenIssuerKeyRotationMutation:
+		return c.TokenIssuerKeyRotation.mutate(ctx, m)
 	case *TokenMintMutation:
 		return c.TokenMint.mutate(ctx, m)
 	case *TokenOutputMutation:
@@ -417,6 +447,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Tree.mutate(ctx, m)
 	case *TreeNodeMutation:
 		return c.TreeNode.mutate(ctx, m)
+	case *UserEventMutation:
+		return c.UserEvent.mutate(ctx, m)
+	case *UserEventSequenceMutation:
+		return c.UserEventSequence.mutate(ctx, m)
 	case *UserSignedTransactionMutation:
 		return c.UserSignedTransaction.mutate(ctx, m)
 	case *UtxoMutation:
@@ -2325,6 +2359,22 @@ func (c *SparkInvoiceClient) QueryTokenTransaction(si *SparkInvoice) *TokenTrans
 	return query
 }
 
+// QueryTransfer queries the transfer edge of a SparkInvoice.
+func (c *SparkInvoiceClient) QueryTransfer(si *SparkInvoice) *TransferQuery {
+	query := (&TransferClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := si.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sparkinvoice.Table, sparkinvoice.FieldID, id),
+			sqlgraph.To(transfer.Table, transfer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, sparkinvoice.TransferTable, sparkinvoice.TransferColumn),
+		)
+		fromV = sqlgraph.Neighbors(si.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SparkInvoiceClient) Hooks() []Hook {
 	return c.hooks.SparkInvoice
@@ -2350,6 +2400,155 @@ func (c *SparkInvoiceClient) mutate(ctx context.Context, m *SparkInvoiceMutation
 	}
 }
 
+// TokenAllowlistEntryClient is a client for the TokenAllowlistEntry schema.
+type TokenAllowlistEntryClient struct {
+	config
+}
+
+// NewTokenAllowlistEntryClient returns a client for the TokenAllowlistEntry from the given config.
+func NewTokenAllowlistEntryClient(c config) *TokenAllowlistEntryClient {
+	return &TokenAllowlistEntryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tokenallowlistentry.Hooks(f(g(h())))`.
+func (c *TokenAllowlistEntryClient) Use(hooks ...Hook) {
+	c.hooks.TokenAllowlistEntry = append(c.hooks.TokenAllowlistEntry, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tokenallowlistentry.Intercept(f(g(h())))`.
+func (c *TokenAllowlistEntryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenAllowlistEntry = append(c.inters.TokenAllowlistEntry, interceptors...)
+}
+
+// Create returns a builder for creating a TokenAllowlistEntry entity.
+func (c *TokenAllowlistEntryClient) Create() *TokenAllowlistEntryCreate {
+	mutation := newTokenAllowlistEntryMutation(c.config, OpCreate)
+	return &TokenAllowlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TokenAllowlistEntry entities.
+func (c *TokenAllowlistEntryClient) CreateBulk(builders ...*TokenAllowlistEntryCreate) *TokenAllowlistEntryCreateBulk {
+	return &TokenAllowlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenAllowlistEntryClient) MapCreateBulk(slice any, setFunc func(*TokenAllowlistEntryCreate, int)) *TokenAllowlistEntryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenAllowlistEntryCreateBulk{err: fmt.Errorf("calling to TokenAllowlistEntryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenAllowlistEntryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenAllowlistEntryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenAllowlistEntry.
+func (c *TokenAllowlistEntryClient) Update() *TokenAllowlistEntryUpdate {
+	mutation := newTokenAllowlistEntryMutation(c.config, OpUpdate)
+	return &TokenAllowlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenAllowlistEntryClient) UpdateOne(tae *TokenAllowlistEntry) *TokenAllowlistEntryUpdateOne {
+	mutation := newTokenAllowlistEntryMutation(c.config, OpUpdateOne, withTokenAllowlistEntry(tae))
+	return &TokenAllowlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenAllowlistEntryClient) UpdateOneID(id uuid.UUID) *TokenAllowlistEntryUpdateOne {
+	mutation := newTokenAllowlistEntryMutation(c.config, OpUpdateOne, withTokenAllowlistEntryID(id))
+	return &TokenAllowlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenAllowlistEntry.
+func (c *TokenAllowlistEntryClient) Delete() *TokenAllowlistEntryDelete {
+	mutation := newTokenAllowlistEntryMutation(c.config, OpDelete)
+	return &TokenAllowlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenAllowlistEntryClient) DeleteOne(tae *TokenAllowlistEntry) *TokenAllowlistEntryDeleteOne {
+	return c.DeleteOneID(tae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenAllowlistEntryClient) DeleteOneID(id uuid.UUID) *TokenAllowlistEntryDeleteOne {
+	builder := c.Delete().Where(tokenallowlistentry.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenAllowlistEntryDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenAllowlistEntry.
+func (c *TokenAllowlistEntryClient) Query() *TokenAllowlistEntryQuery {
+	return &TokenAllowlistEntryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenAllowlistEntry},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenAllowlistEntry entity by its id.
+func (c *TokenAllowlistEntryClient) Get(ctx context.Context, id uuid.UUID) (*TokenAllowlistEntry, error) {
+	return c.Query().Where(tokenallowlistentry.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenAllowlistEntryClient) GetX(ctx context.Context, id uuid.UUID) *TokenAllowlistEntry {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTokenCreate queries the token_create edge of a TokenAllowlistEntry.
+func (c *TokenAllowlistEntryClient) QueryTokenCreate(tae *TokenAllowlistEntry) *TokenCreateQuery {
+	query := (&TokenCreateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tae.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokenallowlistentry.Table, tokenallowlistentry.FieldID, id),
+			sqlgraph.To(tokencreate.Table, tokencreate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tokenallowlistentry.TokenCreateTable, tokenallowlistentry.TokenCreateColumn),
+		)
+		fromV = sqlgraph.Neighbors(tae.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TokenAllowlistEntryClient) Hooks() []Hook {
+	return c.hooks.TokenAllowlistEntry
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenAllowlistEntryClient) Interceptors() []Interceptor {
+	return c.inters.TokenAllowlistEntry
+}
+
+func (c *TokenAllowlistEntryClient) mutate(ctx context.Context, m *TokenAllowlistEntryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenAllowlistEntryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenAllowlistEntryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenAllowlistEntryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenAllowlistEntryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenAllowlistEntry mutation op: %q", m.Op())
+	}
+}
+
 // TokenCreateClient is a client for the TokenCreate schema.
 type TokenCreateClient struct {
 	config
@@ -2522,6 +2721,38 @@ func (c *TokenCreateClient) QueryTokenFreeze(tc *TokenCreate) *TokenFreezeQuery
 	return query
 }
 
+// QueryIssuerKeyRotations queries the issuer_key_rotations edge of a TokenCreate.
+func (c *TokenCreateClient) QueryIssuerKeyRotations(tc *TokenCreate) *TokenIssuerKeyRotationQuery {
+	query := (&TokenIssuerKeyRotationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokencreate.Table, tokencreate.FieldID, id),
+			sqlgraph.To(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tokencreate.IssuerKeyRotationsTable, tokencreate.IssuerKeyRotationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAllowlistEntries queries the allowlist_entries edge of a TokenCreate.
+func (c *TokenCreateClient) QueryAllowlistEntries(tc *TokenCreate) *TokenAllowlistEntryQuery {
+	query := (&TokenAllowlistEntryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokencreate.Table, tokencreate.FieldID, id),
+			sqlgraph.To(tokenallowlistentry.Table, tokenallowlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tokencreate.AllowlistEntriesTable, tokencreate.AllowlistEntriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(tc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TokenCreateClient) Hooks() []Hook {
 	return c.hooks.TokenCreate
@@ -2696,6 +2927,155 @@ func (c *TokenFreezeClient) mutate(ctx context.Context, m *TokenFreezeMutation)
 	}
 }
 
+// TokenIssuerKeyRotationClient is a client for the TokenIssuerKeyRotation schema.
+type TokenIssuerKeyRotationClient struct {
+	config
+}
+
+// NewTokenIssuerKeyRotationClient returns a client for the TokenIssuerKeyRotation from the given config.
+func NewTokenIssuerKeyRotationClient(c config) *TokenIssuerKeyRotationClient {
+	return &TokenIssuerKeyRotationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tokenissuerkeyrotation.Hooks(f(g(h())))`.
+func (c *TokenIssuerKeyRotationClient) Use(hooks ...Hook) {
+	c.hooks.TokenIssuerKeyRotation = append(c.hooks.TokenIssuerKeyRotation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tokenissuerkeyrotation.Intercept(f(g(h())))`.
+func (c *TokenIssuerKeyRotationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenIssuerKeyRotation = append(c.inters.TokenIssuerKeyRotation, interceptors...)
+}
+
+// Create returns a builder for creating a TokenIssuerKeyRotation entity.
+func (c *TokenIssuerKeyRotationClient) Create() *TokenIssuerKeyRotationCreate {
+	mutation := newTokenIssuerKeyRotationMutation(c.config, OpCreate)
+	return &TokenIssuerKeyRotationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TokenIssuerKeyRotation entities.
+func (c *TokenIssuerKeyRotationClient) CreateBulk(builders ...*TokenIssuerKeyRotationCreate) *TokenIssuerKeyRotationCreateBulk {
+	return &TokenIssuerKeyRotationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenIssuerKeyRotationClient) MapCreateBulk(slice any, setFunc func(*TokenIssuerKeyRotationCreate, int)) *TokenIssuerKeyRotationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenIssuerKeyRotationCreateBulk{err: fmt.Errorf("calling to TokenIssuerKeyRotationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenIssuerKeyRotationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenIssuerKeyRotationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenIssuerKeyRotation.
+func (c *TokenIssuerKeyRotationClient) Update() *TokenIssuerKeyRotationUpdate {
+	mutation := newTokenIssuerKeyRotationMutation(c.config, OpUpdate)
+	return &TokenIssuerKeyRotationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenIssuerKeyRotationClient) UpdateOne(tikr *TokenIssuerKeyRotation) *TokenIssuerKeyRotationUpdateOne {
+	mutation := newTokenIssuerKeyRotationMutation(c.config, OpUpdateOne, withTokenIssuerKeyRotation(tikr))
+	return &TokenIssuerKeyRotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenIssuerKeyRotationClient) UpdateOneID(id uuid.UUID) *TokenIssuerKeyRotationUpdateOne {
+	mutation := newTokenIssuerKeyRotationMutation(c.config, OpUpdateOne, withTokenIssuerKeyRotationID(id))
+	return &TokenIssuerKeyRotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenIssuerKeyRotation.
+func (c *TokenIssuerKeyRotationClient) Delete() *TokenIssuerKeyRotationDelete {
+	mutation := newTokenIssuerKeyRotationMutation(c.config, OpDelete)
+	return &TokenIssuerKeyRotationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenIssuerKeyRotationClient) DeleteOne(tikr *TokenIssuerKeyRotation) *TokenIssuerKeyRotationDeleteOne {
+	return c.DeleteOneID(tikr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenIssuerKeyRotationClient) DeleteOneID(id uuid.UUID) *TokenIssuerKeyRotationDeleteOne {
+	builder := c.Delete().Where(tokenissuerkeyrotation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenIssuerKeyRotationDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenIssuerKeyRotation.
+func (c *TokenIssuerKeyRotationClient) Query() *TokenIssuerKeyRotationQuery {
+	return &TokenIssuerKeyRotationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenIssuerKeyRotation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenIssuerKeyRotation entity by its id.
+func (c *TokenIssuerKeyRotationClient) Get(ctx context.Context, id uuid.UUID) (*TokenIssuerKeyRotation, error) {
+	return c.Query().Where(tokenissuerkeyrotation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenIssuerKeyRotationClient) GetX(ctx context.Context, id uuid.UUID) *TokenIssuerKeyRotation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTokenCreate queries the token_create edge of a TokenIssuerKeyRotation.
+func (c *TokenIssuerKeyRotationClient) QueryTokenCreate(tikr *TokenIssuerKeyRotation) *TokenCreateQuery {
+	query := (&TokenCreateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tikr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.FieldID, id),
+			sqlgraph.To(tokencreate.Table, tokencreate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tokenissuerkeyrotation.TokenCreateTable, tokenissuerkeyrotation.TokenCreateColumn),
+		)
+		fromV = sqlgraph.Neighbors(tikr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TokenIssuerKeyRotationClient) Hooks() []Hook {
+	return c.hooks.TokenIssuerKeyRotation
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenIssuerKeyRotationClient) Interceptors() []Interceptor {
+	return c.inters.TokenIssuerKeyRotation
+}
+
+func (c *TokenIssuerKeyRotationClient) mutate(ctx context.Context, m *TokenIssuerKeyRotationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenIssuerKeyRotationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenIssuerKeyRotationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenIssuerKeyRotationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenIssuerKeyRotationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenIssuerKeyRotation mutation op: %q", m.Op())
+	}
+}
+
 // TokenMintClient is a client for the TokenMint schema.
 type TokenMintClient struct {
 	config
@@ -3773,6 +4153,22 @@ func (c *TransferClient) QueryPaymentIntent(t *Transfer) *PaymentIntentQuery {
 	return query
 }
 
+// QuerySparkInvoice queries the spark_invoice edge of a Transfer.
+func (c *TransferClient) QuerySparkInvoice(t *Transfer) *SparkInvoiceQuery {
+	query := (&SparkInvoiceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(transfer.Table, transfer.FieldID, id),
+			sqlgraph.To(sparkinvoice.Table, sparkinvoice.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, transfer.SparkInvoiceTable, transfer.SparkInvoiceColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *TransferClient) Hooks() []Hook {
 	return c.hooks.Transfer
@@ -4325,6 +4721,272 @@ func (c *TreeNodeClient) mutate(ctx context.Context, m *TreeNodeMutation) (Value
 	}
 }
 
+// UserEventClient is a client for the UserEvent schema.
+type UserEventClient struct {
+	config
+}
+
+// NewUserEventClient returns a client for the UserEvent from the given config.
+func NewUserEventClient(c config) *UserEventClient {
+	return &UserEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userevent.Hooks(f(g(h())))`.
+func (c *UserEventClient) Use(hooks ...Hook) {
+	c.hooks.UserEvent = append(c.hooks.UserEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userevent.Intercept(f(g(h())))`.
+func (c *UserEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserEvent = append(c.inters.UserEvent, interceptors...)
+}
+
+// Create returns a builder for creating a UserEvent entity.
+func (c *UserEventClient) Create() *UserEventCreate {
+	mutation := newUserEventMutation(c.config, OpCreate)
+	return &UserEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserEvent entities.
+func (c *UserEventClient) CreateBulk(builders ...*UserEventCreate) *UserEventCreateBulk {
+	return &UserEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserEventClient) MapCreateBulk(slice any, setFunc func(*UserEventCreate, int)) *UserEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserEventCreateBulk{err: fmt.Errorf("calling to UserEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserEvent.
+func (c *UserEventClient) Update() *UserEventUpdate {
+	mutation := newUserEventMutation(c.config, OpUpdate)
+	return &UserEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserEventClient) UpdateOne(ue *UserEvent) *UserEventUpdateOne {
+	mutation := newUserEventMutation(c.config, OpUpdateOne, withUserEvent(ue))
+	return &UserEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserEventClient) UpdateOneID(id uuid.UUID) *UserEventUpdateOne {
+	mutation := newUserEventMutation(c.config, OpUpdateOne, withUserEventID(id))
+	return &UserEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserEvent.
+func (c *UserEventClient) Delete() *UserEventDelete {
+	mutation := newUserEventMutation(c.config, OpDelete)
+	return &UserEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserEventClient) DeleteOne(ue *UserEvent) *UserEventDeleteOne {
+	return c.DeleteOneID(ue.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserEventClient) DeleteOneID(id uuid.UUID) *UserEventDeleteOne {
+	builder := c.Delete().Where(userevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserEventDeleteOne{builder}
+}
+
+// Query returns a query builder for UserEvent.
+func (c *UserEventClient) Query() *UserEventQuery {
+	return &UserEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserEvent entity by its id.
+func (c *UserEventClient) Get(ctx context.Context, id uuid.UUID) (*UserEvent, error) {
+	return c.Query().Where(userevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserEventClient) GetX(ctx context.Context, id uuid.UUID) *UserEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserEventClient) Hooks() []Hook {
+	return c.hooks.UserEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserEventClient) Interceptors() []Interceptor {
+	return c.inters.UserEvent
+}
+
+func (c *UserEventClient) mutate(ctx context.Context, m *UserEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserEvent mutation op: %q", m.Op())
+	}
+}
+
+// UserEventSequenceClient is a client for the UserEventSequence schema.
+type UserEventSequenceClient struct {
+	config
+}
+
+// NewUserEventSequenceClient returns a client for the UserEventSequence from the given config.
+func NewUserEventSequenceClient(c config) *UserEventSequenceClient {
+	return &UserEventSequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usereventsequence.Hooks(f(g(h())))`.
+func (c *UserEventSequenceClient) Use(hooks ...Hook) {
+	c.hooks.UserEventSequence = append(c.hooks.UserEventSequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usereventsequence.Intercept(f(g(h())))`.
+func (c *UserEventSequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserEventSequence = append(c.inters.UserEventSequence, interceptors...)
+}
+
+// Create returns a builder for creating a UserEventSequence entity.
+func (c *UserEventSequenceClient) Create() *UserEventSequenceCreate {
+	mutation := newUserEventSequenceMutation(c.config, OpCreate)
+	return &UserEventSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserEventSequence entities.
+func (c *UserEventSequenceClient) CreateBulk(builders ...*UserEventSequenceCreate) *UserEventSequenceCreateBulk {
+	return &UserEventSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserEventSequenceClient) MapCreateBulk(slice any, setFunc func(*UserEventSequenceCreate, int)) *UserEventSequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserEventSequenceCreateBulk{err: fmt.Errorf("calling to UserEventSequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserEventSequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserEventSequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserEventSequence.
+func (c *UserEventSequenceClient) Update() *UserEventSequenceUpdate {
+	mutation := newUserEventSequenceMutation(c.config, OpUpdate)
+	return &UserEventSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserEventSequenceClient) UpdateOne(ues *UserEventSequence) *UserEventSequenceUpdateOne {
+	mutation := newUserEventSequenceMutation(c.config, OpUpdateOne, withUserEventSequence(ues))
+	return &UserEventSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserEventSequenceClient) UpdateOneID(id uuid.UUID) *UserEventSequenceUpdateOne {
+	mutation := newUserEventSequenceMutation(c.config, OpUpdateOne, withUserEventSequenceID(id))
+	return &UserEventSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserEventSequence.
+func (c *UserEventSequenceClient) Delete() *UserEventSequenceDelete {
+	mutation := newUserEventSequenceMutation(c.config, OpDelete)
+	return &UserEventSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserEventSequenceClient) DeleteOne(ues *UserEventSequence) *UserEventSequenceDeleteOne {
+	return c.DeleteOneID(ues.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserEventSequenceClient) DeleteOneID(id uuid.UUID) *UserEventSequenceDeleteOne {
+	builder := c.Delete().Where(usereventsequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserEventSequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for UserEventSequence.
+func (c *UserEventSequenceClient) Query() *UserEventSequenceQuery {
+	return &UserEventSequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserEventSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserEventSequence entity by its id.
+func (c *UserEventSequenceClient) Get(ctx context.Context, id uuid.UUID) (*UserEventSequence, error) {
+	return c.Query().Where(usereventsequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserEventSequenceClient) GetX(ctx context.Context, id uuid.UUID) *UserEventSequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserEventSequenceClient) Hooks() []Hook {
+	return c.hooks.UserEventSequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserEventSequenceClient) Interceptors() []Interceptor {
+	return c.inters.UserEventSequence
+}
+
+func (c *UserEventSequenceClient) mutate(ctx context.Context, m *UserEventSequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserEventSequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserEventSequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserEventSequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserEventSequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserEventSequence mutation op: %q", m.Op())
+	}
+}
+
 // UserSignedTransactionClient is a client for the UserSignedTransaction schema.
 type UserSignedTransactionClient struct {
 	config
@@ -4809,18 +5471,21 @@ type (
 	hooks struct {
 		BlockHeight, CooperativeExit, DepositAddress, EntityDkgKey, Gossip,
 		L1TokenCreate, PaymentIntent, PreimageRequest, PreimageShare,
-		SigningCommitment, SigningKeyshare, SigningNonce, SparkInvoice, TokenCreate,
-		TokenFreeze, TokenMint, TokenOutput, TokenPartialRevocationSecretShare,
-		TokenTransaction, TokenTransactionPeerSignature, Transfer, TransferLeaf, Tree,
-		TreeNode, UserSignedTransaction, Utxo, UtxoSwap []ent.Hook
+		SigningCommitment, SigningKeyshare, SigningNonce, SparkInvoice,
+		TokenAllowlistEntry, TokenCreate, TokenFreeze, TokenIssuerKeyRotation,
+		TokenMint, TokenOutput, TokenPartialRevocationSecretShare, TokenTransaction,
+		TokenTransactionPeerSignature, Transfer, TransferLeaf, Tree, TreeNode,
+		UserEvent, UserEventSequence, UserSignedTransaction, Utxo, UtxoSwap []ent.Hook
 	}
 	inters struct {
 		BlockHeight, CooperativeExit, DepositAddress, EntityDkgKey, Gossip,
 		L1TokenCreate, PaymentIntent, PreimageRequest, PreimageShare,
-		SigningCommitment, SigningKeyshare, SigningNonce, SparkInvoice, TokenCreate,
-		TokenFreeze, TokenMint, TokenOutput, TokenPartialRevocationSecretShare,
-		TokenTransaction, TokenTransactionPeerSignature, Transfer, TransferLeaf, Tree,
-		TreeNode, UserSignedTransaction, Utxo, UtxoSwap []ent.Interceptor
+		SigningCommitment, SigningKeyshare, SigningNonce, SparkInvoice,
+		TokenAllowlistEntry, TokenCreate, TokenFreeze, TokenIssuerKeyRotation,
+		TokenMint, TokenOutput, TokenPartialRevocationSecretShare, TokenTransaction,
+		TokenTransactionPeerSignature, Transfer, TransferLeaf, Tree, TreeNode,
+		UserEvent, UserEventSequence, UserSignedTransaction, Utxo,
+		UtxoSwap []ent.Interceptor
 	}
 )
 
@@ -25,8 +25,10 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokenpartialrevocationsecretshare"
@@ -36,6 +38,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
 	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
@@ -112,8 +116,10 @@ func checkColumn(table, column string) error {
 			signingkeyshare.Table:                   signingkeyshare.ValidColumn,
 			signingnonce.Table:                      signingnonce.ValidColumn,
 			sparkinvoice.Table:                      sparkinvoice.ValidColumn,
+			tokenallowlistentry.Table:               tokenallowlistentry.ValidColumn,
 			tokencreate.Table:                       tokencreate.ValidColumn,
 			tokenfreeze.Table:                       tokenfreeze.ValidColumn,
+			tokenissuerkeyrotation.Table:            tokenissuerkeyrotation.ValidColumn,
 			tokenmint.Table:                         tokenmint.ValidColumn,
 			tokenoutput.Table:                       tokenoutput.ValidColumn,
 			tokenpartialrevocationsecretshare.Table: tokenpartialrevocationsecretshare.ValidColumn,
@@ -123,6 +129,8 @@ func checkColumn(table, column string) error {
 			transferleaf.Table:                      transferleaf.ValidColumn,
 			tree.Table:                              tree.ValidColumn,
 			treenode.Table:                          treenode.ValidColumn,
+			userevent.Table:                         userevent.ValidColumn,
+			usereventsequence.Table:                 usereventsequence.ValidColumn,
 			usersignedtransaction.Table:             usersignedtransaction.ValidColumn,
 			utxo.Table:                              utxo.ValidColumn,
 			utxoswap.Table:                          utxoswap.ValidColumn,
@@ -31,7 +31,15 @@ type Gossip struct {
 	// Receipts holds the value of the "receipts" field.
 	Receipts *[]byte `json:"receipts,omitempty"`
 	// Status holds the value of the "status" field.
-	Status       schematype.GossipStatus `json:"status,omitempty"`
+	Status schematype.GossipStatus `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts []int `json:"attempts,omitempty"`
+	// NextAttemptTimes holds the value of the "next_attempt_times" field.
+	NextAttemptTimes []time.Time `json:"next_attempt_times,omitempty"`
+	// NextAttemptTime holds the value of the "next_attempt_time" field.
+	NextAttemptTime *time.Time `json:"next_attempt_time,omitempty"`
+	// ExpiryTime holds the value of the "expiry_time" field.
+	ExpiryTime   *time.Time `json:"expiry_time,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -40,11 +48,11 @@ func (*Gossip) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case gossip.FieldParticipants, gossip.FieldMessage, gossip.FieldReceipts:
+		case gossip.FieldParticipants, gossip.FieldMessage, gossip.FieldReceipts, gossip.FieldAttempts, gossip.FieldNextAttemptTimes:
 			values[i] = new([]byte)
 		case gossip.FieldStatus:
 			values[i] = new(sql.NullString)
-		case gossip.FieldCreateTime, gossip.FieldUpdateTime:
+		case gossip.FieldCreateTime, gossip.FieldUpdateTime, gossip.FieldNextAttemptTime, gossip.FieldExpiryTime:
 			values[i] = new(sql.NullTime)
 		case gossip.FieldID:
 			values[i] = new(uuid.UUID)
@@ -107,6 +115,36 @@ func (_go *Gossip) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_go.Status = schematype.GossipStatus(value.String)
 			}
+		case gossip.FieldAttempts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_go.Attempts); err != nil {
+					return fmt.Errorf("unmarshal field attempts: %w", err)
+				}
+			}
+		case gossip.FieldNextAttemptTimes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_times", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_go.NextAttemptTimes); err != nil {
+					return fmt.Errorf("unmarshal field next_attempt_times: %w", err)
+				}
+			}
+		case gossip.FieldNextAttemptTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_time", values[i])
+			} else if value.Valid {
+				_go.NextAttemptTime = new(time.Time)
+				*_go.NextAttemptTime = value.Time
+			}
+		case gossip.FieldExpiryTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expiry_time", values[i])
+			} else if value.Valid {
+				_go.ExpiryTime = new(time.Time)
+				*_go.ExpiryTime = value.Time
+			}
 		default:
 			_go.selectValues.Set(columns[i], values[i])
 		}
@@ -162,6 +200,22 @@ func (_go *Gossip) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _go.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _go.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_times=")
+	builder.WriteString(fmt.Sprintf("%v", _go.NextAttemptTimes))
+	builder.WriteString(", ")
+	if v := _go.NextAttemptTime; v != nil {
+		builder.WriteString("next_attempt_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _go.ExpiryTime; v != nil {
+		builder.WriteString("expiry_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
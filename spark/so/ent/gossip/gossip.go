@@ -28,6 +28,14 @@ const (
 	FieldReceipts = "receipts"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldNextAttemptTimes holds the string denoting the next_attempt_times field in the database.
+	FieldNextAttemptTimes = "next_attempt_times"
+	// FieldNextAttemptTime holds the string denoting the next_attempt_time field in the database.
+	FieldNextAttemptTime = "next_attempt_time"
+	// FieldExpiryTime holds the string denoting the expiry_time field in the database.
+	FieldExpiryTime = "expiry_time"
 	// Table holds the table name of the gossip in the database.
 	Table = "gossips"
 )
@@ -41,6 +49,10 @@ var Columns = []string{
 	FieldMessage,
 	FieldReceipts,
 	FieldStatus,
+	FieldAttempts,
+	FieldNextAttemptTimes,
+	FieldNextAttemptTime,
+	FieldExpiryTime,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,7 +83,7 @@ const DefaultStatus schematype.GossipStatus = "PENDING"
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s schematype.GossipStatus) error {
 	switch s {
-	case "PENDING", "DELIVERED":
+	case "PENDING", "DELIVERED", "FAILED":
 		return nil
 	default:
 		return fmt.Errorf("gossip: invalid enum value for status field: %q", s)
@@ -100,3 +112,13 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
+
+// ByNextAttemptTime orders the results by the next_attempt_time field.
+func ByNextAttemptTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptTime, opts...).ToFunc()
+}
+
+// ByExpiryTime orders the results by the expiry_time field.
+func ByExpiryTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiryTime, opts...).ToFunc()
+}
@@ -76,6 +76,16 @@ func Receipts(v []byte) predicate.Gossip {
 	return predicate.Gossip(sql.FieldEQ(FieldReceipts, v))
 }
 
+// NextAttemptTime applies equality check predicate on the "next_attempt_time" field. It's identical to NextAttemptTimeEQ.
+func NextAttemptTime(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldEQ(FieldNextAttemptTime, v))
+}
+
+// ExpiryTime applies equality check predicate on the "expiry_time" field. It's identical to ExpiryTimeEQ.
+func ExpiryTime(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldEQ(FieldExpiryTime, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Gossip {
 	return predicate.Gossip(sql.FieldEQ(FieldCreateTime, v))
@@ -266,6 +276,126 @@ func StatusNotIn(vs ...schematype.GossipStatus) predicate.Gossip {
 	return predicate.Gossip(sql.FieldNotIn(FieldStatus, v...))
 }
 
+// AttemptsIsNil applies the IsNil predicate on the "attempts" field.
+func AttemptsIsNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldIsNull(FieldAttempts))
+}
+
+// AttemptsNotNil applies the NotNil predicate on the "attempts" field.
+func AttemptsNotNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldNotNull(FieldAttempts))
+}
+
+// NextAttemptTimesIsNil applies the IsNil predicate on the "next_attempt_times" field.
+func NextAttemptTimesIsNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldIsNull(FieldNextAttemptTimes))
+}
+
+// NextAttemptTimesNotNil applies the NotNil predicate on the "next_attempt_times" field.
+func NextAttemptTimesNotNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldNotNull(FieldNextAttemptTimes))
+}
+
+// NextAttemptTimeEQ applies the EQ predicate on the "next_attempt_time" field.
+func NextAttemptTimeEQ(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldEQ(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeNEQ applies the NEQ predicate on the "next_attempt_time" field.
+func NextAttemptTimeNEQ(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldNEQ(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeIn applies the In predicate on the "next_attempt_time" field.
+func NextAttemptTimeIn(vs ...time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldIn(FieldNextAttemptTime, vs...))
+}
+
+// NextAttemptTimeNotIn applies the NotIn predicate on the "next_attempt_time" field.
+func NextAttemptTimeNotIn(vs ...time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldNotIn(FieldNextAttemptTime, vs...))
+}
+
+// NextAttemptTimeGT applies the GT predicate on the "next_attempt_time" field.
+func NextAttemptTimeGT(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldGT(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeGTE applies the GTE predicate on the "next_attempt_time" field.
+func NextAttemptTimeGTE(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldGTE(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeLT applies the LT predicate on the "next_attempt_time" field.
+func NextAttemptTimeLT(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldLT(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeLTE applies the LTE predicate on the "next_attempt_time" field.
+func NextAttemptTimeLTE(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldLTE(FieldNextAttemptTime, v))
+}
+
+// NextAttemptTimeIsNil applies the IsNil predicate on the "next_attempt_time" field.
+func NextAttemptTimeIsNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldIsNull(FieldNextAttemptTime))
+}
+
+// NextAttemptTimeNotNil applies the NotNil predicate on the "next_attempt_time" field.
+func NextAttemptTimeNotNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldNotNull(FieldNextAttemptTime))
+}
+
+// ExpiryTimeEQ applies the EQ predicate on the "expiry_time" field.
+func ExpiryTimeEQ(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldEQ(FieldExpiryTime, v))
+}
+
+// ExpiryTimeNEQ applies the NEQ predicate on the "expiry_time" field.
+func ExpiryTimeNEQ(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldNEQ(FieldExpiryTime, v))
+}
+
+// ExpiryTimeIn applies the In predicate on the "expiry_time" field.
+func ExpiryTimeIn(vs ...time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldIn(FieldExpiryTime, vs...))
+}
+
+// ExpiryTimeNotIn applies the NotIn predicate on the "expiry_time" field.
+func ExpiryTimeNotIn(vs ...time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldNotIn(FieldExpiryTime, vs...))
+}
+
+// ExpiryTimeGT applies the GT predicate on the "expiry_time" field.
+func ExpiryTimeGT(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldGT(FieldExpiryTime, v))
+}
+
+// ExpiryTimeGTE applies the GTE predicate on the "expiry_time" field.
+func ExpiryTimeGTE(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldGTE(FieldExpiryTime, v))
+}
+
+// ExpiryTimeLT applies the LT predicate on the "expiry_time" field.
+func ExpiryTimeLT(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldLT(FieldExpiryTime, v))
+}
+
+// ExpiryTimeLTE applies the LTE predicate on the "expiry_time" field.
+func ExpiryTimeLTE(v time.Time) predicate.Gossip {
+	return predicate.Gossip(sql.FieldLTE(FieldExpiryTime, v))
+}
+
+// ExpiryTimeIsNil applies the IsNil predicate on the "expiry_time" field.
+func ExpiryTimeIsNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldIsNull(FieldExpiryTime))
+}
+
+// ExpiryTimeNotNil applies the NotNil predicate on the "expiry_time" field.
+func ExpiryTimeNotNil() predicate.Gossip {
+	return predicate.Gossip(sql.FieldNotNull(FieldExpiryTime))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Gossip) predicate.Gossip {
 	return predicate.Gossip(sql.AndPredicates(predicates...))
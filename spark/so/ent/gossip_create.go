@@ -85,6 +85,46 @@ func (gc *GossipCreate) SetNillableStatus(ss *schematype.GossipStatus) *GossipCr
 	return gc
 }
 
+// SetAttempts sets the "attempts" field.
+func (gc *GossipCreate) SetAttempts(i []int) *GossipCreate {
+	gc.mutation.SetAttempts(i)
+	return gc
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (gc *GossipCreate) SetNextAttemptTimes(t []time.Time) *GossipCreate {
+	gc.mutation.SetNextAttemptTimes(t)
+	return gc
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (gc *GossipCreate) SetNextAttemptTime(t time.Time) *GossipCreate {
+	gc.mutation.SetNextAttemptTime(t)
+	return gc
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (gc *GossipCreate) SetNillableNextAttemptTime(t *time.Time) *GossipCreate {
+	if t != nil {
+		gc.SetNextAttemptTime(*t)
+	}
+	return gc
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (gc *GossipCreate) SetExpiryTime(t time.Time) *GossipCreate {
+	gc.mutation.SetExpiryTime(t)
+	return gc
+}
+
+// SetNillableExpiryTime sets the "expiry_time" field if the given value is not nil.
+func (gc *GossipCreate) SetNillableExpiryTime(t *time.Time) *GossipCreate {
+	if t != nil {
+		gc.SetExpiryTime(*t)
+	}
+	return gc
+}
+
 // SetID sets the "id" field.
 func (gc *GossipCreate) SetID(u uuid.UUID) *GossipCreate {
 	gc.mutation.SetID(u)
@@ -242,6 +282,22 @@ func (gc *GossipCreate) createSpec() (*Gossip, *sqlgraph.CreateSpec) {
 		_spec.SetField(gossip.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := gc.mutation.Attempts(); ok {
+		_spec.SetField(gossip.FieldAttempts, field.TypeJSON, value)
+		_node.Attempts = value
+	}
+	if value, ok := gc.mutation.NextAttemptTimes(); ok {
+		_spec.SetField(gossip.FieldNextAttemptTimes, field.TypeJSON, value)
+		_node.NextAttemptTimes = value
+	}
+	if value, ok := gc.mutation.NextAttemptTime(); ok {
+		_spec.SetField(gossip.FieldNextAttemptTime, field.TypeTime, value)
+		_node.NextAttemptTime = &value
+	}
+	if value, ok := gc.mutation.ExpiryTime(); ok {
+		_spec.SetField(gossip.FieldExpiryTime, field.TypeTime, value)
+		_node.ExpiryTime = &value
+	}
 	return _node, _spec
 }
 
@@ -330,6 +386,78 @@ func (u *GossipUpsert) UpdateStatus() *GossipUpsert {
 	return u
 }
 
+// SetAttempts sets the "attempts" field.
+func (u *GossipUpsert) SetAttempts(v []int) *GossipUpsert {
+	u.Set(gossip.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *GossipUpsert) UpdateAttempts() *GossipUpsert {
+	u.SetExcluded(gossip.FieldAttempts)
+	return u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *GossipUpsert) ClearAttempts() *GossipUpsert {
+	u.SetNull(gossip.FieldAttempts)
+	return u
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (u *GossipUpsert) SetNextAttemptTimes(v []time.Time) *GossipUpsert {
+	u.Set(gossip.FieldNextAttemptTimes, v)
+	return u
+}
+
+// UpdateNextAttemptTimes sets the "next_attempt_times" field to the value that was provided on create.
+func (u *GossipUpsert) UpdateNextAttemptTimes() *GossipUpsert {
+	u.SetExcluded(gossip.FieldNextAttemptTimes)
+	return u
+}
+
+// ClearNextAttemptTimes clears the value of the "next_attempt_times" field.
+func (u *GossipUpsert) ClearNextAttemptTimes() *GossipUpsert {
+	u.SetNull(gossip.FieldNextAttemptTimes)
+	return u
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (u *GossipUpsert) SetNextAttemptTime(v time.Time) *GossipUpsert {
+	u.Set(gossip.FieldNextAttemptTime, v)
+	return u
+}
+
+// UpdateNextAttemptTime sets the "next_attempt_time" field to the value that was provided on create.
+func (u *GossipUpsert) UpdateNextAttemptTime() *GossipUpsert {
+	u.SetExcluded(gossip.FieldNextAttemptTime)
+	return u
+}
+
+// ClearNextAttemptTime clears the value of the "next_attempt_time" field.
+func (u *GossipUpsert) ClearNextAttemptTime() *GossipUpsert {
+	u.SetNull(gossip.FieldNextAttemptTime)
+	return u
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (u *GossipUpsert) SetExpiryTime(v time.Time) *GossipUpsert {
+	u.Set(gossip.FieldExpiryTime, v)
+	return u
+}
+
+// UpdateExpiryTime sets the "expiry_time" field to the value that was provided on create.
+func (u *GossipUpsert) UpdateExpiryTime() *GossipUpsert {
+	u.SetExcluded(gossip.FieldExpiryTime)
+	return u
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (u *GossipUpsert) ClearExpiryTime() *GossipUpsert {
+	u.SetNull(gossip.FieldExpiryTime)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -429,6 +557,90 @@ func (u *GossipUpsertOne) UpdateStatus() *GossipUpsertOne {
 	})
 }
 
+// SetAttempts sets the "attempts" field.
+func (u *GossipUpsertOne) SetAttempts(v []int) *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *GossipUpsertOne) UpdateAttempts() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *GossipUpsertOne) ClearAttempts() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearAttempts()
+	})
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (u *GossipUpsertOne) SetNextAttemptTimes(v []time.Time) *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetNextAttemptTimes(v)
+	})
+}
+
+// UpdateNextAttemptTimes sets the "next_attempt_times" field to the value that was provided on create.
+func (u *GossipUpsertOne) UpdateNextAttemptTimes() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateNextAttemptTimes()
+	})
+}
+
+// ClearNextAttemptTimes clears the value of the "next_attempt_times" field.
+func (u *GossipUpsertOne) ClearNextAttemptTimes() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearNextAttemptTimes()
+	})
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (u *GossipUpsertOne) SetNextAttemptTime(v time.Time) *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetNextAttemptTime(v)
+	})
+}
+
+// UpdateNextAttemptTime sets the "next_attempt_time" field to the value that was provided on create.
+func (u *GossipUpsertOne) UpdateNextAttemptTime() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateNextAttemptTime()
+	})
+}
+
+// ClearNextAttemptTime clears the value of the "next_attempt_time" field.
+func (u *GossipUpsertOne) ClearNextAttemptTime() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearNextAttemptTime()
+	})
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (u *GossipUpsertOne) SetExpiryTime(v time.Time) *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetExpiryTime(v)
+	})
+}
+
+// UpdateExpiryTime sets the "expiry_time" field to the value that was provided on create.
+func (u *GossipUpsertOne) UpdateExpiryTime() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateExpiryTime()
+	})
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (u *GossipUpsertOne) ClearExpiryTime() *GossipUpsertOne {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearExpiryTime()
+	})
+}
+
 // Exec executes the query.
 func (u *GossipUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -695,6 +907,90 @@ func (u *GossipUpsertBulk) UpdateStatus() *GossipUpsertBulk {
 	})
 }
 
+// SetAttempts sets the "attempts" field.
+func (u *GossipUpsertBulk) SetAttempts(v []int) *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *GossipUpsertBulk) UpdateAttempts() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *GossipUpsertBulk) ClearAttempts() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearAttempts()
+	})
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (u *GossipUpsertBulk) SetNextAttemptTimes(v []time.Time) *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetNextAttemptTimes(v)
+	})
+}
+
+// UpdateNextAttemptTimes sets the "next_attempt_times" field to the value that was provided on create.
+func (u *GossipUpsertBulk) UpdateNextAttemptTimes() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateNextAttemptTimes()
+	})
+}
+
+// ClearNextAttemptTimes clears the value of the "next_attempt_times" field.
+func (u *GossipUpsertBulk) ClearNextAttemptTimes() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearNextAttemptTimes()
+	})
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (u *GossipUpsertBulk) SetNextAttemptTime(v time.Time) *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetNextAttemptTime(v)
+	})
+}
+
+// UpdateNextAttemptTime sets the "next_attempt_time" field to the value that was provided on create.
+func (u *GossipUpsertBulk) UpdateNextAttemptTime() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateNextAttemptTime()
+	})
+}
+
+// ClearNextAttemptTime clears the value of the "next_attempt_time" field.
+func (u *GossipUpsertBulk) ClearNextAttemptTime() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearNextAttemptTime()
+	})
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (u *GossipUpsertBulk) SetExpiryTime(v time.Time) *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.SetExpiryTime(v)
+	})
+}
+
+// UpdateExpiryTime sets the "expiry_time" field to the value that was provided on create.
+func (u *GossipUpsertBulk) UpdateExpiryTime() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.UpdateExpiryTime()
+	})
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (u *GossipUpsertBulk) ClearExpiryTime() *GossipUpsertBulk {
+	return u.Update(func(s *GossipUpsert) {
+		s.ClearExpiryTime()
+	})
+}
+
 // Exec executes the query.
 func (u *GossipUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
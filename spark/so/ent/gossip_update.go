@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/lightsparkdev/spark/so/ent/gossip"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
@@ -55,6 +56,82 @@ func (gu *GossipUpdate) SetNillableStatus(ss *schematype.GossipStatus) *GossipUp
 	return gu
 }
 
+// SetAttempts sets the "attempts" field.
+func (gu *GossipUpdate) SetAttempts(i []int) *GossipUpdate {
+	gu.mutation.SetAttempts(i)
+	return gu
+}
+
+// AppendAttempts appends i to the "attempts" field.
+func (gu *GossipUpdate) AppendAttempts(i []int) *GossipUpdate {
+	gu.mutation.AppendAttempts(i)
+	return gu
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (gu *GossipUpdate) ClearAttempts() *GossipUpdate {
+	gu.mutation.ClearAttempts()
+	return gu
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (gu *GossipUpdate) SetNextAttemptTimes(t []time.Time) *GossipUpdate {
+	gu.mutation.SetNextAttemptTimes(t)
+	return gu
+}
+
+// AppendNextAttemptTimes appends t to the "next_attempt_times" field.
+func (gu *GossipUpdate) AppendNextAttemptTimes(t []time.Time) *GossipUpdate {
+	gu.mutation.AppendNextAttemptTimes(t)
+	return gu
+}
+
+// ClearNextAttemptTimes clears the value of the "next_attempt_times" field.
+func (gu *GossipUpdate) ClearNextAttemptTimes() *GossipUpdate {
+	gu.mutation.ClearNextAttemptTimes()
+	return gu
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (gu *GossipUpdate) SetNextAttemptTime(t time.Time) *GossipUpdate {
+	gu.mutation.SetNextAttemptTime(t)
+	return gu
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (gu *GossipUpdate) SetNillableNextAttemptTime(t *time.Time) *GossipUpdate {
+	if t != nil {
+		gu.SetNextAttemptTime(*t)
+	}
+	return gu
+}
+
+// ClearNextAttemptTime clears the value of the "next_attempt_time" field.
+func (gu *GossipUpdate) ClearNextAttemptTime() *GossipUpdate {
+	gu.mutation.ClearNextAttemptTime()
+	return gu
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (gu *GossipUpdate) SetExpiryTime(t time.Time) *GossipUpdate {
+	gu.mutation.SetExpiryTime(t)
+	return gu
+}
+
+// SetNillableExpiryTime sets the "expiry_time" field if the given value is not nil.
+func (gu *GossipUpdate) SetNillableExpiryTime(t *time.Time) *GossipUpdate {
+	if t != nil {
+		gu.SetExpiryTime(*t)
+	}
+	return gu
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (gu *GossipUpdate) ClearExpiryTime() *GossipUpdate {
+	gu.mutation.ClearExpiryTime()
+	return gu
+}
+
 // Mutation returns the GossipMutation object of the builder.
 func (gu *GossipUpdate) Mutation() *GossipMutation {
 	return gu.mutation
@@ -127,6 +204,40 @@ func (gu *GossipUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := gu.mutation.Status(); ok {
 		_spec.SetField(gossip.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := gu.mutation.Attempts(); ok {
+		_spec.SetField(gossip.FieldAttempts, field.TypeJSON, value)
+	}
+	if value, ok := gu.mutation.AppendedAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gossip.FieldAttempts, value)
+		})
+	}
+	if gu.mutation.AttemptsCleared() {
+		_spec.ClearField(gossip.FieldAttempts, field.TypeJSON)
+	}
+	if value, ok := gu.mutation.NextAttemptTimes(); ok {
+		_spec.SetField(gossip.FieldNextAttemptTimes, field.TypeJSON, value)
+	}
+	if value, ok := gu.mutation.AppendedNextAttemptTimes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gossip.FieldNextAttemptTimes, value)
+		})
+	}
+	if gu.mutation.NextAttemptTimesCleared() {
+		_spec.ClearField(gossip.FieldNextAttemptTimes, field.TypeJSON)
+	}
+	if value, ok := gu.mutation.NextAttemptTime(); ok {
+		_spec.SetField(gossip.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if gu.mutation.NextAttemptTimeCleared() {
+		_spec.ClearField(gossip.FieldNextAttemptTime, field.TypeTime)
+	}
+	if value, ok := gu.mutation.ExpiryTime(); ok {
+		_spec.SetField(gossip.FieldExpiryTime, field.TypeTime, value)
+	}
+	if gu.mutation.ExpiryTimeCleared() {
+		_spec.ClearField(gossip.FieldExpiryTime, field.TypeTime)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{gossip.Label}
@@ -173,6 +284,82 @@ func (guo *GossipUpdateOne) SetNillableStatus(ss *schematype.GossipStatus) *Goss
 	return guo
 }
 
+// SetAttempts sets the "attempts" field.
+func (guo *GossipUpdateOne) SetAttempts(i []int) *GossipUpdateOne {
+	guo.mutation.SetAttempts(i)
+	return guo
+}
+
+// AppendAttempts appends i to the "attempts" field.
+func (guo *GossipUpdateOne) AppendAttempts(i []int) *GossipUpdateOne {
+	guo.mutation.AppendAttempts(i)
+	return guo
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (guo *GossipUpdateOne) ClearAttempts() *GossipUpdateOne {
+	guo.mutation.ClearAttempts()
+	return guo
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (guo *GossipUpdateOne) SetNextAttemptTimes(t []time.Time) *GossipUpdateOne {
+	guo.mutation.SetNextAttemptTimes(t)
+	return guo
+}
+
+// AppendNextAttemptTimes appends t to the "next_attempt_times" field.
+func (guo *GossipUpdateOne) AppendNextAttemptTimes(t []time.Time) *GossipUpdateOne {
+	guo.mutation.AppendNextAttemptTimes(t)
+	return guo
+}
+
+// ClearNextAttemptTimes clears the value of the "next_attempt_times" field.
+func (guo *GossipUpdateOne) ClearNextAttemptTimes() *GossipUpdateOne {
+	guo.mutation.ClearNextAttemptTimes()
+	return guo
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (guo *GossipUpdateOne) SetNextAttemptTime(t time.Time) *GossipUpdateOne {
+	guo.mutation.SetNextAttemptTime(t)
+	return guo
+}
+
+// SetNillableNextAttemptTime sets the "next_attempt_time" field if the given value is not nil.
+func (guo *GossipUpdateOne) SetNillableNextAttemptTime(t *time.Time) *GossipUpdateOne {
+	if t != nil {
+		guo.SetNextAttemptTime(*t)
+	}
+	return guo
+}
+
+// ClearNextAttemptTime clears the value of the "next_attempt_time" field.
+func (guo *GossipUpdateOne) ClearNextAttemptTime() *GossipUpdateOne {
+	guo.mutation.ClearNextAttemptTime()
+	return guo
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (guo *GossipUpdateOne) SetExpiryTime(t time.Time) *GossipUpdateOne {
+	guo.mutation.SetExpiryTime(t)
+	return guo
+}
+
+// SetNillableExpiryTime sets the "expiry_time" field if the given value is not nil.
+func (guo *GossipUpdateOne) SetNillableExpiryTime(t *time.Time) *GossipUpdateOne {
+	if t != nil {
+		guo.SetExpiryTime(*t)
+	}
+	return guo
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (guo *GossipUpdateOne) ClearExpiryTime() *GossipUpdateOne {
+	guo.mutation.ClearExpiryTime()
+	return guo
+}
+
 // Mutation returns the GossipMutation object of the builder.
 func (guo *GossipUpdateOne) Mutation() *GossipMutation {
 	return guo.mutation
@@ -275,6 +462,40 @@ func (guo *GossipUpdateOne) sqlSave(ctx context.Context) (_node *Gossip, err err
 	if value, ok := guo.mutation.Status(); ok {
 		_spec.SetField(gossip.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := guo.mutation.Attempts(); ok {
+		_spec.SetField(gossip.FieldAttempts, field.TypeJSON, value)
+	}
+	if value, ok := guo.mutation.AppendedAttempts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gossip.FieldAttempts, value)
+		})
+	}
+	if guo.mutation.AttemptsCleared() {
+		_spec.ClearField(gossip.FieldAttempts, field.TypeJSON)
+	}
+	if value, ok := guo.mutation.NextAttemptTimes(); ok {
+		_spec.SetField(gossip.FieldNextAttemptTimes, field.TypeJSON, value)
+	}
+	if value, ok := guo.mutation.AppendedNextAttemptTimes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, gossip.FieldNextAttemptTimes, value)
+		})
+	}
+	if guo.mutation.NextAttemptTimesCleared() {
+		_spec.ClearField(gossip.FieldNextAttemptTimes, field.TypeJSON)
+	}
+	if value, ok := guo.mutation.NextAttemptTime(); ok {
+		_spec.SetField(gossip.FieldNextAttemptTime, field.TypeTime, value)
+	}
+	if guo.mutation.NextAttemptTimeCleared() {
+		_spec.ClearField(gossip.FieldNextAttemptTime, field.TypeTime)
+	}
+	if value, ok := guo.mutation.ExpiryTime(); ok {
+		_spec.SetField(gossip.FieldExpiryTime, field.TypeTime, value)
+	}
+	if guo.mutation.ExpiryTimeCleared() {
+		_spec.ClearField(gossip.FieldExpiryTime, field.TypeTime)
+	}
 	_node = &Gossip{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -165,6 +165,18 @@ func (f SparkInvoiceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SparkInvoiceMutation", m)
 }
 
+// The TokenAllowlistEntryFunc type is an adapter to allow the use of ordinary
+// function as TokenAllowlistEntry mutator.
+type TokenAllowlistEntryFunc func(context.Context, *ent.TokenAllowlistEntryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenAllowlistEntryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenAllowlistEntryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenAllowlistEntryMutation", m)
+}
+
 // The TokenCreateFunc type is an adapter to allow the use of ordinary
 // function as TokenCreate mutator.
 type TokenCreateFunc func(context.Context, *ent.TokenCreateMutation) (ent.Value, error)
@@ -189,6 +201,18 @@ func (f TokenFreezeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenFreezeMutation", m)
 }
 
+// The TokenIssuerKeyRotationFunc type is an adapter to allow the use of ordinary
+// function as TokenIssuerKeyRotation mutator.
+type TokenIssuerKeyRotationFunc func(context.Context, *ent.TokenIssuerKeyRotationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenIssuerKeyRotationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenIssuerKeyRotationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenIssuerKeyRotationMutation", m)
+}
+
 // The TokenMintFunc type is an adapter to allow the use of ordinary
 // function as TokenMint mutator.
 type TokenMintFunc func(context.Context, *ent.TokenMintMutation) (ent.Value, error)
@@ -297,6 +321,30 @@ func (f TreeNodeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TreeNodeMutation", m)
 }
 
+// The UserEventFunc type is an adapter to allow the use of ordinary
+// function as UserEvent mutator.
+type UserEventFunc func(context.Context, *ent.UserEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserEventMutation", m)
+}
+
+// The UserEventSequenceFunc type is an adapter to allow the use of ordinary
+// function as UserEventSequence mutator.
+type UserEventSequenceFunc func(context.Context, *ent.UserEventSequenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f UserEventSequenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.UserEventSequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserEventSequenceMutation", m)
+}
+
 // The UserSignedTransactionFunc type is an adapter to allow the use of ordinary
 // function as UserSignedTransaction mutator.
 type UserSignedTransactionFunc func(context.Context, *ent.UserSignedTransactionMutation) (ent.Value, error)
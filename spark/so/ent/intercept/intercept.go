@@ -22,8 +22,10 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokenpartialrevocationsecretshare"
@@ -33,6 +35,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
 	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
@@ -445,6 +449,33 @@ func (f TraverseSparkInvoice) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.SparkInvoiceQuery", q)
 }
 
+// The TokenAllowlistEntryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TokenAllowlistEntryFunc func(context.Context, *ent.TokenAllowlistEntryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TokenAllowlistEntryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TokenAllowlistEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TokenAllowlistEntryQuery", q)
+}
+
+// The TraverseTokenAllowlistEntry type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTokenAllowlistEntry func(context.Context, *ent.TokenAllowlistEntryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTokenAllowlistEntry) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTokenAllowlistEntry) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TokenAllowlistEntryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TokenAllowlistEntryQuery", q)
+}
+
 // The TokenCreateFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TokenCreateFunc func(context.Context, *ent.TokenCreateQuery) (ent.Value, error)
 
@@ -499,6 +530,33 @@ func (f TraverseTokenFreeze) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.TokenFreezeQuery", q)
 }
 
+// The TokenIssuerKeyRotationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TokenIssuerKeyRotationFunc func(context.Context, *ent.TokenIssuerKeyRotationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f TokenIssuerKeyRotationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.TokenIssuerKeyRotationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.TokenIssuerKeyRotationQuery", q)
+}
+
+// The TraverseTokenIssuerKeyRotation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTokenIssuerKeyRotation func(context.Context, *ent.TokenIssuerKeyRotationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTokenIssuerKeyRotation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTokenIssuerKeyRotation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TokenIssuerKeyRotationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.TokenIssuerKeyRotationQuery", q)
+}
+
 // The TokenMintFunc type is an adapter to allow the use of ordinary function as a Querier.
 type TokenMintFunc func(context.Context, *ent.TokenMintQuery) (ent.Value, error)
 
@@ -742,6 +800,60 @@ func (f TraverseTreeNode) Traverse(ctx context.Context, q ent.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *ent.TreeNodeQuery", q)
 }
 
+// The UserEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserEventFunc func(context.Context, *ent.UserEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserEventQuery", q)
+}
+
+// The TraverseUserEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserEvent func(context.Context, *ent.UserEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserEventQuery", q)
+}
+
+// The UserEventSequenceFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserEventSequenceFunc func(context.Context, *ent.UserEventSequenceQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserEventSequenceFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserEventSequenceQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserEventSequenceQuery", q)
+}
+
+// The TraverseUserEventSequence type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserEventSequence func(context.Context, *ent.UserEventSequenceQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserEventSequence) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserEventSequence) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserEventSequenceQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserEventSequenceQuery", q)
+}
+
 // The UserSignedTransactionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserSignedTransactionFunc func(context.Context, *ent.UserSignedTransactionQuery) (ent.Value, error)
 
@@ -852,10 +964,14 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.SigningNonceQuery, predicate.SigningNonce, signingnonce.OrderOption]{typ: ent.TypeSigningNonce, tq: q}, nil
 	case *ent.SparkInvoiceQuery:
 		return &query[*ent.SparkInvoiceQuery, predicate.SparkInvoice, sparkinvoice.OrderOption]{typ: ent.TypeSparkInvoice, tq: q}, nil
+	case *ent.TokenAllowlistEntryQuery:
+		return &query[*ent.TokenAllowlistEntryQuery, predicate.TokenAllowlistEntry, tokenallowlistentry.OrderOption]{typ: ent.TypeTokenAllowlistEntry, tq: q}, nil
 	case *ent.TokenCreateQuery:
 		return &query[*ent.TokenCreateQuery, predicate.TokenCreate, tokencreate.OrderOption]{typ: ent.TypeTokenCreate, tq: q}, nil
 	case *ent.TokenFreezeQuery:
 		return &query[*ent.TokenFreezeQuery, predicate.TokenFreeze, tokenfreeze.OrderOption]{typ: ent.TypeTokenFreeze, tq: q}, nil
+	case *ent.TokenIssuerKeyRotationQuery:
+		return &query[*ent.TokenIssuerKeyRotationQuery, predicate.TokenIssuerKeyRotation, tokenissuerkeyrotation.OrderOption]{typ: ent.TypeTokenIssuerKeyRotation, tq: q}, nil
 	case *ent.TokenMintQuery:
 		return &query[*ent.TokenMintQuery, predicate.TokenMint, tokenmint.OrderOption]{typ: ent.TypeTokenMint, tq: q}, nil
 	case *ent.TokenOutputQuery:
@@ -874,6 +990,10 @@ func NewQuery(q ent.Query) (Query, error) {
 		return &query[*ent.TreeQuery, predicate.Tree, tree.OrderOption]{typ: ent.TypeTree, tq: q}, nil
 	case *ent.TreeNodeQuery:
 		return &query[*ent.TreeNodeQuery, predicate.TreeNode, treenode.OrderOption]{typ: ent.TypeTreeNode, tq: q}, nil
+	case *ent.UserEventQuery:
+		return &query[*ent.UserEventQuery, predicate.UserEvent, userevent.OrderOption]{typ: ent.TypeUserEvent, tq: q}, nil
+	case *ent.UserEventSequenceQuery:
+		return &query[*ent.UserEventSequenceQuery, predicate.UserEventSequence, usereventsequence.OrderOption]{typ: ent.TypeUserEventSequence, tq: q}, nil
 	case *ent.UserSignedTransactionQuery:
 		return &query[*ent.UserSignedTransactionQuery, predicate.UserSignedTransaction, usersignedtransaction.OrderOption]{typ: ent.TypeUserSignedTransaction, tq: q}, nil
 	case *ent.UtxoQuery:
@@ -0,0 +1,2 @@
+-- Modify "block_heights" table
+ALTER TABLE "block_heights" ADD COLUMN "block_hash" bytea NULL;
@@ -0,0 +1,10 @@
+-- Create "user_events" table
+CREATE TABLE "user_events" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "identity_public_key" bytea NOT NULL, "sequence" bigint NOT NULL, "event" bytea NOT NULL, PRIMARY KEY ("id"));
+-- Create index "userevent_create_time" to table: "user_events"
+CREATE INDEX "userevent_create_time" ON "user_events" ("create_time");
+-- Create index "userevent_identity_public_key_sequence" to table: "user_events"
+CREATE UNIQUE INDEX "userevent_identity_public_key_sequence" ON "user_events" ("identity_public_key", "sequence");
+-- Create "user_event_sequences" table
+CREATE TABLE "user_event_sequences" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "identity_public_key" bytea NOT NULL, "last_sequence" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "user_event_sequences_identity_public_key_key" to table: "user_event_sequences"
+CREATE UNIQUE INDEX "user_event_sequences_identity_public_key_key" ON "user_event_sequences" ("identity_public_key");
@@ -0,0 +1,6 @@
+-- Modify "gossips" table
+ALTER TABLE "gossips" ADD COLUMN "attempts" jsonb NULL, ADD COLUMN "next_attempt_times" jsonb NULL, ADD COLUMN "next_attempt_time" timestamptz NULL, ADD COLUMN "expiry_time" timestamptz NULL;
+-- Create index "gossip_status_next_attempt_time" to table: "gossips"
+CREATE INDEX "gossip_status_next_attempt_time" ON "gossips" ("status", "next_attempt_time");
+-- Backfill "expiry_time" so that pending messages stop being retried a day after the upgrade
+UPDATE "gossips" SET "expiry_time" = now() + interval '1 day' WHERE "status" = 'PENDING';
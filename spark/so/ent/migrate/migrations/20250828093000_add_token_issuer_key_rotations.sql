@@ -0,0 +1,6 @@
+-- Create "token_issuer_key_rotations" table
+CREATE TABLE "token_issuer_key_rotations" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "previous_issuer_public_key" bytea NOT NULL, "new_issuer_public_key" bytea NOT NULL, "issuer_signature" bytea NOT NULL, "issuer_provided_timestamp" bigint NOT NULL, "token_create_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "token_issuer_key_rotations_token_creates_issuer_key_rotations" FOREIGN KEY ("token_create_id") REFERENCES "token_creates" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "token_issuer_key_rotations_issuer_signature_key" to table: "token_issuer_key_rotations"
+CREATE UNIQUE INDEX "token_issuer_key_rotations_issuer_signature_key" ON "token_issuer_key_rotations" ("issuer_signature");
+-- Create index "tokenissuerkeyrotation_token_create_id_issuer_provided_timestamp" to table: "token_issuer_key_rotations"
+CREATE UNIQUE INDEX "tokenissuerkeyrotation_token_create_id_issuer_provided_timestamp" ON "token_issuer_key_rotations" ("token_create_id", "issuer_provided_timestamp");
@@ -0,0 +1,4 @@
+-- Create index "tokentransaction_create_time" to table: "token_transactions"
+CREATE INDEX "tokentransaction_create_time" ON "token_transactions" ("create_time");
+-- Create index "tokentransaction_status_create_time" to table: "token_transactions"
+CREATE INDEX "tokentransaction_status_create_time" ON "token_transactions" ("status", "create_time");
@@ -0,0 +1,8 @@
+-- Modify "token_creates" table
+ALTER TABLE "token_creates" ADD COLUMN "is_transfer_restricted" boolean NOT NULL DEFAULT false;
+-- Create "token_allowlist_entries" table
+CREATE TABLE "token_allowlist_entries" ("id" uuid NOT NULL, "create_time" timestamptz NOT NULL, "update_time" timestamptz NOT NULL, "status" character varying NOT NULL, "owner_public_key" bytea NOT NULL, "issuer_signature" bytea NOT NULL, "issuer_provided_timestamp" bigint NOT NULL, "token_create_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "token_allowlist_entries_token_creates_allowlist_entries" FOREIGN KEY ("token_create_id") REFERENCES "token_creates" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- Create index "token_allowlist_entries_issuer_signature_key" to table: "token_allowlist_entries"
+CREATE UNIQUE INDEX "token_allowlist_entries_issuer_signature_key" ON "token_allowlist_entries" ("issuer_signature");
+-- Create index "tokenallowlistentry_token_create_id_owner_public_key" to table: "token_allowlist_entries"
+CREATE UNIQUE INDEX "tokenallowlistentry_token_create_id_owner_public_key" ON "token_allowlist_entries" ("token_create_id", "owner_public_key");
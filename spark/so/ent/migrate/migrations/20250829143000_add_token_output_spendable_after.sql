@@ -0,0 +1,2 @@
+-- Modify "token_outputs" table
+ALTER TABLE "token_outputs" ADD COLUMN "spendable_after" timestamptz NULL;
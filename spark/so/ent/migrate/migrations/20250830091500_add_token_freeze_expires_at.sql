@@ -0,0 +1,4 @@
+-- Modify "token_freezes" table
+ALTER TABLE "token_freezes" ADD COLUMN "expires_at" timestamptz NULL;
+-- Create index "tokenfreeze_status_expires_at" to table: "token_freezes"
+CREATE INDEX "tokenfreeze_status_expires_at" ON "token_freezes" ("status", "expires_at");
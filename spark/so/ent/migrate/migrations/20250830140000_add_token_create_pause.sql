@@ -0,0 +1,2 @@
+-- Modify "token_creates" table
+ALTER TABLE "token_creates" ADD COLUMN "is_paused" boolean NOT NULL DEFAULT false, ADD COLUMN "pause_issuer_signature" bytea NULL, ADD COLUMN "pause_issuer_provided_timestamp" bigint NULL;
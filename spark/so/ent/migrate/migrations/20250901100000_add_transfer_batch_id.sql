@@ -0,0 +1,4 @@
+-- Modify "transfers" table
+ALTER TABLE "transfers" ADD COLUMN "batch_id" uuid NULL;
+-- Create index "transfer_batch_id" to table: "transfers"
+CREATE INDEX "transfer_batch_id" ON "transfers" ("batch_id");
@@ -0,0 +1,2 @@
+-- Create index "tokenissuerkeyrotation_token_create_id_previous_issuer_public_key" to table: "token_issuer_key_rotations"
+CREATE UNIQUE INDEX "tokenissuerkeyrotation_token_create_id_previous_issuer_public_key" ON "token_issuer_key_rotations" ("token_create_id", "previous_issuer_public_key");
@@ -0,0 +1,2 @@
+-- Create index "transfer_transfer_spark_invoice" to table: "transfers"
+CREATE UNIQUE INDEX "transfer_transfer_spark_invoice" ON "transfers" ("transfer_spark_invoice") WHERE ((status)::text <> ALL ((ARRAY['EXPIRED'::character varying, 'RETURNED'::character varying])::text[]));
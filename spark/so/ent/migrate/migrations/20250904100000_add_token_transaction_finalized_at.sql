@@ -0,0 +1,6 @@
+-- Modify "token_transactions" table
+ALTER TABLE "token_transactions" ADD COLUMN "finalized_at" timestamptz NULL;
+-- Backfill "finalized_at" for transactions that are already final
+UPDATE "token_transactions" SET "finalized_at" = "update_time" WHERE "status" IN ('REVEALED', 'FINALIZED') OR ("status" = 'SIGNED' AND "token_transaction_mint" IS NOT NULL);
+-- Create index "tokentransaction_finalized_at" to table: "token_transactions"
+CREATE INDEX "tokentransaction_finalized_at" ON "token_transactions" ("finalized_at");
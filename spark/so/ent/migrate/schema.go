@@ -16,6 +16,7 @@ var (
 		{Name: "update_time", Type: field.TypeTime},
 		{Name: "height", Type: field.TypeInt64},
 		{Name: "network", Type: field.TypeEnum, Enums: []string{"UNSPECIFIED", "MAINNET", "REGTEST", "TESTNET", "SIGNET"}},
+		{Name: "block_hash", Type: field.TypeBytes, Nullable: true},
 	}
 	// BlockHeightsTable holds the schema information for the "block_heights" table.
 	BlockHeightsTable = &schema.Table{
@@ -142,7 +143,11 @@ var (
 		{Name: "participants", Type: field.TypeJSON},
 		{Name: "message", Type: field.TypeBytes},
 		{Name: "receipts", Type: field.TypeBytes},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "DELIVERED"}, Default: "PENDING"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "DELIVERED", "FAILED"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeJSON, Nullable: true},
+		{Name: "next_attempt_times", Type: field.TypeJSON, Nullable: true},
+		{Name: "next_attempt_time", Type: field.TypeTime, Nullable: true},
+		{Name: "expiry_time", Type: field.TypeTime, Nullable: true},
 	}
 	// GossipsTable holds the schema information for the "gossips" table.
 	GossipsTable = &schema.Table{
@@ -155,6 +160,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{GossipsColumns[6]},
 			},
+			{
+				Name:    "gossip_status_next_attempt_time",
+				Unique:  false,
+				Columns: []*schema.Column{GossipsColumns[6], GossipsColumns[9]},
+			},
 		},
 	}
 	// L1tokenCreatesColumns holds the columns for the "l1token_creates" table.
@@ -360,6 +370,38 @@ var (
 		Columns:    SparkInvoicesColumns,
 		PrimaryKey: []*schema.Column{SparkInvoicesColumns[0]},
 	}
+	// TokenAllowlistEntriesColumns holds the columns for the "token_allowlist_entries" table.
+	TokenAllowlistEntriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"ALLOWED", "REMOVED"}},
+		{Name: "owner_public_key", Type: field.TypeBytes},
+		{Name: "issuer_signature", Type: field.TypeBytes, Unique: true},
+		{Name: "issuer_provided_timestamp", Type: field.TypeUint64},
+		{Name: "token_create_id", Type: field.TypeUUID},
+	}
+	// TokenAllowlistEntriesTable holds the schema information for the "token_allowlist_entries" table.
+	TokenAllowlistEntriesTable = &schema.Table{
+		Name:       "token_allowlist_entries",
+		Columns:    TokenAllowlistEntriesColumns,
+		PrimaryKey: []*schema.Column{TokenAllowlistEntriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "token_allowlist_entries_token_creates_allowlist_entries",
+				Columns:    []*schema.Column{TokenAllowlistEntriesColumns[7]},
+				RefColumns: []*schema.Column{TokenCreatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenallowlistentry_token_create_id_owner_public_key",
+				Unique:  true,
+				Columns: []*schema.Column{TokenAllowlistEntriesColumns[7], TokenAllowlistEntriesColumns[4]},
+			},
+		},
+	}
 	// TokenCreatesColumns holds the columns for the "token_creates" table.
 	TokenCreatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -377,6 +419,10 @@ var (
 		{Name: "operator_specific_issuer_signature", Type: field.TypeBytes, Unique: true, Nullable: true},
 		{Name: "creation_entity_public_key", Type: field.TypeBytes},
 		{Name: "wallet_provided_timestamp", Type: field.TypeUint64, Nullable: true},
+		{Name: "is_transfer_restricted", Type: field.TypeBool, Default: false},
+		{Name: "is_paused", Type: field.TypeBool, Default: false},
+		{Name: "pause_issuer_signature", Type: field.TypeBytes, Nullable: true},
+		{Name: "pause_issuer_provided_timestamp", Type: field.TypeUint64, Nullable: true},
 		{Name: "token_create_l1_token_create", Type: field.TypeUUID, Nullable: true},
 	}
 	// TokenCreatesTable holds the schema information for the "token_creates" table.
@@ -387,7 +433,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "token_creates_l1token_creates_l1_token_create",
-				Columns:    []*schema.Column{TokenCreatesColumns[15]},
+				Columns:    []*schema.Column{TokenCreatesColumns[19]},
 				RefColumns: []*schema.Column{L1tokenCreatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -416,6 +462,7 @@ var (
 		{Name: "issuer_signature", Type: field.TypeBytes, Unique: true},
 		{Name: "wallet_provided_freeze_timestamp", Type: field.TypeUint64},
 		{Name: "wallet_provided_thaw_timestamp", Type: field.TypeUint64, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "token_create_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// TokenFreezesTable holds the schema information for the "token_freezes" table.
@@ -426,7 +473,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "token_freezes_token_creates_token_freeze",
-				Columns:    []*schema.Column{TokenFreezesColumns[9]},
+				Columns:    []*schema.Column{TokenFreezesColumns[10]},
 				RefColumns: []*schema.Column{TokenCreatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -445,12 +492,54 @@ var (
 			{
 				Name:    "tokenfreeze_owner_public_key_token_create_id_wallet_provided_f",
 				Unique:  true,
-				Columns: []*schema.Column{TokenFreezesColumns[4], TokenFreezesColumns[9], TokenFreezesColumns[7]},
+				Columns: []*schema.Column{TokenFreezesColumns[4], TokenFreezesColumns[10], TokenFreezesColumns[7]},
 			},
 			{
 				Name:    "tokenfreeze_owner_public_key_token_create_id_wallet_provided_t",
 				Unique:  true,
-				Columns: []*schema.Column{TokenFreezesColumns[4], TokenFreezesColumns[9], TokenFreezesColumns[8]},
+				Columns: []*schema.Column{TokenFreezesColumns[4], TokenFreezesColumns[10], TokenFreezesColumns[8]},
+			},
+			{
+				Name:    "tokenfreeze_status_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{TokenFreezesColumns[3], TokenFreezesColumns[9]},
+			},
+		},
+	}
+	// TokenIssuerKeyRotationsColumns holds the columns for the "token_issuer_key_rotations" table.
+	TokenIssuerKeyRotationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "previous_issuer_public_key", Type: field.TypeBytes},
+		{Name: "new_issuer_public_key", Type: field.TypeBytes},
+		{Name: "issuer_signature", Type: field.TypeBytes, Unique: true},
+		{Name: "issuer_provided_timestamp", Type: field.TypeUint64},
+		{Name: "token_create_id", Type: field.TypeUUID},
+	}
+	// TokenIssuerKeyRotationsTable holds the schema information for the "token_issuer_key_rotations" table.
+	TokenIssuerKeyRotationsTable = &schema.Table{
+		Name:       "token_issuer_key_rotations",
+		Columns:    TokenIssuerKeyRotationsColumns,
+		PrimaryKey: []*schema.Column{TokenIssuerKeyRotationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "token_issuer_key_rotations_token_creates_issuer_key_rotations",
+				Columns:    []*schema.Column{TokenIssuerKeyRotationsColumns[7]},
+				RefColumns: []*schema.Column{TokenCreatesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenissuerkeyrotation_token_create_id_issuer_provided_timestamp",
+				Unique:  true,
+				Columns: []*schema.Column{TokenIssuerKeyRotationsColumns[7], TokenIssuerKeyRotationsColumns[6]},
+			},
+			{
+				Name:    "tokenissuerkeyrotation_token_create_id_previous_issuer_public_key",
+				Unique:  true,
+				Columns: []*schema.Column{TokenIssuerKeyRotationsColumns[7], TokenIssuerKeyRotationsColumns[3]},
 			},
 		},
 	}
@@ -503,6 +592,7 @@ var (
 		{Name: "confirmed_withdraw_block_hash", Type: field.TypeBytes, Nullable: true},
 		{Name: "network", Type: field.TypeEnum, Nullable: true, Enums: []string{"UNSPECIFIED", "MAINNET", "REGTEST", "TESTNET", "SIGNET"}},
 		{Name: "token_identifier", Type: field.TypeBytes},
+		{Name: "spendable_after", Type: field.TypeTime, Nullable: true},
 		{Name: "token_create_id", Type: field.TypeUUID},
 		{Name: "token_output_revocation_keyshare", Type: field.TypeUUID},
 		{Name: "token_output_output_created_token_transaction", Type: field.TypeUUID, Nullable: true},
@@ -516,25 +606,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "token_outputs_token_creates_token_output",
-				Columns:    []*schema.Column{TokenOutputsColumns[18]},
+				Columns:    []*schema.Column{TokenOutputsColumns[19]},
 				RefColumns: []*schema.Column{TokenCreatesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "token_outputs_signing_keyshares_revocation_keyshare",
-				Columns:    []*schema.Column{TokenOutputsColumns[19]},
+				Columns:    []*schema.Column{TokenOutputsColumns[20]},
 				RefColumns: []*schema.Column{SigningKeysharesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "token_outputs_token_transactions_output_created_token_transaction",
-				Columns:    []*schema.Column{TokenOutputsColumns[20]},
+				Columns:    []*schema.Column{TokenOutputsColumns[21]},
 				RefColumns: []*schema.Column{TokenTransactionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "token_outputs_token_transactions_output_spent_token_transaction",
-				Columns:    []*schema.Column{TokenOutputsColumns[21]},
+				Columns:    []*schema.Column{TokenOutputsColumns[22]},
 				RefColumns: []*schema.Column{TokenTransactionsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -558,17 +648,17 @@ var (
 			{
 				Name:    "tokenoutput_token_output_output_spent_token_transaction",
 				Unique:  false,
-				Columns: []*schema.Column{TokenOutputsColumns[21]},
+				Columns: []*schema.Column{TokenOutputsColumns[22]},
 			},
 			{
 				Name:    "tokenoutput_created_transaction_output_vout_token_output_output_created_token_transaction",
 				Unique:  true,
-				Columns: []*schema.Column{TokenOutputsColumns[10], TokenOutputsColumns[20]},
+				Columns: []*schema.Column{TokenOutputsColumns[10], TokenOutputsColumns[21]},
 			},
 			{
 				Name:    "tokenoutput_token_create_id",
 				Unique:  false,
-				Columns: []*schema.Column{TokenOutputsColumns[18]},
+				Columns: []*schema.Column{TokenOutputsColumns[19]},
 			},
 		},
 	}
@@ -619,6 +709,7 @@ var (
 		{Name: "expiry_time", Type: field.TypeTime, Nullable: true},
 		{Name: "coordinator_public_key", Type: field.TypeBytes, Nullable: true},
 		{Name: "client_created_timestamp", Type: field.TypeTime, Nullable: true},
+		{Name: "finalized_at", Type: field.TypeTime, Nullable: true},
 		{Name: "version", Type: field.TypeInt, Default: 0},
 		{Name: "token_transaction_mint", Type: field.TypeUUID, Nullable: true},
 		{Name: "token_transaction_create", Type: field.TypeUUID, Nullable: true},
@@ -632,19 +723,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "token_transactions_token_mints_mint",
-				Columns:    []*schema.Column{TokenTransactionsColumns[11]},
+				Columns:    []*schema.Column{TokenTransactionsColumns[12]},
 				RefColumns: []*schema.Column{TokenMintsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "token_transactions_token_creates_create",
-				Columns:    []*schema.Column{TokenTransactionsColumns[12]},
+				Columns:    []*schema.Column{TokenTransactionsColumns[13]},
 				RefColumns: []*schema.Column{TokenCreatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "token_transactions_payment_intents_payment_intent",
-				Columns:    []*schema.Column{TokenTransactionsColumns[13]},
+				Columns:    []*schema.Column{TokenTransactionsColumns[14]},
 				RefColumns: []*schema.Column{PaymentIntentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -665,6 +756,21 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TokenTransactionsColumns[7], TokenTransactionsColumns[6]},
 			},
+			{
+				Name:    "tokentransaction_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{TokenTransactionsColumns[1]},
+			},
+			{
+				Name:    "tokentransaction_status_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{TokenTransactionsColumns[6], TokenTransactionsColumns[1]},
+			},
+			{
+				Name:    "tokentransaction_finalized_at",
+				Unique:  false,
+				Columns: []*schema.Column{TokenTransactionsColumns[10]},
+			},
 		},
 	}
 	// TokenTransactionPeerSignaturesColumns holds the columns for the "token_transaction_peer_signatures" table.
@@ -711,10 +817,12 @@ var (
 		{Name: "receiver_identity_pubkey", Type: field.TypeBytes},
 		{Name: "total_value", Type: field.TypeUint64},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"SENDER_INITIATED", "SENDER_INITIATED_COORDINATOR", "SENDER_KEY_TWEAK_PENDING", "SENDER_KEY_TWEAKED", "RECEIVER_KEY_TWEAKED", "RECEIVER_KEY_TWEAK_LOCKED", "RECEIVER_REFUND_SIGNED", "COMPLETED", "EXPIRED", "RETURNED", "RECEIVER_KEY_TWEAK_APPLIED"}},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP", "HTLC"}},
 		{Name: "expiry_time", Type: field.TypeTime},
 		{Name: "completion_time", Type: field.TypeTime, Nullable: true},
+		{Name: "batch_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "transfer_payment_intent", Type: field.TypeUUID, Nullable: true},
+		{Name: "transfer_spark_invoice", Type: field.TypeUUID, Nullable: true},
 	}
 	// TransfersTable holds the schema information for the "transfers" table.
 	TransfersTable = &schema.Table{
@@ -724,10 +832,16 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "transfers_payment_intents_payment_intent",
-				Columns:    []*schema.Column{TransfersColumns[10]},
+				Columns:    []*schema.Column{TransfersColumns[11]},
 				RefColumns: []*schema.Column{PaymentIntentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "transfers_spark_invoices_spark_invoice",
+				Columns:    []*schema.Column{TransfersColumns[12]},
+				RefColumns: []*schema.Column{SparkInvoicesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -750,6 +864,19 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{TransfersColumns[2]},
 			},
+			{
+				Name:    "transfer_batch_id",
+				Unique:  false,
+				Columns: []*schema.Column{TransfersColumns[10]},
+			},
+			{
+				Name:    "transfer_transfer_spark_invoice",
+				Unique:  true,
+				Columns: []*schema.Column{TransfersColumns[12]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "status NOT IN ('EXPIRED', 'RETURNED')",
+				},
+			},
 		},
 	}
 	// TransferLeafsColumns holds the columns for the "transfer_leafs" table.
@@ -936,6 +1063,47 @@ var (
 			},
 		},
 	}
+	// UserEventsColumns holds the columns for the "user_events" table.
+	UserEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "identity_public_key", Type: field.TypeBytes},
+		{Name: "sequence", Type: field.TypeUint64},
+		{Name: "event", Type: field.TypeBytes},
+	}
+	// UserEventsTable holds the schema information for the "user_events" table.
+	UserEventsTable = &schema.Table{
+		Name:       "user_events",
+		Columns:    UserEventsColumns,
+		PrimaryKey: []*schema.Column{UserEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "userevent_identity_public_key_sequence",
+				Unique:  true,
+				Columns: []*schema.Column{UserEventsColumns[3], UserEventsColumns[4]},
+			},
+			{
+				Name:    "userevent_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{UserEventsColumns[1]},
+			},
+		},
+	}
+	// UserEventSequencesColumns holds the columns for the "user_event_sequences" table.
+	UserEventSequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "identity_public_key", Type: field.TypeBytes, Unique: true},
+		{Name: "last_sequence", Type: field.TypeUint64},
+	}
+	// UserEventSequencesTable holds the schema information for the "user_event_sequences" table.
+	UserEventSequencesTable = &schema.Table{
+		Name:       "user_event_sequences",
+		Columns:    UserEventSequencesColumns,
+		PrimaryKey: []*schema.Column{UserEventSequencesColumns[0]},
+	}
 	// UserSignedTransactionsColumns holds the columns for the "user_signed_transactions" table.
 	UserSignedTransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1123,8 +1291,10 @@ var (
 		SigningKeysharesTable,
 		SigningNoncesTable,
 		SparkInvoicesTable,
+		TokenAllowlistEntriesTable,
 		TokenCreatesTable,
 		TokenFreezesTable,
+		TokenIssuerKeyRotationsTable,
 		TokenMintsTable,
 		TokenOutputsTable,
 		TokenPartialRevocationSecretSharesTable,
@@ -1134,6 +1304,8 @@ var (
 		TransferLeafsTable,
 		TreesTable,
 		TreeNodesTable,
+		UserEventsTable,
+		UserEventSequencesTable,
 		UserSignedTransactionsTable,
 		UtxosTable,
 		UtxoSwapsTable,
@@ -1148,8 +1320,10 @@ func init() {
 	EntityDkgKeysTable.ForeignKeys[0].RefTable = SigningKeysharesTable
 	PreimageRequestsTable.ForeignKeys[0].RefTable = TransfersTable
 	PreimageSharesTable.ForeignKeys[0].RefTable = PreimageRequestsTable
+	TokenAllowlistEntriesTable.ForeignKeys[0].RefTable = TokenCreatesTable
 	TokenCreatesTable.ForeignKeys[0].RefTable = L1tokenCreatesTable
 	TokenFreezesTable.ForeignKeys[0].RefTable = TokenCreatesTable
+	TokenIssuerKeyRotationsTable.ForeignKeys[0].RefTable = TokenCreatesTable
 	TokenOutputsTable.ForeignKeys[0].RefTable = TokenCreatesTable
 	TokenOutputsTable.ForeignKeys[1].RefTable = SigningKeysharesTable
 	TokenOutputsTable.ForeignKeys[2].RefTable = TokenTransactionsTable
@@ -1160,6 +1334,7 @@ func init() {
 	TokenTransactionsTable.ForeignKeys[2].RefTable = PaymentIntentsTable
 	TokenTransactionPeerSignaturesTable.ForeignKeys[0].RefTable = TokenTransactionsTable
 	TransfersTable.ForeignKeys[0].RefTable = PaymentIntentsTable
+	TransfersTable.ForeignKeys[1].RefTable = SparkInvoicesTable
 	TransferLeafsTable.ForeignKeys[0].RefTable = TransfersTable
 	TransferLeafsTable.ForeignKeys[1].RefTable = TreeNodesTable
 	TreesTable.ForeignKeys[0].RefTable = TreeNodesTable
@@ -27,8 +27,10 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokenpartialrevocationsecretshare"
@@ -38,6 +40,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
 	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
@@ -65,8 +69,10 @@ const (
 	TypeSigningKeyshare                   = "SigningKeyshare"
 	TypeSigningNonce                      = "SigningNonce"
 	TypeSparkInvoice                      = "SparkInvoice"
+	TypeTokenAllowlistEntry               = "TokenAllowlistEntry"
 	TypeTokenCreate                       = "TokenCreate"
 	TypeTokenFreeze                       = "TokenFreeze"
+	TypeTokenIssuerKeyRotation            = "TokenIssuerKeyRotation"
 	TypeTokenMint                         = "TokenMint"
 	TypeTokenOutput                       = "TokenOutput"
 	TypeTokenPartialRevocationSecretShare = "TokenPartialRevocationSecretShare"
@@ -76,6 +82,8 @@ const (
 	TypeTransferLeaf                      = "TransferLeaf"
 	TypeTree                              = "Tree"
 	TypeTreeNode                          = "TreeNode"
+	TypeUserEvent                         = "UserEvent"
+	TypeUserEventSequence                 = "UserEventSequence"
 	TypeUserSignedTransaction             = "UserSignedTransaction"
 	TypeUtxo                              = "Utxo"
 	TypeUtxoSwap                          = "UtxoSwap"
@@ -92,6 +100,7 @@ type BlockHeightMutation struct {
 	height        *int64
 	addheight     *int64
 	network       *schematype.Network
+	block_hash    *[]byte
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*BlockHeight, error)
@@ -366,6 +375,55 @@ func (m *BlockHeightMutation) ResetNetwork() {
 	m.network = nil
 }
 
+// SetBlockHash sets the "block_hash" field.
+func (m *BlockHeightMutation) SetBlockHash(b []byte) {
+	m.block_hash = &b
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *BlockHeightMutation) BlockHash() (r []byte, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the BlockHeight entity.
+// If the BlockHeight object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlockHeightMutation) OldBlockHash(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+	}
+	return oldValue.BlockHash, nil
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (m *BlockHeightMutation) ClearBlockHash() {
+	m.block_hash = nil
+	m.clearedFields[blockheight.FieldBlockHash] = struct{}{}
+}
+
+// BlockHashCleared returns if the "block_hash" field was cleared in this mutation.
+func (m *BlockHeightMutation) BlockHashCleared() bool {
+	_, ok := m.clearedFields[blockheight.FieldBlockHash]
+	return ok
+}
+
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *BlockHeightMutation) ResetBlockHash() {
+	m.block_hash = nil
+	delete(m.clearedFields, blockheight.FieldBlockHash)
+}
+
 // Where appends a list predicates to the BlockHeightMutation builder.
 func (m *BlockHeightMutation) Where(ps ...predicate.BlockHeight) {
 	m.predicates = append(m.predicates, ps...)
@@ -400,7 +458,7 @@ func (m *BlockHeightMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BlockHeightMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.create_time != nil {
 		fields = append(fields, blockheight.FieldCreateTime)
 	}
@@ -413,6 +471,9 @@ func (m *BlockHeightMutation) Fields() []string {
 	if m.network != nil {
 		fields = append(fields, blockheight.FieldNetwork)
 	}
+	if m.block_hash != nil {
+		fields = append(fields, blockheight.FieldBlockHash)
+	}
 	return fields
 }
 
@@ -429,6 +490,8 @@ func (m *BlockHeightMutation) Field(name string) (ent.Value, bool) {
 		return m.Height()
 	case blockheight.FieldNetwork:
 		return m.Network()
+	case blockheight.FieldBlockHash:
+		return m.BlockHash()
 	}
 	return nil, false
 }
@@ -446,6 +509,8 @@ func (m *BlockHeightMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldHeight(ctx)
 	case blockheight.FieldNetwork:
 		return m.OldNetwork(ctx)
+	case blockheight.FieldBlockHash:
+		return m.OldBlockHash(ctx)
 	}
 	return nil, fmt.Errorf("unknown BlockHeight field %s", name)
 }
@@ -483,6 +548,13 @@ func (m *BlockHeightMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetNetwork(v)
 		return nil
+	case blockheight.FieldBlockHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHash(v)
+		return nil
 	}
 	return fmt.Errorf("unknown BlockHeight field %s", name)
 }
@@ -527,7 +599,11 @@ func (m *BlockHeightMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *BlockHeightMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(blockheight.FieldBlockHash) {
+		fields = append(fields, blockheight.FieldBlockHash)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -540,6 +616,11 @@ func (m *BlockHeightMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *BlockHeightMutation) ClearField(name string) error {
+	switch name {
+	case blockheight.FieldBlockHash:
+		m.ClearBlockHash()
+		return nil
+	}
 	return fmt.Errorf("unknown BlockHeight nullable field %s", name)
 }
 
@@ -559,6 +640,9 @@ func (m *BlockHeightMutation) ResetField(name string) error {
 	case blockheight.FieldNetwork:
 		m.ResetNetwork()
 		return nil
+	case blockheight.FieldBlockHash:
+		m.ResetBlockHash()
+		return nil
 	}
 	return fmt.Errorf("unknown BlockHeight field %s", name)
 }
@@ -2983,20 +3067,26 @@ func (m *EntityDkgKeyMutation) ResetEdge(name string) error {
 // GossipMutation represents an operation that mutates the Gossip nodes in the graph.
 type GossipMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	create_time        *time.Time
-	update_time        *time.Time
-	participants       *[]string
-	appendparticipants []string
-	message            *[]byte
-	receipts           *[]byte
-	status             *schematype.GossipStatus
-	clearedFields      map[string]struct{}
-	done               bool
-	oldValue           func(context.Context) (*Gossip, error)
-	predicates         []predicate.Gossip
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	participants             *[]string
+	appendparticipants       []string
+	message                  *[]byte
+	receipts                 *[]byte
+	status                   *schematype.GossipStatus
+	attempts                 *[]int
+	appendattempts           []int
+	next_attempt_times       *[]time.Time
+	appendnext_attempt_times []time.Time
+	next_attempt_time        *time.Time
+	expiry_time              *time.Time
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*Gossip, error)
+	predicates               []predicate.Gossip
 }
 
 var _ ent.Mutation = (*GossipMutation)(nil)
@@ -3334,6 +3424,234 @@ func (m *GossipMutation) ResetStatus() {
 	m.status = nil
 }
 
+// SetAttempts sets the "attempts" field.
+func (m *GossipMutation) SetAttempts(i []int) {
+	m.attempts = &i
+	m.appendattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *GossipMutation) Attempts() (r []int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the Gossip entity.
+// If the Gossip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GossipMutation) OldAttempts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AppendAttempts adds i to the "attempts" field.
+func (m *GossipMutation) AppendAttempts(i []int) {
+	m.appendattempts = append(m.appendattempts, i...)
+}
+
+// AppendedAttempts returns the list of values that were appended to the "attempts" field in this mutation.
+func (m *GossipMutation) AppendedAttempts() ([]int, bool) {
+	if len(m.appendattempts) == 0 {
+		return nil, false
+	}
+	return m.appendattempts, true
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (m *GossipMutation) ClearAttempts() {
+	m.attempts = nil
+	m.appendattempts = nil
+	m.clearedFields[gossip.FieldAttempts] = struct{}{}
+}
+
+// AttemptsCleared returns if the "attempts" field was cleared in this mutation.
+func (m *GossipMutation) AttemptsCleared() bool {
+	_, ok := m.clearedFields[gossip.FieldAttempts]
+	return ok
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *GossipMutation) ResetAttempts() {
+	m.attempts = nil
+	m.appendattempts = nil
+	delete(m.clearedFields, gossip.FieldAttempts)
+}
+
+// SetNextAttemptTimes sets the "next_attempt_times" field.
+func (m *GossipMutation) SetNextAttemptTimes(t []time.Time) {
+	m.next_attempt_times = &t
+	m.appendnext_attempt_times = nil
+}
+
+// NextAttemptTimes returns the value of the "next_attempt_times" field in the mutation.
+func (m *GossipMutation) NextAttemptTimes() (r []time.Time, exists bool) {
+	v := m.next_attempt_times
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptTimes returns the old "next_attempt_times" field's value of the Gossip entity.
+// If the Gossip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GossipMutation) OldNextAttemptTimes(ctx context.Context) (v []time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptTimes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptTimes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptTimes: %w", err)
+	}
+	return oldValue.NextAttemptTimes, nil
+}
+
+// AppendNextAttemptTimes adds t to the "next_attempt_times" field.
+func (m *GossipMutation) AppendNextAttemptTimes(t []time.Time) {
+	m.appendnext_attempt_times = append(m.appendnext_attempt_times, t...)
+}
+
+// AppendedNextAttemptTimes returns the list of values that were appended to the "next_attempt_times" field in this mutation.
+func (m *GossipMutation) AppendedNextAttemptTimes() ([]time.Time, bool) {
+	if len(m.appendnext_attempt_times) == 0 {
+		return nil, false
+	}
+	return m.appendnext_attempt_times, true
+}
+
+// ClearNextAttemptTimes clears the value of the "next_attempt_times" field.
+func (m *GossipMutation) ClearNextAttemptTimes() {
+	m.next_attempt_times = nil
+	m.appendnext_attempt_times = nil
+	m.clearedFields[gossip.FieldNextAttemptTimes] = struct{}{}
+}
+
+// NextAttemptTimesCleared returns if the "next_attempt_times" field was cleared in this mutation.
+func (m *GossipMutation) NextAttemptTimesCleared() bool {
+	_, ok := m.clearedFields[gossip.FieldNextAttemptTimes]
+	return ok
+}
+
+// ResetNextAttemptTimes resets all changes to the "next_attempt_times" field.
+func (m *GossipMutation) ResetNextAttemptTimes() {
+	m.next_attempt_times = nil
+	m.appendnext_attempt_times = nil
+	delete(m.clearedFields, gossip.FieldNextAttemptTimes)
+}
+
+// SetNextAttemptTime sets the "next_attempt_time" field.
+func (m *GossipMutation) SetNextAttemptTime(t time.Time) {
+	m.next_attempt_time = &t
+}
+
+// NextAttemptTime returns the value of the "next_attempt_time" field in the mutation.
+func (m *GossipMutation) NextAttemptTime() (r time.Time, exists bool) {
+	v := m.next_attempt_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptTime returns the old "next_attempt_time" field's value of the Gossip entity.
+// If the Gossip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GossipMutation) OldNextAttemptTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptTime: %w", err)
+	}
+	return oldValue.NextAttemptTime, nil
+}
+
+// ClearNextAttemptTime clears the value of the "next_attempt_time" field.
+func (m *GossipMutation) ClearNextAttemptTime() {
+	m.next_attempt_time = nil
+	m.clearedFields[gossip.FieldNextAttemptTime] = struct{}{}
+}
+
+// NextAttemptTimeCleared returns if the "next_attempt_time" field was cleared in this mutation.
+func (m *GossipMutation) NextAttemptTimeCleared() bool {
+	_, ok := m.clearedFields[gossip.FieldNextAttemptTime]
+	return ok
+}
+
+// ResetNextAttemptTime resets all changes to the "next_attempt_time" field.
+func (m *GossipMutation) ResetNextAttemptTime() {
+	m.next_attempt_time = nil
+	delete(m.clearedFields, gossip.FieldNextAttemptTime)
+}
+
+// SetExpiryTime sets the "expiry_time" field.
+func (m *GossipMutation) SetExpiryTime(t time.Time) {
+	m.expiry_time = &t
+}
+
+// ExpiryTime returns the value of the "expiry_time" field in the mutation.
+func (m *GossipMutation) ExpiryTime() (r time.Time, exists bool) {
+	v := m.expiry_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiryTime returns the old "expiry_time" field's value of the Gossip entity.
+// If the Gossip object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GossipMutation) OldExpiryTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTime: %w", err)
+	}
+	return oldValue.ExpiryTime, nil
+}
+
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (m *GossipMutation) ClearExpiryTime() {
+	m.expiry_time = nil
+	m.clearedFields[gossip.FieldExpiryTime] = struct{}{}
+}
+
+// ExpiryTimeCleared returns if the "expiry_time" field was cleared in this mutation.
+func (m *GossipMutation) ExpiryTimeCleared() bool {
+	_, ok := m.clearedFields[gossip.FieldExpiryTime]
+	return ok
+}
+
+// ResetExpiryTime resets all changes to the "expiry_time" field.
+func (m *GossipMutation) ResetExpiryTime() {
+	m.expiry_time = nil
+	delete(m.clearedFields, gossip.FieldExpiryTime)
+}
+
 // Where appends a list predicates to the GossipMutation builder.
 func (m *GossipMutation) Where(ps ...predicate.Gossip) {
 	m.predicates = append(m.predicates, ps...)
@@ -3368,7 +3686,7 @@ func (m *GossipMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *GossipMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
 		fields = append(fields, gossip.FieldCreateTime)
 	}
@@ -3387,6 +3705,18 @@ func (m *GossipMutation) Fields() []string {
 	if m.status != nil {
 		fields = append(fields, gossip.FieldStatus)
 	}
+	if m.attempts != nil {
+		fields = append(fields, gossip.FieldAttempts)
+	}
+	if m.next_attempt_times != nil {
+		fields = append(fields, gossip.FieldNextAttemptTimes)
+	}
+	if m.next_attempt_time != nil {
+		fields = append(fields, gossip.FieldNextAttemptTime)
+	}
+	if m.expiry_time != nil {
+		fields = append(fields, gossip.FieldExpiryTime)
+	}
 	return fields
 }
 
@@ -3407,6 +3737,14 @@ func (m *GossipMutation) Field(name string) (ent.Value, bool) {
 		return m.Receipts()
 	case gossip.FieldStatus:
 		return m.Status()
+	case gossip.FieldAttempts:
+		return m.Attempts()
+	case gossip.FieldNextAttemptTimes:
+		return m.NextAttemptTimes()
+	case gossip.FieldNextAttemptTime:
+		return m.NextAttemptTime()
+	case gossip.FieldExpiryTime:
+		return m.ExpiryTime()
 	}
 	return nil, false
 }
@@ -3428,6 +3766,14 @@ func (m *GossipMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldReceipts(ctx)
 	case gossip.FieldStatus:
 		return m.OldStatus(ctx)
+	case gossip.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case gossip.FieldNextAttemptTimes:
+		return m.OldNextAttemptTimes(ctx)
+	case gossip.FieldNextAttemptTime:
+		return m.OldNextAttemptTime(ctx)
+	case gossip.FieldExpiryTime:
+		return m.OldExpiryTime(ctx)
 	}
 	return nil, fmt.Errorf("unknown Gossip field %s", name)
 }
@@ -3479,6 +3825,34 @@ func (m *GossipMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetStatus(v)
 		return nil
+	case gossip.FieldAttempts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case gossip.FieldNextAttemptTimes:
+		v, ok := value.([]time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptTimes(v)
+		return nil
+	case gossip.FieldNextAttemptTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptTime(v)
+		return nil
+	case gossip.FieldExpiryTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTime(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Gossip field %s", name)
 }
@@ -3508,7 +3882,20 @@ func (m *GossipMutation) AddField(name string, value ent.Value) error {
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
 func (m *GossipMutation) ClearedFields() []string {
-	return nil
+	var fields []string
+	if m.FieldCleared(gossip.FieldAttempts) {
+		fields = append(fields, gossip.FieldAttempts)
+	}
+	if m.FieldCleared(gossip.FieldNextAttemptTimes) {
+		fields = append(fields, gossip.FieldNextAttemptTimes)
+	}
+	if m.FieldCleared(gossip.FieldNextAttemptTime) {
+		fields = append(fields, gossip.FieldNextAttemptTime)
+	}
+	if m.FieldCleared(gossip.FieldExpiryTime) {
+		fields = append(fields, gossip.FieldExpiryTime)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
@@ -3521,6 +3908,20 @@ func (m *GossipMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *GossipMutation) ClearField(name string) error {
+	switch name {
+	case gossip.FieldAttempts:
+		m.ClearAttempts()
+		return nil
+	case gossip.FieldNextAttemptTimes:
+		m.ClearNextAttemptTimes()
+		return nil
+	case gossip.FieldNextAttemptTime:
+		m.ClearNextAttemptTime()
+		return nil
+	case gossip.FieldExpiryTime:
+		m.ClearExpiryTime()
+		return nil
+	}
 	return fmt.Errorf("unknown Gossip nullable field %s", name)
 }
 
@@ -3546,6 +3947,18 @@ func (m *GossipMutation) ResetField(name string) error {
 	case gossip.FieldStatus:
 		m.ResetStatus()
 		return nil
+	case gossip.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case gossip.FieldNextAttemptTimes:
+		m.ResetNextAttemptTimes()
+		return nil
+	case gossip.FieldNextAttemptTime:
+		m.ResetNextAttemptTime()
+		return nil
+	case gossip.FieldExpiryTime:
+		m.ResetExpiryTime()
+		return nil
 	}
 	return fmt.Errorf("unknown Gossip field %s", name)
 }
@@ -8683,6 +9096,9 @@ type SparkInvoiceMutation struct {
 	token_transaction        map[uuid.UUID]struct{}
 	removedtoken_transaction map[uuid.UUID]struct{}
 	clearedtoken_transaction bool
+	transfer                 map[uuid.UUID]struct{}
+	removedtransfer          map[uuid.UUID]struct{}
+	clearedtransfer          bool
 	done                     bool
 	oldValue                 func(context.Context) (*SparkInvoice, error)
 	predicates               []predicate.SparkInvoice
@@ -9039,28 +9455,82 @@ func (m *SparkInvoiceMutation) ResetTokenTransaction() {
 	m.removedtoken_transaction = nil
 }
 
-// Where appends a list predicates to the SparkInvoiceMutation builder.
-func (m *SparkInvoiceMutation) Where(ps ...predicate.SparkInvoice) {
-	m.predicates = append(m.predicates, ps...)
+// AddTransferIDs adds the "transfer" edge to the Transfer entity by ids.
+func (m *SparkInvoiceMutation) AddTransferIDs(ids ...uuid.UUID) {
+	if m.transfer == nil {
+		m.transfer = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.transfer[ids[i]] = struct{}{}
+	}
 }
 
-// WhereP appends storage-level predicates to the SparkInvoiceMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SparkInvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SparkInvoice, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearTransfer clears the "transfer" edge to the Transfer entity.
+func (m *SparkInvoiceMutation) ClearTransfer() {
+	m.clearedtransfer = true
 }
 
-// Op returns the operation name.
-func (m *SparkInvoiceMutation) Op() Op {
-	return m.op
+// TransferCleared reports if the "transfer" edge to the Transfer entity was cleared.
+func (m *SparkInvoiceMutation) TransferCleared() bool {
+	return m.clearedtransfer
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SparkInvoiceMutation) SetOp(op Op) {
+// RemoveTransferIDs removes the "transfer" edge to the Transfer entity by IDs.
+func (m *SparkInvoiceMutation) RemoveTransferIDs(ids ...uuid.UUID) {
+	if m.removedtransfer == nil {
+		m.removedtransfer = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.transfer, ids[i])
+		m.removedtransfer[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransfer returns the removed IDs of the "transfer" edge to the Transfer entity.
+func (m *SparkInvoiceMutation) RemovedTransferIDs() (ids []uuid.UUID) {
+	for id := range m.removedtransfer {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TransferIDs returns the "transfer" edge IDs in the mutation.
+func (m *SparkInvoiceMutation) TransferIDs() (ids []uuid.UUID) {
+	for id := range m.transfer {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTransfer resets all changes to the "transfer" edge.
+func (m *SparkInvoiceMutation) ResetTransfer() {
+	m.transfer = nil
+	m.clearedtransfer = false
+	m.removedtransfer = nil
+}
+
+// Where appends a list predicates to the SparkInvoiceMutation builder.
+func (m *SparkInvoiceMutation) Where(ps ...predicate.SparkInvoice) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SparkInvoiceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SparkInvoiceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SparkInvoice, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SparkInvoiceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SparkInvoiceMutation) SetOp(op Op) {
 	m.op = op
 }
 
@@ -9249,10 +9719,13 @@ func (m *SparkInvoiceMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *SparkInvoiceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.token_transaction != nil {
 		edges = append(edges, sparkinvoice.EdgeTokenTransaction)
 	}
+	if m.transfer != nil {
+		edges = append(edges, sparkinvoice.EdgeTransfer)
+	}
 	return edges
 }
 
@@ -9266,16 +9739,25 @@ func (m *SparkInvoiceMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case sparkinvoice.EdgeTransfer:
+		ids := make([]ent.Value, 0, len(m.transfer))
+		for id := range m.transfer {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *SparkInvoiceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.removedtoken_transaction != nil {
 		edges = append(edges, sparkinvoice.EdgeTokenTransaction)
 	}
+	if m.removedtransfer != nil {
+		edges = append(edges, sparkinvoice.EdgeTransfer)
+	}
 	return edges
 }
 
@@ -9289,16 +9771,25 @@ func (m *SparkInvoiceMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case sparkinvoice.EdgeTransfer:
+		ids := make([]ent.Value, 0, len(m.removedtransfer))
+		for id := range m.removedtransfer {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *SparkInvoiceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+	edges := make([]string, 0, 2)
 	if m.clearedtoken_transaction {
 		edges = append(edges, sparkinvoice.EdgeTokenTransaction)
 	}
+	if m.clearedtransfer {
+		edges = append(edges, sparkinvoice.EdgeTransfer)
+	}
 	return edges
 }
 
@@ -9308,6 +9799,8 @@ func (m *SparkInvoiceMutation) EdgeCleared(name string) bool {
 	switch name {
 	case sparkinvoice.EdgeTokenTransaction:
 		return m.clearedtoken_transaction
+	case sparkinvoice.EdgeTransfer:
+		return m.clearedtransfer
 	}
 	return false
 }
@@ -9327,60 +9820,45 @@ func (m *SparkInvoiceMutation) ResetEdge(name string) error {
 	case sparkinvoice.EdgeTokenTransaction:
 		m.ResetTokenTransaction()
 		return nil
+	case sparkinvoice.EdgeTransfer:
+		m.ResetTransfer()
+		return nil
 	}
 	return fmt.Errorf("unknown SparkInvoice edge %s", name)
 }
 
-// TokenCreateMutation represents an operation that mutates the TokenCreate nodes in the graph.
-type TokenCreateMutation struct {
+// TokenAllowlistEntryMutation represents an operation that mutates the TokenAllowlistEntry nodes in the graph.
+type TokenAllowlistEntryMutation struct {
 	config
-	op                                 Op
-	typ                                string
-	id                                 *uuid.UUID
-	create_time                        *time.Time
-	update_time                        *time.Time
-	issuer_public_key                  *[]byte
-	token_name                         *string
-	token_ticker                       *string
-	decimals                           *uint8
-	adddecimals                        *int8
-	max_supply                         *[]byte
-	is_freezable                       *bool
-	network                            *schematype.Network
-	token_identifier                   *[]byte
-	issuer_signature                   *[]byte
-	operator_specific_issuer_signature *[]byte
-	creation_entity_public_key         *[]byte
-	wallet_provided_timestamp          *uint64
-	addwallet_provided_timestamp       *int64
-	clearedFields                      map[string]struct{}
-	token_transaction                  map[uuid.UUID]struct{}
-	removedtoken_transaction           map[uuid.UUID]struct{}
-	clearedtoken_transaction           bool
-	l1_token_create                    *uuid.UUID
-	clearedl1_token_create             bool
-	token_output                       map[uuid.UUID]struct{}
-	removedtoken_output                map[uuid.UUID]struct{}
-	clearedtoken_output                bool
-	token_freeze                       map[uuid.UUID]struct{}
-	removedtoken_freeze                map[uuid.UUID]struct{}
-	clearedtoken_freeze                bool
-	done                               bool
-	oldValue                           func(context.Context) (*TokenCreate, error)
-	predicates                         []predicate.TokenCreate
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	create_time                  *time.Time
+	update_time                  *time.Time
+	status                       *schematype.TokenAllowlistStatus
+	owner_public_key             *[]byte
+	issuer_signature             *[]byte
+	issuer_provided_timestamp    *uint64
+	addissuer_provided_timestamp *int64
+	clearedFields                map[string]struct{}
+	token_create                 *uuid.UUID
+	clearedtoken_create          bool
+	done                         bool
+	oldValue                     func(context.Context) (*TokenAllowlistEntry, error)
+	predicates                   []predicate.TokenAllowlistEntry
 }
 
-var _ ent.Mutation = (*TokenCreateMutation)(nil)
+var _ ent.Mutation = (*TokenAllowlistEntryMutation)(nil)
 
-// tokencreateOption allows management of the mutation configuration using functional options.
-type tokencreateOption func(*TokenCreateMutation)
+// tokenallowlistentryOption allows management of the mutation configuration using functional options.
+type tokenallowlistentryOption func(*TokenAllowlistEntryMutation)
 
-// newTokenCreateMutation creates new mutation for the TokenCreate entity.
-func newTokenCreateMutation(c config, op Op, opts ...tokencreateOption) *TokenCreateMutation {
-	m := &TokenCreateMutation{
+// newTokenAllowlistEntryMutation creates new mutation for the TokenAllowlistEntry entity.
+func newTokenAllowlistEntryMutation(c config, op Op, opts ...tokenallowlistentryOption) *TokenAllowlistEntryMutation {
+	m := &TokenAllowlistEntryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTokenCreate,
+		typ:           TypeTokenAllowlistEntry,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9389,20 +9867,20 @@ func newTokenCreateMutation(c config, op Op, opts ...tokencreateOption) *TokenCr
 	return m
 }
 
-// withTokenCreateID sets the ID field of the mutation.
-func withTokenCreateID(id uuid.UUID) tokencreateOption {
-	return func(m *TokenCreateMutation) {
+// withTokenAllowlistEntryID sets the ID field of the mutation.
+func withTokenAllowlistEntryID(id uuid.UUID) tokenallowlistentryOption {
+	return func(m *TokenAllowlistEntryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TokenCreate
+			value *TokenAllowlistEntry
 		)
-		m.oldValue = func(ctx context.Context) (*TokenCreate, error) {
+		m.oldValue = func(ctx context.Context) (*TokenAllowlistEntry, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TokenCreate.Get(ctx, id)
+					value, err = m.Client().TokenAllowlistEntry.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9411,10 +9889,10 @@ func withTokenCreateID(id uuid.UUID) tokencreateOption {
 	}
 }
 
-// withTokenCreate sets the old TokenCreate of the mutation.
-func withTokenCreate(node *TokenCreate) tokencreateOption {
-	return func(m *TokenCreateMutation) {
-		m.oldValue = func(context.Context) (*TokenCreate, error) {
+// withTokenAllowlistEntry sets the old TokenAllowlistEntry of the mutation.
+func withTokenAllowlistEntry(node *TokenAllowlistEntry) tokenallowlistentryOption {
+	return func(m *TokenAllowlistEntryMutation) {
+		m.oldValue = func(context.Context) (*TokenAllowlistEntry, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9423,7 +9901,7 @@ func withTokenCreate(node *TokenCreate) tokencreateOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenCreateMutation) Client() *Client {
+func (m TokenAllowlistEntryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9431,7 +9909,7 @@ func (m TokenCreateMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenCreateMutation) Tx() (*Tx, error) {
+func (m TokenAllowlistEntryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9441,14 +9919,14 @@ func (m TokenCreateMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenCreate entities.
-func (m *TokenCreateMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenAllowlistEntry entities.
+func (m *TokenAllowlistEntryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenCreateMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenAllowlistEntryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9459,7 +9937,7 @@ func (m *TokenCreateMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenCreateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenAllowlistEntryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9468,19 +9946,19 @@ func (m *TokenCreateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenCreate.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenAllowlistEntry.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TokenCreateMutation) SetCreateTime(t time.Time) {
+func (m *TokenAllowlistEntryMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenCreateMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenAllowlistEntryMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -9488,10 +9966,10 @@ func (m *TokenCreateMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenAllowlistEntryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -9506,17 +9984,17 @@ func (m *TokenCreateMutation) OldCreateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenCreateMutation) ResetCreateTime() {
+func (m *TokenAllowlistEntryMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TokenCreateMutation) SetUpdateTime(t time.Time) {
+func (m *TokenAllowlistEntryMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenCreateMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenAllowlistEntryMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -9524,10 +10002,10 @@ func (m *TokenCreateMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenAllowlistEntryMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -9542,1845 +10020,1806 @@ func (m *TokenCreateMutation) OldUpdateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenCreateMutation) ResetUpdateTime() {
+func (m *TokenAllowlistEntryMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetIssuerPublicKey sets the "issuer_public_key" field.
-func (m *TokenCreateMutation) SetIssuerPublicKey(b []byte) {
-	m.issuer_public_key = &b
+// SetStatus sets the "status" field.
+func (m *TokenAllowlistEntryMutation) SetStatus(sas schematype.TokenAllowlistStatus) {
+	m.status = &sas
 }
 
-// IssuerPublicKey returns the value of the "issuer_public_key" field in the mutation.
-func (m *TokenCreateMutation) IssuerPublicKey() (r []byte, exists bool) {
-	v := m.issuer_public_key
+// Status returns the value of the "status" field in the mutation.
+func (m *TokenAllowlistEntryMutation) Status() (r schematype.TokenAllowlistStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIssuerPublicKey returns the old "issuer_public_key" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldIssuerPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenAllowlistEntryMutation) OldStatus(ctx context.Context) (v schematype.TokenAllowlistStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuerPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuerPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuerPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.IssuerPublicKey, nil
+	return oldValue.Status, nil
 }
 
-// ResetIssuerPublicKey resets all changes to the "issuer_public_key" field.
-func (m *TokenCreateMutation) ResetIssuerPublicKey() {
-	m.issuer_public_key = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TokenAllowlistEntryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetTokenName sets the "token_name" field.
-func (m *TokenCreateMutation) SetTokenName(s string) {
-	m.token_name = &s
+// SetOwnerPublicKey sets the "owner_public_key" field.
+func (m *TokenAllowlistEntryMutation) SetOwnerPublicKey(b []byte) {
+	m.owner_public_key = &b
 }
 
-// TokenName returns the value of the "token_name" field in the mutation.
-func (m *TokenCreateMutation) TokenName() (r string, exists bool) {
-	v := m.token_name
+// OwnerPublicKey returns the value of the "owner_public_key" field in the mutation.
+func (m *TokenAllowlistEntryMutation) OwnerPublicKey() (r []byte, exists bool) {
+	v := m.owner_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenName returns the old "token_name" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerPublicKey returns the old "owner_public_key" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldTokenName(ctx context.Context) (v string, err error) {
+func (m *TokenAllowlistEntryMutation) OldOwnerPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenName requires an ID field in the mutation")
+		return v, errors.New("OldOwnerPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerPublicKey: %w", err)
 	}
-	return oldValue.TokenName, nil
+	return oldValue.OwnerPublicKey, nil
 }
 
-// ResetTokenName resets all changes to the "token_name" field.
-func (m *TokenCreateMutation) ResetTokenName() {
-	m.token_name = nil
+// ResetOwnerPublicKey resets all changes to the "owner_public_key" field.
+func (m *TokenAllowlistEntryMutation) ResetOwnerPublicKey() {
+	m.owner_public_key = nil
 }
 
-// SetTokenTicker sets the "token_ticker" field.
-func (m *TokenCreateMutation) SetTokenTicker(s string) {
-	m.token_ticker = &s
+// SetIssuerSignature sets the "issuer_signature" field.
+func (m *TokenAllowlistEntryMutation) SetIssuerSignature(b []byte) {
+	m.issuer_signature = &b
 }
 
-// TokenTicker returns the value of the "token_ticker" field in the mutation.
-func (m *TokenCreateMutation) TokenTicker() (r string, exists bool) {
-	v := m.token_ticker
+// IssuerSignature returns the value of the "issuer_signature" field in the mutation.
+func (m *TokenAllowlistEntryMutation) IssuerSignature() (r []byte, exists bool) {
+	v := m.issuer_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenTicker returns the old "token_ticker" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldTokenTicker(ctx context.Context) (v string, err error) {
+func (m *TokenAllowlistEntryMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenTicker is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenTicker requires an ID field in the mutation")
+		return v, errors.New("OldIssuerSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenTicker: %w", err)
+		return v, fmt.Errorf("querying old value for OldIssuerSignature: %w", err)
 	}
-	return oldValue.TokenTicker, nil
+	return oldValue.IssuerSignature, nil
 }
 
-// ResetTokenTicker resets all changes to the "token_ticker" field.
-func (m *TokenCreateMutation) ResetTokenTicker() {
-	m.token_ticker = nil
+// ResetIssuerSignature resets all changes to the "issuer_signature" field.
+func (m *TokenAllowlistEntryMutation) ResetIssuerSignature() {
+	m.issuer_signature = nil
 }
 
-// SetDecimals sets the "decimals" field.
-func (m *TokenCreateMutation) SetDecimals(u uint8) {
-	m.decimals = &u
-	m.adddecimals = nil
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (m *TokenAllowlistEntryMutation) SetIssuerProvidedTimestamp(u uint64) {
+	m.issuer_provided_timestamp = &u
+	m.addissuer_provided_timestamp = nil
 }
 
-// Decimals returns the value of the "decimals" field in the mutation.
-func (m *TokenCreateMutation) Decimals() (r uint8, exists bool) {
-	v := m.decimals
+// IssuerProvidedTimestamp returns the value of the "issuer_provided_timestamp" field in the mutation.
+func (m *TokenAllowlistEntryMutation) IssuerProvidedTimestamp() (r uint64, exists bool) {
+	v := m.issuer_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDecimals returns the old "decimals" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuerProvidedTimestamp returns the old "issuer_provided_timestamp" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldDecimals(ctx context.Context) (v uint8, err error) {
+func (m *TokenAllowlistEntryMutation) OldIssuerProvidedTimestamp(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDecimals is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuerProvidedTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDecimals requires an ID field in the mutation")
+		return v, errors.New("OldIssuerProvidedTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDecimals: %w", err)
+		return v, fmt.Errorf("querying old value for OldIssuerProvidedTimestamp: %w", err)
 	}
-	return oldValue.Decimals, nil
+	return oldValue.IssuerProvidedTimestamp, nil
 }
 
-// AddDecimals adds u to the "decimals" field.
-func (m *TokenCreateMutation) AddDecimals(u int8) {
-	if m.adddecimals != nil {
-		*m.adddecimals += u
+// AddIssuerProvidedTimestamp adds u to the "issuer_provided_timestamp" field.
+func (m *TokenAllowlistEntryMutation) AddIssuerProvidedTimestamp(u int64) {
+	if m.addissuer_provided_timestamp != nil {
+		*m.addissuer_provided_timestamp += u
 	} else {
-		m.adddecimals = &u
+		m.addissuer_provided_timestamp = &u
 	}
 }
 
-// AddedDecimals returns the value that was added to the "decimals" field in this mutation.
-func (m *TokenCreateMutation) AddedDecimals() (r int8, exists bool) {
-	v := m.adddecimals
+// AddedIssuerProvidedTimestamp returns the value that was added to the "issuer_provided_timestamp" field in this mutation.
+func (m *TokenAllowlistEntryMutation) AddedIssuerProvidedTimestamp() (r int64, exists bool) {
+	v := m.addissuer_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDecimals resets all changes to the "decimals" field.
-func (m *TokenCreateMutation) ResetDecimals() {
-	m.decimals = nil
-	m.adddecimals = nil
+// ResetIssuerProvidedTimestamp resets all changes to the "issuer_provided_timestamp" field.
+func (m *TokenAllowlistEntryMutation) ResetIssuerProvidedTimestamp() {
+	m.issuer_provided_timestamp = nil
+	m.addissuer_provided_timestamp = nil
 }
 
-// SetMaxSupply sets the "max_supply" field.
-func (m *TokenCreateMutation) SetMaxSupply(b []byte) {
-	m.max_supply = &b
+// SetTokenCreateID sets the "token_create_id" field.
+func (m *TokenAllowlistEntryMutation) SetTokenCreateID(u uuid.UUID) {
+	m.token_create = &u
 }
 
-// MaxSupply returns the value of the "max_supply" field in the mutation.
-func (m *TokenCreateMutation) MaxSupply() (r []byte, exists bool) {
-	v := m.max_supply
+// TokenCreateID returns the value of the "token_create_id" field in the mutation.
+func (m *TokenAllowlistEntryMutation) TokenCreateID() (r uuid.UUID, exists bool) {
+	v := m.token_create
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxSupply returns the old "max_supply" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenCreateID returns the old "token_create_id" field's value of the TokenAllowlistEntry entity.
+// If the TokenAllowlistEntry object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldMaxSupply(ctx context.Context) (v []byte, err error) {
+func (m *TokenAllowlistEntryMutation) OldTokenCreateID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxSupply is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenCreateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxSupply requires an ID field in the mutation")
+		return v, errors.New("OldTokenCreateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxSupply: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenCreateID: %w", err)
 	}
-	return oldValue.MaxSupply, nil
+	return oldValue.TokenCreateID, nil
 }
 
-// ResetMaxSupply resets all changes to the "max_supply" field.
-func (m *TokenCreateMutation) ResetMaxSupply() {
-	m.max_supply = nil
+// ResetTokenCreateID resets all changes to the "token_create_id" field.
+func (m *TokenAllowlistEntryMutation) ResetTokenCreateID() {
+	m.token_create = nil
 }
 
-// SetIsFreezable sets the "is_freezable" field.
-func (m *TokenCreateMutation) SetIsFreezable(b bool) {
-	m.is_freezable = &b
+// ClearTokenCreate clears the "token_create" edge to the TokenCreate entity.
+func (m *TokenAllowlistEntryMutation) ClearTokenCreate() {
+	m.clearedtoken_create = true
+	m.clearedFields[tokenallowlistentry.FieldTokenCreateID] = struct{}{}
 }
 
-// IsFreezable returns the value of the "is_freezable" field in the mutation.
-func (m *TokenCreateMutation) IsFreezable() (r bool, exists bool) {
-	v := m.is_freezable
-	if v == nil {
-		return
-	}
-	return *v, true
+// TokenCreateCleared reports if the "token_create" edge to the TokenCreate entity was cleared.
+func (m *TokenAllowlistEntryMutation) TokenCreateCleared() bool {
+	return m.clearedtoken_create
 }
 
-// OldIsFreezable returns the old "is_freezable" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldIsFreezable(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsFreezable is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsFreezable requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsFreezable: %w", err)
+// TokenCreateIDs returns the "token_create" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenCreateID instead. It exists only for internal usage by the builders.
+func (m *TokenAllowlistEntryMutation) TokenCreateIDs() (ids []uuid.UUID) {
+	if id := m.token_create; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.IsFreezable, nil
+	return
 }
 
-// ResetIsFreezable resets all changes to the "is_freezable" field.
-func (m *TokenCreateMutation) ResetIsFreezable() {
-	m.is_freezable = nil
+// ResetTokenCreate resets all changes to the "token_create" edge.
+func (m *TokenAllowlistEntryMutation) ResetTokenCreate() {
+	m.token_create = nil
+	m.clearedtoken_create = false
 }
 
-// SetNetwork sets the "network" field.
-func (m *TokenCreateMutation) SetNetwork(s schematype.Network) {
-	m.network = &s
+// Where appends a list predicates to the TokenAllowlistEntryMutation builder.
+func (m *TokenAllowlistEntryMutation) Where(ps ...predicate.TokenAllowlistEntry) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// Network returns the value of the "network" field in the mutation.
-func (m *TokenCreateMutation) Network() (r schematype.Network, exists bool) {
-	v := m.network
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the TokenAllowlistEntryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenAllowlistEntryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenAllowlistEntry, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldNetwork returns the old "network" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldNetwork(ctx context.Context) (v schematype.Network, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNetwork requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
-	}
-	return oldValue.Network, nil
+// Op returns the operation name.
+func (m *TokenAllowlistEntryMutation) Op() Op {
+	return m.op
 }
 
-// ResetNetwork resets all changes to the "network" field.
-func (m *TokenCreateMutation) ResetNetwork() {
-	m.network = nil
+// SetOp allows setting the mutation operation.
+func (m *TokenAllowlistEntryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetTokenIdentifier sets the "token_identifier" field.
-func (m *TokenCreateMutation) SetTokenIdentifier(b []byte) {
-	m.token_identifier = &b
+// Type returns the node type of this mutation (TokenAllowlistEntry).
+func (m *TokenAllowlistEntryMutation) Type() string {
+	return m.typ
 }
 
-// TokenIdentifier returns the value of the "token_identifier" field in the mutation.
-func (m *TokenCreateMutation) TokenIdentifier() (r []byte, exists bool) {
-	v := m.token_identifier
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenAllowlistEntryMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, tokenallowlistentry.FieldCreateTime)
 	}
-	return *v, true
-}
-
-// OldTokenIdentifier returns the old "token_identifier" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldTokenIdentifier(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenIdentifier is only allowed on UpdateOne operations")
+	if m.update_time != nil {
+		fields = append(fields, tokenallowlistentry.FieldUpdateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenIdentifier requires an ID field in the mutation")
+	if m.status != nil {
+		fields = append(fields, tokenallowlistentry.FieldStatus)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenIdentifier: %w", err)
+	if m.owner_public_key != nil {
+		fields = append(fields, tokenallowlistentry.FieldOwnerPublicKey)
 	}
-	return oldValue.TokenIdentifier, nil
-}
-
-// ResetTokenIdentifier resets all changes to the "token_identifier" field.
-func (m *TokenCreateMutation) ResetTokenIdentifier() {
-	m.token_identifier = nil
+	if m.issuer_signature != nil {
+		fields = append(fields, tokenallowlistentry.FieldIssuerSignature)
+	}
+	if m.issuer_provided_timestamp != nil {
+		fields = append(fields, tokenallowlistentry.FieldIssuerProvidedTimestamp)
+	}
+	if m.token_create != nil {
+		fields = append(fields, tokenallowlistentry.FieldTokenCreateID)
+	}
+	return fields
 }
 
-// SetIssuerSignature sets the "issuer_signature" field.
-func (m *TokenCreateMutation) SetIssuerSignature(b []byte) {
-	m.issuer_signature = &b
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenAllowlistEntryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenallowlistentry.FieldCreateTime:
+		return m.CreateTime()
+	case tokenallowlistentry.FieldUpdateTime:
+		return m.UpdateTime()
+	case tokenallowlistentry.FieldStatus:
+		return m.Status()
+	case tokenallowlistentry.FieldOwnerPublicKey:
+		return m.OwnerPublicKey()
+	case tokenallowlistentry.FieldIssuerSignature:
+		return m.IssuerSignature()
+	case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+		return m.IssuerProvidedTimestamp()
+	case tokenallowlistentry.FieldTokenCreateID:
+		return m.TokenCreateID()
+	}
+	return nil, false
 }
 
-// IssuerSignature returns the value of the "issuer_signature" field in the mutation.
-func (m *TokenCreateMutation) IssuerSignature() (r []byte, exists bool) {
-	v := m.issuer_signature
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenAllowlistEntryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenallowlistentry.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tokenallowlistentry.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tokenallowlistentry.FieldStatus:
+		return m.OldStatus(ctx)
+	case tokenallowlistentry.FieldOwnerPublicKey:
+		return m.OldOwnerPublicKey(ctx)
+	case tokenallowlistentry.FieldIssuerSignature:
+		return m.OldIssuerSignature(ctx)
+	case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+		return m.OldIssuerProvidedTimestamp(ctx)
+	case tokenallowlistentry.FieldTokenCreateID:
+		return m.OldTokenCreateID(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown TokenAllowlistEntry field %s", name)
 }
 
-// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuerSignature requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuerSignature: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenAllowlistEntryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenallowlistentry.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tokenallowlistentry.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tokenallowlistentry.FieldStatus:
+		v, ok := value.(schematype.TokenAllowlistStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tokenallowlistentry.FieldOwnerPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerPublicKey(v)
+		return nil
+	case tokenallowlistentry.FieldIssuerSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerSignature(v)
+		return nil
+	case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerProvidedTimestamp(v)
+		return nil
+	case tokenallowlistentry.FieldTokenCreateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenCreateID(v)
+		return nil
 	}
-	return oldValue.IssuerSignature, nil
+	return fmt.Errorf("unknown TokenAllowlistEntry field %s", name)
 }
 
-// ClearIssuerSignature clears the value of the "issuer_signature" field.
-func (m *TokenCreateMutation) ClearIssuerSignature() {
-	m.issuer_signature = nil
-	m.clearedFields[tokencreate.FieldIssuerSignature] = struct{}{}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenAllowlistEntryMutation) AddedFields() []string {
+	var fields []string
+	if m.addissuer_provided_timestamp != nil {
+		fields = append(fields, tokenallowlistentry.FieldIssuerProvidedTimestamp)
+	}
+	return fields
 }
 
-// IssuerSignatureCleared returns if the "issuer_signature" field was cleared in this mutation.
-func (m *TokenCreateMutation) IssuerSignatureCleared() bool {
-	_, ok := m.clearedFields[tokencreate.FieldIssuerSignature]
-	return ok
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenAllowlistEntryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+		return m.AddedIssuerProvidedTimestamp()
+	}
+	return nil, false
 }
 
-// ResetIssuerSignature resets all changes to the "issuer_signature" field.
-func (m *TokenCreateMutation) ResetIssuerSignature() {
-	m.issuer_signature = nil
-	delete(m.clearedFields, tokencreate.FieldIssuerSignature)
-}
-
-// SetOperatorSpecificIssuerSignature sets the "operator_specific_issuer_signature" field.
-func (m *TokenCreateMutation) SetOperatorSpecificIssuerSignature(b []byte) {
-	m.operator_specific_issuer_signature = &b
-}
-
-// OperatorSpecificIssuerSignature returns the value of the "operator_specific_issuer_signature" field in the mutation.
-func (m *TokenCreateMutation) OperatorSpecificIssuerSignature() (r []byte, exists bool) {
-	v := m.operator_specific_issuer_signature
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOperatorSpecificIssuerSignature returns the old "operator_specific_issuer_signature" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldOperatorSpecificIssuerSignature(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperatorSpecificIssuerSignature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperatorSpecificIssuerSignature requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperatorSpecificIssuerSignature: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenAllowlistEntryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuerProvidedTimestamp(v)
+		return nil
 	}
-	return oldValue.OperatorSpecificIssuerSignature, nil
+	return fmt.Errorf("unknown TokenAllowlistEntry numeric field %s", name)
 }
 
-// ClearOperatorSpecificIssuerSignature clears the value of the "operator_specific_issuer_signature" field.
-func (m *TokenCreateMutation) ClearOperatorSpecificIssuerSignature() {
-	m.operator_specific_issuer_signature = nil
-	m.clearedFields[tokencreate.FieldOperatorSpecificIssuerSignature] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenAllowlistEntryMutation) ClearedFields() []string {
+	return nil
 }
 
-// OperatorSpecificIssuerSignatureCleared returns if the "operator_specific_issuer_signature" field was cleared in this mutation.
-func (m *TokenCreateMutation) OperatorSpecificIssuerSignatureCleared() bool {
-	_, ok := m.clearedFields[tokencreate.FieldOperatorSpecificIssuerSignature]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenAllowlistEntryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetOperatorSpecificIssuerSignature resets all changes to the "operator_specific_issuer_signature" field.
-func (m *TokenCreateMutation) ResetOperatorSpecificIssuerSignature() {
-	m.operator_specific_issuer_signature = nil
-	delete(m.clearedFields, tokencreate.FieldOperatorSpecificIssuerSignature)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenAllowlistEntryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TokenAllowlistEntry nullable field %s", name)
 }
 
-// SetCreationEntityPublicKey sets the "creation_entity_public_key" field.
-func (m *TokenCreateMutation) SetCreationEntityPublicKey(b []byte) {
-	m.creation_entity_public_key = &b
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenAllowlistEntryMutation) ResetField(name string) error {
+	switch name {
+	case tokenallowlistentry.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tokenallowlistentry.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tokenallowlistentry.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tokenallowlistentry.FieldOwnerPublicKey:
+		m.ResetOwnerPublicKey()
+		return nil
+	case tokenallowlistentry.FieldIssuerSignature:
+		m.ResetIssuerSignature()
+		return nil
+	case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+		m.ResetIssuerProvidedTimestamp()
+		return nil
+	case tokenallowlistentry.FieldTokenCreateID:
+		m.ResetTokenCreateID()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenAllowlistEntry field %s", name)
 }
 
-// CreationEntityPublicKey returns the value of the "creation_entity_public_key" field in the mutation.
-func (m *TokenCreateMutation) CreationEntityPublicKey() (r []byte, exists bool) {
-	v := m.creation_entity_public_key
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenAllowlistEntryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.token_create != nil {
+		edges = append(edges, tokenallowlistentry.EdgeTokenCreate)
 	}
-	return *v, true
+	return edges
 }
 
-// OldCreationEntityPublicKey returns the old "creation_entity_public_key" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldCreationEntityPublicKey(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreationEntityPublicKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreationEntityPublicKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreationEntityPublicKey: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenAllowlistEntryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tokenallowlistentry.EdgeTokenCreate:
+		if id := m.token_create; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.CreationEntityPublicKey, nil
+	return nil
 }
 
-// ResetCreationEntityPublicKey resets all changes to the "creation_entity_public_key" field.
-func (m *TokenCreateMutation) ResetCreationEntityPublicKey() {
-	m.creation_entity_public_key = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenAllowlistEntryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetWalletProvidedTimestamp sets the "wallet_provided_timestamp" field.
-func (m *TokenCreateMutation) SetWalletProvidedTimestamp(u uint64) {
-	m.wallet_provided_timestamp = &u
-	m.addwallet_provided_timestamp = nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenAllowlistEntryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// WalletProvidedTimestamp returns the value of the "wallet_provided_timestamp" field in the mutation.
-func (m *TokenCreateMutation) WalletProvidedTimestamp() (r uint64, exists bool) {
-	v := m.wallet_provided_timestamp
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenAllowlistEntryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtoken_create {
+		edges = append(edges, tokenallowlistentry.EdgeTokenCreate)
 	}
-	return *v, true
+	return edges
 }
 
-// OldWalletProvidedTimestamp returns the old "wallet_provided_timestamp" field's value of the TokenCreate entity.
-// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenCreateMutation) OldWalletProvidedTimestamp(ctx context.Context) (v uint64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWalletProvidedTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWalletProvidedTimestamp requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWalletProvidedTimestamp: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenAllowlistEntryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tokenallowlistentry.EdgeTokenCreate:
+		return m.clearedtoken_create
 	}
-	return oldValue.WalletProvidedTimestamp, nil
+	return false
 }
 
-// AddWalletProvidedTimestamp adds u to the "wallet_provided_timestamp" field.
-func (m *TokenCreateMutation) AddWalletProvidedTimestamp(u int64) {
-	if m.addwallet_provided_timestamp != nil {
-		*m.addwallet_provided_timestamp += u
-	} else {
-		m.addwallet_provided_timestamp = &u
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenAllowlistEntryMutation) ClearEdge(name string) error {
+	switch name {
+	case tokenallowlistentry.EdgeTokenCreate:
+		m.ClearTokenCreate()
+		return nil
 	}
+	return fmt.Errorf("unknown TokenAllowlistEntry unique edge %s", name)
 }
 
-// AddedWalletProvidedTimestamp returns the value that was added to the "wallet_provided_timestamp" field in this mutation.
-func (m *TokenCreateMutation) AddedWalletProvidedTimestamp() (r int64, exists bool) {
-	v := m.addwallet_provided_timestamp
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenAllowlistEntryMutation) ResetEdge(name string) error {
+	switch name {
+	case tokenallowlistentry.EdgeTokenCreate:
+		m.ResetTokenCreate()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenAllowlistEntry edge %s", name)
 }
 
-// ClearWalletProvidedTimestamp clears the value of the "wallet_provided_timestamp" field.
-func (m *TokenCreateMutation) ClearWalletProvidedTimestamp() {
-	m.wallet_provided_timestamp = nil
-	m.addwallet_provided_timestamp = nil
-	m.clearedFields[tokencreate.FieldWalletProvidedTimestamp] = struct{}{}
+// TokenCreateMutation represents an operation that mutates the TokenCreate nodes in the graph.
+type TokenCreateMutation struct {
+	config
+	op                                 Op
+	typ                                string
+	id                                 *uuid.UUID
+	create_time                        *time.Time
+	update_time                        *time.Time
+	issuer_public_key                  *[]byte
+	token_name                         *string
+	token_ticker                       *string
+	decimals                           *uint8
+	adddecimals                        *int8
+	max_supply                         *[]byte
+	is_freezable                       *bool
+	network                            *schematype.Network
+	token_identifier                   *[]byte
+	issuer_signature                   *[]byte
+	operator_specific_issuer_signature *[]byte
+	creation_entity_public_key         *[]byte
+	wallet_provided_timestamp          *uint64
+	addwallet_provided_timestamp       *int64
+	is_transfer_restricted             *bool
+	is_paused                          *bool
+	pause_issuer_signature             *[]byte
+	pause_issuer_provided_timestamp    *uint64
+	addpause_issuer_provided_timestamp *int64
+	clearedFields                      map[string]struct{}
+	token_transaction                  map[uuid.UUID]struct{}
+	removedtoken_transaction           map[uuid.UUID]struct{}
+	clearedtoken_transaction           bool
+	l1_token_create                    *uuid.UUID
+	clearedl1_token_create             bool
+	token_output                       map[uuid.UUID]struct{}
+	removedtoken_output                map[uuid.UUID]struct{}
+	clearedtoken_output                bool
+	token_freeze                       map[uuid.UUID]struct{}
+	removedtoken_freeze                map[uuid.UUID]struct{}
+	clearedtoken_freeze                bool
+	issuer_key_rotations               map[uuid.UUID]struct{}
+	removedissuer_key_rotations        map[uuid.UUID]struct{}
+	clearedissuer_key_rotations        bool
+	allowlist_entries                  map[uuid.UUID]struct{}
+	removedallowlist_entries           map[uuid.UUID]struct{}
+	clearedallowlist_entries           bool
+	done                               bool
+	oldValue                           func(context.Context) (*TokenCreate, error)
+	predicates                         []predicate.TokenCreate
 }
 
-// WalletProvidedTimestampCleared returns if the "wallet_provided_timestamp" field was cleared in this mutation.
-func (m *TokenCreateMutation) WalletProvidedTimestampCleared() bool {
-	_, ok := m.clearedFields[tokencreate.FieldWalletProvidedTimestamp]
-	return ok
-}
+var _ ent.Mutation = (*TokenCreateMutation)(nil)
 
-// ResetWalletProvidedTimestamp resets all changes to the "wallet_provided_timestamp" field.
-func (m *TokenCreateMutation) ResetWalletProvidedTimestamp() {
-	m.wallet_provided_timestamp = nil
-	m.addwallet_provided_timestamp = nil
-	delete(m.clearedFields, tokencreate.FieldWalletProvidedTimestamp)
-}
+// tokencreateOption allows management of the mutation configuration using functional options.
+type tokencreateOption func(*TokenCreateMutation)
 
-// AddTokenTransactionIDs adds the "token_transaction" edge to the TokenTransaction entity by ids.
-func (m *TokenCreateMutation) AddTokenTransactionIDs(ids ...uuid.UUID) {
-	if m.token_transaction == nil {
-		m.token_transaction = make(map[uuid.UUID]struct{})
+// newTokenCreateMutation creates new mutation for the TokenCreate entity.
+func newTokenCreateMutation(c config, op Op, opts ...tokencreateOption) *TokenCreateMutation {
+	m := &TokenCreateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenCreate,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		m.token_transaction[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// ClearTokenTransaction clears the "token_transaction" edge to the TokenTransaction entity.
-func (m *TokenCreateMutation) ClearTokenTransaction() {
-	m.clearedtoken_transaction = true
-}
-
-// TokenTransactionCleared reports if the "token_transaction" edge to the TokenTransaction entity was cleared.
-func (m *TokenCreateMutation) TokenTransactionCleared() bool {
-	return m.clearedtoken_transaction
+// withTokenCreateID sets the ID field of the mutation.
+func withTokenCreateID(id uuid.UUID) tokencreateOption {
+	return func(m *TokenCreateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenCreate
+		)
+		m.oldValue = func(ctx context.Context) (*TokenCreate, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenCreate.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// RemoveTokenTransactionIDs removes the "token_transaction" edge to the TokenTransaction entity by IDs.
-func (m *TokenCreateMutation) RemoveTokenTransactionIDs(ids ...uuid.UUID) {
-	if m.removedtoken_transaction == nil {
-		m.removedtoken_transaction = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.token_transaction, ids[i])
-		m.removedtoken_transaction[ids[i]] = struct{}{}
+// withTokenCreate sets the old TokenCreate of the mutation.
+func withTokenCreate(node *TokenCreate) tokencreateOption {
+	return func(m *TokenCreateMutation) {
+		m.oldValue = func(context.Context) (*TokenCreate, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// RemovedTokenTransaction returns the removed IDs of the "token_transaction" edge to the TokenTransaction entity.
-func (m *TokenCreateMutation) RemovedTokenTransactionIDs() (ids []uuid.UUID) {
-	for id := range m.removedtoken_transaction {
-		ids = append(ids, id)
-	}
-	return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenCreateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// TokenTransactionIDs returns the "token_transaction" edge IDs in the mutation.
-func (m *TokenCreateMutation) TokenTransactionIDs() (ids []uuid.UUID) {
-	for id := range m.token_transaction {
-		ids = append(ids, id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenCreateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetTokenTransaction resets all changes to the "token_transaction" edge.
-func (m *TokenCreateMutation) ResetTokenTransaction() {
-	m.token_transaction = nil
-	m.clearedtoken_transaction = false
-	m.removedtoken_transaction = nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenCreate entities.
+func (m *TokenCreateMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// SetL1TokenCreateID sets the "l1_token_create" edge to the L1TokenCreate entity by id.
-func (m *TokenCreateMutation) SetL1TokenCreateID(id uuid.UUID) {
-	m.l1_token_create = &id
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenCreateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ClearL1TokenCreate clears the "l1_token_create" edge to the L1TokenCreate entity.
-func (m *TokenCreateMutation) ClearL1TokenCreate() {
-	m.clearedl1_token_create = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenCreateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenCreate.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// L1TokenCreateCleared reports if the "l1_token_create" edge to the L1TokenCreate entity was cleared.
-func (m *TokenCreateMutation) L1TokenCreateCleared() bool {
-	return m.clearedl1_token_create
+// SetCreateTime sets the "create_time" field.
+func (m *TokenCreateMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// L1TokenCreateID returns the "l1_token_create" edge ID in the mutation.
-func (m *TokenCreateMutation) L1TokenCreateID() (id uuid.UUID, exists bool) {
-	if m.l1_token_create != nil {
-		return *m.l1_token_create, true
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TokenCreateMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// L1TokenCreateIDs returns the "l1_token_create" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// L1TokenCreateID instead. It exists only for internal usage by the builders.
-func (m *TokenCreateMutation) L1TokenCreateIDs() (ids []uuid.UUID) {
-	if id := m.l1_token_create; id != nil {
-		ids = append(ids, *id)
+// OldCreateTime returns the old "create_time" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetL1TokenCreate resets all changes to the "l1_token_create" edge.
-func (m *TokenCreateMutation) ResetL1TokenCreate() {
-	m.l1_token_create = nil
-	m.clearedl1_token_create = false
-}
-
-// AddTokenOutputIDs adds the "token_output" edge to the TokenOutput entity by ids.
-func (m *TokenCreateMutation) AddTokenOutputIDs(ids ...uuid.UUID) {
-	if m.token_output == nil {
-		m.token_output = make(map[uuid.UUID]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.token_output[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
+	return oldValue.CreateTime, nil
 }
 
-// ClearTokenOutput clears the "token_output" edge to the TokenOutput entity.
-func (m *TokenCreateMutation) ClearTokenOutput() {
-	m.clearedtoken_output = true
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TokenCreateMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// TokenOutputCleared reports if the "token_output" edge to the TokenOutput entity was cleared.
-func (m *TokenCreateMutation) TokenOutputCleared() bool {
-	return m.clearedtoken_output
+// SetUpdateTime sets the "update_time" field.
+func (m *TokenCreateMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// RemoveTokenOutputIDs removes the "token_output" edge to the TokenOutput entity by IDs.
-func (m *TokenCreateMutation) RemoveTokenOutputIDs(ids ...uuid.UUID) {
-	if m.removedtoken_output == nil {
-		m.removedtoken_output = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.token_output, ids[i])
-		m.removedtoken_output[ids[i]] = struct{}{}
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TokenCreateMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTokenOutput returns the removed IDs of the "token_output" edge to the TokenOutput entity.
-func (m *TokenCreateMutation) RemovedTokenOutputIDs() (ids []uuid.UUID) {
-	for id := range m.removedtoken_output {
-		ids = append(ids, id)
+// OldUpdateTime returns the old "update_time" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// TokenOutputIDs returns the "token_output" edge IDs in the mutation.
-func (m *TokenCreateMutation) TokenOutputIDs() (ids []uuid.UUID) {
-	for id := range m.token_output {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TokenCreateMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// ResetTokenOutput resets all changes to the "token_output" edge.
-func (m *TokenCreateMutation) ResetTokenOutput() {
-	m.token_output = nil
-	m.clearedtoken_output = false
-	m.removedtoken_output = nil
+// SetIssuerPublicKey sets the "issuer_public_key" field.
+func (m *TokenCreateMutation) SetIssuerPublicKey(b []byte) {
+	m.issuer_public_key = &b
 }
 
-// AddTokenFreezeIDs adds the "token_freeze" edge to the TokenFreeze entity by ids.
-func (m *TokenCreateMutation) AddTokenFreezeIDs(ids ...uuid.UUID) {
-	if m.token_freeze == nil {
-		m.token_freeze = make(map[uuid.UUID]struct{})
+// IssuerPublicKey returns the value of the "issuer_public_key" field in the mutation.
+func (m *TokenCreateMutation) IssuerPublicKey() (r []byte, exists bool) {
+	v := m.issuer_public_key
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.token_freeze[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldIssuerPublicKey returns the old "issuer_public_key" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldIssuerPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuerPublicKey is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuerPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerPublicKey: %w", err)
+	}
+	return oldValue.IssuerPublicKey, nil
 }
 
-// ClearTokenFreeze clears the "token_freeze" edge to the TokenFreeze entity.
-func (m *TokenCreateMutation) ClearTokenFreeze() {
-	m.clearedtoken_freeze = true
+// ResetIssuerPublicKey resets all changes to the "issuer_public_key" field.
+func (m *TokenCreateMutation) ResetIssuerPublicKey() {
+	m.issuer_public_key = nil
 }
 
-// TokenFreezeCleared reports if the "token_freeze" edge to the TokenFreeze entity was cleared.
-func (m *TokenCreateMutation) TokenFreezeCleared() bool {
-	return m.clearedtoken_freeze
+// SetTokenName sets the "token_name" field.
+func (m *TokenCreateMutation) SetTokenName(s string) {
+	m.token_name = &s
 }
 
-// RemoveTokenFreezeIDs removes the "token_freeze" edge to the TokenFreeze entity by IDs.
-func (m *TokenCreateMutation) RemoveTokenFreezeIDs(ids ...uuid.UUID) {
-	if m.removedtoken_freeze == nil {
-		m.removedtoken_freeze = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.token_freeze, ids[i])
-		m.removedtoken_freeze[ids[i]] = struct{}{}
+// TokenName returns the value of the "token_name" field in the mutation.
+func (m *TokenCreateMutation) TokenName() (r string, exists bool) {
+	v := m.token_name
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTokenFreeze returns the removed IDs of the "token_freeze" edge to the TokenFreeze entity.
-func (m *TokenCreateMutation) RemovedTokenFreezeIDs() (ids []uuid.UUID) {
-	for id := range m.removedtoken_freeze {
-		ids = append(ids, id)
+// OldTokenName returns the old "token_name" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldTokenName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenName is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenName: %w", err)
+	}
+	return oldValue.TokenName, nil
 }
 
-// TokenFreezeIDs returns the "token_freeze" edge IDs in the mutation.
-func (m *TokenCreateMutation) TokenFreezeIDs() (ids []uuid.UUID) {
-	for id := range m.token_freeze {
-		ids = append(ids, id)
-	}
-	return
+// ResetTokenName resets all changes to the "token_name" field.
+func (m *TokenCreateMutation) ResetTokenName() {
+	m.token_name = nil
 }
 
-// ResetTokenFreeze resets all changes to the "token_freeze" edge.
-func (m *TokenCreateMutation) ResetTokenFreeze() {
-	m.token_freeze = nil
-	m.clearedtoken_freeze = false
-	m.removedtoken_freeze = nil
+// SetTokenTicker sets the "token_ticker" field.
+func (m *TokenCreateMutation) SetTokenTicker(s string) {
+	m.token_ticker = &s
 }
 
-// Where appends a list predicates to the TokenCreateMutation builder.
-func (m *TokenCreateMutation) Where(ps ...predicate.TokenCreate) {
-	m.predicates = append(m.predicates, ps...)
+// TokenTicker returns the value of the "token_ticker" field in the mutation.
+func (m *TokenCreateMutation) TokenTicker() (r string, exists bool) {
+	v := m.token_ticker
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the TokenCreateMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenCreateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenCreate, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldTokenTicker returns the old "token_ticker" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldTokenTicker(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenTicker is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenTicker requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenTicker: %w", err)
+	}
+	return oldValue.TokenTicker, nil
 }
 
-// Op returns the operation name.
-func (m *TokenCreateMutation) Op() Op {
-	return m.op
+// ResetTokenTicker resets all changes to the "token_ticker" field.
+func (m *TokenCreateMutation) ResetTokenTicker() {
+	m.token_ticker = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TokenCreateMutation) SetOp(op Op) {
-	m.op = op
+// SetDecimals sets the "decimals" field.
+func (m *TokenCreateMutation) SetDecimals(u uint8) {
+	m.decimals = &u
+	m.adddecimals = nil
 }
 
-// Type returns the node type of this mutation (TokenCreate).
-func (m *TokenCreateMutation) Type() string {
-	return m.typ
+// Decimals returns the value of the "decimals" field in the mutation.
+func (m *TokenCreateMutation) Decimals() (r uint8, exists bool) {
+	v := m.decimals
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TokenCreateMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.create_time != nil {
-		fields = append(fields, tokencreate.FieldCreateTime)
+// OldDecimals returns the old "decimals" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldDecimals(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecimals is only allowed on UpdateOne operations")
 	}
-	if m.update_time != nil {
-		fields = append(fields, tokencreate.FieldUpdateTime)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecimals requires an ID field in the mutation")
 	}
-	if m.issuer_public_key != nil {
-		fields = append(fields, tokencreate.FieldIssuerPublicKey)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecimals: %w", err)
 	}
-	if m.token_name != nil {
-		fields = append(fields, tokencreate.FieldTokenName)
+	return oldValue.Decimals, nil
+}
+
+// AddDecimals adds u to the "decimals" field.
+func (m *TokenCreateMutation) AddDecimals(u int8) {
+	if m.adddecimals != nil {
+		*m.adddecimals += u
+	} else {
+		m.adddecimals = &u
 	}
-	if m.token_ticker != nil {
-		fields = append(fields, tokencreate.FieldTokenTicker)
+}
+
+// AddedDecimals returns the value that was added to the "decimals" field in this mutation.
+func (m *TokenCreateMutation) AddedDecimals() (r int8, exists bool) {
+	v := m.adddecimals
+	if v == nil {
+		return
 	}
-	if m.decimals != nil {
-		fields = append(fields, tokencreate.FieldDecimals)
+	return *v, true
+}
+
+// ResetDecimals resets all changes to the "decimals" field.
+func (m *TokenCreateMutation) ResetDecimals() {
+	m.decimals = nil
+	m.adddecimals = nil
+}
+
+// SetMaxSupply sets the "max_supply" field.
+func (m *TokenCreateMutation) SetMaxSupply(b []byte) {
+	m.max_supply = &b
+}
+
+// MaxSupply returns the value of the "max_supply" field in the mutation.
+func (m *TokenCreateMutation) MaxSupply() (r []byte, exists bool) {
+	v := m.max_supply
+	if v == nil {
+		return
 	}
-	if m.max_supply != nil {
-		fields = append(fields, tokencreate.FieldMaxSupply)
+	return *v, true
+}
+
+// OldMaxSupply returns the old "max_supply" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldMaxSupply(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSupply is only allowed on UpdateOne operations")
 	}
-	if m.is_freezable != nil {
-		fields = append(fields, tokencreate.FieldIsFreezable)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSupply requires an ID field in the mutation")
 	}
-	if m.network != nil {
-		fields = append(fields, tokencreate.FieldNetwork)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSupply: %w", err)
 	}
-	if m.token_identifier != nil {
-		fields = append(fields, tokencreate.FieldTokenIdentifier)
+	return oldValue.MaxSupply, nil
+}
+
+// ResetMaxSupply resets all changes to the "max_supply" field.
+func (m *TokenCreateMutation) ResetMaxSupply() {
+	m.max_supply = nil
+}
+
+// SetIsFreezable sets the "is_freezable" field.
+func (m *TokenCreateMutation) SetIsFreezable(b bool) {
+	m.is_freezable = &b
+}
+
+// IsFreezable returns the value of the "is_freezable" field in the mutation.
+func (m *TokenCreateMutation) IsFreezable() (r bool, exists bool) {
+	v := m.is_freezable
+	if v == nil {
+		return
 	}
-	if m.issuer_signature != nil {
-		fields = append(fields, tokencreate.FieldIssuerSignature)
+	return *v, true
+}
+
+// OldIsFreezable returns the old "is_freezable" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldIsFreezable(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsFreezable is only allowed on UpdateOne operations")
 	}
-	if m.operator_specific_issuer_signature != nil {
-		fields = append(fields, tokencreate.FieldOperatorSpecificIssuerSignature)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsFreezable requires an ID field in the mutation")
 	}
-	if m.creation_entity_public_key != nil {
-		fields = append(fields, tokencreate.FieldCreationEntityPublicKey)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsFreezable: %w", err)
 	}
-	if m.wallet_provided_timestamp != nil {
-		fields = append(fields, tokencreate.FieldWalletProvidedTimestamp)
+	return oldValue.IsFreezable, nil
+}
+
+// ResetIsFreezable resets all changes to the "is_freezable" field.
+func (m *TokenCreateMutation) ResetIsFreezable() {
+	m.is_freezable = nil
+}
+
+// SetNetwork sets the "network" field.
+func (m *TokenCreateMutation) SetNetwork(s schematype.Network) {
+	m.network = &s
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *TokenCreateMutation) Network() (r schematype.Network, exists bool) {
+	v := m.network
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TokenCreateMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tokencreate.FieldCreateTime:
-		return m.CreateTime()
-	case tokencreate.FieldUpdateTime:
-		return m.UpdateTime()
-	case tokencreate.FieldIssuerPublicKey:
-		return m.IssuerPublicKey()
-	case tokencreate.FieldTokenName:
-		return m.TokenName()
-	case tokencreate.FieldTokenTicker:
-		return m.TokenTicker()
-	case tokencreate.FieldDecimals:
-		return m.Decimals()
-	case tokencreate.FieldMaxSupply:
-		return m.MaxSupply()
-	case tokencreate.FieldIsFreezable:
-		return m.IsFreezable()
-	case tokencreate.FieldNetwork:
-		return m.Network()
-	case tokencreate.FieldTokenIdentifier:
-		return m.TokenIdentifier()
-	case tokencreate.FieldIssuerSignature:
-		return m.IssuerSignature()
-	case tokencreate.FieldOperatorSpecificIssuerSignature:
-		return m.OperatorSpecificIssuerSignature()
-	case tokencreate.FieldCreationEntityPublicKey:
-		return m.CreationEntityPublicKey()
-	case tokencreate.FieldWalletProvidedTimestamp:
-		return m.WalletProvidedTimestamp()
+// OldNetwork returns the old "network" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldNetwork(ctx context.Context) (v schematype.Network, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TokenCreateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case tokencreate.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case tokencreate.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case tokencreate.FieldIssuerPublicKey:
-		return m.OldIssuerPublicKey(ctx)
-	case tokencreate.FieldTokenName:
-		return m.OldTokenName(ctx)
-	case tokencreate.FieldTokenTicker:
-		return m.OldTokenTicker(ctx)
-	case tokencreate.FieldDecimals:
-		return m.OldDecimals(ctx)
-	case tokencreate.FieldMaxSupply:
-		return m.OldMaxSupply(ctx)
-	case tokencreate.FieldIsFreezable:
-		return m.OldIsFreezable(ctx)
-	case tokencreate.FieldNetwork:
-		return m.OldNetwork(ctx)
-	case tokencreate.FieldTokenIdentifier:
-		return m.OldTokenIdentifier(ctx)
-	case tokencreate.FieldIssuerSignature:
-		return m.OldIssuerSignature(ctx)
-	case tokencreate.FieldOperatorSpecificIssuerSignature:
-		return m.OldOperatorSpecificIssuerSignature(ctx)
-	case tokencreate.FieldCreationEntityPublicKey:
-		return m.OldCreationEntityPublicKey(ctx)
-	case tokencreate.FieldWalletProvidedTimestamp:
-		return m.OldWalletProvidedTimestamp(ctx)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
 	}
-	return nil, fmt.Errorf("unknown TokenCreate field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TokenCreateMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case tokencreate.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case tokencreate.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case tokencreate.FieldIssuerPublicKey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIssuerPublicKey(v)
-		return nil
-	case tokencreate.FieldTokenName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenName(v)
-		return nil
-	case tokencreate.FieldTokenTicker:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenTicker(v)
-		return nil
-	case tokencreate.FieldDecimals:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDecimals(v)
-		return nil
-	case tokencreate.FieldMaxSupply:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxSupply(v)
-		return nil
-	case tokencreate.FieldIsFreezable:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsFreezable(v)
-		return nil
-	case tokencreate.FieldNetwork:
-		v, ok := value.(schematype.Network)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNetwork(v)
-		return nil
-	case tokencreate.FieldTokenIdentifier:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenIdentifier(v)
-		return nil
-	case tokencreate.FieldIssuerSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIssuerSignature(v)
-		return nil
-	case tokencreate.FieldOperatorSpecificIssuerSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOperatorSpecificIssuerSignature(v)
-		return nil
-	case tokencreate.FieldCreationEntityPublicKey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreationEntityPublicKey(v)
-		return nil
-	case tokencreate.FieldWalletProvidedTimestamp:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWalletProvidedTimestamp(v)
-		return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
 	}
-	return fmt.Errorf("unknown TokenCreate field %s", name)
+	return oldValue.Network, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TokenCreateMutation) AddedFields() []string {
-	var fields []string
-	if m.adddecimals != nil {
-		fields = append(fields, tokencreate.FieldDecimals)
-	}
-	if m.addwallet_provided_timestamp != nil {
-		fields = append(fields, tokencreate.FieldWalletProvidedTimestamp)
-	}
-	return fields
+// ResetNetwork resets all changes to the "network" field.
+func (m *TokenCreateMutation) ResetNetwork() {
+	m.network = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TokenCreateMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case tokencreate.FieldDecimals:
-		return m.AddedDecimals()
-	case tokencreate.FieldWalletProvidedTimestamp:
-		return m.AddedWalletProvidedTimestamp()
-	}
-	return nil, false
+// SetTokenIdentifier sets the "token_identifier" field.
+func (m *TokenCreateMutation) SetTokenIdentifier(b []byte) {
+	m.token_identifier = &b
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TokenCreateMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case tokencreate.FieldDecimals:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDecimals(v)
-		return nil
-	case tokencreate.FieldWalletProvidedTimestamp:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWalletProvidedTimestamp(v)
-		return nil
+// TokenIdentifier returns the value of the "token_identifier" field in the mutation.
+func (m *TokenCreateMutation) TokenIdentifier() (r []byte, exists bool) {
+	v := m.token_identifier
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown TokenCreate numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TokenCreateMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(tokencreate.FieldIssuerSignature) {
-		fields = append(fields, tokencreate.FieldIssuerSignature)
+// OldTokenIdentifier returns the old "token_identifier" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldTokenIdentifier(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenIdentifier is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(tokencreate.FieldOperatorSpecificIssuerSignature) {
-		fields = append(fields, tokencreate.FieldOperatorSpecificIssuerSignature)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenIdentifier requires an ID field in the mutation")
 	}
-	if m.FieldCleared(tokencreate.FieldWalletProvidedTimestamp) {
-		fields = append(fields, tokencreate.FieldWalletProvidedTimestamp)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenIdentifier: %w", err)
 	}
-	return fields
+	return oldValue.TokenIdentifier, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TokenCreateMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetTokenIdentifier resets all changes to the "token_identifier" field.
+func (m *TokenCreateMutation) ResetTokenIdentifier() {
+	m.token_identifier = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TokenCreateMutation) ClearField(name string) error {
-	switch name {
-	case tokencreate.FieldIssuerSignature:
-		m.ClearIssuerSignature()
-		return nil
-	case tokencreate.FieldOperatorSpecificIssuerSignature:
-		m.ClearOperatorSpecificIssuerSignature()
-		return nil
-	case tokencreate.FieldWalletProvidedTimestamp:
-		m.ClearWalletProvidedTimestamp()
-		return nil
-	}
-	return fmt.Errorf("unknown TokenCreate nullable field %s", name)
+// SetIssuerSignature sets the "issuer_signature" field.
+func (m *TokenCreateMutation) SetIssuerSignature(b []byte) {
+	m.issuer_signature = &b
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TokenCreateMutation) ResetField(name string) error {
-	switch name {
-	case tokencreate.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case tokencreate.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case tokencreate.FieldIssuerPublicKey:
-		m.ResetIssuerPublicKey()
-		return nil
-	case tokencreate.FieldTokenName:
-		m.ResetTokenName()
-		return nil
-	case tokencreate.FieldTokenTicker:
-		m.ResetTokenTicker()
-		return nil
-	case tokencreate.FieldDecimals:
-		m.ResetDecimals()
-		return nil
-	case tokencreate.FieldMaxSupply:
-		m.ResetMaxSupply()
-		return nil
-	case tokencreate.FieldIsFreezable:
-		m.ResetIsFreezable()
-		return nil
-	case tokencreate.FieldNetwork:
-		m.ResetNetwork()
-		return nil
-	case tokencreate.FieldTokenIdentifier:
-		m.ResetTokenIdentifier()
-		return nil
-	case tokencreate.FieldIssuerSignature:
-		m.ResetIssuerSignature()
-		return nil
-	case tokencreate.FieldOperatorSpecificIssuerSignature:
-		m.ResetOperatorSpecificIssuerSignature()
-		return nil
-	case tokencreate.FieldCreationEntityPublicKey:
-		m.ResetCreationEntityPublicKey()
-		return nil
-	case tokencreate.FieldWalletProvidedTimestamp:
-		m.ResetWalletProvidedTimestamp()
-		return nil
+// IssuerSignature returns the value of the "issuer_signature" field in the mutation.
+func (m *TokenCreateMutation) IssuerSignature() (r []byte, exists bool) {
+	v := m.issuer_signature
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown TokenCreate field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenCreateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.token_transaction != nil {
-		edges = append(edges, tokencreate.EdgeTokenTransaction)
-	}
-	if m.l1_token_create != nil {
-		edges = append(edges, tokencreate.EdgeL1TokenCreate)
+// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
 	}
-	if m.token_output != nil {
-		edges = append(edges, tokencreate.EdgeTokenOutput)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuerSignature requires an ID field in the mutation")
 	}
-	if m.token_freeze != nil {
-		edges = append(edges, tokencreate.EdgeTokenFreeze)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerSignature: %w", err)
 	}
-	return edges
+	return oldValue.IssuerSignature, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TokenCreateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tokencreate.EdgeTokenTransaction:
-		ids := make([]ent.Value, 0, len(m.token_transaction))
-		for id := range m.token_transaction {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokencreate.EdgeL1TokenCreate:
-		if id := m.l1_token_create; id != nil {
-			return []ent.Value{*id}
-		}
-	case tokencreate.EdgeTokenOutput:
-		ids := make([]ent.Value, 0, len(m.token_output))
-		for id := range m.token_output {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokencreate.EdgeTokenFreeze:
-		ids := make([]ent.Value, 0, len(m.token_freeze))
-		for id := range m.token_freeze {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearIssuerSignature clears the value of the "issuer_signature" field.
+func (m *TokenCreateMutation) ClearIssuerSignature() {
+	m.issuer_signature = nil
+	m.clearedFields[tokencreate.FieldIssuerSignature] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenCreateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedtoken_transaction != nil {
-		edges = append(edges, tokencreate.EdgeTokenTransaction)
-	}
-	if m.removedtoken_output != nil {
-		edges = append(edges, tokencreate.EdgeTokenOutput)
-	}
-	if m.removedtoken_freeze != nil {
-		edges = append(edges, tokencreate.EdgeTokenFreeze)
-	}
-	return edges
+// IssuerSignatureCleared returns if the "issuer_signature" field was cleared in this mutation.
+func (m *TokenCreateMutation) IssuerSignatureCleared() bool {
+	_, ok := m.clearedFields[tokencreate.FieldIssuerSignature]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TokenCreateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tokencreate.EdgeTokenTransaction:
-		ids := make([]ent.Value, 0, len(m.removedtoken_transaction))
-		for id := range m.removedtoken_transaction {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokencreate.EdgeTokenOutput:
-		ids := make([]ent.Value, 0, len(m.removedtoken_output))
-		for id := range m.removedtoken_output {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokencreate.EdgeTokenFreeze:
-		ids := make([]ent.Value, 0, len(m.removedtoken_freeze))
-		for id := range m.removedtoken_freeze {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ResetIssuerSignature resets all changes to the "issuer_signature" field.
+func (m *TokenCreateMutation) ResetIssuerSignature() {
+	m.issuer_signature = nil
+	delete(m.clearedFields, tokencreate.FieldIssuerSignature)
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenCreateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedtoken_transaction {
-		edges = append(edges, tokencreate.EdgeTokenTransaction)
+// SetOperatorSpecificIssuerSignature sets the "operator_specific_issuer_signature" field.
+func (m *TokenCreateMutation) SetOperatorSpecificIssuerSignature(b []byte) {
+	m.operator_specific_issuer_signature = &b
+}
+
+// OperatorSpecificIssuerSignature returns the value of the "operator_specific_issuer_signature" field in the mutation.
+func (m *TokenCreateMutation) OperatorSpecificIssuerSignature() (r []byte, exists bool) {
+	v := m.operator_specific_issuer_signature
+	if v == nil {
+		return
 	}
-	if m.clearedl1_token_create {
-		edges = append(edges, tokencreate.EdgeL1TokenCreate)
+	return *v, true
+}
+
+// OldOperatorSpecificIssuerSignature returns the old "operator_specific_issuer_signature" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldOperatorSpecificIssuerSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorSpecificIssuerSignature is only allowed on UpdateOne operations")
 	}
-	if m.clearedtoken_output {
-		edges = append(edges, tokencreate.EdgeTokenOutput)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorSpecificIssuerSignature requires an ID field in the mutation")
 	}
-	if m.clearedtoken_freeze {
-		edges = append(edges, tokencreate.EdgeTokenFreeze)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorSpecificIssuerSignature: %w", err)
 	}
-	return edges
+	return oldValue.OperatorSpecificIssuerSignature, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TokenCreateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tokencreate.EdgeTokenTransaction:
-		return m.clearedtoken_transaction
-	case tokencreate.EdgeL1TokenCreate:
-		return m.clearedl1_token_create
-	case tokencreate.EdgeTokenOutput:
-		return m.clearedtoken_output
-	case tokencreate.EdgeTokenFreeze:
-		return m.clearedtoken_freeze
-	}
-	return false
+// ClearOperatorSpecificIssuerSignature clears the value of the "operator_specific_issuer_signature" field.
+func (m *TokenCreateMutation) ClearOperatorSpecificIssuerSignature() {
+	m.operator_specific_issuer_signature = nil
+	m.clearedFields[tokencreate.FieldOperatorSpecificIssuerSignature] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TokenCreateMutation) ClearEdge(name string) error {
-	switch name {
-	case tokencreate.EdgeL1TokenCreate:
-		m.ClearL1TokenCreate()
-		return nil
-	}
-	return fmt.Errorf("unknown TokenCreate unique edge %s", name)
+// OperatorSpecificIssuerSignatureCleared returns if the "operator_specific_issuer_signature" field was cleared in this mutation.
+func (m *TokenCreateMutation) OperatorSpecificIssuerSignatureCleared() bool {
+	_, ok := m.clearedFields[tokencreate.FieldOperatorSpecificIssuerSignature]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TokenCreateMutation) ResetEdge(name string) error {
-	switch name {
-	case tokencreate.EdgeTokenTransaction:
-		m.ResetTokenTransaction()
-		return nil
-	case tokencreate.EdgeL1TokenCreate:
-		m.ResetL1TokenCreate()
-		return nil
-	case tokencreate.EdgeTokenOutput:
-		m.ResetTokenOutput()
-		return nil
-	case tokencreate.EdgeTokenFreeze:
-		m.ResetTokenFreeze()
-		return nil
-	}
-	return fmt.Errorf("unknown TokenCreate edge %s", name)
+// ResetOperatorSpecificIssuerSignature resets all changes to the "operator_specific_issuer_signature" field.
+func (m *TokenCreateMutation) ResetOperatorSpecificIssuerSignature() {
+	m.operator_specific_issuer_signature = nil
+	delete(m.clearedFields, tokencreate.FieldOperatorSpecificIssuerSignature)
 }
 
-// TokenFreezeMutation represents an operation that mutates the TokenFreeze nodes in the graph.
-type TokenFreezeMutation struct {
-	config
-	op                                  Op
-	typ                                 string
-	id                                  *uuid.UUID
-	create_time                         *time.Time
-	update_time                         *time.Time
-	status                              *schematype.TokenFreezeStatus
-	owner_public_key                    *[]byte
-	token_public_key                    *[]byte
-	issuer_signature                    *[]byte
-	wallet_provided_freeze_timestamp    *uint64
-	addwallet_provided_freeze_timestamp *int64
-	wallet_provided_thaw_timestamp      *uint64
-	addwallet_provided_thaw_timestamp   *int64
-	clearedFields                       map[string]struct{}
-	token_create                        *uuid.UUID
-	clearedtoken_create                 bool
-	done                                bool
-	oldValue                            func(context.Context) (*TokenFreeze, error)
-	predicates                          []predicate.TokenFreeze
+// SetCreationEntityPublicKey sets the "creation_entity_public_key" field.
+func (m *TokenCreateMutation) SetCreationEntityPublicKey(b []byte) {
+	m.creation_entity_public_key = &b
 }
 
-var _ ent.Mutation = (*TokenFreezeMutation)(nil)
-
-// tokenfreezeOption allows management of the mutation configuration using functional options.
-type tokenfreezeOption func(*TokenFreezeMutation)
+// CreationEntityPublicKey returns the value of the "creation_entity_public_key" field in the mutation.
+func (m *TokenCreateMutation) CreationEntityPublicKey() (r []byte, exists bool) {
+	v := m.creation_entity_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newTokenFreezeMutation creates new mutation for the TokenFreeze entity.
-func newTokenFreezeMutation(c config, op Op, opts ...tokenfreezeOption) *TokenFreezeMutation {
-	m := &TokenFreezeMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTokenFreeze,
-		clearedFields: make(map[string]struct{}),
+// OldCreationEntityPublicKey returns the old "creation_entity_public_key" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldCreationEntityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreationEntityPublicKey is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreationEntityPublicKey requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreationEntityPublicKey: %w", err)
+	}
+	return oldValue.CreationEntityPublicKey, nil
 }
 
-// withTokenFreezeID sets the ID field of the mutation.
-func withTokenFreezeID(id uuid.UUID) tokenfreezeOption {
-	return func(m *TokenFreezeMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *TokenFreeze
-		)
-		m.oldValue = func(ctx context.Context) (*TokenFreeze, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().TokenFreeze.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetCreationEntityPublicKey resets all changes to the "creation_entity_public_key" field.
+func (m *TokenCreateMutation) ResetCreationEntityPublicKey() {
+	m.creation_entity_public_key = nil
 }
 
-// withTokenFreeze sets the old TokenFreeze of the mutation.
-func withTokenFreeze(node *TokenFreeze) tokenfreezeOption {
-	return func(m *TokenFreezeMutation) {
-		m.oldValue = func(context.Context) (*TokenFreeze, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetWalletProvidedTimestamp sets the "wallet_provided_timestamp" field.
+func (m *TokenCreateMutation) SetWalletProvidedTimestamp(u uint64) {
+	m.wallet_provided_timestamp = &u
+	m.addwallet_provided_timestamp = nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenFreezeMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// WalletProvidedTimestamp returns the value of the "wallet_provided_timestamp" field in the mutation.
+func (m *TokenCreateMutation) WalletProvidedTimestamp() (r uint64, exists bool) {
+	v := m.wallet_provided_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TokenFreezeMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldWalletProvidedTimestamp returns the old "wallet_provided_timestamp" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenCreateMutation) OldWalletProvidedTimestamp(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalletProvidedTimestamp is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalletProvidedTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalletProvidedTimestamp: %w", err)
+	}
+	return oldValue.WalletProvidedTimestamp, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenFreeze entities.
-func (m *TokenFreezeMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// AddWalletProvidedTimestamp adds u to the "wallet_provided_timestamp" field.
+func (m *TokenCreateMutation) AddWalletProvidedTimestamp(u int64) {
+	if m.addwallet_provided_timestamp != nil {
+		*m.addwallet_provided_timestamp += u
+	} else {
+		m.addwallet_provided_timestamp = &u
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TokenFreezeMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// AddedWalletProvidedTimestamp returns the value that was added to the "wallet_provided_timestamp" field in this mutation.
+func (m *TokenCreateMutation) AddedWalletProvidedTimestamp() (r int64, exists bool) {
+	v := m.addwallet_provided_timestamp
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TokenFreezeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenFreeze.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ClearWalletProvidedTimestamp clears the value of the "wallet_provided_timestamp" field.
+func (m *TokenCreateMutation) ClearWalletProvidedTimestamp() {
+	m.wallet_provided_timestamp = nil
+	m.addwallet_provided_timestamp = nil
+	m.clearedFields[tokencreate.FieldWalletProvidedTimestamp] = struct{}{}
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *TokenFreezeMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// WalletProvidedTimestampCleared returns if the "wallet_provided_timestamp" field was cleared in this mutation.
+func (m *TokenCreateMutation) WalletProvidedTimestampCleared() bool {
+	_, ok := m.clearedFields[tokencreate.FieldWalletProvidedTimestamp]
+	return ok
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenFreezeMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// ResetWalletProvidedTimestamp resets all changes to the "wallet_provided_timestamp" field.
+func (m *TokenCreateMutation) ResetWalletProvidedTimestamp() {
+	m.wallet_provided_timestamp = nil
+	m.addwallet_provided_timestamp = nil
+	delete(m.clearedFields, tokencreate.FieldWalletProvidedTimestamp)
+}
+
+// SetIsTransferRestricted sets the "is_transfer_restricted" field.
+func (m *TokenCreateMutation) SetIsTransferRestricted(b bool) {
+	m.is_transfer_restricted = &b
+}
+
+// IsTransferRestricted returns the value of the "is_transfer_restricted" field in the mutation.
+func (m *TokenCreateMutation) IsTransferRestricted() (r bool, exists bool) {
+	v := m.is_transfer_restricted
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// OldIsTransferRestricted returns the old "is_transfer_restricted" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenCreateMutation) OldIsTransferRestricted(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsTransferRestricted is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldIsTransferRestricted requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsTransferRestricted: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.IsTransferRestricted, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenFreezeMutation) ResetCreateTime() {
-	m.create_time = nil
+// ResetIsTransferRestricted resets all changes to the "is_transfer_restricted" field.
+func (m *TokenCreateMutation) ResetIsTransferRestricted() {
+	m.is_transfer_restricted = nil
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *TokenFreezeMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SetIsPaused sets the "is_paused" field.
+func (m *TokenCreateMutation) SetIsPaused(b bool) {
+	m.is_paused = &b
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenFreezeMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// IsPaused returns the value of the "is_paused" field in the mutation.
+func (m *TokenCreateMutation) IsPaused() (r bool, exists bool) {
+	v := m.is_paused
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// OldIsPaused returns the old "is_paused" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenCreateMutation) OldIsPaused(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsPaused is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldIsPaused requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsPaused: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.IsPaused, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenFreezeMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetIsPaused resets all changes to the "is_paused" field.
+func (m *TokenCreateMutation) ResetIsPaused() {
+	m.is_paused = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *TokenFreezeMutation) SetStatus(sfs schematype.TokenFreezeStatus) {
-	m.status = &sfs
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (m *TokenCreateMutation) SetPauseIssuerSignature(b []byte) {
+	m.pause_issuer_signature = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TokenFreezeMutation) Status() (r schematype.TokenFreezeStatus, exists bool) {
-	v := m.status
+// PauseIssuerSignature returns the value of the "pause_issuer_signature" field in the mutation.
+func (m *TokenCreateMutation) PauseIssuerSignature() (r []byte, exists bool) {
+	v := m.pause_issuer_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// OldPauseIssuerSignature returns the old "pause_issuer_signature" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldStatus(ctx context.Context) (v schematype.TokenFreezeStatus, err error) {
+func (m *TokenCreateMutation) OldPauseIssuerSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPauseIssuerSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPauseIssuerSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPauseIssuerSignature: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.PauseIssuerSignature, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TokenFreezeMutation) ResetStatus() {
-	m.status = nil
+// ClearPauseIssuerSignature clears the value of the "pause_issuer_signature" field.
+func (m *TokenCreateMutation) ClearPauseIssuerSignature() {
+	m.pause_issuer_signature = nil
+	m.clearedFields[tokencreate.FieldPauseIssuerSignature] = struct{}{}
 }
 
-// SetOwnerPublicKey sets the "owner_public_key" field.
-func (m *TokenFreezeMutation) SetOwnerPublicKey(b []byte) {
-	m.owner_public_key = &b
+// PauseIssuerSignatureCleared returns if the "pause_issuer_signature" field was cleared in this mutation.
+func (m *TokenCreateMutation) PauseIssuerSignatureCleared() bool {
+	_, ok := m.clearedFields[tokencreate.FieldPauseIssuerSignature]
+	return ok
 }
 
-// OwnerPublicKey returns the value of the "owner_public_key" field in the mutation.
-func (m *TokenFreezeMutation) OwnerPublicKey() (r []byte, exists bool) {
-	v := m.owner_public_key
+// ResetPauseIssuerSignature resets all changes to the "pause_issuer_signature" field.
+func (m *TokenCreateMutation) ResetPauseIssuerSignature() {
+	m.pause_issuer_signature = nil
+	delete(m.clearedFields, tokencreate.FieldPauseIssuerSignature)
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (m *TokenCreateMutation) SetPauseIssuerProvidedTimestamp(u uint64) {
+	m.pause_issuer_provided_timestamp = &u
+	m.addpause_issuer_provided_timestamp = nil
+}
+
+// PauseIssuerProvidedTimestamp returns the value of the "pause_issuer_provided_timestamp" field in the mutation.
+func (m *TokenCreateMutation) PauseIssuerProvidedTimestamp() (r uint64, exists bool) {
+	v := m.pause_issuer_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerPublicKey returns the old "owner_public_key" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// OldPauseIssuerProvidedTimestamp returns the old "pause_issuer_provided_timestamp" field's value of the TokenCreate entity.
+// If the TokenCreate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldOwnerPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenCreateMutation) OldPauseIssuerProvidedTimestamp(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPauseIssuerProvidedTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldPauseIssuerProvidedTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPauseIssuerProvidedTimestamp: %w", err)
 	}
-	return oldValue.OwnerPublicKey, nil
-}
-
-// ResetOwnerPublicKey resets all changes to the "owner_public_key" field.
-func (m *TokenFreezeMutation) ResetOwnerPublicKey() {
-	m.owner_public_key = nil
+	return oldValue.PauseIssuerProvidedTimestamp, nil
 }
 
-// SetTokenPublicKey sets the "token_public_key" field.
-func (m *TokenFreezeMutation) SetTokenPublicKey(b []byte) {
-	m.token_public_key = &b
+// AddPauseIssuerProvidedTimestamp adds u to the "pause_issuer_provided_timestamp" field.
+func (m *TokenCreateMutation) AddPauseIssuerProvidedTimestamp(u int64) {
+	if m.addpause_issuer_provided_timestamp != nil {
+		*m.addpause_issuer_provided_timestamp += u
+	} else {
+		m.addpause_issuer_provided_timestamp = &u
+	}
 }
 
-// TokenPublicKey returns the value of the "token_public_key" field in the mutation.
-func (m *TokenFreezeMutation) TokenPublicKey() (r []byte, exists bool) {
-	v := m.token_public_key
+// AddedPauseIssuerProvidedTimestamp returns the value that was added to the "pause_issuer_provided_timestamp" field in this mutation.
+func (m *TokenCreateMutation) AddedPauseIssuerProvidedTimestamp() (r int64, exists bool) {
+	v := m.addpause_issuer_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenPublicKey returns the old "token_public_key" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldTokenPublicKey(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenPublicKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenPublicKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenPublicKey: %w", err)
-	}
-	return oldValue.TokenPublicKey, nil
-}
-
-// ClearTokenPublicKey clears the value of the "token_public_key" field.
-func (m *TokenFreezeMutation) ClearTokenPublicKey() {
-	m.token_public_key = nil
-	m.clearedFields[tokenfreeze.FieldTokenPublicKey] = struct{}{}
+// ClearPauseIssuerProvidedTimestamp clears the value of the "pause_issuer_provided_timestamp" field.
+func (m *TokenCreateMutation) ClearPauseIssuerProvidedTimestamp() {
+	m.pause_issuer_provided_timestamp = nil
+	m.addpause_issuer_provided_timestamp = nil
+	m.clearedFields[tokencreate.FieldPauseIssuerProvidedTimestamp] = struct{}{}
 }
 
-// TokenPublicKeyCleared returns if the "token_public_key" field was cleared in this mutation.
-func (m *TokenFreezeMutation) TokenPublicKeyCleared() bool {
-	_, ok := m.clearedFields[tokenfreeze.FieldTokenPublicKey]
+// PauseIssuerProvidedTimestampCleared returns if the "pause_issuer_provided_timestamp" field was cleared in this mutation.
+func (m *TokenCreateMutation) PauseIssuerProvidedTimestampCleared() bool {
+	_, ok := m.clearedFields[tokencreate.FieldPauseIssuerProvidedTimestamp]
 	return ok
 }
 
-// ResetTokenPublicKey resets all changes to the "token_public_key" field.
-func (m *TokenFreezeMutation) ResetTokenPublicKey() {
-	m.token_public_key = nil
-	delete(m.clearedFields, tokenfreeze.FieldTokenPublicKey)
-}
-
-// SetIssuerSignature sets the "issuer_signature" field.
-func (m *TokenFreezeMutation) SetIssuerSignature(b []byte) {
-	m.issuer_signature = &b
-}
-
-// IssuerSignature returns the value of the "issuer_signature" field in the mutation.
-func (m *TokenFreezeMutation) IssuerSignature() (r []byte, exists bool) {
-	v := m.issuer_signature
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetPauseIssuerProvidedTimestamp resets all changes to the "pause_issuer_provided_timestamp" field.
+func (m *TokenCreateMutation) ResetPauseIssuerProvidedTimestamp() {
+	m.pause_issuer_provided_timestamp = nil
+	m.addpause_issuer_provided_timestamp = nil
+	delete(m.clearedFields, tokencreate.FieldPauseIssuerProvidedTimestamp)
 }
 
-// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuerSignature requires an ID field in the mutation")
+// AddTokenTransactionIDs adds the "token_transaction" edge to the TokenTransaction entity by ids.
+func (m *TokenCreateMutation) AddTokenTransactionIDs(ids ...uuid.UUID) {
+	if m.token_transaction == nil {
+		m.token_transaction = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuerSignature: %w", err)
+	for i := range ids {
+		m.token_transaction[ids[i]] = struct{}{}
 	}
-	return oldValue.IssuerSignature, nil
-}
-
-// ResetIssuerSignature resets all changes to the "issuer_signature" field.
-func (m *TokenFreezeMutation) ResetIssuerSignature() {
-	m.issuer_signature = nil
 }
 
-// SetWalletProvidedFreezeTimestamp sets the "wallet_provided_freeze_timestamp" field.
-func (m *TokenFreezeMutation) SetWalletProvidedFreezeTimestamp(u uint64) {
-	m.wallet_provided_freeze_timestamp = &u
-	m.addwallet_provided_freeze_timestamp = nil
+// ClearTokenTransaction clears the "token_transaction" edge to the TokenTransaction entity.
+func (m *TokenCreateMutation) ClearTokenTransaction() {
+	m.clearedtoken_transaction = true
 }
 
-// WalletProvidedFreezeTimestamp returns the value of the "wallet_provided_freeze_timestamp" field in the mutation.
-func (m *TokenFreezeMutation) WalletProvidedFreezeTimestamp() (r uint64, exists bool) {
-	v := m.wallet_provided_freeze_timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
+// TokenTransactionCleared reports if the "token_transaction" edge to the TokenTransaction entity was cleared.
+func (m *TokenCreateMutation) TokenTransactionCleared() bool {
+	return m.clearedtoken_transaction
 }
 
-// OldWalletProvidedFreezeTimestamp returns the old "wallet_provided_freeze_timestamp" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldWalletProvidedFreezeTimestamp(ctx context.Context) (v uint64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWalletProvidedFreezeTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWalletProvidedFreezeTimestamp requires an ID field in the mutation")
+// RemoveTokenTransactionIDs removes the "token_transaction" edge to the TokenTransaction entity by IDs.
+func (m *TokenCreateMutation) RemoveTokenTransactionIDs(ids ...uuid.UUID) {
+	if m.removedtoken_transaction == nil {
+		m.removedtoken_transaction = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWalletProvidedFreezeTimestamp: %w", err)
+	for i := range ids {
+		delete(m.token_transaction, ids[i])
+		m.removedtoken_transaction[ids[i]] = struct{}{}
 	}
-	return oldValue.WalletProvidedFreezeTimestamp, nil
 }
 
-// AddWalletProvidedFreezeTimestamp adds u to the "wallet_provided_freeze_timestamp" field.
-func (m *TokenFreezeMutation) AddWalletProvidedFreezeTimestamp(u int64) {
-	if m.addwallet_provided_freeze_timestamp != nil {
-		*m.addwallet_provided_freeze_timestamp += u
-	} else {
-		m.addwallet_provided_freeze_timestamp = &u
+// RemovedTokenTransaction returns the removed IDs of the "token_transaction" edge to the TokenTransaction entity.
+func (m *TokenCreateMutation) RemovedTokenTransactionIDs() (ids []uuid.UUID) {
+	for id := range m.removedtoken_transaction {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedWalletProvidedFreezeTimestamp returns the value that was added to the "wallet_provided_freeze_timestamp" field in this mutation.
-func (m *TokenFreezeMutation) AddedWalletProvidedFreezeTimestamp() (r int64, exists bool) {
-	v := m.addwallet_provided_freeze_timestamp
-	if v == nil {
-		return
+// TokenTransactionIDs returns the "token_transaction" edge IDs in the mutation.
+func (m *TokenCreateMutation) TokenTransactionIDs() (ids []uuid.UUID) {
+	for id := range m.token_transaction {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetWalletProvidedFreezeTimestamp resets all changes to the "wallet_provided_freeze_timestamp" field.
-func (m *TokenFreezeMutation) ResetWalletProvidedFreezeTimestamp() {
-	m.wallet_provided_freeze_timestamp = nil
-	m.addwallet_provided_freeze_timestamp = nil
+// ResetTokenTransaction resets all changes to the "token_transaction" edge.
+func (m *TokenCreateMutation) ResetTokenTransaction() {
+	m.token_transaction = nil
+	m.clearedtoken_transaction = false
+	m.removedtoken_transaction = nil
 }
 
-// SetWalletProvidedThawTimestamp sets the "wallet_provided_thaw_timestamp" field.
-func (m *TokenFreezeMutation) SetWalletProvidedThawTimestamp(u uint64) {
-	m.wallet_provided_thaw_timestamp = &u
-	m.addwallet_provided_thaw_timestamp = nil
+// SetL1TokenCreateID sets the "l1_token_create" edge to the L1TokenCreate entity by id.
+func (m *TokenCreateMutation) SetL1TokenCreateID(id uuid.UUID) {
+	m.l1_token_create = &id
 }
 
-// WalletProvidedThawTimestamp returns the value of the "wallet_provided_thaw_timestamp" field in the mutation.
-func (m *TokenFreezeMutation) WalletProvidedThawTimestamp() (r uint64, exists bool) {
-	v := m.wallet_provided_thaw_timestamp
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearL1TokenCreate clears the "l1_token_create" edge to the L1TokenCreate entity.
+func (m *TokenCreateMutation) ClearL1TokenCreate() {
+	m.clearedl1_token_create = true
 }
 
-// OldWalletProvidedThawTimestamp returns the old "wallet_provided_thaw_timestamp" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldWalletProvidedThawTimestamp(ctx context.Context) (v uint64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWalletProvidedThawTimestamp is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWalletProvidedThawTimestamp requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWalletProvidedThawTimestamp: %w", err)
-	}
-	return oldValue.WalletProvidedThawTimestamp, nil
+// L1TokenCreateCleared reports if the "l1_token_create" edge to the L1TokenCreate entity was cleared.
+func (m *TokenCreateMutation) L1TokenCreateCleared() bool {
+	return m.clearedl1_token_create
 }
 
-// AddWalletProvidedThawTimestamp adds u to the "wallet_provided_thaw_timestamp" field.
-func (m *TokenFreezeMutation) AddWalletProvidedThawTimestamp(u int64) {
-	if m.addwallet_provided_thaw_timestamp != nil {
-		*m.addwallet_provided_thaw_timestamp += u
-	} else {
-		m.addwallet_provided_thaw_timestamp = &u
+// L1TokenCreateID returns the "l1_token_create" edge ID in the mutation.
+func (m *TokenCreateMutation) L1TokenCreateID() (id uuid.UUID, exists bool) {
+	if m.l1_token_create != nil {
+		return *m.l1_token_create, true
 	}
+	return
 }
 
-// AddedWalletProvidedThawTimestamp returns the value that was added to the "wallet_provided_thaw_timestamp" field in this mutation.
-func (m *TokenFreezeMutation) AddedWalletProvidedThawTimestamp() (r int64, exists bool) {
-	v := m.addwallet_provided_thaw_timestamp
-	if v == nil {
-		return
+// L1TokenCreateIDs returns the "l1_token_create" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// L1TokenCreateID instead. It exists only for internal usage by the builders.
+func (m *TokenCreateMutation) L1TokenCreateIDs() (ids []uuid.UUID) {
+	if id := m.l1_token_create; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// ClearWalletProvidedThawTimestamp clears the value of the "wallet_provided_thaw_timestamp" field.
-func (m *TokenFreezeMutation) ClearWalletProvidedThawTimestamp() {
-	m.wallet_provided_thaw_timestamp = nil
-	m.addwallet_provided_thaw_timestamp = nil
-	m.clearedFields[tokenfreeze.FieldWalletProvidedThawTimestamp] = struct{}{}
+// ResetL1TokenCreate resets all changes to the "l1_token_create" edge.
+func (m *TokenCreateMutation) ResetL1TokenCreate() {
+	m.l1_token_create = nil
+	m.clearedl1_token_create = false
 }
 
-// WalletProvidedThawTimestampCleared returns if the "wallet_provided_thaw_timestamp" field was cleared in this mutation.
-func (m *TokenFreezeMutation) WalletProvidedThawTimestampCleared() bool {
-	_, ok := m.clearedFields[tokenfreeze.FieldWalletProvidedThawTimestamp]
-	return ok
+// AddTokenOutputIDs adds the "token_output" edge to the TokenOutput entity by ids.
+func (m *TokenCreateMutation) AddTokenOutputIDs(ids ...uuid.UUID) {
+	if m.token_output == nil {
+		m.token_output = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.token_output[ids[i]] = struct{}{}
+	}
 }
 
-// ResetWalletProvidedThawTimestamp resets all changes to the "wallet_provided_thaw_timestamp" field.
-func (m *TokenFreezeMutation) ResetWalletProvidedThawTimestamp() {
-	m.wallet_provided_thaw_timestamp = nil
-	m.addwallet_provided_thaw_timestamp = nil
-	delete(m.clearedFields, tokenfreeze.FieldWalletProvidedThawTimestamp)
+// ClearTokenOutput clears the "token_output" edge to the TokenOutput entity.
+func (m *TokenCreateMutation) ClearTokenOutput() {
+	m.clearedtoken_output = true
 }
 
-// SetTokenCreateID sets the "token_create_id" field.
-func (m *TokenFreezeMutation) SetTokenCreateID(u uuid.UUID) {
-	m.token_create = &u
+// TokenOutputCleared reports if the "token_output" edge to the TokenOutput entity was cleared.
+func (m *TokenCreateMutation) TokenOutputCleared() bool {
+	return m.clearedtoken_output
 }
 
-// TokenCreateID returns the value of the "token_create_id" field in the mutation.
-func (m *TokenFreezeMutation) TokenCreateID() (r uuid.UUID, exists bool) {
-	v := m.token_create
-	if v == nil {
-		return
+// RemoveTokenOutputIDs removes the "token_output" edge to the TokenOutput entity by IDs.
+func (m *TokenCreateMutation) RemoveTokenOutputIDs(ids ...uuid.UUID) {
+	if m.removedtoken_output == nil {
+		m.removedtoken_output = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.token_output, ids[i])
+		m.removedtoken_output[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTokenCreateID returns the old "token_create_id" field's value of the TokenFreeze entity.
-// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenFreezeMutation) OldTokenCreateID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenCreateID is only allowed on UpdateOne operations")
+// RemovedTokenOutput returns the removed IDs of the "token_output" edge to the TokenOutput entity.
+func (m *TokenCreateMutation) RemovedTokenOutputIDs() (ids []uuid.UUID) {
+	for id := range m.removedtoken_output {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenCreateID requires an ID field in the mutation")
+	return
+}
+
+// TokenOutputIDs returns the "token_output" edge IDs in the mutation.
+func (m *TokenCreateMutation) TokenOutputIDs() (ids []uuid.UUID) {
+	for id := range m.token_output {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenCreateID: %w", err)
+	return
+}
+
+// ResetTokenOutput resets all changes to the "token_output" edge.
+func (m *TokenCreateMutation) ResetTokenOutput() {
+	m.token_output = nil
+	m.clearedtoken_output = false
+	m.removedtoken_output = nil
+}
+
+// AddTokenFreezeIDs adds the "token_freeze" edge to the TokenFreeze entity by ids.
+func (m *TokenCreateMutation) AddTokenFreezeIDs(ids ...uuid.UUID) {
+	if m.token_freeze == nil {
+		m.token_freeze = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.token_freeze[ids[i]] = struct{}{}
 	}
-	return oldValue.TokenCreateID, nil
 }
 
-// ClearTokenCreateID clears the value of the "token_create_id" field.
-func (m *TokenFreezeMutation) ClearTokenCreateID() {
-	m.token_create = nil
-	m.clearedFields[tokenfreeze.FieldTokenCreateID] = struct{}{}
+// ClearTokenFreeze clears the "token_freeze" edge to the TokenFreeze entity.
+func (m *TokenCreateMutation) ClearTokenFreeze() {
+	m.clearedtoken_freeze = true
 }
 
-// TokenCreateIDCleared returns if the "token_create_id" field was cleared in this mutation.
-func (m *TokenFreezeMutation) TokenCreateIDCleared() bool {
-	_, ok := m.clearedFields[tokenfreeze.FieldTokenCreateID]
-	return ok
+// TokenFreezeCleared reports if the "token_freeze" edge to the TokenFreeze entity was cleared.
+func (m *TokenCreateMutation) TokenFreezeCleared() bool {
+	return m.clearedtoken_freeze
 }
 
-// ResetTokenCreateID resets all changes to the "token_create_id" field.
-func (m *TokenFreezeMutation) ResetTokenCreateID() {
-	m.token_create = nil
-	delete(m.clearedFields, tokenfreeze.FieldTokenCreateID)
+// RemoveTokenFreezeIDs removes the "token_freeze" edge to the TokenFreeze entity by IDs.
+func (m *TokenCreateMutation) RemoveTokenFreezeIDs(ids ...uuid.UUID) {
+	if m.removedtoken_freeze == nil {
+		m.removedtoken_freeze = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.token_freeze, ids[i])
+		m.removedtoken_freeze[ids[i]] = struct{}{}
+	}
 }
 
-// ClearTokenCreate clears the "token_create" edge to the TokenCreate entity.
-func (m *TokenFreezeMutation) ClearTokenCreate() {
-	m.clearedtoken_create = true
-	m.clearedFields[tokenfreeze.FieldTokenCreateID] = struct{}{}
+// RemovedTokenFreeze returns the removed IDs of the "token_freeze" edge to the TokenFreeze entity.
+func (m *TokenCreateMutation) RemovedTokenFreezeIDs() (ids []uuid.UUID) {
+	for id := range m.removedtoken_freeze {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TokenCreateCleared reports if the "token_create" edge to the TokenCreate entity was cleared.
-func (m *TokenFreezeMutation) TokenCreateCleared() bool {
-	return m.TokenCreateIDCleared() || m.clearedtoken_create
+// TokenFreezeIDs returns the "token_freeze" edge IDs in the mutation.
+func (m *TokenCreateMutation) TokenFreezeIDs() (ids []uuid.UUID) {
+	for id := range m.token_freeze {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// TokenCreateIDs returns the "token_create" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenCreateID instead. It exists only for internal usage by the builders.
-func (m *TokenFreezeMutation) TokenCreateIDs() (ids []uuid.UUID) {
-	if id := m.token_create; id != nil {
-		ids = append(ids, *id)
+// ResetTokenFreeze resets all changes to the "token_freeze" edge.
+func (m *TokenCreateMutation) ResetTokenFreeze() {
+	m.token_freeze = nil
+	m.clearedtoken_freeze = false
+	m.removedtoken_freeze = nil
+}
+
+// AddIssuerKeyRotationIDs adds the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity by ids.
+func (m *TokenCreateMutation) AddIssuerKeyRotationIDs(ids ...uuid.UUID) {
+	if m.issuer_key_rotations == nil {
+		m.issuer_key_rotations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.issuer_key_rotations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIssuerKeyRotations clears the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity.
+func (m *TokenCreateMutation) ClearIssuerKeyRotations() {
+	m.clearedissuer_key_rotations = true
+}
+
+// IssuerKeyRotationsCleared reports if the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity was cleared.
+func (m *TokenCreateMutation) IssuerKeyRotationsCleared() bool {
+	return m.clearedissuer_key_rotations
+}
+
+// RemoveIssuerKeyRotationIDs removes the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity by IDs.
+func (m *TokenCreateMutation) RemoveIssuerKeyRotationIDs(ids ...uuid.UUID) {
+	if m.removedissuer_key_rotations == nil {
+		m.removedissuer_key_rotations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.issuer_key_rotations, ids[i])
+		m.removedissuer_key_rotations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIssuerKeyRotations returns the removed IDs of the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity.
+func (m *TokenCreateMutation) RemovedIssuerKeyRotationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedissuer_key_rotations {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTokenCreate resets all changes to the "token_create" edge.
-func (m *TokenFreezeMutation) ResetTokenCreate() {
-	m.token_create = nil
-	m.clearedtoken_create = false
+// IssuerKeyRotationsIDs returns the "issuer_key_rotations" edge IDs in the mutation.
+func (m *TokenCreateMutation) IssuerKeyRotationsIDs() (ids []uuid.UUID) {
+	for id := range m.issuer_key_rotations {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the TokenFreezeMutation builder.
-func (m *TokenFreezeMutation) Where(ps ...predicate.TokenFreeze) {
+// ResetIssuerKeyRotations resets all changes to the "issuer_key_rotations" edge.
+func (m *TokenCreateMutation) ResetIssuerKeyRotations() {
+	m.issuer_key_rotations = nil
+	m.clearedissuer_key_rotations = false
+	m.removedissuer_key_rotations = nil
+}
+
+// AddAllowlistEntryIDs adds the "allowlist_entries" edge to the TokenAllowlistEntry entity by ids.
+func (m *TokenCreateMutation) AddAllowlistEntryIDs(ids ...uuid.UUID) {
+	if m.allowlist_entries == nil {
+		m.allowlist_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.allowlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAllowlistEntries clears the "allowlist_entries" edge to the TokenAllowlistEntry entity.
+func (m *TokenCreateMutation) ClearAllowlistEntries() {
+	m.clearedallowlist_entries = true
+}
+
+// AllowlistEntriesCleared reports if the "allowlist_entries" edge to the TokenAllowlistEntry entity was cleared.
+func (m *TokenCreateMutation) AllowlistEntriesCleared() bool {
+	return m.clearedallowlist_entries
+}
+
+// RemoveAllowlistEntryIDs removes the "allowlist_entries" edge to the TokenAllowlistEntry entity by IDs.
+func (m *TokenCreateMutation) RemoveAllowlistEntryIDs(ids ...uuid.UUID) {
+	if m.removedallowlist_entries == nil {
+		m.removedallowlist_entries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.allowlist_entries, ids[i])
+		m.removedallowlist_entries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAllowlistEntries returns the removed IDs of the "allowlist_entries" edge to the TokenAllowlistEntry entity.
+func (m *TokenCreateMutation) RemovedAllowlistEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedallowlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AllowlistEntriesIDs returns the "allowlist_entries" edge IDs in the mutation.
+func (m *TokenCreateMutation) AllowlistEntriesIDs() (ids []uuid.UUID) {
+	for id := range m.allowlist_entries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAllowlistEntries resets all changes to the "allowlist_entries" edge.
+func (m *TokenCreateMutation) ResetAllowlistEntries() {
+	m.allowlist_entries = nil
+	m.clearedallowlist_entries = false
+	m.removedallowlist_entries = nil
+}
+
+// Where appends a list predicates to the TokenCreateMutation builder.
+func (m *TokenCreateMutation) Where(ps ...predicate.TokenCreate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenFreezeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenCreateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenFreezeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenFreeze, len(ps))
+func (m *TokenCreateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenCreate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -11388,51 +11827,78 @@ func (m *TokenFreezeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenFreezeMutation) Op() Op {
+func (m *TokenCreateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenFreezeMutation) SetOp(op Op) {
+func (m *TokenCreateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TokenFreeze).
-func (m *TokenFreezeMutation) Type() string {
+// Type returns the node type of this mutation (TokenCreate).
+func (m *TokenCreateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenFreezeMutation) Fields() []string {
-	fields := make([]string, 0, 9)
+func (m *TokenCreateMutation) Fields() []string {
+	fields := make([]string, 0, 18)
 	if m.create_time != nil {
-		fields = append(fields, tokenfreeze.FieldCreateTime)
+		fields = append(fields, tokencreate.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tokenfreeze.FieldUpdateTime)
+		fields = append(fields, tokencreate.FieldUpdateTime)
 	}
-	if m.status != nil {
-		fields = append(fields, tokenfreeze.FieldStatus)
+	if m.issuer_public_key != nil {
+		fields = append(fields, tokencreate.FieldIssuerPublicKey)
 	}
-	if m.owner_public_key != nil {
-		fields = append(fields, tokenfreeze.FieldOwnerPublicKey)
+	if m.token_name != nil {
+		fields = append(fields, tokencreate.FieldTokenName)
 	}
-	if m.token_public_key != nil {
-		fields = append(fields, tokenfreeze.FieldTokenPublicKey)
+	if m.token_ticker != nil {
+		fields = append(fields, tokencreate.FieldTokenTicker)
+	}
+	if m.decimals != nil {
+		fields = append(fields, tokencreate.FieldDecimals)
+	}
+	if m.max_supply != nil {
+		fields = append(fields, tokencreate.FieldMaxSupply)
+	}
+	if m.is_freezable != nil {
+		fields = append(fields, tokencreate.FieldIsFreezable)
+	}
+	if m.network != nil {
+		fields = append(fields, tokencreate.FieldNetwork)
+	}
+	if m.token_identifier != nil {
+		fields = append(fields, tokencreate.FieldTokenIdentifier)
 	}
 	if m.issuer_signature != nil {
-		fields = append(fields, tokenfreeze.FieldIssuerSignature)
+		fields = append(fields, tokencreate.FieldIssuerSignature)
 	}
-	if m.wallet_provided_freeze_timestamp != nil {
-		fields = append(fields, tokenfreeze.FieldWalletProvidedFreezeTimestamp)
+	if m.operator_specific_issuer_signature != nil {
+		fields = append(fields, tokencreate.FieldOperatorSpecificIssuerSignature)
 	}
-	if m.wallet_provided_thaw_timestamp != nil {
-		fields = append(fields, tokenfreeze.FieldWalletProvidedThawTimestamp)
+	if m.creation_entity_public_key != nil {
+		fields = append(fields, tokencreate.FieldCreationEntityPublicKey)
 	}
-	if m.token_create != nil {
-		fields = append(fields, tokenfreeze.FieldTokenCreateID)
+	if m.wallet_provided_timestamp != nil {
+		fields = append(fields, tokencreate.FieldWalletProvidedTimestamp)
+	}
+	if m.is_transfer_restricted != nil {
+		fields = append(fields, tokencreate.FieldIsTransferRestricted)
+	}
+	if m.is_paused != nil {
+		fields = append(fields, tokencreate.FieldIsPaused)
+	}
+	if m.pause_issuer_signature != nil {
+		fields = append(fields, tokencreate.FieldPauseIssuerSignature)
+	}
+	if m.pause_issuer_provided_timestamp != nil {
+		fields = append(fields, tokencreate.FieldPauseIssuerProvidedTimestamp)
 	}
 	return fields
 }
@@ -11440,138 +11906,240 @@ func (m *TokenFreezeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenFreezeMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenCreateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tokenfreeze.FieldCreateTime:
+	case tokencreate.FieldCreateTime:
 		return m.CreateTime()
-	case tokenfreeze.FieldUpdateTime:
+	case tokencreate.FieldUpdateTime:
 		return m.UpdateTime()
-	case tokenfreeze.FieldStatus:
-		return m.Status()
-	case tokenfreeze.FieldOwnerPublicKey:
-		return m.OwnerPublicKey()
-	case tokenfreeze.FieldTokenPublicKey:
-		return m.TokenPublicKey()
-	case tokenfreeze.FieldIssuerSignature:
-		return m.IssuerSignature()
-	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
-		return m.WalletProvidedFreezeTimestamp()
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
-		return m.WalletProvidedThawTimestamp()
-	case tokenfreeze.FieldTokenCreateID:
-		return m.TokenCreateID()
-	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
+	case tokencreate.FieldIssuerPublicKey:
+		return m.IssuerPublicKey()
+	case tokencreate.FieldTokenName:
+		return m.TokenName()
+	case tokencreate.FieldTokenTicker:
+		return m.TokenTicker()
+	case tokencreate.FieldDecimals:
+		return m.Decimals()
+	case tokencreate.FieldMaxSupply:
+		return m.MaxSupply()
+	case tokencreate.FieldIsFreezable:
+		return m.IsFreezable()
+	case tokencreate.FieldNetwork:
+		return m.Network()
+	case tokencreate.FieldTokenIdentifier:
+		return m.TokenIdentifier()
+	case tokencreate.FieldIssuerSignature:
+		return m.IssuerSignature()
+	case tokencreate.FieldOperatorSpecificIssuerSignature:
+		return m.OperatorSpecificIssuerSignature()
+	case tokencreate.FieldCreationEntityPublicKey:
+		return m.CreationEntityPublicKey()
+	case tokencreate.FieldWalletProvidedTimestamp:
+		return m.WalletProvidedTimestamp()
+	case tokencreate.FieldIsTransferRestricted:
+		return m.IsTransferRestricted()
+	case tokencreate.FieldIsPaused:
+		return m.IsPaused()
+	case tokencreate.FieldPauseIssuerSignature:
+		return m.PauseIssuerSignature()
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
+		return m.PauseIssuerProvidedTimestamp()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenFreezeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenCreateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tokenfreeze.FieldCreateTime:
+	case tokencreate.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tokenfreeze.FieldUpdateTime:
+	case tokencreate.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tokenfreeze.FieldStatus:
-		return m.OldStatus(ctx)
-	case tokenfreeze.FieldOwnerPublicKey:
-		return m.OldOwnerPublicKey(ctx)
-	case tokenfreeze.FieldTokenPublicKey:
-		return m.OldTokenPublicKey(ctx)
-	case tokenfreeze.FieldIssuerSignature:
+	case tokencreate.FieldIssuerPublicKey:
+		return m.OldIssuerPublicKey(ctx)
+	case tokencreate.FieldTokenName:
+		return m.OldTokenName(ctx)
+	case tokencreate.FieldTokenTicker:
+		return m.OldTokenTicker(ctx)
+	case tokencreate.FieldDecimals:
+		return m.OldDecimals(ctx)
+	case tokencreate.FieldMaxSupply:
+		return m.OldMaxSupply(ctx)
+	case tokencreate.FieldIsFreezable:
+		return m.OldIsFreezable(ctx)
+	case tokencreate.FieldNetwork:
+		return m.OldNetwork(ctx)
+	case tokencreate.FieldTokenIdentifier:
+		return m.OldTokenIdentifier(ctx)
+	case tokencreate.FieldIssuerSignature:
 		return m.OldIssuerSignature(ctx)
-	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
-		return m.OldWalletProvidedFreezeTimestamp(ctx)
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
-		return m.OldWalletProvidedThawTimestamp(ctx)
-	case tokenfreeze.FieldTokenCreateID:
-		return m.OldTokenCreateID(ctx)
+	case tokencreate.FieldOperatorSpecificIssuerSignature:
+		return m.OldOperatorSpecificIssuerSignature(ctx)
+	case tokencreate.FieldCreationEntityPublicKey:
+		return m.OldCreationEntityPublicKey(ctx)
+	case tokencreate.FieldWalletProvidedTimestamp:
+		return m.OldWalletProvidedTimestamp(ctx)
+	case tokencreate.FieldIsTransferRestricted:
+		return m.OldIsTransferRestricted(ctx)
+	case tokencreate.FieldIsPaused:
+		return m.OldIsPaused(ctx)
+	case tokencreate.FieldPauseIssuerSignature:
+		return m.OldPauseIssuerSignature(ctx)
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
+		return m.OldPauseIssuerProvidedTimestamp(ctx)
 	}
-	return nil, fmt.Errorf("unknown TokenFreeze field %s", name)
+	return nil, fmt.Errorf("unknown TokenCreate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenFreezeMutation) SetField(name string, value ent.Value) error {
+func (m *TokenCreateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tokenfreeze.FieldCreateTime:
+	case tokencreate.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tokenfreeze.FieldUpdateTime:
+	case tokencreate.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tokenfreeze.FieldStatus:
-		v, ok := value.(schematype.TokenFreezeStatus)
+	case tokencreate.FieldIssuerPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetIssuerPublicKey(v)
 		return nil
-	case tokenfreeze.FieldOwnerPublicKey:
+	case tokencreate.FieldTokenName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenName(v)
+		return nil
+	case tokencreate.FieldTokenTicker:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenTicker(v)
+		return nil
+	case tokencreate.FieldDecimals:
+		v, ok := value.(uint8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecimals(v)
+		return nil
+	case tokencreate.FieldMaxSupply:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerPublicKey(v)
+		m.SetMaxSupply(v)
 		return nil
-	case tokenfreeze.FieldTokenPublicKey:
+	case tokencreate.FieldIsFreezable:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsFreezable(v)
+		return nil
+	case tokencreate.FieldNetwork:
+		v, ok := value.(schematype.Network)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	case tokencreate.FieldTokenIdentifier:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenPublicKey(v)
+		m.SetTokenIdentifier(v)
 		return nil
-	case tokenfreeze.FieldIssuerSignature:
+	case tokencreate.FieldIssuerSignature:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIssuerSignature(v)
 		return nil
-	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
-		v, ok := value.(uint64)
+	case tokencreate.FieldOperatorSpecificIssuerSignature:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWalletProvidedFreezeTimestamp(v)
+		m.SetOperatorSpecificIssuerSignature(v)
 		return nil
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+	case tokencreate.FieldCreationEntityPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreationEntityPublicKey(v)
+		return nil
+	case tokencreate.FieldWalletProvidedTimestamp:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWalletProvidedThawTimestamp(v)
+		m.SetWalletProvidedTimestamp(v)
 		return nil
-	case tokenfreeze.FieldTokenCreateID:
-		v, ok := value.(uuid.UUID)
+	case tokencreate.FieldIsTransferRestricted:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenCreateID(v)
+		m.SetIsTransferRestricted(v)
+		return nil
+	case tokencreate.FieldIsPaused:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPaused(v)
+		return nil
+	case tokencreate.FieldPauseIssuerSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPauseIssuerSignature(v)
+		return nil
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPauseIssuerProvidedTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenFreeze field %s", name)
+	return fmt.Errorf("unknown TokenCreate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenFreezeMutation) AddedFields() []string {
+func (m *TokenCreateMutation) AddedFields() []string {
 	var fields []string
-	if m.addwallet_provided_freeze_timestamp != nil {
-		fields = append(fields, tokenfreeze.FieldWalletProvidedFreezeTimestamp)
+	if m.adddecimals != nil {
+		fields = append(fields, tokencreate.FieldDecimals)
 	}
-	if m.addwallet_provided_thaw_timestamp != nil {
-		fields = append(fields, tokenfreeze.FieldWalletProvidedThawTimestamp)
+	if m.addwallet_provided_timestamp != nil {
+		fields = append(fields, tokencreate.FieldWalletProvidedTimestamp)
+	}
+	if m.addpause_issuer_provided_timestamp != nil {
+		fields = append(fields, tokencreate.FieldPauseIssuerProvidedTimestamp)
 	}
 	return fields
 }
@@ -11579,12 +12147,14 @@ func (m *TokenFreezeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenFreezeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenCreateMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
-		return m.AddedWalletProvidedFreezeTimestamp()
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
-		return m.AddedWalletProvidedThawTimestamp()
+	case tokencreate.FieldDecimals:
+		return m.AddedDecimals()
+	case tokencreate.FieldWalletProvidedTimestamp:
+		return m.AddedWalletProvidedTimestamp()
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
+		return m.AddedPauseIssuerProvidedTimestamp()
 	}
 	return nil, false
 }
@@ -11592,209 +12162,389 @@ func (m *TokenFreezeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenFreezeMutation) AddField(name string, value ent.Value) error {
+func (m *TokenCreateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
+	case tokencreate.FieldDecimals:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDecimals(v)
+		return nil
+	case tokencreate.FieldWalletProvidedTimestamp:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWalletProvidedFreezeTimestamp(v)
+		m.AddWalletProvidedTimestamp(v)
 		return nil
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWalletProvidedThawTimestamp(v)
+		m.AddPauseIssuerProvidedTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenFreeze numeric field %s", name)
+	return fmt.Errorf("unknown TokenCreate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenFreezeMutation) ClearedFields() []string {
+func (m *TokenCreateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tokenfreeze.FieldTokenPublicKey) {
-		fields = append(fields, tokenfreeze.FieldTokenPublicKey)
+	if m.FieldCleared(tokencreate.FieldIssuerSignature) {
+		fields = append(fields, tokencreate.FieldIssuerSignature)
 	}
-	if m.FieldCleared(tokenfreeze.FieldWalletProvidedThawTimestamp) {
-		fields = append(fields, tokenfreeze.FieldWalletProvidedThawTimestamp)
+	if m.FieldCleared(tokencreate.FieldOperatorSpecificIssuerSignature) {
+		fields = append(fields, tokencreate.FieldOperatorSpecificIssuerSignature)
 	}
-	if m.FieldCleared(tokenfreeze.FieldTokenCreateID) {
-		fields = append(fields, tokenfreeze.FieldTokenCreateID)
+	if m.FieldCleared(tokencreate.FieldWalletProvidedTimestamp) {
+		fields = append(fields, tokencreate.FieldWalletProvidedTimestamp)
+	}
+	if m.FieldCleared(tokencreate.FieldPauseIssuerSignature) {
+		fields = append(fields, tokencreate.FieldPauseIssuerSignature)
+	}
+	if m.FieldCleared(tokencreate.FieldPauseIssuerProvidedTimestamp) {
+		fields = append(fields, tokencreate.FieldPauseIssuerProvidedTimestamp)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenFreezeMutation) FieldCleared(name string) bool {
+func (m *TokenCreateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenFreezeMutation) ClearField(name string) error {
+func (m *TokenCreateMutation) ClearField(name string) error {
 	switch name {
-	case tokenfreeze.FieldTokenPublicKey:
-		m.ClearTokenPublicKey()
+	case tokencreate.FieldIssuerSignature:
+		m.ClearIssuerSignature()
 		return nil
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
-		m.ClearWalletProvidedThawTimestamp()
+	case tokencreate.FieldOperatorSpecificIssuerSignature:
+		m.ClearOperatorSpecificIssuerSignature()
 		return nil
-	case tokenfreeze.FieldTokenCreateID:
-		m.ClearTokenCreateID()
+	case tokencreate.FieldWalletProvidedTimestamp:
+		m.ClearWalletProvidedTimestamp()
+		return nil
+	case tokencreate.FieldPauseIssuerSignature:
+		m.ClearPauseIssuerSignature()
+		return nil
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
+		m.ClearPauseIssuerProvidedTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenFreeze nullable field %s", name)
+	return fmt.Errorf("unknown TokenCreate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenFreezeMutation) ResetField(name string) error {
+func (m *TokenCreateMutation) ResetField(name string) error {
 	switch name {
-	case tokenfreeze.FieldCreateTime:
+	case tokencreate.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tokenfreeze.FieldUpdateTime:
+	case tokencreate.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tokenfreeze.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case tokenfreeze.FieldOwnerPublicKey:
-		m.ResetOwnerPublicKey()
+	case tokencreate.FieldIssuerPublicKey:
+		m.ResetIssuerPublicKey()
 		return nil
-	case tokenfreeze.FieldTokenPublicKey:
-		m.ResetTokenPublicKey()
+	case tokencreate.FieldTokenName:
+		m.ResetTokenName()
 		return nil
-	case tokenfreeze.FieldIssuerSignature:
+	case tokencreate.FieldTokenTicker:
+		m.ResetTokenTicker()
+		return nil
+	case tokencreate.FieldDecimals:
+		m.ResetDecimals()
+		return nil
+	case tokencreate.FieldMaxSupply:
+		m.ResetMaxSupply()
+		return nil
+	case tokencreate.FieldIsFreezable:
+		m.ResetIsFreezable()
+		return nil
+	case tokencreate.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	case tokencreate.FieldTokenIdentifier:
+		m.ResetTokenIdentifier()
+		return nil
+	case tokencreate.FieldIssuerSignature:
 		m.ResetIssuerSignature()
 		return nil
-	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
-		m.ResetWalletProvidedFreezeTimestamp()
+	case tokencreate.FieldOperatorSpecificIssuerSignature:
+		m.ResetOperatorSpecificIssuerSignature()
 		return nil
-	case tokenfreeze.FieldWalletProvidedThawTimestamp:
-		m.ResetWalletProvidedThawTimestamp()
+	case tokencreate.FieldCreationEntityPublicKey:
+		m.ResetCreationEntityPublicKey()
 		return nil
-	case tokenfreeze.FieldTokenCreateID:
-		m.ResetTokenCreateID()
+	case tokencreate.FieldWalletProvidedTimestamp:
+		m.ResetWalletProvidedTimestamp()
+		return nil
+	case tokencreate.FieldIsTransferRestricted:
+		m.ResetIsTransferRestricted()
+		return nil
+	case tokencreate.FieldIsPaused:
+		m.ResetIsPaused()
+		return nil
+	case tokencreate.FieldPauseIssuerSignature:
+		m.ResetPauseIssuerSignature()
+		return nil
+	case tokencreate.FieldPauseIssuerProvidedTimestamp:
+		m.ResetPauseIssuerProvidedTimestamp()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenFreeze field %s", name)
+	return fmt.Errorf("unknown TokenCreate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenFreezeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.token_create != nil {
-		edges = append(edges, tokenfreeze.EdgeTokenCreate)
+func (m *TokenCreateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.token_transaction != nil {
+		edges = append(edges, tokencreate.EdgeTokenTransaction)
+	}
+	if m.l1_token_create != nil {
+		edges = append(edges, tokencreate.EdgeL1TokenCreate)
+	}
+	if m.token_output != nil {
+		edges = append(edges, tokencreate.EdgeTokenOutput)
+	}
+	if m.token_freeze != nil {
+		edges = append(edges, tokencreate.EdgeTokenFreeze)
+	}
+	if m.issuer_key_rotations != nil {
+		edges = append(edges, tokencreate.EdgeIssuerKeyRotations)
+	}
+	if m.allowlist_entries != nil {
+		edges = append(edges, tokencreate.EdgeAllowlistEntries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenFreezeMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenCreateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tokenfreeze.EdgeTokenCreate:
-		if id := m.token_create; id != nil {
+	case tokencreate.EdgeTokenTransaction:
+		ids := make([]ent.Value, 0, len(m.token_transaction))
+		for id := range m.token_transaction {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeL1TokenCreate:
+		if id := m.l1_token_create; id != nil {
 			return []ent.Value{*id}
 		}
+	case tokencreate.EdgeTokenOutput:
+		ids := make([]ent.Value, 0, len(m.token_output))
+		for id := range m.token_output {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeTokenFreeze:
+		ids := make([]ent.Value, 0, len(m.token_freeze))
+		for id := range m.token_freeze {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeIssuerKeyRotations:
+		ids := make([]ent.Value, 0, len(m.issuer_key_rotations))
+		for id := range m.issuer_key_rotations {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeAllowlistEntries:
+		ids := make([]ent.Value, 0, len(m.allowlist_entries))
+		for id := range m.allowlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenFreezeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TokenCreateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedtoken_transaction != nil {
+		edges = append(edges, tokencreate.EdgeTokenTransaction)
+	}
+	if m.removedtoken_output != nil {
+		edges = append(edges, tokencreate.EdgeTokenOutput)
+	}
+	if m.removedtoken_freeze != nil {
+		edges = append(edges, tokencreate.EdgeTokenFreeze)
+	}
+	if m.removedissuer_key_rotations != nil {
+		edges = append(edges, tokencreate.EdgeIssuerKeyRotations)
+	}
+	if m.removedallowlist_entries != nil {
+		edges = append(edges, tokencreate.EdgeAllowlistEntries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenFreezeMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenCreateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tokencreate.EdgeTokenTransaction:
+		ids := make([]ent.Value, 0, len(m.removedtoken_transaction))
+		for id := range m.removedtoken_transaction {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeTokenOutput:
+		ids := make([]ent.Value, 0, len(m.removedtoken_output))
+		for id := range m.removedtoken_output {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeTokenFreeze:
+		ids := make([]ent.Value, 0, len(m.removedtoken_freeze))
+		for id := range m.removedtoken_freeze {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeIssuerKeyRotations:
+		ids := make([]ent.Value, 0, len(m.removedissuer_key_rotations))
+		for id := range m.removedissuer_key_rotations {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokencreate.EdgeAllowlistEntries:
+		ids := make([]ent.Value, 0, len(m.removedallowlist_entries))
+		for id := range m.removedallowlist_entries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenFreezeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtoken_create {
-		edges = append(edges, tokenfreeze.EdgeTokenCreate)
+func (m *TokenCreateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedtoken_transaction {
+		edges = append(edges, tokencreate.EdgeTokenTransaction)
+	}
+	if m.clearedl1_token_create {
+		edges = append(edges, tokencreate.EdgeL1TokenCreate)
+	}
+	if m.clearedtoken_output {
+		edges = append(edges, tokencreate.EdgeTokenOutput)
+	}
+	if m.clearedtoken_freeze {
+		edges = append(edges, tokencreate.EdgeTokenFreeze)
+	}
+	if m.clearedissuer_key_rotations {
+		edges = append(edges, tokencreate.EdgeIssuerKeyRotations)
+	}
+	if m.clearedallowlist_entries {
+		edges = append(edges, tokencreate.EdgeAllowlistEntries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenFreezeMutation) EdgeCleared(name string) bool {
+func (m *TokenCreateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tokenfreeze.EdgeTokenCreate:
-		return m.clearedtoken_create
+	case tokencreate.EdgeTokenTransaction:
+		return m.clearedtoken_transaction
+	case tokencreate.EdgeL1TokenCreate:
+		return m.clearedl1_token_create
+	case tokencreate.EdgeTokenOutput:
+		return m.clearedtoken_output
+	case tokencreate.EdgeTokenFreeze:
+		return m.clearedtoken_freeze
+	case tokencreate.EdgeIssuerKeyRotations:
+		return m.clearedissuer_key_rotations
+	case tokencreate.EdgeAllowlistEntries:
+		return m.clearedallowlist_entries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenFreezeMutation) ClearEdge(name string) error {
+func (m *TokenCreateMutation) ClearEdge(name string) error {
 	switch name {
-	case tokenfreeze.EdgeTokenCreate:
-		m.ClearTokenCreate()
+	case tokencreate.EdgeL1TokenCreate:
+		m.ClearL1TokenCreate()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenFreeze unique edge %s", name)
+	return fmt.Errorf("unknown TokenCreate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenFreezeMutation) ResetEdge(name string) error {
+func (m *TokenCreateMutation) ResetEdge(name string) error {
 	switch name {
-	case tokenfreeze.EdgeTokenCreate:
-		m.ResetTokenCreate()
+	case tokencreate.EdgeTokenTransaction:
+		m.ResetTokenTransaction()
+		return nil
+	case tokencreate.EdgeL1TokenCreate:
+		m.ResetL1TokenCreate()
+		return nil
+	case tokencreate.EdgeTokenOutput:
+		m.ResetTokenOutput()
+		return nil
+	case tokencreate.EdgeTokenFreeze:
+		m.ResetTokenFreeze()
+		return nil
+	case tokencreate.EdgeIssuerKeyRotations:
+		m.ResetIssuerKeyRotations()
+		return nil
+	case tokencreate.EdgeAllowlistEntries:
+		m.ResetAllowlistEntries()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenFreeze edge %s", name)
+	return fmt.Errorf("unknown TokenCreate edge %s", name)
 }
 
-// TokenMintMutation represents an operation that mutates the TokenMint nodes in the graph.
-type TokenMintMutation struct {
+// TokenFreezeMutation represents an operation that mutates the TokenFreeze nodes in the graph.
+type TokenFreezeMutation struct {
 	config
-	op                                 Op
-	typ                                string
-	id                                 *uuid.UUID
-	create_time                        *time.Time
-	update_time                        *time.Time
-	issuer_public_key                  *[]byte
-	wallet_provided_timestamp          *uint64
-	addwallet_provided_timestamp       *int64
-	issuer_signature                   *[]byte
-	operator_specific_issuer_signature *[]byte
-	token_identifier                   *[]byte
-	clearedFields                      map[string]struct{}
-	token_transaction                  map[uuid.UUID]struct{}
-	removedtoken_transaction           map[uuid.UUID]struct{}
-	clearedtoken_transaction           bool
-	done                               bool
-	oldValue                           func(context.Context) (*TokenMint, error)
-	predicates                         []predicate.TokenMint
+	op                                  Op
+	typ                                 string
+	id                                  *uuid.UUID
+	create_time                         *time.Time
+	update_time                         *time.Time
+	status                              *schematype.TokenFreezeStatus
+	owner_public_key                    *[]byte
+	token_public_key                    *[]byte
+	issuer_signature                    *[]byte
+	wallet_provided_freeze_timestamp    *uint64
+	addwallet_provided_freeze_timestamp *int64
+	wallet_provided_thaw_timestamp      *uint64
+	addwallet_provided_thaw_timestamp   *int64
+	expires_at                          *time.Time
+	clearedFields                       map[string]struct{}
+	token_create                        *uuid.UUID
+	clearedtoken_create                 bool
+	done                                bool
+	oldValue                            func(context.Context) (*TokenFreeze, error)
+	predicates                          []predicate.TokenFreeze
 }
 
-var _ ent.Mutation = (*TokenMintMutation)(nil)
+var _ ent.Mutation = (*TokenFreezeMutation)(nil)
 
-// tokenmintOption allows management of the mutation configuration using functional options.
-type tokenmintOption func(*TokenMintMutation)
+// tokenfreezeOption allows management of the mutation configuration using functional options.
+type tokenfreezeOption func(*TokenFreezeMutation)
 
-// newTokenMintMutation creates new mutation for the TokenMint entity.
-func newTokenMintMutation(c config, op Op, opts ...tokenmintOption) *TokenMintMutation {
-	m := &TokenMintMutation{
+// newTokenFreezeMutation creates new mutation for the TokenFreeze entity.
+func newTokenFreezeMutation(c config, op Op, opts ...tokenfreezeOption) *TokenFreezeMutation {
+	m := &TokenFreezeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTokenMint,
+		typ:           TypeTokenFreeze,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -11803,20 +12553,20 @@ func newTokenMintMutation(c config, op Op, opts ...tokenmintOption) *TokenMintMu
 	return m
 }
 
-// withTokenMintID sets the ID field of the mutation.
-func withTokenMintID(id uuid.UUID) tokenmintOption {
-	return func(m *TokenMintMutation) {
+// withTokenFreezeID sets the ID field of the mutation.
+func withTokenFreezeID(id uuid.UUID) tokenfreezeOption {
+	return func(m *TokenFreezeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TokenMint
+			value *TokenFreeze
 		)
-		m.oldValue = func(ctx context.Context) (*TokenMint, error) {
+		m.oldValue = func(ctx context.Context) (*TokenFreeze, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TokenMint.Get(ctx, id)
+					value, err = m.Client().TokenFreeze.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -11825,10 +12575,10 @@ func withTokenMintID(id uuid.UUID) tokenmintOption {
 	}
 }
 
-// withTokenMint sets the old TokenMint of the mutation.
-func withTokenMint(node *TokenMint) tokenmintOption {
-	return func(m *TokenMintMutation) {
-		m.oldValue = func(context.Context) (*TokenMint, error) {
+// withTokenFreeze sets the old TokenFreeze of the mutation.
+func withTokenFreeze(node *TokenFreeze) tokenfreezeOption {
+	return func(m *TokenFreezeMutation) {
+		m.oldValue = func(context.Context) (*TokenFreeze, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -11837,7 +12587,7 @@ func withTokenMint(node *TokenMint) tokenmintOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMintMutation) Client() *Client {
+func (m TokenFreezeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -11845,7 +12595,7 @@ func (m TokenMintMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMintMutation) Tx() (*Tx, error) {
+func (m TokenFreezeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -11855,14 +12605,14 @@ func (m TokenMintMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenMint entities.
-func (m *TokenMintMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenFreeze entities.
+func (m *TokenFreezeMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenMintMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenFreezeMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -11873,7 +12623,7 @@ func (m *TokenMintMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenMintMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenFreezeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -11882,19 +12632,19 @@ func (m *TokenMintMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenMint.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenFreeze.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TokenMintMutation) SetCreateTime(t time.Time) {
+func (m *TokenFreezeMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenMintMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenFreezeMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -11902,10 +12652,10 @@ func (m *TokenMintMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenFreezeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -11920,17 +12670,17 @@ func (m *TokenMintMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenMintMutation) ResetCreateTime() {
+func (m *TokenFreezeMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TokenMintMutation) SetUpdateTime(t time.Time) {
+func (m *TokenFreezeMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenMintMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenFreezeMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -11938,10 +12688,10 @@ func (m *TokenMintMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenFreezeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -11956,109 +12706,138 @@ func (m *TokenMintMutation) OldUpdateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenMintMutation) ResetUpdateTime() {
+func (m *TokenFreezeMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetIssuerPublicKey sets the "issuer_public_key" field.
-func (m *TokenMintMutation) SetIssuerPublicKey(b []byte) {
-	m.issuer_public_key = &b
+// SetStatus sets the "status" field.
+func (m *TokenFreezeMutation) SetStatus(sfs schematype.TokenFreezeStatus) {
+	m.status = &sfs
 }
 
-// IssuerPublicKey returns the value of the "issuer_public_key" field in the mutation.
-func (m *TokenMintMutation) IssuerPublicKey() (r []byte, exists bool) {
-	v := m.issuer_public_key
+// Status returns the value of the "status" field in the mutation.
+func (m *TokenFreezeMutation) Status() (r schematype.TokenFreezeStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIssuerPublicKey returns the old "issuer_public_key" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldIssuerPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenFreezeMutation) OldStatus(ctx context.Context) (v schematype.TokenFreezeStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIssuerPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIssuerPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIssuerPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.IssuerPublicKey, nil
+	return oldValue.Status, nil
 }
 
-// ResetIssuerPublicKey resets all changes to the "issuer_public_key" field.
-func (m *TokenMintMutation) ResetIssuerPublicKey() {
-	m.issuer_public_key = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TokenFreezeMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetWalletProvidedTimestamp sets the "wallet_provided_timestamp" field.
-func (m *TokenMintMutation) SetWalletProvidedTimestamp(u uint64) {
-	m.wallet_provided_timestamp = &u
-	m.addwallet_provided_timestamp = nil
+// SetOwnerPublicKey sets the "owner_public_key" field.
+func (m *TokenFreezeMutation) SetOwnerPublicKey(b []byte) {
+	m.owner_public_key = &b
 }
 
-// WalletProvidedTimestamp returns the value of the "wallet_provided_timestamp" field in the mutation.
-func (m *TokenMintMutation) WalletProvidedTimestamp() (r uint64, exists bool) {
-	v := m.wallet_provided_timestamp
+// OwnerPublicKey returns the value of the "owner_public_key" field in the mutation.
+func (m *TokenFreezeMutation) OwnerPublicKey() (r []byte, exists bool) {
+	v := m.owner_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWalletProvidedTimestamp returns the old "wallet_provided_timestamp" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerPublicKey returns the old "owner_public_key" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldWalletProvidedTimestamp(ctx context.Context) (v uint64, err error) {
+func (m *TokenFreezeMutation) OldOwnerPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWalletProvidedTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWalletProvidedTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldOwnerPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWalletProvidedTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerPublicKey: %w", err)
 	}
-	return oldValue.WalletProvidedTimestamp, nil
+	return oldValue.OwnerPublicKey, nil
 }
 
-// AddWalletProvidedTimestamp adds u to the "wallet_provided_timestamp" field.
-func (m *TokenMintMutation) AddWalletProvidedTimestamp(u int64) {
-	if m.addwallet_provided_timestamp != nil {
-		*m.addwallet_provided_timestamp += u
-	} else {
-		m.addwallet_provided_timestamp = &u
-	}
+// ResetOwnerPublicKey resets all changes to the "owner_public_key" field.
+func (m *TokenFreezeMutation) ResetOwnerPublicKey() {
+	m.owner_public_key = nil
 }
 
-// AddedWalletProvidedTimestamp returns the value that was added to the "wallet_provided_timestamp" field in this mutation.
-func (m *TokenMintMutation) AddedWalletProvidedTimestamp() (r int64, exists bool) {
-	v := m.addwallet_provided_timestamp
+// SetTokenPublicKey sets the "token_public_key" field.
+func (m *TokenFreezeMutation) SetTokenPublicKey(b []byte) {
+	m.token_public_key = &b
+}
+
+// TokenPublicKey returns the value of the "token_public_key" field in the mutation.
+func (m *TokenFreezeMutation) TokenPublicKey() (r []byte, exists bool) {
+	v := m.token_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetWalletProvidedTimestamp resets all changes to the "wallet_provided_timestamp" field.
-func (m *TokenMintMutation) ResetWalletProvidedTimestamp() {
-	m.wallet_provided_timestamp = nil
-	m.addwallet_provided_timestamp = nil
+// OldTokenPublicKey returns the old "token_public_key" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenFreezeMutation) OldTokenPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenPublicKey: %w", err)
+	}
+	return oldValue.TokenPublicKey, nil
+}
+
+// ClearTokenPublicKey clears the value of the "token_public_key" field.
+func (m *TokenFreezeMutation) ClearTokenPublicKey() {
+	m.token_public_key = nil
+	m.clearedFields[tokenfreeze.FieldTokenPublicKey] = struct{}{}
+}
+
+// TokenPublicKeyCleared returns if the "token_public_key" field was cleared in this mutation.
+func (m *TokenFreezeMutation) TokenPublicKeyCleared() bool {
+	_, ok := m.clearedFields[tokenfreeze.FieldTokenPublicKey]
+	return ok
+}
+
+// ResetTokenPublicKey resets all changes to the "token_public_key" field.
+func (m *TokenFreezeMutation) ResetTokenPublicKey() {
+	m.token_public_key = nil
+	delete(m.clearedFields, tokenfreeze.FieldTokenPublicKey)
 }
 
 // SetIssuerSignature sets the "issuer_signature" field.
-func (m *TokenMintMutation) SetIssuerSignature(b []byte) {
+func (m *TokenFreezeMutation) SetIssuerSignature(b []byte) {
 	m.issuer_signature = &b
 }
 
 // IssuerSignature returns the value of the "issuer_signature" field in the mutation.
-func (m *TokenMintMutation) IssuerSignature() (r []byte, exists bool) {
+func (m *TokenFreezeMutation) IssuerSignature() (r []byte, exists bool) {
 	v := m.issuer_signature
 	if v == nil {
 		return
@@ -12066,10 +12845,10 @@ func (m *TokenMintMutation) IssuerSignature() (r []byte, exists bool) {
 	return *v, true
 }
 
-// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
+func (m *TokenFreezeMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
 	}
@@ -12084,171 +12863,270 @@ func (m *TokenMintMutation) OldIssuerSignature(ctx context.Context) (v []byte, e
 }
 
 // ResetIssuerSignature resets all changes to the "issuer_signature" field.
-func (m *TokenMintMutation) ResetIssuerSignature() {
+func (m *TokenFreezeMutation) ResetIssuerSignature() {
 	m.issuer_signature = nil
 }
 
-// SetOperatorSpecificIssuerSignature sets the "operator_specific_issuer_signature" field.
-func (m *TokenMintMutation) SetOperatorSpecificIssuerSignature(b []byte) {
-	m.operator_specific_issuer_signature = &b
+// SetWalletProvidedFreezeTimestamp sets the "wallet_provided_freeze_timestamp" field.
+func (m *TokenFreezeMutation) SetWalletProvidedFreezeTimestamp(u uint64) {
+	m.wallet_provided_freeze_timestamp = &u
+	m.addwallet_provided_freeze_timestamp = nil
 }
 
-// OperatorSpecificIssuerSignature returns the value of the "operator_specific_issuer_signature" field in the mutation.
-func (m *TokenMintMutation) OperatorSpecificIssuerSignature() (r []byte, exists bool) {
-	v := m.operator_specific_issuer_signature
+// WalletProvidedFreezeTimestamp returns the value of the "wallet_provided_freeze_timestamp" field in the mutation.
+func (m *TokenFreezeMutation) WalletProvidedFreezeTimestamp() (r uint64, exists bool) {
+	v := m.wallet_provided_freeze_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOperatorSpecificIssuerSignature returns the old "operator_specific_issuer_signature" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldWalletProvidedFreezeTimestamp returns the old "wallet_provided_freeze_timestamp" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldOperatorSpecificIssuerSignature(ctx context.Context) (v []byte, err error) {
+func (m *TokenFreezeMutation) OldWalletProvidedFreezeTimestamp(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperatorSpecificIssuerSignature is only allowed on UpdateOne operations")
+		return v, errors.New("OldWalletProvidedFreezeTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperatorSpecificIssuerSignature requires an ID field in the mutation")
+		return v, errors.New("OldWalletProvidedFreezeTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperatorSpecificIssuerSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldWalletProvidedFreezeTimestamp: %w", err)
 	}
-	return oldValue.OperatorSpecificIssuerSignature, nil
+	return oldValue.WalletProvidedFreezeTimestamp, nil
 }
 
-// ClearOperatorSpecificIssuerSignature clears the value of the "operator_specific_issuer_signature" field.
-func (m *TokenMintMutation) ClearOperatorSpecificIssuerSignature() {
-	m.operator_specific_issuer_signature = nil
-	m.clearedFields[tokenmint.FieldOperatorSpecificIssuerSignature] = struct{}{}
+// AddWalletProvidedFreezeTimestamp adds u to the "wallet_provided_freeze_timestamp" field.
+func (m *TokenFreezeMutation) AddWalletProvidedFreezeTimestamp(u int64) {
+	if m.addwallet_provided_freeze_timestamp != nil {
+		*m.addwallet_provided_freeze_timestamp += u
+	} else {
+		m.addwallet_provided_freeze_timestamp = &u
+	}
 }
 
-// OperatorSpecificIssuerSignatureCleared returns if the "operator_specific_issuer_signature" field was cleared in this mutation.
-func (m *TokenMintMutation) OperatorSpecificIssuerSignatureCleared() bool {
-	_, ok := m.clearedFields[tokenmint.FieldOperatorSpecificIssuerSignature]
-	return ok
+// AddedWalletProvidedFreezeTimestamp returns the value that was added to the "wallet_provided_freeze_timestamp" field in this mutation.
+func (m *TokenFreezeMutation) AddedWalletProvidedFreezeTimestamp() (r int64, exists bool) {
+	v := m.addwallet_provided_freeze_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetOperatorSpecificIssuerSignature resets all changes to the "operator_specific_issuer_signature" field.
-func (m *TokenMintMutation) ResetOperatorSpecificIssuerSignature() {
-	m.operator_specific_issuer_signature = nil
-	delete(m.clearedFields, tokenmint.FieldOperatorSpecificIssuerSignature)
+// ResetWalletProvidedFreezeTimestamp resets all changes to the "wallet_provided_freeze_timestamp" field.
+func (m *TokenFreezeMutation) ResetWalletProvidedFreezeTimestamp() {
+	m.wallet_provided_freeze_timestamp = nil
+	m.addwallet_provided_freeze_timestamp = nil
 }
 
-// SetTokenIdentifier sets the "token_identifier" field.
-func (m *TokenMintMutation) SetTokenIdentifier(b []byte) {
-	m.token_identifier = &b
+// SetWalletProvidedThawTimestamp sets the "wallet_provided_thaw_timestamp" field.
+func (m *TokenFreezeMutation) SetWalletProvidedThawTimestamp(u uint64) {
+	m.wallet_provided_thaw_timestamp = &u
+	m.addwallet_provided_thaw_timestamp = nil
 }
 
-// TokenIdentifier returns the value of the "token_identifier" field in the mutation.
-func (m *TokenMintMutation) TokenIdentifier() (r []byte, exists bool) {
-	v := m.token_identifier
+// WalletProvidedThawTimestamp returns the value of the "wallet_provided_thaw_timestamp" field in the mutation.
+func (m *TokenFreezeMutation) WalletProvidedThawTimestamp() (r uint64, exists bool) {
+	v := m.wallet_provided_thaw_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenIdentifier returns the old "token_identifier" field's value of the TokenMint entity.
-// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// OldWalletProvidedThawTimestamp returns the old "wallet_provided_thaw_timestamp" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenMintMutation) OldTokenIdentifier(ctx context.Context) (v []byte, err error) {
+func (m *TokenFreezeMutation) OldWalletProvidedThawTimestamp(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenIdentifier is only allowed on UpdateOne operations")
+		return v, errors.New("OldWalletProvidedThawTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenIdentifier requires an ID field in the mutation")
+		return v, errors.New("OldWalletProvidedThawTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenIdentifier: %w", err)
+		return v, fmt.Errorf("querying old value for OldWalletProvidedThawTimestamp: %w", err)
 	}
-	return oldValue.TokenIdentifier, nil
+	return oldValue.WalletProvidedThawTimestamp, nil
 }
 
-// ClearTokenIdentifier clears the value of the "token_identifier" field.
-func (m *TokenMintMutation) ClearTokenIdentifier() {
-	m.token_identifier = nil
-	m.clearedFields[tokenmint.FieldTokenIdentifier] = struct{}{}
+// AddWalletProvidedThawTimestamp adds u to the "wallet_provided_thaw_timestamp" field.
+func (m *TokenFreezeMutation) AddWalletProvidedThawTimestamp(u int64) {
+	if m.addwallet_provided_thaw_timestamp != nil {
+		*m.addwallet_provided_thaw_timestamp += u
+	} else {
+		m.addwallet_provided_thaw_timestamp = &u
+	}
 }
 
-// TokenIdentifierCleared returns if the "token_identifier" field was cleared in this mutation.
-func (m *TokenMintMutation) TokenIdentifierCleared() bool {
-	_, ok := m.clearedFields[tokenmint.FieldTokenIdentifier]
+// AddedWalletProvidedThawTimestamp returns the value that was added to the "wallet_provided_thaw_timestamp" field in this mutation.
+func (m *TokenFreezeMutation) AddedWalletProvidedThawTimestamp() (r int64, exists bool) {
+	v := m.addwallet_provided_thaw_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWalletProvidedThawTimestamp clears the value of the "wallet_provided_thaw_timestamp" field.
+func (m *TokenFreezeMutation) ClearWalletProvidedThawTimestamp() {
+	m.wallet_provided_thaw_timestamp = nil
+	m.addwallet_provided_thaw_timestamp = nil
+	m.clearedFields[tokenfreeze.FieldWalletProvidedThawTimestamp] = struct{}{}
+}
+
+// WalletProvidedThawTimestampCleared returns if the "wallet_provided_thaw_timestamp" field was cleared in this mutation.
+func (m *TokenFreezeMutation) WalletProvidedThawTimestampCleared() bool {
+	_, ok := m.clearedFields[tokenfreeze.FieldWalletProvidedThawTimestamp]
 	return ok
 }
 
-// ResetTokenIdentifier resets all changes to the "token_identifier" field.
-func (m *TokenMintMutation) ResetTokenIdentifier() {
-	m.token_identifier = nil
-	delete(m.clearedFields, tokenmint.FieldTokenIdentifier)
+// ResetWalletProvidedThawTimestamp resets all changes to the "wallet_provided_thaw_timestamp" field.
+func (m *TokenFreezeMutation) ResetWalletProvidedThawTimestamp() {
+	m.wallet_provided_thaw_timestamp = nil
+	m.addwallet_provided_thaw_timestamp = nil
+	delete(m.clearedFields, tokenfreeze.FieldWalletProvidedThawTimestamp)
 }
 
-// AddTokenTransactionIDs adds the "token_transaction" edge to the TokenTransaction entity by ids.
-func (m *TokenMintMutation) AddTokenTransactionIDs(ids ...uuid.UUID) {
-	if m.token_transaction == nil {
-		m.token_transaction = make(map[uuid.UUID]struct{})
+// SetTokenCreateID sets the "token_create_id" field.
+func (m *TokenFreezeMutation) SetTokenCreateID(u uuid.UUID) {
+	m.token_create = &u
+}
+
+// TokenCreateID returns the value of the "token_create_id" field in the mutation.
+func (m *TokenFreezeMutation) TokenCreateID() (r uuid.UUID, exists bool) {
+	v := m.token_create
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.token_transaction[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldTokenCreateID returns the old "token_create_id" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenFreezeMutation) OldTokenCreateID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenCreateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenCreateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenCreateID: %w", err)
 	}
+	return oldValue.TokenCreateID, nil
 }
 
-// ClearTokenTransaction clears the "token_transaction" edge to the TokenTransaction entity.
-func (m *TokenMintMutation) ClearTokenTransaction() {
-	m.clearedtoken_transaction = true
+// ClearTokenCreateID clears the value of the "token_create_id" field.
+func (m *TokenFreezeMutation) ClearTokenCreateID() {
+	m.token_create = nil
+	m.clearedFields[tokenfreeze.FieldTokenCreateID] = struct{}{}
 }
 
-// TokenTransactionCleared reports if the "token_transaction" edge to the TokenTransaction entity was cleared.
-func (m *TokenMintMutation) TokenTransactionCleared() bool {
-	return m.clearedtoken_transaction
+// TokenCreateIDCleared returns if the "token_create_id" field was cleared in this mutation.
+func (m *TokenFreezeMutation) TokenCreateIDCleared() bool {
+	_, ok := m.clearedFields[tokenfreeze.FieldTokenCreateID]
+	return ok
 }
 
-// RemoveTokenTransactionIDs removes the "token_transaction" edge to the TokenTransaction entity by IDs.
-func (m *TokenMintMutation) RemoveTokenTransactionIDs(ids ...uuid.UUID) {
-	if m.removedtoken_transaction == nil {
-		m.removedtoken_transaction = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.token_transaction, ids[i])
-		m.removedtoken_transaction[ids[i]] = struct{}{}
+// ResetTokenCreateID resets all changes to the "token_create_id" field.
+func (m *TokenFreezeMutation) ResetTokenCreateID() {
+	m.token_create = nil
+	delete(m.clearedFields, tokenfreeze.FieldTokenCreateID)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *TokenFreezeMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *TokenFreezeMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTokenTransaction returns the removed IDs of the "token_transaction" edge to the TokenTransaction entity.
-func (m *TokenMintMutation) RemovedTokenTransactionIDs() (ids []uuid.UUID) {
-	for id := range m.removedtoken_transaction {
-		ids = append(ids, id)
+// OldExpiresAt returns the old "expires_at" field's value of the TokenFreeze entity.
+// If the TokenFreeze object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenFreezeMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
 }
 
-// TokenTransactionIDs returns the "token_transaction" edge IDs in the mutation.
-func (m *TokenMintMutation) TokenTransactionIDs() (ids []uuid.UUID) {
-	for id := range m.token_transaction {
-		ids = append(ids, id)
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *TokenFreezeMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[tokenfreeze.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *TokenFreezeMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[tokenfreeze.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *TokenFreezeMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, tokenfreeze.FieldExpiresAt)
+}
+
+// ClearTokenCreate clears the "token_create" edge to the TokenCreate entity.
+func (m *TokenFreezeMutation) ClearTokenCreate() {
+	m.clearedtoken_create = true
+	m.clearedFields[tokenfreeze.FieldTokenCreateID] = struct{}{}
+}
+
+// TokenCreateCleared reports if the "token_create" edge to the TokenCreate entity was cleared.
+func (m *TokenFreezeMutation) TokenCreateCleared() bool {
+	return m.TokenCreateIDCleared() || m.clearedtoken_create
+}
+
+// TokenCreateIDs returns the "token_create" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenCreateID instead. It exists only for internal usage by the builders.
+func (m *TokenFreezeMutation) TokenCreateIDs() (ids []uuid.UUID) {
+	if id := m.token_create; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTokenTransaction resets all changes to the "token_transaction" edge.
-func (m *TokenMintMutation) ResetTokenTransaction() {
-	m.token_transaction = nil
-	m.clearedtoken_transaction = false
-	m.removedtoken_transaction = nil
+// ResetTokenCreate resets all changes to the "token_create" edge.
+func (m *TokenFreezeMutation) ResetTokenCreate() {
+	m.token_create = nil
+	m.clearedtoken_create = false
 }
 
-// Where appends a list predicates to the TokenMintMutation builder.
-func (m *TokenMintMutation) Where(ps ...predicate.TokenMint) {
+// Where appends a list predicates to the TokenFreezeMutation builder.
+func (m *TokenFreezeMutation) Where(ps ...predicate.TokenFreeze) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenMintMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenFreezeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenMintMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenMint, len(ps))
+func (m *TokenFreezeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenFreeze, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -12256,45 +13134,54 @@ func (m *TokenMintMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenMintMutation) Op() Op {
+func (m *TokenFreezeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenMintMutation) SetOp(op Op) {
+func (m *TokenFreezeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TokenMint).
-func (m *TokenMintMutation) Type() string {
+// Type returns the node type of this mutation (TokenFreeze).
+func (m *TokenFreezeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenMintMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *TokenFreezeMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
-		fields = append(fields, tokenmint.FieldCreateTime)
+		fields = append(fields, tokenfreeze.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tokenmint.FieldUpdateTime)
+		fields = append(fields, tokenfreeze.FieldUpdateTime)
 	}
-	if m.issuer_public_key != nil {
-		fields = append(fields, tokenmint.FieldIssuerPublicKey)
+	if m.status != nil {
+		fields = append(fields, tokenfreeze.FieldStatus)
 	}
-	if m.wallet_provided_timestamp != nil {
-		fields = append(fields, tokenmint.FieldWalletProvidedTimestamp)
+	if m.owner_public_key != nil {
+		fields = append(fields, tokenfreeze.FieldOwnerPublicKey)
+	}
+	if m.token_public_key != nil {
+		fields = append(fields, tokenfreeze.FieldTokenPublicKey)
 	}
 	if m.issuer_signature != nil {
-		fields = append(fields, tokenmint.FieldIssuerSignature)
+		fields = append(fields, tokenfreeze.FieldIssuerSignature)
 	}
-	if m.operator_specific_issuer_signature != nil {
-		fields = append(fields, tokenmint.FieldOperatorSpecificIssuerSignature)
+	if m.wallet_provided_freeze_timestamp != nil {
+		fields = append(fields, tokenfreeze.FieldWalletProvidedFreezeTimestamp)
 	}
-	if m.token_identifier != nil {
-		fields = append(fields, tokenmint.FieldTokenIdentifier)
+	if m.wallet_provided_thaw_timestamp != nil {
+		fields = append(fields, tokenfreeze.FieldWalletProvidedThawTimestamp)
+	}
+	if m.token_create != nil {
+		fields = append(fields, tokenfreeze.FieldTokenCreateID)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, tokenfreeze.FieldExpiresAt)
 	}
 	return fields
 }
@@ -12302,22 +13189,28 @@ func (m *TokenMintMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenMintMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenFreezeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tokenmint.FieldCreateTime:
+	case tokenfreeze.FieldCreateTime:
 		return m.CreateTime()
-	case tokenmint.FieldUpdateTime:
+	case tokenfreeze.FieldUpdateTime:
 		return m.UpdateTime()
-	case tokenmint.FieldIssuerPublicKey:
-		return m.IssuerPublicKey()
-	case tokenmint.FieldWalletProvidedTimestamp:
-		return m.WalletProvidedTimestamp()
-	case tokenmint.FieldIssuerSignature:
+	case tokenfreeze.FieldStatus:
+		return m.Status()
+	case tokenfreeze.FieldOwnerPublicKey:
+		return m.OwnerPublicKey()
+	case tokenfreeze.FieldTokenPublicKey:
+		return m.TokenPublicKey()
+	case tokenfreeze.FieldIssuerSignature:
 		return m.IssuerSignature()
-	case tokenmint.FieldOperatorSpecificIssuerSignature:
-		return m.OperatorSpecificIssuerSignature()
-	case tokenmint.FieldTokenIdentifier:
-		return m.TokenIdentifier()
+	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
+		return m.WalletProvidedFreezeTimestamp()
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		return m.WalletProvidedThawTimestamp()
+	case tokenfreeze.FieldTokenCreateID:
+		return m.TokenCreateID()
+	case tokenfreeze.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -12325,90 +13218,120 @@ func (m *TokenMintMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenMintMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenFreezeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tokenmint.FieldCreateTime:
+	case tokenfreeze.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tokenmint.FieldUpdateTime:
+	case tokenfreeze.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tokenmint.FieldIssuerPublicKey:
-		return m.OldIssuerPublicKey(ctx)
-	case tokenmint.FieldWalletProvidedTimestamp:
-		return m.OldWalletProvidedTimestamp(ctx)
-	case tokenmint.FieldIssuerSignature:
-		return m.OldIssuerSignature(ctx)
-	case tokenmint.FieldOperatorSpecificIssuerSignature:
-		return m.OldOperatorSpecificIssuerSignature(ctx)
-	case tokenmint.FieldTokenIdentifier:
-		return m.OldTokenIdentifier(ctx)
+	case tokenfreeze.FieldStatus:
+		return m.OldStatus(ctx)
+	case tokenfreeze.FieldOwnerPublicKey:
+		return m.OldOwnerPublicKey(ctx)
+	case tokenfreeze.FieldTokenPublicKey:
+		return m.OldTokenPublicKey(ctx)
+	case tokenfreeze.FieldIssuerSignature:
+		return m.OldIssuerSignature(ctx)
+	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
+		return m.OldWalletProvidedFreezeTimestamp(ctx)
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		return m.OldWalletProvidedThawTimestamp(ctx)
+	case tokenfreeze.FieldTokenCreateID:
+		return m.OldTokenCreateID(ctx)
+	case tokenfreeze.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown TokenMint field %s", name)
+	return nil, fmt.Errorf("unknown TokenFreeze field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMintMutation) SetField(name string, value ent.Value) error {
+func (m *TokenFreezeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tokenmint.FieldCreateTime:
+	case tokenfreeze.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tokenmint.FieldUpdateTime:
+	case tokenfreeze.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tokenmint.FieldIssuerPublicKey:
+	case tokenfreeze.FieldStatus:
+		v, ok := value.(schematype.TokenFreezeStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tokenfreeze.FieldOwnerPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIssuerPublicKey(v)
+		m.SetOwnerPublicKey(v)
 		return nil
-	case tokenmint.FieldWalletProvidedTimestamp:
-		v, ok := value.(uint64)
+	case tokenfreeze.FieldTokenPublicKey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWalletProvidedTimestamp(v)
+		m.SetTokenPublicKey(v)
 		return nil
-	case tokenmint.FieldIssuerSignature:
+	case tokenfreeze.FieldIssuerSignature:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetIssuerSignature(v)
 		return nil
-	case tokenmint.FieldOperatorSpecificIssuerSignature:
-		v, ok := value.([]byte)
+	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperatorSpecificIssuerSignature(v)
+		m.SetWalletProvidedFreezeTimestamp(v)
 		return nil
-	case tokenmint.FieldTokenIdentifier:
-		v, ok := value.([]byte)
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenIdentifier(v)
+		m.SetWalletProvidedThawTimestamp(v)
+		return nil
+	case tokenfreeze.FieldTokenCreateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenCreateID(v)
+		return nil
+	case tokenfreeze.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenMint field %s", name)
+	return fmt.Errorf("unknown TokenFreeze field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenMintMutation) AddedFields() []string {
+func (m *TokenFreezeMutation) AddedFields() []string {
 	var fields []string
-	if m.addwallet_provided_timestamp != nil {
-		fields = append(fields, tokenmint.FieldWalletProvidedTimestamp)
+	if m.addwallet_provided_freeze_timestamp != nil {
+		fields = append(fields, tokenfreeze.FieldWalletProvidedFreezeTimestamp)
+	}
+	if m.addwallet_provided_thaw_timestamp != nil {
+		fields = append(fields, tokenfreeze.FieldWalletProvidedThawTimestamp)
 	}
 	return fields
 }
@@ -12416,10 +13339,12 @@ func (m *TokenMintMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenMintMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenFreezeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tokenmint.FieldWalletProvidedTimestamp:
-		return m.AddedWalletProvidedTimestamp()
+	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
+		return m.AddedWalletProvidedFreezeTimestamp()
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		return m.AddedWalletProvidedThawTimestamp()
 	}
 	return nil, false
 }
@@ -12427,224 +13352,216 @@ func (m *TokenMintMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenMintMutation) AddField(name string, value ent.Value) error {
+func (m *TokenFreezeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tokenmint.FieldWalletProvidedTimestamp:
+	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWalletProvidedTimestamp(v)
+		m.AddWalletProvidedFreezeTimestamp(v)
+		return nil
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWalletProvidedThawTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenMint numeric field %s", name)
+	return fmt.Errorf("unknown TokenFreeze numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenMintMutation) ClearedFields() []string {
+func (m *TokenFreezeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tokenmint.FieldOperatorSpecificIssuerSignature) {
-		fields = append(fields, tokenmint.FieldOperatorSpecificIssuerSignature)
+	if m.FieldCleared(tokenfreeze.FieldTokenPublicKey) {
+		fields = append(fields, tokenfreeze.FieldTokenPublicKey)
 	}
-	if m.FieldCleared(tokenmint.FieldTokenIdentifier) {
-		fields = append(fields, tokenmint.FieldTokenIdentifier)
+	if m.FieldCleared(tokenfreeze.FieldWalletProvidedThawTimestamp) {
+		fields = append(fields, tokenfreeze.FieldWalletProvidedThawTimestamp)
+	}
+	if m.FieldCleared(tokenfreeze.FieldTokenCreateID) {
+		fields = append(fields, tokenfreeze.FieldTokenCreateID)
+	}
+	if m.FieldCleared(tokenfreeze.FieldExpiresAt) {
+		fields = append(fields, tokenfreeze.FieldExpiresAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenMintMutation) FieldCleared(name string) bool {
+func (m *TokenFreezeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMintMutation) ClearField(name string) error {
+func (m *TokenFreezeMutation) ClearField(name string) error {
 	switch name {
-	case tokenmint.FieldOperatorSpecificIssuerSignature:
-		m.ClearOperatorSpecificIssuerSignature()
+	case tokenfreeze.FieldTokenPublicKey:
+		m.ClearTokenPublicKey()
 		return nil
-	case tokenmint.FieldTokenIdentifier:
-		m.ClearTokenIdentifier()
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		m.ClearWalletProvidedThawTimestamp()
+		return nil
+	case tokenfreeze.FieldTokenCreateID:
+		m.ClearTokenCreateID()
+		return nil
+	case tokenfreeze.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenMint nullable field %s", name)
+	return fmt.Errorf("unknown TokenFreeze nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenMintMutation) ResetField(name string) error {
+func (m *TokenFreezeMutation) ResetField(name string) error {
 	switch name {
-	case tokenmint.FieldCreateTime:
+	case tokenfreeze.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tokenmint.FieldUpdateTime:
+	case tokenfreeze.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tokenmint.FieldIssuerPublicKey:
-		m.ResetIssuerPublicKey()
+	case tokenfreeze.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case tokenmint.FieldWalletProvidedTimestamp:
-		m.ResetWalletProvidedTimestamp()
+	case tokenfreeze.FieldOwnerPublicKey:
+		m.ResetOwnerPublicKey()
 		return nil
-	case tokenmint.FieldIssuerSignature:
+	case tokenfreeze.FieldTokenPublicKey:
+		m.ResetTokenPublicKey()
+		return nil
+	case tokenfreeze.FieldIssuerSignature:
 		m.ResetIssuerSignature()
 		return nil
-	case tokenmint.FieldOperatorSpecificIssuerSignature:
-		m.ResetOperatorSpecificIssuerSignature()
+	case tokenfreeze.FieldWalletProvidedFreezeTimestamp:
+		m.ResetWalletProvidedFreezeTimestamp()
 		return nil
-	case tokenmint.FieldTokenIdentifier:
-		m.ResetTokenIdentifier()
+	case tokenfreeze.FieldWalletProvidedThawTimestamp:
+		m.ResetWalletProvidedThawTimestamp()
+		return nil
+	case tokenfreeze.FieldTokenCreateID:
+		m.ResetTokenCreateID()
+		return nil
+	case tokenfreeze.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenMint field %s", name)
+	return fmt.Errorf("unknown TokenFreeze field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenMintMutation) AddedEdges() []string {
+func (m *TokenFreezeMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.token_transaction != nil {
-		edges = append(edges, tokenmint.EdgeTokenTransaction)
+	if m.token_create != nil {
+		edges = append(edges, tokenfreeze.EdgeTokenCreate)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenMintMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenFreezeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tokenmint.EdgeTokenTransaction:
-		ids := make([]ent.Value, 0, len(m.token_transaction))
-		for id := range m.token_transaction {
-			ids = append(ids, id)
+	case tokenfreeze.EdgeTokenCreate:
+		if id := m.token_create; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenMintMutation) RemovedEdges() []string {
+func (m *TokenFreezeMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedtoken_transaction != nil {
-		edges = append(edges, tokenmint.EdgeTokenTransaction)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenMintMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case tokenmint.EdgeTokenTransaction:
-		ids := make([]ent.Value, 0, len(m.removedtoken_transaction))
-		for id := range m.removedtoken_transaction {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TokenFreezeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenMintMutation) ClearedEdges() []string {
+func (m *TokenFreezeMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtoken_transaction {
-		edges = append(edges, tokenmint.EdgeTokenTransaction)
+	if m.clearedtoken_create {
+		edges = append(edges, tokenfreeze.EdgeTokenCreate)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenMintMutation) EdgeCleared(name string) bool {
+func (m *TokenFreezeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tokenmint.EdgeTokenTransaction:
-		return m.clearedtoken_transaction
+	case tokenfreeze.EdgeTokenCreate:
+		return m.clearedtoken_create
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenMintMutation) ClearEdge(name string) error {
+func (m *TokenFreezeMutation) ClearEdge(name string) error {
 	switch name {
+	case tokenfreeze.EdgeTokenCreate:
+		m.ClearTokenCreate()
+		return nil
 	}
-	return fmt.Errorf("unknown TokenMint unique edge %s", name)
+	return fmt.Errorf("unknown TokenFreeze unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenMintMutation) ResetEdge(name string) error {
+func (m *TokenFreezeMutation) ResetEdge(name string) error {
 	switch name {
-	case tokenmint.EdgeTokenTransaction:
-		m.ResetTokenTransaction()
+	case tokenfreeze.EdgeTokenCreate:
+		m.ResetTokenCreate()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenMint edge %s", name)
+	return fmt.Errorf("unknown TokenFreeze edge %s", name)
 }
 
-// TokenOutputMutation represents an operation that mutates the TokenOutput nodes in the graph.
-type TokenOutputMutation struct {
+// TokenIssuerKeyRotationMutation represents an operation that mutates the TokenIssuerKeyRotation nodes in the graph.
+type TokenIssuerKeyRotationMutation struct {
 	config
-	op                                             Op
-	typ                                            string
-	id                                             *uuid.UUID
-	create_time                                    *time.Time
-	update_time                                    *time.Time
-	status                                         *schematype.TokenOutputStatus
-	owner_public_key                               *[]byte
-	withdraw_bond_sats                             *uint64
-	addwithdraw_bond_sats                          *int64
-	withdraw_relative_block_locktime               *uint64
-	addwithdraw_relative_block_locktime            *int64
-	withdraw_revocation_commitment                 *[]byte
-	token_public_key                               *[]byte
-	token_amount                                   *[]byte
-	created_transaction_output_vout                *int32
-	addcreated_transaction_output_vout             *int32
-	spent_ownership_signature                      *[]byte
-	spent_operator_specific_ownership_signature    *[]byte
-	spent_transaction_input_vout                   *int32
-	addspent_transaction_input_vout                *int32
-	spent_revocation_secret                        *[]byte
-	confirmed_withdraw_block_hash                  *[]byte
-	network                                        *schematype.Network
-	token_identifier                               *[]byte
-	clearedFields                                  map[string]struct{}
-	revocation_keyshare                            *uuid.UUID
-	clearedrevocation_keyshare                     bool
-	output_created_token_transaction               *uuid.UUID
-	clearedoutput_created_token_transaction        bool
-	output_spent_token_transaction                 *uuid.UUID
-	clearedoutput_spent_token_transaction          bool
-	output_spent_started_token_transactions        map[uuid.UUID]struct{}
-	removedoutput_spent_started_token_transactions map[uuid.UUID]struct{}
-	clearedoutput_spent_started_token_transactions bool
-	token_partial_revocation_secret_shares         map[uuid.UUID]struct{}
-	removedtoken_partial_revocation_secret_shares  map[uuid.UUID]struct{}
-	clearedtoken_partial_revocation_secret_shares  bool
-	token_create                                   *uuid.UUID
-	clearedtoken_create                            bool
-	done                                           bool
-	oldValue                                       func(context.Context) (*TokenOutput, error)
-	predicates                                     []predicate.TokenOutput
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	create_time                  *time.Time
+	update_time                  *time.Time
+	previous_issuer_public_key   *[]byte
+	new_issuer_public_key        *[]byte
+	issuer_signature             *[]byte
+	issuer_provided_timestamp    *uint64
+	addissuer_provided_timestamp *int64
+	clearedFields                map[string]struct{}
+	token_create                 *uuid.UUID
+	clearedtoken_create          bool
+	done                         bool
+	oldValue                     func(context.Context) (*TokenIssuerKeyRotation, error)
+	predicates                   []predicate.TokenIssuerKeyRotation
 }
 
-var _ ent.Mutation = (*TokenOutputMutation)(nil)
+var _ ent.Mutation = (*TokenIssuerKeyRotationMutation)(nil)
 
-// tokenoutputOption allows management of the mutation configuration using functional options.
-type tokenoutputOption func(*TokenOutputMutation)
+// tokenissuerkeyrotationOption allows management of the mutation configuration using functional options.
+type tokenissuerkeyrotationOption func(*TokenIssuerKeyRotationMutation)
 
-// newTokenOutputMutation creates new mutation for the TokenOutput entity.
-func newTokenOutputMutation(c config, op Op, opts ...tokenoutputOption) *TokenOutputMutation {
-	m := &TokenOutputMutation{
+// newTokenIssuerKeyRotationMutation creates new mutation for the TokenIssuerKeyRotation entity.
+func newTokenIssuerKeyRotationMutation(c config, op Op, opts ...tokenissuerkeyrotationOption) *TokenIssuerKeyRotationMutation {
+	m := &TokenIssuerKeyRotationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTokenOutput,
+		typ:           TypeTokenIssuerKeyRotation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -12653,20 +13570,20 @@ func newTokenOutputMutation(c config, op Op, opts ...tokenoutputOption) *TokenOu
 	return m
 }
 
-// withTokenOutputID sets the ID field of the mutation.
-func withTokenOutputID(id uuid.UUID) tokenoutputOption {
-	return func(m *TokenOutputMutation) {
+// withTokenIssuerKeyRotationID sets the ID field of the mutation.
+func withTokenIssuerKeyRotationID(id uuid.UUID) tokenissuerkeyrotationOption {
+	return func(m *TokenIssuerKeyRotationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TokenOutput
+			value *TokenIssuerKeyRotation
 		)
-		m.oldValue = func(ctx context.Context) (*TokenOutput, error) {
+		m.oldValue = func(ctx context.Context) (*TokenIssuerKeyRotation, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TokenOutput.Get(ctx, id)
+					value, err = m.Client().TokenIssuerKeyRotation.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -12675,10 +13592,10 @@ func withTokenOutputID(id uuid.UUID) tokenoutputOption {
 	}
 }
 
-// withTokenOutput sets the old TokenOutput of the mutation.
-func withTokenOutput(node *TokenOutput) tokenoutputOption {
-	return func(m *TokenOutputMutation) {
-		m.oldValue = func(context.Context) (*TokenOutput, error) {
+// withTokenIssuerKeyRotation sets the old TokenIssuerKeyRotation of the mutation.
+func withTokenIssuerKeyRotation(node *TokenIssuerKeyRotation) tokenissuerkeyrotationOption {
+	return func(m *TokenIssuerKeyRotationMutation) {
+		m.oldValue = func(context.Context) (*TokenIssuerKeyRotation, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -12687,7 +13604,7 @@ func withTokenOutput(node *TokenOutput) tokenoutputOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenOutputMutation) Client() *Client {
+func (m TokenIssuerKeyRotationMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -12695,7 +13612,7 @@ func (m TokenOutputMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenOutputMutation) Tx() (*Tx, error) {
+func (m TokenIssuerKeyRotationMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -12705,14 +13622,14 @@ func (m TokenOutputMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenOutput entities.
-func (m *TokenOutputMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenIssuerKeyRotation entities.
+func (m *TokenIssuerKeyRotationMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenOutputMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenIssuerKeyRotationMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -12723,7 +13640,7 @@ func (m *TokenOutputMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenOutputMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenIssuerKeyRotationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -12732,19 +13649,19 @@ func (m *TokenOutputMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenOutput.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenIssuerKeyRotation.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TokenOutputMutation) SetCreateTime(t time.Time) {
+func (m *TokenIssuerKeyRotationMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenOutputMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenIssuerKeyRotationMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -12752,10 +13669,10 @@ func (m *TokenOutputMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -12770,17 +13687,17 @@ func (m *TokenOutputMutation) OldCreateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenOutputMutation) ResetCreateTime() {
+func (m *TokenIssuerKeyRotationMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TokenOutputMutation) SetUpdateTime(t time.Time) {
+func (m *TokenIssuerKeyRotationMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenOutputMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenIssuerKeyRotationMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -12788,10 +13705,10 @@ func (m *TokenOutputMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -12806,1019 +13723,1047 @@ func (m *TokenOutputMutation) OldUpdateTime(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenOutputMutation) ResetUpdateTime() {
+func (m *TokenIssuerKeyRotationMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *TokenOutputMutation) SetStatus(sos schematype.TokenOutputStatus) {
-	m.status = &sos
+// SetPreviousIssuerPublicKey sets the "previous_issuer_public_key" field.
+func (m *TokenIssuerKeyRotationMutation) SetPreviousIssuerPublicKey(b []byte) {
+	m.previous_issuer_public_key = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TokenOutputMutation) Status() (r schematype.TokenOutputStatus, exists bool) {
-	v := m.status
+// PreviousIssuerPublicKey returns the value of the "previous_issuer_public_key" field in the mutation.
+func (m *TokenIssuerKeyRotationMutation) PreviousIssuerPublicKey() (r []byte, exists bool) {
+	v := m.previous_issuer_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousIssuerPublicKey returns the old "previous_issuer_public_key" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldStatus(ctx context.Context) (v schematype.TokenOutputStatus, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldPreviousIssuerPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousIssuerPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPreviousIssuerPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousIssuerPublicKey: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.PreviousIssuerPublicKey, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TokenOutputMutation) ResetStatus() {
-	m.status = nil
+// ResetPreviousIssuerPublicKey resets all changes to the "previous_issuer_public_key" field.
+func (m *TokenIssuerKeyRotationMutation) ResetPreviousIssuerPublicKey() {
+	m.previous_issuer_public_key = nil
 }
 
-// SetOwnerPublicKey sets the "owner_public_key" field.
-func (m *TokenOutputMutation) SetOwnerPublicKey(b []byte) {
-	m.owner_public_key = &b
+// SetNewIssuerPublicKey sets the "new_issuer_public_key" field.
+func (m *TokenIssuerKeyRotationMutation) SetNewIssuerPublicKey(b []byte) {
+	m.new_issuer_public_key = &b
 }
 
-// OwnerPublicKey returns the value of the "owner_public_key" field in the mutation.
-func (m *TokenOutputMutation) OwnerPublicKey() (r []byte, exists bool) {
-	v := m.owner_public_key
+// NewIssuerPublicKey returns the value of the "new_issuer_public_key" field in the mutation.
+func (m *TokenIssuerKeyRotationMutation) NewIssuerPublicKey() (r []byte, exists bool) {
+	v := m.new_issuer_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerPublicKey returns the old "owner_public_key" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldNewIssuerPublicKey returns the old "new_issuer_public_key" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldOwnerPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldNewIssuerPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldNewIssuerPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldNewIssuerPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldNewIssuerPublicKey: %w", err)
 	}
-	return oldValue.OwnerPublicKey, nil
+	return oldValue.NewIssuerPublicKey, nil
 }
 
-// ResetOwnerPublicKey resets all changes to the "owner_public_key" field.
-func (m *TokenOutputMutation) ResetOwnerPublicKey() {
-	m.owner_public_key = nil
+// ResetNewIssuerPublicKey resets all changes to the "new_issuer_public_key" field.
+func (m *TokenIssuerKeyRotationMutation) ResetNewIssuerPublicKey() {
+	m.new_issuer_public_key = nil
 }
 
-// SetWithdrawBondSats sets the "withdraw_bond_sats" field.
-func (m *TokenOutputMutation) SetWithdrawBondSats(u uint64) {
-	m.withdraw_bond_sats = &u
-	m.addwithdraw_bond_sats = nil
+// SetIssuerSignature sets the "issuer_signature" field.
+func (m *TokenIssuerKeyRotationMutation) SetIssuerSignature(b []byte) {
+	m.issuer_signature = &b
 }
 
-// WithdrawBondSats returns the value of the "withdraw_bond_sats" field in the mutation.
-func (m *TokenOutputMutation) WithdrawBondSats() (r uint64, exists bool) {
-	v := m.withdraw_bond_sats
+// IssuerSignature returns the value of the "issuer_signature" field in the mutation.
+func (m *TokenIssuerKeyRotationMutation) IssuerSignature() (r []byte, exists bool) {
+	v := m.issuer_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWithdrawBondSats returns the old "withdraw_bond_sats" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldWithdrawBondSats(ctx context.Context) (v uint64, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWithdrawBondSats is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWithdrawBondSats requires an ID field in the mutation")
+		return v, errors.New("OldIssuerSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWithdrawBondSats: %w", err)
-	}
-	return oldValue.WithdrawBondSats, nil
-}
-
-// AddWithdrawBondSats adds u to the "withdraw_bond_sats" field.
-func (m *TokenOutputMutation) AddWithdrawBondSats(u int64) {
-	if m.addwithdraw_bond_sats != nil {
-		*m.addwithdraw_bond_sats += u
-	} else {
-		m.addwithdraw_bond_sats = &u
-	}
-}
-
-// AddedWithdrawBondSats returns the value that was added to the "withdraw_bond_sats" field in this mutation.
-func (m *TokenOutputMutation) AddedWithdrawBondSats() (r int64, exists bool) {
-	v := m.addwithdraw_bond_sats
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldIssuerSignature: %w", err)
 	}
-	return *v, true
+	return oldValue.IssuerSignature, nil
 }
 
-// ResetWithdrawBondSats resets all changes to the "withdraw_bond_sats" field.
-func (m *TokenOutputMutation) ResetWithdrawBondSats() {
-	m.withdraw_bond_sats = nil
-	m.addwithdraw_bond_sats = nil
+// ResetIssuerSignature resets all changes to the "issuer_signature" field.
+func (m *TokenIssuerKeyRotationMutation) ResetIssuerSignature() {
+	m.issuer_signature = nil
 }
 
-// SetWithdrawRelativeBlockLocktime sets the "withdraw_relative_block_locktime" field.
-func (m *TokenOutputMutation) SetWithdrawRelativeBlockLocktime(u uint64) {
-	m.withdraw_relative_block_locktime = &u
-	m.addwithdraw_relative_block_locktime = nil
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (m *TokenIssuerKeyRotationMutation) SetIssuerProvidedTimestamp(u uint64) {
+	m.issuer_provided_timestamp = &u
+	m.addissuer_provided_timestamp = nil
 }
 
-// WithdrawRelativeBlockLocktime returns the value of the "withdraw_relative_block_locktime" field in the mutation.
-func (m *TokenOutputMutation) WithdrawRelativeBlockLocktime() (r uint64, exists bool) {
-	v := m.withdraw_relative_block_locktime
+// IssuerProvidedTimestamp returns the value of the "issuer_provided_timestamp" field in the mutation.
+func (m *TokenIssuerKeyRotationMutation) IssuerProvidedTimestamp() (r uint64, exists bool) {
+	v := m.issuer_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWithdrawRelativeBlockLocktime returns the old "withdraw_relative_block_locktime" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuerProvidedTimestamp returns the old "issuer_provided_timestamp" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldWithdrawRelativeBlockLocktime(ctx context.Context) (v uint64, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldIssuerProvidedTimestamp(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWithdrawRelativeBlockLocktime is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuerProvidedTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWithdrawRelativeBlockLocktime requires an ID field in the mutation")
+		return v, errors.New("OldIssuerProvidedTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWithdrawRelativeBlockLocktime: %w", err)
+		return v, fmt.Errorf("querying old value for OldIssuerProvidedTimestamp: %w", err)
 	}
-	return oldValue.WithdrawRelativeBlockLocktime, nil
+	return oldValue.IssuerProvidedTimestamp, nil
 }
 
-// AddWithdrawRelativeBlockLocktime adds u to the "withdraw_relative_block_locktime" field.
-func (m *TokenOutputMutation) AddWithdrawRelativeBlockLocktime(u int64) {
-	if m.addwithdraw_relative_block_locktime != nil {
-		*m.addwithdraw_relative_block_locktime += u
+// AddIssuerProvidedTimestamp adds u to the "issuer_provided_timestamp" field.
+func (m *TokenIssuerKeyRotationMutation) AddIssuerProvidedTimestamp(u int64) {
+	if m.addissuer_provided_timestamp != nil {
+		*m.addissuer_provided_timestamp += u
 	} else {
-		m.addwithdraw_relative_block_locktime = &u
+		m.addissuer_provided_timestamp = &u
 	}
 }
 
-// AddedWithdrawRelativeBlockLocktime returns the value that was added to the "withdraw_relative_block_locktime" field in this mutation.
-func (m *TokenOutputMutation) AddedWithdrawRelativeBlockLocktime() (r int64, exists bool) {
-	v := m.addwithdraw_relative_block_locktime
+// AddedIssuerProvidedTimestamp returns the value that was added to the "issuer_provided_timestamp" field in this mutation.
+func (m *TokenIssuerKeyRotationMutation) AddedIssuerProvidedTimestamp() (r int64, exists bool) {
+	v := m.addissuer_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetWithdrawRelativeBlockLocktime resets all changes to the "withdraw_relative_block_locktime" field.
-func (m *TokenOutputMutation) ResetWithdrawRelativeBlockLocktime() {
-	m.withdraw_relative_block_locktime = nil
-	m.addwithdraw_relative_block_locktime = nil
+// ResetIssuerProvidedTimestamp resets all changes to the "issuer_provided_timestamp" field.
+func (m *TokenIssuerKeyRotationMutation) ResetIssuerProvidedTimestamp() {
+	m.issuer_provided_timestamp = nil
+	m.addissuer_provided_timestamp = nil
 }
 
-// SetWithdrawRevocationCommitment sets the "withdraw_revocation_commitment" field.
-func (m *TokenOutputMutation) SetWithdrawRevocationCommitment(b []byte) {
-	m.withdraw_revocation_commitment = &b
+// SetTokenCreateID sets the "token_create_id" field.
+func (m *TokenIssuerKeyRotationMutation) SetTokenCreateID(u uuid.UUID) {
+	m.token_create = &u
 }
 
-// WithdrawRevocationCommitment returns the value of the "withdraw_revocation_commitment" field in the mutation.
-func (m *TokenOutputMutation) WithdrawRevocationCommitment() (r []byte, exists bool) {
-	v := m.withdraw_revocation_commitment
+// TokenCreateID returns the value of the "token_create_id" field in the mutation.
+func (m *TokenIssuerKeyRotationMutation) TokenCreateID() (r uuid.UUID, exists bool) {
+	v := m.token_create
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWithdrawRevocationCommitment returns the old "withdraw_revocation_commitment" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenCreateID returns the old "token_create_id" field's value of the TokenIssuerKeyRotation entity.
+// If the TokenIssuerKeyRotation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldWithdrawRevocationCommitment(ctx context.Context) (v []byte, err error) {
+func (m *TokenIssuerKeyRotationMutation) OldTokenCreateID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWithdrawRevocationCommitment is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenCreateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWithdrawRevocationCommitment requires an ID field in the mutation")
+		return v, errors.New("OldTokenCreateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWithdrawRevocationCommitment: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenCreateID: %w", err)
 	}
-	return oldValue.WithdrawRevocationCommitment, nil
+	return oldValue.TokenCreateID, nil
 }
 
-// ResetWithdrawRevocationCommitment resets all changes to the "withdraw_revocation_commitment" field.
-func (m *TokenOutputMutation) ResetWithdrawRevocationCommitment() {
-	m.withdraw_revocation_commitment = nil
+// ResetTokenCreateID resets all changes to the "token_create_id" field.
+func (m *TokenIssuerKeyRotationMutation) ResetTokenCreateID() {
+	m.token_create = nil
 }
 
-// SetTokenPublicKey sets the "token_public_key" field.
-func (m *TokenOutputMutation) SetTokenPublicKey(b []byte) {
-	m.token_public_key = &b
+// ClearTokenCreate clears the "token_create" edge to the TokenCreate entity.
+func (m *TokenIssuerKeyRotationMutation) ClearTokenCreate() {
+	m.clearedtoken_create = true
+	m.clearedFields[tokenissuerkeyrotation.FieldTokenCreateID] = struct{}{}
 }
 
-// TokenPublicKey returns the value of the "token_public_key" field in the mutation.
-func (m *TokenOutputMutation) TokenPublicKey() (r []byte, exists bool) {
-	v := m.token_public_key
-	if v == nil {
-		return
-	}
-	return *v, true
+// TokenCreateCleared reports if the "token_create" edge to the TokenCreate entity was cleared.
+func (m *TokenIssuerKeyRotationMutation) TokenCreateCleared() bool {
+	return m.clearedtoken_create
 }
 
-// OldTokenPublicKey returns the old "token_public_key" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldTokenPublicKey(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenPublicKey is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenPublicKey requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenPublicKey: %w", err)
+// TokenCreateIDs returns the "token_create" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenCreateID instead. It exists only for internal usage by the builders.
+func (m *TokenIssuerKeyRotationMutation) TokenCreateIDs() (ids []uuid.UUID) {
+	if id := m.token_create; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.TokenPublicKey, nil
+	return
 }
 
-// ClearTokenPublicKey clears the value of the "token_public_key" field.
-func (m *TokenOutputMutation) ClearTokenPublicKey() {
-	m.token_public_key = nil
-	m.clearedFields[tokenoutput.FieldTokenPublicKey] = struct{}{}
+// ResetTokenCreate resets all changes to the "token_create" edge.
+func (m *TokenIssuerKeyRotationMutation) ResetTokenCreate() {
+	m.token_create = nil
+	m.clearedtoken_create = false
 }
 
-// TokenPublicKeyCleared returns if the "token_public_key" field was cleared in this mutation.
-func (m *TokenOutputMutation) TokenPublicKeyCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldTokenPublicKey]
-	return ok
+// Where appends a list predicates to the TokenIssuerKeyRotationMutation builder.
+func (m *TokenIssuerKeyRotationMutation) Where(ps ...predicate.TokenIssuerKeyRotation) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetTokenPublicKey resets all changes to the "token_public_key" field.
-func (m *TokenOutputMutation) ResetTokenPublicKey() {
-	m.token_public_key = nil
-	delete(m.clearedFields, tokenoutput.FieldTokenPublicKey)
+// WhereP appends storage-level predicates to the TokenIssuerKeyRotationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenIssuerKeyRotationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenIssuerKeyRotation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetTokenAmount sets the "token_amount" field.
-func (m *TokenOutputMutation) SetTokenAmount(b []byte) {
-	m.token_amount = &b
+// Op returns the operation name.
+func (m *TokenIssuerKeyRotationMutation) Op() Op {
+	return m.op
 }
 
-// TokenAmount returns the value of the "token_amount" field in the mutation.
-func (m *TokenOutputMutation) TokenAmount() (r []byte, exists bool) {
-	v := m.token_amount
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *TokenIssuerKeyRotationMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldTokenAmount returns the old "token_amount" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldTokenAmount(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenAmount is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (TokenIssuerKeyRotation).
+func (m *TokenIssuerKeyRotationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenIssuerKeyRotationMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldCreateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenAmount requires an ID field in the mutation")
+	if m.update_time != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldUpdateTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenAmount: %w", err)
+	if m.previous_issuer_public_key != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldPreviousIssuerPublicKey)
 	}
-	return oldValue.TokenAmount, nil
+	if m.new_issuer_public_key != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldNewIssuerPublicKey)
+	}
+	if m.issuer_signature != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldIssuerSignature)
+	}
+	if m.issuer_provided_timestamp != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldIssuerProvidedTimestamp)
+	}
+	if m.token_create != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldTokenCreateID)
+	}
+	return fields
 }
 
-// ResetTokenAmount resets all changes to the "token_amount" field.
-func (m *TokenOutputMutation) ResetTokenAmount() {
-	m.token_amount = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenIssuerKeyRotationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenissuerkeyrotation.FieldCreateTime:
+		return m.CreateTime()
+	case tokenissuerkeyrotation.FieldUpdateTime:
+		return m.UpdateTime()
+	case tokenissuerkeyrotation.FieldPreviousIssuerPublicKey:
+		return m.PreviousIssuerPublicKey()
+	case tokenissuerkeyrotation.FieldNewIssuerPublicKey:
+		return m.NewIssuerPublicKey()
+	case tokenissuerkeyrotation.FieldIssuerSignature:
+		return m.IssuerSignature()
+	case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+		return m.IssuerProvidedTimestamp()
+	case tokenissuerkeyrotation.FieldTokenCreateID:
+		return m.TokenCreateID()
+	}
+	return nil, false
 }
 
-// SetCreatedTransactionOutputVout sets the "created_transaction_output_vout" field.
-func (m *TokenOutputMutation) SetCreatedTransactionOutputVout(i int32) {
-	m.created_transaction_output_vout = &i
-	m.addcreated_transaction_output_vout = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenIssuerKeyRotationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenissuerkeyrotation.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tokenissuerkeyrotation.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tokenissuerkeyrotation.FieldPreviousIssuerPublicKey:
+		return m.OldPreviousIssuerPublicKey(ctx)
+	case tokenissuerkeyrotation.FieldNewIssuerPublicKey:
+		return m.OldNewIssuerPublicKey(ctx)
+	case tokenissuerkeyrotation.FieldIssuerSignature:
+		return m.OldIssuerSignature(ctx)
+	case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+		return m.OldIssuerProvidedTimestamp(ctx)
+	case tokenissuerkeyrotation.FieldTokenCreateID:
+		return m.OldTokenCreateID(ctx)
+	}
+	return nil, fmt.Errorf("unknown TokenIssuerKeyRotation field %s", name)
 }
 
-// CreatedTransactionOutputVout returns the value of the "created_transaction_output_vout" field in the mutation.
-func (m *TokenOutputMutation) CreatedTransactionOutputVout() (r int32, exists bool) {
-	v := m.created_transaction_output_vout
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenIssuerKeyRotationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenissuerkeyrotation.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tokenissuerkeyrotation.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tokenissuerkeyrotation.FieldPreviousIssuerPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousIssuerPublicKey(v)
+		return nil
+	case tokenissuerkeyrotation.FieldNewIssuerPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNewIssuerPublicKey(v)
+		return nil
+	case tokenissuerkeyrotation.FieldIssuerSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerSignature(v)
+		return nil
+	case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIssuerProvidedTimestamp(v)
+		return nil
+	case tokenissuerkeyrotation.FieldTokenCreateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenCreateID(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenIssuerKeyRotation field %s", name)
 }
 
-// OldCreatedTransactionOutputVout returns the old "created_transaction_output_vout" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldCreatedTransactionOutputVout(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedTransactionOutputVout is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedTransactionOutputVout requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedTransactionOutputVout: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenIssuerKeyRotationMutation) AddedFields() []string {
+	var fields []string
+	if m.addissuer_provided_timestamp != nil {
+		fields = append(fields, tokenissuerkeyrotation.FieldIssuerProvidedTimestamp)
 	}
-	return oldValue.CreatedTransactionOutputVout, nil
+	return fields
 }
 
-// AddCreatedTransactionOutputVout adds i to the "created_transaction_output_vout" field.
-func (m *TokenOutputMutation) AddCreatedTransactionOutputVout(i int32) {
-	if m.addcreated_transaction_output_vout != nil {
-		*m.addcreated_transaction_output_vout += i
-	} else {
-		m.addcreated_transaction_output_vout = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenIssuerKeyRotationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+		return m.AddedIssuerProvidedTimestamp()
 	}
+	return nil, false
 }
 
-// AddedCreatedTransactionOutputVout returns the value that was added to the "created_transaction_output_vout" field in this mutation.
-func (m *TokenOutputMutation) AddedCreatedTransactionOutputVout() (r int32, exists bool) {
-	v := m.addcreated_transaction_output_vout
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenIssuerKeyRotationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIssuerProvidedTimestamp(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenIssuerKeyRotation numeric field %s", name)
 }
 
-// ResetCreatedTransactionOutputVout resets all changes to the "created_transaction_output_vout" field.
-func (m *TokenOutputMutation) ResetCreatedTransactionOutputVout() {
-	m.created_transaction_output_vout = nil
-	m.addcreated_transaction_output_vout = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenIssuerKeyRotationMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetSpentOwnershipSignature sets the "spent_ownership_signature" field.
-func (m *TokenOutputMutation) SetSpentOwnershipSignature(b []byte) {
-	m.spent_ownership_signature = &b
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenIssuerKeyRotationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SpentOwnershipSignature returns the value of the "spent_ownership_signature" field in the mutation.
-func (m *TokenOutputMutation) SpentOwnershipSignature() (r []byte, exists bool) {
-	v := m.spent_ownership_signature
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenIssuerKeyRotationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TokenIssuerKeyRotation nullable field %s", name)
 }
 
-// OldSpentOwnershipSignature returns the old "spent_ownership_signature" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldSpentOwnershipSignature(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpentOwnershipSignature is only allowed on UpdateOne operations")
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenIssuerKeyRotationMutation) ResetField(name string) error {
+	switch name {
+	case tokenissuerkeyrotation.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tokenissuerkeyrotation.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tokenissuerkeyrotation.FieldPreviousIssuerPublicKey:
+		m.ResetPreviousIssuerPublicKey()
+		return nil
+	case tokenissuerkeyrotation.FieldNewIssuerPublicKey:
+		m.ResetNewIssuerPublicKey()
+		return nil
+	case tokenissuerkeyrotation.FieldIssuerSignature:
+		m.ResetIssuerSignature()
+		return nil
+	case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+		m.ResetIssuerProvidedTimestamp()
+		return nil
+	case tokenissuerkeyrotation.FieldTokenCreateID:
+		m.ResetTokenCreateID()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpentOwnershipSignature requires an ID field in the mutation")
+	return fmt.Errorf("unknown TokenIssuerKeyRotation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenIssuerKeyRotationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.token_create != nil {
+		edges = append(edges, tokenissuerkeyrotation.EdgeTokenCreate)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpentOwnershipSignature: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenIssuerKeyRotationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tokenissuerkeyrotation.EdgeTokenCreate:
+		if id := m.token_create; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.SpentOwnershipSignature, nil
+	return nil
 }
 
-// ClearSpentOwnershipSignature clears the value of the "spent_ownership_signature" field.
-func (m *TokenOutputMutation) ClearSpentOwnershipSignature() {
-	m.spent_ownership_signature = nil
-	m.clearedFields[tokenoutput.FieldSpentOwnershipSignature] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenIssuerKeyRotationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SpentOwnershipSignatureCleared returns if the "spent_ownership_signature" field was cleared in this mutation.
-func (m *TokenOutputMutation) SpentOwnershipSignatureCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldSpentOwnershipSignature]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenIssuerKeyRotationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetSpentOwnershipSignature resets all changes to the "spent_ownership_signature" field.
-func (m *TokenOutputMutation) ResetSpentOwnershipSignature() {
-	m.spent_ownership_signature = nil
-	delete(m.clearedFields, tokenoutput.FieldSpentOwnershipSignature)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenIssuerKeyRotationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtoken_create {
+		edges = append(edges, tokenissuerkeyrotation.EdgeTokenCreate)
+	}
+	return edges
 }
 
-// SetSpentOperatorSpecificOwnershipSignature sets the "spent_operator_specific_ownership_signature" field.
-func (m *TokenOutputMutation) SetSpentOperatorSpecificOwnershipSignature(b []byte) {
-	m.spent_operator_specific_ownership_signature = &b
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenIssuerKeyRotationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tokenissuerkeyrotation.EdgeTokenCreate:
+		return m.clearedtoken_create
+	}
+	return false
 }
 
-// SpentOperatorSpecificOwnershipSignature returns the value of the "spent_operator_specific_ownership_signature" field in the mutation.
-func (m *TokenOutputMutation) SpentOperatorSpecificOwnershipSignature() (r []byte, exists bool) {
-	v := m.spent_operator_specific_ownership_signature
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenIssuerKeyRotationMutation) ClearEdge(name string) error {
+	switch name {
+	case tokenissuerkeyrotation.EdgeTokenCreate:
+		m.ClearTokenCreate()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenIssuerKeyRotation unique edge %s", name)
 }
 
-// OldSpentOperatorSpecificOwnershipSignature returns the old "spent_operator_specific_ownership_signature" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldSpentOperatorSpecificOwnershipSignature(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpentOperatorSpecificOwnershipSignature is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpentOperatorSpecificOwnershipSignature requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpentOperatorSpecificOwnershipSignature: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenIssuerKeyRotationMutation) ResetEdge(name string) error {
+	switch name {
+	case tokenissuerkeyrotation.EdgeTokenCreate:
+		m.ResetTokenCreate()
+		return nil
 	}
-	return oldValue.SpentOperatorSpecificOwnershipSignature, nil
+	return fmt.Errorf("unknown TokenIssuerKeyRotation edge %s", name)
 }
 
-// ClearSpentOperatorSpecificOwnershipSignature clears the value of the "spent_operator_specific_ownership_signature" field.
-func (m *TokenOutputMutation) ClearSpentOperatorSpecificOwnershipSignature() {
-	m.spent_operator_specific_ownership_signature = nil
-	m.clearedFields[tokenoutput.FieldSpentOperatorSpecificOwnershipSignature] = struct{}{}
+// TokenMintMutation represents an operation that mutates the TokenMint nodes in the graph.
+type TokenMintMutation struct {
+	config
+	op                                 Op
+	typ                                string
+	id                                 *uuid.UUID
+	create_time                        *time.Time
+	update_time                        *time.Time
+	issuer_public_key                  *[]byte
+	wallet_provided_timestamp          *uint64
+	addwallet_provided_timestamp       *int64
+	issuer_signature                   *[]byte
+	operator_specific_issuer_signature *[]byte
+	token_identifier                   *[]byte
+	clearedFields                      map[string]struct{}
+	token_transaction                  map[uuid.UUID]struct{}
+	removedtoken_transaction           map[uuid.UUID]struct{}
+	clearedtoken_transaction           bool
+	done                               bool
+	oldValue                           func(context.Context) (*TokenMint, error)
+	predicates                         []predicate.TokenMint
 }
 
-// SpentOperatorSpecificOwnershipSignatureCleared returns if the "spent_operator_specific_ownership_signature" field was cleared in this mutation.
-func (m *TokenOutputMutation) SpentOperatorSpecificOwnershipSignatureCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldSpentOperatorSpecificOwnershipSignature]
-	return ok
-}
+var _ ent.Mutation = (*TokenMintMutation)(nil)
 
-// ResetSpentOperatorSpecificOwnershipSignature resets all changes to the "spent_operator_specific_ownership_signature" field.
-func (m *TokenOutputMutation) ResetSpentOperatorSpecificOwnershipSignature() {
-	m.spent_operator_specific_ownership_signature = nil
-	delete(m.clearedFields, tokenoutput.FieldSpentOperatorSpecificOwnershipSignature)
-}
+// tokenmintOption allows management of the mutation configuration using functional options.
+type tokenmintOption func(*TokenMintMutation)
 
-// SetSpentTransactionInputVout sets the "spent_transaction_input_vout" field.
-func (m *TokenOutputMutation) SetSpentTransactionInputVout(i int32) {
-	m.spent_transaction_input_vout = &i
-	m.addspent_transaction_input_vout = nil
+// newTokenMintMutation creates new mutation for the TokenMint entity.
+func newTokenMintMutation(c config, op Op, opts ...tokenmintOption) *TokenMintMutation {
+	m := &TokenMintMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenMint,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SpentTransactionInputVout returns the value of the "spent_transaction_input_vout" field in the mutation.
-func (m *TokenOutputMutation) SpentTransactionInputVout() (r int32, exists bool) {
-	v := m.spent_transaction_input_vout
-	if v == nil {
-		return
+// withTokenMintID sets the ID field of the mutation.
+func withTokenMintID(id uuid.UUID) tokenmintOption {
+	return func(m *TokenMintMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenMint
+		)
+		m.oldValue = func(ctx context.Context) (*TokenMint, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenMint.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldSpentTransactionInputVout returns the old "spent_transaction_input_vout" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldSpentTransactionInputVout(ctx context.Context) (v int32, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpentTransactionInputVout is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpentTransactionInputVout requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpentTransactionInputVout: %w", err)
+// withTokenMint sets the old TokenMint of the mutation.
+func withTokenMint(node *TokenMint) tokenmintOption {
+	return func(m *TokenMintMutation) {
+		m.oldValue = func(context.Context) (*TokenMint, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.SpentTransactionInputVout, nil
 }
 
-// AddSpentTransactionInputVout adds i to the "spent_transaction_input_vout" field.
-func (m *TokenOutputMutation) AddSpentTransactionInputVout(i int32) {
-	if m.addspent_transaction_input_vout != nil {
-		*m.addspent_transaction_input_vout += i
-	} else {
-		m.addspent_transaction_input_vout = &i
-	}
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMintMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddedSpentTransactionInputVout returns the value that was added to the "spent_transaction_input_vout" field in this mutation.
-func (m *TokenOutputMutation) AddedSpentTransactionInputVout() (r int32, exists bool) {
-	v := m.addspent_transaction_input_vout
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMintMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearSpentTransactionInputVout clears the value of the "spent_transaction_input_vout" field.
-func (m *TokenOutputMutation) ClearSpentTransactionInputVout() {
-	m.spent_transaction_input_vout = nil
-	m.addspent_transaction_input_vout = nil
-	m.clearedFields[tokenoutput.FieldSpentTransactionInputVout] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenMint entities.
+func (m *TokenMintMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// SpentTransactionInputVoutCleared returns if the "spent_transaction_input_vout" field was cleared in this mutation.
-func (m *TokenOutputMutation) SpentTransactionInputVoutCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldSpentTransactionInputVout]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenMintMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetSpentTransactionInputVout resets all changes to the "spent_transaction_input_vout" field.
-func (m *TokenOutputMutation) ResetSpentTransactionInputVout() {
-	m.spent_transaction_input_vout = nil
-	m.addspent_transaction_input_vout = nil
-	delete(m.clearedFields, tokenoutput.FieldSpentTransactionInputVout)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenMintMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenMint.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetSpentRevocationSecret sets the "spent_revocation_secret" field.
-func (m *TokenOutputMutation) SetSpentRevocationSecret(b []byte) {
-	m.spent_revocation_secret = &b
+// SetCreateTime sets the "create_time" field.
+func (m *TokenMintMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// SpentRevocationSecret returns the value of the "spent_revocation_secret" field in the mutation.
-func (m *TokenOutputMutation) SpentRevocationSecret() (r []byte, exists bool) {
-	v := m.spent_revocation_secret
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TokenMintMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSpentRevocationSecret returns the old "spent_revocation_secret" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldSpentRevocationSecret(ctx context.Context) (v []byte, err error) {
+func (m *TokenMintMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSpentRevocationSecret is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSpentRevocationSecret requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSpentRevocationSecret: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.SpentRevocationSecret, nil
-}
-
-// ClearSpentRevocationSecret clears the value of the "spent_revocation_secret" field.
-func (m *TokenOutputMutation) ClearSpentRevocationSecret() {
-	m.spent_revocation_secret = nil
-	m.clearedFields[tokenoutput.FieldSpentRevocationSecret] = struct{}{}
-}
-
-// SpentRevocationSecretCleared returns if the "spent_revocation_secret" field was cleared in this mutation.
-func (m *TokenOutputMutation) SpentRevocationSecretCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldSpentRevocationSecret]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetSpentRevocationSecret resets all changes to the "spent_revocation_secret" field.
-func (m *TokenOutputMutation) ResetSpentRevocationSecret() {
-	m.spent_revocation_secret = nil
-	delete(m.clearedFields, tokenoutput.FieldSpentRevocationSecret)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TokenMintMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SetConfirmedWithdrawBlockHash sets the "confirmed_withdraw_block_hash" field.
-func (m *TokenOutputMutation) SetConfirmedWithdrawBlockHash(b []byte) {
-	m.confirmed_withdraw_block_hash = &b
+// SetUpdateTime sets the "update_time" field.
+func (m *TokenMintMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// ConfirmedWithdrawBlockHash returns the value of the "confirmed_withdraw_block_hash" field in the mutation.
-func (m *TokenOutputMutation) ConfirmedWithdrawBlockHash() (r []byte, exists bool) {
-	v := m.confirmed_withdraw_block_hash
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TokenMintMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConfirmedWithdrawBlockHash returns the old "confirmed_withdraw_block_hash" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldConfirmedWithdrawBlockHash(ctx context.Context) (v []byte, err error) {
+func (m *TokenMintMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConfirmedWithdrawBlockHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConfirmedWithdrawBlockHash requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConfirmedWithdrawBlockHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.ConfirmedWithdrawBlockHash, nil
+	return oldValue.UpdateTime, nil
 }
 
-// ClearConfirmedWithdrawBlockHash clears the value of the "confirmed_withdraw_block_hash" field.
-func (m *TokenOutputMutation) ClearConfirmedWithdrawBlockHash() {
-	m.confirmed_withdraw_block_hash = nil
-	m.clearedFields[tokenoutput.FieldConfirmedWithdrawBlockHash] = struct{}{}
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TokenMintMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// ConfirmedWithdrawBlockHashCleared returns if the "confirmed_withdraw_block_hash" field was cleared in this mutation.
-func (m *TokenOutputMutation) ConfirmedWithdrawBlockHashCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldConfirmedWithdrawBlockHash]
-	return ok
+// SetIssuerPublicKey sets the "issuer_public_key" field.
+func (m *TokenMintMutation) SetIssuerPublicKey(b []byte) {
+	m.issuer_public_key = &b
 }
 
-// ResetConfirmedWithdrawBlockHash resets all changes to the "confirmed_withdraw_block_hash" field.
-func (m *TokenOutputMutation) ResetConfirmedWithdrawBlockHash() {
-	m.confirmed_withdraw_block_hash = nil
-	delete(m.clearedFields, tokenoutput.FieldConfirmedWithdrawBlockHash)
+// IssuerPublicKey returns the value of the "issuer_public_key" field in the mutation.
+func (m *TokenMintMutation) IssuerPublicKey() (r []byte, exists bool) {
+	v := m.issuer_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetNetwork sets the "network" field.
-func (m *TokenOutputMutation) SetNetwork(s schematype.Network) {
-	m.network = &s
+// OldIssuerPublicKey returns the old "issuer_public_key" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMintMutation) OldIssuerPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIssuerPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIssuerPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIssuerPublicKey: %w", err)
+	}
+	return oldValue.IssuerPublicKey, nil
 }
 
-// Network returns the value of the "network" field in the mutation.
-func (m *TokenOutputMutation) Network() (r schematype.Network, exists bool) {
-	v := m.network
+// ResetIssuerPublicKey resets all changes to the "issuer_public_key" field.
+func (m *TokenMintMutation) ResetIssuerPublicKey() {
+	m.issuer_public_key = nil
+}
+
+// SetWalletProvidedTimestamp sets the "wallet_provided_timestamp" field.
+func (m *TokenMintMutation) SetWalletProvidedTimestamp(u uint64) {
+	m.wallet_provided_timestamp = &u
+	m.addwallet_provided_timestamp = nil
+}
+
+// WalletProvidedTimestamp returns the value of the "wallet_provided_timestamp" field in the mutation.
+func (m *TokenMintMutation) WalletProvidedTimestamp() (r uint64, exists bool) {
+	v := m.wallet_provided_timestamp
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNetwork returns the old "network" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldWalletProvidedTimestamp returns the old "wallet_provided_timestamp" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldNetwork(ctx context.Context) (v schematype.Network, err error) {
+func (m *TokenMintMutation) OldWalletProvidedTimestamp(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+		return v, errors.New("OldWalletProvidedTimestamp is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNetwork requires an ID field in the mutation")
+		return v, errors.New("OldWalletProvidedTimestamp requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+		return v, fmt.Errorf("querying old value for OldWalletProvidedTimestamp: %w", err)
 	}
-	return oldValue.Network, nil
+	return oldValue.WalletProvidedTimestamp, nil
 }
 
-// ClearNetwork clears the value of the "network" field.
-func (m *TokenOutputMutation) ClearNetwork() {
-	m.network = nil
-	m.clearedFields[tokenoutput.FieldNetwork] = struct{}{}
+// AddWalletProvidedTimestamp adds u to the "wallet_provided_timestamp" field.
+func (m *TokenMintMutation) AddWalletProvidedTimestamp(u int64) {
+	if m.addwallet_provided_timestamp != nil {
+		*m.addwallet_provided_timestamp += u
+	} else {
+		m.addwallet_provided_timestamp = &u
+	}
 }
 
-// NetworkCleared returns if the "network" field was cleared in this mutation.
-func (m *TokenOutputMutation) NetworkCleared() bool {
-	_, ok := m.clearedFields[tokenoutput.FieldNetwork]
-	return ok
+// AddedWalletProvidedTimestamp returns the value that was added to the "wallet_provided_timestamp" field in this mutation.
+func (m *TokenMintMutation) AddedWalletProvidedTimestamp() (r int64, exists bool) {
+	v := m.addwallet_provided_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetNetwork resets all changes to the "network" field.
-func (m *TokenOutputMutation) ResetNetwork() {
-	m.network = nil
-	delete(m.clearedFields, tokenoutput.FieldNetwork)
+// ResetWalletProvidedTimestamp resets all changes to the "wallet_provided_timestamp" field.
+func (m *TokenMintMutation) ResetWalletProvidedTimestamp() {
+	m.wallet_provided_timestamp = nil
+	m.addwallet_provided_timestamp = nil
 }
 
-// SetTokenIdentifier sets the "token_identifier" field.
-func (m *TokenOutputMutation) SetTokenIdentifier(b []byte) {
-	m.token_identifier = &b
+// SetIssuerSignature sets the "issuer_signature" field.
+func (m *TokenMintMutation) SetIssuerSignature(b []byte) {
+	m.issuer_signature = &b
 }
 
-// TokenIdentifier returns the value of the "token_identifier" field in the mutation.
-func (m *TokenOutputMutation) TokenIdentifier() (r []byte, exists bool) {
-	v := m.token_identifier
+// IssuerSignature returns the value of the "issuer_signature" field in the mutation.
+func (m *TokenMintMutation) IssuerSignature() (r []byte, exists bool) {
+	v := m.issuer_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenIdentifier returns the old "token_identifier" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldIssuerSignature returns the old "issuer_signature" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldTokenIdentifier(ctx context.Context) (v []byte, err error) {
+func (m *TokenMintMutation) OldIssuerSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenIdentifier is only allowed on UpdateOne operations")
+		return v, errors.New("OldIssuerSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenIdentifier requires an ID field in the mutation")
+		return v, errors.New("OldIssuerSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenIdentifier: %w", err)
+		return v, fmt.Errorf("querying old value for OldIssuerSignature: %w", err)
 	}
-	return oldValue.TokenIdentifier, nil
+	return oldValue.IssuerSignature, nil
 }
 
-// ResetTokenIdentifier resets all changes to the "token_identifier" field.
-func (m *TokenOutputMutation) ResetTokenIdentifier() {
-	m.token_identifier = nil
+// ResetIssuerSignature resets all changes to the "issuer_signature" field.
+func (m *TokenMintMutation) ResetIssuerSignature() {
+	m.issuer_signature = nil
 }
 
-// SetTokenCreateID sets the "token_create_id" field.
-func (m *TokenOutputMutation) SetTokenCreateID(u uuid.UUID) {
-	m.token_create = &u
+// SetOperatorSpecificIssuerSignature sets the "operator_specific_issuer_signature" field.
+func (m *TokenMintMutation) SetOperatorSpecificIssuerSignature(b []byte) {
+	m.operator_specific_issuer_signature = &b
 }
 
-// TokenCreateID returns the value of the "token_create_id" field in the mutation.
-func (m *TokenOutputMutation) TokenCreateID() (r uuid.UUID, exists bool) {
-	v := m.token_create
+// OperatorSpecificIssuerSignature returns the value of the "operator_specific_issuer_signature" field in the mutation.
+func (m *TokenMintMutation) OperatorSpecificIssuerSignature() (r []byte, exists bool) {
+	v := m.operator_specific_issuer_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTokenCreateID returns the old "token_create_id" field's value of the TokenOutput entity.
-// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// OldOperatorSpecificIssuerSignature returns the old "operator_specific_issuer_signature" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenOutputMutation) OldTokenCreateID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TokenMintMutation) OldOperatorSpecificIssuerSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTokenCreateID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperatorSpecificIssuerSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTokenCreateID requires an ID field in the mutation")
+		return v, errors.New("OldOperatorSpecificIssuerSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTokenCreateID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperatorSpecificIssuerSignature: %w", err)
 	}
-	return oldValue.TokenCreateID, nil
+	return oldValue.OperatorSpecificIssuerSignature, nil
 }
 
-// ResetTokenCreateID resets all changes to the "token_create_id" field.
-func (m *TokenOutputMutation) ResetTokenCreateID() {
-	m.token_create = nil
+// ClearOperatorSpecificIssuerSignature clears the value of the "operator_specific_issuer_signature" field.
+func (m *TokenMintMutation) ClearOperatorSpecificIssuerSignature() {
+	m.operator_specific_issuer_signature = nil
+	m.clearedFields[tokenmint.FieldOperatorSpecificIssuerSignature] = struct{}{}
 }
 
-// SetRevocationKeyshareID sets the "revocation_keyshare" edge to the SigningKeyshare entity by id.
-func (m *TokenOutputMutation) SetRevocationKeyshareID(id uuid.UUID) {
-	m.revocation_keyshare = &id
+// OperatorSpecificIssuerSignatureCleared returns if the "operator_specific_issuer_signature" field was cleared in this mutation.
+func (m *TokenMintMutation) OperatorSpecificIssuerSignatureCleared() bool {
+	_, ok := m.clearedFields[tokenmint.FieldOperatorSpecificIssuerSignature]
+	return ok
 }
 
-// ClearRevocationKeyshare clears the "revocation_keyshare" edge to the SigningKeyshare entity.
-func (m *TokenOutputMutation) ClearRevocationKeyshare() {
-	m.clearedrevocation_keyshare = true
+// ResetOperatorSpecificIssuerSignature resets all changes to the "operator_specific_issuer_signature" field.
+func (m *TokenMintMutation) ResetOperatorSpecificIssuerSignature() {
+	m.operator_specific_issuer_signature = nil
+	delete(m.clearedFields, tokenmint.FieldOperatorSpecificIssuerSignature)
 }
 
-// RevocationKeyshareCleared reports if the "revocation_keyshare" edge to the SigningKeyshare entity was cleared.
-func (m *TokenOutputMutation) RevocationKeyshareCleared() bool {
-	return m.clearedrevocation_keyshare
+// SetTokenIdentifier sets the "token_identifier" field.
+func (m *TokenMintMutation) SetTokenIdentifier(b []byte) {
+	m.token_identifier = &b
 }
 
-// RevocationKeyshareID returns the "revocation_keyshare" edge ID in the mutation.
-func (m *TokenOutputMutation) RevocationKeyshareID() (id uuid.UUID, exists bool) {
-	if m.revocation_keyshare != nil {
-		return *m.revocation_keyshare, true
+// TokenIdentifier returns the value of the "token_identifier" field in the mutation.
+func (m *TokenMintMutation) TokenIdentifier() (r []byte, exists bool) {
+	v := m.token_identifier
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RevocationKeyshareIDs returns the "revocation_keyshare" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RevocationKeyshareID instead. It exists only for internal usage by the builders.
-func (m *TokenOutputMutation) RevocationKeyshareIDs() (ids []uuid.UUID) {
-	if id := m.revocation_keyshare; id != nil {
-		ids = append(ids, *id)
+// OldTokenIdentifier returns the old "token_identifier" field's value of the TokenMint entity.
+// If the TokenMint object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenMintMutation) OldTokenIdentifier(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenIdentifier is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenIdentifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenIdentifier: %w", err)
+	}
+	return oldValue.TokenIdentifier, nil
 }
 
-// ResetRevocationKeyshare resets all changes to the "revocation_keyshare" edge.
-func (m *TokenOutputMutation) ResetRevocationKeyshare() {
-	m.revocation_keyshare = nil
-	m.clearedrevocation_keyshare = false
+// ClearTokenIdentifier clears the value of the "token_identifier" field.
+func (m *TokenMintMutation) ClearTokenIdentifier() {
+	m.token_identifier = nil
+	m.clearedFields[tokenmint.FieldTokenIdentifier] = struct{}{}
 }
 
-// SetOutputCreatedTokenTransactionID sets the "output_created_token_transaction" edge to the TokenTransaction entity by id.
-func (m *TokenOutputMutation) SetOutputCreatedTokenTransactionID(id uuid.UUID) {
-	m.output_created_token_transaction = &id
+// TokenIdentifierCleared returns if the "token_identifier" field was cleared in this mutation.
+func (m *TokenMintMutation) TokenIdentifierCleared() bool {
+	_, ok := m.clearedFields[tokenmint.FieldTokenIdentifier]
+	return ok
 }
 
-// ClearOutputCreatedTokenTransaction clears the "output_created_token_transaction" edge to the TokenTransaction entity.
-func (m *TokenOutputMutation) ClearOutputCreatedTokenTransaction() {
-	m.clearedoutput_created_token_transaction = true
+// ResetTokenIdentifier resets all changes to the "token_identifier" field.
+func (m *TokenMintMutation) ResetTokenIdentifier() {
+	m.token_identifier = nil
+	delete(m.clearedFields, tokenmint.FieldTokenIdentifier)
 }
 
-// OutputCreatedTokenTransactionCleared reports if the "output_created_token_transaction" edge to the TokenTransaction entity was cleared.
-func (m *TokenOutputMutation) OutputCreatedTokenTransactionCleared() bool {
-	return m.clearedoutput_created_token_transaction
+// AddTokenTransactionIDs adds the "token_transaction" edge to the TokenTransaction entity by ids.
+func (m *TokenMintMutation) AddTokenTransactionIDs(ids ...uuid.UUID) {
+	if m.token_transaction == nil {
+		m.token_transaction = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.token_transaction[ids[i]] = struct{}{}
+	}
 }
 
-// OutputCreatedTokenTransactionID returns the "output_created_token_transaction" edge ID in the mutation.
-func (m *TokenOutputMutation) OutputCreatedTokenTransactionID() (id uuid.UUID, exists bool) {
-	if m.output_created_token_transaction != nil {
-		return *m.output_created_token_transaction, true
-	}
-	return
-}
-
-// OutputCreatedTokenTransactionIDs returns the "output_created_token_transaction" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OutputCreatedTokenTransactionID instead. It exists only for internal usage by the builders.
-func (m *TokenOutputMutation) OutputCreatedTokenTransactionIDs() (ids []uuid.UUID) {
-	if id := m.output_created_token_transaction; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOutputCreatedTokenTransaction resets all changes to the "output_created_token_transaction" edge.
-func (m *TokenOutputMutation) ResetOutputCreatedTokenTransaction() {
-	m.output_created_token_transaction = nil
-	m.clearedoutput_created_token_transaction = false
-}
-
-// SetOutputSpentTokenTransactionID sets the "output_spent_token_transaction" edge to the TokenTransaction entity by id.
-func (m *TokenOutputMutation) SetOutputSpentTokenTransactionID(id uuid.UUID) {
-	m.output_spent_token_transaction = &id
-}
-
-// ClearOutputSpentTokenTransaction clears the "output_spent_token_transaction" edge to the TokenTransaction entity.
-func (m *TokenOutputMutation) ClearOutputSpentTokenTransaction() {
-	m.clearedoutput_spent_token_transaction = true
-}
-
-// OutputSpentTokenTransactionCleared reports if the "output_spent_token_transaction" edge to the TokenTransaction entity was cleared.
-func (m *TokenOutputMutation) OutputSpentTokenTransactionCleared() bool {
-	return m.clearedoutput_spent_token_transaction
-}
-
-// OutputSpentTokenTransactionID returns the "output_spent_token_transaction" edge ID in the mutation.
-func (m *TokenOutputMutation) OutputSpentTokenTransactionID() (id uuid.UUID, exists bool) {
-	if m.output_spent_token_transaction != nil {
-		return *m.output_spent_token_transaction, true
-	}
-	return
-}
-
-// OutputSpentTokenTransactionIDs returns the "output_spent_token_transaction" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OutputSpentTokenTransactionID instead. It exists only for internal usage by the builders.
-func (m *TokenOutputMutation) OutputSpentTokenTransactionIDs() (ids []uuid.UUID) {
-	if id := m.output_spent_token_transaction; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetOutputSpentTokenTransaction resets all changes to the "output_spent_token_transaction" edge.
-func (m *TokenOutputMutation) ResetOutputSpentTokenTransaction() {
-	m.output_spent_token_transaction = nil
-	m.clearedoutput_spent_token_transaction = false
-}
-
-// AddOutputSpentStartedTokenTransactionIDs adds the "output_spent_started_token_transactions" edge to the TokenTransaction entity by ids.
-func (m *TokenOutputMutation) AddOutputSpentStartedTokenTransactionIDs(ids ...uuid.UUID) {
-	if m.output_spent_started_token_transactions == nil {
-		m.output_spent_started_token_transactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.output_spent_started_token_transactions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearOutputSpentStartedTokenTransactions clears the "output_spent_started_token_transactions" edge to the TokenTransaction entity.
-func (m *TokenOutputMutation) ClearOutputSpentStartedTokenTransactions() {
-	m.clearedoutput_spent_started_token_transactions = true
-}
-
-// OutputSpentStartedTokenTransactionsCleared reports if the "output_spent_started_token_transactions" edge to the TokenTransaction entity was cleared.
-func (m *TokenOutputMutation) OutputSpentStartedTokenTransactionsCleared() bool {
-	return m.clearedoutput_spent_started_token_transactions
-}
-
-// RemoveOutputSpentStartedTokenTransactionIDs removes the "output_spent_started_token_transactions" edge to the TokenTransaction entity by IDs.
-func (m *TokenOutputMutation) RemoveOutputSpentStartedTokenTransactionIDs(ids ...uuid.UUID) {
-	if m.removedoutput_spent_started_token_transactions == nil {
-		m.removedoutput_spent_started_token_transactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.output_spent_started_token_transactions, ids[i])
-		m.removedoutput_spent_started_token_transactions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedOutputSpentStartedTokenTransactions returns the removed IDs of the "output_spent_started_token_transactions" edge to the TokenTransaction entity.
-func (m *TokenOutputMutation) RemovedOutputSpentStartedTokenTransactionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedoutput_spent_started_token_transactions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// OutputSpentStartedTokenTransactionsIDs returns the "output_spent_started_token_transactions" edge IDs in the mutation.
-func (m *TokenOutputMutation) OutputSpentStartedTokenTransactionsIDs() (ids []uuid.UUID) {
-	for id := range m.output_spent_started_token_transactions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetOutputSpentStartedTokenTransactions resets all changes to the "output_spent_started_token_transactions" edge.
-func (m *TokenOutputMutation) ResetOutputSpentStartedTokenTransactions() {
-	m.output_spent_started_token_transactions = nil
-	m.clearedoutput_spent_started_token_transactions = false
-	m.removedoutput_spent_started_token_transactions = nil
-}
-
-// AddTokenPartialRevocationSecretShareIDs adds the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity by ids.
-func (m *TokenOutputMutation) AddTokenPartialRevocationSecretShareIDs(ids ...uuid.UUID) {
-	if m.token_partial_revocation_secret_shares == nil {
-		m.token_partial_revocation_secret_shares = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.token_partial_revocation_secret_shares[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTokenPartialRevocationSecretShares clears the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity.
-func (m *TokenOutputMutation) ClearTokenPartialRevocationSecretShares() {
-	m.clearedtoken_partial_revocation_secret_shares = true
+// ClearTokenTransaction clears the "token_transaction" edge to the TokenTransaction entity.
+func (m *TokenMintMutation) ClearTokenTransaction() {
+	m.clearedtoken_transaction = true
 }
 
-// TokenPartialRevocationSecretSharesCleared reports if the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity was cleared.
-func (m *TokenOutputMutation) TokenPartialRevocationSecretSharesCleared() bool {
-	return m.clearedtoken_partial_revocation_secret_shares
+// TokenTransactionCleared reports if the "token_transaction" edge to the TokenTransaction entity was cleared.
+func (m *TokenMintMutation) TokenTransactionCleared() bool {
+	return m.clearedtoken_transaction
 }
 
-// RemoveTokenPartialRevocationSecretShareIDs removes the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity by IDs.
-func (m *TokenOutputMutation) RemoveTokenPartialRevocationSecretShareIDs(ids ...uuid.UUID) {
-	if m.removedtoken_partial_revocation_secret_shares == nil {
-		m.removedtoken_partial_revocation_secret_shares = make(map[uuid.UUID]struct{})
+// RemoveTokenTransactionIDs removes the "token_transaction" edge to the TokenTransaction entity by IDs.
+func (m *TokenMintMutation) RemoveTokenTransactionIDs(ids ...uuid.UUID) {
+	if m.removedtoken_transaction == nil {
+		m.removedtoken_transaction = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.token_partial_revocation_secret_shares, ids[i])
-		m.removedtoken_partial_revocation_secret_shares[ids[i]] = struct{}{}
+		delete(m.token_transaction, ids[i])
+		m.removedtoken_transaction[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedTokenPartialRevocationSecretShares returns the removed IDs of the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity.
-func (m *TokenOutputMutation) RemovedTokenPartialRevocationSecretSharesIDs() (ids []uuid.UUID) {
-	for id := range m.removedtoken_partial_revocation_secret_shares {
+// RemovedTokenTransaction returns the removed IDs of the "token_transaction" edge to the TokenTransaction entity.
+func (m *TokenMintMutation) RemovedTokenTransactionIDs() (ids []uuid.UUID) {
+	for id := range m.removedtoken_transaction {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// TokenPartialRevocationSecretSharesIDs returns the "token_partial_revocation_secret_shares" edge IDs in the mutation.
-func (m *TokenOutputMutation) TokenPartialRevocationSecretSharesIDs() (ids []uuid.UUID) {
-	for id := range m.token_partial_revocation_secret_shares {
+// TokenTransactionIDs returns the "token_transaction" edge IDs in the mutation.
+func (m *TokenMintMutation) TokenTransactionIDs() (ids []uuid.UUID) {
+	for id := range m.token_transaction {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTokenPartialRevocationSecretShares resets all changes to the "token_partial_revocation_secret_shares" edge.
-func (m *TokenOutputMutation) ResetTokenPartialRevocationSecretShares() {
-	m.token_partial_revocation_secret_shares = nil
-	m.clearedtoken_partial_revocation_secret_shares = false
-	m.removedtoken_partial_revocation_secret_shares = nil
-}
-
-// ClearTokenCreate clears the "token_create" edge to the TokenCreate entity.
-func (m *TokenOutputMutation) ClearTokenCreate() {
-	m.clearedtoken_create = true
-	m.clearedFields[tokenoutput.FieldTokenCreateID] = struct{}{}
-}
-
-// TokenCreateCleared reports if the "token_create" edge to the TokenCreate entity was cleared.
-func (m *TokenOutputMutation) TokenCreateCleared() bool {
-	return m.clearedtoken_create
-}
-
-// TokenCreateIDs returns the "token_create" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenCreateID instead. It exists only for internal usage by the builders.
-func (m *TokenOutputMutation) TokenCreateIDs() (ids []uuid.UUID) {
-	if id := m.token_create; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetTokenCreate resets all changes to the "token_create" edge.
-func (m *TokenOutputMutation) ResetTokenCreate() {
-	m.token_create = nil
-	m.clearedtoken_create = false
+// ResetTokenTransaction resets all changes to the "token_transaction" edge.
+func (m *TokenMintMutation) ResetTokenTransaction() {
+	m.token_transaction = nil
+	m.clearedtoken_transaction = false
+	m.removedtoken_transaction = nil
 }
 
-// Where appends a list predicates to the TokenOutputMutation builder.
-func (m *TokenOutputMutation) Where(ps ...predicate.TokenOutput) {
+// Where appends a list predicates to the TokenMintMutation builder.
+func (m *TokenMintMutation) Where(ps ...predicate.TokenMint) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenOutputMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenMintMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenOutputMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenOutput, len(ps))
+func (m *TokenMintMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenMint, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -13826,78 +14771,45 @@ func (m *TokenOutputMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenOutputMutation) Op() Op {
+func (m *TokenMintMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenOutputMutation) SetOp(op Op) {
+func (m *TokenMintMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TokenOutput).
-func (m *TokenOutputMutation) Type() string {
+// Type returns the node type of this mutation (TokenMint).
+func (m *TokenMintMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenOutputMutation) Fields() []string {
-	fields := make([]string, 0, 18)
+func (m *TokenMintMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.create_time != nil {
-		fields = append(fields, tokenoutput.FieldCreateTime)
+		fields = append(fields, tokenmint.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tokenoutput.FieldUpdateTime)
-	}
-	if m.status != nil {
-		fields = append(fields, tokenoutput.FieldStatus)
-	}
-	if m.owner_public_key != nil {
-		fields = append(fields, tokenoutput.FieldOwnerPublicKey)
-	}
-	if m.withdraw_bond_sats != nil {
-		fields = append(fields, tokenoutput.FieldWithdrawBondSats)
-	}
-	if m.withdraw_relative_block_locktime != nil {
-		fields = append(fields, tokenoutput.FieldWithdrawRelativeBlockLocktime)
+		fields = append(fields, tokenmint.FieldUpdateTime)
 	}
-	if m.withdraw_revocation_commitment != nil {
-		fields = append(fields, tokenoutput.FieldWithdrawRevocationCommitment)
+	if m.issuer_public_key != nil {
+		fields = append(fields, tokenmint.FieldIssuerPublicKey)
 	}
-	if m.token_public_key != nil {
-		fields = append(fields, tokenoutput.FieldTokenPublicKey)
+	if m.wallet_provided_timestamp != nil {
+		fields = append(fields, tokenmint.FieldWalletProvidedTimestamp)
 	}
-	if m.token_amount != nil {
-		fields = append(fields, tokenoutput.FieldTokenAmount)
+	if m.issuer_signature != nil {
+		fields = append(fields, tokenmint.FieldIssuerSignature)
 	}
-	if m.created_transaction_output_vout != nil {
-		fields = append(fields, tokenoutput.FieldCreatedTransactionOutputVout)
+	if m.operator_specific_issuer_signature != nil {
+		fields = append(fields, tokenmint.FieldOperatorSpecificIssuerSignature)
 	}
-	if m.spent_ownership_signature != nil {
-		fields = append(fields, tokenoutput.FieldSpentOwnershipSignature)
-	}
-	if m.spent_operator_specific_ownership_signature != nil {
-		fields = append(fields, tokenoutput.FieldSpentOperatorSpecificOwnershipSignature)
-	}
-	if m.spent_transaction_input_vout != nil {
-		fields = append(fields, tokenoutput.FieldSpentTransactionInputVout)
-	}
-	if m.spent_revocation_secret != nil {
-		fields = append(fields, tokenoutput.FieldSpentRevocationSecret)
-	}
-	if m.confirmed_withdraw_block_hash != nil {
-		fields = append(fields, tokenoutput.FieldConfirmedWithdrawBlockHash)
-	}
-	if m.network != nil {
-		fields = append(fields, tokenoutput.FieldNetwork)
-	}
-	if m.token_identifier != nil {
-		fields = append(fields, tokenoutput.FieldTokenIdentifier)
-	}
-	if m.token_create != nil {
-		fields = append(fields, tokenoutput.FieldTokenCreateID)
+	if m.token_identifier != nil {
+		fields = append(fields, tokenmint.FieldTokenIdentifier)
 	}
 	return fields
 }
@@ -13905,44 +14817,22 @@ func (m *TokenOutputMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenOutputMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenMintMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tokenoutput.FieldCreateTime:
+	case tokenmint.FieldCreateTime:
 		return m.CreateTime()
-	case tokenoutput.FieldUpdateTime:
+	case tokenmint.FieldUpdateTime:
 		return m.UpdateTime()
-	case tokenoutput.FieldStatus:
-		return m.Status()
-	case tokenoutput.FieldOwnerPublicKey:
-		return m.OwnerPublicKey()
-	case tokenoutput.FieldWithdrawBondSats:
-		return m.WithdrawBondSats()
-	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
-		return m.WithdrawRelativeBlockLocktime()
-	case tokenoutput.FieldWithdrawRevocationCommitment:
-		return m.WithdrawRevocationCommitment()
-	case tokenoutput.FieldTokenPublicKey:
-		return m.TokenPublicKey()
-	case tokenoutput.FieldTokenAmount:
-		return m.TokenAmount()
-	case tokenoutput.FieldCreatedTransactionOutputVout:
-		return m.CreatedTransactionOutputVout()
-	case tokenoutput.FieldSpentOwnershipSignature:
-		return m.SpentOwnershipSignature()
-	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
-		return m.SpentOperatorSpecificOwnershipSignature()
-	case tokenoutput.FieldSpentTransactionInputVout:
-		return m.SpentTransactionInputVout()
-	case tokenoutput.FieldSpentRevocationSecret:
-		return m.SpentRevocationSecret()
-	case tokenoutput.FieldConfirmedWithdrawBlockHash:
-		return m.ConfirmedWithdrawBlockHash()
-	case tokenoutput.FieldNetwork:
-		return m.Network()
-	case tokenoutput.FieldTokenIdentifier:
+	case tokenmint.FieldIssuerPublicKey:
+		return m.IssuerPublicKey()
+	case tokenmint.FieldWalletProvidedTimestamp:
+		return m.WalletProvidedTimestamp()
+	case tokenmint.FieldIssuerSignature:
+		return m.IssuerSignature()
+	case tokenmint.FieldOperatorSpecificIssuerSignature:
+		return m.OperatorSpecificIssuerSignature()
+	case tokenmint.FieldTokenIdentifier:
 		return m.TokenIdentifier()
-	case tokenoutput.FieldTokenCreateID:
-		return m.TokenCreateID()
 	}
 	return nil, false
 }
@@ -13950,198 +14840,90 @@ func (m *TokenOutputMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenOutputMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenMintMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tokenoutput.FieldCreateTime:
+	case tokenmint.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tokenoutput.FieldUpdateTime:
+	case tokenmint.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tokenoutput.FieldStatus:
-		return m.OldStatus(ctx)
-	case tokenoutput.FieldOwnerPublicKey:
-		return m.OldOwnerPublicKey(ctx)
-	case tokenoutput.FieldWithdrawBondSats:
-		return m.OldWithdrawBondSats(ctx)
-	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
-		return m.OldWithdrawRelativeBlockLocktime(ctx)
-	case tokenoutput.FieldWithdrawRevocationCommitment:
-		return m.OldWithdrawRevocationCommitment(ctx)
-	case tokenoutput.FieldTokenPublicKey:
-		return m.OldTokenPublicKey(ctx)
-	case tokenoutput.FieldTokenAmount:
-		return m.OldTokenAmount(ctx)
-	case tokenoutput.FieldCreatedTransactionOutputVout:
-		return m.OldCreatedTransactionOutputVout(ctx)
-	case tokenoutput.FieldSpentOwnershipSignature:
-		return m.OldSpentOwnershipSignature(ctx)
-	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
-		return m.OldSpentOperatorSpecificOwnershipSignature(ctx)
-	case tokenoutput.FieldSpentTransactionInputVout:
-		return m.OldSpentTransactionInputVout(ctx)
-	case tokenoutput.FieldSpentRevocationSecret:
-		return m.OldSpentRevocationSecret(ctx)
-	case tokenoutput.FieldConfirmedWithdrawBlockHash:
-		return m.OldConfirmedWithdrawBlockHash(ctx)
-	case tokenoutput.FieldNetwork:
-		return m.OldNetwork(ctx)
-	case tokenoutput.FieldTokenIdentifier:
+	case tokenmint.FieldIssuerPublicKey:
+		return m.OldIssuerPublicKey(ctx)
+	case tokenmint.FieldWalletProvidedTimestamp:
+		return m.OldWalletProvidedTimestamp(ctx)
+	case tokenmint.FieldIssuerSignature:
+		return m.OldIssuerSignature(ctx)
+	case tokenmint.FieldOperatorSpecificIssuerSignature:
+		return m.OldOperatorSpecificIssuerSignature(ctx)
+	case tokenmint.FieldTokenIdentifier:
 		return m.OldTokenIdentifier(ctx)
-	case tokenoutput.FieldTokenCreateID:
-		return m.OldTokenCreateID(ctx)
 	}
-	return nil, fmt.Errorf("unknown TokenOutput field %s", name)
+	return nil, fmt.Errorf("unknown TokenMint field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenOutputMutation) SetField(name string, value ent.Value) error {
+func (m *TokenMintMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tokenoutput.FieldCreateTime:
+	case tokenmint.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tokenoutput.FieldUpdateTime:
+	case tokenmint.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tokenoutput.FieldStatus:
-		v, ok := value.(schematype.TokenOutputStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case tokenoutput.FieldOwnerPublicKey:
+	case tokenmint.FieldIssuerPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerPublicKey(v)
-		return nil
-	case tokenoutput.FieldWithdrawBondSats:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWithdrawBondSats(v)
+		m.SetIssuerPublicKey(v)
 		return nil
-	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+	case tokenmint.FieldWalletProvidedTimestamp:
 		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWithdrawRelativeBlockLocktime(v)
-		return nil
-	case tokenoutput.FieldWithdrawRevocationCommitment:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWithdrawRevocationCommitment(v)
-		return nil
-	case tokenoutput.FieldTokenPublicKey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenPublicKey(v)
-		return nil
-	case tokenoutput.FieldTokenAmount:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenAmount(v)
-		return nil
-	case tokenoutput.FieldCreatedTransactionOutputVout:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedTransactionOutputVout(v)
-		return nil
-	case tokenoutput.FieldSpentOwnershipSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpentOwnershipSignature(v)
-		return nil
-	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpentOperatorSpecificOwnershipSignature(v)
-		return nil
-	case tokenoutput.FieldSpentTransactionInputVout:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSpentTransactionInputVout(v)
+		m.SetWalletProvidedTimestamp(v)
 		return nil
-	case tokenoutput.FieldSpentRevocationSecret:
+	case tokenmint.FieldIssuerSignature:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSpentRevocationSecret(v)
+		m.SetIssuerSignature(v)
 		return nil
-	case tokenoutput.FieldConfirmedWithdrawBlockHash:
+	case tokenmint.FieldOperatorSpecificIssuerSignature:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConfirmedWithdrawBlockHash(v)
-		return nil
-	case tokenoutput.FieldNetwork:
-		v, ok := value.(schematype.Network)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNetwork(v)
+		m.SetOperatorSpecificIssuerSignature(v)
 		return nil
-	case tokenoutput.FieldTokenIdentifier:
+	case tokenmint.FieldTokenIdentifier:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetTokenIdentifier(v)
 		return nil
-	case tokenoutput.FieldTokenCreateID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTokenCreateID(v)
-		return nil
 	}
-	return fmt.Errorf("unknown TokenOutput field %s", name)
+	return fmt.Errorf("unknown TokenMint field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenOutputMutation) AddedFields() []string {
+func (m *TokenMintMutation) AddedFields() []string {
 	var fields []string
-	if m.addwithdraw_bond_sats != nil {
-		fields = append(fields, tokenoutput.FieldWithdrawBondSats)
-	}
-	if m.addwithdraw_relative_block_locktime != nil {
-		fields = append(fields, tokenoutput.FieldWithdrawRelativeBlockLocktime)
-	}
-	if m.addcreated_transaction_output_vout != nil {
-		fields = append(fields, tokenoutput.FieldCreatedTransactionOutputVout)
-	}
-	if m.addspent_transaction_input_vout != nil {
-		fields = append(fields, tokenoutput.FieldSpentTransactionInputVout)
+	if m.addwallet_provided_timestamp != nil {
+		fields = append(fields, tokenmint.FieldWalletProvidedTimestamp)
 	}
 	return fields
 }
@@ -14149,16 +14931,10 @@ func (m *TokenOutputMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenOutputMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenMintMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tokenoutput.FieldWithdrawBondSats:
-		return m.AddedWithdrawBondSats()
-	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
-		return m.AddedWithdrawRelativeBlockLocktime()
-	case tokenoutput.FieldCreatedTransactionOutputVout:
-		return m.AddedCreatedTransactionOutputVout()
-	case tokenoutput.FieldSpentTransactionInputVout:
-		return m.AddedSpentTransactionInputVout()
+	case tokenmint.FieldWalletProvidedTimestamp:
+		return m.AddedWalletProvidedTimestamp()
 	}
 	return nil, false
 }
@@ -14166,251 +14942,121 @@ func (m *TokenOutputMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenOutputMutation) AddField(name string, value ent.Value) error {
+func (m *TokenMintMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tokenoutput.FieldWithdrawBondSats:
+	case tokenmint.FieldWalletProvidedTimestamp:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddWithdrawBondSats(v)
-		return nil
-	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddWithdrawRelativeBlockLocktime(v)
-		return nil
-	case tokenoutput.FieldCreatedTransactionOutputVout:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCreatedTransactionOutputVout(v)
-		return nil
-	case tokenoutput.FieldSpentTransactionInputVout:
-		v, ok := value.(int32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSpentTransactionInputVout(v)
+		m.AddWalletProvidedTimestamp(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenOutput numeric field %s", name)
+	return fmt.Errorf("unknown TokenMint numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenOutputMutation) ClearedFields() []string {
+func (m *TokenMintMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tokenoutput.FieldTokenPublicKey) {
-		fields = append(fields, tokenoutput.FieldTokenPublicKey)
-	}
-	if m.FieldCleared(tokenoutput.FieldSpentOwnershipSignature) {
-		fields = append(fields, tokenoutput.FieldSpentOwnershipSignature)
-	}
-	if m.FieldCleared(tokenoutput.FieldSpentOperatorSpecificOwnershipSignature) {
-		fields = append(fields, tokenoutput.FieldSpentOperatorSpecificOwnershipSignature)
-	}
-	if m.FieldCleared(tokenoutput.FieldSpentTransactionInputVout) {
-		fields = append(fields, tokenoutput.FieldSpentTransactionInputVout)
-	}
-	if m.FieldCleared(tokenoutput.FieldSpentRevocationSecret) {
-		fields = append(fields, tokenoutput.FieldSpentRevocationSecret)
-	}
-	if m.FieldCleared(tokenoutput.FieldConfirmedWithdrawBlockHash) {
-		fields = append(fields, tokenoutput.FieldConfirmedWithdrawBlockHash)
+	if m.FieldCleared(tokenmint.FieldOperatorSpecificIssuerSignature) {
+		fields = append(fields, tokenmint.FieldOperatorSpecificIssuerSignature)
 	}
-	if m.FieldCleared(tokenoutput.FieldNetwork) {
-		fields = append(fields, tokenoutput.FieldNetwork)
+	if m.FieldCleared(tokenmint.FieldTokenIdentifier) {
+		fields = append(fields, tokenmint.FieldTokenIdentifier)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenOutputMutation) FieldCleared(name string) bool {
+func (m *TokenMintMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenOutputMutation) ClearField(name string) error {
+func (m *TokenMintMutation) ClearField(name string) error {
 	switch name {
-	case tokenoutput.FieldTokenPublicKey:
-		m.ClearTokenPublicKey()
-		return nil
-	case tokenoutput.FieldSpentOwnershipSignature:
-		m.ClearSpentOwnershipSignature()
-		return nil
-	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
-		m.ClearSpentOperatorSpecificOwnershipSignature()
-		return nil
-	case tokenoutput.FieldSpentTransactionInputVout:
-		m.ClearSpentTransactionInputVout()
-		return nil
-	case tokenoutput.FieldSpentRevocationSecret:
-		m.ClearSpentRevocationSecret()
-		return nil
-	case tokenoutput.FieldConfirmedWithdrawBlockHash:
-		m.ClearConfirmedWithdrawBlockHash()
+	case tokenmint.FieldOperatorSpecificIssuerSignature:
+		m.ClearOperatorSpecificIssuerSignature()
 		return nil
-	case tokenoutput.FieldNetwork:
-		m.ClearNetwork()
+	case tokenmint.FieldTokenIdentifier:
+		m.ClearTokenIdentifier()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenOutput nullable field %s", name)
+	return fmt.Errorf("unknown TokenMint nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenOutputMutation) ResetField(name string) error {
+func (m *TokenMintMutation) ResetField(name string) error {
 	switch name {
-	case tokenoutput.FieldCreateTime:
+	case tokenmint.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tokenoutput.FieldUpdateTime:
+	case tokenmint.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tokenoutput.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case tokenoutput.FieldOwnerPublicKey:
-		m.ResetOwnerPublicKey()
-		return nil
-	case tokenoutput.FieldWithdrawBondSats:
-		m.ResetWithdrawBondSats()
-		return nil
-	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
-		m.ResetWithdrawRelativeBlockLocktime()
-		return nil
-	case tokenoutput.FieldWithdrawRevocationCommitment:
-		m.ResetWithdrawRevocationCommitment()
-		return nil
-	case tokenoutput.FieldTokenPublicKey:
-		m.ResetTokenPublicKey()
-		return nil
-	case tokenoutput.FieldTokenAmount:
-		m.ResetTokenAmount()
-		return nil
-	case tokenoutput.FieldCreatedTransactionOutputVout:
-		m.ResetCreatedTransactionOutputVout()
-		return nil
-	case tokenoutput.FieldSpentOwnershipSignature:
-		m.ResetSpentOwnershipSignature()
-		return nil
-	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
-		m.ResetSpentOperatorSpecificOwnershipSignature()
-		return nil
-	case tokenoutput.FieldSpentTransactionInputVout:
-		m.ResetSpentTransactionInputVout()
+	case tokenmint.FieldIssuerPublicKey:
+		m.ResetIssuerPublicKey()
 		return nil
-	case tokenoutput.FieldSpentRevocationSecret:
-		m.ResetSpentRevocationSecret()
+	case tokenmint.FieldWalletProvidedTimestamp:
+		m.ResetWalletProvidedTimestamp()
 		return nil
-	case tokenoutput.FieldConfirmedWithdrawBlockHash:
-		m.ResetConfirmedWithdrawBlockHash()
+	case tokenmint.FieldIssuerSignature:
+		m.ResetIssuerSignature()
 		return nil
-	case tokenoutput.FieldNetwork:
-		m.ResetNetwork()
+	case tokenmint.FieldOperatorSpecificIssuerSignature:
+		m.ResetOperatorSpecificIssuerSignature()
 		return nil
-	case tokenoutput.FieldTokenIdentifier:
+	case tokenmint.FieldTokenIdentifier:
 		m.ResetTokenIdentifier()
 		return nil
-	case tokenoutput.FieldTokenCreateID:
-		m.ResetTokenCreateID()
-		return nil
 	}
-	return fmt.Errorf("unknown TokenOutput field %s", name)
+	return fmt.Errorf("unknown TokenMint field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenOutputMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.revocation_keyshare != nil {
-		edges = append(edges, tokenoutput.EdgeRevocationKeyshare)
-	}
-	if m.output_created_token_transaction != nil {
-		edges = append(edges, tokenoutput.EdgeOutputCreatedTokenTransaction)
-	}
-	if m.output_spent_token_transaction != nil {
-		edges = append(edges, tokenoutput.EdgeOutputSpentTokenTransaction)
-	}
-	if m.output_spent_started_token_transactions != nil {
-		edges = append(edges, tokenoutput.EdgeOutputSpentStartedTokenTransactions)
-	}
-	if m.token_partial_revocation_secret_shares != nil {
-		edges = append(edges, tokenoutput.EdgeTokenPartialRevocationSecretShares)
-	}
-	if m.token_create != nil {
-		edges = append(edges, tokenoutput.EdgeTokenCreate)
+func (m *TokenMintMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.token_transaction != nil {
+		edges = append(edges, tokenmint.EdgeTokenTransaction)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenOutputMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenMintMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tokenoutput.EdgeRevocationKeyshare:
-		if id := m.revocation_keyshare; id != nil {
-			return []ent.Value{*id}
-		}
-	case tokenoutput.EdgeOutputCreatedTokenTransaction:
-		if id := m.output_created_token_transaction; id != nil {
-			return []ent.Value{*id}
-		}
-	case tokenoutput.EdgeOutputSpentTokenTransaction:
-		if id := m.output_spent_token_transaction; id != nil {
-			return []ent.Value{*id}
-		}
-	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
-		ids := make([]ent.Value, 0, len(m.output_spent_started_token_transactions))
-		for id := range m.output_spent_started_token_transactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
-		ids := make([]ent.Value, 0, len(m.token_partial_revocation_secret_shares))
-		for id := range m.token_partial_revocation_secret_shares {
+	case tokenmint.EdgeTokenTransaction:
+		ids := make([]ent.Value, 0, len(m.token_transaction))
+		for id := range m.token_transaction {
 			ids = append(ids, id)
 		}
 		return ids
-	case tokenoutput.EdgeTokenCreate:
-		if id := m.token_create; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenOutputMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.removedoutput_spent_started_token_transactions != nil {
-		edges = append(edges, tokenoutput.EdgeOutputSpentStartedTokenTransactions)
-	}
-	if m.removedtoken_partial_revocation_secret_shares != nil {
-		edges = append(edges, tokenoutput.EdgeTokenPartialRevocationSecretShares)
+func (m *TokenMintMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedtoken_transaction != nil {
+		edges = append(edges, tokenmint.EdgeTokenTransaction)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenOutputMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenMintMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
-		ids := make([]ent.Value, 0, len(m.removedoutput_spent_started_token_transactions))
-		for id := range m.removedoutput_spent_started_token_transactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
-		ids := make([]ent.Value, 0, len(m.removedtoken_partial_revocation_secret_shares))
-		for id := range m.removedtoken_partial_revocation_secret_shares {
+	case tokenmint.EdgeTokenTransaction:
+		ids := make([]ent.Value, 0, len(m.removedtoken_transaction))
+		for id := range m.removedtoken_transaction {
 			ids = append(ids, id)
 		}
 		return ids
@@ -14419,124 +15065,102 @@ func (m *TokenOutputMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenOutputMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
-	if m.clearedrevocation_keyshare {
-		edges = append(edges, tokenoutput.EdgeRevocationKeyshare)
-	}
-	if m.clearedoutput_created_token_transaction {
-		edges = append(edges, tokenoutput.EdgeOutputCreatedTokenTransaction)
-	}
-	if m.clearedoutput_spent_token_transaction {
-		edges = append(edges, tokenoutput.EdgeOutputSpentTokenTransaction)
-	}
-	if m.clearedoutput_spent_started_token_transactions {
-		edges = append(edges, tokenoutput.EdgeOutputSpentStartedTokenTransactions)
-	}
-	if m.clearedtoken_partial_revocation_secret_shares {
-		edges = append(edges, tokenoutput.EdgeTokenPartialRevocationSecretShares)
-	}
-	if m.clearedtoken_create {
-		edges = append(edges, tokenoutput.EdgeTokenCreate)
+func (m *TokenMintMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtoken_transaction {
+		edges = append(edges, tokenmint.EdgeTokenTransaction)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenOutputMutation) EdgeCleared(name string) bool {
+func (m *TokenMintMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tokenoutput.EdgeRevocationKeyshare:
-		return m.clearedrevocation_keyshare
-	case tokenoutput.EdgeOutputCreatedTokenTransaction:
-		return m.clearedoutput_created_token_transaction
-	case tokenoutput.EdgeOutputSpentTokenTransaction:
-		return m.clearedoutput_spent_token_transaction
-	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
-		return m.clearedoutput_spent_started_token_transactions
-	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
-		return m.clearedtoken_partial_revocation_secret_shares
-	case tokenoutput.EdgeTokenCreate:
-		return m.clearedtoken_create
+	case tokenmint.EdgeTokenTransaction:
+		return m.clearedtoken_transaction
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenOutputMutation) ClearEdge(name string) error {
+func (m *TokenMintMutation) ClearEdge(name string) error {
 	switch name {
-	case tokenoutput.EdgeRevocationKeyshare:
-		m.ClearRevocationKeyshare()
-		return nil
-	case tokenoutput.EdgeOutputCreatedTokenTransaction:
-		m.ClearOutputCreatedTokenTransaction()
-		return nil
-	case tokenoutput.EdgeOutputSpentTokenTransaction:
-		m.ClearOutputSpentTokenTransaction()
-		return nil
-	case tokenoutput.EdgeTokenCreate:
-		m.ClearTokenCreate()
-		return nil
 	}
-	return fmt.Errorf("unknown TokenOutput unique edge %s", name)
+	return fmt.Errorf("unknown TokenMint unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenOutputMutation) ResetEdge(name string) error {
+func (m *TokenMintMutation) ResetEdge(name string) error {
 	switch name {
-	case tokenoutput.EdgeRevocationKeyshare:
-		m.ResetRevocationKeyshare()
-		return nil
-	case tokenoutput.EdgeOutputCreatedTokenTransaction:
-		m.ResetOutputCreatedTokenTransaction()
-		return nil
-	case tokenoutput.EdgeOutputSpentTokenTransaction:
-		m.ResetOutputSpentTokenTransaction()
-		return nil
-	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
-		m.ResetOutputSpentStartedTokenTransactions()
-		return nil
-	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
-		m.ResetTokenPartialRevocationSecretShares()
-		return nil
-	case tokenoutput.EdgeTokenCreate:
-		m.ResetTokenCreate()
+	case tokenmint.EdgeTokenTransaction:
+		m.ResetTokenTransaction()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenOutput edge %s", name)
+	return fmt.Errorf("unknown TokenMint edge %s", name)
 }
 
-// TokenPartialRevocationSecretShareMutation represents an operation that mutates the TokenPartialRevocationSecretShare nodes in the graph.
-type TokenPartialRevocationSecretShareMutation struct {
+// TokenOutputMutation represents an operation that mutates the TokenOutput nodes in the graph.
+type TokenOutputMutation struct {
 	config
-	op                           Op
-	typ                          string
-	id                           *uuid.UUID
-	create_time                  *time.Time
-	update_time                  *time.Time
-	operator_identity_public_key *[]byte
-	secret_share                 *[]byte
-	clearedFields                map[string]struct{}
-	token_output                 *uuid.UUID
-	clearedtoken_output          bool
-	done                         bool
-	oldValue                     func(context.Context) (*TokenPartialRevocationSecretShare, error)
-	predicates                   []predicate.TokenPartialRevocationSecretShare
+	op                                             Op
+	typ                                            string
+	id                                             *uuid.UUID
+	create_time                                    *time.Time
+	update_time                                    *time.Time
+	status                                         *schematype.TokenOutputStatus
+	owner_public_key                               *[]byte
+	withdraw_bond_sats                             *uint64
+	addwithdraw_bond_sats                          *int64
+	withdraw_relative_block_locktime               *uint64
+	addwithdraw_relative_block_locktime            *int64
+	withdraw_revocation_commitment                 *[]byte
+	token_public_key                               *[]byte
+	token_amount                                   *[]byte
+	created_transaction_output_vout                *int32
+	addcreated_transaction_output_vout             *int32
+	spent_ownership_signature                      *[]byte
+	spent_operator_specific_ownership_signature    *[]byte
+	spent_transaction_input_vout                   *int32
+	addspent_transaction_input_vout                *int32
+	spent_revocation_secret                        *[]byte
+	confirmed_withdraw_block_hash                  *[]byte
+	network                                        *schematype.Network
+	token_identifier                               *[]byte
+	spendable_after                                *time.Time
+	clearedFields                                  map[string]struct{}
+	revocation_keyshare                            *uuid.UUID
+	clearedrevocation_keyshare                     bool
+	output_created_token_transaction               *uuid.UUID
+	clearedoutput_created_token_transaction        bool
+	output_spent_token_transaction                 *uuid.UUID
+	clearedoutput_spent_token_transaction          bool
+	output_spent_started_token_transactions        map[uuid.UUID]struct{}
+	removedoutput_spent_started_token_transactions map[uuid.UUID]struct{}
+	clearedoutput_spent_started_token_transactions bool
+	token_partial_revocation_secret_shares         map[uuid.UUID]struct{}
+	removedtoken_partial_revocation_secret_shares  map[uuid.UUID]struct{}
+	clearedtoken_partial_revocation_secret_shares  bool
+	token_create                                   *uuid.UUID
+	clearedtoken_create                            bool
+	done                                           bool
+	oldValue                                       func(context.Context) (*TokenOutput, error)
+	predicates                                     []predicate.TokenOutput
 }
 
-var _ ent.Mutation = (*TokenPartialRevocationSecretShareMutation)(nil)
+var _ ent.Mutation = (*TokenOutputMutation)(nil)
 
-// tokenpartialrevocationsecretshareOption allows management of the mutation configuration using functional options.
-type tokenpartialrevocationsecretshareOption func(*TokenPartialRevocationSecretShareMutation)
+// tokenoutputOption allows management of the mutation configuration using functional options.
+type tokenoutputOption func(*TokenOutputMutation)
 
-// newTokenPartialRevocationSecretShareMutation creates new mutation for the TokenPartialRevocationSecretShare entity.
-func newTokenPartialRevocationSecretShareMutation(c config, op Op, opts ...tokenpartialrevocationsecretshareOption) *TokenPartialRevocationSecretShareMutation {
-	m := &TokenPartialRevocationSecretShareMutation{
+// newTokenOutputMutation creates new mutation for the TokenOutput entity.
+func newTokenOutputMutation(c config, op Op, opts ...tokenoutputOption) *TokenOutputMutation {
+	m := &TokenOutputMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTokenPartialRevocationSecretShare,
+		typ:           TypeTokenOutput,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -14545,20 +15169,20 @@ func newTokenPartialRevocationSecretShareMutation(c config, op Op, opts ...token
 	return m
 }
 
-// withTokenPartialRevocationSecretShareID sets the ID field of the mutation.
-func withTokenPartialRevocationSecretShareID(id uuid.UUID) tokenpartialrevocationsecretshareOption {
-	return func(m *TokenPartialRevocationSecretShareMutation) {
+// withTokenOutputID sets the ID field of the mutation.
+func withTokenOutputID(id uuid.UUID) tokenoutputOption {
+	return func(m *TokenOutputMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TokenPartialRevocationSecretShare
+			value *TokenOutput
 		)
-		m.oldValue = func(ctx context.Context) (*TokenPartialRevocationSecretShare, error) {
+		m.oldValue = func(ctx context.Context) (*TokenOutput, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TokenPartialRevocationSecretShare.Get(ctx, id)
+					value, err = m.Client().TokenOutput.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -14567,10 +15191,10 @@ func withTokenPartialRevocationSecretShareID(id uuid.UUID) tokenpartialrevocatio
 	}
 }
 
-// withTokenPartialRevocationSecretShare sets the old TokenPartialRevocationSecretShare of the mutation.
-func withTokenPartialRevocationSecretShare(node *TokenPartialRevocationSecretShare) tokenpartialrevocationsecretshareOption {
-	return func(m *TokenPartialRevocationSecretShareMutation) {
-		m.oldValue = func(context.Context) (*TokenPartialRevocationSecretShare, error) {
+// withTokenOutput sets the old TokenOutput of the mutation.
+func withTokenOutput(node *TokenOutput) tokenoutputOption {
+	return func(m *TokenOutputMutation) {
+		m.oldValue = func(context.Context) (*TokenOutput, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -14579,7 +15203,7 @@ func withTokenPartialRevocationSecretShare(node *TokenPartialRevocationSecretSha
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenPartialRevocationSecretShareMutation) Client() *Client {
+func (m TokenOutputMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -14587,7 +15211,7 @@ func (m TokenPartialRevocationSecretShareMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenPartialRevocationSecretShareMutation) Tx() (*Tx, error) {
+func (m TokenOutputMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -14597,14 +15221,14 @@ func (m TokenPartialRevocationSecretShareMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenPartialRevocationSecretShare entities.
-func (m *TokenPartialRevocationSecretShareMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenOutput entities.
+func (m *TokenOutputMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenPartialRevocationSecretShareMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenOutputMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -14615,7 +15239,7 @@ func (m *TokenPartialRevocationSecretShareMutation) ID() (id uuid.UUID, exists b
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenPartialRevocationSecretShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenOutputMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -14624,19 +15248,19 @@ func (m *TokenPartialRevocationSecretShareMutation) IDs(ctx context.Context) ([]
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenPartialRevocationSecretShare.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenOutput.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TokenPartialRevocationSecretShareMutation) SetCreateTime(t time.Time) {
+func (m *TokenOutputMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenPartialRevocationSecretShareMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenOutputMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -14644,10 +15268,10 @@ func (m *TokenPartialRevocationSecretShareMutation) CreateTime() (r time.Time, e
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenPartialRevocationSecretShare entity.
-// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenPartialRevocationSecretShareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenOutputMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -14662,17 +15286,17 @@ func (m *TokenPartialRevocationSecretShareMutation) OldCreateTime(ctx context.Co
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenPartialRevocationSecretShareMutation) ResetCreateTime() {
+func (m *TokenOutputMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TokenPartialRevocationSecretShareMutation) SetUpdateTime(t time.Time) {
+func (m *TokenOutputMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenPartialRevocationSecretShareMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenOutputMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -14680,10 +15304,10 @@ func (m *TokenPartialRevocationSecretShareMutation) UpdateTime() (r time.Time, e
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenPartialRevocationSecretShare entity.
-// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenPartialRevocationSecretShareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenOutputMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -14698,1366 +15322,1068 @@ func (m *TokenPartialRevocationSecretShareMutation) OldUpdateTime(ctx context.Co
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenPartialRevocationSecretShareMutation) ResetUpdateTime() {
+func (m *TokenOutputMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetOperatorIdentityPublicKey sets the "operator_identity_public_key" field.
-func (m *TokenPartialRevocationSecretShareMutation) SetOperatorIdentityPublicKey(b []byte) {
-	m.operator_identity_public_key = &b
+// SetStatus sets the "status" field.
+func (m *TokenOutputMutation) SetStatus(sos schematype.TokenOutputStatus) {
+	m.status = &sos
 }
 
-// OperatorIdentityPublicKey returns the value of the "operator_identity_public_key" field in the mutation.
-func (m *TokenPartialRevocationSecretShareMutation) OperatorIdentityPublicKey() (r []byte, exists bool) {
-	v := m.operator_identity_public_key
+// Status returns the value of the "status" field in the mutation.
+func (m *TokenOutputMutation) Status() (r schematype.TokenOutputStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOperatorIdentityPublicKey returns the old "operator_identity_public_key" field's value of the TokenPartialRevocationSecretShare entity.
-// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenPartialRevocationSecretShareMutation) OldOperatorIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenOutputMutation) OldStatus(ctx context.Context) (v schematype.TokenOutputStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperatorIdentityPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperatorIdentityPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperatorIdentityPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.OperatorIdentityPublicKey, nil
+	return oldValue.Status, nil
 }
 
-// ResetOperatorIdentityPublicKey resets all changes to the "operator_identity_public_key" field.
-func (m *TokenPartialRevocationSecretShareMutation) ResetOperatorIdentityPublicKey() {
-	m.operator_identity_public_key = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TokenOutputMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetSecretShare sets the "secret_share" field.
-func (m *TokenPartialRevocationSecretShareMutation) SetSecretShare(b []byte) {
-	m.secret_share = &b
+// SetOwnerPublicKey sets the "owner_public_key" field.
+func (m *TokenOutputMutation) SetOwnerPublicKey(b []byte) {
+	m.owner_public_key = &b
 }
 
-// SecretShare returns the value of the "secret_share" field in the mutation.
-func (m *TokenPartialRevocationSecretShareMutation) SecretShare() (r []byte, exists bool) {
-	v := m.secret_share
+// OwnerPublicKey returns the value of the "owner_public_key" field in the mutation.
+func (m *TokenOutputMutation) OwnerPublicKey() (r []byte, exists bool) {
+	v := m.owner_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSecretShare returns the old "secret_share" field's value of the TokenPartialRevocationSecretShare entity.
-// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
+// OldOwnerPublicKey returns the old "owner_public_key" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenPartialRevocationSecretShareMutation) OldSecretShare(ctx context.Context) (v []byte, err error) {
+func (m *TokenOutputMutation) OldOwnerPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecretShare is only allowed on UpdateOne operations")
+		return v, errors.New("OldOwnerPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecretShare requires an ID field in the mutation")
+		return v, errors.New("OldOwnerPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecretShare: %w", err)
+		return v, fmt.Errorf("querying old value for OldOwnerPublicKey: %w", err)
 	}
-	return oldValue.SecretShare, nil
-}
-
-// ResetSecretShare resets all changes to the "secret_share" field.
-func (m *TokenPartialRevocationSecretShareMutation) ResetSecretShare() {
-	m.secret_share = nil
-}
-
-// SetTokenOutputID sets the "token_output" edge to the TokenOutput entity by id.
-func (m *TokenPartialRevocationSecretShareMutation) SetTokenOutputID(id uuid.UUID) {
-	m.token_output = &id
+	return oldValue.OwnerPublicKey, nil
 }
 
-// ClearTokenOutput clears the "token_output" edge to the TokenOutput entity.
-func (m *TokenPartialRevocationSecretShareMutation) ClearTokenOutput() {
-	m.clearedtoken_output = true
+// ResetOwnerPublicKey resets all changes to the "owner_public_key" field.
+func (m *TokenOutputMutation) ResetOwnerPublicKey() {
+	m.owner_public_key = nil
 }
 
-// TokenOutputCleared reports if the "token_output" edge to the TokenOutput entity was cleared.
-func (m *TokenPartialRevocationSecretShareMutation) TokenOutputCleared() bool {
-	return m.clearedtoken_output
+// SetWithdrawBondSats sets the "withdraw_bond_sats" field.
+func (m *TokenOutputMutation) SetWithdrawBondSats(u uint64) {
+	m.withdraw_bond_sats = &u
+	m.addwithdraw_bond_sats = nil
 }
 
-// TokenOutputID returns the "token_output" edge ID in the mutation.
-func (m *TokenPartialRevocationSecretShareMutation) TokenOutputID() (id uuid.UUID, exists bool) {
-	if m.token_output != nil {
-		return *m.token_output, true
+// WithdrawBondSats returns the value of the "withdraw_bond_sats" field in the mutation.
+func (m *TokenOutputMutation) WithdrawBondSats() (r uint64, exists bool) {
+	v := m.withdraw_bond_sats
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TokenOutputIDs returns the "token_output" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenOutputID instead. It exists only for internal usage by the builders.
-func (m *TokenPartialRevocationSecretShareMutation) TokenOutputIDs() (ids []uuid.UUID) {
-	if id := m.token_output; id != nil {
-		ids = append(ids, *id)
+// OldWithdrawBondSats returns the old "withdraw_bond_sats" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenOutputMutation) OldWithdrawBondSats(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWithdrawBondSats is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetTokenOutput resets all changes to the "token_output" edge.
-func (m *TokenPartialRevocationSecretShareMutation) ResetTokenOutput() {
-	m.token_output = nil
-	m.clearedtoken_output = false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWithdrawBondSats requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWithdrawBondSats: %w", err)
+	}
+	return oldValue.WithdrawBondSats, nil
 }
 
-// Where appends a list predicates to the TokenPartialRevocationSecretShareMutation builder.
-func (m *TokenPartialRevocationSecretShareMutation) Where(ps ...predicate.TokenPartialRevocationSecretShare) {
-	m.predicates = append(m.predicates, ps...)
+// AddWithdrawBondSats adds u to the "withdraw_bond_sats" field.
+func (m *TokenOutputMutation) AddWithdrawBondSats(u int64) {
+	if m.addwithdraw_bond_sats != nil {
+		*m.addwithdraw_bond_sats += u
+	} else {
+		m.addwithdraw_bond_sats = &u
+	}
 }
 
-// WhereP appends storage-level predicates to the TokenPartialRevocationSecretShareMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenPartialRevocationSecretShareMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenPartialRevocationSecretShare, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedWithdrawBondSats returns the value that was added to the "withdraw_bond_sats" field in this mutation.
+func (m *TokenOutputMutation) AddedWithdrawBondSats() (r int64, exists bool) {
+	v := m.addwithdraw_bond_sats
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *TokenPartialRevocationSecretShareMutation) Op() Op {
-	return m.op
+// ResetWithdrawBondSats resets all changes to the "withdraw_bond_sats" field.
+func (m *TokenOutputMutation) ResetWithdrawBondSats() {
+	m.withdraw_bond_sats = nil
+	m.addwithdraw_bond_sats = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TokenPartialRevocationSecretShareMutation) SetOp(op Op) {
-	m.op = op
+// SetWithdrawRelativeBlockLocktime sets the "withdraw_relative_block_locktime" field.
+func (m *TokenOutputMutation) SetWithdrawRelativeBlockLocktime(u uint64) {
+	m.withdraw_relative_block_locktime = &u
+	m.addwithdraw_relative_block_locktime = nil
 }
 
-// Type returns the node type of this mutation (TokenPartialRevocationSecretShare).
-func (m *TokenPartialRevocationSecretShareMutation) Type() string {
-	return m.typ
+// WithdrawRelativeBlockLocktime returns the value of the "withdraw_relative_block_locktime" field in the mutation.
+func (m *TokenOutputMutation) WithdrawRelativeBlockLocktime() (r uint64, exists bool) {
+	v := m.withdraw_relative_block_locktime
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TokenPartialRevocationSecretShareMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.create_time != nil {
-		fields = append(fields, tokenpartialrevocationsecretshare.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, tokenpartialrevocationsecretshare.FieldUpdateTime)
+// OldWithdrawRelativeBlockLocktime returns the old "withdraw_relative_block_locktime" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenOutputMutation) OldWithdrawRelativeBlockLocktime(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWithdrawRelativeBlockLocktime is only allowed on UpdateOne operations")
 	}
-	if m.operator_identity_public_key != nil {
-		fields = append(fields, tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWithdrawRelativeBlockLocktime requires an ID field in the mutation")
 	}
-	if m.secret_share != nil {
-		fields = append(fields, tokenpartialrevocationsecretshare.FieldSecretShare)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWithdrawRelativeBlockLocktime: %w", err)
 	}
-	return fields
+	return oldValue.WithdrawRelativeBlockLocktime, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TokenPartialRevocationSecretShareMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tokenpartialrevocationsecretshare.FieldCreateTime:
-		return m.CreateTime()
-	case tokenpartialrevocationsecretshare.FieldUpdateTime:
-		return m.UpdateTime()
-	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
-		return m.OperatorIdentityPublicKey()
-	case tokenpartialrevocationsecretshare.FieldSecretShare:
-		return m.SecretShare()
+// AddWithdrawRelativeBlockLocktime adds u to the "withdraw_relative_block_locktime" field.
+func (m *TokenOutputMutation) AddWithdrawRelativeBlockLocktime(u int64) {
+	if m.addwithdraw_relative_block_locktime != nil {
+		*m.addwithdraw_relative_block_locktime += u
+	} else {
+		m.addwithdraw_relative_block_locktime = &u
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TokenPartialRevocationSecretShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case tokenpartialrevocationsecretshare.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case tokenpartialrevocationsecretshare.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
-		return m.OldOperatorIdentityPublicKey(ctx)
-	case tokenpartialrevocationsecretshare.FieldSecretShare:
-		return m.OldSecretShare(ctx)
+// AddedWithdrawRelativeBlockLocktime returns the value that was added to the "withdraw_relative_block_locktime" field in this mutation.
+func (m *TokenOutputMutation) AddedWithdrawRelativeBlockLocktime() (r int64, exists bool) {
+	v := m.addwithdraw_relative_block_locktime
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown TokenPartialRevocationSecretShare field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TokenPartialRevocationSecretShareMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case tokenpartialrevocationsecretshare.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case tokenpartialrevocationsecretshare.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOperatorIdentityPublicKey(v)
-		return nil
-	case tokenpartialrevocationsecretshare.FieldSecretShare:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecretShare(v)
-		return nil
-	}
-	return fmt.Errorf("unknown TokenPartialRevocationSecretShare field %s", name)
+// ResetWithdrawRelativeBlockLocktime resets all changes to the "withdraw_relative_block_locktime" field.
+func (m *TokenOutputMutation) ResetWithdrawRelativeBlockLocktime() {
+	m.withdraw_relative_block_locktime = nil
+	m.addwithdraw_relative_block_locktime = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) AddedFields() []string {
-	return nil
+// SetWithdrawRevocationCommitment sets the "withdraw_revocation_commitment" field.
+func (m *TokenOutputMutation) SetWithdrawRevocationCommitment(b []byte) {
+	m.withdraw_revocation_commitment = &b
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TokenPartialRevocationSecretShareMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// WithdrawRevocationCommitment returns the value of the "withdraw_revocation_commitment" field in the mutation.
+func (m *TokenOutputMutation) WithdrawRevocationCommitment() (r []byte, exists bool) {
+	v := m.withdraw_revocation_commitment
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TokenPartialRevocationSecretShareMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldWithdrawRevocationCommitment returns the old "withdraw_revocation_commitment" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenOutputMutation) OldWithdrawRevocationCommitment(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWithdrawRevocationCommitment is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown TokenPartialRevocationSecretShare numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWithdrawRevocationCommitment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWithdrawRevocationCommitment: %w", err)
+	}
+	return oldValue.WithdrawRevocationCommitment, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TokenPartialRevocationSecretShareMutation) ClearedFields() []string {
-	return nil
+// ResetWithdrawRevocationCommitment resets all changes to the "withdraw_revocation_commitment" field.
+func (m *TokenOutputMutation) ResetWithdrawRevocationCommitment() {
+	m.withdraw_revocation_commitment = nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetTokenPublicKey sets the "token_public_key" field.
+func (m *TokenOutputMutation) SetTokenPublicKey(b []byte) {
+	m.token_public_key = &b
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TokenPartialRevocationSecretShareMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TokenPartialRevocationSecretShare nullable field %s", name)
+// TokenPublicKey returns the value of the "token_public_key" field in the mutation.
+func (m *TokenOutputMutation) TokenPublicKey() (r []byte, exists bool) {
+	v := m.token_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TokenPartialRevocationSecretShareMutation) ResetField(name string) error {
-	switch name {
-	case tokenpartialrevocationsecretshare.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case tokenpartialrevocationsecretshare.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
-		m.ResetOperatorIdentityPublicKey()
-		return nil
-	case tokenpartialrevocationsecretshare.FieldSecretShare:
-		m.ResetSecretShare()
-		return nil
+// OldTokenPublicKey returns the old "token_public_key" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenOutputMutation) OldTokenPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenPublicKey is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown TokenPartialRevocationSecretShare field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.token_output != nil {
-		edges = append(edges, tokenpartialrevocationsecretshare.EdgeTokenOutput)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenPublicKey requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
-		if id := m.token_output; id != nil {
-			return []ent.Value{*id}
-		}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenPublicKey: %w", err)
 	}
-	return nil
+	return oldValue.TokenPublicKey, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ClearTokenPublicKey clears the value of the "token_public_key" field.
+func (m *TokenOutputMutation) ClearTokenPublicKey() {
+	m.token_public_key = nil
+	m.clearedFields[tokenoutput.FieldTokenPublicKey] = struct{}{}
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// TokenPublicKeyCleared returns if the "token_public_key" field was cleared in this mutation.
+func (m *TokenOutputMutation) TokenPublicKeyCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldTokenPublicKey]
+	return ok
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedtoken_output {
-		edges = append(edges, tokenpartialrevocationsecretshare.EdgeTokenOutput)
-	}
-	return edges
+// ResetTokenPublicKey resets all changes to the "token_public_key" field.
+func (m *TokenOutputMutation) ResetTokenPublicKey() {
+	m.token_public_key = nil
+	delete(m.clearedFields, tokenoutput.FieldTokenPublicKey)
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TokenPartialRevocationSecretShareMutation) EdgeCleared(name string) bool {
-	switch name {
-	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
-		return m.clearedtoken_output
-	}
-	return false
+// SetTokenAmount sets the "token_amount" field.
+func (m *TokenOutputMutation) SetTokenAmount(b []byte) {
+	m.token_amount = &b
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TokenPartialRevocationSecretShareMutation) ClearEdge(name string) error {
-	switch name {
-	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
-		m.ClearTokenOutput()
-		return nil
+// TokenAmount returns the value of the "token_amount" field in the mutation.
+func (m *TokenOutputMutation) TokenAmount() (r []byte, exists bool) {
+	v := m.token_amount
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown TokenPartialRevocationSecretShare unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TokenPartialRevocationSecretShareMutation) ResetEdge(name string) error {
-	switch name {
-	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
-		m.ResetTokenOutput()
-		return nil
+// OldTokenAmount returns the old "token_amount" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenOutputMutation) OldTokenAmount(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenAmount is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown TokenPartialRevocationSecretShare edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenAmount: %w", err)
+	}
+	return oldValue.TokenAmount, nil
 }
 
-// TokenTransactionMutation represents an operation that mutates the TokenTransaction nodes in the graph.
-type TokenTransactionMutation struct {
-	config
-	op                               Op
-	typ                              string
-	id                               *uuid.UUID
-	create_time                      *time.Time
-	update_time                      *time.Time
-	partial_token_transaction_hash   *[]byte
-	finalized_token_transaction_hash *[]byte
-	operator_signature               *[]byte
-	status                           *schematype.TokenTransactionStatus
-	expiry_time                      *time.Time
-	coordinator_public_key           *[]byte
-	client_created_timestamp         *time.Time
-	version                          *schematype.TokenTransactionVersion
-	addversion                       *schematype.TokenTransactionVersion
-	clearedFields                    map[string]struct{}
-	spent_output                     map[uuid.UUID]struct{}
-	removedspent_output              map[uuid.UUID]struct{}
-	clearedspent_output              bool
-	spent_output_v2                  map[uuid.UUID]struct{}
-	removedspent_output_v2           map[uuid.UUID]struct{}
-	clearedspent_output_v2           bool
-	created_output                   map[uuid.UUID]struct{}
-	removedcreated_output            map[uuid.UUID]struct{}
-	clearedcreated_output            bool
-	mint                             *uuid.UUID
-	clearedmint                      bool
-	create                           *uuid.UUID
-	clearedcreate                    bool
-	payment_intent                   *uuid.UUID
-	clearedpayment_intent            bool
-	peer_signatures                  map[uuid.UUID]struct{}
-	removedpeer_signatures           map[uuid.UUID]struct{}
-	clearedpeer_signatures           bool
-	spark_invoice                    map[uuid.UUID]struct{}
-	removedspark_invoice             map[uuid.UUID]struct{}
-	clearedspark_invoice             bool
-	done                             bool
-	oldValue                         func(context.Context) (*TokenTransaction, error)
-	predicates                       []predicate.TokenTransaction
+// ResetTokenAmount resets all changes to the "token_amount" field.
+func (m *TokenOutputMutation) ResetTokenAmount() {
+	m.token_amount = nil
 }
 
-var _ ent.Mutation = (*TokenTransactionMutation)(nil)
+// SetCreatedTransactionOutputVout sets the "created_transaction_output_vout" field.
+func (m *TokenOutputMutation) SetCreatedTransactionOutputVout(i int32) {
+	m.created_transaction_output_vout = &i
+	m.addcreated_transaction_output_vout = nil
+}
 
-// tokentransactionOption allows management of the mutation configuration using functional options.
-type tokentransactionOption func(*TokenTransactionMutation)
+// CreatedTransactionOutputVout returns the value of the "created_transaction_output_vout" field in the mutation.
+func (m *TokenOutputMutation) CreatedTransactionOutputVout() (r int32, exists bool) {
+	v := m.created_transaction_output_vout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newTokenTransactionMutation creates new mutation for the TokenTransaction entity.
-func newTokenTransactionMutation(c config, op Op, opts ...tokentransactionOption) *TokenTransactionMutation {
-	m := &TokenTransactionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTokenTransaction,
-		clearedFields: make(map[string]struct{}),
+// OldCreatedTransactionOutputVout returns the old "created_transaction_output_vout" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenOutputMutation) OldCreatedTransactionOutputVout(ctx context.Context) (v int32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTransactionOutputVout is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTransactionOutputVout requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTransactionOutputVout: %w", err)
+	}
+	return oldValue.CreatedTransactionOutputVout, nil
 }
 
-// withTokenTransactionID sets the ID field of the mutation.
-func withTokenTransactionID(id uuid.UUID) tokentransactionOption {
-	return func(m *TokenTransactionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *TokenTransaction
-		)
-		m.oldValue = func(ctx context.Context) (*TokenTransaction, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().TokenTransaction.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// AddCreatedTransactionOutputVout adds i to the "created_transaction_output_vout" field.
+func (m *TokenOutputMutation) AddCreatedTransactionOutputVout(i int32) {
+	if m.addcreated_transaction_output_vout != nil {
+		*m.addcreated_transaction_output_vout += i
+	} else {
+		m.addcreated_transaction_output_vout = &i
 	}
 }
 
-// withTokenTransaction sets the old TokenTransaction of the mutation.
-func withTokenTransaction(node *TokenTransaction) tokentransactionOption {
-	return func(m *TokenTransactionMutation) {
-		m.oldValue = func(context.Context) (*TokenTransaction, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddedCreatedTransactionOutputVout returns the value that was added to the "created_transaction_output_vout" field in this mutation.
+func (m *TokenOutputMutation) AddedCreatedTransactionOutputVout() (r int32, exists bool) {
+	v := m.addcreated_transaction_output_vout
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenTransactionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetCreatedTransactionOutputVout resets all changes to the "created_transaction_output_vout" field.
+func (m *TokenOutputMutation) ResetCreatedTransactionOutputVout() {
+	m.created_transaction_output_vout = nil
+	m.addcreated_transaction_output_vout = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TokenTransactionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetSpentOwnershipSignature sets the "spent_ownership_signature" field.
+func (m *TokenOutputMutation) SetSpentOwnershipSignature(b []byte) {
+	m.spent_ownership_signature = &b
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenTransaction entities.
-func (m *TokenTransactionMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TokenTransactionMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TokenTransactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenTransaction.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetCreateTime sets the "create_time" field.
-func (m *TokenTransactionMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
-}
-
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenTransactionMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
+// SpentOwnershipSignature returns the value of the "spent_ownership_signature" field in the mutation.
+func (m *TokenOutputMutation) SpentOwnershipSignature() (r []byte, exists bool) {
+	v := m.spent_ownership_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSpentOwnershipSignature returns the old "spent_ownership_signature" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenOutputMutation) OldSpentOwnershipSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpentOwnershipSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+		return v, errors.New("OldSpentOwnershipSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpentOwnershipSignature: %w", err)
 	}
-	return oldValue.CreateTime, nil
+	return oldValue.SpentOwnershipSignature, nil
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenTransactionMutation) ResetCreateTime() {
-	m.create_time = nil
+// ClearSpentOwnershipSignature clears the value of the "spent_ownership_signature" field.
+func (m *TokenOutputMutation) ClearSpentOwnershipSignature() {
+	m.spent_ownership_signature = nil
+	m.clearedFields[tokenoutput.FieldSpentOwnershipSignature] = struct{}{}
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *TokenTransactionMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// SpentOwnershipSignatureCleared returns if the "spent_ownership_signature" field was cleared in this mutation.
+func (m *TokenOutputMutation) SpentOwnershipSignatureCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldSpentOwnershipSignature]
+	return ok
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenTransactionMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
+// ResetSpentOwnershipSignature resets all changes to the "spent_ownership_signature" field.
+func (m *TokenOutputMutation) ResetSpentOwnershipSignature() {
+	m.spent_ownership_signature = nil
+	delete(m.clearedFields, tokenoutput.FieldSpentOwnershipSignature)
+}
+
+// SetSpentOperatorSpecificOwnershipSignature sets the "spent_operator_specific_ownership_signature" field.
+func (m *TokenOutputMutation) SetSpentOperatorSpecificOwnershipSignature(b []byte) {
+	m.spent_operator_specific_ownership_signature = &b
+}
+
+// SpentOperatorSpecificOwnershipSignature returns the value of the "spent_operator_specific_ownership_signature" field in the mutation.
+func (m *TokenOutputMutation) SpentOperatorSpecificOwnershipSignature() (r []byte, exists bool) {
+	v := m.spent_operator_specific_ownership_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSpentOperatorSpecificOwnershipSignature returns the old "spent_operator_specific_ownership_signature" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenOutputMutation) OldSpentOperatorSpecificOwnershipSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpentOperatorSpecificOwnershipSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+		return v, errors.New("OldSpentOperatorSpecificOwnershipSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpentOperatorSpecificOwnershipSignature: %w", err)
 	}
-	return oldValue.UpdateTime, nil
+	return oldValue.SpentOperatorSpecificOwnershipSignature, nil
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenTransactionMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ClearSpentOperatorSpecificOwnershipSignature clears the value of the "spent_operator_specific_ownership_signature" field.
+func (m *TokenOutputMutation) ClearSpentOperatorSpecificOwnershipSignature() {
+	m.spent_operator_specific_ownership_signature = nil
+	m.clearedFields[tokenoutput.FieldSpentOperatorSpecificOwnershipSignature] = struct{}{}
 }
 
-// SetPartialTokenTransactionHash sets the "partial_token_transaction_hash" field.
-func (m *TokenTransactionMutation) SetPartialTokenTransactionHash(b []byte) {
-	m.partial_token_transaction_hash = &b
+// SpentOperatorSpecificOwnershipSignatureCleared returns if the "spent_operator_specific_ownership_signature" field was cleared in this mutation.
+func (m *TokenOutputMutation) SpentOperatorSpecificOwnershipSignatureCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldSpentOperatorSpecificOwnershipSignature]
+	return ok
 }
 
-// PartialTokenTransactionHash returns the value of the "partial_token_transaction_hash" field in the mutation.
-func (m *TokenTransactionMutation) PartialTokenTransactionHash() (r []byte, exists bool) {
-	v := m.partial_token_transaction_hash
+// ResetSpentOperatorSpecificOwnershipSignature resets all changes to the "spent_operator_specific_ownership_signature" field.
+func (m *TokenOutputMutation) ResetSpentOperatorSpecificOwnershipSignature() {
+	m.spent_operator_specific_ownership_signature = nil
+	delete(m.clearedFields, tokenoutput.FieldSpentOperatorSpecificOwnershipSignature)
+}
+
+// SetSpentTransactionInputVout sets the "spent_transaction_input_vout" field.
+func (m *TokenOutputMutation) SetSpentTransactionInputVout(i int32) {
+	m.spent_transaction_input_vout = &i
+	m.addspent_transaction_input_vout = nil
+}
+
+// SpentTransactionInputVout returns the value of the "spent_transaction_input_vout" field in the mutation.
+func (m *TokenOutputMutation) SpentTransactionInputVout() (r int32, exists bool) {
+	v := m.spent_transaction_input_vout
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPartialTokenTransactionHash returns the old "partial_token_transaction_hash" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSpentTransactionInputVout returns the old "spent_transaction_input_vout" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldPartialTokenTransactionHash(ctx context.Context) (v []byte, err error) {
+func (m *TokenOutputMutation) OldSpentTransactionInputVout(ctx context.Context) (v int32, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPartialTokenTransactionHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpentTransactionInputVout is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPartialTokenTransactionHash requires an ID field in the mutation")
+		return v, errors.New("OldSpentTransactionInputVout requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPartialTokenTransactionHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpentTransactionInputVout: %w", err)
 	}
-	return oldValue.PartialTokenTransactionHash, nil
-}
-
-// ResetPartialTokenTransactionHash resets all changes to the "partial_token_transaction_hash" field.
-func (m *TokenTransactionMutation) ResetPartialTokenTransactionHash() {
-	m.partial_token_transaction_hash = nil
+	return oldValue.SpentTransactionInputVout, nil
 }
 
-// SetFinalizedTokenTransactionHash sets the "finalized_token_transaction_hash" field.
-func (m *TokenTransactionMutation) SetFinalizedTokenTransactionHash(b []byte) {
-	m.finalized_token_transaction_hash = &b
+// AddSpentTransactionInputVout adds i to the "spent_transaction_input_vout" field.
+func (m *TokenOutputMutation) AddSpentTransactionInputVout(i int32) {
+	if m.addspent_transaction_input_vout != nil {
+		*m.addspent_transaction_input_vout += i
+	} else {
+		m.addspent_transaction_input_vout = &i
+	}
 }
 
-// FinalizedTokenTransactionHash returns the value of the "finalized_token_transaction_hash" field in the mutation.
-func (m *TokenTransactionMutation) FinalizedTokenTransactionHash() (r []byte, exists bool) {
-	v := m.finalized_token_transaction_hash
+// AddedSpentTransactionInputVout returns the value that was added to the "spent_transaction_input_vout" field in this mutation.
+func (m *TokenOutputMutation) AddedSpentTransactionInputVout() (r int32, exists bool) {
+	v := m.addspent_transaction_input_vout
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFinalizedTokenTransactionHash returns the old "finalized_token_transaction_hash" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldFinalizedTokenTransactionHash(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFinalizedTokenTransactionHash is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFinalizedTokenTransactionHash requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFinalizedTokenTransactionHash: %w", err)
-	}
-	return oldValue.FinalizedTokenTransactionHash, nil
+// ClearSpentTransactionInputVout clears the value of the "spent_transaction_input_vout" field.
+func (m *TokenOutputMutation) ClearSpentTransactionInputVout() {
+	m.spent_transaction_input_vout = nil
+	m.addspent_transaction_input_vout = nil
+	m.clearedFields[tokenoutput.FieldSpentTransactionInputVout] = struct{}{}
 }
 
-// ResetFinalizedTokenTransactionHash resets all changes to the "finalized_token_transaction_hash" field.
-func (m *TokenTransactionMutation) ResetFinalizedTokenTransactionHash() {
-	m.finalized_token_transaction_hash = nil
+// SpentTransactionInputVoutCleared returns if the "spent_transaction_input_vout" field was cleared in this mutation.
+func (m *TokenOutputMutation) SpentTransactionInputVoutCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldSpentTransactionInputVout]
+	return ok
 }
 
-// SetOperatorSignature sets the "operator_signature" field.
-func (m *TokenTransactionMutation) SetOperatorSignature(b []byte) {
-	m.operator_signature = &b
+// ResetSpentTransactionInputVout resets all changes to the "spent_transaction_input_vout" field.
+func (m *TokenOutputMutation) ResetSpentTransactionInputVout() {
+	m.spent_transaction_input_vout = nil
+	m.addspent_transaction_input_vout = nil
+	delete(m.clearedFields, tokenoutput.FieldSpentTransactionInputVout)
 }
 
-// OperatorSignature returns the value of the "operator_signature" field in the mutation.
-func (m *TokenTransactionMutation) OperatorSignature() (r []byte, exists bool) {
-	v := m.operator_signature
+// SetSpentRevocationSecret sets the "spent_revocation_secret" field.
+func (m *TokenOutputMutation) SetSpentRevocationSecret(b []byte) {
+	m.spent_revocation_secret = &b
+}
+
+// SpentRevocationSecret returns the value of the "spent_revocation_secret" field in the mutation.
+func (m *TokenOutputMutation) SpentRevocationSecret() (r []byte, exists bool) {
+	v := m.spent_revocation_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOperatorSignature returns the old "operator_signature" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSpentRevocationSecret returns the old "spent_revocation_secret" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldOperatorSignature(ctx context.Context) (v []byte, err error) {
+func (m *TokenOutputMutation) OldSpentRevocationSecret(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOperatorSignature is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpentRevocationSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOperatorSignature requires an ID field in the mutation")
+		return v, errors.New("OldSpentRevocationSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOperatorSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldSpentRevocationSecret: %w", err)
 	}
-	return oldValue.OperatorSignature, nil
+	return oldValue.SpentRevocationSecret, nil
 }
 
-// ClearOperatorSignature clears the value of the "operator_signature" field.
-func (m *TokenTransactionMutation) ClearOperatorSignature() {
-	m.operator_signature = nil
-	m.clearedFields[tokentransaction.FieldOperatorSignature] = struct{}{}
+// ClearSpentRevocationSecret clears the value of the "spent_revocation_secret" field.
+func (m *TokenOutputMutation) ClearSpentRevocationSecret() {
+	m.spent_revocation_secret = nil
+	m.clearedFields[tokenoutput.FieldSpentRevocationSecret] = struct{}{}
 }
 
-// OperatorSignatureCleared returns if the "operator_signature" field was cleared in this mutation.
-func (m *TokenTransactionMutation) OperatorSignatureCleared() bool {
-	_, ok := m.clearedFields[tokentransaction.FieldOperatorSignature]
+// SpentRevocationSecretCleared returns if the "spent_revocation_secret" field was cleared in this mutation.
+func (m *TokenOutputMutation) SpentRevocationSecretCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldSpentRevocationSecret]
 	return ok
 }
 
-// ResetOperatorSignature resets all changes to the "operator_signature" field.
-func (m *TokenTransactionMutation) ResetOperatorSignature() {
-	m.operator_signature = nil
-	delete(m.clearedFields, tokentransaction.FieldOperatorSignature)
+// ResetSpentRevocationSecret resets all changes to the "spent_revocation_secret" field.
+func (m *TokenOutputMutation) ResetSpentRevocationSecret() {
+	m.spent_revocation_secret = nil
+	delete(m.clearedFields, tokenoutput.FieldSpentRevocationSecret)
 }
 
-// SetStatus sets the "status" field.
-func (m *TokenTransactionMutation) SetStatus(sts schematype.TokenTransactionStatus) {
-	m.status = &sts
+// SetConfirmedWithdrawBlockHash sets the "confirmed_withdraw_block_hash" field.
+func (m *TokenOutputMutation) SetConfirmedWithdrawBlockHash(b []byte) {
+	m.confirmed_withdraw_block_hash = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TokenTransactionMutation) Status() (r schematype.TokenTransactionStatus, exists bool) {
-	v := m.status
+// ConfirmedWithdrawBlockHash returns the value of the "confirmed_withdraw_block_hash" field in the mutation.
+func (m *TokenOutputMutation) ConfirmedWithdrawBlockHash() (r []byte, exists bool) {
+	v := m.confirmed_withdraw_block_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldConfirmedWithdrawBlockHash returns the old "confirmed_withdraw_block_hash" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldStatus(ctx context.Context) (v schematype.TokenTransactionStatus, err error) {
+func (m *TokenOutputMutation) OldConfirmedWithdrawBlockHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldConfirmedWithdrawBlockHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldConfirmedWithdrawBlockHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldConfirmedWithdrawBlockHash: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.ConfirmedWithdrawBlockHash, nil
 }
 
-// ClearStatus clears the value of the "status" field.
-func (m *TokenTransactionMutation) ClearStatus() {
-	m.status = nil
-	m.clearedFields[tokentransaction.FieldStatus] = struct{}{}
+// ClearConfirmedWithdrawBlockHash clears the value of the "confirmed_withdraw_block_hash" field.
+func (m *TokenOutputMutation) ClearConfirmedWithdrawBlockHash() {
+	m.confirmed_withdraw_block_hash = nil
+	m.clearedFields[tokenoutput.FieldConfirmedWithdrawBlockHash] = struct{}{}
 }
 
-// StatusCleared returns if the "status" field was cleared in this mutation.
-func (m *TokenTransactionMutation) StatusCleared() bool {
-	_, ok := m.clearedFields[tokentransaction.FieldStatus]
+// ConfirmedWithdrawBlockHashCleared returns if the "confirmed_withdraw_block_hash" field was cleared in this mutation.
+func (m *TokenOutputMutation) ConfirmedWithdrawBlockHashCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldConfirmedWithdrawBlockHash]
 	return ok
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TokenTransactionMutation) ResetStatus() {
-	m.status = nil
-	delete(m.clearedFields, tokentransaction.FieldStatus)
+// ResetConfirmedWithdrawBlockHash resets all changes to the "confirmed_withdraw_block_hash" field.
+func (m *TokenOutputMutation) ResetConfirmedWithdrawBlockHash() {
+	m.confirmed_withdraw_block_hash = nil
+	delete(m.clearedFields, tokenoutput.FieldConfirmedWithdrawBlockHash)
 }
 
-// SetExpiryTime sets the "expiry_time" field.
-func (m *TokenTransactionMutation) SetExpiryTime(t time.Time) {
-	m.expiry_time = &t
+// SetNetwork sets the "network" field.
+func (m *TokenOutputMutation) SetNetwork(s schematype.Network) {
+	m.network = &s
 }
 
-// ExpiryTime returns the value of the "expiry_time" field in the mutation.
-func (m *TokenTransactionMutation) ExpiryTime() (r time.Time, exists bool) {
-	v := m.expiry_time
+// Network returns the value of the "network" field in the mutation.
+func (m *TokenOutputMutation) Network() (r schematype.Network, exists bool) {
+	v := m.network
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiryTime returns the old "expiry_time" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldNetwork returns the old "network" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldExpiryTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenOutputMutation) OldNetwork(ctx context.Context) (v schematype.Network, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiryTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiryTime requires an ID field in the mutation")
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiryTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
 	}
-	return oldValue.ExpiryTime, nil
+	return oldValue.Network, nil
 }
 
-// ClearExpiryTime clears the value of the "expiry_time" field.
-func (m *TokenTransactionMutation) ClearExpiryTime() {
-	m.expiry_time = nil
-	m.clearedFields[tokentransaction.FieldExpiryTime] = struct{}{}
+// ClearNetwork clears the value of the "network" field.
+func (m *TokenOutputMutation) ClearNetwork() {
+	m.network = nil
+	m.clearedFields[tokenoutput.FieldNetwork] = struct{}{}
 }
 
-// ExpiryTimeCleared returns if the "expiry_time" field was cleared in this mutation.
-func (m *TokenTransactionMutation) ExpiryTimeCleared() bool {
-	_, ok := m.clearedFields[tokentransaction.FieldExpiryTime]
+// NetworkCleared returns if the "network" field was cleared in this mutation.
+func (m *TokenOutputMutation) NetworkCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldNetwork]
 	return ok
 }
 
-// ResetExpiryTime resets all changes to the "expiry_time" field.
-func (m *TokenTransactionMutation) ResetExpiryTime() {
-	m.expiry_time = nil
-	delete(m.clearedFields, tokentransaction.FieldExpiryTime)
+// ResetNetwork resets all changes to the "network" field.
+func (m *TokenOutputMutation) ResetNetwork() {
+	m.network = nil
+	delete(m.clearedFields, tokenoutput.FieldNetwork)
 }
 
-// SetCoordinatorPublicKey sets the "coordinator_public_key" field.
-func (m *TokenTransactionMutation) SetCoordinatorPublicKey(b []byte) {
-	m.coordinator_public_key = &b
+// SetTokenIdentifier sets the "token_identifier" field.
+func (m *TokenOutputMutation) SetTokenIdentifier(b []byte) {
+	m.token_identifier = &b
 }
 
-// CoordinatorPublicKey returns the value of the "coordinator_public_key" field in the mutation.
-func (m *TokenTransactionMutation) CoordinatorPublicKey() (r []byte, exists bool) {
-	v := m.coordinator_public_key
+// TokenIdentifier returns the value of the "token_identifier" field in the mutation.
+func (m *TokenOutputMutation) TokenIdentifier() (r []byte, exists bool) {
+	v := m.token_identifier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCoordinatorPublicKey returns the old "coordinator_public_key" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenIdentifier returns the old "token_identifier" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldCoordinatorPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenOutputMutation) OldTokenIdentifier(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCoordinatorPublicKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenIdentifier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCoordinatorPublicKey requires an ID field in the mutation")
+		return v, errors.New("OldTokenIdentifier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCoordinatorPublicKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenIdentifier: %w", err)
 	}
-	return oldValue.CoordinatorPublicKey, nil
-}
-
-// ClearCoordinatorPublicKey clears the value of the "coordinator_public_key" field.
-func (m *TokenTransactionMutation) ClearCoordinatorPublicKey() {
-	m.coordinator_public_key = nil
-	m.clearedFields[tokentransaction.FieldCoordinatorPublicKey] = struct{}{}
-}
-
-// CoordinatorPublicKeyCleared returns if the "coordinator_public_key" field was cleared in this mutation.
-func (m *TokenTransactionMutation) CoordinatorPublicKeyCleared() bool {
-	_, ok := m.clearedFields[tokentransaction.FieldCoordinatorPublicKey]
-	return ok
+	return oldValue.TokenIdentifier, nil
 }
 
-// ResetCoordinatorPublicKey resets all changes to the "coordinator_public_key" field.
-func (m *TokenTransactionMutation) ResetCoordinatorPublicKey() {
-	m.coordinator_public_key = nil
-	delete(m.clearedFields, tokentransaction.FieldCoordinatorPublicKey)
+// ResetTokenIdentifier resets all changes to the "token_identifier" field.
+func (m *TokenOutputMutation) ResetTokenIdentifier() {
+	m.token_identifier = nil
 }
 
-// SetClientCreatedTimestamp sets the "client_created_timestamp" field.
-func (m *TokenTransactionMutation) SetClientCreatedTimestamp(t time.Time) {
-	m.client_created_timestamp = &t
+// SetTokenCreateID sets the "token_create_id" field.
+func (m *TokenOutputMutation) SetTokenCreateID(u uuid.UUID) {
+	m.token_create = &u
 }
 
-// ClientCreatedTimestamp returns the value of the "client_created_timestamp" field in the mutation.
-func (m *TokenTransactionMutation) ClientCreatedTimestamp() (r time.Time, exists bool) {
-	v := m.client_created_timestamp
+// TokenCreateID returns the value of the "token_create_id" field in the mutation.
+func (m *TokenOutputMutation) TokenCreateID() (r uuid.UUID, exists bool) {
+	v := m.token_create
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientCreatedTimestamp returns the old "client_created_timestamp" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenCreateID returns the old "token_create_id" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldClientCreatedTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *TokenOutputMutation) OldTokenCreateID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientCreatedTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenCreateID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientCreatedTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldTokenCreateID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientCreatedTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenCreateID: %w", err)
 	}
-	return oldValue.ClientCreatedTimestamp, nil
-}
-
-// ClearClientCreatedTimestamp clears the value of the "client_created_timestamp" field.
-func (m *TokenTransactionMutation) ClearClientCreatedTimestamp() {
-	m.client_created_timestamp = nil
-	m.clearedFields[tokentransaction.FieldClientCreatedTimestamp] = struct{}{}
-}
-
-// ClientCreatedTimestampCleared returns if the "client_created_timestamp" field was cleared in this mutation.
-func (m *TokenTransactionMutation) ClientCreatedTimestampCleared() bool {
-	_, ok := m.clearedFields[tokentransaction.FieldClientCreatedTimestamp]
-	return ok
+	return oldValue.TokenCreateID, nil
 }
 
-// ResetClientCreatedTimestamp resets all changes to the "client_created_timestamp" field.
-func (m *TokenTransactionMutation) ResetClientCreatedTimestamp() {
-	m.client_created_timestamp = nil
-	delete(m.clearedFields, tokentransaction.FieldClientCreatedTimestamp)
+// ResetTokenCreateID resets all changes to the "token_create_id" field.
+func (m *TokenOutputMutation) ResetTokenCreateID() {
+	m.token_create = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *TokenTransactionMutation) SetVersion(stv schematype.TokenTransactionVersion) {
-	m.version = &stv
-	m.addversion = nil
+// SetSpendableAfter sets the "spendable_after" field.
+func (m *TokenOutputMutation) SetSpendableAfter(t time.Time) {
+	m.spendable_after = &t
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *TokenTransactionMutation) Version() (r schematype.TokenTransactionVersion, exists bool) {
-	v := m.version
+// SpendableAfter returns the value of the "spendable_after" field in the mutation.
+func (m *TokenOutputMutation) SpendableAfter() (r time.Time, exists bool) {
+	v := m.spendable_after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the TokenTransaction entity.
-// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// OldSpendableAfter returns the old "spendable_after" field's value of the TokenOutput entity.
+// If the TokenOutput object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionMutation) OldVersion(ctx context.Context) (v schematype.TokenTransactionVersion, err error) {
+func (m *TokenOutputMutation) OldSpendableAfter(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldSpendableAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldSpendableAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
-	}
-	return oldValue.Version, nil
-}
-
-// AddVersion adds stv to the "version" field.
-func (m *TokenTransactionMutation) AddVersion(stv schematype.TokenTransactionVersion) {
-	if m.addversion != nil {
-		*m.addversion += stv
-	} else {
-		m.addversion = &stv
+		return v, fmt.Errorf("querying old value for OldSpendableAfter: %w", err)
 	}
+	return oldValue.SpendableAfter, nil
 }
 
-// AddedVersion returns the value that was added to the "version" field in this mutation.
-func (m *TokenTransactionMutation) AddedVersion() (r schematype.TokenTransactionVersion, exists bool) {
-	v := m.addversion
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSpendableAfter clears the value of the "spendable_after" field.
+func (m *TokenOutputMutation) ClearSpendableAfter() {
+	m.spendable_after = nil
+	m.clearedFields[tokenoutput.FieldSpendableAfter] = struct{}{}
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *TokenTransactionMutation) ResetVersion() {
-	m.version = nil
-	m.addversion = nil
+// SpendableAfterCleared returns if the "spendable_after" field was cleared in this mutation.
+func (m *TokenOutputMutation) SpendableAfterCleared() bool {
+	_, ok := m.clearedFields[tokenoutput.FieldSpendableAfter]
+	return ok
 }
 
-// AddSpentOutputIDs adds the "spent_output" edge to the TokenOutput entity by ids.
-func (m *TokenTransactionMutation) AddSpentOutputIDs(ids ...uuid.UUID) {
-	if m.spent_output == nil {
-		m.spent_output = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.spent_output[ids[i]] = struct{}{}
-	}
+// ResetSpendableAfter resets all changes to the "spendable_after" field.
+func (m *TokenOutputMutation) ResetSpendableAfter() {
+	m.spendable_after = nil
+	delete(m.clearedFields, tokenoutput.FieldSpendableAfter)
 }
 
-// ClearSpentOutput clears the "spent_output" edge to the TokenOutput entity.
-func (m *TokenTransactionMutation) ClearSpentOutput() {
-	m.clearedspent_output = true
+// SetRevocationKeyshareID sets the "revocation_keyshare" edge to the SigningKeyshare entity by id.
+func (m *TokenOutputMutation) SetRevocationKeyshareID(id uuid.UUID) {
+	m.revocation_keyshare = &id
 }
 
-// SpentOutputCleared reports if the "spent_output" edge to the TokenOutput entity was cleared.
-func (m *TokenTransactionMutation) SpentOutputCleared() bool {
-	return m.clearedspent_output
+// ClearRevocationKeyshare clears the "revocation_keyshare" edge to the SigningKeyshare entity.
+func (m *TokenOutputMutation) ClearRevocationKeyshare() {
+	m.clearedrevocation_keyshare = true
 }
 
-// RemoveSpentOutputIDs removes the "spent_output" edge to the TokenOutput entity by IDs.
-func (m *TokenTransactionMutation) RemoveSpentOutputIDs(ids ...uuid.UUID) {
-	if m.removedspent_output == nil {
-		m.removedspent_output = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.spent_output, ids[i])
-		m.removedspent_output[ids[i]] = struct{}{}
-	}
+// RevocationKeyshareCleared reports if the "revocation_keyshare" edge to the SigningKeyshare entity was cleared.
+func (m *TokenOutputMutation) RevocationKeyshareCleared() bool {
+	return m.clearedrevocation_keyshare
 }
 
-// RemovedSpentOutput returns the removed IDs of the "spent_output" edge to the TokenOutput entity.
-func (m *TokenTransactionMutation) RemovedSpentOutputIDs() (ids []uuid.UUID) {
-	for id := range m.removedspent_output {
-		ids = append(ids, id)
+// RevocationKeyshareID returns the "revocation_keyshare" edge ID in the mutation.
+func (m *TokenOutputMutation) RevocationKeyshareID() (id uuid.UUID, exists bool) {
+	if m.revocation_keyshare != nil {
+		return *m.revocation_keyshare, true
 	}
 	return
 }
 
-// SpentOutputIDs returns the "spent_output" edge IDs in the mutation.
-func (m *TokenTransactionMutation) SpentOutputIDs() (ids []uuid.UUID) {
-	for id := range m.spent_output {
-		ids = append(ids, id)
+// RevocationKeyshareIDs returns the "revocation_keyshare" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RevocationKeyshareID instead. It exists only for internal usage by the builders.
+func (m *TokenOutputMutation) RevocationKeyshareIDs() (ids []uuid.UUID) {
+	if id := m.revocation_keyshare; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSpentOutput resets all changes to the "spent_output" edge.
-func (m *TokenTransactionMutation) ResetSpentOutput() {
-	m.spent_output = nil
-	m.clearedspent_output = false
-	m.removedspent_output = nil
+// ResetRevocationKeyshare resets all changes to the "revocation_keyshare" edge.
+func (m *TokenOutputMutation) ResetRevocationKeyshare() {
+	m.revocation_keyshare = nil
+	m.clearedrevocation_keyshare = false
 }
 
-// AddSpentOutputV2IDs adds the "spent_output_v2" edge to the TokenOutput entity by ids.
-func (m *TokenTransactionMutation) AddSpentOutputV2IDs(ids ...uuid.UUID) {
-	if m.spent_output_v2 == nil {
-		m.spent_output_v2 = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.spent_output_v2[ids[i]] = struct{}{}
-	}
+// SetOutputCreatedTokenTransactionID sets the "output_created_token_transaction" edge to the TokenTransaction entity by id.
+func (m *TokenOutputMutation) SetOutputCreatedTokenTransactionID(id uuid.UUID) {
+	m.output_created_token_transaction = &id
 }
 
-// ClearSpentOutputV2 clears the "spent_output_v2" edge to the TokenOutput entity.
-func (m *TokenTransactionMutation) ClearSpentOutputV2() {
-	m.clearedspent_output_v2 = true
+// ClearOutputCreatedTokenTransaction clears the "output_created_token_transaction" edge to the TokenTransaction entity.
+func (m *TokenOutputMutation) ClearOutputCreatedTokenTransaction() {
+	m.clearedoutput_created_token_transaction = true
 }
 
-// SpentOutputV2Cleared reports if the "spent_output_v2" edge to the TokenOutput entity was cleared.
-func (m *TokenTransactionMutation) SpentOutputV2Cleared() bool {
-	return m.clearedspent_output_v2
+// OutputCreatedTokenTransactionCleared reports if the "output_created_token_transaction" edge to the TokenTransaction entity was cleared.
+func (m *TokenOutputMutation) OutputCreatedTokenTransactionCleared() bool {
+	return m.clearedoutput_created_token_transaction
 }
 
-// RemoveSpentOutputV2IDs removes the "spent_output_v2" edge to the TokenOutput entity by IDs.
-func (m *TokenTransactionMutation) RemoveSpentOutputV2IDs(ids ...uuid.UUID) {
-	if m.removedspent_output_v2 == nil {
-		m.removedspent_output_v2 = make(map[uuid.UUID]struct{})
+// OutputCreatedTokenTransactionID returns the "output_created_token_transaction" edge ID in the mutation.
+func (m *TokenOutputMutation) OutputCreatedTokenTransactionID() (id uuid.UUID, exists bool) {
+	if m.output_created_token_transaction != nil {
+		return *m.output_created_token_transaction, true
 	}
-	for i := range ids {
-		delete(m.spent_output_v2, ids[i])
-		m.removedspent_output_v2[ids[i]] = struct{}{}
+	return
+}
+
+// OutputCreatedTokenTransactionIDs returns the "output_created_token_transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OutputCreatedTokenTransactionID instead. It exists only for internal usage by the builders.
+func (m *TokenOutputMutation) OutputCreatedTokenTransactionIDs() (ids []uuid.UUID) {
+	if id := m.output_created_token_transaction; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// RemovedSpentOutputV2 returns the removed IDs of the "spent_output_v2" edge to the TokenOutput entity.
-func (m *TokenTransactionMutation) RemovedSpentOutputV2IDs() (ids []uuid.UUID) {
-	for id := range m.removedspent_output_v2 {
-		ids = append(ids, id)
+// ResetOutputCreatedTokenTransaction resets all changes to the "output_created_token_transaction" edge.
+func (m *TokenOutputMutation) ResetOutputCreatedTokenTransaction() {
+	m.output_created_token_transaction = nil
+	m.clearedoutput_created_token_transaction = false
+}
+
+// SetOutputSpentTokenTransactionID sets the "output_spent_token_transaction" edge to the TokenTransaction entity by id.
+func (m *TokenOutputMutation) SetOutputSpentTokenTransactionID(id uuid.UUID) {
+	m.output_spent_token_transaction = &id
+}
+
+// ClearOutputSpentTokenTransaction clears the "output_spent_token_transaction" edge to the TokenTransaction entity.
+func (m *TokenOutputMutation) ClearOutputSpentTokenTransaction() {
+	m.clearedoutput_spent_token_transaction = true
+}
+
+// OutputSpentTokenTransactionCleared reports if the "output_spent_token_transaction" edge to the TokenTransaction entity was cleared.
+func (m *TokenOutputMutation) OutputSpentTokenTransactionCleared() bool {
+	return m.clearedoutput_spent_token_transaction
+}
+
+// OutputSpentTokenTransactionID returns the "output_spent_token_transaction" edge ID in the mutation.
+func (m *TokenOutputMutation) OutputSpentTokenTransactionID() (id uuid.UUID, exists bool) {
+	if m.output_spent_token_transaction != nil {
+		return *m.output_spent_token_transaction, true
 	}
 	return
 }
 
-// SpentOutputV2IDs returns the "spent_output_v2" edge IDs in the mutation.
-func (m *TokenTransactionMutation) SpentOutputV2IDs() (ids []uuid.UUID) {
-	for id := range m.spent_output_v2 {
-		ids = append(ids, id)
+// OutputSpentTokenTransactionIDs returns the "output_spent_token_transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OutputSpentTokenTransactionID instead. It exists only for internal usage by the builders.
+func (m *TokenOutputMutation) OutputSpentTokenTransactionIDs() (ids []uuid.UUID) {
+	if id := m.output_spent_token_transaction; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSpentOutputV2 resets all changes to the "spent_output_v2" edge.
-func (m *TokenTransactionMutation) ResetSpentOutputV2() {
-	m.spent_output_v2 = nil
-	m.clearedspent_output_v2 = false
-	m.removedspent_output_v2 = nil
+// ResetOutputSpentTokenTransaction resets all changes to the "output_spent_token_transaction" edge.
+func (m *TokenOutputMutation) ResetOutputSpentTokenTransaction() {
+	m.output_spent_token_transaction = nil
+	m.clearedoutput_spent_token_transaction = false
 }
 
-// AddCreatedOutputIDs adds the "created_output" edge to the TokenOutput entity by ids.
-func (m *TokenTransactionMutation) AddCreatedOutputIDs(ids ...uuid.UUID) {
-	if m.created_output == nil {
-		m.created_output = make(map[uuid.UUID]struct{})
+// AddOutputSpentStartedTokenTransactionIDs adds the "output_spent_started_token_transactions" edge to the TokenTransaction entity by ids.
+func (m *TokenOutputMutation) AddOutputSpentStartedTokenTransactionIDs(ids ...uuid.UUID) {
+	if m.output_spent_started_token_transactions == nil {
+		m.output_spent_started_token_transactions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.created_output[ids[i]] = struct{}{}
+		m.output_spent_started_token_transactions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearCreatedOutput clears the "created_output" edge to the TokenOutput entity.
-func (m *TokenTransactionMutation) ClearCreatedOutput() {
-	m.clearedcreated_output = true
+// ClearOutputSpentStartedTokenTransactions clears the "output_spent_started_token_transactions" edge to the TokenTransaction entity.
+func (m *TokenOutputMutation) ClearOutputSpentStartedTokenTransactions() {
+	m.clearedoutput_spent_started_token_transactions = true
 }
 
-// CreatedOutputCleared reports if the "created_output" edge to the TokenOutput entity was cleared.
-func (m *TokenTransactionMutation) CreatedOutputCleared() bool {
-	return m.clearedcreated_output
+// OutputSpentStartedTokenTransactionsCleared reports if the "output_spent_started_token_transactions" edge to the TokenTransaction entity was cleared.
+func (m *TokenOutputMutation) OutputSpentStartedTokenTransactionsCleared() bool {
+	return m.clearedoutput_spent_started_token_transactions
 }
 
-// RemoveCreatedOutputIDs removes the "created_output" edge to the TokenOutput entity by IDs.
-func (m *TokenTransactionMutation) RemoveCreatedOutputIDs(ids ...uuid.UUID) {
-	if m.removedcreated_output == nil {
-		m.removedcreated_output = make(map[uuid.UUID]struct{})
+// RemoveOutputSpentStartedTokenTransactionIDs removes the "output_spent_started_token_transactions" edge to the TokenTransaction entity by IDs.
+func (m *TokenOutputMutation) RemoveOutputSpentStartedTokenTransactionIDs(ids ...uuid.UUID) {
+	if m.removedoutput_spent_started_token_transactions == nil {
+		m.removedoutput_spent_started_token_transactions = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.created_output, ids[i])
-		m.removedcreated_output[ids[i]] = struct{}{}
+		delete(m.output_spent_started_token_transactions, ids[i])
+		m.removedoutput_spent_started_token_transactions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedCreatedOutput returns the removed IDs of the "created_output" edge to the TokenOutput entity.
-func (m *TokenTransactionMutation) RemovedCreatedOutputIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_output {
+// RemovedOutputSpentStartedTokenTransactions returns the removed IDs of the "output_spent_started_token_transactions" edge to the TokenTransaction entity.
+func (m *TokenOutputMutation) RemovedOutputSpentStartedTokenTransactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedoutput_spent_started_token_transactions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// CreatedOutputIDs returns the "created_output" edge IDs in the mutation.
-func (m *TokenTransactionMutation) CreatedOutputIDs() (ids []uuid.UUID) {
-	for id := range m.created_output {
+// OutputSpentStartedTokenTransactionsIDs returns the "output_spent_started_token_transactions" edge IDs in the mutation.
+func (m *TokenOutputMutation) OutputSpentStartedTokenTransactionsIDs() (ids []uuid.UUID) {
+	for id := range m.output_spent_started_token_transactions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCreatedOutput resets all changes to the "created_output" edge.
-func (m *TokenTransactionMutation) ResetCreatedOutput() {
-	m.created_output = nil
-	m.clearedcreated_output = false
-	m.removedcreated_output = nil
+// ResetOutputSpentStartedTokenTransactions resets all changes to the "output_spent_started_token_transactions" edge.
+func (m *TokenOutputMutation) ResetOutputSpentStartedTokenTransactions() {
+	m.output_spent_started_token_transactions = nil
+	m.clearedoutput_spent_started_token_transactions = false
+	m.removedoutput_spent_started_token_transactions = nil
 }
 
-// SetMintID sets the "mint" edge to the TokenMint entity by id.
-func (m *TokenTransactionMutation) SetMintID(id uuid.UUID) {
-	m.mint = &id
+// AddTokenPartialRevocationSecretShareIDs adds the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity by ids.
+func (m *TokenOutputMutation) AddTokenPartialRevocationSecretShareIDs(ids ...uuid.UUID) {
+	if m.token_partial_revocation_secret_shares == nil {
+		m.token_partial_revocation_secret_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.token_partial_revocation_secret_shares[ids[i]] = struct{}{}
+	}
 }
 
-// ClearMint clears the "mint" edge to the TokenMint entity.
-func (m *TokenTransactionMutation) ClearMint() {
-	m.clearedmint = true
+// ClearTokenPartialRevocationSecretShares clears the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity.
+func (m *TokenOutputMutation) ClearTokenPartialRevocationSecretShares() {
+	m.clearedtoken_partial_revocation_secret_shares = true
 }
 
-// MintCleared reports if the "mint" edge to the TokenMint entity was cleared.
-func (m *TokenTransactionMutation) MintCleared() bool {
-	return m.clearedmint
+// TokenPartialRevocationSecretSharesCleared reports if the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity was cleared.
+func (m *TokenOutputMutation) TokenPartialRevocationSecretSharesCleared() bool {
+	return m.clearedtoken_partial_revocation_secret_shares
 }
 
-// MintID returns the "mint" edge ID in the mutation.
-func (m *TokenTransactionMutation) MintID() (id uuid.UUID, exists bool) {
-	if m.mint != nil {
-		return *m.mint, true
+// RemoveTokenPartialRevocationSecretShareIDs removes the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity by IDs.
+func (m *TokenOutputMutation) RemoveTokenPartialRevocationSecretShareIDs(ids ...uuid.UUID) {
+	if m.removedtoken_partial_revocation_secret_shares == nil {
+		m.removedtoken_partial_revocation_secret_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.token_partial_revocation_secret_shares, ids[i])
+		m.removedtoken_partial_revocation_secret_shares[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// MintIDs returns the "mint" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MintID instead. It exists only for internal usage by the builders.
-func (m *TokenTransactionMutation) MintIDs() (ids []uuid.UUID) {
-	if id := m.mint; id != nil {
-		ids = append(ids, *id)
+// RemovedTokenPartialRevocationSecretShares returns the removed IDs of the "token_partial_revocation_secret_shares" edge to the TokenPartialRevocationSecretShare entity.
+func (m *TokenOutputMutation) RemovedTokenPartialRevocationSecretSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtoken_partial_revocation_secret_shares {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetMint resets all changes to the "mint" edge.
-func (m *TokenTransactionMutation) ResetMint() {
-	m.mint = nil
-	m.clearedmint = false
+// TokenPartialRevocationSecretSharesIDs returns the "token_partial_revocation_secret_shares" edge IDs in the mutation.
+func (m *TokenOutputMutation) TokenPartialRevocationSecretSharesIDs() (ids []uuid.UUID) {
+	for id := range m.token_partial_revocation_secret_shares {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetCreateID sets the "create" edge to the TokenCreate entity by id.
-func (m *TokenTransactionMutation) SetCreateID(id uuid.UUID) {
-	m.create = &id
+// ResetTokenPartialRevocationSecretShares resets all changes to the "token_partial_revocation_secret_shares" edge.
+func (m *TokenOutputMutation) ResetTokenPartialRevocationSecretShares() {
+	m.token_partial_revocation_secret_shares = nil
+	m.clearedtoken_partial_revocation_secret_shares = false
+	m.removedtoken_partial_revocation_secret_shares = nil
 }
 
-// ClearCreate clears the "create" edge to the TokenCreate entity.
-func (m *TokenTransactionMutation) ClearCreate() {
-	m.clearedcreate = true
+// ClearTokenCreate clears the "token_create" edge to the TokenCreate entity.
+func (m *TokenOutputMutation) ClearTokenCreate() {
+	m.clearedtoken_create = true
+	m.clearedFields[tokenoutput.FieldTokenCreateID] = struct{}{}
 }
 
-// CreateCleared reports if the "create" edge to the TokenCreate entity was cleared.
-func (m *TokenTransactionMutation) CreateCleared() bool {
-	return m.clearedcreate
+// TokenCreateCleared reports if the "token_create" edge to the TokenCreate entity was cleared.
+func (m *TokenOutputMutation) TokenCreateCleared() bool {
+	return m.clearedtoken_create
 }
 
-// CreateID returns the "create" edge ID in the mutation.
-func (m *TokenTransactionMutation) CreateID() (id uuid.UUID, exists bool) {
-	if m.create != nil {
-		return *m.create, true
+// TokenCreateIDs returns the "token_create" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TokenCreateID instead. It exists only for internal usage by the builders.
+func (m *TokenOutputMutation) TokenCreateIDs() (ids []uuid.UUID) {
+	if id := m.token_create; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// CreateIDs returns the "create" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreateID instead. It exists only for internal usage by the builders.
-func (m *TokenTransactionMutation) CreateIDs() (ids []uuid.UUID) {
-	if id := m.create; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetCreate resets all changes to the "create" edge.
-func (m *TokenTransactionMutation) ResetCreate() {
-	m.create = nil
-	m.clearedcreate = false
-}
-
-// SetPaymentIntentID sets the "payment_intent" edge to the PaymentIntent entity by id.
-func (m *TokenTransactionMutation) SetPaymentIntentID(id uuid.UUID) {
-	m.payment_intent = &id
-}
-
-// ClearPaymentIntent clears the "payment_intent" edge to the PaymentIntent entity.
-func (m *TokenTransactionMutation) ClearPaymentIntent() {
-	m.clearedpayment_intent = true
-}
-
-// PaymentIntentCleared reports if the "payment_intent" edge to the PaymentIntent entity was cleared.
-func (m *TokenTransactionMutation) PaymentIntentCleared() bool {
-	return m.clearedpayment_intent
-}
-
-// PaymentIntentID returns the "payment_intent" edge ID in the mutation.
-func (m *TokenTransactionMutation) PaymentIntentID() (id uuid.UUID, exists bool) {
-	if m.payment_intent != nil {
-		return *m.payment_intent, true
-	}
-	return
-}
-
-// PaymentIntentIDs returns the "payment_intent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PaymentIntentID instead. It exists only for internal usage by the builders.
-func (m *TokenTransactionMutation) PaymentIntentIDs() (ids []uuid.UUID) {
-	if id := m.payment_intent; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetPaymentIntent resets all changes to the "payment_intent" edge.
-func (m *TokenTransactionMutation) ResetPaymentIntent() {
-	m.payment_intent = nil
-	m.clearedpayment_intent = false
-}
-
-// AddPeerSignatureIDs adds the "peer_signatures" edge to the TokenTransactionPeerSignature entity by ids.
-func (m *TokenTransactionMutation) AddPeerSignatureIDs(ids ...uuid.UUID) {
-	if m.peer_signatures == nil {
-		m.peer_signatures = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.peer_signatures[ids[i]] = struct{}{}
-	}
-}
-
-// ClearPeerSignatures clears the "peer_signatures" edge to the TokenTransactionPeerSignature entity.
-func (m *TokenTransactionMutation) ClearPeerSignatures() {
-	m.clearedpeer_signatures = true
-}
-
-// PeerSignaturesCleared reports if the "peer_signatures" edge to the TokenTransactionPeerSignature entity was cleared.
-func (m *TokenTransactionMutation) PeerSignaturesCleared() bool {
-	return m.clearedpeer_signatures
-}
-
-// RemovePeerSignatureIDs removes the "peer_signatures" edge to the TokenTransactionPeerSignature entity by IDs.
-func (m *TokenTransactionMutation) RemovePeerSignatureIDs(ids ...uuid.UUID) {
-	if m.removedpeer_signatures == nil {
-		m.removedpeer_signatures = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.peer_signatures, ids[i])
-		m.removedpeer_signatures[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedPeerSignatures returns the removed IDs of the "peer_signatures" edge to the TokenTransactionPeerSignature entity.
-func (m *TokenTransactionMutation) RemovedPeerSignaturesIDs() (ids []uuid.UUID) {
-	for id := range m.removedpeer_signatures {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// PeerSignaturesIDs returns the "peer_signatures" edge IDs in the mutation.
-func (m *TokenTransactionMutation) PeerSignaturesIDs() (ids []uuid.UUID) {
-	for id := range m.peer_signatures {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetPeerSignatures resets all changes to the "peer_signatures" edge.
-func (m *TokenTransactionMutation) ResetPeerSignatures() {
-	m.peer_signatures = nil
-	m.clearedpeer_signatures = false
-	m.removedpeer_signatures = nil
-}
-
-// AddSparkInvoiceIDs adds the "spark_invoice" edge to the SparkInvoice entity by ids.
-func (m *TokenTransactionMutation) AddSparkInvoiceIDs(ids ...uuid.UUID) {
-	if m.spark_invoice == nil {
-		m.spark_invoice = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.spark_invoice[ids[i]] = struct{}{}
-	}
-}
-
-// ClearSparkInvoice clears the "spark_invoice" edge to the SparkInvoice entity.
-func (m *TokenTransactionMutation) ClearSparkInvoice() {
-	m.clearedspark_invoice = true
-}
-
-// SparkInvoiceCleared reports if the "spark_invoice" edge to the SparkInvoice entity was cleared.
-func (m *TokenTransactionMutation) SparkInvoiceCleared() bool {
-	return m.clearedspark_invoice
-}
-
-// RemoveSparkInvoiceIDs removes the "spark_invoice" edge to the SparkInvoice entity by IDs.
-func (m *TokenTransactionMutation) RemoveSparkInvoiceIDs(ids ...uuid.UUID) {
-	if m.removedspark_invoice == nil {
-		m.removedspark_invoice = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.spark_invoice, ids[i])
-		m.removedspark_invoice[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedSparkInvoice returns the removed IDs of the "spark_invoice" edge to the SparkInvoice entity.
-func (m *TokenTransactionMutation) RemovedSparkInvoiceIDs() (ids []uuid.UUID) {
-	for id := range m.removedspark_invoice {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// SparkInvoiceIDs returns the "spark_invoice" edge IDs in the mutation.
-func (m *TokenTransactionMutation) SparkInvoiceIDs() (ids []uuid.UUID) {
-	for id := range m.spark_invoice {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetSparkInvoice resets all changes to the "spark_invoice" edge.
-func (m *TokenTransactionMutation) ResetSparkInvoice() {
-	m.spark_invoice = nil
-	m.clearedspark_invoice = false
-	m.removedspark_invoice = nil
+// ResetTokenCreate resets all changes to the "token_create" edge.
+func (m *TokenOutputMutation) ResetTokenCreate() {
+	m.token_create = nil
+	m.clearedtoken_create = false
 }
 
-// Where appends a list predicates to the TokenTransactionMutation builder.
-func (m *TokenTransactionMutation) Where(ps ...predicate.TokenTransaction) {
+// Where appends a list predicates to the TokenOutputMutation builder.
+func (m *TokenOutputMutation) Where(ps ...predicate.TokenOutput) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenTransactionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenOutputMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenTransaction, len(ps))
+func (m *TokenOutputMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenOutput, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -16065,54 +16391,81 @@ func (m *TokenTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TokenTransactionMutation) Op() Op {
+func (m *TokenOutputMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenTransactionMutation) SetOp(op Op) {
+func (m *TokenOutputMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TokenTransaction).
-func (m *TokenTransactionMutation) Type() string {
+// Type returns the node type of this mutation (TokenOutput).
+func (m *TokenOutputMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenTransactionMutation) Fields() []string {
-	fields := make([]string, 0, 10)
+func (m *TokenOutputMutation) Fields() []string {
+	fields := make([]string, 0, 19)
 	if m.create_time != nil {
-		fields = append(fields, tokentransaction.FieldCreateTime)
+		fields = append(fields, tokenoutput.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tokentransaction.FieldUpdateTime)
+		fields = append(fields, tokenoutput.FieldUpdateTime)
 	}
-	if m.partial_token_transaction_hash != nil {
-		fields = append(fields, tokentransaction.FieldPartialTokenTransactionHash)
+	if m.status != nil {
+		fields = append(fields, tokenoutput.FieldStatus)
 	}
-	if m.finalized_token_transaction_hash != nil {
-		fields = append(fields, tokentransaction.FieldFinalizedTokenTransactionHash)
+	if m.owner_public_key != nil {
+		fields = append(fields, tokenoutput.FieldOwnerPublicKey)
 	}
-	if m.operator_signature != nil {
-		fields = append(fields, tokentransaction.FieldOperatorSignature)
+	if m.withdraw_bond_sats != nil {
+		fields = append(fields, tokenoutput.FieldWithdrawBondSats)
 	}
-	if m.status != nil {
-		fields = append(fields, tokentransaction.FieldStatus)
+	if m.withdraw_relative_block_locktime != nil {
+		fields = append(fields, tokenoutput.FieldWithdrawRelativeBlockLocktime)
 	}
-	if m.expiry_time != nil {
-		fields = append(fields, tokentransaction.FieldExpiryTime)
+	if m.withdraw_revocation_commitment != nil {
+		fields = append(fields, tokenoutput.FieldWithdrawRevocationCommitment)
 	}
-	if m.coordinator_public_key != nil {
-		fields = append(fields, tokentransaction.FieldCoordinatorPublicKey)
+	if m.token_public_key != nil {
+		fields = append(fields, tokenoutput.FieldTokenPublicKey)
 	}
-	if m.client_created_timestamp != nil {
-		fields = append(fields, tokentransaction.FieldClientCreatedTimestamp)
+	if m.token_amount != nil {
+		fields = append(fields, tokenoutput.FieldTokenAmount)
 	}
-	if m.version != nil {
-		fields = append(fields, tokentransaction.FieldVersion)
+	if m.created_transaction_output_vout != nil {
+		fields = append(fields, tokenoutput.FieldCreatedTransactionOutputVout)
+	}
+	if m.spent_ownership_signature != nil {
+		fields = append(fields, tokenoutput.FieldSpentOwnershipSignature)
+	}
+	if m.spent_operator_specific_ownership_signature != nil {
+		fields = append(fields, tokenoutput.FieldSpentOperatorSpecificOwnershipSignature)
+	}
+	if m.spent_transaction_input_vout != nil {
+		fields = append(fields, tokenoutput.FieldSpentTransactionInputVout)
+	}
+	if m.spent_revocation_secret != nil {
+		fields = append(fields, tokenoutput.FieldSpentRevocationSecret)
+	}
+	if m.confirmed_withdraw_block_hash != nil {
+		fields = append(fields, tokenoutput.FieldConfirmedWithdrawBlockHash)
+	}
+	if m.network != nil {
+		fields = append(fields, tokenoutput.FieldNetwork)
+	}
+	if m.token_identifier != nil {
+		fields = append(fields, tokenoutput.FieldTokenIdentifier)
+	}
+	if m.token_create != nil {
+		fields = append(fields, tokenoutput.FieldTokenCreateID)
+	}
+	if m.spendable_after != nil {
+		fields = append(fields, tokenoutput.FieldSpendableAfter)
 	}
 	return fields
 }
@@ -16120,28 +16473,46 @@ func (m *TokenTransactionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenTransactionMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenOutputMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tokentransaction.FieldCreateTime:
+	case tokenoutput.FieldCreateTime:
 		return m.CreateTime()
-	case tokentransaction.FieldUpdateTime:
+	case tokenoutput.FieldUpdateTime:
 		return m.UpdateTime()
-	case tokentransaction.FieldPartialTokenTransactionHash:
-		return m.PartialTokenTransactionHash()
-	case tokentransaction.FieldFinalizedTokenTransactionHash:
-		return m.FinalizedTokenTransactionHash()
-	case tokentransaction.FieldOperatorSignature:
-		return m.OperatorSignature()
-	case tokentransaction.FieldStatus:
+	case tokenoutput.FieldStatus:
 		return m.Status()
-	case tokentransaction.FieldExpiryTime:
-		return m.ExpiryTime()
-	case tokentransaction.FieldCoordinatorPublicKey:
-		return m.CoordinatorPublicKey()
-	case tokentransaction.FieldClientCreatedTimestamp:
-		return m.ClientCreatedTimestamp()
-	case tokentransaction.FieldVersion:
-		return m.Version()
+	case tokenoutput.FieldOwnerPublicKey:
+		return m.OwnerPublicKey()
+	case tokenoutput.FieldWithdrawBondSats:
+		return m.WithdrawBondSats()
+	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+		return m.WithdrawRelativeBlockLocktime()
+	case tokenoutput.FieldWithdrawRevocationCommitment:
+		return m.WithdrawRevocationCommitment()
+	case tokenoutput.FieldTokenPublicKey:
+		return m.TokenPublicKey()
+	case tokenoutput.FieldTokenAmount:
+		return m.TokenAmount()
+	case tokenoutput.FieldCreatedTransactionOutputVout:
+		return m.CreatedTransactionOutputVout()
+	case tokenoutput.FieldSpentOwnershipSignature:
+		return m.SpentOwnershipSignature()
+	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
+		return m.SpentOperatorSpecificOwnershipSignature()
+	case tokenoutput.FieldSpentTransactionInputVout:
+		return m.SpentTransactionInputVout()
+	case tokenoutput.FieldSpentRevocationSecret:
+		return m.SpentRevocationSecret()
+	case tokenoutput.FieldConfirmedWithdrawBlockHash:
+		return m.ConfirmedWithdrawBlockHash()
+	case tokenoutput.FieldNetwork:
+		return m.Network()
+	case tokenoutput.FieldTokenIdentifier:
+		return m.TokenIdentifier()
+	case tokenoutput.FieldTokenCreateID:
+		return m.TokenCreateID()
+	case tokenoutput.FieldSpendableAfter:
+		return m.SpendableAfter()
 	}
 	return nil, false
 }
@@ -16149,117 +16520,207 @@ func (m *TokenTransactionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenTransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenOutputMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tokentransaction.FieldCreateTime:
+	case tokenoutput.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tokentransaction.FieldUpdateTime:
+	case tokenoutput.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tokentransaction.FieldPartialTokenTransactionHash:
-		return m.OldPartialTokenTransactionHash(ctx)
-	case tokentransaction.FieldFinalizedTokenTransactionHash:
-		return m.OldFinalizedTokenTransactionHash(ctx)
-	case tokentransaction.FieldOperatorSignature:
-		return m.OldOperatorSignature(ctx)
-	case tokentransaction.FieldStatus:
+	case tokenoutput.FieldStatus:
 		return m.OldStatus(ctx)
-	case tokentransaction.FieldExpiryTime:
-		return m.OldExpiryTime(ctx)
-	case tokentransaction.FieldCoordinatorPublicKey:
-		return m.OldCoordinatorPublicKey(ctx)
-	case tokentransaction.FieldClientCreatedTimestamp:
-		return m.OldClientCreatedTimestamp(ctx)
-	case tokentransaction.FieldVersion:
-		return m.OldVersion(ctx)
+	case tokenoutput.FieldOwnerPublicKey:
+		return m.OldOwnerPublicKey(ctx)
+	case tokenoutput.FieldWithdrawBondSats:
+		return m.OldWithdrawBondSats(ctx)
+	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+		return m.OldWithdrawRelativeBlockLocktime(ctx)
+	case tokenoutput.FieldWithdrawRevocationCommitment:
+		return m.OldWithdrawRevocationCommitment(ctx)
+	case tokenoutput.FieldTokenPublicKey:
+		return m.OldTokenPublicKey(ctx)
+	case tokenoutput.FieldTokenAmount:
+		return m.OldTokenAmount(ctx)
+	case tokenoutput.FieldCreatedTransactionOutputVout:
+		return m.OldCreatedTransactionOutputVout(ctx)
+	case tokenoutput.FieldSpentOwnershipSignature:
+		return m.OldSpentOwnershipSignature(ctx)
+	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
+		return m.OldSpentOperatorSpecificOwnershipSignature(ctx)
+	case tokenoutput.FieldSpentTransactionInputVout:
+		return m.OldSpentTransactionInputVout(ctx)
+	case tokenoutput.FieldSpentRevocationSecret:
+		return m.OldSpentRevocationSecret(ctx)
+	case tokenoutput.FieldConfirmedWithdrawBlockHash:
+		return m.OldConfirmedWithdrawBlockHash(ctx)
+	case tokenoutput.FieldNetwork:
+		return m.OldNetwork(ctx)
+	case tokenoutput.FieldTokenIdentifier:
+		return m.OldTokenIdentifier(ctx)
+	case tokenoutput.FieldTokenCreateID:
+		return m.OldTokenCreateID(ctx)
+	case tokenoutput.FieldSpendableAfter:
+		return m.OldSpendableAfter(ctx)
 	}
-	return nil, fmt.Errorf("unknown TokenTransaction field %s", name)
+	return nil, fmt.Errorf("unknown TokenOutput field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenTransactionMutation) SetField(name string, value ent.Value) error {
+func (m *TokenOutputMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tokentransaction.FieldCreateTime:
+	case tokenoutput.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tokentransaction.FieldUpdateTime:
+	case tokenoutput.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tokentransaction.FieldPartialTokenTransactionHash:
+	case tokenoutput.FieldStatus:
+		v, ok := value.(schematype.TokenOutputStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tokenoutput.FieldOwnerPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPartialTokenTransactionHash(v)
+		m.SetOwnerPublicKey(v)
 		return nil
-	case tokentransaction.FieldFinalizedTokenTransactionHash:
+	case tokenoutput.FieldWithdrawBondSats:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWithdrawBondSats(v)
+		return nil
+	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWithdrawRelativeBlockLocktime(v)
+		return nil
+	case tokenoutput.FieldWithdrawRevocationCommitment:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFinalizedTokenTransactionHash(v)
+		m.SetWithdrawRevocationCommitment(v)
 		return nil
-	case tokentransaction.FieldOperatorSignature:
+	case tokenoutput.FieldTokenPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOperatorSignature(v)
+		m.SetTokenPublicKey(v)
 		return nil
-	case tokentransaction.FieldStatus:
-		v, ok := value.(schematype.TokenTransactionStatus)
+	case tokenoutput.FieldTokenAmount:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetTokenAmount(v)
 		return nil
-	case tokentransaction.FieldExpiryTime:
-		v, ok := value.(time.Time)
+	case tokenoutput.FieldCreatedTransactionOutputVout:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiryTime(v)
+		m.SetCreatedTransactionOutputVout(v)
 		return nil
-	case tokentransaction.FieldCoordinatorPublicKey:
+	case tokenoutput.FieldSpentOwnershipSignature:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCoordinatorPublicKey(v)
+		m.SetSpentOwnershipSignature(v)
 		return nil
-	case tokentransaction.FieldClientCreatedTimestamp:
-		v, ok := value.(time.Time)
+	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientCreatedTimestamp(v)
+		m.SetSpentOperatorSpecificOwnershipSignature(v)
 		return nil
-	case tokentransaction.FieldVersion:
-		v, ok := value.(schematype.TokenTransactionVersion)
+	case tokenoutput.FieldSpentTransactionInputVout:
+		v, ok := value.(int32)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetSpentTransactionInputVout(v)
+		return nil
+	case tokenoutput.FieldSpentRevocationSecret:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpentRevocationSecret(v)
+		return nil
+	case tokenoutput.FieldConfirmedWithdrawBlockHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfirmedWithdrawBlockHash(v)
+		return nil
+	case tokenoutput.FieldNetwork:
+		v, ok := value.(schematype.Network)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	case tokenoutput.FieldTokenIdentifier:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenIdentifier(v)
+		return nil
+	case tokenoutput.FieldTokenCreateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenCreateID(v)
+		return nil
+	case tokenoutput.FieldSpendableAfter:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpendableAfter(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransaction field %s", name)
+	return fmt.Errorf("unknown TokenOutput field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenTransactionMutation) AddedFields() []string {
+func (m *TokenOutputMutation) AddedFields() []string {
 	var fields []string
-	if m.addversion != nil {
-		fields = append(fields, tokentransaction.FieldVersion)
+	if m.addwithdraw_bond_sats != nil {
+		fields = append(fields, tokenoutput.FieldWithdrawBondSats)
+	}
+	if m.addwithdraw_relative_block_locktime != nil {
+		fields = append(fields, tokenoutput.FieldWithdrawRelativeBlockLocktime)
+	}
+	if m.addcreated_transaction_output_vout != nil {
+		fields = append(fields, tokenoutput.FieldCreatedTransactionOutputVout)
+	}
+	if m.addspent_transaction_input_vout != nil {
+		fields = append(fields, tokenoutput.FieldSpentTransactionInputVout)
 	}
 	return fields
 }
@@ -16267,10 +16728,16 @@ func (m *TokenTransactionMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenTransactionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenOutputMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tokentransaction.FieldVersion:
-		return m.AddedVersion()
+	case tokenoutput.FieldWithdrawBondSats:
+		return m.AddedWithdrawBondSats()
+	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+		return m.AddedWithdrawRelativeBlockLocktime()
+	case tokenoutput.FieldCreatedTransactionOutputVout:
+		return m.AddedCreatedTransactionOutputVout()
+	case tokenoutput.FieldSpentTransactionInputVout:
+		return m.AddedSpentTransactionInputVout()
 	}
 	return nil, false
 }
@@ -16278,241 +16745,260 @@ func (m *TokenTransactionMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenTransactionMutation) AddField(name string, value ent.Value) error {
+func (m *TokenOutputMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tokentransaction.FieldVersion:
-		v, ok := value.(schematype.TokenTransactionVersion)
+	case tokenoutput.FieldWithdrawBondSats:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVersion(v)
+		m.AddWithdrawBondSats(v)
+		return nil
+	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWithdrawRelativeBlockLocktime(v)
+		return nil
+	case tokenoutput.FieldCreatedTransactionOutputVout:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreatedTransactionOutputVout(v)
+		return nil
+	case tokenoutput.FieldSpentTransactionInputVout:
+		v, ok := value.(int32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpentTransactionInputVout(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransaction numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TokenTransactionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(tokentransaction.FieldOperatorSignature) {
-		fields = append(fields, tokentransaction.FieldOperatorSignature)
-	}
-	if m.FieldCleared(tokentransaction.FieldStatus) {
-		fields = append(fields, tokentransaction.FieldStatus)
-	}
-	if m.FieldCleared(tokentransaction.FieldExpiryTime) {
-		fields = append(fields, tokentransaction.FieldExpiryTime)
-	}
-	if m.FieldCleared(tokentransaction.FieldCoordinatorPublicKey) {
-		fields = append(fields, tokentransaction.FieldCoordinatorPublicKey)
-	}
-	if m.FieldCleared(tokentransaction.FieldClientCreatedTimestamp) {
-		fields = append(fields, tokentransaction.FieldClientCreatedTimestamp)
+	return fmt.Errorf("unknown TokenOutput numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenOutputMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tokenoutput.FieldTokenPublicKey) {
+		fields = append(fields, tokenoutput.FieldTokenPublicKey)
+	}
+	if m.FieldCleared(tokenoutput.FieldSpentOwnershipSignature) {
+		fields = append(fields, tokenoutput.FieldSpentOwnershipSignature)
+	}
+	if m.FieldCleared(tokenoutput.FieldSpentOperatorSpecificOwnershipSignature) {
+		fields = append(fields, tokenoutput.FieldSpentOperatorSpecificOwnershipSignature)
+	}
+	if m.FieldCleared(tokenoutput.FieldSpentTransactionInputVout) {
+		fields = append(fields, tokenoutput.FieldSpentTransactionInputVout)
+	}
+	if m.FieldCleared(tokenoutput.FieldSpentRevocationSecret) {
+		fields = append(fields, tokenoutput.FieldSpentRevocationSecret)
+	}
+	if m.FieldCleared(tokenoutput.FieldConfirmedWithdrawBlockHash) {
+		fields = append(fields, tokenoutput.FieldConfirmedWithdrawBlockHash)
+	}
+	if m.FieldCleared(tokenoutput.FieldNetwork) {
+		fields = append(fields, tokenoutput.FieldNetwork)
+	}
+	if m.FieldCleared(tokenoutput.FieldSpendableAfter) {
+		fields = append(fields, tokenoutput.FieldSpendableAfter)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenTransactionMutation) FieldCleared(name string) bool {
+func (m *TokenOutputMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenTransactionMutation) ClearField(name string) error {
+func (m *TokenOutputMutation) ClearField(name string) error {
 	switch name {
-	case tokentransaction.FieldOperatorSignature:
-		m.ClearOperatorSignature()
+	case tokenoutput.FieldTokenPublicKey:
+		m.ClearTokenPublicKey()
 		return nil
-	case tokentransaction.FieldStatus:
-		m.ClearStatus()
+	case tokenoutput.FieldSpentOwnershipSignature:
+		m.ClearSpentOwnershipSignature()
 		return nil
-	case tokentransaction.FieldExpiryTime:
-		m.ClearExpiryTime()
+	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
+		m.ClearSpentOperatorSpecificOwnershipSignature()
 		return nil
-	case tokentransaction.FieldCoordinatorPublicKey:
-		m.ClearCoordinatorPublicKey()
+	case tokenoutput.FieldSpentTransactionInputVout:
+		m.ClearSpentTransactionInputVout()
 		return nil
-	case tokentransaction.FieldClientCreatedTimestamp:
-		m.ClearClientCreatedTimestamp()
+	case tokenoutput.FieldSpentRevocationSecret:
+		m.ClearSpentRevocationSecret()
+		return nil
+	case tokenoutput.FieldConfirmedWithdrawBlockHash:
+		m.ClearConfirmedWithdrawBlockHash()
+		return nil
+	case tokenoutput.FieldNetwork:
+		m.ClearNetwork()
+		return nil
+	case tokenoutput.FieldSpendableAfter:
+		m.ClearSpendableAfter()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransaction nullable field %s", name)
+	return fmt.Errorf("unknown TokenOutput nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenTransactionMutation) ResetField(name string) error {
+func (m *TokenOutputMutation) ResetField(name string) error {
 	switch name {
-	case tokentransaction.FieldCreateTime:
+	case tokenoutput.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tokentransaction.FieldUpdateTime:
+	case tokenoutput.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tokentransaction.FieldPartialTokenTransactionHash:
-		m.ResetPartialTokenTransactionHash()
+	case tokenoutput.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case tokentransaction.FieldFinalizedTokenTransactionHash:
-		m.ResetFinalizedTokenTransactionHash()
+	case tokenoutput.FieldOwnerPublicKey:
+		m.ResetOwnerPublicKey()
 		return nil
-	case tokentransaction.FieldOperatorSignature:
-		m.ResetOperatorSignature()
+	case tokenoutput.FieldWithdrawBondSats:
+		m.ResetWithdrawBondSats()
 		return nil
-	case tokentransaction.FieldStatus:
-		m.ResetStatus()
+	case tokenoutput.FieldWithdrawRelativeBlockLocktime:
+		m.ResetWithdrawRelativeBlockLocktime()
 		return nil
-	case tokentransaction.FieldExpiryTime:
-		m.ResetExpiryTime()
+	case tokenoutput.FieldWithdrawRevocationCommitment:
+		m.ResetWithdrawRevocationCommitment()
 		return nil
-	case tokentransaction.FieldCoordinatorPublicKey:
-		m.ResetCoordinatorPublicKey()
+	case tokenoutput.FieldTokenPublicKey:
+		m.ResetTokenPublicKey()
 		return nil
-	case tokentransaction.FieldClientCreatedTimestamp:
-		m.ResetClientCreatedTimestamp()
+	case tokenoutput.FieldTokenAmount:
+		m.ResetTokenAmount()
 		return nil
-	case tokentransaction.FieldVersion:
-		m.ResetVersion()
+	case tokenoutput.FieldCreatedTransactionOutputVout:
+		m.ResetCreatedTransactionOutputVout()
+		return nil
+	case tokenoutput.FieldSpentOwnershipSignature:
+		m.ResetSpentOwnershipSignature()
+		return nil
+	case tokenoutput.FieldSpentOperatorSpecificOwnershipSignature:
+		m.ResetSpentOperatorSpecificOwnershipSignature()
+		return nil
+	case tokenoutput.FieldSpentTransactionInputVout:
+		m.ResetSpentTransactionInputVout()
+		return nil
+	case tokenoutput.FieldSpentRevocationSecret:
+		m.ResetSpentRevocationSecret()
+		return nil
+	case tokenoutput.FieldConfirmedWithdrawBlockHash:
+		m.ResetConfirmedWithdrawBlockHash()
+		return nil
+	case tokenoutput.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	case tokenoutput.FieldTokenIdentifier:
+		m.ResetTokenIdentifier()
+		return nil
+	case tokenoutput.FieldTokenCreateID:
+		m.ResetTokenCreateID()
+		return nil
+	case tokenoutput.FieldSpendableAfter:
+		m.ResetSpendableAfter()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransaction field %s", name)
+	return fmt.Errorf("unknown TokenOutput field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenTransactionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.spent_output != nil {
-		edges = append(edges, tokentransaction.EdgeSpentOutput)
-	}
-	if m.spent_output_v2 != nil {
-		edges = append(edges, tokentransaction.EdgeSpentOutputV2)
-	}
-	if m.created_output != nil {
-		edges = append(edges, tokentransaction.EdgeCreatedOutput)
+func (m *TokenOutputMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.revocation_keyshare != nil {
+		edges = append(edges, tokenoutput.EdgeRevocationKeyshare)
 	}
-	if m.mint != nil {
-		edges = append(edges, tokentransaction.EdgeMint)
+	if m.output_created_token_transaction != nil {
+		edges = append(edges, tokenoutput.EdgeOutputCreatedTokenTransaction)
 	}
-	if m.create != nil {
-		edges = append(edges, tokentransaction.EdgeCreate)
+	if m.output_spent_token_transaction != nil {
+		edges = append(edges, tokenoutput.EdgeOutputSpentTokenTransaction)
 	}
-	if m.payment_intent != nil {
-		edges = append(edges, tokentransaction.EdgePaymentIntent)
+	if m.output_spent_started_token_transactions != nil {
+		edges = append(edges, tokenoutput.EdgeOutputSpentStartedTokenTransactions)
 	}
-	if m.peer_signatures != nil {
-		edges = append(edges, tokentransaction.EdgePeerSignatures)
+	if m.token_partial_revocation_secret_shares != nil {
+		edges = append(edges, tokenoutput.EdgeTokenPartialRevocationSecretShares)
 	}
-	if m.spark_invoice != nil {
-		edges = append(edges, tokentransaction.EdgeSparkInvoice)
+	if m.token_create != nil {
+		edges = append(edges, tokenoutput.EdgeTokenCreate)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenTransactionMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenOutputMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tokentransaction.EdgeSpentOutput:
-		ids := make([]ent.Value, 0, len(m.spent_output))
-		for id := range m.spent_output {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokentransaction.EdgeSpentOutputV2:
-		ids := make([]ent.Value, 0, len(m.spent_output_v2))
-		for id := range m.spent_output_v2 {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokentransaction.EdgeCreatedOutput:
-		ids := make([]ent.Value, 0, len(m.created_output))
-		for id := range m.created_output {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokentransaction.EdgeMint:
-		if id := m.mint; id != nil {
+	case tokenoutput.EdgeRevocationKeyshare:
+		if id := m.revocation_keyshare; id != nil {
 			return []ent.Value{*id}
 		}
-	case tokentransaction.EdgeCreate:
-		if id := m.create; id != nil {
+	case tokenoutput.EdgeOutputCreatedTokenTransaction:
+		if id := m.output_created_token_transaction; id != nil {
 			return []ent.Value{*id}
 		}
-	case tokentransaction.EdgePaymentIntent:
-		if id := m.payment_intent; id != nil {
+	case tokenoutput.EdgeOutputSpentTokenTransaction:
+		if id := m.output_spent_token_transaction; id != nil {
 			return []ent.Value{*id}
 		}
-	case tokentransaction.EdgePeerSignatures:
-		ids := make([]ent.Value, 0, len(m.peer_signatures))
-		for id := range m.peer_signatures {
+	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
+		ids := make([]ent.Value, 0, len(m.output_spent_started_token_transactions))
+		for id := range m.output_spent_started_token_transactions {
 			ids = append(ids, id)
 		}
 		return ids
-	case tokentransaction.EdgeSparkInvoice:
-		ids := make([]ent.Value, 0, len(m.spark_invoice))
-		for id := range m.spark_invoice {
+	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
+		ids := make([]ent.Value, 0, len(m.token_partial_revocation_secret_shares))
+		for id := range m.token_partial_revocation_secret_shares {
 			ids = append(ids, id)
 		}
 		return ids
+	case tokenoutput.EdgeTokenCreate:
+		if id := m.token_create; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenTransactionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.removedspent_output != nil {
-		edges = append(edges, tokentransaction.EdgeSpentOutput)
-	}
-	if m.removedspent_output_v2 != nil {
-		edges = append(edges, tokentransaction.EdgeSpentOutputV2)
-	}
-	if m.removedcreated_output != nil {
-		edges = append(edges, tokentransaction.EdgeCreatedOutput)
-	}
-	if m.removedpeer_signatures != nil {
-		edges = append(edges, tokentransaction.EdgePeerSignatures)
+func (m *TokenOutputMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedoutput_spent_started_token_transactions != nil {
+		edges = append(edges, tokenoutput.EdgeOutputSpentStartedTokenTransactions)
 	}
-	if m.removedspark_invoice != nil {
-		edges = append(edges, tokentransaction.EdgeSparkInvoice)
+	if m.removedtoken_partial_revocation_secret_shares != nil {
+		edges = append(edges, tokenoutput.EdgeTokenPartialRevocationSecretShares)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenTransactionMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenOutputMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case tokentransaction.EdgeSpentOutput:
-		ids := make([]ent.Value, 0, len(m.removedspent_output))
-		for id := range m.removedspent_output {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokentransaction.EdgeSpentOutputV2:
-		ids := make([]ent.Value, 0, len(m.removedspent_output_v2))
-		for id := range m.removedspent_output_v2 {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokentransaction.EdgeCreatedOutput:
-		ids := make([]ent.Value, 0, len(m.removedcreated_output))
-		for id := range m.removedcreated_output {
-			ids = append(ids, id)
-		}
-		return ids
-	case tokentransaction.EdgePeerSignatures:
-		ids := make([]ent.Value, 0, len(m.removedpeer_signatures))
-		for id := range m.removedpeer_signatures {
+	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
+		ids := make([]ent.Value, 0, len(m.removedoutput_spent_started_token_transactions))
+		for id := range m.removedoutput_spent_started_token_transactions {
 			ids = append(ids, id)
 		}
 		return ids
-	case tokentransaction.EdgeSparkInvoice:
-		ids := make([]ent.Value, 0, len(m.removedspark_invoice))
-		for id := range m.removedspark_invoice {
+	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
+		ids := make([]ent.Value, 0, len(m.removedtoken_partial_revocation_secret_shares))
+		for id := range m.removedtoken_partial_revocation_secret_shares {
 			ids = append(ids, id)
 		}
 		return ids
@@ -16521,110 +17007,97 @@ func (m *TokenTransactionMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenTransactionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
-	if m.clearedspent_output {
-		edges = append(edges, tokentransaction.EdgeSpentOutput)
-	}
-	if m.clearedspent_output_v2 {
-		edges = append(edges, tokentransaction.EdgeSpentOutputV2)
-	}
-	if m.clearedcreated_output {
-		edges = append(edges, tokentransaction.EdgeCreatedOutput)
+func (m *TokenOutputMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedrevocation_keyshare {
+		edges = append(edges, tokenoutput.EdgeRevocationKeyshare)
 	}
-	if m.clearedmint {
-		edges = append(edges, tokentransaction.EdgeMint)
+	if m.clearedoutput_created_token_transaction {
+		edges = append(edges, tokenoutput.EdgeOutputCreatedTokenTransaction)
 	}
-	if m.clearedcreate {
-		edges = append(edges, tokentransaction.EdgeCreate)
+	if m.clearedoutput_spent_token_transaction {
+		edges = append(edges, tokenoutput.EdgeOutputSpentTokenTransaction)
 	}
-	if m.clearedpayment_intent {
-		edges = append(edges, tokentransaction.EdgePaymentIntent)
+	if m.clearedoutput_spent_started_token_transactions {
+		edges = append(edges, tokenoutput.EdgeOutputSpentStartedTokenTransactions)
 	}
-	if m.clearedpeer_signatures {
-		edges = append(edges, tokentransaction.EdgePeerSignatures)
+	if m.clearedtoken_partial_revocation_secret_shares {
+		edges = append(edges, tokenoutput.EdgeTokenPartialRevocationSecretShares)
 	}
-	if m.clearedspark_invoice {
-		edges = append(edges, tokentransaction.EdgeSparkInvoice)
+	if m.clearedtoken_create {
+		edges = append(edges, tokenoutput.EdgeTokenCreate)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenTransactionMutation) EdgeCleared(name string) bool {
+func (m *TokenOutputMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tokentransaction.EdgeSpentOutput:
-		return m.clearedspent_output
-	case tokentransaction.EdgeSpentOutputV2:
-		return m.clearedspent_output_v2
-	case tokentransaction.EdgeCreatedOutput:
-		return m.clearedcreated_output
-	case tokentransaction.EdgeMint:
-		return m.clearedmint
-	case tokentransaction.EdgeCreate:
-		return m.clearedcreate
-	case tokentransaction.EdgePaymentIntent:
-		return m.clearedpayment_intent
-	case tokentransaction.EdgePeerSignatures:
-		return m.clearedpeer_signatures
-	case tokentransaction.EdgeSparkInvoice:
-		return m.clearedspark_invoice
+	case tokenoutput.EdgeRevocationKeyshare:
+		return m.clearedrevocation_keyshare
+	case tokenoutput.EdgeOutputCreatedTokenTransaction:
+		return m.clearedoutput_created_token_transaction
+	case tokenoutput.EdgeOutputSpentTokenTransaction:
+		return m.clearedoutput_spent_token_transaction
+	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
+		return m.clearedoutput_spent_started_token_transactions
+	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
+		return m.clearedtoken_partial_revocation_secret_shares
+	case tokenoutput.EdgeTokenCreate:
+		return m.clearedtoken_create
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenTransactionMutation) ClearEdge(name string) error {
+func (m *TokenOutputMutation) ClearEdge(name string) error {
 	switch name {
-	case tokentransaction.EdgeMint:
-		m.ClearMint()
+	case tokenoutput.EdgeRevocationKeyshare:
+		m.ClearRevocationKeyshare()
 		return nil
-	case tokentransaction.EdgeCreate:
-		m.ClearCreate()
+	case tokenoutput.EdgeOutputCreatedTokenTransaction:
+		m.ClearOutputCreatedTokenTransaction()
 		return nil
-	case tokentransaction.EdgePaymentIntent:
-		m.ClearPaymentIntent()
+	case tokenoutput.EdgeOutputSpentTokenTransaction:
+		m.ClearOutputSpentTokenTransaction()
+		return nil
+	case tokenoutput.EdgeTokenCreate:
+		m.ClearTokenCreate()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransaction unique edge %s", name)
+	return fmt.Errorf("unknown TokenOutput unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenTransactionMutation) ResetEdge(name string) error {
+func (m *TokenOutputMutation) ResetEdge(name string) error {
 	switch name {
-	case tokentransaction.EdgeSpentOutput:
-		m.ResetSpentOutput()
-		return nil
-	case tokentransaction.EdgeSpentOutputV2:
-		m.ResetSpentOutputV2()
-		return nil
-	case tokentransaction.EdgeCreatedOutput:
-		m.ResetCreatedOutput()
+	case tokenoutput.EdgeRevocationKeyshare:
+		m.ResetRevocationKeyshare()
 		return nil
-	case tokentransaction.EdgeMint:
-		m.ResetMint()
+	case tokenoutput.EdgeOutputCreatedTokenTransaction:
+		m.ResetOutputCreatedTokenTransaction()
 		return nil
-	case tokentransaction.EdgeCreate:
-		m.ResetCreate()
+	case tokenoutput.EdgeOutputSpentTokenTransaction:
+		m.ResetOutputSpentTokenTransaction()
 		return nil
-	case tokentransaction.EdgePaymentIntent:
-		m.ResetPaymentIntent()
+	case tokenoutput.EdgeOutputSpentStartedTokenTransactions:
+		m.ResetOutputSpentStartedTokenTransactions()
 		return nil
-	case tokentransaction.EdgePeerSignatures:
-		m.ResetPeerSignatures()
+	case tokenoutput.EdgeTokenPartialRevocationSecretShares:
+		m.ResetTokenPartialRevocationSecretShares()
 		return nil
-	case tokentransaction.EdgeSparkInvoice:
-		m.ResetSparkInvoice()
+	case tokenoutput.EdgeTokenCreate:
+		m.ResetTokenCreate()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransaction edge %s", name)
+	return fmt.Errorf("unknown TokenOutput edge %s", name)
 }
 
-// TokenTransactionPeerSignatureMutation represents an operation that mutates the TokenTransactionPeerSignature nodes in the graph.
-type TokenTransactionPeerSignatureMutation struct {
+// TokenPartialRevocationSecretShareMutation represents an operation that mutates the TokenPartialRevocationSecretShare nodes in the graph.
+type TokenPartialRevocationSecretShareMutation struct {
 	config
 	op                           Op
 	typ                          string
@@ -16632,26 +17105,26 @@ type TokenTransactionPeerSignatureMutation struct {
 	create_time                  *time.Time
 	update_time                  *time.Time
 	operator_identity_public_key *[]byte
-	signature                    *[]byte
+	secret_share                 *[]byte
 	clearedFields                map[string]struct{}
-	token_transaction            *uuid.UUID
-	clearedtoken_transaction     bool
+	token_output                 *uuid.UUID
+	clearedtoken_output          bool
 	done                         bool
-	oldValue                     func(context.Context) (*TokenTransactionPeerSignature, error)
-	predicates                   []predicate.TokenTransactionPeerSignature
+	oldValue                     func(context.Context) (*TokenPartialRevocationSecretShare, error)
+	predicates                   []predicate.TokenPartialRevocationSecretShare
 }
 
-var _ ent.Mutation = (*TokenTransactionPeerSignatureMutation)(nil)
+var _ ent.Mutation = (*TokenPartialRevocationSecretShareMutation)(nil)
 
-// tokentransactionpeersignatureOption allows management of the mutation configuration using functional options.
-type tokentransactionpeersignatureOption func(*TokenTransactionPeerSignatureMutation)
+// tokenpartialrevocationsecretshareOption allows management of the mutation configuration using functional options.
+type tokenpartialrevocationsecretshareOption func(*TokenPartialRevocationSecretShareMutation)
 
-// newTokenTransactionPeerSignatureMutation creates new mutation for the TokenTransactionPeerSignature entity.
-func newTokenTransactionPeerSignatureMutation(c config, op Op, opts ...tokentransactionpeersignatureOption) *TokenTransactionPeerSignatureMutation {
-	m := &TokenTransactionPeerSignatureMutation{
+// newTokenPartialRevocationSecretShareMutation creates new mutation for the TokenPartialRevocationSecretShare entity.
+func newTokenPartialRevocationSecretShareMutation(c config, op Op, opts ...tokenpartialrevocationsecretshareOption) *TokenPartialRevocationSecretShareMutation {
+	m := &TokenPartialRevocationSecretShareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTokenTransactionPeerSignature,
+		typ:           TypeTokenPartialRevocationSecretShare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -16660,20 +17133,20 @@ func newTokenTransactionPeerSignatureMutation(c config, op Op, opts ...tokentran
 	return m
 }
 
-// withTokenTransactionPeerSignatureID sets the ID field of the mutation.
-func withTokenTransactionPeerSignatureID(id uuid.UUID) tokentransactionpeersignatureOption {
-	return func(m *TokenTransactionPeerSignatureMutation) {
+// withTokenPartialRevocationSecretShareID sets the ID field of the mutation.
+func withTokenPartialRevocationSecretShareID(id uuid.UUID) tokenpartialrevocationsecretshareOption {
+	return func(m *TokenPartialRevocationSecretShareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TokenTransactionPeerSignature
+			value *TokenPartialRevocationSecretShare
 		)
-		m.oldValue = func(ctx context.Context) (*TokenTransactionPeerSignature, error) {
+		m.oldValue = func(ctx context.Context) (*TokenPartialRevocationSecretShare, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TokenTransactionPeerSignature.Get(ctx, id)
+					value, err = m.Client().TokenPartialRevocationSecretShare.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -16682,10 +17155,10 @@ func withTokenTransactionPeerSignatureID(id uuid.UUID) tokentransactionpeersigna
 	}
 }
 
-// withTokenTransactionPeerSignature sets the old TokenTransactionPeerSignature of the mutation.
-func withTokenTransactionPeerSignature(node *TokenTransactionPeerSignature) tokentransactionpeersignatureOption {
-	return func(m *TokenTransactionPeerSignatureMutation) {
-		m.oldValue = func(context.Context) (*TokenTransactionPeerSignature, error) {
+// withTokenPartialRevocationSecretShare sets the old TokenPartialRevocationSecretShare of the mutation.
+func withTokenPartialRevocationSecretShare(node *TokenPartialRevocationSecretShare) tokenpartialrevocationsecretshareOption {
+	return func(m *TokenPartialRevocationSecretShareMutation) {
+		m.oldValue = func(context.Context) (*TokenPartialRevocationSecretShare, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -16694,7 +17167,7 @@ func withTokenTransactionPeerSignature(node *TokenTransactionPeerSignature) toke
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenTransactionPeerSignatureMutation) Client() *Client {
+func (m TokenPartialRevocationSecretShareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -16702,7 +17175,7 @@ func (m TokenTransactionPeerSignatureMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenTransactionPeerSignatureMutation) Tx() (*Tx, error) {
+func (m TokenPartialRevocationSecretShareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -16712,14 +17185,14 @@ func (m TokenTransactionPeerSignatureMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TokenTransactionPeerSignature entities.
-func (m *TokenTransactionPeerSignatureMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenPartialRevocationSecretShare entities.
+func (m *TokenPartialRevocationSecretShareMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TokenTransactionPeerSignatureMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenPartialRevocationSecretShareMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -16730,7 +17203,7 @@ func (m *TokenTransactionPeerSignatureMutation) ID() (id uuid.UUID, exists bool)
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TokenTransactionPeerSignatureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenPartialRevocationSecretShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -16739,19 +17212,19 @@ func (m *TokenTransactionPeerSignatureMutation) IDs(ctx context.Context) ([]uuid
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TokenTransactionPeerSignature.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenPartialRevocationSecretShare.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TokenTransactionPeerSignatureMutation) SetCreateTime(t time.Time) {
+func (m *TokenPartialRevocationSecretShareMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TokenTransactionPeerSignatureMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenPartialRevocationSecretShareMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -16759,10 +17232,10 @@ func (m *TokenTransactionPeerSignatureMutation) CreateTime() (r time.Time, exist
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TokenTransactionPeerSignature entity.
-// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenPartialRevocationSecretShare entity.
+// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionPeerSignatureMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenPartialRevocationSecretShareMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -16777,17 +17250,17 @@ func (m *TokenTransactionPeerSignatureMutation) OldCreateTime(ctx context.Contex
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TokenTransactionPeerSignatureMutation) ResetCreateTime() {
+func (m *TokenPartialRevocationSecretShareMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TokenTransactionPeerSignatureMutation) SetUpdateTime(t time.Time) {
+func (m *TokenPartialRevocationSecretShareMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TokenTransactionPeerSignatureMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenPartialRevocationSecretShareMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -16795,10 +17268,10 @@ func (m *TokenTransactionPeerSignatureMutation) UpdateTime() (r time.Time, exist
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TokenTransactionPeerSignature entity.
-// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenPartialRevocationSecretShare entity.
+// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionPeerSignatureMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenPartialRevocationSecretShareMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -16813,17 +17286,17 @@ func (m *TokenTransactionPeerSignatureMutation) OldUpdateTime(ctx context.Contex
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TokenTransactionPeerSignatureMutation) ResetUpdateTime() {
+func (m *TokenPartialRevocationSecretShareMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
 // SetOperatorIdentityPublicKey sets the "operator_identity_public_key" field.
-func (m *TokenTransactionPeerSignatureMutation) SetOperatorIdentityPublicKey(b []byte) {
+func (m *TokenPartialRevocationSecretShareMutation) SetOperatorIdentityPublicKey(b []byte) {
 	m.operator_identity_public_key = &b
 }
 
 // OperatorIdentityPublicKey returns the value of the "operator_identity_public_key" field in the mutation.
-func (m *TokenTransactionPeerSignatureMutation) OperatorIdentityPublicKey() (r []byte, exists bool) {
+func (m *TokenPartialRevocationSecretShareMutation) OperatorIdentityPublicKey() (r []byte, exists bool) {
 	v := m.operator_identity_public_key
 	if v == nil {
 		return
@@ -16831,10 +17304,10 @@ func (m *TokenTransactionPeerSignatureMutation) OperatorIdentityPublicKey() (r [
 	return *v, true
 }
 
-// OldOperatorIdentityPublicKey returns the old "operator_identity_public_key" field's value of the TokenTransactionPeerSignature entity.
-// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// OldOperatorIdentityPublicKey returns the old "operator_identity_public_key" field's value of the TokenPartialRevocationSecretShare entity.
+// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionPeerSignatureMutation) OldOperatorIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+func (m *TokenPartialRevocationSecretShareMutation) OldOperatorIdentityPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOperatorIdentityPublicKey is only allowed on UpdateOne operations")
 	}
@@ -16849,94 +17322,94 @@ func (m *TokenTransactionPeerSignatureMutation) OldOperatorIdentityPublicKey(ctx
 }
 
 // ResetOperatorIdentityPublicKey resets all changes to the "operator_identity_public_key" field.
-func (m *TokenTransactionPeerSignatureMutation) ResetOperatorIdentityPublicKey() {
+func (m *TokenPartialRevocationSecretShareMutation) ResetOperatorIdentityPublicKey() {
 	m.operator_identity_public_key = nil
 }
 
-// SetSignature sets the "signature" field.
-func (m *TokenTransactionPeerSignatureMutation) SetSignature(b []byte) {
-	m.signature = &b
+// SetSecretShare sets the "secret_share" field.
+func (m *TokenPartialRevocationSecretShareMutation) SetSecretShare(b []byte) {
+	m.secret_share = &b
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *TokenTransactionPeerSignatureMutation) Signature() (r []byte, exists bool) {
-	v := m.signature
-	if v == nil {
-		return
+// SecretShare returns the value of the "secret_share" field in the mutation.
+func (m *TokenPartialRevocationSecretShareMutation) SecretShare() (r []byte, exists bool) {
+	v := m.secret_share
+	if v == nil {
+		return
 	}
 	return *v, true
 }
 
-// OldSignature returns the old "signature" field's value of the TokenTransactionPeerSignature entity.
-// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretShare returns the old "secret_share" field's value of the TokenPartialRevocationSecretShare entity.
+// If the TokenPartialRevocationSecretShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TokenTransactionPeerSignatureMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+func (m *TokenPartialRevocationSecretShareMutation) OldSecretShare(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretShare is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignature requires an ID field in the mutation")
+		return v, errors.New("OldSecretShare requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretShare: %w", err)
 	}
-	return oldValue.Signature, nil
+	return oldValue.SecretShare, nil
 }
 
-// ResetSignature resets all changes to the "signature" field.
-func (m *TokenTransactionPeerSignatureMutation) ResetSignature() {
-	m.signature = nil
+// ResetSecretShare resets all changes to the "secret_share" field.
+func (m *TokenPartialRevocationSecretShareMutation) ResetSecretShare() {
+	m.secret_share = nil
 }
 
-// SetTokenTransactionID sets the "token_transaction" edge to the TokenTransaction entity by id.
-func (m *TokenTransactionPeerSignatureMutation) SetTokenTransactionID(id uuid.UUID) {
-	m.token_transaction = &id
+// SetTokenOutputID sets the "token_output" edge to the TokenOutput entity by id.
+func (m *TokenPartialRevocationSecretShareMutation) SetTokenOutputID(id uuid.UUID) {
+	m.token_output = &id
 }
 
-// ClearTokenTransaction clears the "token_transaction" edge to the TokenTransaction entity.
-func (m *TokenTransactionPeerSignatureMutation) ClearTokenTransaction() {
-	m.clearedtoken_transaction = true
+// ClearTokenOutput clears the "token_output" edge to the TokenOutput entity.
+func (m *TokenPartialRevocationSecretShareMutation) ClearTokenOutput() {
+	m.clearedtoken_output = true
 }
 
-// TokenTransactionCleared reports if the "token_transaction" edge to the TokenTransaction entity was cleared.
-func (m *TokenTransactionPeerSignatureMutation) TokenTransactionCleared() bool {
-	return m.clearedtoken_transaction
+// TokenOutputCleared reports if the "token_output" edge to the TokenOutput entity was cleared.
+func (m *TokenPartialRevocationSecretShareMutation) TokenOutputCleared() bool {
+	return m.clearedtoken_output
 }
 
-// TokenTransactionID returns the "token_transaction" edge ID in the mutation.
-func (m *TokenTransactionPeerSignatureMutation) TokenTransactionID() (id uuid.UUID, exists bool) {
-	if m.token_transaction != nil {
-		return *m.token_transaction, true
+// TokenOutputID returns the "token_output" edge ID in the mutation.
+func (m *TokenPartialRevocationSecretShareMutation) TokenOutputID() (id uuid.UUID, exists bool) {
+	if m.token_output != nil {
+		return *m.token_output, true
 	}
 	return
 }
 
-// TokenTransactionIDs returns the "token_transaction" edge IDs in the mutation.
+// TokenOutputIDs returns the "token_output" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TokenTransactionID instead. It exists only for internal usage by the builders.
-func (m *TokenTransactionPeerSignatureMutation) TokenTransactionIDs() (ids []uuid.UUID) {
-	if id := m.token_transaction; id != nil {
+// TokenOutputID instead. It exists only for internal usage by the builders.
+func (m *TokenPartialRevocationSecretShareMutation) TokenOutputIDs() (ids []uuid.UUID) {
+	if id := m.token_output; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTokenTransaction resets all changes to the "token_transaction" edge.
-func (m *TokenTransactionPeerSignatureMutation) ResetTokenTransaction() {
-	m.token_transaction = nil
-	m.clearedtoken_transaction = false
+// ResetTokenOutput resets all changes to the "token_output" edge.
+func (m *TokenPartialRevocationSecretShareMutation) ResetTokenOutput() {
+	m.token_output = nil
+	m.clearedtoken_output = false
 }
 
-// Where appends a list predicates to the TokenTransactionPeerSignatureMutation builder.
-func (m *TokenTransactionPeerSignatureMutation) Where(ps ...predicate.TokenTransactionPeerSignature) {
+// Where appends a list predicates to the TokenPartialRevocationSecretShareMutation builder.
+func (m *TokenPartialRevocationSecretShareMutation) Where(ps ...predicate.TokenPartialRevocationSecretShare) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TokenTransactionPeerSignatureMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenPartialRevocationSecretShareMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TokenTransactionPeerSignatureMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TokenTransactionPeerSignature, len(ps))
+func (m *TokenPartialRevocationSecretShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenPartialRevocationSecretShare, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -16944,36 +17417,36 @@ func (m *TokenTransactionPeerSignatureMutation) WhereP(ps ...func(*sql.Selector)
 }
 
 // Op returns the operation name.
-func (m *TokenTransactionPeerSignatureMutation) Op() Op {
+func (m *TokenPartialRevocationSecretShareMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TokenTransactionPeerSignatureMutation) SetOp(op Op) {
+func (m *TokenPartialRevocationSecretShareMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TokenTransactionPeerSignature).
-func (m *TokenTransactionPeerSignatureMutation) Type() string {
+// Type returns the node type of this mutation (TokenPartialRevocationSecretShare).
+func (m *TokenPartialRevocationSecretShareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TokenTransactionPeerSignatureMutation) Fields() []string {
+func (m *TokenPartialRevocationSecretShareMutation) Fields() []string {
 	fields := make([]string, 0, 4)
 	if m.create_time != nil {
-		fields = append(fields, tokentransactionpeersignature.FieldCreateTime)
+		fields = append(fields, tokenpartialrevocationsecretshare.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tokentransactionpeersignature.FieldUpdateTime)
+		fields = append(fields, tokenpartialrevocationsecretshare.FieldUpdateTime)
 	}
 	if m.operator_identity_public_key != nil {
-		fields = append(fields, tokentransactionpeersignature.FieldOperatorIdentityPublicKey)
+		fields = append(fields, tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey)
 	}
-	if m.signature != nil {
-		fields = append(fields, tokentransactionpeersignature.FieldSignature)
+	if m.secret_share != nil {
+		fields = append(fields, tokenpartialrevocationsecretshare.FieldSecretShare)
 	}
 	return fields
 }
@@ -16981,16 +17454,16 @@ func (m *TokenTransactionPeerSignatureMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TokenTransactionPeerSignatureMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenPartialRevocationSecretShareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tokentransactionpeersignature.FieldCreateTime:
+	case tokenpartialrevocationsecretshare.FieldCreateTime:
 		return m.CreateTime()
-	case tokentransactionpeersignature.FieldUpdateTime:
+	case tokenpartialrevocationsecretshare.FieldUpdateTime:
 		return m.UpdateTime()
-	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
 		return m.OperatorIdentityPublicKey()
-	case tokentransactionpeersignature.FieldSignature:
-		return m.Signature()
+	case tokenpartialrevocationsecretshare.FieldSecretShare:
+		return m.SecretShare()
 	}
 	return nil, false
 }
@@ -16998,133 +17471,133 @@ func (m *TokenTransactionPeerSignatureMutation) Field(name string) (ent.Value, b
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TokenTransactionPeerSignatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenPartialRevocationSecretShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tokentransactionpeersignature.FieldCreateTime:
+	case tokenpartialrevocationsecretshare.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tokentransactionpeersignature.FieldUpdateTime:
+	case tokenpartialrevocationsecretshare.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
 		return m.OldOperatorIdentityPublicKey(ctx)
-	case tokentransactionpeersignature.FieldSignature:
-		return m.OldSignature(ctx)
+	case tokenpartialrevocationsecretshare.FieldSecretShare:
+		return m.OldSecretShare(ctx)
 	}
-	return nil, fmt.Errorf("unknown TokenTransactionPeerSignature field %s", name)
+	return nil, fmt.Errorf("unknown TokenPartialRevocationSecretShare field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenTransactionPeerSignatureMutation) SetField(name string, value ent.Value) error {
+func (m *TokenPartialRevocationSecretShareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tokentransactionpeersignature.FieldCreateTime:
+	case tokenpartialrevocationsecretshare.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tokentransactionpeersignature.FieldUpdateTime:
+	case tokenpartialrevocationsecretshare.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOperatorIdentityPublicKey(v)
 		return nil
-	case tokentransactionpeersignature.FieldSignature:
+	case tokenpartialrevocationsecretshare.FieldSecretShare:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSignature(v)
+		m.SetSecretShare(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransactionPeerSignature field %s", name)
+	return fmt.Errorf("unknown TokenPartialRevocationSecretShare field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TokenTransactionPeerSignatureMutation) AddedFields() []string {
+func (m *TokenPartialRevocationSecretShareMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TokenTransactionPeerSignatureMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenPartialRevocationSecretShareMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TokenTransactionPeerSignatureMutation) AddField(name string, value ent.Value) error {
+func (m *TokenPartialRevocationSecretShareMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown TokenTransactionPeerSignature numeric field %s", name)
+	return fmt.Errorf("unknown TokenPartialRevocationSecretShare numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TokenTransactionPeerSignatureMutation) ClearedFields() []string {
+func (m *TokenPartialRevocationSecretShareMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) FieldCleared(name string) bool {
+func (m *TokenPartialRevocationSecretShareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenTransactionPeerSignatureMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown TokenTransactionPeerSignature nullable field %s", name)
+func (m *TokenPartialRevocationSecretShareMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TokenPartialRevocationSecretShare nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TokenTransactionPeerSignatureMutation) ResetField(name string) error {
+func (m *TokenPartialRevocationSecretShareMutation) ResetField(name string) error {
 	switch name {
-	case tokentransactionpeersignature.FieldCreateTime:
+	case tokenpartialrevocationsecretshare.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tokentransactionpeersignature.FieldUpdateTime:
+	case tokenpartialrevocationsecretshare.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+	case tokenpartialrevocationsecretshare.FieldOperatorIdentityPublicKey:
 		m.ResetOperatorIdentityPublicKey()
 		return nil
-	case tokentransactionpeersignature.FieldSignature:
-		m.ResetSignature()
+	case tokenpartialrevocationsecretshare.FieldSecretShare:
+		m.ResetSecretShare()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransactionPeerSignature field %s", name)
+	return fmt.Errorf("unknown TokenPartialRevocationSecretShare field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) AddedEdges() []string {
+func (m *TokenPartialRevocationSecretShareMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.token_transaction != nil {
-		edges = append(edges, tokentransactionpeersignature.EdgeTokenTransaction)
+	if m.token_output != nil {
+		edges = append(edges, tokenpartialrevocationsecretshare.EdgeTokenOutput)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenPartialRevocationSecretShareMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tokentransactionpeersignature.EdgeTokenTransaction:
-		if id := m.token_transaction; id != nil {
+	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
+		if id := m.token_output; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -17132,118 +17605,136 @@ func (m *TokenTransactionPeerSignatureMutation) AddedIDs(name string) []ent.Valu
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) RemovedEdges() []string {
+func (m *TokenPartialRevocationSecretShareMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenPartialRevocationSecretShareMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) ClearedEdges() []string {
+func (m *TokenPartialRevocationSecretShareMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedtoken_transaction {
-		edges = append(edges, tokentransactionpeersignature.EdgeTokenTransaction)
+	if m.clearedtoken_output {
+		edges = append(edges, tokenpartialrevocationsecretshare.EdgeTokenOutput)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TokenTransactionPeerSignatureMutation) EdgeCleared(name string) bool {
+func (m *TokenPartialRevocationSecretShareMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tokentransactionpeersignature.EdgeTokenTransaction:
-		return m.clearedtoken_transaction
+	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
+		return m.clearedtoken_output
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TokenTransactionPeerSignatureMutation) ClearEdge(name string) error {
+func (m *TokenPartialRevocationSecretShareMutation) ClearEdge(name string) error {
 	switch name {
-	case tokentransactionpeersignature.EdgeTokenTransaction:
-		m.ClearTokenTransaction()
+	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
+		m.ClearTokenOutput()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransactionPeerSignature unique edge %s", name)
+	return fmt.Errorf("unknown TokenPartialRevocationSecretShare unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TokenTransactionPeerSignatureMutation) ResetEdge(name string) error {
+func (m *TokenPartialRevocationSecretShareMutation) ResetEdge(name string) error {
 	switch name {
-	case tokentransactionpeersignature.EdgeTokenTransaction:
-		m.ResetTokenTransaction()
+	case tokenpartialrevocationsecretshare.EdgeTokenOutput:
+		m.ResetTokenOutput()
 		return nil
 	}
-	return fmt.Errorf("unknown TokenTransactionPeerSignature edge %s", name)
+	return fmt.Errorf("unknown TokenPartialRevocationSecretShare edge %s", name)
 }
 
-// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
-type TransferMutation struct {
+// TokenTransactionMutation represents an operation that mutates the TokenTransaction nodes in the graph.
+type TokenTransactionMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	create_time              *time.Time
-	update_time              *time.Time
-	sender_identity_pubkey   *[]byte
-	receiver_identity_pubkey *[]byte
-	total_value              *uint64
-	addtotal_value           *int64
-	status                   *schematype.TransferStatus
-	_type                    *schematype.TransferType
-	expiry_time              *time.Time
-	completion_time          *time.Time
-	clearedFields            map[string]struct{}
-	transfer_leaves          map[uuid.UUID]struct{}
-	removedtransfer_leaves   map[uuid.UUID]struct{}
-	clearedtransfer_leaves   bool
-	payment_intent           *uuid.UUID
-	clearedpayment_intent    bool
-	done                     bool
-	oldValue                 func(context.Context) (*Transfer, error)
-	predicates               []predicate.Transfer
-}
-
-var _ ent.Mutation = (*TransferMutation)(nil)
-
-// transferOption allows management of the mutation configuration using functional options.
-type transferOption func(*TransferMutation)
-
-// newTransferMutation creates new mutation for the Transfer entity.
-func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
-	m := &TransferMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTransfer,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+	op                               Op
+	typ                              string
+	id                               *uuid.UUID
+	create_time                      *time.Time
+	update_time                      *time.Time
+	partial_token_transaction_hash   *[]byte
+	finalized_token_transaction_hash *[]byte
+	operator_signature               *[]byte
+	status                           *schematype.TokenTransactionStatus
+	expiry_time                      *time.Time
+	coordinator_public_key           *[]byte
+	client_created_timestamp         *time.Time
+	finalized_at                     *time.Time
+	version                          *schematype.TokenTransactionVersion
+	addversion                       *schematype.TokenTransactionVersion
+	clearedFields                    map[string]struct{}
+	spent_output                     map[uuid.UUID]struct{}
+	removedspent_output              map[uuid.UUID]struct{}
+	clearedspent_output              bool
+	spent_output_v2                  map[uuid.UUID]struct{}
+	removedspent_output_v2           map[uuid.UUID]struct{}
+	clearedspent_output_v2           bool
+	created_output                   map[uuid.UUID]struct{}
+	removedcreated_output            map[uuid.UUID]struct{}
+	clearedcreated_output            bool
+	mint                             *uuid.UUID
+	clearedmint                      bool
+	create                           *uuid.UUID
+	clearedcreate                    bool
+	payment_intent                   *uuid.UUID
+	clearedpayment_intent            bool
+	peer_signatures                  map[uuid.UUID]struct{}
+	removedpeer_signatures           map[uuid.UUID]struct{}
+	clearedpeer_signatures           bool
+	spark_invoice                    map[uuid.UUID]struct{}
+	removedspark_invoice             map[uuid.UUID]struct{}
+	clearedspark_invoice             bool
+	done                             bool
+	oldValue                         func(context.Context) (*TokenTransaction, error)
+	predicates                       []predicate.TokenTransaction
 }
 
-// withTransferID sets the ID field of the mutation.
-func withTransferID(id uuid.UUID) transferOption {
-	return func(m *TransferMutation) {
+var _ ent.Mutation = (*TokenTransactionMutation)(nil)
+
+// tokentransactionOption allows management of the mutation configuration using functional options.
+type tokentransactionOption func(*TokenTransactionMutation)
+
+// newTokenTransactionMutation creates new mutation for the TokenTransaction entity.
+func newTokenTransactionMutation(c config, op Op, opts ...tokentransactionOption) *TokenTransactionMutation {
+	m := &TokenTransactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenTransaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenTransactionID sets the ID field of the mutation.
+func withTokenTransactionID(id uuid.UUID) tokentransactionOption {
+	return func(m *TokenTransactionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Transfer
+			value *TokenTransaction
 		)
-		m.oldValue = func(ctx context.Context) (*Transfer, error) {
+		m.oldValue = func(ctx context.Context) (*TokenTransaction, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Transfer.Get(ctx, id)
+					value, err = m.Client().TokenTransaction.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -17252,10 +17743,10 @@ func withTransferID(id uuid.UUID) transferOption {
 	}
 }
 
-// withTransfer sets the old Transfer of the mutation.
-func withTransfer(node *Transfer) transferOption {
-	return func(m *TransferMutation) {
-		m.oldValue = func(context.Context) (*Transfer, error) {
+// withTokenTransaction sets the old TokenTransaction of the mutation.
+func withTokenTransaction(node *TokenTransaction) tokentransactionOption {
+	return func(m *TokenTransactionMutation) {
+		m.oldValue = func(context.Context) (*TokenTransaction, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -17264,7 +17755,7 @@ func withTransfer(node *Transfer) transferOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransferMutation) Client() *Client {
+func (m TokenTransactionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -17272,7 +17763,7 @@ func (m TransferMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TransferMutation) Tx() (*Tx, error) {
+func (m TokenTransactionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -17282,14 +17773,14 @@ func (m TransferMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Transfer entities.
-func (m *TransferMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TokenTransaction entities.
+func (m *TokenTransactionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TransferMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TokenTransactionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -17300,7 +17791,7 @@ func (m *TransferMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TokenTransactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -17309,19 +17800,19 @@ func (m *TransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TokenTransaction.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TransferMutation) SetCreateTime(t time.Time) {
+func (m *TokenTransactionMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TransferMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TokenTransactionMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -17329,10 +17820,10 @@ func (m *TransferMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenTransactionMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -17347,17 +17838,17 @@ func (m *TransferMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TransferMutation) ResetCreateTime() {
+func (m *TokenTransactionMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TransferMutation) SetUpdateTime(t time.Time) {
+func (m *TokenTransactionMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TransferMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TokenTransactionMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -17365,10 +17856,10 @@ func (m *TransferMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenTransactionMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -17383,145 +17874,138 @@ func (m *TransferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TransferMutation) ResetUpdateTime() {
+func (m *TokenTransactionMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetSenderIdentityPubkey sets the "sender_identity_pubkey" field.
-func (m *TransferMutation) SetSenderIdentityPubkey(b []byte) {
-	m.sender_identity_pubkey = &b
+// SetPartialTokenTransactionHash sets the "partial_token_transaction_hash" field.
+func (m *TokenTransactionMutation) SetPartialTokenTransactionHash(b []byte) {
+	m.partial_token_transaction_hash = &b
 }
 
-// SenderIdentityPubkey returns the value of the "sender_identity_pubkey" field in the mutation.
-func (m *TransferMutation) SenderIdentityPubkey() (r []byte, exists bool) {
-	v := m.sender_identity_pubkey
+// PartialTokenTransactionHash returns the value of the "partial_token_transaction_hash" field in the mutation.
+func (m *TokenTransactionMutation) PartialTokenTransactionHash() (r []byte, exists bool) {
+	v := m.partial_token_transaction_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSenderIdentityPubkey returns the old "sender_identity_pubkey" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldPartialTokenTransactionHash returns the old "partial_token_transaction_hash" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldSenderIdentityPubkey(ctx context.Context) (v []byte, err error) {
+func (m *TokenTransactionMutation) OldPartialTokenTransactionHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderIdentityPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPartialTokenTransactionHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderIdentityPubkey requires an ID field in the mutation")
+		return v, errors.New("OldPartialTokenTransactionHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderIdentityPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPartialTokenTransactionHash: %w", err)
 	}
-	return oldValue.SenderIdentityPubkey, nil
+	return oldValue.PartialTokenTransactionHash, nil
 }
 
-// ResetSenderIdentityPubkey resets all changes to the "sender_identity_pubkey" field.
-func (m *TransferMutation) ResetSenderIdentityPubkey() {
-	m.sender_identity_pubkey = nil
+// ResetPartialTokenTransactionHash resets all changes to the "partial_token_transaction_hash" field.
+func (m *TokenTransactionMutation) ResetPartialTokenTransactionHash() {
+	m.partial_token_transaction_hash = nil
 }
 
-// SetReceiverIdentityPubkey sets the "receiver_identity_pubkey" field.
-func (m *TransferMutation) SetReceiverIdentityPubkey(b []byte) {
-	m.receiver_identity_pubkey = &b
+// SetFinalizedTokenTransactionHash sets the "finalized_token_transaction_hash" field.
+func (m *TokenTransactionMutation) SetFinalizedTokenTransactionHash(b []byte) {
+	m.finalized_token_transaction_hash = &b
 }
 
-// ReceiverIdentityPubkey returns the value of the "receiver_identity_pubkey" field in the mutation.
-func (m *TransferMutation) ReceiverIdentityPubkey() (r []byte, exists bool) {
-	v := m.receiver_identity_pubkey
+// FinalizedTokenTransactionHash returns the value of the "finalized_token_transaction_hash" field in the mutation.
+func (m *TokenTransactionMutation) FinalizedTokenTransactionHash() (r []byte, exists bool) {
+	v := m.finalized_token_transaction_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReceiverIdentityPubkey returns the old "receiver_identity_pubkey" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldFinalizedTokenTransactionHash returns the old "finalized_token_transaction_hash" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldReceiverIdentityPubkey(ctx context.Context) (v []byte, err error) {
+func (m *TokenTransactionMutation) OldFinalizedTokenTransactionHash(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceiverIdentityPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinalizedTokenTransactionHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceiverIdentityPubkey requires an ID field in the mutation")
+		return v, errors.New("OldFinalizedTokenTransactionHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceiverIdentityPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinalizedTokenTransactionHash: %w", err)
 	}
-	return oldValue.ReceiverIdentityPubkey, nil
+	return oldValue.FinalizedTokenTransactionHash, nil
 }
 
-// ResetReceiverIdentityPubkey resets all changes to the "receiver_identity_pubkey" field.
-func (m *TransferMutation) ResetReceiverIdentityPubkey() {
-	m.receiver_identity_pubkey = nil
+// ResetFinalizedTokenTransactionHash resets all changes to the "finalized_token_transaction_hash" field.
+func (m *TokenTransactionMutation) ResetFinalizedTokenTransactionHash() {
+	m.finalized_token_transaction_hash = nil
 }
 
-// SetTotalValue sets the "total_value" field.
-func (m *TransferMutation) SetTotalValue(u uint64) {
-	m.total_value = &u
-	m.addtotal_value = nil
+// SetOperatorSignature sets the "operator_signature" field.
+func (m *TokenTransactionMutation) SetOperatorSignature(b []byte) {
+	m.operator_signature = &b
 }
 
-// TotalValue returns the value of the "total_value" field in the mutation.
-func (m *TransferMutation) TotalValue() (r uint64, exists bool) {
-	v := m.total_value
+// OperatorSignature returns the value of the "operator_signature" field in the mutation.
+func (m *TokenTransactionMutation) OperatorSignature() (r []byte, exists bool) {
+	v := m.operator_signature
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalValue returns the old "total_value" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldOperatorSignature returns the old "operator_signature" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldTotalValue(ctx context.Context) (v uint64, err error) {
+func (m *TokenTransactionMutation) OldOperatorSignature(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldOperatorSignature is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalValue requires an ID field in the mutation")
+		return v, errors.New("OldOperatorSignature requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldOperatorSignature: %w", err)
 	}
-	return oldValue.TotalValue, nil
+	return oldValue.OperatorSignature, nil
 }
 
-// AddTotalValue adds u to the "total_value" field.
-func (m *TransferMutation) AddTotalValue(u int64) {
-	if m.addtotal_value != nil {
-		*m.addtotal_value += u
-	} else {
-		m.addtotal_value = &u
-	}
+// ClearOperatorSignature clears the value of the "operator_signature" field.
+func (m *TokenTransactionMutation) ClearOperatorSignature() {
+	m.operator_signature = nil
+	m.clearedFields[tokentransaction.FieldOperatorSignature] = struct{}{}
 }
 
-// AddedTotalValue returns the value that was added to the "total_value" field in this mutation.
-func (m *TransferMutation) AddedTotalValue() (r int64, exists bool) {
-	v := m.addtotal_value
-	if v == nil {
-		return
-	}
-	return *v, true
+// OperatorSignatureCleared returns if the "operator_signature" field was cleared in this mutation.
+func (m *TokenTransactionMutation) OperatorSignatureCleared() bool {
+	_, ok := m.clearedFields[tokentransaction.FieldOperatorSignature]
+	return ok
 }
 
-// ResetTotalValue resets all changes to the "total_value" field.
-func (m *TransferMutation) ResetTotalValue() {
-	m.total_value = nil
-	m.addtotal_value = nil
+// ResetOperatorSignature resets all changes to the "operator_signature" field.
+func (m *TokenTransactionMutation) ResetOperatorSignature() {
+	m.operator_signature = nil
+	delete(m.clearedFields, tokentransaction.FieldOperatorSignature)
 }
 
 // SetStatus sets the "status" field.
-func (m *TransferMutation) SetStatus(ss schematype.TransferStatus) {
-	m.status = &ss
+func (m *TokenTransactionMutation) SetStatus(sts schematype.TokenTransactionStatus) {
+	m.status = &sts
 }
 
 // Status returns the value of the "status" field in the mutation.
-func (m *TransferMutation) Status() (r schematype.TransferStatus, exists bool) {
+func (m *TokenTransactionMutation) Status() (r schematype.TokenTransactionStatus, exists bool) {
 	v := m.status
 	if v == nil {
 		return
@@ -17529,10 +18013,10 @@ func (m *TransferMutation) Status() (r schematype.TransferStatus, exists bool) {
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldStatus(ctx context.Context) (v schematype.TransferStatus, err error) {
+func (m *TokenTransactionMutation) OldStatus(ctx context.Context) (v schematype.TokenTransactionStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
@@ -17546,54 +18030,31 @@ func (m *TransferMutation) OldStatus(ctx context.Context) (v schematype.Transfer
 	return oldValue.Status, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TransferMutation) ResetStatus() {
+// ClearStatus clears the value of the "status" field.
+func (m *TokenTransactionMutation) ClearStatus() {
 	m.status = nil
+	m.clearedFields[tokentransaction.FieldStatus] = struct{}{}
 }
 
-// SetType sets the "type" field.
-func (m *TransferMutation) SetType(st schematype.TransferType) {
-	m._type = &st
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *TransferMutation) GetType() (r schematype.TransferType, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldType(ctx context.Context) (v schematype.TransferType, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
+// StatusCleared returns if the "status" field was cleared in this mutation.
+func (m *TokenTransactionMutation) StatusCleared() bool {
+	_, ok := m.clearedFields[tokentransaction.FieldStatus]
+	return ok
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *TransferMutation) ResetType() {
-	m._type = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TokenTransactionMutation) ResetStatus() {
+	m.status = nil
+	delete(m.clearedFields, tokentransaction.FieldStatus)
 }
 
 // SetExpiryTime sets the "expiry_time" field.
-func (m *TransferMutation) SetExpiryTime(t time.Time) {
+func (m *TokenTransactionMutation) SetExpiryTime(t time.Time) {
 	m.expiry_time = &t
 }
 
 // ExpiryTime returns the value of the "expiry_time" field in the mutation.
-func (m *TransferMutation) ExpiryTime() (r time.Time, exists bool) {
+func (m *TokenTransactionMutation) ExpiryTime() (r time.Time, exists bool) {
 	v := m.expiry_time
 	if v == nil {
 		return
@@ -17601,10 +18062,10 @@ func (m *TransferMutation) ExpiryTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldExpiryTime returns the old "expiry_time" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiryTime returns the old "expiry_time" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldExpiryTime(ctx context.Context) (v time.Time, err error) {
+func (m *TokenTransactionMutation) OldExpiryTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldExpiryTime is only allowed on UpdateOne operations")
 	}
@@ -17618,1351 +18079,1525 @@ func (m *TransferMutation) OldExpiryTime(ctx context.Context) (v time.Time, err
 	return oldValue.ExpiryTime, nil
 }
 
+// ClearExpiryTime clears the value of the "expiry_time" field.
+func (m *TokenTransactionMutation) ClearExpiryTime() {
+	m.expiry_time = nil
+	m.clearedFields[tokentransaction.FieldExpiryTime] = struct{}{}
+}
+
+// ExpiryTimeCleared returns if the "expiry_time" field was cleared in this mutation.
+func (m *TokenTransactionMutation) ExpiryTimeCleared() bool {
+	_, ok := m.clearedFields[tokentransaction.FieldExpiryTime]
+	return ok
+}
+
 // ResetExpiryTime resets all changes to the "expiry_time" field.
-func (m *TransferMutation) ResetExpiryTime() {
+func (m *TokenTransactionMutation) ResetExpiryTime() {
 	m.expiry_time = nil
+	delete(m.clearedFields, tokentransaction.FieldExpiryTime)
 }
 
-// SetCompletionTime sets the "completion_time" field.
-func (m *TransferMutation) SetCompletionTime(t time.Time) {
-	m.completion_time = &t
+// SetCoordinatorPublicKey sets the "coordinator_public_key" field.
+func (m *TokenTransactionMutation) SetCoordinatorPublicKey(b []byte) {
+	m.coordinator_public_key = &b
 }
 
-// CompletionTime returns the value of the "completion_time" field in the mutation.
-func (m *TransferMutation) CompletionTime() (r time.Time, exists bool) {
-	v := m.completion_time
+// CoordinatorPublicKey returns the value of the "coordinator_public_key" field in the mutation.
+func (m *TokenTransactionMutation) CoordinatorPublicKey() (r []byte, exists bool) {
+	v := m.coordinator_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCompletionTime returns the old "completion_time" field's value of the Transfer entity.
-// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// OldCoordinatorPublicKey returns the old "coordinator_public_key" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferMutation) OldCompletionTime(ctx context.Context) (v *time.Time, err error) {
+func (m *TokenTransactionMutation) OldCoordinatorPublicKey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletionTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldCoordinatorPublicKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletionTime requires an ID field in the mutation")
+		return v, errors.New("OldCoordinatorPublicKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletionTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldCoordinatorPublicKey: %w", err)
 	}
-	return oldValue.CompletionTime, nil
+	return oldValue.CoordinatorPublicKey, nil
 }
 
-// ClearCompletionTime clears the value of the "completion_time" field.
-func (m *TransferMutation) ClearCompletionTime() {
-	m.completion_time = nil
-	m.clearedFields[transfer.FieldCompletionTime] = struct{}{}
+// ClearCoordinatorPublicKey clears the value of the "coordinator_public_key" field.
+func (m *TokenTransactionMutation) ClearCoordinatorPublicKey() {
+	m.coordinator_public_key = nil
+	m.clearedFields[tokentransaction.FieldCoordinatorPublicKey] = struct{}{}
 }
 
-// CompletionTimeCleared returns if the "completion_time" field was cleared in this mutation.
-func (m *TransferMutation) CompletionTimeCleared() bool {
-	_, ok := m.clearedFields[transfer.FieldCompletionTime]
+// CoordinatorPublicKeyCleared returns if the "coordinator_public_key" field was cleared in this mutation.
+func (m *TokenTransactionMutation) CoordinatorPublicKeyCleared() bool {
+	_, ok := m.clearedFields[tokentransaction.FieldCoordinatorPublicKey]
 	return ok
 }
 
-// ResetCompletionTime resets all changes to the "completion_time" field.
-func (m *TransferMutation) ResetCompletionTime() {
-	m.completion_time = nil
-	delete(m.clearedFields, transfer.FieldCompletionTime)
-}
-
-// AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by ids.
-func (m *TransferMutation) AddTransferLeafeIDs(ids ...uuid.UUID) {
-	if m.transfer_leaves == nil {
-		m.transfer_leaves = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.transfer_leaves[ids[i]] = struct{}{}
-	}
-}
-
-// ClearTransferLeaves clears the "transfer_leaves" edge to the TransferLeaf entity.
-func (m *TransferMutation) ClearTransferLeaves() {
-	m.clearedtransfer_leaves = true
+// ResetCoordinatorPublicKey resets all changes to the "coordinator_public_key" field.
+func (m *TokenTransactionMutation) ResetCoordinatorPublicKey() {
+	m.coordinator_public_key = nil
+	delete(m.clearedFields, tokentransaction.FieldCoordinatorPublicKey)
 }
 
-// TransferLeavesCleared reports if the "transfer_leaves" edge to the TransferLeaf entity was cleared.
-func (m *TransferMutation) TransferLeavesCleared() bool {
-	return m.clearedtransfer_leaves
+// SetClientCreatedTimestamp sets the "client_created_timestamp" field.
+func (m *TokenTransactionMutation) SetClientCreatedTimestamp(t time.Time) {
+	m.client_created_timestamp = &t
 }
 
-// RemoveTransferLeafeIDs removes the "transfer_leaves" edge to the TransferLeaf entity by IDs.
-func (m *TransferMutation) RemoveTransferLeafeIDs(ids ...uuid.UUID) {
-	if m.removedtransfer_leaves == nil {
-		m.removedtransfer_leaves = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.transfer_leaves, ids[i])
-		m.removedtransfer_leaves[ids[i]] = struct{}{}
+// ClientCreatedTimestamp returns the value of the "client_created_timestamp" field in the mutation.
+func (m *TokenTransactionMutation) ClientCreatedTimestamp() (r time.Time, exists bool) {
+	v := m.client_created_timestamp
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedTransferLeaves returns the removed IDs of the "transfer_leaves" edge to the TransferLeaf entity.
-func (m *TransferMutation) RemovedTransferLeavesIDs() (ids []uuid.UUID) {
-	for id := range m.removedtransfer_leaves {
-		ids = append(ids, id)
+// OldClientCreatedTimestamp returns the old "client_created_timestamp" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionMutation) OldClientCreatedTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientCreatedTimestamp is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// TransferLeavesIDs returns the "transfer_leaves" edge IDs in the mutation.
-func (m *TransferMutation) TransferLeavesIDs() (ids []uuid.UUID) {
-	for id := range m.transfer_leaves {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientCreatedTimestamp requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientCreatedTimestamp: %w", err)
+	}
+	return oldValue.ClientCreatedTimestamp, nil
 }
 
-// ResetTransferLeaves resets all changes to the "transfer_leaves" edge.
-func (m *TransferMutation) ResetTransferLeaves() {
-	m.transfer_leaves = nil
-	m.clearedtransfer_leaves = false
-	m.removedtransfer_leaves = nil
+// ClearClientCreatedTimestamp clears the value of the "client_created_timestamp" field.
+func (m *TokenTransactionMutation) ClearClientCreatedTimestamp() {
+	m.client_created_timestamp = nil
+	m.clearedFields[tokentransaction.FieldClientCreatedTimestamp] = struct{}{}
 }
 
-// SetPaymentIntentID sets the "payment_intent" edge to the PaymentIntent entity by id.
-func (m *TransferMutation) SetPaymentIntentID(id uuid.UUID) {
-	m.payment_intent = &id
+// ClientCreatedTimestampCleared returns if the "client_created_timestamp" field was cleared in this mutation.
+func (m *TokenTransactionMutation) ClientCreatedTimestampCleared() bool {
+	_, ok := m.clearedFields[tokentransaction.FieldClientCreatedTimestamp]
+	return ok
 }
 
-// ClearPaymentIntent clears the "payment_intent" edge to the PaymentIntent entity.
-func (m *TransferMutation) ClearPaymentIntent() {
-	m.clearedpayment_intent = true
+// ResetClientCreatedTimestamp resets all changes to the "client_created_timestamp" field.
+func (m *TokenTransactionMutation) ResetClientCreatedTimestamp() {
+	m.client_created_timestamp = nil
+	delete(m.clearedFields, tokentransaction.FieldClientCreatedTimestamp)
 }
 
-// PaymentIntentCleared reports if the "payment_intent" edge to the PaymentIntent entity was cleared.
-func (m *TransferMutation) PaymentIntentCleared() bool {
-	return m.clearedpayment_intent
+// SetFinalizedAt sets the "finalized_at" field.
+func (m *TokenTransactionMutation) SetFinalizedAt(t time.Time) {
+	m.finalized_at = &t
 }
 
-// PaymentIntentID returns the "payment_intent" edge ID in the mutation.
-func (m *TransferMutation) PaymentIntentID() (id uuid.UUID, exists bool) {
-	if m.payment_intent != nil {
-		return *m.payment_intent, true
+// FinalizedAt returns the value of the "finalized_at" field in the mutation.
+func (m *TokenTransactionMutation) FinalizedAt() (r time.Time, exists bool) {
+	v := m.finalized_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// PaymentIntentIDs returns the "payment_intent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PaymentIntentID instead. It exists only for internal usage by the builders.
-func (m *TransferMutation) PaymentIntentIDs() (ids []uuid.UUID) {
-	if id := m.payment_intent; id != nil {
-		ids = append(ids, *id)
+// OldFinalizedAt returns the old "finalized_at" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionMutation) OldFinalizedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinalizedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinalizedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinalizedAt: %w", err)
+	}
+	return oldValue.FinalizedAt, nil
 }
 
-// ResetPaymentIntent resets all changes to the "payment_intent" edge.
-func (m *TransferMutation) ResetPaymentIntent() {
-	m.payment_intent = nil
-	m.clearedpayment_intent = false
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (m *TokenTransactionMutation) ClearFinalizedAt() {
+	m.finalized_at = nil
+	m.clearedFields[tokentransaction.FieldFinalizedAt] = struct{}{}
 }
 
-// Where appends a list predicates to the TransferMutation builder.
-func (m *TransferMutation) Where(ps ...predicate.Transfer) {
-	m.predicates = append(m.predicates, ps...)
+// FinalizedAtCleared returns if the "finalized_at" field was cleared in this mutation.
+func (m *TokenTransactionMutation) FinalizedAtCleared() bool {
+	_, ok := m.clearedFields[tokentransaction.FieldFinalizedAt]
+	return ok
 }
 
-// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Transfer, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ResetFinalizedAt resets all changes to the "finalized_at" field.
+func (m *TokenTransactionMutation) ResetFinalizedAt() {
+	m.finalized_at = nil
+	delete(m.clearedFields, tokentransaction.FieldFinalizedAt)
 }
 
-// Op returns the operation name.
-func (m *TransferMutation) Op() Op {
-	return m.op
+// SetVersion sets the "version" field.
+func (m *TokenTransactionMutation) SetVersion(stv schematype.TokenTransactionVersion) {
+	m.version = &stv
+	m.addversion = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *TransferMutation) SetOp(op Op) {
-	m.op = op
+// Version returns the value of the "version" field in the mutation.
+func (m *TokenTransactionMutation) Version() (r schematype.TokenTransactionVersion, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Type returns the node type of this mutation (Transfer).
-func (m *TransferMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *TransferMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.create_time != nil {
-		fields = append(fields, transfer.FieldCreateTime)
-	}
-	if m.update_time != nil {
-		fields = append(fields, transfer.FieldUpdateTime)
-	}
-	if m.sender_identity_pubkey != nil {
-		fields = append(fields, transfer.FieldSenderIdentityPubkey)
-	}
-	if m.receiver_identity_pubkey != nil {
-		fields = append(fields, transfer.FieldReceiverIdentityPubkey)
-	}
-	if m.total_value != nil {
-		fields = append(fields, transfer.FieldTotalValue)
-	}
-	if m.status != nil {
-		fields = append(fields, transfer.FieldStatus)
-	}
-	if m._type != nil {
-		fields = append(fields, transfer.FieldType)
+// OldVersion returns the old "version" field's value of the TokenTransaction entity.
+// If the TokenTransaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionMutation) OldVersion(ctx context.Context) (v schematype.TokenTransactionVersion, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
-	if m.expiry_time != nil {
-		fields = append(fields, transfer.FieldExpiryTime)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
-	if m.completion_time != nil {
-		fields = append(fields, transfer.FieldCompletionTime)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return fields
+	return oldValue.Version, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *TransferMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case transfer.FieldCreateTime:
-		return m.CreateTime()
-	case transfer.FieldUpdateTime:
-		return m.UpdateTime()
-	case transfer.FieldSenderIdentityPubkey:
-		return m.SenderIdentityPubkey()
-	case transfer.FieldReceiverIdentityPubkey:
-		return m.ReceiverIdentityPubkey()
-	case transfer.FieldTotalValue:
-		return m.TotalValue()
-	case transfer.FieldStatus:
-		return m.Status()
-	case transfer.FieldType:
-		return m.GetType()
-	case transfer.FieldExpiryTime:
-		return m.ExpiryTime()
-	case transfer.FieldCompletionTime:
-		return m.CompletionTime()
+// AddVersion adds stv to the "version" field.
+func (m *TokenTransactionMutation) AddVersion(stv schematype.TokenTransactionVersion) {
+	if m.addversion != nil {
+		*m.addversion += stv
+	} else {
+		m.addversion = &stv
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case transfer.FieldCreateTime:
-		return m.OldCreateTime(ctx)
-	case transfer.FieldUpdateTime:
-		return m.OldUpdateTime(ctx)
-	case transfer.FieldSenderIdentityPubkey:
-		return m.OldSenderIdentityPubkey(ctx)
-	case transfer.FieldReceiverIdentityPubkey:
-		return m.OldReceiverIdentityPubkey(ctx)
-	case transfer.FieldTotalValue:
-		return m.OldTotalValue(ctx)
-	case transfer.FieldStatus:
-		return m.OldStatus(ctx)
-	case transfer.FieldType:
-		return m.OldType(ctx)
-	case transfer.FieldExpiryTime:
-		return m.OldExpiryTime(ctx)
-	case transfer.FieldCompletionTime:
-		return m.OldCompletionTime(ctx)
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *TokenTransactionMutation) AddedVersion() (r schematype.TokenTransactionVersion, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Transfer field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TransferMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case transfer.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case transfer.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case transfer.FieldSenderIdentityPubkey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSenderIdentityPubkey(v)
-		return nil
-	case transfer.FieldReceiverIdentityPubkey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReceiverIdentityPubkey(v)
-		return nil
-	case transfer.FieldTotalValue:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalValue(v)
-		return nil
-	case transfer.FieldStatus:
-		v, ok := value.(schematype.TransferStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case transfer.FieldType:
-		v, ok := value.(schematype.TransferType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case transfer.FieldExpiryTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExpiryTime(v)
-		return nil
-	case transfer.FieldCompletionTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletionTime(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Transfer field %s", name)
+// ResetVersion resets all changes to the "version" field.
+func (m *TokenTransactionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *TransferMutation) AddedFields() []string {
-	var fields []string
-	if m.addtotal_value != nil {
-		fields = append(fields, transfer.FieldTotalValue)
+// AddSpentOutputIDs adds the "spent_output" edge to the TokenOutput entity by ids.
+func (m *TokenTransactionMutation) AddSpentOutputIDs(ids ...uuid.UUID) {
+	if m.spent_output == nil {
+		m.spent_output = make(map[uuid.UUID]struct{})
 	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case transfer.FieldTotalValue:
-		return m.AddedTotalValue()
+	for i := range ids {
+		m.spent_output[ids[i]] = struct{}{}
 	}
-	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TransferMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case transfer.FieldTotalValue:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalValue(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Transfer numeric field %s", name)
+// ClearSpentOutput clears the "spent_output" edge to the TokenOutput entity.
+func (m *TokenTransactionMutation) ClearSpentOutput() {
+	m.clearedspent_output = true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *TransferMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(transfer.FieldCompletionTime) {
-		fields = append(fields, transfer.FieldCompletionTime)
-	}
-	return fields
+// SpentOutputCleared reports if the "spent_output" edge to the TokenOutput entity was cleared.
+func (m *TokenTransactionMutation) SpentOutputCleared() bool {
+	return m.clearedspent_output
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *TransferMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// RemoveSpentOutputIDs removes the "spent_output" edge to the TokenOutput entity by IDs.
+func (m *TokenTransactionMutation) RemoveSpentOutputIDs(ids ...uuid.UUID) {
+	if m.removedspent_output == nil {
+		m.removedspent_output = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.spent_output, ids[i])
+		m.removedspent_output[ids[i]] = struct{}{}
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *TransferMutation) ClearField(name string) error {
-	switch name {
-	case transfer.FieldCompletionTime:
-		m.ClearCompletionTime()
-		return nil
+// RemovedSpentOutput returns the removed IDs of the "spent_output" edge to the TokenOutput entity.
+func (m *TokenTransactionMutation) RemovedSpentOutputIDs() (ids []uuid.UUID) {
+	for id := range m.removedspent_output {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Transfer nullable field %s", name)
+	return
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *TransferMutation) ResetField(name string) error {
-	switch name {
-	case transfer.FieldCreateTime:
-		m.ResetCreateTime()
-		return nil
-	case transfer.FieldUpdateTime:
-		m.ResetUpdateTime()
-		return nil
-	case transfer.FieldSenderIdentityPubkey:
-		m.ResetSenderIdentityPubkey()
-		return nil
-	case transfer.FieldReceiverIdentityPubkey:
-		m.ResetReceiverIdentityPubkey()
-		return nil
-	case transfer.FieldTotalValue:
-		m.ResetTotalValue()
-		return nil
-	case transfer.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case transfer.FieldType:
-		m.ResetType()
-		return nil
-	case transfer.FieldExpiryTime:
-		m.ResetExpiryTime()
-		return nil
-	case transfer.FieldCompletionTime:
-		m.ResetCompletionTime()
-		return nil
+// SpentOutputIDs returns the "spent_output" edge IDs in the mutation.
+func (m *TokenTransactionMutation) SpentOutputIDs() (ids []uuid.UUID) {
+	for id := range m.spent_output {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Transfer field %s", name)
+	return
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransferMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.transfer_leaves != nil {
-		edges = append(edges, transfer.EdgeTransferLeaves)
-	}
-	if m.payment_intent != nil {
-		edges = append(edges, transfer.EdgePaymentIntent)
-	}
-	return edges
+// ResetSpentOutput resets all changes to the "spent_output" edge.
+func (m *TokenTransactionMutation) ResetSpentOutput() {
+	m.spent_output = nil
+	m.clearedspent_output = false
+	m.removedspent_output = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *TransferMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case transfer.EdgeTransferLeaves:
-		ids := make([]ent.Value, 0, len(m.transfer_leaves))
-		for id := range m.transfer_leaves {
-			ids = append(ids, id)
-		}
-		return ids
-	case transfer.EdgePaymentIntent:
-		if id := m.payment_intent; id != nil {
-			return []ent.Value{*id}
-		}
+// AddSpentOutputV2IDs adds the "spent_output_v2" edge to the TokenOutput entity by ids.
+func (m *TokenTransactionMutation) AddSpentOutputV2IDs(ids ...uuid.UUID) {
+	if m.spent_output_v2 == nil {
+		m.spent_output_v2 = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.spent_output_v2[ids[i]] = struct{}{}
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransferMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtransfer_leaves != nil {
-		edges = append(edges, transfer.EdgeTransferLeaves)
-	}
-	return edges
+// ClearSpentOutputV2 clears the "spent_output_v2" edge to the TokenOutput entity.
+func (m *TokenTransactionMutation) ClearSpentOutputV2() {
+	m.clearedspent_output_v2 = true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case transfer.EdgeTransferLeaves:
-		ids := make([]ent.Value, 0, len(m.removedtransfer_leaves))
-		for id := range m.removedtransfer_leaves {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// SpentOutputV2Cleared reports if the "spent_output_v2" edge to the TokenOutput entity was cleared.
+func (m *TokenTransactionMutation) SpentOutputV2Cleared() bool {
+	return m.clearedspent_output_v2
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransferMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtransfer_leaves {
-		edges = append(edges, transfer.EdgeTransferLeaves)
+// RemoveSpentOutputV2IDs removes the "spent_output_v2" edge to the TokenOutput entity by IDs.
+func (m *TokenTransactionMutation) RemoveSpentOutputV2IDs(ids ...uuid.UUID) {
+	if m.removedspent_output_v2 == nil {
+		m.removedspent_output_v2 = make(map[uuid.UUID]struct{})
 	}
-	if m.clearedpayment_intent {
-		edges = append(edges, transfer.EdgePaymentIntent)
+	for i := range ids {
+		delete(m.spent_output_v2, ids[i])
+		m.removedspent_output_v2[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *TransferMutation) EdgeCleared(name string) bool {
-	switch name {
-	case transfer.EdgeTransferLeaves:
-		return m.clearedtransfer_leaves
-	case transfer.EdgePaymentIntent:
-		return m.clearedpayment_intent
+// RemovedSpentOutputV2 returns the removed IDs of the "spent_output_v2" edge to the TokenOutput entity.
+func (m *TokenTransactionMutation) RemovedSpentOutputV2IDs() (ids []uuid.UUID) {
+	for id := range m.removedspent_output_v2 {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *TransferMutation) ClearEdge(name string) error {
-	switch name {
-	case transfer.EdgePaymentIntent:
-		m.ClearPaymentIntent()
-		return nil
+// SpentOutputV2IDs returns the "spent_output_v2" edge IDs in the mutation.
+func (m *TokenTransactionMutation) SpentOutputV2IDs() (ids []uuid.UUID) {
+	for id := range m.spent_output_v2 {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Transfer unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *TransferMutation) ResetEdge(name string) error {
-	switch name {
-	case transfer.EdgeTransferLeaves:
-		m.ResetTransferLeaves()
-		return nil
-	case transfer.EdgePaymentIntent:
-		m.ResetPaymentIntent()
-		return nil
-	}
-	return fmt.Errorf("unknown Transfer edge %s", name)
+// ResetSpentOutputV2 resets all changes to the "spent_output_v2" edge.
+func (m *TokenTransactionMutation) ResetSpentOutputV2() {
+	m.spent_output_v2 = nil
+	m.clearedspent_output_v2 = false
+	m.removedspent_output_v2 = nil
 }
 
-// TransferLeafMutation represents an operation that mutates the TransferLeaf nodes in the graph.
-type TransferLeafMutation struct {
-	config
-	op                                      Op
-	typ                                     string
-	id                                      *uuid.UUID
-	create_time                             *time.Time
-	update_time                             *time.Time
-	secret_cipher                           *[]byte
-	signature                               *[]byte
-	previous_refund_tx                      *[]byte
-	previous_direct_refund_tx               *[]byte
-	previous_direct_from_cpfp_refund_tx     *[]byte
-	intermediate_refund_tx                  *[]byte
-	intermediate_direct_refund_tx           *[]byte
-	intermediate_direct_from_cpfp_refund_tx *[]byte
-	key_tweak                               *[]byte
-	sender_key_tweak_proof                  *[]byte
-	receiver_key_tweak                      *[]byte
-	clearedFields                           map[string]struct{}
-	transfer                                *uuid.UUID
-	clearedtransfer                         bool
-	leaf                                    *uuid.UUID
-	clearedleaf                             bool
-	done                                    bool
-	oldValue                                func(context.Context) (*TransferLeaf, error)
-	predicates                              []predicate.TransferLeaf
+// AddCreatedOutputIDs adds the "created_output" edge to the TokenOutput entity by ids.
+func (m *TokenTransactionMutation) AddCreatedOutputIDs(ids ...uuid.UUID) {
+	if m.created_output == nil {
+		m.created_output = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_output[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*TransferLeafMutation)(nil)
+// ClearCreatedOutput clears the "created_output" edge to the TokenOutput entity.
+func (m *TokenTransactionMutation) ClearCreatedOutput() {
+	m.clearedcreated_output = true
+}
 
-// transferleafOption allows management of the mutation configuration using functional options.
-type transferleafOption func(*TransferLeafMutation)
+// CreatedOutputCleared reports if the "created_output" edge to the TokenOutput entity was cleared.
+func (m *TokenTransactionMutation) CreatedOutputCleared() bool {
+	return m.clearedcreated_output
+}
 
-// newTransferLeafMutation creates new mutation for the TransferLeaf entity.
-func newTransferLeafMutation(c config, op Op, opts ...transferleafOption) *TransferLeafMutation {
-	m := &TransferLeafMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTransferLeaf,
-		clearedFields: make(map[string]struct{}),
+// RemoveCreatedOutputIDs removes the "created_output" edge to the TokenOutput entity by IDs.
+func (m *TokenTransactionMutation) RemoveCreatedOutputIDs(ids ...uuid.UUID) {
+	if m.removedcreated_output == nil {
+		m.removedcreated_output = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.created_output, ids[i])
+		m.removedcreated_output[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withTransferLeafID sets the ID field of the mutation.
-func withTransferLeafID(id uuid.UUID) transferleafOption {
-	return func(m *TransferLeafMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *TransferLeaf
-		)
-		m.oldValue = func(ctx context.Context) (*TransferLeaf, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().TransferLeaf.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedCreatedOutput returns the removed IDs of the "created_output" edge to the TokenOutput entity.
+func (m *TokenTransactionMutation) RemovedCreatedOutputIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_output {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withTransferLeaf sets the old TransferLeaf of the mutation.
-func withTransferLeaf(node *TransferLeaf) transferleafOption {
-	return func(m *TransferLeafMutation) {
-		m.oldValue = func(context.Context) (*TransferLeaf, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// CreatedOutputIDs returns the "created_output" edge IDs in the mutation.
+func (m *TokenTransactionMutation) CreatedOutputIDs() (ids []uuid.UUID) {
+	for id := range m.created_output {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TransferLeafMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetCreatedOutput resets all changes to the "created_output" edge.
+func (m *TokenTransactionMutation) ResetCreatedOutput() {
+	m.created_output = nil
+	m.clearedcreated_output = false
+	m.removedcreated_output = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m TransferLeafMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetMintID sets the "mint" edge to the TokenMint entity by id.
+func (m *TokenTransactionMutation) SetMintID(id uuid.UUID) {
+	m.mint = &id
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TransferLeaf entities.
-func (m *TransferLeafMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearMint clears the "mint" edge to the TokenMint entity.
+func (m *TokenTransactionMutation) ClearMint() {
+	m.clearedmint = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *TransferLeafMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// MintCleared reports if the "mint" edge to the TokenMint entity was cleared.
+func (m *TokenTransactionMutation) MintCleared() bool {
+	return m.clearedmint
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *TransferLeafMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TransferLeaf.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// MintID returns the "mint" edge ID in the mutation.
+func (m *TokenTransactionMutation) MintID() (id uuid.UUID, exists bool) {
+	if m.mint != nil {
+		return *m.mint, true
 	}
+	return
 }
 
-// SetCreateTime sets the "create_time" field.
-func (m *TransferLeafMutation) SetCreateTime(t time.Time) {
-	m.create_time = &t
+// MintIDs returns the "mint" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MintID instead. It exists only for internal usage by the builders.
+func (m *TokenTransactionMutation) MintIDs() (ids []uuid.UUID) {
+	if id := m.mint; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TransferLeafMutation) CreateTime() (r time.Time, exists bool) {
-	v := m.create_time
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetMint resets all changes to the "mint" edge.
+func (m *TokenTransactionMutation) ResetMint() {
+	m.mint = nil
+	m.clearedmint = false
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
-	}
-	return oldValue.CreateTime, nil
+// SetCreateID sets the "create" edge to the TokenCreate entity by id.
+func (m *TokenTransactionMutation) SetCreateID(id uuid.UUID) {
+	m.create = &id
 }
 
-// ResetCreateTime resets all changes to the "create_time" field.
-func (m *TransferLeafMutation) ResetCreateTime() {
-	m.create_time = nil
+// ClearCreate clears the "create" edge to the TokenCreate entity.
+func (m *TokenTransactionMutation) ClearCreate() {
+	m.clearedcreate = true
 }
 
-// SetUpdateTime sets the "update_time" field.
-func (m *TransferLeafMutation) SetUpdateTime(t time.Time) {
-	m.update_time = &t
+// CreateCleared reports if the "create" edge to the TokenCreate entity was cleared.
+func (m *TokenTransactionMutation) CreateCleared() bool {
+	return m.clearedcreate
 }
 
-// UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TransferLeafMutation) UpdateTime() (r time.Time, exists bool) {
-	v := m.update_time
-	if v == nil {
-		return
+// CreateID returns the "create" edge ID in the mutation.
+func (m *TokenTransactionMutation) CreateID() (id uuid.UUID, exists bool) {
+	if m.create != nil {
+		return *m.create, true
 	}
-	return *v, true
+	return
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+// CreateIDs returns the "create" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreateID instead. It exists only for internal usage by the builders.
+func (m *TokenTransactionMutation) CreateIDs() (ids []uuid.UUID) {
+	if id := m.create; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdateTime, nil
+	return
 }
 
-// ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TransferLeafMutation) ResetUpdateTime() {
-	m.update_time = nil
+// ResetCreate resets all changes to the "create" edge.
+func (m *TokenTransactionMutation) ResetCreate() {
+	m.create = nil
+	m.clearedcreate = false
 }
 
-// SetSecretCipher sets the "secret_cipher" field.
-func (m *TransferLeafMutation) SetSecretCipher(b []byte) {
-	m.secret_cipher = &b
+// SetPaymentIntentID sets the "payment_intent" edge to the PaymentIntent entity by id.
+func (m *TokenTransactionMutation) SetPaymentIntentID(id uuid.UUID) {
+	m.payment_intent = &id
 }
 
-// SecretCipher returns the value of the "secret_cipher" field in the mutation.
-func (m *TransferLeafMutation) SecretCipher() (r []byte, exists bool) {
-	v := m.secret_cipher
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPaymentIntent clears the "payment_intent" edge to the PaymentIntent entity.
+func (m *TokenTransactionMutation) ClearPaymentIntent() {
+	m.clearedpayment_intent = true
 }
 
-// OldSecretCipher returns the old "secret_cipher" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldSecretCipher(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSecretCipher is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSecretCipher requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSecretCipher: %w", err)
+// PaymentIntentCleared reports if the "payment_intent" edge to the PaymentIntent entity was cleared.
+func (m *TokenTransactionMutation) PaymentIntentCleared() bool {
+	return m.clearedpayment_intent
+}
+
+// PaymentIntentID returns the "payment_intent" edge ID in the mutation.
+func (m *TokenTransactionMutation) PaymentIntentID() (id uuid.UUID, exists bool) {
+	if m.payment_intent != nil {
+		return *m.payment_intent, true
 	}
-	return oldValue.SecretCipher, nil
+	return
 }
 
-// ClearSecretCipher clears the value of the "secret_cipher" field.
-func (m *TransferLeafMutation) ClearSecretCipher() {
-	m.secret_cipher = nil
-	m.clearedFields[transferleaf.FieldSecretCipher] = struct{}{}
+// PaymentIntentIDs returns the "payment_intent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PaymentIntentID instead. It exists only for internal usage by the builders.
+func (m *TokenTransactionMutation) PaymentIntentIDs() (ids []uuid.UUID) {
+	if id := m.payment_intent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SecretCipherCleared returns if the "secret_cipher" field was cleared in this mutation.
-func (m *TransferLeafMutation) SecretCipherCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldSecretCipher]
-	return ok
+// ResetPaymentIntent resets all changes to the "payment_intent" edge.
+func (m *TokenTransactionMutation) ResetPaymentIntent() {
+	m.payment_intent = nil
+	m.clearedpayment_intent = false
 }
 
-// ResetSecretCipher resets all changes to the "secret_cipher" field.
-func (m *TransferLeafMutation) ResetSecretCipher() {
-	m.secret_cipher = nil
-	delete(m.clearedFields, transferleaf.FieldSecretCipher)
+// AddPeerSignatureIDs adds the "peer_signatures" edge to the TokenTransactionPeerSignature entity by ids.
+func (m *TokenTransactionMutation) AddPeerSignatureIDs(ids ...uuid.UUID) {
+	if m.peer_signatures == nil {
+		m.peer_signatures = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.peer_signatures[ids[i]] = struct{}{}
+	}
 }
 
-// SetSignature sets the "signature" field.
-func (m *TransferLeafMutation) SetSignature(b []byte) {
-	m.signature = &b
+// ClearPeerSignatures clears the "peer_signatures" edge to the TokenTransactionPeerSignature entity.
+func (m *TokenTransactionMutation) ClearPeerSignatures() {
+	m.clearedpeer_signatures = true
 }
 
-// Signature returns the value of the "signature" field in the mutation.
-func (m *TransferLeafMutation) Signature() (r []byte, exists bool) {
-	v := m.signature
-	if v == nil {
-		return
-	}
-	return *v, true
+// PeerSignaturesCleared reports if the "peer_signatures" edge to the TokenTransactionPeerSignature entity was cleared.
+func (m *TokenTransactionMutation) PeerSignaturesCleared() bool {
+	return m.clearedpeer_signatures
 }
 
-// OldSignature returns the old "signature" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldSignature(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+// RemovePeerSignatureIDs removes the "peer_signatures" edge to the TokenTransactionPeerSignature entity by IDs.
+func (m *TokenTransactionMutation) RemovePeerSignatureIDs(ids ...uuid.UUID) {
+	if m.removedpeer_signatures == nil {
+		m.removedpeer_signatures = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSignature requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.peer_signatures, ids[i])
+		m.removedpeer_signatures[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
-	}
-	return oldValue.Signature, nil
-}
-
-// ClearSignature clears the value of the "signature" field.
-func (m *TransferLeafMutation) ClearSignature() {
-	m.signature = nil
-	m.clearedFields[transferleaf.FieldSignature] = struct{}{}
-}
-
-// SignatureCleared returns if the "signature" field was cleared in this mutation.
-func (m *TransferLeafMutation) SignatureCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldSignature]
-	return ok
 }
 
-// ResetSignature resets all changes to the "signature" field.
-func (m *TransferLeafMutation) ResetSignature() {
-	m.signature = nil
-	delete(m.clearedFields, transferleaf.FieldSignature)
+// RemovedPeerSignatures returns the removed IDs of the "peer_signatures" edge to the TokenTransactionPeerSignature entity.
+func (m *TokenTransactionMutation) RemovedPeerSignaturesIDs() (ids []uuid.UUID) {
+	for id := range m.removedpeer_signatures {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetPreviousRefundTx sets the "previous_refund_tx" field.
-func (m *TransferLeafMutation) SetPreviousRefundTx(b []byte) {
-	m.previous_refund_tx = &b
+// PeerSignaturesIDs returns the "peer_signatures" edge IDs in the mutation.
+func (m *TokenTransactionMutation) PeerSignaturesIDs() (ids []uuid.UUID) {
+	for id := range m.peer_signatures {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// PreviousRefundTx returns the value of the "previous_refund_tx" field in the mutation.
-func (m *TransferLeafMutation) PreviousRefundTx() (r []byte, exists bool) {
-	v := m.previous_refund_tx
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetPeerSignatures resets all changes to the "peer_signatures" edge.
+func (m *TokenTransactionMutation) ResetPeerSignatures() {
+	m.peer_signatures = nil
+	m.clearedpeer_signatures = false
+	m.removedpeer_signatures = nil
 }
 
-// OldPreviousRefundTx returns the old "previous_refund_tx" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldPreviousRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreviousRefundTx is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreviousRefundTx requires an ID field in the mutation")
+// AddSparkInvoiceIDs adds the "spark_invoice" edge to the SparkInvoice entity by ids.
+func (m *TokenTransactionMutation) AddSparkInvoiceIDs(ids ...uuid.UUID) {
+	if m.spark_invoice == nil {
+		m.spark_invoice = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreviousRefundTx: %w", err)
+	for i := range ids {
+		m.spark_invoice[ids[i]] = struct{}{}
 	}
-	return oldValue.PreviousRefundTx, nil
-}
-
-// ResetPreviousRefundTx resets all changes to the "previous_refund_tx" field.
-func (m *TransferLeafMutation) ResetPreviousRefundTx() {
-	m.previous_refund_tx = nil
 }
 
-// SetPreviousDirectRefundTx sets the "previous_direct_refund_tx" field.
-func (m *TransferLeafMutation) SetPreviousDirectRefundTx(b []byte) {
-	m.previous_direct_refund_tx = &b
+// ClearSparkInvoice clears the "spark_invoice" edge to the SparkInvoice entity.
+func (m *TokenTransactionMutation) ClearSparkInvoice() {
+	m.clearedspark_invoice = true
 }
 
-// PreviousDirectRefundTx returns the value of the "previous_direct_refund_tx" field in the mutation.
-func (m *TransferLeafMutation) PreviousDirectRefundTx() (r []byte, exists bool) {
-	v := m.previous_direct_refund_tx
-	if v == nil {
-		return
-	}
-	return *v, true
+// SparkInvoiceCleared reports if the "spark_invoice" edge to the SparkInvoice entity was cleared.
+func (m *TokenTransactionMutation) SparkInvoiceCleared() bool {
+	return m.clearedspark_invoice
 }
 
-// OldPreviousDirectRefundTx returns the old "previous_direct_refund_tx" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldPreviousDirectRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreviousDirectRefundTx is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreviousDirectRefundTx requires an ID field in the mutation")
+// RemoveSparkInvoiceIDs removes the "spark_invoice" edge to the SparkInvoice entity by IDs.
+func (m *TokenTransactionMutation) RemoveSparkInvoiceIDs(ids ...uuid.UUID) {
+	if m.removedspark_invoice == nil {
+		m.removedspark_invoice = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreviousDirectRefundTx: %w", err)
+	for i := range ids {
+		delete(m.spark_invoice, ids[i])
+		m.removedspark_invoice[ids[i]] = struct{}{}
 	}
-	return oldValue.PreviousDirectRefundTx, nil
-}
-
-// ClearPreviousDirectRefundTx clears the value of the "previous_direct_refund_tx" field.
-func (m *TransferLeafMutation) ClearPreviousDirectRefundTx() {
-	m.previous_direct_refund_tx = nil
-	m.clearedFields[transferleaf.FieldPreviousDirectRefundTx] = struct{}{}
 }
 
-// PreviousDirectRefundTxCleared returns if the "previous_direct_refund_tx" field was cleared in this mutation.
-func (m *TransferLeafMutation) PreviousDirectRefundTxCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldPreviousDirectRefundTx]
-	return ok
+// RemovedSparkInvoice returns the removed IDs of the "spark_invoice" edge to the SparkInvoice entity.
+func (m *TokenTransactionMutation) RemovedSparkInvoiceIDs() (ids []uuid.UUID) {
+	for id := range m.removedspark_invoice {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ResetPreviousDirectRefundTx resets all changes to the "previous_direct_refund_tx" field.
-func (m *TransferLeafMutation) ResetPreviousDirectRefundTx() {
-	m.previous_direct_refund_tx = nil
-	delete(m.clearedFields, transferleaf.FieldPreviousDirectRefundTx)
+// SparkInvoiceIDs returns the "spark_invoice" edge IDs in the mutation.
+func (m *TokenTransactionMutation) SparkInvoiceIDs() (ids []uuid.UUID) {
+	for id := range m.spark_invoice {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetPreviousDirectFromCpfpRefundTx sets the "previous_direct_from_cpfp_refund_tx" field.
-func (m *TransferLeafMutation) SetPreviousDirectFromCpfpRefundTx(b []byte) {
-	m.previous_direct_from_cpfp_refund_tx = &b
+// ResetSparkInvoice resets all changes to the "spark_invoice" edge.
+func (m *TokenTransactionMutation) ResetSparkInvoice() {
+	m.spark_invoice = nil
+	m.clearedspark_invoice = false
+	m.removedspark_invoice = nil
 }
 
-// PreviousDirectFromCpfpRefundTx returns the value of the "previous_direct_from_cpfp_refund_tx" field in the mutation.
-func (m *TransferLeafMutation) PreviousDirectFromCpfpRefundTx() (r []byte, exists bool) {
-	v := m.previous_direct_from_cpfp_refund_tx
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the TokenTransactionMutation builder.
+func (m *TokenTransactionMutation) Where(ps ...predicate.TokenTransaction) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldPreviousDirectFromCpfpRefundTx returns the old "previous_direct_from_cpfp_refund_tx" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldPreviousDirectFromCpfpRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPreviousDirectFromCpfpRefundTx is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPreviousDirectFromCpfpRefundTx requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPreviousDirectFromCpfpRefundTx: %w", err)
+// WhereP appends storage-level predicates to the TokenTransactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenTransactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenTransaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.PreviousDirectFromCpfpRefundTx, nil
-}
-
-// ClearPreviousDirectFromCpfpRefundTx clears the value of the "previous_direct_from_cpfp_refund_tx" field.
-func (m *TransferLeafMutation) ClearPreviousDirectFromCpfpRefundTx() {
-	m.previous_direct_from_cpfp_refund_tx = nil
-	m.clearedFields[transferleaf.FieldPreviousDirectFromCpfpRefundTx] = struct{}{}
+	m.Where(p...)
 }
 
-// PreviousDirectFromCpfpRefundTxCleared returns if the "previous_direct_from_cpfp_refund_tx" field was cleared in this mutation.
-func (m *TransferLeafMutation) PreviousDirectFromCpfpRefundTxCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldPreviousDirectFromCpfpRefundTx]
-	return ok
+// Op returns the operation name.
+func (m *TokenTransactionMutation) Op() Op {
+	return m.op
 }
 
-// ResetPreviousDirectFromCpfpRefundTx resets all changes to the "previous_direct_from_cpfp_refund_tx" field.
-func (m *TransferLeafMutation) ResetPreviousDirectFromCpfpRefundTx() {
-	m.previous_direct_from_cpfp_refund_tx = nil
-	delete(m.clearedFields, transferleaf.FieldPreviousDirectFromCpfpRefundTx)
+// SetOp allows setting the mutation operation.
+func (m *TokenTransactionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetIntermediateRefundTx sets the "intermediate_refund_tx" field.
-func (m *TransferLeafMutation) SetIntermediateRefundTx(b []byte) {
-	m.intermediate_refund_tx = &b
+// Type returns the node type of this mutation (TokenTransaction).
+func (m *TokenTransactionMutation) Type() string {
+	return m.typ
 }
 
-// IntermediateRefundTx returns the value of the "intermediate_refund_tx" field in the mutation.
-func (m *TransferLeafMutation) IntermediateRefundTx() (r []byte, exists bool) {
-	v := m.intermediate_refund_tx
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenTransactionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.create_time != nil {
+		fields = append(fields, tokentransaction.FieldCreateTime)
 	}
-	return *v, true
-}
-
-// OldIntermediateRefundTx returns the old "intermediate_refund_tx" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldIntermediateRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntermediateRefundTx is only allowed on UpdateOne operations")
+	if m.update_time != nil {
+		fields = append(fields, tokentransaction.FieldUpdateTime)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntermediateRefundTx requires an ID field in the mutation")
+	if m.partial_token_transaction_hash != nil {
+		fields = append(fields, tokentransaction.FieldPartialTokenTransactionHash)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntermediateRefundTx: %w", err)
+	if m.finalized_token_transaction_hash != nil {
+		fields = append(fields, tokentransaction.FieldFinalizedTokenTransactionHash)
 	}
-	return oldValue.IntermediateRefundTx, nil
-}
-
-// ResetIntermediateRefundTx resets all changes to the "intermediate_refund_tx" field.
-func (m *TransferLeafMutation) ResetIntermediateRefundTx() {
-	m.intermediate_refund_tx = nil
+	if m.operator_signature != nil {
+		fields = append(fields, tokentransaction.FieldOperatorSignature)
+	}
+	if m.status != nil {
+		fields = append(fields, tokentransaction.FieldStatus)
+	}
+	if m.expiry_time != nil {
+		fields = append(fields, tokentransaction.FieldExpiryTime)
+	}
+	if m.coordinator_public_key != nil {
+		fields = append(fields, tokentransaction.FieldCoordinatorPublicKey)
+	}
+	if m.client_created_timestamp != nil {
+		fields = append(fields, tokentransaction.FieldClientCreatedTimestamp)
+	}
+	if m.finalized_at != nil {
+		fields = append(fields, tokentransaction.FieldFinalizedAt)
+	}
+	if m.version != nil {
+		fields = append(fields, tokentransaction.FieldVersion)
+	}
+	return fields
 }
 
-// SetIntermediateDirectRefundTx sets the "intermediate_direct_refund_tx" field.
-func (m *TransferLeafMutation) SetIntermediateDirectRefundTx(b []byte) {
-	m.intermediate_direct_refund_tx = &b
-}
-
-// IntermediateDirectRefundTx returns the value of the "intermediate_direct_refund_tx" field in the mutation.
-func (m *TransferLeafMutation) IntermediateDirectRefundTx() (r []byte, exists bool) {
-	v := m.intermediate_direct_refund_tx
-	if v == nil {
-		return
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenTransactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokentransaction.FieldCreateTime:
+		return m.CreateTime()
+	case tokentransaction.FieldUpdateTime:
+		return m.UpdateTime()
+	case tokentransaction.FieldPartialTokenTransactionHash:
+		return m.PartialTokenTransactionHash()
+	case tokentransaction.FieldFinalizedTokenTransactionHash:
+		return m.FinalizedTokenTransactionHash()
+	case tokentransaction.FieldOperatorSignature:
+		return m.OperatorSignature()
+	case tokentransaction.FieldStatus:
+		return m.Status()
+	case tokentransaction.FieldExpiryTime:
+		return m.ExpiryTime()
+	case tokentransaction.FieldCoordinatorPublicKey:
+		return m.CoordinatorPublicKey()
+	case tokentransaction.FieldClientCreatedTimestamp:
+		return m.ClientCreatedTimestamp()
+	case tokentransaction.FieldFinalizedAt:
+		return m.FinalizedAt()
+	case tokentransaction.FieldVersion:
+		return m.Version()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldIntermediateDirectRefundTx returns the old "intermediate_direct_refund_tx" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldIntermediateDirectRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntermediateDirectRefundTx is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntermediateDirectRefundTx requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntermediateDirectRefundTx: %w", err)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenTransactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokentransaction.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tokentransaction.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tokentransaction.FieldPartialTokenTransactionHash:
+		return m.OldPartialTokenTransactionHash(ctx)
+	case tokentransaction.FieldFinalizedTokenTransactionHash:
+		return m.OldFinalizedTokenTransactionHash(ctx)
+	case tokentransaction.FieldOperatorSignature:
+		return m.OldOperatorSignature(ctx)
+	case tokentransaction.FieldStatus:
+		return m.OldStatus(ctx)
+	case tokentransaction.FieldExpiryTime:
+		return m.OldExpiryTime(ctx)
+	case tokentransaction.FieldCoordinatorPublicKey:
+		return m.OldCoordinatorPublicKey(ctx)
+	case tokentransaction.FieldClientCreatedTimestamp:
+		return m.OldClientCreatedTimestamp(ctx)
+	case tokentransaction.FieldFinalizedAt:
+		return m.OldFinalizedAt(ctx)
+	case tokentransaction.FieldVersion:
+		return m.OldVersion(ctx)
 	}
-	return oldValue.IntermediateDirectRefundTx, nil
-}
-
-// ClearIntermediateDirectRefundTx clears the value of the "intermediate_direct_refund_tx" field.
-func (m *TransferLeafMutation) ClearIntermediateDirectRefundTx() {
-	m.intermediate_direct_refund_tx = nil
-	m.clearedFields[transferleaf.FieldIntermediateDirectRefundTx] = struct{}{}
+	return nil, fmt.Errorf("unknown TokenTransaction field %s", name)
 }
 
-// IntermediateDirectRefundTxCleared returns if the "intermediate_direct_refund_tx" field was cleared in this mutation.
-func (m *TransferLeafMutation) IntermediateDirectRefundTxCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldIntermediateDirectRefundTx]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenTransactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokentransaction.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tokentransaction.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tokentransaction.FieldPartialTokenTransactionHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartialTokenTransactionHash(v)
+		return nil
+	case tokentransaction.FieldFinalizedTokenTransactionHash:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinalizedTokenTransactionHash(v)
+		return nil
+	case tokentransaction.FieldOperatorSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorSignature(v)
+		return nil
+	case tokentransaction.FieldStatus:
+		v, ok := value.(schematype.TokenTransactionStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tokentransaction.FieldExpiryTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTime(v)
+		return nil
+	case tokentransaction.FieldCoordinatorPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoordinatorPublicKey(v)
+		return nil
+	case tokentransaction.FieldClientCreatedTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientCreatedTimestamp(v)
+		return nil
+	case tokentransaction.FieldFinalizedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinalizedAt(v)
+		return nil
+	case tokentransaction.FieldVersion:
+		v, ok := value.(schematype.TokenTransactionVersion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenTransaction field %s", name)
 }
 
-// ResetIntermediateDirectRefundTx resets all changes to the "intermediate_direct_refund_tx" field.
-func (m *TransferLeafMutation) ResetIntermediateDirectRefundTx() {
-	m.intermediate_direct_refund_tx = nil
-	delete(m.clearedFields, transferleaf.FieldIntermediateDirectRefundTx)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenTransactionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, tokentransaction.FieldVersion)
+	}
+	return fields
 }
 
-// SetIntermediateDirectFromCpfpRefundTx sets the "intermediate_direct_from_cpfp_refund_tx" field.
-func (m *TransferLeafMutation) SetIntermediateDirectFromCpfpRefundTx(b []byte) {
-	m.intermediate_direct_from_cpfp_refund_tx = &b
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenTransactionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokentransaction.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
 }
 
-// IntermediateDirectFromCpfpRefundTx returns the value of the "intermediate_direct_from_cpfp_refund_tx" field in the mutation.
-func (m *TransferLeafMutation) IntermediateDirectFromCpfpRefundTx() (r []byte, exists bool) {
-	v := m.intermediate_direct_from_cpfp_refund_tx
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenTransactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tokentransaction.FieldVersion:
+		v, ok := value.(schematype.TokenTransactionVersion)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenTransaction numeric field %s", name)
 }
 
-// OldIntermediateDirectFromCpfpRefundTx returns the old "intermediate_direct_from_cpfp_refund_tx" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldIntermediateDirectFromCpfpRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntermediateDirectFromCpfpRefundTx is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenTransactionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tokentransaction.FieldOperatorSignature) {
+		fields = append(fields, tokentransaction.FieldOperatorSignature)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntermediateDirectFromCpfpRefundTx requires an ID field in the mutation")
+	if m.FieldCleared(tokentransaction.FieldStatus) {
+		fields = append(fields, tokentransaction.FieldStatus)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntermediateDirectFromCpfpRefundTx: %w", err)
+	if m.FieldCleared(tokentransaction.FieldExpiryTime) {
+		fields = append(fields, tokentransaction.FieldExpiryTime)
 	}
-	return oldValue.IntermediateDirectFromCpfpRefundTx, nil
-}
-
-// ClearIntermediateDirectFromCpfpRefundTx clears the value of the "intermediate_direct_from_cpfp_refund_tx" field.
-func (m *TransferLeafMutation) ClearIntermediateDirectFromCpfpRefundTx() {
-	m.intermediate_direct_from_cpfp_refund_tx = nil
-	m.clearedFields[transferleaf.FieldIntermediateDirectFromCpfpRefundTx] = struct{}{}
+	if m.FieldCleared(tokentransaction.FieldCoordinatorPublicKey) {
+		fields = append(fields, tokentransaction.FieldCoordinatorPublicKey)
+	}
+	if m.FieldCleared(tokentransaction.FieldClientCreatedTimestamp) {
+		fields = append(fields, tokentransaction.FieldClientCreatedTimestamp)
+	}
+	if m.FieldCleared(tokentransaction.FieldFinalizedAt) {
+		fields = append(fields, tokentransaction.FieldFinalizedAt)
+	}
+	return fields
 }
 
-// IntermediateDirectFromCpfpRefundTxCleared returns if the "intermediate_direct_from_cpfp_refund_tx" field was cleared in this mutation.
-func (m *TransferLeafMutation) IntermediateDirectFromCpfpRefundTxCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldIntermediateDirectFromCpfpRefundTx]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenTransactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetIntermediateDirectFromCpfpRefundTx resets all changes to the "intermediate_direct_from_cpfp_refund_tx" field.
-func (m *TransferLeafMutation) ResetIntermediateDirectFromCpfpRefundTx() {
-	m.intermediate_direct_from_cpfp_refund_tx = nil
-	delete(m.clearedFields, transferleaf.FieldIntermediateDirectFromCpfpRefundTx)
-}
-
-// SetKeyTweak sets the "key_tweak" field.
-func (m *TransferLeafMutation) SetKeyTweak(b []byte) {
-	m.key_tweak = &b
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenTransactionMutation) ClearField(name string) error {
+	switch name {
+	case tokentransaction.FieldOperatorSignature:
+		m.ClearOperatorSignature()
+		return nil
+	case tokentransaction.FieldStatus:
+		m.ClearStatus()
+		return nil
+	case tokentransaction.FieldExpiryTime:
+		m.ClearExpiryTime()
+		return nil
+	case tokentransaction.FieldCoordinatorPublicKey:
+		m.ClearCoordinatorPublicKey()
+		return nil
+	case tokentransaction.FieldClientCreatedTimestamp:
+		m.ClearClientCreatedTimestamp()
+		return nil
+	case tokentransaction.FieldFinalizedAt:
+		m.ClearFinalizedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenTransaction nullable field %s", name)
 }
 
-// KeyTweak returns the value of the "key_tweak" field in the mutation.
-func (m *TransferLeafMutation) KeyTweak() (r []byte, exists bool) {
-	v := m.key_tweak
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenTransactionMutation) ResetField(name string) error {
+	switch name {
+	case tokentransaction.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tokentransaction.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tokentransaction.FieldPartialTokenTransactionHash:
+		m.ResetPartialTokenTransactionHash()
+		return nil
+	case tokentransaction.FieldFinalizedTokenTransactionHash:
+		m.ResetFinalizedTokenTransactionHash()
+		return nil
+	case tokentransaction.FieldOperatorSignature:
+		m.ResetOperatorSignature()
+		return nil
+	case tokentransaction.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tokentransaction.FieldExpiryTime:
+		m.ResetExpiryTime()
+		return nil
+	case tokentransaction.FieldCoordinatorPublicKey:
+		m.ResetCoordinatorPublicKey()
+		return nil
+	case tokentransaction.FieldClientCreatedTimestamp:
+		m.ResetClientCreatedTimestamp()
+		return nil
+	case tokentransaction.FieldFinalizedAt:
+		m.ResetFinalizedAt()
+		return nil
+	case tokentransaction.FieldVersion:
+		m.ResetVersion()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenTransaction field %s", name)
 }
 
-// OldKeyTweak returns the old "key_tweak" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldKeyTweak(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeyTweak is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenTransactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.spent_output != nil {
+		edges = append(edges, tokentransaction.EdgeSpentOutput)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeyTweak requires an ID field in the mutation")
+	if m.spent_output_v2 != nil {
+		edges = append(edges, tokentransaction.EdgeSpentOutputV2)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeyTweak: %w", err)
+	if m.created_output != nil {
+		edges = append(edges, tokentransaction.EdgeCreatedOutput)
 	}
-	return oldValue.KeyTweak, nil
-}
-
-// ClearKeyTweak clears the value of the "key_tweak" field.
-func (m *TransferLeafMutation) ClearKeyTweak() {
-	m.key_tweak = nil
-	m.clearedFields[transferleaf.FieldKeyTweak] = struct{}{}
-}
-
-// KeyTweakCleared returns if the "key_tweak" field was cleared in this mutation.
-func (m *TransferLeafMutation) KeyTweakCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldKeyTweak]
-	return ok
-}
-
-// ResetKeyTweak resets all changes to the "key_tweak" field.
-func (m *TransferLeafMutation) ResetKeyTweak() {
-	m.key_tweak = nil
-	delete(m.clearedFields, transferleaf.FieldKeyTweak)
-}
-
-// SetSenderKeyTweakProof sets the "sender_key_tweak_proof" field.
-func (m *TransferLeafMutation) SetSenderKeyTweakProof(b []byte) {
-	m.sender_key_tweak_proof = &b
-}
-
-// SenderKeyTweakProof returns the value of the "sender_key_tweak_proof" field in the mutation.
-func (m *TransferLeafMutation) SenderKeyTweakProof() (r []byte, exists bool) {
-	v := m.sender_key_tweak_proof
-	if v == nil {
-		return
+	if m.mint != nil {
+		edges = append(edges, tokentransaction.EdgeMint)
 	}
-	return *v, true
-}
-
-// OldSenderKeyTweakProof returns the old "sender_key_tweak_proof" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldSenderKeyTweakProof(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSenderKeyTweakProof is only allowed on UpdateOne operations")
+	if m.create != nil {
+		edges = append(edges, tokentransaction.EdgeCreate)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSenderKeyTweakProof requires an ID field in the mutation")
+	if m.payment_intent != nil {
+		edges = append(edges, tokentransaction.EdgePaymentIntent)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSenderKeyTweakProof: %w", err)
+	if m.peer_signatures != nil {
+		edges = append(edges, tokentransaction.EdgePeerSignatures)
 	}
-	return oldValue.SenderKeyTweakProof, nil
-}
-
-// ClearSenderKeyTweakProof clears the value of the "sender_key_tweak_proof" field.
-func (m *TransferLeafMutation) ClearSenderKeyTweakProof() {
-	m.sender_key_tweak_proof = nil
-	m.clearedFields[transferleaf.FieldSenderKeyTweakProof] = struct{}{}
-}
-
-// SenderKeyTweakProofCleared returns if the "sender_key_tweak_proof" field was cleared in this mutation.
-func (m *TransferLeafMutation) SenderKeyTweakProofCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldSenderKeyTweakProof]
-	return ok
-}
-
-// ResetSenderKeyTweakProof resets all changes to the "sender_key_tweak_proof" field.
-func (m *TransferLeafMutation) ResetSenderKeyTweakProof() {
-	m.sender_key_tweak_proof = nil
-	delete(m.clearedFields, transferleaf.FieldSenderKeyTweakProof)
-}
-
-// SetReceiverKeyTweak sets the "receiver_key_tweak" field.
-func (m *TransferLeafMutation) SetReceiverKeyTweak(b []byte) {
-	m.receiver_key_tweak = &b
+	if m.spark_invoice != nil {
+		edges = append(edges, tokentransaction.EdgeSparkInvoice)
+	}
+	return edges
 }
 
-// ReceiverKeyTweak returns the value of the "receiver_key_tweak" field in the mutation.
-func (m *TransferLeafMutation) ReceiverKeyTweak() (r []byte, exists bool) {
-	v := m.receiver_key_tweak
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenTransactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tokentransaction.EdgeSpentOutput:
+		ids := make([]ent.Value, 0, len(m.spent_output))
+		for id := range m.spent_output {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeSpentOutputV2:
+		ids := make([]ent.Value, 0, len(m.spent_output_v2))
+		for id := range m.spent_output_v2 {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeCreatedOutput:
+		ids := make([]ent.Value, 0, len(m.created_output))
+		for id := range m.created_output {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeMint:
+		if id := m.mint; id != nil {
+			return []ent.Value{*id}
+		}
+	case tokentransaction.EdgeCreate:
+		if id := m.create; id != nil {
+			return []ent.Value{*id}
+		}
+	case tokentransaction.EdgePaymentIntent:
+		if id := m.payment_intent; id != nil {
+			return []ent.Value{*id}
+		}
+	case tokentransaction.EdgePeerSignatures:
+		ids := make([]ent.Value, 0, len(m.peer_signatures))
+		for id := range m.peer_signatures {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeSparkInvoice:
+		ids := make([]ent.Value, 0, len(m.spark_invoice))
+		for id := range m.spark_invoice {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldReceiverKeyTweak returns the old "receiver_key_tweak" field's value of the TransferLeaf entity.
-// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransferLeafMutation) OldReceiverKeyTweak(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReceiverKeyTweak is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenTransactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedspent_output != nil {
+		edges = append(edges, tokentransaction.EdgeSpentOutput)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReceiverKeyTweak requires an ID field in the mutation")
+	if m.removedspent_output_v2 != nil {
+		edges = append(edges, tokentransaction.EdgeSpentOutputV2)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReceiverKeyTweak: %w", err)
+	if m.removedcreated_output != nil {
+		edges = append(edges, tokentransaction.EdgeCreatedOutput)
 	}
-	return oldValue.ReceiverKeyTweak, nil
+	if m.removedpeer_signatures != nil {
+		edges = append(edges, tokentransaction.EdgePeerSignatures)
+	}
+	if m.removedspark_invoice != nil {
+		edges = append(edges, tokentransaction.EdgeSparkInvoice)
+	}
+	return edges
 }
 
-// ClearReceiverKeyTweak clears the value of the "receiver_key_tweak" field.
-func (m *TransferLeafMutation) ClearReceiverKeyTweak() {
-	m.receiver_key_tweak = nil
-	m.clearedFields[transferleaf.FieldReceiverKeyTweak] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenTransactionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tokentransaction.EdgeSpentOutput:
+		ids := make([]ent.Value, 0, len(m.removedspent_output))
+		for id := range m.removedspent_output {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeSpentOutputV2:
+		ids := make([]ent.Value, 0, len(m.removedspent_output_v2))
+		for id := range m.removedspent_output_v2 {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeCreatedOutput:
+		ids := make([]ent.Value, 0, len(m.removedcreated_output))
+		for id := range m.removedcreated_output {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgePeerSignatures:
+		ids := make([]ent.Value, 0, len(m.removedpeer_signatures))
+		for id := range m.removedpeer_signatures {
+			ids = append(ids, id)
+		}
+		return ids
+	case tokentransaction.EdgeSparkInvoice:
+		ids := make([]ent.Value, 0, len(m.removedspark_invoice))
+		for id := range m.removedspark_invoice {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// ReceiverKeyTweakCleared returns if the "receiver_key_tweak" field was cleared in this mutation.
-func (m *TransferLeafMutation) ReceiverKeyTweakCleared() bool {
-	_, ok := m.clearedFields[transferleaf.FieldReceiverKeyTweak]
-	return ok
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenTransactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedspent_output {
+		edges = append(edges, tokentransaction.EdgeSpentOutput)
+	}
+	if m.clearedspent_output_v2 {
+		edges = append(edges, tokentransaction.EdgeSpentOutputV2)
+	}
+	if m.clearedcreated_output {
+		edges = append(edges, tokentransaction.EdgeCreatedOutput)
+	}
+	if m.clearedmint {
+		edges = append(edges, tokentransaction.EdgeMint)
+	}
+	if m.clearedcreate {
+		edges = append(edges, tokentransaction.EdgeCreate)
+	}
+	if m.clearedpayment_intent {
+		edges = append(edges, tokentransaction.EdgePaymentIntent)
+	}
+	if m.clearedpeer_signatures {
+		edges = append(edges, tokentransaction.EdgePeerSignatures)
+	}
+	if m.clearedspark_invoice {
+		edges = append(edges, tokentransaction.EdgeSparkInvoice)
+	}
+	return edges
 }
 
-// ResetReceiverKeyTweak resets all changes to the "receiver_key_tweak" field.
-func (m *TransferLeafMutation) ResetReceiverKeyTweak() {
-	m.receiver_key_tweak = nil
-	delete(m.clearedFields, transferleaf.FieldReceiverKeyTweak)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenTransactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tokentransaction.EdgeSpentOutput:
+		return m.clearedspent_output
+	case tokentransaction.EdgeSpentOutputV2:
+		return m.clearedspent_output_v2
+	case tokentransaction.EdgeCreatedOutput:
+		return m.clearedcreated_output
+	case tokentransaction.EdgeMint:
+		return m.clearedmint
+	case tokentransaction.EdgeCreate:
+		return m.clearedcreate
+	case tokentransaction.EdgePaymentIntent:
+		return m.clearedpayment_intent
+	case tokentransaction.EdgePeerSignatures:
+		return m.clearedpeer_signatures
+	case tokentransaction.EdgeSparkInvoice:
+		return m.clearedspark_invoice
+	}
+	return false
 }
 
-// SetTransferID sets the "transfer" edge to the Transfer entity by id.
-func (m *TransferLeafMutation) SetTransferID(id uuid.UUID) {
-	m.transfer = &id
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenTransactionMutation) ClearEdge(name string) error {
+	switch name {
+	case tokentransaction.EdgeMint:
+		m.ClearMint()
+		return nil
+	case tokentransaction.EdgeCreate:
+		m.ClearCreate()
+		return nil
+	case tokentransaction.EdgePaymentIntent:
+		m.ClearPaymentIntent()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenTransaction unique edge %s", name)
 }
 
-// ClearTransfer clears the "transfer" edge to the Transfer entity.
-func (m *TransferLeafMutation) ClearTransfer() {
-	m.clearedtransfer = true
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenTransactionMutation) ResetEdge(name string) error {
+	switch name {
+	case tokentransaction.EdgeSpentOutput:
+		m.ResetSpentOutput()
+		return nil
+	case tokentransaction.EdgeSpentOutputV2:
+		m.ResetSpentOutputV2()
+		return nil
+	case tokentransaction.EdgeCreatedOutput:
+		m.ResetCreatedOutput()
+		return nil
+	case tokentransaction.EdgeMint:
+		m.ResetMint()
+		return nil
+	case tokentransaction.EdgeCreate:
+		m.ResetCreate()
+		return nil
+	case tokentransaction.EdgePaymentIntent:
+		m.ResetPaymentIntent()
+		return nil
+	case tokentransaction.EdgePeerSignatures:
+		m.ResetPeerSignatures()
+		return nil
+	case tokentransaction.EdgeSparkInvoice:
+		m.ResetSparkInvoice()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenTransaction edge %s", name)
 }
 
-// TransferCleared reports if the "transfer" edge to the Transfer entity was cleared.
-func (m *TransferLeafMutation) TransferCleared() bool {
-	return m.clearedtransfer
+// TokenTransactionPeerSignatureMutation represents an operation that mutates the TokenTransactionPeerSignature nodes in the graph.
+type TokenTransactionPeerSignatureMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *uuid.UUID
+	create_time                  *time.Time
+	update_time                  *time.Time
+	operator_identity_public_key *[]byte
+	signature                    *[]byte
+	clearedFields                map[string]struct{}
+	token_transaction            *uuid.UUID
+	clearedtoken_transaction     bool
+	done                         bool
+	oldValue                     func(context.Context) (*TokenTransactionPeerSignature, error)
+	predicates                   []predicate.TokenTransactionPeerSignature
 }
 
-// TransferID returns the "transfer" edge ID in the mutation.
-func (m *TransferLeafMutation) TransferID() (id uuid.UUID, exists bool) {
-	if m.transfer != nil {
-		return *m.transfer, true
+var _ ent.Mutation = (*TokenTransactionPeerSignatureMutation)(nil)
+
+// tokentransactionpeersignatureOption allows management of the mutation configuration using functional options.
+type tokentransactionpeersignatureOption func(*TokenTransactionPeerSignatureMutation)
+
+// newTokenTransactionPeerSignatureMutation creates new mutation for the TokenTransactionPeerSignature entity.
+func newTokenTransactionPeerSignatureMutation(c config, op Op, opts ...tokentransactionpeersignatureOption) *TokenTransactionPeerSignatureMutation {
+	m := &TokenTransactionPeerSignatureMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenTransactionPeerSignature,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// TransferIDs returns the "transfer" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TransferID instead. It exists only for internal usage by the builders.
-func (m *TransferLeafMutation) TransferIDs() (ids []uuid.UUID) {
-	if id := m.transfer; id != nil {
-		ids = append(ids, *id)
+// withTokenTransactionPeerSignatureID sets the ID field of the mutation.
+func withTokenTransactionPeerSignatureID(id uuid.UUID) tokentransactionpeersignatureOption {
+	return func(m *TokenTransactionPeerSignatureMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenTransactionPeerSignature
+		)
+		m.oldValue = func(ctx context.Context) (*TokenTransactionPeerSignature, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenTransactionPeerSignature.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// ResetTransfer resets all changes to the "transfer" edge.
-func (m *TransferLeafMutation) ResetTransfer() {
-	m.transfer = nil
-	m.clearedtransfer = false
+// withTokenTransactionPeerSignature sets the old TokenTransactionPeerSignature of the mutation.
+func withTokenTransactionPeerSignature(node *TokenTransactionPeerSignature) tokentransactionpeersignatureOption {
+	return func(m *TokenTransactionPeerSignatureMutation) {
+		m.oldValue = func(context.Context) (*TokenTransactionPeerSignature, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetLeafID sets the "leaf" edge to the TreeNode entity by id.
-func (m *TransferLeafMutation) SetLeafID(id uuid.UUID) {
-	m.leaf = &id
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenTransactionPeerSignatureMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearLeaf clears the "leaf" edge to the TreeNode entity.
-func (m *TransferLeafMutation) ClearLeaf() {
-	m.clearedleaf = true
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenTransactionPeerSignatureMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// LeafCleared reports if the "leaf" edge to the TreeNode entity was cleared.
-func (m *TransferLeafMutation) LeafCleared() bool {
-	return m.clearedleaf
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenTransactionPeerSignature entities.
+func (m *TokenTransactionPeerSignatureMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// LeafID returns the "leaf" edge ID in the mutation.
-func (m *TransferLeafMutation) LeafID() (id uuid.UUID, exists bool) {
-	if m.leaf != nil {
-		return *m.leaf, true
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenTransactionPeerSignatureMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenTransactionPeerSignatureMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenTransactionPeerSignature.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TokenTransactionPeerSignatureMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TokenTransactionPeerSignatureMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the TokenTransactionPeerSignature entity.
+// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionPeerSignatureMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TokenTransactionPeerSignatureMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TokenTransactionPeerSignatureMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TokenTransactionPeerSignatureMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the TokenTransactionPeerSignature entity.
+// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionPeerSignatureMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TokenTransactionPeerSignatureMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOperatorIdentityPublicKey sets the "operator_identity_public_key" field.
+func (m *TokenTransactionPeerSignatureMutation) SetOperatorIdentityPublicKey(b []byte) {
+	m.operator_identity_public_key = &b
+}
+
+// OperatorIdentityPublicKey returns the value of the "operator_identity_public_key" field in the mutation.
+func (m *TokenTransactionPeerSignatureMutation) OperatorIdentityPublicKey() (r []byte, exists bool) {
+	v := m.operator_identity_public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperatorIdentityPublicKey returns the old "operator_identity_public_key" field's value of the TokenTransactionPeerSignature entity.
+// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionPeerSignatureMutation) OldOperatorIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorIdentityPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorIdentityPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorIdentityPublicKey: %w", err)
+	}
+	return oldValue.OperatorIdentityPublicKey, nil
+}
+
+// ResetOperatorIdentityPublicKey resets all changes to the "operator_identity_public_key" field.
+func (m *TokenTransactionPeerSignatureMutation) ResetOperatorIdentityPublicKey() {
+	m.operator_identity_public_key = nil
+}
+
+// SetSignature sets the "signature" field.
+func (m *TokenTransactionPeerSignatureMutation) SetSignature(b []byte) {
+	m.signature = &b
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *TokenTransactionPeerSignatureMutation) Signature() (r []byte, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSignature returns the old "signature" field's value of the TokenTransactionPeerSignature entity.
+// If the TokenTransactionPeerSignature object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenTransactionPeerSignatureMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *TokenTransactionPeerSignatureMutation) ResetSignature() {
+	m.signature = nil
+}
+
+// SetTokenTransactionID sets the "token_transaction" edge to the TokenTransaction entity by id.
+func (m *TokenTransactionPeerSignatureMutation) SetTokenTransactionID(id uuid.UUID) {
+	m.token_transaction = &id
+}
+
+// ClearTokenTransaction clears the "token_transaction" edge to the TokenTransaction entity.
+func (m *TokenTransactionPeerSignatureMutation) ClearTokenTransaction() {
+	m.clearedtoken_transaction = true
+}
+
+// TokenTransactionCleared reports if the "token_transaction" edge to the TokenTransaction entity was cleared.
+func (m *TokenTransactionPeerSignatureMutation) TokenTransactionCleared() bool {
+	return m.clearedtoken_transaction
+}
+
+// TokenTransactionID returns the "token_transaction" edge ID in the mutation.
+func (m *TokenTransactionPeerSignatureMutation) TokenTransactionID() (id uuid.UUID, exists bool) {
+	if m.token_transaction != nil {
+		return *m.token_transaction, true
 	}
 	return
 }
 
-// LeafIDs returns the "leaf" edge IDs in the mutation.
+// TokenTransactionIDs returns the "token_transaction" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// LeafID instead. It exists only for internal usage by the builders.
-func (m *TransferLeafMutation) LeafIDs() (ids []uuid.UUID) {
-	if id := m.leaf; id != nil {
+// TokenTransactionID instead. It exists only for internal usage by the builders.
+func (m *TokenTransactionPeerSignatureMutation) TokenTransactionIDs() (ids []uuid.UUID) {
+	if id := m.token_transaction; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetLeaf resets all changes to the "leaf" edge.
-func (m *TransferLeafMutation) ResetLeaf() {
-	m.leaf = nil
-	m.clearedleaf = false
+// ResetTokenTransaction resets all changes to the "token_transaction" edge.
+func (m *TokenTransactionPeerSignatureMutation) ResetTokenTransaction() {
+	m.token_transaction = nil
+	m.clearedtoken_transaction = false
 }
 
-// Where appends a list predicates to the TransferLeafMutation builder.
-func (m *TransferLeafMutation) Where(ps ...predicate.TransferLeaf) {
+// Where appends a list predicates to the TokenTransactionPeerSignatureMutation builder.
+func (m *TokenTransactionPeerSignatureMutation) Where(ps ...predicate.TokenTransactionPeerSignature) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TransferLeafMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TokenTransactionPeerSignatureMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TransferLeafMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TransferLeaf, len(ps))
+func (m *TokenTransactionPeerSignatureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenTransactionPeerSignature, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -18970,63 +19605,36 @@ func (m *TransferLeafMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TransferLeafMutation) Op() Op {
+func (m *TokenTransactionPeerSignatureMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TransferLeafMutation) SetOp(op Op) {
+func (m *TokenTransactionPeerSignatureMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TransferLeaf).
-func (m *TransferLeafMutation) Type() string {
+// Type returns the node type of this mutation (TokenTransactionPeerSignature).
+func (m *TokenTransactionPeerSignatureMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TransferLeafMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *TokenTransactionPeerSignatureMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.create_time != nil {
-		fields = append(fields, transferleaf.FieldCreateTime)
+		fields = append(fields, tokentransactionpeersignature.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, transferleaf.FieldUpdateTime)
+		fields = append(fields, tokentransactionpeersignature.FieldUpdateTime)
 	}
-	if m.secret_cipher != nil {
-		fields = append(fields, transferleaf.FieldSecretCipher)
+	if m.operator_identity_public_key != nil {
+		fields = append(fields, tokentransactionpeersignature.FieldOperatorIdentityPublicKey)
 	}
 	if m.signature != nil {
-		fields = append(fields, transferleaf.FieldSignature)
-	}
-	if m.previous_refund_tx != nil {
-		fields = append(fields, transferleaf.FieldPreviousRefundTx)
-	}
-	if m.previous_direct_refund_tx != nil {
-		fields = append(fields, transferleaf.FieldPreviousDirectRefundTx)
-	}
-	if m.previous_direct_from_cpfp_refund_tx != nil {
-		fields = append(fields, transferleaf.FieldPreviousDirectFromCpfpRefundTx)
-	}
-	if m.intermediate_refund_tx != nil {
-		fields = append(fields, transferleaf.FieldIntermediateRefundTx)
-	}
-	if m.intermediate_direct_refund_tx != nil {
-		fields = append(fields, transferleaf.FieldIntermediateDirectRefundTx)
-	}
-	if m.intermediate_direct_from_cpfp_refund_tx != nil {
-		fields = append(fields, transferleaf.FieldIntermediateDirectFromCpfpRefundTx)
-	}
-	if m.key_tweak != nil {
-		fields = append(fields, transferleaf.FieldKeyTweak)
-	}
-	if m.sender_key_tweak_proof != nil {
-		fields = append(fields, transferleaf.FieldSenderKeyTweakProof)
-	}
-	if m.receiver_key_tweak != nil {
-		fields = append(fields, transferleaf.FieldReceiverKeyTweak)
+		fields = append(fields, tokentransactionpeersignature.FieldSignature)
 	}
 	return fields
 }
@@ -19034,34 +19642,16 @@ func (m *TransferLeafMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TransferLeafMutation) Field(name string) (ent.Value, bool) {
+func (m *TokenTransactionPeerSignatureMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case transferleaf.FieldCreateTime:
+	case tokentransactionpeersignature.FieldCreateTime:
 		return m.CreateTime()
-	case transferleaf.FieldUpdateTime:
+	case tokentransactionpeersignature.FieldUpdateTime:
 		return m.UpdateTime()
-	case transferleaf.FieldSecretCipher:
-		return m.SecretCipher()
-	case transferleaf.FieldSignature:
+	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+		return m.OperatorIdentityPublicKey()
+	case tokentransactionpeersignature.FieldSignature:
 		return m.Signature()
-	case transferleaf.FieldPreviousRefundTx:
-		return m.PreviousRefundTx()
-	case transferleaf.FieldPreviousDirectRefundTx:
-		return m.PreviousDirectRefundTx()
-	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
-		return m.PreviousDirectFromCpfpRefundTx()
-	case transferleaf.FieldIntermediateRefundTx:
-		return m.IntermediateRefundTx()
-	case transferleaf.FieldIntermediateDirectRefundTx:
-		return m.IntermediateDirectRefundTx()
-	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
-		return m.IntermediateDirectFromCpfpRefundTx()
-	case transferleaf.FieldKeyTweak:
-		return m.KeyTweak()
-	case transferleaf.FieldSenderKeyTweakProof:
-		return m.SenderKeyTweakProof()
-	case transferleaf.FieldReceiverKeyTweak:
-		return m.ReceiverKeyTweak()
 	}
 	return nil, false
 }
@@ -19069,305 +19659,133 @@ func (m *TransferLeafMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TransferLeafMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TokenTransactionPeerSignatureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case transferleaf.FieldCreateTime:
+	case tokentransactionpeersignature.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case transferleaf.FieldUpdateTime:
+	case tokentransactionpeersignature.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case transferleaf.FieldSecretCipher:
-		return m.OldSecretCipher(ctx)
-	case transferleaf.FieldSignature:
+	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+		return m.OldOperatorIdentityPublicKey(ctx)
+	case tokentransactionpeersignature.FieldSignature:
 		return m.OldSignature(ctx)
-	case transferleaf.FieldPreviousRefundTx:
-		return m.OldPreviousRefundTx(ctx)
-	case transferleaf.FieldPreviousDirectRefundTx:
-		return m.OldPreviousDirectRefundTx(ctx)
-	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
-		return m.OldPreviousDirectFromCpfpRefundTx(ctx)
-	case transferleaf.FieldIntermediateRefundTx:
-		return m.OldIntermediateRefundTx(ctx)
-	case transferleaf.FieldIntermediateDirectRefundTx:
-		return m.OldIntermediateDirectRefundTx(ctx)
-	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
-		return m.OldIntermediateDirectFromCpfpRefundTx(ctx)
-	case transferleaf.FieldKeyTweak:
-		return m.OldKeyTweak(ctx)
-	case transferleaf.FieldSenderKeyTweakProof:
-		return m.OldSenderKeyTweakProof(ctx)
-	case transferleaf.FieldReceiverKeyTweak:
-		return m.OldReceiverKeyTweak(ctx)
 	}
-	return nil, fmt.Errorf("unknown TransferLeaf field %s", name)
+	return nil, fmt.Errorf("unknown TokenTransactionPeerSignature field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransferLeafMutation) SetField(name string, value ent.Value) error {
+func (m *TokenTransactionPeerSignatureMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case transferleaf.FieldCreateTime:
+	case tokentransactionpeersignature.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case transferleaf.FieldUpdateTime:
+	case tokentransactionpeersignature.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case transferleaf.FieldSecretCipher:
+	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecretCipher(v)
+		m.SetOperatorIdentityPublicKey(v)
 		return nil
-	case transferleaf.FieldSignature:
+	case tokentransactionpeersignature.FieldSignature:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSignature(v)
 		return nil
-	case transferleaf.FieldPreviousRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPreviousRefundTx(v)
-		return nil
-	case transferleaf.FieldPreviousDirectRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPreviousDirectRefundTx(v)
-		return nil
-	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPreviousDirectFromCpfpRefundTx(v)
-		return nil
-	case transferleaf.FieldIntermediateRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIntermediateRefundTx(v)
-		return nil
-	case transferleaf.FieldIntermediateDirectRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIntermediateDirectRefundTx(v)
-		return nil
-	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIntermediateDirectFromCpfpRefundTx(v)
-		return nil
-	case transferleaf.FieldKeyTweak:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetKeyTweak(v)
-		return nil
-	case transferleaf.FieldSenderKeyTweakProof:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSenderKeyTweakProof(v)
-		return nil
-	case transferleaf.FieldReceiverKeyTweak:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReceiverKeyTweak(v)
-		return nil
 	}
-	return fmt.Errorf("unknown TransferLeaf field %s", name)
+	return fmt.Errorf("unknown TokenTransactionPeerSignature field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TransferLeafMutation) AddedFields() []string {
+func (m *TokenTransactionPeerSignatureMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TransferLeafMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TokenTransactionPeerSignatureMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TransferLeafMutation) AddField(name string, value ent.Value) error {
+func (m *TokenTransactionPeerSignatureMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown TransferLeaf numeric field %s", name)
+	return fmt.Errorf("unknown TokenTransactionPeerSignature numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TransferLeafMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(transferleaf.FieldSecretCipher) {
-		fields = append(fields, transferleaf.FieldSecretCipher)
-	}
-	if m.FieldCleared(transferleaf.FieldSignature) {
-		fields = append(fields, transferleaf.FieldSignature)
-	}
-	if m.FieldCleared(transferleaf.FieldPreviousDirectRefundTx) {
-		fields = append(fields, transferleaf.FieldPreviousDirectRefundTx)
-	}
-	if m.FieldCleared(transferleaf.FieldPreviousDirectFromCpfpRefundTx) {
-		fields = append(fields, transferleaf.FieldPreviousDirectFromCpfpRefundTx)
-	}
-	if m.FieldCleared(transferleaf.FieldIntermediateDirectRefundTx) {
-		fields = append(fields, transferleaf.FieldIntermediateDirectRefundTx)
-	}
-	if m.FieldCleared(transferleaf.FieldIntermediateDirectFromCpfpRefundTx) {
-		fields = append(fields, transferleaf.FieldIntermediateDirectFromCpfpRefundTx)
-	}
-	if m.FieldCleared(transferleaf.FieldKeyTweak) {
-		fields = append(fields, transferleaf.FieldKeyTweak)
-	}
-	if m.FieldCleared(transferleaf.FieldSenderKeyTweakProof) {
-		fields = append(fields, transferleaf.FieldSenderKeyTweakProof)
-	}
-	if m.FieldCleared(transferleaf.FieldReceiverKeyTweak) {
-		fields = append(fields, transferleaf.FieldReceiverKeyTweak)
-	}
-	return fields
+func (m *TokenTransactionPeerSignatureMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TransferLeafMutation) FieldCleared(name string) bool {
+func (m *TokenTransactionPeerSignatureMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TransferLeafMutation) ClearField(name string) error {
-	switch name {
-	case transferleaf.FieldSecretCipher:
-		m.ClearSecretCipher()
-		return nil
-	case transferleaf.FieldSignature:
-		m.ClearSignature()
-		return nil
-	case transferleaf.FieldPreviousDirectRefundTx:
-		m.ClearPreviousDirectRefundTx()
-		return nil
-	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
-		m.ClearPreviousDirectFromCpfpRefundTx()
-		return nil
-	case transferleaf.FieldIntermediateDirectRefundTx:
-		m.ClearIntermediateDirectRefundTx()
-		return nil
-	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
-		m.ClearIntermediateDirectFromCpfpRefundTx()
-		return nil
-	case transferleaf.FieldKeyTweak:
-		m.ClearKeyTweak()
-		return nil
-	case transferleaf.FieldSenderKeyTweakProof:
-		m.ClearSenderKeyTweakProof()
-		return nil
-	case transferleaf.FieldReceiverKeyTweak:
-		m.ClearReceiverKeyTweak()
-		return nil
-	}
-	return fmt.Errorf("unknown TransferLeaf nullable field %s", name)
+func (m *TokenTransactionPeerSignatureMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TokenTransactionPeerSignature nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TransferLeafMutation) ResetField(name string) error {
+func (m *TokenTransactionPeerSignatureMutation) ResetField(name string) error {
 	switch name {
-	case transferleaf.FieldCreateTime:
+	case tokentransactionpeersignature.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case transferleaf.FieldUpdateTime:
+	case tokentransactionpeersignature.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case transferleaf.FieldSecretCipher:
-		m.ResetSecretCipher()
+	case tokentransactionpeersignature.FieldOperatorIdentityPublicKey:
+		m.ResetOperatorIdentityPublicKey()
 		return nil
-	case transferleaf.FieldSignature:
+	case tokentransactionpeersignature.FieldSignature:
 		m.ResetSignature()
 		return nil
-	case transferleaf.FieldPreviousRefundTx:
-		m.ResetPreviousRefundTx()
-		return nil
-	case transferleaf.FieldPreviousDirectRefundTx:
-		m.ResetPreviousDirectRefundTx()
-		return nil
-	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
-		m.ResetPreviousDirectFromCpfpRefundTx()
-		return nil
-	case transferleaf.FieldIntermediateRefundTx:
-		m.ResetIntermediateRefundTx()
-		return nil
-	case transferleaf.FieldIntermediateDirectRefundTx:
-		m.ResetIntermediateDirectRefundTx()
-		return nil
-	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
-		m.ResetIntermediateDirectFromCpfpRefundTx()
-		return nil
-	case transferleaf.FieldKeyTweak:
-		m.ResetKeyTweak()
-		return nil
-	case transferleaf.FieldSenderKeyTweakProof:
-		m.ResetSenderKeyTweakProof()
-		return nil
-	case transferleaf.FieldReceiverKeyTweak:
-		m.ResetReceiverKeyTweak()
-		return nil
 	}
-	return fmt.Errorf("unknown TransferLeaf field %s", name)
+	return fmt.Errorf("unknown TokenTransactionPeerSignature field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TransferLeafMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.transfer != nil {
-		edges = append(edges, transferleaf.EdgeTransfer)
-	}
-	if m.leaf != nil {
-		edges = append(edges, transferleaf.EdgeLeaf)
+func (m *TokenTransactionPeerSignatureMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.token_transaction != nil {
+		edges = append(edges, tokentransactionpeersignature.EdgeTokenTransaction)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TransferLeafMutation) AddedIDs(name string) []ent.Value {
+func (m *TokenTransactionPeerSignatureMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case transferleaf.EdgeTransfer:
-		if id := m.transfer; id != nil {
-			return []ent.Value{*id}
-		}
-	case transferleaf.EdgeLeaf:
-		if id := m.leaf; id != nil {
+	case tokentransactionpeersignature.EdgeTokenTransaction:
+		if id := m.token_transaction; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -19375,105 +19793,99 @@ func (m *TransferLeafMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TransferLeafMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TokenTransactionPeerSignatureMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TransferLeafMutation) RemovedIDs(name string) []ent.Value {
+func (m *TokenTransactionPeerSignatureMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TransferLeafMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedtransfer {
-		edges = append(edges, transferleaf.EdgeTransfer)
-	}
-	if m.clearedleaf {
-		edges = append(edges, transferleaf.EdgeLeaf)
+func (m *TokenTransactionPeerSignatureMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedtoken_transaction {
+		edges = append(edges, tokentransactionpeersignature.EdgeTokenTransaction)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TransferLeafMutation) EdgeCleared(name string) bool {
+func (m *TokenTransactionPeerSignatureMutation) EdgeCleared(name string) bool {
 	switch name {
-	case transferleaf.EdgeTransfer:
-		return m.clearedtransfer
-	case transferleaf.EdgeLeaf:
-		return m.clearedleaf
+	case tokentransactionpeersignature.EdgeTokenTransaction:
+		return m.clearedtoken_transaction
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TransferLeafMutation) ClearEdge(name string) error {
+func (m *TokenTransactionPeerSignatureMutation) ClearEdge(name string) error {
 	switch name {
-	case transferleaf.EdgeTransfer:
-		m.ClearTransfer()
-		return nil
-	case transferleaf.EdgeLeaf:
-		m.ClearLeaf()
+	case tokentransactionpeersignature.EdgeTokenTransaction:
+		m.ClearTokenTransaction()
 		return nil
 	}
-	return fmt.Errorf("unknown TransferLeaf unique edge %s", name)
+	return fmt.Errorf("unknown TokenTransactionPeerSignature unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TransferLeafMutation) ResetEdge(name string) error {
+func (m *TokenTransactionPeerSignatureMutation) ResetEdge(name string) error {
 	switch name {
-	case transferleaf.EdgeTransfer:
-		m.ResetTransfer()
-		return nil
-	case transferleaf.EdgeLeaf:
-		m.ResetLeaf()
+	case tokentransactionpeersignature.EdgeTokenTransaction:
+		m.ResetTokenTransaction()
 		return nil
 	}
-	return fmt.Errorf("unknown TransferLeaf edge %s", name)
+	return fmt.Errorf("unknown TokenTransactionPeerSignature edge %s", name)
 }
 
-// TreeMutation represents an operation that mutates the Tree nodes in the graph.
-type TreeMutation struct {
+// TransferMutation represents an operation that mutates the Transfer nodes in the graph.
+type TransferMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	create_time           *time.Time
-	update_time           *time.Time
-	owner_identity_pubkey *[]byte
-	status                *schematype.TreeStatus
-	network               *schematype.Network
-	base_txid             *[]byte
-	vout                  *int16
-	addvout               *int16
-	clearedFields         map[string]struct{}
-	root                  *uuid.UUID
-	clearedroot           bool
-	nodes                 map[uuid.UUID]struct{}
-	removednodes          map[uuid.UUID]struct{}
-	clearednodes          bool
-	done                  bool
-	oldValue              func(context.Context) (*Tree, error)
-	predicates            []predicate.Tree
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	create_time              *time.Time
+	update_time              *time.Time
+	sender_identity_pubkey   *[]byte
+	receiver_identity_pubkey *[]byte
+	total_value              *uint64
+	addtotal_value           *int64
+	status                   *schematype.TransferStatus
+	_type                    *schematype.TransferType
+	expiry_time              *time.Time
+	completion_time          *time.Time
+	batch_id                 *uuid.UUID
+	clearedFields            map[string]struct{}
+	transfer_leaves          map[uuid.UUID]struct{}
+	removedtransfer_leaves   map[uuid.UUID]struct{}
+	clearedtransfer_leaves   bool
+	payment_intent           *uuid.UUID
+	clearedpayment_intent    bool
+	spark_invoice            *uuid.UUID
+	clearedspark_invoice     bool
+	done                     bool
+	oldValue                 func(context.Context) (*Transfer, error)
+	predicates               []predicate.Transfer
 }
 
-var _ ent.Mutation = (*TreeMutation)(nil)
+var _ ent.Mutation = (*TransferMutation)(nil)
 
-// treeOption allows management of the mutation configuration using functional options.
-type treeOption func(*TreeMutation)
+// transferOption allows management of the mutation configuration using functional options.
+type transferOption func(*TransferMutation)
 
-// newTreeMutation creates new mutation for the Tree entity.
-func newTreeMutation(c config, op Op, opts ...treeOption) *TreeMutation {
-	m := &TreeMutation{
+// newTransferMutation creates new mutation for the Transfer entity.
+func newTransferMutation(c config, op Op, opts ...transferOption) *TransferMutation {
+	m := &TransferMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTree,
+		typ:           TypeTransfer,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -19482,20 +19894,20 @@ func newTreeMutation(c config, op Op, opts ...treeOption) *TreeMutation {
 	return m
 }
 
-// withTreeID sets the ID field of the mutation.
-func withTreeID(id uuid.UUID) treeOption {
-	return func(m *TreeMutation) {
+// withTransferID sets the ID field of the mutation.
+func withTransferID(id uuid.UUID) transferOption {
+	return func(m *TransferMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tree
+			value *Transfer
 		)
-		m.oldValue = func(ctx context.Context) (*Tree, error) {
+		m.oldValue = func(ctx context.Context) (*Transfer, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tree.Get(ctx, id)
+					value, err = m.Client().Transfer.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -19504,10 +19916,10 @@ func withTreeID(id uuid.UUID) treeOption {
 	}
 }
 
-// withTree sets the old Tree of the mutation.
-func withTree(node *Tree) treeOption {
-	return func(m *TreeMutation) {
-		m.oldValue = func(context.Context) (*Tree, error) {
+// withTransfer sets the old Transfer of the mutation.
+func withTransfer(node *Transfer) transferOption {
+	return func(m *TransferMutation) {
+		m.oldValue = func(context.Context) (*Transfer, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -19516,7 +19928,7 @@ func withTree(node *Tree) treeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TreeMutation) Client() *Client {
+func (m TransferMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -19524,7 +19936,7 @@ func (m TreeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TreeMutation) Tx() (*Tx, error) {
+func (m TransferMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -19534,14 +19946,14 @@ func (m TreeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Tree entities.
-func (m *TreeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Transfer entities.
+func (m *TransferMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TreeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TransferMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -19552,7 +19964,7 @@ func (m *TreeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TreeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -19561,19 +19973,19 @@ func (m *TreeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tree.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Transfer.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TreeMutation) SetCreateTime(t time.Time) {
+func (m *TransferMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TreeMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TransferMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -19581,10 +19993,10 @@ func (m *TreeMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TransferMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -19599,17 +20011,17 @@ func (m *TreeMutation) OldCreateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TreeMutation) ResetCreateTime() {
+func (m *TransferMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TreeMutation) SetUpdateTime(t time.Time) {
+func (m *TransferMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TreeMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TransferMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -19617,10 +20029,10 @@ func (m *TreeMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TransferMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -19635,312 +20047,485 @@ func (m *TreeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err erro
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TreeMutation) ResetUpdateTime() {
+func (m *TransferMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
-func (m *TreeMutation) SetOwnerIdentityPubkey(b []byte) {
-	m.owner_identity_pubkey = &b
+// SetSenderIdentityPubkey sets the "sender_identity_pubkey" field.
+func (m *TransferMutation) SetSenderIdentityPubkey(b []byte) {
+	m.sender_identity_pubkey = &b
 }
 
-// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
-func (m *TreeMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
-	v := m.owner_identity_pubkey
+// SenderIdentityPubkey returns the value of the "sender_identity_pubkey" field in the mutation.
+func (m *TransferMutation) SenderIdentityPubkey() (r []byte, exists bool) {
+	v := m.sender_identity_pubkey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldSenderIdentityPubkey returns the old "sender_identity_pubkey" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+func (m *TransferMutation) OldSenderIdentityPubkey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+		return v, errors.New("OldSenderIdentityPubkey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+		return v, errors.New("OldSenderIdentityPubkey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+		return v, fmt.Errorf("querying old value for OldSenderIdentityPubkey: %w", err)
 	}
-	return oldValue.OwnerIdentityPubkey, nil
+	return oldValue.SenderIdentityPubkey, nil
 }
 
-// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
-func (m *TreeMutation) ResetOwnerIdentityPubkey() {
-	m.owner_identity_pubkey = nil
+// ResetSenderIdentityPubkey resets all changes to the "sender_identity_pubkey" field.
+func (m *TransferMutation) ResetSenderIdentityPubkey() {
+	m.sender_identity_pubkey = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *TreeMutation) SetStatus(ss schematype.TreeStatus) {
-	m.status = &ss
+// SetReceiverIdentityPubkey sets the "receiver_identity_pubkey" field.
+func (m *TransferMutation) SetReceiverIdentityPubkey(b []byte) {
+	m.receiver_identity_pubkey = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TreeMutation) Status() (r schematype.TreeStatus, exists bool) {
-	v := m.status
+// ReceiverIdentityPubkey returns the value of the "receiver_identity_pubkey" field in the mutation.
+func (m *TransferMutation) ReceiverIdentityPubkey() (r []byte, exists bool) {
+	v := m.receiver_identity_pubkey
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldReceiverIdentityPubkey returns the old "receiver_identity_pubkey" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldStatus(ctx context.Context) (v schematype.TreeStatus, err error) {
+func (m *TransferMutation) OldReceiverIdentityPubkey(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceiverIdentityPubkey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldReceiverIdentityPubkey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceiverIdentityPubkey: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.ReceiverIdentityPubkey, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TreeMutation) ResetStatus() {
-	m.status = nil
+// ResetReceiverIdentityPubkey resets all changes to the "receiver_identity_pubkey" field.
+func (m *TransferMutation) ResetReceiverIdentityPubkey() {
+	m.receiver_identity_pubkey = nil
 }
 
-// SetNetwork sets the "network" field.
-func (m *TreeMutation) SetNetwork(s schematype.Network) {
-	m.network = &s
+// SetTotalValue sets the "total_value" field.
+func (m *TransferMutation) SetTotalValue(u uint64) {
+	m.total_value = &u
+	m.addtotal_value = nil
 }
 
-// Network returns the value of the "network" field in the mutation.
-func (m *TreeMutation) Network() (r schematype.Network, exists bool) {
-	v := m.network
+// TotalValue returns the value of the "total_value" field in the mutation.
+func (m *TransferMutation) TotalValue() (r uint64, exists bool) {
+	v := m.total_value
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNetwork returns the old "network" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalValue returns the old "total_value" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldNetwork(ctx context.Context) (v schematype.Network, err error) {
+func (m *TransferMutation) OldTotalValue(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalValue is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNetwork requires an ID field in the mutation")
+		return v, errors.New("OldTotalValue requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalValue: %w", err)
 	}
-	return oldValue.Network, nil
+	return oldValue.TotalValue, nil
 }
 
-// ResetNetwork resets all changes to the "network" field.
-func (m *TreeMutation) ResetNetwork() {
-	m.network = nil
+// AddTotalValue adds u to the "total_value" field.
+func (m *TransferMutation) AddTotalValue(u int64) {
+	if m.addtotal_value != nil {
+		*m.addtotal_value += u
+	} else {
+		m.addtotal_value = &u
+	}
 }
 
-// SetBaseTxid sets the "base_txid" field.
-func (m *TreeMutation) SetBaseTxid(b []byte) {
-	m.base_txid = &b
+// AddedTotalValue returns the value that was added to the "total_value" field in this mutation.
+func (m *TransferMutation) AddedTotalValue() (r int64, exists bool) {
+	v := m.addtotal_value
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// BaseTxid returns the value of the "base_txid" field in the mutation.
-func (m *TreeMutation) BaseTxid() (r []byte, exists bool) {
-	v := m.base_txid
+// ResetTotalValue resets all changes to the "total_value" field.
+func (m *TransferMutation) ResetTotalValue() {
+	m.total_value = nil
+	m.addtotal_value = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TransferMutation) SetStatus(ss schematype.TransferStatus) {
+	m.status = &ss
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TransferMutation) Status() (r schematype.TransferStatus, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBaseTxid returns the old "base_txid" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldBaseTxid(ctx context.Context) (v []byte, err error) {
+func (m *TransferMutation) OldStatus(ctx context.Context) (v schematype.TransferStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBaseTxid is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBaseTxid requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBaseTxid: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.BaseTxid, nil
+	return oldValue.Status, nil
 }
 
-// ResetBaseTxid resets all changes to the "base_txid" field.
-func (m *TreeMutation) ResetBaseTxid() {
-	m.base_txid = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *TransferMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetVout sets the "vout" field.
-func (m *TreeMutation) SetVout(i int16) {
-	m.vout = &i
-	m.addvout = nil
+// SetType sets the "type" field.
+func (m *TransferMutation) SetType(st schematype.TransferType) {
+	m._type = &st
 }
 
-// Vout returns the value of the "vout" field in the mutation.
-func (m *TreeMutation) Vout() (r int16, exists bool) {
-	v := m.vout
+// GetType returns the value of the "type" field in the mutation.
+func (m *TransferMutation) GetType() (r schematype.TransferType, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVout returns the old "vout" field's value of the Tree entity.
-// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeMutation) OldVout(ctx context.Context) (v int16, err error) {
+func (m *TransferMutation) OldType(ctx context.Context) (v schematype.TransferType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVout is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVout requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVout: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Vout, nil
+	return oldValue.Type, nil
 }
 
-// AddVout adds i to the "vout" field.
-func (m *TreeMutation) AddVout(i int16) {
-	if m.addvout != nil {
-		*m.addvout += i
-	} else {
-		m.addvout = &i
-	}
+// ResetType resets all changes to the "type" field.
+func (m *TransferMutation) ResetType() {
+	m._type = nil
 }
 
-// AddedVout returns the value that was added to the "vout" field in this mutation.
-func (m *TreeMutation) AddedVout() (r int16, exists bool) {
-	v := m.addvout
+// SetExpiryTime sets the "expiry_time" field.
+func (m *TransferMutation) SetExpiryTime(t time.Time) {
+	m.expiry_time = &t
+}
+
+// ExpiryTime returns the value of the "expiry_time" field in the mutation.
+func (m *TransferMutation) ExpiryTime() (r time.Time, exists bool) {
+	v := m.expiry_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetVout resets all changes to the "vout" field.
-func (m *TreeMutation) ResetVout() {
-	m.vout = nil
-	m.addvout = nil
+// OldExpiryTime returns the old "expiry_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldExpiryTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiryTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiryTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiryTime: %w", err)
+	}
+	return oldValue.ExpiryTime, nil
 }
 
-// SetRootID sets the "root" edge to the TreeNode entity by id.
-func (m *TreeMutation) SetRootID(id uuid.UUID) {
-	m.root = &id
+// ResetExpiryTime resets all changes to the "expiry_time" field.
+func (m *TransferMutation) ResetExpiryTime() {
+	m.expiry_time = nil
 }
 
-// ClearRoot clears the "root" edge to the TreeNode entity.
-func (m *TreeMutation) ClearRoot() {
-	m.clearedroot = true
+// SetCompletionTime sets the "completion_time" field.
+func (m *TransferMutation) SetCompletionTime(t time.Time) {
+	m.completion_time = &t
 }
 
-// RootCleared reports if the "root" edge to the TreeNode entity was cleared.
-func (m *TreeMutation) RootCleared() bool {
-	return m.clearedroot
+// CompletionTime returns the value of the "completion_time" field in the mutation.
+func (m *TransferMutation) CompletionTime() (r time.Time, exists bool) {
+	v := m.completion_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RootID returns the "root" edge ID in the mutation.
-func (m *TreeMutation) RootID() (id uuid.UUID, exists bool) {
-	if m.root != nil {
-		return *m.root, true
+// OldCompletionTime returns the old "completion_time" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldCompletionTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletionTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletionTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletionTime: %w", err)
+	}
+	return oldValue.CompletionTime, nil
 }
 
-// RootIDs returns the "root" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RootID instead. It exists only for internal usage by the builders.
-func (m *TreeMutation) RootIDs() (ids []uuid.UUID) {
-	if id := m.root; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ClearCompletionTime clears the value of the "completion_time" field.
+func (m *TransferMutation) ClearCompletionTime() {
+	m.completion_time = nil
+	m.clearedFields[transfer.FieldCompletionTime] = struct{}{}
 }
 
-// ResetRoot resets all changes to the "root" edge.
-func (m *TreeMutation) ResetRoot() {
-	m.root = nil
-	m.clearedroot = false
+// CompletionTimeCleared returns if the "completion_time" field was cleared in this mutation.
+func (m *TransferMutation) CompletionTimeCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldCompletionTime]
+	return ok
 }
 
-// AddNodeIDs adds the "nodes" edge to the TreeNode entity by ids.
-func (m *TreeMutation) AddNodeIDs(ids ...uuid.UUID) {
-	if m.nodes == nil {
-		m.nodes = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.nodes[ids[i]] = struct{}{}
-	}
+// ResetCompletionTime resets all changes to the "completion_time" field.
+func (m *TransferMutation) ResetCompletionTime() {
+	m.completion_time = nil
+	delete(m.clearedFields, transfer.FieldCompletionTime)
 }
 
-// ClearNodes clears the "nodes" edge to the TreeNode entity.
-func (m *TreeMutation) ClearNodes() {
-	m.clearednodes = true
+// SetBatchID sets the "batch_id" field.
+func (m *TransferMutation) SetBatchID(u uuid.UUID) {
+	m.batch_id = &u
 }
 
-// NodesCleared reports if the "nodes" edge to the TreeNode entity was cleared.
-func (m *TreeMutation) NodesCleared() bool {
-	return m.clearednodes
+// BatchID returns the value of the "batch_id" field in the mutation.
+func (m *TransferMutation) BatchID() (r uuid.UUID, exists bool) {
+	v := m.batch_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveNodeIDs removes the "nodes" edge to the TreeNode entity by IDs.
-func (m *TreeMutation) RemoveNodeIDs(ids ...uuid.UUID) {
-	if m.removednodes == nil {
-		m.removednodes = make(map[uuid.UUID]struct{})
+// OldBatchID returns the old "batch_id" field's value of the Transfer entity.
+// If the Transfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferMutation) OldBatchID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBatchID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.nodes, ids[i])
-		m.removednodes[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBatchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBatchID: %w", err)
 	}
+	return oldValue.BatchID, nil
 }
 
-// RemovedNodes returns the removed IDs of the "nodes" edge to the TreeNode entity.
-func (m *TreeMutation) RemovedNodesIDs() (ids []uuid.UUID) {
-	for id := range m.removednodes {
-		ids = append(ids, id)
+// ClearBatchID clears the value of the "batch_id" field.
+func (m *TransferMutation) ClearBatchID() {
+	m.batch_id = nil
+	m.clearedFields[transfer.FieldBatchID] = struct{}{}
+}
+
+// BatchIDCleared returns if the "batch_id" field was cleared in this mutation.
+func (m *TransferMutation) BatchIDCleared() bool {
+	_, ok := m.clearedFields[transfer.FieldBatchID]
+	return ok
+}
+
+// ResetBatchID resets all changes to the "batch_id" field.
+func (m *TransferMutation) ResetBatchID() {
+	m.batch_id = nil
+	delete(m.clearedFields, transfer.FieldBatchID)
+}
+
+// AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by ids.
+func (m *TransferMutation) AddTransferLeafeIDs(ids ...uuid.UUID) {
+	if m.transfer_leaves == nil {
+		m.transfer_leaves = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.transfer_leaves[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTransferLeaves clears the "transfer_leaves" edge to the TransferLeaf entity.
+func (m *TransferMutation) ClearTransferLeaves() {
+	m.clearedtransfer_leaves = true
+}
+
+// TransferLeavesCleared reports if the "transfer_leaves" edge to the TransferLeaf entity was cleared.
+func (m *TransferMutation) TransferLeavesCleared() bool {
+	return m.clearedtransfer_leaves
+}
+
+// RemoveTransferLeafeIDs removes the "transfer_leaves" edge to the TransferLeaf entity by IDs.
+func (m *TransferMutation) RemoveTransferLeafeIDs(ids ...uuid.UUID) {
+	if m.removedtransfer_leaves == nil {
+		m.removedtransfer_leaves = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.transfer_leaves, ids[i])
+		m.removedtransfer_leaves[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTransferLeaves returns the removed IDs of the "transfer_leaves" edge to the TransferLeaf entity.
+func (m *TransferMutation) RemovedTransferLeavesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtransfer_leaves {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// NodesIDs returns the "nodes" edge IDs in the mutation.
-func (m *TreeMutation) NodesIDs() (ids []uuid.UUID) {
-	for id := range m.nodes {
+// TransferLeavesIDs returns the "transfer_leaves" edge IDs in the mutation.
+func (m *TransferMutation) TransferLeavesIDs() (ids []uuid.UUID) {
+	for id := range m.transfer_leaves {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetNodes resets all changes to the "nodes" edge.
-func (m *TreeMutation) ResetNodes() {
-	m.nodes = nil
-	m.clearednodes = false
-	m.removednodes = nil
+// ResetTransferLeaves resets all changes to the "transfer_leaves" edge.
+func (m *TransferMutation) ResetTransferLeaves() {
+	m.transfer_leaves = nil
+	m.clearedtransfer_leaves = false
+	m.removedtransfer_leaves = nil
 }
 
-// Where appends a list predicates to the TreeMutation builder.
-func (m *TreeMutation) Where(ps ...predicate.Tree) {
+// SetPaymentIntentID sets the "payment_intent" edge to the PaymentIntent entity by id.
+func (m *TransferMutation) SetPaymentIntentID(id uuid.UUID) {
+	m.payment_intent = &id
+}
+
+// ClearPaymentIntent clears the "payment_intent" edge to the PaymentIntent entity.
+func (m *TransferMutation) ClearPaymentIntent() {
+	m.clearedpayment_intent = true
+}
+
+// PaymentIntentCleared reports if the "payment_intent" edge to the PaymentIntent entity was cleared.
+func (m *TransferMutation) PaymentIntentCleared() bool {
+	return m.clearedpayment_intent
+}
+
+// PaymentIntentID returns the "payment_intent" edge ID in the mutation.
+func (m *TransferMutation) PaymentIntentID() (id uuid.UUID, exists bool) {
+	if m.payment_intent != nil {
+		return *m.payment_intent, true
+	}
+	return
+}
+
+// PaymentIntentIDs returns the "payment_intent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PaymentIntentID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) PaymentIntentIDs() (ids []uuid.UUID) {
+	if id := m.payment_intent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPaymentIntent resets all changes to the "payment_intent" edge.
+func (m *TransferMutation) ResetPaymentIntent() {
+	m.payment_intent = nil
+	m.clearedpayment_intent = false
+}
+
+// SetSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by id.
+func (m *TransferMutation) SetSparkInvoiceID(id uuid.UUID) {
+	m.spark_invoice = &id
+}
+
+// ClearSparkInvoice clears the "spark_invoice" edge to the SparkInvoice entity.
+func (m *TransferMutation) ClearSparkInvoice() {
+	m.clearedspark_invoice = true
+}
+
+// SparkInvoiceCleared reports if the "spark_invoice" edge to the SparkInvoice entity was cleared.
+func (m *TransferMutation) SparkInvoiceCleared() bool {
+	return m.clearedspark_invoice
+}
+
+// SparkInvoiceID returns the "spark_invoice" edge ID in the mutation.
+func (m *TransferMutation) SparkInvoiceID() (id uuid.UUID, exists bool) {
+	if m.spark_invoice != nil {
+		return *m.spark_invoice, true
+	}
+	return
+}
+
+// SparkInvoiceIDs returns the "spark_invoice" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SparkInvoiceID instead. It exists only for internal usage by the builders.
+func (m *TransferMutation) SparkInvoiceIDs() (ids []uuid.UUID) {
+	if id := m.spark_invoice; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSparkInvoice resets all changes to the "spark_invoice" edge.
+func (m *TransferMutation) ResetSparkInvoice() {
+	m.spark_invoice = nil
+	m.clearedspark_invoice = false
+}
+
+// Where appends a list predicates to the TransferMutation builder.
+func (m *TransferMutation) Where(ps ...predicate.Transfer) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TreeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TransferMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TreeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tree, len(ps))
+func (m *TransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Transfer, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -19948,45 +20533,54 @@ func (m *TreeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TreeMutation) Op() Op {
+func (m *TransferMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TreeMutation) SetOp(op Op) {
+func (m *TransferMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tree).
-func (m *TreeMutation) Type() string {
+// Type returns the node type of this mutation (Transfer).
+func (m *TransferMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TreeMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+func (m *TransferMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.create_time != nil {
-		fields = append(fields, tree.FieldCreateTime)
+		fields = append(fields, transfer.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, tree.FieldUpdateTime)
+		fields = append(fields, transfer.FieldUpdateTime)
 	}
-	if m.owner_identity_pubkey != nil {
-		fields = append(fields, tree.FieldOwnerIdentityPubkey)
+	if m.sender_identity_pubkey != nil {
+		fields = append(fields, transfer.FieldSenderIdentityPubkey)
+	}
+	if m.receiver_identity_pubkey != nil {
+		fields = append(fields, transfer.FieldReceiverIdentityPubkey)
+	}
+	if m.total_value != nil {
+		fields = append(fields, transfer.FieldTotalValue)
 	}
 	if m.status != nil {
-		fields = append(fields, tree.FieldStatus)
+		fields = append(fields, transfer.FieldStatus)
 	}
-	if m.network != nil {
-		fields = append(fields, tree.FieldNetwork)
+	if m._type != nil {
+		fields = append(fields, transfer.FieldType)
 	}
-	if m.base_txid != nil {
-		fields = append(fields, tree.FieldBaseTxid)
+	if m.expiry_time != nil {
+		fields = append(fields, transfer.FieldExpiryTime)
 	}
-	if m.vout != nil {
-		fields = append(fields, tree.FieldVout)
+	if m.completion_time != nil {
+		fields = append(fields, transfer.FieldCompletionTime)
+	}
+	if m.batch_id != nil {
+		fields = append(fields, transfer.FieldBatchID)
 	}
 	return fields
 }
@@ -19994,22 +20588,28 @@ func (m *TreeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TreeMutation) Field(name string) (ent.Value, bool) {
+func (m *TransferMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case tree.FieldCreateTime:
+	case transfer.FieldCreateTime:
 		return m.CreateTime()
-	case tree.FieldUpdateTime:
+	case transfer.FieldUpdateTime:
 		return m.UpdateTime()
-	case tree.FieldOwnerIdentityPubkey:
-		return m.OwnerIdentityPubkey()
-	case tree.FieldStatus:
+	case transfer.FieldSenderIdentityPubkey:
+		return m.SenderIdentityPubkey()
+	case transfer.FieldReceiverIdentityPubkey:
+		return m.ReceiverIdentityPubkey()
+	case transfer.FieldTotalValue:
+		return m.TotalValue()
+	case transfer.FieldStatus:
 		return m.Status()
-	case tree.FieldNetwork:
-		return m.Network()
-	case tree.FieldBaseTxid:
-		return m.BaseTxid()
-	case tree.FieldVout:
-		return m.Vout()
+	case transfer.FieldType:
+		return m.GetType()
+	case transfer.FieldExpiryTime:
+		return m.ExpiryTime()
+	case transfer.FieldCompletionTime:
+		return m.CompletionTime()
+	case transfer.FieldBatchID:
+		return m.BatchID()
 	}
 	return nil, false
 }
@@ -20017,90 +20617,117 @@ func (m *TreeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TreeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tree.FieldCreateTime:
+	case transfer.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case tree.FieldUpdateTime:
+	case transfer.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case tree.FieldOwnerIdentityPubkey:
-		return m.OldOwnerIdentityPubkey(ctx)
-	case tree.FieldStatus:
+	case transfer.FieldSenderIdentityPubkey:
+		return m.OldSenderIdentityPubkey(ctx)
+	case transfer.FieldReceiverIdentityPubkey:
+		return m.OldReceiverIdentityPubkey(ctx)
+	case transfer.FieldTotalValue:
+		return m.OldTotalValue(ctx)
+	case transfer.FieldStatus:
 		return m.OldStatus(ctx)
-	case tree.FieldNetwork:
-		return m.OldNetwork(ctx)
-	case tree.FieldBaseTxid:
-		return m.OldBaseTxid(ctx)
-	case tree.FieldVout:
-		return m.OldVout(ctx)
+	case transfer.FieldType:
+		return m.OldType(ctx)
+	case transfer.FieldExpiryTime:
+		return m.OldExpiryTime(ctx)
+	case transfer.FieldCompletionTime:
+		return m.OldCompletionTime(ctx)
+	case transfer.FieldBatchID:
+		return m.OldBatchID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tree field %s", name)
+	return nil, fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TreeMutation) SetField(name string, value ent.Value) error {
+func (m *TransferMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tree.FieldCreateTime:
+	case transfer.FieldCreateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreateTime(v)
 		return nil
-	case tree.FieldUpdateTime:
+	case transfer.FieldUpdateTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdateTime(v)
 		return nil
-	case tree.FieldOwnerIdentityPubkey:
+	case transfer.FieldSenderIdentityPubkey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOwnerIdentityPubkey(v)
+		m.SetSenderIdentityPubkey(v)
 		return nil
-	case tree.FieldStatus:
-		v, ok := value.(schematype.TreeStatus)
+	case transfer.FieldReceiverIdentityPubkey:
+		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetReceiverIdentityPubkey(v)
 		return nil
-	case tree.FieldNetwork:
-		v, ok := value.(schematype.Network)
+	case transfer.FieldTotalValue:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNetwork(v)
+		m.SetTotalValue(v)
 		return nil
-	case tree.FieldBaseTxid:
-		v, ok := value.([]byte)
+	case transfer.FieldStatus:
+		v, ok := value.(schematype.TransferStatus)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBaseTxid(v)
+		m.SetStatus(v)
 		return nil
-	case tree.FieldVout:
-		v, ok := value.(int16)
+	case transfer.FieldType:
+		v, ok := value.(schematype.TransferType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVout(v)
+		m.SetType(v)
+		return nil
+	case transfer.FieldExpiryTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiryTime(v)
+		return nil
+	case transfer.FieldCompletionTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletionTime(v)
+		return nil
+	case transfer.FieldBatchID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBatchID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tree field %s", name)
+	return fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TreeMutation) AddedFields() []string {
+func (m *TransferMutation) AddedFields() []string {
 	var fields []string
-	if m.addvout != nil {
-		fields = append(fields, tree.FieldVout)
+	if m.addtotal_value != nil {
+		fields = append(fields, transfer.FieldTotalValue)
 	}
 	return fields
 }
@@ -20108,10 +20735,10 @@ func (m *TreeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TreeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TransferMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case tree.FieldVout:
-		return m.AddedVout()
+	case transfer.FieldTotalValue:
+		return m.AddedTotalValue()
 	}
 	return nil, false
 }
@@ -20119,113 +20746,144 @@ func (m *TreeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TreeMutation) AddField(name string, value ent.Value) error {
+func (m *TransferMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case tree.FieldVout:
-		v, ok := value.(int16)
+	case transfer.FieldTotalValue:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddVout(v)
+		m.AddTotalValue(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tree numeric field %s", name)
+	return fmt.Errorf("unknown Transfer numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TreeMutation) ClearedFields() []string {
-	return nil
+func (m *TransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transfer.FieldCompletionTime) {
+		fields = append(fields, transfer.FieldCompletionTime)
+	}
+	if m.FieldCleared(transfer.FieldBatchID) {
+		fields = append(fields, transfer.FieldBatchID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TreeMutation) FieldCleared(name string) bool {
+func (m *TransferMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TreeMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Tree nullable field %s", name)
+func (m *TransferMutation) ClearField(name string) error {
+	switch name {
+	case transfer.FieldCompletionTime:
+		m.ClearCompletionTime()
+		return nil
+	case transfer.FieldBatchID:
+		m.ClearBatchID()
+		return nil
+	}
+	return fmt.Errorf("unknown Transfer nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TreeMutation) ResetField(name string) error {
+func (m *TransferMutation) ResetField(name string) error {
 	switch name {
-	case tree.FieldCreateTime:
+	case transfer.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case tree.FieldUpdateTime:
+	case transfer.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case tree.FieldOwnerIdentityPubkey:
-		m.ResetOwnerIdentityPubkey()
+	case transfer.FieldSenderIdentityPubkey:
+		m.ResetSenderIdentityPubkey()
 		return nil
-	case tree.FieldStatus:
+	case transfer.FieldReceiverIdentityPubkey:
+		m.ResetReceiverIdentityPubkey()
+		return nil
+	case transfer.FieldTotalValue:
+		m.ResetTotalValue()
+		return nil
+	case transfer.FieldStatus:
 		m.ResetStatus()
 		return nil
-	case tree.FieldNetwork:
-		m.ResetNetwork()
+	case transfer.FieldType:
+		m.ResetType()
 		return nil
-	case tree.FieldBaseTxid:
-		m.ResetBaseTxid()
+	case transfer.FieldExpiryTime:
+		m.ResetExpiryTime()
 		return nil
-	case tree.FieldVout:
-		m.ResetVout()
+	case transfer.FieldCompletionTime:
+		m.ResetCompletionTime()
+		return nil
+	case transfer.FieldBatchID:
+		m.ResetBatchID()
 		return nil
 	}
-	return fmt.Errorf("unknown Tree field %s", name)
+	return fmt.Errorf("unknown Transfer field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TreeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.root != nil {
-		edges = append(edges, tree.EdgeRoot)
+func (m *TransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.transfer_leaves != nil {
+		edges = append(edges, transfer.EdgeTransferLeaves)
 	}
-	if m.nodes != nil {
-		edges = append(edges, tree.EdgeNodes)
+	if m.payment_intent != nil {
+		edges = append(edges, transfer.EdgePaymentIntent)
+	}
+	if m.spark_invoice != nil {
+		edges = append(edges, transfer.EdgeSparkInvoice)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TreeMutation) AddedIDs(name string) []ent.Value {
+func (m *TransferMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tree.EdgeRoot:
-		if id := m.root; id != nil {
-			return []ent.Value{*id}
-		}
-	case tree.EdgeNodes:
-		ids := make([]ent.Value, 0, len(m.nodes))
-		for id := range m.nodes {
+	case transfer.EdgeTransferLeaves:
+		ids := make([]ent.Value, 0, len(m.transfer_leaves))
+		for id := range m.transfer_leaves {
 			ids = append(ids, id)
 		}
 		return ids
+	case transfer.EdgePaymentIntent:
+		if id := m.payment_intent; id != nil {
+			return []ent.Value{*id}
+		}
+	case transfer.EdgeSparkInvoice:
+		if id := m.spark_invoice; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TreeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removednodes != nil {
-		edges = append(edges, tree.EdgeNodes)
+func (m *TransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedtransfer_leaves != nil {
+		edges = append(edges, transfer.EdgeTransferLeaves)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TreeMutation) RemovedIDs(name string) []ent.Value {
+func (m *TransferMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case tree.EdgeNodes:
-		ids := make([]ent.Value, 0, len(m.removednodes))
-		for id := range m.removednodes {
+	case transfer.EdgeTransferLeaves:
+		ids := make([]ent.Value, 0, len(m.removedtransfer_leaves))
+		for id := range m.removedtransfer_leaves {
 			ids = append(ids, id)
 		}
 		return ids
@@ -20234,105 +20892,105 @@ func (m *TreeMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TreeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedroot {
-		edges = append(edges, tree.EdgeRoot)
+func (m *TransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedtransfer_leaves {
+		edges = append(edges, transfer.EdgeTransferLeaves)
 	}
-	if m.clearednodes {
-		edges = append(edges, tree.EdgeNodes)
+	if m.clearedpayment_intent {
+		edges = append(edges, transfer.EdgePaymentIntent)
+	}
+	if m.clearedspark_invoice {
+		edges = append(edges, transfer.EdgeSparkInvoice)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TreeMutation) EdgeCleared(name string) bool {
+func (m *TransferMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tree.EdgeRoot:
-		return m.clearedroot
-	case tree.EdgeNodes:
-		return m.clearednodes
+	case transfer.EdgeTransferLeaves:
+		return m.clearedtransfer_leaves
+	case transfer.EdgePaymentIntent:
+		return m.clearedpayment_intent
+	case transfer.EdgeSparkInvoice:
+		return m.clearedspark_invoice
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TreeMutation) ClearEdge(name string) error {
+func (m *TransferMutation) ClearEdge(name string) error {
 	switch name {
-	case tree.EdgeRoot:
-		m.ClearRoot()
+	case transfer.EdgePaymentIntent:
+		m.ClearPaymentIntent()
+		return nil
+	case transfer.EdgeSparkInvoice:
+		m.ClearSparkInvoice()
 		return nil
 	}
-	return fmt.Errorf("unknown Tree unique edge %s", name)
+	return fmt.Errorf("unknown Transfer unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TreeMutation) ResetEdge(name string) error {
+func (m *TransferMutation) ResetEdge(name string) error {
 	switch name {
-	case tree.EdgeRoot:
-		m.ResetRoot()
+	case transfer.EdgeTransferLeaves:
+		m.ResetTransferLeaves()
 		return nil
-	case tree.EdgeNodes:
-		m.ResetNodes()
+	case transfer.EdgePaymentIntent:
+		m.ResetPaymentIntent()
+		return nil
+	case transfer.EdgeSparkInvoice:
+		m.ResetSparkInvoice()
 		return nil
 	}
-	return fmt.Errorf("unknown Tree edge %s", name)
+	return fmt.Errorf("unknown Transfer edge %s", name)
 }
 
-// TreeNodeMutation represents an operation that mutates the TreeNode nodes in the graph.
-type TreeNodeMutation struct {
+// TransferLeafMutation represents an operation that mutates the TransferLeaf nodes in the graph.
+type TransferLeafMutation struct {
 	config
-	op                            Op
-	typ                           string
-	id                            *uuid.UUID
-	create_time                   *time.Time
-	update_time                   *time.Time
-	value                         *uint64
-	addvalue                      *int64
-	status                        *schematype.TreeNodeStatus
-	verifying_pubkey              *[]byte
-	owner_identity_pubkey         *[]byte
-	owner_signing_pubkey          *[]byte
-	raw_tx                        *[]byte
-	vout                          *int16
-	addvout                       *int16
-	raw_refund_tx                 *[]byte
-	node_confirmation_height      *uint64
-	addnode_confirmation_height   *int64
-	refund_confirmation_height    *uint64
-	addrefund_confirmation_height *int64
-	direct_refund_tx              *[]byte
-	direct_tx                     *[]byte
-	direct_from_cpfp_refund_tx    *[]byte
-	clearedFields                 map[string]struct{}
-	tree                          *uuid.UUID
-	clearedtree                   bool
-	parent                        *uuid.UUID
-	clearedparent                 bool
-	signing_keyshare              *uuid.UUID
-	clearedsigning_keyshare       bool
-	children                      map[uuid.UUID]struct{}
-	removedchildren               map[uuid.UUID]struct{}
-	clearedchildren               bool
-	done                          bool
-	oldValue                      func(context.Context) (*TreeNode, error)
-	predicates                    []predicate.TreeNode
-}
-
-var _ ent.Mutation = (*TreeNodeMutation)(nil)
-
-// treenodeOption allows management of the mutation configuration using functional options.
-type treenodeOption func(*TreeNodeMutation)
-
-// newTreeNodeMutation creates new mutation for the TreeNode entity.
-func newTreeNodeMutation(c config, op Op, opts ...treenodeOption) *TreeNodeMutation {
-	m := &TreeNodeMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeTreeNode,
+	op                                      Op
+	typ                                     string
+	id                                      *uuid.UUID
+	create_time                             *time.Time
+	update_time                             *time.Time
+	secret_cipher                           *[]byte
+	signature                               *[]byte
+	previous_refund_tx                      *[]byte
+	previous_direct_refund_tx               *[]byte
+	previous_direct_from_cpfp_refund_tx     *[]byte
+	intermediate_refund_tx                  *[]byte
+	intermediate_direct_refund_tx           *[]byte
+	intermediate_direct_from_cpfp_refund_tx *[]byte
+	key_tweak                               *[]byte
+	sender_key_tweak_proof                  *[]byte
+	receiver_key_tweak                      *[]byte
+	clearedFields                           map[string]struct{}
+	transfer                                *uuid.UUID
+	clearedtransfer                         bool
+	leaf                                    *uuid.UUID
+	clearedleaf                             bool
+	done                                    bool
+	oldValue                                func(context.Context) (*TransferLeaf, error)
+	predicates                              []predicate.TransferLeaf
+}
+
+var _ ent.Mutation = (*TransferLeafMutation)(nil)
+
+// transferleafOption allows management of the mutation configuration using functional options.
+type transferleafOption func(*TransferLeafMutation)
+
+// newTransferLeafMutation creates new mutation for the TransferLeaf entity.
+func newTransferLeafMutation(c config, op Op, opts ...transferleafOption) *TransferLeafMutation {
+	m := &TransferLeafMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTransferLeaf,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -20341,20 +20999,20 @@ func newTreeNodeMutation(c config, op Op, opts ...treenodeOption) *TreeNodeMutat
 	return m
 }
 
-// withTreeNodeID sets the ID field of the mutation.
-func withTreeNodeID(id uuid.UUID) treenodeOption {
-	return func(m *TreeNodeMutation) {
+// withTransferLeafID sets the ID field of the mutation.
+func withTransferLeafID(id uuid.UUID) transferleafOption {
+	return func(m *TransferLeafMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *TreeNode
+			value *TransferLeaf
 		)
-		m.oldValue = func(ctx context.Context) (*TreeNode, error) {
+		m.oldValue = func(ctx context.Context) (*TransferLeaf, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().TreeNode.Get(ctx, id)
+					value, err = m.Client().TransferLeaf.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -20363,10 +21021,10 @@ func withTreeNodeID(id uuid.UUID) treenodeOption {
 	}
 }
 
-// withTreeNode sets the old TreeNode of the mutation.
-func withTreeNode(node *TreeNode) treenodeOption {
-	return func(m *TreeNodeMutation) {
-		m.oldValue = func(context.Context) (*TreeNode, error) {
+// withTransferLeaf sets the old TransferLeaf of the mutation.
+func withTransferLeaf(node *TransferLeaf) transferleafOption {
+	return func(m *TransferLeafMutation) {
+		m.oldValue = func(context.Context) (*TransferLeaf, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -20375,7 +21033,7 @@ func withTreeNode(node *TreeNode) treenodeOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TreeNodeMutation) Client() *Client {
+func (m TransferLeafMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -20383,7 +21041,7 @@ func (m TreeNodeMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TreeNodeMutation) Tx() (*Tx, error) {
+func (m TransferLeafMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -20393,14 +21051,14 @@ func (m TreeNodeMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of TreeNode entities.
-func (m *TreeNodeMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of TransferLeaf entities.
+func (m *TransferLeafMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TreeNodeMutation) ID() (id uuid.UUID, exists bool) {
+func (m *TransferLeafMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -20411,7 +21069,7 @@ func (m *TreeNodeMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TreeNodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *TransferLeafMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -20420,19 +21078,19 @@ func (m *TreeNodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().TreeNode.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TransferLeaf.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreateTime sets the "create_time" field.
-func (m *TreeNodeMutation) SetCreateTime(t time.Time) {
+func (m *TransferLeafMutation) SetCreateTime(t time.Time) {
 	m.create_time = &t
 }
 
 // CreateTime returns the value of the "create_time" field in the mutation.
-func (m *TreeNodeMutation) CreateTime() (r time.Time, exists bool) {
+func (m *TransferLeafMutation) CreateTime() (r time.Time, exists bool) {
 	v := m.create_time
 	if v == nil {
 		return
@@ -20440,10 +21098,10 @@ func (m *TreeNodeMutation) CreateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreateTime returns the old "create_time" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TransferLeafMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
@@ -20458,17 +21116,17 @@ func (m *TreeNodeMutation) OldCreateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreateTime resets all changes to the "create_time" field.
-func (m *TreeNodeMutation) ResetCreateTime() {
+func (m *TransferLeafMutation) ResetCreateTime() {
 	m.create_time = nil
 }
 
 // SetUpdateTime sets the "update_time" field.
-func (m *TreeNodeMutation) SetUpdateTime(t time.Time) {
+func (m *TransferLeafMutation) SetUpdateTime(t time.Time) {
 	m.update_time = &t
 }
 
 // UpdateTime returns the value of the "update_time" field in the mutation.
-func (m *TreeNodeMutation) UpdateTime() (r time.Time, exists bool) {
+func (m *TransferLeafMutation) UpdateTime() (r time.Time, exists bool) {
 	v := m.update_time
 	if v == nil {
 		return
@@ -20476,10 +21134,10 @@ func (m *TreeNodeMutation) UpdateTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdateTime returns the old "update_time" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+func (m *TransferLeafMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
@@ -20494,818 +21152,4419 @@ func (m *TreeNodeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdateTime resets all changes to the "update_time" field.
-func (m *TreeNodeMutation) ResetUpdateTime() {
+func (m *TransferLeafMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
-// SetValue sets the "value" field.
-func (m *TreeNodeMutation) SetValue(u uint64) {
-	m.value = &u
-	m.addvalue = nil
+// SetSecretCipher sets the "secret_cipher" field.
+func (m *TransferLeafMutation) SetSecretCipher(b []byte) {
+	m.secret_cipher = &b
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *TreeNodeMutation) Value() (r uint64, exists bool) {
-	v := m.value
+// SecretCipher returns the value of the "secret_cipher" field in the mutation.
+func (m *TransferLeafMutation) SecretCipher() (r []byte, exists bool) {
+	v := m.secret_cipher
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretCipher returns the old "secret_cipher" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldValue(ctx context.Context) (v uint64, err error) {
+func (m *TransferLeafMutation) OldSecretCipher(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretCipher is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldSecretCipher requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretCipher: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.SecretCipher, nil
 }
 
-// AddValue adds u to the "value" field.
-func (m *TreeNodeMutation) AddValue(u int64) {
-	if m.addvalue != nil {
-		*m.addvalue += u
-	} else {
-		m.addvalue = &u
+// ClearSecretCipher clears the value of the "secret_cipher" field.
+func (m *TransferLeafMutation) ClearSecretCipher() {
+	m.secret_cipher = nil
+	m.clearedFields[transferleaf.FieldSecretCipher] = struct{}{}
+}
+
+// SecretCipherCleared returns if the "secret_cipher" field was cleared in this mutation.
+func (m *TransferLeafMutation) SecretCipherCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldSecretCipher]
+	return ok
+}
+
+// ResetSecretCipher resets all changes to the "secret_cipher" field.
+func (m *TransferLeafMutation) ResetSecretCipher() {
+	m.secret_cipher = nil
+	delete(m.clearedFields, transferleaf.FieldSecretCipher)
+}
+
+// SetSignature sets the "signature" field.
+func (m *TransferLeafMutation) SetSignature(b []byte) {
+	m.signature = &b
+}
+
+// Signature returns the value of the "signature" field in the mutation.
+func (m *TransferLeafMutation) Signature() (r []byte, exists bool) {
+	v := m.signature
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// AddedValue returns the value that was added to the "value" field in this mutation.
-func (m *TreeNodeMutation) AddedValue() (r int64, exists bool) {
-	v := m.addvalue
+// OldSignature returns the old "signature" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldSignature(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSignature is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSignature requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSignature: %w", err)
+	}
+	return oldValue.Signature, nil
+}
+
+// ClearSignature clears the value of the "signature" field.
+func (m *TransferLeafMutation) ClearSignature() {
+	m.signature = nil
+	m.clearedFields[transferleaf.FieldSignature] = struct{}{}
+}
+
+// SignatureCleared returns if the "signature" field was cleared in this mutation.
+func (m *TransferLeafMutation) SignatureCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldSignature]
+	return ok
+}
+
+// ResetSignature resets all changes to the "signature" field.
+func (m *TransferLeafMutation) ResetSignature() {
+	m.signature = nil
+	delete(m.clearedFields, transferleaf.FieldSignature)
+}
+
+// SetPreviousRefundTx sets the "previous_refund_tx" field.
+func (m *TransferLeafMutation) SetPreviousRefundTx(b []byte) {
+	m.previous_refund_tx = &b
+}
+
+// PreviousRefundTx returns the value of the "previous_refund_tx" field in the mutation.
+func (m *TransferLeafMutation) PreviousRefundTx() (r []byte, exists bool) {
+	v := m.previous_refund_tx
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *TreeNodeMutation) ResetValue() {
-	m.value = nil
-	m.addvalue = nil
+// OldPreviousRefundTx returns the old "previous_refund_tx" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldPreviousRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousRefundTx: %w", err)
+	}
+	return oldValue.PreviousRefundTx, nil
 }
 
-// SetStatus sets the "status" field.
-func (m *TreeNodeMutation) SetStatus(sns schematype.TreeNodeStatus) {
-	m.status = &sns
+// ResetPreviousRefundTx resets all changes to the "previous_refund_tx" field.
+func (m *TransferLeafMutation) ResetPreviousRefundTx() {
+	m.previous_refund_tx = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *TreeNodeMutation) Status() (r schematype.TreeNodeStatus, exists bool) {
-	v := m.status
+// SetPreviousDirectRefundTx sets the "previous_direct_refund_tx" field.
+func (m *TransferLeafMutation) SetPreviousDirectRefundTx(b []byte) {
+	m.previous_direct_refund_tx = &b
+}
+
+// PreviousDirectRefundTx returns the value of the "previous_direct_refund_tx" field in the mutation.
+func (m *TransferLeafMutation) PreviousDirectRefundTx() (r []byte, exists bool) {
+	v := m.previous_direct_refund_tx
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldPreviousDirectRefundTx returns the old "previous_direct_refund_tx" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldStatus(ctx context.Context) (v schematype.TreeNodeStatus, err error) {
+func (m *TransferLeafMutation) OldPreviousDirectRefundTx(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldPreviousDirectRefundTx is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldPreviousDirectRefundTx requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldPreviousDirectRefundTx: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.PreviousDirectRefundTx, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *TreeNodeMutation) ResetStatus() {
-	m.status = nil
+// ClearPreviousDirectRefundTx clears the value of the "previous_direct_refund_tx" field.
+func (m *TransferLeafMutation) ClearPreviousDirectRefundTx() {
+	m.previous_direct_refund_tx = nil
+	m.clearedFields[transferleaf.FieldPreviousDirectRefundTx] = struct{}{}
+}
+
+// PreviousDirectRefundTxCleared returns if the "previous_direct_refund_tx" field was cleared in this mutation.
+func (m *TransferLeafMutation) PreviousDirectRefundTxCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldPreviousDirectRefundTx]
+	return ok
+}
+
+// ResetPreviousDirectRefundTx resets all changes to the "previous_direct_refund_tx" field.
+func (m *TransferLeafMutation) ResetPreviousDirectRefundTx() {
+	m.previous_direct_refund_tx = nil
+	delete(m.clearedFields, transferleaf.FieldPreviousDirectRefundTx)
+}
+
+// SetPreviousDirectFromCpfpRefundTx sets the "previous_direct_from_cpfp_refund_tx" field.
+func (m *TransferLeafMutation) SetPreviousDirectFromCpfpRefundTx(b []byte) {
+	m.previous_direct_from_cpfp_refund_tx = &b
+}
+
+// PreviousDirectFromCpfpRefundTx returns the value of the "previous_direct_from_cpfp_refund_tx" field in the mutation.
+func (m *TransferLeafMutation) PreviousDirectFromCpfpRefundTx() (r []byte, exists bool) {
+	v := m.previous_direct_from_cpfp_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousDirectFromCpfpRefundTx returns the old "previous_direct_from_cpfp_refund_tx" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldPreviousDirectFromCpfpRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousDirectFromCpfpRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousDirectFromCpfpRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousDirectFromCpfpRefundTx: %w", err)
+	}
+	return oldValue.PreviousDirectFromCpfpRefundTx, nil
+}
+
+// ClearPreviousDirectFromCpfpRefundTx clears the value of the "previous_direct_from_cpfp_refund_tx" field.
+func (m *TransferLeafMutation) ClearPreviousDirectFromCpfpRefundTx() {
+	m.previous_direct_from_cpfp_refund_tx = nil
+	m.clearedFields[transferleaf.FieldPreviousDirectFromCpfpRefundTx] = struct{}{}
+}
+
+// PreviousDirectFromCpfpRefundTxCleared returns if the "previous_direct_from_cpfp_refund_tx" field was cleared in this mutation.
+func (m *TransferLeafMutation) PreviousDirectFromCpfpRefundTxCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldPreviousDirectFromCpfpRefundTx]
+	return ok
+}
+
+// ResetPreviousDirectFromCpfpRefundTx resets all changes to the "previous_direct_from_cpfp_refund_tx" field.
+func (m *TransferLeafMutation) ResetPreviousDirectFromCpfpRefundTx() {
+	m.previous_direct_from_cpfp_refund_tx = nil
+	delete(m.clearedFields, transferleaf.FieldPreviousDirectFromCpfpRefundTx)
+}
+
+// SetIntermediateRefundTx sets the "intermediate_refund_tx" field.
+func (m *TransferLeafMutation) SetIntermediateRefundTx(b []byte) {
+	m.intermediate_refund_tx = &b
+}
+
+// IntermediateRefundTx returns the value of the "intermediate_refund_tx" field in the mutation.
+func (m *TransferLeafMutation) IntermediateRefundTx() (r []byte, exists bool) {
+	v := m.intermediate_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntermediateRefundTx returns the old "intermediate_refund_tx" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldIntermediateRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntermediateRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntermediateRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntermediateRefundTx: %w", err)
+	}
+	return oldValue.IntermediateRefundTx, nil
+}
+
+// ResetIntermediateRefundTx resets all changes to the "intermediate_refund_tx" field.
+func (m *TransferLeafMutation) ResetIntermediateRefundTx() {
+	m.intermediate_refund_tx = nil
+}
+
+// SetIntermediateDirectRefundTx sets the "intermediate_direct_refund_tx" field.
+func (m *TransferLeafMutation) SetIntermediateDirectRefundTx(b []byte) {
+	m.intermediate_direct_refund_tx = &b
+}
+
+// IntermediateDirectRefundTx returns the value of the "intermediate_direct_refund_tx" field in the mutation.
+func (m *TransferLeafMutation) IntermediateDirectRefundTx() (r []byte, exists bool) {
+	v := m.intermediate_direct_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntermediateDirectRefundTx returns the old "intermediate_direct_refund_tx" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldIntermediateDirectRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntermediateDirectRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntermediateDirectRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntermediateDirectRefundTx: %w", err)
+	}
+	return oldValue.IntermediateDirectRefundTx, nil
+}
+
+// ClearIntermediateDirectRefundTx clears the value of the "intermediate_direct_refund_tx" field.
+func (m *TransferLeafMutation) ClearIntermediateDirectRefundTx() {
+	m.intermediate_direct_refund_tx = nil
+	m.clearedFields[transferleaf.FieldIntermediateDirectRefundTx] = struct{}{}
+}
+
+// IntermediateDirectRefundTxCleared returns if the "intermediate_direct_refund_tx" field was cleared in this mutation.
+func (m *TransferLeafMutation) IntermediateDirectRefundTxCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldIntermediateDirectRefundTx]
+	return ok
+}
+
+// ResetIntermediateDirectRefundTx resets all changes to the "intermediate_direct_refund_tx" field.
+func (m *TransferLeafMutation) ResetIntermediateDirectRefundTx() {
+	m.intermediate_direct_refund_tx = nil
+	delete(m.clearedFields, transferleaf.FieldIntermediateDirectRefundTx)
+}
+
+// SetIntermediateDirectFromCpfpRefundTx sets the "intermediate_direct_from_cpfp_refund_tx" field.
+func (m *TransferLeafMutation) SetIntermediateDirectFromCpfpRefundTx(b []byte) {
+	m.intermediate_direct_from_cpfp_refund_tx = &b
+}
+
+// IntermediateDirectFromCpfpRefundTx returns the value of the "intermediate_direct_from_cpfp_refund_tx" field in the mutation.
+func (m *TransferLeafMutation) IntermediateDirectFromCpfpRefundTx() (r []byte, exists bool) {
+	v := m.intermediate_direct_from_cpfp_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntermediateDirectFromCpfpRefundTx returns the old "intermediate_direct_from_cpfp_refund_tx" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldIntermediateDirectFromCpfpRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntermediateDirectFromCpfpRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntermediateDirectFromCpfpRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntermediateDirectFromCpfpRefundTx: %w", err)
+	}
+	return oldValue.IntermediateDirectFromCpfpRefundTx, nil
+}
+
+// ClearIntermediateDirectFromCpfpRefundTx clears the value of the "intermediate_direct_from_cpfp_refund_tx" field.
+func (m *TransferLeafMutation) ClearIntermediateDirectFromCpfpRefundTx() {
+	m.intermediate_direct_from_cpfp_refund_tx = nil
+	m.clearedFields[transferleaf.FieldIntermediateDirectFromCpfpRefundTx] = struct{}{}
+}
+
+// IntermediateDirectFromCpfpRefundTxCleared returns if the "intermediate_direct_from_cpfp_refund_tx" field was cleared in this mutation.
+func (m *TransferLeafMutation) IntermediateDirectFromCpfpRefundTxCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldIntermediateDirectFromCpfpRefundTx]
+	return ok
+}
+
+// ResetIntermediateDirectFromCpfpRefundTx resets all changes to the "intermediate_direct_from_cpfp_refund_tx" field.
+func (m *TransferLeafMutation) ResetIntermediateDirectFromCpfpRefundTx() {
+	m.intermediate_direct_from_cpfp_refund_tx = nil
+	delete(m.clearedFields, transferleaf.FieldIntermediateDirectFromCpfpRefundTx)
+}
+
+// SetKeyTweak sets the "key_tweak" field.
+func (m *TransferLeafMutation) SetKeyTweak(b []byte) {
+	m.key_tweak = &b
+}
+
+// KeyTweak returns the value of the "key_tweak" field in the mutation.
+func (m *TransferLeafMutation) KeyTweak() (r []byte, exists bool) {
+	v := m.key_tweak
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyTweak returns the old "key_tweak" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldKeyTweak(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyTweak is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyTweak requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyTweak: %w", err)
+	}
+	return oldValue.KeyTweak, nil
+}
+
+// ClearKeyTweak clears the value of the "key_tweak" field.
+func (m *TransferLeafMutation) ClearKeyTweak() {
+	m.key_tweak = nil
+	m.clearedFields[transferleaf.FieldKeyTweak] = struct{}{}
+}
+
+// KeyTweakCleared returns if the "key_tweak" field was cleared in this mutation.
+func (m *TransferLeafMutation) KeyTweakCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldKeyTweak]
+	return ok
+}
+
+// ResetKeyTweak resets all changes to the "key_tweak" field.
+func (m *TransferLeafMutation) ResetKeyTweak() {
+	m.key_tweak = nil
+	delete(m.clearedFields, transferleaf.FieldKeyTweak)
+}
+
+// SetSenderKeyTweakProof sets the "sender_key_tweak_proof" field.
+func (m *TransferLeafMutation) SetSenderKeyTweakProof(b []byte) {
+	m.sender_key_tweak_proof = &b
+}
+
+// SenderKeyTweakProof returns the value of the "sender_key_tweak_proof" field in the mutation.
+func (m *TransferLeafMutation) SenderKeyTweakProof() (r []byte, exists bool) {
+	v := m.sender_key_tweak_proof
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSenderKeyTweakProof returns the old "sender_key_tweak_proof" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldSenderKeyTweakProof(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSenderKeyTweakProof is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSenderKeyTweakProof requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSenderKeyTweakProof: %w", err)
+	}
+	return oldValue.SenderKeyTweakProof, nil
+}
+
+// ClearSenderKeyTweakProof clears the value of the "sender_key_tweak_proof" field.
+func (m *TransferLeafMutation) ClearSenderKeyTweakProof() {
+	m.sender_key_tweak_proof = nil
+	m.clearedFields[transferleaf.FieldSenderKeyTweakProof] = struct{}{}
+}
+
+// SenderKeyTweakProofCleared returns if the "sender_key_tweak_proof" field was cleared in this mutation.
+func (m *TransferLeafMutation) SenderKeyTweakProofCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldSenderKeyTweakProof]
+	return ok
+}
+
+// ResetSenderKeyTweakProof resets all changes to the "sender_key_tweak_proof" field.
+func (m *TransferLeafMutation) ResetSenderKeyTweakProof() {
+	m.sender_key_tweak_proof = nil
+	delete(m.clearedFields, transferleaf.FieldSenderKeyTweakProof)
+}
+
+// SetReceiverKeyTweak sets the "receiver_key_tweak" field.
+func (m *TransferLeafMutation) SetReceiverKeyTweak(b []byte) {
+	m.receiver_key_tweak = &b
+}
+
+// ReceiverKeyTweak returns the value of the "receiver_key_tweak" field in the mutation.
+func (m *TransferLeafMutation) ReceiverKeyTweak() (r []byte, exists bool) {
+	v := m.receiver_key_tweak
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiverKeyTweak returns the old "receiver_key_tweak" field's value of the TransferLeaf entity.
+// If the TransferLeaf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransferLeafMutation) OldReceiverKeyTweak(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiverKeyTweak is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiverKeyTweak requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiverKeyTweak: %w", err)
+	}
+	return oldValue.ReceiverKeyTweak, nil
+}
+
+// ClearReceiverKeyTweak clears the value of the "receiver_key_tweak" field.
+func (m *TransferLeafMutation) ClearReceiverKeyTweak() {
+	m.receiver_key_tweak = nil
+	m.clearedFields[transferleaf.FieldReceiverKeyTweak] = struct{}{}
+}
+
+// ReceiverKeyTweakCleared returns if the "receiver_key_tweak" field was cleared in this mutation.
+func (m *TransferLeafMutation) ReceiverKeyTweakCleared() bool {
+	_, ok := m.clearedFields[transferleaf.FieldReceiverKeyTweak]
+	return ok
+}
+
+// ResetReceiverKeyTweak resets all changes to the "receiver_key_tweak" field.
+func (m *TransferLeafMutation) ResetReceiverKeyTweak() {
+	m.receiver_key_tweak = nil
+	delete(m.clearedFields, transferleaf.FieldReceiverKeyTweak)
+}
+
+// SetTransferID sets the "transfer" edge to the Transfer entity by id.
+func (m *TransferLeafMutation) SetTransferID(id uuid.UUID) {
+	m.transfer = &id
+}
+
+// ClearTransfer clears the "transfer" edge to the Transfer entity.
+func (m *TransferLeafMutation) ClearTransfer() {
+	m.clearedtransfer = true
+}
+
+// TransferCleared reports if the "transfer" edge to the Transfer entity was cleared.
+func (m *TransferLeafMutation) TransferCleared() bool {
+	return m.clearedtransfer
+}
+
+// TransferID returns the "transfer" edge ID in the mutation.
+func (m *TransferLeafMutation) TransferID() (id uuid.UUID, exists bool) {
+	if m.transfer != nil {
+		return *m.transfer, true
+	}
+	return
+}
+
+// TransferIDs returns the "transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransferID instead. It exists only for internal usage by the builders.
+func (m *TransferLeafMutation) TransferIDs() (ids []uuid.UUID) {
+	if id := m.transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransfer resets all changes to the "transfer" edge.
+func (m *TransferLeafMutation) ResetTransfer() {
+	m.transfer = nil
+	m.clearedtransfer = false
+}
+
+// SetLeafID sets the "leaf" edge to the TreeNode entity by id.
+func (m *TransferLeafMutation) SetLeafID(id uuid.UUID) {
+	m.leaf = &id
+}
+
+// ClearLeaf clears the "leaf" edge to the TreeNode entity.
+func (m *TransferLeafMutation) ClearLeaf() {
+	m.clearedleaf = true
+}
+
+// LeafCleared reports if the "leaf" edge to the TreeNode entity was cleared.
+func (m *TransferLeafMutation) LeafCleared() bool {
+	return m.clearedleaf
+}
+
+// LeafID returns the "leaf" edge ID in the mutation.
+func (m *TransferLeafMutation) LeafID() (id uuid.UUID, exists bool) {
+	if m.leaf != nil {
+		return *m.leaf, true
+	}
+	return
+}
+
+// LeafIDs returns the "leaf" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LeafID instead. It exists only for internal usage by the builders.
+func (m *TransferLeafMutation) LeafIDs() (ids []uuid.UUID) {
+	if id := m.leaf; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLeaf resets all changes to the "leaf" edge.
+func (m *TransferLeafMutation) ResetLeaf() {
+	m.leaf = nil
+	m.clearedleaf = false
+}
+
+// Where appends a list predicates to the TransferLeafMutation builder.
+func (m *TransferLeafMutation) Where(ps ...predicate.TransferLeaf) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TransferLeafMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TransferLeafMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TransferLeaf, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TransferLeafMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TransferLeafMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TransferLeaf).
+func (m *TransferLeafMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TransferLeafMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, transferleaf.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, transferleaf.FieldUpdateTime)
+	}
+	if m.secret_cipher != nil {
+		fields = append(fields, transferleaf.FieldSecretCipher)
+	}
+	if m.signature != nil {
+		fields = append(fields, transferleaf.FieldSignature)
+	}
+	if m.previous_refund_tx != nil {
+		fields = append(fields, transferleaf.FieldPreviousRefundTx)
+	}
+	if m.previous_direct_refund_tx != nil {
+		fields = append(fields, transferleaf.FieldPreviousDirectRefundTx)
+	}
+	if m.previous_direct_from_cpfp_refund_tx != nil {
+		fields = append(fields, transferleaf.FieldPreviousDirectFromCpfpRefundTx)
+	}
+	if m.intermediate_refund_tx != nil {
+		fields = append(fields, transferleaf.FieldIntermediateRefundTx)
+	}
+	if m.intermediate_direct_refund_tx != nil {
+		fields = append(fields, transferleaf.FieldIntermediateDirectRefundTx)
+	}
+	if m.intermediate_direct_from_cpfp_refund_tx != nil {
+		fields = append(fields, transferleaf.FieldIntermediateDirectFromCpfpRefundTx)
+	}
+	if m.key_tweak != nil {
+		fields = append(fields, transferleaf.FieldKeyTweak)
+	}
+	if m.sender_key_tweak_proof != nil {
+		fields = append(fields, transferleaf.FieldSenderKeyTweakProof)
+	}
+	if m.receiver_key_tweak != nil {
+		fields = append(fields, transferleaf.FieldReceiverKeyTweak)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TransferLeafMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case transferleaf.FieldCreateTime:
+		return m.CreateTime()
+	case transferleaf.FieldUpdateTime:
+		return m.UpdateTime()
+	case transferleaf.FieldSecretCipher:
+		return m.SecretCipher()
+	case transferleaf.FieldSignature:
+		return m.Signature()
+	case transferleaf.FieldPreviousRefundTx:
+		return m.PreviousRefundTx()
+	case transferleaf.FieldPreviousDirectRefundTx:
+		return m.PreviousDirectRefundTx()
+	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
+		return m.PreviousDirectFromCpfpRefundTx()
+	case transferleaf.FieldIntermediateRefundTx:
+		return m.IntermediateRefundTx()
+	case transferleaf.FieldIntermediateDirectRefundTx:
+		return m.IntermediateDirectRefundTx()
+	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
+		return m.IntermediateDirectFromCpfpRefundTx()
+	case transferleaf.FieldKeyTweak:
+		return m.KeyTweak()
+	case transferleaf.FieldSenderKeyTweakProof:
+		return m.SenderKeyTweakProof()
+	case transferleaf.FieldReceiverKeyTweak:
+		return m.ReceiverKeyTweak()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TransferLeafMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case transferleaf.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case transferleaf.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case transferleaf.FieldSecretCipher:
+		return m.OldSecretCipher(ctx)
+	case transferleaf.FieldSignature:
+		return m.OldSignature(ctx)
+	case transferleaf.FieldPreviousRefundTx:
+		return m.OldPreviousRefundTx(ctx)
+	case transferleaf.FieldPreviousDirectRefundTx:
+		return m.OldPreviousDirectRefundTx(ctx)
+	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
+		return m.OldPreviousDirectFromCpfpRefundTx(ctx)
+	case transferleaf.FieldIntermediateRefundTx:
+		return m.OldIntermediateRefundTx(ctx)
+	case transferleaf.FieldIntermediateDirectRefundTx:
+		return m.OldIntermediateDirectRefundTx(ctx)
+	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
+		return m.OldIntermediateDirectFromCpfpRefundTx(ctx)
+	case transferleaf.FieldKeyTweak:
+		return m.OldKeyTweak(ctx)
+	case transferleaf.FieldSenderKeyTweakProof:
+		return m.OldSenderKeyTweakProof(ctx)
+	case transferleaf.FieldReceiverKeyTweak:
+		return m.OldReceiverKeyTweak(ctx)
+	}
+	return nil, fmt.Errorf("unknown TransferLeaf field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferLeafMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case transferleaf.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case transferleaf.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case transferleaf.FieldSecretCipher:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretCipher(v)
+		return nil
+	case transferleaf.FieldSignature:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSignature(v)
+		return nil
+	case transferleaf.FieldPreviousRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousRefundTx(v)
+		return nil
+	case transferleaf.FieldPreviousDirectRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousDirectRefundTx(v)
+		return nil
+	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousDirectFromCpfpRefundTx(v)
+		return nil
+	case transferleaf.FieldIntermediateRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntermediateRefundTx(v)
+		return nil
+	case transferleaf.FieldIntermediateDirectRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntermediateDirectRefundTx(v)
+		return nil
+	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntermediateDirectFromCpfpRefundTx(v)
+		return nil
+	case transferleaf.FieldKeyTweak:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyTweak(v)
+		return nil
+	case transferleaf.FieldSenderKeyTweakProof:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSenderKeyTweakProof(v)
+		return nil
+	case transferleaf.FieldReceiverKeyTweak:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiverKeyTweak(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TransferLeaf field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TransferLeafMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TransferLeafMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TransferLeafMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TransferLeaf numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TransferLeafMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(transferleaf.FieldSecretCipher) {
+		fields = append(fields, transferleaf.FieldSecretCipher)
+	}
+	if m.FieldCleared(transferleaf.FieldSignature) {
+		fields = append(fields, transferleaf.FieldSignature)
+	}
+	if m.FieldCleared(transferleaf.FieldPreviousDirectRefundTx) {
+		fields = append(fields, transferleaf.FieldPreviousDirectRefundTx)
+	}
+	if m.FieldCleared(transferleaf.FieldPreviousDirectFromCpfpRefundTx) {
+		fields = append(fields, transferleaf.FieldPreviousDirectFromCpfpRefundTx)
+	}
+	if m.FieldCleared(transferleaf.FieldIntermediateDirectRefundTx) {
+		fields = append(fields, transferleaf.FieldIntermediateDirectRefundTx)
+	}
+	if m.FieldCleared(transferleaf.FieldIntermediateDirectFromCpfpRefundTx) {
+		fields = append(fields, transferleaf.FieldIntermediateDirectFromCpfpRefundTx)
+	}
+	if m.FieldCleared(transferleaf.FieldKeyTweak) {
+		fields = append(fields, transferleaf.FieldKeyTweak)
+	}
+	if m.FieldCleared(transferleaf.FieldSenderKeyTweakProof) {
+		fields = append(fields, transferleaf.FieldSenderKeyTweakProof)
+	}
+	if m.FieldCleared(transferleaf.FieldReceiverKeyTweak) {
+		fields = append(fields, transferleaf.FieldReceiverKeyTweak)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TransferLeafMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TransferLeafMutation) ClearField(name string) error {
+	switch name {
+	case transferleaf.FieldSecretCipher:
+		m.ClearSecretCipher()
+		return nil
+	case transferleaf.FieldSignature:
+		m.ClearSignature()
+		return nil
+	case transferleaf.FieldPreviousDirectRefundTx:
+		m.ClearPreviousDirectRefundTx()
+		return nil
+	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
+		m.ClearPreviousDirectFromCpfpRefundTx()
+		return nil
+	case transferleaf.FieldIntermediateDirectRefundTx:
+		m.ClearIntermediateDirectRefundTx()
+		return nil
+	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
+		m.ClearIntermediateDirectFromCpfpRefundTx()
+		return nil
+	case transferleaf.FieldKeyTweak:
+		m.ClearKeyTweak()
+		return nil
+	case transferleaf.FieldSenderKeyTweakProof:
+		m.ClearSenderKeyTweakProof()
+		return nil
+	case transferleaf.FieldReceiverKeyTweak:
+		m.ClearReceiverKeyTweak()
+		return nil
+	}
+	return fmt.Errorf("unknown TransferLeaf nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TransferLeafMutation) ResetField(name string) error {
+	switch name {
+	case transferleaf.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case transferleaf.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case transferleaf.FieldSecretCipher:
+		m.ResetSecretCipher()
+		return nil
+	case transferleaf.FieldSignature:
+		m.ResetSignature()
+		return nil
+	case transferleaf.FieldPreviousRefundTx:
+		m.ResetPreviousRefundTx()
+		return nil
+	case transferleaf.FieldPreviousDirectRefundTx:
+		m.ResetPreviousDirectRefundTx()
+		return nil
+	case transferleaf.FieldPreviousDirectFromCpfpRefundTx:
+		m.ResetPreviousDirectFromCpfpRefundTx()
+		return nil
+	case transferleaf.FieldIntermediateRefundTx:
+		m.ResetIntermediateRefundTx()
+		return nil
+	case transferleaf.FieldIntermediateDirectRefundTx:
+		m.ResetIntermediateDirectRefundTx()
+		return nil
+	case transferleaf.FieldIntermediateDirectFromCpfpRefundTx:
+		m.ResetIntermediateDirectFromCpfpRefundTx()
+		return nil
+	case transferleaf.FieldKeyTweak:
+		m.ResetKeyTweak()
+		return nil
+	case transferleaf.FieldSenderKeyTweakProof:
+		m.ResetSenderKeyTweakProof()
+		return nil
+	case transferleaf.FieldReceiverKeyTweak:
+		m.ResetReceiverKeyTweak()
+		return nil
+	}
+	return fmt.Errorf("unknown TransferLeaf field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TransferLeafMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.transfer != nil {
+		edges = append(edges, transferleaf.EdgeTransfer)
+	}
+	if m.leaf != nil {
+		edges = append(edges, transferleaf.EdgeLeaf)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TransferLeafMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case transferleaf.EdgeTransfer:
+		if id := m.transfer; id != nil {
+			return []ent.Value{*id}
+		}
+	case transferleaf.EdgeLeaf:
+		if id := m.leaf; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TransferLeafMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TransferLeafMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TransferLeafMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtransfer {
+		edges = append(edges, transferleaf.EdgeTransfer)
+	}
+	if m.clearedleaf {
+		edges = append(edges, transferleaf.EdgeLeaf)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TransferLeafMutation) EdgeCleared(name string) bool {
+	switch name {
+	case transferleaf.EdgeTransfer:
+		return m.clearedtransfer
+	case transferleaf.EdgeLeaf:
+		return m.clearedleaf
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TransferLeafMutation) ClearEdge(name string) error {
+	switch name {
+	case transferleaf.EdgeTransfer:
+		m.ClearTransfer()
+		return nil
+	case transferleaf.EdgeLeaf:
+		m.ClearLeaf()
+		return nil
+	}
+	return fmt.Errorf("unknown TransferLeaf unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TransferLeafMutation) ResetEdge(name string) error {
+	switch name {
+	case transferleaf.EdgeTransfer:
+		m.ResetTransfer()
+		return nil
+	case transferleaf.EdgeLeaf:
+		m.ResetLeaf()
+		return nil
+	}
+	return fmt.Errorf("unknown TransferLeaf edge %s", name)
+}
+
+// TreeMutation represents an operation that mutates the Tree nodes in the graph.
+type TreeMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	create_time           *time.Time
+	update_time           *time.Time
+	owner_identity_pubkey *[]byte
+	status                *schematype.TreeStatus
+	network               *schematype.Network
+	base_txid             *[]byte
+	vout                  *int16
+	addvout               *int16
+	clearedFields         map[string]struct{}
+	root                  *uuid.UUID
+	clearedroot           bool
+	nodes                 map[uuid.UUID]struct{}
+	removednodes          map[uuid.UUID]struct{}
+	clearednodes          bool
+	done                  bool
+	oldValue              func(context.Context) (*Tree, error)
+	predicates            []predicate.Tree
+}
+
+var _ ent.Mutation = (*TreeMutation)(nil)
+
+// treeOption allows management of the mutation configuration using functional options.
+type treeOption func(*TreeMutation)
+
+// newTreeMutation creates new mutation for the Tree entity.
+func newTreeMutation(c config, op Op, opts ...treeOption) *TreeMutation {
+	m := &TreeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTree,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTreeID sets the ID field of the mutation.
+func withTreeID(id uuid.UUID) treeOption {
+	return func(m *TreeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tree
+		)
+		m.oldValue = func(ctx context.Context) (*Tree, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tree.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTree sets the old Tree of the mutation.
+func withTree(node *Tree) treeOption {
+	return func(m *TreeMutation) {
+		m.oldValue = func(context.Context) (*Tree, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TreeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TreeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tree entities.
+func (m *TreeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TreeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TreeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tree.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TreeMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TreeMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TreeMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TreeMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TreeMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TreeMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
+func (m *TreeMutation) SetOwnerIdentityPubkey(b []byte) {
+	m.owner_identity_pubkey = &b
+}
+
+// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
+func (m *TreeMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
+	v := m.owner_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+	}
+	return oldValue.OwnerIdentityPubkey, nil
+}
+
+// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
+func (m *TreeMutation) ResetOwnerIdentityPubkey() {
+	m.owner_identity_pubkey = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TreeMutation) SetStatus(ss schematype.TreeStatus) {
+	m.status = &ss
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TreeMutation) Status() (r schematype.TreeStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldStatus(ctx context.Context) (v schematype.TreeStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TreeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetNetwork sets the "network" field.
+func (m *TreeMutation) SetNetwork(s schematype.Network) {
+	m.network = &s
+}
+
+// Network returns the value of the "network" field in the mutation.
+func (m *TreeMutation) Network() (r schematype.Network, exists bool) {
+	v := m.network
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetwork returns the old "network" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldNetwork(ctx context.Context) (v schematype.Network, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetwork is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetwork requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetwork: %w", err)
+	}
+	return oldValue.Network, nil
+}
+
+// ResetNetwork resets all changes to the "network" field.
+func (m *TreeMutation) ResetNetwork() {
+	m.network = nil
+}
+
+// SetBaseTxid sets the "base_txid" field.
+func (m *TreeMutation) SetBaseTxid(b []byte) {
+	m.base_txid = &b
+}
+
+// BaseTxid returns the value of the "base_txid" field in the mutation.
+func (m *TreeMutation) BaseTxid() (r []byte, exists bool) {
+	v := m.base_txid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBaseTxid returns the old "base_txid" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldBaseTxid(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBaseTxid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBaseTxid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBaseTxid: %w", err)
+	}
+	return oldValue.BaseTxid, nil
+}
+
+// ResetBaseTxid resets all changes to the "base_txid" field.
+func (m *TreeMutation) ResetBaseTxid() {
+	m.base_txid = nil
+}
+
+// SetVout sets the "vout" field.
+func (m *TreeMutation) SetVout(i int16) {
+	m.vout = &i
+	m.addvout = nil
+}
+
+// Vout returns the value of the "vout" field in the mutation.
+func (m *TreeMutation) Vout() (r int16, exists bool) {
+	v := m.vout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVout returns the old "vout" field's value of the Tree entity.
+// If the Tree object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeMutation) OldVout(ctx context.Context) (v int16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVout: %w", err)
+	}
+	return oldValue.Vout, nil
+}
+
+// AddVout adds i to the "vout" field.
+func (m *TreeMutation) AddVout(i int16) {
+	if m.addvout != nil {
+		*m.addvout += i
+	} else {
+		m.addvout = &i
+	}
+}
+
+// AddedVout returns the value that was added to the "vout" field in this mutation.
+func (m *TreeMutation) AddedVout() (r int16, exists bool) {
+	v := m.addvout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVout resets all changes to the "vout" field.
+func (m *TreeMutation) ResetVout() {
+	m.vout = nil
+	m.addvout = nil
+}
+
+// SetRootID sets the "root" edge to the TreeNode entity by id.
+func (m *TreeMutation) SetRootID(id uuid.UUID) {
+	m.root = &id
+}
+
+// ClearRoot clears the "root" edge to the TreeNode entity.
+func (m *TreeMutation) ClearRoot() {
+	m.clearedroot = true
+}
+
+// RootCleared reports if the "root" edge to the TreeNode entity was cleared.
+func (m *TreeMutation) RootCleared() bool {
+	return m.clearedroot
+}
+
+// RootID returns the "root" edge ID in the mutation.
+func (m *TreeMutation) RootID() (id uuid.UUID, exists bool) {
+	if m.root != nil {
+		return *m.root, true
+	}
+	return
+}
+
+// RootIDs returns the "root" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RootID instead. It exists only for internal usage by the builders.
+func (m *TreeMutation) RootIDs() (ids []uuid.UUID) {
+	if id := m.root; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoot resets all changes to the "root" edge.
+func (m *TreeMutation) ResetRoot() {
+	m.root = nil
+	m.clearedroot = false
+}
+
+// AddNodeIDs adds the "nodes" edge to the TreeNode entity by ids.
+func (m *TreeMutation) AddNodeIDs(ids ...uuid.UUID) {
+	if m.nodes == nil {
+		m.nodes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.nodes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNodes clears the "nodes" edge to the TreeNode entity.
+func (m *TreeMutation) ClearNodes() {
+	m.clearednodes = true
+}
+
+// NodesCleared reports if the "nodes" edge to the TreeNode entity was cleared.
+func (m *TreeMutation) NodesCleared() bool {
+	return m.clearednodes
+}
+
+// RemoveNodeIDs removes the "nodes" edge to the TreeNode entity by IDs.
+func (m *TreeMutation) RemoveNodeIDs(ids ...uuid.UUID) {
+	if m.removednodes == nil {
+		m.removednodes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.nodes, ids[i])
+		m.removednodes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNodes returns the removed IDs of the "nodes" edge to the TreeNode entity.
+func (m *TreeMutation) RemovedNodesIDs() (ids []uuid.UUID) {
+	for id := range m.removednodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NodesIDs returns the "nodes" edge IDs in the mutation.
+func (m *TreeMutation) NodesIDs() (ids []uuid.UUID) {
+	for id := range m.nodes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNodes resets all changes to the "nodes" edge.
+func (m *TreeMutation) ResetNodes() {
+	m.nodes = nil
+	m.clearednodes = false
+	m.removednodes = nil
+}
+
+// Where appends a list predicates to the TreeMutation builder.
+func (m *TreeMutation) Where(ps ...predicate.Tree) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TreeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TreeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tree, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TreeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TreeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tree).
+func (m *TreeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TreeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, tree.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, tree.FieldUpdateTime)
+	}
+	if m.owner_identity_pubkey != nil {
+		fields = append(fields, tree.FieldOwnerIdentityPubkey)
+	}
+	if m.status != nil {
+		fields = append(fields, tree.FieldStatus)
+	}
+	if m.network != nil {
+		fields = append(fields, tree.FieldNetwork)
+	}
+	if m.base_txid != nil {
+		fields = append(fields, tree.FieldBaseTxid)
+	}
+	if m.vout != nil {
+		fields = append(fields, tree.FieldVout)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TreeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tree.FieldCreateTime:
+		return m.CreateTime()
+	case tree.FieldUpdateTime:
+		return m.UpdateTime()
+	case tree.FieldOwnerIdentityPubkey:
+		return m.OwnerIdentityPubkey()
+	case tree.FieldStatus:
+		return m.Status()
+	case tree.FieldNetwork:
+		return m.Network()
+	case tree.FieldBaseTxid:
+		return m.BaseTxid()
+	case tree.FieldVout:
+		return m.Vout()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TreeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tree.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tree.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tree.FieldOwnerIdentityPubkey:
+		return m.OldOwnerIdentityPubkey(ctx)
+	case tree.FieldStatus:
+		return m.OldStatus(ctx)
+	case tree.FieldNetwork:
+		return m.OldNetwork(ctx)
+	case tree.FieldBaseTxid:
+		return m.OldBaseTxid(ctx)
+	case tree.FieldVout:
+		return m.OldVout(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tree field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tree.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tree.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tree.FieldOwnerIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerIdentityPubkey(v)
+		return nil
+	case tree.FieldStatus:
+		v, ok := value.(schematype.TreeStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tree.FieldNetwork:
+		v, ok := value.(schematype.Network)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetwork(v)
+		return nil
+	case tree.FieldBaseTxid:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBaseTxid(v)
+		return nil
+	case tree.FieldVout:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVout(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tree field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TreeMutation) AddedFields() []string {
+	var fields []string
+	if m.addvout != nil {
+		fields = append(fields, tree.FieldVout)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TreeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tree.FieldVout:
+		return m.AddedVout()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tree.FieldVout:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVout(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tree numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TreeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TreeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TreeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tree nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TreeMutation) ResetField(name string) error {
+	switch name {
+	case tree.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tree.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tree.FieldOwnerIdentityPubkey:
+		m.ResetOwnerIdentityPubkey()
+		return nil
+	case tree.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tree.FieldNetwork:
+		m.ResetNetwork()
+		return nil
+	case tree.FieldBaseTxid:
+		m.ResetBaseTxid()
+		return nil
+	case tree.FieldVout:
+		m.ResetVout()
+		return nil
+	}
+	return fmt.Errorf("unknown Tree field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TreeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.root != nil {
+		edges = append(edges, tree.EdgeRoot)
+	}
+	if m.nodes != nil {
+		edges = append(edges, tree.EdgeNodes)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TreeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tree.EdgeRoot:
+		if id := m.root; id != nil {
+			return []ent.Value{*id}
+		}
+	case tree.EdgeNodes:
+		ids := make([]ent.Value, 0, len(m.nodes))
+		for id := range m.nodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TreeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removednodes != nil {
+		edges = append(edges, tree.EdgeNodes)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TreeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tree.EdgeNodes:
+		ids := make([]ent.Value, 0, len(m.removednodes))
+		for id := range m.removednodes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TreeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedroot {
+		edges = append(edges, tree.EdgeRoot)
+	}
+	if m.clearednodes {
+		edges = append(edges, tree.EdgeNodes)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TreeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tree.EdgeRoot:
+		return m.clearedroot
+	case tree.EdgeNodes:
+		return m.clearednodes
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TreeMutation) ClearEdge(name string) error {
+	switch name {
+	case tree.EdgeRoot:
+		m.ClearRoot()
+		return nil
+	}
+	return fmt.Errorf("unknown Tree unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TreeMutation) ResetEdge(name string) error {
+	switch name {
+	case tree.EdgeRoot:
+		m.ResetRoot()
+		return nil
+	case tree.EdgeNodes:
+		m.ResetNodes()
+		return nil
+	}
+	return fmt.Errorf("unknown Tree edge %s", name)
+}
+
+// TreeNodeMutation represents an operation that mutates the TreeNode nodes in the graph.
+type TreeNodeMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	create_time                   *time.Time
+	update_time                   *time.Time
+	value                         *uint64
+	addvalue                      *int64
+	status                        *schematype.TreeNodeStatus
+	verifying_pubkey              *[]byte
+	owner_identity_pubkey         *[]byte
+	owner_signing_pubkey          *[]byte
+	raw_tx                        *[]byte
+	vout                          *int16
+	addvout                       *int16
+	raw_refund_tx                 *[]byte
+	node_confirmation_height      *uint64
+	addnode_confirmation_height   *int64
+	refund_confirmation_height    *uint64
+	addrefund_confirmation_height *int64
+	direct_refund_tx              *[]byte
+	direct_tx                     *[]byte
+	direct_from_cpfp_refund_tx    *[]byte
+	clearedFields                 map[string]struct{}
+	tree                          *uuid.UUID
+	clearedtree                   bool
+	parent                        *uuid.UUID
+	clearedparent                 bool
+	signing_keyshare              *uuid.UUID
+	clearedsigning_keyshare       bool
+	children                      map[uuid.UUID]struct{}
+	removedchildren               map[uuid.UUID]struct{}
+	clearedchildren               bool
+	done                          bool
+	oldValue                      func(context.Context) (*TreeNode, error)
+	predicates                    []predicate.TreeNode
+}
+
+var _ ent.Mutation = (*TreeNodeMutation)(nil)
+
+// treenodeOption allows management of the mutation configuration using functional options.
+type treenodeOption func(*TreeNodeMutation)
+
+// newTreeNodeMutation creates new mutation for the TreeNode entity.
+func newTreeNodeMutation(c config, op Op, opts ...treenodeOption) *TreeNodeMutation {
+	m := &TreeNodeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTreeNode,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTreeNodeID sets the ID field of the mutation.
+func withTreeNodeID(id uuid.UUID) treenodeOption {
+	return func(m *TreeNodeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TreeNode
+		)
+		m.oldValue = func(ctx context.Context) (*TreeNode, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TreeNode.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTreeNode sets the old TreeNode of the mutation.
+func withTreeNode(node *TreeNode) treenodeOption {
+	return func(m *TreeNodeMutation) {
+		m.oldValue = func(context.Context) (*TreeNode, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TreeNodeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TreeNodeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TreeNode entities.
+func (m *TreeNodeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TreeNodeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TreeNodeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TreeNode.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TreeNodeMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TreeNodeMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TreeNodeMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TreeNodeMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TreeNodeMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TreeNodeMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetValue sets the "value" field.
+func (m *TreeNodeMutation) SetValue(u uint64) {
+	m.value = &u
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *TreeNodeMutation) Value() (r uint64, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldValue(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds u to the "value" field.
+func (m *TreeNodeMutation) AddValue(u int64) {
+	if m.addvalue != nil {
+		*m.addvalue += u
+	} else {
+		m.addvalue = &u
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *TreeNodeMutation) AddedValue() (r int64, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *TreeNodeMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TreeNodeMutation) SetStatus(sns schematype.TreeNodeStatus) {
+	m.status = &sns
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TreeNodeMutation) Status() (r schematype.TreeNodeStatus, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldStatus(ctx context.Context) (v schematype.TreeNodeStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TreeNodeMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetVerifyingPubkey sets the "verifying_pubkey" field.
+func (m *TreeNodeMutation) SetVerifyingPubkey(b []byte) {
+	m.verifying_pubkey = &b
+}
+
+// VerifyingPubkey returns the value of the "verifying_pubkey" field in the mutation.
+func (m *TreeNodeMutation) VerifyingPubkey() (r []byte, exists bool) {
+	v := m.verifying_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVerifyingPubkey returns the old "verifying_pubkey" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldVerifyingPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVerifyingPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVerifyingPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVerifyingPubkey: %w", err)
+	}
+	return oldValue.VerifyingPubkey, nil
+}
+
+// ResetVerifyingPubkey resets all changes to the "verifying_pubkey" field.
+func (m *TreeNodeMutation) ResetVerifyingPubkey() {
+	m.verifying_pubkey = nil
+}
+
+// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
+func (m *TreeNodeMutation) SetOwnerIdentityPubkey(b []byte) {
+	m.owner_identity_pubkey = &b
+}
+
+// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
+func (m *TreeNodeMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
+	v := m.owner_identity_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+	}
+	return oldValue.OwnerIdentityPubkey, nil
+}
+
+// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
+func (m *TreeNodeMutation) ResetOwnerIdentityPubkey() {
+	m.owner_identity_pubkey = nil
+}
+
+// SetOwnerSigningPubkey sets the "owner_signing_pubkey" field.
+func (m *TreeNodeMutation) SetOwnerSigningPubkey(b []byte) {
+	m.owner_signing_pubkey = &b
+}
+
+// OwnerSigningPubkey returns the value of the "owner_signing_pubkey" field in the mutation.
+func (m *TreeNodeMutation) OwnerSigningPubkey() (r []byte, exists bool) {
+	v := m.owner_signing_pubkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerSigningPubkey returns the old "owner_signing_pubkey" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldOwnerSigningPubkey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerSigningPubkey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerSigningPubkey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerSigningPubkey: %w", err)
+	}
+	return oldValue.OwnerSigningPubkey, nil
+}
+
+// ResetOwnerSigningPubkey resets all changes to the "owner_signing_pubkey" field.
+func (m *TreeNodeMutation) ResetOwnerSigningPubkey() {
+	m.owner_signing_pubkey = nil
+}
+
+// SetRawTx sets the "raw_tx" field.
+func (m *TreeNodeMutation) SetRawTx(b []byte) {
+	m.raw_tx = &b
+}
+
+// RawTx returns the value of the "raw_tx" field in the mutation.
+func (m *TreeNodeMutation) RawTx() (r []byte, exists bool) {
+	v := m.raw_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawTx returns the old "raw_tx" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldRawTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawTx: %w", err)
+	}
+	return oldValue.RawTx, nil
+}
+
+// ResetRawTx resets all changes to the "raw_tx" field.
+func (m *TreeNodeMutation) ResetRawTx() {
+	m.raw_tx = nil
+}
+
+// SetVout sets the "vout" field.
+func (m *TreeNodeMutation) SetVout(i int16) {
+	m.vout = &i
+	m.addvout = nil
+}
+
+// Vout returns the value of the "vout" field in the mutation.
+func (m *TreeNodeMutation) Vout() (r int16, exists bool) {
+	v := m.vout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVout returns the old "vout" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldVout(ctx context.Context) (v int16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVout: %w", err)
+	}
+	return oldValue.Vout, nil
+}
+
+// AddVout adds i to the "vout" field.
+func (m *TreeNodeMutation) AddVout(i int16) {
+	if m.addvout != nil {
+		*m.addvout += i
+	} else {
+		m.addvout = &i
+	}
+}
+
+// AddedVout returns the value that was added to the "vout" field in this mutation.
+func (m *TreeNodeMutation) AddedVout() (r int16, exists bool) {
+	v := m.addvout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVout resets all changes to the "vout" field.
+func (m *TreeNodeMutation) ResetVout() {
+	m.vout = nil
+	m.addvout = nil
+}
+
+// SetRawRefundTx sets the "raw_refund_tx" field.
+func (m *TreeNodeMutation) SetRawRefundTx(b []byte) {
+	m.raw_refund_tx = &b
+}
+
+// RawRefundTx returns the value of the "raw_refund_tx" field in the mutation.
+func (m *TreeNodeMutation) RawRefundTx() (r []byte, exists bool) {
+	v := m.raw_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRawRefundTx returns the old "raw_refund_tx" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldRawRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRawRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRawRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRawRefundTx: %w", err)
+	}
+	return oldValue.RawRefundTx, nil
+}
+
+// ClearRawRefundTx clears the value of the "raw_refund_tx" field.
+func (m *TreeNodeMutation) ClearRawRefundTx() {
+	m.raw_refund_tx = nil
+	m.clearedFields[treenode.FieldRawRefundTx] = struct{}{}
+}
+
+// RawRefundTxCleared returns if the "raw_refund_tx" field was cleared in this mutation.
+func (m *TreeNodeMutation) RawRefundTxCleared() bool {
+	_, ok := m.clearedFields[treenode.FieldRawRefundTx]
+	return ok
+}
+
+// ResetRawRefundTx resets all changes to the "raw_refund_tx" field.
+func (m *TreeNodeMutation) ResetRawRefundTx() {
+	m.raw_refund_tx = nil
+	delete(m.clearedFields, treenode.FieldRawRefundTx)
+}
+
+// SetNodeConfirmationHeight sets the "node_confirmation_height" field.
+func (m *TreeNodeMutation) SetNodeConfirmationHeight(u uint64) {
+	m.node_confirmation_height = &u
+	m.addnode_confirmation_height = nil
+}
+
+// NodeConfirmationHeight returns the value of the "node_confirmation_height" field in the mutation.
+func (m *TreeNodeMutation) NodeConfirmationHeight() (r uint64, exists bool) {
+	v := m.node_confirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNodeConfirmationHeight returns the old "node_confirmation_height" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldNodeConfirmationHeight(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNodeConfirmationHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNodeConfirmationHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNodeConfirmationHeight: %w", err)
+	}
+	return oldValue.NodeConfirmationHeight, nil
+}
+
+// AddNodeConfirmationHeight adds u to the "node_confirmation_height" field.
+func (m *TreeNodeMutation) AddNodeConfirmationHeight(u int64) {
+	if m.addnode_confirmation_height != nil {
+		*m.addnode_confirmation_height += u
+	} else {
+		m.addnode_confirmation_height = &u
+	}
+}
+
+// AddedNodeConfirmationHeight returns the value that was added to the "node_confirmation_height" field in this mutation.
+func (m *TreeNodeMutation) AddedNodeConfirmationHeight() (r int64, exists bool) {
+	v := m.addnode_confirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNodeConfirmationHeight clears the value of the "node_confirmation_height" field.
+func (m *TreeNodeMutation) ClearNodeConfirmationHeight() {
+	m.node_confirmation_height = nil
+	m.addnode_confirmation_height = nil
+	m.clearedFields[treenode.FieldNodeConfirmationHeight] = struct{}{}
+}
+
+// NodeConfirmationHeightCleared returns if the "node_confirmation_height" field was cleared in this mutation.
+func (m *TreeNodeMutation) NodeConfirmationHeightCleared() bool {
+	_, ok := m.clearedFields[treenode.FieldNodeConfirmationHeight]
+	return ok
+}
+
+// ResetNodeConfirmationHeight resets all changes to the "node_confirmation_height" field.
+func (m *TreeNodeMutation) ResetNodeConfirmationHeight() {
+	m.node_confirmation_height = nil
+	m.addnode_confirmation_height = nil
+	delete(m.clearedFields, treenode.FieldNodeConfirmationHeight)
+}
+
+// SetRefundConfirmationHeight sets the "refund_confirmation_height" field.
+func (m *TreeNodeMutation) SetRefundConfirmationHeight(u uint64) {
+	m.refund_confirmation_height = &u
+	m.addrefund_confirmation_height = nil
+}
+
+// RefundConfirmationHeight returns the value of the "refund_confirmation_height" field in the mutation.
+func (m *TreeNodeMutation) RefundConfirmationHeight() (r uint64, exists bool) {
+	v := m.refund_confirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundConfirmationHeight returns the old "refund_confirmation_height" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldRefundConfirmationHeight(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundConfirmationHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundConfirmationHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundConfirmationHeight: %w", err)
+	}
+	return oldValue.RefundConfirmationHeight, nil
+}
+
+// AddRefundConfirmationHeight adds u to the "refund_confirmation_height" field.
+func (m *TreeNodeMutation) AddRefundConfirmationHeight(u int64) {
+	if m.addrefund_confirmation_height != nil {
+		*m.addrefund_confirmation_height += u
+	} else {
+		m.addrefund_confirmation_height = &u
+	}
+}
+
+// AddedRefundConfirmationHeight returns the value that was added to the "refund_confirmation_height" field in this mutation.
+func (m *TreeNodeMutation) AddedRefundConfirmationHeight() (r int64, exists bool) {
+	v := m.addrefund_confirmation_height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRefundConfirmationHeight clears the value of the "refund_confirmation_height" field.
+func (m *TreeNodeMutation) ClearRefundConfirmationHeight() {
+	m.refund_confirmation_height = nil
+	m.addrefund_confirmation_height = nil
+	m.clearedFields[treenode.FieldRefundConfirmationHeight] = struct{}{}
+}
+
+// RefundConfirmationHeightCleared returns if the "refund_confirmation_height" field was cleared in this mutation.
+func (m *TreeNodeMutation) RefundConfirmationHeightCleared() bool {
+	_, ok := m.clearedFields[treenode.FieldRefundConfirmationHeight]
+	return ok
+}
+
+// ResetRefundConfirmationHeight resets all changes to the "refund_confirmation_height" field.
+func (m *TreeNodeMutation) ResetRefundConfirmationHeight() {
+	m.refund_confirmation_height = nil
+	m.addrefund_confirmation_height = nil
+	delete(m.clearedFields, treenode.FieldRefundConfirmationHeight)
+}
+
+// SetDirectRefundTx sets the "direct_refund_tx" field.
+func (m *TreeNodeMutation) SetDirectRefundTx(b []byte) {
+	m.direct_refund_tx = &b
+}
+
+// DirectRefundTx returns the value of the "direct_refund_tx" field in the mutation.
+func (m *TreeNodeMutation) DirectRefundTx() (r []byte, exists bool) {
+	v := m.direct_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirectRefundTx returns the old "direct_refund_tx" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldDirectRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirectRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirectRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirectRefundTx: %w", err)
+	}
+	return oldValue.DirectRefundTx, nil
+}
+
+// ClearDirectRefundTx clears the value of the "direct_refund_tx" field.
+func (m *TreeNodeMutation) ClearDirectRefundTx() {
+	m.direct_refund_tx = nil
+	m.clearedFields[treenode.FieldDirectRefundTx] = struct{}{}
+}
+
+// DirectRefundTxCleared returns if the "direct_refund_tx" field was cleared in this mutation.
+func (m *TreeNodeMutation) DirectRefundTxCleared() bool {
+	_, ok := m.clearedFields[treenode.FieldDirectRefundTx]
+	return ok
+}
+
+// ResetDirectRefundTx resets all changes to the "direct_refund_tx" field.
+func (m *TreeNodeMutation) ResetDirectRefundTx() {
+	m.direct_refund_tx = nil
+	delete(m.clearedFields, treenode.FieldDirectRefundTx)
+}
+
+// SetDirectTx sets the "direct_tx" field.
+func (m *TreeNodeMutation) SetDirectTx(b []byte) {
+	m.direct_tx = &b
+}
+
+// DirectTx returns the value of the "direct_tx" field in the mutation.
+func (m *TreeNodeMutation) DirectTx() (r []byte, exists bool) {
+	v := m.direct_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirectTx returns the old "direct_tx" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldDirectTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirectTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirectTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirectTx: %w", err)
+	}
+	return oldValue.DirectTx, nil
+}
+
+// ClearDirectTx clears the value of the "direct_tx" field.
+func (m *TreeNodeMutation) ClearDirectTx() {
+	m.direct_tx = nil
+	m.clearedFields[treenode.FieldDirectTx] = struct{}{}
+}
+
+// DirectTxCleared returns if the "direct_tx" field was cleared in this mutation.
+func (m *TreeNodeMutation) DirectTxCleared() bool {
+	_, ok := m.clearedFields[treenode.FieldDirectTx]
+	return ok
+}
+
+// ResetDirectTx resets all changes to the "direct_tx" field.
+func (m *TreeNodeMutation) ResetDirectTx() {
+	m.direct_tx = nil
+	delete(m.clearedFields, treenode.FieldDirectTx)
+}
+
+// SetDirectFromCpfpRefundTx sets the "direct_from_cpfp_refund_tx" field.
+func (m *TreeNodeMutation) SetDirectFromCpfpRefundTx(b []byte) {
+	m.direct_from_cpfp_refund_tx = &b
+}
+
+// DirectFromCpfpRefundTx returns the value of the "direct_from_cpfp_refund_tx" field in the mutation.
+func (m *TreeNodeMutation) DirectFromCpfpRefundTx() (r []byte, exists bool) {
+	v := m.direct_from_cpfp_refund_tx
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirectFromCpfpRefundTx returns the old "direct_from_cpfp_refund_tx" field's value of the TreeNode entity.
+// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TreeNodeMutation) OldDirectFromCpfpRefundTx(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirectFromCpfpRefundTx is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirectFromCpfpRefundTx requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirectFromCpfpRefundTx: %w", err)
+	}
+	return oldValue.DirectFromCpfpRefundTx, nil
+}
+
+// ClearDirectFromCpfpRefundTx clears the value of the "direct_from_cpfp_refund_tx" field.
+func (m *TreeNodeMutation) ClearDirectFromCpfpRefundTx() {
+	m.direct_from_cpfp_refund_tx = nil
+	m.clearedFields[treenode.FieldDirectFromCpfpRefundTx] = struct{}{}
+}
+
+// DirectFromCpfpRefundTxCleared returns if the "direct_from_cpfp_refund_tx" field was cleared in this mutation.
+func (m *TreeNodeMutation) DirectFromCpfpRefundTxCleared() bool {
+	_, ok := m.clearedFields[treenode.FieldDirectFromCpfpRefundTx]
+	return ok
+}
+
+// ResetDirectFromCpfpRefundTx resets all changes to the "direct_from_cpfp_refund_tx" field.
+func (m *TreeNodeMutation) ResetDirectFromCpfpRefundTx() {
+	m.direct_from_cpfp_refund_tx = nil
+	delete(m.clearedFields, treenode.FieldDirectFromCpfpRefundTx)
+}
+
+// SetTreeID sets the "tree" edge to the Tree entity by id.
+func (m *TreeNodeMutation) SetTreeID(id uuid.UUID) {
+	m.tree = &id
+}
+
+// ClearTree clears the "tree" edge to the Tree entity.
+func (m *TreeNodeMutation) ClearTree() {
+	m.clearedtree = true
+}
+
+// TreeCleared reports if the "tree" edge to the Tree entity was cleared.
+func (m *TreeNodeMutation) TreeCleared() bool {
+	return m.clearedtree
+}
+
+// TreeID returns the "tree" edge ID in the mutation.
+func (m *TreeNodeMutation) TreeID() (id uuid.UUID, exists bool) {
+	if m.tree != nil {
+		return *m.tree, true
+	}
+	return
+}
+
+// TreeIDs returns the "tree" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TreeID instead. It exists only for internal usage by the builders.
+func (m *TreeNodeMutation) TreeIDs() (ids []uuid.UUID) {
+	if id := m.tree; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTree resets all changes to the "tree" edge.
+func (m *TreeNodeMutation) ResetTree() {
+	m.tree = nil
+	m.clearedtree = false
+}
+
+// SetParentID sets the "parent" edge to the TreeNode entity by id.
+func (m *TreeNodeMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the TreeNode entity.
+func (m *TreeNodeMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the TreeNode entity was cleared.
+func (m *TreeNodeMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *TreeNodeMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TreeNodeMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TreeNodeMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// SetSigningKeyshareID sets the "signing_keyshare" edge to the SigningKeyshare entity by id.
+func (m *TreeNodeMutation) SetSigningKeyshareID(id uuid.UUID) {
+	m.signing_keyshare = &id
+}
+
+// ClearSigningKeyshare clears the "signing_keyshare" edge to the SigningKeyshare entity.
+func (m *TreeNodeMutation) ClearSigningKeyshare() {
+	m.clearedsigning_keyshare = true
+}
+
+// SigningKeyshareCleared reports if the "signing_keyshare" edge to the SigningKeyshare entity was cleared.
+func (m *TreeNodeMutation) SigningKeyshareCleared() bool {
+	return m.clearedsigning_keyshare
+}
+
+// SigningKeyshareID returns the "signing_keyshare" edge ID in the mutation.
+func (m *TreeNodeMutation) SigningKeyshareID() (id uuid.UUID, exists bool) {
+	if m.signing_keyshare != nil {
+		return *m.signing_keyshare, true
+	}
+	return
+}
+
+// SigningKeyshareIDs returns the "signing_keyshare" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SigningKeyshareID instead. It exists only for internal usage by the builders.
+func (m *TreeNodeMutation) SigningKeyshareIDs() (ids []uuid.UUID) {
+	if id := m.signing_keyshare; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSigningKeyshare resets all changes to the "signing_keyshare" edge.
+func (m *TreeNodeMutation) ResetSigningKeyshare() {
+	m.signing_keyshare = nil
+	m.clearedsigning_keyshare = false
+}
+
+// AddChildIDs adds the "children" edge to the TreeNode entity by ids.
+func (m *TreeNodeMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the TreeNode entity.
+func (m *TreeNodeMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the TreeNode entity was cleared.
+func (m *TreeNodeMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the TreeNode entity by IDs.
+func (m *TreeNodeMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the TreeNode entity.
+func (m *TreeNodeMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *TreeNodeMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *TreeNodeMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the TreeNodeMutation builder.
+func (m *TreeNodeMutation) Where(ps ...predicate.TreeNode) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TreeNodeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TreeNodeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TreeNode, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TreeNodeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TreeNodeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TreeNode).
+func (m *TreeNodeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TreeNodeMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.create_time != nil {
+		fields = append(fields, treenode.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, treenode.FieldUpdateTime)
+	}
+	if m.value != nil {
+		fields = append(fields, treenode.FieldValue)
+	}
+	if m.status != nil {
+		fields = append(fields, treenode.FieldStatus)
+	}
+	if m.verifying_pubkey != nil {
+		fields = append(fields, treenode.FieldVerifyingPubkey)
+	}
+	if m.owner_identity_pubkey != nil {
+		fields = append(fields, treenode.FieldOwnerIdentityPubkey)
+	}
+	if m.owner_signing_pubkey != nil {
+		fields = append(fields, treenode.FieldOwnerSigningPubkey)
+	}
+	if m.raw_tx != nil {
+		fields = append(fields, treenode.FieldRawTx)
+	}
+	if m.vout != nil {
+		fields = append(fields, treenode.FieldVout)
+	}
+	if m.raw_refund_tx != nil {
+		fields = append(fields, treenode.FieldRawRefundTx)
+	}
+	if m.node_confirmation_height != nil {
+		fields = append(fields, treenode.FieldNodeConfirmationHeight)
+	}
+	if m.refund_confirmation_height != nil {
+		fields = append(fields, treenode.FieldRefundConfirmationHeight)
+	}
+	if m.direct_refund_tx != nil {
+		fields = append(fields, treenode.FieldDirectRefundTx)
+	}
+	if m.direct_tx != nil {
+		fields = append(fields, treenode.FieldDirectTx)
+	}
+	if m.direct_from_cpfp_refund_tx != nil {
+		fields = append(fields, treenode.FieldDirectFromCpfpRefundTx)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TreeNodeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case treenode.FieldCreateTime:
+		return m.CreateTime()
+	case treenode.FieldUpdateTime:
+		return m.UpdateTime()
+	case treenode.FieldValue:
+		return m.Value()
+	case treenode.FieldStatus:
+		return m.Status()
+	case treenode.FieldVerifyingPubkey:
+		return m.VerifyingPubkey()
+	case treenode.FieldOwnerIdentityPubkey:
+		return m.OwnerIdentityPubkey()
+	case treenode.FieldOwnerSigningPubkey:
+		return m.OwnerSigningPubkey()
+	case treenode.FieldRawTx:
+		return m.RawTx()
+	case treenode.FieldVout:
+		return m.Vout()
+	case treenode.FieldRawRefundTx:
+		return m.RawRefundTx()
+	case treenode.FieldNodeConfirmationHeight:
+		return m.NodeConfirmationHeight()
+	case treenode.FieldRefundConfirmationHeight:
+		return m.RefundConfirmationHeight()
+	case treenode.FieldDirectRefundTx:
+		return m.DirectRefundTx()
+	case treenode.FieldDirectTx:
+		return m.DirectTx()
+	case treenode.FieldDirectFromCpfpRefundTx:
+		return m.DirectFromCpfpRefundTx()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TreeNodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case treenode.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case treenode.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case treenode.FieldValue:
+		return m.OldValue(ctx)
+	case treenode.FieldStatus:
+		return m.OldStatus(ctx)
+	case treenode.FieldVerifyingPubkey:
+		return m.OldVerifyingPubkey(ctx)
+	case treenode.FieldOwnerIdentityPubkey:
+		return m.OldOwnerIdentityPubkey(ctx)
+	case treenode.FieldOwnerSigningPubkey:
+		return m.OldOwnerSigningPubkey(ctx)
+	case treenode.FieldRawTx:
+		return m.OldRawTx(ctx)
+	case treenode.FieldVout:
+		return m.OldVout(ctx)
+	case treenode.FieldRawRefundTx:
+		return m.OldRawRefundTx(ctx)
+	case treenode.FieldNodeConfirmationHeight:
+		return m.OldNodeConfirmationHeight(ctx)
+	case treenode.FieldRefundConfirmationHeight:
+		return m.OldRefundConfirmationHeight(ctx)
+	case treenode.FieldDirectRefundTx:
+		return m.OldDirectRefundTx(ctx)
+	case treenode.FieldDirectTx:
+		return m.OldDirectTx(ctx)
+	case treenode.FieldDirectFromCpfpRefundTx:
+		return m.OldDirectFromCpfpRefundTx(ctx)
+	}
+	return nil, fmt.Errorf("unknown TreeNode field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreeNodeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case treenode.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case treenode.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case treenode.FieldValue:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case treenode.FieldStatus:
+		v, ok := value.(schematype.TreeNodeStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case treenode.FieldVerifyingPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVerifyingPubkey(v)
+		return nil
+	case treenode.FieldOwnerIdentityPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerIdentityPubkey(v)
+		return nil
+	case treenode.FieldOwnerSigningPubkey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerSigningPubkey(v)
+		return nil
+	case treenode.FieldRawTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawTx(v)
+		return nil
+	case treenode.FieldVout:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVout(v)
+		return nil
+	case treenode.FieldRawRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRawRefundTx(v)
+		return nil
+	case treenode.FieldNodeConfirmationHeight:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNodeConfirmationHeight(v)
+		return nil
+	case treenode.FieldRefundConfirmationHeight:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundConfirmationHeight(v)
+		return nil
+	case treenode.FieldDirectRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirectRefundTx(v)
+		return nil
+	case treenode.FieldDirectTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirectTx(v)
+		return nil
+	case treenode.FieldDirectFromCpfpRefundTx:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirectFromCpfpRefundTx(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TreeNode field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TreeNodeMutation) AddedFields() []string {
+	var fields []string
+	if m.addvalue != nil {
+		fields = append(fields, treenode.FieldValue)
+	}
+	if m.addvout != nil {
+		fields = append(fields, treenode.FieldVout)
+	}
+	if m.addnode_confirmation_height != nil {
+		fields = append(fields, treenode.FieldNodeConfirmationHeight)
+	}
+	if m.addrefund_confirmation_height != nil {
+		fields = append(fields, treenode.FieldRefundConfirmationHeight)
+	}
+	return fields
 }
 
-// SetVerifyingPubkey sets the "verifying_pubkey" field.
-func (m *TreeNodeMutation) SetVerifyingPubkey(b []byte) {
-	m.verifying_pubkey = &b
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TreeNodeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case treenode.FieldValue:
+		return m.AddedValue()
+	case treenode.FieldVout:
+		return m.AddedVout()
+	case treenode.FieldNodeConfirmationHeight:
+		return m.AddedNodeConfirmationHeight()
+	case treenode.FieldRefundConfirmationHeight:
+		return m.AddedRefundConfirmationHeight()
+	}
+	return nil, false
 }
 
-// VerifyingPubkey returns the value of the "verifying_pubkey" field in the mutation.
-func (m *TreeNodeMutation) VerifyingPubkey() (r []byte, exists bool) {
-	v := m.verifying_pubkey
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TreeNodeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case treenode.FieldValue:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	case treenode.FieldVout:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVout(v)
+		return nil
+	case treenode.FieldNodeConfirmationHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNodeConfirmationHeight(v)
+		return nil
+	case treenode.FieldRefundConfirmationHeight:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefundConfirmationHeight(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TreeNode numeric field %s", name)
 }
 
-// OldVerifyingPubkey returns the old "verifying_pubkey" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldVerifyingPubkey(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVerifyingPubkey is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TreeNodeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(treenode.FieldRawRefundTx) {
+		fields = append(fields, treenode.FieldRawRefundTx)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVerifyingPubkey requires an ID field in the mutation")
+	if m.FieldCleared(treenode.FieldNodeConfirmationHeight) {
+		fields = append(fields, treenode.FieldNodeConfirmationHeight)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVerifyingPubkey: %w", err)
+	if m.FieldCleared(treenode.FieldRefundConfirmationHeight) {
+		fields = append(fields, treenode.FieldRefundConfirmationHeight)
 	}
-	return oldValue.VerifyingPubkey, nil
+	if m.FieldCleared(treenode.FieldDirectRefundTx) {
+		fields = append(fields, treenode.FieldDirectRefundTx)
+	}
+	if m.FieldCleared(treenode.FieldDirectTx) {
+		fields = append(fields, treenode.FieldDirectTx)
+	}
+	if m.FieldCleared(treenode.FieldDirectFromCpfpRefundTx) {
+		fields = append(fields, treenode.FieldDirectFromCpfpRefundTx)
+	}
+	return fields
 }
 
-// ResetVerifyingPubkey resets all changes to the "verifying_pubkey" field.
-func (m *TreeNodeMutation) ResetVerifyingPubkey() {
-	m.verifying_pubkey = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TreeNodeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetOwnerIdentityPubkey sets the "owner_identity_pubkey" field.
-func (m *TreeNodeMutation) SetOwnerIdentityPubkey(b []byte) {
-	m.owner_identity_pubkey = &b
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TreeNodeMutation) ClearField(name string) error {
+	switch name {
+	case treenode.FieldRawRefundTx:
+		m.ClearRawRefundTx()
+		return nil
+	case treenode.FieldNodeConfirmationHeight:
+		m.ClearNodeConfirmationHeight()
+		return nil
+	case treenode.FieldRefundConfirmationHeight:
+		m.ClearRefundConfirmationHeight()
+		return nil
+	case treenode.FieldDirectRefundTx:
+		m.ClearDirectRefundTx()
+		return nil
+	case treenode.FieldDirectTx:
+		m.ClearDirectTx()
+		return nil
+	case treenode.FieldDirectFromCpfpRefundTx:
+		m.ClearDirectFromCpfpRefundTx()
+		return nil
+	}
+	return fmt.Errorf("unknown TreeNode nullable field %s", name)
 }
 
-// OwnerIdentityPubkey returns the value of the "owner_identity_pubkey" field in the mutation.
-func (m *TreeNodeMutation) OwnerIdentityPubkey() (r []byte, exists bool) {
-	v := m.owner_identity_pubkey
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TreeNodeMutation) ResetField(name string) error {
+	switch name {
+	case treenode.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case treenode.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case treenode.FieldValue:
+		m.ResetValue()
+		return nil
+	case treenode.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case treenode.FieldVerifyingPubkey:
+		m.ResetVerifyingPubkey()
+		return nil
+	case treenode.FieldOwnerIdentityPubkey:
+		m.ResetOwnerIdentityPubkey()
+		return nil
+	case treenode.FieldOwnerSigningPubkey:
+		m.ResetOwnerSigningPubkey()
+		return nil
+	case treenode.FieldRawTx:
+		m.ResetRawTx()
+		return nil
+	case treenode.FieldVout:
+		m.ResetVout()
+		return nil
+	case treenode.FieldRawRefundTx:
+		m.ResetRawRefundTx()
+		return nil
+	case treenode.FieldNodeConfirmationHeight:
+		m.ResetNodeConfirmationHeight()
+		return nil
+	case treenode.FieldRefundConfirmationHeight:
+		m.ResetRefundConfirmationHeight()
+		return nil
+	case treenode.FieldDirectRefundTx:
+		m.ResetDirectRefundTx()
+		return nil
+	case treenode.FieldDirectTx:
+		m.ResetDirectTx()
+		return nil
+	case treenode.FieldDirectFromCpfpRefundTx:
+		m.ResetDirectFromCpfpRefundTx()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TreeNode field %s", name)
 }
 
-// OldOwnerIdentityPubkey returns the old "owner_identity_pubkey" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldOwnerIdentityPubkey(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerIdentityPubkey is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TreeNodeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.tree != nil {
+		edges = append(edges, treenode.EdgeTree)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerIdentityPubkey requires an ID field in the mutation")
+	if m.parent != nil {
+		edges = append(edges, treenode.EdgeParent)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerIdentityPubkey: %w", err)
+	if m.signing_keyshare != nil {
+		edges = append(edges, treenode.EdgeSigningKeyshare)
 	}
-	return oldValue.OwnerIdentityPubkey, nil
-}
-
-// ResetOwnerIdentityPubkey resets all changes to the "owner_identity_pubkey" field.
-func (m *TreeNodeMutation) ResetOwnerIdentityPubkey() {
-	m.owner_identity_pubkey = nil
+	if m.children != nil {
+		edges = append(edges, treenode.EdgeChildren)
+	}
+	return edges
 }
 
-// SetOwnerSigningPubkey sets the "owner_signing_pubkey" field.
-func (m *TreeNodeMutation) SetOwnerSigningPubkey(b []byte) {
-	m.owner_signing_pubkey = &b
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TreeNodeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case treenode.EdgeTree:
+		if id := m.tree; id != nil {
+			return []ent.Value{*id}
+		}
+	case treenode.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case treenode.EdgeSigningKeyshare:
+		if id := m.signing_keyshare; id != nil {
+			return []ent.Value{*id}
+		}
+	case treenode.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// OwnerSigningPubkey returns the value of the "owner_signing_pubkey" field in the mutation.
-func (m *TreeNodeMutation) OwnerSigningPubkey() (r []byte, exists bool) {
-	v := m.owner_signing_pubkey
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TreeNodeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, treenode.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TreeNodeMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case treenode.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldOwnerSigningPubkey returns the old "owner_signing_pubkey" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldOwnerSigningPubkey(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerSigningPubkey is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TreeNodeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedtree {
+		edges = append(edges, treenode.EdgeTree)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerSigningPubkey requires an ID field in the mutation")
+	if m.clearedparent {
+		edges = append(edges, treenode.EdgeParent)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerSigningPubkey: %w", err)
+	if m.clearedsigning_keyshare {
+		edges = append(edges, treenode.EdgeSigningKeyshare)
 	}
-	return oldValue.OwnerSigningPubkey, nil
+	if m.clearedchildren {
+		edges = append(edges, treenode.EdgeChildren)
+	}
+	return edges
 }
 
-// ResetOwnerSigningPubkey resets all changes to the "owner_signing_pubkey" field.
-func (m *TreeNodeMutation) ResetOwnerSigningPubkey() {
-	m.owner_signing_pubkey = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TreeNodeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case treenode.EdgeTree:
+		return m.clearedtree
+	case treenode.EdgeParent:
+		return m.clearedparent
+	case treenode.EdgeSigningKeyshare:
+		return m.clearedsigning_keyshare
+	case treenode.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
 }
 
-// SetRawTx sets the "raw_tx" field.
-func (m *TreeNodeMutation) SetRawTx(b []byte) {
-	m.raw_tx = &b
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TreeNodeMutation) ClearEdge(name string) error {
+	switch name {
+	case treenode.EdgeTree:
+		m.ClearTree()
+		return nil
+	case treenode.EdgeParent:
+		m.ClearParent()
+		return nil
+	case treenode.EdgeSigningKeyshare:
+		m.ClearSigningKeyshare()
+		return nil
+	}
+	return fmt.Errorf("unknown TreeNode unique edge %s", name)
 }
 
-// RawTx returns the value of the "raw_tx" field in the mutation.
-func (m *TreeNodeMutation) RawTx() (r []byte, exists bool) {
-	v := m.raw_tx
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TreeNodeMutation) ResetEdge(name string) error {
+	switch name {
+	case treenode.EdgeTree:
+		m.ResetTree()
+		return nil
+	case treenode.EdgeParent:
+		m.ResetParent()
+		return nil
+	case treenode.EdgeSigningKeyshare:
+		m.ResetSigningKeyshare()
+		return nil
+	case treenode.EdgeChildren:
+		m.ResetChildren()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TreeNode edge %s", name)
 }
 
-// OldRawTx returns the old "raw_tx" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldRawTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawTx is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawTx requires an ID field in the mutation")
+// UserEventMutation represents an operation that mutates the UserEvent nodes in the graph.
+type UserEventMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	identity_public_key *[]byte
+	sequence            *uint64
+	addsequence         *int64
+	event               *[]byte
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*UserEvent, error)
+	predicates          []predicate.UserEvent
+}
+
+var _ ent.Mutation = (*UserEventMutation)(nil)
+
+// usereventOption allows management of the mutation configuration using functional options.
+type usereventOption func(*UserEventMutation)
+
+// newUserEventMutation creates new mutation for the UserEvent entity.
+func newUserEventMutation(c config, op Op, opts ...usereventOption) *UserEventMutation {
+	m := &UserEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserEvent,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawTx: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.RawTx, nil
+	return m
 }
 
-// ResetRawTx resets all changes to the "raw_tx" field.
-func (m *TreeNodeMutation) ResetRawTx() {
-	m.raw_tx = nil
+// withUserEventID sets the ID field of the mutation.
+func withUserEventID(id uuid.UUID) usereventOption {
+	return func(m *UserEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserEvent
+		)
+		m.oldValue = func(ctx context.Context) (*UserEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetVout sets the "vout" field.
-func (m *TreeNodeMutation) SetVout(i int16) {
-	m.vout = &i
-	m.addvout = nil
+// withUserEvent sets the old UserEvent of the mutation.
+func withUserEvent(node *UserEvent) usereventOption {
+	return func(m *UserEventMutation) {
+		m.oldValue = func(context.Context) (*UserEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// Vout returns the value of the "vout" field in the mutation.
-func (m *TreeNodeMutation) Vout() (r int16, exists bool) {
-	v := m.vout
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldVout returns the old "vout" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldVout(ctx context.Context) (v int16, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVout is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVout requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVout: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Vout, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddVout adds i to the "vout" field.
-func (m *TreeNodeMutation) AddVout(i int16) {
-	if m.addvout != nil {
-		*m.addvout += i
-	} else {
-		m.addvout = &i
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserEvent entities.
+func (m *UserEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddedVout returns the value that was added to the "vout" field in this mutation.
-func (m *TreeNodeMutation) AddedVout() (r int16, exists bool) {
-	v := m.addvout
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetVout resets all changes to the "vout" field.
-func (m *TreeNodeMutation) ResetVout() {
-	m.vout = nil
-	m.addvout = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetRawRefundTx sets the "raw_refund_tx" field.
-func (m *TreeNodeMutation) SetRawRefundTx(b []byte) {
-	m.raw_refund_tx = &b
+// SetCreateTime sets the "create_time" field.
+func (m *UserEventMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// RawRefundTx returns the value of the "raw_refund_tx" field in the mutation.
-func (m *TreeNodeMutation) RawRefundTx() (r []byte, exists bool) {
-	v := m.raw_refund_tx
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *UserEventMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRawRefundTx returns the old "raw_refund_tx" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldCreateTime returns the old "create_time" field's value of the UserEvent entity.
+// If the UserEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldRawRefundTx(ctx context.Context) (v []byte, err error) {
+func (m *UserEventMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRawRefundTx is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRawRefundTx requires an ID field in the mutation")
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRawRefundTx: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
 	}
-	return oldValue.RawRefundTx, nil
-}
-
-// ClearRawRefundTx clears the value of the "raw_refund_tx" field.
-func (m *TreeNodeMutation) ClearRawRefundTx() {
-	m.raw_refund_tx = nil
-	m.clearedFields[treenode.FieldRawRefundTx] = struct{}{}
-}
-
-// RawRefundTxCleared returns if the "raw_refund_tx" field was cleared in this mutation.
-func (m *TreeNodeMutation) RawRefundTxCleared() bool {
-	_, ok := m.clearedFields[treenode.FieldRawRefundTx]
-	return ok
+	return oldValue.CreateTime, nil
 }
 
-// ResetRawRefundTx resets all changes to the "raw_refund_tx" field.
-func (m *TreeNodeMutation) ResetRawRefundTx() {
-	m.raw_refund_tx = nil
-	delete(m.clearedFields, treenode.FieldRawRefundTx)
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *UserEventMutation) ResetCreateTime() {
+	m.create_time = nil
 }
-
-// SetNodeConfirmationHeight sets the "node_confirmation_height" field.
-func (m *TreeNodeMutation) SetNodeConfirmationHeight(u uint64) {
-	m.node_confirmation_height = &u
-	m.addnode_confirmation_height = nil
+
+// SetUpdateTime sets the "update_time" field.
+func (m *UserEventMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
 }
 
-// NodeConfirmationHeight returns the value of the "node_confirmation_height" field in the mutation.
-func (m *TreeNodeMutation) NodeConfirmationHeight() (r uint64, exists bool) {
-	v := m.node_confirmation_height
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *UserEventMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNodeConfirmationHeight returns the old "node_confirmation_height" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateTime returns the old "update_time" field's value of the UserEvent entity.
+// If the UserEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldNodeConfirmationHeight(ctx context.Context) (v uint64, err error) {
+func (m *UserEventMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNodeConfirmationHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNodeConfirmationHeight requires an ID field in the mutation")
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNodeConfirmationHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
 	}
-	return oldValue.NodeConfirmationHeight, nil
+	return oldValue.UpdateTime, nil
 }
 
-// AddNodeConfirmationHeight adds u to the "node_confirmation_height" field.
-func (m *TreeNodeMutation) AddNodeConfirmationHeight(u int64) {
-	if m.addnode_confirmation_height != nil {
-		*m.addnode_confirmation_height += u
-	} else {
-		m.addnode_confirmation_height = &u
-	}
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *UserEventMutation) ResetUpdateTime() {
+	m.update_time = nil
 }
 
-// AddedNodeConfirmationHeight returns the value that was added to the "node_confirmation_height" field in this mutation.
-func (m *TreeNodeMutation) AddedNodeConfirmationHeight() (r int64, exists bool) {
-	v := m.addnode_confirmation_height
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (m *UserEventMutation) SetIdentityPublicKey(b []byte) {
+	m.identity_public_key = &b
+}
+
+// IdentityPublicKey returns the value of the "identity_public_key" field in the mutation.
+func (m *UserEventMutation) IdentityPublicKey() (r []byte, exists bool) {
+	v := m.identity_public_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearNodeConfirmationHeight clears the value of the "node_confirmation_height" field.
-func (m *TreeNodeMutation) ClearNodeConfirmationHeight() {
-	m.node_confirmation_height = nil
-	m.addnode_confirmation_height = nil
-	m.clearedFields[treenode.FieldNodeConfirmationHeight] = struct{}{}
-}
-
-// NodeConfirmationHeightCleared returns if the "node_confirmation_height" field was cleared in this mutation.
-func (m *TreeNodeMutation) NodeConfirmationHeightCleared() bool {
-	_, ok := m.clearedFields[treenode.FieldNodeConfirmationHeight]
-	return ok
+// OldIdentityPublicKey returns the old "identity_public_key" field's value of the UserEvent entity.
+// If the UserEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserEventMutation) OldIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentityPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentityPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentityPublicKey: %w", err)
+	}
+	return oldValue.IdentityPublicKey, nil
 }
 
-// ResetNodeConfirmationHeight resets all changes to the "node_confirmation_height" field.
-func (m *TreeNodeMutation) ResetNodeConfirmationHeight() {
-	m.node_confirmation_height = nil
-	m.addnode_confirmation_height = nil
-	delete(m.clearedFields, treenode.FieldNodeConfirmationHeight)
+// ResetIdentityPublicKey resets all changes to the "identity_public_key" field.
+func (m *UserEventMutation) ResetIdentityPublicKey() {
+	m.identity_public_key = nil
 }
 
-// SetRefundConfirmationHeight sets the "refund_confirmation_height" field.
-func (m *TreeNodeMutation) SetRefundConfirmationHeight(u uint64) {
-	m.refund_confirmation_height = &u
-	m.addrefund_confirmation_height = nil
+// SetSequence sets the "sequence" field.
+func (m *UserEventMutation) SetSequence(u uint64) {
+	m.sequence = &u
+	m.addsequence = nil
 }
 
-// RefundConfirmationHeight returns the value of the "refund_confirmation_height" field in the mutation.
-func (m *TreeNodeMutation) RefundConfirmationHeight() (r uint64, exists bool) {
-	v := m.refund_confirmation_height
+// Sequence returns the value of the "sequence" field in the mutation.
+func (m *UserEventMutation) Sequence() (r uint64, exists bool) {
+	v := m.sequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRefundConfirmationHeight returns the old "refund_confirmation_height" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldSequence returns the old "sequence" field's value of the UserEvent entity.
+// If the UserEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldRefundConfirmationHeight(ctx context.Context) (v uint64, err error) {
+func (m *UserEventMutation) OldSequence(ctx context.Context) (v uint64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRefundConfirmationHeight is only allowed on UpdateOne operations")
+		return v, errors.New("OldSequence is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRefundConfirmationHeight requires an ID field in the mutation")
+		return v, errors.New("OldSequence requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRefundConfirmationHeight: %w", err)
+		return v, fmt.Errorf("querying old value for OldSequence: %w", err)
 	}
-	return oldValue.RefundConfirmationHeight, nil
+	return oldValue.Sequence, nil
 }
 
-// AddRefundConfirmationHeight adds u to the "refund_confirmation_height" field.
-func (m *TreeNodeMutation) AddRefundConfirmationHeight(u int64) {
-	if m.addrefund_confirmation_height != nil {
-		*m.addrefund_confirmation_height += u
+// AddSequence adds u to the "sequence" field.
+func (m *UserEventMutation) AddSequence(u int64) {
+	if m.addsequence != nil {
+		*m.addsequence += u
 	} else {
-		m.addrefund_confirmation_height = &u
+		m.addsequence = &u
 	}
 }
 
-// AddedRefundConfirmationHeight returns the value that was added to the "refund_confirmation_height" field in this mutation.
-func (m *TreeNodeMutation) AddedRefundConfirmationHeight() (r int64, exists bool) {
-	v := m.addrefund_confirmation_height
+// AddedSequence returns the value that was added to the "sequence" field in this mutation.
+func (m *UserEventMutation) AddedSequence() (r int64, exists bool) {
+	v := m.addsequence
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearRefundConfirmationHeight clears the value of the "refund_confirmation_height" field.
-func (m *TreeNodeMutation) ClearRefundConfirmationHeight() {
-	m.refund_confirmation_height = nil
-	m.addrefund_confirmation_height = nil
-	m.clearedFields[treenode.FieldRefundConfirmationHeight] = struct{}{}
-}
-
-// RefundConfirmationHeightCleared returns if the "refund_confirmation_height" field was cleared in this mutation.
-func (m *TreeNodeMutation) RefundConfirmationHeightCleared() bool {
-	_, ok := m.clearedFields[treenode.FieldRefundConfirmationHeight]
-	return ok
-}
-
-// ResetRefundConfirmationHeight resets all changes to the "refund_confirmation_height" field.
-func (m *TreeNodeMutation) ResetRefundConfirmationHeight() {
-	m.refund_confirmation_height = nil
-	m.addrefund_confirmation_height = nil
-	delete(m.clearedFields, treenode.FieldRefundConfirmationHeight)
+// ResetSequence resets all changes to the "sequence" field.
+func (m *UserEventMutation) ResetSequence() {
+	m.sequence = nil
+	m.addsequence = nil
 }
 
-// SetDirectRefundTx sets the "direct_refund_tx" field.
-func (m *TreeNodeMutation) SetDirectRefundTx(b []byte) {
-	m.direct_refund_tx = &b
+// SetEvent sets the "event" field.
+func (m *UserEventMutation) SetEvent(b []byte) {
+	m.event = &b
 }
 
-// DirectRefundTx returns the value of the "direct_refund_tx" field in the mutation.
-func (m *TreeNodeMutation) DirectRefundTx() (r []byte, exists bool) {
-	v := m.direct_refund_tx
+// Event returns the value of the "event" field in the mutation.
+func (m *UserEventMutation) Event() (r []byte, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDirectRefundTx returns the old "direct_refund_tx" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
+// OldEvent returns the old "event" field's value of the UserEvent entity.
+// If the UserEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldDirectRefundTx(ctx context.Context) (v []byte, err error) {
+func (m *UserEventMutation) OldEvent(ctx context.Context) (v []byte, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDirectRefundTx is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDirectRefundTx requires an ID field in the mutation")
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDirectRefundTx: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
 	}
-	return oldValue.DirectRefundTx, nil
+	return oldValue.Event, nil
 }
 
-// ClearDirectRefundTx clears the value of the "direct_refund_tx" field.
-func (m *TreeNodeMutation) ClearDirectRefundTx() {
-	m.direct_refund_tx = nil
-	m.clearedFields[treenode.FieldDirectRefundTx] = struct{}{}
+// ResetEvent resets all changes to the "event" field.
+func (m *UserEventMutation) ResetEvent() {
+	m.event = nil
 }
 
-// DirectRefundTxCleared returns if the "direct_refund_tx" field was cleared in this mutation.
-func (m *TreeNodeMutation) DirectRefundTxCleared() bool {
-	_, ok := m.clearedFields[treenode.FieldDirectRefundTx]
-	return ok
+// Where appends a list predicates to the UserEventMutation builder.
+func (m *UserEventMutation) Where(ps ...predicate.UserEvent) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetDirectRefundTx resets all changes to the "direct_refund_tx" field.
-func (m *TreeNodeMutation) ResetDirectRefundTx() {
-	m.direct_refund_tx = nil
-	delete(m.clearedFields, treenode.FieldDirectRefundTx)
+// WhereP appends storage-level predicates to the UserEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetDirectTx sets the "direct_tx" field.
-func (m *TreeNodeMutation) SetDirectTx(b []byte) {
-	m.direct_tx = &b
+// Op returns the operation name.
+func (m *UserEventMutation) Op() Op {
+	return m.op
 }
 
-// DirectTx returns the value of the "direct_tx" field in the mutation.
-func (m *TreeNodeMutation) DirectTx() (r []byte, exists bool) {
-	v := m.direct_tx
-	if v == nil {
-		return
+// SetOp allows setting the mutation operation.
+func (m *UserEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserEvent).
+func (m *UserEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.create_time != nil {
+		fields = append(fields, userevent.FieldCreateTime)
 	}
-	return *v, true
+	if m.update_time != nil {
+		fields = append(fields, userevent.FieldUpdateTime)
+	}
+	if m.identity_public_key != nil {
+		fields = append(fields, userevent.FieldIdentityPublicKey)
+	}
+	if m.sequence != nil {
+		fields = append(fields, userevent.FieldSequence)
+	}
+	if m.event != nil {
+		fields = append(fields, userevent.FieldEvent)
+	}
+	return fields
 }
 
-// OldDirectTx returns the old "direct_tx" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldDirectTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDirectTx is only allowed on UpdateOne operations")
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userevent.FieldCreateTime:
+		return m.CreateTime()
+	case userevent.FieldUpdateTime:
+		return m.UpdateTime()
+	case userevent.FieldIdentityPublicKey:
+		return m.IdentityPublicKey()
+	case userevent.FieldSequence:
+		return m.Sequence()
+	case userevent.FieldEvent:
+		return m.Event()
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDirectTx requires an ID field in the mutation")
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userevent.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case userevent.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case userevent.FieldIdentityPublicKey:
+		return m.OldIdentityPublicKey(ctx)
+	case userevent.FieldSequence:
+		return m.OldSequence(ctx)
+	case userevent.FieldEvent:
+		return m.OldEvent(ctx)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDirectTx: %w", err)
+	return nil, fmt.Errorf("unknown UserEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userevent.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case userevent.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case userevent.FieldIdentityPublicKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdentityPublicKey(v)
+		return nil
+	case userevent.FieldSequence:
+		v, ok := value.(uint64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSequence(v)
+		return nil
+	case userevent.FieldEvent:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvent(v)
+		return nil
 	}
-	return oldValue.DirectTx, nil
+	return fmt.Errorf("unknown UserEvent field %s", name)
 }
 
-// ClearDirectTx clears the value of the "direct_tx" field.
-func (m *TreeNodeMutation) ClearDirectTx() {
-	m.direct_tx = nil
-	m.clearedFields[treenode.FieldDirectTx] = struct{}{}
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addsequence != nil {
+		fields = append(fields, userevent.FieldSequence)
+	}
+	return fields
 }
 
-// DirectTxCleared returns if the "direct_tx" field was cleared in this mutation.
-func (m *TreeNodeMutation) DirectTxCleared() bool {
-	_, ok := m.clearedFields[treenode.FieldDirectTx]
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userevent.FieldSequence:
+		return m.AddedSequence()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case userevent.FieldSequence:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSequence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserEventMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetDirectTx resets all changes to the "direct_tx" field.
-func (m *TreeNodeMutation) ResetDirectTx() {
-	m.direct_tx = nil
-	delete(m.clearedFields, treenode.FieldDirectTx)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserEventMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserEvent nullable field %s", name)
 }
 
-// SetDirectFromCpfpRefundTx sets the "direct_from_cpfp_refund_tx" field.
-func (m *TreeNodeMutation) SetDirectFromCpfpRefundTx(b []byte) {
-	m.direct_from_cpfp_refund_tx = &b
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserEventMutation) ResetField(name string) error {
+	switch name {
+	case userevent.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case userevent.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case userevent.FieldIdentityPublicKey:
+		m.ResetIdentityPublicKey()
+		return nil
+	case userevent.FieldSequence:
+		m.ResetSequence()
+		return nil
+	case userevent.FieldEvent:
+		m.ResetEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown UserEvent field %s", name)
 }
 
-// DirectFromCpfpRefundTx returns the value of the "direct_from_cpfp_refund_tx" field in the mutation.
-func (m *TreeNodeMutation) DirectFromCpfpRefundTx() (r []byte, exists bool) {
-	v := m.direct_from_cpfp_refund_tx
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldDirectFromCpfpRefundTx returns the old "direct_from_cpfp_refund_tx" field's value of the TreeNode entity.
-// If the TreeNode object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TreeNodeMutation) OldDirectFromCpfpRefundTx(ctx context.Context) (v []byte, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDirectFromCpfpRefundTx is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDirectFromCpfpRefundTx requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDirectFromCpfpRefundTx: %w", err)
-	}
-	return oldValue.DirectFromCpfpRefundTx, nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ClearDirectFromCpfpRefundTx clears the value of the "direct_from_cpfp_refund_tx" field.
-func (m *TreeNodeMutation) ClearDirectFromCpfpRefundTx() {
-	m.direct_from_cpfp_refund_tx = nil
-	m.clearedFields[treenode.FieldDirectFromCpfpRefundTx] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// DirectFromCpfpRefundTxCleared returns if the "direct_from_cpfp_refund_tx" field was cleared in this mutation.
-func (m *TreeNodeMutation) DirectFromCpfpRefundTxCleared() bool {
-	_, ok := m.clearedFields[treenode.FieldDirectFromCpfpRefundTx]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetDirectFromCpfpRefundTx resets all changes to the "direct_from_cpfp_refund_tx" field.
-func (m *TreeNodeMutation) ResetDirectFromCpfpRefundTx() {
-	m.direct_from_cpfp_refund_tx = nil
-	delete(m.clearedFields, treenode.FieldDirectFromCpfpRefundTx)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetTreeID sets the "tree" edge to the Tree entity by id.
-func (m *TreeNodeMutation) SetTreeID(id uuid.UUID) {
-	m.tree = &id
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserEventMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearTree clears the "tree" edge to the Tree entity.
-func (m *TreeNodeMutation) ClearTree() {
-	m.clearedtree = true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserEvent unique edge %s", name)
 }
 
-// TreeCleared reports if the "tree" edge to the Tree entity was cleared.
-func (m *TreeNodeMutation) TreeCleared() bool {
-	return m.clearedtree
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserEvent edge %s", name)
 }
 
-// TreeID returns the "tree" edge ID in the mutation.
-func (m *TreeNodeMutation) TreeID() (id uuid.UUID, exists bool) {
-	if m.tree != nil {
-		return *m.tree, true
+// UserEventSequenceMutation represents an operation that mutates the UserEventSequence nodes in the graph.
+type UserEventSequenceMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	create_time         *time.Time
+	update_time         *time.Time
+	identity_public_key *[]byte
+	last_sequence       *uint64
+	addlast_sequence    *int64
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*UserEventSequence, error)
+	predicates          []predicate.UserEventSequence
+}
+
+var _ ent.Mutation = (*UserEventSequenceMutation)(nil)
+
+// usereventsequenceOption allows management of the mutation configuration using functional options.
+type usereventsequenceOption func(*UserEventSequenceMutation)
+
+// newUserEventSequenceMutation creates new mutation for the UserEventSequence entity.
+func newUserEventSequenceMutation(c config, op Op, opts ...usereventsequenceOption) *UserEventSequenceMutation {
+	m := &UserEventSequenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserEventSequence,
+		clearedFields: make(map[string]struct{}),
 	}
-	return
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// TreeIDs returns the "tree" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TreeID instead. It exists only for internal usage by the builders.
-func (m *TreeNodeMutation) TreeIDs() (ids []uuid.UUID) {
-	if id := m.tree; id != nil {
-		ids = append(ids, *id)
+// withUserEventSequenceID sets the ID field of the mutation.
+func withUserEventSequenceID(id uuid.UUID) usereventsequenceOption {
+	return func(m *UserEventSequenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserEventSequence
+		)
+		m.oldValue = func(ctx context.Context) (*UserEventSequence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserEventSequence.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// ResetTree resets all changes to the "tree" edge.
-func (m *TreeNodeMutation) ResetTree() {
-	m.tree = nil
-	m.clearedtree = false
+// withUserEventSequence sets the old UserEventSequence of the mutation.
+func withUserEventSequence(node *UserEventSequence) usereventsequenceOption {
+	return func(m *UserEventSequenceMutation) {
+		m.oldValue = func(context.Context) (*UserEventSequence, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetParentID sets the "parent" edge to the TreeNode entity by id.
-func (m *TreeNodeMutation) SetParentID(id uuid.UUID) {
-	m.parent = &id
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserEventSequenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ClearParent clears the "parent" edge to the TreeNode entity.
-func (m *TreeNodeMutation) ClearParent() {
-	m.clearedparent = true
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserEventSequenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ParentCleared reports if the "parent" edge to the TreeNode entity was cleared.
-func (m *TreeNodeMutation) ParentCleared() bool {
-	return m.clearedparent
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserEventSequence entities.
+func (m *UserEventSequenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *TreeNodeMutation) ParentID() (id uuid.UUID, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserEventSequenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return
+	return *m.id, true
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *TreeNodeMutation) ParentIDs() (ids []uuid.UUID) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserEventSequenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserEventSequence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *TreeNodeMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// SetCreateTime sets the "create_time" field.
+func (m *UserEventSequenceMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
 }
 
-// SetSigningKeyshareID sets the "signing_keyshare" edge to the SigningKeyshare entity by id.
-func (m *TreeNodeMutation) SetSigningKeyshareID(id uuid.UUID) {
-	m.signing_keyshare = &id
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *UserEventSequenceMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearSigningKeyshare clears the "signing_keyshare" edge to the SigningKeyshare entity.
-func (m *TreeNodeMutation) ClearSigningKeyshare() {
-	m.clearedsigning_keyshare = true
+// OldCreateTime returns the old "create_time" field's value of the UserEventSequence entity.
+// If the UserEventSequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserEventSequenceMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
 }
 
-// SigningKeyshareCleared reports if the "signing_keyshare" edge to the SigningKeyshare entity was cleared.
-func (m *TreeNodeMutation) SigningKeyshareCleared() bool {
-	return m.clearedsigning_keyshare
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *UserEventSequenceMutation) ResetCreateTime() {
+	m.create_time = nil
 }
 
-// SigningKeyshareID returns the "signing_keyshare" edge ID in the mutation.
-func (m *TreeNodeMutation) SigningKeyshareID() (id uuid.UUID, exists bool) {
-	if m.signing_keyshare != nil {
-		return *m.signing_keyshare, true
+// SetUpdateTime sets the "update_time" field.
+func (m *UserEventSequenceMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *UserEventSequenceMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SigningKeyshareIDs returns the "signing_keyshare" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SigningKeyshareID instead. It exists only for internal usage by the builders.
-func (m *TreeNodeMutation) SigningKeyshareIDs() (ids []uuid.UUID) {
-	if id := m.signing_keyshare; id != nil {
-		ids = append(ids, *id)
+// OldUpdateTime returns the old "update_time" field's value of the UserEventSequence entity.
+// If the UserEventSequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserEventSequenceMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
 }
 
-// ResetSigningKeyshare resets all changes to the "signing_keyshare" edge.
-func (m *TreeNodeMutation) ResetSigningKeyshare() {
-	m.signing_keyshare = nil
-	m.clearedsigning_keyshare = false
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *UserEventSequenceMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (m *UserEventSequenceMutation) SetIdentityPublicKey(b []byte) {
+	m.identity_public_key = &b
 }
 
-// AddChildIDs adds the "children" edge to the TreeNode entity by ids.
-func (m *TreeNodeMutation) AddChildIDs(ids ...uuid.UUID) {
-	if m.children == nil {
-		m.children = make(map[uuid.UUID]struct{})
+// IdentityPublicKey returns the value of the "identity_public_key" field in the mutation.
+func (m *UserEventSequenceMutation) IdentityPublicKey() (r []byte, exists bool) {
+	v := m.identity_public_key
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.children[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldIdentityPublicKey returns the old "identity_public_key" field's value of the UserEventSequence entity.
+// If the UserEventSequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserEventSequenceMutation) OldIdentityPublicKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdentityPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdentityPublicKey requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdentityPublicKey: %w", err)
+	}
+	return oldValue.IdentityPublicKey, nil
 }
 
-// ClearChildren clears the "children" edge to the TreeNode entity.
-func (m *TreeNodeMutation) ClearChildren() {
-	m.clearedchildren = true
+// ResetIdentityPublicKey resets all changes to the "identity_public_key" field.
+func (m *UserEventSequenceMutation) ResetIdentityPublicKey() {
+	m.identity_public_key = nil
 }
 
-// ChildrenCleared reports if the "children" edge to the TreeNode entity was cleared.
-func (m *TreeNodeMutation) ChildrenCleared() bool {
-	return m.clearedchildren
+// SetLastSequence sets the "last_sequence" field.
+func (m *UserEventSequenceMutation) SetLastSequence(u uint64) {
+	m.last_sequence = &u
+	m.addlast_sequence = nil
 }
 
-// RemoveChildIDs removes the "children" edge to the TreeNode entity by IDs.
-func (m *TreeNodeMutation) RemoveChildIDs(ids ...uuid.UUID) {
-	if m.removedchildren == nil {
-		m.removedchildren = make(map[uuid.UUID]struct{})
+// LastSequence returns the value of the "last_sequence" field in the mutation.
+func (m *UserEventSequenceMutation) LastSequence() (r uint64, exists bool) {
+	v := m.last_sequence
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.children, ids[i])
-		m.removedchildren[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLastSequence returns the old "last_sequence" field's value of the UserEventSequence entity.
+// If the UserEventSequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserEventSequenceMutation) OldLastSequence(ctx context.Context) (v uint64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSequence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSequence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSequence: %w", err)
 	}
+	return oldValue.LastSequence, nil
 }
 
-// RemovedChildren returns the removed IDs of the "children" edge to the TreeNode entity.
-func (m *TreeNodeMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.removedchildren {
-		ids = append(ids, id)
+// AddLastSequence adds u to the "last_sequence" field.
+func (m *UserEventSequenceMutation) AddLastSequence(u int64) {
+	if m.addlast_sequence != nil {
+		*m.addlast_sequence += u
+	} else {
+		m.addlast_sequence = &u
 	}
-	return
 }
 
-// ChildrenIDs returns the "children" edge IDs in the mutation.
-func (m *TreeNodeMutation) ChildrenIDs() (ids []uuid.UUID) {
-	for id := range m.children {
-		ids = append(ids, id)
+// AddedLastSequence returns the value that was added to the "last_sequence" field in this mutation.
+func (m *UserEventSequenceMutation) AddedLastSequence() (r int64, exists bool) {
+	v := m.addlast_sequence
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetChildren resets all changes to the "children" edge.
-func (m *TreeNodeMutation) ResetChildren() {
-	m.children = nil
-	m.clearedchildren = false
-	m.removedchildren = nil
+// ResetLastSequence resets all changes to the "last_sequence" field.
+func (m *UserEventSequenceMutation) ResetLastSequence() {
+	m.last_sequence = nil
+	m.addlast_sequence = nil
 }
 
-// Where appends a list predicates to the TreeNodeMutation builder.
-func (m *TreeNodeMutation) Where(ps ...predicate.TreeNode) {
+// Where appends a list predicates to the UserEventSequenceMutation builder.
+func (m *UserEventSequenceMutation) Where(ps ...predicate.UserEventSequence) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TreeNodeMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserEventSequenceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TreeNodeMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.TreeNode, len(ps))
+func (m *UserEventSequenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserEventSequence, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -21313,69 +25572,36 @@ func (m *TreeNodeMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TreeNodeMutation) Op() Op {
+func (m *UserEventSequenceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TreeNodeMutation) SetOp(op Op) {
+func (m *UserEventSequenceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (TreeNode).
-func (m *TreeNodeMutation) Type() string {
+// Type returns the node type of this mutation (UserEventSequence).
+func (m *UserEventSequenceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TreeNodeMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+func (m *UserEventSequenceMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.create_time != nil {
-		fields = append(fields, treenode.FieldCreateTime)
+		fields = append(fields, usereventsequence.FieldCreateTime)
 	}
 	if m.update_time != nil {
-		fields = append(fields, treenode.FieldUpdateTime)
-	}
-	if m.value != nil {
-		fields = append(fields, treenode.FieldValue)
-	}
-	if m.status != nil {
-		fields = append(fields, treenode.FieldStatus)
-	}
-	if m.verifying_pubkey != nil {
-		fields = append(fields, treenode.FieldVerifyingPubkey)
-	}
-	if m.owner_identity_pubkey != nil {
-		fields = append(fields, treenode.FieldOwnerIdentityPubkey)
-	}
-	if m.owner_signing_pubkey != nil {
-		fields = append(fields, treenode.FieldOwnerSigningPubkey)
-	}
-	if m.raw_tx != nil {
-		fields = append(fields, treenode.FieldRawTx)
-	}
-	if m.vout != nil {
-		fields = append(fields, treenode.FieldVout)
-	}
-	if m.raw_refund_tx != nil {
-		fields = append(fields, treenode.FieldRawRefundTx)
-	}
-	if m.node_confirmation_height != nil {
-		fields = append(fields, treenode.FieldNodeConfirmationHeight)
+		fields = append(fields, usereventsequence.FieldUpdateTime)
 	}
-	if m.refund_confirmation_height != nil {
-		fields = append(fields, treenode.FieldRefundConfirmationHeight)
-	}
-	if m.direct_refund_tx != nil {
-		fields = append(fields, treenode.FieldDirectRefundTx)
-	}
-	if m.direct_tx != nil {
-		fields = append(fields, treenode.FieldDirectTx)
+	if m.identity_public_key != nil {
+		fields = append(fields, usereventsequence.FieldIdentityPublicKey)
 	}
-	if m.direct_from_cpfp_refund_tx != nil {
-		fields = append(fields, treenode.FieldDirectFromCpfpRefundTx)
+	if m.last_sequence != nil {
+		fields = append(fields, usereventsequence.FieldLastSequence)
 	}
 	return fields
 }
@@ -21383,38 +25609,16 @@ func (m *TreeNodeMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TreeNodeMutation) Field(name string) (ent.Value, bool) {
+func (m *UserEventSequenceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case treenode.FieldCreateTime:
+	case usereventsequence.FieldCreateTime:
 		return m.CreateTime()
-	case treenode.FieldUpdateTime:
+	case usereventsequence.FieldUpdateTime:
 		return m.UpdateTime()
-	case treenode.FieldValue:
-		return m.Value()
-	case treenode.FieldStatus:
-		return m.Status()
-	case treenode.FieldVerifyingPubkey:
-		return m.VerifyingPubkey()
-	case treenode.FieldOwnerIdentityPubkey:
-		return m.OwnerIdentityPubkey()
-	case treenode.FieldOwnerSigningPubkey:
-		return m.OwnerSigningPubkey()
-	case treenode.FieldRawTx:
-		return m.RawTx()
-	case treenode.FieldVout:
-		return m.Vout()
-	case treenode.FieldRawRefundTx:
-		return m.RawRefundTx()
-	case treenode.FieldNodeConfirmationHeight:
-		return m.NodeConfirmationHeight()
-	case treenode.FieldRefundConfirmationHeight:
-		return m.RefundConfirmationHeight()
-	case treenode.FieldDirectRefundTx:
-		return m.DirectRefundTx()
-	case treenode.FieldDirectTx:
-		return m.DirectTx()
-	case treenode.FieldDirectFromCpfpRefundTx:
-		return m.DirectFromCpfpRefundTx()
+	case usereventsequence.FieldIdentityPublicKey:
+		return m.IdentityPublicKey()
+	case usereventsequence.FieldLastSequence:
+		return m.LastSequence()
 	}
 	return nil, false
 }
@@ -21422,171 +25626,63 @@ func (m *TreeNodeMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TreeNodeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserEventSequenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case treenode.FieldCreateTime:
+	case usereventsequence.FieldCreateTime:
 		return m.OldCreateTime(ctx)
-	case treenode.FieldUpdateTime:
+	case usereventsequence.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
-	case treenode.FieldValue:
-		return m.OldValue(ctx)
-	case treenode.FieldStatus:
-		return m.OldStatus(ctx)
-	case treenode.FieldVerifyingPubkey:
-		return m.OldVerifyingPubkey(ctx)
-	case treenode.FieldOwnerIdentityPubkey:
-		return m.OldOwnerIdentityPubkey(ctx)
-	case treenode.FieldOwnerSigningPubkey:
-		return m.OldOwnerSigningPubkey(ctx)
-	case treenode.FieldRawTx:
-		return m.OldRawTx(ctx)
-	case treenode.FieldVout:
-		return m.OldVout(ctx)
-	case treenode.FieldRawRefundTx:
-		return m.OldRawRefundTx(ctx)
-	case treenode.FieldNodeConfirmationHeight:
-		return m.OldNodeConfirmationHeight(ctx)
-	case treenode.FieldRefundConfirmationHeight:
-		return m.OldRefundConfirmationHeight(ctx)
-	case treenode.FieldDirectRefundTx:
-		return m.OldDirectRefundTx(ctx)
-	case treenode.FieldDirectTx:
-		return m.OldDirectTx(ctx)
-	case treenode.FieldDirectFromCpfpRefundTx:
-		return m.OldDirectFromCpfpRefundTx(ctx)
+	case usereventsequence.FieldIdentityPublicKey:
+		return m.OldIdentityPublicKey(ctx)
+	case usereventsequence.FieldLastSequence:
+		return m.OldLastSequence(ctx)
 	}
-	return nil, fmt.Errorf("unknown TreeNode field %s", name)
+	return nil, fmt.Errorf("unknown UserEventSequence field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *TreeNodeMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case treenode.FieldCreateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreateTime(v)
-		return nil
-	case treenode.FieldUpdateTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdateTime(v)
-		return nil
-	case treenode.FieldValue:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetValue(v)
-		return nil
-	case treenode.FieldStatus:
-		v, ok := value.(schematype.TreeNodeStatus)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case treenode.FieldVerifyingPubkey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVerifyingPubkey(v)
-		return nil
-	case treenode.FieldOwnerIdentityPubkey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerIdentityPubkey(v)
-		return nil
-	case treenode.FieldOwnerSigningPubkey:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerSigningPubkey(v)
-		return nil
-	case treenode.FieldRawTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRawTx(v)
-		return nil
-	case treenode.FieldVout:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetVout(v)
-		return nil
-	case treenode.FieldRawRefundTx:
-		v, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRawRefundTx(v)
-		return nil
-	case treenode.FieldNodeConfirmationHeight:
-		v, ok := value.(uint64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNodeConfirmationHeight(v)
-		return nil
-	case treenode.FieldRefundConfirmationHeight:
-		v, ok := value.(uint64)
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserEventSequenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case usereventsequence.FieldCreateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRefundConfirmationHeight(v)
+		m.SetCreateTime(v)
 		return nil
-	case treenode.FieldDirectRefundTx:
-		v, ok := value.([]byte)
+	case usereventsequence.FieldUpdateTime:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDirectRefundTx(v)
+		m.SetUpdateTime(v)
 		return nil
-	case treenode.FieldDirectTx:
+	case usereventsequence.FieldIdentityPublicKey:
 		v, ok := value.([]byte)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDirectTx(v)
+		m.SetIdentityPublicKey(v)
 		return nil
-	case treenode.FieldDirectFromCpfpRefundTx:
-		v, ok := value.([]byte)
+	case usereventsequence.FieldLastSequence:
+		v, ok := value.(uint64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDirectFromCpfpRefundTx(v)
+		m.SetLastSequence(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TreeNode field %s", name)
+	return fmt.Errorf("unknown UserEventSequence field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TreeNodeMutation) AddedFields() []string {
+func (m *UserEventSequenceMutation) AddedFields() []string {
 	var fields []string
-	if m.addvalue != nil {
-		fields = append(fields, treenode.FieldValue)
-	}
-	if m.addvout != nil {
-		fields = append(fields, treenode.FieldVout)
-	}
-	if m.addnode_confirmation_height != nil {
-		fields = append(fields, treenode.FieldNodeConfirmationHeight)
-	}
-	if m.addrefund_confirmation_height != nil {
-		fields = append(fields, treenode.FieldRefundConfirmationHeight)
+	if m.addlast_sequence != nil {
+		fields = append(fields, usereventsequence.FieldLastSequence)
 	}
 	return fields
 }
@@ -21594,16 +25690,10 @@ func (m *TreeNodeMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TreeNodeMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserEventSequenceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case treenode.FieldValue:
-		return m.AddedValue()
-	case treenode.FieldVout:
-		return m.AddedVout()
-	case treenode.FieldNodeConfirmationHeight:
-		return m.AddedNodeConfirmationHeight()
-	case treenode.FieldRefundConfirmationHeight:
-		return m.AddedRefundConfirmationHeight()
+	case usereventsequence.FieldLastSequence:
+		return m.AddedLastSequence()
 	}
 	return nil, false
 }
@@ -21611,287 +25701,104 @@ func (m *TreeNodeMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TreeNodeMutation) AddField(name string, value ent.Value) error {
+func (m *UserEventSequenceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case treenode.FieldValue:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddValue(v)
-		return nil
-	case treenode.FieldVout:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddVout(v)
-		return nil
-	case treenode.FieldNodeConfirmationHeight:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddNodeConfirmationHeight(v)
-		return nil
-	case treenode.FieldRefundConfirmationHeight:
+	case usereventsequence.FieldLastSequence:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRefundConfirmationHeight(v)
+		m.AddLastSequence(v)
 		return nil
 	}
-	return fmt.Errorf("unknown TreeNode numeric field %s", name)
+	return fmt.Errorf("unknown UserEventSequence numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TreeNodeMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(treenode.FieldRawRefundTx) {
-		fields = append(fields, treenode.FieldRawRefundTx)
-	}
-	if m.FieldCleared(treenode.FieldNodeConfirmationHeight) {
-		fields = append(fields, treenode.FieldNodeConfirmationHeight)
-	}
-	if m.FieldCleared(treenode.FieldRefundConfirmationHeight) {
-		fields = append(fields, treenode.FieldRefundConfirmationHeight)
-	}
-	if m.FieldCleared(treenode.FieldDirectRefundTx) {
-		fields = append(fields, treenode.FieldDirectRefundTx)
-	}
-	if m.FieldCleared(treenode.FieldDirectTx) {
-		fields = append(fields, treenode.FieldDirectTx)
-	}
-	if m.FieldCleared(treenode.FieldDirectFromCpfpRefundTx) {
-		fields = append(fields, treenode.FieldDirectFromCpfpRefundTx)
-	}
-	return fields
+func (m *UserEventSequenceMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TreeNodeMutation) FieldCleared(name string) bool {
+func (m *UserEventSequenceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TreeNodeMutation) ClearField(name string) error {
-	switch name {
-	case treenode.FieldRawRefundTx:
-		m.ClearRawRefundTx()
-		return nil
-	case treenode.FieldNodeConfirmationHeight:
-		m.ClearNodeConfirmationHeight()
-		return nil
-	case treenode.FieldRefundConfirmationHeight:
-		m.ClearRefundConfirmationHeight()
-		return nil
-	case treenode.FieldDirectRefundTx:
-		m.ClearDirectRefundTx()
-		return nil
-	case treenode.FieldDirectTx:
-		m.ClearDirectTx()
-		return nil
-	case treenode.FieldDirectFromCpfpRefundTx:
-		m.ClearDirectFromCpfpRefundTx()
-		return nil
-	}
-	return fmt.Errorf("unknown TreeNode nullable field %s", name)
+func (m *UserEventSequenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserEventSequence nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TreeNodeMutation) ResetField(name string) error {
+func (m *UserEventSequenceMutation) ResetField(name string) error {
 	switch name {
-	case treenode.FieldCreateTime:
+	case usereventsequence.FieldCreateTime:
 		m.ResetCreateTime()
 		return nil
-	case treenode.FieldUpdateTime:
+	case usereventsequence.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
-	case treenode.FieldValue:
-		m.ResetValue()
-		return nil
-	case treenode.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case treenode.FieldVerifyingPubkey:
-		m.ResetVerifyingPubkey()
-		return nil
-	case treenode.FieldOwnerIdentityPubkey:
-		m.ResetOwnerIdentityPubkey()
-		return nil
-	case treenode.FieldOwnerSigningPubkey:
-		m.ResetOwnerSigningPubkey()
-		return nil
-	case treenode.FieldRawTx:
-		m.ResetRawTx()
-		return nil
-	case treenode.FieldVout:
-		m.ResetVout()
-		return nil
-	case treenode.FieldRawRefundTx:
-		m.ResetRawRefundTx()
-		return nil
-	case treenode.FieldNodeConfirmationHeight:
-		m.ResetNodeConfirmationHeight()
-		return nil
-	case treenode.FieldRefundConfirmationHeight:
-		m.ResetRefundConfirmationHeight()
-		return nil
-	case treenode.FieldDirectRefundTx:
-		m.ResetDirectRefundTx()
-		return nil
-	case treenode.FieldDirectTx:
-		m.ResetDirectTx()
+	case usereventsequence.FieldIdentityPublicKey:
+		m.ResetIdentityPublicKey()
 		return nil
-	case treenode.FieldDirectFromCpfpRefundTx:
-		m.ResetDirectFromCpfpRefundTx()
+	case usereventsequence.FieldLastSequence:
+		m.ResetLastSequence()
 		return nil
 	}
-	return fmt.Errorf("unknown TreeNode field %s", name)
+	return fmt.Errorf("unknown UserEventSequence field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TreeNodeMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.tree != nil {
-		edges = append(edges, treenode.EdgeTree)
-	}
-	if m.parent != nil {
-		edges = append(edges, treenode.EdgeParent)
-	}
-	if m.signing_keyshare != nil {
-		edges = append(edges, treenode.EdgeSigningKeyshare)
-	}
-	if m.children != nil {
-		edges = append(edges, treenode.EdgeChildren)
-	}
+func (m *UserEventSequenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TreeNodeMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case treenode.EdgeTree:
-		if id := m.tree; id != nil {
-			return []ent.Value{*id}
-		}
-	case treenode.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
-		}
-	case treenode.EdgeSigningKeyshare:
-		if id := m.signing_keyshare; id != nil {
-			return []ent.Value{*id}
-		}
-	case treenode.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.children))
-		for id := range m.children {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserEventSequenceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TreeNodeMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedchildren != nil {
-		edges = append(edges, treenode.EdgeChildren)
-	}
+func (m *UserEventSequenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TreeNodeMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case treenode.EdgeChildren:
-		ids := make([]ent.Value, 0, len(m.removedchildren))
-		for id := range m.removedchildren {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserEventSequenceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TreeNodeMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedtree {
-		edges = append(edges, treenode.EdgeTree)
-	}
-	if m.clearedparent {
-		edges = append(edges, treenode.EdgeParent)
-	}
-	if m.clearedsigning_keyshare {
-		edges = append(edges, treenode.EdgeSigningKeyshare)
-	}
-	if m.clearedchildren {
-		edges = append(edges, treenode.EdgeChildren)
-	}
+func (m *UserEventSequenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TreeNodeMutation) EdgeCleared(name string) bool {
-	switch name {
-	case treenode.EdgeTree:
-		return m.clearedtree
-	case treenode.EdgeParent:
-		return m.clearedparent
-	case treenode.EdgeSigningKeyshare:
-		return m.clearedsigning_keyshare
-	case treenode.EdgeChildren:
-		return m.clearedchildren
-	}
+func (m *UserEventSequenceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TreeNodeMutation) ClearEdge(name string) error {
-	switch name {
-	case treenode.EdgeTree:
-		m.ClearTree()
-		return nil
-	case treenode.EdgeParent:
-		m.ClearParent()
-		return nil
-	case treenode.EdgeSigningKeyshare:
-		m.ClearSigningKeyshare()
-		return nil
-	}
-	return fmt.Errorf("unknown TreeNode unique edge %s", name)
+func (m *UserEventSequenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserEventSequence unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TreeNodeMutation) ResetEdge(name string) error {
-	switch name {
-	case treenode.EdgeTree:
-		m.ResetTree()
-		return nil
-	case treenode.EdgeParent:
-		m.ResetParent()
-		return nil
-	case treenode.EdgeSigningKeyshare:
-		m.ResetSigningKeyshare()
-		return nil
-	case treenode.EdgeChildren:
-		m.ResetChildren()
-		return nil
-	}
-	return fmt.Errorf("unknown TreeNode edge %s", name)
+func (m *UserEventSequenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserEventSequence edge %s", name)
 }
 
 // UserSignedTransactionMutation represents an operation that mutates the UserSignedTransaction nodes in the graph.
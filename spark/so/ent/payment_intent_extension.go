@@ -0,0 +1,83 @@
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/so/ent/paymentintent"
+)
+
+// PaymentIntentID returns the ID of a payment intent, which is the ID of the spark invoice it encodes.
+func PaymentIntentID(paymentIntent string) (uuid.UUID, error) {
+	parsedInvoice, err := common.ParseSparkInvoice(paymentIntent)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse payment intent %s: %w", paymentIntent, err)
+	}
+	id, err := uuid.FromBytes(parsedInvoice.Id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse payment intent ID in %s: %w", paymentIntent, err)
+	}
+	return id, nil
+}
+
+// AttachPaymentIntentToTransfer records the payment intent a transfer was started with.
+func AttachPaymentIntentToTransfer(ctx context.Context, transfer *Transfer, paymentIntent string) error {
+	return attachPaymentIntent(ctx, paymentIntent,
+		func(create *PaymentIntentCreate) *PaymentIntentCreate { return create.AddTransferIDs(transfer.ID) },
+		func(update *PaymentIntentUpdateOne) *PaymentIntentUpdateOne {
+			return update.AddTransferIDs(transfer.ID)
+		},
+	)
+}
+
+// AttachPaymentIntentToTokenTransaction records the payment intent a token transaction was started with.
+func AttachPaymentIntentToTokenTransaction(ctx context.Context, tokenTransaction *TokenTransaction, paymentIntent string) error {
+	return attachPaymentIntent(ctx, paymentIntent,
+		func(create *PaymentIntentCreate) *PaymentIntentCreate {
+			return create.AddTokenTransactionIDs(tokenTransaction.ID)
+		},
+		func(update *PaymentIntentUpdateOne) *PaymentIntentUpdateOne {
+			return update.AddTokenTransactionIDs(tokenTransaction.ID)
+		},
+	)
+}
+
+// attachPaymentIntent creates the payment intent with the given edge, or adds the edge if the payment intent was
+// already recorded for another transfer or token transaction.
+func attachPaymentIntent(
+	ctx context.Context,
+	paymentIntent string,
+	addEdgeOnCreate func(*PaymentIntentCreate) *PaymentIntentCreate,
+	addEdgeOnUpdate func(*PaymentIntentUpdateOne) *PaymentIntentUpdateOne,
+) error {
+	id, err := PaymentIntentID(paymentIntent)
+	if err != nil {
+		return err
+	}
+	db, err := GetDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing, err := db.PaymentIntent.Query().Where(paymentintent.IDEQ(id)).Only(ctx)
+	if IsNotFound(err) {
+		_, err = addEdgeOnCreate(db.PaymentIntent.Create().SetID(id).SetPaymentIntent(paymentIntent)).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create payment intent: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query payment intent: %w", err)
+	}
+	if existing.PaymentIntent != paymentIntent {
+		return fmt.Errorf("payment intent ID %s was already used by a different payment intent", id)
+	}
+	_, err = addEdgeOnUpdate(existing.Update()).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to attach payment intent: %w", err)
+	}
+	return nil
+}
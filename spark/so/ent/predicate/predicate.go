@@ -45,12 +45,18 @@ type SigningNonce func(*sql.Selector)
 // SparkInvoice is the predicate function for sparkinvoice builders.
 type SparkInvoice func(*sql.Selector)
 
+// TokenAllowlistEntry is the predicate function for tokenallowlistentry builders.
+type TokenAllowlistEntry func(*sql.Selector)
+
 // TokenCreate is the predicate function for tokencreate builders.
 type TokenCreate func(*sql.Selector)
 
 // TokenFreeze is the predicate function for tokenfreeze builders.
 type TokenFreeze func(*sql.Selector)
 
+// TokenIssuerKeyRotation is the predicate function for tokenissuerkeyrotation builders.
+type TokenIssuerKeyRotation func(*sql.Selector)
+
 // TokenMint is the predicate function for tokenmint builders.
 type TokenMint func(*sql.Selector)
 
@@ -78,6 +84,12 @@ type Tree func(*sql.Selector)
 // TreeNode is the predicate function for treenode builders.
 type TreeNode func(*sql.Selector)
 
+// UserEvent is the predicate function for userevent builders.
+type UserEvent func(*sql.Selector)
+
+// UserEventSequence is the predicate function for usereventsequence builders.
+type UserEventSequence func(*sql.Selector)
+
 // UserSignedTransaction is the predicate function for usersignedtransaction builders.
 type UserSignedTransaction func(*sql.Selector)
 
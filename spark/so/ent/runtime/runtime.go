@@ -21,8 +21,10 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
 	"github.com/lightsparkdev/spark/so/ent/signingnonce"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenmint"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokenpartialrevocationsecretshare"
@@ -32,6 +34,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
 	"github.com/lightsparkdev/spark/so/ent/usersignedtransaction"
 	"github.com/lightsparkdev/spark/so/ent/utxo"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
@@ -368,6 +372,33 @@ func init() {
 	sparkinvoiceDescID := sparkinvoiceMixinFields0[0].Descriptor()
 	// sparkinvoice.DefaultID holds the default value on creation for the id field.
 	sparkinvoice.DefaultID = sparkinvoiceDescID.Default.(func() uuid.UUID)
+	tokenallowlistentryMixin := schema.TokenAllowlistEntry{}.Mixin()
+	tokenallowlistentryMixinFields0 := tokenallowlistentryMixin[0].Fields()
+	_ = tokenallowlistentryMixinFields0
+	tokenallowlistentryFields := schema.TokenAllowlistEntry{}.Fields()
+	_ = tokenallowlistentryFields
+	// tokenallowlistentryDescCreateTime is the schema descriptor for create_time field.
+	tokenallowlistentryDescCreateTime := tokenallowlistentryMixinFields0[1].Descriptor()
+	// tokenallowlistentry.DefaultCreateTime holds the default value on creation for the create_time field.
+	tokenallowlistentry.DefaultCreateTime = tokenallowlistentryDescCreateTime.Default.(func() time.Time)
+	// tokenallowlistentryDescUpdateTime is the schema descriptor for update_time field.
+	tokenallowlistentryDescUpdateTime := tokenallowlistentryMixinFields0[2].Descriptor()
+	// tokenallowlistentry.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tokenallowlistentry.DefaultUpdateTime = tokenallowlistentryDescUpdateTime.Default.(func() time.Time)
+	// tokenallowlistentry.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tokenallowlistentry.UpdateDefaultUpdateTime = tokenallowlistentryDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tokenallowlistentryDescOwnerPublicKey is the schema descriptor for owner_public_key field.
+	tokenallowlistentryDescOwnerPublicKey := tokenallowlistentryFields[1].Descriptor()
+	// tokenallowlistentry.OwnerPublicKeyValidator is a validator for the "owner_public_key" field. It is called by the builders before save.
+	tokenallowlistentry.OwnerPublicKeyValidator = tokenallowlistentryDescOwnerPublicKey.Validators[0].(func([]byte) error)
+	// tokenallowlistentryDescIssuerSignature is the schema descriptor for issuer_signature field.
+	tokenallowlistentryDescIssuerSignature := tokenallowlistentryFields[2].Descriptor()
+	// tokenallowlistentry.IssuerSignatureValidator is a validator for the "issuer_signature" field. It is called by the builders before save.
+	tokenallowlistentry.IssuerSignatureValidator = tokenallowlistentryDescIssuerSignature.Validators[0].(func([]byte) error)
+	// tokenallowlistentryDescID is the schema descriptor for id field.
+	tokenallowlistentryDescID := tokenallowlistentryMixinFields0[0].Descriptor()
+	// tokenallowlistentry.DefaultID holds the default value on creation for the id field.
+	tokenallowlistentry.DefaultID = tokenallowlistentryDescID.Default.(func() uuid.UUID)
 	tokencreateMixin := schema.TokenCreate{}.Mixin()
 	tokencreateMixinFields0 := tokencreateMixin[0].Fields()
 	_ = tokencreateMixinFields0
@@ -413,6 +444,14 @@ func init() {
 	tokencreateDescCreationEntityPublicKey := tokencreateFields[2].Descriptor()
 	// tokencreate.CreationEntityPublicKeyValidator is a validator for the "creation_entity_public_key" field. It is called by the builders before save.
 	tokencreate.CreationEntityPublicKeyValidator = tokencreateDescCreationEntityPublicKey.Validators[0].(func([]byte) error)
+	// tokencreateDescIsTransferRestricted is the schema descriptor for is_transfer_restricted field.
+	tokencreateDescIsTransferRestricted := tokencreateFields[4].Descriptor()
+	// tokencreate.DefaultIsTransferRestricted holds the default value on creation for the is_transfer_restricted field.
+	tokencreate.DefaultIsTransferRestricted = tokencreateDescIsTransferRestricted.Default.(bool)
+	// tokencreateDescIsPaused is the schema descriptor for is_paused field.
+	tokencreateDescIsPaused := tokencreateFields[5].Descriptor()
+	// tokencreate.DefaultIsPaused holds the default value on creation for the is_paused field.
+	tokencreate.DefaultIsPaused = tokencreateDescIsPaused.Default.(bool)
 	// tokencreateDescID is the schema descriptor for id field.
 	tokencreateDescID := tokencreateMixinFields0[0].Descriptor()
 	// tokencreate.DefaultID holds the default value on creation for the id field.
@@ -444,6 +483,37 @@ func init() {
 	tokenfreezeDescID := tokenfreezeMixinFields0[0].Descriptor()
 	// tokenfreeze.DefaultID holds the default value on creation for the id field.
 	tokenfreeze.DefaultID = tokenfreezeDescID.Default.(func() uuid.UUID)
+	tokenissuerkeyrotationMixin := schema.TokenIssuerKeyRotation{}.Mixin()
+	tokenissuerkeyrotationMixinFields0 := tokenissuerkeyrotationMixin[0].Fields()
+	_ = tokenissuerkeyrotationMixinFields0
+	tokenissuerkeyrotationFields := schema.TokenIssuerKeyRotation{}.Fields()
+	_ = tokenissuerkeyrotationFields
+	// tokenissuerkeyrotationDescCreateTime is the schema descriptor for create_time field.
+	tokenissuerkeyrotationDescCreateTime := tokenissuerkeyrotationMixinFields0[1].Descriptor()
+	// tokenissuerkeyrotation.DefaultCreateTime holds the default value on creation for the create_time field.
+	tokenissuerkeyrotation.DefaultCreateTime = tokenissuerkeyrotationDescCreateTime.Default.(func() time.Time)
+	// tokenissuerkeyrotationDescUpdateTime is the schema descriptor for update_time field.
+	tokenissuerkeyrotationDescUpdateTime := tokenissuerkeyrotationMixinFields0[2].Descriptor()
+	// tokenissuerkeyrotation.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tokenissuerkeyrotation.DefaultUpdateTime = tokenissuerkeyrotationDescUpdateTime.Default.(func() time.Time)
+	// tokenissuerkeyrotation.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tokenissuerkeyrotation.UpdateDefaultUpdateTime = tokenissuerkeyrotationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tokenissuerkeyrotationDescPreviousIssuerPublicKey is the schema descriptor for previous_issuer_public_key field.
+	tokenissuerkeyrotationDescPreviousIssuerPublicKey := tokenissuerkeyrotationFields[0].Descriptor()
+	// tokenissuerkeyrotation.PreviousIssuerPublicKeyValidator is a validator for the "previous_issuer_public_key" field. It is called by the builders before save.
+	tokenissuerkeyrotation.PreviousIssuerPublicKeyValidator = tokenissuerkeyrotationDescPreviousIssuerPublicKey.Validators[0].(func([]byte) error)
+	// tokenissuerkeyrotationDescNewIssuerPublicKey is the schema descriptor for new_issuer_public_key field.
+	tokenissuerkeyrotationDescNewIssuerPublicKey := tokenissuerkeyrotationFields[1].Descriptor()
+	// tokenissuerkeyrotation.NewIssuerPublicKeyValidator is a validator for the "new_issuer_public_key" field. It is called by the builders before save.
+	tokenissuerkeyrotation.NewIssuerPublicKeyValidator = tokenissuerkeyrotationDescNewIssuerPublicKey.Validators[0].(func([]byte) error)
+	// tokenissuerkeyrotationDescIssuerSignature is the schema descriptor for issuer_signature field.
+	tokenissuerkeyrotationDescIssuerSignature := tokenissuerkeyrotationFields[2].Descriptor()
+	// tokenissuerkeyrotation.IssuerSignatureValidator is a validator for the "issuer_signature" field. It is called by the builders before save.
+	tokenissuerkeyrotation.IssuerSignatureValidator = tokenissuerkeyrotationDescIssuerSignature.Validators[0].(func([]byte) error)
+	// tokenissuerkeyrotationDescID is the schema descriptor for id field.
+	tokenissuerkeyrotationDescID := tokenissuerkeyrotationMixinFields0[0].Descriptor()
+	// tokenissuerkeyrotation.DefaultID holds the default value on creation for the id field.
+	tokenissuerkeyrotation.DefaultID = tokenissuerkeyrotationDescID.Default.(func() uuid.UUID)
 	tokenmintMixin := schema.TokenMint{}.Mixin()
 	tokenmintMixinFields0 := tokenmintMixin[0].Fields()
 	_ = tokenmintMixinFields0
@@ -549,7 +619,7 @@ func init() {
 	// tokentransaction.FinalizedTokenTransactionHashValidator is a validator for the "finalized_token_transaction_hash" field. It is called by the builders before save.
 	tokentransaction.FinalizedTokenTransactionHashValidator = tokentransactionDescFinalizedTokenTransactionHash.Validators[0].(func([]byte) error)
 	// tokentransactionDescVersion is the schema descriptor for version field.
-	tokentransactionDescVersion := tokentransactionFields[7].Descriptor()
+	tokentransactionDescVersion := tokentransactionFields[8].Descriptor()
 	// tokentransaction.DefaultVersion holds the default value on creation for the version field.
 	tokentransaction.DefaultVersion = schematype.TokenTransactionVersion(tokentransactionDescVersion.Default.(int))
 	// tokentransaction.VersionValidator is a validator for the "version" field. It is called by the builders before save.
@@ -705,6 +775,56 @@ func init() {
 	treenodeDescID := treenodeMixinFields0[0].Descriptor()
 	// treenode.DefaultID holds the default value on creation for the id field.
 	treenode.DefaultID = treenodeDescID.Default.(func() uuid.UUID)
+	usereventMixin := schema.UserEvent{}.Mixin()
+	usereventMixinFields0 := usereventMixin[0].Fields()
+	_ = usereventMixinFields0
+	usereventFields := schema.UserEvent{}.Fields()
+	_ = usereventFields
+	// usereventDescCreateTime is the schema descriptor for create_time field.
+	usereventDescCreateTime := usereventMixinFields0[1].Descriptor()
+	// userevent.DefaultCreateTime holds the default value on creation for the create_time field.
+	userevent.DefaultCreateTime = usereventDescCreateTime.Default.(func() time.Time)
+	// usereventDescUpdateTime is the schema descriptor for update_time field.
+	usereventDescUpdateTime := usereventMixinFields0[2].Descriptor()
+	// userevent.DefaultUpdateTime holds the default value on creation for the update_time field.
+	userevent.DefaultUpdateTime = usereventDescUpdateTime.Default.(func() time.Time)
+	// userevent.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	userevent.UpdateDefaultUpdateTime = usereventDescUpdateTime.UpdateDefault.(func() time.Time)
+	// usereventDescIdentityPublicKey is the schema descriptor for identity_public_key field.
+	usereventDescIdentityPublicKey := usereventFields[0].Descriptor()
+	// userevent.IdentityPublicKeyValidator is a validator for the "identity_public_key" field. It is called by the builders before save.
+	userevent.IdentityPublicKeyValidator = usereventDescIdentityPublicKey.Validators[0].(func([]byte) error)
+	// usereventDescEvent is the schema descriptor for event field.
+	usereventDescEvent := usereventFields[2].Descriptor()
+	// userevent.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	userevent.EventValidator = usereventDescEvent.Validators[0].(func([]byte) error)
+	// usereventDescID is the schema descriptor for id field.
+	usereventDescID := usereventMixinFields0[0].Descriptor()
+	// userevent.DefaultID holds the default value on creation for the id field.
+	userevent.DefaultID = usereventDescID.Default.(func() uuid.UUID)
+	usereventsequenceMixin := schema.UserEventSequence{}.Mixin()
+	usereventsequenceMixinFields0 := usereventsequenceMixin[0].Fields()
+	_ = usereventsequenceMixinFields0
+	usereventsequenceFields := schema.UserEventSequence{}.Fields()
+	_ = usereventsequenceFields
+	// usereventsequenceDescCreateTime is the schema descriptor for create_time field.
+	usereventsequenceDescCreateTime := usereventsequenceMixinFields0[1].Descriptor()
+	// usereventsequence.DefaultCreateTime holds the default value on creation for the create_time field.
+	usereventsequence.DefaultCreateTime = usereventsequenceDescCreateTime.Default.(func() time.Time)
+	// usereventsequenceDescUpdateTime is the schema descriptor for update_time field.
+	usereventsequenceDescUpdateTime := usereventsequenceMixinFields0[2].Descriptor()
+	// usereventsequence.DefaultUpdateTime holds the default value on creation for the update_time field.
+	usereventsequence.DefaultUpdateTime = usereventsequenceDescUpdateTime.Default.(func() time.Time)
+	// usereventsequence.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	usereventsequence.UpdateDefaultUpdateTime = usereventsequenceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// usereventsequenceDescIdentityPublicKey is the schema descriptor for identity_public_key field.
+	usereventsequenceDescIdentityPublicKey := usereventsequenceFields[0].Descriptor()
+	// usereventsequence.IdentityPublicKeyValidator is a validator for the "identity_public_key" field. It is called by the builders before save.
+	usereventsequence.IdentityPublicKeyValidator = usereventsequenceDescIdentityPublicKey.Validators[0].(func([]byte) error)
+	// usereventsequenceDescID is the schema descriptor for id field.
+	usereventsequenceDescID := usereventsequenceMixinFields0[0].Descriptor()
+	// usereventsequence.DefaultID holds the default value on creation for the id field.
+	usereventsequence.DefaultID = usereventsequenceDescID.Default.(func() uuid.UUID)
 	usersignedtransactionMixin := schema.UserSignedTransaction{}.Mixin()
 	usersignedtransactionMixinFields0 := usersignedtransactionMixin[0].Fields()
 	_ = usersignedtransactionMixinFields0
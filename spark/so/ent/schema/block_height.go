@@ -23,6 +23,8 @@ func (BlockHeight) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("height"),
 		field.Enum("network").GoType(st.Network("")),
+		// Hash of the block at height, used to detect reorgs of the last processed block.
+		field.Bytes("block_hash").Optional(),
 	}
 }
 
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -25,8 +27,17 @@ func (Gossip) Fields() []ent.Field {
 		field.Bytes("message").NotEmpty().Immutable(),
 		// A bitmap of participants that have received the message, it maps with the order of participants.
 		field.Bytes("receipts").Nillable(),
-		// If all participants have received the message, the status is changed to DELIVERED.
+		// If all participants have received the message, the status is changed to DELIVERED. If the
+		// message expires before that, the status is changed to FAILED.
 		field.Enum("status").GoType(st.GossipStatus("")).Default(string(st.GossipStatusPending)),
+		// Number of delivery attempts made to each participant, it maps with the order of participants.
+		field.Ints("attempts").Optional(),
+		// The earliest time the message may be sent to each participant again, it maps with the order of participants.
+		field.JSON("next_attempt_times", []time.Time{}).Optional(),
+		// The earliest next attempt time across the participants that have not received the message.
+		field.Time("next_attempt_time").Optional().Nillable(),
+		// The time after which the message is no longer retried and is marked as FAILED. Nil means it never expires.
+		field.Time("expiry_time").Optional().Nillable(),
 	}
 }
 
@@ -37,5 +48,6 @@ func (Gossip) Edges() []ent.Edge {
 func (Gossip) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("status"),
+		index.Fields("status", "next_attempt_time"),
 	}
 }
@@ -5,11 +5,14 @@ type GossipStatus string
 const (
 	GossipStatusPending   GossipStatus = "PENDING"
 	GossipStatusDelivered GossipStatus = "DELIVERED"
+	// GossipStatusFailed means the message expired before every participant received it.
+	GossipStatusFailed GossipStatus = "FAILED"
 )
 
 func (GossipStatus) Values() []string {
 	return []string{
 		string(GossipStatusPending),
 		string(GossipStatusDelivered),
+		string(GossipStatusFailed),
 	}
 }
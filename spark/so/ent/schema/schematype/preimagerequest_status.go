@@ -1,5 +1,11 @@
 package schematype
 
+import (
+	"fmt"
+
+	pb "github.com/lightsparkdev/spark/proto/spark"
+)
+
 // PreimageRequestStatus is the status of the preimage request
 type PreimageRequestStatus string
 
@@ -20,3 +26,17 @@ func (PreimageRequestStatus) Values() []string {
 		string(PreimageRequestStatusReturned),
 	}
 }
+
+// MarshalProto converts a PreimageRequestStatus to a spark protobuf PreimageRequestStatus.
+func (s PreimageRequestStatus) MarshalProto() (pb.PreimageRequestStatus, error) {
+	switch s {
+	case PreimageRequestStatusWaitingForPreimage:
+		return pb.PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE, nil
+	case PreimageRequestStatusPreimageShared:
+		return pb.PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_PREIMAGE_SHARED, nil
+	case PreimageRequestStatusReturned:
+		return pb.PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_RETURNED, nil
+	default:
+		return pb.PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_WAITING_FOR_PREIMAGE, fmt.Errorf("unknown preimage request status: %s", s)
+	}
+}
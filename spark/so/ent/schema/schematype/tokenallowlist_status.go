@@ -0,0 +1,19 @@
+package schematype
+
+// TokenAllowlistStatus is the status of an owner on the allowlist of a transfer restricted token.
+type TokenAllowlistStatus string
+
+const (
+	// TokenAllowlistStatusAllowed is the status once the issuer has added the owner to the allowlist.
+	TokenAllowlistStatusAllowed TokenAllowlistStatus = "ALLOWED"
+	// TokenAllowlistStatusRemoved is the status after the issuer removed a previously allowed owner.
+	TokenAllowlistStatusRemoved TokenAllowlistStatus = "REMOVED"
+)
+
+// Values returns the values of the token allowlist status.
+func (TokenAllowlistStatus) Values() []string {
+	return []string{
+		string(TokenAllowlistStatusAllowed),
+		string(TokenAllowlistStatusRemoved),
+	}
+}
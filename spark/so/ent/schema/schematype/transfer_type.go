@@ -16,6 +16,8 @@ const (
 	TransferTypeCounterSwap TransferType = "COUNTER_SWAP"
 	// TransferTypeUtxoSwap is the type of transfer that is a swap of an utxos for leaves.
 	TransferTypeUtxoSwap TransferType = "UTXO_SWAP"
+	// TransferTypeHtlc is the type of transfer that the receiver can only claim by revealing a preimage.
+	TransferTypeHtlc TransferType = "HTLC"
 )
 
 // Values returns the values of the transfer type.
@@ -27,5 +29,6 @@ func (TransferType) Values() []string {
 		string(TransferTypeSwap),
 		string(TransferTypeCounterSwap),
 		string(TransferTypeUtxoSwap),
+		string(TransferTypeHtlc),
 	}
 }
@@ -30,3 +30,16 @@ func UtxoSwapFromProtoRequestType(requestType pb.UtxoSwapRequestType) UtxoSwapRe
 		return UtxoSwapRequestTypeFixedAmount
 	}
 }
+
+func UtxoSwapRequestTypeToProto(requestType UtxoSwapRequestType) pb.UtxoSwapRequestType {
+	switch requestType {
+	case UtxoSwapRequestTypeFixedAmount:
+		return pb.UtxoSwapRequestType_Fixed
+	case UtxoSwapRequestTypeMaxFee:
+		return pb.UtxoSwapRequestType_MaxFee
+	case UtxoSwapRequestTypeRefund:
+		return pb.UtxoSwapRequestType_Refund
+	default:
+		return pb.UtxoSwapRequestType_Fixed
+	}
+}
@@ -37,5 +37,6 @@ func (SparkInvoice) Fields() []ent.Field {
 func (SparkInvoice) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("token_transaction", TokenTransaction.Type).Ref("spark_invoice"),
+		edge.From("transfer", Transfer.Type).Ref("spark_invoice"),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+)
+
+// TokenAllowlistEntry records whether an owner may receive a transfer restricted token.
+type TokenAllowlistEntry struct {
+	ent.Schema
+}
+
+func (TokenAllowlistEntry) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (TokenAllowlistEntry) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("status").GoType(st.TokenAllowlistStatus("")),
+		field.Bytes("owner_public_key").NotEmpty().Immutable(),
+		// Signature by the issuer over the latest allowlist update for this owner.
+		field.Bytes("issuer_signature").NotEmpty().Unique(),
+		field.Uint64("issuer_provided_timestamp"),
+		field.UUID("token_create_id", uuid.UUID{}).Immutable(),
+	}
+}
+
+func (TokenAllowlistEntry) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("token_create", TokenCreate.Type).
+			Ref("allowlist_entries").
+			Unique().
+			Required().
+			Immutable().
+			Field("token_create_id"),
+	}
+}
+
+func (TokenAllowlistEntry) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_create_id", "owner_public_key").Unique(),
+	}
+}
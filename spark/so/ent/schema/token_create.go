@@ -24,6 +24,13 @@ func (TokenCreate) Fields() []ent.Field {
 		field.Bytes("operator_specific_issuer_signature").Optional().Unique(),
 		field.Bytes("creation_entity_public_key").NotEmpty().Immutable(),
 		field.Uint64("wallet_provided_timestamp").Optional().Immutable().Deprecated(),
+		// Restricts token outputs to owners on the issuer's allowlist.
+		field.Bool("is_transfer_restricted").Default(false).Immutable(),
+		// Set by the issuer to halt all transfers of the token. The signature and timestamp are those of the
+		// latest pause or unpause.
+		field.Bool("is_paused").Default(false),
+		field.Bytes("pause_issuer_signature").Optional(),
+		field.Uint64("pause_issuer_provided_timestamp").Optional(),
 	}
 }
 
@@ -37,5 +44,7 @@ func (TokenCreate) Edges() []ent.Edge {
 			Unique(),
 		edge.To("token_output", TokenOutput.Type),
 		edge.To("token_freeze", TokenFreeze.Type),
+		edge.To("issuer_key_rotations", TokenIssuerKeyRotation.Type),
+		edge.To("allowlist_entries", TokenAllowlistEntry.Type),
 	}
 }
@@ -31,6 +31,8 @@ func (TokenFreeze) Fields() []ent.Field {
 		field.Uint64("wallet_provided_freeze_timestamp").Immutable(),
 		field.Uint64("wallet_provided_thaw_timestamp").Optional(),
 		field.UUID("token_create_id", uuid.UUID{}).Optional(), // Not immutable for backfill, set immutable and required afterwards
+		// When set, the freeze is automatically thawed at this time.
+		field.Time("expires_at").Optional().Nillable().Immutable(),
 	}
 }
 
@@ -60,5 +62,7 @@ func (TokenFreeze) Indexes() []ent.Index {
 			StorageKey("tokenfreeze_owner_public_key_token_create_id_wallet_provided_f"),
 		index.Fields("owner_public_key", "token_create_id", "wallet_provided_thaw_timestamp").Unique().
 			StorageKey("tokenfreeze_owner_public_key_token_create_id_wallet_provided_t"),
+		// Supports finding expired freezes to thaw.
+		index.Fields("status", "expires_at"),
 	}
 }
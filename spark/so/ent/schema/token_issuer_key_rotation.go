@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TokenIssuerKeyRotation records a change of the key allowed to mint and freeze a token.
+type TokenIssuerKeyRotation struct {
+	ent.Schema
+}
+
+func (TokenIssuerKeyRotation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (TokenIssuerKeyRotation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("previous_issuer_public_key").NotEmpty().Immutable(),
+		field.Bytes("new_issuer_public_key").NotEmpty().Immutable(),
+		// Signature by the previous issuer key over the rotation payload.
+		field.Bytes("issuer_signature").NotEmpty().Immutable().Unique(),
+		field.Uint64("issuer_provided_timestamp").Immutable(),
+		field.UUID("token_create_id", uuid.UUID{}).Immutable(),
+	}
+}
+
+func (TokenIssuerKeyRotation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.
+			From("token_create", TokenCreate.Type).
+			Ref("issuer_key_rotations").
+			Unique().
+			Required().
+			Immutable().
+			Field("token_create_id"),
+	}
+}
+
+func (TokenIssuerKeyRotation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_create_id", "issuer_provided_timestamp").Unique(),
+		// Issuer keys cannot be reused, so each key is rotated away from at most once. This keeps concurrent
+		// rotations signed by the same key from forking the key history.
+		index.Fields("token_create_id", "previous_issuer_public_key").Unique(),
+	}
+}
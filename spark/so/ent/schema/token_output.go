@@ -37,6 +37,8 @@ func (TokenOutput) Fields() []ent.Field {
 		field.Enum("network").GoType(st.Network("")).Optional(),
 		field.Bytes("token_identifier").Immutable(),
 		field.UUID("token_create_id", uuid.UUID{}).Immutable(),
+		// The output cannot be spent on Spark before this time.
+		field.Time("spendable_after").Optional().Nillable().Immutable(),
 	}
 }
 
@@ -29,6 +29,9 @@ func (TokenTransaction) Fields() []ent.Field {
 		field.Time("expiry_time").Optional().Immutable(),
 		field.Bytes("coordinator_public_key").Optional(),
 		field.Time("client_created_timestamp").Optional(),
+		// When the transaction first became final: revealed or finalized, or signed for mints. Unlike the
+		// update time, it does not change when the transaction is updated afterwards.
+		field.Time("finalized_at").Optional().Nillable(),
 		field.Int("version").GoType(st.TokenTransactionVersion(0)).Default(int(st.TokenTransactionVersionV0)).Validate(func(v int) error {
 			if !st.TokenTransactionVersion(v).IsValid() {
 				return fmt.Errorf("invalid token transaction version: %d", v)
@@ -64,5 +67,9 @@ func (TokenTransaction) Indexes() []ent.Index {
 		index.Fields("finalized_token_transaction_hash"),
 		index.Fields("partial_token_transaction_hash"),
 		index.Fields("expiry_time", "status"),
+		// Support paging through transactions by creation time, optionally filtered by status.
+		index.Fields("create_time"),
+		index.Fields("status", "create_time"),
+		index.Fields("finalized_at"),
 	}
 }
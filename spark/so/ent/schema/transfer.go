@@ -2,9 +2,11 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 )
 
@@ -33,6 +35,9 @@ func (Transfer) Fields() []ent.Field {
 		field.Enum("type").GoType(st.TransferType("")),
 		field.Time("expiry_time").Immutable(),
 		field.Time("completion_time").Optional().Nillable(),
+		field.UUID("batch_id", uuid.UUID{}).
+			Optional().
+			Comment("The batch the transfer was sent in. Transfers in a batch are sent or cancelled together."),
 	}
 }
 
@@ -41,6 +46,8 @@ func (Transfer) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("transfer_leaves", TransferLeaf.Type).Ref("transfer"),
 		edge.To("payment_intent", PaymentIntent.Type).Unique(),
+		// The sats spark invoice paid by this transfer, if any.
+		edge.To("spark_invoice", SparkInvoice.Type).Unique(),
 	}
 }
 
@@ -51,5 +58,8 @@ func (Transfer) Indexes() []ent.Index {
 		index.Fields("receiver_identity_pubkey"),
 		index.Fields("status"),
 		index.Fields("update_time"),
+		index.Fields("batch_id"),
+		// A spark invoice can only be paid by one transfer that has not been returned or expired.
+		index.Edges("spark_invoice").Unique().Annotations(entsql.IndexWhere("status NOT IN ('EXPIRED', 'RETURNED')")),
 	}
 }
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// UserEvent is an entry in the per-identity event log that backs SubscribeToEvents. Events are
+// recorded in the same transaction as the change they describe, so a client that reconnects can
+// resume from the last sequence number it received.
+type UserEvent struct {
+	ent.Schema
+}
+
+func (UserEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (UserEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("identity_public_key").
+			NotEmpty().
+			Immutable().
+			Comment("The identity public key of the user the event is for"),
+		field.Uint64("sequence").
+			Immutable().
+			Comment("The position of the event in the identity's event log"),
+		field.Bytes("event").
+			NotEmpty().
+			Immutable().
+			Comment("Serialized SubscribeToEventsResponse in spark.proto"),
+	}
+}
+
+func (UserEvent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (UserEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("identity_public_key", "sequence").Unique(),
+		index.Fields("create_time"),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// UserEventSequence holds the last sequence number allocated in an identity's event log. Allocating
+// a sequence number updates this row, which serializes concurrent writers for the same identity.
+type UserEventSequence struct {
+	ent.Schema
+}
+
+func (UserEventSequence) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (UserEventSequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("identity_public_key").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Uint64("last_sequence"),
+	}
+}
+
+func (UserEventSequence) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
@@ -38,9 +38,11 @@ type SparkInvoice struct {
 type SparkInvoiceEdges struct {
 	// TokenTransaction holds the value of the token_transaction edge.
 	TokenTransaction []*TokenTransaction `json:"token_transaction,omitempty"`
+	// Transfer holds the value of the transfer edge.
+	Transfer []*Transfer `json:"transfer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // TokenTransactionOrErr returns the TokenTransaction value or an error if the edge
@@ -52,6 +54,15 @@ func (e SparkInvoiceEdges) TokenTransactionOrErr() ([]*TokenTransaction, error)
 	return nil, &NotLoadedError{edge: "token_transaction"}
 }
 
+// TransferOrErr returns the Transfer value or an error if the edge
+// was not loaded in eager-loading.
+func (e SparkInvoiceEdges) TransferOrErr() ([]*Transfer, error) {
+	if e.loadedTypes[1] {
+		return e.Transfer, nil
+	}
+	return nil, &NotLoadedError{edge: "transfer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SparkInvoice) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -134,6 +145,11 @@ func (si *SparkInvoice) QueryTokenTransaction() *TokenTransactionQuery {
 	return NewSparkInvoiceClient(si.config).QueryTokenTransaction(si)
 }
 
+// QueryTransfer queries the "transfer" edge of the SparkInvoice entity.
+func (si *SparkInvoice) QueryTransfer() *TransferQuery {
+	return NewSparkInvoiceClient(si.config).QueryTransfer(si)
+}
+
 // Update returns a builder for updating this SparkInvoice.
 // Note that you need to call SparkInvoice.Unwrap() before calling this method if this SparkInvoice
 // was returned from a transaction, and the transaction was committed or rolled back.
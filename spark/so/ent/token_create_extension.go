@@ -1,9 +1,15 @@
 package ent
 
 import (
+	"context"
 	"fmt"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/keys"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 )
 
 func (tc *TokenCreate) ToTokenMetadata() (*common.TokenMetadata, error) {
@@ -21,5 +27,77 @@ func (tc *TokenCreate) ToTokenMetadata() (*common.TokenMetadata, error) {
 		IsFreezable:             tc.IsFreezable,
 		CreationEntityPublicKey: tc.CreationEntityPublicKey,
 		Network:                 network,
+		IsTransferRestricted:    tc.IsTransferRestricted,
 	}, nil
 }
+
+// LatestIssuerKeyRotation returns the most recent issuer key rotation of the token, or nil if the issuer key
+// was never rotated. Rotations loaded with WithIssuerKeyRotations are used instead of querying them again.
+func (tc *TokenCreate) LatestIssuerKeyRotation(ctx context.Context) (*TokenIssuerKeyRotation, error) {
+	if rotations, err := tc.Edges.IssuerKeyRotationsOrErr(); err == nil {
+		var latest *TokenIssuerKeyRotation
+		for _, rotation := range rotations {
+			if latest == nil || rotation.IssuerProvidedTimestamp > latest.IssuerProvidedTimestamp {
+				latest = rotation
+			}
+		}
+		return latest, nil
+	}
+	rotation, err := tc.QueryIssuerKeyRotations().
+		Order(tokenissuerkeyrotation.ByIssuerProvidedTimestamp(sql.OrderDesc())).
+		First(ctx)
+	if IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query issuer key rotations: %w", err)
+	}
+	return rotation, nil
+}
+
+// CurrentIssuerPublicKey returns the key allowed to mint and freeze the token. This is the key the token was
+// created with unless the issuer key has since been rotated.
+func (tc *TokenCreate) CurrentIssuerPublicKey(ctx context.Context) (keys.Public, error) {
+	issuerPublicKey := tc.IssuerPublicKey
+	rotation, err := tc.LatestIssuerKeyRotation(ctx)
+	if err != nil {
+		return keys.Public{}, err
+	}
+	if rotation != nil {
+		issuerPublicKey = rotation.NewIssuerPublicKey
+	}
+	return keys.ParsePublicKey(issuerPublicKey)
+}
+
+// DisallowedOwnerPublicKeys returns the owners that may not receive the token. Owners of a transfer restricted
+// token must be on its allowlist or be the current issuer. All owners may receive an unrestricted token.
+func (tc *TokenCreate) DisallowedOwnerPublicKeys(ctx context.Context, ownerPublicKeys [][]byte) ([][]byte, error) {
+	if !tc.IsTransferRestricted || len(ownerPublicKeys) == 0 {
+		return nil, nil
+	}
+	allowedEntries, err := tc.QueryAllowlistEntries().
+		Where(
+			tokenallowlistentry.OwnerPublicKeyIn(ownerPublicKeys...),
+			tokenallowlistentry.StatusEQ(st.TokenAllowlistStatusAllowed),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token allowlist: %w", err)
+	}
+	currentIssuerPublicKey, err := tc.CurrentIssuerPublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := map[string]bool{string(currentIssuerPublicKey.Serialize()): true}
+	for _, entry := range allowedEntries {
+		allowed[string(entry.OwnerPublicKey)] = true
+	}
+	var disallowed [][]byte
+	for _, ownerPublicKey := range ownerPublicKeys {
+		if !allowed[string(ownerPublicKey)] {
+			disallowed = append(disallowed, ownerPublicKey)
+		}
+	}
+	return disallowed, nil
+}
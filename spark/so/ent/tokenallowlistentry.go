@@ -0,0 +1,207 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+)
+
+// TokenAllowlistEntry is the model entity for the TokenAllowlistEntry schema.
+type TokenAllowlistEntry struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// Status holds the value of the "status" field.
+	Status schematype.TokenAllowlistStatus `json:"status,omitempty"`
+	// OwnerPublicKey holds the value of the "owner_public_key" field.
+	OwnerPublicKey []byte `json:"owner_public_key,omitempty"`
+	// IssuerSignature holds the value of the "issuer_signature" field.
+	IssuerSignature []byte `json:"issuer_signature,omitempty"`
+	// IssuerProvidedTimestamp holds the value of the "issuer_provided_timestamp" field.
+	IssuerProvidedTimestamp uint64 `json:"issuer_provided_timestamp,omitempty"`
+	// TokenCreateID holds the value of the "token_create_id" field.
+	TokenCreateID uuid.UUID `json:"token_create_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TokenAllowlistEntryQuery when eager-loading is set.
+	Edges        TokenAllowlistEntryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TokenAllowlistEntryEdges holds the relations/edges for other nodes in the graph.
+type TokenAllowlistEntryEdges struct {
+	// TokenCreate holds the value of the token_create edge.
+	TokenCreate *TokenCreate `json:"token_create,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TokenCreateOrErr returns the TokenCreate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TokenAllowlistEntryEdges) TokenCreateOrErr() (*TokenCreate, error) {
+	if e.TokenCreate != nil {
+		return e.TokenCreate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tokencreate.Label}
+	}
+	return nil, &NotLoadedError{edge: "token_create"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenAllowlistEntry) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenallowlistentry.FieldOwnerPublicKey, tokenallowlistentry.FieldIssuerSignature:
+			values[i] = new([]byte)
+		case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+			values[i] = new(sql.NullInt64)
+		case tokenallowlistentry.FieldStatus:
+			values[i] = new(sql.NullString)
+		case tokenallowlistentry.FieldCreateTime, tokenallowlistentry.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case tokenallowlistentry.FieldID, tokenallowlistentry.FieldTokenCreateID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenAllowlistEntry fields.
+func (tae *TokenAllowlistEntry) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenallowlistentry.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tae.ID = *value
+			}
+		case tokenallowlistentry.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				tae.CreateTime = value.Time
+			}
+		case tokenallowlistentry.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				tae.UpdateTime = value.Time
+			}
+		case tokenallowlistentry.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				tae.Status = schematype.TokenAllowlistStatus(value.String)
+			}
+		case tokenallowlistentry.FieldOwnerPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field owner_public_key", values[i])
+			} else if value != nil {
+				tae.OwnerPublicKey = *value
+			}
+		case tokenallowlistentry.FieldIssuerSignature:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_signature", values[i])
+			} else if value != nil {
+				tae.IssuerSignature = *value
+			}
+		case tokenallowlistentry.FieldIssuerProvidedTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_provided_timestamp", values[i])
+			} else if value.Valid {
+				tae.IssuerProvidedTimestamp = uint64(value.Int64)
+			}
+		case tokenallowlistentry.FieldTokenCreateID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field token_create_id", values[i])
+			} else if value != nil {
+				tae.TokenCreateID = *value
+			}
+		default:
+			tae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenAllowlistEntry.
+// This includes values selected through modifiers, order, etc.
+func (tae *TokenAllowlistEntry) Value(name string) (ent.Value, error) {
+	return tae.selectValues.Get(name)
+}
+
+// QueryTokenCreate queries the "token_create" edge of the TokenAllowlistEntry entity.
+func (tae *TokenAllowlistEntry) QueryTokenCreate() *TokenCreateQuery {
+	return NewTokenAllowlistEntryClient(tae.config).QueryTokenCreate(tae)
+}
+
+// Update returns a builder for updating this TokenAllowlistEntry.
+// Note that you need to call TokenAllowlistEntry.Unwrap() before calling this method if this TokenAllowlistEntry
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tae *TokenAllowlistEntry) Update() *TokenAllowlistEntryUpdateOne {
+	return NewTokenAllowlistEntryClient(tae.config).UpdateOne(tae)
+}
+
+// Unwrap unwraps the TokenAllowlistEntry entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tae *TokenAllowlistEntry) Unwrap() *TokenAllowlistEntry {
+	_tx, ok := tae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenAllowlistEntry is not a transactional entity")
+	}
+	tae.config.driver = _tx.drv
+	return tae
+}
+
+// String implements the fmt.Stringer.
+func (tae *TokenAllowlistEntry) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenAllowlistEntry(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tae.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(tae.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(tae.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", tae.Status))
+	builder.WriteString(", ")
+	builder.WriteString("owner_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", tae.OwnerPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("issuer_signature=")
+	builder.WriteString(fmt.Sprintf("%v", tae.IssuerSignature))
+	builder.WriteString(", ")
+	builder.WriteString("issuer_provided_timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", tae.IssuerProvidedTimestamp))
+	builder.WriteString(", ")
+	builder.WriteString("token_create_id=")
+	builder.WriteString(fmt.Sprintf("%v", tae.TokenCreateID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenAllowlistEntries is a parsable slice of TokenAllowlistEntry.
+type TokenAllowlistEntries []*TokenAllowlistEntry
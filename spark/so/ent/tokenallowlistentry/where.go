@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenallowlistentry
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// OwnerPublicKey applies equality check predicate on the "owner_public_key" field. It's identical to OwnerPublicKeyEQ.
+func OwnerPublicKey(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldOwnerPublicKey, v))
+}
+
+// IssuerSignature applies equality check predicate on the "issuer_signature" field. It's identical to IssuerSignatureEQ.
+func IssuerSignature(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldIssuerSignature, v))
+}
+
+// IssuerProvidedTimestamp applies equality check predicate on the "issuer_provided_timestamp" field. It's identical to IssuerProvidedTimestampEQ.
+func IssuerProvidedTimestamp(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldIssuerProvidedTimestamp, v))
+}
+
+// TokenCreateID applies equality check predicate on the "token_create_id" field. It's identical to TokenCreateIDEQ.
+func TokenCreateID(v uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldTokenCreateID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v schematype.TokenAllowlistStatus) predicate.TokenAllowlistEntry {
+	vc := v
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v schematype.TokenAllowlistStatus) predicate.TokenAllowlistEntry {
+	vc := v
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...schematype.TokenAllowlistStatus) predicate.TokenAllowlistEntry {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...schematype.TokenAllowlistStatus) predicate.TokenAllowlistEntry {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// OwnerPublicKeyEQ applies the EQ predicate on the "owner_public_key" field.
+func OwnerPublicKeyEQ(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldOwnerPublicKey, v))
+}
+
+// OwnerPublicKeyNEQ applies the NEQ predicate on the "owner_public_key" field.
+func OwnerPublicKeyNEQ(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldOwnerPublicKey, v))
+}
+
+// OwnerPublicKeyIn applies the In predicate on the "owner_public_key" field.
+func OwnerPublicKeyIn(vs ...[]byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldOwnerPublicKey, vs...))
+}
+
+// OwnerPublicKeyNotIn applies the NotIn predicate on the "owner_public_key" field.
+func OwnerPublicKeyNotIn(vs ...[]byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldOwnerPublicKey, vs...))
+}
+
+// OwnerPublicKeyGT applies the GT predicate on the "owner_public_key" field.
+func OwnerPublicKeyGT(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGT(FieldOwnerPublicKey, v))
+}
+
+// OwnerPublicKeyGTE applies the GTE predicate on the "owner_public_key" field.
+func OwnerPublicKeyGTE(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGTE(FieldOwnerPublicKey, v))
+}
+
+// OwnerPublicKeyLT applies the LT predicate on the "owner_public_key" field.
+func OwnerPublicKeyLT(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLT(FieldOwnerPublicKey, v))
+}
+
+// OwnerPublicKeyLTE applies the LTE predicate on the "owner_public_key" field.
+func OwnerPublicKeyLTE(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLTE(FieldOwnerPublicKey, v))
+}
+
+// IssuerSignatureEQ applies the EQ predicate on the "issuer_signature" field.
+func IssuerSignatureEQ(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureNEQ applies the NEQ predicate on the "issuer_signature" field.
+func IssuerSignatureNEQ(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureIn applies the In predicate on the "issuer_signature" field.
+func IssuerSignatureIn(vs ...[]byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldIssuerSignature, vs...))
+}
+
+// IssuerSignatureNotIn applies the NotIn predicate on the "issuer_signature" field.
+func IssuerSignatureNotIn(vs ...[]byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldIssuerSignature, vs...))
+}
+
+// IssuerSignatureGT applies the GT predicate on the "issuer_signature" field.
+func IssuerSignatureGT(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGT(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureGTE applies the GTE predicate on the "issuer_signature" field.
+func IssuerSignatureGTE(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGTE(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureLT applies the LT predicate on the "issuer_signature" field.
+func IssuerSignatureLT(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLT(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureLTE applies the LTE predicate on the "issuer_signature" field.
+func IssuerSignatureLTE(v []byte) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLTE(FieldIssuerSignature, v))
+}
+
+// IssuerProvidedTimestampEQ applies the EQ predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampEQ(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampNEQ applies the NEQ predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampNEQ(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampIn applies the In predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampIn(vs ...uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldIssuerProvidedTimestamp, vs...))
+}
+
+// IssuerProvidedTimestampNotIn applies the NotIn predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampNotIn(vs ...uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldIssuerProvidedTimestamp, vs...))
+}
+
+// IssuerProvidedTimestampGT applies the GT predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampGT(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGT(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampGTE applies the GTE predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampGTE(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldGTE(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampLT applies the LT predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampLT(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLT(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampLTE applies the LTE predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampLTE(v uint64) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldLTE(FieldIssuerProvidedTimestamp, v))
+}
+
+// TokenCreateIDEQ applies the EQ predicate on the "token_create_id" field.
+func TokenCreateIDEQ(v uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldEQ(FieldTokenCreateID, v))
+}
+
+// TokenCreateIDNEQ applies the NEQ predicate on the "token_create_id" field.
+func TokenCreateIDNEQ(v uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNEQ(FieldTokenCreateID, v))
+}
+
+// TokenCreateIDIn applies the In predicate on the "token_create_id" field.
+func TokenCreateIDIn(vs ...uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldIn(FieldTokenCreateID, vs...))
+}
+
+// TokenCreateIDNotIn applies the NotIn predicate on the "token_create_id" field.
+func TokenCreateIDNotIn(vs ...uuid.UUID) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.FieldNotIn(FieldTokenCreateID, vs...))
+}
+
+// HasTokenCreate applies the HasEdge predicate on the "token_create" edge.
+func HasTokenCreate() predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TokenCreateTable, TokenCreateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTokenCreateWith applies the HasEdge predicate on the "token_create" edge with a given conditions (other predicates).
+func HasTokenCreateWith(preds ...predicate.TokenCreate) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(func(s *sql.Selector) {
+		step := newTokenCreateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenAllowlistEntry) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenAllowlistEntry) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenAllowlistEntry) predicate.TokenAllowlistEntry {
+	return predicate.TokenAllowlistEntry(sql.NotPredicates(p))
+}
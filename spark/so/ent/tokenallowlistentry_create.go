@@ -0,0 +1,807 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+)
+
+// TokenAllowlistEntryCreate is the builder for creating a TokenAllowlistEntry entity.
+type TokenAllowlistEntryCreate struct {
+	config
+	mutation *TokenAllowlistEntryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (taec *TokenAllowlistEntryCreate) SetCreateTime(t time.Time) *TokenAllowlistEntryCreate {
+	taec.mutation.SetCreateTime(t)
+	return taec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (taec *TokenAllowlistEntryCreate) SetNillableCreateTime(t *time.Time) *TokenAllowlistEntryCreate {
+	if t != nil {
+		taec.SetCreateTime(*t)
+	}
+	return taec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (taec *TokenAllowlistEntryCreate) SetUpdateTime(t time.Time) *TokenAllowlistEntryCreate {
+	taec.mutation.SetUpdateTime(t)
+	return taec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (taec *TokenAllowlistEntryCreate) SetNillableUpdateTime(t *time.Time) *TokenAllowlistEntryCreate {
+	if t != nil {
+		taec.SetUpdateTime(*t)
+	}
+	return taec
+}
+
+// SetStatus sets the "status" field.
+func (taec *TokenAllowlistEntryCreate) SetStatus(sas schematype.TokenAllowlistStatus) *TokenAllowlistEntryCreate {
+	taec.mutation.SetStatus(sas)
+	return taec
+}
+
+// SetOwnerPublicKey sets the "owner_public_key" field.
+func (taec *TokenAllowlistEntryCreate) SetOwnerPublicKey(b []byte) *TokenAllowlistEntryCreate {
+	taec.mutation.SetOwnerPublicKey(b)
+	return taec
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (taec *TokenAllowlistEntryCreate) SetIssuerSignature(b []byte) *TokenAllowlistEntryCreate {
+	taec.mutation.SetIssuerSignature(b)
+	return taec
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (taec *TokenAllowlistEntryCreate) SetIssuerProvidedTimestamp(u uint64) *TokenAllowlistEntryCreate {
+	taec.mutation.SetIssuerProvidedTimestamp(u)
+	return taec
+}
+
+// SetTokenCreateID sets the "token_create_id" field.
+func (taec *TokenAllowlistEntryCreate) SetTokenCreateID(u uuid.UUID) *TokenAllowlistEntryCreate {
+	taec.mutation.SetTokenCreateID(u)
+	return taec
+}
+
+// SetID sets the "id" field.
+func (taec *TokenAllowlistEntryCreate) SetID(u uuid.UUID) *TokenAllowlistEntryCreate {
+	taec.mutation.SetID(u)
+	return taec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (taec *TokenAllowlistEntryCreate) SetNillableID(u *uuid.UUID) *TokenAllowlistEntryCreate {
+	if u != nil {
+		taec.SetID(*u)
+	}
+	return taec
+}
+
+// SetTokenCreate sets the "token_create" edge to the TokenCreate entity.
+func (taec *TokenAllowlistEntryCreate) SetTokenCreate(t *TokenCreate) *TokenAllowlistEntryCreate {
+	return taec.SetTokenCreateID(t.ID)
+}
+
+// Mutation returns the TokenAllowlistEntryMutation object of the builder.
+func (taec *TokenAllowlistEntryCreate) Mutation() *TokenAllowlistEntryMutation {
+	return taec.mutation
+}
+
+// Save creates the TokenAllowlistEntry in the database.
+func (taec *TokenAllowlistEntryCreate) Save(ctx context.Context) (*TokenAllowlistEntry, error) {
+	taec.defaults()
+	return withHooks(ctx, taec.sqlSave, taec.mutation, taec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (taec *TokenAllowlistEntryCreate) SaveX(ctx context.Context) *TokenAllowlistEntry {
+	v, err := taec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (taec *TokenAllowlistEntryCreate) Exec(ctx context.Context) error {
+	_, err := taec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taec *TokenAllowlistEntryCreate) ExecX(ctx context.Context) {
+	if err := taec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (taec *TokenAllowlistEntryCreate) defaults() {
+	if _, ok := taec.mutation.CreateTime(); !ok {
+		v := tokenallowlistentry.DefaultCreateTime()
+		taec.mutation.SetCreateTime(v)
+	}
+	if _, ok := taec.mutation.UpdateTime(); !ok {
+		v := tokenallowlistentry.DefaultUpdateTime()
+		taec.mutation.SetUpdateTime(v)
+	}
+	if _, ok := taec.mutation.ID(); !ok {
+		v := tokenallowlistentry.DefaultID()
+		taec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (taec *TokenAllowlistEntryCreate) check() error {
+	if _, ok := taec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TokenAllowlistEntry.create_time"`)}
+	}
+	if _, ok := taec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TokenAllowlistEntry.update_time"`)}
+	}
+	if _, ok := taec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TokenAllowlistEntry.status"`)}
+	}
+	if v, ok := taec.mutation.Status(); ok {
+		if err := tokenallowlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.status": %w`, err)}
+		}
+	}
+	if _, ok := taec.mutation.OwnerPublicKey(); !ok {
+		return &ValidationError{Name: "owner_public_key", err: errors.New(`ent: missing required field "TokenAllowlistEntry.owner_public_key"`)}
+	}
+	if v, ok := taec.mutation.OwnerPublicKey(); ok {
+		if err := tokenallowlistentry.OwnerPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "owner_public_key", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.owner_public_key": %w`, err)}
+		}
+	}
+	if _, ok := taec.mutation.IssuerSignature(); !ok {
+		return &ValidationError{Name: "issuer_signature", err: errors.New(`ent: missing required field "TokenAllowlistEntry.issuer_signature"`)}
+	}
+	if v, ok := taec.mutation.IssuerSignature(); ok {
+		if err := tokenallowlistentry.IssuerSignatureValidator(v); err != nil {
+			return &ValidationError{Name: "issuer_signature", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.issuer_signature": %w`, err)}
+		}
+	}
+	if _, ok := taec.mutation.IssuerProvidedTimestamp(); !ok {
+		return &ValidationError{Name: "issuer_provided_timestamp", err: errors.New(`ent: missing required field "TokenAllowlistEntry.issuer_provided_timestamp"`)}
+	}
+	if _, ok := taec.mutation.TokenCreateID(); !ok {
+		return &ValidationError{Name: "token_create_id", err: errors.New(`ent: missing required field "TokenAllowlistEntry.token_create_id"`)}
+	}
+	if len(taec.mutation.TokenCreateIDs()) == 0 {
+		return &ValidationError{Name: "token_create", err: errors.New(`ent: missing required edge "TokenAllowlistEntry.token_create"`)}
+	}
+	return nil
+}
+
+func (taec *TokenAllowlistEntryCreate) sqlSave(ctx context.Context) (*TokenAllowlistEntry, error) {
+	if err := taec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := taec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, taec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	taec.mutation.id = &_node.ID
+	taec.mutation.done = true
+	return _node, nil
+}
+
+func (taec *TokenAllowlistEntryCreate) createSpec() (*TokenAllowlistEntry, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenAllowlistEntry{config: taec.config}
+		_spec = sqlgraph.NewCreateSpec(tokenallowlistentry.Table, sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = taec.conflict
+	if id, ok := taec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := taec.mutation.CreateTime(); ok {
+		_spec.SetField(tokenallowlistentry.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := taec.mutation.UpdateTime(); ok {
+		_spec.SetField(tokenallowlistentry.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := taec.mutation.Status(); ok {
+		_spec.SetField(tokenallowlistentry.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := taec.mutation.OwnerPublicKey(); ok {
+		_spec.SetField(tokenallowlistentry.FieldOwnerPublicKey, field.TypeBytes, value)
+		_node.OwnerPublicKey = value
+	}
+	if value, ok := taec.mutation.IssuerSignature(); ok {
+		_spec.SetField(tokenallowlistentry.FieldIssuerSignature, field.TypeBytes, value)
+		_node.IssuerSignature = value
+	}
+	if value, ok := taec.mutation.IssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokenallowlistentry.FieldIssuerProvidedTimestamp, field.TypeUint64, value)
+		_node.IssuerProvidedTimestamp = value
+	}
+	if nodes := taec.mutation.TokenCreateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tokenallowlistentry.TokenCreateTable,
+			Columns: []string{tokenallowlistentry.TokenCreateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokencreate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TokenCreateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenAllowlistEntry.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenAllowlistEntryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (taec *TokenAllowlistEntryCreate) OnConflict(opts ...sql.ConflictOption) *TokenAllowlistEntryUpsertOne {
+	taec.conflict = opts
+	return &TokenAllowlistEntryUpsertOne{
+		create: taec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenAllowlistEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (taec *TokenAllowlistEntryCreate) OnConflictColumns(columns ...string) *TokenAllowlistEntryUpsertOne {
+	taec.conflict = append(taec.conflict, sql.ConflictColumns(columns...))
+	return &TokenAllowlistEntryUpsertOne{
+		create: taec,
+	}
+}
+
+type (
+	// TokenAllowlistEntryUpsertOne is the builder for "upsert"-ing
+	//  one TokenAllowlistEntry node.
+	TokenAllowlistEntryUpsertOne struct {
+		create *TokenAllowlistEntryCreate
+	}
+
+	// TokenAllowlistEntryUpsert is the "OnConflict" setter.
+	TokenAllowlistEntryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TokenAllowlistEntryUpsert) SetUpdateTime(v time.Time) *TokenAllowlistEntryUpsert {
+	u.Set(tokenallowlistentry.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsert) UpdateUpdateTime() *TokenAllowlistEntryUpsert {
+	u.SetExcluded(tokenallowlistentry.FieldUpdateTime)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TokenAllowlistEntryUpsert) SetStatus(v schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpsert {
+	u.Set(tokenallowlistentry.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsert) UpdateStatus() *TokenAllowlistEntryUpsert {
+	u.SetExcluded(tokenallowlistentry.FieldStatus)
+	return u
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (u *TokenAllowlistEntryUpsert) SetIssuerSignature(v []byte) *TokenAllowlistEntryUpsert {
+	u.Set(tokenallowlistentry.FieldIssuerSignature, v)
+	return u
+}
+
+// UpdateIssuerSignature sets the "issuer_signature" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsert) UpdateIssuerSignature() *TokenAllowlistEntryUpsert {
+	u.SetExcluded(tokenallowlistentry.FieldIssuerSignature)
+	return u
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (u *TokenAllowlistEntryUpsert) SetIssuerProvidedTimestamp(v uint64) *TokenAllowlistEntryUpsert {
+	u.Set(tokenallowlistentry.FieldIssuerProvidedTimestamp, v)
+	return u
+}
+
+// UpdateIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsert) UpdateIssuerProvidedTimestamp() *TokenAllowlistEntryUpsert {
+	u.SetExcluded(tokenallowlistentry.FieldIssuerProvidedTimestamp)
+	return u
+}
+
+// AddIssuerProvidedTimestamp adds v to the "issuer_provided_timestamp" field.
+func (u *TokenAllowlistEntryUpsert) AddIssuerProvidedTimestamp(v uint64) *TokenAllowlistEntryUpsert {
+	u.Add(tokenallowlistentry.FieldIssuerProvidedTimestamp, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenAllowlistEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenallowlistentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenAllowlistEntryUpsertOne) UpdateNewValues() *TokenAllowlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenallowlistentry.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tokenallowlistentry.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.OwnerPublicKey(); exists {
+			s.SetIgnore(tokenallowlistentry.FieldOwnerPublicKey)
+		}
+		if _, exists := u.create.mutation.TokenCreateID(); exists {
+			s.SetIgnore(tokenallowlistentry.FieldTokenCreateID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenAllowlistEntry.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenAllowlistEntryUpsertOne) Ignore() *TokenAllowlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenAllowlistEntryUpsertOne) DoNothing() *TokenAllowlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenAllowlistEntryCreate.OnConflict
+// documentation for more info.
+func (u *TokenAllowlistEntryUpsertOne) Update(set func(*TokenAllowlistEntryUpsert)) *TokenAllowlistEntryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenAllowlistEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TokenAllowlistEntryUpsertOne) SetUpdateTime(v time.Time) *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertOne) UpdateUpdateTime() *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TokenAllowlistEntryUpsertOne) SetStatus(v schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertOne) UpdateStatus() *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (u *TokenAllowlistEntryUpsertOne) SetIssuerSignature(v []byte) *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetIssuerSignature(v)
+	})
+}
+
+// UpdateIssuerSignature sets the "issuer_signature" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertOne) UpdateIssuerSignature() *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateIssuerSignature()
+	})
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (u *TokenAllowlistEntryUpsertOne) SetIssuerProvidedTimestamp(v uint64) *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetIssuerProvidedTimestamp(v)
+	})
+}
+
+// AddIssuerProvidedTimestamp adds v to the "issuer_provided_timestamp" field.
+func (u *TokenAllowlistEntryUpsertOne) AddIssuerProvidedTimestamp(v uint64) *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.AddIssuerProvidedTimestamp(v)
+	})
+}
+
+// UpdateIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertOne) UpdateIssuerProvidedTimestamp() *TokenAllowlistEntryUpsertOne {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateIssuerProvidedTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenAllowlistEntryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenAllowlistEntryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenAllowlistEntryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenAllowlistEntryUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenAllowlistEntryUpsertOne.ID is not supported by MySQL driver. Use TokenAllowlistEntryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenAllowlistEntryUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenAllowlistEntryCreateBulk is the builder for creating many TokenAllowlistEntry entities in bulk.
+type TokenAllowlistEntryCreateBulk struct {
+	config
+	err      error
+	builders []*TokenAllowlistEntryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenAllowlistEntry entities in the database.
+func (taecb *TokenAllowlistEntryCreateBulk) Save(ctx context.Context) ([]*TokenAllowlistEntry, error) {
+	if taecb.err != nil {
+		return nil, taecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(taecb.builders))
+	nodes := make([]*TokenAllowlistEntry, len(taecb.builders))
+	mutators := make([]Mutator, len(taecb.builders))
+	for i := range taecb.builders {
+		func(i int, root context.Context) {
+			builder := taecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenAllowlistEntryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, taecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = taecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, taecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, taecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (taecb *TokenAllowlistEntryCreateBulk) SaveX(ctx context.Context) []*TokenAllowlistEntry {
+	v, err := taecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (taecb *TokenAllowlistEntryCreateBulk) Exec(ctx context.Context) error {
+	_, err := taecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taecb *TokenAllowlistEntryCreateBulk) ExecX(ctx context.Context) {
+	if err := taecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenAllowlistEntry.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenAllowlistEntryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (taecb *TokenAllowlistEntryCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenAllowlistEntryUpsertBulk {
+	taecb.conflict = opts
+	return &TokenAllowlistEntryUpsertBulk{
+		create: taecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenAllowlistEntry.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (taecb *TokenAllowlistEntryCreateBulk) OnConflictColumns(columns ...string) *TokenAllowlistEntryUpsertBulk {
+	taecb.conflict = append(taecb.conflict, sql.ConflictColumns(columns...))
+	return &TokenAllowlistEntryUpsertBulk{
+		create: taecb,
+	}
+}
+
+// TokenAllowlistEntryUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenAllowlistEntry nodes.
+type TokenAllowlistEntryUpsertBulk struct {
+	create *TokenAllowlistEntryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenAllowlistEntry.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenallowlistentry.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenAllowlistEntryUpsertBulk) UpdateNewValues() *TokenAllowlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenallowlistentry.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tokenallowlistentry.FieldCreateTime)
+			}
+			if _, exists := b.mutation.OwnerPublicKey(); exists {
+				s.SetIgnore(tokenallowlistentry.FieldOwnerPublicKey)
+			}
+			if _, exists := b.mutation.TokenCreateID(); exists {
+				s.SetIgnore(tokenallowlistentry.FieldTokenCreateID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenAllowlistEntry.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenAllowlistEntryUpsertBulk) Ignore() *TokenAllowlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenAllowlistEntryUpsertBulk) DoNothing() *TokenAllowlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenAllowlistEntryCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenAllowlistEntryUpsertBulk) Update(set func(*TokenAllowlistEntryUpsert)) *TokenAllowlistEntryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenAllowlistEntryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TokenAllowlistEntryUpsertBulk) SetUpdateTime(v time.Time) *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertBulk) UpdateUpdateTime() *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TokenAllowlistEntryUpsertBulk) SetStatus(v schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertBulk) UpdateStatus() *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (u *TokenAllowlistEntryUpsertBulk) SetIssuerSignature(v []byte) *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetIssuerSignature(v)
+	})
+}
+
+// UpdateIssuerSignature sets the "issuer_signature" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertBulk) UpdateIssuerSignature() *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateIssuerSignature()
+	})
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (u *TokenAllowlistEntryUpsertBulk) SetIssuerProvidedTimestamp(v uint64) *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.SetIssuerProvidedTimestamp(v)
+	})
+}
+
+// AddIssuerProvidedTimestamp adds v to the "issuer_provided_timestamp" field.
+func (u *TokenAllowlistEntryUpsertBulk) AddIssuerProvidedTimestamp(v uint64) *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.AddIssuerProvidedTimestamp(v)
+	})
+}
+
+// UpdateIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field to the value that was provided on create.
+func (u *TokenAllowlistEntryUpsertBulk) UpdateIssuerProvidedTimestamp() *TokenAllowlistEntryUpsertBulk {
+	return u.Update(func(s *TokenAllowlistEntryUpsert) {
+		s.UpdateIssuerProvidedTimestamp()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenAllowlistEntryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenAllowlistEntryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenAllowlistEntryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenAllowlistEntryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
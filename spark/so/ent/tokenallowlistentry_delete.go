@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+)
+
+// TokenAllowlistEntryDelete is the builder for deleting a TokenAllowlistEntry entity.
+type TokenAllowlistEntryDelete struct {
+	config
+	hooks    []Hook
+	mutation *TokenAllowlistEntryMutation
+}
+
+// Where appends a list predicates to the TokenAllowlistEntryDelete builder.
+func (taed *TokenAllowlistEntryDelete) Where(ps ...predicate.TokenAllowlistEntry) *TokenAllowlistEntryDelete {
+	taed.mutation.Where(ps...)
+	return taed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (taed *TokenAllowlistEntryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, taed.sqlExec, taed.mutation, taed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taed *TokenAllowlistEntryDelete) ExecX(ctx context.Context) int {
+	n, err := taed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (taed *TokenAllowlistEntryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tokenallowlistentry.Table, sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID))
+	if ps := taed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, taed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	taed.mutation.done = true
+	return affected, err
+}
+
+// TokenAllowlistEntryDeleteOne is the builder for deleting a single TokenAllowlistEntry entity.
+type TokenAllowlistEntryDeleteOne struct {
+	taed *TokenAllowlistEntryDelete
+}
+
+// Where appends a list predicates to the TokenAllowlistEntryDelete builder.
+func (taedo *TokenAllowlistEntryDeleteOne) Where(ps ...predicate.TokenAllowlistEntry) *TokenAllowlistEntryDeleteOne {
+	taedo.taed.mutation.Where(ps...)
+	return taedo
+}
+
+// Exec executes the deletion query.
+func (taedo *TokenAllowlistEntryDeleteOne) Exec(ctx context.Context) error {
+	n, err := taedo.taed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tokenallowlistentry.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taedo *TokenAllowlistEntryDeleteOne) ExecX(ctx context.Context) {
+	if err := taedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+)
+
+// TokenAllowlistEntryQuery is the builder for querying TokenAllowlistEntry entities.
+type TokenAllowlistEntryQuery struct {
+	config
+	ctx             *QueryContext
+	order           []tokenallowlistentry.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TokenAllowlistEntry
+	withTokenCreate *TokenCreateQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TokenAllowlistEntryQuery builder.
+func (taeq *TokenAllowlistEntryQuery) Where(ps ...predicate.TokenAllowlistEntry) *TokenAllowlistEntryQuery {
+	taeq.predicates = append(taeq.predicates, ps...)
+	return taeq
+}
+
+// Limit the number of records to be returned by this query.
+func (taeq *TokenAllowlistEntryQuery) Limit(limit int) *TokenAllowlistEntryQuery {
+	taeq.ctx.Limit = &limit
+	return taeq
+}
+
+// Offset to start from.
+func (taeq *TokenAllowlistEntryQuery) Offset(offset int) *TokenAllowlistEntryQuery {
+	taeq.ctx.Offset = &offset
+	return taeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (taeq *TokenAllowlistEntryQuery) Unique(unique bool) *TokenAllowlistEntryQuery {
+	taeq.ctx.Unique = &unique
+	return taeq
+}
+
+// Order specifies how the records should be ordered.
+func (taeq *TokenAllowlistEntryQuery) Order(o ...tokenallowlistentry.OrderOption) *TokenAllowlistEntryQuery {
+	taeq.order = append(taeq.order, o...)
+	return taeq
+}
+
+// QueryTokenCreate chains the current query on the "token_create" edge.
+func (taeq *TokenAllowlistEntryQuery) QueryTokenCreate() *TokenCreateQuery {
+	query := (&TokenCreateClient{config: taeq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := taeq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := taeq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokenallowlistentry.Table, tokenallowlistentry.FieldID, selector),
+			sqlgraph.To(tokencreate.Table, tokencreate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tokenallowlistentry.TokenCreateTable, tokenallowlistentry.TokenCreateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(taeq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TokenAllowlistEntry entity from the query.
+// Returns a *NotFoundError when no TokenAllowlistEntry was found.
+func (taeq *TokenAllowlistEntryQuery) First(ctx context.Context) (*TokenAllowlistEntry, error) {
+	nodes, err := taeq.Limit(1).All(setContextOp(ctx, taeq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tokenallowlistentry.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) FirstX(ctx context.Context) *TokenAllowlistEntry {
+	node, err := taeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TokenAllowlistEntry ID from the query.
+// Returns a *NotFoundError when no TokenAllowlistEntry ID was found.
+func (taeq *TokenAllowlistEntryQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = taeq.Limit(1).IDs(setContextOp(ctx, taeq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tokenallowlistentry.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := taeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TokenAllowlistEntry entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TokenAllowlistEntry entity is found.
+// Returns a *NotFoundError when no TokenAllowlistEntry entities are found.
+func (taeq *TokenAllowlistEntryQuery) Only(ctx context.Context) (*TokenAllowlistEntry, error) {
+	nodes, err := taeq.Limit(2).All(setContextOp(ctx, taeq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tokenallowlistentry.Label}
+	default:
+		return nil, &NotSingularError{tokenallowlistentry.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) OnlyX(ctx context.Context) *TokenAllowlistEntry {
+	node, err := taeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TokenAllowlistEntry ID in the query.
+// Returns a *NotSingularError when more than one TokenAllowlistEntry ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (taeq *TokenAllowlistEntryQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = taeq.Limit(2).IDs(setContextOp(ctx, taeq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tokenallowlistentry.Label}
+	default:
+		err = &NotSingularError{tokenallowlistentry.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := taeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TokenAllowlistEntries.
+func (taeq *TokenAllowlistEntryQuery) All(ctx context.Context) ([]*TokenAllowlistEntry, error) {
+	ctx = setContextOp(ctx, taeq.ctx, ent.OpQueryAll)
+	if err := taeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TokenAllowlistEntry, *TokenAllowlistEntryQuery]()
+	return withInterceptors[[]*TokenAllowlistEntry](ctx, taeq, qr, taeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) AllX(ctx context.Context) []*TokenAllowlistEntry {
+	nodes, err := taeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TokenAllowlistEntry IDs.
+func (taeq *TokenAllowlistEntryQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if taeq.ctx.Unique == nil && taeq.path != nil {
+		taeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, taeq.ctx, ent.OpQueryIDs)
+	if err = taeq.Select(tokenallowlistentry.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := taeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (taeq *TokenAllowlistEntryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, taeq.ctx, ent.OpQueryCount)
+	if err := taeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, taeq, querierCount[*TokenAllowlistEntryQuery](), taeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) CountX(ctx context.Context) int {
+	count, err := taeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (taeq *TokenAllowlistEntryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, taeq.ctx, ent.OpQueryExist)
+	switch _, err := taeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (taeq *TokenAllowlistEntryQuery) ExistX(ctx context.Context) bool {
+	exist, err := taeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TokenAllowlistEntryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (taeq *TokenAllowlistEntryQuery) Clone() *TokenAllowlistEntryQuery {
+	if taeq == nil {
+		return nil
+	}
+	return &TokenAllowlistEntryQuery{
+		config:          taeq.config,
+		ctx:             taeq.ctx.Clone(),
+		order:           append([]tokenallowlistentry.OrderOption{}, taeq.order...),
+		inters:          append([]Interceptor{}, taeq.inters...),
+		predicates:      append([]predicate.TokenAllowlistEntry{}, taeq.predicates...),
+		withTokenCreate: taeq.withTokenCreate.Clone(),
+		// clone intermediate query.
+		sql:  taeq.sql.Clone(),
+		path: taeq.path,
+	}
+}
+
+// WithTokenCreate tells the query-builder to eager-load the nodes that are connected to
+// the "token_create" edge. The optional arguments are used to configure the query builder of the edge.
+func (taeq *TokenAllowlistEntryQuery) WithTokenCreate(opts ...func(*TokenCreateQuery)) *TokenAllowlistEntryQuery {
+	query := (&TokenCreateClient{config: taeq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	taeq.withTokenCreate = query
+	return taeq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TokenAllowlistEntry.Query().
+//		GroupBy(tokenallowlistentry.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (taeq *TokenAllowlistEntryQuery) GroupBy(field string, fields ...string) *TokenAllowlistEntryGroupBy {
+	taeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TokenAllowlistEntryGroupBy{build: taeq}
+	grbuild.flds = &taeq.ctx.Fields
+	grbuild.label = tokenallowlistentry.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.TokenAllowlistEntry.Query().
+//		Select(tokenallowlistentry.FieldCreateTime).
+//		Scan(ctx, &v)
+func (taeq *TokenAllowlistEntryQuery) Select(fields ...string) *TokenAllowlistEntrySelect {
+	taeq.ctx.Fields = append(taeq.ctx.Fields, fields...)
+	sbuild := &TokenAllowlistEntrySelect{TokenAllowlistEntryQuery: taeq}
+	sbuild.label = tokenallowlistentry.Label
+	sbuild.flds, sbuild.scan = &taeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TokenAllowlistEntrySelect configured with the given aggregations.
+func (taeq *TokenAllowlistEntryQuery) Aggregate(fns ...AggregateFunc) *TokenAllowlistEntrySelect {
+	return taeq.Select().Aggregate(fns...)
+}
+
+func (taeq *TokenAllowlistEntryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range taeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, taeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range taeq.ctx.Fields {
+		if !tokenallowlistentry.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if taeq.path != nil {
+		prev, err := taeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		taeq.sql = prev
+	}
+	return nil
+}
+
+func (taeq *TokenAllowlistEntryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TokenAllowlistEntry, error) {
+	var (
+		nodes       = []*TokenAllowlistEntry{}
+		_spec       = taeq.querySpec()
+		loadedTypes = [1]bool{
+			taeq.withTokenCreate != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TokenAllowlistEntry).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TokenAllowlistEntry{config: taeq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(taeq.modifiers) > 0 {
+		_spec.Modifiers = taeq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, taeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := taeq.withTokenCreate; query != nil {
+		if err := taeq.loadTokenCreate(ctx, query, nodes, nil,
+			func(n *TokenAllowlistEntry, e *TokenCreate) { n.Edges.TokenCreate = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (taeq *TokenAllowlistEntryQuery) loadTokenCreate(ctx context.Context, query *TokenCreateQuery, nodes []*TokenAllowlistEntry, init func(*TokenAllowlistEntry), assign func(*TokenAllowlistEntry, *TokenCreate)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TokenAllowlistEntry)
+	for i := range nodes {
+		fk := nodes[i].TokenCreateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tokencreate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "token_create_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (taeq *TokenAllowlistEntryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := taeq.querySpec()
+	if len(taeq.modifiers) > 0 {
+		_spec.Modifiers = taeq.modifiers
+	}
+	_spec.Node.Columns = taeq.ctx.Fields
+	if len(taeq.ctx.Fields) > 0 {
+		_spec.Unique = taeq.ctx.Unique != nil && *taeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, taeq.driver, _spec)
+}
+
+func (taeq *TokenAllowlistEntryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tokenallowlistentry.Table, tokenallowlistentry.Columns, sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID))
+	_spec.From = taeq.sql
+	if unique := taeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if taeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := taeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenallowlistentry.FieldID)
+		for i := range fields {
+			if fields[i] != tokenallowlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if taeq.withTokenCreate != nil {
+			_spec.Node.AddColumnOnce(tokenallowlistentry.FieldTokenCreateID)
+		}
+	}
+	if ps := taeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := taeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := taeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := taeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (taeq *TokenAllowlistEntryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(taeq.driver.Dialect())
+	t1 := builder.Table(tokenallowlistentry.Table)
+	columns := taeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tokenallowlistentry.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if taeq.sql != nil {
+		selector = taeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if taeq.ctx.Unique != nil && *taeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range taeq.modifiers {
+		m(selector)
+	}
+	for _, p := range taeq.predicates {
+		p(selector)
+	}
+	for _, p := range taeq.order {
+		p(selector)
+	}
+	if offset := taeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := taeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (taeq *TokenAllowlistEntryQuery) ForUpdate(opts ...sql.LockOption) *TokenAllowlistEntryQuery {
+	if taeq.driver.Dialect() == dialect.Postgres {
+		taeq.Unique(false)
+	}
+	taeq.modifiers = append(taeq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return taeq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (taeq *TokenAllowlistEntryQuery) ForShare(opts ...sql.LockOption) *TokenAllowlistEntryQuery {
+	if taeq.driver.Dialect() == dialect.Postgres {
+		taeq.Unique(false)
+	}
+	taeq.modifiers = append(taeq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return taeq
+}
+
+// TokenAllowlistEntryGroupBy is the group-by builder for TokenAllowlistEntry entities.
+type TokenAllowlistEntryGroupBy struct {
+	selector
+	build *TokenAllowlistEntryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (taegb *TokenAllowlistEntryGroupBy) Aggregate(fns ...AggregateFunc) *TokenAllowlistEntryGroupBy {
+	taegb.fns = append(taegb.fns, fns...)
+	return taegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (taegb *TokenAllowlistEntryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, taegb.build.ctx, ent.OpQueryGroupBy)
+	if err := taegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenAllowlistEntryQuery, *TokenAllowlistEntryGroupBy](ctx, taegb.build, taegb, taegb.build.inters, v)
+}
+
+func (taegb *TokenAllowlistEntryGroupBy) sqlScan(ctx context.Context, root *TokenAllowlistEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(taegb.fns))
+	for _, fn := range taegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*taegb.flds)+len(taegb.fns))
+		for _, f := range *taegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*taegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := taegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TokenAllowlistEntrySelect is the builder for selecting fields of TokenAllowlistEntry entities.
+type TokenAllowlistEntrySelect struct {
+	*TokenAllowlistEntryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (taes *TokenAllowlistEntrySelect) Aggregate(fns ...AggregateFunc) *TokenAllowlistEntrySelect {
+	taes.fns = append(taes.fns, fns...)
+	return taes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (taes *TokenAllowlistEntrySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, taes.ctx, ent.OpQuerySelect)
+	if err := taes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenAllowlistEntryQuery, *TokenAllowlistEntrySelect](ctx, taes.TokenAllowlistEntryQuery, taes, taes.inters, v)
+}
+
+func (taes *TokenAllowlistEntrySelect) sqlScan(ctx context.Context, root *TokenAllowlistEntryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(taes.fns))
+	for _, fn := range taes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*taes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := taes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
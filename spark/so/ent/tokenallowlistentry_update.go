@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+)
+
+// TokenAllowlistEntryUpdate is the builder for updating TokenAllowlistEntry entities.
+type TokenAllowlistEntryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenAllowlistEntryMutation
+}
+
+// Where appends a list predicates to the TokenAllowlistEntryUpdate builder.
+func (taeu *TokenAllowlistEntryUpdate) Where(ps ...predicate.TokenAllowlistEntry) *TokenAllowlistEntryUpdate {
+	taeu.mutation.Where(ps...)
+	return taeu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (taeu *TokenAllowlistEntryUpdate) SetUpdateTime(t time.Time) *TokenAllowlistEntryUpdate {
+	taeu.mutation.SetUpdateTime(t)
+	return taeu
+}
+
+// SetStatus sets the "status" field.
+func (taeu *TokenAllowlistEntryUpdate) SetStatus(sas schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpdate {
+	taeu.mutation.SetStatus(sas)
+	return taeu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (taeu *TokenAllowlistEntryUpdate) SetNillableStatus(sas *schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpdate {
+	if sas != nil {
+		taeu.SetStatus(*sas)
+	}
+	return taeu
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (taeu *TokenAllowlistEntryUpdate) SetIssuerSignature(b []byte) *TokenAllowlistEntryUpdate {
+	taeu.mutation.SetIssuerSignature(b)
+	return taeu
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (taeu *TokenAllowlistEntryUpdate) SetIssuerProvidedTimestamp(u uint64) *TokenAllowlistEntryUpdate {
+	taeu.mutation.ResetIssuerProvidedTimestamp()
+	taeu.mutation.SetIssuerProvidedTimestamp(u)
+	return taeu
+}
+
+// SetNillableIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field if the given value is not nil.
+func (taeu *TokenAllowlistEntryUpdate) SetNillableIssuerProvidedTimestamp(u *uint64) *TokenAllowlistEntryUpdate {
+	if u != nil {
+		taeu.SetIssuerProvidedTimestamp(*u)
+	}
+	return taeu
+}
+
+// AddIssuerProvidedTimestamp adds u to the "issuer_provided_timestamp" field.
+func (taeu *TokenAllowlistEntryUpdate) AddIssuerProvidedTimestamp(u int64) *TokenAllowlistEntryUpdate {
+	taeu.mutation.AddIssuerProvidedTimestamp(u)
+	return taeu
+}
+
+// Mutation returns the TokenAllowlistEntryMutation object of the builder.
+func (taeu *TokenAllowlistEntryUpdate) Mutation() *TokenAllowlistEntryMutation {
+	return taeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (taeu *TokenAllowlistEntryUpdate) Save(ctx context.Context) (int, error) {
+	taeu.defaults()
+	return withHooks(ctx, taeu.sqlSave, taeu.mutation, taeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (taeu *TokenAllowlistEntryUpdate) SaveX(ctx context.Context) int {
+	affected, err := taeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (taeu *TokenAllowlistEntryUpdate) Exec(ctx context.Context) error {
+	_, err := taeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taeu *TokenAllowlistEntryUpdate) ExecX(ctx context.Context) {
+	if err := taeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (taeu *TokenAllowlistEntryUpdate) defaults() {
+	if _, ok := taeu.mutation.UpdateTime(); !ok {
+		v := tokenallowlistentry.UpdateDefaultUpdateTime()
+		taeu.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (taeu *TokenAllowlistEntryUpdate) check() error {
+	if v, ok := taeu.mutation.Status(); ok {
+		if err := tokenallowlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.status": %w`, err)}
+		}
+	}
+	if v, ok := taeu.mutation.IssuerSignature(); ok {
+		if err := tokenallowlistentry.IssuerSignatureValidator(v); err != nil {
+			return &ValidationError{Name: "issuer_signature", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.issuer_signature": %w`, err)}
+		}
+	}
+	if taeu.mutation.TokenCreateCleared() && len(taeu.mutation.TokenCreateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TokenAllowlistEntry.token_create"`)
+	}
+	return nil
+}
+
+func (taeu *TokenAllowlistEntryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := taeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenallowlistentry.Table, tokenallowlistentry.Columns, sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID))
+	if ps := taeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := taeu.mutation.UpdateTime(); ok {
+		_spec.SetField(tokenallowlistentry.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := taeu.mutation.Status(); ok {
+		_spec.SetField(tokenallowlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := taeu.mutation.IssuerSignature(); ok {
+		_spec.SetField(tokenallowlistentry.FieldIssuerSignature, field.TypeBytes, value)
+	}
+	if value, ok := taeu.mutation.IssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokenallowlistentry.FieldIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if value, ok := taeu.mutation.AddedIssuerProvidedTimestamp(); ok {
+		_spec.AddField(tokenallowlistentry.FieldIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, taeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenallowlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	taeu.mutation.done = true
+	return n, nil
+}
+
+// TokenAllowlistEntryUpdateOne is the builder for updating a single TokenAllowlistEntry entity.
+type TokenAllowlistEntryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenAllowlistEntryMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (taeuo *TokenAllowlistEntryUpdateOne) SetUpdateTime(t time.Time) *TokenAllowlistEntryUpdateOne {
+	taeuo.mutation.SetUpdateTime(t)
+	return taeuo
+}
+
+// SetStatus sets the "status" field.
+func (taeuo *TokenAllowlistEntryUpdateOne) SetStatus(sas schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpdateOne {
+	taeuo.mutation.SetStatus(sas)
+	return taeuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (taeuo *TokenAllowlistEntryUpdateOne) SetNillableStatus(sas *schematype.TokenAllowlistStatus) *TokenAllowlistEntryUpdateOne {
+	if sas != nil {
+		taeuo.SetStatus(*sas)
+	}
+	return taeuo
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (taeuo *TokenAllowlistEntryUpdateOne) SetIssuerSignature(b []byte) *TokenAllowlistEntryUpdateOne {
+	taeuo.mutation.SetIssuerSignature(b)
+	return taeuo
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (taeuo *TokenAllowlistEntryUpdateOne) SetIssuerProvidedTimestamp(u uint64) *TokenAllowlistEntryUpdateOne {
+	taeuo.mutation.ResetIssuerProvidedTimestamp()
+	taeuo.mutation.SetIssuerProvidedTimestamp(u)
+	return taeuo
+}
+
+// SetNillableIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field if the given value is not nil.
+func (taeuo *TokenAllowlistEntryUpdateOne) SetNillableIssuerProvidedTimestamp(u *uint64) *TokenAllowlistEntryUpdateOne {
+	if u != nil {
+		taeuo.SetIssuerProvidedTimestamp(*u)
+	}
+	return taeuo
+}
+
+// AddIssuerProvidedTimestamp adds u to the "issuer_provided_timestamp" field.
+func (taeuo *TokenAllowlistEntryUpdateOne) AddIssuerProvidedTimestamp(u int64) *TokenAllowlistEntryUpdateOne {
+	taeuo.mutation.AddIssuerProvidedTimestamp(u)
+	return taeuo
+}
+
+// Mutation returns the TokenAllowlistEntryMutation object of the builder.
+func (taeuo *TokenAllowlistEntryUpdateOne) Mutation() *TokenAllowlistEntryMutation {
+	return taeuo.mutation
+}
+
+// Where appends a list predicates to the TokenAllowlistEntryUpdate builder.
+func (taeuo *TokenAllowlistEntryUpdateOne) Where(ps ...predicate.TokenAllowlistEntry) *TokenAllowlistEntryUpdateOne {
+	taeuo.mutation.Where(ps...)
+	return taeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (taeuo *TokenAllowlistEntryUpdateOne) Select(field string, fields ...string) *TokenAllowlistEntryUpdateOne {
+	taeuo.fields = append([]string{field}, fields...)
+	return taeuo
+}
+
+// Save executes the query and returns the updated TokenAllowlistEntry entity.
+func (taeuo *TokenAllowlistEntryUpdateOne) Save(ctx context.Context) (*TokenAllowlistEntry, error) {
+	taeuo.defaults()
+	return withHooks(ctx, taeuo.sqlSave, taeuo.mutation, taeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (taeuo *TokenAllowlistEntryUpdateOne) SaveX(ctx context.Context) *TokenAllowlistEntry {
+	node, err := taeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (taeuo *TokenAllowlistEntryUpdateOne) Exec(ctx context.Context) error {
+	_, err := taeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (taeuo *TokenAllowlistEntryUpdateOne) ExecX(ctx context.Context) {
+	if err := taeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (taeuo *TokenAllowlistEntryUpdateOne) defaults() {
+	if _, ok := taeuo.mutation.UpdateTime(); !ok {
+		v := tokenallowlistentry.UpdateDefaultUpdateTime()
+		taeuo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (taeuo *TokenAllowlistEntryUpdateOne) check() error {
+	if v, ok := taeuo.mutation.Status(); ok {
+		if err := tokenallowlistentry.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.status": %w`, err)}
+		}
+	}
+	if v, ok := taeuo.mutation.IssuerSignature(); ok {
+		if err := tokenallowlistentry.IssuerSignatureValidator(v); err != nil {
+			return &ValidationError{Name: "issuer_signature", err: fmt.Errorf(`ent: validator failed for field "TokenAllowlistEntry.issuer_signature": %w`, err)}
+		}
+	}
+	if taeuo.mutation.TokenCreateCleared() && len(taeuo.mutation.TokenCreateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TokenAllowlistEntry.token_create"`)
+	}
+	return nil
+}
+
+func (taeuo *TokenAllowlistEntryUpdateOne) sqlSave(ctx context.Context) (_node *TokenAllowlistEntry, err error) {
+	if err := taeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenallowlistentry.Table, tokenallowlistentry.Columns, sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID))
+	id, ok := taeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenAllowlistEntry.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := taeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenallowlistentry.FieldID)
+		for _, f := range fields {
+			if !tokenallowlistentry.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenallowlistentry.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := taeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := taeuo.mutation.UpdateTime(); ok {
+		_spec.SetField(tokenallowlistentry.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := taeuo.mutation.Status(); ok {
+		_spec.SetField(tokenallowlistentry.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := taeuo.mutation.IssuerSignature(); ok {
+		_spec.SetField(tokenallowlistentry.FieldIssuerSignature, field.TypeBytes, value)
+	}
+	if value, ok := taeuo.mutation.IssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokenallowlistentry.FieldIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if value, ok := taeuo.mutation.AddedIssuerProvidedTimestamp(); ok {
+		_spec.AddField(tokenallowlistentry.FieldIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	_node = &TokenAllowlistEntry{config: taeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, taeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenallowlistentry.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	taeuo.mutation.done = true
+	return _node, nil
+}
@@ -50,6 +50,14 @@ type TokenCreate struct {
 	//
 	// Deprecated: Field "wallet_provided_timestamp" was marked as deprecated in the schema.
 	WalletProvidedTimestamp uint64 `json:"wallet_provided_timestamp,omitempty"`
+	// IsTransferRestricted holds the value of the "is_transfer_restricted" field.
+	IsTransferRestricted bool `json:"is_transfer_restricted,omitempty"`
+	// IsPaused holds the value of the "is_paused" field.
+	IsPaused bool `json:"is_paused,omitempty"`
+	// PauseIssuerSignature holds the value of the "pause_issuer_signature" field.
+	PauseIssuerSignature []byte `json:"pause_issuer_signature,omitempty"`
+	// PauseIssuerProvidedTimestamp holds the value of the "pause_issuer_provided_timestamp" field.
+	PauseIssuerProvidedTimestamp uint64 `json:"pause_issuer_provided_timestamp,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenCreateQuery when eager-loading is set.
 	Edges                        TokenCreateEdges `json:"edges"`
@@ -67,9 +75,13 @@ type TokenCreateEdges struct {
 	TokenOutput []*TokenOutput `json:"token_output,omitempty"`
 	// TokenFreeze holds the value of the token_freeze edge.
 	TokenFreeze []*TokenFreeze `json:"token_freeze,omitempty"`
+	// IssuerKeyRotations holds the value of the issuer_key_rotations edge.
+	IssuerKeyRotations []*TokenIssuerKeyRotation `json:"issuer_key_rotations,omitempty"`
+	// AllowlistEntries holds the value of the allowlist_entries edge.
+	AllowlistEntries []*TokenAllowlistEntry `json:"allowlist_entries,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // TokenTransactionOrErr returns the TokenTransaction value or an error if the edge
@@ -110,16 +122,34 @@ func (e TokenCreateEdges) TokenFreezeOrErr() ([]*TokenFreeze, error) {
 	return nil, &NotLoadedError{edge: "token_freeze"}
 }
 
+// IssuerKeyRotationsOrErr returns the IssuerKeyRotations value or an error if the edge
+// was not loaded in eager-loading.
+func (e TokenCreateEdges) IssuerKeyRotationsOrErr() ([]*TokenIssuerKeyRotation, error) {
+	if e.loadedTypes[4] {
+		return e.IssuerKeyRotations, nil
+	}
+	return nil, &NotLoadedError{edge: "issuer_key_rotations"}
+}
+
+// AllowlistEntriesOrErr returns the AllowlistEntries value or an error if the edge
+// was not loaded in eager-loading.
+func (e TokenCreateEdges) AllowlistEntriesOrErr() ([]*TokenAllowlistEntry, error) {
+	if e.loadedTypes[5] {
+		return e.AllowlistEntries, nil
+	}
+	return nil, &NotLoadedError{edge: "allowlist_entries"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*TokenCreate) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tokencreate.FieldIssuerPublicKey, tokencreate.FieldMaxSupply, tokencreate.FieldTokenIdentifier, tokencreate.FieldIssuerSignature, tokencreate.FieldOperatorSpecificIssuerSignature, tokencreate.FieldCreationEntityPublicKey:
+		case tokencreate.FieldIssuerPublicKey, tokencreate.FieldMaxSupply, tokencreate.FieldTokenIdentifier, tokencreate.FieldIssuerSignature, tokencreate.FieldOperatorSpecificIssuerSignature, tokencreate.FieldCreationEntityPublicKey, tokencreate.FieldPauseIssuerSignature:
 			values[i] = new([]byte)
-		case tokencreate.FieldIsFreezable:
+		case tokencreate.FieldIsFreezable, tokencreate.FieldIsTransferRestricted, tokencreate.FieldIsPaused:
 			values[i] = new(sql.NullBool)
-		case tokencreate.FieldDecimals, tokencreate.FieldWalletProvidedTimestamp:
+		case tokencreate.FieldDecimals, tokencreate.FieldWalletProvidedTimestamp, tokencreate.FieldPauseIssuerProvidedTimestamp:
 			values[i] = new(sql.NullInt64)
 		case tokencreate.FieldTokenName, tokencreate.FieldTokenTicker, tokencreate.FieldNetwork:
 			values[i] = new(sql.NullString)
@@ -234,6 +264,30 @@ func (tc *TokenCreate) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				tc.WalletProvidedTimestamp = uint64(value.Int64)
 			}
+		case tokencreate.FieldIsTransferRestricted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_transfer_restricted", values[i])
+			} else if value.Valid {
+				tc.IsTransferRestricted = value.Bool
+			}
+		case tokencreate.FieldIsPaused:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_paused", values[i])
+			} else if value.Valid {
+				tc.IsPaused = value.Bool
+			}
+		case tokencreate.FieldPauseIssuerSignature:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pause_issuer_signature", values[i])
+			} else if value != nil {
+				tc.PauseIssuerSignature = *value
+			}
+		case tokencreate.FieldPauseIssuerProvidedTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pause_issuer_provided_timestamp", values[i])
+			} else if value.Valid {
+				tc.PauseIssuerProvidedTimestamp = uint64(value.Int64)
+			}
 		case tokencreate.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field token_create_l1_token_create", values[i])
@@ -274,6 +328,16 @@ func (tc *TokenCreate) QueryTokenFreeze() *TokenFreezeQuery {
 	return NewTokenCreateClient(tc.config).QueryTokenFreeze(tc)
 }
 
+// QueryIssuerKeyRotations queries the "issuer_key_rotations" edge of the TokenCreate entity.
+func (tc *TokenCreate) QueryIssuerKeyRotations() *TokenIssuerKeyRotationQuery {
+	return NewTokenCreateClient(tc.config).QueryIssuerKeyRotations(tc)
+}
+
+// QueryAllowlistEntries queries the "allowlist_entries" edge of the TokenCreate entity.
+func (tc *TokenCreate) QueryAllowlistEntries() *TokenAllowlistEntryQuery {
+	return NewTokenCreateClient(tc.config).QueryAllowlistEntries(tc)
+}
+
 // Update returns a builder for updating this TokenCreate.
 // Note that you need to call TokenCreate.Unwrap() before calling this method if this TokenCreate
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -338,6 +402,18 @@ func (tc *TokenCreate) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("wallet_provided_timestamp=")
 	builder.WriteString(fmt.Sprintf("%v", tc.WalletProvidedTimestamp))
+	builder.WriteString(", ")
+	builder.WriteString("is_transfer_restricted=")
+	builder.WriteString(fmt.Sprintf("%v", tc.IsTransferRestricted))
+	builder.WriteString(", ")
+	builder.WriteString("is_paused=")
+	builder.WriteString(fmt.Sprintf("%v", tc.IsPaused))
+	builder.WriteString(", ")
+	builder.WriteString("pause_issuer_signature=")
+	builder.WriteString(fmt.Sprintf("%v", tc.PauseIssuerSignature))
+	builder.WriteString(", ")
+	builder.WriteString("pause_issuer_provided_timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", tc.PauseIssuerProvidedTimestamp))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -45,6 +45,14 @@ const (
 	FieldCreationEntityPublicKey = "creation_entity_public_key"
 	// FieldWalletProvidedTimestamp holds the string denoting the wallet_provided_timestamp field in the database.
 	FieldWalletProvidedTimestamp = "wallet_provided_timestamp"
+	// FieldIsTransferRestricted holds the string denoting the is_transfer_restricted field in the database.
+	FieldIsTransferRestricted = "is_transfer_restricted"
+	// FieldIsPaused holds the string denoting the is_paused field in the database.
+	FieldIsPaused = "is_paused"
+	// FieldPauseIssuerSignature holds the string denoting the pause_issuer_signature field in the database.
+	FieldPauseIssuerSignature = "pause_issuer_signature"
+	// FieldPauseIssuerProvidedTimestamp holds the string denoting the pause_issuer_provided_timestamp field in the database.
+	FieldPauseIssuerProvidedTimestamp = "pause_issuer_provided_timestamp"
 	// EdgeTokenTransaction holds the string denoting the token_transaction edge name in mutations.
 	EdgeTokenTransaction = "token_transaction"
 	// EdgeL1TokenCreate holds the string denoting the l1_token_create edge name in mutations.
@@ -53,6 +61,10 @@ const (
 	EdgeTokenOutput = "token_output"
 	// EdgeTokenFreeze holds the string denoting the token_freeze edge name in mutations.
 	EdgeTokenFreeze = "token_freeze"
+	// EdgeIssuerKeyRotations holds the string denoting the issuer_key_rotations edge name in mutations.
+	EdgeIssuerKeyRotations = "issuer_key_rotations"
+	// EdgeAllowlistEntries holds the string denoting the allowlist_entries edge name in mutations.
+	EdgeAllowlistEntries = "allowlist_entries"
 	// Table holds the table name of the tokencreate in the database.
 	Table = "token_creates"
 	// TokenTransactionTable is the table that holds the token_transaction relation/edge.
@@ -83,6 +95,20 @@ const (
 	TokenFreezeInverseTable = "token_freezes"
 	// TokenFreezeColumn is the table column denoting the token_freeze relation/edge.
 	TokenFreezeColumn = "token_create_id"
+	// IssuerKeyRotationsTable is the table that holds the issuer_key_rotations relation/edge.
+	IssuerKeyRotationsTable = "token_issuer_key_rotations"
+	// IssuerKeyRotationsInverseTable is the table name for the TokenIssuerKeyRotation entity.
+	// It exists in this package in order to avoid circular dependency with the "tokenissuerkeyrotation" package.
+	IssuerKeyRotationsInverseTable = "token_issuer_key_rotations"
+	// IssuerKeyRotationsColumn is the table column denoting the issuer_key_rotations relation/edge.
+	IssuerKeyRotationsColumn = "token_create_id"
+	// AllowlistEntriesTable is the table that holds the allowlist_entries relation/edge.
+	AllowlistEntriesTable = "token_allowlist_entries"
+	// AllowlistEntriesInverseTable is the table name for the TokenAllowlistEntry entity.
+	// It exists in this package in order to avoid circular dependency with the "tokenallowlistentry" package.
+	AllowlistEntriesInverseTable = "token_allowlist_entries"
+	// AllowlistEntriesColumn is the table column denoting the allowlist_entries relation/edge.
+	AllowlistEntriesColumn = "token_create_id"
 )
 
 // Columns holds all SQL columns for tokencreate fields.
@@ -101,6 +127,10 @@ var Columns = []string{
 	FieldIssuerSignature,
 	FieldOperatorSpecificIssuerSignature,
 	FieldCreationEntityPublicKey,
+	FieldIsTransferRestricted,
+	FieldIsPaused,
+	FieldPauseIssuerSignature,
+	FieldPauseIssuerProvidedTimestamp,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "token_creates"
@@ -150,6 +180,10 @@ var (
 	IssuerSignatureValidator func([]byte) error
 	// CreationEntityPublicKeyValidator is a validator for the "creation_entity_public_key" field. It is called by the builders before save.
 	CreationEntityPublicKeyValidator func([]byte) error
+	// DefaultIsTransferRestricted holds the default value on creation for the "is_transfer_restricted" field.
+	DefaultIsTransferRestricted bool
+	// DefaultIsPaused holds the default value on creation for the "is_paused" field.
+	DefaultIsPaused bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -212,6 +246,21 @@ func ByWalletProvidedTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWalletProvidedTimestamp, opts...).ToFunc()
 }
 
+// ByIsTransferRestricted orders the results by the is_transfer_restricted field.
+func ByIsTransferRestricted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsTransferRestricted, opts...).ToFunc()
+}
+
+// ByIsPaused orders the results by the is_paused field.
+func ByIsPaused(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPaused, opts...).ToFunc()
+}
+
+// ByPauseIssuerProvidedTimestamp orders the results by the pause_issuer_provided_timestamp field.
+func ByPauseIssuerProvidedTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPauseIssuerProvidedTimestamp, opts...).ToFunc()
+}
+
 // ByTokenTransactionCount orders the results by token_transaction count.
 func ByTokenTransactionCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -260,6 +309,34 @@ func ByTokenFreeze(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTokenFreezeStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByIssuerKeyRotationsCount orders the results by issuer_key_rotations count.
+func ByIssuerKeyRotationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIssuerKeyRotationsStep(), opts...)
+	}
+}
+
+// ByIssuerKeyRotations orders the results by issuer_key_rotations terms.
+func ByIssuerKeyRotations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIssuerKeyRotationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAllowlistEntriesCount orders the results by allowlist_entries count.
+func ByAllowlistEntriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAllowlistEntriesStep(), opts...)
+	}
+}
+
+// ByAllowlistEntries orders the results by allowlist_entries terms.
+func ByAllowlistEntries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAllowlistEntriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTokenTransactionStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -288,3 +365,17 @@ func newTokenFreezeStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, TokenFreezeTable, TokenFreezeColumn),
 	)
 }
+func newIssuerKeyRotationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IssuerKeyRotationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IssuerKeyRotationsTable, IssuerKeyRotationsColumn),
+	)
+}
+func newAllowlistEntriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AllowlistEntriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AllowlistEntriesTable, AllowlistEntriesColumn),
+	)
+}
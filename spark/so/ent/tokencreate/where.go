@@ -122,6 +122,26 @@ func WalletProvidedTimestamp(v uint64) predicate.TokenCreate {
 	return predicate.TokenCreate(sql.FieldEQ(FieldWalletProvidedTimestamp, v))
 }
 
+// IsTransferRestricted applies equality check predicate on the "is_transfer_restricted" field. It's identical to IsTransferRestrictedEQ.
+func IsTransferRestricted(v bool) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldIsTransferRestricted, v))
+}
+
+// IsPaused applies equality check predicate on the "is_paused" field. It's identical to IsPausedEQ.
+func IsPaused(v bool) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldIsPaused, v))
+}
+
+// PauseIssuerSignature applies equality check predicate on the "pause_issuer_signature" field. It's identical to PauseIssuerSignatureEQ.
+func PauseIssuerSignature(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerProvidedTimestamp applies equality check predicate on the "pause_issuer_provided_timestamp" field. It's identical to PauseIssuerProvidedTimestampEQ.
+func PauseIssuerProvidedTimestamp(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldPauseIssuerProvidedTimestamp, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.TokenCreate {
 	return predicate.TokenCreate(sql.FieldEQ(FieldCreateTime, v))
@@ -722,6 +742,126 @@ func WalletProvidedTimestampNotNil() predicate.TokenCreate {
 	return predicate.TokenCreate(sql.FieldNotNull(FieldWalletProvidedTimestamp))
 }
 
+// IsTransferRestrictedEQ applies the EQ predicate on the "is_transfer_restricted" field.
+func IsTransferRestrictedEQ(v bool) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldIsTransferRestricted, v))
+}
+
+// IsTransferRestrictedNEQ applies the NEQ predicate on the "is_transfer_restricted" field.
+func IsTransferRestrictedNEQ(v bool) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNEQ(FieldIsTransferRestricted, v))
+}
+
+// IsPausedEQ applies the EQ predicate on the "is_paused" field.
+func IsPausedEQ(v bool) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldIsPaused, v))
+}
+
+// IsPausedNEQ applies the NEQ predicate on the "is_paused" field.
+func IsPausedNEQ(v bool) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNEQ(FieldIsPaused, v))
+}
+
+// PauseIssuerSignatureEQ applies the EQ predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureEQ(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerSignatureNEQ applies the NEQ predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureNEQ(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNEQ(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerSignatureIn applies the In predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureIn(vs ...[]byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldIn(FieldPauseIssuerSignature, vs...))
+}
+
+// PauseIssuerSignatureNotIn applies the NotIn predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureNotIn(vs ...[]byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNotIn(FieldPauseIssuerSignature, vs...))
+}
+
+// PauseIssuerSignatureGT applies the GT predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureGT(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldGT(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerSignatureGTE applies the GTE predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureGTE(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldGTE(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerSignatureLT applies the LT predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureLT(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldLT(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerSignatureLTE applies the LTE predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureLTE(v []byte) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldLTE(FieldPauseIssuerSignature, v))
+}
+
+// PauseIssuerSignatureIsNil applies the IsNil predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureIsNil() predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldIsNull(FieldPauseIssuerSignature))
+}
+
+// PauseIssuerSignatureNotNil applies the NotNil predicate on the "pause_issuer_signature" field.
+func PauseIssuerSignatureNotNil() predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNotNull(FieldPauseIssuerSignature))
+}
+
+// PauseIssuerProvidedTimestampEQ applies the EQ predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampEQ(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldEQ(FieldPauseIssuerProvidedTimestamp, v))
+}
+
+// PauseIssuerProvidedTimestampNEQ applies the NEQ predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampNEQ(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNEQ(FieldPauseIssuerProvidedTimestamp, v))
+}
+
+// PauseIssuerProvidedTimestampIn applies the In predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampIn(vs ...uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldIn(FieldPauseIssuerProvidedTimestamp, vs...))
+}
+
+// PauseIssuerProvidedTimestampNotIn applies the NotIn predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampNotIn(vs ...uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNotIn(FieldPauseIssuerProvidedTimestamp, vs...))
+}
+
+// PauseIssuerProvidedTimestampGT applies the GT predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampGT(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldGT(FieldPauseIssuerProvidedTimestamp, v))
+}
+
+// PauseIssuerProvidedTimestampGTE applies the GTE predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampGTE(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldGTE(FieldPauseIssuerProvidedTimestamp, v))
+}
+
+// PauseIssuerProvidedTimestampLT applies the LT predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampLT(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldLT(FieldPauseIssuerProvidedTimestamp, v))
+}
+
+// PauseIssuerProvidedTimestampLTE applies the LTE predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampLTE(v uint64) predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldLTE(FieldPauseIssuerProvidedTimestamp, v))
+}
+
+// PauseIssuerProvidedTimestampIsNil applies the IsNil predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampIsNil() predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldIsNull(FieldPauseIssuerProvidedTimestamp))
+}
+
+// PauseIssuerProvidedTimestampNotNil applies the NotNil predicate on the "pause_issuer_provided_timestamp" field.
+func PauseIssuerProvidedTimestampNotNil() predicate.TokenCreate {
+	return predicate.TokenCreate(sql.FieldNotNull(FieldPauseIssuerProvidedTimestamp))
+}
+
 // HasTokenTransaction applies the HasEdge predicate on the "token_transaction" edge.
 func HasTokenTransaction() predicate.TokenCreate {
 	return predicate.TokenCreate(func(s *sql.Selector) {
@@ -814,6 +954,52 @@ func HasTokenFreezeWith(preds ...predicate.TokenFreeze) predicate.TokenCreate {
 	})
 }
 
+// HasIssuerKeyRotations applies the HasEdge predicate on the "issuer_key_rotations" edge.
+func HasIssuerKeyRotations() predicate.TokenCreate {
+	return predicate.TokenCreate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IssuerKeyRotationsTable, IssuerKeyRotationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIssuerKeyRotationsWith applies the HasEdge predicate on the "issuer_key_rotations" edge with a given conditions (other predicates).
+func HasIssuerKeyRotationsWith(preds ...predicate.TokenIssuerKeyRotation) predicate.TokenCreate {
+	return predicate.TokenCreate(func(s *sql.Selector) {
+		step := newIssuerKeyRotationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAllowlistEntries applies the HasEdge predicate on the "allowlist_entries" edge.
+func HasAllowlistEntries() predicate.TokenCreate {
+	return predicate.TokenCreate(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AllowlistEntriesTable, AllowlistEntriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAllowlistEntriesWith applies the HasEdge predicate on the "allowlist_entries" edge with a given conditions (other predicates).
+func HasAllowlistEntriesWith(preds ...predicate.TokenAllowlistEntry) predicate.TokenCreate {
+	return predicate.TokenCreate(func(s *sql.Selector) {
+		step := newAllowlistEntriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TokenCreate) predicate.TokenCreate {
 	return predicate.TokenCreate(sql.AndPredicates(predicates...))
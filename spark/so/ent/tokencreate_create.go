@@ -15,8 +15,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/so/ent/l1tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
 )
@@ -137,6 +139,54 @@ func (tcc *TokenCreateCreate) SetNillableWalletProvidedTimestamp(u *uint64) *Tok
 	return tcc
 }
 
+// SetIsTransferRestricted sets the "is_transfer_restricted" field.
+func (tcc *TokenCreateCreate) SetIsTransferRestricted(b bool) *TokenCreateCreate {
+	tcc.mutation.SetIsTransferRestricted(b)
+	return tcc
+}
+
+// SetNillableIsTransferRestricted sets the "is_transfer_restricted" field if the given value is not nil.
+func (tcc *TokenCreateCreate) SetNillableIsTransferRestricted(b *bool) *TokenCreateCreate {
+	if b != nil {
+		tcc.SetIsTransferRestricted(*b)
+	}
+	return tcc
+}
+
+// SetIsPaused sets the "is_paused" field.
+func (tcc *TokenCreateCreate) SetIsPaused(b bool) *TokenCreateCreate {
+	tcc.mutation.SetIsPaused(b)
+	return tcc
+}
+
+// SetNillableIsPaused sets the "is_paused" field if the given value is not nil.
+func (tcc *TokenCreateCreate) SetNillableIsPaused(b *bool) *TokenCreateCreate {
+	if b != nil {
+		tcc.SetIsPaused(*b)
+	}
+	return tcc
+}
+
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (tcc *TokenCreateCreate) SetPauseIssuerSignature(b []byte) *TokenCreateCreate {
+	tcc.mutation.SetPauseIssuerSignature(b)
+	return tcc
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (tcc *TokenCreateCreate) SetPauseIssuerProvidedTimestamp(u uint64) *TokenCreateCreate {
+	tcc.mutation.SetPauseIssuerProvidedTimestamp(u)
+	return tcc
+}
+
+// SetNillablePauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field if the given value is not nil.
+func (tcc *TokenCreateCreate) SetNillablePauseIssuerProvidedTimestamp(u *uint64) *TokenCreateCreate {
+	if u != nil {
+		tcc.SetPauseIssuerProvidedTimestamp(*u)
+	}
+	return tcc
+}
+
 // SetID sets the "id" field.
 func (tcc *TokenCreateCreate) SetID(u uuid.UUID) *TokenCreateCreate {
 	tcc.mutation.SetID(u)
@@ -215,6 +265,36 @@ func (tcc *TokenCreateCreate) AddTokenFreeze(t ...*TokenFreeze) *TokenCreateCrea
 	return tcc.AddTokenFreezeIDs(ids...)
 }
 
+// AddIssuerKeyRotationIDs adds the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity by IDs.
+func (tcc *TokenCreateCreate) AddIssuerKeyRotationIDs(ids ...uuid.UUID) *TokenCreateCreate {
+	tcc.mutation.AddIssuerKeyRotationIDs(ids...)
+	return tcc
+}
+
+// AddIssuerKeyRotations adds the "issuer_key_rotations" edges to the TokenIssuerKeyRotation entity.
+func (tcc *TokenCreateCreate) AddIssuerKeyRotations(t ...*TokenIssuerKeyRotation) *TokenCreateCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcc.AddIssuerKeyRotationIDs(ids...)
+}
+
+// AddAllowlistEntryIDs adds the "allowlist_entries" edge to the TokenAllowlistEntry entity by IDs.
+func (tcc *TokenCreateCreate) AddAllowlistEntryIDs(ids ...uuid.UUID) *TokenCreateCreate {
+	tcc.mutation.AddAllowlistEntryIDs(ids...)
+	return tcc
+}
+
+// AddAllowlistEntries adds the "allowlist_entries" edges to the TokenAllowlistEntry entity.
+func (tcc *TokenCreateCreate) AddAllowlistEntries(t ...*TokenAllowlistEntry) *TokenCreateCreate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcc.AddAllowlistEntryIDs(ids...)
+}
+
 // Mutation returns the TokenCreateMutation object of the builder.
 func (tcc *TokenCreateCreate) Mutation() *TokenCreateMutation {
 	return tcc.mutation
@@ -258,6 +338,14 @@ func (tcc *TokenCreateCreate) defaults() {
 		v := tokencreate.DefaultUpdateTime()
 		tcc.mutation.SetUpdateTime(v)
 	}
+	if _, ok := tcc.mutation.IsTransferRestricted(); !ok {
+		v := tokencreate.DefaultIsTransferRestricted
+		tcc.mutation.SetIsTransferRestricted(v)
+	}
+	if _, ok := tcc.mutation.IsPaused(); !ok {
+		v := tokencreate.DefaultIsPaused
+		tcc.mutation.SetIsPaused(v)
+	}
 	if _, ok := tcc.mutation.ID(); !ok {
 		v := tokencreate.DefaultID()
 		tcc.mutation.SetID(v)
@@ -339,6 +427,12 @@ func (tcc *TokenCreateCreate) check() error {
 			return &ValidationError{Name: "creation_entity_public_key", err: fmt.Errorf(`ent: validator failed for field "TokenCreate.creation_entity_public_key": %w`, err)}
 		}
 	}
+	if _, ok := tcc.mutation.IsTransferRestricted(); !ok {
+		return &ValidationError{Name: "is_transfer_restricted", err: errors.New(`ent: missing required field "TokenCreate.is_transfer_restricted"`)}
+	}
+	if _, ok := tcc.mutation.IsPaused(); !ok {
+		return &ValidationError{Name: "is_paused", err: errors.New(`ent: missing required field "TokenCreate.is_paused"`)}
+	}
 	return nil
 }
 
@@ -431,6 +525,22 @@ func (tcc *TokenCreateCreate) createSpec() (*TokenCreate, *sqlgraph.CreateSpec)
 		_spec.SetField(tokencreate.FieldWalletProvidedTimestamp, field.TypeUint64, value)
 		_node.WalletProvidedTimestamp = value
 	}
+	if value, ok := tcc.mutation.IsTransferRestricted(); ok {
+		_spec.SetField(tokencreate.FieldIsTransferRestricted, field.TypeBool, value)
+		_node.IsTransferRestricted = value
+	}
+	if value, ok := tcc.mutation.IsPaused(); ok {
+		_spec.SetField(tokencreate.FieldIsPaused, field.TypeBool, value)
+		_node.IsPaused = value
+	}
+	if value, ok := tcc.mutation.PauseIssuerSignature(); ok {
+		_spec.SetField(tokencreate.FieldPauseIssuerSignature, field.TypeBytes, value)
+		_node.PauseIssuerSignature = value
+	}
+	if value, ok := tcc.mutation.PauseIssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64, value)
+		_node.PauseIssuerProvidedTimestamp = value
+	}
 	if nodes := tcc.mutation.TokenTransactionIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -496,6 +606,38 @@ func (tcc *TokenCreateCreate) createSpec() (*TokenCreate, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tcc.mutation.IssuerKeyRotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.AllowlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -596,6 +738,60 @@ func (u *TokenCreateUpsert) ClearOperatorSpecificIssuerSignature() *TokenCreateU
 	return u
 }
 
+// SetIsPaused sets the "is_paused" field.
+func (u *TokenCreateUpsert) SetIsPaused(v bool) *TokenCreateUpsert {
+	u.Set(tokencreate.FieldIsPaused, v)
+	return u
+}
+
+// UpdateIsPaused sets the "is_paused" field to the value that was provided on create.
+func (u *TokenCreateUpsert) UpdateIsPaused() *TokenCreateUpsert {
+	u.SetExcluded(tokencreate.FieldIsPaused)
+	return u
+}
+
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (u *TokenCreateUpsert) SetPauseIssuerSignature(v []byte) *TokenCreateUpsert {
+	u.Set(tokencreate.FieldPauseIssuerSignature, v)
+	return u
+}
+
+// UpdatePauseIssuerSignature sets the "pause_issuer_signature" field to the value that was provided on create.
+func (u *TokenCreateUpsert) UpdatePauseIssuerSignature() *TokenCreateUpsert {
+	u.SetExcluded(tokencreate.FieldPauseIssuerSignature)
+	return u
+}
+
+// ClearPauseIssuerSignature clears the value of the "pause_issuer_signature" field.
+func (u *TokenCreateUpsert) ClearPauseIssuerSignature() *TokenCreateUpsert {
+	u.SetNull(tokencreate.FieldPauseIssuerSignature)
+	return u
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsert) SetPauseIssuerProvidedTimestamp(v uint64) *TokenCreateUpsert {
+	u.Set(tokencreate.FieldPauseIssuerProvidedTimestamp, v)
+	return u
+}
+
+// UpdatePauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field to the value that was provided on create.
+func (u *TokenCreateUpsert) UpdatePauseIssuerProvidedTimestamp() *TokenCreateUpsert {
+	u.SetExcluded(tokencreate.FieldPauseIssuerProvidedTimestamp)
+	return u
+}
+
+// AddPauseIssuerProvidedTimestamp adds v to the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsert) AddPauseIssuerProvidedTimestamp(v uint64) *TokenCreateUpsert {
+	u.Add(tokencreate.FieldPauseIssuerProvidedTimestamp, v)
+	return u
+}
+
+// ClearPauseIssuerProvidedTimestamp clears the value of the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsert) ClearPauseIssuerProvidedTimestamp() *TokenCreateUpsert {
+	u.SetNull(tokencreate.FieldPauseIssuerProvidedTimestamp)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -646,6 +842,9 @@ func (u *TokenCreateUpsertOne) UpdateNewValues() *TokenCreateUpsertOne {
 		if _, exists := u.create.mutation.WalletProvidedTimestamp(); exists {
 			s.SetIgnore(tokencreate.FieldWalletProvidedTimestamp)
 		}
+		if _, exists := u.create.mutation.IsTransferRestricted(); exists {
+			s.SetIgnore(tokencreate.FieldIsTransferRestricted)
+		}
 	}))
 	return u
 }
@@ -733,6 +932,69 @@ func (u *TokenCreateUpsertOne) ClearOperatorSpecificIssuerSignature() *TokenCrea
 	})
 }
 
+// SetIsPaused sets the "is_paused" field.
+func (u *TokenCreateUpsertOne) SetIsPaused(v bool) *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.SetIsPaused(v)
+	})
+}
+
+// UpdateIsPaused sets the "is_paused" field to the value that was provided on create.
+func (u *TokenCreateUpsertOne) UpdateIsPaused() *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.UpdateIsPaused()
+	})
+}
+
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (u *TokenCreateUpsertOne) SetPauseIssuerSignature(v []byte) *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.SetPauseIssuerSignature(v)
+	})
+}
+
+// UpdatePauseIssuerSignature sets the "pause_issuer_signature" field to the value that was provided on create.
+func (u *TokenCreateUpsertOne) UpdatePauseIssuerSignature() *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.UpdatePauseIssuerSignature()
+	})
+}
+
+// ClearPauseIssuerSignature clears the value of the "pause_issuer_signature" field.
+func (u *TokenCreateUpsertOne) ClearPauseIssuerSignature() *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.ClearPauseIssuerSignature()
+	})
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsertOne) SetPauseIssuerProvidedTimestamp(v uint64) *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.SetPauseIssuerProvidedTimestamp(v)
+	})
+}
+
+// AddPauseIssuerProvidedTimestamp adds v to the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsertOne) AddPauseIssuerProvidedTimestamp(v uint64) *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.AddPauseIssuerProvidedTimestamp(v)
+	})
+}
+
+// UpdatePauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field to the value that was provided on create.
+func (u *TokenCreateUpsertOne) UpdatePauseIssuerProvidedTimestamp() *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.UpdatePauseIssuerProvidedTimestamp()
+	})
+}
+
+// ClearPauseIssuerProvidedTimestamp clears the value of the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsertOne) ClearPauseIssuerProvidedTimestamp() *TokenCreateUpsertOne {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.ClearPauseIssuerProvidedTimestamp()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenCreateUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -949,6 +1211,9 @@ func (u *TokenCreateUpsertBulk) UpdateNewValues() *TokenCreateUpsertBulk {
 			if _, exists := b.mutation.WalletProvidedTimestamp(); exists {
 				s.SetIgnore(tokencreate.FieldWalletProvidedTimestamp)
 			}
+			if _, exists := b.mutation.IsTransferRestricted(); exists {
+				s.SetIgnore(tokencreate.FieldIsTransferRestricted)
+			}
 		}
 	}))
 	return u
@@ -1037,6 +1302,69 @@ func (u *TokenCreateUpsertBulk) ClearOperatorSpecificIssuerSignature() *TokenCre
 	})
 }
 
+// SetIsPaused sets the "is_paused" field.
+func (u *TokenCreateUpsertBulk) SetIsPaused(v bool) *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.SetIsPaused(v)
+	})
+}
+
+// UpdateIsPaused sets the "is_paused" field to the value that was provided on create.
+func (u *TokenCreateUpsertBulk) UpdateIsPaused() *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.UpdateIsPaused()
+	})
+}
+
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (u *TokenCreateUpsertBulk) SetPauseIssuerSignature(v []byte) *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.SetPauseIssuerSignature(v)
+	})
+}
+
+// UpdatePauseIssuerSignature sets the "pause_issuer_signature" field to the value that was provided on create.
+func (u *TokenCreateUpsertBulk) UpdatePauseIssuerSignature() *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.UpdatePauseIssuerSignature()
+	})
+}
+
+// ClearPauseIssuerSignature clears the value of the "pause_issuer_signature" field.
+func (u *TokenCreateUpsertBulk) ClearPauseIssuerSignature() *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.ClearPauseIssuerSignature()
+	})
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsertBulk) SetPauseIssuerProvidedTimestamp(v uint64) *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.SetPauseIssuerProvidedTimestamp(v)
+	})
+}
+
+// AddPauseIssuerProvidedTimestamp adds v to the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsertBulk) AddPauseIssuerProvidedTimestamp(v uint64) *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.AddPauseIssuerProvidedTimestamp(v)
+	})
+}
+
+// UpdatePauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field to the value that was provided on create.
+func (u *TokenCreateUpsertBulk) UpdatePauseIssuerProvidedTimestamp() *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.UpdatePauseIssuerProvidedTimestamp()
+	})
+}
+
+// ClearPauseIssuerProvidedTimestamp clears the value of the "pause_issuer_provided_timestamp" field.
+func (u *TokenCreateUpsertBulk) ClearPauseIssuerProvidedTimestamp() *TokenCreateUpsertBulk {
+	return u.Update(func(s *TokenCreateUpsert) {
+		s.ClearPauseIssuerProvidedTimestamp()
+	})
+}
+
 // Exec executes the query.
 func (u *TokenCreateUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -15,6 +15,10 @@ func getTokenIdentifierFromTransaction(tokenTransaction *tokenpb.TokenTransactio
 	if len(tokenTransaction.TokenOutputs) > 0 && tokenTransaction.TokenOutputs[0].GetTokenIdentifier() != nil {
 		return tokenTransaction.TokenOutputs[0].GetTokenIdentifier()
 	}
+	// Burns have no outputs and name the token in the burn input
+	if tokenTransaction.GetBurnInput() != nil {
+		return tokenTransaction.GetBurnInput().GetTokenIdentifier()
+	}
 	return nil
 }
 
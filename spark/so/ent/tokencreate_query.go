@@ -16,8 +16,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/so/ent/l1tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
 )
@@ -25,16 +27,18 @@ import (
 // TokenCreateQuery is the builder for querying TokenCreate entities.
 type TokenCreateQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []tokencreate.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.TokenCreate
-	withTokenTransaction *TokenTransactionQuery
-	withL1TokenCreate    *L1TokenCreateQuery
-	withTokenOutput      *TokenOutputQuery
-	withTokenFreeze      *TokenFreezeQuery
-	withFKs              bool
-	modifiers            []func(*sql.Selector)
+	ctx                    *QueryContext
+	order                  []tokencreate.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.TokenCreate
+	withTokenTransaction   *TokenTransactionQuery
+	withL1TokenCreate      *L1TokenCreateQuery
+	withTokenOutput        *TokenOutputQuery
+	withTokenFreeze        *TokenFreezeQuery
+	withIssuerKeyRotations *TokenIssuerKeyRotationQuery
+	withAllowlistEntries   *TokenAllowlistEntryQuery
+	withFKs                bool
+	modifiers              []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -159,6 +163,50 @@ func (tcq *TokenCreateQuery) QueryTokenFreeze() *TokenFreezeQuery {
 	return query
 }
 
+// QueryIssuerKeyRotations chains the current query on the "issuer_key_rotations" edge.
+func (tcq *TokenCreateQuery) QueryIssuerKeyRotations() *TokenIssuerKeyRotationQuery {
+	query := (&TokenIssuerKeyRotationClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokencreate.Table, tokencreate.FieldID, selector),
+			sqlgraph.To(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tokencreate.IssuerKeyRotationsTable, tokencreate.IssuerKeyRotationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAllowlistEntries chains the current query on the "allowlist_entries" edge.
+func (tcq *TokenCreateQuery) QueryAllowlistEntries() *TokenAllowlistEntryQuery {
+	query := (&TokenAllowlistEntryClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokencreate.Table, tokencreate.FieldID, selector),
+			sqlgraph.To(tokenallowlistentry.Table, tokenallowlistentry.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tokencreate.AllowlistEntriesTable, tokencreate.AllowlistEntriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first TokenCreate entity from the query.
 // Returns a *NotFoundError when no TokenCreate was found.
 func (tcq *TokenCreateQuery) First(ctx context.Context) (*TokenCreate, error) {
@@ -346,15 +394,17 @@ func (tcq *TokenCreateQuery) Clone() *TokenCreateQuery {
 		return nil
 	}
 	return &TokenCreateQuery{
-		config:               tcq.config,
-		ctx:                  tcq.ctx.Clone(),
-		order:                append([]tokencreate.OrderOption{}, tcq.order...),
-		inters:               append([]Interceptor{}, tcq.inters...),
-		predicates:           append([]predicate.TokenCreate{}, tcq.predicates...),
-		withTokenTransaction: tcq.withTokenTransaction.Clone(),
-		withL1TokenCreate:    tcq.withL1TokenCreate.Clone(),
-		withTokenOutput:      tcq.withTokenOutput.Clone(),
-		withTokenFreeze:      tcq.withTokenFreeze.Clone(),
+		config:                 tcq.config,
+		ctx:                    tcq.ctx.Clone(),
+		order:                  append([]tokencreate.OrderOption{}, tcq.order...),
+		inters:                 append([]Interceptor{}, tcq.inters...),
+		predicates:             append([]predicate.TokenCreate{}, tcq.predicates...),
+		withTokenTransaction:   tcq.withTokenTransaction.Clone(),
+		withL1TokenCreate:      tcq.withL1TokenCreate.Clone(),
+		withTokenOutput:        tcq.withTokenOutput.Clone(),
+		withTokenFreeze:        tcq.withTokenFreeze.Clone(),
+		withIssuerKeyRotations: tcq.withIssuerKeyRotations.Clone(),
+		withAllowlistEntries:   tcq.withAllowlistEntries.Clone(),
 		// clone intermediate query.
 		sql:  tcq.sql.Clone(),
 		path: tcq.path,
@@ -405,6 +455,28 @@ func (tcq *TokenCreateQuery) WithTokenFreeze(opts ...func(*TokenFreezeQuery)) *T
 	return tcq
 }
 
+// WithIssuerKeyRotations tells the query-builder to eager-load the nodes that are connected to
+// the "issuer_key_rotations" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TokenCreateQuery) WithIssuerKeyRotations(opts ...func(*TokenIssuerKeyRotationQuery)) *TokenCreateQuery {
+	query := (&TokenIssuerKeyRotationClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withIssuerKeyRotations = query
+	return tcq
+}
+
+// WithAllowlistEntries tells the query-builder to eager-load the nodes that are connected to
+// the "allowlist_entries" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TokenCreateQuery) WithAllowlistEntries(opts ...func(*TokenAllowlistEntryQuery)) *TokenCreateQuery {
+	query := (&TokenAllowlistEntryClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withAllowlistEntries = query
+	return tcq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -484,11 +556,13 @@ func (tcq *TokenCreateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes       = []*TokenCreate{}
 		withFKs     = tcq.withFKs
 		_spec       = tcq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			tcq.withTokenTransaction != nil,
 			tcq.withL1TokenCreate != nil,
 			tcq.withTokenOutput != nil,
 			tcq.withTokenFreeze != nil,
+			tcq.withIssuerKeyRotations != nil,
+			tcq.withAllowlistEntries != nil,
 		}
 	)
 	if tcq.withL1TokenCreate != nil {
@@ -547,6 +621,24 @@ func (tcq *TokenCreateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := tcq.withIssuerKeyRotations; query != nil {
+		if err := tcq.loadIssuerKeyRotations(ctx, query, nodes,
+			func(n *TokenCreate) { n.Edges.IssuerKeyRotations = []*TokenIssuerKeyRotation{} },
+			func(n *TokenCreate, e *TokenIssuerKeyRotation) {
+				n.Edges.IssuerKeyRotations = append(n.Edges.IssuerKeyRotations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withAllowlistEntries; query != nil {
+		if err := tcq.loadAllowlistEntries(ctx, query, nodes,
+			func(n *TokenCreate) { n.Edges.AllowlistEntries = []*TokenAllowlistEntry{} },
+			func(n *TokenCreate, e *TokenAllowlistEntry) {
+				n.Edges.AllowlistEntries = append(n.Edges.AllowlistEntries, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -674,6 +766,66 @@ func (tcq *TokenCreateQuery) loadTokenFreeze(ctx context.Context, query *TokenFr
 	}
 	return nil
 }
+func (tcq *TokenCreateQuery) loadIssuerKeyRotations(ctx context.Context, query *TokenIssuerKeyRotationQuery, nodes []*TokenCreate, init func(*TokenCreate), assign func(*TokenCreate, *TokenIssuerKeyRotation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TokenCreate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tokenissuerkeyrotation.FieldTokenCreateID)
+	}
+	query.Where(predicate.TokenIssuerKeyRotation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tokencreate.IssuerKeyRotationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TokenCreateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "token_create_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (tcq *TokenCreateQuery) loadAllowlistEntries(ctx context.Context, query *TokenAllowlistEntryQuery, nodes []*TokenCreate, init func(*TokenCreate), assign func(*TokenCreate, *TokenAllowlistEntry)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*TokenCreate)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tokenallowlistentry.FieldTokenCreateID)
+	}
+	query.Where(predicate.TokenAllowlistEntry(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(tokencreate.AllowlistEntriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.TokenCreateID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "token_create_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (tcq *TokenCreateQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tcq.querySpec()
@@ -14,8 +14,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/so/ent/l1tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
 )
@@ -63,6 +65,59 @@ func (tcu *TokenCreateUpdate) ClearOperatorSpecificIssuerSignature() *TokenCreat
 	return tcu
 }
 
+// SetIsPaused sets the "is_paused" field.
+func (tcu *TokenCreateUpdate) SetIsPaused(b bool) *TokenCreateUpdate {
+	tcu.mutation.SetIsPaused(b)
+	return tcu
+}
+
+// SetNillableIsPaused sets the "is_paused" field if the given value is not nil.
+func (tcu *TokenCreateUpdate) SetNillableIsPaused(b *bool) *TokenCreateUpdate {
+	if b != nil {
+		tcu.SetIsPaused(*b)
+	}
+	return tcu
+}
+
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (tcu *TokenCreateUpdate) SetPauseIssuerSignature(b []byte) *TokenCreateUpdate {
+	tcu.mutation.SetPauseIssuerSignature(b)
+	return tcu
+}
+
+// ClearPauseIssuerSignature clears the value of the "pause_issuer_signature" field.
+func (tcu *TokenCreateUpdate) ClearPauseIssuerSignature() *TokenCreateUpdate {
+	tcu.mutation.ClearPauseIssuerSignature()
+	return tcu
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (tcu *TokenCreateUpdate) SetPauseIssuerProvidedTimestamp(u uint64) *TokenCreateUpdate {
+	tcu.mutation.ResetPauseIssuerProvidedTimestamp()
+	tcu.mutation.SetPauseIssuerProvidedTimestamp(u)
+	return tcu
+}
+
+// SetNillablePauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field if the given value is not nil.
+func (tcu *TokenCreateUpdate) SetNillablePauseIssuerProvidedTimestamp(u *uint64) *TokenCreateUpdate {
+	if u != nil {
+		tcu.SetPauseIssuerProvidedTimestamp(*u)
+	}
+	return tcu
+}
+
+// AddPauseIssuerProvidedTimestamp adds u to the "pause_issuer_provided_timestamp" field.
+func (tcu *TokenCreateUpdate) AddPauseIssuerProvidedTimestamp(u int64) *TokenCreateUpdate {
+	tcu.mutation.AddPauseIssuerProvidedTimestamp(u)
+	return tcu
+}
+
+// ClearPauseIssuerProvidedTimestamp clears the value of the "pause_issuer_provided_timestamp" field.
+func (tcu *TokenCreateUpdate) ClearPauseIssuerProvidedTimestamp() *TokenCreateUpdate {
+	tcu.mutation.ClearPauseIssuerProvidedTimestamp()
+	return tcu
+}
+
 // AddTokenTransactionIDs adds the "token_transaction" edge to the TokenTransaction entity by IDs.
 func (tcu *TokenCreateUpdate) AddTokenTransactionIDs(ids ...uuid.UUID) *TokenCreateUpdate {
 	tcu.mutation.AddTokenTransactionIDs(ids...)
@@ -127,6 +182,36 @@ func (tcu *TokenCreateUpdate) AddTokenFreeze(t ...*TokenFreeze) *TokenCreateUpda
 	return tcu.AddTokenFreezeIDs(ids...)
 }
 
+// AddIssuerKeyRotationIDs adds the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity by IDs.
+func (tcu *TokenCreateUpdate) AddIssuerKeyRotationIDs(ids ...uuid.UUID) *TokenCreateUpdate {
+	tcu.mutation.AddIssuerKeyRotationIDs(ids...)
+	return tcu
+}
+
+// AddIssuerKeyRotations adds the "issuer_key_rotations" edges to the TokenIssuerKeyRotation entity.
+func (tcu *TokenCreateUpdate) AddIssuerKeyRotations(t ...*TokenIssuerKeyRotation) *TokenCreateUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.AddIssuerKeyRotationIDs(ids...)
+}
+
+// AddAllowlistEntryIDs adds the "allowlist_entries" edge to the TokenAllowlistEntry entity by IDs.
+func (tcu *TokenCreateUpdate) AddAllowlistEntryIDs(ids ...uuid.UUID) *TokenCreateUpdate {
+	tcu.mutation.AddAllowlistEntryIDs(ids...)
+	return tcu
+}
+
+// AddAllowlistEntries adds the "allowlist_entries" edges to the TokenAllowlistEntry entity.
+func (tcu *TokenCreateUpdate) AddAllowlistEntries(t ...*TokenAllowlistEntry) *TokenCreateUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.AddAllowlistEntryIDs(ids...)
+}
+
 // Mutation returns the TokenCreateMutation object of the builder.
 func (tcu *TokenCreateUpdate) Mutation() *TokenCreateMutation {
 	return tcu.mutation
@@ -201,6 +286,48 @@ func (tcu *TokenCreateUpdate) RemoveTokenFreeze(t ...*TokenFreeze) *TokenCreateU
 	return tcu.RemoveTokenFreezeIDs(ids...)
 }
 
+// ClearIssuerKeyRotations clears all "issuer_key_rotations" edges to the TokenIssuerKeyRotation entity.
+func (tcu *TokenCreateUpdate) ClearIssuerKeyRotations() *TokenCreateUpdate {
+	tcu.mutation.ClearIssuerKeyRotations()
+	return tcu
+}
+
+// RemoveIssuerKeyRotationIDs removes the "issuer_key_rotations" edge to TokenIssuerKeyRotation entities by IDs.
+func (tcu *TokenCreateUpdate) RemoveIssuerKeyRotationIDs(ids ...uuid.UUID) *TokenCreateUpdate {
+	tcu.mutation.RemoveIssuerKeyRotationIDs(ids...)
+	return tcu
+}
+
+// RemoveIssuerKeyRotations removes "issuer_key_rotations" edges to TokenIssuerKeyRotation entities.
+func (tcu *TokenCreateUpdate) RemoveIssuerKeyRotations(t ...*TokenIssuerKeyRotation) *TokenCreateUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.RemoveIssuerKeyRotationIDs(ids...)
+}
+
+// ClearAllowlistEntries clears all "allowlist_entries" edges to the TokenAllowlistEntry entity.
+func (tcu *TokenCreateUpdate) ClearAllowlistEntries() *TokenCreateUpdate {
+	tcu.mutation.ClearAllowlistEntries()
+	return tcu
+}
+
+// RemoveAllowlistEntryIDs removes the "allowlist_entries" edge to TokenAllowlistEntry entities by IDs.
+func (tcu *TokenCreateUpdate) RemoveAllowlistEntryIDs(ids ...uuid.UUID) *TokenCreateUpdate {
+	tcu.mutation.RemoveAllowlistEntryIDs(ids...)
+	return tcu
+}
+
+// RemoveAllowlistEntries removes "allowlist_entries" edges to TokenAllowlistEntry entities.
+func (tcu *TokenCreateUpdate) RemoveAllowlistEntries(t ...*TokenAllowlistEntry) *TokenCreateUpdate {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcu.RemoveAllowlistEntryIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tcu *TokenCreateUpdate) Save(ctx context.Context) (int, error) {
 	tcu.defaults()
@@ -277,6 +404,24 @@ func (tcu *TokenCreateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tcu.mutation.WalletProvidedTimestampCleared() {
 		_spec.ClearField(tokencreate.FieldWalletProvidedTimestamp, field.TypeUint64)
 	}
+	if value, ok := tcu.mutation.IsPaused(); ok {
+		_spec.SetField(tokencreate.FieldIsPaused, field.TypeBool, value)
+	}
+	if value, ok := tcu.mutation.PauseIssuerSignature(); ok {
+		_spec.SetField(tokencreate.FieldPauseIssuerSignature, field.TypeBytes, value)
+	}
+	if tcu.mutation.PauseIssuerSignatureCleared() {
+		_spec.ClearField(tokencreate.FieldPauseIssuerSignature, field.TypeBytes)
+	}
+	if value, ok := tcu.mutation.PauseIssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if value, ok := tcu.mutation.AddedPauseIssuerProvidedTimestamp(); ok {
+		_spec.AddField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if tcu.mutation.PauseIssuerProvidedTimestampCleared() {
+		_spec.ClearField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64)
+	}
 	if tcu.mutation.TokenTransactionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -441,6 +586,96 @@ func (tcu *TokenCreateUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tcu.mutation.IssuerKeyRotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RemovedIssuerKeyRotationsIDs(); len(nodes) > 0 && !tcu.mutation.IssuerKeyRotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.IssuerKeyRotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.AllowlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RemovedAllowlistEntriesIDs(); len(nodes) > 0 && !tcu.mutation.AllowlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.AllowlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{tokencreate.Label}
@@ -491,6 +726,59 @@ func (tcuo *TokenCreateUpdateOne) ClearOperatorSpecificIssuerSignature() *TokenC
 	return tcuo
 }
 
+// SetIsPaused sets the "is_paused" field.
+func (tcuo *TokenCreateUpdateOne) SetIsPaused(b bool) *TokenCreateUpdateOne {
+	tcuo.mutation.SetIsPaused(b)
+	return tcuo
+}
+
+// SetNillableIsPaused sets the "is_paused" field if the given value is not nil.
+func (tcuo *TokenCreateUpdateOne) SetNillableIsPaused(b *bool) *TokenCreateUpdateOne {
+	if b != nil {
+		tcuo.SetIsPaused(*b)
+	}
+	return tcuo
+}
+
+// SetPauseIssuerSignature sets the "pause_issuer_signature" field.
+func (tcuo *TokenCreateUpdateOne) SetPauseIssuerSignature(b []byte) *TokenCreateUpdateOne {
+	tcuo.mutation.SetPauseIssuerSignature(b)
+	return tcuo
+}
+
+// ClearPauseIssuerSignature clears the value of the "pause_issuer_signature" field.
+func (tcuo *TokenCreateUpdateOne) ClearPauseIssuerSignature() *TokenCreateUpdateOne {
+	tcuo.mutation.ClearPauseIssuerSignature()
+	return tcuo
+}
+
+// SetPauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field.
+func (tcuo *TokenCreateUpdateOne) SetPauseIssuerProvidedTimestamp(u uint64) *TokenCreateUpdateOne {
+	tcuo.mutation.ResetPauseIssuerProvidedTimestamp()
+	tcuo.mutation.SetPauseIssuerProvidedTimestamp(u)
+	return tcuo
+}
+
+// SetNillablePauseIssuerProvidedTimestamp sets the "pause_issuer_provided_timestamp" field if the given value is not nil.
+func (tcuo *TokenCreateUpdateOne) SetNillablePauseIssuerProvidedTimestamp(u *uint64) *TokenCreateUpdateOne {
+	if u != nil {
+		tcuo.SetPauseIssuerProvidedTimestamp(*u)
+	}
+	return tcuo
+}
+
+// AddPauseIssuerProvidedTimestamp adds u to the "pause_issuer_provided_timestamp" field.
+func (tcuo *TokenCreateUpdateOne) AddPauseIssuerProvidedTimestamp(u int64) *TokenCreateUpdateOne {
+	tcuo.mutation.AddPauseIssuerProvidedTimestamp(u)
+	return tcuo
+}
+
+// ClearPauseIssuerProvidedTimestamp clears the value of the "pause_issuer_provided_timestamp" field.
+func (tcuo *TokenCreateUpdateOne) ClearPauseIssuerProvidedTimestamp() *TokenCreateUpdateOne {
+	tcuo.mutation.ClearPauseIssuerProvidedTimestamp()
+	return tcuo
+}
+
 // AddTokenTransactionIDs adds the "token_transaction" edge to the TokenTransaction entity by IDs.
 func (tcuo *TokenCreateUpdateOne) AddTokenTransactionIDs(ids ...uuid.UUID) *TokenCreateUpdateOne {
 	tcuo.mutation.AddTokenTransactionIDs(ids...)
@@ -555,6 +843,36 @@ func (tcuo *TokenCreateUpdateOne) AddTokenFreeze(t ...*TokenFreeze) *TokenCreate
 	return tcuo.AddTokenFreezeIDs(ids...)
 }
 
+// AddIssuerKeyRotationIDs adds the "issuer_key_rotations" edge to the TokenIssuerKeyRotation entity by IDs.
+func (tcuo *TokenCreateUpdateOne) AddIssuerKeyRotationIDs(ids ...uuid.UUID) *TokenCreateUpdateOne {
+	tcuo.mutation.AddIssuerKeyRotationIDs(ids...)
+	return tcuo
+}
+
+// AddIssuerKeyRotations adds the "issuer_key_rotations" edges to the TokenIssuerKeyRotation entity.
+func (tcuo *TokenCreateUpdateOne) AddIssuerKeyRotations(t ...*TokenIssuerKeyRotation) *TokenCreateUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.AddIssuerKeyRotationIDs(ids...)
+}
+
+// AddAllowlistEntryIDs adds the "allowlist_entries" edge to the TokenAllowlistEntry entity by IDs.
+func (tcuo *TokenCreateUpdateOne) AddAllowlistEntryIDs(ids ...uuid.UUID) *TokenCreateUpdateOne {
+	tcuo.mutation.AddAllowlistEntryIDs(ids...)
+	return tcuo
+}
+
+// AddAllowlistEntries adds the "allowlist_entries" edges to the TokenAllowlistEntry entity.
+func (tcuo *TokenCreateUpdateOne) AddAllowlistEntries(t ...*TokenAllowlistEntry) *TokenCreateUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.AddAllowlistEntryIDs(ids...)
+}
+
 // Mutation returns the TokenCreateMutation object of the builder.
 func (tcuo *TokenCreateUpdateOne) Mutation() *TokenCreateMutation {
 	return tcuo.mutation
@@ -629,6 +947,48 @@ func (tcuo *TokenCreateUpdateOne) RemoveTokenFreeze(t ...*TokenFreeze) *TokenCre
 	return tcuo.RemoveTokenFreezeIDs(ids...)
 }
 
+// ClearIssuerKeyRotations clears all "issuer_key_rotations" edges to the TokenIssuerKeyRotation entity.
+func (tcuo *TokenCreateUpdateOne) ClearIssuerKeyRotations() *TokenCreateUpdateOne {
+	tcuo.mutation.ClearIssuerKeyRotations()
+	return tcuo
+}
+
+// RemoveIssuerKeyRotationIDs removes the "issuer_key_rotations" edge to TokenIssuerKeyRotation entities by IDs.
+func (tcuo *TokenCreateUpdateOne) RemoveIssuerKeyRotationIDs(ids ...uuid.UUID) *TokenCreateUpdateOne {
+	tcuo.mutation.RemoveIssuerKeyRotationIDs(ids...)
+	return tcuo
+}
+
+// RemoveIssuerKeyRotations removes "issuer_key_rotations" edges to TokenIssuerKeyRotation entities.
+func (tcuo *TokenCreateUpdateOne) RemoveIssuerKeyRotations(t ...*TokenIssuerKeyRotation) *TokenCreateUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.RemoveIssuerKeyRotationIDs(ids...)
+}
+
+// ClearAllowlistEntries clears all "allowlist_entries" edges to the TokenAllowlistEntry entity.
+func (tcuo *TokenCreateUpdateOne) ClearAllowlistEntries() *TokenCreateUpdateOne {
+	tcuo.mutation.ClearAllowlistEntries()
+	return tcuo
+}
+
+// RemoveAllowlistEntryIDs removes the "allowlist_entries" edge to TokenAllowlistEntry entities by IDs.
+func (tcuo *TokenCreateUpdateOne) RemoveAllowlistEntryIDs(ids ...uuid.UUID) *TokenCreateUpdateOne {
+	tcuo.mutation.RemoveAllowlistEntryIDs(ids...)
+	return tcuo
+}
+
+// RemoveAllowlistEntries removes "allowlist_entries" edges to TokenAllowlistEntry entities.
+func (tcuo *TokenCreateUpdateOne) RemoveAllowlistEntries(t ...*TokenAllowlistEntry) *TokenCreateUpdateOne {
+	ids := make([]uuid.UUID, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tcuo.RemoveAllowlistEntryIDs(ids...)
+}
+
 // Where appends a list predicates to the TokenCreateUpdate builder.
 func (tcuo *TokenCreateUpdateOne) Where(ps ...predicate.TokenCreate) *TokenCreateUpdateOne {
 	tcuo.mutation.Where(ps...)
@@ -735,6 +1095,24 @@ func (tcuo *TokenCreateUpdateOne) sqlSave(ctx context.Context) (_node *TokenCrea
 	if tcuo.mutation.WalletProvidedTimestampCleared() {
 		_spec.ClearField(tokencreate.FieldWalletProvidedTimestamp, field.TypeUint64)
 	}
+	if value, ok := tcuo.mutation.IsPaused(); ok {
+		_spec.SetField(tokencreate.FieldIsPaused, field.TypeBool, value)
+	}
+	if value, ok := tcuo.mutation.PauseIssuerSignature(); ok {
+		_spec.SetField(tokencreate.FieldPauseIssuerSignature, field.TypeBytes, value)
+	}
+	if tcuo.mutation.PauseIssuerSignatureCleared() {
+		_spec.ClearField(tokencreate.FieldPauseIssuerSignature, field.TypeBytes)
+	}
+	if value, ok := tcuo.mutation.PauseIssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if value, ok := tcuo.mutation.AddedPauseIssuerProvidedTimestamp(); ok {
+		_spec.AddField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64, value)
+	}
+	if tcuo.mutation.PauseIssuerProvidedTimestampCleared() {
+		_spec.ClearField(tokencreate.FieldPauseIssuerProvidedTimestamp, field.TypeUint64)
+	}
 	if tcuo.mutation.TokenTransactionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -899,6 +1277,96 @@ func (tcuo *TokenCreateUpdateOne) sqlSave(ctx context.Context) (_node *TokenCrea
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tcuo.mutation.IssuerKeyRotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RemovedIssuerKeyRotationsIDs(); len(nodes) > 0 && !tcuo.mutation.IssuerKeyRotationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.IssuerKeyRotationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.IssuerKeyRotationsTable,
+			Columns: []string{tokencreate.IssuerKeyRotationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.AllowlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RemovedAllowlistEntriesIDs(); len(nodes) > 0 && !tcuo.mutation.AllowlistEntriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.AllowlistEntriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tokencreate.AllowlistEntriesTable,
+			Columns: []string{tokencreate.AllowlistEntriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokenallowlistentry.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &TokenCreate{config: tcuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
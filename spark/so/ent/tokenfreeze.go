@@ -38,6 +38,8 @@ type TokenFreeze struct {
 	WalletProvidedThawTimestamp uint64 `json:"wallet_provided_thaw_timestamp,omitempty"`
 	// TokenCreateID holds the value of the "token_create_id" field.
 	TokenCreateID uuid.UUID `json:"token_create_id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenFreezeQuery when eager-loading is set.
 	Edges        TokenFreezeEdges `json:"edges"`
@@ -75,7 +77,7 @@ func (*TokenFreeze) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case tokenfreeze.FieldStatus:
 			values[i] = new(sql.NullString)
-		case tokenfreeze.FieldCreateTime, tokenfreeze.FieldUpdateTime:
+		case tokenfreeze.FieldCreateTime, tokenfreeze.FieldUpdateTime, tokenfreeze.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
 		case tokenfreeze.FieldID, tokenfreeze.FieldTokenCreateID:
 			values[i] = new(uuid.UUID)
@@ -154,6 +156,13 @@ func (tf *TokenFreeze) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				tf.TokenCreateID = *value
 			}
+		case tokenfreeze.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				tf.ExpiresAt = new(time.Time)
+				*tf.ExpiresAt = value.Time
+			}
 		default:
 			tf.selectValues.Set(columns[i], values[i])
 		}
@@ -221,6 +230,11 @@ func (tf *TokenFreeze) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("token_create_id=")
 	builder.WriteString(fmt.Sprintf("%v", tf.TokenCreateID))
+	builder.WriteString(", ")
+	if v := tf.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,6 +35,8 @@ const (
 	FieldWalletProvidedThawTimestamp = "wallet_provided_thaw_timestamp"
 	// FieldTokenCreateID holds the string denoting the token_create_id field in the database.
 	FieldTokenCreateID = "token_create_id"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
 	// EdgeTokenCreate holds the string denoting the token_create edge name in mutations.
 	EdgeTokenCreate = "token_create"
 	// Table holds the table name of the tokenfreeze in the database.
@@ -60,6 +62,7 @@ var Columns = []string{
 	FieldWalletProvidedFreezeTimestamp,
 	FieldWalletProvidedThawTimestamp,
 	FieldTokenCreateID,
+	FieldExpiresAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -135,6 +138,11 @@ func ByTokenCreateID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTokenCreateID, opts...).ToFunc()
 }
 
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
 // ByTokenCreateField orders the results by token_create field.
 func ByTokenCreateField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -97,6 +97,11 @@ func TokenCreateID(v uuid.UUID) predicate.TokenFreeze {
 	return predicate.TokenFreeze(sql.FieldEQ(FieldTokenCreateID, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldEQ(FieldExpiresAt, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.TokenFreeze {
 	return predicate.TokenFreeze(sql.FieldEQ(FieldCreateTime, v))
@@ -457,6 +462,56 @@ func TokenCreateIDNotNil() predicate.TokenFreeze {
 	return predicate.TokenFreeze(sql.FieldNotNull(FieldTokenCreateID))
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.TokenFreeze {
+	return predicate.TokenFreeze(sql.FieldNotNull(FieldExpiresAt))
+}
+
 // HasTokenCreate applies the HasEdge predicate on the "token_create" edge.
 func HasTokenCreate() predicate.TokenFreeze {
 	return predicate.TokenFreeze(func(s *sql.Selector) {
@@ -112,6 +112,20 @@ func (tfc *TokenFreezeCreate) SetNillableTokenCreateID(u *uuid.UUID) *TokenFreez
 	return tfc
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (tfc *TokenFreezeCreate) SetExpiresAt(t time.Time) *TokenFreezeCreate {
+	tfc.mutation.SetExpiresAt(t)
+	return tfc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (tfc *TokenFreezeCreate) SetNillableExpiresAt(t *time.Time) *TokenFreezeCreate {
+	if t != nil {
+		tfc.SetExpiresAt(*t)
+	}
+	return tfc
+}
+
 // SetID sets the "id" field.
 func (tfc *TokenFreezeCreate) SetID(u uuid.UUID) *TokenFreezeCreate {
 	tfc.mutation.SetID(u)
@@ -283,6 +297,10 @@ func (tfc *TokenFreezeCreate) createSpec() (*TokenFreeze, *sqlgraph.CreateSpec)
 		_spec.SetField(tokenfreeze.FieldWalletProvidedThawTimestamp, field.TypeUint64, value)
 		_node.WalletProvidedThawTimestamp = value
 	}
+	if value, ok := tfc.mutation.ExpiresAt(); ok {
+		_spec.SetField(tokenfreeze.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
 	if nodes := tfc.mutation.TokenCreateIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -450,6 +468,9 @@ func (u *TokenFreezeUpsertOne) UpdateNewValues() *TokenFreezeUpsertOne {
 		if _, exists := u.create.mutation.WalletProvidedFreezeTimestamp(); exists {
 			s.SetIgnore(tokenfreeze.FieldWalletProvidedFreezeTimestamp)
 		}
+		if _, exists := u.create.mutation.ExpiresAt(); exists {
+			s.SetIgnore(tokenfreeze.FieldExpiresAt)
+		}
 	}))
 	return u
 }
@@ -756,6 +777,9 @@ func (u *TokenFreezeUpsertBulk) UpdateNewValues() *TokenFreezeUpsertBulk {
 			if _, exists := b.mutation.WalletProvidedFreezeTimestamp(); exists {
 				s.SetIgnore(tokenfreeze.FieldWalletProvidedFreezeTimestamp)
 			}
+			if _, exists := b.mutation.ExpiresAt(); exists {
+				s.SetIgnore(tokenfreeze.FieldExpiresAt)
+			}
 		}
 	}))
 	return u
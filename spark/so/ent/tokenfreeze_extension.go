@@ -2,6 +2,7 @@ package ent
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common/logging"
@@ -10,7 +11,21 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
 )
 
+// ActiveTokenFreeze matches freezes that are in effect at the given time. A freeze whose expiry has passed is no
+// longer in effect, even before the thaw_expired_token_freezes task has recorded its thaw.
+func ActiveTokenFreeze(now time.Time) predicate.TokenFreeze {
+	return tokenfreeze.And(
+		tokenfreeze.StatusEQ(st.TokenFreezeStatusFrozen),
+		tokenfreeze.Or(tokenfreeze.ExpiresAtIsNil(), tokenfreeze.ExpiresAtGT(now)),
+	)
+}
+
 func GetActiveFreezes(ctx context.Context, ownerPublicKeys [][]byte, tokenCreateId uuid.UUID) ([]*TokenFreeze, error) {
+	return GetActiveFreezesAt(ctx, ownerPublicKeys, tokenCreateId, time.Now())
+}
+
+// GetActiveFreezesAt returns the freezes of the owners' tokens that are in effect at the given time.
+func GetActiveFreezesAt(ctx context.Context, ownerPublicKeys [][]byte, tokenCreateId uuid.UUID, now time.Time) ([]*TokenFreeze, error) {
 	logger := logging.GetLoggerFromContext(ctx)
 
 	db, err := GetDbFromContext(ctx)
@@ -20,7 +35,7 @@ func GetActiveFreezes(ctx context.Context, ownerPublicKeys [][]byte, tokenCreate
 
 	conditions := []predicate.TokenFreeze{
 		tokenfreeze.OwnerPublicKeyIn(ownerPublicKeys...),
-		tokenfreeze.StatusEQ(st.TokenFreezeStatusFrozen),
+		ActiveTokenFreeze(now),
 		tokenfreeze.TokenCreateID(tokenCreateId),
 	}
 
@@ -54,7 +69,8 @@ func ThawActiveFreeze(ctx context.Context, activeFreezeID uuid.UUID, timestamp u
 	return nil
 }
 
-func ActivateFreeze(ctx context.Context, ownerPublicKey []byte, tokenCreateID uuid.UUID, issuerSignature []byte, timestamp uint64) error {
+// ActivateFreeze records a freeze of the owner's tokens. If expiresAt is set the freeze is thawed at that time.
+func ActivateFreeze(ctx context.Context, ownerPublicKey []byte, tokenCreateID uuid.UUID, issuerSignature []byte, timestamp uint64, expiresAt *time.Time) error {
 	logger := logging.GetLoggerFromContext(ctx)
 
 	db, err := GetDbFromContext(ctx)
@@ -68,10 +84,36 @@ func ActivateFreeze(ctx context.Context, ownerPublicKey []byte, tokenCreateID uu
 		SetTokenCreateID(tokenCreateID).
 		SetWalletProvidedFreezeTimestamp(timestamp).
 		SetIssuerSignature(issuerSignature).
+		SetNillableExpiresAt(expiresAt).
 		Save(ctx)
 	if err != nil {
-		logger.Error("Failed to activate freeze", "error", err, "ownerPublicKey", ownerPublicKey, "tokenCreateID", tokenCreateID, "timestamp", timestamp, "issuerSignature", issuerSignature)
+		logger.Error("Failed to activate freeze", "error", err, "ownerPublicKey", ownerPublicKey, "tokenCreateID", tokenCreateID, "timestamp", timestamp, "issuerSignature", issuerSignature, "expiresAt", expiresAt)
 		return err
 	}
 	return nil
 }
+
+// ThawExpiredFreezes thaws freezes whose expiry has passed and returns how many were thawed. The thaw timestamp is
+// set to the expiry so that every operator records the same thaw.
+func ThawExpiredFreezes(ctx context.Context, now time.Time) (int, error) {
+	db, err := GetDbFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	expiredFreezes, err := db.TokenFreeze.Query().
+		Where(
+			tokenfreeze.StatusEQ(st.TokenFreezeStatusFrozen),
+			tokenfreeze.ExpiresAtLTE(now),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, freeze := range expiredFreezes {
+		if err := ThawActiveFreeze(ctx, freeze.ID, uint64(freeze.ExpiresAt.UnixMilli())); err != nil {
+			return 0, err
+		}
+	}
+	return len(expiredFreezes), nil
+}
@@ -190,6 +190,9 @@ func (tfu *TokenFreezeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tfu.mutation.WalletProvidedThawTimestampCleared() {
 		_spec.ClearField(tokenfreeze.FieldWalletProvidedThawTimestamp, field.TypeUint64)
 	}
+	if tfu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(tokenfreeze.FieldExpiresAt, field.TypeTime)
+	}
 	if tfu.mutation.TokenCreateCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -428,6 +431,9 @@ func (tfuo *TokenFreezeUpdateOne) sqlSave(ctx context.Context) (_node *TokenFree
 	if tfuo.mutation.WalletProvidedThawTimestampCleared() {
 		_spec.ClearField(tokenfreeze.FieldWalletProvidedThawTimestamp, field.TypeUint64)
 	}
+	if tfuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(tokenfreeze.FieldExpiresAt, field.TypeTime)
+	}
 	if tfuo.mutation.TokenCreateCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
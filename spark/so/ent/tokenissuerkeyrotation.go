@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
+)
+
+// TokenIssuerKeyRotation is the model entity for the TokenIssuerKeyRotation schema.
+type TokenIssuerKeyRotation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// PreviousIssuerPublicKey holds the value of the "previous_issuer_public_key" field.
+	PreviousIssuerPublicKey []byte `json:"previous_issuer_public_key,omitempty"`
+	// NewIssuerPublicKey holds the value of the "new_issuer_public_key" field.
+	NewIssuerPublicKey []byte `json:"new_issuer_public_key,omitempty"`
+	// IssuerSignature holds the value of the "issuer_signature" field.
+	IssuerSignature []byte `json:"issuer_signature,omitempty"`
+	// IssuerProvidedTimestamp holds the value of the "issuer_provided_timestamp" field.
+	IssuerProvidedTimestamp uint64 `json:"issuer_provided_timestamp,omitempty"`
+	// TokenCreateID holds the value of the "token_create_id" field.
+	TokenCreateID uuid.UUID `json:"token_create_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TokenIssuerKeyRotationQuery when eager-loading is set.
+	Edges        TokenIssuerKeyRotationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TokenIssuerKeyRotationEdges holds the relations/edges for other nodes in the graph.
+type TokenIssuerKeyRotationEdges struct {
+	// TokenCreate holds the value of the token_create edge.
+	TokenCreate *TokenCreate `json:"token_create,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TokenCreateOrErr returns the TokenCreate value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TokenIssuerKeyRotationEdges) TokenCreateOrErr() (*TokenCreate, error) {
+	if e.TokenCreate != nil {
+		return e.TokenCreate, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tokencreate.Label}
+	}
+	return nil, &NotLoadedError{edge: "token_create"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenIssuerKeyRotation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenissuerkeyrotation.FieldPreviousIssuerPublicKey, tokenissuerkeyrotation.FieldNewIssuerPublicKey, tokenissuerkeyrotation.FieldIssuerSignature:
+			values[i] = new([]byte)
+		case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+			values[i] = new(sql.NullInt64)
+		case tokenissuerkeyrotation.FieldCreateTime, tokenissuerkeyrotation.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case tokenissuerkeyrotation.FieldID, tokenissuerkeyrotation.FieldTokenCreateID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenIssuerKeyRotation fields.
+func (tikr *TokenIssuerKeyRotation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenissuerkeyrotation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tikr.ID = *value
+			}
+		case tokenissuerkeyrotation.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				tikr.CreateTime = value.Time
+			}
+		case tokenissuerkeyrotation.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				tikr.UpdateTime = value.Time
+			}
+		case tokenissuerkeyrotation.FieldPreviousIssuerPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_issuer_public_key", values[i])
+			} else if value != nil {
+				tikr.PreviousIssuerPublicKey = *value
+			}
+		case tokenissuerkeyrotation.FieldNewIssuerPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field new_issuer_public_key", values[i])
+			} else if value != nil {
+				tikr.NewIssuerPublicKey = *value
+			}
+		case tokenissuerkeyrotation.FieldIssuerSignature:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_signature", values[i])
+			} else if value != nil {
+				tikr.IssuerSignature = *value
+			}
+		case tokenissuerkeyrotation.FieldIssuerProvidedTimestamp:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field issuer_provided_timestamp", values[i])
+			} else if value.Valid {
+				tikr.IssuerProvidedTimestamp = uint64(value.Int64)
+			}
+		case tokenissuerkeyrotation.FieldTokenCreateID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field token_create_id", values[i])
+			} else if value != nil {
+				tikr.TokenCreateID = *value
+			}
+		default:
+			tikr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenIssuerKeyRotation.
+// This includes values selected through modifiers, order, etc.
+func (tikr *TokenIssuerKeyRotation) Value(name string) (ent.Value, error) {
+	return tikr.selectValues.Get(name)
+}
+
+// QueryTokenCreate queries the "token_create" edge of the TokenIssuerKeyRotation entity.
+func (tikr *TokenIssuerKeyRotation) QueryTokenCreate() *TokenCreateQuery {
+	return NewTokenIssuerKeyRotationClient(tikr.config).QueryTokenCreate(tikr)
+}
+
+// Update returns a builder for updating this TokenIssuerKeyRotation.
+// Note that you need to call TokenIssuerKeyRotation.Unwrap() before calling this method if this TokenIssuerKeyRotation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tikr *TokenIssuerKeyRotation) Update() *TokenIssuerKeyRotationUpdateOne {
+	return NewTokenIssuerKeyRotationClient(tikr.config).UpdateOne(tikr)
+}
+
+// Unwrap unwraps the TokenIssuerKeyRotation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tikr *TokenIssuerKeyRotation) Unwrap() *TokenIssuerKeyRotation {
+	_tx, ok := tikr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenIssuerKeyRotation is not a transactional entity")
+	}
+	tikr.config.driver = _tx.drv
+	return tikr
+}
+
+// String implements the fmt.Stringer.
+func (tikr *TokenIssuerKeyRotation) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenIssuerKeyRotation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tikr.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(tikr.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(tikr.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("previous_issuer_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", tikr.PreviousIssuerPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("new_issuer_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", tikr.NewIssuerPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("issuer_signature=")
+	builder.WriteString(fmt.Sprintf("%v", tikr.IssuerSignature))
+	builder.WriteString(", ")
+	builder.WriteString("issuer_provided_timestamp=")
+	builder.WriteString(fmt.Sprintf("%v", tikr.IssuerProvidedTimestamp))
+	builder.WriteString(", ")
+	builder.WriteString("token_create_id=")
+	builder.WriteString(fmt.Sprintf("%v", tikr.TokenCreateID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenIssuerKeyRotations is a parsable slice of TokenIssuerKeyRotation.
+type TokenIssuerKeyRotations []*TokenIssuerKeyRotation
@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenissuerkeyrotation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the tokenissuerkeyrotation type in the database.
+	Label = "token_issuer_key_rotation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldPreviousIssuerPublicKey holds the string denoting the previous_issuer_public_key field in the database.
+	FieldPreviousIssuerPublicKey = "previous_issuer_public_key"
+	// FieldNewIssuerPublicKey holds the string denoting the new_issuer_public_key field in the database.
+	FieldNewIssuerPublicKey = "new_issuer_public_key"
+	// FieldIssuerSignature holds the string denoting the issuer_signature field in the database.
+	FieldIssuerSignature = "issuer_signature"
+	// FieldIssuerProvidedTimestamp holds the string denoting the issuer_provided_timestamp field in the database.
+	FieldIssuerProvidedTimestamp = "issuer_provided_timestamp"
+	// FieldTokenCreateID holds the string denoting the token_create_id field in the database.
+	FieldTokenCreateID = "token_create_id"
+	// EdgeTokenCreate holds the string denoting the token_create edge name in mutations.
+	EdgeTokenCreate = "token_create"
+	// Table holds the table name of the tokenissuerkeyrotation in the database.
+	Table = "token_issuer_key_rotations"
+	// TokenCreateTable is the table that holds the token_create relation/edge.
+	TokenCreateTable = "token_issuer_key_rotations"
+	// TokenCreateInverseTable is the table name for the TokenCreate entity.
+	// It exists in this package in order to avoid circular dependency with the "tokencreate" package.
+	TokenCreateInverseTable = "token_creates"
+	// TokenCreateColumn is the table column denoting the token_create relation/edge.
+	TokenCreateColumn = "token_create_id"
+)
+
+// Columns holds all SQL columns for tokenissuerkeyrotation fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldPreviousIssuerPublicKey,
+	FieldNewIssuerPublicKey,
+	FieldIssuerSignature,
+	FieldIssuerProvidedTimestamp,
+	FieldTokenCreateID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// PreviousIssuerPublicKeyValidator is a validator for the "previous_issuer_public_key" field. It is called by the builders before save.
+	PreviousIssuerPublicKeyValidator func([]byte) error
+	// NewIssuerPublicKeyValidator is a validator for the "new_issuer_public_key" field. It is called by the builders before save.
+	NewIssuerPublicKeyValidator func([]byte) error
+	// IssuerSignatureValidator is a validator for the "issuer_signature" field. It is called by the builders before save.
+	IssuerSignatureValidator func([]byte) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TokenIssuerKeyRotation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByIssuerProvidedTimestamp orders the results by the issuer_provided_timestamp field.
+func ByIssuerProvidedTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIssuerProvidedTimestamp, opts...).ToFunc()
+}
+
+// ByTokenCreateID orders the results by the token_create_id field.
+func ByTokenCreateID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenCreateID, opts...).ToFunc()
+}
+
+// ByTokenCreateField orders the results by token_create field.
+func ByTokenCreateField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTokenCreateStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTokenCreateStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TokenCreateInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TokenCreateTable, TokenCreateColumn),
+	)
+}
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenissuerkeyrotation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// PreviousIssuerPublicKey applies equality check predicate on the "previous_issuer_public_key" field. It's identical to PreviousIssuerPublicKeyEQ.
+func PreviousIssuerPublicKey(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldPreviousIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKey applies equality check predicate on the "new_issuer_public_key" field. It's identical to NewIssuerPublicKeyEQ.
+func NewIssuerPublicKey(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldNewIssuerPublicKey, v))
+}
+
+// IssuerSignature applies equality check predicate on the "issuer_signature" field. It's identical to IssuerSignatureEQ.
+func IssuerSignature(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldIssuerSignature, v))
+}
+
+// IssuerProvidedTimestamp applies equality check predicate on the "issuer_provided_timestamp" field. It's identical to IssuerProvidedTimestampEQ.
+func IssuerProvidedTimestamp(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldIssuerProvidedTimestamp, v))
+}
+
+// TokenCreateID applies equality check predicate on the "token_create_id" field. It's identical to TokenCreateIDEQ.
+func TokenCreateID(v uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldTokenCreateID, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// PreviousIssuerPublicKeyEQ applies the EQ predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyEQ(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldPreviousIssuerPublicKey, v))
+}
+
+// PreviousIssuerPublicKeyNEQ applies the NEQ predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyNEQ(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldPreviousIssuerPublicKey, v))
+}
+
+// PreviousIssuerPublicKeyIn applies the In predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyIn(vs ...[]byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldPreviousIssuerPublicKey, vs...))
+}
+
+// PreviousIssuerPublicKeyNotIn applies the NotIn predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyNotIn(vs ...[]byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldPreviousIssuerPublicKey, vs...))
+}
+
+// PreviousIssuerPublicKeyGT applies the GT predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyGT(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldPreviousIssuerPublicKey, v))
+}
+
+// PreviousIssuerPublicKeyGTE applies the GTE predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyGTE(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldPreviousIssuerPublicKey, v))
+}
+
+// PreviousIssuerPublicKeyLT applies the LT predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyLT(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldPreviousIssuerPublicKey, v))
+}
+
+// PreviousIssuerPublicKeyLTE applies the LTE predicate on the "previous_issuer_public_key" field.
+func PreviousIssuerPublicKeyLTE(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldPreviousIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKeyEQ applies the EQ predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyEQ(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldNewIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKeyNEQ applies the NEQ predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyNEQ(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldNewIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKeyIn applies the In predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyIn(vs ...[]byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldNewIssuerPublicKey, vs...))
+}
+
+// NewIssuerPublicKeyNotIn applies the NotIn predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyNotIn(vs ...[]byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldNewIssuerPublicKey, vs...))
+}
+
+// NewIssuerPublicKeyGT applies the GT predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyGT(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldNewIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKeyGTE applies the GTE predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyGTE(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldNewIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKeyLT applies the LT predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyLT(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldNewIssuerPublicKey, v))
+}
+
+// NewIssuerPublicKeyLTE applies the LTE predicate on the "new_issuer_public_key" field.
+func NewIssuerPublicKeyLTE(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldNewIssuerPublicKey, v))
+}
+
+// IssuerSignatureEQ applies the EQ predicate on the "issuer_signature" field.
+func IssuerSignatureEQ(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureNEQ applies the NEQ predicate on the "issuer_signature" field.
+func IssuerSignatureNEQ(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureIn applies the In predicate on the "issuer_signature" field.
+func IssuerSignatureIn(vs ...[]byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldIssuerSignature, vs...))
+}
+
+// IssuerSignatureNotIn applies the NotIn predicate on the "issuer_signature" field.
+func IssuerSignatureNotIn(vs ...[]byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldIssuerSignature, vs...))
+}
+
+// IssuerSignatureGT applies the GT predicate on the "issuer_signature" field.
+func IssuerSignatureGT(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureGTE applies the GTE predicate on the "issuer_signature" field.
+func IssuerSignatureGTE(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureLT applies the LT predicate on the "issuer_signature" field.
+func IssuerSignatureLT(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldIssuerSignature, v))
+}
+
+// IssuerSignatureLTE applies the LTE predicate on the "issuer_signature" field.
+func IssuerSignatureLTE(v []byte) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldIssuerSignature, v))
+}
+
+// IssuerProvidedTimestampEQ applies the EQ predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampEQ(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampNEQ applies the NEQ predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampNEQ(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampIn applies the In predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampIn(vs ...uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldIssuerProvidedTimestamp, vs...))
+}
+
+// IssuerProvidedTimestampNotIn applies the NotIn predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampNotIn(vs ...uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldIssuerProvidedTimestamp, vs...))
+}
+
+// IssuerProvidedTimestampGT applies the GT predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampGT(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGT(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampGTE applies the GTE predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampGTE(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldGTE(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampLT applies the LT predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampLT(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLT(FieldIssuerProvidedTimestamp, v))
+}
+
+// IssuerProvidedTimestampLTE applies the LTE predicate on the "issuer_provided_timestamp" field.
+func IssuerProvidedTimestampLTE(v uint64) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldLTE(FieldIssuerProvidedTimestamp, v))
+}
+
+// TokenCreateIDEQ applies the EQ predicate on the "token_create_id" field.
+func TokenCreateIDEQ(v uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldEQ(FieldTokenCreateID, v))
+}
+
+// TokenCreateIDNEQ applies the NEQ predicate on the "token_create_id" field.
+func TokenCreateIDNEQ(v uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNEQ(FieldTokenCreateID, v))
+}
+
+// TokenCreateIDIn applies the In predicate on the "token_create_id" field.
+func TokenCreateIDIn(vs ...uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldIn(FieldTokenCreateID, vs...))
+}
+
+// TokenCreateIDNotIn applies the NotIn predicate on the "token_create_id" field.
+func TokenCreateIDNotIn(vs ...uuid.UUID) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.FieldNotIn(FieldTokenCreateID, vs...))
+}
+
+// HasTokenCreate applies the HasEdge predicate on the "token_create" edge.
+func HasTokenCreate() predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TokenCreateTable, TokenCreateColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTokenCreateWith applies the HasEdge predicate on the "token_create" edge with a given conditions (other predicates).
+func HasTokenCreateWith(preds ...predicate.TokenCreate) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(func(s *sql.Selector) {
+		step := newTokenCreateStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenIssuerKeyRotation) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenIssuerKeyRotation) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenIssuerKeyRotation) predicate.TokenIssuerKeyRotation {
+	return predicate.TokenIssuerKeyRotation(sql.NotPredicates(p))
+}
@@ -0,0 +1,684 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
+)
+
+// TokenIssuerKeyRotationCreate is the builder for creating a TokenIssuerKeyRotation entity.
+type TokenIssuerKeyRotationCreate struct {
+	config
+	mutation *TokenIssuerKeyRotationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetCreateTime(t time.Time) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetCreateTime(t)
+	return tikrc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (tikrc *TokenIssuerKeyRotationCreate) SetNillableCreateTime(t *time.Time) *TokenIssuerKeyRotationCreate {
+	if t != nil {
+		tikrc.SetCreateTime(*t)
+	}
+	return tikrc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetUpdateTime(t time.Time) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetUpdateTime(t)
+	return tikrc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (tikrc *TokenIssuerKeyRotationCreate) SetNillableUpdateTime(t *time.Time) *TokenIssuerKeyRotationCreate {
+	if t != nil {
+		tikrc.SetUpdateTime(*t)
+	}
+	return tikrc
+}
+
+// SetPreviousIssuerPublicKey sets the "previous_issuer_public_key" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetPreviousIssuerPublicKey(b []byte) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetPreviousIssuerPublicKey(b)
+	return tikrc
+}
+
+// SetNewIssuerPublicKey sets the "new_issuer_public_key" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetNewIssuerPublicKey(b []byte) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetNewIssuerPublicKey(b)
+	return tikrc
+}
+
+// SetIssuerSignature sets the "issuer_signature" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetIssuerSignature(b []byte) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetIssuerSignature(b)
+	return tikrc
+}
+
+// SetIssuerProvidedTimestamp sets the "issuer_provided_timestamp" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetIssuerProvidedTimestamp(u uint64) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetIssuerProvidedTimestamp(u)
+	return tikrc
+}
+
+// SetTokenCreateID sets the "token_create_id" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetTokenCreateID(u uuid.UUID) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetTokenCreateID(u)
+	return tikrc
+}
+
+// SetID sets the "id" field.
+func (tikrc *TokenIssuerKeyRotationCreate) SetID(u uuid.UUID) *TokenIssuerKeyRotationCreate {
+	tikrc.mutation.SetID(u)
+	return tikrc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tikrc *TokenIssuerKeyRotationCreate) SetNillableID(u *uuid.UUID) *TokenIssuerKeyRotationCreate {
+	if u != nil {
+		tikrc.SetID(*u)
+	}
+	return tikrc
+}
+
+// SetTokenCreate sets the "token_create" edge to the TokenCreate entity.
+func (tikrc *TokenIssuerKeyRotationCreate) SetTokenCreate(t *TokenCreate) *TokenIssuerKeyRotationCreate {
+	return tikrc.SetTokenCreateID(t.ID)
+}
+
+// Mutation returns the TokenIssuerKeyRotationMutation object of the builder.
+func (tikrc *TokenIssuerKeyRotationCreate) Mutation() *TokenIssuerKeyRotationMutation {
+	return tikrc.mutation
+}
+
+// Save creates the TokenIssuerKeyRotation in the database.
+func (tikrc *TokenIssuerKeyRotationCreate) Save(ctx context.Context) (*TokenIssuerKeyRotation, error) {
+	tikrc.defaults()
+	return withHooks(ctx, tikrc.sqlSave, tikrc.mutation, tikrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tikrc *TokenIssuerKeyRotationCreate) SaveX(ctx context.Context) *TokenIssuerKeyRotation {
+	v, err := tikrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tikrc *TokenIssuerKeyRotationCreate) Exec(ctx context.Context) error {
+	_, err := tikrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tikrc *TokenIssuerKeyRotationCreate) ExecX(ctx context.Context) {
+	if err := tikrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tikrc *TokenIssuerKeyRotationCreate) defaults() {
+	if _, ok := tikrc.mutation.CreateTime(); !ok {
+		v := tokenissuerkeyrotation.DefaultCreateTime()
+		tikrc.mutation.SetCreateTime(v)
+	}
+	if _, ok := tikrc.mutation.UpdateTime(); !ok {
+		v := tokenissuerkeyrotation.DefaultUpdateTime()
+		tikrc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := tikrc.mutation.ID(); !ok {
+		v := tokenissuerkeyrotation.DefaultID()
+		tikrc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tikrc *TokenIssuerKeyRotationCreate) check() error {
+	if _, ok := tikrc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.create_time"`)}
+	}
+	if _, ok := tikrc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.update_time"`)}
+	}
+	if _, ok := tikrc.mutation.PreviousIssuerPublicKey(); !ok {
+		return &ValidationError{Name: "previous_issuer_public_key", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.previous_issuer_public_key"`)}
+	}
+	if v, ok := tikrc.mutation.PreviousIssuerPublicKey(); ok {
+		if err := tokenissuerkeyrotation.PreviousIssuerPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "previous_issuer_public_key", err: fmt.Errorf(`ent: validator failed for field "TokenIssuerKeyRotation.previous_issuer_public_key": %w`, err)}
+		}
+	}
+	if _, ok := tikrc.mutation.NewIssuerPublicKey(); !ok {
+		return &ValidationError{Name: "new_issuer_public_key", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.new_issuer_public_key"`)}
+	}
+	if v, ok := tikrc.mutation.NewIssuerPublicKey(); ok {
+		if err := tokenissuerkeyrotation.NewIssuerPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "new_issuer_public_key", err: fmt.Errorf(`ent: validator failed for field "TokenIssuerKeyRotation.new_issuer_public_key": %w`, err)}
+		}
+	}
+	if _, ok := tikrc.mutation.IssuerSignature(); !ok {
+		return &ValidationError{Name: "issuer_signature", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.issuer_signature"`)}
+	}
+	if v, ok := tikrc.mutation.IssuerSignature(); ok {
+		if err := tokenissuerkeyrotation.IssuerSignatureValidator(v); err != nil {
+			return &ValidationError{Name: "issuer_signature", err: fmt.Errorf(`ent: validator failed for field "TokenIssuerKeyRotation.issuer_signature": %w`, err)}
+		}
+	}
+	if _, ok := tikrc.mutation.IssuerProvidedTimestamp(); !ok {
+		return &ValidationError{Name: "issuer_provided_timestamp", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.issuer_provided_timestamp"`)}
+	}
+	if _, ok := tikrc.mutation.TokenCreateID(); !ok {
+		return &ValidationError{Name: "token_create_id", err: errors.New(`ent: missing required field "TokenIssuerKeyRotation.token_create_id"`)}
+	}
+	if len(tikrc.mutation.TokenCreateIDs()) == 0 {
+		return &ValidationError{Name: "token_create", err: errors.New(`ent: missing required edge "TokenIssuerKeyRotation.token_create"`)}
+	}
+	return nil
+}
+
+func (tikrc *TokenIssuerKeyRotationCreate) sqlSave(ctx context.Context) (*TokenIssuerKeyRotation, error) {
+	if err := tikrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tikrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tikrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tikrc.mutation.id = &_node.ID
+	tikrc.mutation.done = true
+	return _node, nil
+}
+
+func (tikrc *TokenIssuerKeyRotationCreate) createSpec() (*TokenIssuerKeyRotation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenIssuerKeyRotation{config: tikrc.config}
+		_spec = sqlgraph.NewCreateSpec(tokenissuerkeyrotation.Table, sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tikrc.conflict
+	if id, ok := tikrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tikrc.mutation.CreateTime(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := tikrc.mutation.UpdateTime(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := tikrc.mutation.PreviousIssuerPublicKey(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldPreviousIssuerPublicKey, field.TypeBytes, value)
+		_node.PreviousIssuerPublicKey = value
+	}
+	if value, ok := tikrc.mutation.NewIssuerPublicKey(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldNewIssuerPublicKey, field.TypeBytes, value)
+		_node.NewIssuerPublicKey = value
+	}
+	if value, ok := tikrc.mutation.IssuerSignature(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldIssuerSignature, field.TypeBytes, value)
+		_node.IssuerSignature = value
+	}
+	if value, ok := tikrc.mutation.IssuerProvidedTimestamp(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldIssuerProvidedTimestamp, field.TypeUint64, value)
+		_node.IssuerProvidedTimestamp = value
+	}
+	if nodes := tikrc.mutation.TokenCreateIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   tokenissuerkeyrotation.TokenCreateTable,
+			Columns: []string{tokenissuerkeyrotation.TokenCreateColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tokencreate.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TokenCreateID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenIssuerKeyRotationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (tikrc *TokenIssuerKeyRotationCreate) OnConflict(opts ...sql.ConflictOption) *TokenIssuerKeyRotationUpsertOne {
+	tikrc.conflict = opts
+	return &TokenIssuerKeyRotationUpsertOne{
+		create: tikrc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tikrc *TokenIssuerKeyRotationCreate) OnConflictColumns(columns ...string) *TokenIssuerKeyRotationUpsertOne {
+	tikrc.conflict = append(tikrc.conflict, sql.ConflictColumns(columns...))
+	return &TokenIssuerKeyRotationUpsertOne{
+		create: tikrc,
+	}
+}
+
+type (
+	// TokenIssuerKeyRotationUpsertOne is the builder for "upsert"-ing
+	//  one TokenIssuerKeyRotation node.
+	TokenIssuerKeyRotationUpsertOne struct {
+		create *TokenIssuerKeyRotationCreate
+	}
+
+	// TokenIssuerKeyRotationUpsert is the "OnConflict" setter.
+	TokenIssuerKeyRotationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TokenIssuerKeyRotationUpsert) SetUpdateTime(v time.Time) *TokenIssuerKeyRotationUpsert {
+	u.Set(tokenissuerkeyrotation.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TokenIssuerKeyRotationUpsert) UpdateUpdateTime() *TokenIssuerKeyRotationUpsert {
+	u.SetExcluded(tokenissuerkeyrotation.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenissuerkeyrotation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenIssuerKeyRotationUpsertOne) UpdateNewValues() *TokenIssuerKeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.PreviousIssuerPublicKey(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldPreviousIssuerPublicKey)
+		}
+		if _, exists := u.create.mutation.NewIssuerPublicKey(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldNewIssuerPublicKey)
+		}
+		if _, exists := u.create.mutation.IssuerSignature(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldIssuerSignature)
+		}
+		if _, exists := u.create.mutation.IssuerProvidedTimestamp(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldIssuerProvidedTimestamp)
+		}
+		if _, exists := u.create.mutation.TokenCreateID(); exists {
+			s.SetIgnore(tokenissuerkeyrotation.FieldTokenCreateID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenIssuerKeyRotationUpsertOne) Ignore() *TokenIssuerKeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenIssuerKeyRotationUpsertOne) DoNothing() *TokenIssuerKeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenIssuerKeyRotationCreate.OnConflict
+// documentation for more info.
+func (u *TokenIssuerKeyRotationUpsertOne) Update(set func(*TokenIssuerKeyRotationUpsert)) *TokenIssuerKeyRotationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenIssuerKeyRotationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TokenIssuerKeyRotationUpsertOne) SetUpdateTime(v time.Time) *TokenIssuerKeyRotationUpsertOne {
+	return u.Update(func(s *TokenIssuerKeyRotationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TokenIssuerKeyRotationUpsertOne) UpdateUpdateTime() *TokenIssuerKeyRotationUpsertOne {
+	return u.Update(func(s *TokenIssuerKeyRotationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenIssuerKeyRotationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenIssuerKeyRotationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenIssuerKeyRotationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenIssuerKeyRotationUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TokenIssuerKeyRotationUpsertOne.ID is not supported by MySQL driver. Use TokenIssuerKeyRotationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenIssuerKeyRotationUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenIssuerKeyRotationCreateBulk is the builder for creating many TokenIssuerKeyRotation entities in bulk.
+type TokenIssuerKeyRotationCreateBulk struct {
+	config
+	err      error
+	builders []*TokenIssuerKeyRotationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenIssuerKeyRotation entities in the database.
+func (tikrcb *TokenIssuerKeyRotationCreateBulk) Save(ctx context.Context) ([]*TokenIssuerKeyRotation, error) {
+	if tikrcb.err != nil {
+		return nil, tikrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tikrcb.builders))
+	nodes := make([]*TokenIssuerKeyRotation, len(tikrcb.builders))
+	mutators := make([]Mutator, len(tikrcb.builders))
+	for i := range tikrcb.builders {
+		func(i int, root context.Context) {
+			builder := tikrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenIssuerKeyRotationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tikrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tikrcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tikrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tikrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tikrcb *TokenIssuerKeyRotationCreateBulk) SaveX(ctx context.Context) []*TokenIssuerKeyRotation {
+	v, err := tikrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tikrcb *TokenIssuerKeyRotationCreateBulk) Exec(ctx context.Context) error {
+	_, err := tikrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tikrcb *TokenIssuerKeyRotationCreateBulk) ExecX(ctx context.Context) {
+	if err := tikrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenIssuerKeyRotation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenIssuerKeyRotationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (tikrcb *TokenIssuerKeyRotationCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenIssuerKeyRotationUpsertBulk {
+	tikrcb.conflict = opts
+	return &TokenIssuerKeyRotationUpsertBulk{
+		create: tikrcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tikrcb *TokenIssuerKeyRotationCreateBulk) OnConflictColumns(columns ...string) *TokenIssuerKeyRotationUpsertBulk {
+	tikrcb.conflict = append(tikrcb.conflict, sql.ConflictColumns(columns...))
+	return &TokenIssuerKeyRotationUpsertBulk{
+		create: tikrcb,
+	}
+}
+
+// TokenIssuerKeyRotationUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenIssuerKeyRotation nodes.
+type TokenIssuerKeyRotationUpsertBulk struct {
+	create *TokenIssuerKeyRotationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenissuerkeyrotation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenIssuerKeyRotationUpsertBulk) UpdateNewValues() *TokenIssuerKeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldCreateTime)
+			}
+			if _, exists := b.mutation.PreviousIssuerPublicKey(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldPreviousIssuerPublicKey)
+			}
+			if _, exists := b.mutation.NewIssuerPublicKey(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldNewIssuerPublicKey)
+			}
+			if _, exists := b.mutation.IssuerSignature(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldIssuerSignature)
+			}
+			if _, exists := b.mutation.IssuerProvidedTimestamp(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldIssuerProvidedTimestamp)
+			}
+			if _, exists := b.mutation.TokenCreateID(); exists {
+				s.SetIgnore(tokenissuerkeyrotation.FieldTokenCreateID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenIssuerKeyRotation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenIssuerKeyRotationUpsertBulk) Ignore() *TokenIssuerKeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenIssuerKeyRotationUpsertBulk) DoNothing() *TokenIssuerKeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenIssuerKeyRotationCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenIssuerKeyRotationUpsertBulk) Update(set func(*TokenIssuerKeyRotationUpsert)) *TokenIssuerKeyRotationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenIssuerKeyRotationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *TokenIssuerKeyRotationUpsertBulk) SetUpdateTime(v time.Time) *TokenIssuerKeyRotationUpsertBulk {
+	return u.Update(func(s *TokenIssuerKeyRotationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *TokenIssuerKeyRotationUpsertBulk) UpdateUpdateTime() *TokenIssuerKeyRotationUpsertBulk {
+	return u.Update(func(s *TokenIssuerKeyRotationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenIssuerKeyRotationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenIssuerKeyRotationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenIssuerKeyRotationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenIssuerKeyRotationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
+)
+
+// TokenIssuerKeyRotationDelete is the builder for deleting a TokenIssuerKeyRotation entity.
+type TokenIssuerKeyRotationDelete struct {
+	config
+	hooks    []Hook
+	mutation *TokenIssuerKeyRotationMutation
+}
+
+// Where appends a list predicates to the TokenIssuerKeyRotationDelete builder.
+func (tikrd *TokenIssuerKeyRotationDelete) Where(ps ...predicate.TokenIssuerKeyRotation) *TokenIssuerKeyRotationDelete {
+	tikrd.mutation.Where(ps...)
+	return tikrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tikrd *TokenIssuerKeyRotationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tikrd.sqlExec, tikrd.mutation, tikrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tikrd *TokenIssuerKeyRotationDelete) ExecX(ctx context.Context) int {
+	n, err := tikrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tikrd *TokenIssuerKeyRotationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tokenissuerkeyrotation.Table, sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID))
+	if ps := tikrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tikrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tikrd.mutation.done = true
+	return affected, err
+}
+
+// TokenIssuerKeyRotationDeleteOne is the builder for deleting a single TokenIssuerKeyRotation entity.
+type TokenIssuerKeyRotationDeleteOne struct {
+	tikrd *TokenIssuerKeyRotationDelete
+}
+
+// Where appends a list predicates to the TokenIssuerKeyRotationDelete builder.
+func (tikrdo *TokenIssuerKeyRotationDeleteOne) Where(ps ...predicate.TokenIssuerKeyRotation) *TokenIssuerKeyRotationDeleteOne {
+	tikrdo.tikrd.mutation.Where(ps...)
+	return tikrdo
+}
+
+// Exec executes the deletion query.
+func (tikrdo *TokenIssuerKeyRotationDeleteOne) Exec(ctx context.Context) error {
+	n, err := tikrdo.tikrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tokenissuerkeyrotation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tikrdo *TokenIssuerKeyRotationDeleteOne) ExecX(ctx context.Context) {
+	if err := tikrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
+)
+
+// TokenIssuerKeyRotationQuery is the builder for querying TokenIssuerKeyRotation entities.
+type TokenIssuerKeyRotationQuery struct {
+	config
+	ctx             *QueryContext
+	order           []tokenissuerkeyrotation.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.TokenIssuerKeyRotation
+	withTokenCreate *TokenCreateQuery
+	modifiers       []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TokenIssuerKeyRotationQuery builder.
+func (tikrq *TokenIssuerKeyRotationQuery) Where(ps ...predicate.TokenIssuerKeyRotation) *TokenIssuerKeyRotationQuery {
+	tikrq.predicates = append(tikrq.predicates, ps...)
+	return tikrq
+}
+
+// Limit the number of records to be returned by this query.
+func (tikrq *TokenIssuerKeyRotationQuery) Limit(limit int) *TokenIssuerKeyRotationQuery {
+	tikrq.ctx.Limit = &limit
+	return tikrq
+}
+
+// Offset to start from.
+func (tikrq *TokenIssuerKeyRotationQuery) Offset(offset int) *TokenIssuerKeyRotationQuery {
+	tikrq.ctx.Offset = &offset
+	return tikrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tikrq *TokenIssuerKeyRotationQuery) Unique(unique bool) *TokenIssuerKeyRotationQuery {
+	tikrq.ctx.Unique = &unique
+	return tikrq
+}
+
+// Order specifies how the records should be ordered.
+func (tikrq *TokenIssuerKeyRotationQuery) Order(o ...tokenissuerkeyrotation.OrderOption) *TokenIssuerKeyRotationQuery {
+	tikrq.order = append(tikrq.order, o...)
+	return tikrq
+}
+
+// QueryTokenCreate chains the current query on the "token_create" edge.
+func (tikrq *TokenIssuerKeyRotationQuery) QueryTokenCreate() *TokenCreateQuery {
+	query := (&TokenCreateClient{config: tikrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tikrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tikrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.FieldID, selector),
+			sqlgraph.To(tokencreate.Table, tokencreate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tokenissuerkeyrotation.TokenCreateTable, tokenissuerkeyrotation.TokenCreateColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tikrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TokenIssuerKeyRotation entity from the query.
+// Returns a *NotFoundError when no TokenIssuerKeyRotation was found.
+func (tikrq *TokenIssuerKeyRotationQuery) First(ctx context.Context) (*TokenIssuerKeyRotation, error) {
+	nodes, err := tikrq.Limit(1).All(setContextOp(ctx, tikrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tokenissuerkeyrotation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) FirstX(ctx context.Context) *TokenIssuerKeyRotation {
+	node, err := tikrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TokenIssuerKeyRotation ID from the query.
+// Returns a *NotFoundError when no TokenIssuerKeyRotation ID was found.
+func (tikrq *TokenIssuerKeyRotationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tikrq.Limit(1).IDs(setContextOp(ctx, tikrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tokenissuerkeyrotation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tikrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TokenIssuerKeyRotation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TokenIssuerKeyRotation entity is found.
+// Returns a *NotFoundError when no TokenIssuerKeyRotation entities are found.
+func (tikrq *TokenIssuerKeyRotationQuery) Only(ctx context.Context) (*TokenIssuerKeyRotation, error) {
+	nodes, err := tikrq.Limit(2).All(setContextOp(ctx, tikrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tokenissuerkeyrotation.Label}
+	default:
+		return nil, &NotSingularError{tokenissuerkeyrotation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) OnlyX(ctx context.Context) *TokenIssuerKeyRotation {
+	node, err := tikrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TokenIssuerKeyRotation ID in the query.
+// Returns a *NotSingularError when more than one TokenIssuerKeyRotation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tikrq *TokenIssuerKeyRotationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tikrq.Limit(2).IDs(setContextOp(ctx, tikrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tokenissuerkeyrotation.Label}
+	default:
+		err = &NotSingularError{tokenissuerkeyrotation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tikrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TokenIssuerKeyRotations.
+func (tikrq *TokenIssuerKeyRotationQuery) All(ctx context.Context) ([]*TokenIssuerKeyRotation, error) {
+	ctx = setContextOp(ctx, tikrq.ctx, ent.OpQueryAll)
+	if err := tikrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TokenIssuerKeyRotation, *TokenIssuerKeyRotationQuery]()
+	return withInterceptors[[]*TokenIssuerKeyRotation](ctx, tikrq, qr, tikrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) AllX(ctx context.Context) []*TokenIssuerKeyRotation {
+	nodes, err := tikrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TokenIssuerKeyRotation IDs.
+func (tikrq *TokenIssuerKeyRotationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tikrq.ctx.Unique == nil && tikrq.path != nil {
+		tikrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tikrq.ctx, ent.OpQueryIDs)
+	if err = tikrq.Select(tokenissuerkeyrotation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tikrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tikrq *TokenIssuerKeyRotationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tikrq.ctx, ent.OpQueryCount)
+	if err := tikrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tikrq, querierCount[*TokenIssuerKeyRotationQuery](), tikrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) CountX(ctx context.Context) int {
+	count, err := tikrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tikrq *TokenIssuerKeyRotationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tikrq.ctx, ent.OpQueryExist)
+	switch _, err := tikrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tikrq *TokenIssuerKeyRotationQuery) ExistX(ctx context.Context) bool {
+	exist, err := tikrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TokenIssuerKeyRotationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tikrq *TokenIssuerKeyRotationQuery) Clone() *TokenIssuerKeyRotationQuery {
+	if tikrq == nil {
+		return nil
+	}
+	return &TokenIssuerKeyRotationQuery{
+		config:          tikrq.config,
+		ctx:             tikrq.ctx.Clone(),
+		order:           append([]tokenissuerkeyrotation.OrderOption{}, tikrq.order...),
+		inters:          append([]Interceptor{}, tikrq.inters...),
+		predicates:      append([]predicate.TokenIssuerKeyRotation{}, tikrq.predicates...),
+		withTokenCreate: tikrq.withTokenCreate.Clone(),
+		// clone intermediate query.
+		sql:  tikrq.sql.Clone(),
+		path: tikrq.path,
+	}
+}
+
+// WithTokenCreate tells the query-builder to eager-load the nodes that are connected to
+// the "token_create" edge. The optional arguments are used to configure the query builder of the edge.
+func (tikrq *TokenIssuerKeyRotationQuery) WithTokenCreate(opts ...func(*TokenCreateQuery)) *TokenIssuerKeyRotationQuery {
+	query := (&TokenCreateClient{config: tikrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tikrq.withTokenCreate = query
+	return tikrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TokenIssuerKeyRotation.Query().
+//		GroupBy(tokenissuerkeyrotation.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tikrq *TokenIssuerKeyRotationQuery) GroupBy(field string, fields ...string) *TokenIssuerKeyRotationGroupBy {
+	tikrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TokenIssuerKeyRotationGroupBy{build: tikrq}
+	grbuild.flds = &tikrq.ctx.Fields
+	grbuild.label = tokenissuerkeyrotation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.TokenIssuerKeyRotation.Query().
+//		Select(tokenissuerkeyrotation.FieldCreateTime).
+//		Scan(ctx, &v)
+func (tikrq *TokenIssuerKeyRotationQuery) Select(fields ...string) *TokenIssuerKeyRotationSelect {
+	tikrq.ctx.Fields = append(tikrq.ctx.Fields, fields...)
+	sbuild := &TokenIssuerKeyRotationSelect{TokenIssuerKeyRotationQuery: tikrq}
+	sbuild.label = tokenissuerkeyrotation.Label
+	sbuild.flds, sbuild.scan = &tikrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TokenIssuerKeyRotationSelect configured with the given aggregations.
+func (tikrq *TokenIssuerKeyRotationQuery) Aggregate(fns ...AggregateFunc) *TokenIssuerKeyRotationSelect {
+	return tikrq.Select().Aggregate(fns...)
+}
+
+func (tikrq *TokenIssuerKeyRotationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tikrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tikrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tikrq.ctx.Fields {
+		if !tokenissuerkeyrotation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tikrq.path != nil {
+		prev, err := tikrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tikrq.sql = prev
+	}
+	return nil
+}
+
+func (tikrq *TokenIssuerKeyRotationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TokenIssuerKeyRotation, error) {
+	var (
+		nodes       = []*TokenIssuerKeyRotation{}
+		_spec       = tikrq.querySpec()
+		loadedTypes = [1]bool{
+			tikrq.withTokenCreate != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TokenIssuerKeyRotation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TokenIssuerKeyRotation{config: tikrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(tikrq.modifiers) > 0 {
+		_spec.Modifiers = tikrq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tikrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tikrq.withTokenCreate; query != nil {
+		if err := tikrq.loadTokenCreate(ctx, query, nodes, nil,
+			func(n *TokenIssuerKeyRotation, e *TokenCreate) { n.Edges.TokenCreate = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tikrq *TokenIssuerKeyRotationQuery) loadTokenCreate(ctx context.Context, query *TokenCreateQuery, nodes []*TokenIssuerKeyRotation, init func(*TokenIssuerKeyRotation), assign func(*TokenIssuerKeyRotation, *TokenCreate)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*TokenIssuerKeyRotation)
+	for i := range nodes {
+		fk := nodes[i].TokenCreateID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tokencreate.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "token_create_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tikrq *TokenIssuerKeyRotationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tikrq.querySpec()
+	if len(tikrq.modifiers) > 0 {
+		_spec.Modifiers = tikrq.modifiers
+	}
+	_spec.Node.Columns = tikrq.ctx.Fields
+	if len(tikrq.ctx.Fields) > 0 {
+		_spec.Unique = tikrq.ctx.Unique != nil && *tikrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tikrq.driver, _spec)
+}
+
+func (tikrq *TokenIssuerKeyRotationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.Columns, sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID))
+	_spec.From = tikrq.sql
+	if unique := tikrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tikrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tikrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenissuerkeyrotation.FieldID)
+		for i := range fields {
+			if fields[i] != tokenissuerkeyrotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tikrq.withTokenCreate != nil {
+			_spec.Node.AddColumnOnce(tokenissuerkeyrotation.FieldTokenCreateID)
+		}
+	}
+	if ps := tikrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tikrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tikrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tikrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tikrq *TokenIssuerKeyRotationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tikrq.driver.Dialect())
+	t1 := builder.Table(tokenissuerkeyrotation.Table)
+	columns := tikrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tokenissuerkeyrotation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tikrq.sql != nil {
+		selector = tikrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tikrq.ctx.Unique != nil && *tikrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tikrq.modifiers {
+		m(selector)
+	}
+	for _, p := range tikrq.predicates {
+		p(selector)
+	}
+	for _, p := range tikrq.order {
+		p(selector)
+	}
+	if offset := tikrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tikrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tikrq *TokenIssuerKeyRotationQuery) ForUpdate(opts ...sql.LockOption) *TokenIssuerKeyRotationQuery {
+	if tikrq.driver.Dialect() == dialect.Postgres {
+		tikrq.Unique(false)
+	}
+	tikrq.modifiers = append(tikrq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tikrq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tikrq *TokenIssuerKeyRotationQuery) ForShare(opts ...sql.LockOption) *TokenIssuerKeyRotationQuery {
+	if tikrq.driver.Dialect() == dialect.Postgres {
+		tikrq.Unique(false)
+	}
+	tikrq.modifiers = append(tikrq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tikrq
+}
+
+// TokenIssuerKeyRotationGroupBy is the group-by builder for TokenIssuerKeyRotation entities.
+type TokenIssuerKeyRotationGroupBy struct {
+	selector
+	build *TokenIssuerKeyRotationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tikrgb *TokenIssuerKeyRotationGroupBy) Aggregate(fns ...AggregateFunc) *TokenIssuerKeyRotationGroupBy {
+	tikrgb.fns = append(tikrgb.fns, fns...)
+	return tikrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tikrgb *TokenIssuerKeyRotationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tikrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tikrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenIssuerKeyRotationQuery, *TokenIssuerKeyRotationGroupBy](ctx, tikrgb.build, tikrgb, tikrgb.build.inters, v)
+}
+
+func (tikrgb *TokenIssuerKeyRotationGroupBy) sqlScan(ctx context.Context, root *TokenIssuerKeyRotationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tikrgb.fns))
+	for _, fn := range tikrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tikrgb.flds)+len(tikrgb.fns))
+		for _, f := range *tikrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tikrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tikrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TokenIssuerKeyRotationSelect is the builder for selecting fields of TokenIssuerKeyRotation entities.
+type TokenIssuerKeyRotationSelect struct {
+	*TokenIssuerKeyRotationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tikrs *TokenIssuerKeyRotationSelect) Aggregate(fns ...AggregateFunc) *TokenIssuerKeyRotationSelect {
+	tikrs.fns = append(tikrs.fns, fns...)
+	return tikrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tikrs *TokenIssuerKeyRotationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tikrs.ctx, ent.OpQuerySelect)
+	if err := tikrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TokenIssuerKeyRotationQuery, *TokenIssuerKeyRotationSelect](ctx, tikrs.TokenIssuerKeyRotationQuery, tikrs, tikrs.inters, v)
+}
+
+func (tikrs *TokenIssuerKeyRotationSelect) sqlScan(ctx context.Context, root *TokenIssuerKeyRotationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tikrs.fns))
+	for _, fn := range tikrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tikrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tikrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
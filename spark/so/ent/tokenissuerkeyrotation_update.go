@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
+)
+
+// TokenIssuerKeyRotationUpdate is the builder for updating TokenIssuerKeyRotation entities.
+type TokenIssuerKeyRotationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenIssuerKeyRotationMutation
+}
+
+// Where appends a list predicates to the TokenIssuerKeyRotationUpdate builder.
+func (tikru *TokenIssuerKeyRotationUpdate) Where(ps ...predicate.TokenIssuerKeyRotation) *TokenIssuerKeyRotationUpdate {
+	tikru.mutation.Where(ps...)
+	return tikru
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tikru *TokenIssuerKeyRotationUpdate) SetUpdateTime(t time.Time) *TokenIssuerKeyRotationUpdate {
+	tikru.mutation.SetUpdateTime(t)
+	return tikru
+}
+
+// Mutation returns the TokenIssuerKeyRotationMutation object of the builder.
+func (tikru *TokenIssuerKeyRotationUpdate) Mutation() *TokenIssuerKeyRotationMutation {
+	return tikru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tikru *TokenIssuerKeyRotationUpdate) Save(ctx context.Context) (int, error) {
+	tikru.defaults()
+	return withHooks(ctx, tikru.sqlSave, tikru.mutation, tikru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tikru *TokenIssuerKeyRotationUpdate) SaveX(ctx context.Context) int {
+	affected, err := tikru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tikru *TokenIssuerKeyRotationUpdate) Exec(ctx context.Context) error {
+	_, err := tikru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tikru *TokenIssuerKeyRotationUpdate) ExecX(ctx context.Context) {
+	if err := tikru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tikru *TokenIssuerKeyRotationUpdate) defaults() {
+	if _, ok := tikru.mutation.UpdateTime(); !ok {
+		v := tokenissuerkeyrotation.UpdateDefaultUpdateTime()
+		tikru.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tikru *TokenIssuerKeyRotationUpdate) check() error {
+	if tikru.mutation.TokenCreateCleared() && len(tikru.mutation.TokenCreateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TokenIssuerKeyRotation.token_create"`)
+	}
+	return nil
+}
+
+func (tikru *TokenIssuerKeyRotationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tikru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.Columns, sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID))
+	if ps := tikru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tikru.mutation.UpdateTime(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tikru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenissuerkeyrotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tikru.mutation.done = true
+	return n, nil
+}
+
+// TokenIssuerKeyRotationUpdateOne is the builder for updating a single TokenIssuerKeyRotation entity.
+type TokenIssuerKeyRotationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenIssuerKeyRotationMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) SetUpdateTime(t time.Time) *TokenIssuerKeyRotationUpdateOne {
+	tikruo.mutation.SetUpdateTime(t)
+	return tikruo
+}
+
+// Mutation returns the TokenIssuerKeyRotationMutation object of the builder.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) Mutation() *TokenIssuerKeyRotationMutation {
+	return tikruo.mutation
+}
+
+// Where appends a list predicates to the TokenIssuerKeyRotationUpdate builder.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) Where(ps ...predicate.TokenIssuerKeyRotation) *TokenIssuerKeyRotationUpdateOne {
+	tikruo.mutation.Where(ps...)
+	return tikruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) Select(field string, fields ...string) *TokenIssuerKeyRotationUpdateOne {
+	tikruo.fields = append([]string{field}, fields...)
+	return tikruo
+}
+
+// Save executes the query and returns the updated TokenIssuerKeyRotation entity.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) Save(ctx context.Context) (*TokenIssuerKeyRotation, error) {
+	tikruo.defaults()
+	return withHooks(ctx, tikruo.sqlSave, tikruo.mutation, tikruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) SaveX(ctx context.Context) *TokenIssuerKeyRotation {
+	node, err := tikruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) Exec(ctx context.Context) error {
+	_, err := tikruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) ExecX(ctx context.Context) {
+	if err := tikruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) defaults() {
+	if _, ok := tikruo.mutation.UpdateTime(); !ok {
+		v := tokenissuerkeyrotation.UpdateDefaultUpdateTime()
+		tikruo.mutation.SetUpdateTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tikruo *TokenIssuerKeyRotationUpdateOne) check() error {
+	if tikruo.mutation.TokenCreateCleared() && len(tikruo.mutation.TokenCreateIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TokenIssuerKeyRotation.token_create"`)
+	}
+	return nil
+}
+
+func (tikruo *TokenIssuerKeyRotationUpdateOne) sqlSave(ctx context.Context) (_node *TokenIssuerKeyRotation, err error) {
+	if err := tikruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenissuerkeyrotation.Table, tokenissuerkeyrotation.Columns, sqlgraph.NewFieldSpec(tokenissuerkeyrotation.FieldID, field.TypeUUID))
+	id, ok := tikruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenIssuerKeyRotation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tikruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenissuerkeyrotation.FieldID)
+		for _, f := range fields {
+			if !tokenissuerkeyrotation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenissuerkeyrotation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tikruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tikruo.mutation.UpdateTime(); ok {
+		_spec.SetField(tokenissuerkeyrotation.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &TokenIssuerKeyRotation{config: tikruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tikruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenissuerkeyrotation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tikruo.mutation.done = true
+	return _node, nil
+}
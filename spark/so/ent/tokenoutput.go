@@ -58,6 +58,8 @@ type TokenOutput struct {
 	TokenIdentifier []byte `json:"token_identifier,omitempty"`
 	// TokenCreateID holds the value of the "token_create_id" field.
 	TokenCreateID uuid.UUID `json:"token_create_id,omitempty"`
+	// SpendableAfter holds the value of the "spendable_after" field.
+	SpendableAfter *time.Time `json:"spendable_after,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TokenOutputQuery when eager-loading is set.
 	Edges                                         TokenOutputEdges `json:"edges"`
@@ -159,7 +161,7 @@ func (*TokenOutput) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case tokenoutput.FieldStatus, tokenoutput.FieldNetwork:
 			values[i] = new(sql.NullString)
-		case tokenoutput.FieldCreateTime, tokenoutput.FieldUpdateTime:
+		case tokenoutput.FieldCreateTime, tokenoutput.FieldUpdateTime, tokenoutput.FieldSpendableAfter:
 			values[i] = new(sql.NullTime)
 		case tokenoutput.FieldID, tokenoutput.FieldTokenCreateID:
 			values[i] = new(uuid.UUID)
@@ -298,6 +300,13 @@ func (to *TokenOutput) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				to.TokenCreateID = *value
 			}
+		case tokenoutput.FieldSpendableAfter:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field spendable_after", values[i])
+			} else if value.Valid {
+				to.SpendableAfter = new(time.Time)
+				*to.SpendableAfter = value.Time
+			}
 		case tokenoutput.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field token_output_revocation_keyshare", values[i])
@@ -438,6 +447,11 @@ func (to *TokenOutput) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("token_create_id=")
 	builder.WriteString(fmt.Sprintf("%v", to.TokenCreateID))
+	builder.WriteString(", ")
+	if v := to.SpendableAfter; v != nil {
+		builder.WriteString("spendable_after=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -53,6 +53,8 @@ const (
 	FieldTokenIdentifier = "token_identifier"
 	// FieldTokenCreateID holds the string denoting the token_create_id field in the database.
 	FieldTokenCreateID = "token_create_id"
+	// FieldSpendableAfter holds the string denoting the spendable_after field in the database.
+	FieldSpendableAfter = "spendable_after"
 	// EdgeRevocationKeyshare holds the string denoting the revocation_keyshare edge name in mutations.
 	EdgeRevocationKeyshare = "revocation_keyshare"
 	// EdgeOutputCreatedTokenTransaction holds the string denoting the output_created_token_transaction edge name in mutations.
@@ -130,6 +132,7 @@ var Columns = []string{
 	FieldNetwork,
 	FieldTokenIdentifier,
 	FieldTokenCreateID,
+	FieldSpendableAfter,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "token_outputs"
@@ -249,6 +252,11 @@ func ByTokenCreateID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTokenCreateID, opts...).ToFunc()
 }
 
+// BySpendableAfter orders the results by the spendable_after field.
+func BySpendableAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSpendableAfter, opts...).ToFunc()
+}
+
 // ByRevocationKeyshareField orders the results by revocation_keyshare field.
 func ByRevocationKeyshareField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -137,6 +137,11 @@ func TokenCreateID(v uuid.UUID) predicate.TokenOutput {
 	return predicate.TokenOutput(sql.FieldEQ(FieldTokenCreateID, v))
 }
 
+// SpendableAfter applies equality check predicate on the "spendable_after" field. It's identical to SpendableAfterEQ.
+func SpendableAfter(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldEQ(FieldSpendableAfter, v))
+}
+
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.TokenOutput {
 	return predicate.TokenOutput(sql.FieldEQ(FieldCreateTime, v))
@@ -887,6 +892,56 @@ func TokenCreateIDNotIn(vs ...uuid.UUID) predicate.TokenOutput {
 	return predicate.TokenOutput(sql.FieldNotIn(FieldTokenCreateID, vs...))
 }
 
+// SpendableAfterEQ applies the EQ predicate on the "spendable_after" field.
+func SpendableAfterEQ(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldEQ(FieldSpendableAfter, v))
+}
+
+// SpendableAfterNEQ applies the NEQ predicate on the "spendable_after" field.
+func SpendableAfterNEQ(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldNEQ(FieldSpendableAfter, v))
+}
+
+// SpendableAfterIn applies the In predicate on the "spendable_after" field.
+func SpendableAfterIn(vs ...time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldIn(FieldSpendableAfter, vs...))
+}
+
+// SpendableAfterNotIn applies the NotIn predicate on the "spendable_after" field.
+func SpendableAfterNotIn(vs ...time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldNotIn(FieldSpendableAfter, vs...))
+}
+
+// SpendableAfterGT applies the GT predicate on the "spendable_after" field.
+func SpendableAfterGT(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldGT(FieldSpendableAfter, v))
+}
+
+// SpendableAfterGTE applies the GTE predicate on the "spendable_after" field.
+func SpendableAfterGTE(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldGTE(FieldSpendableAfter, v))
+}
+
+// SpendableAfterLT applies the LT predicate on the "spendable_after" field.
+func SpendableAfterLT(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldLT(FieldSpendableAfter, v))
+}
+
+// SpendableAfterLTE applies the LTE predicate on the "spendable_after" field.
+func SpendableAfterLTE(v time.Time) predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldLTE(FieldSpendableAfter, v))
+}
+
+// SpendableAfterIsNil applies the IsNil predicate on the "spendable_after" field.
+func SpendableAfterIsNil() predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldIsNull(FieldSpendableAfter))
+}
+
+// SpendableAfterNotNil applies the NotNil predicate on the "spendable_after" field.
+func SpendableAfterNotNil() predicate.TokenOutput {
+	return predicate.TokenOutput(sql.FieldNotNull(FieldSpendableAfter))
+}
+
 // HasRevocationKeyshare applies the HasEdge predicate on the "revocation_keyshare" edge.
 func HasRevocationKeyshare() predicate.TokenOutput {
 	return predicate.TokenOutput(func(s *sql.Selector) {
@@ -169,6 +169,20 @@ func (toc *TokenOutputCreate) SetTokenCreateID(u uuid.UUID) *TokenOutputCreate {
 	return toc
 }
 
+// SetSpendableAfter sets the "spendable_after" field.
+func (toc *TokenOutputCreate) SetSpendableAfter(t time.Time) *TokenOutputCreate {
+	toc.mutation.SetSpendableAfter(t)
+	return toc
+}
+
+// SetNillableSpendableAfter sets the "spendable_after" field if the given value is not nil.
+func (toc *TokenOutputCreate) SetNillableSpendableAfter(t *time.Time) *TokenOutputCreate {
+	if t != nil {
+		toc.SetSpendableAfter(*t)
+	}
+	return toc
+}
+
 // SetID sets the "id" field.
 func (toc *TokenOutputCreate) SetID(u uuid.UUID) *TokenOutputCreate {
 	toc.mutation.SetID(u)
@@ -481,6 +495,10 @@ func (toc *TokenOutputCreate) createSpec() (*TokenOutput, *sqlgraph.CreateSpec)
 		_spec.SetField(tokenoutput.FieldTokenIdentifier, field.TypeBytes, value)
 		_node.TokenIdentifier = value
 	}
+	if value, ok := toc.mutation.SpendableAfter(); ok {
+		_spec.SetField(tokenoutput.FieldSpendableAfter, field.TypeTime, value)
+		_node.SpendableAfter = &value
+	}
 	if nodes := toc.mutation.RevocationKeyshareIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -818,6 +836,9 @@ func (u *TokenOutputUpsertOne) UpdateNewValues() *TokenOutputUpsertOne {
 		if _, exists := u.create.mutation.TokenCreateID(); exists {
 			s.SetIgnore(tokenoutput.FieldTokenCreateID)
 		}
+		if _, exists := u.create.mutation.SpendableAfter(); exists {
+			s.SetIgnore(tokenoutput.FieldSpendableAfter)
+		}
 	}))
 	return u
 }
@@ -1223,6 +1244,9 @@ func (u *TokenOutputUpsertBulk) UpdateNewValues() *TokenOutputUpsertBulk {
 			if _, exists := b.mutation.TokenCreateID(); exists {
 				s.SetIgnore(tokenoutput.FieldTokenCreateID)
 			}
+			if _, exists := b.mutation.SpendableAfter(); exists {
+				s.SetIgnore(tokenoutput.FieldSpendableAfter)
+			}
 		}
 	}))
 	return u
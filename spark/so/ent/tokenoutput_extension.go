@@ -16,8 +16,22 @@ import (
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// SpendableAfterProto returns the time before which the output cannot be spent, or nil if it has no time lock.
+func (o *TokenOutput) SpendableAfterProto() *timestamppb.Timestamp {
+	if o.SpendableAfter == nil {
+		return nil
+	}
+	return timestamppb.New(*o.SpendableAfter)
+}
+
+// IsSpendableAt returns whether the output's time lock, if any, has passed at the given time.
+func (o *TokenOutput) IsSpendableAt(now time.Time) bool {
+	return o.SpendableAfter == nil || !now.Before(*o.SpendableAfter)
+}
+
 // FetchAndLockTokenInputs fetches the transaction whose token transaction hashes
 // match the PrevTokenTransactionHash of each output, then loads the created outputs for those transactions,
 // and finally maps each input to the created output in the DB.
@@ -216,3 +230,81 @@ func GetOwnedTokenOutputStats(ctx context.Context, ownerPublicKeys [][]byte, tok
 
 	return outputIDs, totalAmount, nil
 }
+
+// TokenHolderBalance is the balance held by one owner of a token.
+type TokenHolderBalance struct {
+	OwnerPublicKey []byte
+	Balance        *big.Int
+	OutputCount    int
+}
+
+// GetTokenHolderBalancesParams holds the parameters for GetTokenHolderBalances
+type GetTokenHolderBalancesParams struct {
+	TokenIdentifier []byte
+	// Balances are returned as of this time.
+	AsOfTime time.Time
+	Limit    int
+	Offset   int
+}
+
+// GetTokenHolderBalances returns the balance of each holder of a token, ordered by owner public key and paged by
+// owner. An output counts towards its owner's balance if the transaction creating it had finalized by the requested
+// time and no transaction spending it had. Withdrawn outputs are left out regardless of the requested time.
+func GetTokenHolderBalances(ctx context.Context, params GetTokenHolderBalancesParams) ([]*TokenHolderBalance, error) {
+	db, err := GetDbFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	finalizedBy := tokentransaction.FinalizedAtLTE(params.AsOfTime)
+	heldOutputs := []predicate.TokenOutput{
+		tokenoutput.TokenIdentifierEQ(params.TokenIdentifier),
+		tokenoutput.HasOutputCreatedTokenTransactionWith(finalizedBy),
+		tokenoutput.Not(tokenoutput.HasOutputSpentTokenTransactionWith(finalizedBy)),
+		tokenoutput.ConfirmedWithdrawBlockHashIsNil(),
+	}
+
+	var owners []struct {
+		OwnerPublicKey []byte `json:"owner_public_key"`
+	}
+	err = db.TokenOutput.Query().
+		Where(heldOutputs...).
+		Order(Asc(tokenoutput.FieldOwnerPublicKey)).
+		Limit(params.Limit).
+		Offset(params.Offset).
+		Unique(true).
+		Select(tokenoutput.FieldOwnerPublicKey).
+		Scan(ctx, &owners)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token holders: %w", err)
+	}
+	if len(owners) == 0 {
+		return nil, nil
+	}
+
+	ownerPublicKeys := make([][]byte, len(owners))
+	for i, owner := range owners {
+		ownerPublicKeys[i] = owner.OwnerPublicKey
+	}
+	outputs, err := db.TokenOutput.Query().
+		Where(heldOutputs...).
+		Where(tokenoutput.OwnerPublicKeyIn(ownerPublicKeys...)).
+		Select(tokenoutput.FieldOwnerPublicKey, tokenoutput.FieldTokenAmount).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token holder outputs: %w", err)
+	}
+
+	balances := make(map[string]*TokenHolderBalance, len(owners))
+	holders := make([]*TokenHolderBalance, len(owners))
+	for i, ownerPublicKey := range ownerPublicKeys {
+		holders[i] = &TokenHolderBalance{OwnerPublicKey: ownerPublicKey, Balance: new(big.Int)}
+		balances[string(ownerPublicKey)] = holders[i]
+	}
+	for _, output := range outputs {
+		holder := balances[string(output.OwnerPublicKey)]
+		holder.Balance.Add(holder.Balance, new(big.Int).SetBytes(output.TokenAmount))
+		holder.OutputCount++
+	}
+	return holders, nil
+}
@@ -391,6 +391,9 @@ func (tou *TokenOutputUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tou.mutation.NetworkCleared() {
 		_spec.ClearField(tokenoutput.FieldNetwork, field.TypeEnum)
 	}
+	if tou.mutation.SpendableAfterCleared() {
+		_spec.ClearField(tokenoutput.FieldSpendableAfter, field.TypeTime)
+	}
 	if tou.mutation.OutputCreatedTokenTransactionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -948,6 +951,9 @@ func (touo *TokenOutputUpdateOne) sqlSave(ctx context.Context) (_node *TokenOutp
 	if touo.mutation.NetworkCleared() {
 		_spec.ClearField(tokenoutput.FieldNetwork, field.TypeEnum)
 	}
+	if touo.mutation.SpendableAfterCleared() {
+		_spec.ClearField(tokenoutput.FieldSpendableAfter, field.TypeTime)
+	}
 	if touo.mutation.OutputCreatedTokenTransactionCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -40,6 +40,8 @@ type TokenTransaction struct {
 	CoordinatorPublicKey []byte `json:"coordinator_public_key,omitempty"`
 	// ClientCreatedTimestamp holds the value of the "client_created_timestamp" field.
 	ClientCreatedTimestamp time.Time `json:"client_created_timestamp,omitempty"`
+	// FinalizedAt holds the value of the "finalized_at" field.
+	FinalizedAt *time.Time `json:"finalized_at,omitempty"`
 	// Version holds the value of the "version" field.
 	Version schematype.TokenTransactionVersion `json:"version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -163,7 +165,7 @@ func (*TokenTransaction) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case tokentransaction.FieldStatus:
 			values[i] = new(sql.NullString)
-		case tokentransaction.FieldCreateTime, tokentransaction.FieldUpdateTime, tokentransaction.FieldExpiryTime, tokentransaction.FieldClientCreatedTimestamp:
+		case tokentransaction.FieldCreateTime, tokentransaction.FieldUpdateTime, tokentransaction.FieldExpiryTime, tokentransaction.FieldClientCreatedTimestamp, tokentransaction.FieldFinalizedAt:
 			values[i] = new(sql.NullTime)
 		case tokentransaction.FieldID:
 			values[i] = new(uuid.UUID)
@@ -248,6 +250,13 @@ func (tt *TokenTransaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				tt.ClientCreatedTimestamp = value.Time
 			}
+		case tokentransaction.FieldFinalizedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finalized_at", values[i])
+			} else if value.Valid {
+				tt.FinalizedAt = new(time.Time)
+				*tt.FinalizedAt = value.Time
+			}
 		case tokentransaction.FieldVersion:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field version", values[i])
@@ -378,6 +387,11 @@ func (tt *TokenTransaction) String() string {
 	builder.WriteString("client_created_timestamp=")
 	builder.WriteString(tt.ClientCreatedTimestamp.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := tt.FinalizedAt; v != nil {
+		builder.WriteString("finalized_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("version=")
 	builder.WriteString(fmt.Sprintf("%v", tt.Version))
 	builder.WriteByte(')')
@@ -35,6 +35,8 @@ const (
 	FieldCoordinatorPublicKey = "coordinator_public_key"
 	// FieldClientCreatedTimestamp holds the string denoting the client_created_timestamp field in the database.
 	FieldClientCreatedTimestamp = "client_created_timestamp"
+	// FieldFinalizedAt holds the string denoting the finalized_at field in the database.
+	FieldFinalizedAt = "finalized_at"
 	// FieldVersion holds the string denoting the version field in the database.
 	FieldVersion = "version"
 	// EdgeSpentOutput holds the string denoting the spent_output edge name in mutations.
@@ -121,6 +123,7 @@ var Columns = []string{
 	FieldExpiryTime,
 	FieldCoordinatorPublicKey,
 	FieldClientCreatedTimestamp,
+	FieldFinalizedAt,
 	FieldVersion,
 }
 
@@ -218,6 +221,11 @@ func ByClientCreatedTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClientCreatedTimestamp, opts...).ToFunc()
 }
 
+// ByFinalizedAt orders the results by the finalized_at field.
+func ByFinalizedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalizedAt, opts...).ToFunc()
+}
+
 // ByVersion orders the results by the version field.
 func ByVersion(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVersion, opts...).ToFunc()
@@ -97,6 +97,11 @@ func ClientCreatedTimestamp(v time.Time) predicate.TokenTransaction {
 	return predicate.TokenTransaction(sql.FieldEQ(FieldClientCreatedTimestamp, v))
 }
 
+// FinalizedAt applies equality check predicate on the "finalized_at" field. It's identical to FinalizedAtEQ.
+func FinalizedAt(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldEQ(FieldFinalizedAt, v))
+}
+
 // Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
 func Version(v schematype.TokenTransactionVersion) predicate.TokenTransaction {
 	vc := int(v)
@@ -503,6 +508,56 @@ func ClientCreatedTimestampNotNil() predicate.TokenTransaction {
 	return predicate.TokenTransaction(sql.FieldNotNull(FieldClientCreatedTimestamp))
 }
 
+// FinalizedAtEQ applies the EQ predicate on the "finalized_at" field.
+func FinalizedAtEQ(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldEQ(FieldFinalizedAt, v))
+}
+
+// FinalizedAtNEQ applies the NEQ predicate on the "finalized_at" field.
+func FinalizedAtNEQ(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldNEQ(FieldFinalizedAt, v))
+}
+
+// FinalizedAtIn applies the In predicate on the "finalized_at" field.
+func FinalizedAtIn(vs ...time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldIn(FieldFinalizedAt, vs...))
+}
+
+// FinalizedAtNotIn applies the NotIn predicate on the "finalized_at" field.
+func FinalizedAtNotIn(vs ...time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldNotIn(FieldFinalizedAt, vs...))
+}
+
+// FinalizedAtGT applies the GT predicate on the "finalized_at" field.
+func FinalizedAtGT(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldGT(FieldFinalizedAt, v))
+}
+
+// FinalizedAtGTE applies the GTE predicate on the "finalized_at" field.
+func FinalizedAtGTE(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldGTE(FieldFinalizedAt, v))
+}
+
+// FinalizedAtLT applies the LT predicate on the "finalized_at" field.
+func FinalizedAtLT(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldLT(FieldFinalizedAt, v))
+}
+
+// FinalizedAtLTE applies the LTE predicate on the "finalized_at" field.
+func FinalizedAtLTE(v time.Time) predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldLTE(FieldFinalizedAt, v))
+}
+
+// FinalizedAtIsNil applies the IsNil predicate on the "finalized_at" field.
+func FinalizedAtIsNil() predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldIsNull(FieldFinalizedAt))
+}
+
+// FinalizedAtNotNil applies the NotNil predicate on the "finalized_at" field.
+func FinalizedAtNotNil() predicate.TokenTransaction {
+	return predicate.TokenTransaction(sql.FieldNotNull(FieldFinalizedAt))
+}
+
 // VersionEQ applies the EQ predicate on the "version" field.
 func VersionEQ(v schematype.TokenTransactionVersion) predicate.TokenTransaction {
 	vc := int(v)
@@ -125,6 +125,20 @@ func (ttc *TokenTransactionCreate) SetNillableClientCreatedTimestamp(t *time.Tim
 	return ttc
 }
 
+// SetFinalizedAt sets the "finalized_at" field.
+func (ttc *TokenTransactionCreate) SetFinalizedAt(t time.Time) *TokenTransactionCreate {
+	ttc.mutation.SetFinalizedAt(t)
+	return ttc
+}
+
+// SetNillableFinalizedAt sets the "finalized_at" field if the given value is not nil.
+func (ttc *TokenTransactionCreate) SetNillableFinalizedAt(t *time.Time) *TokenTransactionCreate {
+	if t != nil {
+		ttc.SetFinalizedAt(*t)
+	}
+	return ttc
+}
+
 // SetVersion sets the "version" field.
 func (ttc *TokenTransactionCreate) SetVersion(stv schematype.TokenTransactionVersion) *TokenTransactionCreate {
 	ttc.mutation.SetVersion(stv)
@@ -447,6 +461,10 @@ func (ttc *TokenTransactionCreate) createSpec() (*TokenTransaction, *sqlgraph.Cr
 		_spec.SetField(tokentransaction.FieldClientCreatedTimestamp, field.TypeTime, value)
 		_node.ClientCreatedTimestamp = value
 	}
+	if value, ok := ttc.mutation.FinalizedAt(); ok {
+		_spec.SetField(tokentransaction.FieldFinalizedAt, field.TypeTime, value)
+		_node.FinalizedAt = &value
+	}
 	if value, ok := ttc.mutation.Version(); ok {
 		_spec.SetField(tokentransaction.FieldVersion, field.TypeInt, value)
 		_node.Version = value
@@ -742,6 +760,24 @@ func (u *TokenTransactionUpsert) ClearClientCreatedTimestamp() *TokenTransaction
 	return u
 }
 
+// SetFinalizedAt sets the "finalized_at" field.
+func (u *TokenTransactionUpsert) SetFinalizedAt(v time.Time) *TokenTransactionUpsert {
+	u.Set(tokentransaction.FieldFinalizedAt, v)
+	return u
+}
+
+// UpdateFinalizedAt sets the "finalized_at" field to the value that was provided on create.
+func (u *TokenTransactionUpsert) UpdateFinalizedAt() *TokenTransactionUpsert {
+	u.SetExcluded(tokentransaction.FieldFinalizedAt)
+	return u
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (u *TokenTransactionUpsert) ClearFinalizedAt() *TokenTransactionUpsert {
+	u.SetNull(tokentransaction.FieldFinalizedAt)
+	return u
+}
+
 // SetVersion sets the "version" field.
 func (u *TokenTransactionUpsert) SetVersion(v schematype.TokenTransactionVersion) *TokenTransactionUpsert {
 	u.Set(tokentransaction.FieldVersion, v)
@@ -940,6 +976,27 @@ func (u *TokenTransactionUpsertOne) ClearClientCreatedTimestamp() *TokenTransact
 	})
 }
 
+// SetFinalizedAt sets the "finalized_at" field.
+func (u *TokenTransactionUpsertOne) SetFinalizedAt(v time.Time) *TokenTransactionUpsertOne {
+	return u.Update(func(s *TokenTransactionUpsert) {
+		s.SetFinalizedAt(v)
+	})
+}
+
+// UpdateFinalizedAt sets the "finalized_at" field to the value that was provided on create.
+func (u *TokenTransactionUpsertOne) UpdateFinalizedAt() *TokenTransactionUpsertOne {
+	return u.Update(func(s *TokenTransactionUpsert) {
+		s.UpdateFinalizedAt()
+	})
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (u *TokenTransactionUpsertOne) ClearFinalizedAt() *TokenTransactionUpsertOne {
+	return u.Update(func(s *TokenTransactionUpsert) {
+		s.ClearFinalizedAt()
+	})
+}
+
 // SetVersion sets the "version" field.
 func (u *TokenTransactionUpsertOne) SetVersion(v schematype.TokenTransactionVersion) *TokenTransactionUpsertOne {
 	return u.Update(func(s *TokenTransactionUpsert) {
@@ -1308,6 +1365,27 @@ func (u *TokenTransactionUpsertBulk) ClearClientCreatedTimestamp() *TokenTransac
 	})
 }
 
+// SetFinalizedAt sets the "finalized_at" field.
+func (u *TokenTransactionUpsertBulk) SetFinalizedAt(v time.Time) *TokenTransactionUpsertBulk {
+	return u.Update(func(s *TokenTransactionUpsert) {
+		s.SetFinalizedAt(v)
+	})
+}
+
+// UpdateFinalizedAt sets the "finalized_at" field to the value that was provided on create.
+func (u *TokenTransactionUpsertBulk) UpdateFinalizedAt() *TokenTransactionUpsertBulk {
+	return u.Update(func(s *TokenTransactionUpsert) {
+		s.UpdateFinalizedAt()
+	})
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (u *TokenTransactionUpsertBulk) ClearFinalizedAt() *TokenTransactionUpsertBulk {
+	return u.Update(func(s *TokenTransactionUpsert) {
+		s.ClearFinalizedAt()
+	})
+}
+
 // SetVersion sets the "version" field.
 func (u *TokenTransactionUpsertBulk) SetVersion(v schematype.TokenTransactionVersion) *TokenTransactionUpsertBulk {
 	return u.Update(func(s *TokenTransactionUpsert) {
@@ -19,6 +19,7 @@ import (
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
 	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
@@ -91,6 +92,7 @@ func CreateStartedTransactionEntities(
 			SetDecimals(uint8(tokenTransaction.GetCreateInput().GetDecimals())).
 			SetMaxSupply(tokenTransaction.GetCreateInput().GetMaxSupply()).
 			SetIsFreezable(tokenTransaction.GetCreateInput().GetIsFreezable()).
+			SetIsTransferRestricted(tokenTransaction.GetCreateInput().GetIsTransferRestricted()).
 			SetCreationEntityPublicKey(tokenTransaction.GetCreateInput().GetCreationEntityPublicKey()).
 			SetNetwork(network).
 			SetTokenIdentifier(computedTokenIdentifier).
@@ -142,7 +144,7 @@ func CreateStartedTransactionEntities(
 		}
 	}
 
-	if tokenTransaction.GetTransferInput() != nil {
+	if tokenTransaction.GetTransferInput() != nil || tokenTransaction.GetBurnInput() != nil {
 		if len(signaturesWithIndex) != len(orderedOutputToSpendEnts) {
 			return nil, fmt.Errorf(
 				"number of signatures %d doesn't match number of outputs to spend %d",
@@ -217,34 +219,9 @@ func CreateStartedTransactionEntities(
 	// To ensure both backwards and forwards compatibility, fetch and write the missing field.
 	// Since we have already hashed the final token transaction, the txHash still represents
 	// the original token transaction that was passed by the client.
-	var tokenIdentifierToWrite []byte
-	var issuerPublicKeyToWrite []byte
-
-	tokenOutputs := tokenTransaction.GetTokenOutputs()
-	var tokenCreateEnt *TokenCreate
-	if len(tokenOutputs) > 0 {
-		// We enforce one of tokenIdentifier or tokenPublicKey from the client.
-		// Query for the missing field
-		if tokenOutputs[0].TokenIdentifier != nil {
-			tokenCreateEnt, err = db.TokenCreate.Query().
-				Where(tokencreate.TokenIdentifier(tokenOutputs[0].TokenIdentifier)).
-				Only(ctx)
-			if err != nil {
-				// An error occurred when fetching the spark token create ent.
-				return nil, fmt.Errorf("failed to fetch token create ent: %w", err)
-			}
-			issuerPublicKeyToWrite = tokenCreateEnt.IssuerPublicKey
-		} else if tokenOutputs[0].TokenPublicKey != nil {
-			tokenCreateEnt, err = db.TokenCreate.Query().
-				Where(tokencreate.IssuerPublicKey(tokenOutputs[0].TokenPublicKey)).
-				Only(ctx)
-			if err != nil {
-				return nil, fmt.Errorf("failed to fetch token create ent: %w", err)
-			}
-			tokenIdentifierToWrite = tokenCreateEnt.TokenIdentifier
-		}
-	}
-
+	//
+	// Swaps create outputs for several tokens, so the token is looked up for each output.
+	tokenCreateEnts := make(map[string]*TokenCreate)
 	outputEnts := make([]*TokenOutputCreate, 0, len(tokenTransaction.TokenOutputs))
 	for outputIndex, output := range tokenTransaction.TokenOutputs {
 		revocationUUID, err := uuid.Parse(orderedOutputToCreateRevocationKeyshareIDs[outputIndex])
@@ -256,13 +233,33 @@ func CreateStartedTransactionEntities(
 			return nil, err
 		}
 
-		if len(issuerPublicKeyToWrite) == 0 {
-			issuerPublicKeyToWrite = output.TokenPublicKey
-		}
-		if len(tokenIdentifierToWrite) == 0 {
-			tokenIdentifierToWrite = output.TokenIdentifier
+		// We enforce one of tokenIdentifier or tokenPublicKey from the client.
+		// Query for the missing field
+		tokenCreateKey := string(output.TokenIdentifier) + string(output.TokenPublicKey)
+		tokenCreateEnt, ok := tokenCreateEnts[tokenCreateKey]
+		if !ok {
+			if output.TokenIdentifier != nil {
+				tokenCreateEnt, err = db.TokenCreate.Query().
+					Where(tokencreate.TokenIdentifier(output.TokenIdentifier)).
+					Only(ctx)
+			} else {
+				tokenCreateEnt, err = db.TokenCreate.Query().
+					Where(tokencreate.IssuerPublicKey(output.TokenPublicKey)).
+					Only(ctx)
+			}
+			if err != nil {
+				// An error occurred when fetching the spark token create ent.
+				return nil, fmt.Errorf("failed to fetch token create ent: %w", err)
+			}
+			tokenCreateEnts[tokenCreateKey] = tokenCreateEnt
 		}
 
+		var spendableAfter *time.Time
+		if output.GetSpendableAfter() != nil {
+			// Stored with the precision it is hashed with so that the reconstructed transaction hashes the same.
+			spendableAfterTime := output.GetSpendableAfter().AsTime().Truncate(time.Millisecond)
+			spendableAfter = &spendableAfterTime
+		}
 		outputEnts = append(
 			outputEnts,
 			db.TokenOutput.
@@ -273,15 +270,16 @@ func CreateStartedTransactionEntities(
 				SetWithdrawBondSats(*output.WithdrawBondSats).
 				SetWithdrawRelativeBlockLocktime(*output.WithdrawRelativeBlockLocktime).
 				SetWithdrawRevocationCommitment(output.RevocationCommitment).
-				SetTokenPublicKey(issuerPublicKeyToWrite).
-				SetTokenIdentifier(tokenIdentifierToWrite).
+				SetTokenPublicKey(tokenCreateEnt.IssuerPublicKey).
+				SetTokenIdentifier(tokenCreateEnt.TokenIdentifier).
 				SetTokenAmount(output.TokenAmount).
 				SetNetwork(network).
 				SetCreatedTransactionOutputVout(int32(outputIndex)).
 				SetRevocationKeyshareID(revocationUUID).
 				SetOutputCreatedTokenTransactionID(tokenTransactionEnt.ID).
 				SetNetwork(network).
-				SetTokenCreateID(tokenCreateEnt.ID),
+				SetTokenCreateID(tokenCreateEnt.ID).
+				SetNillableSpendableAfter(spendableAfter),
 		)
 	}
 	_, err = db.TokenOutput.CreateBulk(outputEnts...).Save(ctx)
@@ -331,6 +329,25 @@ func prepareSparkInvoiceCreates(ctx context.Context, tokenTransaction *tokenpb.T
 	return invoiceIDs, invoiceCreates, nil
 }
 
+// RecordTokenTransactionsFinalizedAt records the current time as the time the token transactions matching the
+// predicates became final, unless they already have one. It must be called whenever a transaction is revealed or
+// finalized, or a mint is signed.
+func RecordTokenTransactionsFinalizedAt(ctx context.Context, predicates ...predicate.TokenTransaction) error {
+	db, err := GetDbFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = db.TokenTransaction.Update().
+		Where(predicates...).
+		Where(tokentransaction.FinalizedAtIsNil()).
+		SetFinalizedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record token transaction finalized time: %w", err)
+	}
+	return nil
+}
+
 // UpdateSignedTransaction updates the status and ownership signatures of the inputs + outputs
 // and the issuer signature (if applicable).
 func UpdateSignedTransaction(
@@ -373,6 +390,9 @@ func UpdateSignedTransaction(
 		if err != nil {
 			return fmt.Errorf("failed to update mint with signature: %w", err)
 		}
+		if err := RecordTokenTransactionsFinalizedAt(ctx, tokentransaction.IDEQ(tokenTransactionEnt.ID)); err != nil {
+			return err
+		}
 	}
 
 	// Update inputs.
@@ -494,6 +514,9 @@ func UpdateFinalizedTransaction(
 	if err != nil {
 		return fmt.Errorf("failed to update token transaction with finalized status: %w", err)
 	}
+	if err := RecordTokenTransactionsFinalizedAt(ctx, tokentransaction.IDEQ(tokenTransactionEnt.ID)); err != nil {
+		return err
+	}
 
 	spentLeaves := tokenTransactionEnt.Edges.SpentOutput
 	if len(spentLeaves) == 0 {
@@ -609,6 +632,9 @@ func FinalizeCoordinatedTokenTransactionWithRevocationKeys(
 	if err != nil {
 		return fmt.Errorf("failed to update token transaction with finalized status for txHash %x: %w", txHash, err)
 	}
+	if err := RecordTokenTransactionsFinalizedAt(ctx, tokentransaction.IDEQ(tokenTransactionEnt.ID)); err != nil {
+		return err
+	}
 	if err := db.Commit(); err != nil {
 		return fmt.Errorf("failed to commit and replace transaction after finalizing token transaction: %w", err)
 	}
@@ -747,12 +773,7 @@ func FetchAndLockTokenTransactionData(ctx context.Context, finalTokenTransaction
 
 	// Sanity check that inputs and outputs matching the expected length were found.
 	// Also ensure the database entity type matches the protobuf type.
-	sparkTx, err := protoconverter.SparkTokenTransactionFromTokenProto(finalTokenTransaction)
-	if err != nil {
-		return nil, fmt.Errorf("failed to convert token transaction: %w", err)
-	}
-
-	txType, err := utils.InferTokenTransactionTypeSparkProtos(sparkTx)
+	txType, err := inferFinalTokenTransactionType(finalTokenTransaction)
 	if err != nil {
 		return nil, fmt.Errorf("invalid token transaction inputs: %w", err)
 	}
@@ -766,15 +787,15 @@ func FetchAndLockTokenTransactionData(ctx context.Context, finalTokenTransaction
 		if tokenTransaction.Edges.Mint == nil {
 			return nil, fmt.Errorf("database has no mint transaction but protobuf has mint input - transaction type mismatch")
 		}
-	case utils.TokenTransactionTypeTransfer:
+	case utils.TokenTransactionTypeTransfer, utils.TokenTransactionTypeBurn:
 		if tokenTransaction.Edges.Create != nil || tokenTransaction.Edges.Mint != nil {
-			return nil, fmt.Errorf("database has create/mint transaction but protobuf has transfer input - transaction type mismatch")
+			return nil, fmt.Errorf("database has create/mint transaction but protobuf has %s input - transaction type mismatch", txType)
 		}
-		transferInput := finalTokenTransaction.GetTransferInput()
-		if len(transferInput.OutputsToSpend) != len(tokenTransaction.Edges.SpentOutput) {
+		outputsToSpend := utils.GetOutputsToSpend(finalTokenTransaction)
+		if len(outputsToSpend) != len(tokenTransaction.Edges.SpentOutput) {
 			return nil, fmt.Errorf(
 				"number of inputs in proto (%d) does not match number of spent outputs started with this transaction in the database (%d)",
-				len(transferInput.OutputsToSpend),
+				len(outputsToSpend),
 				len(tokenTransaction.Edges.SpentOutput),
 			)
 		}
@@ -792,6 +813,19 @@ func FetchAndLockTokenTransactionData(ctx context.Context, finalTokenTransaction
 	return tokenTransaction, nil
 }
 
+// inferFinalTokenTransactionType returns the type of the transaction, checking that it has exactly one input.
+func inferFinalTokenTransactionType(finalTokenTransaction *tokenpb.TokenTransaction) (utils.TokenTransactionType, error) {
+	// Burns have no equivalent in the spark protos.
+	if finalTokenTransaction.GetBurnInput() != nil {
+		return utils.TokenTransactionTypeBurn, nil
+	}
+	sparkTx, err := protoconverter.SparkTokenTransactionFromTokenProto(finalTokenTransaction)
+	if err != nil {
+		return utils.TokenTransactionTypeUnknown, fmt.Errorf("failed to convert token transaction: %w", err)
+	}
+	return utils.InferTokenTransactionTypeSparkProtos(sparkTx)
+}
+
 func FetchAndLockTokenTransactionDataByHash(ctx context.Context, tokenTransactionHash []byte) (*TokenTransaction, error) {
 	db, err := GetDbFromContext(ctx)
 	if err != nil {
@@ -871,6 +905,7 @@ func (t *TokenTransaction) MarshalProto(config *so.Config) (*tokenpb.TokenTransa
 			TokenPublicKey:                output.TokenPublicKey,
 			TokenIdentifier:               output.TokenIdentifier,
 			TokenAmount:                   output.TokenAmount,
+			SpendableAfter:                output.SpendableAfterProto(),
 		}
 		if t.Version == 0 {
 			tokenTransaction.TokenOutputs[i].TokenIdentifier = nil
@@ -890,6 +925,7 @@ func (t *TokenTransaction) MarshalProto(config *so.Config) (*tokenpb.TokenTransa
 				MaxSupply:               t.Edges.Create.MaxSupply,
 				IsFreezable:             t.Edges.Create.IsFreezable,
 				CreationEntityPublicKey: t.Edges.Create.CreationEntityPublicKey,
+				IsTransferRestricted:    t.Edges.Create.IsTransferRestricted,
 			},
 		}
 	} else if t.Edges.Mint != nil {
@@ -900,10 +936,8 @@ func (t *TokenTransaction) MarshalProto(config *so.Config) (*tokenpb.TokenTransa
 			},
 		}
 	} else if len(t.Edges.SpentOutput) > 0 {
-		// This is a transfer transaction
-		transferInput := &tokenpb.TokenTransferInput{
-			OutputsToSpend: make([]*tokenpb.TokenOutputToSpend, len(t.Edges.SpentOutput)),
-		}
+		// This is a transfer or burn transaction
+		outputsToSpend := make([]*tokenpb.TokenOutputToSpend, len(t.Edges.SpentOutput))
 
 		// Sort outputs to match the original token transaction using SpentTransactionInputVout
 		sortedSpentOutputs := slices.SortedFunc(slices.Values(t.Edges.SpentOutput), func(a, b *TokenOutput) int {
@@ -916,14 +950,28 @@ func (t *TokenTransaction) MarshalProto(config *so.Config) (*tokenpb.TokenTransa
 				return nil, fmt.Errorf("output spent transaction edge not loaded for output %s", output.ID)
 			}
 
-			transferInput.OutputsToSpend[i] = &tokenpb.TokenOutputToSpend{
+			outputsToSpend[i] = &tokenpb.TokenOutputToSpend{
 				PrevTokenTransactionHash: output.Edges.OutputCreatedTokenTransaction.FinalizedTokenTransactionHash,
 				PrevTokenTransactionVout: uint32(output.CreatedTransactionOutputVout),
 			}
 		}
 
-		tokenTransaction.TokenInputs = &tokenpb.TokenTransaction_TransferInput{
-			TransferInput: transferInput,
+		txType, err := t.InferTokenTransactionTypeEnt()
+		if err != nil {
+			return nil, err
+		}
+		if txType == utils.TokenTransactionTypeBurn {
+			// All burned outputs have the token identifier from the burn input (validated when starting the transaction).
+			tokenTransaction.TokenInputs = &tokenpb.TokenTransaction_BurnInput{
+				BurnInput: &tokenpb.TokenBurnInput{
+					OutputsToSpend:  outputsToSpend,
+					TokenIdentifier: sortedSpentOutputs[0].TokenIdentifier,
+				},
+			}
+		} else {
+			tokenTransaction.TokenInputs = &tokenpb.TokenTransaction_TransferInput{
+				TransferInput: &tokenpb.TokenTransferInput{OutputsToSpend: outputsToSpend},
+			}
 		}
 
 		// Because we checked for create and mint inputs below, if it doesn't map to inputs it is a special case where a transfer
@@ -962,6 +1010,12 @@ func (t *TokenTransaction) GetNetworkFromEdges() (st.Network, error) {
 		// All token transaction outputs must have the same network (confirmed in validation when signing
 		// the transaction, so its safe to use the first output).
 		return t.Edges.CreatedOutput[0].Network, nil
+	case utils.TokenTransactionTypeBurn:
+		if len(t.Edges.SpentOutput) == 0 {
+			return st.NetworkUnspecified, fmt.Errorf("no spent outputs were found when reconstructing burn token transaction with ID: %s", t.ID)
+		}
+		// All burned outputs must have the same network as the transaction (validated when starting the transaction).
+		return t.Edges.SpentOutput[0].Network, nil
 	default:
 		return st.NetworkUnspecified, fmt.Errorf("unknown token transaction type: %s", txType)
 	}
@@ -976,7 +1030,16 @@ func (t *TokenTransaction) InferTokenTransactionTypeEnt() (utils.TokenTransactio
 	if t.Edges.Mint != nil {
 		return utils.TokenTransactionTypeMint, nil
 	}
-	// If no create or mint, assume its a transfer.
+	// Burns are the only transactions that spend outputs without creating any, so transfers can only be told
+	// apart from burns when the created outputs are loaded.
+	createdOutputs, err := t.Edges.CreatedOutputOrErr()
+	if err != nil {
+		return utils.TokenTransactionTypeUnknown, fmt.Errorf("failed to infer type of token transaction %s: %w", t.ID, err)
+	}
+	if len(createdOutputs) == 0 {
+		return utils.TokenTransactionTypeBurn, nil
+	}
+	// If no create, mint or burn, assume its a transfer.
 	return utils.TokenTransactionTypeTransfer, nil
 }
 
@@ -0,0 +1,45 @@
+package ent_test
+
+import (
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
+	"github.com/lightsparkdev/spark/so/utils"
+)
+
+func TestInferTokenTransactionTypeEnt(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	burn, err := tx.TokenTransaction.Create().
+		SetPartialTokenTransactionHash([]byte("partial_burn_transaction_hash")).
+		SetFinalizedTokenTransactionHash([]byte("finalized_burn_transaction_hash")).
+		SetStatus(st.TokenTransactionStatusStarted).
+		Save(ctx)
+	require.NoError(t, err)
+
+	loaded, err := tx.TokenTransaction.Query().
+		Where(tokentransaction.ID(burn.ID)).
+		WithCreatedOutput().
+		Only(ctx)
+	require.NoError(t, err)
+	txType, err := loaded.InferTokenTransactionTypeEnt()
+	require.NoError(t, err)
+	assert.Equal(t, utils.TokenTransactionTypeBurn, txType)
+
+	// Without its created outputs, a burn cannot be told apart from a transfer.
+	unloaded, err := tx.TokenTransaction.Get(ctx, burn.ID)
+	require.NoError(t, err)
+	txType, err = unloaded.InferTokenTransactionTypeEnt()
+	require.ErrorContains(t, err, "created_output")
+	assert.Equal(t, utils.TokenTransactionTypeUnknown, txType)
+}
@@ -118,6 +118,26 @@ func (ttu *TokenTransactionUpdate) ClearClientCreatedTimestamp() *TokenTransacti
 	return ttu
 }
 
+// SetFinalizedAt sets the "finalized_at" field.
+func (ttu *TokenTransactionUpdate) SetFinalizedAt(t time.Time) *TokenTransactionUpdate {
+	ttu.mutation.SetFinalizedAt(t)
+	return ttu
+}
+
+// SetNillableFinalizedAt sets the "finalized_at" field if the given value is not nil.
+func (ttu *TokenTransactionUpdate) SetNillableFinalizedAt(t *time.Time) *TokenTransactionUpdate {
+	if t != nil {
+		ttu.SetFinalizedAt(*t)
+	}
+	return ttu
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (ttu *TokenTransactionUpdate) ClearFinalizedAt() *TokenTransactionUpdate {
+	ttu.mutation.ClearFinalizedAt()
+	return ttu
+}
+
 // SetVersion sets the "version" field.
 func (ttu *TokenTransactionUpdate) SetVersion(stv schematype.TokenTransactionVersion) *TokenTransactionUpdate {
 	ttu.mutation.ResetVersion()
@@ -508,6 +528,12 @@ func (ttu *TokenTransactionUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if ttu.mutation.ClientCreatedTimestampCleared() {
 		_spec.ClearField(tokentransaction.FieldClientCreatedTimestamp, field.TypeTime)
 	}
+	if value, ok := ttu.mutation.FinalizedAt(); ok {
+		_spec.SetField(tokentransaction.FieldFinalizedAt, field.TypeTime, value)
+	}
+	if ttu.mutation.FinalizedAtCleared() {
+		_spec.ClearField(tokentransaction.FieldFinalizedAt, field.TypeTime)
+	}
 	if value, ok := ttu.mutation.Version(); ok {
 		_spec.SetField(tokentransaction.FieldVersion, field.TypeInt, value)
 	}
@@ -928,6 +954,26 @@ func (ttuo *TokenTransactionUpdateOne) ClearClientCreatedTimestamp() *TokenTrans
 	return ttuo
 }
 
+// SetFinalizedAt sets the "finalized_at" field.
+func (ttuo *TokenTransactionUpdateOne) SetFinalizedAt(t time.Time) *TokenTransactionUpdateOne {
+	ttuo.mutation.SetFinalizedAt(t)
+	return ttuo
+}
+
+// SetNillableFinalizedAt sets the "finalized_at" field if the given value is not nil.
+func (ttuo *TokenTransactionUpdateOne) SetNillableFinalizedAt(t *time.Time) *TokenTransactionUpdateOne {
+	if t != nil {
+		ttuo.SetFinalizedAt(*t)
+	}
+	return ttuo
+}
+
+// ClearFinalizedAt clears the value of the "finalized_at" field.
+func (ttuo *TokenTransactionUpdateOne) ClearFinalizedAt() *TokenTransactionUpdateOne {
+	ttuo.mutation.ClearFinalizedAt()
+	return ttuo
+}
+
 // SetVersion sets the "version" field.
 func (ttuo *TokenTransactionUpdateOne) SetVersion(stv schematype.TokenTransactionVersion) *TokenTransactionUpdateOne {
 	ttuo.mutation.ResetVersion()
@@ -1348,6 +1394,12 @@ func (ttuo *TokenTransactionUpdateOne) sqlSave(ctx context.Context) (_node *Toke
 	if ttuo.mutation.ClientCreatedTimestampCleared() {
 		_spec.ClearField(tokentransaction.FieldClientCreatedTimestamp, field.TypeTime)
 	}
+	if value, ok := ttuo.mutation.FinalizedAt(); ok {
+		_spec.SetField(tokentransaction.FieldFinalizedAt, field.TypeTime, value)
+	}
+	if ttuo.mutation.FinalizedAtCleared() {
+		_spec.ClearField(tokentransaction.FieldFinalizedAt, field.TypeTime)
+	}
 	if value, ok := ttuo.mutation.Version(); ok {
 		_spec.SetField(tokentransaction.FieldVersion, field.TypeInt, value)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/so/ent/paymentintent"
 	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
 	"github.com/lightsparkdev/spark/so/ent/transfer"
 )
 
@@ -38,10 +39,13 @@ type Transfer struct {
 	ExpiryTime time.Time `json:"expiry_time,omitempty"`
 	// CompletionTime holds the value of the "completion_time" field.
 	CompletionTime *time.Time `json:"completion_time,omitempty"`
+	// The batch the transfer was sent in. Transfers in a batch are sent or cancelled together.
+	BatchID uuid.UUID `json:"batch_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TransferQuery when eager-loading is set.
 	Edges                   TransferEdges `json:"edges"`
 	transfer_payment_intent *uuid.UUID
+	transfer_spark_invoice  *uuid.UUID
 	selectValues            sql.SelectValues
 }
 
@@ -51,9 +55,11 @@ type TransferEdges struct {
 	TransferLeaves []*TransferLeaf `json:"transfer_leaves,omitempty"`
 	// PaymentIntent holds the value of the payment_intent edge.
 	PaymentIntent *PaymentIntent `json:"payment_intent,omitempty"`
+	// SparkInvoice holds the value of the spark_invoice edge.
+	SparkInvoice *SparkInvoice `json:"spark_invoice,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TransferLeavesOrErr returns the TransferLeaves value or an error if the edge
@@ -76,6 +82,17 @@ func (e TransferEdges) PaymentIntentOrErr() (*PaymentIntent, error) {
 	return nil, &NotLoadedError{edge: "payment_intent"}
 }
 
+// SparkInvoiceOrErr returns the SparkInvoice value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TransferEdges) SparkInvoiceOrErr() (*SparkInvoice, error) {
+	if e.SparkInvoice != nil {
+		return e.SparkInvoice, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: sparkinvoice.Label}
+	}
+	return nil, &NotLoadedError{edge: "spark_invoice"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Transfer) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -89,10 +106,12 @@ func (*Transfer) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullString)
 		case transfer.FieldCreateTime, transfer.FieldUpdateTime, transfer.FieldExpiryTime, transfer.FieldCompletionTime:
 			values[i] = new(sql.NullTime)
-		case transfer.FieldID:
+		case transfer.FieldID, transfer.FieldBatchID:
 			values[i] = new(uuid.UUID)
 		case transfer.ForeignKeys[0]: // transfer_payment_intent
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case transfer.ForeignKeys[1]: // transfer_spark_invoice
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -169,6 +188,12 @@ func (t *Transfer) assignValues(columns []string, values []any) error {
 				t.CompletionTime = new(time.Time)
 				*t.CompletionTime = value.Time
 			}
+		case transfer.FieldBatchID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field batch_id", values[i])
+			} else if value != nil {
+				t.BatchID = *value
+			}
 		case transfer.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field transfer_payment_intent", values[i])
@@ -176,6 +201,13 @@ func (t *Transfer) assignValues(columns []string, values []any) error {
 				t.transfer_payment_intent = new(uuid.UUID)
 				*t.transfer_payment_intent = *value.S.(*uuid.UUID)
 			}
+		case transfer.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_spark_invoice", values[i])
+			} else if value.Valid {
+				t.transfer_spark_invoice = new(uuid.UUID)
+				*t.transfer_spark_invoice = *value.S.(*uuid.UUID)
+			}
 		default:
 			t.selectValues.Set(columns[i], values[i])
 		}
@@ -199,6 +231,11 @@ func (t *Transfer) QueryPaymentIntent() *PaymentIntentQuery {
 	return NewTransferClient(t.config).QueryPaymentIntent(t)
 }
 
+// QuerySparkInvoice queries the "spark_invoice" edge of the Transfer entity.
+func (t *Transfer) QuerySparkInvoice() *SparkInvoiceQuery {
+	return NewTransferClient(t.config).QuerySparkInvoice(t)
+}
+
 // Update returns a builder for updating this Transfer.
 // Note that you need to call Transfer.Unwrap() before calling this method if this Transfer
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -250,6 +287,9 @@ func (t *Transfer) String() string {
 		builder.WriteString("completion_time=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("batch_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.BatchID))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -35,10 +35,14 @@ const (
 	FieldExpiryTime = "expiry_time"
 	// FieldCompletionTime holds the string denoting the completion_time field in the database.
 	FieldCompletionTime = "completion_time"
+	// FieldBatchID holds the string denoting the batch_id field in the database.
+	FieldBatchID = "batch_id"
 	// EdgeTransferLeaves holds the string denoting the transfer_leaves edge name in mutations.
 	EdgeTransferLeaves = "transfer_leaves"
 	// EdgePaymentIntent holds the string denoting the payment_intent edge name in mutations.
 	EdgePaymentIntent = "payment_intent"
+	// EdgeSparkInvoice holds the string denoting the spark_invoice edge name in mutations.
+	EdgeSparkInvoice = "spark_invoice"
 	// Table holds the table name of the transfer in the database.
 	Table = "transfers"
 	// TransferLeavesTable is the table that holds the transfer_leaves relation/edge.
@@ -55,6 +59,13 @@ const (
 	PaymentIntentInverseTable = "payment_intents"
 	// PaymentIntentColumn is the table column denoting the payment_intent relation/edge.
 	PaymentIntentColumn = "transfer_payment_intent"
+	// SparkInvoiceTable is the table that holds the spark_invoice relation/edge.
+	SparkInvoiceTable = "transfers"
+	// SparkInvoiceInverseTable is the table name for the SparkInvoice entity.
+	// It exists in this package in order to avoid circular dependency with the "sparkinvoice" package.
+	SparkInvoiceInverseTable = "spark_invoices"
+	// SparkInvoiceColumn is the table column denoting the spark_invoice relation/edge.
+	SparkInvoiceColumn = "transfer_spark_invoice"
 )
 
 // Columns holds all SQL columns for transfer fields.
@@ -69,12 +80,14 @@ var Columns = []string{
 	FieldType,
 	FieldExpiryTime,
 	FieldCompletionTime,
+	FieldBatchID,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "transfers"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
 	"transfer_payment_intent",
+	"transfer_spark_invoice",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -120,7 +133,7 @@ func StatusValidator(s schematype.TransferStatus) error {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type schematype.TransferType) error {
 	switch _type {
-	case "PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP":
+	case "PREIMAGE_SWAP", "COOPERATIVE_EXIT", "TRANSFER", "SWAP", "COUNTER_SWAP", "UTXO_SWAP", "HTLC":
 		return nil
 	default:
 		return fmt.Errorf("transfer: invalid enum value for type field: %q", _type)
@@ -170,6 +183,11 @@ func ByCompletionTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompletionTime, opts...).ToFunc()
 }
 
+// ByBatchID orders the results by the batch_id field.
+func ByBatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBatchID, opts...).ToFunc()
+}
+
 // ByTransferLeavesCount orders the results by transfer_leaves count.
 func ByTransferLeavesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -190,6 +208,13 @@ func ByPaymentIntentField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newPaymentIntentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySparkInvoiceField orders the results by spark_invoice field.
+func BySparkInvoiceField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSparkInvoiceStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newTransferLeavesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -204,3 +229,10 @@ func newPaymentIntentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, PaymentIntentTable, PaymentIntentColumn),
 	)
 }
+func newSparkInvoiceStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SparkInvoiceInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, SparkInvoiceTable, SparkInvoiceColumn),
+	)
+}
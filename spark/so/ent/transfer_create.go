@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/so/ent/paymentintent"
 	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
 	"github.com/lightsparkdev/spark/so/ent/transfer"
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 )
@@ -105,6 +106,20 @@ func (tc *TransferCreate) SetNillableCompletionTime(t *time.Time) *TransferCreat
 	return tc
 }
 
+// SetBatchID sets the "batch_id" field.
+func (tc *TransferCreate) SetBatchID(u uuid.UUID) *TransferCreate {
+	tc.mutation.SetBatchID(u)
+	return tc
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (tc *TransferCreate) SetNillableBatchID(u *uuid.UUID) *TransferCreate {
+	if u != nil {
+		tc.SetBatchID(*u)
+	}
+	return tc
+}
+
 // SetID sets the "id" field.
 func (tc *TransferCreate) SetID(u uuid.UUID) *TransferCreate {
 	tc.mutation.SetID(u)
@@ -153,6 +168,25 @@ func (tc *TransferCreate) SetPaymentIntent(p *PaymentIntent) *TransferCreate {
 	return tc.SetPaymentIntentID(p.ID)
 }
 
+// SetSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by ID.
+func (tc *TransferCreate) SetSparkInvoiceID(id uuid.UUID) *TransferCreate {
+	tc.mutation.SetSparkInvoiceID(id)
+	return tc
+}
+
+// SetNillableSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by ID if the given value is not nil.
+func (tc *TransferCreate) SetNillableSparkInvoiceID(id *uuid.UUID) *TransferCreate {
+	if id != nil {
+		tc = tc.SetSparkInvoiceID(*id)
+	}
+	return tc
+}
+
+// SetSparkInvoice sets the "spark_invoice" edge to the SparkInvoice entity.
+func (tc *TransferCreate) SetSparkInvoice(s *SparkInvoice) *TransferCreate {
+	return tc.SetSparkInvoiceID(s.ID)
+}
+
 // Mutation returns the TransferMutation object of the builder.
 func (tc *TransferCreate) Mutation() *TransferMutation {
 	return tc.mutation
@@ -320,6 +354,10 @@ func (tc *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
 		_spec.SetField(transfer.FieldCompletionTime, field.TypeTime, value)
 		_node.CompletionTime = &value
 	}
+	if value, ok := tc.mutation.BatchID(); ok {
+		_spec.SetField(transfer.FieldBatchID, field.TypeUUID, value)
+		_node.BatchID = value
+	}
 	if nodes := tc.mutation.TransferLeavesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -353,6 +391,23 @@ func (tc *TransferCreate) createSpec() (*Transfer, *sqlgraph.CreateSpec) {
 		_node.transfer_payment_intent = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := tc.mutation.SparkInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transfer.SparkInvoiceTable,
+			Columns: []string{transfer.SparkInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sparkinvoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.transfer_spark_invoice = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -477,6 +532,24 @@ func (u *TransferUpsert) ClearCompletionTime() *TransferUpsert {
 	return u
 }
 
+// SetBatchID sets the "batch_id" field.
+func (u *TransferUpsert) SetBatchID(v uuid.UUID) *TransferUpsert {
+	u.Set(transfer.FieldBatchID, v)
+	return u
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *TransferUpsert) UpdateBatchID() *TransferUpsert {
+	u.SetExcluded(transfer.FieldBatchID)
+	return u
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *TransferUpsert) ClearBatchID() *TransferUpsert {
+	u.SetNull(transfer.FieldBatchID)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -621,6 +694,27 @@ func (u *TransferUpsertOne) ClearCompletionTime() *TransferUpsertOne {
 	})
 }
 
+// SetBatchID sets the "batch_id" field.
+func (u *TransferUpsertOne) SetBatchID(v uuid.UUID) *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *TransferUpsertOne) UpdateBatchID() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *TransferUpsertOne) ClearBatchID() *TransferUpsertOne {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearBatchID()
+	})
+}
+
 // Exec executes the query.
 func (u *TransferUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -932,6 +1026,27 @@ func (u *TransferUpsertBulk) ClearCompletionTime() *TransferUpsertBulk {
 	})
 }
 
+// SetBatchID sets the "batch_id" field.
+func (u *TransferUpsertBulk) SetBatchID(v uuid.UUID) *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.SetBatchID(v)
+	})
+}
+
+// UpdateBatchID sets the "batch_id" field to the value that was provided on create.
+func (u *TransferUpsertBulk) UpdateBatchID() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.UpdateBatchID()
+	})
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (u *TransferUpsertBulk) ClearBatchID() *TransferUpsertBulk {
+	return u.Update(func(s *TransferUpsert) {
+		s.ClearBatchID()
+	})
+}
+
 // Exec executes the query.
 func (u *TransferUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -32,6 +33,23 @@ func (t *Transfer) MarshalProto(ctx context.Context) (*pb.Transfer, error) {
 	if err != nil {
 		return nil, err
 	}
+	var sparkPaymentIntent string
+	// Use the payment intent loaded with WithPaymentIntent if there is one, so that marshalling a page of
+	// transfers does not query each of their payment intents separately.
+	paymentIntent, err := t.Edges.PaymentIntentOrErr()
+	if IsNotLoaded(err) {
+		paymentIntent, err = t.QueryPaymentIntent().Only(ctx)
+	}
+	if err != nil && !IsNotFound(err) {
+		return nil, fmt.Errorf("unable to query payment intent for transfer %s: %w", t.ID.String(), err)
+	}
+	if paymentIntent != nil {
+		sparkPaymentIntent = paymentIntent.PaymentIntent
+	}
+	var batchID string
+	if t.BatchID != uuid.Nil {
+		batchID = t.BatchID.String()
+	}
 	return &pb.Transfer{
 		Id:                        t.ID.String(),
 		SenderIdentityPublicKey:   t.SenderIdentityPubkey,
@@ -43,6 +61,8 @@ func (t *Transfer) MarshalProto(ctx context.Context) (*pb.Transfer, error) {
 		CreatedTime:               timestamppb.New(t.CreateTime),
 		UpdatedTime:               timestamppb.New(t.UpdateTime),
 		Type:                      *transferType,
+		SparkPaymentIntent:        sparkPaymentIntent,
+		BatchId:                   batchID,
 	}, nil
 }
 
@@ -117,6 +137,8 @@ func TransferTypeProto(transferType st.TransferType) (*pb.TransferType, error) {
 		return pb.TransferType_COUNTER_SWAP.Enum(), nil
 	case st.TransferTypeUtxoSwap:
 		return pb.TransferType_UTXO_SWAP.Enum(), nil
+	case st.TransferTypeHtlc:
+		return pb.TransferType_HTLC.Enum(), nil
 	}
 	return nil, fmt.Errorf("unknown transfer type %s", transferType)
 }
@@ -135,6 +157,8 @@ func TransferTypeSchema(transferType pb.TransferType) (st.TransferType, error) {
 		return st.TransferTypeCounterSwap, nil
 	case pb.TransferType_UTXO_SWAP:
 		return st.TransferTypeUtxoSwap, nil
+	case pb.TransferType_HTLC:
+		return st.TransferTypeHtlc, nil
 	}
 	return "", fmt.Errorf("unknown transfer type %s", transferType)
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/paymentintent"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	"github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
 	"github.com/lightsparkdev/spark/so/ent/transfer"
 	"github.com/lightsparkdev/spark/so/ent/transferleaf"
 )
@@ -107,6 +108,26 @@ func (tu *TransferUpdate) ClearCompletionTime() *TransferUpdate {
 	return tu
 }
 
+// SetBatchID sets the "batch_id" field.
+func (tu *TransferUpdate) SetBatchID(u uuid.UUID) *TransferUpdate {
+	tu.mutation.SetBatchID(u)
+	return tu
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (tu *TransferUpdate) SetNillableBatchID(u *uuid.UUID) *TransferUpdate {
+	if u != nil {
+		tu.SetBatchID(*u)
+	}
+	return tu
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (tu *TransferUpdate) ClearBatchID() *TransferUpdate {
+	tu.mutation.ClearBatchID()
+	return tu
+}
+
 // AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by IDs.
 func (tu *TransferUpdate) AddTransferLeafeIDs(ids ...uuid.UUID) *TransferUpdate {
 	tu.mutation.AddTransferLeafeIDs(ids...)
@@ -141,6 +162,25 @@ func (tu *TransferUpdate) SetPaymentIntent(p *PaymentIntent) *TransferUpdate {
 	return tu.SetPaymentIntentID(p.ID)
 }
 
+// SetSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by ID.
+func (tu *TransferUpdate) SetSparkInvoiceID(id uuid.UUID) *TransferUpdate {
+	tu.mutation.SetSparkInvoiceID(id)
+	return tu
+}
+
+// SetNillableSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by ID if the given value is not nil.
+func (tu *TransferUpdate) SetNillableSparkInvoiceID(id *uuid.UUID) *TransferUpdate {
+	if id != nil {
+		tu = tu.SetSparkInvoiceID(*id)
+	}
+	return tu
+}
+
+// SetSparkInvoice sets the "spark_invoice" edge to the SparkInvoice entity.
+func (tu *TransferUpdate) SetSparkInvoice(s *SparkInvoice) *TransferUpdate {
+	return tu.SetSparkInvoiceID(s.ID)
+}
+
 // Mutation returns the TransferMutation object of the builder.
 func (tu *TransferUpdate) Mutation() *TransferMutation {
 	return tu.mutation
@@ -173,6 +213,12 @@ func (tu *TransferUpdate) ClearPaymentIntent() *TransferUpdate {
 	return tu
 }
 
+// ClearSparkInvoice clears the "spark_invoice" edge to the SparkInvoice entity.
+func (tu *TransferUpdate) ClearSparkInvoice() *TransferUpdate {
+	tu.mutation.ClearSparkInvoice()
+	return tu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (tu *TransferUpdate) Save(ctx context.Context) (int, error) {
 	tu.defaults()
@@ -257,6 +303,12 @@ func (tu *TransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.CompletionTimeCleared() {
 		_spec.ClearField(transfer.FieldCompletionTime, field.TypeTime)
 	}
+	if value, ok := tu.mutation.BatchID(); ok {
+		_spec.SetField(transfer.FieldBatchID, field.TypeUUID, value)
+	}
+	if tu.mutation.BatchIDCleared() {
+		_spec.ClearField(transfer.FieldBatchID, field.TypeUUID)
+	}
 	if tu.mutation.TransferLeavesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -331,6 +383,35 @@ func (tu *TransferUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tu.mutation.SparkInvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transfer.SparkInvoiceTable,
+			Columns: []string{transfer.SparkInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sparkinvoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.SparkInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transfer.SparkInvoiceTable,
+			Columns: []string{transfer.SparkInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sparkinvoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{transfer.Label}
@@ -426,6 +507,26 @@ func (tuo *TransferUpdateOne) ClearCompletionTime() *TransferUpdateOne {
 	return tuo
 }
 
+// SetBatchID sets the "batch_id" field.
+func (tuo *TransferUpdateOne) SetBatchID(u uuid.UUID) *TransferUpdateOne {
+	tuo.mutation.SetBatchID(u)
+	return tuo
+}
+
+// SetNillableBatchID sets the "batch_id" field if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableBatchID(u *uuid.UUID) *TransferUpdateOne {
+	if u != nil {
+		tuo.SetBatchID(*u)
+	}
+	return tuo
+}
+
+// ClearBatchID clears the value of the "batch_id" field.
+func (tuo *TransferUpdateOne) ClearBatchID() *TransferUpdateOne {
+	tuo.mutation.ClearBatchID()
+	return tuo
+}
+
 // AddTransferLeafeIDs adds the "transfer_leaves" edge to the TransferLeaf entity by IDs.
 func (tuo *TransferUpdateOne) AddTransferLeafeIDs(ids ...uuid.UUID) *TransferUpdateOne {
 	tuo.mutation.AddTransferLeafeIDs(ids...)
@@ -460,6 +561,25 @@ func (tuo *TransferUpdateOne) SetPaymentIntent(p *PaymentIntent) *TransferUpdate
 	return tuo.SetPaymentIntentID(p.ID)
 }
 
+// SetSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by ID.
+func (tuo *TransferUpdateOne) SetSparkInvoiceID(id uuid.UUID) *TransferUpdateOne {
+	tuo.mutation.SetSparkInvoiceID(id)
+	return tuo
+}
+
+// SetNillableSparkInvoiceID sets the "spark_invoice" edge to the SparkInvoice entity by ID if the given value is not nil.
+func (tuo *TransferUpdateOne) SetNillableSparkInvoiceID(id *uuid.UUID) *TransferUpdateOne {
+	if id != nil {
+		tuo = tuo.SetSparkInvoiceID(*id)
+	}
+	return tuo
+}
+
+// SetSparkInvoice sets the "spark_invoice" edge to the SparkInvoice entity.
+func (tuo *TransferUpdateOne) SetSparkInvoice(s *SparkInvoice) *TransferUpdateOne {
+	return tuo.SetSparkInvoiceID(s.ID)
+}
+
 // Mutation returns the TransferMutation object of the builder.
 func (tuo *TransferUpdateOne) Mutation() *TransferMutation {
 	return tuo.mutation
@@ -492,6 +612,12 @@ func (tuo *TransferUpdateOne) ClearPaymentIntent() *TransferUpdateOne {
 	return tuo
 }
 
+// ClearSparkInvoice clears the "spark_invoice" edge to the SparkInvoice entity.
+func (tuo *TransferUpdateOne) ClearSparkInvoice() *TransferUpdateOne {
+	tuo.mutation.ClearSparkInvoice()
+	return tuo
+}
+
 // Where appends a list predicates to the TransferUpdate builder.
 func (tuo *TransferUpdateOne) Where(ps ...predicate.Transfer) *TransferUpdateOne {
 	tuo.mutation.Where(ps...)
@@ -606,6 +732,12 @@ func (tuo *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err
 	if tuo.mutation.CompletionTimeCleared() {
 		_spec.ClearField(transfer.FieldCompletionTime, field.TypeTime)
 	}
+	if value, ok := tuo.mutation.BatchID(); ok {
+		_spec.SetField(transfer.FieldBatchID, field.TypeUUID, value)
+	}
+	if tuo.mutation.BatchIDCleared() {
+		_spec.ClearField(transfer.FieldBatchID, field.TypeUUID)
+	}
 	if tuo.mutation.TransferLeavesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -680,6 +812,35 @@ func (tuo *TransferUpdateOne) sqlSave(ctx context.Context) (_node *Transfer, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if tuo.mutation.SparkInvoiceCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transfer.SparkInvoiceTable,
+			Columns: []string{transfer.SparkInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sparkinvoice.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.SparkInvoiceIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   transfer.SparkInvoiceTable,
+			Columns: []string{transfer.SparkInvoiceColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sparkinvoice.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Transfer{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
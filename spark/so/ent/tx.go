@@ -40,10 +40,14 @@ type Tx struct {
 	SigningNonce *SigningNonceClient
 	// SparkInvoice is the client for interacting with the SparkInvoice builders.
 	SparkInvoice *SparkInvoiceClient
+	// TokenAllowlistEntry is the client for interacting with the TokenAllowlistEntry builders.
+	TokenAllowlistEntry *TokenAllowlistEntryClient
 	// TokenCreate is the client for interacting with the TokenCreate builders.
 	TokenCreate *TokenCreateClient
 	// TokenFreeze is the client for interacting with the TokenFreeze builders.
 	TokenFreeze *TokenFreezeClient
+	// TokenIssuerKeyRotation is the client for interacting with the TokenIssuerKeyRotation builders.
+	TokenIssuerKeyRotation *TokenIssuerKeyRotationClient
 	// TokenMint is the client for interacting with the TokenMint builders.
 	TokenMint *TokenMintClient
 	// TokenOutput is the client for interacting with the TokenOutput builders.
@@ -62,6 +66,10 @@ type Tx struct {
 	Tree *TreeClient
 	// TreeNode is the client for interacting with the TreeNode builders.
 	TreeNode *TreeNodeClient
+	// UserEvent is the client for interacting with the UserEvent builders.
+	UserEvent *UserEventClient
+	// UserEventSequence is the client for interacting with the UserEventSequence builders.
+	UserEventSequence *UserEventSequenceClient
 	// UserSignedTransaction is the client for interacting with the UserSignedTransaction builders.
 	UserSignedTransaction *UserSignedTransactionClient
 	// Utxo is the client for interacting with the Utxo builders.
@@ -212,8 +220,10 @@ func (tx *Tx) init() {
 	tx.SigningKeyshare = NewSigningKeyshareClient(tx.config)
 	tx.SigningNonce = NewSigningNonceClient(tx.config)
 	tx.SparkInvoice = NewSparkInvoiceClient(tx.config)
+	tx.TokenAllowlistEntry = NewTokenAllowlistEntryClient(tx.config)
 	tx.TokenCreate = NewTokenCreateClient(tx.config)
 	tx.TokenFreeze = NewTokenFreezeClient(tx.config)
+	tx.TokenIssuerKeyRotation = NewTokenIssuerKeyRotationClient(tx.config)
 	tx.TokenMint = NewTokenMintClient(tx.config)
 	tx.TokenOutput = NewTokenOutputClient(tx.config)
 	tx.TokenPartialRevocationSecretShare = NewTokenPartialRevocationSecretShareClient(tx.config)
@@ -223,6 +233,8 @@ func (tx *Tx) init() {
 	tx.TransferLeaf = NewTransferLeafClient(tx.config)
 	tx.Tree = NewTreeClient(tx.config)
 	tx.TreeNode = NewTreeNodeClient(tx.config)
+	tx.UserEvent = NewUserEventClient(tx.config)
+	tx.UserEventSequence = NewUserEventSequenceClient(tx.config)
 	tx.UserSignedTransaction = NewUserSignedTransactionClient(tx.config)
 	tx.Utxo = NewUtxoClient(tx.config)
 	tx.UtxoSwap = NewUtxoSwapClient(tx.config)
@@ -0,0 +1,10 @@
+package ent
+
+// ParentClient returns a client on the driver the transaction was started from. Unlike Client, it is not bound to
+// the transaction, so it can start new transactions once this one completes.
+func (tx *Tx) ParentClient() *Client {
+	client := &Client{config: tx.config}
+	client.driver = tx.config.driver.(*txDriver).drv
+	client.init()
+	return client
+}
@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+)
+
+// UserEvent is the model entity for the UserEvent schema.
+type UserEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// The identity public key of the user the event is for
+	IdentityPublicKey []byte `json:"identity_public_key,omitempty"`
+	// The position of the event in the identity's event log
+	Sequence uint64 `json:"sequence,omitempty"`
+	// Serialized SubscribeToEventsResponse in spark.proto
+	Event        []byte `json:"event,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case userevent.FieldIdentityPublicKey, userevent.FieldEvent:
+			values[i] = new([]byte)
+		case userevent.FieldSequence:
+			values[i] = new(sql.NullInt64)
+		case userevent.FieldCreateTime, userevent.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case userevent.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserEvent fields.
+func (ue *UserEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case userevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ue.ID = *value
+			}
+		case userevent.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ue.CreateTime = value.Time
+			}
+		case userevent.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ue.UpdateTime = value.Time
+			}
+		case userevent.FieldIdentityPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_public_key", values[i])
+			} else if value != nil {
+				ue.IdentityPublicKey = *value
+			}
+		case userevent.FieldSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sequence", values[i])
+			} else if value.Valid {
+				ue.Sequence = uint64(value.Int64)
+			}
+		case userevent.FieldEvent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value != nil {
+				ue.Event = *value
+			}
+		default:
+			ue.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserEvent.
+// This includes values selected through modifiers, order, etc.
+func (ue *UserEvent) Value(name string) (ent.Value, error) {
+	return ue.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserEvent.
+// Note that you need to call UserEvent.Unwrap() before calling this method if this UserEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ue *UserEvent) Update() *UserEventUpdateOne {
+	return NewUserEventClient(ue.config).UpdateOne(ue)
+}
+
+// Unwrap unwraps the UserEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ue *UserEvent) Unwrap() *UserEvent {
+	_tx, ok := ue.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserEvent is not a transactional entity")
+	}
+	ue.config.driver = _tx.drv
+	return ue
+}
+
+// String implements the fmt.Stringer.
+func (ue *UserEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ue.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ue.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ue.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("identity_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", ue.IdentityPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("sequence=")
+	builder.WriteString(fmt.Sprintf("%v", ue.Sequence))
+	builder.WriteString(", ")
+	builder.WriteString("event=")
+	builder.WriteString(fmt.Sprintf("%v", ue.Event))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserEvents is a parsable slice of UserEvent.
+type UserEvents []*UserEvent
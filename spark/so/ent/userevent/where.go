@@ -0,0 +1,296 @@
+// Code generated by ent, DO NOT EDIT.
+
+package userevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// IdentityPublicKey applies equality check predicate on the "identity_public_key" field. It's identical to IdentityPublicKeyEQ.
+func IdentityPublicKey(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// Sequence applies equality check predicate on the "sequence" field. It's identical to SequenceEQ.
+func Sequence(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldSequence, v))
+}
+
+// Event applies equality check predicate on the "event" field. It's identical to EventEQ.
+func Event(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldEvent, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// IdentityPublicKeyEQ applies the EQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyEQ(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyNEQ applies the NEQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyNEQ(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyIn applies the In predicate on the "identity_public_key" field.
+func IdentityPublicKeyIn(vs ...[]byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyNotIn applies the NotIn predicate on the "identity_public_key" field.
+func IdentityPublicKeyNotIn(vs ...[]byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNotIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyGT applies the GT predicate on the "identity_public_key" field.
+func IdentityPublicKeyGT(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyGTE applies the GTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyGTE(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGTE(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLT applies the LT predicate on the "identity_public_key" field.
+func IdentityPublicKeyLT(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLTE applies the LTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyLTE(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLTE(FieldIdentityPublicKey, v))
+}
+
+// SequenceEQ applies the EQ predicate on the "sequence" field.
+func SequenceEQ(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldSequence, v))
+}
+
+// SequenceNEQ applies the NEQ predicate on the "sequence" field.
+func SequenceNEQ(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNEQ(FieldSequence, v))
+}
+
+// SequenceIn applies the In predicate on the "sequence" field.
+func SequenceIn(vs ...uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldIn(FieldSequence, vs...))
+}
+
+// SequenceNotIn applies the NotIn predicate on the "sequence" field.
+func SequenceNotIn(vs ...uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNotIn(FieldSequence, vs...))
+}
+
+// SequenceGT applies the GT predicate on the "sequence" field.
+func SequenceGT(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGT(FieldSequence, v))
+}
+
+// SequenceGTE applies the GTE predicate on the "sequence" field.
+func SequenceGTE(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGTE(FieldSequence, v))
+}
+
+// SequenceLT applies the LT predicate on the "sequence" field.
+func SequenceLT(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLT(FieldSequence, v))
+}
+
+// SequenceLTE applies the LTE predicate on the "sequence" field.
+func SequenceLTE(v uint64) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLTE(FieldSequence, v))
+}
+
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldEQ(FieldEvent, v))
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNEQ(FieldEvent, v))
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...[]byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldIn(FieldEvent, vs...))
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...[]byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldNotIn(FieldEvent, vs...))
+}
+
+// EventGT applies the GT predicate on the "event" field.
+func EventGT(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGT(FieldEvent, v))
+}
+
+// EventGTE applies the GTE predicate on the "event" field.
+func EventGTE(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldGTE(FieldEvent, v))
+}
+
+// EventLT applies the LT predicate on the "event" field.
+func EventLT(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLT(FieldEvent, v))
+}
+
+// EventLTE applies the LTE predicate on the "event" field.
+func EventLTE(v []byte) predicate.UserEvent {
+	return predicate.UserEvent(sql.FieldLTE(FieldEvent, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserEvent) predicate.UserEvent {
+	return predicate.UserEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserEvent) predicate.UserEvent {
+	return predicate.UserEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserEvent) predicate.UserEvent {
+	return predicate.UserEvent(sql.NotPredicates(p))
+}
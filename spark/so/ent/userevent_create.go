@@ -0,0 +1,619 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+)
+
+// UserEventCreate is the builder for creating a UserEvent entity.
+type UserEventCreate struct {
+	config
+	mutation *UserEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (uec *UserEventCreate) SetCreateTime(t time.Time) *UserEventCreate {
+	uec.mutation.SetCreateTime(t)
+	return uec
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (uec *UserEventCreate) SetNillableCreateTime(t *time.Time) *UserEventCreate {
+	if t != nil {
+		uec.SetCreateTime(*t)
+	}
+	return uec
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (uec *UserEventCreate) SetUpdateTime(t time.Time) *UserEventCreate {
+	uec.mutation.SetUpdateTime(t)
+	return uec
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (uec *UserEventCreate) SetNillableUpdateTime(t *time.Time) *UserEventCreate {
+	if t != nil {
+		uec.SetUpdateTime(*t)
+	}
+	return uec
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (uec *UserEventCreate) SetIdentityPublicKey(b []byte) *UserEventCreate {
+	uec.mutation.SetIdentityPublicKey(b)
+	return uec
+}
+
+// SetSequence sets the "sequence" field.
+func (uec *UserEventCreate) SetSequence(u uint64) *UserEventCreate {
+	uec.mutation.SetSequence(u)
+	return uec
+}
+
+// SetEvent sets the "event" field.
+func (uec *UserEventCreate) SetEvent(b []byte) *UserEventCreate {
+	uec.mutation.SetEvent(b)
+	return uec
+}
+
+// SetID sets the "id" field.
+func (uec *UserEventCreate) SetID(u uuid.UUID) *UserEventCreate {
+	uec.mutation.SetID(u)
+	return uec
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (uec *UserEventCreate) SetNillableID(u *uuid.UUID) *UserEventCreate {
+	if u != nil {
+		uec.SetID(*u)
+	}
+	return uec
+}
+
+// Mutation returns the UserEventMutation object of the builder.
+func (uec *UserEventCreate) Mutation() *UserEventMutation {
+	return uec.mutation
+}
+
+// Save creates the UserEvent in the database.
+func (uec *UserEventCreate) Save(ctx context.Context) (*UserEvent, error) {
+	uec.defaults()
+	return withHooks(ctx, uec.sqlSave, uec.mutation, uec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uec *UserEventCreate) SaveX(ctx context.Context) *UserEvent {
+	v, err := uec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uec *UserEventCreate) Exec(ctx context.Context) error {
+	_, err := uec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uec *UserEventCreate) ExecX(ctx context.Context) {
+	if err := uec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uec *UserEventCreate) defaults() {
+	if _, ok := uec.mutation.CreateTime(); !ok {
+		v := userevent.DefaultCreateTime()
+		uec.mutation.SetCreateTime(v)
+	}
+	if _, ok := uec.mutation.UpdateTime(); !ok {
+		v := userevent.DefaultUpdateTime()
+		uec.mutation.SetUpdateTime(v)
+	}
+	if _, ok := uec.mutation.ID(); !ok {
+		v := userevent.DefaultID()
+		uec.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uec *UserEventCreate) check() error {
+	if _, ok := uec.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "UserEvent.create_time"`)}
+	}
+	if _, ok := uec.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "UserEvent.update_time"`)}
+	}
+	if _, ok := uec.mutation.IdentityPublicKey(); !ok {
+		return &ValidationError{Name: "identity_public_key", err: errors.New(`ent: missing required field "UserEvent.identity_public_key"`)}
+	}
+	if v, ok := uec.mutation.IdentityPublicKey(); ok {
+		if err := userevent.IdentityPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "identity_public_key", err: fmt.Errorf(`ent: validator failed for field "UserEvent.identity_public_key": %w`, err)}
+		}
+	}
+	if _, ok := uec.mutation.Sequence(); !ok {
+		return &ValidationError{Name: "sequence", err: errors.New(`ent: missing required field "UserEvent.sequence"`)}
+	}
+	if _, ok := uec.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "UserEvent.event"`)}
+	}
+	if v, ok := uec.mutation.Event(); ok {
+		if err := userevent.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "UserEvent.event": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uec *UserEventCreate) sqlSave(ctx context.Context) (*UserEvent, error) {
+	if err := uec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	uec.mutation.id = &_node.ID
+	uec.mutation.done = true
+	return _node, nil
+}
+
+func (uec *UserEventCreate) createSpec() (*UserEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserEvent{config: uec.config}
+		_spec = sqlgraph.NewCreateSpec(userevent.Table, sqlgraph.NewFieldSpec(userevent.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = uec.conflict
+	if id, ok := uec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := uec.mutation.CreateTime(); ok {
+		_spec.SetField(userevent.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := uec.mutation.UpdateTime(); ok {
+		_spec.SetField(userevent.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := uec.mutation.IdentityPublicKey(); ok {
+		_spec.SetField(userevent.FieldIdentityPublicKey, field.TypeBytes, value)
+		_node.IdentityPublicKey = value
+	}
+	if value, ok := uec.mutation.Sequence(); ok {
+		_spec.SetField(userevent.FieldSequence, field.TypeUint64, value)
+		_node.Sequence = value
+	}
+	if value, ok := uec.mutation.Event(); ok {
+		_spec.SetField(userevent.FieldEvent, field.TypeBytes, value)
+		_node.Event = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserEvent.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (uec *UserEventCreate) OnConflict(opts ...sql.ConflictOption) *UserEventUpsertOne {
+	uec.conflict = opts
+	return &UserEventUpsertOne{
+		create: uec,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uec *UserEventCreate) OnConflictColumns(columns ...string) *UserEventUpsertOne {
+	uec.conflict = append(uec.conflict, sql.ConflictColumns(columns...))
+	return &UserEventUpsertOne{
+		create: uec,
+	}
+}
+
+type (
+	// UserEventUpsertOne is the builder for "upsert"-ing
+	//  one UserEvent node.
+	UserEventUpsertOne struct {
+		create *UserEventCreate
+	}
+
+	// UserEventUpsert is the "OnConflict" setter.
+	UserEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *UserEventUpsert) SetUpdateTime(v time.Time) *UserEventUpsert {
+	u.Set(userevent.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *UserEventUpsert) UpdateUpdateTime() *UserEventUpsert {
+	u.SetExcluded(userevent.FieldUpdateTime)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserEventUpsertOne) UpdateNewValues() *UserEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(userevent.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(userevent.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.IdentityPublicKey(); exists {
+			s.SetIgnore(userevent.FieldIdentityPublicKey)
+		}
+		if _, exists := u.create.mutation.Sequence(); exists {
+			s.SetIgnore(userevent.FieldSequence)
+		}
+		if _, exists := u.create.mutation.Event(); exists {
+			s.SetIgnore(userevent.FieldEvent)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserEventUpsertOne) Ignore() *UserEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserEventUpsertOne) DoNothing() *UserEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserEventCreate.OnConflict
+// documentation for more info.
+func (u *UserEventUpsertOne) Update(set func(*UserEventUpsert)) *UserEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *UserEventUpsertOne) SetUpdateTime(v time.Time) *UserEventUpsertOne {
+	return u.Update(func(s *UserEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *UserEventUpsertOne) UpdateUpdateTime() *UserEventUpsertOne {
+	return u.Update(func(s *UserEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *UserEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserEventUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserEventUpsertOne.ID is not supported by MySQL driver. Use UserEventUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserEventUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserEventCreateBulk is the builder for creating many UserEvent entities in bulk.
+type UserEventCreateBulk struct {
+	config
+	err      error
+	builders []*UserEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserEvent entities in the database.
+func (uecb *UserEventCreateBulk) Save(ctx context.Context) ([]*UserEvent, error) {
+	if uecb.err != nil {
+		return nil, uecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uecb.builders))
+	nodes := make([]*UserEvent, len(uecb.builders))
+	mutators := make([]Mutator, len(uecb.builders))
+	for i := range uecb.builders {
+		func(i int, root context.Context) {
+			builder := uecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uecb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uecb *UserEventCreateBulk) SaveX(ctx context.Context) []*UserEvent {
+	v, err := uecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uecb *UserEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := uecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uecb *UserEventCreateBulk) ExecX(ctx context.Context) {
+	if err := uecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserEventUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (uecb *UserEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserEventUpsertBulk {
+	uecb.conflict = opts
+	return &UserEventUpsertBulk{
+		create: uecb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uecb *UserEventCreateBulk) OnConflictColumns(columns ...string) *UserEventUpsertBulk {
+	uecb.conflict = append(uecb.conflict, sql.ConflictColumns(columns...))
+	return &UserEventUpsertBulk{
+		create: uecb,
+	}
+}
+
+// UserEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserEvent nodes.
+type UserEventUpsertBulk struct {
+	create *UserEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(userevent.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserEventUpsertBulk) UpdateNewValues() *UserEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(userevent.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(userevent.FieldCreateTime)
+			}
+			if _, exists := b.mutation.IdentityPublicKey(); exists {
+				s.SetIgnore(userevent.FieldIdentityPublicKey)
+			}
+			if _, exists := b.mutation.Sequence(); exists {
+				s.SetIgnore(userevent.FieldSequence)
+			}
+			if _, exists := b.mutation.Event(); exists {
+				s.SetIgnore(userevent.FieldEvent)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserEventUpsertBulk) Ignore() *UserEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserEventUpsertBulk) DoNothing() *UserEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserEventUpsertBulk) Update(set func(*UserEventUpsert)) *UserEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *UserEventUpsertBulk) SetUpdateTime(v time.Time) *UserEventUpsertBulk {
+	return u.Update(func(s *UserEventUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *UserEventUpsertBulk) UpdateUpdateTime() *UserEventUpsertBulk {
+	return u.Update(func(s *UserEventUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// Exec executes the query.
+func (u *UserEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+)
+
+// UserEventDelete is the builder for deleting a UserEvent entity.
+type UserEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserEventMutation
+}
+
+// Where appends a list predicates to the UserEventDelete builder.
+func (ued *UserEventDelete) Where(ps ...predicate.UserEvent) *UserEventDelete {
+	ued.mutation.Where(ps...)
+	return ued
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ued *UserEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ued.sqlExec, ued.mutation, ued.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ued *UserEventDelete) ExecX(ctx context.Context) int {
+	n, err := ued.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ued *UserEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(userevent.Table, sqlgraph.NewFieldSpec(userevent.FieldID, field.TypeUUID))
+	if ps := ued.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ued.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ued.mutation.done = true
+	return affected, err
+}
+
+// UserEventDeleteOne is the builder for deleting a single UserEvent entity.
+type UserEventDeleteOne struct {
+	ued *UserEventDelete
+}
+
+// Where appends a list predicates to the UserEventDelete builder.
+func (uedo *UserEventDeleteOne) Where(ps ...predicate.UserEvent) *UserEventDeleteOne {
+	uedo.ued.mutation.Where(ps...)
+	return uedo
+}
+
+// Exec executes the deletion query.
+func (uedo *UserEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := uedo.ued.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{userevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uedo *UserEventDeleteOne) ExecX(ctx context.Context) {
+	if err := uedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+)
+
+// UserEventQuery is the builder for querying UserEvent entities.
+type UserEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []userevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserEvent
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserEventQuery builder.
+func (ueq *UserEventQuery) Where(ps ...predicate.UserEvent) *UserEventQuery {
+	ueq.predicates = append(ueq.predicates, ps...)
+	return ueq
+}
+
+// Limit the number of records to be returned by this query.
+func (ueq *UserEventQuery) Limit(limit int) *UserEventQuery {
+	ueq.ctx.Limit = &limit
+	return ueq
+}
+
+// Offset to start from.
+func (ueq *UserEventQuery) Offset(offset int) *UserEventQuery {
+	ueq.ctx.Offset = &offset
+	return ueq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ueq *UserEventQuery) Unique(unique bool) *UserEventQuery {
+	ueq.ctx.Unique = &unique
+	return ueq
+}
+
+// Order specifies how the records should be ordered.
+func (ueq *UserEventQuery) Order(o ...userevent.OrderOption) *UserEventQuery {
+	ueq.order = append(ueq.order, o...)
+	return ueq
+}
+
+// First returns the first UserEvent entity from the query.
+// Returns a *NotFoundError when no UserEvent was found.
+func (ueq *UserEventQuery) First(ctx context.Context) (*UserEvent, error) {
+	nodes, err := ueq.Limit(1).All(setContextOp(ctx, ueq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{userevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ueq *UserEventQuery) FirstX(ctx context.Context) *UserEvent {
+	node, err := ueq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserEvent ID from the query.
+// Returns a *NotFoundError when no UserEvent ID was found.
+func (ueq *UserEventQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ueq.Limit(1).IDs(setContextOp(ctx, ueq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{userevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ueq *UserEventQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := ueq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserEvent entity is found.
+// Returns a *NotFoundError when no UserEvent entities are found.
+func (ueq *UserEventQuery) Only(ctx context.Context) (*UserEvent, error) {
+	nodes, err := ueq.Limit(2).All(setContextOp(ctx, ueq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{userevent.Label}
+	default:
+		return nil, &NotSingularError{userevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ueq *UserEventQuery) OnlyX(ctx context.Context) *UserEvent {
+	node, err := ueq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserEvent ID in the query.
+// Returns a *NotSingularError when more than one UserEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ueq *UserEventQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = ueq.Limit(2).IDs(setContextOp(ctx, ueq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{userevent.Label}
+	default:
+		err = &NotSingularError{userevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ueq *UserEventQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := ueq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserEvents.
+func (ueq *UserEventQuery) All(ctx context.Context) ([]*UserEvent, error) {
+	ctx = setContextOp(ctx, ueq.ctx, ent.OpQueryAll)
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserEvent, *UserEventQuery]()
+	return withInterceptors[[]*UserEvent](ctx, ueq, qr, ueq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ueq *UserEventQuery) AllX(ctx context.Context) []*UserEvent {
+	nodes, err := ueq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserEvent IDs.
+func (ueq *UserEventQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if ueq.ctx.Unique == nil && ueq.path != nil {
+		ueq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ueq.ctx, ent.OpQueryIDs)
+	if err = ueq.Select(userevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ueq *UserEventQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := ueq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ueq *UserEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ueq.ctx, ent.OpQueryCount)
+	if err := ueq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ueq, querierCount[*UserEventQuery](), ueq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ueq *UserEventQuery) CountX(ctx context.Context) int {
+	count, err := ueq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ueq *UserEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ueq.ctx, ent.OpQueryExist)
+	switch _, err := ueq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ueq *UserEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := ueq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ueq *UserEventQuery) Clone() *UserEventQuery {
+	if ueq == nil {
+		return nil
+	}
+	return &UserEventQuery{
+		config:     ueq.config,
+		ctx:        ueq.ctx.Clone(),
+		order:      append([]userevent.OrderOption{}, ueq.order...),
+		inters:     append([]Interceptor{}, ueq.inters...),
+		predicates: append([]predicate.UserEvent{}, ueq.predicates...),
+		// clone intermediate query.
+		sql:  ueq.sql.Clone(),
+		path: ueq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserEvent.Query().
+//		GroupBy(userevent.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ueq *UserEventQuery) GroupBy(field string, fields ...string) *UserEventGroupBy {
+	ueq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserEventGroupBy{build: ueq}
+	grbuild.flds = &ueq.ctx.Fields
+	grbuild.label = userevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.UserEvent.Query().
+//		Select(userevent.FieldCreateTime).
+//		Scan(ctx, &v)
+func (ueq *UserEventQuery) Select(fields ...string) *UserEventSelect {
+	ueq.ctx.Fields = append(ueq.ctx.Fields, fields...)
+	sbuild := &UserEventSelect{UserEventQuery: ueq}
+	sbuild.label = userevent.Label
+	sbuild.flds, sbuild.scan = &ueq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserEventSelect configured with the given aggregations.
+func (ueq *UserEventQuery) Aggregate(fns ...AggregateFunc) *UserEventSelect {
+	return ueq.Select().Aggregate(fns...)
+}
+
+func (ueq *UserEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ueq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ueq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ueq.ctx.Fields {
+		if !userevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ueq.path != nil {
+		prev, err := ueq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ueq.sql = prev
+	}
+	return nil
+}
+
+func (ueq *UserEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserEvent, error) {
+	var (
+		nodes = []*UserEvent{}
+		_spec = ueq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserEvent{config: ueq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(ueq.modifiers) > 0 {
+		_spec.Modifiers = ueq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ueq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ueq *UserEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ueq.querySpec()
+	if len(ueq.modifiers) > 0 {
+		_spec.Modifiers = ueq.modifiers
+	}
+	_spec.Node.Columns = ueq.ctx.Fields
+	if len(ueq.ctx.Fields) > 0 {
+		_spec.Unique = ueq.ctx.Unique != nil && *ueq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ueq.driver, _spec)
+}
+
+func (ueq *UserEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(userevent.Table, userevent.Columns, sqlgraph.NewFieldSpec(userevent.FieldID, field.TypeUUID))
+	_spec.From = ueq.sql
+	if unique := ueq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ueq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ueq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userevent.FieldID)
+		for i := range fields {
+			if fields[i] != userevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ueq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ueq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ueq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ueq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ueq *UserEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ueq.driver.Dialect())
+	t1 := builder.Table(userevent.Table)
+	columns := ueq.ctx.Fields
+	if len(columns) == 0 {
+		columns = userevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ueq.sql != nil {
+		selector = ueq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ueq.ctx.Unique != nil && *ueq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range ueq.modifiers {
+		m(selector)
+	}
+	for _, p := range ueq.predicates {
+		p(selector)
+	}
+	for _, p := range ueq.order {
+		p(selector)
+	}
+	if offset := ueq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ueq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (ueq *UserEventQuery) ForUpdate(opts ...sql.LockOption) *UserEventQuery {
+	if ueq.driver.Dialect() == dialect.Postgres {
+		ueq.Unique(false)
+	}
+	ueq.modifiers = append(ueq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return ueq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (ueq *UserEventQuery) ForShare(opts ...sql.LockOption) *UserEventQuery {
+	if ueq.driver.Dialect() == dialect.Postgres {
+		ueq.Unique(false)
+	}
+	ueq.modifiers = append(ueq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return ueq
+}
+
+// UserEventGroupBy is the group-by builder for UserEvent entities.
+type UserEventGroupBy struct {
+	selector
+	build *UserEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uegb *UserEventGroupBy) Aggregate(fns ...AggregateFunc) *UserEventGroupBy {
+	uegb.fns = append(uegb.fns, fns...)
+	return uegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uegb *UserEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uegb.build.ctx, ent.OpQueryGroupBy)
+	if err := uegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserEventQuery, *UserEventGroupBy](ctx, uegb.build, uegb, uegb.build.inters, v)
+}
+
+func (uegb *UserEventGroupBy) sqlScan(ctx context.Context, root *UserEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uegb.fns))
+	for _, fn := range uegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uegb.flds)+len(uegb.fns))
+		for _, f := range *uegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserEventSelect is the builder for selecting fields of UserEvent entities.
+type UserEventSelect struct {
+	*UserEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ues *UserEventSelect) Aggregate(fns ...AggregateFunc) *UserEventSelect {
+	ues.fns = append(ues.fns, fns...)
+	return ues
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ues *UserEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ues.ctx, ent.OpQuerySelect)
+	if err := ues.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserEventQuery, *UserEventSelect](ctx, ues.UserEventQuery, ues, ues.inters, v)
+}
+
+func (ues *UserEventSelect) sqlScan(ctx context.Context, root *UserEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ues.fns))
+	for _, fn := range ues.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ues.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ues.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+)
+
+// UserEventUpdate is the builder for updating UserEvent entities.
+type UserEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserEventMutation
+}
+
+// Where appends a list predicates to the UserEventUpdate builder.
+func (ueu *UserEventUpdate) Where(ps ...predicate.UserEvent) *UserEventUpdate {
+	ueu.mutation.Where(ps...)
+	return ueu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ueu *UserEventUpdate) SetUpdateTime(t time.Time) *UserEventUpdate {
+	ueu.mutation.SetUpdateTime(t)
+	return ueu
+}
+
+// Mutation returns the UserEventMutation object of the builder.
+func (ueu *UserEventUpdate) Mutation() *UserEventMutation {
+	return ueu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ueu *UserEventUpdate) Save(ctx context.Context) (int, error) {
+	ueu.defaults()
+	return withHooks(ctx, ueu.sqlSave, ueu.mutation, ueu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ueu *UserEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := ueu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ueu *UserEventUpdate) Exec(ctx context.Context) error {
+	_, err := ueu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ueu *UserEventUpdate) ExecX(ctx context.Context) {
+	if err := ueu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ueu *UserEventUpdate) defaults() {
+	if _, ok := ueu.mutation.UpdateTime(); !ok {
+		v := userevent.UpdateDefaultUpdateTime()
+		ueu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ueu *UserEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userevent.Table, userevent.Columns, sqlgraph.NewFieldSpec(userevent.FieldID, field.TypeUUID))
+	if ps := ueu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ueu.mutation.UpdateTime(); ok {
+		_spec.SetField(userevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ueu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ueu.mutation.done = true
+	return n, nil
+}
+
+// UserEventUpdateOne is the builder for updating a single UserEvent entity.
+type UserEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserEventMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (ueuo *UserEventUpdateOne) SetUpdateTime(t time.Time) *UserEventUpdateOne {
+	ueuo.mutation.SetUpdateTime(t)
+	return ueuo
+}
+
+// Mutation returns the UserEventMutation object of the builder.
+func (ueuo *UserEventUpdateOne) Mutation() *UserEventMutation {
+	return ueuo.mutation
+}
+
+// Where appends a list predicates to the UserEventUpdate builder.
+func (ueuo *UserEventUpdateOne) Where(ps ...predicate.UserEvent) *UserEventUpdateOne {
+	ueuo.mutation.Where(ps...)
+	return ueuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ueuo *UserEventUpdateOne) Select(field string, fields ...string) *UserEventUpdateOne {
+	ueuo.fields = append([]string{field}, fields...)
+	return ueuo
+}
+
+// Save executes the query and returns the updated UserEvent entity.
+func (ueuo *UserEventUpdateOne) Save(ctx context.Context) (*UserEvent, error) {
+	ueuo.defaults()
+	return withHooks(ctx, ueuo.sqlSave, ueuo.mutation, ueuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ueuo *UserEventUpdateOne) SaveX(ctx context.Context) *UserEvent {
+	node, err := ueuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ueuo *UserEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := ueuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ueuo *UserEventUpdateOne) ExecX(ctx context.Context) {
+	if err := ueuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ueuo *UserEventUpdateOne) defaults() {
+	if _, ok := ueuo.mutation.UpdateTime(); !ok {
+		v := userevent.UpdateDefaultUpdateTime()
+		ueuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (ueuo *UserEventUpdateOne) sqlSave(ctx context.Context) (_node *UserEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(userevent.Table, userevent.Columns, sqlgraph.NewFieldSpec(userevent.FieldID, field.TypeUUID))
+	id, ok := ueuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ueuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, userevent.FieldID)
+		for _, f := range fields {
+			if !userevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != userevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ueuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ueuo.mutation.UpdateTime(); ok {
+		_spec.SetField(userevent.FieldUpdateTime, field.TypeTime, value)
+	}
+	_node = &UserEvent{config: ueuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ueuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{userevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ueuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,142 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
+)
+
+// UserEventSequence is the model entity for the UserEventSequence schema.
+type UserEventSequence struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// IdentityPublicKey holds the value of the "identity_public_key" field.
+	IdentityPublicKey []byte `json:"identity_public_key,omitempty"`
+	// LastSequence holds the value of the "last_sequence" field.
+	LastSequence uint64 `json:"last_sequence,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserEventSequence) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usereventsequence.FieldIdentityPublicKey:
+			values[i] = new([]byte)
+		case usereventsequence.FieldLastSequence:
+			values[i] = new(sql.NullInt64)
+		case usereventsequence.FieldCreateTime, usereventsequence.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		case usereventsequence.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserEventSequence fields.
+func (ues *UserEventSequence) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usereventsequence.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ues.ID = *value
+			}
+		case usereventsequence.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				ues.CreateTime = value.Time
+			}
+		case usereventsequence.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				ues.UpdateTime = value.Time
+			}
+		case usereventsequence.FieldIdentityPublicKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_public_key", values[i])
+			} else if value != nil {
+				ues.IdentityPublicKey = *value
+			}
+		case usereventsequence.FieldLastSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sequence", values[i])
+			} else if value.Valid {
+				ues.LastSequence = uint64(value.Int64)
+			}
+		default:
+			ues.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserEventSequence.
+// This includes values selected through modifiers, order, etc.
+func (ues *UserEventSequence) Value(name string) (ent.Value, error) {
+	return ues.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserEventSequence.
+// Note that you need to call UserEventSequence.Unwrap() before calling this method if this UserEventSequence
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ues *UserEventSequence) Update() *UserEventSequenceUpdateOne {
+	return NewUserEventSequenceClient(ues.config).UpdateOne(ues)
+}
+
+// Unwrap unwraps the UserEventSequence entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ues *UserEventSequence) Unwrap() *UserEventSequence {
+	_tx, ok := ues.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserEventSequence is not a transactional entity")
+	}
+	ues.config.driver = _tx.drv
+	return ues
+}
+
+// String implements the fmt.Stringer.
+func (ues *UserEventSequence) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserEventSequence(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ues.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(ues.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(ues.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("identity_public_key=")
+	builder.WriteString(fmt.Sprintf("%v", ues.IdentityPublicKey))
+	builder.WriteString(", ")
+	builder.WriteString("last_sequence=")
+	builder.WriteString(fmt.Sprintf("%v", ues.LastSequence))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserEventSequences is a parsable slice of UserEventSequence.
+type UserEventSequences []*UserEventSequence
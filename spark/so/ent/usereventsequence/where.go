@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package usereventsequence
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLTE(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// IdentityPublicKey applies equality check predicate on the "identity_public_key" field. It's identical to IdentityPublicKeyEQ.
+func IdentityPublicKey(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// LastSequence applies equality check predicate on the "last_sequence" field. It's identical to LastSequenceEQ.
+func LastSequence(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldLastSequence, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// IdentityPublicKeyEQ applies the EQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyEQ(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyNEQ applies the NEQ predicate on the "identity_public_key" field.
+func IdentityPublicKeyNEQ(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNEQ(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyIn applies the In predicate on the "identity_public_key" field.
+func IdentityPublicKeyIn(vs ...[]byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyNotIn applies the NotIn predicate on the "identity_public_key" field.
+func IdentityPublicKeyNotIn(vs ...[]byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNotIn(FieldIdentityPublicKey, vs...))
+}
+
+// IdentityPublicKeyGT applies the GT predicate on the "identity_public_key" field.
+func IdentityPublicKeyGT(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyGTE applies the GTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyGTE(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGTE(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLT applies the LT predicate on the "identity_public_key" field.
+func IdentityPublicKeyLT(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLT(FieldIdentityPublicKey, v))
+}
+
+// IdentityPublicKeyLTE applies the LTE predicate on the "identity_public_key" field.
+func IdentityPublicKeyLTE(v []byte) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLTE(FieldIdentityPublicKey, v))
+}
+
+// LastSequenceEQ applies the EQ predicate on the "last_sequence" field.
+func LastSequenceEQ(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldEQ(FieldLastSequence, v))
+}
+
+// LastSequenceNEQ applies the NEQ predicate on the "last_sequence" field.
+func LastSequenceNEQ(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNEQ(FieldLastSequence, v))
+}
+
+// LastSequenceIn applies the In predicate on the "last_sequence" field.
+func LastSequenceIn(vs ...uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldIn(FieldLastSequence, vs...))
+}
+
+// LastSequenceNotIn applies the NotIn predicate on the "last_sequence" field.
+func LastSequenceNotIn(vs ...uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldNotIn(FieldLastSequence, vs...))
+}
+
+// LastSequenceGT applies the GT predicate on the "last_sequence" field.
+func LastSequenceGT(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGT(FieldLastSequence, v))
+}
+
+// LastSequenceGTE applies the GTE predicate on the "last_sequence" field.
+func LastSequenceGTE(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldGTE(FieldLastSequence, v))
+}
+
+// LastSequenceLT applies the LT predicate on the "last_sequence" field.
+func LastSequenceLT(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLT(FieldLastSequence, v))
+}
+
+// LastSequenceLTE applies the LTE predicate on the "last_sequence" field.
+func LastSequenceLTE(v uint64) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.FieldLTE(FieldLastSequence, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.UserEventSequence) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.UserEventSequence) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.UserEventSequence) predicate.UserEventSequence {
+	return predicate.UserEventSequence(sql.NotPredicates(p))
+}
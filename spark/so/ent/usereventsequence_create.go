@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
+)
+
+// UserEventSequenceCreate is the builder for creating a UserEventSequence entity.
+type UserEventSequenceCreate struct {
+	config
+	mutation *UserEventSequenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (uesc *UserEventSequenceCreate) SetCreateTime(t time.Time) *UserEventSequenceCreate {
+	uesc.mutation.SetCreateTime(t)
+	return uesc
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (uesc *UserEventSequenceCreate) SetNillableCreateTime(t *time.Time) *UserEventSequenceCreate {
+	if t != nil {
+		uesc.SetCreateTime(*t)
+	}
+	return uesc
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (uesc *UserEventSequenceCreate) SetUpdateTime(t time.Time) *UserEventSequenceCreate {
+	uesc.mutation.SetUpdateTime(t)
+	return uesc
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (uesc *UserEventSequenceCreate) SetNillableUpdateTime(t *time.Time) *UserEventSequenceCreate {
+	if t != nil {
+		uesc.SetUpdateTime(*t)
+	}
+	return uesc
+}
+
+// SetIdentityPublicKey sets the "identity_public_key" field.
+func (uesc *UserEventSequenceCreate) SetIdentityPublicKey(b []byte) *UserEventSequenceCreate {
+	uesc.mutation.SetIdentityPublicKey(b)
+	return uesc
+}
+
+// SetLastSequence sets the "last_sequence" field.
+func (uesc *UserEventSequenceCreate) SetLastSequence(u uint64) *UserEventSequenceCreate {
+	uesc.mutation.SetLastSequence(u)
+	return uesc
+}
+
+// SetID sets the "id" field.
+func (uesc *UserEventSequenceCreate) SetID(u uuid.UUID) *UserEventSequenceCreate {
+	uesc.mutation.SetID(u)
+	return uesc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (uesc *UserEventSequenceCreate) SetNillableID(u *uuid.UUID) *UserEventSequenceCreate {
+	if u != nil {
+		uesc.SetID(*u)
+	}
+	return uesc
+}
+
+// Mutation returns the UserEventSequenceMutation object of the builder.
+func (uesc *UserEventSequenceCreate) Mutation() *UserEventSequenceMutation {
+	return uesc.mutation
+}
+
+// Save creates the UserEventSequence in the database.
+func (uesc *UserEventSequenceCreate) Save(ctx context.Context) (*UserEventSequence, error) {
+	uesc.defaults()
+	return withHooks(ctx, uesc.sqlSave, uesc.mutation, uesc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (uesc *UserEventSequenceCreate) SaveX(ctx context.Context) *UserEventSequence {
+	v, err := uesc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uesc *UserEventSequenceCreate) Exec(ctx context.Context) error {
+	_, err := uesc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uesc *UserEventSequenceCreate) ExecX(ctx context.Context) {
+	if err := uesc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uesc *UserEventSequenceCreate) defaults() {
+	if _, ok := uesc.mutation.CreateTime(); !ok {
+		v := usereventsequence.DefaultCreateTime()
+		uesc.mutation.SetCreateTime(v)
+	}
+	if _, ok := uesc.mutation.UpdateTime(); !ok {
+		v := usereventsequence.DefaultUpdateTime()
+		uesc.mutation.SetUpdateTime(v)
+	}
+	if _, ok := uesc.mutation.ID(); !ok {
+		v := usereventsequence.DefaultID()
+		uesc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uesc *UserEventSequenceCreate) check() error {
+	if _, ok := uesc.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "UserEventSequence.create_time"`)}
+	}
+	if _, ok := uesc.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "UserEventSequence.update_time"`)}
+	}
+	if _, ok := uesc.mutation.IdentityPublicKey(); !ok {
+		return &ValidationError{Name: "identity_public_key", err: errors.New(`ent: missing required field "UserEventSequence.identity_public_key"`)}
+	}
+	if v, ok := uesc.mutation.IdentityPublicKey(); ok {
+		if err := usereventsequence.IdentityPublicKeyValidator(v); err != nil {
+			return &ValidationError{Name: "identity_public_key", err: fmt.Errorf(`ent: validator failed for field "UserEventSequence.identity_public_key": %w`, err)}
+		}
+	}
+	if _, ok := uesc.mutation.LastSequence(); !ok {
+		return &ValidationError{Name: "last_sequence", err: errors.New(`ent: missing required field "UserEventSequence.last_sequence"`)}
+	}
+	return nil
+}
+
+func (uesc *UserEventSequenceCreate) sqlSave(ctx context.Context) (*UserEventSequence, error) {
+	if err := uesc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := uesc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, uesc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	uesc.mutation.id = &_node.ID
+	uesc.mutation.done = true
+	return _node, nil
+}
+
+func (uesc *UserEventSequenceCreate) createSpec() (*UserEventSequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserEventSequence{config: uesc.config}
+		_spec = sqlgraph.NewCreateSpec(usereventsequence.Table, sqlgraph.NewFieldSpec(usereventsequence.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = uesc.conflict
+	if id, ok := uesc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := uesc.mutation.CreateTime(); ok {
+		_spec.SetField(usereventsequence.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := uesc.mutation.UpdateTime(); ok {
+		_spec.SetField(usereventsequence.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := uesc.mutation.IdentityPublicKey(); ok {
+		_spec.SetField(usereventsequence.FieldIdentityPublicKey, field.TypeBytes, value)
+		_node.IdentityPublicKey = value
+	}
+	if value, ok := uesc.mutation.LastSequence(); ok {
+		_spec.SetField(usereventsequence.FieldLastSequence, field.TypeUint64, value)
+		_node.LastSequence = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserEventSequence.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserEventSequenceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (uesc *UserEventSequenceCreate) OnConflict(opts ...sql.ConflictOption) *UserEventSequenceUpsertOne {
+	uesc.conflict = opts
+	return &UserEventSequenceUpsertOne{
+		create: uesc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserEventSequence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uesc *UserEventSequenceCreate) OnConflictColumns(columns ...string) *UserEventSequenceUpsertOne {
+	uesc.conflict = append(uesc.conflict, sql.ConflictColumns(columns...))
+	return &UserEventSequenceUpsertOne{
+		create: uesc,
+	}
+}
+
+type (
+	// UserEventSequenceUpsertOne is the builder for "upsert"-ing
+	//  one UserEventSequence node.
+	UserEventSequenceUpsertOne struct {
+		create *UserEventSequenceCreate
+	}
+
+	// UserEventSequenceUpsert is the "OnConflict" setter.
+	UserEventSequenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *UserEventSequenceUpsert) SetUpdateTime(v time.Time) *UserEventSequenceUpsert {
+	u.Set(usereventsequence.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *UserEventSequenceUpsert) UpdateUpdateTime() *UserEventSequenceUpsert {
+	u.SetExcluded(usereventsequence.FieldUpdateTime)
+	return u
+}
+
+// SetLastSequence sets the "last_sequence" field.
+func (u *UserEventSequenceUpsert) SetLastSequence(v uint64) *UserEventSequenceUpsert {
+	u.Set(usereventsequence.FieldLastSequence, v)
+	return u
+}
+
+// UpdateLastSequence sets the "last_sequence" field to the value that was provided on create.
+func (u *UserEventSequenceUpsert) UpdateLastSequence() *UserEventSequenceUpsert {
+	u.SetExcluded(usereventsequence.FieldLastSequence)
+	return u
+}
+
+// AddLastSequence adds v to the "last_sequence" field.
+func (u *UserEventSequenceUpsert) AddLastSequence(v uint64) *UserEventSequenceUpsert {
+	u.Add(usereventsequence.FieldLastSequence, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserEventSequence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usereventsequence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserEventSequenceUpsertOne) UpdateNewValues() *UserEventSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usereventsequence.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(usereventsequence.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.IdentityPublicKey(); exists {
+			s.SetIgnore(usereventsequence.FieldIdentityPublicKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserEventSequence.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserEventSequenceUpsertOne) Ignore() *UserEventSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserEventSequenceUpsertOne) DoNothing() *UserEventSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserEventSequenceCreate.OnConflict
+// documentation for more info.
+func (u *UserEventSequenceUpsertOne) Update(set func(*UserEventSequenceUpsert)) *UserEventSequenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserEventSequenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *UserEventSequenceUpsertOne) SetUpdateTime(v time.Time) *UserEventSequenceUpsertOne {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *UserEventSequenceUpsertOne) UpdateUpdateTime() *UserEventSequenceUpsertOne {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetLastSequence sets the "last_sequence" field.
+func (u *UserEventSequenceUpsertOne) SetLastSequence(v uint64) *UserEventSequenceUpsertOne {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.SetLastSequence(v)
+	})
+}
+
+// AddLastSequence adds v to the "last_sequence" field.
+func (u *UserEventSequenceUpsertOne) AddLastSequence(v uint64) *UserEventSequenceUpsertOne {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.AddLastSequence(v)
+	})
+}
+
+// UpdateLastSequence sets the "last_sequence" field to the value that was provided on create.
+func (u *UserEventSequenceUpsertOne) UpdateLastSequence() *UserEventSequenceUpsertOne {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.UpdateLastSequence()
+	})
+}
+
+// Exec executes the query.
+func (u *UserEventSequenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserEventSequenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserEventSequenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserEventSequenceUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: UserEventSequenceUpsertOne.ID is not supported by MySQL driver. Use UserEventSequenceUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserEventSequenceUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserEventSequenceCreateBulk is the builder for creating many UserEventSequence entities in bulk.
+type UserEventSequenceCreateBulk struct {
+	config
+	err      error
+	builders []*UserEventSequenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserEventSequence entities in the database.
+func (uescb *UserEventSequenceCreateBulk) Save(ctx context.Context) ([]*UserEventSequence, error) {
+	if uescb.err != nil {
+		return nil, uescb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(uescb.builders))
+	nodes := make([]*UserEventSequence, len(uescb.builders))
+	mutators := make([]Mutator, len(uescb.builders))
+	for i := range uescb.builders {
+		func(i int, root context.Context) {
+			builder := uescb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserEventSequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, uescb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = uescb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, uescb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, uescb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uescb *UserEventSequenceCreateBulk) SaveX(ctx context.Context) []*UserEventSequence {
+	v, err := uescb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (uescb *UserEventSequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := uescb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uescb *UserEventSequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := uescb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserEventSequence.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserEventSequenceUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (uescb *UserEventSequenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserEventSequenceUpsertBulk {
+	uescb.conflict = opts
+	return &UserEventSequenceUpsertBulk{
+		create: uescb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserEventSequence.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (uescb *UserEventSequenceCreateBulk) OnConflictColumns(columns ...string) *UserEventSequenceUpsertBulk {
+	uescb.conflict = append(uescb.conflict, sql.ConflictColumns(columns...))
+	return &UserEventSequenceUpsertBulk{
+		create: uescb,
+	}
+}
+
+// UserEventSequenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserEventSequence nodes.
+type UserEventSequenceUpsertBulk struct {
+	create *UserEventSequenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserEventSequence.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usereventsequence.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserEventSequenceUpsertBulk) UpdateNewValues() *UserEventSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usereventsequence.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(usereventsequence.FieldCreateTime)
+			}
+			if _, exists := b.mutation.IdentityPublicKey(); exists {
+				s.SetIgnore(usereventsequence.FieldIdentityPublicKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserEventSequence.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserEventSequenceUpsertBulk) Ignore() *UserEventSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserEventSequenceUpsertBulk) DoNothing() *UserEventSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserEventSequenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserEventSequenceUpsertBulk) Update(set func(*UserEventSequenceUpsert)) *UserEventSequenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserEventSequenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *UserEventSequenceUpsertBulk) SetUpdateTime(v time.Time) *UserEventSequenceUpsertBulk {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *UserEventSequenceUpsertBulk) UpdateUpdateTime() *UserEventSequenceUpsertBulk {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetLastSequence sets the "last_sequence" field.
+func (u *UserEventSequenceUpsertBulk) SetLastSequence(v uint64) *UserEventSequenceUpsertBulk {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.SetLastSequence(v)
+	})
+}
+
+// AddLastSequence adds v to the "last_sequence" field.
+func (u *UserEventSequenceUpsertBulk) AddLastSequence(v uint64) *UserEventSequenceUpsertBulk {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.AddLastSequence(v)
+	})
+}
+
+// UpdateLastSequence sets the "last_sequence" field to the value that was provided on create.
+func (u *UserEventSequenceUpsertBulk) UpdateLastSequence() *UserEventSequenceUpsertBulk {
+	return u.Update(func(s *UserEventSequenceUpsert) {
+		s.UpdateLastSequence()
+	})
+}
+
+// Exec executes the query.
+func (u *UserEventSequenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserEventSequenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserEventSequenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserEventSequenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
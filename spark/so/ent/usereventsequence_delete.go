@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
+)
+
+// UserEventSequenceDelete is the builder for deleting a UserEventSequence entity.
+type UserEventSequenceDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserEventSequenceMutation
+}
+
+// Where appends a list predicates to the UserEventSequenceDelete builder.
+func (uesd *UserEventSequenceDelete) Where(ps ...predicate.UserEventSequence) *UserEventSequenceDelete {
+	uesd.mutation.Where(ps...)
+	return uesd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (uesd *UserEventSequenceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, uesd.sqlExec, uesd.mutation, uesd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uesd *UserEventSequenceDelete) ExecX(ctx context.Context) int {
+	n, err := uesd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (uesd *UserEventSequenceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usereventsequence.Table, sqlgraph.NewFieldSpec(usereventsequence.FieldID, field.TypeUUID))
+	if ps := uesd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, uesd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	uesd.mutation.done = true
+	return affected, err
+}
+
+// UserEventSequenceDeleteOne is the builder for deleting a single UserEventSequence entity.
+type UserEventSequenceDeleteOne struct {
+	uesd *UserEventSequenceDelete
+}
+
+// Where appends a list predicates to the UserEventSequenceDelete builder.
+func (uesdo *UserEventSequenceDeleteOne) Where(ps ...predicate.UserEventSequence) *UserEventSequenceDeleteOne {
+	uesdo.uesd.mutation.Where(ps...)
+	return uesdo
+}
+
+// Exec executes the deletion query.
+func (uesdo *UserEventSequenceDeleteOne) Exec(ctx context.Context) error {
+	n, err := uesdo.uesd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usereventsequence.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uesdo *UserEventSequenceDeleteOne) ExecX(ctx context.Context) {
+	if err := uesdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
+)
+
+// UserEventSequenceQuery is the builder for querying UserEventSequence entities.
+type UserEventSequenceQuery struct {
+	config
+	ctx        *QueryContext
+	order      []usereventsequence.OrderOption
+	inters     []Interceptor
+	predicates []predicate.UserEventSequence
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the UserEventSequenceQuery builder.
+func (uesq *UserEventSequenceQuery) Where(ps ...predicate.UserEventSequence) *UserEventSequenceQuery {
+	uesq.predicates = append(uesq.predicates, ps...)
+	return uesq
+}
+
+// Limit the number of records to be returned by this query.
+func (uesq *UserEventSequenceQuery) Limit(limit int) *UserEventSequenceQuery {
+	uesq.ctx.Limit = &limit
+	return uesq
+}
+
+// Offset to start from.
+func (uesq *UserEventSequenceQuery) Offset(offset int) *UserEventSequenceQuery {
+	uesq.ctx.Offset = &offset
+	return uesq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (uesq *UserEventSequenceQuery) Unique(unique bool) *UserEventSequenceQuery {
+	uesq.ctx.Unique = &unique
+	return uesq
+}
+
+// Order specifies how the records should be ordered.
+func (uesq *UserEventSequenceQuery) Order(o ...usereventsequence.OrderOption) *UserEventSequenceQuery {
+	uesq.order = append(uesq.order, o...)
+	return uesq
+}
+
+// First returns the first UserEventSequence entity from the query.
+// Returns a *NotFoundError when no UserEventSequence was found.
+func (uesq *UserEventSequenceQuery) First(ctx context.Context) (*UserEventSequence, error) {
+	nodes, err := uesq.Limit(1).All(setContextOp(ctx, uesq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{usereventsequence.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) FirstX(ctx context.Context) *UserEventSequence {
+	node, err := uesq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first UserEventSequence ID from the query.
+// Returns a *NotFoundError when no UserEventSequence ID was found.
+func (uesq *UserEventSequenceQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uesq.Limit(1).IDs(setContextOp(ctx, uesq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{usereventsequence.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := uesq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single UserEventSequence entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one UserEventSequence entity is found.
+// Returns a *NotFoundError when no UserEventSequence entities are found.
+func (uesq *UserEventSequenceQuery) Only(ctx context.Context) (*UserEventSequence, error) {
+	nodes, err := uesq.Limit(2).All(setContextOp(ctx, uesq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{usereventsequence.Label}
+	default:
+		return nil, &NotSingularError{usereventsequence.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) OnlyX(ctx context.Context) *UserEventSequence {
+	node, err := uesq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only UserEventSequence ID in the query.
+// Returns a *NotSingularError when more than one UserEventSequence ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (uesq *UserEventSequenceQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = uesq.Limit(2).IDs(setContextOp(ctx, uesq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{usereventsequence.Label}
+	default:
+		err = &NotSingularError{usereventsequence.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := uesq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of UserEventSequences.
+func (uesq *UserEventSequenceQuery) All(ctx context.Context) ([]*UserEventSequence, error) {
+	ctx = setContextOp(ctx, uesq.ctx, ent.OpQueryAll)
+	if err := uesq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*UserEventSequence, *UserEventSequenceQuery]()
+	return withInterceptors[[]*UserEventSequence](ctx, uesq, qr, uesq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) AllX(ctx context.Context) []*UserEventSequence {
+	nodes, err := uesq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of UserEventSequence IDs.
+func (uesq *UserEventSequenceQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if uesq.ctx.Unique == nil && uesq.path != nil {
+		uesq.Unique(true)
+	}
+	ctx = setContextOp(ctx, uesq.ctx, ent.OpQueryIDs)
+	if err = uesq.Select(usereventsequence.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := uesq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (uesq *UserEventSequenceQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, uesq.ctx, ent.OpQueryCount)
+	if err := uesq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, uesq, querierCount[*UserEventSequenceQuery](), uesq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) CountX(ctx context.Context) int {
+	count, err := uesq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (uesq *UserEventSequenceQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, uesq.ctx, ent.OpQueryExist)
+	switch _, err := uesq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (uesq *UserEventSequenceQuery) ExistX(ctx context.Context) bool {
+	exist, err := uesq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the UserEventSequenceQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (uesq *UserEventSequenceQuery) Clone() *UserEventSequenceQuery {
+	if uesq == nil {
+		return nil
+	}
+	return &UserEventSequenceQuery{
+		config:     uesq.config,
+		ctx:        uesq.ctx.Clone(),
+		order:      append([]usereventsequence.OrderOption{}, uesq.order...),
+		inters:     append([]Interceptor{}, uesq.inters...),
+		predicates: append([]predicate.UserEventSequence{}, uesq.predicates...),
+		// clone intermediate query.
+		sql:  uesq.sql.Clone(),
+		path: uesq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.UserEventSequence.Query().
+//		GroupBy(usereventsequence.FieldCreateTime).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (uesq *UserEventSequenceQuery) GroupBy(field string, fields ...string) *UserEventSequenceGroupBy {
+	uesq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserEventSequenceGroupBy{build: uesq}
+	grbuild.flds = &uesq.ctx.Fields
+	grbuild.label = usereventsequence.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreateTime time.Time `json:"create_time,omitempty"`
+//	}
+//
+//	client.UserEventSequence.Query().
+//		Select(usereventsequence.FieldCreateTime).
+//		Scan(ctx, &v)
+func (uesq *UserEventSequenceQuery) Select(fields ...string) *UserEventSequenceSelect {
+	uesq.ctx.Fields = append(uesq.ctx.Fields, fields...)
+	sbuild := &UserEventSequenceSelect{UserEventSequenceQuery: uesq}
+	sbuild.label = usereventsequence.Label
+	sbuild.flds, sbuild.scan = &uesq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserEventSequenceSelect configured with the given aggregations.
+func (uesq *UserEventSequenceQuery) Aggregate(fns ...AggregateFunc) *UserEventSequenceSelect {
+	return uesq.Select().Aggregate(fns...)
+}
+
+func (uesq *UserEventSequenceQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range uesq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, uesq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range uesq.ctx.Fields {
+		if !usereventsequence.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if uesq.path != nil {
+		prev, err := uesq.path(ctx)
+		if err != nil {
+			return err
+		}
+		uesq.sql = prev
+	}
+	return nil
+}
+
+func (uesq *UserEventSequenceQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserEventSequence, error) {
+	var (
+		nodes = []*UserEventSequence{}
+		_spec = uesq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*UserEventSequence).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &UserEventSequence{config: uesq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(uesq.modifiers) > 0 {
+		_spec.Modifiers = uesq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, uesq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (uesq *UserEventSequenceQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := uesq.querySpec()
+	if len(uesq.modifiers) > 0 {
+		_spec.Modifiers = uesq.modifiers
+	}
+	_spec.Node.Columns = uesq.ctx.Fields
+	if len(uesq.ctx.Fields) > 0 {
+		_spec.Unique = uesq.ctx.Unique != nil && *uesq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, uesq.driver, _spec)
+}
+
+func (uesq *UserEventSequenceQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(usereventsequence.Table, usereventsequence.Columns, sqlgraph.NewFieldSpec(usereventsequence.FieldID, field.TypeUUID))
+	_spec.From = uesq.sql
+	if unique := uesq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if uesq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := uesq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usereventsequence.FieldID)
+		for i := range fields {
+			if fields[i] != usereventsequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := uesq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := uesq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := uesq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := uesq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (uesq *UserEventSequenceQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(uesq.driver.Dialect())
+	t1 := builder.Table(usereventsequence.Table)
+	columns := uesq.ctx.Fields
+	if len(columns) == 0 {
+		columns = usereventsequence.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if uesq.sql != nil {
+		selector = uesq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if uesq.ctx.Unique != nil && *uesq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range uesq.modifiers {
+		m(selector)
+	}
+	for _, p := range uesq.predicates {
+		p(selector)
+	}
+	for _, p := range uesq.order {
+		p(selector)
+	}
+	if offset := uesq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := uesq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (uesq *UserEventSequenceQuery) ForUpdate(opts ...sql.LockOption) *UserEventSequenceQuery {
+	if uesq.driver.Dialect() == dialect.Postgres {
+		uesq.Unique(false)
+	}
+	uesq.modifiers = append(uesq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return uesq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (uesq *UserEventSequenceQuery) ForShare(opts ...sql.LockOption) *UserEventSequenceQuery {
+	if uesq.driver.Dialect() == dialect.Postgres {
+		uesq.Unique(false)
+	}
+	uesq.modifiers = append(uesq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return uesq
+}
+
+// UserEventSequenceGroupBy is the group-by builder for UserEventSequence entities.
+type UserEventSequenceGroupBy struct {
+	selector
+	build *UserEventSequenceQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (uesgb *UserEventSequenceGroupBy) Aggregate(fns ...AggregateFunc) *UserEventSequenceGroupBy {
+	uesgb.fns = append(uesgb.fns, fns...)
+	return uesgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uesgb *UserEventSequenceGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uesgb.build.ctx, ent.OpQueryGroupBy)
+	if err := uesgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserEventSequenceQuery, *UserEventSequenceGroupBy](ctx, uesgb.build, uesgb, uesgb.build.inters, v)
+}
+
+func (uesgb *UserEventSequenceGroupBy) sqlScan(ctx context.Context, root *UserEventSequenceQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(uesgb.fns))
+	for _, fn := range uesgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*uesgb.flds)+len(uesgb.fns))
+		for _, f := range *uesgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*uesgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uesgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// UserEventSequenceSelect is the builder for selecting fields of UserEventSequence entities.
+type UserEventSequenceSelect struct {
+	*UserEventSequenceQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (uess *UserEventSequenceSelect) Aggregate(fns ...AggregateFunc) *UserEventSequenceSelect {
+	uess.fns = append(uess.fns, fns...)
+	return uess
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (uess *UserEventSequenceSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, uess.ctx, ent.OpQuerySelect)
+	if err := uess.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*UserEventSequenceQuery, *UserEventSequenceSelect](ctx, uess.UserEventSequenceQuery, uess, uess.inters, v)
+}
+
+func (uess *UserEventSequenceSelect) sqlScan(ctx context.Context, root *UserEventSequenceQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(uess.fns))
+	for _, fn := range uess.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*uess.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := uess.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
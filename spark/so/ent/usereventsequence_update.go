@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
+)
+
+// UserEventSequenceUpdate is the builder for updating UserEventSequence entities.
+type UserEventSequenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserEventSequenceMutation
+}
+
+// Where appends a list predicates to the UserEventSequenceUpdate builder.
+func (uesu *UserEventSequenceUpdate) Where(ps ...predicate.UserEventSequence) *UserEventSequenceUpdate {
+	uesu.mutation.Where(ps...)
+	return uesu
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (uesu *UserEventSequenceUpdate) SetUpdateTime(t time.Time) *UserEventSequenceUpdate {
+	uesu.mutation.SetUpdateTime(t)
+	return uesu
+}
+
+// SetLastSequence sets the "last_sequence" field.
+func (uesu *UserEventSequenceUpdate) SetLastSequence(u uint64) *UserEventSequenceUpdate {
+	uesu.mutation.ResetLastSequence()
+	uesu.mutation.SetLastSequence(u)
+	return uesu
+}
+
+// SetNillableLastSequence sets the "last_sequence" field if the given value is not nil.
+func (uesu *UserEventSequenceUpdate) SetNillableLastSequence(u *uint64) *UserEventSequenceUpdate {
+	if u != nil {
+		uesu.SetLastSequence(*u)
+	}
+	return uesu
+}
+
+// AddLastSequence adds u to the "last_sequence" field.
+func (uesu *UserEventSequenceUpdate) AddLastSequence(u int64) *UserEventSequenceUpdate {
+	uesu.mutation.AddLastSequence(u)
+	return uesu
+}
+
+// Mutation returns the UserEventSequenceMutation object of the builder.
+func (uesu *UserEventSequenceUpdate) Mutation() *UserEventSequenceMutation {
+	return uesu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uesu *UserEventSequenceUpdate) Save(ctx context.Context) (int, error) {
+	uesu.defaults()
+	return withHooks(ctx, uesu.sqlSave, uesu.mutation, uesu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uesu *UserEventSequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := uesu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uesu *UserEventSequenceUpdate) Exec(ctx context.Context) error {
+	_, err := uesu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uesu *UserEventSequenceUpdate) ExecX(ctx context.Context) {
+	if err := uesu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uesu *UserEventSequenceUpdate) defaults() {
+	if _, ok := uesu.mutation.UpdateTime(); !ok {
+		v := usereventsequence.UpdateDefaultUpdateTime()
+		uesu.mutation.SetUpdateTime(v)
+	}
+}
+
+func (uesu *UserEventSequenceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usereventsequence.Table, usereventsequence.Columns, sqlgraph.NewFieldSpec(usereventsequence.FieldID, field.TypeUUID))
+	if ps := uesu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uesu.mutation.UpdateTime(); ok {
+		_spec.SetField(usereventsequence.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := uesu.mutation.LastSequence(); ok {
+		_spec.SetField(usereventsequence.FieldLastSequence, field.TypeUint64, value)
+	}
+	if value, ok := uesu.mutation.AddedLastSequence(); ok {
+		_spec.AddField(usereventsequence.FieldLastSequence, field.TypeUint64, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uesu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usereventsequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uesu.mutation.done = true
+	return n, nil
+}
+
+// UserEventSequenceUpdateOne is the builder for updating a single UserEventSequence entity.
+type UserEventSequenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserEventSequenceMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (uesuo *UserEventSequenceUpdateOne) SetUpdateTime(t time.Time) *UserEventSequenceUpdateOne {
+	uesuo.mutation.SetUpdateTime(t)
+	return uesuo
+}
+
+// SetLastSequence sets the "last_sequence" field.
+func (uesuo *UserEventSequenceUpdateOne) SetLastSequence(u uint64) *UserEventSequenceUpdateOne {
+	uesuo.mutation.ResetLastSequence()
+	uesuo.mutation.SetLastSequence(u)
+	return uesuo
+}
+
+// SetNillableLastSequence sets the "last_sequence" field if the given value is not nil.
+func (uesuo *UserEventSequenceUpdateOne) SetNillableLastSequence(u *uint64) *UserEventSequenceUpdateOne {
+	if u != nil {
+		uesuo.SetLastSequence(*u)
+	}
+	return uesuo
+}
+
+// AddLastSequence adds u to the "last_sequence" field.
+func (uesuo *UserEventSequenceUpdateOne) AddLastSequence(u int64) *UserEventSequenceUpdateOne {
+	uesuo.mutation.AddLastSequence(u)
+	return uesuo
+}
+
+// Mutation returns the UserEventSequenceMutation object of the builder.
+func (uesuo *UserEventSequenceUpdateOne) Mutation() *UserEventSequenceMutation {
+	return uesuo.mutation
+}
+
+// Where appends a list predicates to the UserEventSequenceUpdate builder.
+func (uesuo *UserEventSequenceUpdateOne) Where(ps ...predicate.UserEventSequence) *UserEventSequenceUpdateOne {
+	uesuo.mutation.Where(ps...)
+	return uesuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uesuo *UserEventSequenceUpdateOne) Select(field string, fields ...string) *UserEventSequenceUpdateOne {
+	uesuo.fields = append([]string{field}, fields...)
+	return uesuo
+}
+
+// Save executes the query and returns the updated UserEventSequence entity.
+func (uesuo *UserEventSequenceUpdateOne) Save(ctx context.Context) (*UserEventSequence, error) {
+	uesuo.defaults()
+	return withHooks(ctx, uesuo.sqlSave, uesuo.mutation, uesuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uesuo *UserEventSequenceUpdateOne) SaveX(ctx context.Context) *UserEventSequence {
+	node, err := uesuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uesuo *UserEventSequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := uesuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uesuo *UserEventSequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := uesuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (uesuo *UserEventSequenceUpdateOne) defaults() {
+	if _, ok := uesuo.mutation.UpdateTime(); !ok {
+		v := usereventsequence.UpdateDefaultUpdateTime()
+		uesuo.mutation.SetUpdateTime(v)
+	}
+}
+
+func (uesuo *UserEventSequenceUpdateOne) sqlSave(ctx context.Context) (_node *UserEventSequence, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usereventsequence.Table, usereventsequence.Columns, sqlgraph.NewFieldSpec(usereventsequence.FieldID, field.TypeUUID))
+	id, ok := uesuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserEventSequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uesuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usereventsequence.FieldID)
+		for _, f := range fields {
+			if !usereventsequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usereventsequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uesuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uesuo.mutation.UpdateTime(); ok {
+		_spec.SetField(usereventsequence.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := uesuo.mutation.LastSequence(); ok {
+		_spec.SetField(usereventsequence.FieldLastSequence, field.TypeUint64, value)
+	}
+	if value, ok := uesuo.mutation.AddedLastSequence(); ok {
+		_spec.AddField(usereventsequence.FieldLastSequence, field.TypeUint64, value)
+	}
+	_node = &UserEventSequence{config: uesuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uesuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usereventsequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uesuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,166 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	pbadmin "github.com/lightsparkdev/spark/proto/spark_admin"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/knobs"
+	"github.com/lightsparkdev/spark/so/task"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SparkAdminServer is the operator-facing admin service. Every call must be authenticated as the
+// admin identity key from the config.
+type SparkAdminServer struct {
+	pbadmin.UnimplementedSparkAdminServiceServer
+	config     *so.Config
+	rootClient *ent.Client
+}
+
+// NewSparkAdminServer creates a new SparkAdminServer.
+func NewSparkAdminServer(config *so.Config, rootClient *ent.Client) *SparkAdminServer {
+	return &SparkAdminServer{config: config, rootClient: rootClient}
+}
+
+// authorize checks that the session belongs to the admin identity key.
+func (s *SparkAdminServer) authorize(ctx context.Context) error {
+	if s.config.AdminIdentityPublicKey.IsZero() {
+		return status.Error(codes.PermissionDenied, "admin service is not enabled")
+	}
+	session, err := authn.GetSessionFromContext(ctx)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "no valid session found: %v", err)
+	}
+	if !session.IdentityPublicKey().Equals(s.config.AdminIdentityPublicKey) {
+		return status.Error(codes.PermissionDenied, "session identity is not the admin identity")
+	}
+	return nil
+}
+
+// ListTasks lists the scheduled tasks with their last run and whether they are paused.
+func (s *SparkAdminServer) ListTasks(ctx context.Context, _ *pbadmin.ListTasksRequest) (*pbadmin.ListTasksResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	tasks := task.AllScheduledTasks()
+	resp := &pbadmin.ListTasksResponse{Tasks: make([]*pbadmin.TaskStatus, 0, len(tasks))}
+	for _, scheduled := range tasks {
+		resp.Tasks = append(resp.Tasks, marshalTaskStatus(&scheduled))
+	}
+	return resp, nil
+}
+
+// TriggerTask runs a scheduled task immediately and returns its status once it has finished.
+func (s *SparkAdminServer) TriggerTask(ctx context.Context, req *pbadmin.TriggerTaskRequest) (*pbadmin.TaskStatus, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	selected, err := scheduledTask(req.GetTaskName())
+	if err != nil {
+		return nil, err
+	}
+	// The outcome is reported in the returned status, so the error does not fail the call.
+	_ = selected.RunOnce(s.config, s.rootClient)
+	return marshalTaskStatus(selected), nil
+}
+
+// PauseTask stops a scheduled task from running on its schedule until it is resumed.
+func (s *SparkAdminServer) PauseTask(ctx context.Context, req *pbadmin.PauseTaskRequest) (*pbadmin.TaskStatus, error) {
+	return s.setTaskPaused(ctx, req.GetTaskName(), true)
+}
+
+// ResumeTask resumes a paused task.
+func (s *SparkAdminServer) ResumeTask(ctx context.Context, req *pbadmin.ResumeTaskRequest) (*pbadmin.TaskStatus, error) {
+	return s.setTaskPaused(ctx, req.GetTaskName(), false)
+}
+
+func (s *SparkAdminServer) setTaskPaused(ctx context.Context, taskName string, paused bool) (*pbadmin.TaskStatus, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	selected, err := scheduledTask(taskName)
+	if err != nil {
+		return nil, err
+	}
+	task.SetTaskPaused(selected.Name, paused)
+	return marshalTaskStatus(selected), nil
+}
+
+// GetKnobs returns the current knob values.
+func (s *SparkAdminServer) GetKnobs(ctx context.Context, req *pbadmin.GetKnobsRequest) (*pbadmin.GetKnobsResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	values := knobs.GetKnobsService(ctx).Values()
+	if len(req.GetKnobs()) == 0 {
+		return &pbadmin.GetKnobsResponse{Values: values}, nil
+	}
+	resp := &pbadmin.GetKnobsResponse{Values: make(map[string]float64)}
+	for _, knob := range req.GetKnobs() {
+		if value, ok := values[knob]; ok {
+			resp.Values[knob] = value
+		}
+	}
+	return resp, nil
+}
+
+// GetConfig returns the operator configuration with secrets redacted.
+func (s *SparkAdminServer) GetConfig(ctx context.Context, _ *pbadmin.GetConfigRequest) (*pbadmin.GetConfigResponse, error) {
+	if err := s.authorize(ctx); err != nil {
+		return nil, err
+	}
+	configJSON, err := s.config.RedactedJSON()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal config: %v", err)
+	}
+	return &pbadmin.GetConfigResponse{ConfigJson: string(configJSON)}, nil
+}
+
+func scheduledTask(taskName string) (*task.ScheduledTaskSpec, error) {
+	if strings.TrimSpace(taskName) == "" {
+		return nil, status.Error(codes.InvalidArgument, "task name is required")
+	}
+	for _, t := range task.AllScheduledTasks() {
+		if t.Name == taskName {
+			return &t, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "unknown task: %s", taskName)
+}
+
+func marshalTaskStatus(t *task.ScheduledTaskSpec) *pbadmin.TaskStatus {
+	taskStatus := task.GetTaskStatus(t.Name)
+	resp := &pbadmin.TaskStatus{
+		Name:              t.Name,
+		ExecutionInterval: durationpb.New(t.ExecutionInterval),
+		Disabled:          t.Disabled,
+		Paused:            taskStatus.Paused,
+		Running:           taskStatus.Running,
+	}
+	if taskStatus.LastRunStartTime.IsZero() {
+		return resp
+	}
+	resp.LastRunStartTime = timestamppb.New(taskStatus.LastRunStartTime)
+	resp.LastRunEndTime = timestamppb.New(taskStatus.LastRunEndTime)
+	switch err := taskStatus.LastRunError; {
+	case err == nil:
+		resp.LastRunResult = pbadmin.TaskRunResult_TASK_RUN_RESULT_SUCCESS
+	case task.IsTaskTimeout(err):
+		resp.LastRunResult = pbadmin.TaskRunResult_TASK_RUN_RESULT_TIMEOUT
+		resp.LastRunError = err.Error()
+	case task.IsTaskPanic(err):
+		resp.LastRunResult = pbadmin.TaskRunResult_TASK_RUN_RESULT_PANIC
+		resp.LastRunError = err.Error()
+	default:
+		resp.LastRunResult = pbadmin.TaskRunResult_TASK_RUN_RESULT_FAILURE
+		resp.LastRunError = err.Error()
+	}
+	return resp
+}
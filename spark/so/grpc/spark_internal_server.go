@@ -128,6 +128,12 @@ func (s *SparkInternalServer) InitiateTransfer(ctx context.Context, req *pb.Init
 	return &emptypb.Empty{}, transferHandler.InitiateTransfer(ctx, req)
 }
 
+// InitiateBatchTransfer initiates all transfers of a batch transfer.
+func (s *SparkInternalServer) InitiateBatchTransfer(ctx context.Context, req *pb.InitiateBatchTransferRequest) (*emptypb.Empty, error) {
+	transferHandler := handler.NewInternalTransferHandler(s.config)
+	return &emptypb.Empty{}, transferHandler.InitiateBatchTransfer(ctx, req)
+}
+
 // InitiateTransfer initiates a transfer by creating transfer and transfer_leaf
 func (s *SparkInternalServer) DeliverSenderKeyTweak(ctx context.Context, req *pb.DeliverSenderKeyTweakRequest) (*emptypb.Empty, error) {
 	transferHandler := handler.NewInternalTransferHandler(s.config)
@@ -227,6 +233,26 @@ func (s *SparkInternalServer) Gossip(ctx context.Context, req *pbgossip.GossipMe
 	return &emptypb.Empty{}, gossipHandler.HandleGossipMessage(ctx, req, false)
 }
 
+func (s *SparkInternalServer) QueryFailedGossip(ctx context.Context, req *pb.QueryFailedGossipRequest) (*pb.QueryFailedGossipResponse, error) {
+	sendGossipHandler := handler.NewSendGossipHandler(s.config)
+	return sendGossipHandler.QueryFailedGossip(ctx, req)
+}
+
+func (s *SparkInternalServer) ReplayFailedGossip(ctx context.Context, req *pb.ReplayFailedGossipRequest) (*emptypb.Empty, error) {
+	sendGossipHandler := handler.NewSendGossipHandler(s.config)
+	return &emptypb.Empty{}, sendGossipHandler.ReplayFailedGossip(ctx, req)
+}
+
+func (s *SparkInternalServer) QueryConsistencySnapshot(ctx context.Context, req *pb.QueryConsistencySnapshotRequest) (*pb.ConsistencySnapshot, error) {
+	consistencyAuditHandler := handler.NewConsistencyAuditHandler(s.config)
+	return consistencyAuditHandler.QueryConsistencySnapshot(ctx, req)
+}
+
+func (s *SparkInternalServer) AuditConsistency(ctx context.Context, req *pb.AuditConsistencyRequest) (*pb.ConsistencyReport, error) {
+	consistencyAuditHandler := handler.NewConsistencyAuditHandler(s.config)
+	return consistencyAuditHandler.AuditConsistency(ctx, req)
+}
+
 func (s *SparkInternalServer) FixKeyshare(ctx context.Context, req *pb.FixKeyshareRequest) (*emptypb.Empty, error) {
 	h := handler.NewFixKeyshareHandler(s.config)
 	return &emptypb.Empty{}, h.FixKeyshare(ctx, req)
@@ -14,6 +14,7 @@ import (
 	"github.com/lightsparkdev/spark/common/logging"
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/handler"
 	events "github.com/lightsparkdev/spark/so/stream"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -24,14 +25,15 @@ import (
 type SparkServer struct {
 	pb.UnimplementedSparkServiceServer
 	config     *so.Config
+	dbClient   *ent.Client
 	mockAction *common.MockAction
 }
 
 var emptyResponse = &emptypb.Empty{}
 
 // NewSparkServer creates a new SparkServer.
-func NewSparkServer(config *so.Config, mockAction *common.MockAction) *SparkServer {
-	return &SparkServer{config: config, mockAction: mockAction}
+func NewSparkServer(config *so.Config, dbClient *ent.Client, mockAction *common.MockAction) *SparkServer {
+	return &SparkServer{config: config, dbClient: dbClient, mockAction: mockAction}
 }
 
 // GenerateDepositAddress generates a deposit address for the given public key.
@@ -89,6 +91,22 @@ func (s *SparkServer) StartTransferV2(ctx context.Context, req *pb.StartTransfer
 	return transferHander.StartTransferV2(ctx, req)
 }
 
+// StartBatchTransfer initiates a batch of transfers from sender to several receivers.
+func (s *SparkServer) StartBatchTransfer(ctx context.Context, req *pb.StartBatchTransferRequest) (*pb.StartBatchTransferResponse, error) {
+	if len(req.Transfers) > 0 {
+		ctx, _ = logging.WithIdentityPubkey(ctx, req.Transfers[0].OwnerIdentityPublicKey)
+	}
+	transferHander := handler.NewTransferHandler(s.config)
+	return transferHander.StartBatchTransfer(ctx, req)
+}
+
+// StartHtlcTransfer initiates a transfer that the receiver claims by revealing a preimage.
+func (s *SparkServer) StartHtlcTransfer(ctx context.Context, req *pb.StartHtlcTransferRequest) (*pb.StartTransferResponse, error) {
+	ctx, _ = logging.WithIdentityPubkey(ctx, req.GetTransfer().GetOwnerIdentityPublicKey())
+	transferHander := handler.NewTransferHandler(s.config)
+	return transferHander.StartHtlcTransfer(ctx, req)
+}
+
 // FinalizeTransfer completes a transfer from sender.
 func (s *SparkServer) FinalizeTransfer(ctx context.Context, req *pb.FinalizeTransferRequest) (*pb.FinalizeTransferResponse, error) {
 	ctx, _ = logging.WithIdentityPubkey(ctx, req.OwnerIdentityPublicKey)
@@ -379,7 +397,7 @@ func (s *SparkServer) SubscribeToEvents(req *pb.SubscribeToEventsRequest, st pb.
 	if err != nil {
 		return fmt.Errorf("invalid identity public key: %w", err)
 	}
-	return events.SubscribeToEvents(idPubKey, st)
+	return events.SubscribeToEvents(s.dbClient, idPubKey, req.ResumeFromSequence, st)
 }
 
 // InitiateUtxoSwap swaps a Spark tree node in exchange for a UTXO.
@@ -422,3 +440,9 @@ func (s *SparkServer) QuerySparkInvoices(ctx context.Context, req *pb.QuerySpark
 	invoiceHandler := handler.NewSparkInvoiceHandler(s.config)
 	return invoiceHandler.QuerySparkInvoices(ctx, req)
 }
+
+// QueryUnilateralExitKit returns the transactions to broadcast to unilaterally exit a leaf.
+func (s *SparkServer) QueryUnilateralExitKit(ctx context.Context, req *pb.QueryUnilateralExitKitRequest) (*pb.QueryUnilateralExitKitResponse, error) {
+	treeQueryHandler := handler.NewTreeQueryHandler(s.config)
+	return treeQueryHandler.QueryUnilateralExitKit(ctx, req)
+}
@@ -68,6 +68,24 @@ func (s *SparkTokenServer) QueryTokenOutputs(ctx context.Context, req *tokenpb.Q
 	return resp, err
 }
 
+// QueryTokenHolders returns the holders of a token and their balances.
+func (s *SparkTokenServer) QueryTokenHolders(ctx context.Context, req *tokenpb.QueryTokenHoldersRequest) (*tokenpb.QueryTokenHoldersResponse, error) {
+	queryTokenHandler := tokens.NewQueryTokenHandler(s.soConfig)
+	return queryTokenHandler.QueryTokenHolders(ctx, req)
+}
+
+// QueryTokenSupply returns supply statistics for the given tokens.
+func (s *SparkTokenServer) QueryTokenSupply(ctx context.Context, req *tokenpb.QueryTokenSupplyRequest) (*tokenpb.QueryTokenSupplyResponse, error) {
+	queryTokenHandler := tokens.NewQueryTokenHandler(s.soConfig)
+	return queryTokenHandler.QueryTokenSupply(ctx, req)
+}
+
+// QueryTokenFreezes returns the active freezes of a token.
+func (s *SparkTokenServer) QueryTokenFreezes(ctx context.Context, req *tokenpb.QueryTokenFreezesRequest) (*tokenpb.QueryTokenFreezesResponse, error) {
+	queryTokenHandler := tokens.NewQueryTokenHandler(s.soConfig)
+	return queryTokenHandler.QueryTokenFreezes(ctx, req)
+}
+
 // FreezeTokens prevents transfer of all outputs owned now and in the future by the provided owner public key.
 // Unfreeze undos this operation and re-enables transfers.
 func (s *SparkTokenServer) FreezeTokens(
@@ -81,3 +99,30 @@ func (s *SparkTokenServer) FreezeTokens(
 	}
 	return sparkRes, nil
 }
+
+// RotateIssuerKey replaces the key allowed to mint and freeze a token.
+func (s *SparkTokenServer) RotateIssuerKey(
+	ctx context.Context,
+	req *tokenpb.RotateIssuerKeyRequest,
+) (*tokenpb.RotateIssuerKeyResponse, error) {
+	rotateIssuerKeyHandler := tokens.NewRotateIssuerKeyHandler(s.soConfig)
+	return rotateIssuerKeyHandler.RotateIssuerKey(ctx, req)
+}
+
+// UpdateTokenAllowlist adds an owner to or removes an owner from the allowlist of a transfer restricted token.
+func (s *SparkTokenServer) UpdateTokenAllowlist(
+	ctx context.Context,
+	req *tokenpb.UpdateTokenAllowlistRequest,
+) (*tokenpb.UpdateTokenAllowlistResponse, error) {
+	updateTokenAllowlistHandler := tokens.NewUpdateTokenAllowlistHandler(s.soConfig)
+	return updateTokenAllowlistHandler.UpdateTokenAllowlist(ctx, req)
+}
+
+// PauseToken pauses or unpauses all transfers of a token.
+func (s *SparkTokenServer) PauseToken(
+	ctx context.Context,
+	req *tokenpb.PauseTokenRequest,
+) (*tokenpb.PauseTokenResponse, error) {
+	pauseTokenHandler := tokens.NewPauseTokenHandler(s.soConfig)
+	return pauseTokenHandler.PauseToken(ctx, req)
+}
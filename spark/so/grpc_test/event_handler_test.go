@@ -227,14 +227,81 @@ func TestMultipleSubscriptions(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	select {
-	case ev := <-events1:
-		t.Fatalf("stream1 should not receive any events (received %v)", ev)
-	case event := <-events2:
-		require.NotNil(t, event)
+	for name, events := range map[string]chan *pb.SubscribeToEventsResponse{"stream1": events1, "stream2": events2} {
+		select {
+		case event := <-events:
+			require.NotNil(t, event)
+			require.NotNil(t, event.GetTransfer())
+			require.Equal(t, rootNode.Id, event.GetTransfer().Transfer.Leaves[0].Leaf.Id)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no event received on %s", name)
+		}
+	}
+}
+
+func TestEventHandlerResumeReplaysMissedEvents(t *testing.T) {
+	senderConfig, err := sparktesting.TestWalletConfig()
+	require.NoError(t, err)
+
+	receiverConfig, err := sparktesting.TestWalletConfig()
+	require.NoError(t, err)
+
+	var expectedNodeIDs []string
+	for range 2 {
+		leafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err)
+		rootNode, err := sparktesting.CreateNewTree(senderConfig, faucet, leafPrivKey, 100_000)
+		require.NoError(t, err)
+		expectedNodeIDs = append(expectedNodeIDs, rootNode.Id)
+
+		newLeafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err)
+		transferNode := wallet.LeafKeyTweak{
+			Leaf:              rootNode,
+			SigningPrivKey:    leafPrivKey,
+			NewSigningPrivKey: newLeafPrivKey,
+		}
+		leavesToTransfer := [1]wallet.LeafKeyTweak{transferNode}
+
+		_, err = wallet.SendTransferWithKeyTweaks(
+			t.Context(),
+			senderConfig,
+			leavesToTransfer[:],
+			receiverConfig.IdentityPublicKey(),
+			time.Now().Add(10*time.Minute),
+		)
+		require.NoError(t, err)
+	}
+
+	// The transfers happened while the receiver was offline, so resuming from the start of the
+	// log replays both of them in order.
+	stream, err := wallet.ResumeEventsSubscription(t.Context(), receiverConfig, 0)
+	require.NoError(t, err)
+	skipConnectedEvent(t, stream)
+
+	for i, nodeID := range expectedNodeIDs {
+		event, err := stream.Recv()
+		require.NoError(t, err)
 		require.NotNil(t, event.GetTransfer())
-		require.Equal(t, rootNode.Id, event.GetTransfer().Transfer.Leaves[0].Leaf.Id)
-	case <-time.After(5 * time.Second):
-		t.Fatal("no event received on stream2")
+		assert.Equal(t, uint64(i+1), event.Sequence)
+		assert.Equal(t, nodeID, event.GetTransfer().Transfer.Leaves[0].Leaf.Id)
+	}
+
+	// Resuming from the last received sequence replays nothing.
+	stream, err = wallet.ResumeEventsSubscription(t.Context(), receiverConfig, uint64(len(expectedNodeIDs)))
+	require.NoError(t, err)
+	skipConnectedEvent(t, stream)
+
+	events := make(chan *pb.SubscribeToEventsResponse, 1)
+	go func() {
+		event, err := stream.Recv()
+		if err == nil {
+			events <- event
+		}
+	}()
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event after resuming from the latest sequence: %v", event)
+	case <-time.After(500 * time.Millisecond):
 	}
 }
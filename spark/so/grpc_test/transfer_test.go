@@ -1,6 +1,7 @@
 package grpctest
 
 import (
+	"crypto/sha256"
 	"sync"
 	"testing"
 	"time"
@@ -92,6 +93,144 @@ func TestTransfer(t *testing.T) {
 	require.Equal(t, res[0].Id, claimingNode.Leaf.Id)
 }
 
+func TestBatchTransfer(t *testing.T) {
+	senderConfig, err := sparktesting.TestWalletConfig()
+	require.NoError(t, err, "failed to create sender wallet config")
+
+	recipients := make([]wallet.BatchTransferRecipient, 0, 2)
+	receiverPrivKeys := make([]keys.Private, 0, 2)
+	newLeafPrivKeys := make([]keys.Private, 0, 2)
+	for range 2 {
+		leafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err, "failed to create node signing private key")
+		rootNode, err := sparktesting.CreateNewTree(senderConfig, faucet, leafPrivKey, 100_000)
+		require.NoError(t, err, "failed to create new tree")
+		newLeafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err, "failed to create new node signing private key")
+		receiverPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err, "failed to create receiver private key")
+
+		recipients = append(recipients, wallet.BatchTransferRecipient{
+			Leaves: []wallet.LeafKeyTweak{{
+				Leaf:              rootNode,
+				SigningPrivKey:    leafPrivKey,
+				NewSigningPrivKey: newLeafPrivKey,
+			}},
+			ReceiverIdentityPubkey: receiverPrivKey.Public(),
+		})
+		receiverPrivKeys = append(receiverPrivKeys, receiverPrivKey)
+		newLeafPrivKeys = append(newLeafPrivKeys, newLeafPrivKey)
+	}
+
+	senderTransfers, err := wallet.SendBatchTransferWithKeyTweaks(t.Context(), senderConfig, recipients, time.Now().Add(10*time.Minute))
+	require.NoError(t, err, "failed to send batch transfer")
+	require.Len(t, senderTransfers, 2)
+	require.NotEmpty(t, senderTransfers[0].BatchId)
+	require.Equal(t, senderTransfers[0].BatchId, senderTransfers[1].BatchId)
+
+	// Each receiver only sees and claims their own part of the batch.
+	for i, receiverPrivKey := range receiverPrivKeys {
+		receiverConfig, err := sparktesting.TestWalletConfigWithIdentityKey(receiverPrivKey)
+		require.NoError(t, err, "failed to create wallet config")
+		receiverToken, err := wallet.AuthenticateWithServer(t.Context(), receiverConfig)
+		require.NoError(t, err, "failed to authenticate receiver")
+		receiverCtx := wallet.ContextWithToken(t.Context(), receiverToken)
+		pendingTransfer, err := wallet.QueryPendingTransfers(receiverCtx, receiverConfig)
+		require.NoError(t, err, "failed to query pending transfers")
+		require.Len(t, pendingTransfer.Transfers, 1)
+		receiverTransfer := pendingTransfer.Transfers[0]
+		require.Equal(t, senderTransfers[i].Id, receiverTransfer.Id)
+
+		leafPrivKeyMap, err := wallet.VerifyPendingTransfer(t.Context(), receiverConfig, receiverTransfer)
+		assertVerifiedPendingTransfer(t, err, leafPrivKeyMap, recipients[i].Leaves[0].Leaf, newLeafPrivKeys[i])
+
+		finalLeafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err, "failed to create new node signing private key")
+		claimingNode := wallet.LeafKeyTweak{
+			Leaf:              receiverTransfer.Leaves[0].Leaf,
+			SigningPrivKey:    newLeafPrivKeys[i],
+			NewSigningPrivKey: finalLeafPrivKey,
+		}
+		res, err := wallet.ClaimTransfer(receiverCtx, receiverTransfer, receiverConfig, []wallet.LeafKeyTweak{claimingNode})
+		require.NoError(t, err, "failed to ClaimTransfer")
+		require.Equal(t, claimingNode.Leaf.Id, res[0].Id)
+	}
+}
+
+func TestHtlcTransfer(t *testing.T) {
+	senderConfig, err := sparktesting.TestWalletConfig()
+	require.NoError(t, err, "failed to create sender wallet config")
+	receiverPrivKey, err := keys.GeneratePrivateKey()
+	require.NoError(t, err, "failed to create receiver private key")
+	receiverConfig, err := sparktesting.TestWalletConfigWithIdentityKey(receiverPrivKey)
+	require.NoError(t, err, "failed to create wallet config")
+	receiverToken, err := wallet.AuthenticateWithServer(t.Context(), receiverConfig)
+	require.NoError(t, err, "failed to authenticate receiver")
+	receiverCtx := wallet.ContextWithToken(t.Context(), receiverToken)
+
+	sendHtlc := func(preimage []byte, expiryTime time.Time) (*spark.Transfer, *spark.TreeNode, keys.Private) {
+		leafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err, "failed to create node signing private key")
+		rootNode, err := sparktesting.CreateNewTree(senderConfig, faucet, leafPrivKey, 100_000)
+		require.NoError(t, err, "failed to create new tree")
+		newLeafPrivKey, err := keys.GeneratePrivateKey()
+		require.NoError(t, err, "failed to create new node signing private key")
+
+		paymentHash := sha256.Sum256(preimage)
+		transfer, err := wallet.SendHtlcTransfer(
+			t.Context(),
+			senderConfig,
+			[]wallet.LeafKeyTweak{{Leaf: rootNode, SigningPrivKey: leafPrivKey, NewSigningPrivKey: newLeafPrivKey}},
+			receiverPrivKey.Public(),
+			paymentHash[:],
+			expiryTime,
+		)
+		require.NoError(t, err, "failed to send htlc transfer")
+		require.Equal(t, spark.TransferType_HTLC, transfer.Type)
+		require.Equal(t, spark.TransferStatus_TRANSFER_STATUS_SENDER_KEY_TWEAK_PENDING, transfer.Status)
+		return transfer, rootNode, newLeafPrivKey
+	}
+
+	preimage := []byte("htlc transfer preimage 000000001")
+	senderTransfer, rootNode, newLeafPrivKey := sendHtlc(preimage, time.Now().Add(10*time.Minute))
+
+	// The receiver cannot claim the transfer until they reveal the preimage.
+	pendingTransfer, err := wallet.QueryPendingTransfers(receiverCtx, receiverConfig)
+	require.NoError(t, err, "failed to query pending transfers")
+	require.Empty(t, pendingTransfer.Transfers)
+
+	providedTransfer, err := wallet.ProvideHtlcPreimage(t.Context(), receiverConfig, senderTransfer.Id, preimage)
+	require.NoError(t, err, "failed to provide preimage")
+	require.Equal(t, senderTransfer.Id, providedTransfer.Id)
+
+	pendingTransfer, err = wallet.QueryPendingTransfers(receiverCtx, receiverConfig)
+	require.NoError(t, err, "failed to query pending transfers")
+	require.Len(t, pendingTransfer.Transfers, 1)
+	receiverTransfer := pendingTransfer.Transfers[0]
+	require.Equal(t, senderTransfer.Id, receiverTransfer.Id)
+
+	leafPrivKeyMap, err := wallet.VerifyPendingTransfer(t.Context(), receiverConfig, receiverTransfer)
+	assertVerifiedPendingTransfer(t, err, leafPrivKeyMap, rootNode, newLeafPrivKey)
+
+	finalLeafPrivKey, err := keys.GeneratePrivateKey()
+	require.NoError(t, err, "failed to create new node signing private key")
+	claimingNode := wallet.LeafKeyTweak{
+		Leaf:              receiverTransfer.Leaves[0].Leaf,
+		SigningPrivKey:    newLeafPrivKey,
+		NewSigningPrivKey: finalLeafPrivKey,
+	}
+	res, err := wallet.ClaimTransfer(receiverCtx, receiverTransfer, receiverConfig, []wallet.LeafKeyTweak{claimingNode})
+	require.NoError(t, err, "failed to ClaimTransfer")
+	require.Equal(t, claimingNode.Leaf.Id, res[0].Id)
+
+	// A preimage revealed after the deadline does not unlock the transfer.
+	latePreimage := []byte("htlc transfer preimage 000000002")
+	lateTransfer, _, _ := sendHtlc(latePreimage, time.Now().Add(2*time.Second))
+	time.Sleep(3 * time.Second)
+	_, err = wallet.ProvideHtlcPreimage(t.Context(), receiverConfig, lateTransfer.Id, latePreimage)
+	require.ErrorContains(t, err, "expired")
+}
+
 func TestQueryPendingTransferByNetwork(t *testing.T) {
 	senderConfig, err := sparktesting.TestWalletConfig()
 	require.NoError(t, err, "failed to create sender wallet config")
@@ -45,6 +45,7 @@ const (
 	MaxSecretShareSize      = 32                // Limit secret share size
 	MaxSignatureSize        = 73                // Reasonable limit for ECDSA secp256k1 signatures
 	MaxEstimatedMemoryUsage = 100 * 1024 * 1024 // 100MB limit for estimated memory usage
+	MaxTransfersPerBatch    = 100               // Limit the number of receivers in a batch transfer
 )
 
 type TransferRole int
@@ -276,7 +277,7 @@ func (h *BaseTransferHandler) createTransfer(
 	switch transferType {
 	case st.TransferTypeCooperativeExit:
 		err = h.validateCooperativeExitLeaves(ctx, transfer, leaves, leafCpfpRefundMap, leafDirectRefundMap, leafDirectFromCpfpRefundMap, receiverIdentityPublicKey, requireDirectTx)
-	case st.TransferTypeTransfer, st.TransferTypeSwap, st.TransferTypeCounterSwap:
+	case st.TransferTypeTransfer, st.TransferTypeSwap, st.TransferTypeCounterSwap, st.TransferTypeHtlc:
 		err = h.validateTransferLeaves(ctx, transfer, leaves, leafCpfpRefundMap, leafDirectRefundMap, leafDirectFromCpfpRefundMap, receiverIdentityPublicKey, requireDirectTx)
 	case st.TransferTypeUtxoSwap:
 		err = h.validateUtxoSwapLeaves(ctx, transfer, leaves, leafCpfpRefundMap, leafDirectRefundMap, leafDirectFromCpfpRefundMap, receiverIdentityPublicKey, requireDirectTx)
@@ -542,10 +543,7 @@ func (h *BaseTransferHandler) CancelTransfer(
 		return nil, fmt.Errorf("only sender is eligible to cancel the transfer %s", req.TransferId)
 	}
 
-	if transfer.Status != st.TransferStatusSenderInitiated &&
-		transfer.Status != st.TransferStatusSenderKeyTweakPending &&
-		transfer.Status != st.TransferStatusSenderInitiatedCoordinator &&
-		transfer.Status != st.TransferStatusReturned {
+	if !isCancellableTransferStatus(transfer.Status) {
 		return nil, fmt.Errorf("transfer %s is expected to be at status TransferStatusSenderInitiated, TransferStatusSenderKeyTweakPending or TransferStatusSenderInitiatedCoordinator but %s found", transfer.ID.String(), transfer.Status)
 	}
 
@@ -553,6 +551,10 @@ func (h *BaseTransferHandler) CancelTransfer(
 	if transfer.Status != st.TransferStatusSenderInitiated && transfer.ExpiryTime.After(time.Now()) {
 		return nil, fmt.Errorf("transfer %s has not expired, expires at %s", req.TransferId, transfer.ExpiryTime.String())
 	}
+	// An HTLC transfer is only returned once a preimage provided before the expiry had time to reach every operator.
+	if transfer.Type == st.TransferTypeHtlc && transfer.ExpiryTime.Add(HtlcReturnDelay).After(time.Now()) {
+		return nil, errors.FailedPreconditionErrorf("htlc transfer %s cannot be returned before %s", req.TransferId, transfer.ExpiryTime.Add(HtlcReturnDelay).String())
+	}
 
 	// Check to see if preimage has already been shared before cancelling
 	// Only check external requests as there currently exists some internal
@@ -572,13 +574,60 @@ func (h *BaseTransferHandler) CancelTransfer(
 		return nil, errors.FailedPreconditionErrorf("Cannot cancel an invoice whose preimage has already been revealed")
 	}
 
-	err = h.CreateCancelTransferGossipMessage(ctx, req.TransferId)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create and send gossip message: %w", err)
+	// Transfers sent in a batch are cancelled together.
+	transferIDs := []string{req.TransferId}
+	if transfer.BatchID != uuid.Nil {
+		batchTransfers, err := db.Transfer.Query().
+			Where(
+				enttransfer.BatchIDEQ(transfer.BatchID),
+				enttransfer.SenderIdentityPubkeyEQ(transfer.SenderIdentityPubkey),
+				enttransfer.IDNEQ(transfer.ID),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load transfers in batch %s: %w", transfer.BatchID.String(), err)
+		}
+		for _, batchTransfer := range batchTransfers {
+			if !isCancellableTransferStatus(batchTransfer.Status) {
+				return nil, fmt.Errorf("transfer %s cannot be cancelled because transfer %s in the same batch is at status %s", req.TransferId, batchTransfer.ID.String(), batchTransfer.Status)
+			}
+			transferIDs = append(transferIDs, batchTransfer.ID.String())
+		}
+	}
+
+	for _, transferID := range transferIDs {
+		err = h.CreateCancelTransferGossipMessage(ctx, transferID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create and send gossip message: %w", err)
+		}
 	}
 	return &pbspark.CancelTransferResponse{}, nil
 }
 
+// validateBatchIDUnused rejects a batch whose ID is already used by other transfers. Transfers in a batch are
+// cancelled together, so reusing an ID would tie the new transfers to an unrelated batch.
+func validateBatchIDUnused(ctx context.Context, batchID uuid.UUID) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get db: %w", err)
+	}
+	batchExists, err := db.Transfer.Query().Where(enttransfer.BatchIDEQ(batchID)).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query transfers in batch %s: %w", batchID.String(), err)
+	}
+	if batchExists {
+		return errors.AlreadyExistsErrorf("batch %s already has transfers", batchID.String())
+	}
+	return nil
+}
+
+func isCancellableTransferStatus(status st.TransferStatus) bool {
+	return status == st.TransferStatusSenderInitiated ||
+		status == st.TransferStatusSenderKeyTweakPending ||
+		status == st.TransferStatusSenderInitiatedCoordinator ||
+		status == st.TransferStatusReturned
+}
+
 func (h *BaseTransferHandler) CreateCancelTransferGossipMessage(ctx context.Context, transferID string) error {
 	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
 	participants, err := selection.OperatorIdentifierList(h.config)
@@ -624,10 +673,35 @@ func (h *BaseTransferHandler) CancelTransferInternal(ctx context.Context, transf
 	if err != nil {
 		return fmt.Errorf("unable to load transfer: %w", err)
 	}
+	if transfer.Type == st.TransferTypeHtlc {
+		if err := validateHtlcPreimageNotShared(ctx, transfer); err != nil {
+			return err
+		}
+	}
 
 	return h.executeCancelTransfer(ctx, transfer)
 }
 
+// validateHtlcPreimageNotShared refuses to return an HTLC transfer once this operator has recorded its preimage,
+// since the receiver unlocked the transfer in time.
+func validateHtlcPreimageNotShared(ctx context.Context, transfer *ent.Transfer) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get db: %w", err)
+	}
+	preimageShared, err := db.PreimageRequest.Query().Where(
+		preimagerequest.HasTransfersWith(enttransfer.ID(transfer.ID)),
+		preimagerequest.StatusEQ(st.PreimageRequestStatusPreimageShared),
+	).Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query preimage request for transfer %s: %w", transfer.ID.String(), err)
+	}
+	if preimageShared {
+		return errors.FailedPreconditionErrorf("htlc transfer %s cannot be returned after its preimage was provided", transfer.ID.String())
+	}
+	return nil
+}
+
 func (h *BaseTransferHandler) executeCancelTransfer(ctx context.Context, transfer *ent.Transfer) error {
 	// Don't error if the transfer is already returned.
 	logger := logging.GetLoggerFromContext(ctx)
@@ -720,7 +794,7 @@ func (h *BaseTransferHandler) cancelTransferUnlockLeaves(ctx context.Context, tr
 }
 
 func (h *BaseTransferHandler) cancelTransferCancelRequest(ctx context.Context, transfer *ent.Transfer) error {
-	if transfer.Type == st.TransferTypePreimageSwap {
+	if transfer.Type == st.TransferTypePreimageSwap || transfer.Type == st.TransferTypeHtlc {
 		db, err := ent.GetDbFromContext(ctx)
 		if err != nil {
 			return err
@@ -734,6 +808,9 @@ func (h *BaseTransferHandler) cancelTransferCancelRequest(ctx context.Context, t
 		if err != nil {
 			return fmt.Errorf("unable to update preimage request status: %w", err)
 		}
+		if err := notifyPreimageRequestStatus(ctx, preimageRequest, st.PreimageRequestStatusReturned); err != nil {
+			return fmt.Errorf("unable to notify users of preimage request status: %w", err)
+		}
 	}
 	return nil
 }
@@ -1071,17 +1148,18 @@ func (h *BaseTransferHandler) commitSenderKeyTweaks(ctx context.Context, transfe
 		if err != nil {
 			return nil, fmt.Errorf("unable to parse receiver identity public key: %w", err)
 		}
+		db, err := ent.GetDbFromContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get db: %w", err)
+		}
 		eventRouter := events.GetDefaultRouter()
-		err = eventRouter.NotifyUser(receiverIDPubKey, &pb.SubscribeToEventsResponse{
+		eventRouter.NotifyUser(db, receiverIDPubKey, &pb.SubscribeToEventsResponse{
 			Event: &pb.SubscribeToEventsResponse_Transfer{
 				Transfer: &pb.TransferEvent{
 					Transfer: transferProto,
 				},
 			},
 		})
-		if err != nil {
-			logger.Error("failed to notify user about transfer event", "error", err, "identity_public_key", logging.Pubkey{Pubkey: transfer.ReceiverIdentityPubkey})
-		}
 	}
 	return transfer, nil
 }
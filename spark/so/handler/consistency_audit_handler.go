@@ -0,0 +1,355 @@
+package handler
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common/logging"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
+	enttransfer "github.com/lightsparkdev/spark/so/ent/transfer"
+	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/helper"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// ConsistencyAuditWindow is how far back the scheduled audit looks for updated entities.
+	ConsistencyAuditWindow = 30 * time.Minute
+	// consistencyAuditSettleDelay excludes entities updated too recently, since operators may
+	// still be applying the same update.
+	consistencyAuditSettleDelay = 10 * time.Minute
+	// consistencyAuditBatchSize caps the number of entities of each type audited in one run.
+	consistencyAuditBatchSize = 1000
+
+	// consistencyFieldExists is the divergence field used when some operators do not know about an entity.
+	consistencyFieldExists = "exists"
+)
+
+var (
+	consistencyAuditMeter = otel.Meter("consistency_audit")
+
+	consistencyAuditEntitiesCounter    metric.Int64Counter
+	consistencyAuditDivergencesCounter metric.Int64Counter
+)
+
+func init() {
+	var err error
+
+	consistencyAuditEntitiesCounter, err = consistencyAuditMeter.Int64Counter(
+		"consistency_audit.entities_checked_total",
+		metric.WithDescription("Total number of entities compared across operators by the consistency audit"),
+	)
+	if err != nil {
+		slog.Error("Failed to create consistency audit entities counter", "error", err)
+	}
+
+	consistencyAuditDivergencesCounter, err = consistencyAuditMeter.Int64Counter(
+		"consistency_audit.divergences_total",
+		metric.WithDescription("Total number of entity fields found to differ across operators by the consistency audit"),
+	)
+	if err != nil {
+		slog.Error("Failed to create consistency audit divergences counter", "error", err)
+	}
+}
+
+// ConsistencyAuditHandler compares transfers, tree nodes, token outputs and signing keyshares
+// across operators and reports where they have drifted apart.
+type ConsistencyAuditHandler struct {
+	config *so.Config
+}
+
+func NewConsistencyAuditHandler(config *so.Config) *ConsistencyAuditHandler {
+	return &ConsistencyAuditHandler{config: config}
+}
+
+// consistencyEntityKey identifies an entity in a snapshot.
+type consistencyEntityKey struct {
+	entityType pbinternal.ConsistencyEntityType
+	entityID   string
+}
+
+// AuditConsistency compares the requested entities across all operators. If no entities are
+// requested, the entities this operator updated within the last audit window are compared.
+func (h *ConsistencyAuditHandler) AuditConsistency(ctx context.Context, req *pbinternal.AuditConsistencyRequest) (*pbinternal.ConsistencyReport, error) {
+	entities := req.Entities
+	if len(entities) == 0 {
+		var err error
+		entities, err = h.recentlyUpdatedEntities(ctx, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+	report := &pbinternal.ConsistencyReport{
+		AuditTime: timestamppb.Now(),
+		Auditor:   h.config.Identifier,
+	}
+	if len(entities) == 0 {
+		return report, nil
+	}
+
+	localSnapshot, err := h.QueryConsistencySnapshot(ctx, &pbinternal.QueryConsistencySnapshotRequest{Entities: entities})
+	if err != nil {
+		return nil, err
+	}
+	selection := helper.OperatorSelection{Option: helper.OperatorSelectionOptionExcludeSelf}
+	snapshots, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (*pbinternal.ConsistencySnapshot, error) {
+		conn, err := operator.NewOperatorGRPCConnection()
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+
+		client := pbinternal.NewSparkInternalServiceClient(conn)
+		return client.QueryConsistencySnapshot(ctx, &pbinternal.QueryConsistencySnapshotRequest{Entities: entities})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to query consistency snapshots: %w", err)
+	}
+	snapshots[h.config.Identifier] = localSnapshot
+
+	report.EntitiesChecked = uint32(len(entities))
+	report.Divergences = compareConsistencySnapshots(entities, snapshots)
+
+	entityCounts := make(map[pbinternal.ConsistencyEntityType]int64)
+	for _, entity := range entities {
+		entityCounts[entity.EntityType]++
+	}
+	for entityType, count := range entityCounts {
+		consistencyAuditEntitiesCounter.Add(ctx, count, metric.WithAttributes(
+			attribute.String("entity_type", entityType.String()),
+		))
+	}
+	logger := logging.GetLoggerFromContext(ctx)
+	for _, divergence := range report.Divergences {
+		consistencyAuditDivergencesCounter.Add(ctx, 1, metric.WithAttributes(
+			attribute.String("entity_type", divergence.Entity.EntityType.String()),
+			attribute.String("field", divergence.Field),
+		))
+		logger.Error("operators diverge on entity",
+			"entity_type", divergence.Entity.EntityType.String(),
+			"entity_id", divergence.Entity.EntityId,
+			"field", divergence.Field,
+			"values", divergence.Values,
+		)
+	}
+	logger.Info("consistency audit completed",
+		"entities_checked", report.EntitiesChecked,
+		"divergences", len(report.Divergences),
+	)
+	return report, nil
+}
+
+// compareConsistencySnapshots returns a divergence for every entity field whose value is not the
+// same on every operator, in the order the entities were requested.
+func compareConsistencySnapshots(entities []*pbinternal.ConsistencyEntity, snapshots map[string]*pbinternal.ConsistencySnapshot) []*pbinternal.ConsistencyDivergence {
+	operatorStates := make(map[string]map[consistencyEntityKey]map[string]string, len(snapshots))
+	for identifier, snapshot := range snapshots {
+		states := make(map[consistencyEntityKey]map[string]string, len(snapshot.Entities))
+		for _, state := range snapshot.Entities {
+			states[consistencyEntityKey{state.Entity.EntityType, state.Entity.EntityId}] = state.Fields
+		}
+		operatorStates[identifier] = states
+	}
+	identifiers := slices.Sorted(maps.Keys(operatorStates))
+
+	var divergences []*pbinternal.ConsistencyDivergence
+	for _, entity := range entities {
+		key := consistencyEntityKey{entity.EntityType, entity.EntityId}
+
+		exists := make(map[string]string, len(identifiers))
+		fieldNames := make(map[string]struct{})
+		for _, identifier := range identifiers {
+			fields, ok := operatorStates[identifier][key]
+			exists[identifier] = fmt.Sprint(ok)
+			for name := range fields {
+				fieldNames[name] = struct{}{}
+			}
+		}
+		if !allEqual(exists) {
+			divergences = append(divergences, &pbinternal.ConsistencyDivergence{
+				Entity: entity,
+				Field:  consistencyFieldExists,
+				Values: exists,
+			})
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(fieldNames)) {
+			values := make(map[string]string, len(identifiers))
+			for _, identifier := range identifiers {
+				if fields, ok := operatorStates[identifier][key]; ok {
+					values[identifier] = fields[name]
+				}
+			}
+			if !allEqual(values) {
+				divergences = append(divergences, &pbinternal.ConsistencyDivergence{
+					Entity: entity,
+					Field:  name,
+					Values: values,
+				})
+			}
+		}
+	}
+	return divergences
+}
+
+func allEqual(values map[string]string) bool {
+	var first *string
+	for _, value := range values {
+		if first == nil {
+			first = &value
+		} else if value != *first {
+			return false
+		}
+	}
+	return true
+}
+
+// recentlyUpdatedEntities returns the entities updated within the audit window ending the settle
+// delay before now.
+func (h *ConsistencyAuditHandler) recentlyUpdatedEntities(ctx context.Context, now time.Time) ([]*pbinternal.ConsistencyEntity, error) {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	end := now.Add(-consistencyAuditSettleDelay)
+	start := end.Add(-ConsistencyAuditWindow)
+
+	var entities []*pbinternal.ConsistencyEntity
+	appendEntities := func(entityType pbinternal.ConsistencyEntityType, ids []uuid.UUID) {
+		for _, id := range ids {
+			entities = append(entities, &pbinternal.ConsistencyEntity{EntityType: entityType, EntityId: id.String()})
+		}
+	}
+
+	transferIDs, err := db.Transfer.Query().
+		Where(enttransfer.UpdateTimeGTE(start), enttransfer.UpdateTimeLT(end)).
+		Order(ent.Asc(enttransfer.FieldUpdateTime)).
+		Limit(consistencyAuditBatchSize).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query transfers: %w", err)
+	}
+	appendEntities(pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TRANSFER, transferIDs)
+
+	treeNodeIDs, err := db.TreeNode.Query().
+		Where(treenode.UpdateTimeGTE(start), treenode.UpdateTimeLT(end)).
+		Order(ent.Asc(treenode.FieldUpdateTime)).
+		Limit(consistencyAuditBatchSize).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query tree nodes: %w", err)
+	}
+	appendEntities(pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TREE_NODE, treeNodeIDs)
+
+	tokenOutputIDs, err := db.TokenOutput.Query().
+		Where(tokenoutput.UpdateTimeGTE(start), tokenoutput.UpdateTimeLT(end)).
+		Order(ent.Asc(tokenoutput.FieldUpdateTime)).
+		Limit(consistencyAuditBatchSize).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query token outputs: %w", err)
+	}
+	appendEntities(pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TOKEN_OUTPUT, tokenOutputIDs)
+
+	keyshareIDs, err := db.SigningKeyshare.Query().
+		Where(signingkeyshare.UpdateTimeGTE(start), signingkeyshare.UpdateTimeLT(end)).
+		Order(ent.Asc(signingkeyshare.FieldUpdateTime)).
+		Limit(consistencyAuditBatchSize).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query signing keyshares: %w", err)
+	}
+	appendEntities(pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE, keyshareIDs)
+
+	return entities, nil
+}
+
+// QueryConsistencySnapshot returns this operator's view of the audited fields of the given entities.
+func (h *ConsistencyAuditHandler) QueryConsistencySnapshot(ctx context.Context, req *pbinternal.QueryConsistencySnapshotRequest) (*pbinternal.ConsistencySnapshot, error) {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	ids := make(map[pbinternal.ConsistencyEntityType][]uuid.UUID)
+	for _, entity := range req.Entities {
+		id, err := uuid.Parse(entity.EntityId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid entity id %s: %v", entity.EntityId, err)
+		}
+		ids[entity.EntityType] = append(ids[entity.EntityType], id)
+	}
+
+	snapshot := &pbinternal.ConsistencySnapshot{}
+	appendState := func(entityType pbinternal.ConsistencyEntityType, id uuid.UUID, fields map[string]string) {
+		snapshot.Entities = append(snapshot.Entities, &pbinternal.ConsistencyEntityState{
+			Entity: &pbinternal.ConsistencyEntity{EntityType: entityType, EntityId: id.String()},
+			Fields: fields,
+		})
+	}
+	for _, entityType := range slices.Sorted(maps.Keys(ids)) {
+		entityIDs := ids[entityType]
+		switch entityType {
+		case pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TRANSFER:
+			transfers, err := db.Transfer.Query().Where(enttransfer.IDIn(entityIDs...)).All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query transfers: %w", err)
+			}
+			for _, transfer := range transfers {
+				appendState(entityType, transfer.ID, map[string]string{
+					"status": string(transfer.Status),
+				})
+			}
+		case pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TREE_NODE:
+			nodes, err := db.TreeNode.Query().Where(treenode.IDIn(entityIDs...)).All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query tree nodes: %w", err)
+			}
+			for _, node := range nodes {
+				appendState(entityType, node.ID, map[string]string{
+					"status":                string(node.Status),
+					"owner_identity_pubkey": hex.EncodeToString(node.OwnerIdentityPubkey),
+					"owner_signing_pubkey":  hex.EncodeToString(node.OwnerSigningPubkey),
+				})
+			}
+		case pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TOKEN_OUTPUT:
+			outputs, err := db.TokenOutput.Query().Where(tokenoutput.IDIn(entityIDs...)).All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query token outputs: %w", err)
+			}
+			for _, output := range outputs {
+				appendState(entityType, output.ID, map[string]string{
+					"status": string(output.Status),
+				})
+			}
+		case pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE:
+			keyshares, err := db.SigningKeyshare.Query().Where(signingkeyshare.IDIn(entityIDs...)).All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query signing keyshares: %w", err)
+			}
+			for _, keyshare := range keyshares {
+				appendState(entityType, keyshare.ID, map[string]string{
+					"status": string(keyshare.Status),
+				})
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported entity type %s", entityType)
+		}
+	}
+	return snapshot, nil
+}
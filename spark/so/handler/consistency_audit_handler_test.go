@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common/keys"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newConsistencyEntityState(entity *pbinternal.ConsistencyEntity, fields map[string]string) *pbinternal.ConsistencyEntityState {
+	return &pbinternal.ConsistencyEntityState{Entity: entity, Fields: fields}
+}
+
+func TestCompareConsistencySnapshots(t *testing.T) {
+	transfer := &pbinternal.ConsistencyEntity{
+		EntityType: pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TRANSFER,
+		EntityId:   uuid.NewString(),
+	}
+	node := &pbinternal.ConsistencyEntity{
+		EntityType: pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TREE_NODE,
+		EntityId:   uuid.NewString(),
+	}
+	nodeFields := map[string]string{"status": "AVAILABLE", "owner_identity_pubkey": "02aa", "owner_signing_pubkey": "03bb"}
+
+	tests := []struct {
+		name      string
+		snapshots map[string]*pbinternal.ConsistencySnapshot
+		want      []*pbinternal.ConsistencyDivergence
+	}{
+		{
+			name: "consistent",
+			snapshots: map[string]*pbinternal.ConsistencySnapshot{
+				"a": {Entities: []*pbinternal.ConsistencyEntityState{
+					newConsistencyEntityState(transfer, map[string]string{"status": "COMPLETED"}),
+					newConsistencyEntityState(node, nodeFields),
+				}},
+				"b": {Entities: []*pbinternal.ConsistencyEntityState{
+					newConsistencyEntityState(node, nodeFields),
+					newConsistencyEntityState(transfer, map[string]string{"status": "COMPLETED"}),
+				}},
+			},
+		},
+		{
+			name: "status differs",
+			snapshots: map[string]*pbinternal.ConsistencySnapshot{
+				"a": {Entities: []*pbinternal.ConsistencyEntityState{
+					newConsistencyEntityState(transfer, map[string]string{"status": "COMPLETED"}),
+					newConsistencyEntityState(node, nodeFields),
+				}},
+				"b": {Entities: []*pbinternal.ConsistencyEntityState{
+					newConsistencyEntityState(transfer, map[string]string{"status": "RECEIVER_KEY_TWEAKED"}),
+					newConsistencyEntityState(node, nodeFields),
+				}},
+			},
+			want: []*pbinternal.ConsistencyDivergence{
+				{Entity: transfer, Field: "status", Values: map[string]string{"a": "COMPLETED", "b": "RECEIVER_KEY_TWEAKED"}},
+			},
+		},
+		{
+			name: "owner differs and entity missing",
+			snapshots: map[string]*pbinternal.ConsistencySnapshot{
+				"a": {Entities: []*pbinternal.ConsistencyEntityState{
+					newConsistencyEntityState(transfer, map[string]string{"status": "COMPLETED"}),
+					newConsistencyEntityState(node, nodeFields),
+				}},
+				"b": {Entities: []*pbinternal.ConsistencyEntityState{
+					newConsistencyEntityState(node, map[string]string{"status": "AVAILABLE", "owner_identity_pubkey": "02cc", "owner_signing_pubkey": "03bb"}),
+				}},
+			},
+			want: []*pbinternal.ConsistencyDivergence{
+				{Entity: transfer, Field: consistencyFieldExists, Values: map[string]string{"a": "true", "b": "false"}},
+				{Entity: node, Field: "owner_identity_pubkey", Values: map[string]string{"a": "02aa", "b": "02cc"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := compareConsistencySnapshots([]*pbinternal.ConsistencyEntity{transfer, node}, tt.snapshots)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQueryConsistencySnapshot(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	rng := rand.NewChaCha8([32]byte{})
+	ownerIdentity := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	transfer, err := tx.Transfer.Create().
+		SetStatus(st.TransferStatusReceiverKeyTweaked).
+		SetType(st.TransferTypeTransfer).
+		SetSenderIdentityPubkey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetReceiverIdentityPubkey(ownerIdentity.Serialize()).
+		SetTotalValue(1000).
+		SetExpiryTime(time.Now().Add(24 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	h := NewConsistencyAuditHandler(&so.Config{})
+	missing := uuid.NewString()
+	snapshot, err := h.QueryConsistencySnapshot(ctx, &pbinternal.QueryConsistencySnapshotRequest{
+		Entities: []*pbinternal.ConsistencyEntity{
+			{EntityType: pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TRANSFER, EntityId: transfer.ID.String()},
+			{EntityType: pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_TREE_NODE, EntityId: missing},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, snapshot.Entities, 1)
+	assert.Equal(t, transfer.ID.String(), snapshot.Entities[0].Entity.EntityId)
+	assert.Equal(t, map[string]string{"status": string(st.TransferStatusReceiverKeyTweaked)}, snapshot.Entities[0].Fields)
+
+	_, err = h.QueryConsistencySnapshot(ctx, &pbinternal.QueryConsistencySnapshotRequest{
+		Entities: []*pbinternal.ConsistencyEntity{{EntityType: pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_UNSPECIFIED, EntityId: missing}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRecentlyUpdatedEntities(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	rng := rand.NewChaCha8([32]byte{})
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	keyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusAvailable).
+		SetSecretShare([]byte("test_secret_share")).
+		SetPublicShares(map[string][]byte{"test": []byte("test_public_share")}).
+		SetPublicKey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	h := NewConsistencyAuditHandler(&so.Config{})
+
+	// Entities updated within the settle delay are not audited yet.
+	entities, err := h.recentlyUpdatedEntities(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, entities)
+
+	entities, err = h.recentlyUpdatedEntities(ctx, time.Now().Add(consistencyAuditSettleDelay+time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []*pbinternal.ConsistencyEntity{
+		{EntityType: pbinternal.ConsistencyEntityType_CONSISTENCY_ENTITY_TYPE_SIGNING_KEYSHARE, EntityId: keyshare.ID.String()},
+	}, entities)
+
+	// Entities updated before the window are no longer audited.
+	entities, err = h.recentlyUpdatedEntities(ctx, time.Now().Add(consistencyAuditSettleDelay+ConsistencyAuditWindow+time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, entities)
+}
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/keys"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	events "github.com/lightsparkdev/spark/so/stream"
+)
+
+// notifyUsers records the event for each of the given identities once the current transaction
+// commits. Identities are deduplicated and notified in key order.
+func notifyUsers(ctx context.Context, identityPublicKeys [][]byte, newMessage func() *pb.SubscribeToEventsResponse) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	var notified []keys.Public
+	for _, identityPublicKey := range identityPublicKeys {
+		if len(identityPublicKey) == 0 {
+			continue
+		}
+		idPubKey, err := keys.ParsePublicKey(identityPublicKey)
+		if err != nil {
+			return fmt.Errorf("unable to parse identity public key: %w", err)
+		}
+		if !slices.ContainsFunc(notified, idPubKey.Equals) {
+			notified = append(notified, idPubKey)
+		}
+	}
+	slices.SortFunc(notified, func(a, b keys.Public) int {
+		return bytes.Compare(a.Serialize(), b.Serialize())
+	})
+
+	eventRouter := events.GetDefaultRouter()
+	for _, idPubKey := range notified {
+		eventRouter.NotifyUser(db, idPubKey, newMessage())
+	}
+	return nil
+}
+
+// notifyPreimageRequestStatus notifies the receiver of the preimage request and the parties to
+// its transfer that the request moved to the given status.
+func notifyPreimageRequestStatus(ctx context.Context, preimageRequest *ent.PreimageRequest, status st.PreimageRequestStatus) error {
+	statusProto, err := status.MarshalProto()
+	if err != nil {
+		return err
+	}
+
+	identityPublicKeys := [][]byte{preimageRequest.ReceiverIdentityPubkey}
+	var transferID string
+	transfer, err := preimageRequest.QueryTransfers().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("unable to get transfer for preimage request: %w", err)
+	}
+	if transfer != nil {
+		transferID = transfer.ID.String()
+		identityPublicKeys = append(identityPublicKeys, transfer.SenderIdentityPubkey, transfer.ReceiverIdentityPubkey)
+	}
+
+	return notifyUsers(ctx, identityPublicKeys, func() *pb.SubscribeToEventsResponse {
+		return &pb.SubscribeToEventsResponse{
+			Event: &pb.SubscribeToEventsResponse_PreimageRequest{
+				PreimageRequest: &pb.PreimageRequestEvent{
+					PaymentHash: preimageRequest.PaymentHash,
+					Status:      statusProto,
+					TransferId:  transferID,
+				},
+			},
+		}
+	})
+}
+
+// notifyUtxoSwapCompleted notifies the user of a static deposit UTXO swap that it completed.
+func notifyUtxoSwapCompleted(ctx context.Context, utxoSwap *ent.UtxoSwap, transfer *ent.Transfer) error {
+	utxo, err := utxoSwap.QueryUtxo().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get utxo: %w", err)
+	}
+	protoNetwork, err := common.ProtoNetworkFromSchemaNetwork(utxo.Network)
+	if err != nil {
+		return fmt.Errorf("unable to get proto network: %w", err)
+	}
+	var transferID string
+	if transfer != nil {
+		transferID = transfer.ID.String()
+	}
+
+	return notifyUsers(ctx, [][]byte{utxoSwap.UserIdentityPublicKey}, func() *pb.SubscribeToEventsResponse {
+		return &pb.SubscribeToEventsResponse{
+			Event: &pb.SubscribeToEventsResponse_UtxoSwap{
+				UtxoSwap: &pb.UtxoSwapEvent{
+					Utxo: &pb.UTXO{
+						Txid:    utxo.Txid,
+						Vout:    utxo.Vout,
+						Network: protoNetwork,
+					},
+					RequestType: st.UtxoSwapRequestTypeToProto(utxoSwap.RequestType),
+					TransferId:  transferID,
+				},
+			},
+		}
+	})
+}
+
+// notifyTreeExited notifies the owner of the tree that it exited.
+func notifyTreeExited(ctx context.Context, tree *ent.Tree) error {
+	return notifyUsers(ctx, [][]byte{tree.OwnerIdentityPubkey}, func() *pb.SubscribeToEventsResponse {
+		return &pb.SubscribeToEventsResponse{
+			Event: &pb.SubscribeToEventsResponse_TreeExit{
+				TreeExit: &pb.TreeExitEvent{
+					TreeId: tree.ID.String(),
+				},
+			},
+		}
+	})
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// recordedEvents commits the current transaction, which records the events it notified, and
+// returns the events recorded for the identity, in sequence order.
+func recordedEvents(t *testing.T, ctx context.Context, identityPublicKey keys.Public) []*pb.SubscribeToEventsResponse {
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+	tx, err = ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	userEvents, err := tx.UserEvent.Query().
+		Where(userevent.IdentityPublicKey(identityPublicKey.Serialize())).
+		Order(ent.Asc(userevent.FieldSequence)).
+		All(ctx)
+	require.NoError(t, err)
+
+	messages := make([]*pb.SubscribeToEventsResponse, len(userEvents))
+	for i, userEvent := range userEvents {
+		messages[i] = &pb.SubscribeToEventsResponse{}
+		require.NoError(t, proto.Unmarshal(userEvent.Event, messages[i]))
+	}
+	return messages
+}
+
+func TestNotifyPreimageRequestStatus(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	rng := rand.NewChaCha8([32]byte{})
+	senderIdentity := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+	receiverIdentity := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	transfer, err := tx.Transfer.Create().
+		SetStatus(st.TransferStatusSenderKeyTweakPending).
+		SetType(st.TransferTypePreimageSwap).
+		SetSenderIdentityPubkey(senderIdentity.Serialize()).
+		SetReceiverIdentityPubkey(receiverIdentity.Serialize()).
+		SetTotalValue(1000).
+		SetExpiryTime(time.Now().Add(24 * time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	paymentHash := []byte("payment_hash_for_event_test_____")
+	preimageRequest, err := tx.PreimageRequest.Create().
+		SetPaymentHash(paymentHash).
+		SetStatus(st.PreimageRequestStatusReturned).
+		SetReceiverIdentityPubkey(receiverIdentity.Serialize()).
+		SetTransfers(transfer).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, notifyPreimageRequestStatus(ctx, preimageRequest, st.PreimageRequestStatusReturned))
+
+	// The receiver is both the preimage request's receiver and the transfer's receiver, but is
+	// notified once.
+	for _, identity := range []keys.Public{senderIdentity, receiverIdentity} {
+		messages := recordedEvents(t, ctx, identity)
+		require.Len(t, messages, 1)
+		event := messages[0].GetPreimageRequest()
+		require.NotNil(t, event)
+		assert.Equal(t, paymentHash, event.PaymentHash)
+		assert.Equal(t, pb.PreimageRequestStatus_PREIMAGE_REQUEST_STATUS_RETURNED, event.Status)
+		assert.Equal(t, transfer.ID.String(), event.TransferId)
+	}
+}
+
+func TestCompleteUtxoSwapNotifiesUser(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	rng := rand.NewChaCha8([32]byte{})
+	userIdentity := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	signingKeyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusAvailable).
+		SetSecretShare([]byte("test_secret_share")).
+		SetPublicShares(map[string][]byte{"test": []byte("test_public_share")}).
+		SetPublicKey([]byte("test_public_key")).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	depositAddress, err := tx.DepositAddress.Create().
+		SetAddress("test_static_address").
+		SetOwnerIdentityPubkey(userIdentity.Serialize()).
+		SetOwnerSigningPubkey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetSigningKeyshare(signingKeyshare).
+		SetIsStatic(true).
+		Save(ctx)
+	require.NoError(t, err)
+	utxo, err := tx.Utxo.Create().
+		SetNetwork(st.NetworkRegtest).
+		SetTxid([]byte("test_txid")).
+		SetVout(1).
+		SetBlockHeight(100).
+		SetAmount(1000).
+		SetPkScript([]byte("test_script")).
+		SetDepositAddress(depositAddress).
+		Save(ctx)
+	require.NoError(t, err)
+	utxoSwap, err := tx.UtxoSwap.Create().
+		SetStatus(st.UtxoSwapStatusCreated).
+		SetRequestType(st.UtxoSwapRequestTypeRefund).
+		SetUserIdentityPublicKey(userIdentity.Serialize()).
+		SetCoordinatorIdentityPublicKey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetUtxo(utxo).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, CompleteUtxoSwap(ctx, utxoSwap))
+
+	messages := recordedEvents(t, ctx, userIdentity)
+	require.Len(t, messages, 1)
+	event := messages[0].GetUtxoSwap()
+	require.NotNil(t, event)
+	assert.Equal(t, []byte("test_txid"), event.Utxo.Txid)
+	assert.Equal(t, uint32(1), event.Utxo.Vout)
+	assert.Equal(t, pb.Network_REGTEST, event.Utxo.Network)
+	assert.Equal(t, pb.UtxoSwapRequestType_Refund, event.RequestType)
+	assert.Empty(t, event.TransferId)
+}
+
+func TestMarkTreesExitedNotifiesOwner(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	rng := rand.NewChaCha8([32]byte{})
+	ownerIdentity := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	tree, err := tx.Tree.Create().
+		SetStatus(st.TreeStatusAvailable).
+		SetNetwork(st.NetworkRegtest).
+		SetOwnerIdentityPubkey(ownerIdentity.Serialize()).
+		SetBaseTxid([]byte("test_base_txid")).
+		SetVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	h := NewTreeExitHandler(nil)
+	require.NoError(t, h.MarkTreesExited(ctx, []*ent.Tree{tree}))
+
+	messages := recordedEvents(t, ctx, ownerIdentity)
+	require.Len(t, messages, 1)
+	require.NotNil(t, messages[0].GetTreeExit())
+	assert.Equal(t, tree.ID.String(), messages[0].GetTreeExit().TreeId)
+}
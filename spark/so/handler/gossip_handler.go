@@ -58,6 +58,9 @@ func (h *GossipHandler) HandleGossipMessage(ctx context.Context, gossipMessage *
 	case *pbgossip.GossipMessage_DepositCleanup:
 		depositCleanup := gossipMessage.GetDepositCleanup()
 		h.handleDepositCleanupGossipMessage(ctx, depositCleanup)
+	case *pbgossip.GossipMessage_SettleBatchSenderKeyTweak:
+		settleBatchSenderKeyTweak := gossipMessage.GetSettleBatchSenderKeyTweak()
+		h.handleSettleBatchSenderKeyTweakGossipMessage(ctx, settleBatchSenderKeyTweak, forCoordinator)
 	default:
 		return fmt.Errorf("unsupported gossip message type: %T", gossipMessage.Message)
 	}
@@ -84,6 +87,18 @@ func (h *GossipHandler) handleSettleSenderKeyTweakGossipMessage(ctx context.Cont
 	}
 }
 
+func (h *GossipHandler) handleSettleBatchSenderKeyTweakGossipMessage(ctx context.Context, settleBatchSenderKeyTweak *pbgossip.GossipMessageSettleBatchSenderKeyTweak, forCoordinator bool) {
+	transferHandler := NewBaseTransferHandler(h.config)
+	for _, settleSenderKeyTweak := range settleBatchSenderKeyTweak.Transfers {
+		_, err := transferHandler.CommitSenderKeyTweaks(ctx, settleSenderKeyTweak.TransferId, settleSenderKeyTweak.SenderKeyTweakProofs, forCoordinator)
+		if err != nil {
+			// If there's an error, it's still considered the message is delivered successfully.
+			logger := logging.GetLoggerFromContext(ctx)
+			logger.Error("failed to settle sender key tweak", "error", err, "transfer_id", settleSenderKeyTweak.TransferId, "batch_id", settleBatchSenderKeyTweak.BatchId)
+		}
+	}
+}
+
 func (h *GossipHandler) handleRollbackTransfer(ctx context.Context, req *pbgossip.GossipMessageRollbackTransfer) {
 	logger := logging.GetLoggerFromContext(ctx)
 	logger.Info("Handling rollback transfer gossip message", "transfer_id", req.TransferId)
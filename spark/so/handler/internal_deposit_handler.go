@@ -666,8 +666,11 @@ func CompleteUtxoSwap(ctx context.Context, utxoSwap *ent.UtxoSwap) error {
 	if utxoSwap.Status == st.UtxoSwapStatusCancelled {
 		return fmt.Errorf("utxo swap is already cancelled")
 	}
+	var transfer *ent.Transfer
 	if utxoSwap.RequestType != st.UtxoSwapRequestTypeRefund {
-		transfer, needUpdate, err := GetTransferFromUtxoSwap(ctx, utxoSwap)
+		var needUpdate bool
+		var err error
+		transfer, needUpdate, err = GetTransferFromUtxoSwap(ctx, utxoSwap)
 		if err != nil {
 			return fmt.Errorf("unable to get transfer from utxo swap: %w", err)
 		}
@@ -686,6 +689,9 @@ func CompleteUtxoSwap(ctx context.Context, utxoSwap *ent.UtxoSwap) error {
 	if _, err := utxoSwap.Update().SetStatus(st.UtxoSwapStatusCompleted).Save(ctx); err != nil {
 		return fmt.Errorf("unable to complete utxo swap: %w", err)
 	}
+	if err := notifyUtxoSwapCompleted(ctx, utxoSwap, transfer); err != nil {
+		return fmt.Errorf("unable to notify user of utxo swap completion: %w", err)
+	}
 	return nil
 }
 
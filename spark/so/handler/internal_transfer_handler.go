@@ -184,7 +184,7 @@ func (h *InternalTransferHandler) InitiateTransfer(ctx context.Context, req *pbi
 			return fmt.Errorf("failed to apply signatures to leaf direct from cpfp refund map for transfer id: %s and error: %w", req.TransferId, err)
 		}
 	}
-	_, _, err = h.createTransfer(
+	transfer, leafMap, err := h.createTransfer(
 		ctx,
 		req.TransferId,
 		transferType,
@@ -201,6 +201,55 @@ func (h *InternalTransferHandler) InitiateTransfer(ctx context.Context, req *pbi
 	if err != nil {
 		return fmt.Errorf("failed to initiate transfer for transfer id: %s and error: %w", req.TransferId, err)
 	}
+	if req.SparkPaymentIntent != "" {
+		if err := attachSatsSparkInvoiceToTransfer(ctx, transfer, leafMap, req.SparkPaymentIntent); err != nil {
+			return fmt.Errorf("failed to validate spark invoice for transfer id: %s and error: %w", req.TransferId, err)
+		}
+	}
+	if transferType == st.TransferTypeHtlc {
+		if len(keyTweakMap) == 0 {
+			return fmt.Errorf("htlc transfer id: %s must have a transfer package", req.TransferId)
+		}
+		if err := createHtlcPreimageRequest(ctx, transfer, req.PaymentHash); err != nil {
+			return err
+		}
+	}
+	if req.BatchId != "" {
+		batchID, err := uuid.Parse(req.BatchId)
+		if err != nil {
+			return fmt.Errorf("unable to parse batch_id as a uuid %s: %w", req.BatchId, err)
+		}
+		_, err = transfer.Update().SetBatchID(batchID).Save(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to set batch for transfer id: %s and error: %w", req.TransferId, err)
+		}
+	}
+	return nil
+}
+
+// InitiateBatchTransfer creates all transfers of a batch. The transfers are created in the same database
+// transaction, so either all of them are created or none is.
+func (h *InternalTransferHandler) InitiateBatchTransfer(ctx context.Context, req *pbinternal.InitiateBatchTransferRequest) error {
+	if len(req.Transfers) == 0 {
+		return fmt.Errorf("batch must contain at least one transfer")
+	}
+	for _, transferReq := range req.Transfers {
+		if transferReq.BatchId == "" || transferReq.BatchId != req.Transfers[0].BatchId {
+			return fmt.Errorf("all transfers in a batch must have the same batch id")
+		}
+	}
+	batchID, err := uuid.Parse(req.Transfers[0].BatchId)
+	if err != nil {
+		return fmt.Errorf("unable to parse batch_id as a uuid %s: %w", req.Transfers[0].BatchId, err)
+	}
+	if err := validateBatchIDUnused(ctx, batchID); err != nil {
+		return err
+	}
+	for _, transferReq := range req.Transfers {
+		if err := h.InitiateTransfer(ctx, transferReq); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
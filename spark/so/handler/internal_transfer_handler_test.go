@@ -2,12 +2,15 @@ package handler
 
 import (
 	"bytes"
+	mathrand "math/rand/v2"
 	"testing"
 	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/google/uuid"
+	"github.com/lightsparkdev/spark/common/keys"
 	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/db"
@@ -212,3 +215,37 @@ func TestFinalizeTransfer(t *testing.T) {
 		assert.Equal(t, directFromCpfpRefundTxUpdated, updatedLeaf2.DirectFromCpfpRefundTx)
 	})
 }
+
+func TestInitiateBatchTransferRejectsUsedBatchID(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{6})
+	sender := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	receiver := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+
+	batchID := uuid.New()
+	_, err = tx.Transfer.Create().
+		SetStatus(st.TransferStatusSenderInitiated).
+		SetType(st.TransferTypeTransfer).
+		SetSenderIdentityPubkey(sender).
+		SetReceiverIdentityPubkey(receiver).
+		SetTotalValue(1_000).
+		SetExpiryTime(time.Now().Add(time.Hour)).
+		SetBatchID(batchID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, validateBatchIDUnused(ctx, uuid.New()))
+
+	// A batch cannot add transfers to one that already exists, since they would be cancelled together.
+	handler := NewInternalTransferHandler(config)
+	err = handler.InitiateBatchTransfer(ctx, &pbinternal.InitiateBatchTransferRequest{
+		Transfers: []*pbinternal.InitiateTransferRequest{{TransferId: uuid.NewString(), BatchId: batchID.String()}},
+	})
+	require.ErrorContains(t, err, "already has transfers")
+}
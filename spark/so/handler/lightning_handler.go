@@ -26,10 +26,13 @@ import (
 	"github.com/lightsparkdev/spark/so/authn"
 	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/predicate"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	"github.com/lightsparkdev/spark/so/ent/preimageshare"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	enttransfer "github.com/lightsparkdev/spark/so/ent/transfer"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/helper"
 	decodepay "github.com/nbd-wtf/ln-decodepay"
 	"google.golang.org/grpc"
@@ -51,6 +54,12 @@ func NewLightningHandler(config *so.Config) *LightningHandler {
 	return &LightningHandler{config: config}
 }
 
+// isLightningPreimageRequest excludes the preimage requests that lock HTLC transfers. Those are looked up by
+// transfer id, so they never collide with lightning payments to the same payment hash and receiver.
+func isLightningPreimageRequest() predicate.PreimageRequest {
+	return preimagerequest.Not(preimagerequest.HasTransfersWith(enttransfer.TypeEQ(st.TransferTypeHtlc)))
+}
+
 // StorePreimageShare stores the preimage share for the given payment hash.
 func (h *LightningHandler) StorePreimageShare(ctx context.Context, req *pb.StorePreimageShareRequest) error {
 	if req.PreimageShare == nil {
@@ -318,6 +327,7 @@ func (h *LightningHandler) validateGetPreimageRequestWithFrostServiceClientFacto
 		preimagerequest.PaymentHashEQ(paymentHash),
 		preimagerequest.ReceiverIdentityPubkeyEQ(destinationPubKey.Serialize()),
 		preimagerequest.StatusNEQ(st.PreimageRequestStatusReturned),
+		isLightningPreimageRequest(),
 	).All(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to get preimage request with paymentHash %x: %w ", paymentHash, err)
@@ -1059,6 +1069,9 @@ func (h *LightningHandler) initiatePreimageSwap(ctx context.Context, req *pb.Ini
 	if err != nil {
 		return nil, fmt.Errorf("unable to update preimage request status for payment hash: %x and transfer id: %s: %w", req.PaymentHash, transfer.ID.String(), err)
 	}
+	if err := notifyPreimageRequestStatus(ctx, preimageRequest, st.PreimageRequestStatusPreimageShared); err != nil {
+		return nil, fmt.Errorf("unable to notify users of preimage request status for payment hash: %x and transfer id: %s: %w", req.PaymentHash, transfer.ID.String(), err)
+	}
 
 	return &pb.InitiatePreimageSwapResponse{Preimage: secretBytes, Transfer: transferProto}, nil
 }
@@ -1077,6 +1090,7 @@ func (h *LightningHandler) UpdatePreimageRequest(ctx context.Context, req *pbint
 			preimagerequest.PaymentHashEQ(paymentHash[:]),
 			preimagerequest.ReceiverIdentityPubkeyEQ(req.IdentityPublicKey),
 			preimagerequest.StatusEQ(st.PreimageRequestStatusWaitingForPreimage),
+			isLightningPreimageRequest(),
 		),
 	).First(ctx)
 	if err != nil {
@@ -1088,6 +1102,9 @@ func (h *LightningHandler) UpdatePreimageRequest(ctx context.Context, req *pbint
 	if err != nil {
 		return fmt.Errorf("unable to update preimage request status: %w", err)
 	}
+	if err := notifyPreimageRequestStatus(ctx, preimageRequest, st.PreimageRequestStatusPreimageShared); err != nil {
+		return fmt.Errorf("unable to notify users of preimage request status: %w", err)
+	}
 	return nil
 }
 
@@ -1104,6 +1121,7 @@ func (h *LightningHandler) QueryUserSignedRefunds(ctx context.Context, req *pb.Q
 			preimagerequest.PaymentHashEQ(req.PaymentHash),
 			preimagerequest.ReceiverIdentityPubkeyEQ(req.IdentityPublicKey),
 			preimagerequest.StatusEQ(st.PreimageRequestStatusWaitingForPreimage),
+			isLightningPreimageRequest(),
 		),
 	).First(ctx)
 	if err != nil {
@@ -1170,7 +1188,14 @@ func (h *LightningHandler) QueryUserSignedRefunds(ctx context.Context, req *pb.Q
 	}, nil
 }
 
+// ValidatePreimage records the preimage for the preimage request it unlocks and returns the locked transfer.
 func (h *LightningHandler) ValidatePreimage(ctx context.Context, req *pb.ProvidePreimageRequest) (*ent.Transfer, error) {
+	return h.validatePreimage(ctx, req, 0)
+}
+
+// validatePreimage records the preimage like ValidatePreimage. An HTLC transfer only accepts a preimage until
+// htlcExpiryGracePeriod after its expiry.
+func (h *LightningHandler) validatePreimage(ctx context.Context, req *pb.ProvidePreimageRequest, htlcExpiryGracePeriod time.Duration) (*ent.Transfer, error) {
 	logger := logging.GetLoggerFromContext(ctx)
 	tx, err := ent.GetDbFromContext(ctx)
 	if err != nil {
@@ -1182,19 +1207,35 @@ func (h *LightningHandler) ValidatePreimage(ctx context.Context, req *pb.Provide
 		return nil, fmt.Errorf("invalid preimage")
 	}
 
-	preimageRequest, err := tx.PreimageRequest.Query().Where(
-		preimagerequest.And(
-			preimagerequest.PaymentHashEQ(req.PaymentHash),
-			preimagerequest.ReceiverIdentityPubkeyEQ(req.IdentityPublicKey),
-			preimagerequest.StatusIn(st.PreimageRequestStatusWaitingForPreimage, st.PreimageRequestStatusPreimageShared),
-		),
-	).First(ctx)
+	predicates := []predicate.PreimageRequest{
+		preimagerequest.PaymentHashEQ(req.PaymentHash),
+		preimagerequest.ReceiverIdentityPubkeyEQ(req.IdentityPublicKey),
+		preimagerequest.StatusIn(st.PreimageRequestStatusWaitingForPreimage, st.PreimageRequestStatusPreimageShared),
+	}
+	if req.TransferId != "" {
+		transferID, err := uuid.Parse(req.TransferId)
+		if err != nil {
+			return nil, errors.InvalidUserInputErrorf("invalid transfer id %s: %v", req.TransferId, err)
+		}
+		predicates = append(predicates, preimagerequest.HasTransfersWith(enttransfer.ID(transferID), enttransfer.TypeEQ(st.TransferTypeHtlc)))
+	} else {
+		predicates = append(predicates, isLightningPreimageRequest())
+	}
+	preimageRequest, err := tx.PreimageRequest.Query().Where(predicates...).First(ctx)
 	if err != nil {
 		logger.Error("ProvidePreimage: unable to get preimage request", "error", err, "paymentHash", hex.EncodeToString(req.PaymentHash), "identityPublicKey", hex.EncodeToString(req.IdentityPublicKey))
 		return nil, fmt.Errorf("ProvidePreimage: unable to get preimage request: %w", err)
 	}
 
+	transfer, err := preimageRequest.QueryTransfers().Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get transfer: %w", err)
+	}
+
 	if preimageRequest.Status == st.PreimageRequestStatusWaitingForPreimage {
+		if transfer.Type == st.TransferTypeHtlc && !transfer.ExpiryTime.Add(htlcExpiryGracePeriod).After(time.Now()) {
+			return nil, errors.FailedPreconditionErrorf("htlc transfer %s expired at %s", transfer.ID.String(), transfer.ExpiryTime.String())
+		}
 		preimageRequest, err = preimageRequest.Update().
 			SetStatus(st.PreimageRequestStatusPreimageShared).
 			SetPreimage(req.Preimage).
@@ -1202,22 +1243,24 @@ func (h *LightningHandler) ValidatePreimage(ctx context.Context, req *pb.Provide
 		if err != nil {
 			return nil, fmt.Errorf("unable to update preimage request status: %w", err)
 		}
-	}
-
-	transfer, err := preimageRequest.QueryTransfers().Only(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("unable to get transfer: %w", err)
+		if err := notifyPreimageRequestStatus(ctx, preimageRequest, st.PreimageRequestStatusPreimageShared); err != nil {
+			return nil, fmt.Errorf("unable to notify users of preimage request status: %w", err)
+		}
 	}
 	return transfer, nil
 }
 
+// ValidatePreimageInternal records the preimage provided to the coordinator. Operators accept the preimage of an
+// HTLC transfer until HtlcReturnDelay after its expiry, so that a preimage the coordinator accepted in time reaches
+// every operator, but none records it once the transfer can be returned to the sender.
 func (h *LightningHandler) ValidatePreimageInternal(ctx context.Context, req *pbinternal.ProvidePreimageRequest) (*ent.Transfer, error) {
 	providePreimageRequest := &pb.ProvidePreimageRequest{
 		PaymentHash:       req.PaymentHash,
 		Preimage:          req.Preimage,
 		IdentityPublicKey: req.IdentityPublicKey,
+		TransferId:        req.TransferId,
 	}
-	transfer, err := h.ValidatePreimage(ctx, providePreimageRequest)
+	transfer, err := h.validatePreimage(ctx, providePreimageRequest, HtlcReturnDelay)
 	if err != nil {
 		return nil, fmt.Errorf("unable to validate preimage: %w", err)
 	}
@@ -1243,7 +1286,6 @@ func (h *LightningHandler) ProvidePreimage(ctx context.Context, req *pb.ProvideP
 
 		return &pb.ProvidePreimageResponse{Transfer: transferProto}, nil
 	}
-
 	transferLeaves, err := transfer.QueryTransferLeaves().All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get transfer leaves: %w", err)
@@ -1252,6 +1294,7 @@ func (h *LightningHandler) ProvidePreimage(ctx context.Context, req *pb.ProvideP
 		PaymentHash:       req.PaymentHash,
 		Preimage:          req.Preimage,
 		IdentityPublicKey: req.IdentityPublicKey,
+		TransferId:        req.TransferId,
 	}
 	keyTweakProofMap := make(map[string]*pb.SecretProof)
 	for _, leaf := range transferLeaves {
@@ -1342,6 +1385,7 @@ func (h *LightningHandler) ReturnLightningPayment(ctx context.Context, req *pb.R
 			preimagerequest.PaymentHashEQ(req.PaymentHash),
 			preimagerequest.ReceiverIdentityPubkeyEQ(req.UserIdentityPublicKey),
 			preimagerequest.StatusIn(preimageRequestStatuses...),
+			isLightningPreimageRequest(),
 		),
 	).First(ctx)
 	if err != nil {
@@ -1362,6 +1406,9 @@ func (h *LightningHandler) ReturnLightningPayment(ctx context.Context, req *pb.R
 	if err != nil {
 		return nil, fmt.Errorf("unable to update preimage request status: %w", err)
 	}
+	if err := notifyPreimageRequestStatus(ctx, preimageRequest, st.PreimageRequestStatusReturned); err != nil {
+		return nil, fmt.Errorf("unable to notify users of preimage request status: %w", err)
+	}
 
 	transfer, err := preimageRequest.QueryTransfers().Only(ctx)
 	if err != nil {
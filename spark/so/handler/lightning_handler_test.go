@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"math/rand/v2"
 	"testing"
@@ -13,6 +14,7 @@ import (
 	pbcommon "github.com/lightsparkdev/spark/proto/common"
 	pbfrost "github.com/lightsparkdev/spark/proto/frost"
 	pb "github.com/lightsparkdev/spark/proto/spark"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/authn"
 	"github.com/lightsparkdev/spark/so/authninternal"
@@ -474,6 +476,86 @@ func TestValidatePreimage(t *testing.T) {
 	}
 }
 
+func TestValidateHtlcPreimage(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	config := &so.Config{FrostGRPCConnectionFactory: &sparktesting.TestGRPCConnectionFactory{}}
+	lightningHandler := NewLightningHandler(config)
+
+	rng := rand.NewChaCha8([32]byte{8})
+	sender := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	receiver := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	preimage := []byte("htlc transfer preimage 000000001")
+	paymentHash := sha256.Sum256(preimage)
+
+	createLockedTransfer := func(transferType st.TransferType, expiryTime time.Time) *ent.Transfer {
+		transfer, err := tx.Transfer.Create().
+			SetStatus(st.TransferStatusSenderKeyTweakPending).
+			SetType(transferType).
+			SetSenderIdentityPubkey(sender).
+			SetReceiverIdentityPubkey(receiver).
+			SetTotalValue(1_000).
+			SetExpiryTime(expiryTime).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = tx.PreimageRequest.Create().
+			SetPaymentHash(paymentHash[:]).
+			SetReceiverIdentityPubkey(receiver).
+			SetStatus(st.PreimageRequestStatusWaitingForPreimage).
+			SetTransfers(transfer).
+			Save(ctx)
+		require.NoError(t, err)
+		return transfer
+	}
+	providePreimageRequest := func(transferID string) *pb.ProvidePreimageRequest {
+		return &pb.ProvidePreimageRequest{
+			PaymentHash:       paymentHash[:],
+			Preimage:          preimage,
+			IdentityPublicKey: receiver,
+			TransferId:        transferID,
+		}
+	}
+
+	// HTLC transfers to the same payment hash and receiver don't get in the way of a lightning payment.
+	htlcTransfer := createLockedTransfer(st.TransferTypeHtlc, time.Now().Add(time.Hour))
+	lightningTransfer := createLockedTransfer(st.TransferTypePreimageSwap, time.Now().Add(time.Hour))
+	transfer, err := lightningHandler.ValidatePreimage(ctx, providePreimageRequest(""))
+	require.NoError(t, err)
+	assert.Equal(t, lightningTransfer.ID, transfer.ID)
+	transfer, err = lightningHandler.ValidatePreimage(ctx, providePreimageRequest(htlcTransfer.ID.String()))
+	require.NoError(t, err)
+	assert.Equal(t, htlcTransfer.ID, transfer.ID)
+
+	// A lightning transfer can't be unlocked as an HTLC transfer.
+	_, err = lightningHandler.ValidatePreimage(ctx, providePreimageRequest(lightningTransfer.ID.String()))
+	require.ErrorContains(t, err, "unable to get preimage request")
+
+	// The coordinator only accepts the preimage before the expiry, while the other operators accept it until the
+	// transfer can be returned.
+	returnDelayedTransfer := createLockedTransfer(st.TransferTypeHtlc, time.Now().Add(-time.Minute))
+	_, err = lightningHandler.ValidatePreimage(ctx, providePreimageRequest(returnDelayedTransfer.ID.String()))
+	require.ErrorContains(t, err, "expired")
+	transfer, err = lightningHandler.validatePreimage(ctx, providePreimageRequest(returnDelayedTransfer.ID.String()), HtlcReturnDelay)
+	require.NoError(t, err)
+	assert.Equal(t, returnDelayedTransfer.ID, transfer.ID)
+
+	expiredTransfer := createLockedTransfer(st.TransferTypeHtlc, time.Now().Add(-HtlcReturnDelay-time.Minute))
+	_, err = lightningHandler.ValidatePreimageInternal(ctx, &pbinternal.ProvidePreimageRequest{
+		PaymentHash:       paymentHash[:],
+		Preimage:          preimage,
+		IdentityPublicKey: receiver,
+		TransferId:        expiredTransfer.ID.String(),
+	})
+	require.ErrorContains(t, err, "expired")
+
+	// Once the preimage is recorded, the transfer is no longer returned to the sender.
+	require.NoError(t, validateHtlcPreimageNotShared(ctx, expiredTransfer))
+	require.ErrorContains(t, validateHtlcPreimageNotShared(ctx, returnDelayedTransfer), "preimage was provided")
+}
+
 func TestReturnLightningPayment(t *testing.T) {
 	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
 	defer dbCtx.Close()
@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/common/logging"
 	pbgossip "github.com/lightsparkdev/spark/proto/gossip"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent"
+	entgossip "github.com/lightsparkdev/spark/so/ent/gossip"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/knobs"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SendGossipHandler struct {
@@ -24,17 +31,65 @@ func NewSendGossipHandler(config *so.Config) *SendGossipHandler {
 	return &SendGossipHandler{config: config}
 }
 
+const (
+	// gossipBaseRetryBackoff is the delay before retrying a participant after its first failed attempt.
+	gossipBaseRetryBackoff = 1 * time.Minute
+	// gossipMaxRetryBackoff caps the delay between attempts to a participant.
+	gossipMaxRetryBackoff = 1 * time.Hour
+	// defaultGossipTTL is how long a message is retried before it is marked as FAILED.
+	defaultGossipTTL = 24 * time.Hour
+)
+
+// gossipRetryBackoff returns the delay before the next attempt to a participant that has failed
+// the given number of attempts. The delay doubles with each failed attempt.
+func gossipRetryBackoff(attempts int) time.Duration {
+	backoff := gossipBaseRetryBackoff
+	for i := 1; i < attempts && backoff < gossipMaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, gossipMaxRetryBackoff)
+}
+
+// gossipTTL returns how long a new or replayed message is retried before it is marked as FAILED.
+func gossipTTL(ctx context.Context) time.Duration {
+	return knobs.GetDurationSeconds(knobs.GetKnobsService(ctx), knobs.KnobSoGossipTTL, defaultGossipTTL)
+}
+
 func (h *SendGossipHandler) postSendingGossipMessage(
 	ctx context.Context,
 	message *pbgossip.GossipMessage,
 	gossip *ent.Gossip,
 	bitMap *common.BitMap,
+	attempts []int,
+	nextAttemptTimes []time.Time,
+	now time.Time,
 ) (*ent.Gossip, error) {
-	newStatus := st.GossipStatusPending
-	if bitMap.IsAllSet() {
-		newStatus = st.GossipStatusDelivered
+	update := gossip.Update().SetReceipts(bitMap.Bytes()).SetAttempts(attempts).SetNextAttemptTimes(nextAttemptTimes)
+	switch {
+	case bitMap.IsAllSet():
+		update = update.SetStatus(st.GossipStatusDelivered).ClearNextAttemptTime()
+	case gossip.ExpiryTime != nil && !now.Before(*gossip.ExpiryTime):
+		var undelivered []string
+		for i, participant := range gossip.Participants {
+			if !bitMap.Get(i) {
+				undelivered = append(undelivered, participant)
+			}
+		}
+		logging.GetLoggerFromContext(ctx).Error("gossip message expired before delivery",
+			"gossip_id", gossip.ID.String(),
+			"undelivered_participants", undelivered,
+		)
+		update = update.SetStatus(st.GossipStatusFailed).ClearNextAttemptTime()
+	default:
+		var nextAttemptTime time.Time
+		for i, t := range nextAttemptTimes {
+			if !bitMap.Get(i) && (nextAttemptTime.IsZero() || t.Before(nextAttemptTime)) {
+				nextAttemptTime = t
+			}
+		}
+		update = update.SetStatus(st.GossipStatusPending).SetNextAttemptTime(nextAttemptTime)
 	}
-	gossip, err := gossip.Update().SetStatus(newStatus).SetReceipts(bitMap.Bytes()).Save(ctx)
+	gossip, err := update.Save(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -76,26 +131,40 @@ func (h *SendGossipHandler) sendGossipMessageToParticipant(ctx context.Context,
 }
 
 func (h *SendGossipHandler) CreateAndSendGossipMessage(ctx context.Context, gossipMsg *pbgossip.GossipMessage, participants []string) (*ent.Gossip, error) {
-	db, err := ent.GetDbFromContext(ctx)
+	gossip, err := h.CreateGossipMessage(ctx, gossipMsg, participants)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+		return nil, err
 	}
-	messageBytes, err := proto.Marshal(gossipMsg)
+	gossip, err = h.SendGossipMessage(ctx, gossip)
 	if err != nil {
 		return nil, err
 	}
-	receipts := common.NewBitMap(len(participants)).Bytes()
-	gossip, err := db.Gossip.Create().SetMessage(messageBytes).SetParticipants(participants).SetReceipts(receipts).Save(ctx)
+	return gossip, nil
+}
+
+// CreateGossipMessage records a gossip message without sending it. The message is sent by SendGossipMessage, or
+// by the gossip retry task if it is never sent directly.
+func (h *SendGossipHandler) CreateGossipMessage(ctx context.Context, gossipMsg *pbgossip.GossipMessage, participants []string) (*ent.Gossip, error) {
+	db, err := ent.GetDbFromContext(ctx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
 	}
-	gossip, err = h.SendGossipMessage(ctx, gossip)
+	messageBytes, err := proto.Marshal(gossipMsg)
 	if err != nil {
 		return nil, err
 	}
-	return gossip, nil
+	receipts := common.NewBitMap(len(participants)).Bytes()
+	return db.Gossip.Create().
+		SetMessage(messageBytes).
+		SetParticipants(participants).
+		SetReceipts(receipts).
+		SetExpiryTime(time.Now().Add(gossipTTL(ctx))).
+		Save(ctx)
 }
 
+// SendGossipMessage sends the message to every participant that has not received it and is due
+// for another attempt. Participants that fail are retried with exponential backoff until the
+// message expires, at which point it is marked as FAILED.
 func (h *SendGossipHandler) SendGossipMessage(ctx context.Context, gossip *ent.Gossip) (*ent.Gossip, error) {
 	logger := logging.GetLoggerFromContext(ctx)
 	logger.Info("sending gossip message", "gossip_id", gossip.ID.String())
@@ -107,12 +176,21 @@ func (h *SendGossipHandler) SendGossipMessage(ctx context.Context, gossip *ent.G
 	}
 	message.MessageId = gossip.ID.String()
 
+	now := time.Now()
+	attempts := make([]int, len(gossip.Participants))
+	copy(attempts, gossip.Attempts)
+	nextAttemptTimes := make([]time.Time, len(gossip.Participants))
+	copy(nextAttemptTimes, gossip.NextAttemptTimes)
+	expired := gossip.ExpiryTime != nil && !now.Before(*gossip.ExpiryTime)
+
 	wg := sync.WaitGroup{}
 	success := make(chan int, len(gossip.Participants))
+	var attempted []int
 	for i, participant := range gossip.Participants {
-		if bitMap.Get(i) {
+		if expired || bitMap.Get(i) || now.Before(nextAttemptTimes[i]) {
 			continue
 		}
+		attempted = append(attempted, i)
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
@@ -130,9 +208,134 @@ func (h *SendGossipHandler) SendGossipMessage(ctx context.Context, gossip *ent.G
 	for i := range success {
 		bitMap.Set(i, true)
 	}
-	gossip, err := h.postSendingGossipMessage(ctx, message, gossip, bitMap)
+	for _, i := range attempted {
+		attempts[i]++
+		if !bitMap.Get(i) {
+			nextAttemptTimes[i] = now.Add(gossipRetryBackoff(attempts[i]))
+		}
+	}
+	gossip, err := h.postSendingGossipMessage(ctx, message, gossip, bitMap, attempts, nextAttemptTimes, now)
 	if err != nil {
 		return nil, err
 	}
 	return gossip, nil
 }
+
+// QueryFailedGossip lists the gossip messages that expired before every participant received them,
+// most recent first.
+func (h *SendGossipHandler) QueryFailedGossip(ctx context.Context, req *pbinternal.QueryFailedGossipRequest) (*pbinternal.QueryFailedGossipResponse, error) {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	limit := req.Limit
+	if limit > 100 || limit <= 0 {
+		limit = 100
+	}
+	query := db.Gossip.Query().
+		Where(entgossip.StatusEQ(st.GossipStatusFailed)).
+		Order(ent.Desc(entgossip.FieldCreateTime), ent.Asc(entgossip.FieldID)).
+		Limit(int(limit))
+	if req.Offset > 0 {
+		query = query.Offset(int(req.Offset))
+	}
+	gossips, err := query.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to query failed gossip: %w", err)
+	}
+
+	failedGossips := make([]*pbinternal.FailedGossip, len(gossips))
+	for i, gossip := range gossips {
+		failedGossips[i] = marshalFailedGossip(gossip)
+	}
+
+	nextOffset := int64(-1)
+	if len(gossips) == int(limit) {
+		nextOffset = req.Offset + int64(len(gossips))
+	}
+	return &pbinternal.QueryFailedGossipResponse{
+		Gossip: failedGossips,
+		Offset: nextOffset,
+	}, nil
+}
+
+func marshalFailedGossip(gossip *ent.Gossip) *pbinternal.FailedGossip {
+	bitMap := common.NewBitMapFromBytes(*gossip.Receipts, len(gossip.Participants))
+	participants := make([]*pbinternal.GossipParticipantDelivery, len(gossip.Participants))
+	for i, participant := range gossip.Participants {
+		var attempts uint32
+		if i < len(gossip.Attempts) {
+			attempts = uint32(gossip.Attempts[i])
+		}
+		participants[i] = &pbinternal.GossipParticipantDelivery{
+			Identifier: participant,
+			Delivered:  bitMap.Get(i),
+			Attempts:   attempts,
+		}
+	}
+
+	failedGossip := &pbinternal.FailedGossip{
+		Id:           gossip.ID.String(),
+		Message:      gossip.Message,
+		Participants: participants,
+		CreateTime:   timestamppb.New(gossip.CreateTime),
+	}
+	if gossip.ExpiryTime != nil {
+		failedGossip.ExpiryTime = timestamppb.New(*gossip.ExpiryTime)
+	}
+	return failedGossip
+}
+
+// ReplayFailedGossip moves failed gossip messages back to pending with a fresh TTL. Participants
+// that never received a message have their attempts reset so that the next run of the
+// send_gossip task delivers to them immediately.
+func (h *SendGossipHandler) ReplayFailedGossip(ctx context.Context, req *pbinternal.ReplayFailedGossipRequest) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	gossipIDs := make([]uuid.UUID, len(req.GossipIds))
+	for i, id := range req.GossipIds {
+		gossipID, err := uuid.Parse(id)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid gossip id %s: %v", id, err)
+		}
+		gossipIDs[i] = gossipID
+	}
+
+	gossips, err := db.Gossip.Query().Where(entgossip.IDIn(gossipIDs...)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to query gossip: %w", err)
+	}
+	if len(gossips) != len(gossipIDs) {
+		return status.Errorf(codes.NotFound, "found %d of %d gossip messages", len(gossips), len(gossipIDs))
+	}
+
+	expiryTime := time.Now().Add(gossipTTL(ctx))
+	for _, gossip := range gossips {
+		if gossip.Status != st.GossipStatusFailed {
+			return status.Errorf(codes.FailedPrecondition, "gossip %s is %s, only failed gossip can be replayed", gossip.ID, gossip.Status)
+		}
+		bitMap := common.NewBitMapFromBytes(*gossip.Receipts, len(gossip.Participants))
+		attempts := make([]int, len(gossip.Participants))
+		nextAttemptTimes := make([]time.Time, len(gossip.Participants))
+		for i := range gossip.Participants {
+			if bitMap.Get(i) && i < len(gossip.Attempts) {
+				attempts[i] = gossip.Attempts[i]
+			}
+		}
+		_, err := gossip.Update().
+			SetStatus(st.GossipStatusPending).
+			SetAttempts(attempts).
+			SetNextAttemptTimes(nextAttemptTimes).
+			ClearNextAttemptTime().
+			SetExpiryTime(expiryTime).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to replay gossip %s: %w", gossip.ID, err)
+		}
+	}
+	return nil
+}
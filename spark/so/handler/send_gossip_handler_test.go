@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	pbgossip "github.com/lightsparkdev/spark/proto/gossip"
+	pbinternal "github.com/lightsparkdev/spark/proto/spark_internal"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGossipRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: 1 * time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 3, want: 4 * time.Minute},
+		{attempts: 6, want: 32 * time.Minute},
+		{attempts: 7, want: 1 * time.Hour},
+		{attempts: 1000, want: 1 * time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, gossipRetryBackoff(tt.attempts), "attempts: %d", tt.attempts)
+	}
+}
+
+func TestSendGossipMessageBacksOffFailedParticipants(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	h := NewSendGossipHandler(&so.Config{SigningOperatorMap: map[string]*so.SigningOperator{}})
+
+	gossip, err := h.CreateAndSendGossipMessage(ctx, &pbgossip.GossipMessage{
+		Message: &pbgossip.GossipMessage_CancelTransfer{
+			CancelTransfer: &pbgossip.GossipMessageCancelTransfer{TransferId: uuid.NewString()},
+		},
+	}, []string{"unreachable"})
+	require.NoError(t, err)
+	assert.Equal(t, st.GossipStatusPending, gossip.Status)
+	assert.Equal(t, []int{1}, gossip.Attempts)
+	require.NotNil(t, gossip.NextAttemptTime)
+	assert.WithinDuration(t, time.Now().Add(gossipBaseRetryBackoff), *gossip.NextAttemptTime, 5*time.Second)
+	require.NotNil(t, gossip.ExpiryTime)
+	assert.WithinDuration(t, time.Now().Add(defaultGossipTTL), *gossip.ExpiryTime, 5*time.Second)
+
+	// The participant is not due for another attempt yet.
+	gossip, err = h.SendGossipMessage(ctx, gossip)
+	require.NoError(t, err)
+	assert.Equal(t, []int{1}, gossip.Attempts)
+
+	// Once it is due, it is attempted again and the backoff doubles.
+	gossip, err = gossip.Update().SetNextAttemptTimes([]time.Time{time.Now().Add(-time.Second)}).Save(ctx)
+	require.NoError(t, err)
+	gossip, err = h.SendGossipMessage(ctx, gossip)
+	require.NoError(t, err)
+	assert.Equal(t, st.GossipStatusPending, gossip.Status)
+	assert.Equal(t, []int{2}, gossip.Attempts)
+	assert.WithinDuration(t, time.Now().Add(2*gossipBaseRetryBackoff), *gossip.NextAttemptTime, 5*time.Second)
+}
+
+func TestFailedGossipCanBeListedAndReplayed(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	h := NewSendGossipHandler(&so.Config{SigningOperatorMap: map[string]*so.SigningOperator{}})
+
+	gossip, err := h.CreateAndSendGossipMessage(ctx, &pbgossip.GossipMessage{
+		Message: &pbgossip.GossipMessage_CancelTransfer{
+			CancelTransfer: &pbgossip.GossipMessageCancelTransfer{TransferId: uuid.NewString()},
+		},
+	}, []string{"unreachable"})
+	require.NoError(t, err)
+
+	// Replaying a message that has not failed is rejected.
+	err = h.ReplayFailedGossip(ctx, &pbinternal.ReplayFailedGossipRequest{GossipIds: []string{gossip.ID.String()}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// An expired message is marked as failed without another attempt.
+	gossip, err = gossip.Update().SetExpiryTime(time.Now().Add(-time.Second)).Save(ctx)
+	require.NoError(t, err)
+	gossip, err = h.SendGossipMessage(ctx, gossip)
+	require.NoError(t, err)
+	assert.Equal(t, st.GossipStatusFailed, gossip.Status)
+	assert.Equal(t, []int{1}, gossip.Attempts)
+	assert.Nil(t, gossip.NextAttemptTime)
+
+	resp, err := h.QueryFailedGossip(ctx, &pbinternal.QueryFailedGossipRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Gossip, 1)
+	assert.Equal(t, gossip.ID.String(), resp.Gossip[0].Id)
+	assert.Equal(t, gossip.Message, resp.Gossip[0].Message)
+	require.Len(t, resp.Gossip[0].Participants, 1)
+	assert.Equal(t, "unreachable", resp.Gossip[0].Participants[0].Identifier)
+	assert.False(t, resp.Gossip[0].Participants[0].Delivered)
+	assert.Equal(t, uint32(1), resp.Gossip[0].Participants[0].Attempts)
+	assert.Equal(t, int64(-1), resp.Offset)
+
+	err = h.ReplayFailedGossip(ctx, &pbinternal.ReplayFailedGossipRequest{GossipIds: []string{gossip.ID.String()}})
+	require.NoError(t, err)
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	gossip, err = tx.Gossip.Get(ctx, gossip.ID)
+	require.NoError(t, err)
+	assert.Equal(t, st.GossipStatusPending, gossip.Status)
+	assert.Equal(t, []int{0}, gossip.Attempts)
+	assert.Nil(t, gossip.NextAttemptTime)
+	assert.True(t, gossip.ExpiryTime.After(time.Now()))
+
+	resp, err = h.QueryFailedGossip(ctx, &pbinternal.QueryFailedGossipRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Gossip)
+
+	err = h.ReplayFailedGossip(ctx, &pbinternal.ReplayFailedGossipRequest{GossipIds: []string{uuid.NewString()}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common"
@@ -12,6 +14,8 @@ import (
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
+	enttransfer "github.com/lightsparkdev/spark/so/ent/transfer"
+	"github.com/lightsparkdev/spark/so/errors"
 )
 
 type SparkInvoiceHandler struct {
@@ -29,8 +33,10 @@ func (h *SparkInvoiceHandler) QuerySparkInvoices(ctx context.Context, req *spark
 	ctx, span := tracer.Start(ctx, "SparkInvoiceHandler.QuerySparkInvoices")
 	defer span.End()
 	invoiceParams := req.Invoice
-	satsInvoiceIds := make([]uuid.UUID, len(invoiceParams))
-	tokensInvoiceIds := make([]uuid.UUID, len(invoiceParams))
+	satsInvoices := make(map[uuid.UUID]*common.ParsedSparkInvoice)
+	satsInvoiceStrings := make(map[uuid.UUID]string)
+	satsInvoiceIds := make([]uuid.UUID, 0, len(invoiceParams))
+	tokensInvoiceIds := make([]uuid.UUID, 0, len(invoiceParams))
 	for _, invoice := range invoiceParams {
 		parsedInvoice, err := common.ParseSparkInvoice(invoice)
 		if err != nil {
@@ -42,7 +48,11 @@ func (h *SparkInvoiceHandler) QuerySparkInvoices(ctx context.Context, req *spark
 		}
 		switch parsedInvoice.Payment.Kind {
 		case common.PaymentKindSats:
-			satsInvoiceIds = append(satsInvoiceIds, id)
+			if _, exists := satsInvoices[id]; !exists {
+				satsInvoices[id] = parsedInvoice
+				satsInvoiceStrings[id] = invoice
+				satsInvoiceIds = append(satsInvoiceIds, id)
+			}
 		case common.PaymentKindTokens:
 			tokensInvoiceIds = append(tokensInvoiceIds, id)
 		default:
@@ -53,34 +63,195 @@ func (h *SparkInvoiceHandler) QuerySparkInvoices(ctx context.Context, req *spark
 	if err != nil {
 		return nil, fmt.Errorf("failed to get db from context: %w", err)
 	}
-	baseQuery := db.SparkInvoice.Query()
+
+	sparkInvoicesResponse := make([]*sparkpb.InvoiceResponse, 0)
+	if len(tokensInvoiceIds) > 0 {
+		sparkInvoices, err := db.SparkInvoice.Query().
+			Where(
+				sparkinvoice.IDIn(tokensInvoiceIds...),
+				sparkinvoice.HasTokenTransactionWith(
+					tokentransaction.StatusEQ(st.TokenTransactionStatusFinalized),
+				),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get spark invoices: %w", err)
+		}
+		for _, sparkInvoice := range sparkInvoices {
+			sparkInvoicesResponse = append(sparkInvoicesResponse, &sparkpb.InvoiceResponse{
+				Invoice: sparkInvoice.SparkInvoice,
+				Status:  sparkpb.InvoiceStatus_FINALIZED,
+			})
+		}
+	}
 
 	if len(satsInvoiceIds) > 0 {
-		return nil, fmt.Errorf("sats invoices are not supported yet")
+		sparkInvoices, err := db.SparkInvoice.Query().
+			Where(sparkinvoice.IDIn(satsInvoiceIds...)).
+			WithTransfer().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get spark invoices: %w", err)
+		}
+		transfersByInvoice := make(map[uuid.UUID][]*ent.Transfer)
+		for _, sparkInvoice := range sparkInvoices {
+			transfersByInvoice[sparkInvoice.ID] = sparkInvoice.Edges.Transfer
+		}
+		now := time.Now()
+		for _, id := range satsInvoiceIds {
+			status := satsSparkInvoiceStatus(satsInvoices[id], transfersByInvoice[id], now)
+			if status == sparkpb.InvoiceStatus_NOT_FOUND {
+				continue
+			}
+			sparkInvoicesResponse = append(sparkInvoicesResponse, &sparkpb.InvoiceResponse{
+				Invoice: satsInvoiceStrings[id],
+				Status:  status,
+			})
+		}
 	}
-	if len(tokensInvoiceIds) > 0 {
-		baseQuery = baseQuery.Where(sparkinvoice.IDIn(tokensInvoiceIds...))
+
+	return &sparkpb.QuerySparkInvoicesResponse{
+		InvoiceStatuses: sparkInvoicesResponse,
+	}, nil
+}
+
+// satsSparkInvoiceStatus returns the status of a sats invoice given the transfers that were used to pay it.
+// An invoice is finalized once a paying transfer completes, pending while one is in flight, and expired once
+// its expiry has passed without either.
+func satsSparkInvoiceStatus(parsedInvoice *common.ParsedSparkInvoice, transfers []*ent.Transfer, now time.Time) sparkpb.InvoiceStatus {
+	pending := false
+	for _, transfer := range transfers {
+		switch transfer.Status {
+		case st.TransferStatusCompleted:
+			return sparkpb.InvoiceStatus_FINALIZED
+		case st.TransferStatusExpired, st.TransferStatusReturned:
+		default:
+			pending = true
+		}
 	}
+	if pending {
+		return sparkpb.InvoiceStatus_PENDING
+	}
+	if expiry := parsedInvoice.ExpiryTime; expiry != nil && expiry.AsTime().Before(now) {
+		return sparkpb.InvoiceStatus_EXPIRED
+	}
+	return sparkpb.InvoiceStatus_NOT_FOUND
+}
 
-	sparkInvoicesQuery := baseQuery.Where(
-		sparkinvoice.HasTokenTransactionWith(
-			tokentransaction.StatusEQ(st.TokenTransactionStatusFinalized),
-		),
-	)
-	sparkInvoices, err := sparkInvoicesQuery.All(ctx)
+// attachSatsSparkInvoiceToTransfer validates that the transfer pays the sats spark invoice, links the invoice to the
+// transfer and records it as the transfer's payment intent. An invoice can only be paid once; transfers that expired
+// or were returned no longer count as paying it.
+func attachSatsSparkInvoiceToTransfer(ctx context.Context, transfer *ent.Transfer, leafMap map[string]*ent.TreeNode, invoice string) error {
+	if transfer.Type != st.TransferTypeTransfer {
+		return errors.InvalidUserInputErrorf("spark invoices can only be paid by transfers, got transfer type %s", transfer.Type)
+	}
+	decoded, err := common.DecodeSparkAddress(invoice)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get spark invoices: %w", err)
+		return errors.InvalidUserInputErrorf("failed to decode spark invoice %s: %v", invoice, err)
+	}
+	parsedInvoice, err := common.ParseSparkInvoice(invoice)
+	if err != nil {
+		return errors.InvalidUserInputErrorf("failed to parse spark invoice %s: %v", invoice, err)
+	}
+	if parsedInvoice.Version != 1 {
+		return errors.InvalidUserInputErrorf("version mismatch in invoice %s", invoice)
+	}
+	if parsedInvoice.Payment.Kind != common.PaymentKindSats {
+		return errors.InvalidUserInputErrorf("not a sats payment in invoice %s", invoice)
+	}
+	invoiceID, err := uuid.FromBytes(parsedInvoice.Id)
+	if err != nil {
+		return errors.InvalidUserInputErrorf("failed to parse spark invoice ID in invoice %s: %v", invoice, err)
+	}
+	if !bytes.Equal(parsedInvoice.ReceiverPublicKey, transfer.ReceiverIdentityPubkey) {
+		return errors.InvalidUserInputErrorf("transfer receiver does not match the receiver of invoice %s", invoice)
+	}
+	if parsedInvoice.SenderPublicKey != nil && !bytes.Equal(parsedInvoice.SenderPublicKey, transfer.SenderIdentityPubkey) {
+		return errors.InvalidUserInputErrorf("transfer sender does not match the sender of invoice %s", invoice)
+	}
+	if expiry := parsedInvoice.ExpiryTime; expiry != nil {
+		if err := expiry.CheckValid(); err != nil {
+			return errors.InvalidUserInputErrorf("invalid expiry time in invoice %s: %v", invoice, err)
+		}
+		if expiry.AsTime().Before(time.Now()) {
+			return errors.InvalidUserInputErrorf("expired invoice %s", invoice)
+		}
+	}
+	if decoded.SparkAddress.Signature != nil {
+		if err := common.VerifySparkAddressSignature(decoded.SparkAddress, decoded.Network); err != nil {
+			return errors.InvalidUserInputErrorf("invalid signature in invoice %s: %v", invoice, err)
+		}
 	}
 
-	sparkInvoicesResponse := make([]*sparkpb.InvoiceResponse, 0)
-	for _, sparkInvoice := range sparkInvoices {
-		sparkInvoicesResponse = append(sparkInvoicesResponse, &sparkpb.InvoiceResponse{
-			Invoice: sparkInvoice.SparkInvoice,
-			Status:  sparkpb.InvoiceStatus_FINALIZED,
-		})
+	var transferValue uint64
+	var anyLeaf *ent.TreeNode
+	for _, leaf := range leafMap {
+		transferValue += leaf.Value
+		anyLeaf = leaf
+	}
+	if amount := parsedInvoice.Payment.SatsPayment.Amount; amount != nil && *amount != transferValue {
+		return errors.InvalidUserInputErrorf("transfer value %d does not match amount %d of invoice %s", transferValue, *amount, invoice)
+	}
+	if anyLeaf == nil {
+		return fmt.Errorf("transfer %s has no leaves", transfer.ID)
+	}
+	// Leaves of a transfer are all on the same network, so checking one is enough.
+	tree, err := anyLeaf.QueryTree().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get tree of leaf %s: %w", anyLeaf.ID, err)
+	}
+	network, err := common.SchemaNetworkFromNetwork(decoded.Network)
+	if err != nil {
+		return errors.InvalidUserInputErrorf("invalid network in invoice %s: %v", invoice, err)
+	}
+	if tree.Network != network {
+		return errors.InvalidUserInputErrorf("transfer network %s does not match network %s of invoice %s", tree.Network, network, invoice)
 	}
 
-	return &sparkpb.QuerySparkInvoicesResponse{
-		InvoiceStatuses: sparkInvoicesResponse,
-	}, nil
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get db from context: %w", err)
+	}
+	sparkInvoice, err := db.SparkInvoice.Get(ctx, invoiceID)
+	if ent.IsNotFound(err) {
+		invoiceToCreate := db.SparkInvoice.Create().
+			SetID(invoiceID).
+			SetSparkInvoice(invoice).
+			SetReceiverPublicKey(parsedInvoice.ReceiverPublicKey)
+		if expiry := parsedInvoice.ExpiryTime; expiry != nil {
+			invoiceToCreate = invoiceToCreate.SetExpiryTime(expiry.AsTime())
+		}
+		sparkInvoice, err = invoiceToCreate.Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create spark invoice: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to get spark invoice: %w", err)
+	} else if sparkInvoice.SparkInvoice != invoice {
+		return errors.InvalidUserInputErrorf("spark invoice ID %s was already used by a different invoice", invoiceID)
+	}
+
+	payingTransferExists, err := sparkInvoice.QueryTransfer().
+		Where(
+			enttransfer.IDNEQ(transfer.ID),
+			enttransfer.StatusNotIn(st.TransferStatusExpired, st.TransferStatusReturned),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query transfers paying spark invoice: %w", err)
+	}
+	if payingTransferExists {
+		return errors.AlreadyExistsErrorf("spark invoice %s is already paid or being paid by another transfer", invoice)
+	}
+
+	// The check above can race with a concurrent transfer paying the same invoice. The unique index on active
+	// transfers of an invoice rejects whichever of them links the invoice second.
+	_, err = transfer.Update().SetSparkInvoice(sparkInvoice).Save(ctx)
+	if ent.IsConstraintError(err) {
+		return errors.AlreadyExistsErrorf("spark invoice %s is already paid or being paid by another transfer", invoice)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to link spark invoice to transfer: %w", err)
+	}
+	return ent.AttachPaymentIntentToTransfer(ctx, transfer, invoice)
 }
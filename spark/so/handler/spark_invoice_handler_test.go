@@ -0,0 +1,140 @@
+package handler
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/common/keys"
+	sparkpb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	enttransfer "github.com/lightsparkdev/spark/so/ent/transfer"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+)
+
+func TestSatsSparkInvoiceTransfers(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{5})
+	sender := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	receiver := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	other := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+
+	signingKeyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusAvailable).
+		SetSecretShare([]byte("test_secret_share")).
+		SetPublicShares(map[string][]byte{"test": []byte("test_public_share")}).
+		SetPublicKey([]byte("test_public_key")).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	tree, err := tx.Tree.Create().
+		SetStatus(st.TreeStatusAvailable).
+		SetNetwork(st.NetworkRegtest).
+		SetOwnerIdentityPubkey(sender).
+		SetBaseTxid([]byte("test_base_txid")).
+		SetVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+	createTransfer := func(value uint64) (*ent.Transfer, map[string]*ent.TreeNode) {
+		leaf, err := tx.TreeNode.Create().
+			SetStatus(st.TreeNodeStatusTransferLocked).
+			SetTree(tree).
+			SetSigningKeyshare(signingKeyshare).
+			SetValue(value).
+			SetVerifyingPubkey([]byte("test_verifying_pubkey")).
+			SetOwnerIdentityPubkey(sender).
+			SetOwnerSigningPubkey([]byte("test_owner_signing")).
+			SetRawTx(createTestTxBytes(t, int64(value))).
+			SetRawRefundTx(createTestTxBytes(t, int64(value))).
+			SetVout(0).
+			Save(ctx)
+		require.NoError(t, err)
+		transfer, err := tx.Transfer.Create().
+			SetStatus(st.TransferStatusSenderInitiated).
+			SetType(st.TransferTypeTransfer).
+			SetSenderIdentityPubkey(sender).
+			SetReceiverIdentityPubkey(receiver).
+			SetTotalValue(value).
+			SetExpiryTime(time.Now().Add(time.Hour)).
+			Save(ctx)
+		require.NoError(t, err)
+		return transfer, map[string]*ent.TreeNode{leaf.ID.String(): leaf}
+	}
+	createInvoice := func(amount uint64, senderPublicKey []byte, expiry time.Time) string {
+		id, err := uuid.NewV7()
+		require.NoError(t, err)
+		fields := common.CreateSatsSparkInvoiceFields(id[:], &amount, nil, senderPublicKey, &expiry)
+		invoice, err := common.EncodeSparkAddress(receiver, common.Regtest, fields)
+		require.NoError(t, err)
+		return invoice
+	}
+	queryStatus := func(invoice string) sparkpb.InvoiceStatus {
+		resp, err := NewSparkInvoiceHandler(config).QuerySparkInvoices(ctx, &sparkpb.QuerySparkInvoicesRequest{
+			Invoice: []string{invoice},
+		})
+		require.NoError(t, err)
+		if len(resp.InvoiceStatuses) == 0 {
+			return sparkpb.InvoiceStatus_NOT_FOUND
+		}
+		require.Len(t, resp.InvoiceStatuses, 1)
+		assert.Equal(t, invoice, resp.InvoiceStatuses[0].Invoice)
+		return resp.InvoiceStatuses[0].Status
+	}
+
+	invoice := createInvoice(1000, sender, time.Now().Add(time.Hour))
+	assert.Equal(t, sparkpb.InvoiceStatus_NOT_FOUND, queryStatus(invoice))
+
+	transfer, leafMap := createTransfer(999)
+	require.ErrorContains(t, attachSatsSparkInvoiceToTransfer(ctx, transfer, leafMap, invoice), "does not match amount")
+	restrictedInvoice := createInvoice(1000, other, time.Now().Add(time.Hour))
+	transfer, leafMap = createTransfer(1000)
+	require.ErrorContains(t, attachSatsSparkInvoiceToTransfer(ctx, transfer, leafMap, restrictedInvoice), "does not match the sender")
+
+	require.NoError(t, attachSatsSparkInvoiceToTransfer(ctx, transfer, leafMap, invoice))
+	assert.Equal(t, sparkpb.InvoiceStatus_PENDING, queryStatus(invoice))
+	transferProto, err := transfer.MarshalProto(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, invoice, transferProto.SparkPaymentIntent)
+	loadedTransfer, err := tx.Transfer.Query().Where(enttransfer.IDEQ(transfer.ID)).WithPaymentIntent().Only(ctx)
+	require.NoError(t, err)
+	transferProto, err = loadedTransfer.MarshalProto(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, invoice, transferProto.SparkPaymentIntent)
+
+	secondTransfer, secondLeafMap := createTransfer(1000)
+	require.ErrorContains(t, attachSatsSparkInvoiceToTransfer(ctx, secondTransfer, secondLeafMap, invoice), "already paid")
+	// A concurrent transfer that got past the check cannot be linked to the invoice either.
+	invoiceID, err := ent.PaymentIntentID(invoice)
+	require.NoError(t, err)
+	_, err = secondTransfer.Update().SetSparkInvoiceID(invoiceID).Save(ctx)
+	require.True(t, ent.IsConstraintError(err))
+
+	// Once the first transfer is returned the invoice can be paid again.
+	_, err = transfer.Update().SetStatus(st.TransferStatusReturned).Save(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sparkpb.InvoiceStatus_NOT_FOUND, queryStatus(invoice))
+	require.NoError(t, attachSatsSparkInvoiceToTransfer(ctx, secondTransfer, secondLeafMap, invoice))
+	_, err = secondTransfer.Update().SetStatus(st.TransferStatusCompleted).Save(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, sparkpb.InvoiceStatus_FINALIZED, queryStatus(invoice))
+
+	expiredInvoice := createInvoice(1000, nil, time.Now().Add(-time.Minute))
+	assert.Equal(t, sparkpb.InvoiceStatus_EXPIRED, queryStatus(expiredInvoice))
+	transfer, leafMap = createTransfer(1000)
+	require.ErrorContains(t, attachSatsSparkInvoiceToTransfer(ctx, transfer, leafMap, expiredInvoice), "expired")
+}
@@ -3,11 +3,12 @@ package tokens
 import (
 	"context"
 	"fmt"
-	"github.com/lightsparkdev/spark/common/keys"
+	"time"
 
 	"github.com/lightsparkdev/spark/common"
 	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
 	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/authninternal"
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/tokens"
@@ -16,12 +17,14 @@ import (
 
 type FreezeTokenHandler struct {
 	config *so.Config
+	clock  authninternal.Clock
 }
 
 // NewFreezeTokenHandler creates a new FreezeTokenHandler.
 func NewFreezeTokenHandler(config *so.Config) *FreezeTokenHandler {
 	return &FreezeTokenHandler{
 		config: config,
+		clock:  authninternal.RealClock{},
 	}
 }
 
@@ -56,16 +59,16 @@ func (h *FreezeTokenHandler) FreezeTokens(
 			return nil, fmt.Errorf("failed to get single token for freeze request: %w", err)
 		}
 	}
-	expectedIssuerPublicKey, err := keys.ParsePublicKey(tokenCreateEnt.IssuerPublicKey)
+	expectedIssuerPublicKey, err := tokenCreateEnt.CurrentIssuerPublicKey(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse expected issuer public key: %w", err)
+		return nil, fmt.Errorf("failed to get expected issuer public key: %w", err)
 	}
 	if err := utils.ValidateOwnershipSignature(req.IssuerSignature, freezePayloadHash, expectedIssuerPublicKey); err != nil {
 		return nil, fmt.Errorf("invalid issuer signature %s to freeze token with identifier %x with issuer public key %x: %w", req.IssuerSignature, req.FreezeTokensPayload.TokenIdentifier, expectedIssuerPublicKey, err)
 	}
 
 	// Check for existing freeze.
-	activeFreezes, err := ent.GetActiveFreezes(ctx, [][]byte{req.FreezeTokensPayload.OwnerPublicKey}, tokenCreateEnt.ID)
+	activeFreezes, err := ent.GetActiveFreezesAt(ctx, [][]byte{req.FreezeTokensPayload.OwnerPublicKey}, tokenCreateEnt.ID, h.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", tokens.ErrFailedToQueryTokenFreezeStatus, err)
 	}
@@ -84,11 +87,17 @@ func (h *FreezeTokenHandler) FreezeTokens(
 		if len(activeFreezes) > 0 {
 			return nil, fmt.Errorf("%s", tokens.ErrAlreadyFrozen)
 		}
+		var expiresAt *time.Time
+		if expiry := req.FreezeTokensPayload.FreezeExpiryTimestamp; expiry != 0 {
+			expiryTime := time.UnixMilli(int64(expiry))
+			expiresAt = &expiryTime
+		}
 		err = ent.ActivateFreeze(ctx,
 			req.FreezeTokensPayload.OwnerPublicKey,
 			tokenCreateEnt.ID,
 			req.IssuerSignature,
 			req.FreezeTokensPayload.IssuerProvidedTimestamp,
+			expiresAt,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", tokens.ErrFailedToCreateTokenFreeze, err)
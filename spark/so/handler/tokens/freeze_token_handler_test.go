@@ -0,0 +1,119 @@
+package tokens
+
+import (
+	"crypto/rand"
+	mathrand "math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so/authninternal"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/tokens"
+	"github.com/lightsparkdev/spark/so/utils"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+)
+
+func TestFreezeTokensWithExpiry(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{3})
+	issuerKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	alice := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	bob := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+
+	tokenIdentifier := make([]byte, 32)
+	_, err = rand.Read(tokenIdentifier)
+	require.NoError(t, err)
+	tokenCreate, err := tx.TokenCreate.Create().
+		SetIssuerPublicKey(issuerKey.Public().Serialize()).
+		SetTokenName("TestToken").
+		SetTokenTicker("TTK").
+		SetDecimals(0).
+		SetMaxSupply(make([]byte, 16)).
+		SetIsFreezable(true).
+		SetNetwork(st.NetworkRegtest).
+		SetTokenIdentifier(tokenIdentifier).
+		SetCreationEntityPublicKey(config.IdentityPublicKey().Serialize()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	now := time.Now()
+	freezeRequest := func(owner []byte, issuedAt time.Time, expiresAt time.Time) *tokenpb.FreezeTokensRequest {
+		payload := &tokenpb.FreezeTokensPayload{
+			Version:                   1,
+			OwnerPublicKey:            owner,
+			TokenIdentifier:           tokenIdentifier,
+			IssuerProvidedTimestamp:   uint64(issuedAt.UnixMilli()),
+			OperatorIdentityPublicKey: config.IdentityPublicKey().Serialize(),
+		}
+		if !expiresAt.IsZero() {
+			payload.FreezeExpiryTimestamp = uint64(expiresAt.UnixMilli())
+		}
+		payloadHash, err := utils.HashFreezeTokensPayload(payload)
+		require.NoError(t, err)
+		return &tokenpb.FreezeTokensRequest{
+			FreezeTokensPayload: payload,
+			IssuerSignature:     ecdsa.Sign(issuerKey.ToBTCEC(), payloadHash).Serialize(),
+		}
+	}
+	freezeHandler := NewFreezeTokenHandler(config)
+	queryHandler := NewQueryTokenHandler(config)
+	clock := authninternal.NewTestClock(now.Add(-utils.TokenFreezeExpiryTolerance))
+	freezeHandler.clock = clock
+
+	// The expiry is validated against the issuer provided timestamp, not the operator's clock.
+	aliceIssuedAt := clock.Now()
+	_, err = freezeHandler.FreezeTokens(ctx, freezeRequest(alice, aliceIssuedAt, now.Add(-time.Millisecond)))
+	require.ErrorContains(t, err, "must be at least")
+	_, err = freezeHandler.FreezeTokens(ctx, freezeRequest(alice, aliceIssuedAt, now))
+	require.NoError(t, err)
+	_, err = freezeHandler.FreezeTokens(ctx, freezeRequest(alice, aliceIssuedAt.Add(-time.Millisecond), now))
+	require.ErrorContains(t, err, tokens.ErrAlreadyFrozen)
+
+	// A freeze past its expiry is not in effect even before it has been thawed.
+	clock.Advance(utils.TokenFreezeExpiryTolerance)
+	activeFreezes, err := ent.GetActiveFreezesAt(ctx, [][]byte{alice}, tokenCreate.ID, clock.Now())
+	require.NoError(t, err)
+	assert.Empty(t, activeFreezes)
+
+	expiresAt := now.Add(time.Hour)
+	_, err = freezeHandler.FreezeTokens(ctx, freezeRequest(bob, now, expiresAt))
+	require.NoError(t, err)
+
+	resp, err := queryHandler.QueryTokenFreezes(ctx, &tokenpb.QueryTokenFreezesRequest{TokenIdentifier: tokenIdentifier})
+	require.NoError(t, err)
+	require.Len(t, resp.TokenFreezes, 1)
+	assert.Equal(t, bob, resp.TokenFreezes[0].OwnerPublicKey)
+	assert.Equal(t, expiresAt.UnixMilli(), resp.TokenFreezes[0].ExpiresAt.AsTime().UnixMilli())
+	assert.Equal(t, int64(-1), resp.Offset)
+
+	numThawed, err := ent.ThawExpiredFreezes(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, numThawed)
+	aliceFreeze, err := tx.TokenFreeze.Query().Where(tokenfreeze.OwnerPublicKeyEQ(alice)).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, st.TokenFreezeStatusThawed, aliceFreeze.Status)
+	assert.Equal(t, uint64(aliceFreeze.ExpiresAt.UnixMilli()), aliceFreeze.WalletProvidedThawTimestamp)
+
+	numThawed, err = ent.ThawExpiredFreezes(ctx, expiresAt)
+	require.NoError(t, err)
+	assert.Equal(t, 1, numThawed)
+	resp, err = queryHandler.QueryTokenFreezes(ctx, &tokenpb.QueryTokenFreezesRequest{TokenIdentifier: tokenIdentifier})
+	require.NoError(t, err)
+	assert.Empty(t, resp.TokenFreezes)
+}
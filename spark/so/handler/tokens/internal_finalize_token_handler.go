@@ -1,21 +1,26 @@
 package tokens
 
 import (
+	"bytes"
 	"cmp"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"slices"
 	"strings"
 
 	"github.com/lightsparkdev/spark/common/keys"
+	"github.com/lightsparkdev/spark/common/logging"
 
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/protoconverter"
+	events "github.com/lightsparkdev/spark/so/stream"
 	"github.com/lightsparkdev/spark/so/tokens"
 	"github.com/lightsparkdev/spark/so/utils"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -123,6 +128,10 @@ func (h *InternalFinalizeTokenHandler) FinalizeTokenTransactionInternal(
 	if err != nil {
 		return nil, tokens.FormatErrorWithTransactionEnt(fmt.Sprintf(tokens.ErrFailedToUpdateOutputs, "finalizing"), tokenTransaction, err)
 	}
+	if err := notifyTokenTransactionFinalized(ctx, tokenTransaction); err != nil {
+		logger := logging.GetLoggerFromContext(ctx)
+		logger.Error("failed to notify output owners", "error", err, "token_transaction_hash", hex.EncodeToString(tokenTransaction.FinalizedTokenTransactionHash))
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -160,9 +169,66 @@ func (h *InternalFinalizeTokenHandler) FinalizeCoordinatedTokenTransactionIntern
 			tokenTransaction, nil)
 	}
 
+	// Finalizing commits the transaction, so the owners are notified first for the events to be
+	// recorded once the finalization commits.
+	if err := notifyTokenTransactionFinalized(ctx, tokenTransaction); err != nil {
+		logger := logging.GetLoggerFromContext(ctx)
+		logger.Error("failed to notify output owners", "error", err, "token_transaction_hash", hex.EncodeToString(tokenTransaction.FinalizedTokenTransactionHash))
+	}
 	err = ent.FinalizeCoordinatedTokenTransactionWithRevocationKeys(ctx, tokenTransaction, revocationSecretsToFinalize)
 	if err != nil {
 		return tokens.FormatErrorWithTransactionEnt(fmt.Sprintf(tokens.ErrFailedToUpdateOutputs, "finalizing"), tokenTransaction, err)
 	}
 	return nil
 }
+
+// notifyTokenTransactionFinalized notifies the owner of each output created by the finalized token
+// transaction of the outputs they received. Owners are notified in key order.
+func notifyTokenTransactionFinalized(ctx context.Context, tokenTransaction *ent.TokenTransaction) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	createdOutputs := slices.SortedFunc(slices.Values(tokenTransaction.Edges.CreatedOutput), func(a, b *ent.TokenOutput) int {
+		return cmp.Compare(a.CreatedTransactionOutputVout, b.CreatedTransactionOutputVout)
+	})
+	var owners []keys.Public
+	receivedOutputs := make(map[keys.Public][]*pb.TokenOutput)
+	for _, output := range createdOutputs {
+		owner, err := keys.ParsePublicKey(output.OwnerPublicKey)
+		if err != nil {
+			return fmt.Errorf("unable to parse owner public key of output %s: %w", output.ID, err)
+		}
+		if _, ok := receivedOutputs[owner]; !ok {
+			owners = append(owners, owner)
+		}
+		receivedOutputs[owner] = append(receivedOutputs[owner], &pb.TokenOutput{
+			Id:                            proto.String(output.ID.String()),
+			OwnerPublicKey:                output.OwnerPublicKey,
+			RevocationCommitment:          output.WithdrawRevocationCommitment,
+			WithdrawBondSats:              &output.WithdrawBondSats,
+			WithdrawRelativeBlockLocktime: &output.WithdrawRelativeBlockLocktime,
+			TokenPublicKey:                output.TokenPublicKey,
+			TokenIdentifier:               output.TokenIdentifier,
+			TokenAmount:                   output.TokenAmount,
+			SpendableAfter:                output.SpendableAfterProto(),
+		})
+	}
+	slices.SortFunc(owners, func(a, b keys.Public) int {
+		return bytes.Compare(a.Serialize(), b.Serialize())
+	})
+
+	eventRouter := events.GetDefaultRouter()
+	for _, owner := range owners {
+		eventRouter.NotifyUser(db, owner, &pb.SubscribeToEventsResponse{
+			Event: &pb.SubscribeToEventsResponse_TokenTransaction{
+				TokenTransaction: &pb.TokenTransactionEvent{
+					TokenTransactionHash: tokenTransaction.FinalizedTokenTransactionHash,
+					ReceivedOutputs:      receivedOutputs[owner],
+				},
+			},
+		})
+	}
+	return nil
+}
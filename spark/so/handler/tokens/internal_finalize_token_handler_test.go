@@ -0,0 +1,89 @@
+package tokens
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+)
+
+func TestNotifyTokenTransactionFinalized(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+
+	rng := mathrand.NewChaCha8([32]byte{5})
+	owner := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+	otherOwner := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+	spendableAfter := time.UnixMilli(1_700_000_000_000).UTC()
+
+	lockedOutput := &ent.TokenOutput{
+		ID:                           uuid.New(),
+		OwnerPublicKey:               owner.Serialize(),
+		TokenAmount:                  []byte{1},
+		CreatedTransactionOutputVout: 1,
+		SpendableAfter:               &spendableAfter,
+	}
+	unlockedOutput := &ent.TokenOutput{
+		ID:                           uuid.New(),
+		OwnerPublicKey:               owner.Serialize(),
+		TokenAmount:                  []byte{2},
+		CreatedTransactionOutputVout: 0,
+	}
+	otherOutput := &ent.TokenOutput{
+		ID:                           uuid.New(),
+		OwnerPublicKey:               otherOwner.Serialize(),
+		TokenAmount:                  []byte{3},
+		CreatedTransactionOutputVout: 2,
+	}
+	tokenTransaction := &ent.TokenTransaction{
+		FinalizedTokenTransactionHash: []byte("finalized_token_transaction_hash"),
+		Edges: ent.TokenTransactionEdges{
+			CreatedOutput: []*ent.TokenOutput{lockedOutput, otherOutput, unlockedOutput},
+		},
+	}
+
+	require.NoError(t, notifyTokenTransactionFinalized(ctx, tokenTransaction))
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Commit())
+
+	receivedOutputs := func(identity keys.Public) []*pb.TokenOutput {
+		tx, err := ent.GetDbFromContext(ctx)
+		require.NoError(t, err)
+		userEvents, err := tx.UserEvent.Query().
+			Where(userevent.IdentityPublicKey(identity.Serialize())).
+			All(ctx)
+		require.NoError(t, err)
+		require.Len(t, userEvents, 1)
+		message := &pb.SubscribeToEventsResponse{}
+		require.NoError(t, proto.Unmarshal(userEvents[0].Event, message))
+		event := message.GetTokenTransaction()
+		require.NotNil(t, event)
+		assert.Equal(t, tokenTransaction.FinalizedTokenTransactionHash, event.TokenTransactionHash)
+		return event.ReceivedOutputs
+	}
+
+	// Outputs are reported in vout order, with their time locks.
+	outputs := receivedOutputs(owner)
+	require.Len(t, outputs, 2)
+	assert.Equal(t, unlockedOutput.ID.String(), outputs[0].GetId())
+	assert.Nil(t, outputs[0].GetSpendableAfter())
+	assert.Equal(t, lockedOutput.ID.String(), outputs[1].GetId())
+	require.NotNil(t, outputs[1].GetSpendableAfter())
+	assert.Equal(t, spendableAfter, outputs[1].GetSpendableAfter().AsTime())
+
+	outputs = receivedOutputs(otherOwner)
+	require.Len(t, outputs, 1)
+	assert.Equal(t, otherOutput.ID.String(), outputs[0].GetId())
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/sparkinvoice"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
 	"github.com/lightsparkdev/spark/so/utils"
@@ -122,6 +124,12 @@ func (h *InternalPrepareTokenHandler) PrepareTokenTransactionInternal(ctx contex
 		if err != nil {
 			return nil, err
 		}
+		if err = validateMintIssuerIsCurrent(ctx, req.FinalTokenTransaction); err != nil {
+			return nil, err
+		}
+		if err = validateTokensNotPaused(ctx, req.FinalTokenTransaction, nil); err != nil {
+			return nil, err
+		}
 
 		// When disconnecting LRC20, we must have token metadata
 		if h.config.Token.DisconnectLRC20Node && tokenMetadata == nil {
@@ -136,14 +144,18 @@ func (h *InternalPrepareTokenHandler) PrepareTokenTransactionInternal(ctx contex
 				return nil, err
 			}
 		}
-	case utils.TokenTransactionTypeTransfer:
-		inputTtxos, err = ent.FetchAndLockTokenInputs(ctx, req.FinalTokenTransaction.GetTransferInput().GetOutputsToSpend())
+	case utils.TokenTransactionTypeTransfer, utils.TokenTransactionTypeBurn:
+		outputsToSpend := utils.GetOutputsToSpend(req.FinalTokenTransaction)
+		inputTtxos, err = ent.FetchAndLockTokenInputs(ctx, outputsToSpend)
 		if err != nil {
 			return nil, tokens.FormatErrorWithTransactionProto("failed to fetch outputs to spend", req.FinalTokenTransaction, err)
 		}
-		if len(inputTtxos) != len(req.FinalTokenTransaction.GetTransferInput().GetOutputsToSpend()) {
+		if len(inputTtxos) != len(outputsToSpend) {
 			return nil, tokens.FormatErrorWithTransactionProto("failed to fetch all leaves to spend", req.FinalTokenTransaction,
-				fmt.Errorf("failed to fetch all leaves to spend: got %d leaves, expected %d", len(inputTtxos), len(req.FinalTokenTransaction.GetTransferInput().GetOutputsToSpend())))
+				fmt.Errorf("failed to fetch all leaves to spend: got %d leaves, expected %d", len(inputTtxos), len(outputsToSpend)))
+		}
+		if err = validateTokensNotPaused(ctx, req.FinalTokenTransaction, inputTtxos); err != nil {
+			return nil, err
 		}
 
 		err = validateTransferTokenTransactionUsingPreviousTransactionData(ctx, h.enablePreemption, req.FinalTokenTransaction, req.TokenTransactionSignatures, inputTtxos, h.config.Lrc20Configs[req.FinalTokenTransaction.Network.String()].TransactionExpiryDuration)
@@ -159,6 +171,10 @@ func (h *InternalPrepareTokenHandler) PrepareTokenTransactionInternal(ctx contex
 		return nil, fmt.Errorf("token transaction type unknown")
 	}
 
+	if err = validateOutputOwnersAllowed(ctx, req.FinalTokenTransaction); err != nil {
+		return nil, err
+	}
+
 	// TODO: LRC20 client functionality removed
 	// sparkTokenTransaction, err := protoconverter.SparkTokenTransactionFromTokenProto(req.FinalTokenTransaction)
 	// if err != nil {
@@ -173,10 +189,15 @@ func (h *InternalPrepareTokenHandler) PrepareTokenTransactionInternal(ctx contex
 	//	logger.Info("Token transaction verified with LRC20 node")
 	// }
 	// Save the token transaction, created output ents, and update the outputs to spend.
-	_, err = ent.CreateStartedTransactionEntities(ctx, req.FinalTokenTransaction, req.TokenTransactionSignatures, req.KeyshareIds, inputTtxos, req.CoordinatorPublicKey)
+	tokenTransactionEnt, err := ent.CreateStartedTransactionEntities(ctx, req.FinalTokenTransaction, req.TokenTransactionSignatures, req.KeyshareIds, inputTtxos, req.CoordinatorPublicKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to save token transaction and output ents %s: %w", logging.FormatProto("final_token_transaction", req.FinalTokenTransaction), err)
 	}
+	if req.SparkPaymentIntent != "" {
+		if err := ent.AttachPaymentIntentToTokenTransaction(ctx, tokenTransactionEnt, req.SparkPaymentIntent); err != nil {
+			return nil, tokens.FormatErrorWithTransactionProto("failed to record payment intent", req.FinalTokenTransaction, err)
+		}
+	}
 
 	return &tokeninternalpb.PrepareTransactionResponse{}, nil
 }
@@ -421,6 +442,168 @@ func validateIssuerSignature(
 	return nil
 }
 
+// validateMintIssuerIsCurrent checks that a mint is signed with the token's current issuer key, so that a key
+// which has been rotated out can no longer mint. Once a token's issuer key has been rotated, the issuer key no
+// longer identifies the token, so its mints must set the token identifier.
+func validateMintIssuerIsCurrent(ctx context.Context, tokenTransaction *tokenpb.TokenTransaction) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	mintInput := tokenTransaction.GetMintInput()
+	query := db.TokenCreate.Query()
+	if mintInput.GetTokenIdentifier() != nil {
+		query = query.Where(tokencreate.TokenIdentifierEQ(mintInput.GetTokenIdentifier()))
+	} else {
+		issuerPublicKey := mintInput.GetIssuerPublicKey()
+		rotated, err := db.TokenCreate.Query().
+			Where(
+				tokencreate.Or(
+					tokencreate.IssuerPublicKeyEQ(issuerPublicKey),
+					tokencreate.HasIssuerKeyRotationsWith(tokenissuerkeyrotation.NewIssuerPublicKeyEQ(issuerPublicKey)),
+				),
+				tokencreate.HasIssuerKeyRotations(),
+			).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to query token create: %w", err)
+		}
+		if rotated {
+			return tokens.FormatErrorWithTransactionProto(tokens.ErrRotatedTokenIdentifierRequired, tokenTransaction, nil)
+		}
+		query = query.Where(tokencreate.IssuerPublicKeyEQ(issuerPublicKey))
+	}
+	tokenCreate, err := query.First(ctx)
+	if ent.IsNotFound(err) {
+		// Mints of tokens without a token create entity are handled by the token metadata check.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query token create: %w", err)
+	}
+	currentIssuerPublicKey, err := tokenCreate.CurrentIssuerPublicKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get current issuer public key: %w", err)
+	}
+	if !bytes.Equal(mintInput.GetIssuerPublicKey(), currentIssuerPublicKey.Serialize()) {
+		return tokens.FormatErrorWithTransactionProto(tokens.ErrIssuerKeyNotCurrent, tokenTransaction, nil)
+	}
+	return nil
+}
+
+// validateTokensNotPaused checks that neither the minted token nor the tokens of any spent output have been paused
+// by their issuer. Spent outputs that were created before outputs recorded their token create are resolved by
+// token identifier or issuer public key.
+func validateTokensNotPaused(ctx context.Context, tokenTransaction *tokenpb.TokenTransaction, inputTtxos []*ent.TokenOutput) error {
+	var tokenCreateIDs []uuid.UUID
+	var tokenIdentifiers, issuerPublicKeys [][]byte
+	if mintInput := tokenTransaction.GetMintInput(); mintInput != nil {
+		if mintInput.GetTokenIdentifier() != nil {
+			tokenIdentifiers = append(tokenIdentifiers, mintInput.GetTokenIdentifier())
+		} else {
+			issuerPublicKeys = append(issuerPublicKeys, mintInput.GetIssuerPublicKey())
+		}
+	}
+	for _, input := range inputTtxos {
+		switch {
+		case input.TokenCreateID != uuid.Nil:
+			tokenCreateIDs = append(tokenCreateIDs, input.TokenCreateID)
+		case len(input.TokenIdentifier) > 0:
+			tokenIdentifiers = append(tokenIdentifiers, input.TokenIdentifier)
+		case len(input.TokenPublicKey) > 0:
+			issuerPublicKeys = append(issuerPublicKeys, input.TokenPublicKey)
+		default:
+			return tokens.FormatErrorWithTransactionProto("no created token found for spent output", tokenTransaction,
+				fmt.Errorf("output %s has no token create, token identifier or token public key", input.ID))
+		}
+	}
+
+	var tokenConditions []predicate.TokenCreate
+	if len(tokenCreateIDs) > 0 {
+		tokenConditions = append(tokenConditions, tokencreate.IDIn(tokenCreateIDs...))
+	}
+	if len(tokenIdentifiers) > 0 {
+		tokenConditions = append(tokenConditions, tokencreate.TokenIdentifierIn(tokenIdentifiers...))
+	}
+	if len(issuerPublicKeys) > 0 {
+		tokenConditions = append(tokenConditions, tokencreate.IssuerPublicKeyIn(issuerPublicKeys...))
+	}
+	if len(tokenConditions) == 0 {
+		return nil
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	pausedToken, err := db.TokenCreate.Query().
+		Where(
+			tokencreate.Or(tokenConditions...),
+			tokencreate.IsPaused(true),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query paused tokens: %w", err)
+	}
+	return tokens.FormatErrorWithTransactionProto(tokens.ErrTokenPaused, tokenTransaction,
+		fmt.Errorf("token %x is paused", pausedToken.TokenIdentifier))
+}
+
+// validateOutputOwnersAllowed checks that outputs of transfer restricted tokens are only created for owners on
+// the token's allowlist.
+func validateOutputOwnersAllowed(ctx context.Context, tokenTransaction *tokenpb.TokenTransaction) error {
+	var tokenIdentifiers, issuerPublicKeys [][]byte
+	for _, output := range tokenTransaction.GetTokenOutputs() {
+		if output.GetTokenIdentifier() != nil {
+			tokenIdentifiers = append(tokenIdentifiers, output.GetTokenIdentifier())
+		} else if output.GetTokenPublicKey() != nil {
+			issuerPublicKeys = append(issuerPublicKeys, output.GetTokenPublicKey())
+		}
+	}
+	if len(tokenIdentifiers) == 0 && len(issuerPublicKeys) == 0 {
+		return nil
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	restrictedTokens, err := db.TokenCreate.Query().
+		Where(
+			tokencreate.IsTransferRestricted(true),
+			tokencreate.Or(
+				tokencreate.TokenIdentifierIn(tokenIdentifiers...),
+				tokencreate.IssuerPublicKeyIn(issuerPublicKeys...),
+			),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query transfer restricted tokens: %w", err)
+	}
+
+	for _, tokenCreate := range restrictedTokens {
+		var ownerPublicKeys [][]byte
+		for _, output := range tokenTransaction.GetTokenOutputs() {
+			if bytes.Equal(output.GetTokenIdentifier(), tokenCreate.TokenIdentifier) ||
+				(output.GetTokenIdentifier() == nil && bytes.Equal(output.GetTokenPublicKey(), tokenCreate.IssuerPublicKey)) {
+				ownerPublicKeys = append(ownerPublicKeys, output.GetOwnerPublicKey())
+			}
+		}
+		disallowed, err := tokenCreate.DisallowedOwnerPublicKeys(ctx, ownerPublicKeys)
+		if err != nil {
+			return err
+		}
+		if len(disallowed) > 0 {
+			return tokens.FormatErrorWithTransactionProto(tokens.ErrOwnerNotOnAllowlist, tokenTransaction,
+				fmt.Errorf("owners %x may not receive token %x", disallowed, tokenCreate.TokenIdentifier))
+		}
+	}
+	return nil
+}
+
 func validateTransferTokenTransactionUsingPreviousTransactionData(
 	ctx context.Context,
 	enablePreemption bool,
@@ -429,16 +612,19 @@ func validateTransferTokenTransactionUsingPreviousTransactionData(
 	outputToSpendEnts []*ent.TokenOutput,
 	v0DefaultTransactionExpiryDuration time.Duration,
 ) error {
-	// All created outputs having the same token identifier is validated upstream, so only need to check against the first one.
-	expectedTokenIdentifier := tokenTransaction.TokenOutputs[0].GetTokenIdentifier()
-	if expectedTokenIdentifier != nil {
-		// Validate that all spent outputs have the same token identifier
+	// Burns have no created outputs and name the token in the burn input instead. Transfers identified by token
+	// identifier may swap several tokens, so their inputs are matched to outputs per token below.
+	// Legacy transfers identified by token public key are always for a single token.
+	usesTokenIdentifier := true
+	if burnInput := tokenTransaction.GetBurnInput(); burnInput != nil {
+		// Validate that all spent outputs have the burned token identifier
 		for i, outputEnt := range outputToSpendEnts {
-			if !bytes.Equal(outputEnt.TokenIdentifier, expectedTokenIdentifier) {
+			if !bytes.Equal(outputEnt.TokenIdentifier, burnInput.GetTokenIdentifier()) {
 				return tokens.FormatErrorWithTransactionProto("token identifier mismatch", tokenTransaction, fmt.Errorf("output %d has different token identifier", i))
 			}
 		}
-	} else {
+	} else if tokenTransaction.TokenOutputs[0].GetTokenIdentifier() == nil {
+		usesTokenIdentifier = false
 		expectedTokenPubKey := tokenTransaction.TokenOutputs[0].GetTokenPublicKey()
 		if expectedTokenPubKey == nil {
 			return tokens.FormatErrorWithTransactionProto("invalid token public key", tokenTransaction, fmt.Errorf("token public key is required in outputs"))
@@ -463,19 +649,11 @@ func validateTransferTokenTransactionUsingPreviousTransactionData(
 			}
 		}
 	}
-	// Validate token conservation in inputs + outputs.
-	totalInputAmount := new(big.Int)
-	for _, outputEnt := range outputToSpendEnts {
-		inputAmount := new(big.Int).SetBytes(outputEnt.TokenAmount)
-		totalInputAmount.Add(totalInputAmount, inputAmount)
-	}
-	totalOutputAmount := new(big.Int)
-	for _, outputLeaf := range tokenTransaction.TokenOutputs {
-		outputAmount := new(big.Int).SetBytes(outputLeaf.GetTokenAmount())
-		totalOutputAmount.Add(totalOutputAmount, outputAmount)
-	}
-	if totalInputAmount.Cmp(totalOutputAmount) != 0 {
-		return tokens.FormatErrorWithTransactionProto("token amount mismatch", tokenTransaction, fmt.Errorf("total input amount %s does not match total output amount %s", totalInputAmount.String(), totalOutputAmount.String()))
+	// Validate token conservation in inputs + outputs for each token. Burns destroy their inputs instead.
+	if tokenTransaction.GetBurnInput() == nil {
+		if err := validateTokenAmountsConserved(tokenTransaction, outputToSpendEnts, usesTokenIdentifier); err != nil {
+			return tokens.FormatErrorWithTransactionProto("token amount mismatch", tokenTransaction, err)
+		}
 	}
 
 	// Validate that the ownership signatures match the ownership public keys in the outputs to spend.
@@ -493,11 +671,12 @@ func validateTransferTokenTransactionUsingPreviousTransactionData(
 		ownerSignaturesByIndex[sig.InputIndex] = sig
 	}
 
-	if len(signaturesWithIndex) != len(tokenTransaction.GetTransferInput().GetOutputsToSpend()) {
+	outputsToSpend := utils.GetOutputsToSpend(tokenTransaction)
+	if len(signaturesWithIndex) != len(outputsToSpend) {
 		return tokens.FormatErrorWithTransactionProto("signature count mismatch", tokenTransaction, fmt.Errorf("number of signatures must match number of outputs to spend"))
 	}
 
-	for i := range tokenTransaction.GetTransferInput().GetOutputsToSpend() {
+	for i := range outputsToSpend {
 		index := uint32(i)
 		ownershipSignature, exists := ownerSignaturesByIndex[index]
 		if !exists {
@@ -527,9 +706,53 @@ func validateTransferTokenTransactionUsingPreviousTransactionData(
 	return nil
 }
 
+// validateTokenAmountsConserved checks that, for each token, the spent outputs add up to the created outputs.
+// Every token that is spent must also be created, and the other way around.
+func validateTokenAmountsConserved(tokenTransaction *tokenpb.TokenTransaction, outputToSpendEnts []*ent.TokenOutput, usesTokenIdentifier bool) error {
+	inputAmounts := make(map[string]*big.Int)
+	for _, outputEnt := range outputToSpendEnts {
+		token := string(outputEnt.TokenPublicKey)
+		if usesTokenIdentifier {
+			token = string(outputEnt.TokenIdentifier)
+		}
+		if _, ok := inputAmounts[token]; !ok {
+			inputAmounts[token] = new(big.Int)
+		}
+		inputAmounts[token].Add(inputAmounts[token], new(big.Int).SetBytes(outputEnt.TokenAmount))
+	}
+	outputAmounts := make(map[string]*big.Int)
+	for _, output := range tokenTransaction.TokenOutputs {
+		token := string(output.GetTokenPublicKey())
+		if usesTokenIdentifier {
+			token = string(output.GetTokenIdentifier())
+		}
+		if _, ok := outputAmounts[token]; !ok {
+			outputAmounts[token] = new(big.Int)
+		}
+		outputAmounts[token].Add(outputAmounts[token], new(big.Int).SetBytes(output.GetTokenAmount()))
+	}
+
+	for token, inputAmount := range inputAmounts {
+		outputAmount, ok := outputAmounts[token]
+		if !ok {
+			return fmt.Errorf("token %x is spent but no outputs are created for it", []byte(token))
+		}
+		if inputAmount.Cmp(outputAmount) != 0 {
+			return fmt.Errorf("total input amount %s does not match total output amount %s for token %x", inputAmount.String(), outputAmount.String(), []byte(token))
+		}
+	}
+	for token := range outputAmounts {
+		if _, ok := inputAmounts[token]; !ok {
+			return fmt.Errorf("token %x has outputs created but no inputs spent", []byte(token))
+		}
+	}
+	return nil
+}
+
 // validateOutputIsSpendable checks if a output is eligible to be spent by verifying:
 // 1. The output has an appropriate status (Created+Finalized or already marked as SpentStarted) OR was spent from an expired or pre-emptable transaction
 // 2. The output hasn't been withdrawn already
+// 3. The output's time lock, if any, has passed
 func validateOutputIsSpendable(ctx context.Context, enablePreemption bool, index int, output *ent.TokenOutput, tokenTransaction *tokenpb.TokenTransaction, v0DefaultTransactionExpiryDuration time.Duration) error {
 	if !isSpendableOutputStatus(output.Status) {
 		spentTx := output.Edges.OutputSpentTokenTransaction
@@ -555,6 +778,10 @@ func validateOutputIsSpendable(ctx context.Context, enablePreemption bool, index
 		return fmt.Errorf("output %d cannot be spent: already withdrawn", index)
 	}
 
+	if !output.IsSpendableAt(time.Now()) {
+		return fmt.Errorf("output %d cannot be spent: time locked until %s", index, output.SpendableAfter.UTC().Format(time.RFC3339))
+	}
+
 	return nil
 }
 
@@ -903,10 +1130,7 @@ func validateOutputsMatchSenderAndNetwork(ctx context.Context, tokenTransaction
 		senderPublicKeyBytes = senderPublicKey.Serialize()
 	}
 
-	var outputsToSpend []*tokenpb.TokenOutputToSpend
-	if tokenTransaction.GetTransferInput() != nil {
-		outputsToSpend = tokenTransaction.GetTransferInput().OutputsToSpend
-	}
+	outputsToSpend := utils.GetOutputsToSpend(tokenTransaction)
 	schemaNetwork, err := common.SchemaNetworkFromNetwork(network)
 	if err != nil {
 		return err
@@ -0,0 +1,113 @@
+package tokens
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+)
+
+func TestValidateTokenAmountsConserved(t *testing.T) {
+	tokenA := bytes.Repeat([]byte{0x0a}, 32)
+	tokenB := bytes.Repeat([]byte{0x0b}, 32)
+	amount := func(value int64) []byte {
+		return big.NewInt(value).FillBytes(make([]byte, 16))
+	}
+	// Alice spends 100 A, Bob spends 30 B.
+	spent := []*ent.TokenOutput{
+		{TokenIdentifier: tokenA, TokenAmount: amount(60)},
+		{TokenIdentifier: tokenA, TokenAmount: amount(40)},
+		{TokenIdentifier: tokenB, TokenAmount: amount(30)},
+	}
+
+	tests := []struct {
+		name    string
+		outputs []*tokenpb.TokenOutput
+		wantErr string
+	}{
+		{
+			name: "balanced swap",
+			outputs: []*tokenpb.TokenOutput{
+				{TokenIdentifier: tokenA, TokenAmount: amount(90)},
+				{TokenIdentifier: tokenA, TokenAmount: amount(10)},
+				{TokenIdentifier: tokenB, TokenAmount: amount(30)},
+			},
+		},
+		{
+			name: "one token unbalanced",
+			outputs: []*tokenpb.TokenOutput{
+				{TokenIdentifier: tokenA, TokenAmount: amount(100)},
+				{TokenIdentifier: tokenB, TokenAmount: amount(31)},
+			},
+			wantErr: "total input amount 30 does not match total output amount 31",
+		},
+		{
+			name: "amounts moved between tokens",
+			outputs: []*tokenpb.TokenOutput{
+				{TokenIdentifier: tokenA, TokenAmount: amount(70)},
+				{TokenIdentifier: tokenB, TokenAmount: amount(60)},
+			},
+			wantErr: "does not match total output amount",
+		},
+		{
+			name: "token spent but not created",
+			outputs: []*tokenpb.TokenOutput{
+				{TokenIdentifier: tokenA, TokenAmount: amount(100)},
+			},
+			wantErr: "is spent but no outputs are created for it",
+		},
+		{
+			name: "token created but not spent",
+			outputs: []*tokenpb.TokenOutput{
+				{TokenIdentifier: tokenA, TokenAmount: amount(100)},
+				{TokenIdentifier: tokenB, TokenAmount: amount(30)},
+				{TokenIdentifier: bytes.Repeat([]byte{0x0c}, 32), TokenAmount: amount(1)},
+			},
+			wantErr: "has outputs created but no inputs spent",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTokenAmountsConserved(&tokenpb.TokenTransaction{TokenOutputs: tt.outputs}, spent, true)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateOutputIsSpendableTimeLock(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+	tests := []struct {
+		name           string
+		spendableAfter *time.Time
+		wantErr        bool
+	}{
+		{name: "no time lock", spendableAfter: nil},
+		{name: "time lock passed", spendableAfter: &past},
+		{name: "time lock not passed", spendableAfter: &future, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := &ent.TokenOutput{
+				Status:         st.TokenOutputStatusCreatedFinalized,
+				SpendableAfter: tt.spendableAfter,
+			}
+			err := validateOutputIsSpendable(t.Context(), false, 0, output, &tokenpb.TokenTransaction{}, time.Hour)
+			if tt.wantErr {
+				require.ErrorContains(t, err, "time locked until")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/signingkeyshare"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokenpartialrevocationsecretshare"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
@@ -90,6 +91,10 @@ func (h *InternalSignTokenHandler) SignAndPersistTokenTransaction(
 	if err != nil {
 		return nil, fmt.Errorf("failed to check token transaction type: %w", err)
 	}
+	// Pauses are checked during the Start() step as well, but the issuer may have paused the token since.
+	if err := validateTokensNotPausedEnt(ctx, tokenTransaction); err != nil {
+		return nil, err
+	}
 	switch txType {
 	case utils.TokenTransactionTypeCreate:
 		if tokenTransaction.Edges.Create == nil {
@@ -103,7 +108,7 @@ func (h *InternalSignTokenHandler) SignAndPersistTokenTransaction(
 		if err != nil {
 			return nil, err
 		}
-	case utils.TokenTransactionTypeTransfer:
+	case utils.TokenTransactionTypeTransfer, utils.TokenTransactionTypeBurn:
 		// If token outputs are being spent, verify the expected status of inputs and check for active freezes.
 		if len(tokenTransaction.Edges.SpentOutput) == 0 {
 			return nil, tokens.FormatErrorWithTransactionEnt("no spent outputs found when attempting to validate transfer transaction", tokenTransaction, nil)
@@ -112,28 +117,30 @@ func (h *InternalSignTokenHandler) SignAndPersistTokenTransaction(
 		if len(invalidInputs) > 0 {
 			return nil, tokens.FormatErrorWithTransactionEnt(fmt.Sprintf("%s: %s", tokens.ErrInvalidInputs, strings.Join(invalidInputs, "; ")), tokenTransaction, nil)
 		}
-		// Collect owner public keys for freeze check.
-		ownerPublicKeys := make([][]byte, len(tokenTransaction.Edges.SpentOutput))
-		tokenCreateId := tokenTransaction.Edges.SpentOutput[0].TokenCreateID
-		if tokenCreateId == uuid.Nil {
-			return nil, tokens.FormatErrorWithTransactionEnt("no created token found when attempting to validate transfer transaction", tokenTransaction, nil)
-		}
-		for i, output := range tokenTransaction.Edges.SpentOutput {
-			ownerPublicKeys[i] = output.OwnerPublicKey
+		// Collect owner public keys for freeze check. Swaps spend outputs of several tokens, and a freeze of any
+		// of them blocks the whole transaction.
+		ownerPublicKeysByTokenCreate := make(map[uuid.UUID][][]byte)
+		for _, output := range tokenTransaction.Edges.SpentOutput {
+			if output.TokenCreateID == uuid.Nil {
+				return nil, tokens.FormatErrorWithTransactionEnt("no created token found when attempting to validate transfer transaction", tokenTransaction, nil)
+			}
+			ownerPublicKeysByTokenCreate[output.TokenCreateID] = append(ownerPublicKeysByTokenCreate[output.TokenCreateID], output.OwnerPublicKey)
 		}
 
-		// Bulk query all input ids to ensure none of them are frozen.
-		activeFreezes, err := ent.GetActiveFreezes(ctx, ownerPublicKeys, tokenCreateId)
-		if err != nil {
-			return nil, fmt.Errorf("%s: %w", tokens.ErrFailedToQueryTokenFreezeStatus, err)
-		}
+		for tokenCreateId, ownerPublicKeys := range ownerPublicKeysByTokenCreate {
+			// Bulk query all input ids to ensure none of them are frozen.
+			activeFreezes, err := ent.GetActiveFreezes(ctx, ownerPublicKeys, tokenCreateId)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", tokens.ErrFailedToQueryTokenFreezeStatus, err)
+			}
 
-		if len(activeFreezes) > 0 {
-			for _, freeze := range activeFreezes {
-				logger := logging.GetLoggerFromContext(ctx)
-				logger.Info("Found active freeze", "owner", freeze.OwnerPublicKey, "token", freeze.TokenPublicKey, "freeze_timestamp", freeze.WalletProvidedFreezeTimestamp)
+			if len(activeFreezes) > 0 {
+				for _, freeze := range activeFreezes {
+					logger := logging.GetLoggerFromContext(ctx)
+					logger.Info("Found active freeze", "owner", freeze.OwnerPublicKey, "token", freeze.TokenPublicKey, "freeze_timestamp", freeze.WalletProvidedFreezeTimestamp)
+				}
+				return nil, fmt.Errorf("at least one input is frozen. Cannot proceed with transaction")
 			}
-			return nil, fmt.Errorf("at least one input is frozen. Cannot proceed with transaction")
 		}
 	case utils.TokenTransactionTypeUnknown:
 		return nil, fmt.Errorf("token transaction type unknown")
@@ -297,6 +304,9 @@ func (h *InternalSignTokenHandler) ExchangeRevocationSecretsShares(ctx context.C
 		if err != nil {
 			return nil, tokens.FormatErrorWithTransactionEnt("failed to update token transaction status", tokenTransaction, err)
 		}
+		if err := ent.RecordTokenTransactionsFinalizedAt(ctx, tokentransaction.IDEQ(tokenTransaction.ID)); err != nil {
+			return nil, tokens.FormatErrorWithTransactionEnt("failed to record token transaction finalized time", tokenTransaction, err)
+		}
 	}
 	return response, nil
 }
@@ -800,3 +810,38 @@ func validateInputTokenOutputsMatchSpentTokenOutputs(tokenOutputIDs []uuid.UUID,
 	}
 	return nil
 }
+
+// validateTokensNotPausedEnt checks that none of the tokens created or spent by a stored transaction have been
+// paused by their issuer.
+func validateTokensNotPausedEnt(ctx context.Context, tokenTransaction *ent.TokenTransaction) error {
+	var tokenCreateIDs []uuid.UUID
+	for _, outputs := range [][]*ent.TokenOutput{tokenTransaction.Edges.CreatedOutput, tokenTransaction.Edges.SpentOutput} {
+		for _, output := range outputs {
+			if output.TokenCreateID != uuid.Nil {
+				tokenCreateIDs = append(tokenCreateIDs, output.TokenCreateID)
+			}
+		}
+	}
+	if len(tokenCreateIDs) == 0 {
+		return nil
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	pausedToken, err := db.TokenCreate.Query().
+		Where(
+			tokencreate.IDIn(tokenCreateIDs...),
+			tokencreate.IsPaused(true),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query paused tokens: %w", err)
+	}
+	return tokens.FormatErrorWithTransactionEnt(tokens.ErrTokenPaused, tokenTransaction,
+		fmt.Errorf("token %x is paused", pausedToken.TokenIdentifier))
+}
@@ -0,0 +1,86 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/utils"
+)
+
+type PauseTokenHandler struct {
+	config *so.Config
+}
+
+// NewPauseTokenHandler creates a new PauseTokenHandler.
+func NewPauseTokenHandler(config *so.Config) *PauseTokenHandler {
+	return &PauseTokenHandler{
+		config: config,
+	}
+}
+
+// PauseToken pauses or unpauses all mints, transfers and burns of a token. Pauses and unpauses must have
+// increasing issuer provided timestamps so that every operator ends up in the same state regardless of the order
+// in which it receives them. Retrying an applied pause or unpause succeeds.
+func (h *PauseTokenHandler) PauseToken(
+	ctx context.Context,
+	req *tokenpb.PauseTokenRequest,
+) (*tokenpb.PauseTokenResponse, error) {
+	payload := req.GetPauseTokenPayload()
+	if err := utils.ValidatePauseTokenPayload(payload, h.config.IdentityPublicKey()); err != nil {
+		return nil, fmt.Errorf("pause token payload validation failed: %w", err)
+	}
+
+	payloadHash, err := utils.HashPauseTokenPayload(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash pause token payload: %w", err)
+	}
+
+	tokenCreateEnt, err := queryTokenCreateByIdentifier(ctx, payload.GetTokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+
+	// Retries are recognized before the signature is validated so that a pause signed by an issuer key that has
+	// since been rotated can still be retried.
+	if bytes.Equal(tokenCreateEnt.PauseIssuerSignature, req.GetIssuerSignature()) {
+		if tokenCreateEnt.PauseIssuerProvidedTimestamp != payload.GetIssuerProvidedTimestamp() ||
+			tokenCreateEnt.IsPaused == payload.GetShouldUnpause() {
+			return nil, fmt.Errorf("issuer signature was already used for a different pause or unpause")
+		}
+		return &tokenpb.PauseTokenResponse{IsPaused: tokenCreateEnt.IsPaused}, nil
+	}
+
+	if _, err := validateCurrentIssuerSignature(ctx, tokenCreateEnt, payloadHash, req.GetIssuerSignature()); err != nil {
+		return nil, err
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	// The update only applies if no later pause or unpause was applied in the meantime.
+	updated, err := db.TokenCreate.Update().
+		Where(
+			tokencreate.ID(tokenCreateEnt.ID),
+			tokencreate.Or(
+				tokencreate.PauseIssuerProvidedTimestampIsNil(),
+				tokencreate.PauseIssuerProvidedTimestampLT(payload.GetIssuerProvidedTimestamp()),
+			),
+		).
+		SetIsPaused(!payload.GetShouldUnpause()).
+		SetPauseIssuerSignature(req.GetIssuerSignature()).
+		SetPauseIssuerProvidedTimestamp(payload.GetIssuerProvidedTimestamp()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update token pause state: %w", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("issuer provided timestamp %d must be later than the last pause or unpause", payload.GetIssuerProvidedTimestamp())
+	}
+	return &tokenpb.PauseTokenResponse{IsPaused: !payload.GetShouldUnpause()}, nil
+}
@@ -0,0 +1,132 @@
+package tokens
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/utils"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+)
+
+func TestPauseToken(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{4})
+	issuerKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	otherKey := keys.MustGeneratePrivateKeyFromRand(rng)
+
+	tokenCreate, err := sparktesting.NewTestTokenCreate(t, tx, issuerKey.Public().Serialize()).Save(ctx)
+	require.NoError(t, err)
+	tokenIdentifier := tokenCreate.TokenIdentifier
+
+	pauseRequest := func(signer keys.Private, timestamp uint64, shouldUnpause bool) *tokenpb.PauseTokenRequest {
+		payload := &tokenpb.PauseTokenPayload{
+			TokenIdentifier:           tokenIdentifier,
+			IssuerProvidedTimestamp:   timestamp,
+			OperatorIdentityPublicKey: config.IdentityPublicKey().Serialize(),
+			ShouldUnpause:             shouldUnpause,
+		}
+		payloadHash, err := utils.HashPauseTokenPayload(payload)
+		require.NoError(t, err)
+		return &tokenpb.PauseTokenRequest{
+			PauseTokenPayload: payload,
+			IssuerSignature:   ecdsa.Sign(signer.ToBTCEC(), payloadHash).Serialize(),
+		}
+	}
+	isPaused := func() bool {
+		metadata, err := NewQueryTokenHandler(config).QueryTokenMetadata(ctx, &tokenpb.QueryTokenMetadataRequest{
+			TokenIdentifiers: [][]byte{tokenIdentifier},
+		})
+		require.NoError(t, err)
+		require.Len(t, metadata.TokenMetadata, 1)
+		return metadata.TokenMetadata[0].IsPaused
+	}
+	// Outputs created before outputs recorded their token create are resolved by token identifier.
+	inputs := []*ent.TokenOutput{{TokenCreateID: tokenCreate.ID}}
+	legacyInputs := []*ent.TokenOutput{{TokenIdentifier: tokenIdentifier}}
+	mint := &tokenpb.TokenTransaction{
+		TokenInputs: &tokenpb.TokenTransaction_MintInput{
+			MintInput: &tokenpb.TokenMintInput{
+				IssuerPublicKey: issuerKey.Public().Serialize(),
+				TokenIdentifier: tokenIdentifier,
+			},
+		},
+	}
+	// Transactions that were started before a pause are checked again when they are signed.
+	started := &ent.TokenTransaction{Edges: ent.TokenTransactionEdges{SpentOutput: inputs}}
+	handler := NewPauseTokenHandler(config)
+
+	assert.False(t, isPaused())
+	require.NoError(t, validateTokensNotPaused(ctx, &tokenpb.TokenTransaction{}, inputs))
+	require.NoError(t, validateTokensNotPaused(ctx, mint, nil))
+	require.NoError(t, validateTokensNotPausedEnt(ctx, started))
+
+	_, err = handler.PauseToken(ctx, pauseRequest(otherKey, 100, false))
+	require.ErrorContains(t, err, "invalid issuer signature")
+
+	pause := pauseRequest(issuerKey, 100, false)
+	resp, err := handler.PauseToken(ctx, pause)
+	require.NoError(t, err)
+	assert.True(t, resp.IsPaused)
+	assert.True(t, isPaused())
+	require.ErrorContains(t, validateTokensNotPaused(ctx, &tokenpb.TokenTransaction{}, inputs), "paused")
+	require.ErrorContains(t, validateTokensNotPaused(ctx, &tokenpb.TokenTransaction{}, legacyInputs), "paused")
+	require.ErrorContains(t, validateTokensNotPaused(ctx, mint, nil), "paused")
+	require.ErrorContains(t, validateTokensNotPausedEnt(ctx, started), "paused")
+
+	unpause := pauseRequest(issuerKey, 200, true)
+	resp, err = handler.PauseToken(ctx, unpause)
+	require.NoError(t, err)
+	assert.False(t, resp.IsPaused)
+	assert.False(t, isPaused())
+	require.NoError(t, validateTokensNotPaused(ctx, &tokenpb.TokenTransaction{}, inputs))
+	require.NoError(t, validateTokensNotPaused(ctx, mint, nil))
+	require.NoError(t, validateTokensNotPausedEnt(ctx, started))
+
+	// Retries of the latest unpause succeed, but a pause arriving late does not overwrite it.
+	resp, err = handler.PauseToken(ctx, unpause)
+	require.NoError(t, err)
+	assert.False(t, resp.IsPaused)
+	_, err = handler.PauseToken(ctx, pause)
+	require.ErrorContains(t, err, "must be later than the last pause or unpause")
+	assert.False(t, isPaused())
+
+	// The issuer signature of the latest unpause cannot be reused for a different payload.
+	reused := pauseRequest(issuerKey, 300, true)
+	reused.IssuerSignature = unpause.IssuerSignature
+	_, err = handler.PauseToken(ctx, reused)
+	require.ErrorContains(t, err, "already used for a different pause or unpause")
+
+	// Retries still succeed after the issuer key was rotated away from the key that signed the unpause.
+	newIssuerKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	_, err = tx.TokenIssuerKeyRotation.Create().
+		SetTokenCreateID(tokenCreate.ID).
+		SetPreviousIssuerPublicKey(issuerKey.Public().Serialize()).
+		SetNewIssuerPublicKey(newIssuerKey.Public().Serialize()).
+		SetIssuerSignature([]byte("rotation_signature")).
+		SetIssuerProvidedTimestamp(250).
+		Save(ctx)
+	require.NoError(t, err)
+	resp, err = handler.PauseToken(ctx, unpause)
+	require.NoError(t, err)
+	assert.False(t, resp.IsPaused)
+	_, err = handler.PauseToken(ctx, pauseRequest(issuerKey, 300, false))
+	require.ErrorContains(t, err, "invalid issuer signature")
+	resp, err = handler.PauseToken(ctx, pauseRequest(newIssuerKey, 300, false))
+	require.NoError(t, err)
+	assert.True(t, resp.IsPaused)
+}
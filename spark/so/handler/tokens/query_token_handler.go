@@ -2,7 +2,10 @@ package tokens
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/lightsparkdev/spark/common"
 
@@ -15,12 +18,17 @@ import (
 	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/paymentintent"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
 	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
 	"github.com/lightsparkdev/spark/so/helper"
 	"github.com/lightsparkdev/spark/so/tokens"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type QueryTokenHandler struct {
@@ -64,7 +72,10 @@ func (h *QueryTokenHandler) QueryTokenMetadata(ctx context.Context, req *tokenpb
 	if len(req.IssuerPublicKeys) > 0 {
 		conditions = append(conditions, tokencreate.IssuerPublicKeyIn(req.IssuerPublicKeys...))
 	}
-	query = query.Where(tokencreate.Or(conditions...))
+	query = query.Where(tokencreate.Or(conditions...)).
+		WithIssuerKeyRotations(func(q *ent.TokenIssuerKeyRotationQuery) {
+			q.Order(tokenissuerkeyrotation.ByIssuerProvidedTimestamp())
+		})
 	tokenCreateEntities, err := query.All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query token metadata: %w", err)
@@ -76,7 +87,24 @@ func (h *QueryTokenHandler) QueryTokenMetadata(ctx context.Context, req *tokenpb
 		if err != nil {
 			return nil, fmt.Errorf("failed to convert token create to token metadata: %w", err)
 		}
-		tokenMetadataList = append(tokenMetadataList, tokenMetadata.ToTokenMetadataProto())
+		tokenMetadataProto := tokenMetadata.ToTokenMetadataProto()
+		if tokenMetadataProto == nil {
+			return nil, fmt.Errorf("failed to convert token metadata for token %x", tokenCreate.TokenIdentifier)
+		}
+		currentIssuerPublicKey, err := tokenCreate.CurrentIssuerPublicKey(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current issuer public key of token %x: %w", tokenCreate.TokenIdentifier, err)
+		}
+		tokenMetadataProto.CurrentIssuerPublicKey = currentIssuerPublicKey.Serialize()
+		tokenMetadataProto.IsPaused = tokenCreate.IsPaused
+		for _, rotation := range tokenCreate.Edges.IssuerKeyRotations {
+			tokenMetadataProto.IssuerKeyRotations = append(tokenMetadataProto.IssuerKeyRotations, &tokenpb.IssuerKeyRotation{
+				PreviousIssuerPublicKey: rotation.PreviousIssuerPublicKey,
+				NewIssuerPublicKey:      rotation.NewIssuerPublicKey,
+				IssuerProvidedTimestamp: rotation.IssuerProvidedTimestamp,
+			})
+		}
+		tokenMetadataList = append(tokenMetadataList, tokenMetadataProto)
 	}
 
 	return &tokenpb.QueryTokenMetadataResponse{
@@ -169,8 +197,78 @@ func (h *QueryTokenHandler) queryTokenTransactionsInternal(ctx context.Context,
 		)
 	}
 
+	if len(req.SparkPaymentIntents) > 0 {
+		paymentIntentIDs := make([]uuid.UUID, 0, len(req.SparkPaymentIntents))
+		for _, paymentIntent := range req.SparkPaymentIntents {
+			id, err := ent.PaymentIntentID(paymentIntent)
+			if err != nil {
+				return nil, err
+			}
+			paymentIntentIDs = append(paymentIntentIDs, id)
+		}
+		baseQuery = baseQuery.Where(tokentransaction.HasPaymentIntentWith(paymentintent.IDIn(paymentIntentIDs...)))
+	}
+
+	if req.CreatedAfter != nil {
+		baseQuery = baseQuery.Where(tokentransaction.CreateTimeGTE(req.CreatedAfter.AsTime()))
+	}
+
+	if req.CreatedBefore != nil {
+		baseQuery = baseQuery.Where(tokentransaction.CreateTimeLT(req.CreatedBefore.AsTime()))
+	}
+
+	if len(req.Statuses) > 0 {
+		statuses := make([]st.TokenTransactionStatus, 0, len(req.Statuses))
+		for _, status := range req.Statuses {
+			entStatus, err := protoconverter.ConvertTokenTransactionStatusFromTokenPb(status)
+			if err != nil {
+				return nil, err
+			}
+			statuses = append(statuses, entStatus)
+		}
+		baseQuery = baseQuery.Where(tokentransaction.StatusIn(statuses...))
+	}
+
+	// Sort by last update time unless creation time is requested. Creation time does not change as a transaction
+	// progresses, which together with the ID breaking ties makes cursors unambiguous.
+	sortByCreateTime := req.SortField == tokenpb.TokenTransactionSortField_TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME
+	ascending := req.Order == sparkpb.Order_ASCENDING
+	orderBy := ent.Desc
+	if ascending {
+		orderBy = ent.Asc
+	}
+
+	if req.Cursor != "" {
+		if req.Offset > 0 {
+			return nil, fmt.Errorf("cursor and offset cannot be combined")
+		}
+		if !sortByCreateTime {
+			return nil, fmt.Errorf("cursor requires sorting by creation time")
+		}
+		cursorTime, cursorID, err := decodeTokenTransactionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if ascending {
+			baseQuery = baseQuery.Where(tokentransaction.Or(
+				tokentransaction.CreateTimeGT(cursorTime),
+				tokentransaction.And(tokentransaction.CreateTimeEQ(cursorTime), tokentransaction.IDGT(cursorID)),
+			))
+		} else {
+			baseQuery = baseQuery.Where(tokentransaction.Or(
+				tokentransaction.CreateTimeLT(cursorTime),
+				tokentransaction.And(tokentransaction.CreateTimeEQ(cursorTime), tokentransaction.IDLT(cursorID)),
+			))
+		}
+	}
+
 	// Apply sorting, limit and offset
-	query := baseQuery.Order(ent.Desc(tokentransaction.FieldUpdateTime))
+	var query *ent.TokenTransactionQuery
+	if sortByCreateTime {
+		query = baseQuery.Order(orderBy(tokentransaction.FieldCreateTime), orderBy(tokentransaction.FieldID))
+	} else {
+		query = baseQuery.Order(orderBy(tokentransaction.FieldUpdateTime))
+	}
 
 	if req.Limit == 0 {
 		req.Limit = 100
@@ -192,7 +290,8 @@ func (h *QueryTokenHandler) queryTokenTransactionsInternal(ctx context.Context,
 			slq.WithOutputCreatedTokenTransaction()
 		}).
 		WithMint().
-		WithSparkInvoice()
+		WithSparkInvoice().
+		WithPaymentIntent()
 
 	// Execute the query
 	transactions, err := query.All(ctx)
@@ -217,6 +316,9 @@ func (h *QueryTokenHandler) queryTokenTransactionsInternal(ctx context.Context,
 			Status:               status,
 			TokenTransactionHash: transaction.FinalizedTokenTransactionHash,
 		}
+		if transaction.Edges.PaymentIntent != nil {
+			transactionWithStatus.SparkPaymentIntent = transaction.Edges.PaymentIntent.PaymentIntent
+		}
 
 		if status == tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_FINALIZED {
 			spentTokenOutputsMetadata := make([]*tokenpb.SpentTokenOutputMetadata, 0, len(transaction.Edges.SpentOutput))
@@ -234,10 +336,15 @@ func (h *QueryTokenHandler) queryTokenTransactionsInternal(ctx context.Context,
 		transactionsWithStatus = append(transactionsWithStatus, transactionWithStatus)
 	}
 
-	// Calculate next offset
+	// Calculate next offset and cursor
 	var nextOffset int64
+	var nextCursor string
 	if len(transactions) == int(req.Limit) {
 		nextOffset = req.Offset + int64(len(transactions))
+		if sortByCreateTime {
+			lastTransaction := transactions[len(transactions)-1]
+			nextCursor = encodeTokenTransactionCursor(lastTransaction.CreateTime, lastTransaction.ID)
+		}
 	} else {
 		nextOffset = -1
 	}
@@ -245,9 +352,29 @@ func (h *QueryTokenHandler) queryTokenTransactionsInternal(ctx context.Context,
 	return &tokenpb.QueryTokenTransactionsResponse{
 		TokenTransactionsWithStatus: transactionsWithStatus,
 		Offset:                      nextOffset,
+		NextCursor:                  nextCursor,
 	}, nil
 }
 
+// encodeTokenTransactionCursor encodes the position of a transaction in the creation time ordering.
+func encodeTokenTransactionCursor(createTime time.Time, id uuid.UUID) string {
+	cursor := binary.BigEndian.AppendUint64(nil, uint64(createTime.UnixNano()))
+	cursor = append(cursor, id[:]...)
+	return base64.RawURLEncoding.EncodeToString(cursor)
+}
+
+func decodeTokenTransactionCursor(cursor string) (time.Time, uuid.UUID, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil || len(decoded) != 8+16 {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor")
+	}
+	id, err := uuid.FromBytes(decoded[8:])
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor")
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(decoded[:8]))), id, nil
+}
+
 // QueryTokenTransactionsToken is the native tokenpb endpoint for SparkTokenService.
 // This provides the same functionality as the legacy QueryTokenTransactions but uses
 // tokenpb protocol directly for better performance and cleaner API design.
@@ -422,6 +549,7 @@ func (h *QueryTokenHandler) QueryTokenOutputsToken(ctx context.Context, req *tok
 				TokenPublicKey:                output.TokenPublicKey,
 				TokenIdentifier:               output.TokenIdentifier,
 				TokenAmount:                   output.TokenAmount,
+				SpendableAfter:                output.SpendableAfterProto(),
 			},
 			PreviousTransactionHash: output.Edges.OutputCreatedTokenTransaction.FinalizedTokenTransactionHash,
 			PreviousTransactionVout: uint32(output.CreatedTransactionOutputVout),
@@ -431,3 +559,148 @@ func (h *QueryTokenHandler) QueryTokenOutputsToken(ctx context.Context, req *tok
 		OutputsWithPreviousTransactionData: ownedTokenOutputs,
 	}, nil
 }
+
+// QueryTokenHolders returns the holders of a token and their balances according to this SO, optionally as of a
+// past time. Holders are ordered by owner public key and paged with limit and offset.
+func (h *QueryTokenHandler) QueryTokenHolders(ctx context.Context, req *tokenpb.QueryTokenHoldersRequest) (*tokenpb.QueryTokenHoldersResponse, error) {
+	ctx, span := tracer.Start(ctx, "QueryTokenHandler.QueryTokenHolders")
+	defer span.End()
+
+	if len(req.TokenIdentifier) != 32 {
+		return nil, fmt.Errorf("token identifier must be exactly 32 bytes, got %d", len(req.TokenIdentifier))
+	}
+	asOfTime := time.Now()
+	if req.AsOfTime != nil {
+		asOfTime = req.AsOfTime.AsTime()
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = 100
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	offset := max(req.Offset, 0)
+
+	holders, err := ent.GetTokenHolderBalances(ctx, ent.GetTokenHolderBalancesParams{
+		TokenIdentifier: req.TokenIdentifier,
+		AsOfTime:        asOfTime,
+		Limit:           int(limit),
+		Offset:          int(offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token holder balances: %w", err)
+	}
+
+	tokenHolders := make([]*tokenpb.TokenHolder, len(holders))
+	for i, holder := range holders {
+		tokenHolders[i] = &tokenpb.TokenHolder{
+			OwnerPublicKey: holder.OwnerPublicKey,
+			Balance:        holder.Balance.Bytes(),
+			OutputCount:    uint32(holder.OutputCount),
+		}
+	}
+
+	nextOffset := int64(-1)
+	if len(holders) == int(limit) {
+		nextOffset = offset + int64(len(holders))
+	}
+	return &tokenpb.QueryTokenHoldersResponse{
+		TokenHolders: tokenHolders,
+		Offset:       nextOffset,
+	}, nil
+}
+
+// QueryTokenSupply returns minted, circulating, frozen and max supply for each requested token known to this SO.
+func (h *QueryTokenHandler) QueryTokenSupply(ctx context.Context, req *tokenpb.QueryTokenSupplyRequest) (*tokenpb.QueryTokenSupplyResponse, error) {
+	ctx, span := tracer.Start(ctx, "QueryTokenHandler.QueryTokenSupply")
+	defer span.End()
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	if len(req.TokenIdentifiers) == 0 {
+		return nil, fmt.Errorf("must provide at least one token identifier")
+	}
+
+	tokenCreateEntities, err := db.TokenCreate.Query().
+		Where(tokencreate.TokenIdentifierIn(req.TokenIdentifiers...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token creates: %w", err)
+	}
+
+	tokenSupplies := make([]*tokenpb.TokenSupply, 0, len(tokenCreateEntities))
+	for _, tokenCreate := range tokenCreateEntities {
+		supply, err := tokens.GetTokenSupply(ctx, tokenCreate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get supply for token %x: %w", tokenCreate.TokenIdentifier, err)
+		}
+		tokenSupplies = append(tokenSupplies, &tokenpb.TokenSupply{
+			TokenIdentifier:   tokenCreate.TokenIdentifier,
+			MaxSupply:         supply.MaxSupply.FillBytes(make([]byte, 16)),
+			MintedSupply:      supply.Minted.FillBytes(make([]byte, 16)),
+			CirculatingSupply: supply.Circulating.FillBytes(make([]byte, 16)),
+			FrozenSupply:      supply.Frozen.FillBytes(make([]byte, 16)),
+			FrozenOutputCount: uint32(supply.FrozenOutputCount),
+		})
+	}
+	return &tokenpb.QueryTokenSupplyResponse{TokenSupplies: tokenSupplies}, nil
+}
+
+// QueryTokenFreezes returns the freezes of a token that are currently in effect, including when they expire.
+func (h *QueryTokenHandler) QueryTokenFreezes(ctx context.Context, req *tokenpb.QueryTokenFreezesRequest) (*tokenpb.QueryTokenFreezesResponse, error) {
+	ctx, span := tracer.Start(ctx, "QueryTokenHandler.QueryTokenFreezes")
+	defer span.End()
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	if len(req.TokenIdentifier) != 32 {
+		return nil, fmt.Errorf("token identifier must be exactly 32 bytes, got %d", len(req.TokenIdentifier))
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = 100
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	offset := max(req.Offset, 0)
+
+	tokenCreate, err := db.TokenCreate.Query().Where(tokencreate.TokenIdentifierEQ(req.TokenIdentifier)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token %x: %w", req.TokenIdentifier, err)
+	}
+	activeFreezes, err := tokenCreate.QueryTokenFreeze().
+		Where(ent.ActiveTokenFreeze(time.Now())).
+		Order(ent.Asc(tokenfreeze.FieldOwnerPublicKey), ent.Asc(tokenfreeze.FieldID)).
+		Limit(int(limit)).
+		Offset(int(offset)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", tokens.ErrFailedToQueryTokenFreezeStatus, err)
+	}
+
+	tokenFreezes := make([]*tokenpb.TokenFreezeInfo, len(activeFreezes))
+	for i, freeze := range activeFreezes {
+		tokenFreezes[i] = &tokenpb.TokenFreezeInfo{
+			OwnerPublicKey:          freeze.OwnerPublicKey,
+			IssuerProvidedTimestamp: freeze.WalletProvidedFreezeTimestamp,
+		}
+		if freeze.ExpiresAt != nil {
+			tokenFreezes[i].ExpiresAt = timestamppb.New(*freeze.ExpiresAt)
+		}
+	}
+
+	nextOffset := int64(-1)
+	if len(activeFreezes) == int(limit) {
+		nextOffset = offset + int64(len(activeFreezes))
+	}
+	return &tokenpb.QueryTokenFreezesResponse{
+		TokenFreezes: tokenFreezes,
+		Offset:       nextOffset,
+	}, nil
+}
@@ -3,16 +3,21 @@ package tokens
 import (
 	"context"
 	"crypto/rand"
+	"math/big"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/lightsparkdev/spark/common"
 	sparkpb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/db"
 	"github.com/lightsparkdev/spark/so/ent"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
@@ -180,3 +185,354 @@ func TestExpiredOutputBeforeFinalization(t *testing.T) {
 		assert.Equal(t, mintOutput.ID.String(), outputsResp.OutputsWithPreviousTransactionData[0].Output.GetId())
 	})
 }
+
+func queryTestRandomBytes(t *testing.T, length int) []byte {
+	b := make([]byte, length)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func createQueryTestTokenCreate(t *testing.T, ctx context.Context, tx *ent.Tx, config *so.Config) *ent.TokenCreate {
+	tokenCreate, err := tx.TokenCreate.Create().
+		SetIssuerPublicKey(queryTestRandomBytes(t, 33)).
+		SetTokenName("TestToken").
+		SetTokenTicker("TTK").
+		SetDecimals(0).
+		SetMaxSupply(queryTestRandomBytes(t, 16)).
+		SetIsFreezable(true).
+		SetNetwork(st.NetworkRegtest).
+		SetTokenIdentifier(queryTestRandomBytes(t, 32)).
+		SetCreationEntityPublicKey(config.IdentityPublicKey().Serialize()).
+		Save(ctx)
+	require.NoError(t, err)
+	return tokenCreate
+}
+
+// createQueryTestTransaction creates a token transaction that was last updated at updateTime.
+func createQueryTestTransaction(t *testing.T, tx *ent.Tx, status st.TokenTransactionStatus, updateTime time.Time) *ent.TokenTransactionCreate {
+	return tx.TokenTransaction.Create().
+		SetPartialTokenTransactionHash(queryTestRandomBytes(t, 32)).
+		SetFinalizedTokenTransactionHash(queryTestRandomBytes(t, 32)).
+		SetStatus(status).
+		SetUpdateTime(updateTime)
+}
+
+func createQueryTestMint(t *testing.T, ctx context.Context, tx *ent.Tx, tokenCreate *ent.TokenCreate) *ent.TokenMint {
+	mint, err := tx.TokenMint.Create().
+		SetIssuerPublicKey(tokenCreate.IssuerPublicKey).
+		SetWalletProvidedTimestamp(uint64(time.Now().UnixMilli())).
+		SetIssuerSignature(queryTestRandomBytes(t, 64)).
+		SetTokenIdentifier(tokenCreate.TokenIdentifier).
+		Save(ctx)
+	require.NoError(t, err)
+	return mint
+}
+
+func createQueryTestOutput(t *testing.T, ctx context.Context, tx *ent.Tx, tokenCreate *ent.TokenCreate, createdTx *ent.TokenTransaction, vout int32, owner []byte, amount int64) *ent.TokenOutput {
+	keyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusInUse).
+		SetSecretShare(queryTestRandomBytes(t, 32)).
+		SetPublicShares(map[string][]byte{}).
+		SetPublicKey(queryTestRandomBytes(t, 33)).
+		SetMinSigners(1).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	output, err := tx.TokenOutput.Create().
+		SetStatus(st.TokenOutputStatusCreatedFinalized).
+		SetOwnerPublicKey(owner).
+		SetWithdrawBondSats(1_000).
+		SetWithdrawRelativeBlockLocktime(10).
+		SetWithdrawRevocationCommitment(queryTestRandomBytes(t, 33)).
+		SetTokenAmount(big.NewInt(amount).FillBytes(make([]byte, 16))).
+		SetCreatedTransactionOutputVout(vout).
+		SetRevocationKeyshareID(keyshare.ID).
+		SetTokenIdentifier(tokenCreate.TokenIdentifier).
+		SetTokenCreateID(tokenCreate.ID).
+		SetOutputCreatedTokenTransactionID(createdTx.ID).
+		SetNetwork(st.NetworkRegtest).
+		Save(ctx)
+	require.NoError(t, err)
+	return output
+}
+
+func TestQueryTokenHolders(t *testing.T) {
+	setup := setupQueryTokenTestHandler(t)
+	defer setup.Cleanup()
+	handler := setup.Handler
+	ctx := setup.Ctx
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	randomBytes := func(length int) []byte {
+		b := make([]byte, length)
+		_, err := rand.Read(b)
+		require.NoError(t, err)
+		return b
+	}
+	ownerKey := func(b byte) []byte {
+		key := make([]byte, 33)
+		key[0], key[1] = 0x02, b
+		return key
+	}
+	alice, bob, carol := ownerKey(1), ownerKey(2), ownerKey(3)
+
+	tokenCreate, err := tx.TokenCreate.Create().
+		SetIssuerPublicKey(randomBytes(33)).
+		SetTokenName("TestToken").
+		SetTokenTicker("TTK").
+		SetDecimals(0).
+		SetMaxSupply(randomBytes(16)).
+		SetIsFreezable(true).
+		SetNetwork(st.NetworkRegtest).
+		SetTokenIdentifier(randomBytes(32)).
+		SetCreationEntityPublicKey(handler.config.IdentityPublicKey().Serialize()).
+		Save(ctx)
+	require.NoError(t, err)
+
+	createTransaction := func(status st.TokenTransactionStatus) *ent.TokenTransactionCreate {
+		return tx.TokenTransaction.Create().
+			SetPartialTokenTransactionHash(randomBytes(32)).
+			SetFinalizedTokenTransactionHash(randomBytes(32)).
+			SetStatus(status)
+	}
+	createOutput := func(createdTx *ent.TokenTransaction, vout int32, owner []byte, amount int64) *ent.TokenOutput {
+		keyshare, err := tx.SigningKeyshare.Create().
+			SetStatus(st.KeyshareStatusInUse).
+			SetSecretShare(randomBytes(32)).
+			SetPublicShares(map[string][]byte{}).
+			SetPublicKey(randomBytes(33)).
+			SetMinSigners(1).
+			SetCoordinatorIndex(0).
+			Save(ctx)
+		require.NoError(t, err)
+		output, err := tx.TokenOutput.Create().
+			SetStatus(st.TokenOutputStatusCreatedFinalized).
+			SetOwnerPublicKey(owner).
+			SetWithdrawBondSats(1_000).
+			SetWithdrawRelativeBlockLocktime(10).
+			SetWithdrawRevocationCommitment(randomBytes(33)).
+			SetTokenAmount(big.NewInt(amount).FillBytes(make([]byte, 16))).
+			SetCreatedTransactionOutputVout(vout).
+			SetRevocationKeyshareID(keyshare.ID).
+			SetTokenIdentifier(tokenCreate.TokenIdentifier).
+			SetTokenCreateID(tokenCreate.ID).
+			SetOutputCreatedTokenTransactionID(createdTx.ID).
+			SetNetwork(st.NetworkRegtest).
+			Save(ctx)
+		require.NoError(t, err)
+		return output
+	}
+	spendOutput := func(output *ent.TokenOutput, spentTx *ent.TokenTransaction) {
+		_, err := output.Update().
+			SetStatus(st.TokenOutputStatusSpentStarted).
+			SetOutputSpentTokenTransactionID(spentTx.ID).
+			SetSpentTransactionInputVout(0).
+			Save(ctx)
+		require.NoError(t, err)
+	}
+
+	mintTime := time.Now().Add(-2 * time.Hour)
+	transferTime := time.Now().Add(-time.Hour)
+	mint, err := tx.TokenMint.Create().
+		SetIssuerPublicKey(tokenCreate.IssuerPublicKey).
+		SetWalletProvidedTimestamp(uint64(mintTime.UnixMilli())).
+		SetIssuerSignature(randomBytes(64)).
+		SetTokenIdentifier(tokenCreate.TokenIdentifier).
+		Save(ctx)
+	require.NoError(t, err)
+	mintTx, err := createTransaction(st.TokenTransactionStatusSigned).SetFinalizedAt(mintTime).SetMintID(mint.ID).Save(ctx)
+	require.NoError(t, err)
+	createOutput(mintTx, 0, alice, 50)
+	aliceSpent := createOutput(mintTx, 1, alice, 10)
+	bobPending := createOutput(mintTx, 2, bob, 40)
+
+	transferTx, err := createTransaction(st.TokenTransactionStatusFinalized).SetFinalizedAt(transferTime).Save(ctx)
+	require.NoError(t, err)
+	spendOutput(aliceSpent, transferTx)
+	createOutput(transferTx, 0, carol, 10)
+
+	// A transfer that has not finalized leaves the spent output with its owner.
+	pendingTx, err := createTransaction(st.TokenTransactionStatusStarted).Save(ctx)
+	require.NoError(t, err)
+	spendOutput(bobPending, pendingTx)
+	createOutput(pendingTx, 0, carol, 40)
+
+	holder := func(owner []byte, balance int64, outputCount uint32) *tokenpb.TokenHolder {
+		return &tokenpb.TokenHolder{OwnerPublicKey: owner, Balance: big.NewInt(balance).Bytes(), OutputCount: outputCount}
+	}
+
+	resp, err := handler.QueryTokenHolders(ctx, &tokenpb.QueryTokenHoldersRequest{TokenIdentifier: tokenCreate.TokenIdentifier})
+	require.NoError(t, err)
+	assert.Equal(t, []*tokenpb.TokenHolder{holder(alice, 50, 1), holder(bob, 40, 1), holder(carol, 10, 1)}, resp.TokenHolders)
+	assert.Equal(t, int64(-1), resp.Offset)
+
+	resp, err = handler.QueryTokenHolders(ctx, &tokenpb.QueryTokenHoldersRequest{
+		TokenIdentifier: tokenCreate.TokenIdentifier,
+		AsOfTime:        timestamppb.New(transferTime.Add(-time.Minute)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*tokenpb.TokenHolder{holder(alice, 60, 2), holder(bob, 40, 1)}, resp.TokenHolders)
+
+	resp, err = handler.QueryTokenHolders(ctx, &tokenpb.QueryTokenHoldersRequest{
+		TokenIdentifier: tokenCreate.TokenIdentifier,
+		AsOfTime:        timestamppb.New(mintTime.Add(-time.Minute)),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.TokenHolders)
+
+	resp, err = handler.QueryTokenHolders(ctx, &tokenpb.QueryTokenHoldersRequest{TokenIdentifier: tokenCreate.TokenIdentifier, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []*tokenpb.TokenHolder{holder(alice, 50, 1), holder(bob, 40, 1)}, resp.TokenHolders)
+	assert.Equal(t, int64(2), resp.Offset)
+	resp, err = handler.QueryTokenHolders(ctx, &tokenpb.QueryTokenHoldersRequest{TokenIdentifier: tokenCreate.TokenIdentifier, Limit: 2, Offset: resp.Offset})
+	require.NoError(t, err)
+	assert.Equal(t, []*tokenpb.TokenHolder{holder(carol, 10, 1)}, resp.TokenHolders)
+	assert.Equal(t, int64(-1), resp.Offset)
+}
+
+func TestQueryTokenTransactionsCursor(t *testing.T) {
+	setup := setupQueryTokenTestHandler(t)
+	defer setup.Cleanup()
+	handler := setup.Handler
+	ctx := setup.Ctx
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	tokenCreate := createQueryTestTokenCreate(t, ctx, tx, handler.config)
+	baseTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	createMintTransaction := func(status st.TokenTransactionStatus, createTime time.Time) *ent.TokenTransaction {
+		mint := createQueryTestMint(t, ctx, tx, tokenCreate)
+		transaction, err := createQueryTestTransaction(t, tx, status, time.Now()).
+			SetCreateTime(createTime).
+			SetMintID(mint.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		createQueryTestOutput(t, ctx, tx, tokenCreate, transaction, 0, queryTestRandomBytes(t, 33), 1)
+		return transaction
+	}
+
+	// Two transactions share a creation time so that paging has to break the tie by ID.
+	first := createMintTransaction(st.TokenTransactionStatusFinalized, baseTime)
+	second := createMintTransaction(st.TokenTransactionStatusSigned, baseTime.Add(time.Minute))
+	third := createMintTransaction(st.TokenTransactionStatusFinalized, baseTime.Add(time.Minute))
+	fourth := createMintTransaction(st.TokenTransactionStatusStarted, baseTime.Add(2*time.Minute))
+
+	hashes := func(resp *tokenpb.QueryTokenTransactionsResponse) [][]byte {
+		result := make([][]byte, len(resp.TokenTransactionsWithStatus))
+		for i, transaction := range resp.TokenTransactionsWithStatus {
+			result[i] = transaction.TokenTransactionHash
+		}
+		return result
+	}
+	hash := func(transaction *ent.TokenTransaction) []byte {
+		return transaction.FinalizedTokenTransactionHash
+	}
+	tiedDesc := [][]byte{hash(third), hash(second)}
+	if third.ID.String() < second.ID.String() {
+		tiedDesc = [][]byte{hash(second), hash(third)}
+	}
+	query := func(req *tokenpb.QueryTokenTransactionsRequest) *tokenpb.QueryTokenTransactionsResponse {
+		req.TokenIdentifiers = [][]byte{tokenCreate.TokenIdentifier}
+		req.SortField = tokenpb.TokenTransactionSortField_TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME
+		resp, err := handler.QueryTokenTransactionsToken(ctx, req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := query(&tokenpb.QueryTokenTransactionsRequest{Limit: 2})
+	assert.Equal(t, [][]byte{hash(fourth), tiedDesc[0]}, hashes(resp))
+	require.NotEmpty(t, resp.NextCursor)
+
+	// Transactions created after the first page was read do not shift the following pages.
+	fifth := createMintTransaction(st.TokenTransactionStatusStarted, baseTime.Add(3*time.Minute))
+
+	resp = query(&tokenpb.QueryTokenTransactionsRequest{Limit: 2, Cursor: resp.NextCursor})
+	assert.Equal(t, [][]byte{tiedDesc[1], hash(first)}, hashes(resp))
+	resp = query(&tokenpb.QueryTokenTransactionsRequest{Limit: 2, Cursor: resp.NextCursor})
+	assert.Empty(t, resp.TokenTransactionsWithStatus)
+	assert.Empty(t, resp.NextCursor)
+	assert.Equal(t, int64(-1), resp.Offset)
+
+	resp = query(&tokenpb.QueryTokenTransactionsRequest{Limit: 3, Order: sparkpb.Order_ASCENDING})
+	assert.Equal(t, [][]byte{hash(first), tiedDesc[1], tiedDesc[0]}, hashes(resp))
+	resp = query(&tokenpb.QueryTokenTransactionsRequest{Limit: 3, Order: sparkpb.Order_ASCENDING, Cursor: resp.NextCursor})
+	assert.Equal(t, [][]byte{hash(fourth), hash(fifth)}, hashes(resp))
+
+	resp = query(&tokenpb.QueryTokenTransactionsRequest{
+		CreatedAfter:  timestamppb.New(baseTime.Add(time.Minute)),
+		CreatedBefore: timestamppb.New(baseTime.Add(2 * time.Minute)),
+	})
+	assert.Equal(t, tiedDesc, hashes(resp))
+
+	resp = query(&tokenpb.QueryTokenTransactionsRequest{
+		Statuses: []tokenpb.TokenTransactionStatus{tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_FINALIZED},
+	})
+	assert.Equal(t, [][]byte{hash(third), hash(first)}, hashes(resp))
+
+	// Without a sort field, transactions are sorted by last update time and no cursor is returned.
+	_, err = second.Update().SetUpdateTime(time.Now().Add(time.Minute)).Save(ctx)
+	require.NoError(t, err)
+	resp, err = handler.QueryTokenTransactionsToken(ctx, &tokenpb.QueryTokenTransactionsRequest{
+		TokenIdentifiers: [][]byte{tokenCreate.TokenIdentifier},
+		Limit:            1,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{hash(second)}, hashes(resp))
+	assert.Empty(t, resp.NextCursor)
+
+	createTimeSort := tokenpb.TokenTransactionSortField_TOKEN_TRANSACTION_SORT_FIELD_CREATE_TIME
+	cursor := encodeTokenTransactionCursor(first.CreateTime, first.ID)
+	_, err = handler.QueryTokenTransactionsToken(ctx, &tokenpb.QueryTokenTransactionsRequest{Cursor: cursor, SortField: createTimeSort, Offset: 1})
+	require.ErrorContains(t, err, "cursor and offset cannot be combined")
+	_, err = handler.QueryTokenTransactionsToken(ctx, &tokenpb.QueryTokenTransactionsRequest{Cursor: cursor})
+	require.ErrorContains(t, err, "cursor requires sorting by creation time")
+	_, err = handler.QueryTokenTransactionsToken(ctx, &tokenpb.QueryTokenTransactionsRequest{Cursor: "not-a-cursor", SortField: createTimeSort})
+	require.ErrorContains(t, err, "invalid cursor")
+}
+
+func TestQueryTokenTransactionsByPaymentIntent(t *testing.T) {
+	setup := setupQueryTokenTestHandler(t)
+	defer setup.Cleanup()
+	handler := setup.Handler
+	ctx := setup.Ctx
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	tokenCreate := createQueryTestTokenCreate(t, ctx, tx, handler.config)
+	createMintTransaction := func() *ent.TokenTransaction {
+		mint := createQueryTestMint(t, ctx, tx, tokenCreate)
+		transaction, err := createQueryTestTransaction(t, tx, st.TokenTransactionStatusStarted, time.Now()).
+			SetMintID(mint.ID).
+			Save(ctx)
+		require.NoError(t, err)
+		createQueryTestOutput(t, ctx, tx, tokenCreate, transaction, 0, queryTestRandomBytes(t, 33), 1)
+		return transaction
+	}
+	withPaymentIntent := createMintTransaction()
+	createMintTransaction()
+
+	id, err := uuid.NewV7()
+	require.NoError(t, err)
+	paymentIntent, err := common.EncodeSparkAddress(
+		queryTestRandomBytes(t, 33),
+		common.Regtest,
+		common.CreateTokenSparkInvoiceFields(id[:], tokenCreate.TokenIdentifier, big.NewInt(1).Bytes(), nil, nil, nil),
+	)
+	require.NoError(t, err)
+	require.NoError(t, ent.AttachPaymentIntentToTokenTransaction(ctx, withPaymentIntent, paymentIntent))
+
+	resp, err := handler.QueryTokenTransactionsToken(ctx, &tokenpb.QueryTokenTransactionsRequest{
+		SparkPaymentIntents: []string{paymentIntent},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.TokenTransactionsWithStatus, 1)
+	assert.Equal(t, withPaymentIntent.FinalizedTokenTransactionHash, resp.TokenTransactionsWithStatus[0].TokenTransactionHash)
+	assert.Equal(t, paymentIntent, resp.TokenTransactionsWithStatus[0].SparkPaymentIntent)
+
+	_, err = handler.QueryTokenTransactionsToken(ctx, &tokenpb.QueryTokenTransactionsRequest{
+		SparkPaymentIntents: []string{"not-a-payment-intent"},
+	})
+	require.ErrorContains(t, err, "failed to parse payment intent")
+}
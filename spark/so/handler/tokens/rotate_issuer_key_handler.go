@@ -0,0 +1,129 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/tokencreate"
+	"github.com/lightsparkdev/spark/so/ent/tokenissuerkeyrotation"
+	"github.com/lightsparkdev/spark/so/utils"
+)
+
+type RotateIssuerKeyHandler struct {
+	config *so.Config
+}
+
+// NewRotateIssuerKeyHandler creates a new RotateIssuerKeyHandler.
+func NewRotateIssuerKeyHandler(config *so.Config) *RotateIssuerKeyHandler {
+	return &RotateIssuerKeyHandler{
+		config: config,
+	}
+}
+
+// RotateIssuerKey replaces the key allowed to mint and freeze a token. The rotation must be signed by the
+// current issuer key, and the new key must not have been an issuer key of the token before. Retrying an
+// already applied rotation succeeds without recording it twice.
+func (h *RotateIssuerKeyHandler) RotateIssuerKey(
+	ctx context.Context,
+	req *tokenpb.RotateIssuerKeyRequest,
+) (*tokenpb.RotateIssuerKeyResponse, error) {
+	payload := req.GetRotateIssuerKeyPayload()
+	if err := utils.ValidateRotateIssuerKeyPayload(payload, h.config.IdentityPublicKey()); err != nil {
+		return nil, fmt.Errorf("rotate issuer key payload validation failed: %w", err)
+	}
+
+	payloadHash, err := utils.HashRotateIssuerKeyPayload(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash rotate issuer key payload: %w", err)
+	}
+
+	tokenCreateEnt, err := queryTokenCreateByIdentifier(ctx, payload.GetTokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+
+	existingRotation, err := tokenCreateEnt.QueryIssuerKeyRotations().
+		Where(tokenissuerkeyrotation.IssuerSignatureEQ(req.GetIssuerSignature())).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to query issuer key rotations: %w", err)
+	}
+	if existingRotation != nil {
+		if !bytes.Equal(existingRotation.NewIssuerPublicKey, payload.GetNewIssuerPublicKey()) {
+			return nil, fmt.Errorf("issuer signature was already used for a different issuer key rotation")
+		}
+		return &tokenpb.RotateIssuerKeyResponse{CurrentIssuerPublicKey: existingRotation.NewIssuerPublicKey}, nil
+	}
+
+	latestRotation, err := tokenCreateEnt.LatestIssuerKeyRotation(ctx)
+	if err != nil {
+		return nil, err
+	}
+	currentIssuerPublicKey, err := validateCurrentIssuerSignature(ctx, tokenCreateEnt, payloadHash, req.GetIssuerSignature())
+	if err != nil {
+		return nil, err
+	}
+	if latestRotation != nil && payload.GetIssuerProvidedTimestamp() <= latestRotation.IssuerProvidedTimestamp {
+		return nil, fmt.Errorf("issuer provided timestamp %d must be later than the last rotation at %d", payload.GetIssuerProvidedTimestamp(), latestRotation.IssuerProvidedTimestamp)
+	}
+	if bytes.Equal(payload.GetNewIssuerPublicKey(), tokenCreateEnt.IssuerPublicKey) {
+		return nil, fmt.Errorf("new issuer public key was already used as an issuer public key of this token")
+	}
+	keyWasUsed, err := tokenCreateEnt.QueryIssuerKeyRotations().
+		Where(tokenissuerkeyrotation.NewIssuerPublicKeyEQ(payload.GetNewIssuerPublicKey())).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query issuer key rotations: %w", err)
+	}
+	if keyWasUsed {
+		return nil, fmt.Errorf("new issuer public key was already used as an issuer public key of this token")
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+	_, err = db.TokenIssuerKeyRotation.Create().
+		SetTokenCreateID(tokenCreateEnt.ID).
+		SetPreviousIssuerPublicKey(currentIssuerPublicKey.Serialize()).
+		SetNewIssuerPublicKey(payload.GetNewIssuerPublicKey()).
+		SetIssuerSignature(req.GetIssuerSignature()).
+		SetIssuerProvidedTimestamp(payload.GetIssuerProvidedTimestamp()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record issuer key rotation: %w", err)
+	}
+
+	return &tokenpb.RotateIssuerKeyResponse{CurrentIssuerPublicKey: payload.GetNewIssuerPublicKey()}, nil
+}
+
+// queryTokenCreateByIdentifier returns the token an issuer signed payload refers to.
+func queryTokenCreateByIdentifier(ctx context.Context, tokenIdentifier []byte) (*ent.TokenCreate, error) {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+	tokenCreate, err := db.TokenCreate.Query().Where(tokencreate.TokenIdentifierEQ(tokenIdentifier)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token with identifier %x: %w", tokenIdentifier, err)
+	}
+	return tokenCreate, nil
+}
+
+// validateCurrentIssuerSignature checks that the payload was signed by the current issuer key of the token and
+// returns that key.
+func validateCurrentIssuerSignature(ctx context.Context, tokenCreate *ent.TokenCreate, payloadHash []byte, issuerSignature []byte) (keys.Public, error) {
+	currentIssuerPublicKey, err := tokenCreate.CurrentIssuerPublicKey(ctx)
+	if err != nil {
+		return keys.Public{}, fmt.Errorf("failed to get current issuer public key: %w", err)
+	}
+	if err := utils.ValidateOwnershipSignature(issuerSignature, payloadHash, currentIssuerPublicKey); err != nil {
+		return keys.Public{}, fmt.Errorf("invalid issuer signature for token with identifier %x with issuer public key %s: %w", tokenCreate.TokenIdentifier, currentIssuerPublicKey, err)
+	}
+	return currentIssuerPublicKey, nil
+}
@@ -0,0 +1,124 @@
+package tokens
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/utils"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+)
+
+func TestRotateIssuerKey(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{1})
+	originalKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	secondKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	thirdKey := keys.MustGeneratePrivateKeyFromRand(rng)
+
+	tokenCreate, err := sparktesting.NewTestTokenCreate(t, tx, originalKey.Public().Serialize()).Save(ctx)
+	require.NoError(t, err)
+	tokenIdentifier := tokenCreate.TokenIdentifier
+
+	rotateRequest := func(signer keys.Private, newKey keys.Public, timestamp uint64) *tokenpb.RotateIssuerKeyRequest {
+		payload := &tokenpb.RotateIssuerKeyPayload{
+			TokenIdentifier:           tokenIdentifier,
+			NewIssuerPublicKey:        newKey.Serialize(),
+			IssuerProvidedTimestamp:   timestamp,
+			OperatorIdentityPublicKey: config.IdentityPublicKey().Serialize(),
+		}
+		payloadHash, err := utils.HashRotateIssuerKeyPayload(payload)
+		require.NoError(t, err)
+		return &tokenpb.RotateIssuerKeyRequest{
+			RotateIssuerKeyPayload: payload,
+			IssuerSignature:        ecdsa.Sign(signer.ToBTCEC(), payloadHash).Serialize(),
+		}
+	}
+	mintTransactionFor := func(issuer keys.Private, tokenIdentifier []byte) *tokenpb.TokenTransaction {
+		return &tokenpb.TokenTransaction{
+			TokenInputs: &tokenpb.TokenTransaction_MintInput{
+				MintInput: &tokenpb.TokenMintInput{
+					IssuerPublicKey: issuer.Public().Serialize(),
+					TokenIdentifier: tokenIdentifier,
+				},
+			},
+		}
+	}
+	mintTransaction := func(issuer keys.Private) *tokenpb.TokenTransaction {
+		return mintTransactionFor(issuer, tokenIdentifier)
+	}
+	handler := NewRotateIssuerKeyHandler(config)
+
+	require.NoError(t, validateMintIssuerIsCurrent(ctx, mintTransaction(originalKey)))
+	require.NoError(t, validateMintIssuerIsCurrent(ctx, mintTransactionFor(originalKey, nil)))
+	require.ErrorContains(t, validateMintIssuerIsCurrent(ctx, mintTransaction(secondKey)), "not the current issuer key")
+
+	_, err = handler.RotateIssuerKey(ctx, rotateRequest(secondKey, thirdKey.Public(), 100))
+	require.ErrorContains(t, err, "invalid issuer signature")
+
+	firstRotation := rotateRequest(originalKey, secondKey.Public(), 100)
+	resp, err := handler.RotateIssuerKey(ctx, firstRotation)
+	require.NoError(t, err)
+	assert.Equal(t, secondKey.Public().Serialize(), resp.CurrentIssuerPublicKey)
+
+	// Retries of an applied rotation succeed.
+	_, err = handler.RotateIssuerKey(ctx, firstRotation)
+	require.NoError(t, err)
+
+	require.ErrorContains(t, validateMintIssuerIsCurrent(ctx, mintTransaction(originalKey)), "not the current issuer key")
+	require.NoError(t, validateMintIssuerIsCurrent(ctx, mintTransaction(secondKey)))
+	// Once rotated, the token can no longer be identified by an issuer key, whether the old or the new one.
+	require.ErrorContains(t, validateMintIssuerIsCurrent(ctx, mintTransactionFor(originalKey, nil)), "must set the token identifier")
+	require.ErrorContains(t, validateMintIssuerIsCurrent(ctx, mintTransactionFor(secondKey, nil)), "must set the token identifier")
+
+	_, err = handler.RotateIssuerKey(ctx, rotateRequest(originalKey, thirdKey.Public(), 200))
+	require.ErrorContains(t, err, "invalid issuer signature")
+	_, err = handler.RotateIssuerKey(ctx, rotateRequest(secondKey, thirdKey.Public(), 100))
+	require.ErrorContains(t, err, "must be later than the last rotation")
+	_, err = handler.RotateIssuerKey(ctx, rotateRequest(secondKey, thirdKey.Public(), 200))
+	require.NoError(t, err)
+
+	// Keys that were already used by the token cannot become the issuer key again.
+	_, err = handler.RotateIssuerKey(ctx, rotateRequest(thirdKey, originalKey.Public(), 300))
+	require.ErrorContains(t, err, "already used")
+	_, err = handler.RotateIssuerKey(ctx, rotateRequest(thirdKey, secondKey.Public(), 300))
+	require.ErrorContains(t, err, "already used")
+
+	// A concurrent rotation away from the same key cannot fork the key history.
+	_, err = tx.TokenIssuerKeyRotation.Create().
+		SetTokenCreateID(tokenCreate.ID).
+		SetPreviousIssuerPublicKey(secondKey.Public().Serialize()).
+		SetNewIssuerPublicKey(keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()).
+		SetIssuerSignature([]byte("concurrent_rotation_signature")).
+		SetIssuerProvidedTimestamp(250).
+		Save(ctx)
+	require.True(t, ent.IsConstraintError(err))
+
+	metadata, err := NewQueryTokenHandler(config).QueryTokenMetadata(ctx, &tokenpb.QueryTokenMetadataRequest{
+		TokenIdentifiers: [][]byte{tokenIdentifier},
+	})
+	require.NoError(t, err)
+	require.Len(t, metadata.TokenMetadata, 1)
+	tokenMetadata := metadata.TokenMetadata[0]
+	assert.Equal(t, originalKey.Public().Serialize(), tokenMetadata.IssuerPublicKey)
+	assert.Equal(t, thirdKey.Public().Serialize(), tokenMetadata.CurrentIssuerPublicKey)
+	require.Len(t, tokenMetadata.IssuerKeyRotations, 2)
+	assert.Equal(t, originalKey.Public().Serialize(), tokenMetadata.IssuerKeyRotations[0].PreviousIssuerPublicKey)
+	assert.Equal(t, secondKey.Public().Serialize(), tokenMetadata.IssuerKeyRotations[0].NewIssuerPublicKey)
+	assert.Equal(t, secondKey.Public().Serialize(), tokenMetadata.IssuerKeyRotations[1].PreviousIssuerPublicKey)
+	assert.Equal(t, uint64(200), tokenMetadata.IssuerKeyRotations[1].IssuerProvidedTimestamp)
+}
@@ -128,6 +128,9 @@ func (h *SignTokenHandler) SignTokenTransaction(
 	}, nil
 }
 
+// CommitTransaction collects operator signatures for the transaction and, for transactions with inputs,
+// exchanges revocation secrets to finalize it. All spent outputs are committed together, so a transfer
+// swapping several tokens either completes for every token or for none.
 func (h *SignTokenHandler) CommitTransaction(ctx context.Context, req *tokenpb.CommitTransactionRequest) (*tokenpb.CommitTransactionResponse, error) {
 	ctx, span := tracer.Start(ctx, "SignTokenHandler.CommitTransaction", getTokenTransactionAttributes(req.FinalTokenTransaction))
 	defer span.End()
@@ -220,7 +223,7 @@ func (h *SignTokenHandler) CommitTransaction(ctx context.Context, req *tokenpb.C
 	case utils.TokenTransactionTypeCreate, utils.TokenTransactionTypeMint:
 		// We validated the signatures package above, so we know that it is finalized.
 		return finalizedCommitTransactionResponse, nil
-	case utils.TokenTransactionTypeTransfer:
+	case utils.TokenTransactionTypeTransfer, utils.TokenTransactionTypeBurn:
 		if response, err := h.ExchangeRevocationSecretsAndFinalizeIfPossible(ctx, req.FinalTokenTransaction, internalSignatures, req.FinalTokenTransactionHash); err != nil {
 			return nil, tokens.FormatErrorWithTransactionEnt("failed to exchange revocation secret shares and finalize if possible", tokenTransaction, err)
 		} else {
@@ -305,7 +308,7 @@ func (h *SignTokenHandler) checkShouldReturnEarlyWithoutProcessing(
 				return finalizedCommitTransactionResponse, nil
 			}
 		}
-	case utils.TokenTransactionTypeTransfer:
+	case utils.TokenTransactionTypeTransfer, utils.TokenTransactionTypeBurn:
 		if tokenTransaction.Status == st.TokenTransactionStatusFinalized {
 			return finalizedCommitTransactionResponse, nil
 		}
@@ -358,6 +361,9 @@ func (h *SignTokenHandler) exchangeRevocationSecretShares(ctx context.Context, a
 		Save(ctx); err != nil {
 		return nil, fmt.Errorf("failed to update token transaction status to Revealed: %w for token txHash: %x", err, tokenTransactionHash)
 	}
+	if err := ent.RecordTokenTransactionsFinalizedAt(ctx, tokentransaction.FinalizedTokenTransactionHashEQ(tokenTransactionHash)); err != nil {
+		return nil, fmt.Errorf("%w for token txHash: %x", err, tokenTransactionHash)
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit and replace transaction after setting status to revealed: %w for token txHash: %x", err, tokenTransactionHash)
 	}
@@ -395,7 +401,7 @@ func (h *SignTokenHandler) prepareRevocationSecretSharesForExchange(ctx context.
 		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
 	}
 
-	outputsToSpend := tokenTransaction.GetTransferInput().GetOutputsToSpend()
+	outputsToSpend := utils.GetOutputsToSpend(tokenTransaction)
 
 	var matchOutputsToSpendPredicates []predicate.TokenOutput
 	for _, outputToSpend := range outputsToSpend {
@@ -443,7 +449,7 @@ func (h *SignTokenHandler) prepareRevocationSecretSharesForExchange(ctx context.
 	for _, identityPubkey := range allOperatorPubkeys {
 		sharesToReturnMap[identityPubkey.ToHex()] = &tokeninternalpb.OperatorRevocationShares{
 			OperatorIdentityPublicKey: identityPubkey.Serialize(),
-			Shares:                    make([]*tokeninternalpb.RevocationSecretShare, 0, len(tokenTransaction.GetTransferInput().GetOutputsToSpend())),
+			Shares:                    make([]*tokeninternalpb.RevocationSecretShare, 0, len(outputsToSpend)),
 		}
 	}
 
@@ -109,6 +109,11 @@ func (h *StartTokenTransactionHandler) StartTokenTransaction(ctx context.Context
 			return nil, err
 		}
 	}
+	if req.SparkPaymentIntent != "" {
+		if _, err := ent.PaymentIntentID(req.SparkPaymentIntent); err != nil {
+			return nil, tokens.FormatErrorWithTransactionProto("invalid payment intent", req.PartialTokenTransaction, err)
+		}
+	}
 
 	finalTokenTransaction, keyshareIDStrings, err := h.constructFinalTokenTransaction(ctx, req.PartialTokenTransaction, req.ValidityDurationSeconds)
 	if err != nil {
@@ -131,6 +136,7 @@ func (h *StartTokenTransactionHandler) StartTokenTransaction(ctx context.Context
 			req.PartialTokenTransactionOwnerSignatures,
 			keyshareIDStrings,
 			h.config.IdentityPublicKey(),
+			req.SparkPaymentIntent,
 			// If pre-emption is enabled, we need to call spark_token_internal.PrepareTransaction;
 			// otherwise we call spark_internal.StartTokenTransactionInternal.
 			h.enablePreemption,
@@ -153,6 +159,7 @@ func (h *StartTokenTransactionHandler) StartTokenTransaction(ctx context.Context
 		FinalTokenTransaction:      finalTokenTransaction,
 		TokenTransactionSignatures: req.PartialTokenTransactionOwnerSignatures,
 		CoordinatorPublicKey:       h.config.IdentityPublicKey().Serialize(),
+		SparkPaymentIntent:         req.SparkPaymentIntent,
 	})
 	if err != nil {
 		return nil, tokens.FormatErrorWithTransactionProto(tokens.ErrFailedToExecuteWithCoordinator, req.PartialTokenTransaction, err)
@@ -172,7 +179,7 @@ func (h *StartTokenTransactionHandler) StartTokenTransaction(ctx context.Context
 // callPrepareTokenTransactionInternal handles calling the PrepareTokenTransactionInternal RPC on an operator
 func callPrepareTokenTransactionInternal(ctx context.Context, operator *so.SigningOperator,
 	finalTokenTransaction *tokenpb.TokenTransaction, signaturesWithIndex []*tokenpb.SignatureWithIndex,
-	keyshareIDStrings []string, coordinatorPublicKey keys.Public,
+	keyshareIDStrings []string, coordinatorPublicKey keys.Public, sparkPaymentIntent string,
 	callSparkTokenInternal bool,
 ) error {
 	ctx, span := tracer.Start(ctx, "StartTokenTransactionHandler.callPrepareTokenTransactionInternal", getTokenTransactionAttributes(finalTokenTransaction))
@@ -188,6 +195,7 @@ func callPrepareTokenTransactionInternal(ctx context.Context, operator *so.Signi
 		FinalTokenTransaction:      finalTokenTransaction,
 		TokenTransactionSignatures: signaturesWithIndex,
 		CoordinatorPublicKey:       coordinatorPublicKey.Serialize(),
+		SparkPaymentIntent:         sparkPaymentIntent,
 	}
 	if callSparkTokenInternal {
 		client := tokeninternalpb.NewSparkTokenInternalServiceClient(conn)
@@ -281,8 +289,8 @@ func preemptOrRejectTransactions(
 		return tokens.FormatErrorWithTransactionProto("Cannot determine token transaction type", tokenTransaction, err)
 	}
 
-	if tokenTransactionType == utils.TokenTransactionTypeTransfer {
-		outputsToSpend := tokenTransaction.GetTransferInput().GetOutputsToSpend() // TODO: This is a hack to get the outputs to spend.
+	if tokenTransactionType == utils.TokenTransactionTypeTransfer || tokenTransactionType == utils.TokenTransactionTypeBurn {
+		outputsToSpend := utils.GetOutputsToSpend(tokenTransaction)
 
 		// Fetch input TTXOs and check if any are already being spent
 		inputTtxos, err := ent.FetchAndLockTokenInputs(ctx, outputsToSpend)
@@ -490,6 +498,8 @@ func (h *StartTokenTransactionHandler) constructFinalTokenTransaction(
 				output.WithdrawRelativeBlockLocktime = &withdrawRelativeBlockLocktime
 			}
 		}
+	case utils.TokenTransactionTypeBurn:
+		// Burns create no outputs, so no keyshares are needed.
 
 	default:
 		return nil, nil, tokens.FormatErrorWithTransactionProto("unknown token transaction type", partialTokenTransaction,
@@ -0,0 +1,106 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/ent/tokenallowlistentry"
+	"github.com/lightsparkdev/spark/so/tokens"
+	"github.com/lightsparkdev/spark/so/utils"
+)
+
+type UpdateTokenAllowlistHandler struct {
+	config *so.Config
+}
+
+// NewUpdateTokenAllowlistHandler creates a new UpdateTokenAllowlistHandler.
+func NewUpdateTokenAllowlistHandler(config *so.Config) *UpdateTokenAllowlistHandler {
+	return &UpdateTokenAllowlistHandler{
+		config: config,
+	}
+}
+
+// UpdateTokenAllowlist adds an owner to or removes an owner from the allowlist of a transfer restricted token.
+// Updates for an owner must have increasing issuer provided timestamps so that every operator ends up with the
+// same allowlist regardless of the order in which it receives them. Retrying an applied update succeeds.
+func (h *UpdateTokenAllowlistHandler) UpdateTokenAllowlist(
+	ctx context.Context,
+	req *tokenpb.UpdateTokenAllowlistRequest,
+) (*tokenpb.UpdateTokenAllowlistResponse, error) {
+	payload := req.GetUpdateTokenAllowlistPayload()
+	if err := utils.ValidateUpdateTokenAllowlistPayload(payload, h.config.IdentityPublicKey()); err != nil {
+		return nil, fmt.Errorf("update token allowlist payload validation failed: %w", err)
+	}
+
+	payloadHash, err := utils.HashUpdateTokenAllowlistPayload(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash update token allowlist payload: %w", err)
+	}
+
+	tokenCreateEnt, err := queryTokenCreateByIdentifier(ctx, payload.GetTokenIdentifier())
+	if err != nil {
+		return nil, err
+	}
+	if !tokenCreateEnt.IsTransferRestricted {
+		return nil, fmt.Errorf("%s: %x", tokens.ErrTokenNotTransferRestricted, payload.GetTokenIdentifier())
+	}
+
+	if _, err := validateCurrentIssuerSignature(ctx, tokenCreateEnt, payloadHash, req.GetIssuerSignature()); err != nil {
+		return nil, err
+	}
+
+	status := st.TokenAllowlistStatusAllowed
+	if payload.GetShouldRemove() {
+		status = st.TokenAllowlistStatusRemoved
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database: %w", err)
+	}
+	entry, err := tokenCreateEnt.QueryAllowlistEntries().
+		Where(tokenallowlistentry.OwnerPublicKeyEQ(payload.GetOwnerPublicKey())).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		_, err = db.TokenAllowlistEntry.Create().
+			SetTokenCreateID(tokenCreateEnt.ID).
+			SetOwnerPublicKey(payload.GetOwnerPublicKey()).
+			SetStatus(status).
+			SetIssuerSignature(req.GetIssuerSignature()).
+			SetIssuerProvidedTimestamp(payload.GetIssuerProvidedTimestamp()).
+			Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create token allowlist entry: %w", err)
+		}
+		return &tokenpb.UpdateTokenAllowlistResponse{IsAllowed: !payload.GetShouldRemove()}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query token allowlist: %w", err)
+	}
+
+	if bytes.Equal(entry.IssuerSignature, req.GetIssuerSignature()) {
+		return &tokenpb.UpdateTokenAllowlistResponse{IsAllowed: entry.Status == st.TokenAllowlistStatusAllowed}, nil
+	}
+	// The update only applies if no later update for the owner was applied in the meantime.
+	updated, err := db.TokenAllowlistEntry.Update().
+		Where(
+			tokenallowlistentry.ID(entry.ID),
+			tokenallowlistentry.IssuerProvidedTimestampLT(payload.GetIssuerProvidedTimestamp()),
+		).
+		SetStatus(status).
+		SetIssuerSignature(req.GetIssuerSignature()).
+		SetIssuerProvidedTimestamp(payload.GetIssuerProvidedTimestamp()).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update token allowlist entry: %w", err)
+	}
+	if updated == 0 {
+		return nil, fmt.Errorf("issuer provided timestamp %d must be later than the last allowlist update", payload.GetIssuerProvidedTimestamp())
+	}
+	return &tokenpb.UpdateTokenAllowlistResponse{IsAllowed: !payload.GetShouldRemove()}, nil
+}
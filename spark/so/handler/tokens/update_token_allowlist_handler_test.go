@@ -0,0 +1,103 @@
+package tokens
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/utils"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+)
+
+func TestUpdateTokenAllowlist(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{2})
+	issuerKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	otherKey := keys.MustGeneratePrivateKeyFromRand(rng)
+	alice := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	bob := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+
+	createToken := func(isTransferRestricted bool) []byte {
+		tokenCreate, err := sparktesting.NewTestTokenCreate(t, tx, issuerKey.Public().Serialize()).
+			SetIsTransferRestricted(isTransferRestricted).
+			Save(ctx)
+		require.NoError(t, err)
+		return tokenCreate.TokenIdentifier
+	}
+	restrictedToken := createToken(true)
+	unrestrictedToken := createToken(false)
+
+	updateRequest := func(signer keys.Private, tokenIdentifier []byte, owner []byte, timestamp uint64, shouldRemove bool) *tokenpb.UpdateTokenAllowlistRequest {
+		payload := &tokenpb.UpdateTokenAllowlistPayload{
+			TokenIdentifier:           tokenIdentifier,
+			OwnerPublicKey:            owner,
+			IssuerProvidedTimestamp:   timestamp,
+			OperatorIdentityPublicKey: config.IdentityPublicKey().Serialize(),
+			ShouldRemove:              shouldRemove,
+		}
+		payloadHash, err := utils.HashUpdateTokenAllowlistPayload(payload)
+		require.NoError(t, err)
+		return &tokenpb.UpdateTokenAllowlistRequest{
+			UpdateTokenAllowlistPayload: payload,
+			IssuerSignature:             ecdsa.Sign(signer.ToBTCEC(), payloadHash).Serialize(),
+		}
+	}
+	transferTo := func(tokenIdentifier []byte, owners ...[]byte) *tokenpb.TokenTransaction {
+		transaction := &tokenpb.TokenTransaction{}
+		for _, owner := range owners {
+			transaction.TokenOutputs = append(transaction.TokenOutputs, &tokenpb.TokenOutput{
+				OwnerPublicKey:  owner,
+				TokenIdentifier: tokenIdentifier,
+			})
+		}
+		return transaction
+	}
+	handler := NewUpdateTokenAllowlistHandler(config)
+
+	require.NoError(t, validateOutputOwnersAllowed(ctx, transferTo(unrestrictedToken, alice, bob)))
+	require.NoError(t, validateOutputOwnersAllowed(ctx, transferTo(restrictedToken, issuerKey.Public().Serialize())))
+	require.ErrorContains(t, validateOutputOwnersAllowed(ctx, transferTo(restrictedToken, alice)), "not on the allowlist")
+
+	_, err = handler.UpdateTokenAllowlist(ctx, updateRequest(issuerKey, unrestrictedToken, alice, 100, false))
+	require.ErrorContains(t, err, "not transfer restricted")
+	_, err = handler.UpdateTokenAllowlist(ctx, updateRequest(otherKey, restrictedToken, alice, 100, false))
+	require.ErrorContains(t, err, "invalid issuer signature")
+
+	addAlice := updateRequest(issuerKey, restrictedToken, alice, 100, false)
+	resp, err := handler.UpdateTokenAllowlist(ctx, addAlice)
+	require.NoError(t, err)
+	assert.True(t, resp.IsAllowed)
+	require.NoError(t, validateOutputOwnersAllowed(ctx, transferTo(restrictedToken, alice)))
+	require.ErrorContains(t, validateOutputOwnersAllowed(ctx, transferTo(restrictedToken, alice, bob)), "not on the allowlist")
+
+	removeAlice := updateRequest(issuerKey, restrictedToken, alice, 200, true)
+	resp, err = handler.UpdateTokenAllowlist(ctx, removeAlice)
+	require.NoError(t, err)
+	assert.False(t, resp.IsAllowed)
+	require.ErrorContains(t, validateOutputOwnersAllowed(ctx, transferTo(restrictedToken, alice)), "not on the allowlist")
+
+	// Retries of the latest update succeed, but an older update arriving late does not overwrite it.
+	resp, err = handler.UpdateTokenAllowlist(ctx, removeAlice)
+	require.NoError(t, err)
+	assert.False(t, resp.IsAllowed)
+	_, err = handler.UpdateTokenAllowlist(ctx, addAlice)
+	require.ErrorContains(t, err, "must be later than the last allowlist update")
+
+	_, err = handler.UpdateTokenAllowlist(ctx, updateRequest(issuerKey, restrictedToken, alice, 300, false))
+	require.NoError(t, err)
+	require.NoError(t, validateOutputOwnersAllowed(ctx, transferTo(restrictedToken, alice)))
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -25,6 +26,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/blockheight"
 	"github.com/lightsparkdev/spark/so/ent/cooperativeexit"
+	"github.com/lightsparkdev/spark/so/ent/paymentintent"
 	"github.com/lightsparkdev/spark/so/ent/predicate"
 	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
@@ -55,6 +57,14 @@ type TransferHandler struct {
 
 var transferTypeKey = attribute.Key("transfer_type")
 
+const (
+	// MaximumHtlcExpiryTime is the longest an HTLC transfer can wait for the receiver to reveal the preimage.
+	MaximumHtlcExpiryTime = 7 * 24 * time.Hour
+	// HtlcReturnDelay is how long after its expiry an unclaimed HTLC transfer is returned to the sender, so that
+	// a preimage provided just before the expiry can reach every operator first.
+	HtlcReturnDelay = 5 * time.Minute
+)
+
 // NewTransferHandler creates a new TransferHandler.
 func NewTransferHandler(config *so.Config) *TransferHandler {
 	return &TransferHandler{BaseTransferHandler: NewBaseTransferHandler(config), config: config}
@@ -124,6 +134,65 @@ func (h *TransferHandler) startTransferInternal(ctx context.Context, req *pb.Sta
 		return nil, err
 	}
 
+	prepared, err := h.prepareTransfer(ctx, req, transferType, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey, requireDirectTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// This call to other SOs will check the validity of the transfer package. If no error is
+	// returned, it means the transfer package is valid and the transfer is considered sent.
+	err = h.syncTransferInit(ctx, req, transferType, prepared.finalCpfpSignatureMap, prepared.finalDirectSignatureMap, prepared.finalDirectFromCpfpSignatureMap)
+	if err != nil {
+		cancelErr := h.CreateCancelTransferGossipMessage(ctx, req.TransferId)
+		if cancelErr != nil {
+			logger.Error("failed to create cancel transfer gossip message", "error", cancelErr, "transfer_id", req.TransferId)
+		}
+		logger.Error("failed to sync transfer init for transfer", "transfer_id", req.TransferId, "error", err)
+		return nil, fmt.Errorf("failed to sync transfer init for transfer %s: %w", req.TransferId, err)
+	}
+
+	// After this point, the transfer send is considered successful.
+	return h.settleStartedTransfer(ctx, req, prepared)
+}
+
+// preparedTransfer is a transfer that this operator has created and signed the refunds of, but that has not been
+// synced to the other operators yet.
+type preparedTransfer struct {
+	transfer                        *ent.Transfer
+	leafMap                         map[string]*ent.TreeNode
+	leafTweakMap                    map[string]*pb.SendLeafKeyTweak
+	signingResults                  []*pb.LeafRefundTxSigningResult
+	finalCpfpSignatureMap           map[string][]byte
+	finalDirectSignatureMap         map[string][]byte
+	finalDirectFromCpfpSignatureMap map[string][]byte
+}
+
+func (h *TransferHandler) prepareTransfer(ctx context.Context, req *pb.StartTransferRequest, transferType st.TransferType, cpfpAdaptorPubKey keys.Public, directAdaptorPubKey keys.Public, directFromCpfpAdaptorPubKey keys.Public, requireDirectTx bool) (*preparedTransfer, error) {
+	prepared, err := h.createPreparedTransfer(ctx, req, transferType, requireDirectTx)
+	if err != nil {
+		return nil, err
+	}
+	if req.TransferPackage == nil {
+		prepared.signingResults, err = signRefunds(ctx, h.config, req, prepared.leafMap, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign refunds for transfer %s: %w", req.TransferId, err)
+		}
+		return prepared, nil
+	}
+
+	cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap, err := signRefundsWithPregeneratedNonce(ctx, h.config, req, prepared.leafMap, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign refunds with pregenerated nonce: %w", err)
+	}
+	err = h.applyRefundSigningResults(ctx, req, prepared, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey, cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap)
+	if err != nil {
+		return nil, err
+	}
+	return prepared, nil
+}
+
+// createPreparedTransfer validates the transfer package and creates the transfer, without signing its refunds.
+func (h *TransferHandler) createPreparedTransfer(ctx context.Context, req *pb.StartTransferRequest, transferType st.TransferType, requireDirectTx bool) (*preparedTransfer, error) {
 	leafTweakMap, err := h.validateTransferPackage(ctx, req.TransferId, req.TransferPackage, req.OwnerIdentityPublicKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate transfer package for transfer %s: %w", req.TransferId, err)
@@ -158,127 +227,194 @@ func (h *TransferHandler) startTransferInternal(ctx context.Context, req *pb.Sta
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transfer for transfer %s: %w", req.TransferId, err)
 	}
-
-	var signingResults []*pb.LeafRefundTxSigningResult
-	var finalCpfpSignatureMap map[string][]byte
-	var finalDirectSignatureMap map[string][]byte
-	var finalDirectFromCpfpSignatureMap map[string][]byte
-	if req.TransferPackage == nil {
-		signingResults, err = signRefunds(ctx, h.config, req, leafMap, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey)
-		if err != nil {
-			return nil, fmt.Errorf("failed to sign refunds for transfer %s: %w", req.TransferId, err)
+	if req.SparkPaymentIntent != "" {
+		if err := attachSatsSparkInvoiceToTransfer(ctx, transfer, leafMap, req.SparkPaymentIntent); err != nil {
+			return nil, fmt.Errorf("failed to validate spark invoice for transfer %s: %w", req.TransferId, err)
 		}
-	} else {
-		cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap, err := signRefundsWithPregeneratedNonce(ctx, h.config, req, leafMap, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey)
-		if err != nil {
-			return nil, fmt.Errorf("failed to sign refunds with pregenerated nonce: %w", err)
-		}
-		finalCpfpSignatureMap, finalDirectSignatureMap, finalDirectFromCpfpSignatureMap, err = aggregateSignatures(ctx, h.config, req, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey, cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap, leafMap)
+	}
+
+	return &preparedTransfer{
+		transfer:     transfer,
+		leafMap:      leafMap,
+		leafTweakMap: leafTweakMap,
+	}, nil
+}
+
+// applyRefundSigningResults aggregates the operators' signature shares for the refunds of a prepared transfer with
+// the user's, and records the signed refunds on the transfer leaves.
+func (h *TransferHandler) applyRefundSigningResults(
+	ctx context.Context,
+	req *pb.StartTransferRequest,
+	prepared *preparedTransfer,
+	cpfpAdaptorPubKey keys.Public,
+	directAdaptorPubKey keys.Public,
+	directFromCpfpAdaptorPubKey keys.Public,
+	cpfpSigningResultMap map[string]*helper.SigningResult,
+	directSigningResultMap map[string]*helper.SigningResult,
+	directFromCpfpSigningResultMap map[string]*helper.SigningResult,
+) error {
+	leafMap := prepared.leafMap
+	finalCpfpSignatureMap, finalDirectSignatureMap, finalDirectFromCpfpSignatureMap, err := aggregateSignatures(ctx, h.config, req, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey, cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap, leafMap)
+	if err != nil {
+		return fmt.Errorf("failed to aggregate signatures: %w", err)
+	}
+
+	// Update the leaves with the final signatures for refunds
+	err = h.updateCpfpTransferLeavesSignatures(ctx, prepared.transfer, finalCpfpSignatureMap)
+	if err != nil {
+		return fmt.Errorf("failed to update CPFP transfer leaves signatures: %w", err)
+	}
+
+	if len(finalDirectSignatureMap) > 0 && len(finalDirectFromCpfpSignatureMap) > 0 {
+		err = h.updateDirectTransferLeavesSignatures(ctx, prepared.transfer, finalDirectSignatureMap)
 		if err != nil {
-			return nil, fmt.Errorf("failed to aggregate signatures: %w", err)
+			return fmt.Errorf("failed to update direct transfer leaves signatures: %w", err)
 		}
-
-		// Update the leaves with the final signatures for refunds
-		err = h.updateCpfpTransferLeavesSignatures(ctx, transfer, finalCpfpSignatureMap)
+		err = h.updateDirectFromCpfpTransferLeavesSignatures(ctx, prepared.transfer, finalDirectFromCpfpSignatureMap)
 		if err != nil {
-			return nil, fmt.Errorf("failed to update CPFP transfer leaves signatures: %w", err)
+			return fmt.Errorf("failed to update direct from cpfp transfer leaves signatures: %w", err)
 		}
-
-		if len(finalDirectSignatureMap) > 0 && len(finalDirectFromCpfpSignatureMap) > 0 {
-			err = h.updateDirectTransferLeavesSignatures(ctx, transfer, finalDirectSignatureMap)
-			if err != nil {
-				return nil, fmt.Errorf("failed to update direct transfer leaves signatures: %w", err)
-			}
-			err = h.updateDirectFromCpfpTransferLeavesSignatures(ctx, transfer, finalDirectFromCpfpSignatureMap)
+	}
+	// Build the proto signing results including both CPFP and direct refund signatures.
+	for leafID := range leafMap {
+		var cpfpProto *pb.SigningResult
+		var directProto *pb.SigningResult
+		var directFromCpfpProto *pb.SigningResult
+		if res, ok := cpfpSigningResultMap[leafID]; ok {
+			cpfRes, err := res.MarshalProto()
 			if err != nil {
-				return nil, fmt.Errorf("failed to update direct from cpfp transfer leaves signatures: %w", err)
+				return fmt.Errorf("unable to marshal cpfp signing result: %w", err)
 			}
-		}
-		// Build the proto signing results including both CPFP and direct refund signatures.
-		for leafID := range leafMap {
-			var cpfpProto *pb.SigningResult
-			var directProto *pb.SigningResult
-			var directFromCpfpProto *pb.SigningResult
-			if res, ok := cpfpSigningResultMap[leafID]; ok {
-				cpfRes, err := res.MarshalProto()
+			cpfpProto = cpfRes
+			if res, ok := directSigningResultMap[leafID]; ok && len(directSigningResultMap) > 0 {
+				dirRes, err := res.MarshalProto()
 				if err != nil {
-					return nil, fmt.Errorf("unable to marshal cpfp signing result: %w", err)
-				}
-				cpfpProto = cpfRes
-				if res, ok := directSigningResultMap[leafID]; ok && len(directSigningResultMap) > 0 {
-					dirRes, err := res.MarshalProto()
-					if err != nil {
-						return nil, fmt.Errorf("unable to marshal direct signing result: %w", err)
-					}
-					directProto = dirRes
+					return fmt.Errorf("unable to marshal direct signing result: %w", err)
 				}
-				if res, ok := directFromCpfpSigningResultMap[leafID]; ok && len(directFromCpfpSigningResultMap) > 0 {
-					dirFromCpfpRes, err := res.MarshalProto()
-					if err != nil {
-						return nil, fmt.Errorf("unable to marshal direct from cpfp signing result: %w", err)
-					}
-					directFromCpfpProto = dirFromCpfpRes
+				directProto = dirRes
+			}
+			if res, ok := directFromCpfpSigningResultMap[leafID]; ok && len(directFromCpfpSigningResultMap) > 0 {
+				dirFromCpfpRes, err := res.MarshalProto()
+				if err != nil {
+					return fmt.Errorf("unable to marshal direct from cpfp signing result: %w", err)
 				}
+				directFromCpfpProto = dirFromCpfpRes
 			}
-
-			signingResults = append(signingResults, &pb.LeafRefundTxSigningResult{
-				LeafId:                              leafID,
-				RefundTxSigningResult:               cpfpProto,
-				DirectRefundTxSigningResult:         directProto,
-				DirectFromCpfpRefundTxSigningResult: directFromCpfpProto,
-				VerifyingKey:                        leafMap[leafID].VerifyingPubkey,
-			})
 		}
-	}
 
-	// This call to other SOs will check the validity of the transfer package. If no error is
-	// returned, it means the transfer package is valid and the transfer is considered sent.
-	err = h.syncTransferInit(ctx, req, transferType, finalCpfpSignatureMap, finalDirectSignatureMap, finalDirectFromCpfpSignatureMap)
-	if err != nil {
-		cancelErr := h.CreateCancelTransferGossipMessage(ctx, req.TransferId)
-		if cancelErr != nil {
-			logger.Error("failed to create cancel transfer gossip message", "error", cancelErr, "transfer_id", req.TransferId)
-		}
-		logger.Error("failed to sync transfer init for transfer", "transfer_id", req.TransferId, "error", err)
-		return nil, fmt.Errorf("failed to sync transfer init for transfer %s: %w", req.TransferId, err)
+		prepared.signingResults = append(prepared.signingResults, &pb.LeafRefundTxSigningResult{
+			LeafId:                              leafID,
+			RefundTxSigningResult:               cpfpProto,
+			DirectRefundTxSigningResult:         directProto,
+			DirectFromCpfpRefundTxSigningResult: directFromCpfpProto,
+			VerifyingKey:                        leafMap[leafID].VerifyingPubkey,
+		})
 	}
 
-	// After this point, the transfer send is considered successful.
+	prepared.finalCpfpSignatureMap = finalCpfpSignatureMap
+	prepared.finalDirectSignatureMap = finalDirectSignatureMap
+	prepared.finalDirectFromCpfpSignatureMap = finalDirectFromCpfpSignatureMap
+	return nil
+}
 
-	if req.TransferPackage != nil {
-		// If all other SOs have settled the sender key tweaks, we can commit the sender key tweaks.
-		// If there's any error, it means one or more of the SOs are down at the time, we will have a
-		// cron job to retry the key commit.
-		keyTweakProofMap := make(map[string]*pb.SecretProof)
-		for _, leaf := range leafTweakMap {
-			keyTweakProofMap[leaf.LeafId] = &pb.SecretProof{
-				Proofs: leaf.SecretShareTweak.Proofs,
-			}
-		}
+// settleStartedTransfer settles the sender key tweaks of a transfer once all operators have initiated it.
+func (h *TransferHandler) settleStartedTransfer(ctx context.Context, req *pb.StartTransferRequest, prepared *preparedTransfer) (*pb.StartTransferResponse, error) {
+	logger := logging.GetLoggerFromContext(ctx)
 
-		sendGossipHandler := NewSendGossipHandler(h.config)
-		selection := helper.OperatorSelection{
-			Option: helper.OperatorSelectionOptionExcludeSelf,
-		}
-		participants, err := selection.OperatorIdentifierList(h.config)
-		if err != nil {
-			return nil, fmt.Errorf("unable to get operator list: %w", err)
-		}
-		_, err = sendGossipHandler.CreateAndSendGossipMessage(ctx, &pbgossip.GossipMessage{
-			Message: &pbgossip.GossipMessage_SettleSenderKeyTweak{
-				SettleSenderKeyTweak: &pbgossip.GossipMessageSettleSenderKeyTweak{
-					TransferId:           req.TransferId,
-					SenderKeyTweakProofs: keyTweakProofMap,
-				},
-			},
-		}, participants)
+	// If all other SOs have settled the sender key tweaks, we can commit the sender key tweaks.
+	// If there's any error, it means one or more of the SOs are down at the time, we will have a
+	// cron job to retry the key commit.
+	gossip, err := h.createSettleSenderKeyTweakGossip(ctx, req, prepared)
+	if err != nil {
+		return nil, err
+	}
+	if gossip != nil {
+		_, err = NewSendGossipHandler(h.config).SendGossipMessage(ctx, gossip)
 		if err != nil {
 			logger.Error("failed to create and send gossip message to settle sender key tweak", "error", err, "transfer_id", req.TransferId)
 			return nil, fmt.Errorf("failed to create and send gossip message to settle sender key tweak: %w", err)
 		}
-		transfer, err = h.loadTransferForUpdate(ctx, req.TransferId)
+	}
+	return h.startedTransferResponse(ctx, req, prepared), nil
+}
+
+// createSettleSenderKeyTweakGossip records the gossip message that settles the sender key tweaks of a started
+// transfer on the other operators. It returns nil if the transfer has no transfer package.
+func (h *TransferHandler) createSettleSenderKeyTweakGossip(ctx context.Context, req *pb.StartTransferRequest, prepared *preparedTransfer) (*ent.Gossip, error) {
+	if req.TransferPackage == nil {
+		return nil, nil
+	}
+	selection := helper.OperatorSelection{
+		Option: helper.OperatorSelectionOptionExcludeSelf,
+	}
+	participants, err := selection.OperatorIdentifierList(h.config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get operator list: %w", err)
+	}
+	gossip, err := NewSendGossipHandler(h.config).CreateGossipMessage(ctx, &pbgossip.GossipMessage{
+		Message: &pbgossip.GossipMessage_SettleSenderKeyTweak{
+			SettleSenderKeyTweak: settleSenderKeyTweakMessage(req, prepared),
+		},
+	}, participants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gossip message to settle sender key tweak for transfer %s: %w", req.TransferId, err)
+	}
+	return gossip, nil
+}
+
+// createSettleBatchSenderKeyTweakGossip records the gossip message that settles the sender key tweaks of every
+// transfer in a started batch on the other operators.
+func (h *TransferHandler) createSettleBatchSenderKeyTweakGossip(ctx context.Context, req *pb.StartBatchTransferRequest, preparedTransfers []*preparedTransfer) (*ent.Gossip, error) {
+	transfers := make([]*pbgossip.GossipMessageSettleSenderKeyTweak, 0, len(preparedTransfers))
+	for i, prepared := range preparedTransfers {
+		transfers = append(transfers, settleSenderKeyTweakMessage(req.Transfers[i], prepared))
+	}
+	selection := helper.OperatorSelection{
+		Option: helper.OperatorSelectionOptionExcludeSelf,
+	}
+	participants, err := selection.OperatorIdentifierList(h.config)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get operator list: %w", err)
+	}
+	gossip, err := NewSendGossipHandler(h.config).CreateGossipMessage(ctx, &pbgossip.GossipMessage{
+		Message: &pbgossip.GossipMessage_SettleBatchSenderKeyTweak{
+			SettleBatchSenderKeyTweak: &pbgossip.GossipMessageSettleBatchSenderKeyTweak{
+				BatchId:   req.BatchId,
+				Transfers: transfers,
+			},
+		},
+	}, participants)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gossip message to settle sender key tweaks for batch %s: %w", req.BatchId, err)
+	}
+	return gossip, nil
+}
+
+// settleSenderKeyTweakMessage returns the message that settles the sender key tweaks of a started transfer.
+func settleSenderKeyTweakMessage(req *pb.StartTransferRequest, prepared *preparedTransfer) *pbgossip.GossipMessageSettleSenderKeyTweak {
+	keyTweakProofMap := make(map[string]*pb.SecretProof)
+	for _, leaf := range prepared.leafTweakMap {
+		keyTweakProofMap[leaf.LeafId] = &pb.SecretProof{
+			Proofs: leaf.SecretShareTweak.Proofs,
+		}
+	}
+	return &pbgossip.GossipMessageSettleSenderKeyTweak{
+		TransferId:           req.TransferId,
+		SenderKeyTweakProofs: keyTweakProofMap,
+	}
+}
+
+// startedTransferResponse returns the started transfer as it is after its sender key tweaks were settled. The
+// transfer is sent at this point, so failing to load or marshal it only degrades the response.
+func (h *TransferHandler) startedTransferResponse(ctx context.Context, req *pb.StartTransferRequest, prepared *preparedTransfer) *pb.StartTransferResponse {
+	logger := logging.GetLoggerFromContext(ctx)
+	transfer := prepared.transfer
+	if req.TransferPackage != nil {
+		settledTransfer, err := h.loadTransferForUpdate(ctx, req.TransferId)
 		if err != nil {
-			return nil, fmt.Errorf("unable to load transfer: %w", err)
+			logger.Error("unable to load transfer", "error", err, "transfer_id", req.TransferId)
+		} else {
+			transfer = settledTransfer
 		}
 	}
 
@@ -287,7 +423,7 @@ func (h *TransferHandler) startTransferInternal(ctx context.Context, req *pb.Sta
 		logger.Error("unable to marshal transfer", "error", err, "transfer_id", transfer.ID)
 	}
 
-	return &pb.StartTransferResponse{Transfer: transferProto, SigningResults: signingResults}, nil
+	return &pb.StartTransferResponse{Transfer: transferProto, SigningResults: prepared.signingResults}
 }
 
 func (h *TransferHandler) updateCpfpTransferLeavesSignatures(ctx context.Context, transfer *ent.Transfer, finalSignatureMap map[string][]byte) error {
@@ -446,6 +582,198 @@ func (h *TransferHandler) StartLeafSwapV2(ctx context.Context, req *pb.StartTran
 	return h.startTransferInternal(ctx, req, st.TransferTypeSwap, keys.Public{}, keys.Public{}, keys.Public{}, true)
 }
 
+// StartBatchTransfer sends leaves to several receivers at once. Each transfer in the batch has its own receiver, who
+// claims it like any other transfer. Every operator creates the whole batch in a single database transaction, and if
+// any operator rejects it, all transfers in the batch are cancelled through the cancel transfer gossip.
+func (h *TransferHandler) StartBatchTransfer(ctx context.Context, req *pb.StartBatchTransferRequest) (*pb.StartBatchTransferResponse, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "TransferHandler.StartBatchTransfer")
+	defer span.End()
+
+	batchID, err := uuid.Parse(req.BatchId)
+	if err != nil {
+		return nil, errors.InvalidUserInputErrorf("unable to parse batch_id as a uuid %s: %w", req.BatchId, err)
+	}
+	if len(req.Transfers) == 0 || len(req.Transfers) > MaxTransfersPerBatch {
+		return nil, errors.InvalidUserInputErrorf("batch %s must contain between 1 and %d transfers, got %d", req.BatchId, MaxTransfersPerBatch, len(req.Transfers))
+	}
+	if err := validateBatchIDUnused(ctx, batchID); err != nil {
+		return nil, err
+	}
+	ownerIdentityPublicKey := req.Transfers[0].OwnerIdentityPublicKey
+	if err := authz.EnforceSessionIdentityPublicKeyMatches(ctx, h.config, ownerIdentityPublicKey); err != nil {
+		return nil, err
+	}
+	transferIDs := make(map[string]bool, len(req.Transfers))
+	for _, transferReq := range req.Transfers {
+		if !bytes.Equal(transferReq.OwnerIdentityPublicKey, ownerIdentityPublicKey) {
+			return nil, errors.InvalidUserInputErrorf("all transfers in batch %s must have the same owner", req.BatchId)
+		}
+		if transferReq.TransferPackage == nil {
+			return nil, errors.InvalidUserInputErrorf("transfer %s in batch %s must have a transfer package", transferReq.TransferId, req.BatchId)
+		}
+		if transferIDs[transferReq.TransferId] {
+			return nil, errors.InvalidUserInputErrorf("transfer %s appears more than once in batch %s", transferReq.TransferId, req.BatchId)
+		}
+		transferIDs[transferReq.TransferId] = true
+	}
+
+	preparedTransfers := make([]*preparedTransfer, 0, len(req.Transfers))
+	batchRefundJobs := make([]*refundSigningJobs, 0, len(req.Transfers))
+	var signingJobs []*helper.SigningJobWithPregeneratedNonce
+	for _, transferReq := range req.Transfers {
+		prepared, err := h.createPreparedTransfer(ctx, transferReq, st.TransferTypeTransfer, false)
+		if err != nil {
+			return nil, err
+		}
+		prepared.transfer, err = prepared.transfer.Update().SetBatchID(batchID).Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to set batch for transfer %s: %w", transferReq.TransferId, err)
+		}
+		refundJobs, err := newRefundSigningJobs(ctx, transferReq, prepared.leafMap, keys.Public{}, keys.Public{}, keys.Public{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create refund signing jobs for transfer %s: %w", transferReq.TransferId, err)
+		}
+		preparedTransfers = append(preparedTransfers, prepared)
+		batchRefundJobs = append(batchRefundJobs, refundJobs)
+		signingJobs = append(signingJobs, refundJobs.jobs...)
+	}
+
+	// The refunds of every transfer in the batch are signed in a single round with the other operators.
+	signingResults, err := helper.SignFrostWithPregeneratedNonce(ctx, h.config, signingJobs)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign refunds for batch %s: %w", req.BatchId, err)
+	}
+	initTransferRequests := make([]*pbinternal.InitiateTransferRequest, 0, len(req.Transfers))
+	for i, transferReq := range req.Transfers {
+		prepared := preparedTransfers[i]
+		cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap := batchRefundJobs[i].splitResults(signingResults)
+		err = h.applyRefundSigningResults(ctx, transferReq, prepared, keys.Public{}, keys.Public{}, keys.Public{}, cpfpSigningResultMap, directSigningResultMap, directFromCpfpSigningResultMap)
+		if err != nil {
+			return nil, err
+		}
+		initTransferRequest, err := initiateTransferRequest(transferReq, st.TransferTypeTransfer, prepared.finalCpfpSignatureMap, prepared.finalDirectSignatureMap, prepared.finalDirectFromCpfpSignatureMap)
+		if err != nil {
+			return nil, err
+		}
+		initTransferRequest.BatchId = req.BatchId
+		initTransferRequests = append(initTransferRequests, initTransferRequest)
+	}
+
+	// An operator that rejects the batch creates none of its transfers, but the operators that accepted it have to
+	// cancel all of them.
+	err = h.syncBatchTransferInit(ctx, initTransferRequests)
+	if err != nil {
+		for _, transferReq := range req.Transfers {
+			cancelErr := h.CreateCancelTransferGossipMessage(ctx, transferReq.TransferId)
+			if cancelErr != nil {
+				logger.Error("failed to create cancel transfer gossip message", "error", cancelErr, "transfer_id", transferReq.TransferId, "batch_id", req.BatchId)
+			}
+		}
+		logger.Error("failed to sync transfer init for batch", "batch_id", req.BatchId, "error", err)
+		return nil, fmt.Errorf("failed to sync transfer init for batch %s: %w", req.BatchId, err)
+	}
+
+	// After this point, every transfer in the batch is considered sent. The sender key tweaks of the whole batch are
+	// settled on the other operators by a single gossip message, so that no transfer is settled unless all of them
+	// will be. Failures to send are retried by the gossip task and do not fail the batch.
+	gossip, err := h.createSettleBatchSenderKeyTweakGossip(ctx, req, preparedTransfers)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := NewSendGossipHandler(h.config).SendGossipMessage(ctx, gossip); err != nil {
+		logger.Error("failed to send gossip message to settle batch sender key tweaks", "error", err, "batch_id", req.BatchId)
+	}
+
+	responses := make([]*pb.StartTransferResponse, 0, len(preparedTransfers))
+	for i, prepared := range preparedTransfers {
+		responses = append(responses, h.startedTransferResponse(ctx, req.Transfers[i], prepared))
+	}
+	return &pb.StartBatchTransferResponse{Transfers: responses}, nil
+}
+
+// StartHtlcTransfer starts a transfer that the receiver can only claim by revealing the preimage of the payment hash
+// before the transfer expires. The sender key tweaks are delivered to all operators with the transfer, but they are
+// only settled once the receiver provides the preimage. If the transfer expires first, it is returned to the sender.
+func (h *TransferHandler) StartHtlcTransfer(ctx context.Context, req *pb.StartHtlcTransferRequest) (*pb.StartTransferResponse, error) {
+	logger := logging.GetLoggerFromContext(ctx)
+
+	ctx, span := tracer.Start(ctx, "TransferHandler.StartHtlcTransfer")
+	defer span.End()
+
+	transferReq := req.Transfer
+	if transferReq == nil {
+		return nil, errors.InvalidUserInputErrorf("transfer is required")
+	}
+	if err := authz.EnforceSessionIdentityPublicKeyMatches(ctx, h.config, transferReq.OwnerIdentityPublicKey); err != nil {
+		return nil, err
+	}
+	if len(req.PaymentHash) != sha256.Size {
+		return nil, errors.InvalidUserInputErrorf("payment hash must be %d bytes, got %d", sha256.Size, len(req.PaymentHash))
+	}
+	if transferReq.TransferPackage == nil {
+		return nil, errors.InvalidUserInputErrorf("htlc transfer %s must have a transfer package", transferReq.TransferId)
+	}
+	expiryTime := transferReq.ExpiryTime.AsTime()
+	if expiryTime.Unix() == 0 || expiryTime.Before(time.Now()) || expiryTime.After(time.Now().Add(MaximumHtlcExpiryTime)) {
+		return nil, errors.InvalidUserInputErrorf("htlc transfer %s must expire within %s", transferReq.TransferId, MaximumHtlcExpiryTime)
+	}
+
+	prepared, err := h.prepareTransfer(ctx, transferReq, st.TransferTypeHtlc, keys.Public{}, keys.Public{}, keys.Public{}, false)
+	if err != nil {
+		return nil, err
+	}
+	// The sender key tweaks stay pending until the receiver provides the preimage.
+	prepared.transfer, err = prepared.transfer.Update().SetStatus(st.TransferStatusSenderKeyTweakPending).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update status of transfer %s: %w", transferReq.TransferId, err)
+	}
+	if err := createHtlcPreimageRequest(ctx, prepared.transfer, req.PaymentHash); err != nil {
+		return nil, err
+	}
+
+	initTransferRequest, err := initiateTransferRequest(transferReq, st.TransferTypeHtlc, prepared.finalCpfpSignatureMap, prepared.finalDirectSignatureMap, prepared.finalDirectFromCpfpSignatureMap)
+	if err != nil {
+		return nil, err
+	}
+	initTransferRequest.PaymentHash = req.PaymentHash
+	err = h.sendInitiateTransfer(ctx, initTransferRequest)
+	if err != nil {
+		cancelErr := h.CreateCancelTransferGossipMessage(ctx, transferReq.TransferId)
+		if cancelErr != nil {
+			logger.Error("failed to create cancel transfer gossip message", "error", cancelErr, "transfer_id", transferReq.TransferId)
+		}
+		logger.Error("failed to sync transfer init for htlc transfer", "transfer_id", transferReq.TransferId, "error", err)
+		return nil, fmt.Errorf("failed to sync transfer init for transfer %s: %w", transferReq.TransferId, err)
+	}
+
+	transferProto, err := prepared.transfer.MarshalProto(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal transfer %s: %w", transferReq.TransferId, err)
+	}
+	return &pb.StartTransferResponse{Transfer: transferProto, SigningResults: prepared.signingResults}, nil
+}
+
+// createHtlcPreimageRequest locks an HTLC transfer to the preimage of the payment hash. The receiver provides the
+// preimage by transfer id, so the lock never collides with other transfers or lightning payments to the same hash.
+func createHtlcPreimageRequest(ctx context.Context, transfer *ent.Transfer, paymentHash []byte) error {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	_, err = db.PreimageRequest.Create().
+		SetPaymentHash(paymentHash).
+		SetReceiverIdentityPubkey(transfer.ReceiverIdentityPubkey).
+		SetStatus(st.PreimageRequestStatusWaitingForPreimage).
+		SetTransfers(transfer).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to create preimage request for transfer %s: %w", transfer.ID.String(), err)
+	}
+	return nil
+}
+
 // CounterLeafSwap initiates a leaf swap for the other side, signing refunds with an adaptor public key.
 func (h *TransferHandler) CounterLeafSwap(ctx context.Context, req *pb.CounterLeafSwapRequest) (*pb.CounterLeafSwapResponse, error) {
 	adaptorPublicKey, err := keys.ParsePublicKey(req.AdaptorPublicKey)
@@ -501,6 +829,50 @@ func (h *TransferHandler) syncTransferInit(ctx context.Context, req *pb.StartTra
 		transferTypeKey.String(string(transferType)),
 	))
 	defer span.End()
+	initTransferRequest, err := initiateTransferRequest(req, transferType, cpfpRefundSignatures, directRefundSignatures, directFromCpfpRefundSignatures)
+	if err != nil {
+		return err
+	}
+	return h.sendInitiateTransfer(ctx, initTransferRequest)
+}
+
+func (h *TransferHandler) sendInitiateTransfer(ctx context.Context, initTransferRequest *pbinternal.InitiateTransferRequest) error {
+	selection := helper.OperatorSelection{
+		Option: helper.OperatorSelectionOptionExcludeSelf,
+	}
+	_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (any, error) {
+		conn, err := operator.NewOperatorGRPCConnection()
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+
+		client := pbinternal.NewSparkInternalServiceClient(conn)
+		return client.InitiateTransfer(ctx, initTransferRequest)
+	})
+	return err
+}
+
+func (h *TransferHandler) syncBatchTransferInit(ctx context.Context, initTransferRequests []*pbinternal.InitiateTransferRequest) error {
+	ctx, span := tracer.Start(ctx, "TransferHandler.syncBatchTransferInit")
+	defer span.End()
+	selection := helper.OperatorSelection{
+		Option: helper.OperatorSelectionOptionExcludeSelf,
+	}
+	_, err := helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (any, error) {
+		conn, err := operator.NewOperatorGRPCConnection()
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+
+		client := pbinternal.NewSparkInternalServiceClient(conn)
+		return client.InitiateBatchTransfer(ctx, &pbinternal.InitiateBatchTransferRequest{Transfers: initTransferRequests})
+	})
+	return err
+}
+
+func initiateTransferRequest(req *pb.StartTransferRequest, transferType st.TransferType, cpfpRefundSignatures map[string][]byte, directRefundSignatures map[string][]byte, directFromCpfpRefundSignatures map[string][]byte) (*pbinternal.InitiateTransferRequest, error) {
 	var leaves []*pbinternal.InitiateTransferLeaf
 	for _, leaf := range req.LeavesToSend {
 		var directRefundTx []byte
@@ -520,9 +892,9 @@ func (h *TransferHandler) syncTransferInit(ctx context.Context, req *pb.StartTra
 	}
 	transferTypeProto, err := ent.TransferTypeProto(transferType)
 	if err != nil {
-		return fmt.Errorf("unable to get transfer type proto: %w", err)
+		return nil, fmt.Errorf("unable to get transfer type proto: %w", err)
 	}
-	initTransferRequest := &pbinternal.InitiateTransferRequest{
+	return &pbinternal.InitiateTransferRequest{
 		TransferId:                     req.TransferId,
 		SenderIdentityPublicKey:        req.OwnerIdentityPublicKey,
 		ReceiverIdentityPublicKey:      req.ReceiverIdentityPublicKey,
@@ -533,21 +905,8 @@ func (h *TransferHandler) syncTransferInit(ctx context.Context, req *pb.StartTra
 		RefundSignatures:               cpfpRefundSignatures,
 		DirectRefundSignatures:         directRefundSignatures,
 		DirectFromCpfpRefundSignatures: directFromCpfpRefundSignatures,
-	}
-	selection := helper.OperatorSelection{
-		Option: helper.OperatorSelectionOptionExcludeSelf,
-	}
-	_, err = helper.ExecuteTaskWithAllOperators(ctx, h.config, &selection, func(ctx context.Context, operator *so.SigningOperator) (any, error) {
-		conn, err := operator.NewOperatorGRPCConnection()
-		if err != nil {
-			return nil, err
-		}
-		defer conn.Close()
-
-		client := pbinternal.NewSparkInternalServiceClient(conn)
-		return client.InitiateTransfer(ctx, initTransferRequest)
-	})
-	return err
+		SparkPaymentIntent:             req.SparkPaymentIntent,
+	}, nil
 }
 
 func (h *TransferHandler) syncDeliverSenderKeyTweak(ctx context.Context, req *pb.FinalizeTransferWithTransferPackageRequest, transferType st.TransferType) error {
@@ -792,12 +1151,42 @@ func signRefundsWithPregeneratedNonce(
 	ctx, span := tracer.Start(ctx, "TransferHandler.signRefunds")
 	defer span.End()
 
+	refundJobs, err := newRefundSigningJobs(ctx, requests, leafMap, cpfpAdaptorPubKey, directAdaptorPubKey, directFromCpfpAdaptorPubKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	signingResults, err := helper.SignFrostWithPregeneratedNonce(ctx, config, refundJobs.jobs)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to sign frost: %w", err)
+	}
+	cpfpResults, directResults, directFromCpfpResults := refundJobs.splitResults(signingResults)
+	return cpfpResults, directResults, directFromCpfpResults, nil
+}
+
+// refundSigningJobs are the signing jobs for the refunds of a transfer, along with the leaf and kind of refund that
+// each job signs.
+type refundSigningJobs struct {
+	jobs                      []*helper.SigningJobWithPregeneratedNonce
+	leafJobMap                map[string]*ent.TreeNode
+	jobIsDirectRefund         map[string]bool
+	jobIsDirectFromCpfpRefund map[string]bool
+}
+
+// newRefundSigningJobs creates the signing jobs for the user signed refunds of a transfer package.
+func newRefundSigningJobs(
+	ctx context.Context,
+	requests *pb.StartTransferRequest,
+	leafMap map[string]*ent.TreeNode,
+	cpfpAdaptorPubKey keys.Public,
+	directAdaptorPubKey keys.Public,
+	directFromCpfpAdaptorPubKey keys.Public,
+) (*refundSigningJobs, error) {
 	leafJobMap := make(map[string]*ent.TreeNode)
 	jobIsDirectRefund := make(map[string]bool)
 	jobIsDirectFromCpfpRefund := make(map[string]bool)
 
 	if requests.TransferPackage == nil {
-		return nil, nil, nil, fmt.Errorf("transfer package is nil")
+		return nil, fmt.Errorf("transfer package is nil")
 	}
 
 	signingJobs := make([]*helper.SigningJobWithPregeneratedNonce, 0)
@@ -805,25 +1194,25 @@ func signRefundsWithPregeneratedNonce(
 		leaf := leafMap[req.LeafId]
 		refundTx, err := common.TxFromRawTxBytes(req.RawTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to load new refund tx: %w", err)
+			return nil, fmt.Errorf("unable to load new refund tx: %w", err)
 		}
 
 		leafTx, err := common.TxFromRawTxBytes(leaf.RawTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to load leaf tx: %w", err)
+			return nil, fmt.Errorf("unable to load leaf tx: %w", err)
 		}
 		if len(leafTx.TxOut) <= 0 {
-			return nil, nil, nil, fmt.Errorf("vout out of bounds")
+			return nil, fmt.Errorf("vout out of bounds")
 		}
 		refundTxSigHash, err := common.SigHashFromTx(refundTx, 0, leafTx.TxOut[0])
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to calculate sighash from refund tx: %w", err)
+			return nil, fmt.Errorf("unable to calculate sighash from refund tx: %w", err)
 		}
 
 		userNonceCommitment := objects.SigningCommitment{}
 		err = userNonceCommitment.UnmarshalProto(req.SigningNonceCommitment)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to unmarshal signing nonce commitment: %w", err)
+			return nil, fmt.Errorf("unable to unmarshal signing nonce commitment: %w", err)
 		}
 		cpfpJobID := uuid.New().String()
 		jobIsDirectRefund[cpfpJobID] = false
@@ -831,7 +1220,7 @@ func signRefundsWithPregeneratedNonce(
 
 		signingKeyshare, err := leaf.QuerySigningKeyshare().Only(ctx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to get signing keyshare id: %w", err)
+			return nil, fmt.Errorf("failed to get signing keyshare id: %w", err)
 		}
 
 		round1Packages := make(map[string]objects.SigningCommitment)
@@ -839,16 +1228,16 @@ func signRefundsWithPregeneratedNonce(
 			obj := objects.SigningCommitment{}
 			err = obj.UnmarshalProto(commitment)
 			if err != nil {
-				return nil, nil, nil, fmt.Errorf("unable to unmarshal signing commitment: %w", err)
+				return nil, fmt.Errorf("unable to unmarshal signing commitment: %w", err)
 			}
 			round1Packages[key] = obj
 			if len(obj.Hiding) == 0 || len(obj.Binding) == 0 {
-				return nil, nil, nil, fmt.Errorf("cpfp signing commitment is invalid for key %s: hiding or binding is empty", key)
+				return nil, fmt.Errorf("cpfp signing commitment is invalid for key %s: hiding or binding is empty", key)
 			}
 		}
 		leafVerifyingPubKey, err := keys.ParsePublicKey(leaf.VerifyingPubkey)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to parse verifying public key: %w", err)
+			return nil, fmt.Errorf("failed to parse verifying public key: %w", err)
 		}
 		signingJobs = append(
 			signingJobs,
@@ -872,45 +1261,45 @@ func signRefundsWithPregeneratedNonce(
 		leaf := leafMap[req.LeafId]
 		directRefundTx, err := common.TxFromRawTxBytes(req.RawTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to load new direct refund tx: %w", err)
+			return nil, fmt.Errorf("unable to load new direct refund tx: %w", err)
 		}
 
 		directTx, err := common.TxFromRawTxBytes(leaf.DirectTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to load leaf tx: %w", err)
+			return nil, fmt.Errorf("unable to load leaf tx: %w", err)
 		}
 		if len(directTx.TxOut) <= 0 {
-			return nil, nil, nil, fmt.Errorf("vout out of bounds")
+			return nil, fmt.Errorf("vout out of bounds")
 		}
 		directRefundTxSigHash, err := common.SigHashFromTx(directRefundTx, 0, directTx.TxOut[0])
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to calculate sighash from direct refund tx: %w", err)
+			return nil, fmt.Errorf("unable to calculate sighash from direct refund tx: %w", err)
 		}
 
 		userNonceCommitment := objects.SigningCommitment{}
 		err = userNonceCommitment.UnmarshalProto(req.SigningNonceCommitment)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to unmarshal signing nonce commitment: %w", err)
+			return nil, fmt.Errorf("unable to unmarshal signing nonce commitment: %w", err)
 		}
 
 		directJobID := uuid.New().String()
 		jobIsDirectRefund[directJobID] = true
 		signingKeyshare, err := leaf.QuerySigningKeyshare().Only(ctx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to get signing keyshare id: %w", err)
+			return nil, fmt.Errorf("failed to get signing keyshare id: %w", err)
 		}
 
 		round1Packages := make(map[string]objects.SigningCommitment)
 		for key, commitment := range req.SigningCommitments.SigningCommitments {
 			obj := objects.SigningCommitment{}
 			if err = obj.UnmarshalProto(commitment); err != nil {
-				return nil, nil, nil, fmt.Errorf("unable to unmarshal signing commitment: %w", err)
+				return nil, fmt.Errorf("unable to unmarshal signing commitment: %w", err)
 			}
 			round1Packages[key] = obj
 		}
 		leafVerifyingPubKey, err := keys.ParsePublicKey(leaf.VerifyingPubkey)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to parse verifying public key: %w", err)
+			return nil, fmt.Errorf("failed to parse verifying public key: %w", err)
 		}
 		signingJobs = append(signingJobs, &helper.SigningJobWithPregeneratedNonce{
 			SigningJob: helper.SigningJob{
@@ -930,44 +1319,44 @@ func signRefundsWithPregeneratedNonce(
 		leaf := leafMap[req.LeafId]
 		directFromCpfpRefundTx, err := common.TxFromRawTxBytes(req.RawTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to load new direct from cpfp refund tx: %w", err)
+			return nil, fmt.Errorf("unable to load new direct from cpfp refund tx: %w", err)
 		}
 		directFromCpfpLeafTx, err := common.TxFromRawTxBytes(leaf.RawTx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to load leaf tx: %w", err)
+			return nil, fmt.Errorf("unable to load leaf tx: %w", err)
 		}
 		if len(directFromCpfpLeafTx.TxOut) <= 0 {
-			return nil, nil, nil, fmt.Errorf("vout out of bounds")
+			return nil, fmt.Errorf("vout out of bounds")
 		}
 		directFromCpfpRefundTxSigHash, err := common.SigHashFromTx(directFromCpfpRefundTx, 0, directFromCpfpLeafTx.TxOut[0])
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to calculate sighash from direct from cpfp refund tx: %w", err)
+			return nil, fmt.Errorf("unable to calculate sighash from direct from cpfp refund tx: %w", err)
 		}
 
 		userNonceCommitment := objects.SigningCommitment{}
 		err = userNonceCommitment.UnmarshalProto(req.SigningNonceCommitment)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to unmarshal signing nonce commitment: %w", err)
+			return nil, fmt.Errorf("unable to unmarshal signing nonce commitment: %w", err)
 		}
 
 		directFromCpfpJobID := uuid.New().String()
 		jobIsDirectFromCpfpRefund[directFromCpfpJobID] = true
 		signingKeyshare, err := leaf.QuerySigningKeyshare().Only(ctx)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to get signing keyshare id: %w", err)
+			return nil, fmt.Errorf("failed to get signing keyshare id: %w", err)
 		}
 
 		round1Packages := make(map[string]objects.SigningCommitment)
 		for key, commitment := range req.SigningCommitments.SigningCommitments {
 			obj := objects.SigningCommitment{}
 			if err = obj.UnmarshalProto(commitment); err != nil {
-				return nil, nil, nil, fmt.Errorf("unable to unmarshal signing commitment: %w", err)
+				return nil, fmt.Errorf("unable to unmarshal signing commitment: %w", err)
 			}
 			round1Packages[key] = obj
 		}
 		leafVerifyingPubKey, err := keys.ParsePublicKey(leaf.VerifyingPubkey)
 		if err != nil {
-			return nil, nil, nil, fmt.Errorf("failed to parse verifying public key: %w", err)
+			return nil, fmt.Errorf("failed to parse verifying public key: %w", err)
 		}
 		signingJobs = append(signingJobs, &helper.SigningJobWithPregeneratedNonce{
 			SigningJob: helper.SigningJob{
@@ -986,35 +1375,44 @@ func signRefundsWithPregeneratedNonce(
 	// Validate that no signing jobs have empty round1Packages
 	for _, job := range signingJobs {
 		if len(job.Round1Packages) == 0 {
-			return nil, nil, nil, fmt.Errorf("signing job %s has empty round1Packages (message: %x)", job.SigningJob.JobID, job.SigningJob.Message)
+			return nil, fmt.Errorf("signing job %s has empty round1Packages (message: %x)", job.SigningJob.JobID, job.SigningJob.Message)
 		}
 		for key, commitment := range job.Round1Packages {
 			if len(commitment.Hiding) == 0 || len(commitment.Binding) == 0 {
-				return nil, nil, nil, fmt.Errorf("signing job %s has invalid commitment for key %s: hiding or binding is empty (message: %x)", job.SigningJob.JobID, key, job.SigningJob.Message)
+				return nil, fmt.Errorf("signing job %s has invalid commitment for key %s: hiding or binding is empty (message: %x)", job.SigningJob.JobID, key, job.SigningJob.Message)
 			}
 		}
 	}
 
-	signingResults, err := helper.SignFrostWithPregeneratedNonce(ctx, config, signingJobs)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("unable to sign frost: %w", err)
-	}
+	return &refundSigningJobs{
+		jobs:                      signingJobs,
+		leafJobMap:                leafJobMap,
+		jobIsDirectRefund:         jobIsDirectRefund,
+		jobIsDirectFromCpfpRefund: jobIsDirectFromCpfpRefund,
+	}, nil
+}
 
+// splitResults returns the results of this transfer's jobs by leaf ID, for the CPFP, direct and direct from CPFP
+// refunds. Results of jobs that belong to other transfers signed in the same round are ignored.
+func (j *refundSigningJobs) splitResults(signingResults []*helper.SigningResult) (map[string]*helper.SigningResult, map[string]*helper.SigningResult, map[string]*helper.SigningResult) {
 	cpfpResults := make(map[string]*helper.SigningResult)
 	directResults := make(map[string]*helper.SigningResult)
 	directFromCpfpResults := make(map[string]*helper.SigningResult)
 
 	for _, signingResult := range signingResults {
-		leaf := leafJobMap[signingResult.JobID]
-		if jobIsDirectRefund[signingResult.JobID] {
+		leaf, ok := j.leafJobMap[signingResult.JobID]
+		if !ok {
+			continue
+		}
+		if j.jobIsDirectRefund[signingResult.JobID] {
 			directResults[leaf.ID.String()] = signingResult
-		} else if jobIsDirectFromCpfpRefund[signingResult.JobID] {
+		} else if j.jobIsDirectFromCpfpRefund[signingResult.JobID] {
 			directFromCpfpResults[leaf.ID.String()] = signingResult
 		} else {
 			cpfpResults[leaf.ID.String()] = signingResult
 		}
 	}
-	return cpfpResults, directResults, directFromCpfpResults, nil
+	return cpfpResults, directResults, directFromCpfpResults
 }
 
 func aggregateSignatures(
@@ -1127,8 +1525,6 @@ func (h *TransferHandler) FinalizeTransfer(ctx context.Context, req *pb.Finalize
 	ctx, span := tracer.Start(ctx, "TransferHandler.FinalizeTransfer")
 	defer span.End()
 
-	logger := logging.GetLoggerFromContext(ctx)
-
 	if err := authz.EnforceSessionIdentityPublicKeyMatches(ctx, h.config, req.OwnerIdentityPublicKey); err != nil {
 		return nil, err
 	}
@@ -1186,16 +1582,13 @@ func (h *TransferHandler) FinalizeTransfer(ctx context.Context, req *pb.Finalize
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse receiver identity public key: %w", err)
 	}
-	err = eventRouter.NotifyUser(receiverIDPubKey, &pb.SubscribeToEventsResponse{
+	eventRouter.NotifyUser(db, receiverIDPubKey, &pb.SubscribeToEventsResponse{
 		Event: &pb.SubscribeToEventsResponse_Transfer{
 			Transfer: &pb.TransferEvent{
 				Transfer: transferProto,
 			},
 		},
 	})
-	if err != nil {
-		logger.Error("failed to notify user about transfer event", "error", err, "identity_public_key", logging.Pubkey{Pubkey: transfer.ReceiverIdentityPubkey})
-	}
 
 	return &pb.FinalizeTransferResponse{Transfer: transferProto}, nil
 }
@@ -1601,6 +1994,18 @@ func (h *TransferHandler) queryTransfers(ctx context.Context, filter *pb.Transfe
 		transferPredicate = append(transferPredicate, enttransfer.TypeIn(transferTypes...))
 	}
 
+	if len(filter.SparkPaymentIntents) > 0 {
+		paymentIntentIDs := make([]uuid.UUID, 0, len(filter.SparkPaymentIntents))
+		for _, paymentIntent := range filter.SparkPaymentIntents {
+			paymentIntentID, err := ent.PaymentIntentID(paymentIntent)
+			if err != nil {
+				return nil, err
+			}
+			paymentIntentIDs = append(paymentIntentIDs, paymentIntentID)
+		}
+		transferPredicate = append(transferPredicate, enttransfer.HasPaymentIntentWith(paymentintent.IDIn(paymentIntentIDs...)))
+	}
+
 	var network st.Network
 	if filter.GetNetwork() == pb.Network_UNSPECIFIED {
 		network = st.NetworkMainnet
@@ -1631,7 +2036,7 @@ func (h *TransferHandler) queryTransfers(ctx context.Context, filter *pb.Transfe
 		transferPredicate = append(transferPredicate, enttransfer.StatusIn(statuses...))
 	}
 
-	baseQuery := db.Transfer.Query()
+	baseQuery := db.Transfer.Query().WithPaymentIntent()
 	if len(transferPredicate) > 0 {
 		baseQuery = baseQuery.Where(enttransfer.And(transferPredicate...))
 	}
@@ -2443,17 +2848,18 @@ func (h *TransferHandler) ResumeSendTransfer(ctx context.Context, transfer *ent.
 		if err != nil {
 			return fmt.Errorf("unable to parse receiver identity public key: %w", err)
 		}
+		db, err := ent.GetDbFromContext(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to get db: %w", err)
+		}
 		eventRouter := events.GetDefaultRouter()
-		err = eventRouter.NotifyUser(receiverIDPubKey, &pb.SubscribeToEventsResponse{
+		eventRouter.NotifyUser(db, receiverIDPubKey, &pb.SubscribeToEventsResponse{
 			Event: &pb.SubscribeToEventsResponse_Transfer{
 				Transfer: &pb.TransferEvent{
 					Transfer: transferProto,
 				},
 			},
 		})
-		if err != nil {
-			logger.Error("failed to notify user about transfer event", "error", err, "identity_public_key", logging.Pubkey{Pubkey: transfer.ReceiverIdentityPubkey})
-		}
 	}
 
 	// If there's an error, it means some SOs are not online. We can retry later.
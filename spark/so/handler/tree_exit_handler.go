@@ -90,6 +90,9 @@ func (h *TreeExitHandler) MarkTreesExited(ctx context.Context, trees []*ent.Tree
 			if err != nil {
 				return fmt.Errorf("failed to update tree nodes status on tree %s: %w", tree.ID.String(), err)
 			}
+			if err := notifyTreeExited(ctx, tree); err != nil {
+				return fmt.Errorf("failed to notify owner of tree %s exit: %w", tree.ID.String(), err)
+			}
 		}
 	}
 	return nil
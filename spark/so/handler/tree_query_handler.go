@@ -1,13 +1,16 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common"
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	"github.com/lightsparkdev/spark/so"
+	"github.com/lightsparkdev/spark/so/authz"
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/depositaddress"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
@@ -15,6 +18,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/tree"
 	"github.com/lightsparkdev/spark/so/ent/treenode"
 	enttreenode "github.com/lightsparkdev/spark/so/ent/treenode"
+	"github.com/lightsparkdev/spark/so/errors"
 	"github.com/lightsparkdev/spark/so/utils"
 )
 
@@ -470,3 +474,126 @@ func (h *TreeQueryHandler) QueryNodesByValue(ctx context.Context, req *pb.QueryN
 
 	return response, nil
 }
+
+// QueryUnilateralExitKit returns the transactions the owner of a leaf has to broadcast to exit it unilaterally,
+// ordered from the root of the tree down to the refund of the leaf.
+func (h *TreeQueryHandler) QueryUnilateralExitKit(ctx context.Context, req *pb.QueryUnilateralExitKitRequest) (*pb.QueryUnilateralExitKitResponse, error) {
+	leafID, err := uuid.Parse(req.GetLeafId())
+	if err != nil {
+		return nil, errors.InvalidUserInputErrorf("unable to parse leaf id as a uuid %s: %w", req.GetLeafId(), err)
+	}
+
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+	leaf, err := db.TreeNode.Query().Where(treenode.IDEQ(leafID)).WithTree().Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.NotFoundErrorf("leaf %s not found", leafID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaf %s: %w", leafID, err)
+	}
+	if err := authz.EnforceSessionIdentityPublicKeyMatches(ctx, h.config, leaf.OwnerIdentityPubkey); err != nil {
+		return nil, err
+	}
+	if len(leaf.RawRefundTx) == 0 {
+		return nil, errors.FailedPreconditionErrorf("node %s has no refund transaction to exit with", leafID)
+	}
+	// Matches getAncestorChain, which does not return the root node on mainnet.
+	if leaf.Edges.Tree.Network == st.NetworkMainnet {
+		return nil, errors.FailedPreconditionErrorf("unilateral exit is temporarily disabled on mainnet")
+	}
+	network, err := common.ProtoNetworkFromSchemaNetwork(leaf.Edges.Tree.Network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert schema network to proto network: %w", err)
+	}
+
+	ancestors := []*ent.TreeNode{leaf}
+	for node := leaf; ; {
+		node, err = node.QueryParent().Only(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent of node %s: %w", ancestors[len(ancestors)-1].ID, err)
+		}
+		ancestors = append(ancestors, node)
+	}
+
+	steps := make([]*pb.UnilateralExitStep, 0, len(ancestors)+1)
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		node := ancestors[i]
+		step, err := unilateralExitStep(node, pb.UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_NODE, node.RawTx, node.DirectTx, nil, node.NodeConfirmationHeight)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+	refundStep, err := unilateralExitStep(leaf, pb.UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_REFUND, leaf.RawRefundTx, leaf.DirectRefundTx, leaf.DirectFromCpfpRefundTx, leaf.RefundConfirmationHeight)
+	if err != nil {
+		return nil, err
+	}
+	steps = append(steps, refundStep)
+
+	return &pb.QueryUnilateralExitKitResponse{
+		LeafId:  leafID.String(),
+		Network: network,
+		Steps:   steps,
+	}, nil
+}
+
+func unilateralExitStep(
+	node *ent.TreeNode,
+	stepType pb.UnilateralExitStepType,
+	cpfpTx []byte,
+	directTx []byte,
+	directFromCpfpTx []byte,
+	confirmationHeight uint64,
+) (*pb.UnilateralExitStep, error) {
+	step := &pb.UnilateralExitStep{
+		NodeId: node.ID.String(),
+		Type:   stepType,
+	}
+	var err error
+	if step.CpfpTx, err = unilateralExitTransaction(cpfpTx); err != nil {
+		return nil, fmt.Errorf("failed to parse %s cpfp tx of node %s: %w", stepType, node.ID, err)
+	}
+	if step.DirectTx, err = unilateralExitTransaction(directTx); err != nil {
+		return nil, fmt.Errorf("failed to parse %s direct tx of node %s: %w", stepType, node.ID, err)
+	}
+	if step.DirectFromCpfpTx, err = unilateralExitTransaction(directFromCpfpTx); err != nil {
+		return nil, fmt.Errorf("failed to parse %s direct from cpfp tx of node %s: %w", stepType, node.ID, err)
+	}
+	if confirmationHeight > 0 {
+		step.ConfirmationHeight = &confirmationHeight
+	}
+	return step, nil
+}
+
+// unilateralExitTransaction returns the relative timelock and fee-bump anchor of a raw transaction, or nil if
+// the transaction is empty.
+func unilateralExitTransaction(rawTx []byte) (*pb.UnilateralExitTransaction, error) {
+	if len(rawTx) == 0 {
+		return nil, nil
+	}
+	tx, err := common.TxFromRawTxBytes(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	exitTx := &pb.UnilateralExitTransaction{Tx: rawTx}
+	if len(tx.TxIn) > 0 && tx.TxIn[0].Sequence&wire.SequenceLockTimeDisabled == 0 {
+		// The timelock is reported in blocks, so a time-based lock can't be described.
+		if tx.TxIn[0].Sequence&wire.SequenceLockTimeIsSeconds != 0 {
+			return nil, fmt.Errorf("relative timelock of tx %s is in seconds, expected blocks", tx.TxHash())
+		}
+		exitTx.Timelock = tx.TxIn[0].Sequence & wire.SequenceLockTimeMask
+	}
+	for vout, txOut := range tx.TxOut {
+		if bytes.Equal(txOut.PkScript, common.EphemeralAnchorPkScript()) {
+			anchorVout := uint32(vout)
+			exitTx.AnchorVout = &anchorVout
+		}
+	}
+	return exitTx, nil
+}
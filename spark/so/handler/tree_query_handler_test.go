@@ -1,13 +1,21 @@
 package handler
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/lightsparkdev/spark/common"
+	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/db"
 	"github.com/lightsparkdev/spark/so/ent"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -78,3 +86,118 @@ func TestQueryStaticDepositAddresses(t *testing.T) {
 		Save(ctx)
 	require.NoError(t, err)
 }
+
+func TestQueryUnilateralExitKit(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	serialize := func(msgTx *wire.MsgTx) []byte {
+		var buf bytes.Buffer
+		require.NoError(t, msgTx.Serialize(&buf))
+		return buf.Bytes()
+	}
+	createTx := func(sequence uint32, withAnchor bool) []byte {
+		msgTx := wire.NewMsgTx(3)
+		msgTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{1}}, Sequence: sequence})
+		msgTx.AddTxOut(wire.NewTxOut(1000, []byte{txscript.OP_TRUE}))
+		if withAnchor {
+			msgTx.AddTxOut(wire.NewTxOut(0, common.EphemeralAnchorPkScript()))
+		}
+		return serialize(msgTx)
+	}
+
+	signingKeyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusAvailable).
+		SetSecretShare([]byte("test_secret_share")).
+		SetPublicShares(map[string][]byte{"test": []byte("test_public_share")}).
+		SetPublicKey([]byte("test_public_key")).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	createTree := func(network st.Network) (*ent.TreeNode, *ent.TreeNode) {
+		tree, err := tx.Tree.Create().
+			SetStatus(st.TreeStatusAvailable).
+			SetNetwork(network).
+			SetOwnerIdentityPubkey([]byte("test_identity_pubkey")).
+			SetBaseTxid([]byte("test_base_txid_" + network)).
+			SetVout(0).
+			Save(ctx)
+		require.NoError(t, err)
+		root, err := tx.TreeNode.Create().
+			SetStatus(st.TreeNodeStatusSplitted).
+			SetTree(tree).
+			SetSigningKeyshare(signingKeyshare).
+			SetValue(1000).
+			SetVerifyingPubkey([]byte("test_verifying_pubkey")).
+			SetOwnerIdentityPubkey([]byte("test_identity_pubkey")).
+			SetOwnerSigningPubkey([]byte("test_owner_signing")).
+			SetRawTx(createTx(wire.MaxTxInSequenceNum, false)).
+			SetNodeConfirmationHeight(100).
+			SetVout(0).
+			Save(ctx)
+		require.NoError(t, err)
+		leaf, err := tx.TreeNode.Create().
+			SetStatus(st.TreeNodeStatusAvailable).
+			SetTree(tree).
+			SetParent(root).
+			SetSigningKeyshare(signingKeyshare).
+			SetValue(1000).
+			SetVerifyingPubkey([]byte("test_verifying_pubkey")).
+			SetOwnerIdentityPubkey([]byte("test_identity_pubkey")).
+			SetOwnerSigningPubkey([]byte("test_owner_signing")).
+			SetRawTx(createTx(1<<30|2000, true)).
+			SetDirectTx(createTx(1<<30|2050, false)).
+			SetRawRefundTx(createTx(1<<30|1900, true)).
+			SetDirectRefundTx(createTx(1<<30|1950, false)).
+			SetDirectFromCpfpRefundTx(createTx(1<<30|1950, false)).
+			SetVout(0).
+			Save(ctx)
+		require.NoError(t, err)
+		return root, leaf
+	}
+
+	handler := NewTreeQueryHandler(&so.Config{})
+	root, leaf := createTree(st.NetworkRegtest)
+
+	resp, err := handler.QueryUnilateralExitKit(ctx, &pb.QueryUnilateralExitKitRequest{LeafId: leaf.ID.String()})
+	require.NoError(t, err)
+	assert.Equal(t, pb.Network_REGTEST, resp.Network)
+	require.Len(t, resp.Steps, 3)
+
+	rootStep := resp.Steps[0]
+	assert.Equal(t, root.ID.String(), rootStep.NodeId)
+	assert.Equal(t, pb.UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_NODE, rootStep.Type)
+	assert.Equal(t, uint32(0), rootStep.CpfpTx.Timelock)
+	assert.Nil(t, rootStep.CpfpTx.AnchorVout)
+	assert.Nil(t, rootStep.DirectTx)
+	assert.Equal(t, uint64(100), rootStep.GetConfirmationHeight())
+
+	leafStep := resp.Steps[1]
+	assert.Equal(t, leaf.ID.String(), leafStep.NodeId)
+	assert.Equal(t, uint32(2000), leafStep.CpfpTx.Timelock)
+	require.NotNil(t, leafStep.CpfpTx.AnchorVout)
+	assert.Equal(t, uint32(1), *leafStep.CpfpTx.AnchorVout)
+	assert.Equal(t, uint32(2050), leafStep.DirectTx.Timelock)
+	assert.Nil(t, leafStep.DirectTx.AnchorVout)
+	assert.Nil(t, leafStep.ConfirmationHeight)
+
+	refundStep := resp.Steps[2]
+	assert.Equal(t, pb.UnilateralExitStepType_UNILATERAL_EXIT_STEP_TYPE_REFUND, refundStep.Type)
+	assert.Equal(t, leaf.RawRefundTx, refundStep.CpfpTx.Tx)
+	assert.Equal(t, uint32(1900), refundStep.CpfpTx.Timelock)
+	assert.Equal(t, leaf.DirectFromCpfpRefundTx, refundStep.DirectFromCpfpTx.Tx)
+
+	_, err = handler.QueryUnilateralExitKit(ctx, &pb.QueryUnilateralExitKitRequest{LeafId: root.ID.String()})
+	require.ErrorContains(t, err, "no refund transaction")
+
+	_, mainnetLeaf := createTree(st.NetworkMainnet)
+	_, err = handler.QueryUnilateralExitKit(ctx, &pb.QueryUnilateralExitKitRequest{LeafId: mainnetLeaf.ID.String()})
+	require.ErrorContains(t, err, "disabled on mainnet")
+
+	_, err = unilateralExitTransaction(createTx(wire.SequenceLockTimeIsSeconds|2000, false))
+	require.ErrorContains(t, err, "in seconds")
+}
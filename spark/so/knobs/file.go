@@ -0,0 +1,216 @@
+package knobs
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// DefaultFilePollInterval is how often the knobs file is checked for changes.
+const DefaultFilePollInterval = 10 * time.Second
+
+var (
+	meter = otel.Meter("knobs")
+
+	fileReloadCounter metric.Int64Counter
+)
+
+func init() {
+	var err error
+	fileReloadCounter, err = meter.Int64Counter(
+		"knobs.file.reload_total",
+		metric.WithDescription("Number of times the knobs file was reloaded, by result"),
+	)
+	if err != nil {
+		slog.Error("Failed to create knobs file reload counter", "error", err)
+	}
+}
+
+type knobsFileValuesProvider struct {
+	context      context.Context
+	logger       *slog.Logger
+	path         string
+	pollInterval time.Duration
+	values       atomic.Pointer[map[string]float64]
+
+	// The modification time and size of the file when it was last read, used to detect changes.
+	modTime time.Time
+	size    int64
+}
+
+// NewKnobsFileValuesProvider returns a provider that reads knob values from a local YAML or JSON
+// file and reloads them whenever the file changes. The file uses the same layout as the knobs
+// ConfigMap: each knob maps either to a number or to a map of targets to numbers.
+//
+//	spark.so.transfer_limit: 100
+//	spark.so.ratelimit.limit:
+//	  /spark.SparkService/start_transfer: 10
+//
+// The file must be valid when the provider is created. After that, a file that fails to parse or
+// validate is logged and ignored, and the previous values stay in effect. The current values are
+// exported through the knobs.value gauge.
+func NewKnobsFileValuesProvider(ctx context.Context, path string, pollInterval time.Duration) (*knobsFileValuesProvider, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultFilePollInterval
+	}
+	provider := &knobsFileValuesProvider{
+		context:      ctx,
+		logger:       slog.Default().With("component", "knobs", "path", path),
+		path:         path,
+		pollInterval: pollInterval,
+	}
+
+	if _, err := provider.reload(); err != nil {
+		return nil, fmt.Errorf("failed to load knobs file: %w", err)
+	}
+
+	_, err := meter.Float64ObservableGauge(
+		"knobs.value",
+		metric.WithDescription("Current value of each knob loaded from the knobs file"),
+		metric.WithFloat64Callback(provider.observe),
+	)
+	if err != nil {
+		slog.Error("Failed to create knobs value gauge", "error", err)
+	}
+
+	go provider.watch()
+
+	return provider, nil
+}
+
+func (k *knobsFileValuesProvider) GetValue(key string, defaultValue float64) float64 {
+	if value, exists := (*k.values.Load())[key]; exists {
+		return value
+	}
+	return defaultValue
+}
+
+func (k *knobsFileValuesProvider) Values() map[string]float64 {
+	return maps.Clone(*k.values.Load())
+}
+
+func (k *knobsFileValuesProvider) observe(_ context.Context, observer metric.Float64Observer) error {
+	for key, value := range *k.values.Load() {
+		observer.Observe(value, metric.WithAttributes(attribute.String("knob", key)))
+	}
+	return nil
+}
+
+// watch polls the file until the context is cancelled and reloads it when it changes.
+func (k *knobsFileValuesProvider) watch() {
+	ticker := time.NewTicker(k.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-k.context.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := k.reload()
+			if err != nil {
+				fileReloadCounter.Add(k.context, 1, metric.WithAttributes(attribute.String("result", "error")))
+				k.logger.Error("Failed to reload knobs file, keeping previous values", "error", err)
+			} else if reloaded {
+				fileReloadCounter.Add(k.context, 1, metric.WithAttributes(attribute.String("result", "success")))
+			}
+		}
+	}
+}
+
+// reload reads the file if it changed since it was last read and swaps in its values. It reports
+// whether new values were loaded. The values are only replaced if the whole file is valid.
+func (k *knobsFileValuesProvider) reload() (bool, error) {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return false, err
+	}
+	if k.values.Load() != nil && info.ModTime().Equal(k.modTime) && info.Size() == k.size {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(k.path)
+	if err != nil {
+		return false, err
+	}
+	// Only remember the file once it has been read successfully, so that a failed read is retried.
+	k.modTime = info.ModTime()
+	k.size = info.Size()
+
+	values, err := parseKnobsFile(data)
+	if err != nil {
+		return false, err
+	}
+	k.values.Store(&values)
+	k.logger.Info("Updated knobs", "knobs", values)
+	return true, nil
+}
+
+// parseKnobsFile parses and validates the contents of a knobs file. YAML is a superset of JSON, so
+// both formats are accepted.
+func parseKnobsFile(data []byte) (map[string]float64, error) {
+	values := make(map[string]float64)
+	if len(bytes.TrimSpace(data)) == 0 {
+		return values, nil
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse knobs file: %w", err)
+	}
+
+	for name, value := range raw {
+		if name == "" {
+			return nil, fmt.Errorf("knob name must not be empty")
+		}
+		if targets, ok := value.(map[string]any); ok {
+			for target, targetValue := range targets {
+				if target == "" {
+					return nil, fmt.Errorf("knob %s has an empty target", name)
+				}
+				parsed, err := knobFileValue(targetValue)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value for knob %s@%s: %w", name, target, err)
+				}
+				values[fmt.Sprintf("%s@%s", name, target)] = parsed
+			}
+			continue
+		}
+		parsed, err := knobFileValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for knob %s: %w", name, err)
+		}
+		values[name] = parsed
+	}
+	return values, nil
+}
+
+func knobFileValue(value any) (float64, error) {
+	var parsed float64
+	switch v := value.(type) {
+	case int64:
+		parsed = float64(v)
+	case uint64:
+		parsed = float64(v)
+	case int:
+		parsed = float64(v)
+	case float64:
+		parsed = v
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", value)
+	}
+	if math.IsNaN(parsed) || math.IsInf(parsed, 0) {
+		return 0, fmt.Errorf("expected a finite number, got %v", parsed)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,121 @@
+package knobs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKnobsFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           string
+		expectedValues map[string]float64
+		expectError    bool
+	}{
+		{
+			name: "yaml scalar and target values",
+			data: "key1: 1\nkey2: 2.5\nfeature.rollout:\n  target1: 25\n  target2: 75.5\n",
+			expectedValues: map[string]float64{
+				"key1":                    1.0,
+				"key2":                    2.5,
+				"feature.rollout@target1": 25.0,
+				"feature.rollout@target2": 75.5,
+			},
+		},
+		{
+			name: "json",
+			data: `{"key1": 1, "feature.rollout": {"target1": 25}}`,
+			expectedValues: map[string]float64{
+				"key1":                    1.0,
+				"feature.rollout@target1": 25.0,
+			},
+		},
+		{
+			name:           "empty file",
+			data:           "\n",
+			expectedValues: map[string]float64{},
+		},
+		{
+			name:        "non-numeric value",
+			data:        "key1: 1\nkey2: enabled\n",
+			expectError: true,
+		},
+		{
+			name:        "non-numeric target value",
+			data:        "feature.rollout:\n  target1: [1, 2]\n",
+			expectError: true,
+		},
+		{
+			name:        "non-finite value",
+			data:        "key1: .inf\n",
+			expectError: true,
+		},
+		{
+			name:        "invalid yaml",
+			data:        "invalid: yaml: content",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := parseKnobsFile([]byte(tt.data))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedValues, values)
+		})
+	}
+}
+
+func TestKnobsFileValuesProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "knobs.yaml")
+	writeFile := func(data string, modTime time.Time) {
+		require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+	start := time.Now().Add(-time.Hour)
+	writeFile("key1: 1\n", start)
+
+	provider, err := NewKnobsFileValuesProvider(t.Context(), path, time.Hour)
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, provider.GetValue("key1", 0), 0.001)
+	assert.InDelta(t, 5.0, provider.GetValue("missing", 5), 0.001)
+
+	// An unchanged file is not reloaded.
+	reloaded, err := provider.reload()
+	require.NoError(t, err)
+	assert.False(t, reloaded)
+
+	writeFile("key1: 2\nkey2:\n  target: 3\n", start.Add(time.Minute))
+	reloaded, err = provider.reload()
+	require.NoError(t, err)
+	assert.True(t, reloaded)
+	assert.InDelta(t, 2.0, provider.GetValue("key1", 0), 0.001)
+	assert.InDelta(t, 3.0, provider.GetValue("key2@target", 0), 0.001)
+
+	// An invalid file keeps the previous values in effect.
+	writeFile("key1: not a number\n", start.Add(2*time.Minute))
+	_, err = provider.reload()
+	require.Error(t, err)
+	assert.InDelta(t, 2.0, provider.GetValue("key1", 0), 0.001)
+	assert.InDelta(t, 3.0, provider.GetValue("key2@target", 0), 0.001)
+}
+
+func TestKnobsFileValuesProvider_InvalidInitialFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "knobs.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("key1: [1]\n"), 0o600))
+
+	_, err := NewKnobsFileValuesProvider(t.Context(), path, time.Hour)
+	require.Error(t, err)
+
+	_, err = NewKnobsFileValuesProvider(t.Context(), filepath.Join(t.TempDir(), "missing.yaml"), time.Hour)
+	require.Error(t, err)
+}
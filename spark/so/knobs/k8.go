@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"sync"
 	"time"
 
@@ -50,6 +51,13 @@ func (k *knobsK8ValuesProvider) GetValue(key string, defaultValue float64) float
 	return defaultValue
 }
 
+func (k *knobsK8ValuesProvider) Values() map[string]float64 {
+	k.lock.RLock()
+	defer k.lock.RUnlock()
+
+	return maps.Clone(k.values)
+}
+
 // fetchAndUpdate continuously fetches and updates knob values from a Kubernetes ConfigMap.
 // This function sets up a Kubernetes informer to watch for ConfigMap changes in real-time.
 //
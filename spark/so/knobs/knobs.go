@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/md5"
 	"fmt"
+	"maps"
 	"math/big"
 	"math/rand"
 	"time"
@@ -23,10 +24,14 @@ const (
 	KnobGrpcServerKeepaliveTimeout       = "spark.so.grpc.server.keepalive_timeout"
 	KnobGrpcServerUnaryHandlerTimeout    = "spark.so.grpc.server.unary_handler_timeout"
 	KnobSoGenerateStaticDepositAddressV2 = "spark.so.generate_static_deposit_address_v2"
+	KnobSoGossipTTL                      = "spark.so.gossip.ttl"
 )
 
 type Config struct {
 	Enabled *bool `yaml:"enabled"`
+	// File is the path of a local YAML or JSON file to read knobs from instead of the Kubernetes
+	// ConfigMap. The file is reloaded when it changes.
+	File string `yaml:"file"`
 }
 
 func (c *Config) IsEnabled() bool {
@@ -41,6 +46,8 @@ type Knobs interface {
 	RolloutRandom(knob string, defaultValue float64) bool
 	RolloutUUIDTarget(knob string, id uuid.UUID, target *string, defaultValue float64) bool
 	RolloutUUID(knob string, id uuid.UUID, defaultValue float64) bool
+	// Values returns a copy of every knob value that is currently set, keyed like the provider.
+	Values() map[string]float64
 }
 
 // Context helpers for passing Knobs service through request handling
@@ -72,6 +79,11 @@ type KnobsValuesProvider interface {
 	GetValue(key string, defaultValue float64) float64
 }
 
+// KnobsValuesLister is implemented by providers that can list the values they hold.
+type KnobsValuesLister interface {
+	Values() map[string]float64
+}
+
 func New(knobsValuesProvider KnobsValuesProvider) *knobsImpl {
 	return &knobsImpl{
 		provider: knobsValuesProvider,
@@ -98,6 +110,14 @@ func (k knobsImpl) GetValue(knob string, defaultValue float64) float64 {
 	return k.GetValueTarget(knob, nil, defaultValue)
 }
 
+// Values returns the values held by the provider, or none if it cannot list them.
+func (k knobsImpl) Values() map[string]float64 {
+	if lister, ok := k.provider.(KnobsValuesLister); ok {
+		return lister.Values()
+	}
+	return map[string]float64{}
+}
+
 // RolloutRandomTarget determines if a feature should be rolled out based on a random value.
 // This function uses pseudo-random number generation to decide feature rollouts.
 //
@@ -211,6 +231,10 @@ func (m fixedKnobs) GetValue(knob string, defaultValue float64) float64 {
 	return m.GetValueTarget(knob, nil, defaultValue)
 }
 
+func (m fixedKnobs) Values() map[string]float64 {
+	return maps.Clone(m.values)
+}
+
 func (m fixedKnobs) RolloutRandomTarget(knob string, target *string, defaultValue float64) bool {
 	value := m.GetValueTarget(knob, target, defaultValue)
 	return value > 0
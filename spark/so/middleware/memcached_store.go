@@ -0,0 +1,267 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lightsparkdev/spark/common/logging"
+)
+
+const (
+	defaultMemcachedTimeout    = 100 * time.Millisecond
+	defaultMemcachedMaxIdle    = 8
+	maxMemcachedKeyLength      = 250
+	memcachedResponseStored    = "STORED"
+	memcachedResponseNotStored = "NOT_STORED"
+	memcachedResponseNotFound  = "NOT_FOUND"
+)
+
+var errMemcachedNotFound = errors.New("memcached: key not found")
+
+// MemcachedStoreConfig is the configuration for a MemcachedStore.
+type MemcachedStoreConfig struct {
+	// Addrs are the host:port addresses of the cache servers. Keys are spread across them by hash.
+	Addrs []string
+	// Tokens is the number of requests allowed per key in each interval.
+	Tokens uint64
+	// Interval is the length of each rate limiting window.
+	Interval time.Duration
+	// Timeout bounds dialing and each request to a cache server. Defaults to 100ms.
+	Timeout time.Duration
+	// FailOpen allows requests through when the cache cannot be reached. Otherwise they are
+	// rejected.
+	FailOpen bool
+	// Clock is used to determine the current window. Defaults to the real clock.
+	Clock Clock
+}
+
+// MemcachedStore is a MemoryStore that keeps its counters in a shared cache speaking the memcached
+// text protocol, so that every replica behind a load balancer enforces the same budget. Each key
+// gets a fixed-window counter that expires with its window.
+type MemcachedStore struct {
+	servers []*memcachedServer
+	// mu guards tokens and interval, which can be changed while the store is in use.
+	mu       sync.RWMutex
+	tokens   uint64
+	interval time.Duration
+	timeout  time.Duration
+	failOpen bool
+	clock    Clock
+}
+
+// NewMemcachedStore returns a MemcachedStore for the given servers.
+func NewMemcachedStore(config *MemcachedStoreConfig) (*MemcachedStore, error) {
+	if len(config.Addrs) == 0 {
+		return nil, fmt.Errorf("at least one memcached address is required")
+	}
+	if config.Interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive, got %s", config.Interval)
+	}
+
+	store := &MemcachedStore{
+		tokens:   config.Tokens,
+		interval: config.Interval,
+		timeout:  config.Timeout,
+		failOpen: config.FailOpen,
+		clock:    config.Clock,
+	}
+	if store.timeout <= 0 {
+		store.timeout = defaultMemcachedTimeout
+	}
+	if store.clock == nil {
+		store.clock = &realClock{}
+	}
+	for _, addr := range config.Addrs {
+		store.servers = append(store.servers, &memcachedServer{
+			addr:    addr,
+			timeout: store.timeout,
+			idle:    make(chan *memcachedConn, defaultMemcachedMaxIdle),
+		})
+	}
+	return store, nil
+}
+
+// Take counts a request against the key in the current window. If the cache cannot be reached,
+// the request is allowed when the store fails open and an error is returned otherwise.
+func (s *MemcachedStore) Take(ctx context.Context, key string) (tokens uint64, remaining uint64, reset uint64, ok bool, err error) {
+	s.mu.RLock()
+	tokens, interval := s.tokens, s.interval
+	s.mu.RUnlock()
+
+	window := s.clock.Now().UnixNano() / int64(interval)
+	reset = uint64((window + 1) * int64(interval))
+
+	count, err := s.increment(ctx, memcachedKey(key, window), interval)
+	if err != nil {
+		if s.failOpen {
+			logger := logging.GetLoggerFromContext(ctx)
+			logger.Warn("rate limiter store unavailable, allowing request", "error", err)
+			return tokens, tokens, reset, true, nil
+		}
+		return 0, 0, 0, false, fmt.Errorf("rate limiter store unavailable: %w", err)
+	}
+
+	if count > tokens {
+		return tokens, 0, reset, false, nil
+	}
+	return tokens, tokens - count, reset, true, nil
+}
+
+// SetLimit changes the number of requests allowed per key in each interval. Counters already in the
+// cache are kept, so a change of the limit alone does not reset them.
+func (s *MemcachedStore) SetLimit(tokens uint64, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", interval)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = tokens
+	s.interval = interval
+	return nil
+}
+
+// increment adds one to the counter for the key, creating it if this is the first request of the
+// window, and returns the new count.
+func (s *MemcachedStore) increment(ctx context.Context, key string, interval time.Duration) (uint64, error) {
+	server := s.servers[crc32.ChecksumIEEE([]byte(key))%uint32(len(s.servers))]
+	// Expire the counter once its window is over. Memcached expirations have a granularity of a
+	// second, so round up.
+	expiration := int64((interval+time.Second-1)/time.Second) + 1
+
+	count, err := server.incr(ctx, key)
+	if !errors.Is(err, errMemcachedNotFound) {
+		return count, err
+	}
+	added, err := server.add(ctx, key, "1", expiration)
+	if err != nil {
+		return 0, err
+	}
+	if added {
+		return 1, nil
+	}
+	// Another replica created the counter first.
+	return server.incr(ctx, key)
+}
+
+// memcachedKey returns the cache key for the rate limit key in the given window. Keys that are not
+// valid memcached keys are hashed.
+func memcachedKey(key string, window int64) string {
+	windowKey := fmt.Sprintf("%s:%d", key, window)
+	if len(windowKey) <= maxMemcachedKeyLength && !strings.ContainsAny(windowKey, " \t\r\n") {
+		return windowKey
+	}
+	hash := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("rl:%s:%d", hex.EncodeToString(hash[:]), window)
+}
+
+type memcachedServer struct {
+	addr    string
+	timeout time.Duration
+	idle    chan *memcachedConn
+}
+
+type memcachedConn struct {
+	conn net.Conn
+	rw   *bufio.ReadWriter
+}
+
+func (s *memcachedServer) incr(ctx context.Context, key string) (uint64, error) {
+	line, err := s.roundTrip(ctx, fmt.Sprintf("incr %s 1\r\n", key))
+	if err != nil {
+		return 0, err
+	}
+	if line == memcachedResponseNotFound {
+		return 0, errMemcachedNotFound
+	}
+	count, err := strconv.ParseUint(line, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("memcached: unexpected response to incr: %q", line)
+	}
+	return count, nil
+}
+
+func (s *memcachedServer) add(ctx context.Context, key string, value string, expiration int64) (bool, error) {
+	line, err := s.roundTrip(ctx, fmt.Sprintf("add %s 0 %d %d\r\n%s\r\n", key, expiration, len(value), value))
+	if err != nil {
+		return false, err
+	}
+	switch line {
+	case memcachedResponseStored:
+		return true, nil
+	case memcachedResponseNotStored:
+		return false, nil
+	default:
+		return false, fmt.Errorf("memcached: unexpected response to add: %q", line)
+	}
+}
+
+// roundTrip sends the command and returns the first line of the response. Connections are reused
+// unless the request fails.
+func (s *memcachedServer) roundTrip(ctx context.Context, command string) (string, error) {
+	c, err := s.getConn(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := c.conn.SetDeadline(deadline); err != nil {
+		c.conn.Close()
+		return "", err
+	}
+	if _, err := c.rw.WriteString(command); err != nil {
+		c.conn.Close()
+		return "", err
+	}
+	if err := c.rw.Flush(); err != nil {
+		c.conn.Close()
+		return "", err
+	}
+	line, err := c.rw.ReadString('\n')
+	if err != nil {
+		c.conn.Close()
+		return "", err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "ERROR" || strings.HasPrefix(line, "CLIENT_ERROR") || strings.HasPrefix(line, "SERVER_ERROR") {
+		c.conn.Close()
+		return "", fmt.Errorf("memcached: %s", line)
+	}
+
+	s.putConn(c)
+	return line, nil
+}
+
+func (s *memcachedServer) getConn(ctx context.Context) (*memcachedConn, error) {
+	select {
+	case c := <-s.idle:
+		return c, nil
+	default:
+	}
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return nil, err
+	}
+	return &memcachedConn{conn: conn, rw: bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))}, nil
+}
+
+func (s *memcachedServer) putConn(c *memcachedConn) {
+	select {
+	case s.idle <- c:
+	default:
+		c.conn.Close()
+	}
+}
@@ -0,0 +1,276 @@
+package middleware
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark/so/knobs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeMemcachedServer is an in-process server implementing the subset of the memcached text
+// protocol used by MemcachedStore.
+type fakeMemcachedServer struct {
+	listener net.Listener
+
+	mu     sync.Mutex
+	values map[string]uint64
+}
+
+func newFakeMemcachedServer(t *testing.T) *fakeMemcachedServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeMemcachedServer{listener: listener, values: make(map[string]uint64)}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+func (s *fakeMemcachedServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeMemcachedServer) serve(conn net.Conn) {
+	defer conn.Close()
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		var response string
+		switch {
+		case len(fields) == 3 && fields[0] == "incr":
+			delta, _ := strconv.ParseUint(fields[2], 10, 64)
+			s.mu.Lock()
+			value, ok := s.values[fields[1]]
+			if ok {
+				value += delta
+				s.values[fields[1]] = value
+				response = strconv.FormatUint(value, 10)
+			} else {
+				response = "NOT_FOUND"
+			}
+			s.mu.Unlock()
+		case len(fields) == 5 && fields[0] == "add":
+			data, err := rw.ReadString('\n')
+			if err != nil {
+				return
+			}
+			value, _ := strconv.ParseUint(strings.TrimSpace(data), 10, 64)
+			s.mu.Lock()
+			if _, ok := s.values[fields[1]]; ok {
+				response = "NOT_STORED"
+			} else {
+				s.values[fields[1]] = value
+				response = "STORED"
+			}
+			s.mu.Unlock()
+		default:
+			response = "ERROR"
+		}
+		if _, err := rw.WriteString(response + "\r\n"); err != nil {
+			return
+		}
+		if err := rw.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// unreachableAddr returns an address that refuses connections.
+func unreachableAddr(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+	return addr
+}
+
+func TestMemcachedStore(t *testing.T) {
+	server := newFakeMemcachedServer(t)
+	clock := &testClock{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	newStore := func() *MemcachedStore {
+		store, err := NewMemcachedStore(&MemcachedStoreConfig{
+			Addrs:    []string{server.Addr()},
+			Tokens:   3,
+			Interval: time.Minute,
+			Clock:    clock,
+		})
+		require.NoError(t, err)
+		return store
+	}
+
+	t.Run("replicas share counters", func(t *testing.T) {
+		replica1, replica2 := newStore(), newStore()
+
+		for i, store := range []*MemcachedStore{replica1, replica2, replica1} {
+			tokens, remaining, reset, ok, err := store.Take(t.Context(), "rl:shared")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, uint64(3), tokens)
+			assert.Equal(t, uint64(2-i), remaining)
+			assert.Equal(t, uint64(clock.Time.Add(time.Minute).UnixNano()), reset)
+		}
+
+		_, remaining, _, ok, err := replica2.Take(t.Context(), "rl:shared")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, uint64(0), remaining)
+
+		// Other keys have their own budget.
+		_, _, _, ok, err = replica2.Take(t.Context(), "rl:other")
+		require.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("counters reset with the window", func(t *testing.T) {
+		store := newStore()
+		for range 3 {
+			_, _, _, ok, err := store.Take(t.Context(), "rl:window")
+			require.NoError(t, err)
+			assert.True(t, ok)
+		}
+		_, _, _, ok, err := store.Take(t.Context(), "rl:window")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		clock.Time = clock.Time.Add(time.Minute)
+		_, remaining, _, ok, err := store.Take(t.Context(), "rl:window")
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, uint64(2), remaining)
+	})
+
+	t.Run("limit changes keep counters", func(t *testing.T) {
+		store := newStore()
+		for range 2 {
+			_, _, _, ok, err := store.Take(t.Context(), "rl:limit")
+			require.NoError(t, err)
+			assert.True(t, ok)
+		}
+
+		require.NoError(t, store.SetLimit(2, time.Minute))
+		tokens, remaining, _, ok, err := store.Take(t.Context(), "rl:limit")
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, uint64(2), tokens)
+		assert.Equal(t, uint64(0), remaining)
+
+		require.Error(t, store.SetLimit(2, 0))
+	})
+}
+
+func TestMemcachedStoreUnavailable(t *testing.T) {
+	addr := unreachableAddr(t)
+
+	t.Run("fail closed", func(t *testing.T) {
+		store, err := NewMemcachedStore(&MemcachedStoreConfig{Addrs: []string{addr}, Tokens: 1, Interval: time.Minute})
+		require.NoError(t, err)
+
+		_, _, _, ok, err := store.Take(t.Context(), "rl:key")
+		require.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		store, err := NewMemcachedStore(&MemcachedStoreConfig{Addrs: []string{addr}, Tokens: 1, Interval: time.Minute, FailOpen: true})
+		require.NoError(t, err)
+
+		for range 3 {
+			_, _, _, ok, err := store.Take(t.Context(), "rl:key")
+			require.NoError(t, err)
+			assert.True(t, ok)
+		}
+	})
+}
+
+func TestMemcachedKey(t *testing.T) {
+	assert.Equal(t, "rl:/test.Service/TestMethod:1.2.3.4:42", memcachedKey("rl:/test.Service/TestMethod:1.2.3.4", 42))
+
+	long := memcachedKey(strings.Repeat("a", 300), 42)
+	assert.LessOrEqual(t, len(long), maxMemcachedKeyLength)
+	assert.True(t, strings.HasPrefix(long, "rl:"))
+	assert.True(t, strings.HasSuffix(long, ":42"))
+	assert.NotEqual(t, long, memcachedKey(strings.Repeat("b", 300), 42))
+
+	assert.NotContains(t, memcachedKey("rl:with space", 42), " ")
+}
+
+func TestRateLimiterWithMemcachedStore(t *testing.T) {
+	server := newFakeMemcachedServer(t)
+	config := &RateLimiterConfig{
+		Window:         time.Minute,
+		MaxRequests:    2,
+		Methods:        []string{"/test.Service/TestMethod"},
+		MemcachedAddrs: []string{server.Addr()},
+	}
+	clock := &testClock{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	replica1, err := NewRateLimiter(config, WithClock(clock))
+	require.NoError(t, err)
+	replica2, err := NewRateLimiter(config, WithClock(clock))
+	require.NoError(t, err)
+
+	handler := func(_ context.Context, _ any) (any, error) {
+		return "ok", nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/TestMethod"}
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.New(map[string]string{
+		"x-forwarded-for": "1.2.3.4",
+	}))
+
+	_, err = replica1.UnaryServerInterceptor()(ctx, "request", info, handler)
+	require.NoError(t, err)
+	_, err = replica2.UnaryServerInterceptor()(ctx, "request", info, handler)
+	require.NoError(t, err)
+
+	// The budget is shared, so the third request is rejected by either replica.
+	_, err = replica1.UnaryServerInterceptor()(ctx, "request", info, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestRateLimiterWithMemcachedStoreLimitChange(t *testing.T) {
+	server := newFakeMemcachedServer(t)
+	config := &RateLimiterConfig{
+		Window:         time.Minute,
+		MaxRequests:    2,
+		Methods:        []string{"/test.Service/TestMethod"},
+		MemcachedAddrs: []string{server.Addr()},
+	}
+	clock := &testClock{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	knobValues := map[string]float64{}
+	rateLimiter, err := NewRateLimiter(config, WithClock(clock), WithKnobs(knobs.NewFixedKnobs(knobValues)))
+	require.NoError(t, err)
+
+	store, err := rateLimiter.storeFor("/test.Service/TestMethod")
+	require.NoError(t, err)
+	knobValues[knobs.KnobRateLimitLimit+"@/test.Service/TestMethod"] = 5
+	updatedStore, err := rateLimiter.storeFor("/test.Service/TestMethod")
+	require.NoError(t, err)
+
+	// The shared store is updated in place rather than replaced.
+	assert.Same(t, store, updatedStore)
+	assert.Equal(t, rateLimit{tokens: 5, interval: time.Minute}, rateLimiter.stores["/test.Service/TestMethod"].limit)
+}
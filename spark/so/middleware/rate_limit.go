@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
+	"github.com/lightsparkdev/spark/so/authn"
 	"github.com/lightsparkdev/spark/so/knobs"
 	"github.com/sethvargo/go-limiter"
 	"github.com/sethvargo/go-limiter/memorystore"
@@ -42,6 +44,11 @@ type RateLimiterConfig struct {
 	MaxRequests         int
 	Methods             []string
 	XffClientIpPosition int
+	// MemcachedAddrs are the cache servers that hold the counters shared by all replicas. If
+	// empty, each replica keeps its own counters in memory.
+	MemcachedAddrs []string
+	// FailOpen allows requests through when the shared cache cannot be reached.
+	FailOpen bool
 }
 
 type RateLimiterConfigProvider interface {
@@ -50,9 +57,27 @@ type RateLimiterConfigProvider interface {
 
 type RateLimiter struct {
 	config *RateLimiterConfig
-	store  MemoryStore
-	clock  Clock
-	knobs  knobs.Knobs
+	// store, if set, is used for every method regardless of its limit.
+	store MemoryStore
+	clock Clock
+	knobs knobs.Knobs
+
+	mu sync.Mutex
+	// stores holds the store of each rate limited method. There is one store per method so that the
+	// number of stores does not grow as limits are changed.
+	stores map[string]*methodStore
+}
+
+// methodStore is the store of a rate limited method and the limit it enforces.
+type methodStore struct {
+	limit rateLimit
+	store MemoryStore
+}
+
+// rateLimit is the number of requests allowed per key in each interval.
+type rateLimit struct {
+	tokens   uint64
+	interval time.Duration
 }
 
 type RateLimiterOption func(*RateLimiter)
@@ -108,68 +133,165 @@ func NewRateLimiter(configOrProvider any, opts ...RateLimiterOption) (*RateLimit
 		config: config,
 		clock:  &realClock{},
 		knobs:  nil,
+		stores: make(map[string]*methodStore),
 	}
 
 	for _, opt := range opts {
 		opt(rateLimiter)
 	}
 
-	interval := config.Window
-	maxRequests := uint64(config.MaxRequests)
+	// Create a store for the default limit up front so that configuration errors surface here.
+	if rateLimiter.store == nil {
+		if _, err := rateLimiter.newStore(rateLimiter.defaultLimit()); err != nil {
+			return nil, err
+		}
+	}
+
+	return rateLimiter, nil
+}
+
+// defaultLimit returns the limit for methods without their own limit.
+func (r *RateLimiter) defaultLimit() rateLimit {
+	limit := rateLimit{tokens: uint64(r.config.MaxRequests), interval: r.config.Window}
 	// Knob values should not be set to negative values—they will be cast to uint64.
-	if rateLimiter.knobs != nil {
-		interval = time.Duration(uint64(rateLimiter.knobs.GetValue(knobs.KnobRateLimitPeriod, float64(config.Window)))) * time.Second
-		maxRequests = uint64(rateLimiter.knobs.GetValue(knobs.KnobRateLimitLimit, float64(config.MaxRequests)))
+	if r.knobs != nil {
+		limit.interval = time.Duration(uint64(r.knobs.GetValue(knobs.KnobRateLimitPeriod, limit.interval.Seconds()))) * time.Second
+		limit.tokens = uint64(r.knobs.GetValue(knobs.KnobRateLimitLimit, float64(limit.tokens)))
 	}
+	return limit
+}
 
-	if rateLimiter.store == nil {
-		defaultStore, err := memorystore.New(&memorystore.Config{
-			Tokens:   maxRequests,
-			Interval: interval,
-		})
-		if err != nil {
-			return nil, err
+// limitFor returns the limit for the given method. The period and limit knobs can target a method
+// to give it a limit of its own, so that expensive methods can be limited more tightly than cheap
+// ones.
+func (r *RateLimiter) limitFor(method string) rateLimit {
+	limit := r.defaultLimit()
+	if r.knobs != nil {
+		limit.interval = time.Duration(uint64(r.knobs.GetValueTarget(knobs.KnobRateLimitPeriod, &method, limit.interval.Seconds()))) * time.Second
+		limit.tokens = uint64(r.knobs.GetValueTarget(knobs.KnobRateLimitLimit, &method, float64(limit.tokens)))
+	}
+	return limit
+}
+
+// storeFor returns the store that enforces the current limit of the given method, creating it on
+// first use. When the method's limit changes, a memcached store is updated in place so that the
+// shared counters carry over, and an in-memory store is replaced.
+func (r *RateLimiter) storeFor(method string) (MemoryStore, error) {
+	if r.store != nil {
+		return r.store, nil
+	}
+
+	limit := r.limitFor(method)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.stores[method]
+	if ok && existing.limit == limit {
+		return existing.store, nil
+	}
+	if ok {
+		if memcachedStore, isMemcached := existing.store.(*MemcachedStore); isMemcached {
+			if err := memcachedStore.SetLimit(limit.tokens, limit.interval); err != nil {
+				return nil, err
+			}
+			existing.limit = limit
+			return memcachedStore, nil
 		}
+	}
 
-		rateLimiter.store = &realMemoryStore{store: defaultStore}
+	store, err := r.newStore(limit)
+	if err != nil {
+		return nil, err
 	}
+	r.stores[method] = &methodStore{limit: limit, store: store}
+	return store, nil
+}
 
-	return rateLimiter, nil
+// newStore creates a store that enforces the given limit.
+func (r *RateLimiter) newStore(limit rateLimit) (MemoryStore, error) {
+	if len(r.config.MemcachedAddrs) > 0 {
+		return NewMemcachedStore(&MemcachedStoreConfig{
+			Addrs:    r.config.MemcachedAddrs,
+			Tokens:   limit.tokens,
+			Interval: limit.interval,
+			FailOpen: r.config.FailOpen,
+			Clock:    r.clock,
+		})
+	}
+	defaultStore, err := memorystore.New(&memorystore.Config{
+		Tokens:   limit.tokens,
+		Interval: limit.interval,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &realMemoryStore{store: defaultStore}, nil
 }
 
 func (r *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		shouldLimit := slices.Contains(r.config.Methods, info.FullMethod)
-		if r.knobs != nil {
-			// A value of > 0 means to enforce rate limiting for the given method.
-			// A value of 0 means to not enforce the limit for the given method.
-			// Any other value means use the default configuration.
-			methodLimitEnabled := int(r.knobs.GetValueTarget(knobs.KnobRateLimitMethods, &info.FullMethod, -1))
-			if methodLimitEnabled > 0 {
-				shouldLimit = true
-			} else if methodLimitEnabled == 0 {
-				shouldLimit = false
-			}
+		if err := r.take(ctx, info.FullMethod); err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		if !shouldLimit {
-			return handler(ctx, req)
+// StreamServerInterceptor limits the rate at which streams are opened. Messages sent on an open
+// stream are not counted.
+func (r *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := r.take(ss.Context(), info.FullMethod); err != nil {
+			return err
 		}
+		return handler(srv, ss)
+	}
+}
 
-		ip, err := GetClientIpFromHeader(ctx, r.config.XffClientIpPosition)
-		if err != nil {
-			return handler(ctx, req)
+// take counts a call to the method against its limit, returning an error if the method is rate
+// limited and the limit has been reached.
+func (r *RateLimiter) take(ctx context.Context, method string) error {
+	shouldLimit := slices.Contains(r.config.Methods, method)
+	if r.knobs != nil {
+		// A value of > 0 means to enforce rate limiting for the given method.
+		// A value of 0 means to not enforce the limit for the given method.
+		// Any other value means use the default configuration.
+		methodLimitEnabled := int(r.knobs.GetValueTarget(knobs.KnobRateLimitMethods, &method, -1))
+		if methodLimitEnabled > 0 {
+			shouldLimit = true
+		} else if methodLimitEnabled == 0 {
+			shouldLimit = false
 		}
+	}
+
+	if !shouldLimit {
+		return nil
+	}
+
+	// Requests are counted against both the client IP and, if the request is authenticated, the
+	// session identity. Each method has its own buckets.
+	var rateLimitKeys []string
+	if ip, err := GetClientIpFromHeader(ctx, r.config.XffClientIpPosition); err == nil {
+		rateLimitKeys = append(rateLimitKeys, sanitizeKey(fmt.Sprintf("rl:%s:%s", method, ip)))
+	}
+	if session, err := authn.GetSessionFromContext(ctx); err == nil && session != nil {
+		rateLimitKeys = append(rateLimitKeys, sanitizeKey(fmt.Sprintf("rl:%s:identity:%x", method, session.IdentityPublicKeyBytes())))
+	}
+	if len(rateLimitKeys) == 0 {
+		return nil
+	}
 
-		key := sanitizeKey(fmt.Sprintf("rl:%s:%s", info.FullMethod, ip))
-		_, _, _, ok, err := r.store.Take(ctx, key)
+	store, err := r.storeFor(method)
+	if err != nil {
+		return status.Errorf(codes.Internal, "rate limit error: %v", err)
+	}
+	for _, key := range rateLimitKeys {
+		_, _, _, ok, err := store.Take(ctx, key)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "rate limit error: %v", err)
+			return status.Errorf(codes.Internal, "rate limit error: %v", err)
 		}
 		if !ok {
-			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
+			return status.Errorf(codes.ResourceExhausted, "rate limit exceeded")
 		}
-
-		return handler(ctx, req)
 	}
+	return nil
 }
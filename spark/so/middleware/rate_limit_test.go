@@ -2,9 +2,13 @@ package middleware
 
 import (
 	"context"
+	"math/rand/v2"
 	"testing"
 	"time"
 
+	"github.com/lightsparkdev/spark/common/keys"
+	"github.com/lightsparkdev/spark/so/authn"
+	"github.com/lightsparkdev/spark/so/authninternal"
 	"github.com/lightsparkdev/spark/so/knobs"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -380,4 +384,177 @@ func TestRateLimiter(t *testing.T) {
 			})
 		}
 	})
+	t.Run("per-method limits from knob targets", func(t *testing.T) {
+		config := &RateLimiterConfig{
+			Window:      time.Minute,
+			MaxRequests: 2,
+			Methods:     []string{"/test.Service/Expensive", "/test.Service/Cheap"},
+		}
+		mockKnobs := knobs.NewFixedKnobs(map[string]float64{
+			knobs.KnobRateLimitLimit + "@/test.Service/Expensive": 1,
+		})
+		rateLimiter, err := NewRateLimiter(config, WithKnobs(mockKnobs))
+		require.NoError(t, err)
+
+		interceptor := rateLimiter.UnaryServerInterceptor()
+		handler := func(_ context.Context, _ any) (any, error) {
+			return "ok", nil
+		}
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.New(map[string]string{
+			"x-forwarded-for": "1.2.3.4",
+		}))
+		expensive := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Expensive"}
+		cheap := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Cheap"}
+
+		_, err = interceptor(ctx, "request", expensive, handler)
+		require.NoError(t, err)
+		_, err = interceptor(ctx, "request", expensive, handler)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		// The cheap method has its own bucket with the default limit.
+		for range 2 {
+			_, err = interceptor(ctx, "request", cheap, handler)
+			require.NoError(t, err)
+		}
+		_, err = interceptor(ctx, "request", cheap, handler)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("authenticated identity limited across IPs", func(t *testing.T) {
+		rateLimiter, err := NewRateLimiter(config)
+		require.NoError(t, err)
+
+		identityKey := keys.MustGeneratePrivateKeyFromRand(rand.NewChaCha8([32]byte{}))
+		tokenVerifier, err := authninternal.NewSessionTokenCreatorVerifier(identityKey, authninternal.RealClock{})
+		require.NoError(t, err)
+		tokenResult, err := tokenVerifier.CreateToken(identityKey.Public().Serialize(), time.Hour)
+		require.NoError(t, err)
+		authenticate := func(ip string) context.Context {
+			ctx := metadata.NewIncomingContext(t.Context(), metadata.New(map[string]string{
+				"x-forwarded-for": ip,
+				"authorization":   "Bearer " + tokenResult.Token,
+			}))
+			var authenticatedCtx context.Context
+			_, err := authn.NewInterceptor(tokenVerifier).AuthnInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				authenticatedCtx = ctx
+				return nil, nil
+			})
+			require.NoError(t, err)
+			return authenticatedCtx
+		}
+
+		interceptor := rateLimiter.UnaryServerInterceptor()
+		handler := func(_ context.Context, _ any) (any, error) {
+			return "ok", nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/TestMethod"}
+
+		_, err = interceptor(authenticate("1.2.3.4"), "request", info, handler)
+		require.NoError(t, err)
+		_, err = interceptor(authenticate("5.6.7.8"), "request", info, handler)
+		require.NoError(t, err)
+
+		// Each IP has budget left, but the identity does not.
+		_, err = interceptor(authenticate("9.10.11.12"), "request", info, handler)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+// testServerStream is a server stream that only carries a context.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestRateLimiterStreamServerInterceptor(t *testing.T) {
+	config := &RateLimiterConfig{
+		Window:      time.Minute,
+		MaxRequests: 2,
+		Methods:     []string{"/test.Service/Subscribe"},
+	}
+	rateLimiter, err := NewRateLimiter(config)
+	require.NoError(t, err)
+
+	interceptor := rateLimiter.StreamServerInterceptor()
+	handler := func(_ any, _ grpc.ServerStream) error {
+		return nil
+	}
+	stream := &testServerStream{ctx: metadata.NewIncomingContext(t.Context(), metadata.New(map[string]string{
+		"x-forwarded-for": "1.2.3.4",
+	}))}
+	info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Subscribe", IsServerStream: true}
+
+	for range 2 {
+		require.NoError(t, interceptor(nil, stream, info, handler))
+	}
+	err = interceptor(nil, stream, info, handler)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Streams of methods that are not rate limited are not counted.
+	notLimited := &grpc.StreamServerInfo{FullMethod: "/test.Service/NotLimited", IsServerStream: true}
+	for range 5 {
+		require.NoError(t, interceptor(nil, stream, notLimited, handler))
+	}
+}
+
+func TestRateLimiterStoreFor(t *testing.T) {
+	config := &RateLimiterConfig{
+		Window:      time.Minute,
+		MaxRequests: 2,
+		Methods:     []string{"/test.Service/TestMethod"},
+	}
+	knobValues := map[string]float64{}
+	rateLimiter, err := NewRateLimiter(config, WithKnobs(knobs.NewFixedKnobs(knobValues)))
+	require.NoError(t, err)
+
+	store, err := rateLimiter.storeFor("/test.Service/TestMethod")
+	require.NoError(t, err)
+	sameStore, err := rateLimiter.storeFor("/test.Service/TestMethod")
+	require.NoError(t, err)
+	assert.Same(t, store, sameStore)
+
+	// Changing the method's limit replaces its store instead of adding one per limit.
+	for limit := range 5 {
+		knobValues[knobs.KnobRateLimitLimit+"@/test.Service/TestMethod"] = float64(limit + 1)
+		_, err := rateLimiter.storeFor("/test.Service/TestMethod")
+		require.NoError(t, err)
+	}
+	assert.Len(t, rateLimiter.stores, 1)
+	assert.Equal(t, rateLimit{tokens: 5, interval: time.Minute}, rateLimiter.stores["/test.Service/TestMethod"].limit)
+}
+
+func TestRateLimiterLimitFor(t *testing.T) {
+	config := &RateLimiterConfig{
+		Window:      time.Second,
+		MaxRequests: 2,
+	}
+
+	t.Run("without knobs", func(t *testing.T) {
+		rateLimiter, err := NewRateLimiter(config)
+		require.NoError(t, err)
+		assert.Equal(t, rateLimit{tokens: 2, interval: time.Second}, rateLimiter.limitFor("/test.Service/TestMethod"))
+	})
+
+	t.Run("knobs override defaults and targets override knobs", func(t *testing.T) {
+		mockKnobs := knobs.NewFixedKnobs(map[string]float64{
+			knobs.KnobRateLimitPeriod:                           10,
+			knobs.KnobRateLimitLimit:                            5,
+			knobs.KnobRateLimitPeriod + "@/test.Service/Period": 60,
+			knobs.KnobRateLimitLimit + "@/test.Service/Limit":   1,
+		})
+		rateLimiter, err := NewRateLimiter(config, WithKnobs(mockKnobs))
+		require.NoError(t, err)
+
+		assert.Equal(t, rateLimit{tokens: 5, interval: 10 * time.Second}, rateLimiter.limitFor("/test.Service/TestMethod"))
+		assert.Equal(t, rateLimit{tokens: 5, interval: time.Minute}, rateLimiter.limitFor("/test.Service/Period"))
+		assert.Equal(t, rateLimit{tokens: 1, interval: 10 * time.Second}, rateLimiter.limitFor("/test.Service/Limit"))
+	})
 }
@@ -40,6 +40,7 @@ func SparkStartTokenTransactionInternalRequestFromTokenProto(prepareReq *tokenin
 		TokenTransactionSignatures: tokenTransactionSignatures,
 		KeyshareIds:                prepareReq.KeyshareIds,
 		CoordinatorPublicKey:       prepareReq.CoordinatorPublicKey,
+		SparkPaymentIntent:         prepareReq.SparkPaymentIntent,
 	}
 
 	return startReq, nil
@@ -72,6 +73,7 @@ func TokenProtoPrepareTransactionRequestFromSpark(startReq *internalpb.StartToke
 		TokenTransactionSignatures: tokenTransactionSignatures,
 		KeyshareIds:                startReq.KeyshareIds,
 		CoordinatorPublicKey:       startReq.CoordinatorPublicKey,
+		SparkPaymentIntent:         startReq.SparkPaymentIntent,
 	}
 
 	return prepareReq, nil
@@ -30,6 +30,7 @@ func SparkQueryTokenOutputsResponseFromTokenProto(resp *tokenpb.QueryTokenOutput
 				TokenPublicKey:                tokenOutput.Output.TokenPublicKey,
 				TokenAmount:                   tokenOutput.Output.TokenAmount,
 				TokenIdentifier:               tokenOutput.Output.TokenIdentifier,
+				SpendableAfter:                tokenOutput.Output.SpendableAfter,
 			},
 			PreviousTransactionHash: tokenOutput.PreviousTransactionHash,
 			PreviousTransactionVout: tokenOutput.PreviousTransactionVout,
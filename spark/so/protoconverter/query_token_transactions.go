@@ -82,6 +82,26 @@ func ConvertTokenTransactionStatusToTokenPb(status st.TokenTransactionStatus) to
 	}
 }
 
+// ConvertTokenTransactionStatusFromTokenPb converts from tokenpb.TokenTransactionStatus to st.TokenTransactionStatus
+func ConvertTokenTransactionStatusFromTokenPb(status tokenpb.TokenTransactionStatus) (st.TokenTransactionStatus, error) {
+	switch status {
+	case tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_STARTED:
+		return st.TokenTransactionStatusStarted, nil
+	case tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_STARTED_CANCELLED:
+		return st.TokenTransactionStatusStartedCancelled, nil
+	case tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_SIGNED:
+		return st.TokenTransactionStatusSigned, nil
+	case tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_SIGNED_CANCELLED:
+		return st.TokenTransactionStatusSignedCancelled, nil
+	case tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_REVEALED:
+		return st.TokenTransactionStatusRevealed, nil
+	case tokenpb.TokenTransactionStatus_TOKEN_TRANSACTION_FINALIZED:
+		return st.TokenTransactionStatusFinalized, nil
+	default:
+		return "", fmt.Errorf("unknown token transaction status: %s", status)
+	}
+}
+
 // ConvertTokenTransactionStatusToSparkPb converts from tokenpb.TokenTransactionStatus to sparkpb.TokenTransactionStatus
 func ConvertTokenTransactionStatusToSparkPb(status tokenpb.TokenTransactionStatus) sparkpb.TokenTransactionStatus {
 	switch status {
@@ -36,8 +36,7 @@ func SparkStartTokenTransactionRequestFromTokenProto(startReq *tokenpb.StartTran
 		IdentityPublicKey:          startReq.IdentityPublicKey,
 		PartialTokenTransaction:    sparkTokenTransaction,
 		TokenTransactionSignatures: tokenTransactionSignatures,
-		// Note: SparkPaymentIntent is not available in StartTransactionRequest
-		// so it will be empty when converting from StartTransactionRequest
+		SparkPaymentIntent:         startReq.SparkPaymentIntent,
 		// Note: ValidityDurationSeconds is not available in StartTokenTransactionRequest
 		// so it will be lost when converting from StartTransactionRequest
 	}
@@ -72,8 +71,7 @@ func TokenProtoStartTransactionRequestFromSpark(sparkStartReq *sparkpb.StartToke
 		PartialTokenTransaction:                tokenTransaction,
 		PartialTokenTransactionOwnerSignatures: partialTokenTransactionOwnerSignatures,
 		ValidityDurationSeconds:                uint64(validityDuration.Seconds()),
-		// Note: SparkPaymentIntent is not available in StartTransactionRequest
-		// so it will be lost when converting from StartTokenTransactionRequest
+		SparkPaymentIntent:                     sparkStartReq.SparkPaymentIntent,
 	}
 
 	return startReq, nil
@@ -26,6 +26,7 @@ func SparkTokenTransactionFromTokenProto(tokenTx *tokenpb.TokenTransaction) (*pb
 			TokenPublicKey:                o.TokenPublicKey,
 			TokenIdentifier:               o.TokenIdentifier,
 			TokenAmount:                   o.TokenAmount,
+			SpendableAfter:                o.SpendableAfter,
 		}
 	}
 
@@ -83,6 +84,8 @@ func SparkTokenTransactionFromTokenProto(tokenTx *tokenpb.TokenTransaction) (*pb
 				OutputsToSpend: outputsToSpend,
 			},
 		}
+	case *tokenpb.TokenTransaction_BurnInput:
+		return nil, fmt.Errorf("burn_input has no spark token transaction equivalent")
 	default:
 		return nil, fmt.Errorf("unknown token_inputs type")
 	}
@@ -107,6 +110,7 @@ func TokenProtoFromSparkTokenTransaction(sparkTx *pb.TokenTransaction) (*tokenpb
 			TokenPublicKey:                o.TokenPublicKey,
 			TokenIdentifier:               o.TokenIdentifier,
 			TokenAmount:                   o.TokenAmount,
+			SpendableAfter:                o.SpendableAfter,
 		}
 	}
 
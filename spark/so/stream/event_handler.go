@@ -1,17 +1,36 @@
 package events
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/lightsparkdev/spark/common/keys"
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
+	"github.com/lightsparkdev/spark/so/ent/usereventsequence"
 
 	pb "github.com/lightsparkdev/spark/proto/spark"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// EventRetention is how long recorded events are kept for clients to resume from.
+const EventRetention = 30 * 24 * time.Hour
+
+// replayBatchSize is the number of recorded events loaded from the database at a time when
+// replaying events to a subscriber.
+const replayBatchSize = 100
+
+// subscriberQueueSize is the number of events that can be queued for a subscriber before it is
+// considered too slow and dropped.
+const subscriberQueueSize = 256
+
 var (
 	defaultRouter *EventRouter
 	routerOnce    sync.Once
@@ -25,58 +44,261 @@ func GetDefaultRouter() *EventRouter {
 }
 
 type EventRouter struct {
-	streams sync.Map
-	mutexes sync.Map
+	mu          sync.Mutex
+	subscribers map[keys.Public]map[*subscriber]struct{}
+	// pending holds the events notified as part of each open transaction, in notification order.
+	pending map[*ent.Tx][]pendingEvent
+}
+
+type pendingEvent struct {
+	identityPublicKey keys.Public
+	message           *pb.SubscribeToEventsResponse
 }
 
 func NewEventRouter() *EventRouter {
 	return &EventRouter{
-		streams: sync.Map{},
-		mutexes: sync.Map{},
+		subscribers: make(map[keys.Public]map[*subscriber]struct{}),
+		pending:     make(map[*ent.Tx][]pendingEvent),
 	}
 }
 
-func (s *EventRouter) RegisterStream(identityPublicKey keys.Public, stream pb.SparkService_SubscribeToEventsServer) error {
-	mutex, _ := s.mutexes.LoadOrStore(identityPublicKey, &sync.Mutex{})
-	mutex.(*sync.Mutex).Lock()
-	defer mutex.(*sync.Mutex).Unlock()
+// subscriber is a stream subscribed to the events of an identity. Events are queued for the
+// subscriber and sent by its own goroutine, so a slow stream does not hold up notifications for
+// other subscribers. A subscriber whose queue fills up is dropped, and its client is expected to
+// resubscribe with the last sequence number it received.
+//
+// The subscriber tracks the sequence number of the last recorded event sent on the stream so that
+// every event is delivered exactly once and in order, backfilling from the event log when a live
+// event arrives ahead of its predecessors.
+type subscriber struct {
+	stream            pb.SparkService_SubscribeToEventsServer
+	dbClient          *ent.Client
+	identityPublicKey keys.Public
+
+	queue    chan *pb.SubscribeToEventsResponse
+	dropped  chan struct{}
+	dropOnce sync.Once
 
-	s.streams.Store(identityPublicKey, stream)
+	lastSequence uint64
+}
+
+func newSubscriber(dbClient *ent.Client, identityPublicKey keys.Public, stream pb.SparkService_SubscribeToEventsServer) *subscriber {
+	return &subscriber{
+		stream:            stream,
+		dbClient:          dbClient,
+		identityPublicKey: identityPublicKey,
+		queue:             make(chan *pb.SubscribeToEventsResponse, subscriberQueueSize),
+		dropped:           make(chan struct{}),
+	}
+}
+
+// RegisterStream adds a live-only subscription for the identity that lasts until the stream's
+// context is done.
+func (s *EventRouter) RegisterStream(identityPublicKey keys.Public, stream pb.SparkService_SubscribeToEventsServer) error {
+	sub := newSubscriber(nil, identityPublicKey, stream)
+	if err := s.registerSubscriber(sub); err != nil {
+		return err
+	}
 	go func() {
-		<-stream.Context().Done()
-		if mutex, ok := s.mutexes.Load(identityPublicKey); ok {
-			mutex.(*sync.Mutex).Lock()
-			defer mutex.(*sync.Mutex).Unlock()
-
-			if current, ok := s.streams.Load(identityPublicKey); ok {
-				if current.(pb.SparkService_SubscribeToEventsServer) == stream {
-					s.streams.Delete(identityPublicKey)
-					s.mutexes.Delete(identityPublicKey)
+		defer s.removeSubscriber(sub)
+		_ = sub.run(stream.Context())
+	}()
+
+	return nil
+}
+
+func (s *EventRouter) registerSubscriber(sub *subscriber) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	subscribers, ok := s.subscribers[sub.identityPublicKey]
+	if !ok {
+		subscribers = make(map[*subscriber]struct{})
+		s.subscribers[sub.identityPublicKey] = subscribers
+	}
+	subscribers[sub] = struct{}{}
+
+	return nil
+}
+
+func (s *EventRouter) removeSubscriber(sub *subscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.removeSubscriberLocked(sub)
+}
+
+// removeSubscriberLocked removes the subscriber from its identity's subscriptions. The caller
+// must hold s.mu.
+func (s *EventRouter) removeSubscriberLocked(sub *subscriber) {
+	subscribers, ok := s.subscribers[sub.identityPublicKey]
+	if !ok {
+		return
+	}
+	delete(subscribers, sub)
+	if len(subscribers) == 0 {
+		delete(s.subscribers, sub.identityPublicKey)
+	}
+}
+
+// NotifyUser records the event in the identity's event log once dbTx commits and delivers it to
+// every subscriber of the identity; if dbTx is rolled back the event is discarded. Events notified
+// as part of the same transaction are recorded in the order they were notified. Each event is
+// recorded in its own transaction, so the business transaction neither waits on the identity's
+// sequence row nor fails if the event cannot be recorded.
+func (s *EventRouter) NotifyUser(dbTx *ent.Tx, identityPublicKey keys.Public, message *pb.SubscribeToEventsResponse) {
+	s.mu.Lock()
+	pending, registered := s.pending[dbTx]
+	s.pending[dbTx] = append(pending, pendingEvent{identityPublicKey: identityPublicKey, message: message})
+	s.mu.Unlock()
+	if registered {
+		return
+	}
+
+	dbTx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			err := next.Commit(ctx, tx)
+			pending := s.takePending(dbTx)
+			if err != nil {
+				return err
+			}
+			for _, event := range pending {
+				if err := recordEvent(ctx, tx.ParentClient(), event.identityPublicKey, event.message); err != nil {
+					logger := logging.GetLoggerFromContext(ctx)
+					logger.Error("failed to record event", "error", err, "identity_public_key", event.identityPublicKey)
+					event.message.Sequence = 0
 				}
+				s.deliver(ctx, event.identityPublicKey, event.message)
 			}
-		}
-	}()
+			return nil
+		})
+	})
+	dbTx.OnRollback(func(next ent.Rollbacker) ent.Rollbacker {
+		return ent.RollbackFunc(func(ctx context.Context, tx *ent.Tx) error {
+			s.takePending(dbTx)
+			return next.Rollback(ctx, tx)
+		})
+	})
+}
+
+// takePending removes and returns the events notified as part of dbTx.
+func (s *EventRouter) takePending(dbTx *ent.Tx) []pendingEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
+	pending := s.pending[dbTx]
+	delete(s.pending, dbTx)
+	return pending
+}
+
+// recordEvent assigns the event the next sequence number in the identity's event log and records
+// it. The sequence row stays locked only until the event is recorded, so events become visible in
+// sequence order.
+func recordEvent(ctx context.Context, dbClient *ent.Client, identityPublicKey keys.Public, message *pb.SubscribeToEventsResponse) error {
+	dbTx, err := dbClient.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction: %w", err)
+	}
+	sequence, err := nextSequence(ctx, dbTx, identityPublicKey)
+	if err != nil {
+		return errors.Join(err, dbTx.Rollback())
+	}
+	message.Sequence = sequence
+
+	event, err := proto.Marshal(message)
+	if err != nil {
+		return errors.Join(fmt.Errorf("unable to marshal event: %w", err), dbTx.Rollback())
+	}
+	_, err = dbTx.UserEvent.Create().
+		SetIdentityPublicKey(identityPublicKey.Serialize()).
+		SetSequence(sequence).
+		SetEvent(event).
+		Save(ctx)
+	if err != nil {
+		return errors.Join(fmt.Errorf("unable to record event: %w", err), dbTx.Rollback())
+	}
+	if err := dbTx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit event: %w", err)
+	}
 	return nil
 }
 
-func (s *EventRouter) NotifyUser(identityPublicKey keys.Public, message *pb.SubscribeToEventsResponse) error {
-	mutex, ok := s.mutexes.Load(identityPublicKey)
-	if !ok || mutex == nil {
-		return nil
+// nextSequence allocates the next sequence number in the identity's event log. The row lock taken
+// by the upsert serializes concurrent writers for the same identity until dbTx completes.
+func nextSequence(ctx context.Context, dbTx *ent.Tx, identityPublicKey keys.Public) (uint64, error) {
+	err := dbTx.UserEventSequence.Create().
+		SetIdentityPublicKey(identityPublicKey.Serialize()).
+		SetLastSequence(1).
+		OnConflictColumns(usereventsequence.FieldIdentityPublicKey).
+		Update(func(u *ent.UserEventSequenceUpsert) {
+			u.AddLastSequence(1).UpdateUpdateTime()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to allocate event sequence: %w", err)
+	}
+	eventSequence, err := dbTx.UserEventSequence.Query().
+		Where(usereventsequence.IdentityPublicKey(identityPublicKey.Serialize())).
+		Only(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to load event sequence: %w", err)
 	}
-	mutex.(*sync.Mutex).Lock()
-	defer mutex.(*sync.Mutex).Unlock()
+	return eventSequence.LastSequence, nil
+}
 
-	if currentStream, ok := s.streams.Load(identityPublicKey); ok {
-		if err := currentStream.(pb.SparkService_SubscribeToEventsServer).Send(message); err != nil {
-			s.streams.Delete(identityPublicKey)
-			s.mutexes.Delete(identityPublicKey)
+// deliver queues the message for every subscriber of the identity. It never blocks on a
+// subscriber: one whose queue is full is dropped instead.
+func (s *EventRouter) deliver(ctx context.Context, identityPublicKey keys.Public, message *pb.SubscribeToEventsResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for sub := range s.subscribers[identityPublicKey] {
+		select {
+		case sub.queue <- message:
+		default:
+			logger := logging.GetLoggerFromContext(ctx)
+			logger.Warn("Dropping event subscriber with a full queue", "identity_public_key", identityPublicKey)
+			s.removeSubscriberLocked(sub)
+			sub.drop()
+		}
+	}
+}
+
+// drop signals the subscriber's goroutine to end the stream.
+func (s *subscriber) drop() {
+	s.dropOnce.Do(func() {
+		close(s.dropped)
+	})
+}
+
+func (s *subscriber) droppedError() error {
+	return status.Errorf(codes.ResourceExhausted, "event subscriber fell behind, resubscribe with resume_from_sequence %d", s.lastSequence)
+}
+
+// run sends queued messages on the stream until the context is done or the subscriber is
+// dropped. A drop takes effect once any send in progress returns.
+func (s *subscriber) run(ctx context.Context) error {
+	for {
+		select {
+		case <-s.dropped:
+			return s.droppedError()
+		default:
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.dropped:
+			return s.droppedError()
+		case message := <-s.queue:
+			if err := s.send(ctx, message); err != nil {
+				if isStreamClosedError(err) {
+					return nil
+				}
 
-			if !isStreamClosedError(err) {
 				network := "unknown"
 				address := "unknown"
-				if ctxPeer, ok := peer.FromContext(currentStream.(pb.SparkService_SubscribeToEventsServer).Context()); ok {
+				if ctxPeer, ok := peer.FromContext(s.stream.Context()); ok {
 					network = ctxPeer.Addr.Network()
 					address = ctxPeer.Addr.String()
 				}
@@ -85,28 +307,146 @@ func (s *EventRouter) NotifyUser(identityPublicKey keys.Public, message *pb.Subs
 			}
 		}
 	}
+}
 
+// send sends the message on the subscriber's stream. Recorded events that were already sent are
+// skipped, and any recorded events missing between the last one sent and this one are replayed
+// first.
+func (s *subscriber) send(ctx context.Context, message *pb.SubscribeToEventsResponse) error {
+	sequence := message.GetSequence()
+	if sequence == 0 {
+		return s.stream.Send(message)
+	}
+	if sequence <= s.lastSequence {
+		return nil
+	}
+	if sequence > s.lastSequence+1 && s.dbClient != nil {
+		if err := s.replay(ctx, sequence-1); err != nil {
+			return err
+		}
+	}
+	if err := s.stream.Send(message); err != nil {
+		return err
+	}
+	s.lastSequence = sequence
+	return nil
+}
+
+// replay sends the recorded events after the last one sent, up to and including untilSequence.
+func (s *subscriber) replay(ctx context.Context, untilSequence uint64) error {
+	for s.lastSequence < untilSequence {
+		userEvents, err := s.dbClient.UserEvent.Query().
+			Where(
+				userevent.IdentityPublicKey(s.identityPublicKey.Serialize()),
+				userevent.SequenceGT(s.lastSequence),
+				userevent.SequenceLTE(untilSequence),
+			).
+			Order(ent.Asc(userevent.FieldSequence)).
+			Limit(replayBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to load events: %w", err)
+		}
+		for _, userEvent := range userEvents {
+			message := &pb.SubscribeToEventsResponse{}
+			if err := proto.Unmarshal(userEvent.Event, message); err != nil {
+				return fmt.Errorf("unable to unmarshal event %d: %w", userEvent.Sequence, err)
+			}
+			if err := s.stream.Send(message); err != nil {
+				return err
+			}
+			s.lastSequence = userEvent.Sequence
+		}
+		if len(userEvents) < replayBatchSize {
+			return nil
+		}
+	}
 	return nil
 }
 
-func SubscribeToEvents(identityPublicKey keys.Public, st pb.SparkService_SubscribeToEventsServer) error {
-	streamRouter := GetDefaultRouter()
-	if err := streamRouter.RegisterStream(identityPublicKey, st); err != nil {
+// lastRecordedSequence returns the sequence number of the most recent event recorded for the
+// identity, or 0 if none has been recorded.
+func lastRecordedSequence(ctx context.Context, dbClient *ent.Client, identityPublicKey keys.Public) (uint64, error) {
+	eventSequence, err := dbClient.UserEventSequence.Query().
+		Where(usereventsequence.IdentityPublicKey(identityPublicKey.Serialize())).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("unable to load event sequence: %w", err)
+	}
+	return eventSequence.LastSequence, nil
+}
+
+// SubscribeToEvents streams the identity's events on st until the client disconnects. If
+// resumeFromSequence is set, every retained event recorded after it is replayed first; otherwise
+// only events recorded after the subscription starts are sent.
+func SubscribeToEvents(dbClient *ent.Client, identityPublicKey keys.Public, resumeFromSequence *uint64, st pb.SparkService_SubscribeToEventsServer) error {
+	return GetDefaultRouter().SubscribeToEvents(dbClient, identityPublicKey, resumeFromSequence, st)
+}
+
+// SubscribeToEvents adds a subscription for the identity alongside any existing ones and streams
+// events on st until the client disconnects or falls too far behind.
+func (s *EventRouter) SubscribeToEvents(dbClient *ent.Client, identityPublicKey keys.Public, resumeFromSequence *uint64, st pb.SparkService_SubscribeToEventsServer) error {
+	ctx := st.Context()
+
+	// Register before replaying so that live events recorded during the replay are queued
+	// instead of missed. The subscriber skips any it already replayed.
+	sub := newSubscriber(dbClient, identityPublicKey, st)
+	if err := s.registerSubscriber(sub); err != nil {
+		return err
+	}
+	defer s.removeSubscriber(sub)
+
+	if err := sub.start(ctx, resumeFromSequence); err != nil {
+		if isStreamClosedError(err) || errors.Is(err, context.Canceled) {
+			return nil
+		}
 		return err
 	}
 
+	err := sub.run(ctx)
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+// start sends the connected event and replays recorded events the client has not received yet.
+func (s *subscriber) start(ctx context.Context, resumeFromSequence *uint64) error {
+	lastSequence, err := lastRecordedSequence(ctx, s.dbClient, s.identityPublicKey)
+	if err != nil {
+		return err
+	}
+	if resumeFromSequence != nil {
+		s.lastSequence = min(*resumeFromSequence, lastSequence)
+	} else {
+		s.lastSequence = lastSequence
+	}
+
 	connectedEvent := &pb.SubscribeToEventsResponse{
 		Event: &pb.SubscribeToEventsResponse_Connected{
 			Connected: &pb.ConnectedEvent{},
 		},
 	}
-
-	if err := streamRouter.NotifyUser(identityPublicKey, connectedEvent); err != nil {
+	if err := s.send(ctx, connectedEvent); err != nil {
 		return err
 	}
 
-	<-st.Context().Done()
-	return nil
+	return s.replay(ctx, lastSequence)
+}
+
+// DeleteExpiredEvents deletes recorded events older than EventRetention. The per-identity
+// sequence counters are kept so that sequence numbers are never reused.
+func DeleteExpiredEvents(ctx context.Context, dbTx *ent.Tx) (int, error) {
+	deleted, err := dbTx.UserEvent.Delete().
+		Where(userevent.CreateTimeLT(time.Now().Add(-EventRetention))).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to delete expired events: %w", err)
+	}
+	return deleted, nil
 }
 
 func isStreamClosedError(err error) bool {
@@ -2,18 +2,24 @@ package events
 
 import (
 	"context"
+	"fmt"
 	"math/rand/v2"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/lightsparkdev/spark/common/keys"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/userevent"
 
 	pb "github.com/lightsparkdev/spark/proto/spark"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type MockStream struct {
@@ -111,14 +117,14 @@ func TestEventRouterConcurrency(t *testing.T) {
 		go func() {
 			defer wg.Done()
 			msg := &pb.SubscribeToEventsResponse{}
-			_ = router.NotifyUser(identityKey, msg)
+			router.deliver(t.Context(), identityKey, msg)
 		}()
 	}
 
 	wg.Wait()
 }
 
-func TestEventRouterLastStreamWins(t *testing.T) {
+func TestEventRouterFanOut(t *testing.T) {
 	router := NewEventRouter()
 	rng := rand.NewChaCha8([32]byte{})
 	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
@@ -135,12 +141,370 @@ func TestEventRouterLastStreamWins(t *testing.T) {
 	require.NoError(t, err)
 
 	testMsg := &pb.SubscribeToEventsResponse{}
-	_ = router.NotifyUser(identityKey, testMsg)
+	router.deliver(t.Context(), identityKey, testMsg)
 
-	if len(stream1.messages) > 0 || len(stream2.messages) > 0 {
-		t.Error("Previous streams should not receive messages")
+	for _, stream := range []*MockStream{stream1, stream2, stream3} {
+		require.Eventually(t, func() bool {
+			return len(stream.Messages()) == 1
+		}, time.Second, time.Millisecond, "every stream should receive the message")
 	}
-	if len(stream3.messages) == 0 {
-		t.Error("Last stream should receive messages")
+}
+
+func TestEventRouterRemovesClosedStream(t *testing.T) {
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	closedStream := &MockStream{ctx: ctx}
+	openStream := NewMockStream(t)
+	require.NoError(t, router.RegisterStream(identityKey, closedStream))
+	require.NoError(t, router.RegisterStream(identityKey, openStream))
+
+	cancel()
+	require.Eventually(t, func() bool {
+		router.mu.Lock()
+		defer router.mu.Unlock()
+		return len(router.subscribers[identityKey]) == 1
+	}, time.Second, time.Millisecond)
+
+	router.deliver(t.Context(), identityKey, &pb.SubscribeToEventsResponse{})
+	require.Eventually(t, func() bool {
+		return len(openStream.Messages()) == 1
+	}, time.Second, time.Millisecond)
+	assert.Empty(t, closedStream.Messages())
+}
+
+// BlockingStream is a stream whose Send blocks until the stream is unblocked.
+type BlockingStream struct {
+	*MockStream
+	unblock chan struct{}
+}
+
+func (b *BlockingStream) Send(msg *pb.SubscribeToEventsResponse) error {
+	<-b.unblock
+	return b.MockStream.Send(msg)
+}
+
+func TestEventRouterDropsSlowSubscriber(t *testing.T) {
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	slowStream := &BlockingStream{MockStream: NewMockStream(t), unblock: make(chan struct{})}
+	slowSubscriber := newSubscriber(nil, identityKey, slowStream)
+	require.NoError(t, router.registerSubscriber(slowSubscriber))
+	slowDone := make(chan error, 1)
+	go func() {
+		slowDone <- slowSubscriber.run(t.Context())
+	}()
+
+	fastStream := NewMockStream(t)
+	require.NoError(t, router.RegisterStream(identityKey, fastStream))
+
+	// The slow subscriber takes one message off its queue and then blocks sending it, so its
+	// queue overflows on the last of these messages. The fast subscriber drains its queue in
+	// between so that it never overflows.
+	numDelivered := 0
+	for _, batch := range []int{subscriberQueueSize, 2} {
+		for range batch {
+			router.deliver(t.Context(), identityKey, &pb.SubscribeToEventsResponse{})
+		}
+		numDelivered += batch
+		require.Eventually(t, func() bool {
+			return len(fastStream.Messages()) == numDelivered
+		}, time.Second, time.Millisecond, "fast subscriber should receive every message")
+	}
+
+	// The stream ends once the send in progress returns.
+	close(slowStream.unblock)
+	select {
+	case err := <-slowDone:
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	case <-time.After(time.Second):
+		t.Fatal("slow subscriber was not dropped")
+	}
+	assert.Len(t, slowStream.Messages(), 1)
+
+	router.mu.Lock()
+	defer router.mu.Unlock()
+	assert.NotContains(t, router.subscribers[identityKey], slowSubscriber)
+}
+
+func (m *MockStream) Messages() []*pb.SubscribeToEventsResponse {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*pb.SubscribeToEventsResponse(nil), m.messages...)
+}
+
+func newTransferEvent(id string) *pb.SubscribeToEventsResponse {
+	return &pb.SubscribeToEventsResponse{
+		Event: &pb.SubscribeToEventsResponse_Transfer{
+			Transfer: &pb.TransferEvent{
+				Transfer: &pb.Transfer{Id: id},
+			},
+		},
+	}
+}
+
+func TestNotifyUserRecordsEventsInSequence(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+	otherIdentityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	var msgs []*pb.SubscribeToEventsResponse
+	for i := range 3 {
+		msg := newTransferEvent(fmt.Sprintf("transfer-%d", i))
+		router.NotifyUser(tx, identityKey, msg)
+		msgs = append(msgs, msg)
+	}
+	otherMsg := newTransferEvent("other")
+	router.NotifyUser(tx, otherIdentityKey, otherMsg)
+
+	// Events are only recorded once the transaction commits.
+	count, err := tx.UserEvent.Query().Count(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+	require.NoError(t, tx.Commit())
+
+	for i, msg := range msgs {
+		assert.Equal(t, uint64(i+1), msg.Sequence)
+	}
+	assert.Equal(t, uint64(1), otherMsg.Sequence)
+
+	userEvents, err := dbCtx.Client.UserEvent.Query().
+		Where(userevent.IdentityPublicKey(identityKey.Serialize())).
+		Order(ent.Asc(userevent.FieldSequence)).
+		All(ctx)
+	require.NoError(t, err)
+	require.Len(t, userEvents, 3)
+	for i, userEvent := range userEvents {
+		recorded := &pb.SubscribeToEventsResponse{}
+		require.NoError(t, proto.Unmarshal(userEvent.Event, recorded))
+		assert.Equal(t, uint64(i+1), recorded.Sequence)
+		assert.Equal(t, fmt.Sprintf("transfer-%d", i), recorded.GetTransfer().GetTransfer().GetId())
+	}
+}
+
+func TestNotifyUserDeliversWhenEventCannotBeRecorded(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	stream := NewMockStream(t)
+	require.NoError(t, router.RegisterStream(identityKey, stream))
+
+	// An event already recorded at the next sequence makes recording the notification fail.
+	_, err := dbCtx.Client.UserEvent.Create().
+		SetIdentityPublicKey(identityKey.Serialize()).
+		SetSequence(1).
+		SetEvent([]byte{0}).
+		Save(ctx)
+	require.NoError(t, err)
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	router.NotifyUser(tx, identityKey, newTransferEvent("unrecorded"))
+	require.NoError(t, tx.Commit())
+
+	require.Eventually(t, func() bool {
+		return len(stream.Messages()) == 1
+	}, time.Second, time.Millisecond)
+	messages := stream.Messages()
+	assert.Equal(t, "unrecorded", messages[0].GetTransfer().GetTransfer().GetId())
+	assert.Zero(t, messages[0].Sequence)
+}
+
+func TestNotifyUserDeliversOnlyAfterCommit(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	stream := NewMockStream(t)
+	require.NoError(t, router.RegisterStream(identityKey, stream))
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	router.NotifyUser(tx, identityKey, newTransferEvent("rolled-back"))
+	assert.Empty(t, stream.Messages())
+	require.NoError(t, tx.Rollback())
+	assert.Empty(t, stream.Messages())
+
+	tx, err = ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	router.NotifyUser(tx, identityKey, newTransferEvent("committed"))
+	assert.Empty(t, stream.Messages())
+	require.NoError(t, tx.Commit())
+
+	require.Eventually(t, func() bool {
+		return len(stream.Messages()) == 1
+	}, time.Second, time.Millisecond)
+	messages := stream.Messages()
+	assert.Equal(t, "committed", messages[0].GetTransfer().GetTransfer().GetId())
+	assert.Equal(t, uint64(1), messages[0].Sequence)
+}
+
+func TestSubscribeToEventsResume(t *testing.T) {
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tests := []struct {
+		name               string
+		resumeFromSequence *uint64
+		wantTransferIDs    []string
+	}{
+		{
+			name:            "no resume sends only new events",
+			wantTransferIDs: nil,
+		},
+		{
+			name:               "resume from zero replays all events",
+			resumeFromSequence: proto.Uint64(0),
+			wantTransferIDs:    []string{"transfer-0", "transfer-1", "transfer-2"},
+		},
+		{
+			name:               "resume replays events after the sequence",
+			resumeFromSequence: proto.Uint64(1),
+			wantTransferIDs:    []string{"transfer-1", "transfer-2"},
+		},
+		{
+			name:               "resume from latest replays nothing",
+			resumeFromSequence: proto.Uint64(3),
+			wantTransferIDs:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+			defer dbCtx.Close()
+			router := GetDefaultRouter()
+
+			tx, err := ent.GetDbFromContext(ctx)
+			require.NoError(t, err)
+			for i := range 3 {
+				router.NotifyUser(tx, identityKey, newTransferEvent(fmt.Sprintf("transfer-%d", i)))
+			}
+			require.NoError(t, tx.Commit())
+
+			streamCtx, cancel := context.WithCancel(t.Context())
+			stream := &MockStream{ctx: streamCtx}
+			done := make(chan error)
+			go func() {
+				done <- SubscribeToEvents(dbCtx.Client, identityKey, tt.resumeFromSequence, stream)
+			}()
+			require.Eventually(t, func() bool {
+				return len(stream.Messages()) == len(tt.wantTransferIDs)+1
+			}, time.Second, time.Millisecond)
+
+			tx, err = ent.GetDbFromContext(ctx)
+			require.NoError(t, err)
+			router.NotifyUser(tx, identityKey, newTransferEvent("live"))
+			require.NoError(t, tx.Commit())
+
+			cancel()
+			require.NoError(t, <-done)
+
+			messages := stream.Messages()
+			require.NotNil(t, messages[0].GetConnected())
+			var transferIDs []string
+			for _, msg := range messages[1:] {
+				transferIDs = append(transferIDs, msg.GetTransfer().GetTransfer().GetId())
+			}
+			assert.Equal(t, append(tt.wantTransferIDs, "live"), transferIDs)
+			assert.Equal(t, uint64(4), messages[len(messages)-1].Sequence)
+		})
 	}
 }
+
+func TestSubscriberBackfillsMissedEvents(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	var recorded []*pb.SubscribeToEventsResponse
+	for i := range 3 {
+		msg := newTransferEvent(fmt.Sprintf("transfer-%d", i))
+		router.NotifyUser(tx, identityKey, msg)
+		recorded = append(recorded, msg)
+	}
+	require.NoError(t, tx.Commit())
+
+	stream := NewMockStream(t)
+	sub := newSubscriber(dbCtx.Client, identityKey, stream)
+	require.NoError(t, router.registerSubscriber(sub))
+
+	// The last event is delivered before the ones preceding it.
+	router.deliver(t.Context(), identityKey, recorded[2])
+	router.deliver(t.Context(), identityKey, recorded[0])
+	router.deliver(t.Context(), identityKey, recorded[1])
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+	go func() {
+		done <- sub.run(ctx)
+	}()
+	require.Eventually(t, func() bool {
+		return len(stream.Messages()) == 3
+	}, time.Second, time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	for i, msg := range stream.Messages() {
+		assert.Equal(t, uint64(i+1), msg.Sequence)
+	}
+}
+
+func TestDeleteExpiredEvents(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	router := NewEventRouter()
+	rng := rand.NewChaCha8([32]byte{})
+	identityKey := keys.MustGeneratePrivateKeyFromRand(rng).Public()
+
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	retained := newTransferEvent("retained")
+	router.NotifyUser(tx, identityKey, retained)
+	require.NoError(t, tx.Commit())
+
+	tx, err = ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+	_, err = tx.UserEvent.Create().
+		SetIdentityPublicKey(identityKey.Serialize()).
+		SetSequence(retained.Sequence + 1).
+		SetEvent([]byte{0}).
+		SetCreateTime(time.Now().Add(-EventRetention - time.Hour)).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = tx.UserEventSequence.Update().AddLastSequence(1).Save(ctx)
+	require.NoError(t, err)
+
+	deleted, err := DeleteExpiredEvents(ctx, tx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	remaining, err := tx.UserEvent.Query().All(ctx)
+	require.NoError(t, err)
+	require.Len(t, remaining, 1)
+	assert.Equal(t, retained.Sequence, remaining[0].Sequence)
+
+	// Sequence numbers keep increasing after events are deleted.
+	next := newTransferEvent("next")
+	router.NotifyUser(tx, identityKey, next)
+	require.NoError(t, tx.Commit())
+	assert.Equal(t, uint64(3), next.Sequence)
+}
@@ -335,3 +335,57 @@ func TestPanicRecoveryInterceptor_TestPanic(t *testing.T) {
 	err = taskWithRecovery.Task(ctx, config)
 	require.ErrorIs(t, err, errTaskPanic)
 }
+
+func TestStatusMiddleware_RecordsRuns(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+
+	taskErr := errors.New("task failed")
+	task := BaseTaskSpec{
+		Name: "TestStatusMiddleware_RecordsRuns",
+		Task: func(_ context.Context, _ *so.Config) error {
+			return taskErr
+		},
+	}
+	require.True(t, GetTaskStatus(task.Name).LastRunStartTime.IsZero())
+
+	err = task.wrapMiddleware(StatusMiddleware()).Task(ctx, config)
+	require.ErrorIs(t, err, taskErr)
+
+	status := GetTaskStatus(task.Name)
+	require.False(t, status.Running)
+	require.False(t, status.LastRunStartTime.IsZero())
+	require.False(t, status.LastRunEndTime.Before(status.LastRunStartTime))
+	require.ErrorIs(t, status.LastRunError, taskErr)
+}
+
+func TestPauseMiddleware_SkipsPausedTask(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+
+	runs := 0
+	task := BaseTaskSpec{
+		Name: "TestPauseMiddleware_SkipsPausedTask",
+		Task: func(_ context.Context, _ *so.Config) error {
+			runs++
+			return nil
+		},
+	}
+	pausable := task.chainMiddleware(PauseMiddleware(), StatusMiddleware())
+
+	SetTaskPaused(task.Name, true)
+	require.NoError(t, pausable.Task(ctx, config))
+	require.Equal(t, 0, runs)
+	require.True(t, GetTaskStatus(task.Name).LastRunStartTime.IsZero())
+
+	SetTaskPaused(task.Name, false)
+	require.NoError(t, pausable.Task(ctx, config))
+	require.Equal(t, 1, runs)
+	require.NoError(t, GetTaskStatus(task.Name).LastRunError)
+}
@@ -0,0 +1,117 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lightsparkdev/spark/common/logging"
+	"github.com/lightsparkdev/spark/so"
+)
+
+// TaskStatus is the state of a task in this process.
+type TaskStatus struct { //nolint:revive
+	// Paused is whether scheduled runs of the task are skipped.
+	Paused bool
+	// Running is whether a run of the task is in progress.
+	Running bool
+	// LastRunStartTime is when the last completed run started. It is zero if the task has not run.
+	LastRunStartTime time.Time
+	// LastRunEndTime is when the last completed run finished.
+	LastRunEndTime time.Time
+	// LastRunError is the error returned by the last completed run, or nil if it succeeded.
+	LastRunError error
+}
+
+type taskStatusRegistry struct {
+	mu       sync.Mutex
+	statuses map[string]*taskStatus
+}
+
+type taskStatus struct {
+	paused           bool
+	running          int
+	lastRunStartTime time.Time
+	lastRunEndTime   time.Time
+	lastRunError     error
+}
+
+// statuses tracks every task run by this process. It is not persisted, so pauses are lost on restart.
+var statuses = &taskStatusRegistry{statuses: make(map[string]*taskStatus)}
+
+func (r *taskStatusRegistry) get(name string) *taskStatus {
+	status, ok := r.statuses[name]
+	if !ok {
+		status = &taskStatus{}
+		r.statuses[name] = status
+	}
+	return status
+}
+
+// GetTaskStatus returns the status of the named task.
+func GetTaskStatus(name string) TaskStatus {
+	statuses.mu.Lock()
+	defer statuses.mu.Unlock()
+
+	status := statuses.get(name)
+	return TaskStatus{
+		Paused:           status.paused,
+		Running:          status.running > 0,
+		LastRunStartTime: status.lastRunStartTime,
+		LastRunEndTime:   status.lastRunEndTime,
+		LastRunError:     status.lastRunError,
+	}
+}
+
+// SetTaskPaused pauses or resumes scheduled runs of the named task. A run that is in progress is not
+// interrupted.
+func SetTaskPaused(name string, paused bool) {
+	statuses.mu.Lock()
+	defer statuses.mu.Unlock()
+
+	statuses.get(name).paused = paused
+}
+
+// PauseMiddleware skips the task while it is paused.
+func PauseMiddleware() TaskMiddleware {
+	return func(ctx context.Context, config *so.Config, task *BaseTaskSpec) error {
+		if GetTaskStatus(task.Name).Paused {
+			logging.GetLoggerFromContext(ctx).Info("Skipping paused task", "task.name", task.Name)
+			return nil
+		}
+		return task.Task(ctx, config)
+	}
+}
+
+// StatusMiddleware records each run of the task so that it can be reported by GetTaskStatus.
+func StatusMiddleware() TaskMiddleware {
+	return func(ctx context.Context, config *so.Config, task *BaseTaskSpec) error {
+		start := time.Now()
+		statuses.mu.Lock()
+		statuses.get(task.Name).running++
+		statuses.mu.Unlock()
+
+		err := task.Task(ctx, config)
+
+		statuses.mu.Lock()
+		status := statuses.get(task.Name)
+		status.running--
+		status.lastRunStartTime = start
+		status.lastRunEndTime = time.Now()
+		status.lastRunError = err
+		statuses.mu.Unlock()
+
+		return err
+	}
+}
+
+// IsTaskTimeout reports whether the error is from a task that ran past its timeout.
+func IsTaskTimeout(err error) bool {
+	return errors.Is(err, errTaskTimeout)
+}
+
+// IsTaskPanic reports whether the error is from a task that panicked.
+func IsTaskPanic(err error) bool {
+	return errors.Is(err, errTaskPanic)
+}
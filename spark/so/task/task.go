@@ -32,6 +32,7 @@ import (
 	"github.com/lightsparkdev/spark/so"
 	"github.com/lightsparkdev/spark/so/ent"
 	"github.com/lightsparkdev/spark/so/ent/gossip"
+	"github.com/lightsparkdev/spark/so/ent/preimagerequest"
 	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
 	"github.com/lightsparkdev/spark/so/ent/signingcommitment"
 	"github.com/lightsparkdev/spark/so/ent/tokentransaction"
@@ -40,6 +41,7 @@ import (
 	"github.com/lightsparkdev/spark/so/ent/treenode"
 	"github.com/lightsparkdev/spark/so/ent/utxoswap"
 	"github.com/lightsparkdev/spark/so/handler"
+	events "github.com/lightsparkdev/spark/so/stream"
 )
 
 var (
@@ -197,6 +199,11 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 								transfer.ExpiryTimeLT(time.Now().Add(-24*time.Hour*14)),
 								transfer.ExpiryTimeNEQ(time.Unix(0, 0)),
 							),
+							transfer.And(
+								transfer.StatusEQ(st.TransferStatusSenderKeyTweakPending),
+								transfer.TypeEQ(st.TransferTypeHtlc),
+								transfer.ExpiryTimeLT(time.Now().Add(-handler.HtlcReturnDelay)),
+							),
 						))
 
 					transfers, err := query.All(ctx)
@@ -205,6 +212,20 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 					}
 
 					for _, dbTransfer := range transfers {
+						if dbTransfer.Type == st.TransferTypeHtlc {
+							// The receiver revealed the preimage in time, so the transfer is theirs even if the
+							// sender key tweaks have not settled yet.
+							preimageRevealed, err := tx.PreimageRequest.Query().Where(
+								preimagerequest.HasTransfersWith(transfer.ID(dbTransfer.ID)),
+								preimagerequest.StatusEQ(st.PreimageRequestStatusPreimageShared),
+							).Exist(ctx)
+							if err != nil {
+								return err
+							}
+							if preimageRevealed {
+								continue
+							}
+						}
 						logger.Info("Cancelling transfer", "transfer_id", dbTransfer.ID)
 						err := h.CancelTransferInternal(ctx, dbTransfer.ID.String())
 						if err != nil {
@@ -286,6 +307,29 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 				},
 			},
 		},
+		{
+			ExecutionInterval: 1 * time.Hour,
+			BaseTaskSpec: BaseTaskSpec{
+				Name:         "delete_expired_user_events",
+				RunInTestEnv: true,
+				Task: func(ctx context.Context, _ *so.Config) error {
+					logger := logging.GetLoggerFromContext(ctx)
+					tx, err := ent.GetDbFromContext(ctx)
+					if err != nil {
+						return fmt.Errorf("failed to get or create current tx for request: %w", err)
+					}
+
+					numDeleted, err := events.DeleteExpiredEvents(ctx, tx)
+					if err != nil {
+						return err
+					}
+					if numDeleted > 0 {
+						logger.Info(fmt.Sprintf("Deleted %d expired user events.", numDeleted))
+					}
+					return nil
+				},
+			},
+		},
 		{
 			ExecutionInterval: 5 * time.Minute,
 			BaseTaskSpec: BaseTaskSpec{
@@ -320,6 +364,24 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 				},
 			},
 		},
+		{
+			ExecutionInterval: 1 * time.Minute,
+			BaseTaskSpec: BaseTaskSpec{
+				Name:         "thaw_expired_token_freezes",
+				RunInTestEnv: true,
+				Task: func(ctx context.Context, _ *so.Config) error {
+					logger := logging.GetLoggerFromContext(ctx)
+					numThawed, err := ent.ThawExpiredFreezes(ctx, time.Now())
+					if err != nil {
+						return fmt.Errorf("failed to thaw expired token freezes: %w", err)
+					}
+					if numThawed > 0 {
+						logger.Info(fmt.Sprintf("Thawed %d expired token freezes.", numThawed))
+					}
+					return nil
+				},
+			},
+		},
 		{
 			ExecutionInterval: 10 * time.Minute,
 			BaseTaskSpec: BaseTaskSpec{
@@ -388,6 +450,7 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 									TokenPublicKey:                createdOutput.TokenPublicKey,
 									TokenIdentifier:               createdOutput.TokenIdentifier,
 									TokenAmount:                   createdOutput.TokenAmount,
+									SpendableAfter:                createdOutput.SpendableAfterProto(),
 								})
 							}
 						}
@@ -398,8 +461,14 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 							if err != nil {
 								return fmt.Errorf("unable to get proto network: %w", err)
 							}
+						} else if len(tokenTransaction.Edges.SpentOutput) > 0 {
+							// Burns create no outputs.
+							protoNetwork, err = common.ProtoNetworkFromSchemaNetwork(tokenTransaction.Edges.SpentOutput[0].Network)
+							if err != nil {
+								return fmt.Errorf("unable to get proto network: %w", err)
+							}
 						} else {
-							return fmt.Errorf("no created outputs found for token transaction: %s", tokenTransaction.ID)
+							return fmt.Errorf("no created or spent outputs found for token transaction: %s", tokenTransaction.ID)
 						}
 
 						if tokenTransaction.Edges.PeerSignatures != nil {
@@ -431,6 +500,14 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 							ExpiryTime:   timestamppb.New(tokenTransaction.ExpiryTime),
 							Network:      protoNetwork,
 						}
+						if len(createdOutputs) == 0 && len(tokenTransaction.Edges.SpentOutput) > 0 {
+							tokenPb.TokenInputs = &tokenpb.TokenTransaction_BurnInput{
+								BurnInput: &tokenpb.TokenBurnInput{
+									OutputsToSpend:  spentOutputs,
+									TokenIdentifier: tokenTransaction.Edges.SpentOutput[0].TokenIdentifier,
+								},
+							}
+						}
 						logger.Info("[cron] Finalizing token transaction",
 							"num_signatures", len(signaturesPackage),
 							"operator_ids", slices.Collect(maps.Keys(signaturesPackage)),
@@ -448,7 +525,7 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 			},
 		},
 		{
-			ExecutionInterval: 5 * time.Minute,
+			ExecutionInterval: 1 * time.Minute,
 			BaseTaskSpec: BaseTaskSpec{
 				Name:         "send_gossip",
 				RunInTestEnv: true,
@@ -459,7 +536,19 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 					if err != nil {
 						return fmt.Errorf("failed to get or create current tx for request: %w", err)
 					}
-					query := tx.Gossip.Query().Where(gossip.StatusEQ(st.GossipStatusPending)).Limit(1000)
+					now := time.Now()
+					// Only pick up messages with a participant due for another attempt, or that
+					// expired and need to be marked as failed.
+					query := tx.Gossip.Query().
+						Where(
+							gossip.StatusEQ(st.GossipStatusPending),
+							gossip.Or(
+								gossip.NextAttemptTimeIsNil(),
+								gossip.NextAttemptTimeLTE(now),
+								gossip.ExpiryTimeLTE(now),
+							),
+						).
+						Limit(1000)
 					gossips, err := query.ForUpdate().All(ctx)
 					if err != nil {
 						return err
@@ -540,6 +629,18 @@ func AllScheduledTasks() []ScheduledTaskSpec {
 				},
 			},
 		},
+		{
+			ExecutionInterval: handler.ConsistencyAuditWindow,
+			BaseTaskSpec: BaseTaskSpec{
+				Name:         "audit_consistency",
+				RunInTestEnv: false,
+				Task: func(ctx context.Context, config *so.Config) error {
+					h := handler.NewConsistencyAuditHandler(config)
+					_, err := h.AuditConsistency(ctx, &pbinternal.AuditConsistencyRequest{})
+					return err
+				},
+			},
+		},
 	}
 }
 
@@ -640,6 +741,7 @@ func (t *BaseTaskSpec) RunOnce(config *so.Config, dbClient *ent.Client) error {
 	ctx := context.Background()
 
 	wrappedTask := t.chainMiddleware(
+		StatusMiddleware(),
 		LogMiddleware(),
 		DatabaseMiddleware(db.NewDefaultSessionFactory(dbClient, config.Database.NewTxTimeout)),
 		TimeoutMiddleware(),
@@ -651,6 +753,8 @@ func (t *BaseTaskSpec) RunOnce(config *so.Config, dbClient *ent.Client) error {
 
 func (t *ScheduledTaskSpec) Schedule(scheduler gocron.Scheduler, config *so.Config, dbClient *ent.Client) error {
 	wrappedTask := t.chainMiddleware(
+		PauseMiddleware(),
+		StatusMiddleware(),
 		LogMiddleware(),
 		DatabaseMiddleware(db.NewDefaultSessionFactory(dbClient, config.Database.NewTxTimeout)),
 		TimeoutMiddleware(),
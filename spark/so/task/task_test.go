@@ -0,0 +1,127 @@
+package task
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/lightsparkdev/spark/so/handler"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getScheduledTask(t *testing.T, name string) ScheduledTaskSpec {
+	for _, task := range AllScheduledTasks() {
+		if task.Name == name {
+			return task
+		}
+	}
+	require.FailNow(t, "scheduled task not found", name)
+	return ScheduledTaskSpec{}
+}
+
+func TestCancelExpiredTransfersReturnsHtlcTransfers(t *testing.T) {
+	config, err := sparktesting.TestConfig()
+	require.NoError(t, err)
+	ctx, _ := db.SetupPostgresTestContext(t)
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	rng := mathrand.NewChaCha8([32]byte{7})
+	sender := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+	receiver := keys.MustGeneratePrivateKeyFromRand(rng).Public().Serialize()
+
+	signingKeyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusAvailable).
+		SetSecretShare([]byte("test_secret_share")).
+		SetPublicShares(map[string][]byte{"test": []byte("test_public_share")}).
+		SetPublicKey([]byte("test_public_key")).
+		SetMinSigners(2).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+	tree, err := tx.Tree.Create().
+		SetStatus(st.TreeStatusAvailable).
+		SetNetwork(st.NetworkRegtest).
+		SetOwnerIdentityPubkey(sender).
+		SetBaseTxid([]byte("test_base_txid")).
+		SetVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+	createHtlc := func(expiryTime time.Time, preimageStatus st.PreimageRequestStatus) (*ent.Transfer, *ent.TreeNode, *ent.PreimageRequest) {
+		leaf, err := tx.TreeNode.Create().
+			SetStatus(st.TreeNodeStatusTransferLocked).
+			SetTree(tree).
+			SetSigningKeyshare(signingKeyshare).
+			SetValue(1_000).
+			SetVerifyingPubkey([]byte("test_verifying_pubkey")).
+			SetOwnerIdentityPubkey(sender).
+			SetOwnerSigningPubkey([]byte("test_owner_signing")).
+			SetRawTx([]byte("test_raw_tx")).
+			SetRawRefundTx([]byte("test_raw_refund_tx")).
+			SetVout(0).
+			Save(ctx)
+		require.NoError(t, err)
+		transfer, err := tx.Transfer.Create().
+			SetStatus(st.TransferStatusSenderKeyTweakPending).
+			SetType(st.TransferTypeHtlc).
+			SetSenderIdentityPubkey(sender).
+			SetReceiverIdentityPubkey(receiver).
+			SetTotalValue(1_000).
+			SetExpiryTime(expiryTime).
+			Save(ctx)
+		require.NoError(t, err)
+		_, err = tx.TransferLeaf.Create().
+			SetTransfer(transfer).
+			SetLeaf(leaf).
+			SetPreviousRefundTx([]byte("test_previous_refund_tx")).
+			SetIntermediateRefundTx([]byte("test_intermediate_refund_tx")).
+			Save(ctx)
+		require.NoError(t, err)
+		preimageRequest, err := tx.PreimageRequest.Create().
+			SetPaymentHash([]byte("test_payment_hash")).
+			SetReceiverIdentityPubkey(receiver).
+			SetStatus(preimageStatus).
+			SetTransfers(transfer).
+			Save(ctx)
+		require.NoError(t, err)
+		return transfer, leaf, preimageRequest
+	}
+
+	expired := time.Now().Add(-handler.HtlcReturnDelay - time.Minute)
+	unclaimed, unclaimedLeaf, unclaimedPreimageRequest := createHtlc(expired, st.PreimageRequestStatusWaitingForPreimage)
+	claimed, claimedLeaf, _ := createHtlc(expired, st.PreimageRequestStatusPreimageShared)
+	// A preimage provided just before the expiry may still be on its way to the other operators.
+	returnDelayed, returnDelayedLeaf, _ := createHtlc(time.Now().Add(-time.Minute), st.PreimageRequestStatusWaitingForPreimage)
+
+	task := getScheduledTask(t, "cancel_expired_transfers")
+	require.NoError(t, task.Task(ctx, config))
+
+	unclaimed, err = tx.Transfer.Get(ctx, unclaimed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, st.TransferStatusReturned, unclaimed.Status)
+	unclaimedLeaf, err = tx.TreeNode.Get(ctx, unclaimedLeaf.ID)
+	require.NoError(t, err)
+	assert.Equal(t, st.TreeNodeStatusAvailable, unclaimedLeaf.Status)
+	assert.Equal(t, sender, unclaimedLeaf.OwnerIdentityPubkey)
+	unclaimedPreimageRequest, err = tx.PreimageRequest.Get(ctx, unclaimedPreimageRequest.ID)
+	require.NoError(t, err)
+	assert.Equal(t, st.PreimageRequestStatusReturned, unclaimedPreimageRequest.Status)
+
+	for _, pending := range []struct {
+		transfer *ent.Transfer
+		leaf     *ent.TreeNode
+	}{{claimed, claimedLeaf}, {returnDelayed, returnDelayedLeaf}} {
+		transfer, err := tx.Transfer.Get(ctx, pending.transfer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, st.TransferStatusSenderKeyTweakPending, transfer.Status)
+		leaf, err := tx.TreeNode.Get(ctx, pending.leaf.ID)
+		require.NoError(t, err)
+		assert.Equal(t, st.TreeNodeStatusTransferLocked, leaf.Status)
+	}
+}
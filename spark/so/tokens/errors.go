@@ -69,6 +69,11 @@ const (
 	ErrFailedToDecodeSparkInvoice         = "failed to decode spark invoice"
 	ErrInvalidSparkInvoice                = "invalid spark invoice"
 	ErrSparkInvoiceExpired                = "spark invoice expired"
+	ErrIssuerKeyNotCurrent                = "mint issuer public key is not the current issuer key of the token"
+	ErrRotatedTokenIdentifierRequired     = "mints of a token whose issuer key was rotated must set the token identifier"
+	ErrOwnerNotOnAllowlist                = "output owner is not on the allowlist of the transfer restricted token"
+	ErrTokenNotTransferRestricted         = "token is not transfer restricted"
+	ErrTokenPaused                        = "token is paused by the issuer"
 )
 
 func FormatErrorWithTransactionEnt(msg string, tokenTransaction *ent.TokenTransaction, err error) error {
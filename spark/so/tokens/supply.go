@@ -0,0 +1,88 @@
+package tokens
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/lightsparkdev/spark/common"
+	"github.com/lightsparkdev/spark/so/ent"
+	"github.com/lightsparkdev/spark/so/ent/tokenfreeze"
+	"github.com/lightsparkdev/spark/so/ent/tokenoutput"
+)
+
+// TokenSupply holds supply statistics for a token.
+type TokenSupply struct {
+	MaxSupply *big.Int
+	// Minted is everything ever minted.
+	Minted *big.Int
+	// Circulating is everything minted less everything burned.
+	Circulating *big.Int
+	// Frozen is the part of the circulating supply held by owners with an active freeze.
+	Frozen            *big.Int
+	FrozenOutputCount int
+}
+
+// GetTokenSupply returns the supply statistics for a token.
+func GetTokenSupply(ctx context.Context, tokenCreate *ent.TokenCreate) (*TokenSupply, error) {
+	byTokenIdentifier := func(q *ent.TokenOutputQuery) *ent.TokenOutputQuery {
+		return q.Where(tokenoutput.TokenIdentifierEQ(tokenCreate.TokenIdentifier))
+	}
+	minted, err := calculateMintedSupply(ctx, byTokenIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate minted supply: %w", err)
+	}
+	burned, err := calculateBurnedSupply(ctx, byTokenIdentifier)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate burned supply: %w", err)
+	}
+
+	frozen, frozenOutputCount, err := calculateFrozenSupply(ctx, tokenCreate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate frozen supply: %w", err)
+	}
+
+	return &TokenSupply{
+		MaxSupply:         new(big.Int).SetBytes(tokenCreate.MaxSupply),
+		Minted:            minted,
+		Circulating:       new(big.Int).Sub(minted, burned),
+		Frozen:            frozen,
+		FrozenOutputCount: frozenOutputCount,
+	}, nil
+}
+
+// calculateFrozenSupply sums the outputs owned by owners with an active freeze on the token.
+func calculateFrozenSupply(ctx context.Context, tokenCreate *ent.TokenCreate) (*big.Int, int, error) {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	activeFreezes, err := db.TokenFreeze.Query().
+		Where(
+			tokenfreeze.TokenCreateID(tokenCreate.ID),
+			ent.ActiveTokenFreeze(time.Now()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", ErrFailedToQueryTokenFreezeStatus, err)
+	}
+	if len(activeFreezes) == 0 {
+		return new(big.Int), 0, nil
+	}
+
+	frozenOwners := make([][]byte, len(activeFreezes))
+	for i, freeze := range activeFreezes {
+		frozenOwners[i] = freeze.OwnerPublicKey
+	}
+	network, err := common.NetworkFromSchemaNetwork(tokenCreate.Network)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get token network: %w", err)
+	}
+	outputIDs, frozenAmount, err := ent.GetOwnedTokenOutputStats(ctx, frozenOwners, tokenCreate.TokenIdentifier, network)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%s: %w", ErrFailedToGetOwnedOutputStats, err)
+	}
+	return frozenAmount, len(outputIDs), nil
+}
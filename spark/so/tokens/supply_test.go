@@ -0,0 +1,86 @@
+package tokens
+
+import (
+	mathrand "math/rand/v2"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	sparktesting "github.com/lightsparkdev/spark/testing"
+)
+
+func TestGetTokenSupply(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	issuerPublicKey := keys.MustGeneratePrivateKeyFromRand(mathrand.NewChaCha8([32]byte{2})).Public().Serialize()
+	tokenCreate, err := sparktesting.NewTestTokenCreate(t, tx, issuerPublicKey).
+		SetMaxSupply(tokenAmount(1_000)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	mint, err := tx.TokenMint.Create().
+		SetIssuerPublicKey(issuerPublicKey).
+		SetWalletProvidedTimestamp(uint64(time.Now().UnixMilli())).
+		SetIssuerSignature(randomBytes(t, 64)).
+		SetTokenIdentifier(tokenCreate.TokenIdentifier).
+		Save(ctx)
+	require.NoError(t, err)
+	mintTx, err := tx.TokenTransaction.Create().
+		SetPartialTokenTransactionHash(randomBytes(t, 32)).
+		SetFinalizedTokenTransactionHash(randomBytes(t, 32)).
+		SetStatus(st.TokenTransactionStatusSigned).
+		SetMintID(mint.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	createTestTokenOutput(t, ctx, tx, tokenCreate, mintTx, 0, 50)
+	frozenOutput := createTestTokenOutput(t, ctx, tx, tokenCreate, mintTx, 1, 30)
+	burnedOutput := createTestTokenOutput(t, ctx, tx, tokenCreate, mintTx, 2, 20)
+
+	burnTx, err := tx.TokenTransaction.Create().
+		SetPartialTokenTransactionHash(randomBytes(t, 32)).
+		SetFinalizedTokenTransactionHash(randomBytes(t, 32)).
+		SetStatus(st.TokenTransactionStatusFinalized).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = burnedOutput.Update().
+		SetStatus(st.TokenOutputStatusSpentFinalized).
+		SetOutputSpentTokenTransactionID(burnTx.ID).
+		SetSpentTransactionInputVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	_, err = tx.TokenFreeze.Create().
+		SetStatus(st.TokenFreezeStatusFrozen).
+		SetOwnerPublicKey(frozenOutput.OwnerPublicKey).
+		SetIssuerSignature(randomBytes(t, 64)).
+		SetWalletProvidedFreezeTimestamp(uint64(time.Now().UnixMilli())).
+		SetTokenCreateID(tokenCreate.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	// Thawed freezes do not count.
+	_, err = tx.TokenFreeze.Create().
+		SetStatus(st.TokenFreezeStatusThawed).
+		SetOwnerPublicKey(burnedOutput.OwnerPublicKey).
+		SetIssuerSignature(randomBytes(t, 64)).
+		SetWalletProvidedFreezeTimestamp(uint64(time.Now().UnixMilli())).
+		SetTokenCreateID(tokenCreate.ID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	supply, err := GetTokenSupply(ctx, tokenCreate)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1_000), supply.MaxSupply.Int64())
+	assert.Equal(t, int64(100), supply.Minted.Int64())
+	assert.Equal(t, int64(80), supply.Circulating.Int64())
+	assert.Equal(t, int64(30), supply.Frozen.Int64())
+	assert.Equal(t, 1, supply.FrozenOutputCount)
+}
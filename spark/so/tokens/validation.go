@@ -92,6 +92,8 @@ func validateMintAgainstMaxSupplyCore(ctx context.Context, mintAmount *big.Int,
 		tokenCreate, err = db.TokenCreate.Query().Where(tokencreate.TokenIdentifierEQ(tokenIdentifier)).First(ctx)
 		identifierInfo = fmt.Sprintf("token identifier: %x", tokenIdentifier)
 	} else if !issuerPublicKey.IsZero() {
+		// Mints of tokens whose issuer key was rotated must set the token identifier, so the issuer key here is
+		// the key the token was created with.
 		tokenCreate, err = db.TokenCreate.Query().Where(tokencreate.IssuerPublicKeyEQ(issuerPublicKey.Serialize())).First(ctx)
 		identifierInfo = fmt.Sprintf("issuer public key: %v", issuerPublicKey)
 	} else {
@@ -132,22 +134,36 @@ func validateMintAgainstMaxSupplyCore(ctx context.Context, mintAmount *big.Int,
 	return nil
 }
 
-// calculateCurrentSupplyByTokenIdentifier calculates the current minted supply for a token by token identifier.
+// calculateCurrentSupplyByTokenIdentifier calculates the current supply for a token by token identifier.
 func calculateCurrentSupplyByTokenIdentifier(ctx context.Context, tokenIdentifier []byte) (*big.Int, error) {
 	return calculateCurrentSupply(ctx, func(q *ent.TokenOutputQuery) *ent.TokenOutputQuery {
 		return q.Where(tokenoutput.TokenIdentifierEQ(tokenIdentifier))
 	})
 }
 
-// calculateCurrentSupplyByIssuerKey calculates the current minted supply for a token by issuer public key.
+// calculateCurrentSupplyByIssuerKey calculates the current supply for a token by issuer public key.
 func calculateCurrentSupplyByIssuerKey(ctx context.Context, issuerPublicKey keys.Public) (*big.Int, error) {
 	return calculateCurrentSupply(ctx, func(q *ent.TokenOutputQuery) *ent.TokenOutputQuery {
 		return q.Where(tokenoutput.TokenPublicKeyEQ(issuerPublicKey.Serialize()))
 	})
 }
 
-// calculateCurrentSupply is a helper function that executes the common query logic.
+// calculateCurrentSupply is a helper function that executes the common query logic. The current supply is
+// everything minted less everything burned, so burns free up room to mint up to the max supply again.
 func calculateCurrentSupply(ctx context.Context, whereClause func(*ent.TokenOutputQuery) *ent.TokenOutputQuery) (*big.Int, error) {
+	totalMinted, err := calculateMintedSupply(ctx, whereClause)
+	if err != nil {
+		return nil, err
+	}
+	totalBurned, err := calculateBurnedSupply(ctx, whereClause)
+	if err != nil {
+		return nil, err
+	}
+	return totalMinted.Sub(totalMinted, totalBurned), nil
+}
+
+// calculateMintedSupply sums the outputs of signed mints.
+func calculateMintedSupply(ctx context.Context, whereClause func(*ent.TokenOutputQuery) *ent.TokenOutputQuery) (*big.Int, error) {
 	db, err := ent.GetDbFromContext(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
@@ -170,3 +186,31 @@ func calculateCurrentSupply(ctx context.Context, whereClause func(*ent.TokenOutp
 	}
 	return totalMinted, nil
 }
+
+// calculateBurnedSupply sums the outputs spent by burns. Burns are only counted once their revocation secrets
+// have been revealed, after which they can no longer be cancelled.
+func calculateBurnedSupply(ctx context.Context, whereClause func(*ent.TokenOutputQuery) *ent.TokenOutputQuery) (*big.Int, error) {
+	db, err := ent.GetDbFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get or create current tx for request: %w", err)
+	}
+
+	burnedOutputs, err := whereClause(db.TokenOutput.Query()).
+		Where(tokenoutput.HasOutputSpentTokenTransactionWith(
+			tokentransaction.StatusIn(st.TokenTransactionStatusRevealed, st.TokenTransactionStatusFinalized),
+			tokentransaction.Not(tokentransaction.HasCreatedOutput()),
+			tokentransaction.Not(tokentransaction.HasMint()),
+			tokentransaction.Not(tokentransaction.HasCreate()),
+		)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch burned outputs: %w", err)
+	}
+
+	totalBurned := new(big.Int)
+	for _, out := range burnedOutputs {
+		amount := new(big.Int).SetBytes(out.TokenAmount)
+		totalBurned.Add(totalBurned, amount)
+	}
+	return totalBurned, nil
+}
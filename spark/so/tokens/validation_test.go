@@ -0,0 +1,137 @@
+package tokens
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	mathrand "math/rand/v2"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/lightsparkdev/spark/common/keys"
+	tokenpb "github.com/lightsparkdev/spark/proto/spark_token"
+	"github.com/lightsparkdev/spark/so/db"
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+)
+
+func randomBytes(t *testing.T, length int) []byte {
+	b := make([]byte, length)
+	_, err := rand.Read(b)
+	require.NoError(t, err)
+	return b
+}
+
+func tokenAmount(amount int64) []byte {
+	return big.NewInt(amount).FillBytes(make([]byte, 16))
+}
+
+func createTestTokenOutput(t *testing.T, ctx context.Context, tx *ent.Tx, tokenCreate *ent.TokenCreate, createdTx *ent.TokenTransaction, vout int32, amount int64) *ent.TokenOutput {
+	keyshare, err := tx.SigningKeyshare.Create().
+		SetStatus(st.KeyshareStatusInUse).
+		SetSecretShare(randomBytes(t, 32)).
+		SetPublicShares(map[string][]byte{}).
+		SetPublicKey(randomBytes(t, 33)).
+		SetMinSigners(1).
+		SetCoordinatorIndex(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	output, err := tx.TokenOutput.Create().
+		SetStatus(st.TokenOutputStatusCreatedFinalized).
+		SetOwnerPublicKey(randomBytes(t, 33)).
+		SetWithdrawBondSats(1_000).
+		SetWithdrawRelativeBlockLocktime(10).
+		SetWithdrawRevocationCommitment(randomBytes(t, 33)).
+		SetTokenAmount(tokenAmount(amount)).
+		SetCreatedTransactionOutputVout(vout).
+		SetRevocationKeyshareID(keyshare.ID).
+		SetTokenIdentifier(tokenCreate.TokenIdentifier).
+		SetTokenCreateID(tokenCreate.ID).
+		SetOutputCreatedTokenTransactionID(createdTx.ID).
+		SetNetwork(st.NetworkRegtest).
+		Save(ctx)
+	require.NoError(t, err)
+	return output
+}
+
+func TestValidateMintDoesNotExceedMaxSupplyCountsBurns(t *testing.T) {
+	ctx, dbCtx := db.NewTestSQLiteContext(t, t.Context())
+	defer dbCtx.Close()
+	tx, err := ent.GetDbFromContext(ctx)
+	require.NoError(t, err)
+
+	issuerPublicKey := keys.MustGeneratePrivateKeyFromRand(mathrand.NewChaCha8([32]byte{1})).Public().Serialize()
+	tokenCreate, err := tx.TokenCreate.Create().
+		SetIssuerPublicKey(issuerPublicKey).
+		SetTokenName("TestToken").
+		SetTokenTicker("TT").
+		SetDecimals(0).
+		SetMaxSupply(tokenAmount(100)).
+		SetIsFreezable(true).
+		SetNetwork(st.NetworkRegtest).
+		SetTokenIdentifier(randomBytes(t, 32)).
+		SetCreationEntityPublicKey(randomBytes(t, 33)).
+		Save(ctx)
+	require.NoError(t, err)
+
+	mint, err := tx.TokenMint.Create().
+		SetIssuerPublicKey(issuerPublicKey).
+		SetWalletProvidedTimestamp(uint64(time.Now().UnixMilli())).
+		SetIssuerSignature(randomBytes(t, 64)).
+		SetTokenIdentifier(tokenCreate.TokenIdentifier).
+		Save(ctx)
+	require.NoError(t, err)
+	mintTx, err := tx.TokenTransaction.Create().
+		SetPartialTokenTransactionHash(randomBytes(t, 32)).
+		SetFinalizedTokenTransactionHash(randomBytes(t, 32)).
+		SetStatus(st.TokenTransactionStatusSigned).
+		SetMintID(mint.ID).
+		Save(ctx)
+	require.NoError(t, err)
+	createTestTokenOutput(t, ctx, tx, tokenCreate, mintTx, 0, 60)
+	burnedOutput := createTestTokenOutput(t, ctx, tx, tokenCreate, mintTx, 1, 40)
+
+	mintTransaction := func(amount int64) *tokenpb.TokenTransaction {
+		return &tokenpb.TokenTransaction{
+			TokenInputs: &tokenpb.TokenTransaction_MintInput{
+				MintInput: &tokenpb.TokenMintInput{
+					IssuerPublicKey: issuerPublicKey,
+					TokenIdentifier: tokenCreate.TokenIdentifier,
+				},
+			},
+			TokenOutputs: []*tokenpb.TokenOutput{{TokenAmount: tokenAmount(amount)}},
+		}
+	}
+
+	require.ErrorContains(t, ValidateMintDoesNotExceedMaxSupply(ctx, mintTransaction(1)), "mint would exceed max supply")
+
+	burnTx, err := tx.TokenTransaction.Create().
+		SetPartialTokenTransactionHash(randomBytes(t, 32)).
+		SetFinalizedTokenTransactionHash(randomBytes(t, 32)).
+		SetStatus(st.TokenTransactionStatusSigned).
+		Save(ctx)
+	require.NoError(t, err)
+	_, err = burnedOutput.Update().
+		SetStatus(st.TokenOutputStatusSpentSigned).
+		SetOutputSpentTokenTransactionID(burnTx.ID).
+		SetSpentTransactionInputVout(0).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// A burn that can still be cancelled does not free up supply.
+	require.ErrorContains(t, ValidateMintDoesNotExceedMaxSupply(ctx, mintTransaction(1)), "mint would exceed max supply")
+
+	_, err = burnTx.Update().SetStatus(st.TokenTransactionStatusFinalized).Save(ctx)
+	require.NoError(t, err)
+
+	supply, err := calculateCurrentSupplyByTokenIdentifier(ctx, tokenCreate.TokenIdentifier)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(60), supply)
+	require.NoError(t, ValidateMintDoesNotExceedMaxSupply(ctx, mintTransaction(40)))
+	require.ErrorContains(t, ValidateMintDoesNotExceedMaxSupply(ctx, mintTransaction(41)), "mint would exceed max supply")
+}
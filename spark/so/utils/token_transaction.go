@@ -8,6 +8,7 @@ import (
 	"hash"
 	"math/big"
 	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lightsparkdev/spark/common/keys"
@@ -25,6 +26,11 @@ import (
 // MaxInputOrOutputTokenTransactionOutputs defines the maximum number of input or token outputs allowed in a token transaction.
 const MaxInputOrOutputTokenTransactionOutputs = 500
 
+// TokenFreezeExpiryTolerance is the minimum time between the issuer provided timestamp and the expiry of a freeze,
+// leaving room for the request to reach every operator. The expiry is checked against the signed timestamp rather
+// than the operator's clock so that all operators accept or reject the same freeze.
+const TokenFreezeExpiryTolerance = time.Minute
+
 // zero represents a big.Int with value 0, used for amount comparisons.
 var zero = new(big.Int)
 
@@ -36,12 +42,14 @@ const (
 	TokenTransactionTypeCreate
 	TokenTransactionTypeMint
 	TokenTransactionTypeTransfer
+	TokenTransactionTypeBurn
 )
 
 var tokenTransactionTypeBytes = map[TokenTransactionType][]byte{
 	TokenTransactionTypeCreate:   {0, 0, 0, byte(tokenpb.TokenTransactionType_TOKEN_TRANSACTION_TYPE_CREATE)},
 	TokenTransactionTypeMint:     {0, 0, 0, byte(tokenpb.TokenTransactionType_TOKEN_TRANSACTION_TYPE_MINT)},
 	TokenTransactionTypeTransfer: {0, 0, 0, byte(tokenpb.TokenTransactionType_TOKEN_TRANSACTION_TYPE_TRANSFER)},
+	TokenTransactionTypeBurn:     {0, 0, 0, byte(tokenpb.TokenTransactionType_TOKEN_TRANSACTION_TYPE_BURN)},
 }
 
 // String returns the string representation of the token transaction type
@@ -53,6 +61,8 @@ func (t TokenTransactionType) String() string {
 		return "MINT"
 	case TokenTransactionTypeTransfer:
 		return "TRANSFER"
+	case TokenTransactionTypeBurn:
+		return "BURN"
 	default:
 		return "UNKNOWN"
 	}
@@ -186,6 +196,8 @@ func hashTokenTransactionV1(tokenTransaction *tokenpb.TokenTransaction, partialH
 		inputHashes, err = hashCreateInputV1(h, tokenTransaction.GetCreateInput(), partialHash)
 	case TokenTransactionTypeMint:
 		inputHashes, err = hashMintInputV1(h, tokenTransaction.GetMintInput())
+	case TokenTransactionTypeBurn:
+		inputHashes, err = hashBurnInputV1(h, tokenTransaction.GetBurnInput())
 	default:
 		return nil, fmt.Errorf("token transaction type %s is not valid", inputType)
 	}
@@ -262,6 +274,13 @@ func hashTokenTransactionV1(tokenTransaction *tokenpb.TokenTransaction, partialH
 		}
 		h.Write(tokenAmount)
 
+		// Only hashed when set so that hashes of outputs without a time lock are unchanged.
+		if spendableAfter := output.GetSpendableAfter(); spendableAfter != nil {
+			spendableAfterBytes := make([]byte, 8)
+			binary.BigEndian.PutUint64(spendableAfterBytes, uint64(spendableAfter.AsTime().UnixMilli()))
+			h.Write(spendableAfterBytes)
+		}
+
 		allHashes = append(allHashes, h.Sum(nil)...)
 	}
 
@@ -360,6 +379,27 @@ func hashTransferInputV1(h hash.Hash, transferSource *tokenpb.TokenTransferInput
 	return transferHashes, nil
 }
 
+func hashBurnInputV1(h hash.Hash, burnInput *tokenpb.TokenBurnInput) ([]byte, error) {
+	if burnInput == nil {
+		return nil, fmt.Errorf("burn input cannot be nil when hashing burn transaction")
+	}
+	// The outputs to spend are hashed the same way as for transfers.
+	burnHashes, err := hashTransferInputV1(h, &tokenpb.TokenTransferInput{OutputsToSpend: burnInput.GetOutputsToSpend()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash burn outputs to spend: %w", err)
+	}
+
+	h.Reset()
+	if tokenIdentifier := burnInput.GetTokenIdentifier(); tokenIdentifier != nil {
+		if len(tokenIdentifier) != 32 {
+			return nil, fmt.Errorf("invalid token identifier length: expected 32 bytes, got %d", len(tokenIdentifier))
+		}
+		h.Write(tokenIdentifier)
+	}
+	burnHashes = append(burnHashes, h.Sum(nil)...)
+	return burnHashes, nil
+}
+
 func hashCreateInputV1(h hash.Hash, createInput *tokenpb.TokenCreateInput, partialHash bool) ([]byte, error) {
 	if createInput == nil {
 		return nil, fmt.Errorf("create input cannot be nil when hashing create transaction")
@@ -423,6 +463,13 @@ func hashCreateInputV1(h hash.Hash, createInput *tokenpb.TokenCreateInput, parti
 		}
 	}
 	createHashes = append(createHashes, h.Sum(nil)...)
+
+	// Only hashed when set so that hashes of unrestricted token creations are unchanged.
+	if createInput.GetIsTransferRestricted() {
+		h.Reset()
+		h.Write([]byte{1})
+		createHashes = append(createHashes, h.Sum(nil)...)
+	}
 	return createHashes, nil
 }
 
@@ -887,6 +934,15 @@ func hashFreezePayloadContents(h hash.Hash, payload *tokenpb.FreezeTokensPayload
 	h.Write(operatorPubKey)
 	allHashes = append(allHashes, h.Sum(nil)...)
 
+	// Only hashed when set so that hashes of freezes without an expiry are unchanged.
+	if expiry := payload.GetFreezeExpiryTimestamp(); expiry != 0 {
+		h.Reset()
+		expiryBytes := make([]byte, 8)
+		binary.LittleEndian.PutUint64(expiryBytes, expiry)
+		h.Write(expiryBytes)
+		allHashes = append(allHashes, h.Sum(nil)...)
+	}
+
 	return allHashes, nil
 }
 
@@ -923,12 +979,15 @@ func InferTokenTransactionTypeSparkProtos(tokenTransaction *sparkpb.TokenTransac
 func InferTokenTransactionType(tokenTransaction *tokenpb.TokenTransaction) (TokenTransactionType, error) {
 	hasCreateInput := tokenTransaction.GetCreateInput() != nil
 	hasMintInput := tokenTransaction.GetMintInput() != nil
+	hasBurnInput := tokenTransaction.GetBurnInput() != nil
 
 	var inputType TokenTransactionType
 	if hasCreateInput {
 		inputType = TokenTransactionTypeCreate
 	} else if hasMintInput {
 		inputType = TokenTransactionTypeMint
+	} else if hasBurnInput {
+		inputType = TokenTransactionTypeBurn
 	} else {
 		// If no create or mint, assume its a transfer.
 		inputType = TokenTransactionTypeTransfer
@@ -970,7 +1029,7 @@ func validateBaseMintTransaction(
 	inputSignatures []*tokenpb.SignatureWithIndex,
 	requireTokenIdentifierForMints bool,
 ) error {
-	err := validateBaseTokenOutputs(tokenTransaction, requireTokenIdentifierForMints)
+	err := validateBaseTokenOutputs(tokenTransaction, requireTokenIdentifierForMints, false)
 	if err != nil {
 		return fmt.Errorf("token output consistency validation failed: %w", err)
 	}
@@ -1041,7 +1100,8 @@ func validateBaseTransferTransaction(
 	inputSignatures []*tokenpb.SignatureWithIndex,
 	requireTokenIdentifierForTransfers bool,
 ) error {
-	err := validateBaseTokenOutputs(tokenTransaction, requireTokenIdentifierForTransfers)
+	// Transfers may swap several tokens at once, as long as each token is conserved.
+	err := validateBaseTokenOutputs(tokenTransaction, requireTokenIdentifierForTransfers, true)
 	if err != nil {
 		return fmt.Errorf("token output consistency validation failed: %w", err)
 	}
@@ -1077,8 +1137,44 @@ func validateBaseTransferTransaction(
 	return nil
 }
 
+func validateBaseBurnTransaction(
+	tokenTransaction *tokenpb.TokenTransaction,
+	inputSignatures []*tokenpb.SignatureWithIndex,
+) error {
+	burnInput := tokenTransaction.GetBurnInput()
+	if len(tokenTransaction.TokenOutputs) > 0 {
+		return fmt.Errorf("burn transactions must not have any outputs")
+	}
+	if len(burnInput.GetTokenIdentifier()) != 32 {
+		return fmt.Errorf("burn token identifier cannot be nil and must be 32 bytes")
+	}
+
+	if len(burnInput.GetOutputsToSpend()) == 0 {
+		return fmt.Errorf("burn outputs to spend cannot be empty")
+	}
+	if len(burnInput.GetOutputsToSpend()) > MaxInputOrOutputTokenTransactionOutputs {
+		return fmt.Errorf("too many outputs to spend, maximum is %d", MaxInputOrOutputTokenTransactionOutputs)
+	}
+
+	// Validate there is the correct number of signatures for outputs to spend.
+	if len(inputSignatures) != len(burnInput.GetOutputsToSpend()) {
+		return fmt.Errorf("number of signatures must match number of outputs to spend")
+	}
+
+	return nil
+}
+
+// GetOutputsToSpend returns the outputs spent by a transfer or burn transaction, or nil for other
+// transaction types.
+func GetOutputsToSpend(tokenTransaction *tokenpb.TokenTransaction) []*tokenpb.TokenOutputToSpend {
+	if burnInput := tokenTransaction.GetBurnInput(); burnInput != nil {
+		return burnInput.GetOutputsToSpend()
+	}
+	return tokenTransaction.GetTransferInput().GetOutputsToSpend()
+}
+
 // ValidatePartialTokenTransaction validates a token transaction request received from a user.
-// It checks the transaction structure, signatures, and token amounts for create, mint and transfer operations.
+// It checks the transaction structure, signatures, and token amounts for create, mint, transfer and burn operations.
 // It also ensures that SO-filled fields are not set, as these will be filled by the SOs to form the final transaction.
 func ValidatePartialTokenTransaction(
 	tokenTransaction *tokenpb.TokenTransaction,
@@ -1131,13 +1227,18 @@ func ValidatePartialTokenTransaction(
 				return fmt.Errorf("output %d ID will be added by the SO - do not set this field when starting transactions", i)
 			}
 		}
+	case TokenTransactionTypeBurn:
+		// Burns create no outputs, so there are no SO-filled fields.
 	default:
 		return fmt.Errorf("token transaction type unknown")
 	}
 	return nil
 }
 
-func validateBaseTokenOutputs(tokenTransaction *tokenpb.TokenTransaction, requireTokenIdentifier bool) error {
+// validateBaseTokenOutputs checks that the outputs consistently identify their tokens. If allowMultipleTokens
+// is set, outputs identified by token identifier may be for different tokens. Outputs identified by the
+// legacy token public key must always be for the same token.
+func validateBaseTokenOutputs(tokenTransaction *tokenpb.TokenTransaction, requireTokenIdentifier bool, allowMultipleTokens bool) error {
 	if len(tokenTransaction.TokenOutputs) == 0 {
 		return fmt.Errorf("token outputs cannot be empty for mint and transfer transactions")
 	}
@@ -1157,7 +1258,7 @@ func validateBaseTokenOutputs(tokenTransaction *tokenpb.TokenTransaction, requir
 			if output.GetTokenIdentifier() == nil {
 				return fmt.Errorf("output %d missing token identifier", i)
 			}
-			if !bytes.Equal(output.GetTokenIdentifier(), expectedTokenIdentifier) {
+			if !allowMultipleTokens && !bytes.Equal(output.GetTokenIdentifier(), expectedTokenIdentifier) {
 				return fmt.Errorf("output %d token identifier (%x) must match mint input token identifier (%x)",
 					i, output.GetTokenIdentifier(), expectedTokenIdentifier)
 			}
@@ -1257,6 +1358,18 @@ func ValidateFreezeTokensPayload(payload *tokenpb.FreezeTokensPayload, expectedS
 	if payload.GetIssuerProvidedTimestamp() == 0 {
 		return fmt.Errorf("issuer provided timestamp cannot be 0")
 	}
+	if expiry := payload.GetFreezeExpiryTimestamp(); expiry != 0 {
+		if payload.Version == 0 {
+			return fmt.Errorf("freeze expiry timestamp requires version 1")
+		}
+		if payload.GetShouldUnfreeze() {
+			return fmt.Errorf("freeze expiry timestamp cannot be set when unfreezing")
+		}
+		minExpiry := payload.GetIssuerProvidedTimestamp() + uint64(TokenFreezeExpiryTolerance.Milliseconds())
+		if expiry < minExpiry {
+			return fmt.Errorf("freeze expiry timestamp %d must be at least %s after the issuer provided timestamp %d", expiry, TokenFreezeExpiryTolerance, payload.GetIssuerProvidedTimestamp())
+		}
+	}
 
 	payloadOpIDPubKey, err := keys.ParsePublicKey(payload.GetOperatorIdentityPublicKey())
 	if err != nil {
@@ -1268,6 +1381,167 @@ func ValidateFreezeTokensPayload(payload *tokenpb.FreezeTokensPayload, expectedS
 	return nil
 }
 
+// HashRotateIssuerKeyPayload generates a hash of the issuer key rotation payload by concatenating
+// hashes of the version, token identifier, new issuer key, timestamp and operator key.
+func HashRotateIssuerKeyPayload(payload *tokenpb.RotateIssuerKeyPayload) ([]byte, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("rotate issuer key payload cannot be nil")
+	}
+	if payload.Version != 0 {
+		return nil, fmt.Errorf("unsupported payload version: %d", payload.Version)
+	}
+	if payload.GetTokenIdentifier() == nil {
+		return nil, fmt.Errorf("token identifier cannot be nil")
+	}
+	if len(payload.GetNewIssuerPublicKey()) == 0 {
+		return nil, fmt.Errorf("new issuer public key cannot be empty")
+	}
+	if len(payload.GetOperatorIdentityPublicKey()) == 0 {
+		return nil, fmt.Errorf("operator identity public key cannot be empty")
+	}
+
+	return hashTokenIssuerPayloadFields(
+		binary.BigEndian.AppendUint32(nil, payload.GetVersion()),
+		payload.GetTokenIdentifier(),
+		payload.GetNewIssuerPublicKey(),
+		binary.BigEndian.AppendUint64(nil, payload.GetIssuerProvidedTimestamp()),
+		payload.GetOperatorIdentityPublicKey(),
+	), nil
+}
+
+func ValidateRotateIssuerKeyPayload(payload *tokenpb.RotateIssuerKeyPayload, expectedSparkOperatorPublicKey keys.Public) error {
+	if payload == nil {
+		return fmt.Errorf("rotate issuer key payload cannot be nil")
+	}
+	if payload.Version != 0 {
+		return fmt.Errorf("invalid rotate issuer key payload version: %d", payload.Version)
+	}
+	if _, err := keys.ParsePublicKey(payload.GetNewIssuerPublicKey()); err != nil {
+		return fmt.Errorf("failed to parse new issuer public key: %w", err)
+	}
+	return validateTokenIssuerPayload(payload.GetTokenIdentifier(), payload.GetIssuerProvidedTimestamp(), payload.GetOperatorIdentityPublicKey(), expectedSparkOperatorPublicKey)
+}
+
+// hashTokenIssuerPayloadFields hashes each field of an issuer signed token payload and returns the hash of the
+// concatenated field hashes.
+func hashTokenIssuerPayloadFields(fields ...[]byte) []byte {
+	h := sha256.New()
+	var allHashes []byte
+	for _, field := range fields {
+		h.Reset()
+		h.Write(field)
+		allHashes = h.Sum(allHashes)
+	}
+
+	// Final hash of all concatenated hashes
+	h.Reset()
+	h.Write(allHashes)
+	return h.Sum(nil)
+}
+
+func boolByte(b bool) []byte {
+	if b {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+// validateTokenIssuerPayload validates the fields shared by issuer signed token payloads.
+func validateTokenIssuerPayload(tokenIdentifier []byte, issuerProvidedTimestamp uint64, operatorIdentityPublicKey []byte, expectedSparkOperatorPublicKey keys.Public) error {
+	if len(tokenIdentifier) != 32 {
+		return fmt.Errorf("token identifier must be exactly 32 bytes, got %d", len(tokenIdentifier))
+	}
+	if issuerProvidedTimestamp == 0 {
+		return fmt.Errorf("issuer provided timestamp cannot be 0")
+	}
+
+	payloadOpIDPubKey, err := keys.ParsePublicKey(operatorIdentityPublicKey)
+	if err != nil {
+		return fmt.Errorf("failed to parse operator identity public key: %w", err)
+	}
+	if !payloadOpIDPubKey.Equals(expectedSparkOperatorPublicKey) {
+		return fmt.Errorf("operator identity public key %x does not match expected operator %s from config", operatorIdentityPublicKey, expectedSparkOperatorPublicKey)
+	}
+	return nil
+}
+
+// HashUpdateTokenAllowlistPayload generates a hash of the allowlist update payload by concatenating
+// hashes of the version, token identifier, owner key, timestamp, operator key and removal flag.
+func HashUpdateTokenAllowlistPayload(payload *tokenpb.UpdateTokenAllowlistPayload) ([]byte, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("update token allowlist payload cannot be nil")
+	}
+	if payload.Version != 0 {
+		return nil, fmt.Errorf("unsupported payload version: %d", payload.Version)
+	}
+	if payload.GetTokenIdentifier() == nil {
+		return nil, fmt.Errorf("token identifier cannot be nil")
+	}
+	if len(payload.GetOwnerPublicKey()) == 0 {
+		return nil, fmt.Errorf("owner public key cannot be empty")
+	}
+	if len(payload.GetOperatorIdentityPublicKey()) == 0 {
+		return nil, fmt.Errorf("operator identity public key cannot be empty")
+	}
+
+	return hashTokenIssuerPayloadFields(
+		binary.BigEndian.AppendUint32(nil, payload.GetVersion()),
+		payload.GetTokenIdentifier(),
+		payload.GetOwnerPublicKey(),
+		binary.BigEndian.AppendUint64(nil, payload.GetIssuerProvidedTimestamp()),
+		payload.GetOperatorIdentityPublicKey(),
+		boolByte(payload.GetShouldRemove()),
+	), nil
+}
+
+func ValidateUpdateTokenAllowlistPayload(payload *tokenpb.UpdateTokenAllowlistPayload, expectedSparkOperatorPublicKey keys.Public) error {
+	if payload == nil {
+		return fmt.Errorf("update token allowlist payload cannot be nil")
+	}
+	if payload.Version != 0 {
+		return fmt.Errorf("invalid update token allowlist payload version: %d", payload.Version)
+	}
+	if _, err := keys.ParsePublicKey(payload.GetOwnerPublicKey()); err != nil {
+		return fmt.Errorf("failed to parse owner public key: %w", err)
+	}
+	return validateTokenIssuerPayload(payload.GetTokenIdentifier(), payload.GetIssuerProvidedTimestamp(), payload.GetOperatorIdentityPublicKey(), expectedSparkOperatorPublicKey)
+}
+
+// HashPauseTokenPayload generates a hash of the pause token payload by concatenating
+// hashes of the version, token identifier, timestamp, operator key and unpause flag.
+func HashPauseTokenPayload(payload *tokenpb.PauseTokenPayload) ([]byte, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("pause token payload cannot be nil")
+	}
+	if payload.Version != 0 {
+		return nil, fmt.Errorf("unsupported payload version: %d", payload.Version)
+	}
+	if payload.GetTokenIdentifier() == nil {
+		return nil, fmt.Errorf("token identifier cannot be nil")
+	}
+	if len(payload.GetOperatorIdentityPublicKey()) == 0 {
+		return nil, fmt.Errorf("operator identity public key cannot be empty")
+	}
+
+	return hashTokenIssuerPayloadFields(
+		binary.BigEndian.AppendUint32(nil, payload.GetVersion()),
+		payload.GetTokenIdentifier(),
+		binary.BigEndian.AppendUint64(nil, payload.GetIssuerProvidedTimestamp()),
+		payload.GetOperatorIdentityPublicKey(),
+		boolByte(payload.GetShouldUnpause()),
+	), nil
+}
+
+func ValidatePauseTokenPayload(payload *tokenpb.PauseTokenPayload, expectedSparkOperatorPublicKey keys.Public) error {
+	if payload == nil {
+		return fmt.Errorf("pause token payload cannot be nil")
+	}
+	if payload.Version != 0 {
+		return fmt.Errorf("invalid pause token payload version: %d", payload.Version)
+	}
+	return validateTokenIssuerPayload(payload.GetTokenIdentifier(), payload.GetIssuerProvidedTimestamp(), payload.GetOperatorIdentityPublicKey(), expectedSparkOperatorPublicKey)
+}
+
 // ValidateRevocationKeys validates that the provided revocation private keys correspond to the expected public keys.
 // It ensures the private keys can correctly derive the expected public keys, preventing key mismatches.
 func ValidateRevocationKeys(revocationPrivateKeys []keys.Private, expectedRevocationPublicKeys []keys.Public) error {
@@ -1373,6 +1647,14 @@ func validateBaseTokenTransaction(
 	if !st.TokenTransactionVersion(tokenTransaction.Version).IsValid() {
 		return fmt.Errorf("invalid token transaction version: %d", tokenTransaction.Version)
 	}
+	// V0 transactions are hashed without spendable_after, so a time lock on them would not be signed.
+	if tokenTransaction.Version == 0 {
+		for i, output := range tokenTransaction.TokenOutputs {
+			if output.GetSpendableAfter() != nil {
+				return fmt.Errorf("output %d cannot have spendable_after in a version 0 token transaction", i)
+			}
+		}
+	}
 
 	inputCount := 0
 	if tokenTransaction.GetMintInput() != nil {
@@ -1384,8 +1666,11 @@ func validateBaseTokenTransaction(
 	if tokenTransaction.GetCreateInput() != nil {
 		inputCount++
 	}
+	if tokenTransaction.GetBurnInput() != nil {
+		inputCount++
+	}
 	if inputCount != 1 {
-		return fmt.Errorf("token transaction must have exactly one of create_input, mint_input, or transfer_input")
+		return fmt.Errorf("token transaction must have exactly one of create_input, mint_input, transfer_input, or burn_input")
 	}
 
 	// Validate that the transaction has exactly one input type
@@ -1407,6 +1692,10 @@ func validateBaseTokenTransaction(
 		if err := validateBaseTransferTransaction(tokenTransaction, inputSignatures, requireTokenIdentifierForTransfers); err != nil {
 			return err
 		}
+	case TokenTransactionTypeBurn:
+		if err := validateBaseBurnTransaction(tokenTransaction, inputSignatures); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("token transaction type unknown")
 	}
@@ -1495,6 +1784,8 @@ func ValidateFinalTokenTransaction(
 				return fmt.Errorf("withdrawal locktime mismatch for output %d", i)
 			}
 		}
+	case TokenTransactionTypeBurn:
+		// Burns create no outputs, so there are no SO-filled fields.
 	default:
 		return fmt.Errorf("token transaction type unknown")
 	}
@@ -552,12 +552,20 @@ func TestHashTokenTransactionV1UniqueHash(t *testing.T) {
 		t.Fatalf("failed to hash final transfer transaction: %v", err)
 	}
 
+	finalTimeLockedMintTokenTransaction := proto.CloneOf(finalMintTokenTransaction)
+	finalTimeLockedMintTokenTransaction.TokenOutputs[0].SpendableAfter = timestamppb.New(time.Unix(2000, 0))
+	finalTimeLockedMintHash, err := HashTokenTransactionV1(finalTimeLockedMintTokenTransaction, false)
+	if err != nil {
+		t.Fatalf("failed to hash final time locked issuance transaction: %v", err)
+	}
+
 	// Create map to check for duplicates
 	hashes := map[string]string{
-		"partialMint":     hex.EncodeToString(partialMintHash),
-		"partialTransfer": hex.EncodeToString(partialTransferHash),
-		"finalMint":       hex.EncodeToString(finalMintHash),
-		"finalTransfer":   hex.EncodeToString(finalTransferHash),
+		"partialMint":         hex.EncodeToString(partialMintHash),
+		"partialTransfer":     hex.EncodeToString(partialTransferHash),
+		"finalMint":           hex.EncodeToString(finalMintHash),
+		"finalTransfer":       hex.EncodeToString(finalTransferHash),
+		"finalTimeLockedMint": hex.EncodeToString(finalTimeLockedMintHash),
 	}
 
 	// Check that all hashes are unique
@@ -692,6 +700,202 @@ func TestHashTokenTransactionTransferV1(t *testing.T) {
 	}
 }
 
+func testBurnTransaction() *tokenpb.TokenTransaction {
+	return &tokenpb.TokenTransaction{
+		Version: 1,
+		TokenInputs: &tokenpb.TokenTransaction_BurnInput{
+			BurnInput: &tokenpb.TokenBurnInput{
+				OutputsToSpend: []*tokenpb.TokenOutputToSpend{
+					{
+						PrevTokenTransactionHash: testData.prevTxHash[:],
+						PrevTokenTransactionVout: 0,
+					},
+				},
+				TokenIdentifier: testData.tokenIdentifier,
+			},
+		},
+		SparkOperatorIdentityPublicKeys: [][]byte{testSparkOperatorPubKey.Serialize()},
+		Network:                         pb.Network_REGTEST,
+		ClientCreatedTimestamp:          timestamppb.New(time.UnixMilli(int64(testData.clientTimestamp))),
+	}
+}
+
+func TestHashTokenTransactionBurnV1(t *testing.T) {
+	burnTransaction := testBurnTransaction()
+	burnTransaction.ExpiryTime = timestamppb.New(time.UnixMilli(int64(testData.expiryTime)))
+
+	hash, err := HashTokenTransactionV1(burnTransaction, false)
+	if err != nil {
+		t.Fatalf("failed to hash burn transaction: %v", err)
+	}
+
+	want := []byte{
+		0xb2, 0xb0, 0x39, 0x03, 0x77, 0x82, 0x33, 0x03, 0x0d, 0x3d, 0x98, 0x76, 0x2c, 0x94, 0x0c, 0x50,
+		0x34, 0x1f, 0x7e, 0x65, 0x2e, 0xc3, 0x7f, 0x0b, 0x10, 0xa8, 0xe9, 0xbd, 0x84, 0x24, 0x17, 0x84,
+	}
+	if diff := cmp.Diff(want, hash); diff != "" {
+		t.Errorf("hash mismatch (-want +got):\n%s", diff)
+		t.Logf("Actual hash: %x", hash)
+	}
+
+	// The same outputs spent by a transfer hash differently.
+	transferTransaction := proto.Clone(burnTransaction).(*tokenpb.TokenTransaction)
+	transferTransaction.TokenInputs = &tokenpb.TokenTransaction_TransferInput{
+		TransferInput: &tokenpb.TokenTransferInput{OutputsToSpend: burnTransaction.GetBurnInput().GetOutputsToSpend()},
+	}
+	transferHash, err := HashTokenTransactionV1(transferTransaction, false)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, transferHash)
+
+	otherTokenTransaction := proto.Clone(burnTransaction).(*tokenpb.TokenTransaction)
+	otherTokenTransaction.GetBurnInput().TokenIdentifier = bytes.Repeat([]byte{0x08}, 32)
+	otherTokenHash, err := HashTokenTransactionV1(otherTokenTransaction, false)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherTokenHash)
+}
+
+func TestValidatePartialTokenTransactionBurn(t *testing.T) {
+	operators := map[string]*pb.SigningOperatorInfo{
+		"0": {Identifier: "0", PublicKey: testSparkOperatorPubKey.Serialize()},
+	}
+	signatures := []*tokenpb.SignatureWithIndex{{Signature: bytes.Repeat([]byte{0x01}, 64), InputIndex: 0}}
+
+	tests := []struct {
+		name       string
+		modify     func(tx *tokenpb.TokenTransaction)
+		signatures []*tokenpb.SignatureWithIndex
+		wantErr    string
+	}{
+		{
+			name:       "valid",
+			modify:     func(*tokenpb.TokenTransaction) {},
+			signatures: signatures,
+		},
+		{
+			name: "outputs",
+			modify: func(tx *tokenpb.TokenTransaction) {
+				tx.TokenOutputs = []*tokenpb.TokenOutput{{
+					OwnerPublicKey:  testIdentityPubKey.Serialize(),
+					TokenIdentifier: testData.tokenIdentifier,
+					TokenAmount:     testData.tokenAmount,
+				}}
+			},
+			signatures: signatures,
+			wantErr:    "burn transactions must not have any outputs",
+		},
+		{
+			name:       "missing token identifier",
+			modify:     func(tx *tokenpb.TokenTransaction) { tx.GetBurnInput().TokenIdentifier = nil },
+			signatures: signatures,
+			wantErr:    "burn token identifier cannot be nil and must be 32 bytes",
+		},
+		{
+			name:       "no outputs to spend",
+			modify:     func(tx *tokenpb.TokenTransaction) { tx.GetBurnInput().OutputsToSpend = nil },
+			signatures: nil,
+			wantErr:    "burn outputs to spend cannot be empty",
+		},
+		{
+			name:       "missing signature",
+			modify:     func(*tokenpb.TokenTransaction) {},
+			signatures: nil,
+			wantErr:    "number of signatures must match number of outputs to spend",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := testBurnTransaction()
+			tt.modify(tx)
+			err := ValidatePartialTokenTransaction(tx, tt.signatures, operators, []common.Network{common.Regtest}, true, true)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePartialTokenTransactionMultipleTokens(t *testing.T) {
+	operators := map[string]*pb.SigningOperatorInfo{
+		"0": {Identifier: "0", PublicKey: testSparkOperatorPubKey.Serialize()},
+	}
+	otherTokenIdentifier := bytes.Repeat([]byte{0x08}, 32)
+	outputs := func() []*tokenpb.TokenOutput {
+		return []*tokenpb.TokenOutput{
+			{OwnerPublicKey: testIdentityPubKey.Serialize(), TokenIdentifier: testData.tokenIdentifier, TokenAmount: testData.tokenAmount},
+			{OwnerPublicKey: testIdentityPubKey.Serialize(), TokenIdentifier: otherTokenIdentifier, TokenAmount: testData.tokenAmount},
+		}
+	}
+
+	swap := &tokenpb.TokenTransaction{
+		Version: 1,
+		TokenInputs: &tokenpb.TokenTransaction_TransferInput{
+			TransferInput: &tokenpb.TokenTransferInput{
+				OutputsToSpend: []*tokenpb.TokenOutputToSpend{
+					{PrevTokenTransactionHash: testData.prevTxHash[:], PrevTokenTransactionVout: 0},
+					{PrevTokenTransactionHash: testData.prevTxHash[:], PrevTokenTransactionVout: 1},
+				},
+			},
+		},
+		TokenOutputs:                    outputs(),
+		SparkOperatorIdentityPublicKeys: [][]byte{testSparkOperatorPubKey.Serialize()},
+		Network:                         pb.Network_REGTEST,
+		ClientCreatedTimestamp:          timestamppb.New(time.UnixMilli(int64(testData.clientTimestamp))),
+	}
+	swapSignatures := []*tokenpb.SignatureWithIndex{
+		{Signature: bytes.Repeat([]byte{0x01}, 64), InputIndex: 0},
+		{Signature: bytes.Repeat([]byte{0x01}, 64), InputIndex: 1},
+	}
+	require.NoError(t, ValidatePartialTokenTransaction(swap, swapSignatures, operators, []common.Network{common.Regtest}, true, true))
+
+	mint := &tokenpb.TokenTransaction{
+		Version: 1,
+		TokenInputs: &tokenpb.TokenTransaction_MintInput{
+			MintInput: &tokenpb.TokenMintInput{
+				IssuerPublicKey: testTokenPublicKey.Serialize(),
+				TokenIdentifier: testData.tokenIdentifier,
+			},
+		},
+		TokenOutputs:                    outputs(),
+		SparkOperatorIdentityPublicKeys: [][]byte{testSparkOperatorPubKey.Serialize()},
+		Network:                         pb.Network_REGTEST,
+		ClientCreatedTimestamp:          timestamppb.New(time.UnixMilli(int64(testData.clientTimestamp))),
+	}
+	mintSignatures := []*tokenpb.SignatureWithIndex{{Signature: bytes.Repeat([]byte{0x01}, 64), InputIndex: 0}}
+	require.ErrorContains(t, ValidatePartialTokenTransaction(mint, mintSignatures, operators, []common.Network{common.Regtest}, true, true), "must match mint input token identifier")
+}
+
+func TestValidatePartialTokenTransactionSpendableAfter(t *testing.T) {
+	operators := map[string]*pb.SigningOperatorInfo{
+		"0": {Identifier: "0", PublicKey: testSparkOperatorPubKey.Serialize()},
+	}
+	mint := func(version uint32) *tokenpb.TokenTransaction {
+		return &tokenpb.TokenTransaction{
+			Version: version,
+			TokenInputs: &tokenpb.TokenTransaction_MintInput{
+				MintInput: &tokenpb.TokenMintInput{
+					IssuerPublicKey: testTokenPublicKey.Serialize(),
+					TokenIdentifier: testData.tokenIdentifier,
+				},
+			},
+			TokenOutputs: []*tokenpb.TokenOutput{{
+				OwnerPublicKey:  testIdentityPubKey.Serialize(),
+				TokenIdentifier: testData.tokenIdentifier,
+				TokenAmount:     testData.tokenAmount,
+				SpendableAfter:  timestamppb.New(time.Unix(2000, 0)),
+			}},
+			SparkOperatorIdentityPublicKeys: [][]byte{testSparkOperatorPubKey.Serialize()},
+			Network:                         pb.Network_REGTEST,
+			ClientCreatedTimestamp:          timestamppb.New(time.UnixMilli(int64(testData.clientTimestamp))),
+		}
+	}
+	signatures := []*tokenpb.SignatureWithIndex{{Signature: bytes.Repeat([]byte{0x01}, 64), InputIndex: 0}}
+
+	require.NoError(t, ValidatePartialTokenTransaction(mint(1), signatures, operators, []common.Network{common.Regtest}, true, true))
+	require.ErrorContains(t, ValidatePartialTokenTransaction(mint(0), signatures, operators, []common.Network{common.Regtest}, true, true), "cannot have spendable_after in a version 0")
+}
+
 func TestHashTokenTransactionV1RequiredFields(t *testing.T) {
 	prevTxHash := sha256.Sum256([]byte("previous transaction"))
 
@@ -1825,6 +2029,19 @@ func TestHashFreezeTokensPayloadParameterChanges(t *testing.T) {
 			},
 			baseHash: baseHashV1,
 		},
+		{
+			name: "v1 with freeze expiry",
+			payload: &tokenpb.FreezeTokensPayload{
+				Version:                   1,
+				OwnerPublicKey:            ownerPubKey.Serialize(),
+				TokenIdentifier:           tokenIdentifier,
+				ShouldUnfreeze:            false,
+				IssuerProvidedTimestamp:   1234567890,
+				OperatorIdentityPublicKey: operatorPubKey.Serialize(),
+				FreezeExpiryTimestamp:     1234567891,
+			},
+			baseHash: baseHashV1,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2028,6 +2245,72 @@ func TestValidateFreezeTokensPayload(t *testing.T) {
 			expectedOperatorKey: operatorPubKey,
 			wantErr:             "",
 		},
+		{
+			name: "v0 with freeze expiry (should fail)",
+			payload: &tokenpb.FreezeTokensPayload{
+				Version:                   0,
+				OwnerPublicKey:            ownerPubKey.Serialize(),
+				TokenPublicKey:            tokenPubKey.Serialize(),
+				IssuerProvidedTimestamp:   1234567890,
+				OperatorIdentityPublicKey: operatorPubKey.Serialize(),
+				FreezeExpiryTimestamp:     1234567891,
+			},
+			expectedOperatorKey: operatorPubKey,
+			wantErr:             "freeze expiry timestamp requires version 1",
+		},
+		{
+			name: "freeze expiry when unfreezing (should fail)",
+			payload: &tokenpb.FreezeTokensPayload{
+				Version:                   1,
+				OwnerPublicKey:            ownerPubKey.Serialize(),
+				TokenIdentifier:           tokenIdentifier,
+				ShouldUnfreeze:            true,
+				IssuerProvidedTimestamp:   1234567890,
+				OperatorIdentityPublicKey: operatorPubKey.Serialize(),
+				FreezeExpiryTimestamp:     1234567891,
+			},
+			expectedOperatorKey: operatorPubKey,
+			wantErr:             "cannot be set when unfreezing",
+		},
+		{
+			name: "freeze expiry before timestamp (should fail)",
+			payload: &tokenpb.FreezeTokensPayload{
+				Version:                   1,
+				OwnerPublicKey:            ownerPubKey.Serialize(),
+				TokenIdentifier:           tokenIdentifier,
+				IssuerProvidedTimestamp:   1234567890,
+				OperatorIdentityPublicKey: operatorPubKey.Serialize(),
+				FreezeExpiryTimestamp:     1234567890,
+			},
+			expectedOperatorKey: operatorPubKey,
+			wantErr:             "must be at least 1m0s after the issuer provided timestamp",
+		},
+		{
+			name: "freeze expiry within tolerance of timestamp (should fail)",
+			payload: &tokenpb.FreezeTokensPayload{
+				Version:                   1,
+				OwnerPublicKey:            ownerPubKey.Serialize(),
+				TokenIdentifier:           tokenIdentifier,
+				IssuerProvidedTimestamp:   1234567890,
+				OperatorIdentityPublicKey: operatorPubKey.Serialize(),
+				FreezeExpiryTimestamp:     1234567890 + 59_999,
+			},
+			expectedOperatorKey: operatorPubKey,
+			wantErr:             "must be at least 1m0s after the issuer provided timestamp",
+		},
+		{
+			name: "valid v1 payload with freeze expiry",
+			payload: &tokenpb.FreezeTokensPayload{
+				Version:                   1,
+				OwnerPublicKey:            ownerPubKey.Serialize(),
+				TokenIdentifier:           tokenIdentifier,
+				IssuerProvidedTimestamp:   1234567890,
+				OperatorIdentityPublicKey: operatorPubKey.Serialize(),
+				FreezeExpiryTimestamp:     1234567890 + 60_000,
+			},
+			expectedOperatorKey: operatorPubKey,
+			wantErr:             "",
+		},
 		{
 			name:                "valid payload with nil expected operator (should fail)",
 			payload:             validPayloadV0,
@@ -13,7 +13,6 @@ import (
 	"github.com/btcsuite/btcd/wire"
 
 	"github.com/btcsuite/btcd/btcjson"
-	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/lightsparkdev/spark"
 	"github.com/lightsparkdev/spark/common"
 	"github.com/lightsparkdev/spark/so/ent"
@@ -149,7 +148,7 @@ func QueryNodesWithExpiredTimeLocks(ctx context.Context, dbTx *ent.Tx, blockHeig
 }
 
 // CheckExpiredTimeLocks checks for TXs with expired time locks and broadcasts them if needed.
-func CheckExpiredTimeLocks(ctx context.Context, bitcoinClient *rpcclient.Client, node *ent.TreeNode, blockHeight int64, network common.Network) error {
+func CheckExpiredTimeLocks(ctx context.Context, btcClient bitcoinClient, node *ent.TreeNode, blockHeight int64, network common.Network) error {
 	if node.NodeConfirmationHeight == 0 {
 		nodeTx, err := common.TxFromRawTxBytes(node.RawTx)
 		if err != nil {
@@ -168,7 +167,7 @@ func CheckExpiredTimeLocks(ctx context.Context, bitcoinClient *rpcclient.Client,
 			if parent.NodeConfirmationHeight > 0 {
 				timelockExpiryHeight := uint64(nodeTx.TxIn[0].Sequence&0xFFFF) + parent.NodeConfirmationHeight
 				if len(node.DirectTx) > 0 && timelockExpiryHeight+spark.WatchtowerTimeLockBuffer <= uint64(blockHeight) {
-					if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), node.DirectTx); err != nil {
+					if err := BroadcastTransaction(ctx, btcClient, node.ID.String(), node.DirectTx); err != nil {
 						// Record node tx broadcast failure
 						if nodeTxBroadcastCounter != nil {
 							nodeTxBroadcastCounter.Add(ctx, 1, metric.WithAttributes(
@@ -198,10 +197,10 @@ func CheckExpiredTimeLocks(ctx context.Context, bitcoinClient *rpcclient.Client,
 
 		timelockExpiryHeight := uint64(refundTx.TxIn[0].Sequence&0xFFFF) + node.NodeConfirmationHeight
 		if len(node.DirectRefundTx) > 0 && timelockExpiryHeight+spark.WatchtowerTimeLockBuffer <= uint64(blockHeight) {
-			if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), node.DirectRefundTx); err != nil {
+			if err := BroadcastTransaction(ctx, btcClient, node.ID.String(), node.DirectRefundTx); err != nil {
 				// Try broadcasting the DirectFromCpfpRefundTx as a fallback
 				if len(node.DirectFromCpfpRefundTx) > 0 {
-					if err := BroadcastTransaction(ctx, bitcoinClient, node.ID.String(), node.DirectFromCpfpRefundTx); err != nil {
+					if err := BroadcastTransaction(ctx, btcClient, node.ID.String(), node.DirectFromCpfpRefundTx); err != nil {
 						// Record refund tx broadcast failure
 						if refundTxBroadcastCounter != nil {
 							refundTxBroadcastCounter.Add(ctx, 1, metric.WithAttributes(
@@ -0,0 +1,31 @@
+package sparktesting
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/lightsparkdev/spark/so/ent"
+	st "github.com/lightsparkdev/spark/so/ent/schema/schematype"
+	"github.com/stretchr/testify/require"
+)
+
+// NewTestTokenCreate returns a builder for a regtest token issued by issuerPublicKey with a random token identifier.
+// Callers can override any of the defaults before saving.
+func NewTestTokenCreate(t *testing.T, tx *ent.Tx, issuerPublicKey []byte) *ent.TokenCreateCreate {
+	randomBytes := func(length int) []byte {
+		b := make([]byte, length)
+		_, err := rand.Read(b)
+		require.NoError(t, err)
+		return b
+	}
+	return tx.TokenCreate.Create().
+		SetIssuerPublicKey(issuerPublicKey).
+		SetTokenName("TestToken").
+		SetTokenTicker("TTK").
+		SetDecimals(0).
+		SetMaxSupply(make([]byte, 16)).
+		SetIsFreezable(true).
+		SetNetwork(st.NetworkRegtest).
+		SetTokenIdentifier(randomBytes(32)).
+		SetCreationEntityPublicKey(randomBytes(33))
+}
@@ -19,3 +19,19 @@ func SubscribeToEvents(ctx context.Context, config *TestWalletConfig) (pb.SparkS
 		IdentityPublicKey: config.IdentityPublicKey().Serialize(),
 	})
 }
+
+// ResumeEventsSubscription subscribes to events, replaying every event recorded after
+// lastSequence before streaming live events.
+func ResumeEventsSubscription(ctx context.Context, config *TestWalletConfig, lastSequence uint64) (pb.SparkService_SubscribeToEventsClient, error) {
+	sparkConn, err := config.NewCoordinatorGRPCConnection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to coordinator: %w", err)
+	}
+	// Note: We don't defer close here because the stream needs the connection
+	sparkClient := pb.NewSparkServiceClient(sparkConn)
+
+	return sparkClient.SubscribeToEvents(ctx, &pb.SubscribeToEventsRequest{
+		IdentityPublicKey:  config.IdentityPublicKey().Serialize(),
+		ResumeFromSequence: &lastSequence,
+	})
+}
@@ -7,15 +7,10 @@ import (
 
 	"github.com/lightsparkdev/spark/common/keys"
 
-	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightsparkdev/spark/common"
 )
 
-func EphemeralAnchorOutput() *wire.TxOut {
-	return wire.NewTxOut(0, []byte{txscript.OP_TRUE, 0x02, 0x4e, 0x73})
-}
-
 // maybeApplyFee subtracts the default fee from the amount if it's greater than the fee.
 // Returns the original amount if it's less than or equal to the fee.
 func maybeApplyFee(amount int64) int64 {
@@ -110,7 +105,7 @@ func createRefundTxs(
 		return nil, nil, fmt.Errorf("failed to create refund pkscript: %w", err)
 	}
 	cpfpRefundTx.AddTxOut(wire.NewTxOut(amountSats, refundPkScript))
-	cpfpRefundTx.AddTxOut(EphemeralAnchorOutput())
+	cpfpRefundTx.AddTxOut(common.EphemeralAnchorOutput())
 
 	// Create direct refund tx (with fee, no anchor)
 	directRefundTx := wire.NewMsgTx(3)
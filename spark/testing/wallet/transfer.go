@@ -114,6 +114,150 @@ func SendTransferWithKeyTweaks(
 	return resp.Transfer, nil
 }
 
+// SendHtlcTransfer sends leaves that the receiver can only claim by revealing the preimage of the payment hash
+// before the expiry time.
+func SendHtlcTransfer(
+	ctx context.Context,
+	config *TestWalletConfig,
+	leaves []LeafKeyTweak,
+	receiverIdentityPubkey keys.Public,
+	paymentHash []byte,
+	expiryTime time.Time,
+) (*pb.Transfer, error) {
+	sparkConn, err := config.NewCoordinatorGRPCConnection()
+	if err != nil {
+		return nil, err
+	}
+	defer sparkConn.Close()
+
+	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
+	}
+	authCtx := ContextWithToken(ctx, token)
+
+	client := pb.NewSparkServiceClient(sparkConn)
+	transferID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate transfer id: %w", err)
+	}
+
+	transferPackage, err := CreateTransferPackage(authCtx, transferID, config, client, leaves, receiverIdentityPubkey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare transfer data: %w", err)
+	}
+
+	resp, err := client.StartHtlcTransfer(authCtx, &pb.StartHtlcTransferRequest{
+		Transfer: &pb.StartTransferRequest{
+			TransferId:                transferID.String(),
+			OwnerIdentityPublicKey:    config.IdentityPublicKey().Serialize(),
+			ReceiverIdentityPublicKey: receiverIdentityPubkey.Serialize(),
+			ExpiryTime:                timestamppb.New(expiryTime),
+			TransferPackage:           transferPackage,
+		},
+		PaymentHash: paymentHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start htlc transfer: %w", err)
+	}
+
+	return resp.Transfer, nil
+}
+
+// ProvideHtlcPreimage reveals the preimage that unlocks the HTLC transfer with the given id.
+func ProvideHtlcPreimage(ctx context.Context, config *TestWalletConfig, transferID string, preimage []byte) (*pb.Transfer, error) {
+	sparkConn, err := config.NewCoordinatorGRPCConnection()
+	if err != nil {
+		return nil, err
+	}
+	defer sparkConn.Close()
+
+	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
+	}
+	authCtx := ContextWithToken(ctx, token)
+
+	client := pb.NewSparkServiceClient(sparkConn)
+	paymentHash := sha256.Sum256(preimage)
+	resp, err := client.ProvidePreimage(authCtx, &pb.ProvidePreimageRequest{
+		PaymentHash:       paymentHash[:],
+		Preimage:          preimage,
+		IdentityPublicKey: config.IdentityPublicKey().Serialize(),
+		TransferId:        transferID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to provide preimage: %w", err)
+	}
+
+	return resp.Transfer, nil
+}
+
+// BatchTransferRecipient is a receiver of a batch transfer and the leaves sent to them.
+type BatchTransferRecipient struct {
+	Leaves                 []LeafKeyTweak
+	ReceiverIdentityPubkey keys.Public
+}
+
+// SendBatchTransferWithKeyTweaks sends leaves to several receivers in one batch transfer.
+func SendBatchTransferWithKeyTweaks(
+	ctx context.Context,
+	config *TestWalletConfig,
+	recipients []BatchTransferRecipient,
+	expiryTime time.Time,
+) ([]*pb.Transfer, error) {
+	sparkConn, err := config.NewCoordinatorGRPCConnection()
+	if err != nil {
+		return nil, err
+	}
+	defer sparkConn.Close()
+
+	token, err := AuthenticateWithConnection(ctx, config, sparkConn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate with server: %w", err)
+	}
+	authCtx := ContextWithToken(ctx, token)
+
+	client := pb.NewSparkServiceClient(sparkConn)
+	batchID, err := uuid.NewV7()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate batch id: %w", err)
+	}
+
+	transferRequests := make([]*pb.StartTransferRequest, 0, len(recipients))
+	for _, recipient := range recipients {
+		transferID, err := uuid.NewV7()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate transfer id: %w", err)
+		}
+		transferPackage, err := CreateTransferPackage(authCtx, transferID, config, client, recipient.Leaves, recipient.ReceiverIdentityPubkey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare transfer data: %w", err)
+		}
+		transferRequests = append(transferRequests, &pb.StartTransferRequest{
+			TransferId:                transferID.String(),
+			OwnerIdentityPublicKey:    config.IdentityPublicKey().Serialize(),
+			ReceiverIdentityPublicKey: recipient.ReceiverIdentityPubkey.Serialize(),
+			ExpiryTime:                timestamppb.New(expiryTime),
+			TransferPackage:           transferPackage,
+		})
+	}
+
+	resp, err := client.StartBatchTransfer(authCtx, &pb.StartBatchTransferRequest{
+		BatchId:   batchID.String(),
+		Transfers: transferRequests,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start batch transfer: %w", err)
+	}
+
+	transfers := make([]*pb.Transfer, 0, len(resp.Transfers))
+	for _, transferResp := range resp.Transfers {
+		transfers = append(transfers, transferResp.Transfer)
+	}
+	return transfers, nil
+}
+
 func prepareTransferPackage(
 	ctx context.Context,
 	config *TestWalletConfig,